@@ -19,51 +19,58 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaX2/bOBL/KgTvHnZxcuw4Se/WQB4St9saW6dBnKQPbVDQ0tjiRiK1JGXXLfzdDyT1",
-	"16ZsxU2Ku8M9VQ2H83+Gwx/9Hfs8TjgDpiQefMfSDyEm5vNiDkzpj0TwBISiYP7sCyAKgguzNOMiJgoP",
-	"cEAUdBSNAXtYrRLAAyyVoGyO157eEgBTlER3ItLbtihoUOOWpjRwMZKKqNRoASyN8eATZlx1fM4Y+Ar0",
-	"liWhirJ5Z8ZFpxQrsYdBCC6wh+dEhaAZdiijerFD2QKY4mKFPZwmHcU72hrsYclT4UNnzhngh0Z1RmzG",
-	"nUalSfBUTy1ASMqZg93awwL+SqmAQNtt/JO5o6bIpre9SsCqKpWySsv49E/wldbDxP5a8K+r7QQIlUqy",
-	"OMaUvQc2VyEeHHuYpVFEphHggRIpbFrn4a8dThLa8XkAc2Ad+KoE6SgyN1wXJKLG7QPMY6oYjbxURJ5U",
-	"RCjJuFpSFZ5r0dL4wnz9ZC02VGC8cNDLahCTr+fHvV4Pr7VYd6wmJgPuErvVUbO7CrC9SnkKaq8YJ9gS",
-	"GbUo3wOEFMzXtdr/cb6W1XpPCR/AWYeqbyK1o5YP5msToNoG2nWAXJNKuFxF/5ooIhUXjgQKqHy0Qd5q",
-	"WTMBMCQJ8alavb2skFCmYA5C04REBEsi4ML3IQKhW9CYL6BCPOU8AsIMMZfKygpA+oImyngRj4xNMwoC",
-	"8RlSISBNiZYhCEAqpBIFuQGISkSUIn5oDoXdBbn2cMwDcB9MieCK+zy6NQsOAsUVifbZr5p2L4AFXOxv",
-	"92Z1W9iW9wuOXh6yZudvGJd7wZUZb8zZuZUVMUhJ5rAdKkOP8mVvj3E53cPaw++oVHwuSGyZJgJ8rXAe",
-	"uY2sJIrof6mCWO7yPCZCkJWJNGX3JErBTS0VJK6VTYVzJtkOz2ri8tw7Ll0zVJIOuciMqHnuKo2nNsGH",
-	"13fIN0SNCVzR3E/SCfcfQe3lKTOyNlypowzvGP0rBUTLapxxYetP16Nrqokh5mI1vtxmpt2D7DKiDI1N",
-	"SufHCGXq1WkrPZvrt22BFWXTXAQjNhPEEcsolQqEvAahG6gPTIF4Ylb6SfphAWLI45iqOBu7657SodM0",
-	"fkGDboii/AgNSeSnka4SRCQyLQJdRBE3hYMWw+s7ibro1vz9OlxJ6pMIDbPMKsdSnmoXF7oxkzRauaKr",
-	"yppVfxcwwwP8t255gehmt4dueZI4jNVZcs2XIPTMYpmSIKDaThJd13zb6LkyKppbe8VMOTbopCM4tMF0",
-	"N5+ntBmT0vtienMxzpP/kNBmW/PYZv8lC0JtubSKLgO15OKxvQuv7AaX1fZ4yurB7UOH6/SmsnKaHKyp",
-	"3uWx3l5fxM8Xvs2ztxS9nbwVB9Yqxd1A8ntmYxPZVQy7glKw1o40RVtLtDFJdPvPpCBGYtDppIcoKlBx",
-	"/zUDFHZovii72pO0yPZ9cZ0jo9f5GLcYWu775oQKN6/02E5Pv84mhLq3ad7Jdxujidobf59ZYZNxL/VY",
-	"btsX6ySyyjmtShanQ85mdO4Y0GFG0ki9JQqWZFW7jdFkcfoctzGanH4hQSDsffTMqB8w+dNk0eQiCATI",
-	"nydRplMGakzk4/NcOw27LzGRj/ZCt32fK22sSfc242s970qS92RqR6F6fjzC6llsiAx7c73dGKN/nOeG",
-	"L7TKuRiXpWM6F/qYZCMpU8edlUgJUuYn7zYoydPaytbYu7Ujyl27u01ZMq8qP5fmMiM/T7dLeiGXVPmh",
-	"Uxfdwt330eyqmQOkUhEWEBHYG6ESdJpapLRg7+GUyTRJuNALLpxzERHWcPtfxHLY5Ej3HdZo7nLExGAT",
-	"jjjmOPSufmrB6rVnqe9L6KV+6BgylOEh6Jf8Q5H5r8ic3AFahsDQh/sLtCQSBXzJIk6CdjBCVfZHIpj+",
-	"85YK2UJ+O0Z0lksmNeUCOpuBkGgmeIz8VAi9WCNpo9IBaH1LLJ66r0NJjonujZZFT3WbleF1Oo2o/wfs",
-	"3Xmf9Y9gMnlXbjKJWCmknRwKQieaSqtDWqthp2gN7Wdo26QdE3RjWXN2LSCmsnYvqoBmT35rcL0nGOnN",
-	"DwYVHZrrdzcQbfJ31DbBnh6JTasqLzu5aJfq+ey2PSkGrZ5inDzHN2Dh1ksB5FF3EsdbShXu2nlxLQjz",
-	"y9COe9LvXNjD0baGdnQfqQqz3iR377niajd71wUKO3Xbq0iTVLfH5W6obfdAvh2utUVRiwPuwP0WFT5w",
-	"84R+g1tqc/OQm2GVxySNY2Ib1pbzNN3tKgH5I4I0gz1C7ARCObtcDXmcRPCVqtXTMKD6efq6wlPfKO/H",
-	"Ek1XKM6nQ+QXYlAEC4jQL73zu3Li8dDx+RsiVx7qn48hoGnsoZPzd0QEHjo9/xhSBW8jvoBf8X6DknRf",
-	"qA6xhviCS2nAW0VBoGlqEFz0CxzNjzz0Gfc6p5+x/jjr/Mt+/NY5fmW/jv/ZOenbz5P+Pz7jFmaMDZD0",
-	"gpZYAfuNcdlw0nmVrb866xz3M3uP+791+mcZef/sVTtDr6hf1PYzp9/VaIjMzF8xLFM1UzKzx/5z2qRw",
-	"kcbV1txqxti4HrmGjYr5B3QnVm3IN0AkZ8+pHZdPDUsD1lg6kxcPvYc0uGy3q68lzwZnCxIffFzsGwta",
-	"zQRPHgg02SQkAoLXVD7ufH7StaFColBIFoCIQhEQqRBngKThgPQhgr0nDRS1aaI47XNPFidw9SivB6wh",
-	"k12155w6nDeSF/9RiJThl0ewI+993AgiGHhs3/xc4orrptFgc4TYEmRiYagcz3z2gULzQZJ+A0QZur0s",
-	"3w31QdDutWIRFx1rV5JRVmPcJp0y1UsRDzuGpBfxglnIQJLnc0VDvRlhBn/Xbsp+XbDbTblAr2blw84+",
-	"uzGLN0NuJQDVcL2dCxLADfg8joEFRDnhnWIdAvRhgrJdxsXj23tUwbn0snGNTxiaQk4aIMURQVWyvVdn",
-	"P/OKC0PLfbK2gInxSUR9YNL0YHvdxxcJ8UNA/aOevnGLCA/s79sG3e5yuTwiZvmIi3k32yu770fDN1eT",
-	"N53+Ue8oVLEFE6jS/QV/SIBNQjpTqDhl0UWwoJILdHE9Qp0MRgIWJJyy6m8BBzhlAcwog8AEMgFGEooH",
-	"+OSod3SsGydRoYlllyS0uzjuGlay+50G6275E60kdSSmBQWQpcoffyy6Z0RlA0FQkFZ+2WZECxKDfSX7",
-	"5HhNqnKj+m9a1xzVGFiEo4yb7cK2BbaAI9YPdjNIdcmDlc1mpjJkkiRJRH2jfvdPaTOzZL0XCathJusM",
-	"CJcJZxnc0+/1tr354Q8doX7vuGnp1O56FjXtD4CMZnVRlyRAN9YvVubxy8u8YyRVIRf0m83S097Jywv9",
-	"nYspDQJgVuLpy0u84grNeMqMjWc/I5gjpkAwEqEJiAUIlBN62A4inzIs/kH/KW8AdhB9SgeQplTLV2c+",
-	"0y3Xov/uRmChxVEFzdvXDLLuUnD9r2kIDhR142VMK9rQIZ5RA1d+/L+v/I/0ld//w9pKVqYPZrM0VLau",
-	"7TDUxeuH9b8DAAD//8+ibUOqMgAA",
+	"H4sIAAAAAAAC/+w6W3PbNtZ/BYPve2hnKct2nOxWM36wlTTxtrI9vvWh9WQg4khETAIsAEpRO/rvOwB4",
+	"kwhKlCNnMzt5Mi0A537DOfgbhyJJBQeuFR78jVUYQULs59kUuDYfqRQpSM3A/hxKIBromV2aCJkQjQeY",
+	"Eg09zRLAAdaLFPAAKy0Zn+JlYI5Q4JqR+F7G5lhjB6Mr0LKMUR8gpYnOLBXAswQPfsdc6F4oOIdQgzky",
+	"J0wzPu1NhOxVaBUOMEgpJA7wlOgIDMAe48ws9hifAddCLnCAs7SnRc9wgwOsRCZD6E0FB/zYSs4Fnwgv",
+	"U1lKd5XUDKRignvALQMs4c+MSaCGbyufXBwrhKxLO6gprE5ShaviTIw/QagNHVb311J8XjQNINI6zfWY",
+	"MP4r8KmO8OAowDyLYzKOAQ+0zGCduwB/7gmSsl4oKEyB9+CzlqSnydRCnZGYWbEPsEiY5iwOMhkHShOp",
+	"FRd6znR0alArKwv79ZWpWCOBi1JAL0tBQj6fHh0eHuLlcllCW9PVrbWA+9Qd9fjsJgfsTlJhgkYqVgjO",
+	"RS46uO8zkJTAlyu+/+VwHajlFhd+BmSjqmOrqQ2+/Gy4zgDqYaBbBCgoqanL5/TDiPAp3BAN/xbjphFR",
+	"waHGzFiIGAg3B4FTtUuUY1yDnJF4xHimoa5TszIFaTYlQFQmISkyE9OQ2I//lzDBA/x//Spz9fO01a9Y",
+	"GFXHceU1REqywDvZrTMSuWsgTy7oKtmNLeskzRmnYv5BZNIrkXXNFwwUuFYBNIVcZ6NUWeC0uibtzcZR",
+	"l2wz0pCUhEwvzhe5YktxMa7fnFSiqmk6tKDpLkcoYfGiIukaZJiTQ0GFkqXaeh6+jYgEJCZIR4AeRogy",
+	"9aSQhLlkWgNHKUhEyeIAXSXmB4oyrlmM9FwgRZI0BoUMAAmhkBToAQ5q+heZCfIleTxLxo66iRRJd1PJ",
+	"Efm9QIvdbG575WB3VVhzai2mNVUEa9rcbBa3FuDLWMSaUtlfpU7HsQifFMoPIMV4CHYhlTBjIlO5Hisb",
+	"CBAxFpAK4wtovEDD8zscdKHKyF1pkqQvpJIK/h4Uoe7TWBDa1Acx5ULXwFeZ5o7hN7eGRphb47wgpkLl",
+	"4+0t0URpIT3WZRzaK2Jj1wDDXG7vz/3uFRFJ50TCWRhCDNLUxiMxa0lzkVC54Fbt8cIm2wkDWViZ2Ynm",
+	"EUhji0whWjCAmEJEaxJG9rayuVI0SVBQ8N+YUim0CEV8Zxc8G7TQJN7Gv247PQNOhdxuuna1iawh/RJi",
+	"UKisXfhrzBVS8FnGO3upa1hFAkqRKTRVZfejYjnYwlyx73EZ4A9MaTGVJHFAUwmhIbjQ3JpVEk18yb8h",
+	"+Sr7J4w/kDgD/26lIe1QFJRA8hOBo8QnuQ9C+dJ3mg2F9AXdS5vdjIEPr+9RaDe1GnA9lKfZrQifQG+F",
+	"qfJtXaAyjxvec/ZnBohV3jgR0vmf8UdffEsgEXIxOm8CM+JBbhkxjkbnvhSxnc52/+3qYKXbtDvBBZ9I",
+	"4tFlnCkNUl2DNAHUFEggd7TKMM2uZiCHIkmYTrwlllGd2ROWe9AN0UwcoCGJwyw2XmJSrg0R6CyOhXUc",
+	"NBte3yvUR3f29+tooVhIYjTMLatDmVVG1e4JqsokHmaNlVyLOUhzmc6TJaXM8Eni6xXZtkqu0oqB1p0w",
+	"644tNBkNDp0y/cFnlzBjTXqbTm/ORoXxP0e1+dFCt/m/ZEaYc5dO2uWg50I+dRfhpTvg49qlp9wf/DL0",
+	"ld6axJXntBbomsQfCl0312fJ/tS3nnsr1E3jrQlwxVP8AaRogLYGkU3OsEkpJWgjSOu0K4Y2IqkJ/zkW",
+	"xEkCxpxMEcUkKhuztoDCHspnVVTbiYr83EdfHrl4W5Rxs6GDvq1OqEELKoltlPTbvEJYlTYrIvlmZiay",
+	"zsS2/Q85F84Yt+4eKc/9xDUUDF4vV+nsZCj4hE2bLFGYkCzW74mGOVms3v/S2ck+2oQsPflIKJWuUfra",
+	"kk+5+mq4WHpGqQT19TCqbMxBj4h62k8/1IL7mBD15DqNzUZjxeMK9mBdv07yPiP5lYwhbtrHEyz2wkNs",
+	"wdu+61oZ/eUw12RhSC7Q+Dgdsak0aZJfKJV57qxEKVCqyLzNaZnIuPanA+a/6caFaDeHKbctqOMvsPnY",
+	"KPJp06VnZhQSRl5aTAjf0O6sJndKE06JpO5GqCUbZ26EV4IPcMZVlrpOjXcAN4sJb7n9zxI1bBOk/w5r",
+	"KfcJ4ta2Wls6KdviqZuiLgO3+6GaCawmHbsN5Y169EPxocn0R2QzNzX9BI6uHs7QnChExZyb/k63NkId",
+	"929EcvNzg4R8obgdIzYpMJMV4iibTEAqZPqGKMykNIsrW7qQ9IwxcschMfNfh9JiWLdVW26sZ8Ksiq6z",
+	"cczCX2DryYc8ftDb2w/VIWuINUfaCKHc6B3zsXqR1qnYKUND9xraBWlPBd3q1oJfS0iYAuVvmu08BPcN",
+	"ui329kl2jYZ2/908Id2lK/ocTaxzVa4EJWof6UXt1qwUaac3Al6Yoxtw06NzCeTJRJIm/Kje7tp4cS03",
+	"FpehDfekn4V0ydGFhm77fmM6ymOT2nzmUujN4H0XKOylbSshbVj9ElebW22bC/Kmupaui1omuGeef3/+",
+	"BYfNFOaOgXzuzbAO4zZLEuICVkN4Zp/pA6svQWQAbEHiKhAm+PliKMws4jPTi916QKv59G0NprlRPoyU",
+	"GTclRXWIwhINimEGMfrh8PS+qngCdHT6jqhFgI5PR0BZlgTo1ekHImmATk5/i5iG97GYwY94O0Nptk1V",
+	"z+GGhFIoZZu32vRbx5nt4KIf4GB6EKA/8GHv5A9sPl73/uU+fuodvXFfR//svTp2n6+O//EH7sDGyDaS",
+	"XpATh2A7Mz4eXvXe5OtvXveOjnN+j45/6h2/zrcfv37TjdFLFpa+vWfzu7wYIlvz1xjLSc2JzPlxf07a",
+	"CC7NuB6aO9UYa9cjX7FRY/8Z0YnXA/INECX4PqkTale1tPQaK2GK8gXScwJcftoX19K9tbMlSZ6dLraV",
+	"BZ1qgp0LArPNvv+gb82rj03jJ+MbOiIaRWQGiGgUA1EaCQ5IWQj24YjnUcCmgmKlmiizfSHJMgPXU/mq",
+	"wlos2ed73qrDeyN58deKSkUfTYfEyOYhaW0i2PbYtvq56isu20qD9RKigcjqwu7yjPncgMLAQcq8KmEc",
+	"3Z1Xc0OTCLpNK2ZJGbE2GRnjK4C7mFNOeoXicUOR9CJSsAt5k2R/omjxN4tMTHIxOaRbxFQgDFa4fNwY",
+	"Z9dq8faWW9WAarneTiWhcANmRgacEu1t75TrQNHVLcpPWRGP7h5Qrc9llq1oQsLRGIqt1ExFCKpv23p1",
+	"DnOp+HpohUyWrmFiZRKzELiyMdhd9/FZal6qoOODQxzgTMZ44B5eD/r9+Xx+QOzygZDTfn5W9X+9GL67",
+	"vH3XOz44PIh04poJTJv4gq9S4LcRm2hUZll0RmdMCYnOri9QL28jAaepYLz+SH2AM05hwjhQq8gUOEkZ",
+	"HuBXB4cHRzjAKdGR1WWfpKw/O+pbUKr/N6PLfvV2OM08humaAsjtKoY/9jy2qPKCgJZba0+uLWpJEnBT",
+	"st8906Q6NGZ+M7QWXY2B63BUenNR2IXADu2I5aM7DEqfC7pw1sx13pkkaRqz0JLf/6ScZVagt3bCVnom",
+	"y7wRrlLB83aPeerckObVL0ZDx4dHbUsnh4d7I9M9ALKUraI6JxTdOLk4nEcvj/Oek0xHQrK/nJWeHL56",
+	"eaQ/CzlmlAJ3GE9eHuOl0GgiMm55fP01lHnBNUhOYnQLcgYSFRsD7AqR/CEhfjQ/FQHAFaJ5BHDvKXvS",
+	"9B0/udftU/CEgvegrb+6/UjauGCeJprO+CcxrpwZRfblAwqJlAsksmaseA969Un99lCRxx5H+suFi6YL",
+	"70V/q9x69Hj1y3df3Lsv/vyNuWJuvN18sfbCuCU1mymXdYrh+V35KF+TJ+B5hdrurJ7sbaA1nkp/O465",
+	"/zze9i58baptqF1+C6Hhe2nwPRx93XDU6XKgrPtXD9LExNzGimjguyO4qeNFbdD3PxtjPAPWrxxeHAXf",
+	"48r3uPJfiCvLACu7y/m165P08fJx+Z8BAN+jieZeQQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
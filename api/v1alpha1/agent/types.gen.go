@@ -17,6 +17,12 @@ type AgentStatusUpdate struct {
 	Version       string             `json:"version" validate:"required,max=20"`
 }
 
+// ChangeRateSamplesUpload defines model for ChangeRateSamplesUpload.
+type ChangeRateSamplesUpload struct {
+	AgentId openapi_types.UUID              `json:"agentId"`
+	Samples []externalRef0.ChangeRateSample `json:"samples"`
+}
+
 // SourceStatusUpdate defines model for SourceStatusUpdate.
 type SourceStatusUpdate struct {
 	AgentId   openapi_types.UUID     `json:"agentId"`
@@ -26,5 +32,8 @@ type SourceStatusUpdate struct {
 // UpdateAgentStatusJSONRequestBody defines body for UpdateAgentStatus for application/json ContentType.
 type UpdateAgentStatusJSONRequestBody = AgentStatusUpdate
 
+// UploadChangeRateSamplesJSONRequestBody defines body for UploadChangeRateSamples for application/json ContentType.
+type UploadChangeRateSamplesJSONRequestBody = ChangeRateSamplesUpload
+
 // UpdateSourceInventoryJSONRequestBody defines body for UpdateSourceInventory for application/json ContentType.
 type UpdateSourceInventoryJSONRequestBody = SourceStatusUpdate
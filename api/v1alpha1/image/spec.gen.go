@@ -19,16 +19,18 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xWwXLTMBD9Fc3C0VhpKRffUqbQDKVlWsqlk4NqbyxRW1KldToh439nVnYItAF6KRd6",
-	"8o539d7T7r6x11C61juLliIUa4ilxlal8CgEFzjwwXkMZDC9bjFGVSOHFcYyGE/GWSiGerFJZ0Arj1BA",
-	"pGBsDX2fQcDbzgSsoLj6ATPvOWPswjFiY0q0MYFb1fLxqVelRrGfTyCDLjRQgCbysZDy7u4uVymdu1DL",
-	"8WyUJ7O3R6cXR6/280muqW2gz4AMNQx35tFeaLMg8dHUQbF0Ma2WJrogTKtqFNNPM8hgiSEO1+pshQtj",
-	"sWIY59Eqb6CA1/kk34MMvCKd+iKVN3K5JxOKvF6Ru0Er1+nRyzVfp+e6Gulh794jCdIozr5MRxlLo8Tl",
-	"+QkkzkHorBoqZ1xwuPrMyElBUC0ShgjF1X3gy4hB0Fhp+A0LhmzT3k1qOxoKHWbjHrDQB2O8T5HkiIS3",
-	"k2LMPJ5hzsXROx4m5/cnE36UzhLa1DzlfWPK1BTplovt4nK0cKFVBAVcG6vCascm9tm9K0zttvM85oM/",
-	"Mn6NfOhnypcBF1DAC7k1kxydJAcb7SA9VJU4x9sOIw2ce0/PeWlVR9oF823Y54PJwdOTnjp65zqbCN/8",
-	"i87OLGGwqhEXGJYYxKYwA1I1uwSGSc/7DDSq6qEfj1FVjzQkl/6njnw20bOJ+AOegdSoGtK//b4dp7Qo",
-	"NZY3uxzUpPX8+46dffhFwcg6/EPEpHOw3PCjIKGf998DAAD//5Ev0OTiCAAA",
+	"H4sIAAAAAAAC/+xWQU8bOxD+K9a8d1ziwONd9hYqWqJSqAhwQTmY3UnsZtc240lCGu1/r+xNiIBQkFpQ",
+	"pXJayzOebzzzfTteQuFq7yxaDpAvIRQaa5WWh0SO4sKT80hsMG3XGIIaY1yWGAoyno2zkLf+Ym3OgBce",
+	"IYfAZOwYmiYDwpupISwhv7oLM2yixdiRixErU6ANKbhVdTze86rQKPY6XchgShXkoJl9yKWcz+cdlcwd",
+	"R2O5Ohvkcf/D4cngcGev0+1oritoMmDDVQx36tEOtBmx+GLGpGLqolfOTHAkTK3GKHpf+5DBDCm015ra",
+	"EkfGYhnDOI9WeQM5/NfpdnYhA69Yp7pI5Y2c7coURV4v2E3QymX6NHIZr9NEvzHy49p9QhasUZxe9lZp",
+	"zIwSF2fHkDDbRPtl69mPDgeL8xg5ZUCqRkYKkF89DHwRkASvPE3ciQlDti7v2rRpDdMUsxUPYqKP2vgQ",
+	"IqUjUrytECvLyxGG0Tl4F5sZ7XvdbvwUzjLaVDzlfWWKVBTpZqMNceNq5KhWDDlcG6tosYWJTfbgCj27",
+	"qXxs8/5PEb8FZ+9D/ks4ghz+kRsxydYaZCujLaAHqhRneDPFwC3m7utjXlg1Ze3IfG/5vN/df33QE8cf",
+	"3dQmwP/forJ9y0hWVWKANEMSa8cMWI2jSqDt9LDJQKMqH+vxCFX5QkFG179Uke8iehdRHODZ3fALWhGG",
+	"9dR7ctxdGpwLJQi9IxbpUCnmhnXaVOWOs9VCVMZOts2/QfI/S4efU1vy/b1ye348Md6yTC+PXx1M5xoF",
+	"oS0xFqgt15szLhN462NphCNBOHMT/JNYmNizYqFGVbF+knZHySwKjcVk23+8SsR4/k93+vleBivU9iUb",
+	"Up4tFdvnqoRm2PwYAFcgsqZoCwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -18,118 +18,566 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x963LbOLLwq6C4X9Um30qyZMuZGW+56tjKzbNx7LKc7I9NahYiWxLGJMABQDmaLVed",
-	"dzhveJ7kFC68gxRty0lmV38SmQQaje5Go9HdaP7L81kUMwpUCu/oX57wlxBh/fNkAVSqHzFnMXBJQD/2",
-	"OWAJwYl+NWc8wtI78gIsoS9JBF7Pk+sYvCNPSE7owrvrqS4BUElw+IGHqlutBQlK0JKEBC5AQmKZaCyA",
-	"JpF39A+PMtn3GaXgS1BdbjGRhC76c8b7+bDC63nAOeNez1tguQQFsE8oUS/7hK6ASsbXXs9L4r5kfTUb",
-	"r+cJlnAf+gtGwfvciM4ZnTPnpJI4uC+lVsAFYdQB7q7ncfgtIRwCNW9NH0uOEiJVavcKDCuilI+Vz4zN",
-	"fgVfKjw07y85+7KuC8BSytjyMSL0HdCFXHpHo55HkzDEsxC8I8kTqM6u533pMxyTvs8CWADtwxfJcV/i",
-	"hYa6wiHRZD/yWEQkJWEv4WFPSMyloEzeErk8VkMLTQv96ytjUUGBsoxAT4tBhL8cj4bDoXenhq3zSggQ",
-	"ItrWYu24FCmOwCn17JYCf024kO9tkwCEz0kstWB7F+r9nwWaqyZIg+k1QHmHNwEJcQsMQXEslswoNiIh",
-	"0j/+H4e5d+T9aS9XfHtW6+1NbQ8vpzPmHK81NK0MzjoqKt34Wj/OlVVR0fCVZEwrJtPWoWBcS97OtQC/",
-	"vMDzOX9uFZXXjEd1cckR3ECos6xhoyh0l/N0kj2cofeLhnn3OLKXRWaq3yE2R3IJKB8KBVjio08U/X/0",
-	"z2z+/0R9dI5pgkOUPUNJHDIcoBXB6OfpxXvTBStNqZpPWBjqXQjN1ugiBjpdkrlE52TBsUIBnQQrIhhH",
-	"uscn6vUeTzBGgc2Pcww1aKMmipJTF5p24XhHhOy8Zgrqx7Fq8rdXRuDdgjcnoYNlr0kIKdXninJlpnm9",
-	"XCJmhGK9rh5LU6PanUpHqaK6/GyDj3XBd3NQk6mddx9iA7yKvHmuyBjV5zDwehWGfBcUqE1zEiZCAr8y",
-	"vVRroX6DEdcyrvYFivE6EyAfh34SYmUhIt/AQrwArEYG28ionzL8s5cpJVJIkmUDQAmsGntboukzKjkL",
-	"L0NMYXL5weA1x0kovaMXvQqOk8sPyGccBIqBI9sVxaovoiwA9Mz2PUIvnucYEiphAfyepgpEsVz3IkKP",
-	"97XJsq8tljLG5xDZ3SVDelTD2jRCz96cPt+M92ibiI814oej/Rri71kAE5ZQWcL9oIr6+ySaAVeCUUda",
-	"oGcjLYWC0EVonvXQgX709kTNwtoJo97B561MyWwPI3RQm87UX0KQWCu1MKE5DgVUJ3UShuwW3TJ+oxeS",
-	"MH3VGmLUNc+cHzPGQsBUnwHj5GIFfMKiiMgrtSOWBvZGR2PPJb5sBbzv615Ib6ToGQwWgx76pLp88gp0",
-	"80ZHI6/njY729b9j/e+LumGlSKm69FeYK2UjVN9JnFxQuGYXVCna9K/rW1b46zVLeOHPKfmigD9oGUda",
-	"xjdQZN9rWBqtRNlvJ0o3cpiBChQpPDBEKTzQdHkoJZRcAdfrK1VnzSrMNNZi9phVn207dW2Vo1PUVW3q",
-	"6SlwKiuiHKfrJQccCMfOnCkeRTBpmlXRQ8+UrpmeX+cbIaPPB+hsjiiTKOZsRQIIempvTyIQiDLd+lkK",
-	"79iw4vkAnSdCohmgT8lweADHqMzF7e0kdVMo35KdSqVpaVUFzcHpz10tDhEzKhzm0cRhUhRJjTiIJGw2",
-	"M6bkd7UgNxjck1JjdWJP7f9rJnEoOp/dbHNNX3M2mDAqkshOZ8NRWQ9/5ejYwDCLr3uw+iRamJGTqeIU",
-	"WAHHYZjZY0K3QyKJInM2qBC9sr23rqrWbS6V8rueN8ckVNp5I8C0oTUNcBCAPeSsMAnxjIRErp1DSEUg",
-	"p67UpEO5xsQ+Z0IgRZNmjDW4Jl1nIEYFjdcdZgMJDEiaEcKaRlZN/aVM6edO8PnKbSVxQfO50KyIaQHn",
-	"8gg9h6RUGV3gSpmiTjFmURzCFyLXL4m4mSpevaLSRf4LCgjUK0SoPmoERNwgP+uPZhzwTcBuaU26hQLr",
-	"UFF5X90CzTmL0EidXcY9dLsEDmiEiNCjhYCFTIczY88ZkzEnVCJMAzROW0YsbzhAekpodGR2B/94NETX",
-	"p2Z7EYRRCP5qB9/PmuyrJunjg+zxYfHx2D4G/XRQdKFUZW9Kfofr0ybhK2CChGQcL0AR+PpUL8CP5wJh",
-	"ieSSCDNw0c8QsETZzNnARo616z4qnA/cAlmE7FcYsVlA02bpQOWptgvaxVQd3btKWQy8fzHtK2PQKWx1",
-	"dwETbj/t9RLQxVR7aBF8wb4M1wgLRCTCcQyYCzXkKhIDpqMXxoxFn7wrCNBbLNErKoHHnAhA7whNvqCf",
-	"0LMX4/6MyOefvOcDhxftrtdZ9LEQZEGNw24Sqr/m64vpAA3RMUqob54QZQ+N0HF5MfTQGB2Xpb5BHDuK",
-	"BU8oVZuVlo2L6WCzOFiS92pysUkS7qVwLqZPoG6GVXVDA+JjCS6tczFVjSPtRQWtdIaF9pjqBkusOiRh",
-	"oO3YGaCceY/ky/aWq4stL7HESgFB3SOqtK1xO9Xke84BJjjGPpHrN6eFJoXpLTEPbjGHE9+HEBTtgnO2",
-	"KrrpC2fzJRPS6eLSccQ5MeRQvFEtLds0WYJ0AmojwFJif6nDsO0hMHX+ZQG4Q8ExZ5L5LEy9+LUGZqfd",
-	"MH/Z1HsFNGB8c4BVmthKdbAa9TOIvZRlzcSvTC6lgksyXulodU0qIhACLxwLTbdH6etNoaS03Wc1kpAk",
-	"0seTlyAxCeuwzXMIEGRN7UnGLGecerTSo46mRkWcExMFcWBugEKA0jZ6F85WnT536JNrhKVqhkXe0sxP",
-	"uzu+YLVCvSPvYDkeRkPh2hk4YOHE4YuyNg1INkdLdqulvTDfW5yf5LSE5+ONhsPhYDhEb06VthiNhigi",
-	"NJHWY3E4HGopaWdIRp4MR5dQvCVCsgXHkZlBzMHX2NhFVqE4lrgUxGlaJHmwJiL0Iw4TcLcWEmLXm6ps",
-	"pUBsj57BxDkfJlwx6ziZqOPLJieHPuM065oC5n6cTJl/A3IjTGGbdYFKHBrzAyW/JYBIrjgzWVaq0yWT",
-	"5nB17jBWFXnSsxeh6Py0aIgSKl+MO+HZrGq76sJMwzXrqzQJpmJOtIRBCTVzMVJfFgEdx3xDpHHg1OHq",
-	"9BS0INpMiIhESyyWpUXpH+LRixej8YtDvH84G/3gA8Dshx+CEfjjYQCzwx+CHwM8HnfZqzQ2H022jNvM",
-	"NfjYhBpt7fbQDAsIEKMaTYkXJfSGg9Fg3B8P+wuLaBc8Fs0EebMdUjTlI7ln/fFx822XuXyyZSwahI9j",
-	"hyIxfiBxCVwZWj6ow8Q9VWLJw5jm2NQ91KqNn7VB2uU4QJNsw1CbljYl0EkYMq210Wpy+UGgPWTOpJfL",
-	"tSA+DtHEqrUOR87M+uqe4ZJbnI7JKhV1yW6BT6WyrvVKDAKi5onDyxJtGymXc0VB646Y3gsacFIctL4/",
-	"9853nz2u4h128/Tq5DzVvA9hre2a8tb+aT17YUeHAgV5y/hNdxK+Nx1cszZmrF0Pbho2eFLyldNEYNXq",
-	"bcpr10Fre+xzuezM0HXhLRCwtFLcCqSQ9+RWIm2LoZO3XRFSL9pyMAnH2r1sXdY6/qYOvHIJhBdyj2y+",
-	"Sw3zVa7V7oWF7fcLac1sWE0M9E3KugCtl1OsldIvrXlaTUCzmrx9MqpR98l/tLMwwrix9bmozy8SOkSh",
-	"xm2dVR6BqZA0Y6SWWWHNwix3pGYBPcjJ//FcpH6bHO42Pf73GUDRcaPzvxNA16pX0O/ldD+LV+MJo3Oy",
-	"cPhaTMz9DZZwi9elTEMSr8bbyG8i8fgXHATcJPMe6kkFVHy1sUh8EgQcxNcbUSQzCvIci5ut5IYacL9E",
-	"WNyYeH09MpzPsTR6r8pfQ3mXkPzMZnWZPcX+zYKzhAboVzaziYhr6hfTEXUKrvMkk7VxOdjytD109hLd",
-	"LoHqIYyzURkSIvF9EGKehOG6aDU0JcBC6jZq8Q4hMjcT0U6d5hTwMoif2QydvXSdQF2egvSaRpui/ZnN",
-	"pqZh2+WGBjZNsyHqaJqeNqU3BhoQuvgn6iP1jgj0WwIJBPYt5sK+vTQ/0dXHa8ZCgV598SFEcxKCaWqF",
-	"0ra+sv76i8sT9PEcpS8ZFaZ1xkLV+KQiKBXGmh6GHSme5i90S+QSaaZasJj6EBbaGb+Ufahd3Wnej524",
-	"kkgzM3WQyuago5oWRRvN1D8yWM4LL+/wzLgSykJ+A+utrPFQg1fSsKq4oR4PsyJiCuV0GJeIZf6KPILx",
-	"4EzTKPN9FKIIuW9xi0mnTvg2+zQ/jAcswoT2/R+3k5PamJ/Tma5N+TTn7YRrTqfJWp/qELvDpU3ETV+Q",
-	"36EW2BE9xLIgWAzcxq1CWEGIno364+dZfLtLmDyLXbdEyoWy5LimQqD4WQxPa2gK0SM0Qs+K8fTnPbSf",
-	"Pdm3Tw6yJ4f2ydg+MUHz5wN1SkVzlpQmJhDmgHB4i9cCxRwEUGkCZ50Ono0JDS6HSoE3F1OHy3B6T5YM",
-	"yyzpGkpMGXPPaKIhH1nBk5CvFJzdSDy3V+5yU/AeXZSIGRAhCfVlFqefa1OnbJX/WeRn0QF6hf2lheBj",
-	"zomldgrAKJMeIlIoUx848Ws8Rc+G//vf/zN+3tMhXdWbOuPh5KGEzPMdHHRUq2pKfocrraDv6eiqXCuS",
-	"WBIfhYzdJDGSeBYCinAcK+RB0SnIVI0kwJHej5QctlFngK4V7RmVymYgwgYUfBzqzQVWoEhvdwBFQA7z",
-	"EHxp+PDSzi5TLurUk0bMUr7mI8bYv8ELKAXKc4XNxBaIVJRJmweQTeNiWpQ4Itwi9zdYm1VWFzRRzCyR",
-	"S1jb3JJyaslfkd7scyCNkulOC0HPHGkh/TE6RoQqo07bjjms54aFEY41GzGhoqLEauuuvOJ6iMMC8yAE",
-	"IdKYZITpOl0d2cqocKy6G1e3wpoGrq+GItOdOqd1Y8/jyVswmCSJ4GlMpXyM791SKhJ0s6VUoVijjZSn",
-	"8jzQsVnLG6it+tN0CMWIAkqzdTlToDZ143huzBgwTiTI8gZyVmZ5Aa3pAj2UXpbYXx4MI3tdImP6WD10",
-	"5Q+4/FAv88B9TtFWdp4JkTjyfXDpFne9hEKarNQUh671CNOzWvssTLOeV7rO5zdmLJWn0T02UZm+Y09O",
-	"oxd179xK3BLpL52zbLyGLit3r4XENMA8MPpOcjJLzNE3A9/zEiqSOGZcNhx/VyGmDTlZq0hMmljkziyi",
-	"TZrUpLc7rwZVs91Ld4Ac56A4cYcra/eHukWkovYbMQ+CWtWJcZJd4WihzpX7wkJ1mzGN1A6ctkq92W2+",
-	"dyfZcrc7N7uX8ZtsJlpIIiLF/a5TvDN9Wkhed9PfEy1Wl6/N+FWF8pHce5eRpoFxlnb3ZFDW6xEiXadv",
-	"d6j3JkpadWIr5TseUMKh6n4tlKnIMXBibkpX1DeztHRQa+GAha0ZUMyv2ZRb8yz9IfHiuU7eh8A4zi8+",
-	"nmhvqNqBlVXZLQ+1OPbfMafOi0X2RdGBbkfGJeQCMp8DFyYr0k84Vy9LTbqg9HQ1W4g7TyZOy9hs5JYp",
-	"eHPX84RYXiazkPh/g409P1pbOJhO3+ad9J5Z2PNbIWQNnVUBHla1RBs+3Q0Y4/p22C3NhXDoJYeIiNIR",
-	"uZB1fe/yUC31YJpqPBVwaF6/E93ZoX3Uz7n2jU2WmNDOjJ5UO26L3A+5RhqQFfSc1T26Ca0mkT725jk5",
-	"9xDY3jdaXq4CIs0icK+KL1brO9aCeZOXINnJU20uF7E5aP+B5aouQw3BWPNcXw1BHGTCqXHCpQ6fUBhn",
-	"e8Don2XagsklcGSAi/pNs8YbECdomUSY9jngQHthC69TT5CJDJu/iEAKrj6UDu5zWeAERdhfEgqNQ90u",
-	"15UBtA/X+Pc+ea8xCRMOnzyLj76Ar9sb6hCBtKip5uZmDWXFdOk8kXCATtCVRhP5IeZkTkwU4+319WU6",
-	"WSXaaJYoKusb/VKf0zgJABE5aK+y6GSnpWVOPB1QYPMj9MmbmrD2Jw8xXpzpAJ3rS0J0zo6QLqF3tLe3",
-	"IHJw86MYEKbkL0ookes9fddWHc4ZF3sBrCDcE2TRx9xfEgm+TDjsmRWrN3PCqBhEwZ9EDH4f06Cf1USs",
-	"b541uTWKqiX5T9tuZ12Nq60a3unQLp2dJrTV0+eCTpUjnTDP01PXadEzWCn9WLyA0prNmzVM3XktyaOv",
-	"GTfuobQcTZd2fydyae1y0d7nPZPt4F1+Pc+J20ZEmkZ1U1y0X35pz1Kss8sGtjI/1AP7myt1D+ysbyMT",
-	"I5sP8SoXYUxt4QbX0lXtrtcxiMcMpABsGMToIsLo6TqPLj4mFPayADMNds7W7hyRNMJ9/CF3TPbQ6PgV",
-	"Fuse2j8+h4AkUQ8dHL/FPOih8fHflZJ8E7JVsXJC44TiZBOrHjIb62HTdWgIcDRL9J2qvETRsD/+5Kkf",
-	"h/0fzY+f+qMX5tfoh/7Bvvl5sP8X45rfMA3jfXzCmVj35sbJuOZw0H9h37847I/27XxH+z/19w9t8/3D",
-	"F90m+p742dresvi9P5sg7fQvTMyiapG08zH/jZsQzsS4qJq3FCCghek/QDvRokI2RtM2sWP3DpA3XMAo",
-	"ht7TW3UPUXC2t0uvxVu748Nx9ODtYpNZ0MkmuLdBoJpNl5hD8JKIG7Hp/r8+nyzxChCWNp2IUUBCQ9C5",
-	"HR0T1r36rHr5bp9SMtuBi1t5mWENkuxae06ro/FQ/eR1rNX53yT7tp2GH5H0mR/SjbjlVYTdh9knn7EQ",
-	"y19u7KnZ5VHaSmJ8dapRYyRVp/tvOp3k9yTumgyvqoFWG6hDDZ4ssbFQeUefgdU2u9WKO2lekQXcZbFa",
-	"1NuLd1QtyK1SQb+wkeLtkaJBm+nBUqdIWviinUzdixDlu1g1SbcxoyGPwjc4zhccB3AFPosioAFuCv/a",
-	"9xCgiymyvTSJz68/okKwP8/6xBTNIG2qk3ExKjbb6JT3LVVciQSFDZiDyTHrJ9yRTw9fYsJB/IKls1QF",
-	"Kab6pBfJPly9Q5LdgPZldYsa2bErqXoc+jb/TYFU4NOImqlPBMgGZwMifKZT+EiEFzDYSBs1Xp0adyYw",
-	"pSUkJD7Y/CbjVPVOYuwvAe0Php5F2EvdR7e3twOsXw8YX+zZvmLv3dnk1fvpq/7+YDhYysh4fYnUuT1t",
-	"BQlOLs8KX8U48hIawJxQCLQUx0BxTLwj72AwHIz0rQq51NzawzHZW4328gwa/XgBDu69I0KiYkMN2ZqW",
-	"gW1wUnofY44jMFdQ/+GokK5TQPMeypq3DNLXddTG7v2WgHYqWaJmNfV79nsvHRxcd5915Uadf6bntz8c",
-	"plUUbbAXx3Go7AnC6N6v1nWaw+9WQ14HIrRMVLJd/qa4MB6OtjamqbDjGOoDxYlcMk5+N6w/3OJEGwc9",
-	"oxI4xaG57GO2Xr3p/6OYmfVZG++ulEoTw0OYluuyl4XLNDopNrBZI6csWD8BN3Wx/0reo7Ks7mqyNHqC",
-	"0V10NiQIjDB9Bb6e4gClebA7AfY+q+cOhbn3K5uJvX+R4M6IdgiubxhM9OU0hNGvbFYXbv3yZ/2mVWfm",
-	"icEGjNaQSpvnClIrwLLIOlVl0xXIJ1WWaootGvI/RKjHw4OnH/Q14zMSBEDNiOOnH/E9k69ZQu0Uf3r6",
-	"AdVZLyTGLP3WikKtR7XFOU2nNyD1teUswFde/m9A7tb+bu3/u6z972MpNmzW6bfL1MG1szVqsiLT2/Vz",
-	"EoIpo7DkjLJE6PoGLnPV9uhotUZJKEmMudxTC7WfFkG8r+lY/FhVJ/t1/6mX+InvQywhsPf+/Z0d+32t",
-	"iU2260v9fMMBzTQqiXrH7awE9BG72jc9/O+2tt3W9tX9KY3GpnZ1xuDry75tq/YNyN2S3S3Z3ZL9ai7Q",
-	"RDZ+4LJ9gzWNvtfV+pSuWJuU38mY3SmKnaL4IyiKKfAVcPTqQR5nZbDv2SvA/dJHYJuPtXmhLdeX3nRl",
-	"wPYATAogXxeOD8z9uyullq/4fmX11PZ1P5evdNP3/fKqfjvF9sdXbIWyjZRJU6Xom1lDativQGWlUokP",
-	"6APNq5U/TLNmaez9Qv2eDqp1Q7XEupYt1HFo0LaOooN/XB2bFaopVT8qlCq663UUgpYal19ZD7dVhXQI",
-	"6aa6kDs1vFPD30dag1aFmUZ7sCasFvW6h6XpKCO2030FjVMvV7c13VfAtlRt7ZIJ2c912GQJ/k3lI2ne",
-	"oS1Bll5CVtKuU3j/C70YDvQnxoSpL7mHRkMEdEEoKHbq69jmk6bZEBXY+QfSMuhdvmF2V6/MltVKexzd",
-	"u2j6gvTvLO6dqv8+VH2at9wYQ+j+AbRaSEFnzT+hzWVuhzU55b41xTVlS7Q2ScuiLcYa2Bgr8lkYgp/e",
-	"cUx7uqOt0+ztk5E6+8zBLq0557DhSnMITqfKN7FOvfwajMuL4+yY18C89gwgy8GG3J5p+vIp3Iyl4lZf",
-	"OQk9LZG0S0D/rqS1vp10zttpEuTiJtL9YJUB+2PF/ZvFehfK24XyHjXgPSyDenJOw9p8A3K3MHcLc7cw",
-	"n8z2a0nEaViT5u33tiyfyvr8Nlk3zdrA4JMpzJ1m2GmG7WffbDK393S9AF1PELDjyy1vAZtPQl18PDG1",
-	"BWpaRDU5s2/aVUjw7Xb2lo24y/LoJM6bxW+juNyXvYYjG7ibFrZoNeAy/qIVwejD1btmC+6lrUFhGrWy",
-	"3HRAmlN/LCuuXBbEpdN0kY+sIEdhgfxnafLxNzqZbBT9UinZFuOoWOnVZR+dFd7/25pI1al+p1ZSgVk7",
-	"e2lnLz2xvbQEHMpl49ZpXiN/Cf6NyyoK9bLvZo0UULCjftb4C42o0Tam5NKed/f57v8CAAD//+SRpdkr",
-	"rgAA",
+	"H4sIAAAAAAAC/+z97XLctrYoir4Kqs8+tZOz2XJLsZ1Mz0rVlSXbURLZWpbtrLWmcxM0ie5GRAKcANhy",
+	"J9dV5x32G+4nuYWBD4IkyGbLku0k/SexmvgYGBgYGBiff0xSXpScEabk5NEfE5muSIHhn8dLwpT+Ryl4",
+	"SYSiBH5OBcGKZMfwacFFgdXk0STDikwVLcgkmahNSSaPJlIJypaT94nukhGmKM5fi1x367SgWWO0qqJZ",
+	"bCCpsKoACsKqYvLoXxPG1TTljJFUEd3lGlNF2XK64GJaTysnyYQIwcUkmSyxWhE94JQyqj9OKVsTprjY",
+	"TJJJVU4Vn+rVTJKJ5JVIyXTJGZn83AvOGVvw6KKqMtsVU2siJOUsMtz7ZCLIvysqSKbXDfix6GgA0sZ2",
+	"EmxYCFI9V70yPv+NpErDAXt/Ifi7TZcAVkqVdh8Lyn4kbKlWk0eHyYRVeY7nOZk8UqIi7dUlk3dTjks6",
+	"TXlGloRNyTsl8FThJYy6xjkFtD+a8IIqRvOkEnkiFRZKMq6uqVp9q6eWgAv410eGogUC4x5BdwtBgd99",
+	"ezibzSbv37/3owV7lROhfiLzFedX3d26ARHaLo83caoW+XbyrIDy6rl/3gL3Uy6KCOxmqozIVNBSwcGY",
+	"HM8lzytFkN4JxAX8X6LXL39EakUQ1qNKhAVBJZeKZEhxhCX6/vLF80kyAuwoqFISKYvb4ocjuR3DBYlu",
+	"Ab9mRDylQqrntkkTRS/09/8p0UI3QTBM0jPKj3jbIDkeGEMyXMoVN3cHVaSAf/wPQRaTR5P/6159t9yz",
+	"F8u9S9tjUpMyFgJvJu8dvz0beRdA41fwc30fhLxcrBXnwPtN28nP2/YfZrJrDcZv8tB6zcOkEqfpGsAt",
+	"iDrzDXtJYTwrcYtMsAfvFxjz/YehvUkyl/AN8YU5iX4qlGGFH71l6P9Bv/r1/4qm6ByzCufI/4aqMuc4",
+	"Q2uK4cCaLlhfRrr5Cc9zuOjRfINelIRdruhCoXO6FFiDgI6zNZVcIOjxlk2SD0cYZ4Qvvq0hhKENJw4p",
+	"p0s0w8TxI5Vq9Jmpu8VOTf31pSH4OOEtaB7Zsqc0Jw7rC4255qZNkpoi5pRhOFcfilNze0aZjmZFXfq5",
+	"jX3sEn58BwFNw3v3ujSDt4E3v2s0Ft01HEyS1oZ8FhjoLPNxlS2JuiCC8qwL3QmXCslSk4eWhihDGBWc",
+	"qRXStIMWVKY4R/+usFBEdFacVkWVY0XXZHDkqkSKAwIIyxwuSgNRQI8Zr7SQ5dfAqmJOhF4DYdn4y7j0",
+	"SyXvcFHqQzJ5+l9Hs6OH6D+Ooh1yzGA5OMuohh7nF82Tth3CyNr5AhGcrpAe3iG3XniCrsjGMD5ocHY6",
+	"iWweyKnjl664wvkoiFtnxW+GmdBg3GHGjZuE+x07USc4T/V3Lk7JAle5kSKaJEPYkjJChIycFIAN8OYa",
+	"oWsurihbIs4MMhWWVwmgcV7RXE0pQymvmJKOrFIPg0TXK8LQrF48ZYoszX4pgZlcEPESK3I+LyPQPMYs",
+	"u6aZWiG8xhTkf0fFhbufWpBwRgbBGEFHty7dawR+xyshL4g4xZvuOn+yGM7wxgHv0X/b62tRXRu2JKCO",
+	"yBaNI7n4ZXkLZIcwy1CKS5xS5VFVsYykORYkQ4IYYQGV+s52DdwR8rzofjIpKKOFFm9nnwVp8oIqo2zx",
+	"QOrXaWw/I5DXtPuxSC0C79cHD6Lg4ncG3KP7g7C/H6Ssy5KkkWciZwu67L82jKqgJU8TpZVZEWaF+JoI",
+	"QTONHqokyiw1J4gcLA88mn4BZhe7KTIqNR1kAROYc54TzOoHahOYs9MuGHY6qbjAS/KLp6YQ15PY163P",
+	"sPjhNYfpZIXZMiZCwO81lPXRw83ThhaCFwgjENcAnuZe4R3YaUZyhSOyINPbgrPMKCDgaHOeJ4iRJdyI",
+	"hjbVimxQTvCahCibHsUOOuNG6PTNJuqaB0zIDdMVWDgfobKBVoleu1tUfA/k6mnOr58JzKocC6oiB9fo",
+	"wtxGzEGitHKMNNuRYrlCi5xfJ05YbJxV+1YEyRIefjgnLMPCyJrSfLUd9ff/MP/0B8XKoRuCBbwCnXYA",
+	"uk+Sie06+TkmVuvG0zUWWkiWuldkzed2oMin/3Bjv08sRT4VhPxOYpdM5JhphUzI8Ramc9IkxyF1UU0f",
+	"/0WwmGoZ2g8SU2EL1YUClEs3AaNFVE35ECCMUhXgSd9g3/N5F1EZZyTOqAjL5E6aN6aIWOP8nLJKmcG7",
+	"B60gWFaCFM4mMuqNXi/hvO7+4Uoujb8dTQjFWdYEu9OkDdI1ZRm/hrs4hpH2nroFuLmaA3SRHC7Db1li",
+	"drWF7a3E0adU62xrk55f0YKgOVHXRDPda44knBHLjd6cJ+jhrC0teAHgMMaNPZrbt6RnQm/OJVJupgSp",
+	"TUlTnOcbezuxDF7oEtQu11gUKLwgb7x5Q5wYQNEig+mToMOH36AvMLom5OrLzvKdMPT10SxAxtF9D0If",
+	"gRjUDG9leEi6spK9uh9vVOtFTZl6eD/6Qkth6GyXLhmm+aYG6YKI1ILTEsNWWJB6V1FG5ZVEglwLjSum",
+	"bzbNKg/QC4M8VDFF8waZ6QEESbnISHYw7mmnZZTxp95OFGdoiu/GPrYLC9CqntVCCzO1tiJp7eYwWVza",
+	"q+suKKK1qfR3v6fznKdXEtkOSFKWEvhQCrKmvJJ2H2saSBDWFFByYbXRJ49fTZIxUGm8S4WL8o62pB7/",
+	"ZhshlmSO06sfKYtsA05VhXOtrhqp5zIdniwWPCZlmN8dVk1b6c8JkhwtsEBfmHk0orFEWWV1/QYN5gWS",
+	"oLeTr45mq1kxk28nX8aQSKSiBVYk2wF636d3Aa4BImYptwPrFdn02OMQFyjlUiHNqYjQJCuWJItTzZjL",
+	"XE9l2naX29q+Ng6TkByGqeklnJReAgBNidWBYqPftAtDurc+X5pAeAwDfAEP4DfnMqJuFoKwNILLE/vF",
+	"vxW5VFalEGgSjCZW34qSKD0vySVpNxJY6dtcRPeAvEs9a9smQD4J275PJkvBq7JHS5dTFuNp+tBKJD1T",
+	"iiEssXooLCVdMpIhPRYYeUOpY4ukGzKJiEiiEReFWyPrROMqbvbQdGC9UjroRaWgqZZd9K/mtCWIFKXa",
+	"mC1iXEGT+GHwmu7dFlYxO2Qf5eZkoRCvVIuQQCui9VtmHzASPCet9aywRIybHxZc7CDztR/uGts1vTjq",
+	"cIuOn0ySXuXWBNkSpcc9TDysI0nGznemSBGjGEWKMreH5Fb8qIrRIL05Bykar8lIr6tro2ZZF5MAboeR",
+	"QWyfMakwU9QI+R3UW47dJDT9ikg1ga2JQNYkZCHYDfVmnZ3Xw6h1+yVvW6De3ojqUwtPu/oMuk49LLBf",
+	"J9DjNhM392ZN376eNcVfnX0gtGbaPsVOzgC+V2w7/cdXwYFqQo3LMqcpkOCOaoKbeX4OGJtuzGu2gtrv",
+	"OlUSgbVS/XIjdx12wFnIDNH0E6rXPrj5bqfiNNberZYvXPC1oXZYEeRYE4IhiESKe0DHoNC3bCvZiX4r",
+	"KY5ExRBnbk576b2dvLhEc86VfDtBXKC3k8c4vapK9BufI0E0EWdVTrK3k52A2Wk/W3Kza4GkaTICUQkq",
+	"sEpXRoqX1dzMJw/Qcd1am+j1zR/uEGJcIN6ZsB4Y4Tx3kx/c/MpvUN0o6roZi3G9B1nNm/NBsh04+Tt4",
+	"PMqRl3O/ijmvpCLipekA2kb9byIjzxL7AZV44x2jnNFL72tqxkIiGKz7+jCNzoZNaXYkxf0EpDGsFQ1v",
+	"xeUq5UwJnl/kmJGTi9cGLrAbTh497PikXLxGKRdEgnrLdoUnEUGMZwR9Yfs+Qg+/7Go6dvNyBjk+KSj7",
+	"9gi8nY9msw7E56SwXpMe6MMO1KYR+uLZ4y+3w314m4DfB8AfHB51AH/OM3ICdtgQ9q+SXr+CLtASfXEI",
+	"VCgpW+bmtwR9BT99d/xlbeE6TL76+VaWZNweD9FXneVcGhZuHNyDBS1wLjsW7OM859fwEoKDZNm/dZWI",
+	"rHOSdKSpZJKW1Ys1ESe8KKh6iRXljYknh4/uT2Lkq0XmaQq9EChi0BdOAXP46P7bSYC3yeGjw0kyOXx0",
+	"NEnseIePHo61D5bVC0Ze8Rdgz3B/vbrmwV9PeSWCPy/pu8nP4/elcYwLoPEtGDma9ByNQaQcDSNlHDrM",
+	"RAFGgh8MUoIfAC83xQS8sAWcL8fO+lmYaQxk9iGn3gEQ4VY1OCGvGmJPdwFTkxHVML1aCYKzQYcmjTBl",
+	"mrXBA80mujx/VV+EnH15gM4WVvPC1zQjWYKwlFVBQLWhW3/hxvvWbMWXB+i8kgrNCXpbzWZfkW9Rcxdv",
+	"7ybpuvjWV3KUqfQdrTahRXZ6tMQhS85kzHclIlKEqEaCyCrvFzMu6e/6QG4T7BqNwR5u/dpfcYVzOTom",
+	"wTYH/Bp78AlnsipKJ/ENhoDA9C8jHXs2zMIbn6y7iIHNqNHUeiSsidCiuZ0QSWiHZFUUxue948zVuN4H",
+	"T9XgNRdYhhaY5po7bx3QNTRjWScjfTytex/NqdpEpwCNYJRXAupQzTFxKriU8FzphxiG6+N1ZsQi4Hjj",
+	"x+xBgRmSeURY0ciyqf/VxPSX0eHrkzuI4oDzxcBskWkAc3OGJEIp7Y0OdqWJ0SgZg1bsHVWbUyqvLvVe",
+	"PWEqhv4XjCCiPzmlobZao9T3R3NB8FXGr7vub1IPG/PQ932hhfGiO0SKo/uJVsILgg4RNY/qnGBtcjBd",
+	"zNwLzlUpKDP2nvuuZcHrhgcIloQOH5nbIf32cIZePTbXi6SckeyfdvIj3+RIN3E/f+V/fhD+fN/+TODX",
+	"g7cssqkW+9ow/OpxH/EFkDi3R43gV4/hAGqVAlZIrag0E48z9a+L4H0QJ8hw5LS1EdsJ1DVzEzWXOkxo",
+	"Ly61bWYslZVETF9cTrUwGCW2bhgMl/H4w1crgl5cQuQhIu9wqvINwhJR0LgQLKSecl3IAw6Bz0aMRW8n",
+	"L0mGvsMKPWGKiFJQSdCPlFXv0D/QFw/vT+dUffl28uXBWxZ1oxhJ+t56pk3/uf5rsXlxeYBm6FtUsdT8",
+	"QrU8dIi+bR6GBN1H3zapvoccR5KFqBgzhjEq0YvLg+3kYFGedOhiGyXsxHBeXN4Bu5m12Q3LaApuVF2u",
+	"8+JSNzZeVca4OAvaYwYNVlh3qPIM5Ng5QfXmfeC+3N5x7d0WillKfsRzkvfgDxogQZbURJVh/xa3LrZl",
+	"SrUD7YXgKZGSSLBNrniegU+TwoneTZnyErpfnJyh08tL03VFS4ybnUvBlQnQXBGcqxWizLA/ypnpRKqp",
+	"IJJm2vqu+54pCfOgQr8KpMKefJ5UmkowQ68Z9A7epWVKJ8kE5te/BkOOfbE3kXcB47V+/M4O3/r5SfWy",
+	"ngz2gWmdoP77guc0jSRMsL5z4/zLrlc8J7XniHGgXiwC52brhQH6Zk3UEDKipUAbOIeVOQfj7h5RWbex",
+	"cZrherUvqzyqF77lkKTW0TDgJm2cxk9Ia2fiFpbPaXe8z+XhbLYleuaW9+39MAKhUzewCJbeinJYYWm5",
+	"sQbRGlJkUnvLYYmw8TrRoDsHHn7NrAnp8MH/7exKLrZJmvBS649u/GRQgRlewkvZKCvwmrxlvVG2tVd8",
+	"p3tPVOhPeE16PInNijm4CXIT8qWnR5pxOh8VQMRYh7Cj+yurevNgHt0HF7Ee4EbQqp+ML2qAJCoN8Pa/",
+	"0tJvI5rrwc6xXDB2F54L/bOdMUGBFYXDLYlZcFwSeBgaWIu2M/IIW36cBeh3/ZI8w2UEOAhIMbfi61cn",
+	"4AWtKYxxJCGvQKp7dzUumYlVq3fqnLMMb2Ib5Xx467az2aPZLNZU8VbD+9GGrZWbeWvn2ygSKqUX8hP4",
+	"fI+MQjnJuQQtvWV71l9c2zzhB75YgE+c+SypIkbc0f8xTH4c5895ut0v60fd6LIc9O7ojWTRaSLufiU9",
+	"2SCC6JfYzpxihaWy0m+LyKi8OosbSReCEBcK9+xx3ON7hUV2jQU5TlOSE6Fv13O+7nGYWXGpomZKSCO1",
+	"oEQ49OiWVvQG0TZzC0BUIqwU1vadybYMSNqGwTMSzwRWCq54ynOXYSTuyrdt/aqv95qwjIvtcgZ87U7W",
+	"wb4fMXFb1o/81uIcFuKUsTk6btrQW/QhXlZsbjM+tcJmV0StiHBKH2z1ysDNNkiYbm5HA0O95Xfws8IC",
+	"YvZyrDT5R81yu/lZeXj7lmt5dHOZV9RwpyCAjypuBft1MZ2DcwmMPxWdCYaeAHbKHyjLzsNBg9/fFI/d",
+	"8MGvp/VKwBgnJV72cKTKLLBX2c9FA/8a8Utcwlma80ptZTOAnXqeGpo+HL8kOKOMyIju8xRvpkd6ei/J",
+	"WhrwAev2XSasc4QWboGjcnEF/gs5N97RRYIk9x42WBB4WdtnuJaaFEfYkxZifM6zDUoxs54z5AA95wqV",
+	"uA4NgGPoBZqDiJhXyxFb/ax9y1OiMM3B1xqX42VpR6zbnHRg0CSErG9bXgGmYycZriJiEQNvCkVw4fbE",
+	"0km4W9aAkZisZ5JICIIHfkCVpixBcLbRXy2ygzQAJNM7FkFur8vgNjSFLCzyVDSn95Lnldu4loQmeFal",
+	"yqkVnGT9QzUnb6hQQF9NR5oEMc5Ib9z/5MXx6UXUT9F0b4pgPC2n9qkWucAczzjTtw5gb5gVF0QJmppH",
+	"oU1S14QdCZM7orvfEfYbN5RNegCLEh6ZV8vHFctyEjhCNTf+Nz7v91/C4NOn6SzL3GsNsn+NC4TSD+Oh",
+	"wfV3O7oV16iC4CDCFMr5Uk6S7a6jllkNzWOb2IguVQlW87r/nFrUTM9O0YrgzJ0su+IAGrPuLr/u4p3K",
+	"MsebwQB6nT2g8YYzZGPiRWvsQFQhr5h5fluN3opXEBP/Mnhvv50cZkg/MW0TnC+mGd50mx0dPMhcq2iD",
+	"r+BzoItbGccWN+QkgTfJyDu4g4rvzGiRDzhfmJwv3W/6958NZpWgc2AmT3FB800oOuR8yTS55JADtijw",
+	"eCBbo/4YjNT9+syMreGxmxe2iVy8wdf2091ttn5GgyZWY1smaGHiTBWHM2ED5w7QhXnhO89Uwni1XLnP",
+	"aKU1FIy7zlkwb9cqY7LlRhgavI/DvlZ7Pid24Og72O/G4I3R3T8Tjc98dPMIhWb5YLZT83/s1hwLXNxu",
+	"9q+nZj9gZAJZLOYbMHslqKjg3Eu6LLAhBU/FCZIrXBqzBtzj8NkQdoTreAXNUORwnzXDUZB9UdRbT2VN",
+	"itvNGgaGesborRQ5Mz9GA5VCQHaQSiLjb5XkmlPFwH7ijksTxuB10Aof0+2R+7xNzvdivZ7Ji5PHjHGF",
+	"40zlsgKXc0tVLcU0yqixdlWSaK6+pGvSI/TFhJo3OK+I7quvSakIzqzUBS4oDFWskm7mUPh6eDQbZZGo",
+	"dYTD2RHrduh6RdNVY1m6wVoDOg62yaW15Z/3JylK/Gu0R0pvYdirmACOR4jqk6uRpjQnX+A193oV67mZ",
+	"oBSXpWnChV+PINiIG2YzsdKa0sYlbIc2Md4l/MOsdOQdFyOqMz9m7OuJmyf28bWdu/k+rpHt1Pq/CKzI",
+	"L8W8lGj6YOYQ9MjYAueQwJkquiYJeng0a1BcVCsOm7plolhH2J8+Opf2JHW5maUh+wg3gww/wWtcPSYs",
+	"XRVYRNQ3LyqV8prKfVIQVAq+1JeP/iJpQXMsEGFrKjgDd8HE+D9pPkUyhBlnm4JXMt/4+GmxxIz+7iSL",
+	"Eh5UlB2gFyzf1LIvmDWs7ODnvCaChOPH3uDYKNu1rxMj2U+EXMVClkwjEGD1bB0rhZuRMtDIy3FmTDv3",
+	"lknNRXZbczKitPKjSXKHs2fzJ1sydPTdsx6OANHRt5PzS2zMHNICyukVQRst2KAvHsxm/+f//d86R58J",
+	"1AIQv0QWZRk6vO9X3T0bhc4e2JyoOd7W28sOUeMrzBvS2LckSkL1cofPlDbUY0Fl7D6sv8XstHxhkrWk",
+	"hGFBuUzCu2W+Cf7q0vx4ddOlHf4lePKC/uNDOtdAxbR5JFc4luQUXhH138a9iouMiLEBxaH+LFc4Gv9v",
+	"7JN9efqMJ501wwpiMsQ6+3FT1adXcDzuNBpV/eCU4aiPUUFZJXvmq4l9+tXqMG6HbZE5nugNbe5LE6o2",
+	"YsaSc2+cWp1zErzA9NY26BjUsjAMuQ3CvSHJbiXWcBkmrjTRp+7sNLGmdaf84aV5cCGTTLPqZssa9IFo",
+	"ZuiM0e2Wx10sR+cF9NGPMWHcAedcrUJWUudttgJD920mKHd6oHGovXA9OnrABvEZktxGZlHKstlYnII/",
+	"cRqxtJU5RYBKCl4QCEuzRvmoTkCqFRb5BuQvk55DBgk8dI8czyGP6FIQKX9JuVS/lET8spwbAwMpyl9c",
+	"AtHg4y/aUA/jNf1EDtArl4Wj1pNpMSOQzbGRZNYEtFqUKR5dme5pRSNJlFEpw4xR2cctuCcPm00XYvr7",
+	"ZY9jbJQtBJZKVKmqBBneKxcgIUFadtlgAbcen1xgsXHOy+NAMNCOU27cOKl4E4Vu0s76h/KatO+mDq6+",
+	"49ftp5rNR+pvxIwafy5wN3XnWAd+14wVPf4QdlqbneY36dR8Hu/4gk177sfTyM3YuBMThFFBJTiFBMiD",
+	"/ML6NyzR70TwkVfnVhHhJC4ctEDSM5qDqbeHXyHGu04b44ivIeKlN7mv7Q51UQu/N1mQCeZyaYhtUO+A",
+	"lJlaJj2OVoClazWjuyAH8pZ5jGoFiXdXhqGHfdeazmpfre737XTUv+gJy8Lrxfn7pTbPb4J4g+9iFzlM",
+	"0DWYaUAgItloPyPyTrPzHe3DT4JOmgkSnGnvv6gAA2AD8RnfoRUGWYbkuJTggI1Flmsu3NLwWxaNEeNK",
+	"32aldUoTSK5oWeqjtcM2HNq8cnFTGI4+jYJVauBWXQ6pUe6I04R4IszQE7bMqVwhSZgiWmWwAOYJxpkm",
+	"ULPZ7GA2Q88eI6zQ4eEM+IuyYbkPZrNnj2Pw9vhzXarAL+Aj0E5bFVwLnRahw1zhSZPw2muxl1qGcAqs",
+	"1O1AwzOyswEa02lO4aGvODLLAGyCPx1xWjI9WomFdIZzC3Hn7pIumHLHLDt64irHA9eJljn0uTEngzKk",
+	"iKgzsID+0f3x7wozRQGmkHo8vX+L1oXJZ4/+H6QEsHW54lz9UlAmQS5cF+geVH2rc83/0igR0U0HXVZK",
+	"9qj+ZPscGJFKA50hvFDGrk+FF+x3fD7/h1nwJoZZSGhckIxitYOX95ixW/TsttDjoj130iCPYWKvE51H",
+	"r5ta2rIODwvBfyfm6sFIKqyMN7eNv4zRqS1gNDJ7dh1zNGCv21X+arnAuym6eqWm5siyJa9ltYs2V387",
+	"P3uN3EYWmb70WreXkSujy6im4RR+r18ZKRdZ4FVr4AfXiFSnBUJUAdNiXCGcKyJabjhyhY8ePHz0j8U3",
+	"D7PZN4fffHM//Tp7+OAf+GhBMJ6lDx7gbHb4AH81X9xfHM6P5rP5N0dHaXb4IHuYHj6YzxazGZ59cye1",
+	"FU1hDXK7Nl6rJTDvZG8Y8q4nI3QE3ltm69LMk1e86S0lm0z8Bu6m63iiPUjVyl0nob2LlISZmIgbErrk",
+	"1z1EDs+900CWreno/uqrUbq5Zl3Ha/DSbXCTpJkbIsjiZk9EG4wxjPCULhaRbIigZ9gqzvtnXD2qzx4B",
+	"B1W/UrXcJQdFuloHDG+YOmUqzUl7E6XCLJMIS8uYd8rQtvC8fxxDtXdFe397H4QGa211rdXhrogFGZma",
+	"AoHwOZJCPGG67YnD1UT8GCKIB5012HoNbcYLTNk0/WaIZfVbxB0brhj9d0XCInKxy7Wedo4lySkjvVbV",
+	"O2GGQQkjm1NZoowIuiaZeRnrX31uj92YZGvCHDNnSLWzeX/R6xWX1rIOb9+gTETLZOqLLHnX5zLg6mO8",
+	"ET2P2xZn0dmuYHvDUAvjdhPogF78NNWVC6ezw/tHo6NULEOsaXIMXe+UUDB67FsMpG5ja8C0i5ilV0tw",
+	"dmwoUio21nWt4XKD6AK8VxegmumXI5pDfM/npvDiGPdWwUHtuotS3/YY47caeuIb7xdZzW35Cf3tNz7X",
+	"70SNLssCrJOq+xpXEYBx8cNuprpG/9Ag3/P5pWk4XNneo3GYJoGlbK8Lhj3vaNXG7dTaGHYA7JFTblRK",
+	"0wI0HMMXMf00Er7Bmw2nUGoziZTRI8IUPqtzRZBG6KXk6PWZluvrjFMSMbImAv27IpVmiiuq3/KcLfUg",
+	"0ldz9vMaX+FgAIT1i1kH5lKTx9J0meuAYN34R86WUwdQCIzViOkaWwSdYJGbXLNWbOaVBDFFk7KgOJdN",
+	"T6gGImCunX2gHIrPGmN1vz82o7e2pz72PVmwB3NFNS26/Vlqxg6ieM84bf23h26cfcUrGDrr9EQ5GJdm",
+	"GsVc+bCxXaE5SbHz5IND4l+U7r7tD0mrb0SnM4qmHWBUNVsXlA06iO16uO0Na/oPI7TXyG+lJVNNtN7Y",
+	"dvi0PyKQk5g1a0e39Sgblkay3las/SKAUH24SkqeaxsGVugeLum99eG9upm89wfN3kc35C9v948UHW3K",
+	"qZaded0lF+YN84sOF/1lOT9AVtkIgXJARhJC5Qsbimd/swaaptAJOl/jKg6zcPlLnWkpzF5zO84GycSp",
+	"0nfxArE9hl0V7BZsOSMVG69c5IvmMUBXpFSazubEOcVkpqqCVZX9XZSNjfsg0DQ2j+oOZQE+ropypILv",
+	"M9bjNZVzLctDs/yNbW5fqQ3pALNJ8hlp9kTFDtAlXpuwJIwWNCeJjznVRkFiXeJ/dYsyP//aw6huS+03",
+	"Us8Xcdsbqex7WbVcT1uMZGEDS8edfc3jNDchC5sXYqduH6wWJMw4sGkZH/l6X656JKkVM/BXbTMazS1G",
+	"afu67prwgsUQJVuFar+gEhfBIqemQdMNZawO0OI8sVv24RrAlxW7oZrEbme/jsQ7V/bJi3wR4qZ2CTV3",
+	"ZE2vffmS+rWMbqwEHSMu0GPN75zQ37T7o2c6tCOn7OommsXtUpeDpCNyAWfx1cKsbyZnYS5Xt1+x5/i2",
+	"fW05Y28RSW60DTfz+Om1asGydFUzG4szihwvwk7bjvLNOLR9IO3Cbi8DEbSr6PBuIO17jEqUY3+RSZu1",
+	"K0wy4QU4tCTKBjuCd6s2ZkKMK7ikwEuMMD2OLQ6osImPNlFgRjZQtIhlI3PQbUU957nzKmo4w4yToLTK",
+	"GNwoL4g4xZuYH2Rl3pC6pc/1rg9MhjcJ+qY3g8LXBw9GeYzq4TK8uYw78byy6SgzvKn9eGCNMkGzfzya",
+	"zXoBmMy+efTVbGTh8S2U1Jt4AkL6qEIKX9kCzc23tuJAAVDaLyM4s8aT1l7DhRErPVvgPCdS2ctXooIQ",
+	"b5hww4WZLIwUVRCFcA75Ow7QTyvCGu11C8oWBEs615IXgWw/VnGixS69gsWCpArB2iSaaxDmWkVsakIS",
+	"m2EASUXz3Ht7UmUEtB1vsFAjG+EdHmujabrXjzCz0c9trzUnnbt9GO0rqNU1fRW3ai1bGECkZRIbZCcJ",
+	"FukqnuDdbk4813G4kXqrA+sdbFK8Nkp//qG241pNph6OZFLjxlToryOSQyxsPUZr8liD2COMGLzoVgIV",
+	"eINw9lulX0vO0AbE6JbYLey+JCKOMUPc5oyaxJxrUKXUPpX1ZnW9wEIk4ncjX4MFZbvaCXaLRu2xDhjF",
+	"pIZzxF70qhKfDHOwtl0THFn1hhkq0X00p9IcAb0KmnG7oz5uF65AUGJJotzBCF3snSGVLzpW1mhgKQzv",
+	"Kg7tyIgC8owpL/4UCsrdueWnVGn++dSOwQxJyCkDwhs+dz9hwaKFRQwD7CjrFzleLo1DNy3KHFeOIffm",
+	"j+iyEZ/24/5Mh+Ke67eWSR23NlaTBZZK3/HPz078AwSigyCPo64p5jt+eTcB9Tc1ho4Kpl9bL6oWr1gu",
+	"BVliRXr0kf67SzBZL84WUu504Sk4Be2kw+Ri2QOALSq47Wh21ivJvxvT97seqE25/aRo7AEKtCFMEjEu",
+	"m6MGwk7g1hh0b2M3aexGvfQGSnv39sJSfnN/if60g0u0bh6tKErexaIL9NXJUmeJAa5vvSzAYZ28U6iE",
+	"TAJWRbR1O1oItODb+XvX7oizJfRsSuvGYBzFEIxmTfHayenEKDS1dd1c0IBvV2nF5AyTyKo9bTfggEZQ",
+	"73Q0Dvq1EI2+uCqpTHxEReKf21+C8KDzX4dzaSQhqsxMx5B84SUoqPthtPZz62Pstcwmu7ceRqflPnHW",
+	"6m2jmJIHeE2a4wF3pEIq+053+DslfaOCftQ0NAnRnrwruYi0rVFgSMPxfmgeqvVtIX5JhP1oHluAxyBl",
+	"ozZ6X2NFhM4WojctcHYItnySTBo7OUkmTXxPkkkDc7pDveJJMmkua6zTBBzUBhjmpxYs8GMHIPi1DZUf",
+	"8pQ0fmrDp4/KO0OWL7GKnBb31dyT2CagD0N/lbShwlqQM2E1uMqoqktotW2CLxbjrwDnVNT5ICy4I14R",
+	"5r6O+lII4gOwwW/RRKxaZlBgVuFc0+axaUEYeOHXVuQc24ocuKSOhkkDY5KINU1r3SURayIa9GdmmSQT",
+	"XNKxBBNs2SUs7twN0v10rIf1nlXbHHid/5RFcGK2y+MwymuBmvrqdtRZWHy6HhnPoC3wtRmq5/st18RI",
+	"Jp4jjKg/X7dtAJrE17cNTT3+zD2oakequ1aBcTMDjteTmce7JUFj6eKA524Sk4GcqMTmO6K/kwyBDheM",
+	"kHNs4kzfnJtIZTOVKfGjf7cZaQ7Qi8WioXFs2CR7NzpW61bBOYImIbcHQMG1nuZWpciNNxTnuURfnF/q",
+	"Mjoa4Qm6LLBQckX0ss5fvfnSv8udMzSto+ZcuD8szFs65QH6ybgCJUOtTAJRZ851zkMmqM3NtelDRYME",
+	"OzGaRWkt4ywDvmOYrzTgPMNMgfbT/2JNNN+9Ov/RNfWrvjh96n/rqvmMU7QMM/oH2oTgknXDKVqbssxG",
+	"RRc47hDFTstTnGq8HTejKrvp/Z/NR14CTnl1TplW6L8pduwn4znyC/xO371vYkXzf8QCAr5AdDJvSVgU",
+	"SitlPPcTZHX0ukmd5junBe3LWuUej84yodM7jVyK66rvBv1eHdltXfSsHYAOQIgXijzFmx3hjBhedn0D",
+	"r0EfYQkksvIePIbbHSOZLmzdNbbw0iCRAUoPKtz2mFIwUiuhE8qVlXIlbqVLv2yYU4LwElMG7uzWkhYE",
+	"nroCw5Gcc1DyNB8rUIU6+Kgi+E0Rbnlb6ewXAfC5uf0ikNTqIspGJpAbKh5Qk8SodTkIwozH+va7ppla",
+	"tYhDb/JU0t/JSJnNb7OZ4nEwbOvTk2CW1idNR5cwZ+B5u6XWl1uT7RB8TYKNb29bw8AxpEGyENa7GvGJ",
+	"9fl0cJCJ0TFDT8mKu51vkHqXWtMVJWsN9S5kFhwDO4tPFIrD5P/jiK4V7T+kPIncZNo1iSuVE0bSq358",
+	"OUCdVTPn10aH4ld27QyYBvam+TL6nBo8uWHd7NHKoQj/irk5AIDNDRtzFfTdrbZqQV01LK0UPP6NM2q4",
+	"nT0XV8+NFc99qUGWjTvcWMyC0pjGVvzmvENO2+oetzHTpC4HUo0LB39rvxonNnpGRh3fXrtXeKAAZGIt",
+	"X50j3VdefTgQyzZy+MwmMba6Wyl/wPVHi720Qa8hq0niRp+uASDpzbiRgF+HTtVm0nNoakjqESCXffAB",
+	"SMP/bVzg3/2if/1lXci4k+h6gI3qc+Z2xLi46GEdAdwkR1TgOrreQptckBRL9R8VFipmv37D86oAHSG0",
+	"s37pHlxr/MdoQWWKc/RvM84Buvhm5pScazOE70OZLy6Jvpn93444TZDUQeQu0i/u010eIqbLEFMDZx54",
+	"EHh9q+Km8oDxIrYeDXY9URaXVkVl0n4Z4C4ezEbC1+n5ze49NdMxEw5Bplt909Mq2xHqbEdYrSPMODXS",
+	"v2sCrA1dT/9LBwmj//hqMO/SuAnWA/ha92KpdbJqcghL5oUElzTp1c/rJwnxHuI0sreRjYxTXZyiYucd",
+	"NBrdUxEoOsxrp75wrLGxeSbnWIyXXmDwx1jEHQbAa5+llOw44KnrGc1LtBPtFVrVpcDtNlI6lubZlFcK",
+	"1a0C7mHgR+N9KLTB4NyNFIPcyvERZVWJmZfHTCu7V1Wu6NT+YrdrPB6NBjsKyW4HTP/+35z1laL9PXAv",
+	"XlNybUVISAQGui6n5bOKOMraCdLCjGjd2XmGNwPpOGhBGuO5tCIaXxD6YeJ7Rzvfefl2PKb1y3Jrnqsm",
+	"X3FSKJy31mnpPd6PseircFubGVvJ6A7Qr5Iq8qvFv7TFH5o71Cg+asshW7LDLEO/QstfXT8V3/WktZnx",
+	"JLU7nN7dS7P215gpOY9X+xREyytkOEOkSRb8T5fgtnbYxgLcul1+udFEBuV9+7P0plgISjKkudN8YxmD",
+	"7pLUr1a9Ij25lnCNWGMHHckjLnVr7bAe5RBUkZ0wvyNL0dqUAcXods0MtErqgj6Nw+X3dOgkvSSR1Ej9",
+	"BHQDsHpnD264DgTOZjvm3tVL8MbJ0R16zZa98F56xV47kVRQ1zlCzedY7yvDLHVlj2WT07jKyE7tYLwS",
+	"5jlOr7hW8+dkoZCpQzrOjzEE6IOlh+2Fnj/0/jw7fn7cZaeOC9d2s8F7qs89HBq0kg61h+sThyPlo92M",
+	"vVTiqtV/AMfvx3iPF4KhzPoFaj194SKkrEeE+rD9vFmh7WdYkeNS2xBw3mfALuL2i+dcBc5D3sgo6ZJN",
+	"+WJkzcdnFRaZwDTvczXQhpZtMTs6X4q+/by7aBizM7JGBsEFKOEHCNerb2Le2S5SxDQxRnUZK6ni7IGQ",
+	"DpJk5uKcRd/7t+wc0dZRuiUnMST/vH2z4vTywRuWoMOHppxjO9Spu48FfkcLbdM5ug+hAOaP2ee3wZF4",
+	"rcOjKMgh6+vswHdUKqiEZJZRCpKa+rrGNbZlSscm0X87BLnjEFvfQwVlb5yLcre1VKQcobPwg9geiYEk",
+	"RlHfca3fjRz7rOMJpn8Yz5pbINne0HgAjpdk2VNumUgC0nVZzXOaopVp75I9vb7UjmKvL9GCZETg3H9P",
+	"EJ+De1jm3z85l+A/QIgOAzT9T5/o/hfNsfV0OM/RMyIKzEzmO2nanz3X7Z9jG1sR9jhjGcWm1fcXva2+",
+	"x6WWaIBpy2ouFVWVIr5Jw5ft9eUkmZw+mSSTs+eTZPL9xUjjaAOnMEjjl9Mn7V/Onrd/0XPB7sTKXKZl",
+	"dcLFsKxxcvEapdAombAqt/bwhh95qMUsq0ueXhG1dUxpm40ZNZYv77XJQ0nr/Ia+ysCK99TVJgUXm/PH",
+	"sZBVqZD5jChD549j3tfb4Sx4RuJvUUbTy5KQTDoHkxY3p+wKSWjgHbtWG0n1K/752Yn/Ua8MAATbiOWI",
+	"hj0Cv+R5TlLDJHdgWWvCMpPVsKuB7csl8uTyPylaNxOKaOisKeft5OuD2cFXbydboNxS3c0A5hAbYztn",
+	"bBHJU/CiJAxewXXFEnScrankQluCYWdpLFv9kjD1jKoTXhQ0IrAd6+9oSfUidAu0wnIV3kiT9AE+fPjw",
+	"8P7DB/jowfzw65QQMv/66+yQpPdnGZk/+Dr7JsP3B+gpDCchTNmkLc+jqRMMPG4bTLKmOZaGVy4hyHjZ",
+	"DHA+ODy4P70/my4toGPgWPYj5NntoKKP7uKrfvNh6x2muXqxTSh6iE/g3gS8RnZTOAX/6B3FiLSsXqyJ",
+	"MKDEXw+ai+o2qW+DXmqyPkAnvp4Dwq4KnfYgBUkHrU8uXkt0D5lEKBeOz5xYJj/GqoQVlsrdHONqI7su",
+	"scVqxnHBr4m4VK4mQZ9Ruhdz9a7o0cYD9p1NbxGDSe/gidnMuLS4i1wIt8u2PX15fO7uoZtsre3q9tb+",
+	"GbozjS89Oh6Fz02H3jQeFoUyjsOeJJT1yelDsG71ndvr7ve16T02G9b6BJqjAjO8dEoVIAJ/pX1zkyvN",
+	"mfNvh47aD9AaB91TFOxk48jGOZn1yO7nZjdNPeeH1jvadRo5x6XeAjuLiwrjbU9x8HGPemnU7HUnKGy/",
+	"X+ig942liu2SSj1aUmNsENOn9m3ZTkpgr5ThxSxEuIht7d/YVdRey4Otz2WP47ABbnBVTynJYxaXd4ow",
+	"OG4L3cCh13p2YFZvdEcmawz0x9jU9T8QX2wRZkzQ22o2+yotBVnQd/BvcmB+0gOYH9C1D30y7fQQZV4t",
+	"qYVb1uGO8KNVEQbFR/hCXWNBDiiTCuc9+b/7dJ6uguC6ztpZ8tKwe/tONhPrV+mJ4zngbsYQSIsWMNOW",
+	"FiUXyuROxq6Z+ZEIn8hCloLgDEJCNB+rCtZ4u5oB9eZDx5Ev1yY9GF3uhRsp9vHMjh6EGDsI/Dz2ikq8",
+	"b2Ziv0EitJsApoNeL13r7qfnbsLup8cehL4RDVB9SalMCPNA+FgLSXA2IzYl/XH8Td0cNHZh363C1II7",
+	"dr1x9egtr/kGIIJ0FXnG+wZGMKvz6tobocPbjHh08bovS6FXviCcCi4laLM006SsNW6PfHQOEmDf8FY+",
+	"/OLZ4y9vOoG+LHpGrzPkjBowJtrYEvAOS81FRbeoXN8/gTwpvcnDtb3muhU+Q8v1/VtwrE1oef8XnGUi",
+	"KfC7bw8fwKIyJj/aXLQ8zjKXIv6jzCirOSPqHMur7um/wRRmuF8KLK9glqPJ+zZh1GtszJ6099dgPkYk",
+	"22qAQPEOLhDkMg+zPENyA3gzCBfxbXxvzGqHkzy3NDf1qGen5iGhp61jUmWVpkTKRZXnmzH1Xz6PyiS3",
+	"WaCjZ+su/RRdME1PJyoRpp3Qtfyjv1Fpyk3Y3BS2diVYDcw/0cs3ryBg9sm7lOQQTGuaWkK1rV/aqhIv",
+	"Lo51WIP7yJm1LHiKgMbuD58jwDRy1q7mkO3USO3UPqZvGiaoOG5RJ8mSJmkSKPjayuUfEpcZ1JCEw5X5",
+	"yxg3gLDszJilJA/ameKh9sem2GiQb9IgSfOvGo+TZGKgM/+usQGR43W+Bk+ofpKumPc+mfxwcdZHFcfo",
+	"h4szFwFeECxNRVQbDkiVrANSYi7sI92m54JAzaR4/NBVSUN5dl3IaUnE9NpEtQie53OcXk1tZoHycEpZ",
+	"CnYNOVKo/eHirBEo88PF2Us76ksz6A8XZxeHZ/WwWwIELU7GByNFQoNcdK/GP5U17jmrFSiay0I+FVxM",
+	"r2kGjbcnrtP49DA65+1JsAvDoXk/4rmx0jQ3/IpsbuUKy2H492Gqqlsbs40IshmsAFL78cU9kwPHXYRr",
+	"b6Uw3n2xkARMTnV+T41kZPyGPsAh6EM8c7a55Hijj8n98o6qTW/8lv3gc+xA/WPLgzVPrr3qUz9YwE4/",
+	"LKhLcT8XiY+v4WnWLO4t43ejQLDeMKTReLXVubougsOIs3Xqu2k569aPNzowIlYwVl5BjHE4soR3UgIZ",
+	"sQlTYgNhWfArysma5OiLw+n9Lw/QJfx06DQ5JqbJDoR0bAdacK5KQZn6p+1/3zUueN3WjiT1A00AFiAe",
+	"CYLJJeWMZGY0DegjdIi+MNqmbw9n6NXjLxN05H85sr985X95YH+5b38h5ocDrerXldcaCzOKIpxf441E",
+	"pSCSsF3S6tZ7qfEKa3qi8Re1SgV78+IyYne93HFLZs0tYRlNIS12d2deXAZRpW5jZkEXzKDNCus+OqM2",
+	"45AwFBKy0AUlmUUfXZM7Qd+Ly12QFzdtXhAxfXE51Td7iMm68A560UBmRqWiLFV66dCpUZbPnub/KYOM",
+	"K+iJYd96BOOMbrDtBjDMJAHRiFUFETTt7Cn6YvZ//t//ff/LxCc+ab71XeE1elNEauT04lGfKu2H9hIY",
+	"9I7Wwk4yGUVTlHN+VZUIknGiApelBh6uucyzGkWJQHAPazocwo5JYJtypggzsefgo6JtrPpyMQHR7gbQ",
+	"CBRkoTW5Zh9O7eo8cwmSvvp9rWcscXqFl6SnzgmXt4CkkCbN9tfLeHEZUhyVcZL7gWzMKesSmkTkHU5V",
+	"vgFz5opsEC5LgoUecF3IAy61h8c/Q5W4pbc4ZeqzvmRGKX5iTv7mxSX6Yoa+RRWreUGCDqf30beIMv1s",
+	"gudfPdaXZgsLXMI26rcC4sPnrnniEiTIEosst3kCV/waFZht3OnwJ2O4YETnKuxw4O5pCDc9ynMGL/YR",
+	"NePGC0wgUN6JqFTP8fEkpWSS4c3RK/8yGvZ28C0/4zLHvWH3iIvewPu37HaqIx+gMyX93DaPYjNHeBDz",
+	"jXIq2yN0KyqPTAMerbFszuP2AvgflGva+PrHzpSqBLNBaLbIloNcVOxRex99AbKkubBScK226hR0pCqs",
+	"tdWTdO02E2GPe0ZEivwOPCNa7KT3AYFzRQSDwGp5d/Xy6hbWzdhPqm8wwbUmwuSvjxeCqe2vhOht8Sdh",
+	"vkFyRY0MgpkeLKcQZ0WZVAR727l17vAd4c7aeN/5cNJmYfseWQEzxlVfaYrLSoNBsjp9f2MrMmrE7Urq",
+	"G9jl6LcLZGRp8NI87HTJeJg11J7YBGHz3WYhsnE/utAT4gLC3KUsQRdogEGMhwcfjNk3qipy7BEQkzp9",
+	"WsjxA/p0lZ+ihGNf+UYj2swrXWeszsfPmeauOm4RlRCZEuebAjaCIMCsbUIFqsn57eQkGOoLm/IVfKog",
+	"H8+Xbyc95HezolQmuUzaG0VeLyQ1rlytum4QSu4Db5slncIUor6tJApkwQS06rkk7RH1PK0LzOTDjd0m",
+	"WqLQvhmUjajmftpo/F6LOs0UxYOoC9tGinZ1HVFcDStbHFkQqLKSmbsoxxtfORJEc3Qd3KtWWOcL+0ow",
+	"rSVRNg+1EzxWBD08stUF5hXN1ZSyFpcw1dZqPtDaux0O+raKYx+zumUrnP5mtS11e0XyHF2vNoGdyOVT",
+	"z3oOmqjYsMQdLEGXtU30PLaoLSQNd7phUzIRZM6mrD5GjurUd4t5ENQ1ImuB391i6Aszh/WE9T87/YWm",
+	"sAS9nRzpanFvJ19OkjEl5LQ1A6ptyN7ih6Bh0hqDsLqGv8UIW1PBmeZ1/gJsCbm+hoaundEM+ghLaBhG",
+	"3WRJehsqveL6eXmjq86VFBnlX3pal5ypL7FBue5MyioSMFxbxOPVfHnV+NIJAOr0yJ0lZ9hcYJqF1Von",
+	"brbtyxjvaNRafoTF+CQyZ0WJUxVNEkFSn87HNkYypyXCuf6nDYGzNrOIr2Qer8h3jYoqXdkjG4yACMuk",
+	"L4fvqDCnZYJ+J4IbRoXnkou54cFSR/A3z9I3q76z5DIedN+EoB0Paqv5xY7Os1H3iBowI0FBlzkFifLD",
+	"5u0JztfmTomWYah6OPbYGradONAAvCAJvcsxY/Y7TsS2p1515FXFNbgYzbupkuAaYiaBuA36HBFYeqN9",
+	"GlgtTBJbmNZhyRKn5JRoTV/k6jA5P5lrB/l9Sy6Dp4ZzO44nyqL+2Hfr1MKLTjsGW32fUxab8r7wUzAx",
+	"Fi4NeDMkbzCYwnXv5SJ2zjFZq/xg57aP9Zc28PUs0cAuubC0bF1WR6fK8oPEgK9YAe+6mAAiQ7dw/X+p",
+	"BFZkaUCQ+qHngbfavpueK4uOBjYC4BJHBIMU2HPNXVGW9eSq1kl9BGfLWojy81unJcpA+wjadu1l80M1",
+	"J4IRRSQS5DewEsAjF0KipR/CeQblOdgeXdENo5punQBJ1hBhbbOWNdx2wunhkrQDjnREaaLmzAz23IzV",
+	"/HZSj7zFF8VjaCB9iIxF142kBdiucJph7xG/jEtLnL2pS6QSVaoqyxZUhy0FxA6e/n6P6lIrC55nRLjd",
+	"zOHxt0Tm13AA//peUMjJ83YCO/128tT8fe8Cb7Sf0duJGVfhpRuUXzMiNE3ph+vUxJMghZfB6KYPKKJS",
+	"EHBc3+CnRvOAoAysk2RiIjODHruSlMP3Uzdi58srvIz9fBzOqXfQhqt1HYTX8pqqdDUYZtJDXGG4AmYZ",
+	"FpmxzdiaJPCXG14zGlmVfcWQtOeQV6d3PxXypE9Qbkvv+vNAygbzIsYbInpp2NySup151Ce1WWFFlyuw",
+	"AwmSkoywlLgKKiYht1EU2EduUmvyO2Wq6n+N1N8nTXW4Vzt4FULKmd4F1SRFC4oVnyaJq8kXDg1BTnUk",
+	"khtxJK3WCH3p56p/+8nMWv9wYeavf3jRhKT+cBbAVP+q81yqM0PU9a8++0gr3FH/jHCti5F+Z0GkbB6F",
+	"3FHFdo0KtAy93WLzuk03Zqe6ei4odE0EoPlJRl5vGzju/W5uF4O6LJ023tTiDnL+kRQ00msHnEVFrM42",
+	"ZT2L8rQJEEqv3woWOvqw7KbEqrc5ImHdYOs+vBhn7BnbQLBHyta6nG6/Deqj+50bj8bmVmGbg0vGSMCm",
+	"2HKZtfzWLW2k7Gjh1mX66qn3C4k5b2x1alfm76w8xSVOqdqc1OXfR1YBDvtFYTfuKqd0GTX6X353PD16",
+	"8LCu38s4A4+W7y9fPG+ydCzR24lc4aMHDx8Zd7YVsQGXbycH6AJqbdW5znBBUAazmlTe/kcLkdFv1YRp",
+	"R/7H4puH2eybw2++uZ9+nT188A98tCAYz9IHD3A2O3yAv5ov7i8O50fz2fybo6M0O3yQPUwPH8xni9kM",
+	"z7RPgCA4e8HyTW/ei8AqMoY0AssH9BZk10i6fmvG2eULdP/o8GuU8qw2NdjmttAgFhqT0qqMadzgYL6P",
+	"Wc6paWqzyrXMDeNJTw/VNj60iW/pRmwRnT+tjl94az+UdMdhsTJQiSRQItMz4aBSp6+w2tLHbAP9WQ/I",
+	"sUCZ07bnxZZqYZbCLfknSHIkyFRW84LWNwmcBTA9bUwEsv/x7HScy4IuqzpmqeB7ry8QYrTDJ5VYkzEd",
+	"f2x02JKc+9Tq810Ltzn2fVQrpPymGnzB57tI3l3wbNQqz3W7oYdAU7FyE9UMXxOR43K3w/XCdIotzZgh",
+	"T27IVYYNmlHnmByndZmcqFqulQzdufJw0DXahB+JC6oKInUCEnGX4AE6Ni+KjINySCGICwh1cY5qbOJr",
+	"O5thlSDb72I/zDG7cAuMYlsHj31g7prQB6d7bqSL3rc8pjRVHQP7uin5mHRqsDi7EZzJsWmkLSwZ5CGP",
+	"rbjOdd235PH5qmPjd9ET0fZs27PbS5ZviKmXtD11ceFS7kCpX4ipkV7Xk+Od+VftStjB0M1qF+6WCV+D",
+	"MCoRPnWKtEko/tQpdIHVDmUbbkjY28V483JbxowU5jWwm/jl+vTUxQ3S+Ha+La1NpPNB8HyE5cMuARo3",
+	"4EjChfRh7LHNqh1jGhtQm4eKF8hBrW3JlbLFNgeLBvRFebVrekqFMuwvDZfou21WG8o/Wsv4tmTLVN/0",
+	"kO1zMICsCclTKm4Kys3yP8MOGHF001tqAKOyWcwB/Iec6LriuVWO1TUAoDmVQdrbO8hn37eek+bLp1tp",
+	"2X5EosqJRKVm/y5koqvkc/KuTdVrnc+st3+WoaqMqaBs6wsi0miqtMsVFqSJQu8EogIXNz+Dr2DVSCE8",
+	"G0yKfDibbcmKDBgY/xCvcfcSHGcjHDW6I83XWG/mIIcBIzRnIDN4S6ct1mIkiLZ7iRGKvKBQCpJSSXKj",
+	"XTXeS5rIa+clM8w/wykFAY8hhANPlW7l6xajBueCy2oeTXN9TsSS1AdCIrnS02ri0euBc06ZVaWVgqwp",
+	"r2R91ox7oj9v4SPQG4MXwDCgSxL62JoVWgmqsJ4Xxtmxx4F6KTCrcjzGPdxu57Ogh8kdfeGOdZvY9bKl",
+	"8tj2HMW4iRmhELQqI/2i7q+6TlFxP44+knzScj9sXdYmpJWtiagd90FPAcHG/pXhd6/97oja5qHsflQ2",
+	"hj5C/0N7Kyp8pcmOjXbrcK4qNTqevH6pp9eYFHqS/++/jqf//fMfX73/H7H+IoqE4wIqw1PWu0L9b02N",
+	"FaMqotcB9N2ooKB1F7FJBQBvfdy+p2rBbWszW/Zwm67eTeMWrwgu0JysKMvMfeLrH+v31WScUrSVC45p",
+	"n2ip8GIBM5p2bsLG+DKoWOJ9rD6OivWkoQ0KuLbzILEPW8O4fQ0scApwfBYeuW4c9yousEpX1kJsW8EZ",
+	"IRlVIEyDNcJXrD+Iuyp/oDL0tjWb9Tl9fXm6wzm9VQVol+PJPpY3wOTs1nitYdjGx0KIzMZ3aM4WmpE8",
+	"vQp32ZutNKCa7pKINU1rsZ6INRE7qj7+gjrcvWL0IyhGbxhr+fdSploW4IIsoXxxvgHbq4sml6rRg5Es",
+	"IrxbccHwEqqG+Y4dOfIlIrTfVC7aq4Q/KPbeSRU9wopcYdGsjSVjEtJd62nbbxU9WyjPSV+cqCvRgXBc",
+	"iTpccMHznF9P1Uqbwnzx9G23HwzlK2PGI196y7Q+qYM23HPZusfLEptUFs5psvY6sqNZuG2iaFD+vvzu",
+	"DTwkMUuJtAk3rq1t20VDExmEMhU70twIlXabjKyQnbmXknn1AlTQR45+Kf1Z1ODt9I4su6aZWtXZ8J0H",
+	"jo8tSlAlTRiWC3eqGSKU+aEFzbEIY3ziBRMGFUZ3pHtvaSuHdezPoq/Vy4ZaXfCcdIU2dc0Dwc0SvyRp",
+	"pXUYJvJ57WS3OjWiK1qIDhus2O6a/3pk3zYtmQ++rUieoRQzl9bHl82rmKI5cmryqIppMSJHekONWxsD",
+	"RISWXktN3qGUqnGVIMw2xsBf4A3YKHT6tHbxsrFeysnEYGpXuLvadI2+6eHUbVJUe8FjJseXmgL0Okw2",
+	"gkXTD6d3uDhhOtvGwgQI2sX1ESiI3N2H/MVZeH83Sp4bhn2AXhhM+3YuHl4JrGvJHXT1zPhdkBrxwvpp",
+	"Riqegko4yHR0oRNW2W5IYCrNTWz0+5PhynSgYg5zNPaqud28pWmAlyR0HHdGHBOVqNeq3bw1ICZM64NU",
+	"27oKXZ1WsgsZZS2MaIh8wc6SCCjRFtOo7MQy+/SPP7afY+0Kidf6mNZFnhuvTCtm+GcmzbmyKYiMCgqI",
+	"Z4GlIuKREVvAMtZMdhUWYiUZMquRiaUBkFYk+lV//BW6x8CBqRPEOLiCWs32r4ucc+E6aUFVq7BMxxiL",
+	"g+ZxHEhlxMRaH27nr3Ghv8LkcPttIZstRAPL6UkH6hFlpQ99qWpfbSLWJkOEgcyG6QciSouHdl8ZetKn",
+	"ICnebp6dY5M4tt4xExUf2Bcs6TQKHTPjaZHUSU+9cufNuU1GKxv9rXgrtQQb2v9YuEsLH7xppmRI8dIN",
+	"A2/Fnojv+G0f2s/sAqEq867EfgimFE2/9kCG98Ts4JsH+k8tFdI1OXekY1wub0xnrTtG9EULAp+gRt06",
+	"Wt6KXcag6KiLXts61DEXhUoI0DKbgtW2OvaONnZdQTJyhkzBS8NE7Li8JJCcLLGpFrRBrE/iqC/vS6wq",
+	"YSr7bhVD4vb+xjr0pAFMpkDnP0NRzzzDvXXbNhQVk6jEUqGCZowuV6pZvO3+o9msqWv44l+zw5//NZv+",
+	"4+f/39G/ZtOvfv7y0b9m0wfmp/8xzrtAX0omfe1Yn4LB1cIONOA+OvpguG/uinAextvGNIxSkbJPt2jk",
+	"b3PQjUIRRD8wGWS8q1DRF1OmGc8HBgl3N8m+IqcYEvpECZVxNcLPwaIuG+IQ51bTaYOO9GBEUMgpHTez",
+	"W2M0X5jrK63g9gouU0wzxCtlw/jMaJCuPXxyI+4sL95yzRlYdDPOiE9Tj/OcmM55bucwm6D4kqgVscnZ",
+	"S1qSnDKTnP0SZky0HYCUKsixlOagMXLK0UaAlF+0m1T/0406NgTKovPSjeV+uKjH9D/VY9uNeB7GejYJ",
+	"aksB+Qi7NIGCqMRqpSUKvPR5aUQYuShtGhuvlLCsOgwIHf9oWxcRSFqhzTk25+qDJhrMQ1Bn3NPzKX7j",
+	"eXr4kEV5nYhAL7vvdHWU85E3Tikbgd+GynMqFQBvuFRd1LURtB0t8jIQ2r41yNz4hiRGGHIh4ebuIkUZ",
+	"f0CbMNdTUqrVUOHjdgQvwwWF2hCNuHYj/UITD50WswAEnZZj6+PS5wccoXuuFzECYfDqXtQHKzhXrWMF",
+	"boWg/Xf9fYBzWAMrJBZTcy1aO9zWYusMU0c+47Qg07eT+K1eR2qPSr3gQ7vfm7jliKpzae/PZU0/kHlO",
+	"u9ZASPXbCYII7CCsOgZdJ7O9nbnvMDkDVdfVRD9nnevTr9pM+mvtbahPkRPBaN66c6CBEcVMZ2MSUr92",
+	"oyHNh3j0A3mntt/GbgTbvm+VtVElzivwoLHIMTzLN7SQ0tQ4R9PQ9sZAR+eIu0gUNldjzLvDfUOCLL0q",
+	"wJ6pMKspFkQXlNfoQIon4UqKyiVE35jHM85z273YKc02AGLqYMRrD/eUha/rkfnkZc741F3JdhUY1PF6",
+	"FinGZCp8jZlEc5lnj7dO1Vdx8QJy+vlY99bAGBVVrujUNugLe6mrpXQ5aMOsahtuYwI1/myH3mNiFeUR",
+	"560VSa/0/Sl7SJE0kzaEuvq6r9HhGW3mKOLS0seJ6+6hG3C9GDVqXd5nSA4aDWA/XBEXaTmxwPbtAahR",
+	"Ihtwg+hP06Un+oC8K6kgcpcBabMcS198YI6lekPJ9W7QCrLmV7t1qUQkqOOimuc0Ra9f/ljbuMENDb3o",
+	"pkz0mWCpdOWuDmIzrSm5liMyZABCwkiVeg9CjLsBB2kg7kVpBzlj3/EqZkuCn+t1SaX1O3AYE3T48Bv0",
+	"BWcE1Ohf1tWmJVGhpuzo8GGoyT+Mllbsh3tn9Rj06tORXfYw2sDEXqsQ55sYi7Xlh5zSG7xliCKiK+y7",
+	"0A7ZE/lSPyUsaZEQCrzxJUKsFWUXY7QPuolm6GopCmMPNfNhO4wBfMb1cbx3VxuMGKxd1YstKj21oRI9",
+	"D+5YDtFLl76ytozW/hJpWK9YfzIFi7urHpU+lBbkv6NKrrPj58d1KSs3fCAcdidM0OtXJ02fJ1v/wePP",
+	"KvuMy50nO5DVINm1lhY0wdb63UTrCXOt00QSG5M4hToRaV5lXa+qY6hnge89J9e//JfOThRb9A5eDnxR",
+	"M5UwRX5IXS4ux0gZSeCTzjoml10tdh2tQS/vVKSMsE2wR0ROjfUjCjXo5tUyGPbQDHT4anW/L2OlfqC/",
+	"ogUZcKGxlhGs0AqDbqXEUrbqfRjwd4Hp66PZKgaQD/MKlPeKC7wkyOcbjfbjPI/nwqk9rypZH0YzT+zS",
+	"ZjTGX18zquIu9MZTIz5sIJFrjzC4/C6IOMWbvpsRiq3gDZIlMAsWWKsSE5FkhXaeXrVp1qPsm93DJxzg",
+	"Zqpe6n3VI/o3faGirlADGShT/c+F00+Ofky6965EuDK+/a0kiR/hXVh7V3EWAJWgikFWLoEpa0WFffg7",
+	"sWdS8zb8sKkHHLYfd3OnNvbhGusjAqpEV+rBnlzyrsRM0qb321aNb/em5mnZe0sbs8kIT8CaasD9NQmw",
+	"6a3WJvV9bcOL3QvDjwVJs7jb9PeVoDKjqY/b1Fd1yz5lpGLKEvTktVeQPqn0mcEMvWYGk6GLcgyI36Py",
+	"wnHsXNZzN8atJKB7eojHWez6uIbzMIrqoeQ2RZRsaqJC+4X3lnNOpjsRmC4lFTtluvSUd7E2hyo60wjv",
+	"Cqv47l2i/a5XuD4uy8G1edVvJ8nlTqsuSDGPghSd1ND/FYOSF4qjjAAvtXUgWp49+tTMddGvXaR2TRxv",
+	"zqOQ3oQZGf+ZmhU1vUZcoEldCFneiCf1PBJG6MRMw8ab0IGd1O6ndVy87qMFr9oG1Z1bkR3DPkAIHWvN",
+	"e97wd4uQpfMiXnBBUmwjFdY8r4pgncZhqMEv+7QU3vqvlzXEU96cR9Pi+eynXeZXf3SKwDnJOVtKUD2b",
+	"wwfucJpALHVr3ctK04p1FovowaUy7vAx0UUqVGeZ1YP4uZOeWbQvY89UNcsazXxihR3qB9Obc2eWNa3h",
+	"kaRZEVpRIrBIVxt3XgwFGm1Fo48/UbXvGlWWaIc0deNzzoKFaXg7oUm9qp1x29WU9VLeCquzRZfy5liC",
+	"ef8Jy3rzYoSlCLAvuTNaruipd3BKFwsiIEAkfPtq33Xi0yJgibB/kyV1laqa1YQlEggWOSXNAoNfffWw",
+	"t/QBGblonwjR368uPFkTXrMGxPgYjSVmams1pWfQKLxXTFmKXSpeNDpuVaiHFGFQ5LbQgTxMY32B6neV",
+	"FLMIiyjcAC2621aktMGPoiAMpe9VioSh9BjY9gH6yTi7WZfa0ijbV1wbezbB+33pMxTq9WC2qdv4bIUA",
+	"H1oIQn63bk52jMU/UYGZ9pOt/aJ8TJZ1G6tjOlgQ8dRyYjZDRy6NxtSJPrV6vdcrmq4gvZGug2fdpka/",
+	"d2HMpzBkbO/d+uOv7xBD+sKvcJ5vWtnZMENnJ5dQ0GksUN+ZIWPwmD0aOcBL0/j9+z5a0tKHzZbc3IPl",
+	"LhGzbphnPRGzVgfVFdB8POIN/ZBgZD9OYqCOHxyhFjyn/MSWl2uzDrl6mvPrvgJUshQEO1kNcgplS6JQ",
+	"SQTlNhVnKWhKMkf5soRg3tpJnKyJNmPBCBBv6s6obnmALuxI+hrQwjvEB4d3lnGCd2dQm+psFT7pQ3NH",
+	"bdVjgNxMF9XtjC6kp+/obiE9n6agKapDVmzwqYpekmMSKqhY+LNE16QR8awRmARFS5x/iFvW2BJZ27Ic",
+	"jE/xc2Jpq5XjRyPqBIus52kE4iYRMrDOe9T6B2+JhWJEoPVR3OEJynaNFIorZig4IhYbkgY3OE2bYTFR",
+	"l06Eiytnx4N4wSYpaJGXcfPDB9Vr8UgLCNUts7kpSX2mB/lBPIe4KZetSPaT8yroqgRdocp2Ary5raHR",
+	"eN32ZP4xJu5xKpPdTubYg2kqAPQezB7zy5OufmNkuqlvZiAqm5xTfdLyOOF2J67RTGuAZcAyIDkC8GSH",
+	"R81EbotTjHS+6H3ilXVMYpwOBZVXg9oJaBAEJnYz94aj2VjEnsl2TJUqjbtOV8sHv4d7Yj3kGVdTmMO4",
+	"r79q6JylrVuneSPjtShpypKaMGBXPWnqvnaHIRnUNY/19jkl9AM9fL1LV1jJ8gU96sXgCNolL+gduNcH",
+	"a9REVoPq6/Sr8W72IR97ztWlH7fx5ax2EWt9OaknNHquc6uYiu//dR8/HMgAa4mgGbZSu4y3eG0TiJAg",
+	"m2fBMyd3AgbZ/EvDaCOMfnu14absOOJND9KZS+pmHkB2hPEPd8KI2NVxTE8p476NsgMKCEd6Q1xxv3FK",
+	"0sa9GXueOG6023AvqbzaIY8FkLlbkk3c+EH4BhFiPLivTPNO+t5g19rkbmdwu7QD1boaQr2vlmcfLIwu",
+	"qI44/S+CBWD2nDO16kupW+iPNe51R7QhWNRXLZgQ0ytiRHAdsfDF91ovIDZfdqyuPszzaNh1zeDtLNtG",
+	"34065YpbCauVYGTrbVxQdmYaH0ZI3AqhMc+nl17KipuBpKnCB09FLWUYByPQ6NtsGw0XClMPzreuBTk7",
+	"JMjams1mYVQw57kV/6B4pxk92v9Xl2nqVxjqAD3nfgvrbKKdJFBb8Nd+pNuNGyZ1zQB2KaFo5RlYEZVX",
+	"Vn64Kul0LvS720TZ1ZkimuK4RAWVoTzk7nJ/j0uOFtiG1OlXe1aRIHBPK5kqCKeY2+RDQ0KJFwqoakgC",
+	"NbQTE6uTNSpbjbr4NeJ+uDh77IZpfHjhxtxSW7G0r6Doh7NxEux1NF3GT66Wj94kjTY855VKHD15K75J",
+	"3Bz3rI/SU123zRZuHCrW2GbcN3rwbX8MaZmvPukjX0RfHQ0+ibbK//7W31mYvzVpz11ptyXSRbfQGEqe",
+	"WltqRGN5U5FpJH2XNoQxek5spvrxko9bx3+YjtFkVlB0fRfDJvR4U/Rst9KRuIMvRUmLKjdUahobZSdm",
+	"mdXzB4WNtpurG+fUFn9tSkgWogDw5qoDvMZI4mWgQvvwqItAvdL5aPIwekXDB6QV6SziQ/UDtT0gVpWP",
+	"Zk9tQutxWIAur5mi+Q59jJpyx1eh69XQ5NUQN3EeBmcMUUKPYXC3XJ1mYuS8mW6a8fL2aKbrD5tvbAZR",
+	"IpBNqOXB/GPis2FNnXin5esZmNk0xqamTpD+9cEsRpO3msG1JtAak6QguJf8PoRie9/k0IyqTYJ8Dhqb",
+	"91QL6z5NqAnTaYq8Ix+RcWedEcQ9RNA7BeW4TrHLxBVxOqUyFaTE9jjExW0nn1YMHNymzglay8yULadN",
+	"p+ipqcVg/DW04zwgqPGrySGi8TBdU57j8QquAN7XBpoLO3nw5dzAFfliZLPLAJTg44/Wyb/n86kH+o2H",
+	"eZscfRvFWBLvdD5CsnX7elbEFVyZX89OGV4j1BIz7Npnw/ZEShHRwNTFDoAbWl7m62FEixCNFPZ6d2dH",
+	"tfaNNnNb0h6TVbfXqWMFYRDeo8MFGvkIp27wQOAnslN5hu05p8DeXievSdA5Z5ANiaOngo5LPWW63HLi",
+	"KQMYYdlg1inTakvSqcOv7yTplHby+eCMUyH+m5my/nELQO8aU6fJsRs614xqkxTf+4HnV1jh20pyBefl",
+	"p2gB/5WLsR0hXEl37IaBMs0SO3QUHvo7ZUutcTnhRUHVSy2CdXGoG0xTaIFASuuGs6ZlFQ+t4e2+4yzH",
+	"Jm6mN2rmRqO20KNB9hP1Y8fFnZ1wJquijDsju0YorVshnAouZSutxAi0Gf8djTyfRGIc0nJa2IC3wYuy",
+	"sawfTZ8BlBtwzFf0xbPHX+4KFu/S13b42kT5gbv3o0dNz8ZZ3O24Qb7XB5B0F7/jR90ZKQyXcsXVragf",
+	"fHz0th098w07r2v/ZdtzuQ7Ob8INkdjbADhe2iIH0PpN/fhv+aDrr96F6Qv3D4WXX0L8mzMsvHhzDLry",
+	"jF+znOMMDgKrcog86y3JHs79k802GtE9wwdk5WdEF25m3AAuo6ZCIHhr2sxEzSZjQLrJpo/T/VC2ELi7",
+	"W6Xg7zajdusCWurLTq5Mno0fyNaeb1yhjcvL7+pOoDZ+TtS1vXgHR/ANow6oNyH5ZJLrqNTxL5neINb+",
+	"mAp2IUhBZUPzHVT3q8pst30eWRy3HrcBQ//5PYHOEe7jo4bJyQpTNnqjT9odbwvd4zVHWniE5HpJRtck",
+	"6ZQCGk+0gCLQOuuuOxNs8omO19jw0Ut7E++gHuqvFmK+vC6zPT31reVFaZS3f2K66tLQsHeeNtbavEs2",
+	"s6M21qY4B7sQVijj7H8q18L4GpjBZaS6Qq01a8kJaFUVmE0FwRkEmweffW7swF2QSqTHBf32QU/Au4wK",
+	"JKjA6Yoy0jvV9WrTmkDjwLruvp08xTSvBHk7sfAcoDMLkMEOlSYvqG4u4E/GEWXmitCD+YB6XZ7qJYCJ",
+	"Uu2Es6AQ54W+e/Xqwi0WLBLzKqjf6JJ5IqoObu5sWSMPvYA3/CP0dnJZpSmR0uWq9Cs9QOeQ3pkt+CO0",
+	"UqqUj+7dW1J1cPWNPKBc019RMao291LOlKDzSnEh72VkTfJ7ki6nOlCSKpKqSpB75sTCZU45kwdF9n/J",
+	"kqRTzLKpdxIcUXT2lTAp51ecK8qWOoVwHg14fYWX55RVt22BsWMinGW2vgUO42fxchKVdhQRKSlVtIBG",
+	"5Qo0MxsmPuYNZLrpvCnWeWZEp36xR34cVFn6Y0skN1KRIoYraV9WAURDw2q2hHXErqnOZDuPfEnuLM75",
+	"LtEEfXFdVr35nW3rrrYpCtaT/TzyKDgjaEuTSBnBAhW6hdfcNXt7PSM2gcIMWVgP0IvWrplwwBbZh+FL",
+	"ZLGgKYWHVJZp9rWibPlPVApic3xIiDC/hjzGKIXyyFjCX93LY3+U90d5V/XNTU5e7IQZqfgsfKtGlCZn",
+	"Y1/yt6rlcVPH4H5TZ15owhvNMTAuyv/N+ZPtPnAuxa0uvD7fuBAkm3y5m7pxc4IVWVqU3DBFeTMcSSev",
+	"2CBtOwWhTscEDydgS9AV2Rhf0NQBE1l9QTKKWY9avwOCjxU13Vq+wEECY2sLHaP7rRTkHog4xEL9Bze1",
+	"KTdVGOuX3ouVICSoO9WAaJJEdBwminX0K+3NOZhnLXH0GIp9gtyWU7nAaSjrR1LneFoy6xuHLB/7OGaf",
+	"vOMmrHt7vr6uF0qxW0qfcRbkdWGdKid+S+pox+DkhAhu0mlNNPHz/LQnFcqxS3tiEzD5l5jN7OlzoHi3",
+	"ck3RFcsgvNkkGZHV3OVBcdkuuyaBFc0zQdgOpGZB7stb9fhDb88Sq9VwbhiLmrCWl/3JMrqwXvFAbqou",
+	"FR2X5fDptvPA0xxax07vujjR8tQIhzx7ScKS7fR1d4/QOOn8SNdEPy1J6DEkNyw1vjy27pXzewI5QQ9p",
+	"XBUWmOajfYCCuS79+MGPJ36q4Mc34azB76cGgOCXpxaWxqqqiJM4yXEpYxHS2okISeqq+NTVKGs2YwP+",
+	"ElumlCrknaRvIxGQdBsxfHbqPdsWsKf/79Yb339g+aYuXoR/wO816zSCQVgur4EkbNh6j0/+Tg7dzscn",
+	"EsPemdqeTi0NvvI1aAWq6SnuprEbROviLPsAlzDo3vIicknKAwRt3SOnKm4Jr/Bt55vebvu2cH03+gBw",
+	"tUDZ2vxAKmwVfw0FuzD1b+LK3UtXWcZxJZcwGXDGapteXexAb1zzMTxJJqDSHMmjzDpe1ROZH07C6cxP",
+	"b8JJXbf21Ob3FwaA8YH4QOUtcekG0syAo9yNhLh2DcubSHQ9eXkDgV1a+WcgiOTNufNR0PFSV9rmHPEe",
+	"olKZArXbkuz4hqG8GQm80J+ecmFCYIwReVy7n6haWSu2HO7znKu624ib30mREdi2AtI3axzjr3TB5Xi5",
+	"L38F1Ap+ePz70MP5Bp2/etN/M4znwkQIUxj3g6/anhvmxPoNNC6581dvkKucF+bgu+G188EW5/gOxeLh",
+	"ghTPw/dB90BZSdzLoDfs/+zxB3S+pL+TV/at3KdUGBo6HOOyKgpbVL2DPN3u1aYk8kMm0gNsmcTYVihn",
+	"jzcmY8M7G+y9RWMScIpOLl8/pkthOt8EUlnqp0G5NuegL2bfvmayKs3RTNDht0+w3CTo6NtzktGqSNBX",
+	"334H2Ynuf/uTNvo8y/mafDnZvqCy2rZVN1mN9RjUnmWKEmFD0iX6wgVezqb33070Px5MvzH/+Mf08KH5",
+	"1+HX06+OzD+/OvpfbycjlmG8Ke9wJWaC7YuJreGr6UP7/eGD6eGRXe/h0T+mRw9s86MHD8ct9DlN/dm+",
+	"ZfJ7fnZibQH1wiyoFki7HvO/+30A96aXfjqUzNWqMRKE51Kz8tph3Gg9vCXWJhWgapJ8uNbCH7nwoh+Z",
+	"X9L2PJOyijp2sGCrbsBJWXi9G4P1bULH5a4kVAqSmnDlhhNevfFcnrEFvykztr1jPLjUZfHh6bwj0Kpb",
+	"hb248dW2TcgcJWHuLF7qZqBczE635ZoymbChJsqaIKxQTrBUYAQA8TxDmTG97CKeNmRTL5k4THppIRQ7",
+	"mhvWQ8mxsxeVkHodmiDYkv1I2FKtIFfIsJfobn5LjOZJSoQyeWaGPJEe/fFBExkHKUNuv4Cc2Jiw4Uh0",
+	"5yuWcvXLFdm0QLiVtToC6y419GhtWcvK9f2txrpyff+EswXt8VfRav/HujBNzBzX5670RAhuM/gaZWmo",
+	"MQPbK0N660wTX6ux3zgxVjEV1z+BKcLC+nPPGrsVHz+k5qQvWtt5/rWTRwb8Cj6d2uClaMaLbdzL1FON",
+	"IbQ5zmk0Qio2lrEtUREuLqL8beXdGB1fqNdUQ2RRMAlR0bdfQ7ruuaHX3SpqOiKPBfGN1J2bZF5vzh15",
+	"1GXya+W5z7Gmr5VBNTqRihZYxeY9rZo6epiokXo+rmSXHyL2NsmjhORMRtfe2aAb+CWti/Hb1bB09JRT",
+	"HU2CNZrrjfbochTqKSpcWx9p9nMQo3q27MFvfpxR7BYd3Ey0M1wPqpFZp1suxhdq1A8N3bausBA8KLZX",
+	"K94tLLmVz2cLWFekVM06WVvh2YkomunvxmUA6iOHUIfY3OLdaN6Ps81ysS56gCHzFedXpySnaxL1BlIg",
+	"Tg1eM9aF2pDCtRkxQYJkOrtOw1je3YIbRBl51WfrKWycfJG51Bu5K+0iooNp76NL8u+Im7EOZNRsnPmF",
+	"6gGhA8oMwppRjpSph/ejq4ROrzZljNqSCRfLHpNa7QLtTD+NiXcxOrd2+jQYp/UpsB83mHbknosj+QYq",
+	"Xb8NIa4cZoKUq54c+2KNRhD5TkEmrb6xq8U2ecKyklMWTcrKJEkrXYXFEungcbJbTIl0kjKUbBf8Okpb",
+	"NUU8+mMMLWZU6vdNFo8Gc193OZCWDsdNTyO8/OzUSy2OewAVQL20NOdVZv7sq/L9ZGeOYBFrcbfRJaGo",
+	"LgyIaW5O0AgLhEdkEt3hZPisdsjT0c9NyNP1HSDPl4Ydd6mzQT/D5NLiAPV+mYhX29Jkh3TFTExaeJg7",
+	"0dqTdf1jgSnEs3YIPuoFVFNZF0g7ARs6Vs0t15MJUuZ4E72YWvvtx49uaoCkn3tsKm3bS2cXQDEErR73",
+	"RYHrcZCkv4Pp+dVjm2qSStCgj3MiDDyphgR5yhoDj9FtWdDrKX4esC7dCRbggzL3xu2hokf5B5O5+C07",
+	"6RY01W5o4Sp/HlT6tu+RHi+4ZOLtWH0xvkuBM/KSpLwoCMtwX6YK+51k6MUlsr0AxdryW9XmMv0ZUJPq",
+	"UpDENYXygBiFzbby0tRipV5CDCelIJIuGcmmlYgER5k8Z/IXHPMp0d9sAAQtzHI0A3r98kek+BVhB6PT",
+	"atu5W75YgkwNbDCkHt4F/zte5wwuVKb6vaIrKuElOdiKGz1fFxvvTQw9UEhOU8KM/d5Y+CfHJU5XBB0d",
+	"zCYW4ImLdLu+vj7A8PmAi+U921fe+/Hs5MnzyyfTo4PZwUoVxhGKKkh186IkDFLT1HW50XG2ppILdHxx",
+	"FmQ+fDSpWEYWlBHI2MZLwnBJdT25g9nBoXXLhN3SkXP31of3cE6Emto7xN5FRJEYYRbc5t2FPv7eiWXJ",
+	"tr9VkogEZYKXpdsG6OurGJqstuBjxfg10hGEx5l+MEolsOJCIs7yjd4kH2OoxfTJKQB5rMeydyuoEmXJ",
+	"mXUyP5rNrNynbAKKIArn3m9WP2ru8K2Rs+E8sPWt/Ds/aGTfnx3e2oxGmopM9ZrhSq24oL+bHb4/++ru",
+	"J33KxZxmGWFmxvt3P+Nzrp7yisESH8xmdz/hGVNEMJwjYlskE6Pt/9fEnY2foYZChLk9IyoUnAdPAxIk",
+	"JfAk17/oVOKxAzH6HDwjan8I9ofg4x6CslKx66HMsVW43+ZBSJCs0pWpqHqZa30nF+gVwQUotXgBxRrt",
+	"fELX+9TfMbN3lM1Ka9Ky1hNg1oTLxZi6gSAxtb61SBY/iG9Z5yheRo4ipOF6zLPNre1dOAUE5b5vyitK",
+	"VOT9Z8AFPgK1PsYZcnVM/j6c5/NhBO+TWoiUkkhZODtH9JbUOhUUNmwfIN3guPG9xAIXxOTA/1e3ckwO",
+	"hbvqHkFtxrNTiNOdPJr8uyLgyW0lc/PdKDQ9jrbVHvn5Lg+Uh1+v/zO7WD81sdWbay6eaHlEk7MKeH7d",
+	"vE1cptFx2OBO2LOfYDxzPryD2WN4NijI/lb8+bMi4DjDvPcbn8t7f9Ds/dDr+wSzlOQIo9/4vEvc8PF7",
+	"Pt/GM2slvxkGOKSN1LQMEhhgk2SjrLLPunCnzFIvcS90/A2eO/dn/7j7CbV/XU5T9TkwCn0eBxUMv/E5",
+	"8ibYjhJgf/b3Z3+v6rjNo9hzWYu14txWexstjRoF98s3r3RXtNBWbiw3LF0Jzngl802PuGp7jJRaiypX",
+	"tMRC3dMHdZphhW8iOr40Kxwvvx7d9RE/TlNSKpKhKfqez1G6l2M/rzOxTXY1xpotDzRr0QkbjLzOGoN+",
+	"wK32SR//+6ttf7V9dH3KoDVLliSlCwoZHnpPrbY/7Y/s/sjuj+zHUoHGbG8mn+SWC9Y0+lxP612qYs3K",
+	"P7qlbM8o9ozizhjFJRE66ObJjTTOWmC/Z0teTe2J8Ma7nmctzlNdx9mXykJhP5Njd9gA4waoz8WJGell",
+	"CMBfnClFluyP5sdlT1FIzFxRXWls11O7p5BuyaT7X1T5nrH9+RlbfUghOcnik0pDetqPgGXNUmlK0Gvm",
+	"i2rckLP6NEdTG8VqPb23sdZopqR6iC6XDeoW9nBb7zAcpHj60/LYoB65XTgsNuMFpmyafjN5H04/KpFM",
+	"jZZPxIejkPTz4fMtJLJnw3s2/Hm4NQArrClzuhCE/E4GRMyn0MAE+NYEbWLyrfTR4Uu2uINUWIFM4pK4",
+	"PgIfUsrKSkmTOZxXSv8BYer674vTpy7nFhbERK5jcEbdwA86HEG3TTED3M8JSleYLXXKiOsVVkSL3ytc",
+	"loT5uOsarqTONG8jXZysxIVEmjEL7eT9lvVYfp54BBis/NXl4vZ6P4X3VAfnex+qvbvJX9Td5CYMXFRs",
+	"i3dvk3VLw1RdqF+bORZcKggCYMpk6Ik6BNeH8qWe/nNhg0mnBDvLNyh3SNCocpDUInrMH7mWY8Ppt053",
+	"jt/pnCpBXgyYUgOgLyisDHoPZ7OeeaGEdWPOjCxwlavJo8PZLJkUZgL3l0vhcviRnX4a2/8ZOkjv9Zqf",
+	"D6ta4FRxsZmqleDVcmUtJXFZ8wJqAHVKRsTe1kjxcqqDiY0XDw662BlRPeMjGHSOWXZNM7VKEGFLyggR",
+	"RvA0mcd04LwdxAbFusxd14Rc2cRJ+hjrdEZTjcZKkcxMr1v7cuJSVkVpWK2Bvik3B9zHBEAJm/9TOhbp",
+	"uy90Rjm5cvW2BEGLHC+NsOuq8dSrNFKyrKTClCHKpCI4iwqzTg3x1GDqVb01f10lBCSmuiDiJ0KuJo+O",
+	"ZrPRWokOlj6RTqK7W3sL1l7D8Hlyfc+Nb6xrhYQUQ1rWEdrVWk7Za1flvQhabp2TBdDOw7orF1yqqQcA",
+	"Qf5YGNYVqZk8mjyYFTNZ557VP8zgDv7/oIezgxkqKJOI4HSF7qHDWX2HmwLnXOAlqTNxtMb+anW/Pfrh",
+	"bDY7mM3Qs8daMD88nLkauHDnP5jNnj02tM8Vzk/roe6vvoKhPgzvY3TJAfXvbXp7Vv95sPpaYzq1b9N+",
+	"9YNzWqz7INdnW36amE/jiR/m1M18l6b47mz7MOCQQmKUMCoRxQ3IIUGCSO1ck3mtfiP/A7ykNkgEs8wr",
+	"mqspZSjlTCrM6klCpb+tVRbqx4K8R/A8XNA8B+OCtx5IF+2A8EIRcY1FJiGDH0EVk0SZdx0IHDZ9sb3z",
+	"YTw9yhrnlS0eXle+RaUgKckgtaorKlz0JLXoOQt34BvTmehTJLjY7TDub8S/aJqLOMsJbyeXeH+qSFHm",
+	"Lov7sHK8pzAB8kPsfFnpoX2NhFcekrs8IO3Z9nkrutTjcDQibcVWokhAw4aZonAROHuKLfdOlUTB4qQt",
+	"5GGVi3IjFSmkLztFhdFB0jpxe48FurPNd8X12/N8CtNvd7F72+/f0zAantxhbj867HHrATdCnP9dts67",
+	"KUDsvVT6kmHGDuxITVQXpD9dWNaoE/xJDYV/M7Nd30HiTF9MhKWbaclzmm62v+nrLsh0udGTvh7lwsx7",
+	"l9TYmWwvHzWIo0sF497zO5PCATpTqMSZ7Dy+3QO595ntkkIasUkbgEWVE5lAT0mUtAZkcI1A82qxMJ4Y",
+	"2pTKF4NP6igt3oFs1Z7nkzyodzkL+3QOn+78BVw6I/NqeW9escxYWOIvGK1QLuY5qbPNI9MFMtArpQ0o",
+	"YS56lGJJEpPKdfk7LUutY8NijvMcjumK5/acplD50FVTcwdRP3UkSQVR0riQ2azn/tWsFXEZHM+I/g0z",
+	"8NwlzKnItHODWhHng5bzZVOFljiNmZ4fJg9bOvahhGZO0O83Pj9A4AjWVRuCH7FLSY9oRIozz4tTjfnH",
+	"BvF3wxSCGW7NKKd3swmBlwXnlGGxiUiDe53a3nXsjtkcsLEWZ7NMZRrWH+/X3D2lCjVaeqMAlAxEbjjg",
+	"HGAxLldYAk/hIutqa4YtD4ojCVZtO0o9un4ERnV/zlx82ljOttS5uKA5iE6dtS2oOkCPN85cYjjkwrQn",
+	"78rclguqUSDRnEh14B6MLTdT03My1oAdrsIAecfPxhj6tukz9zLKRzm8+uptnt14MNF4d/SF4L8TprNE",
+	"B0fuBp7oT+3kW05Z0yXcQuwOfCdiqTdf9fUW3/CP435t1rxX9XfItCawbcTqFYa92g7sSLTunNTSp3dz",
+	"AtlTx59t1EozY31HkdKUjOIspvy4eThZ6Bfnuv65NINjwrr2EQR/ZTFwxyN6L6OLRe85teREQu96W1If",
+	"xjCVF4Njq21sgmakfjI6/33KdEVNrktfNmTCUnCdIzRpP1/hNWrUT4rkOVrx68Z4wVnVSyBC1jEDlrFw",
+	"RmI6qVO6WOx5RL12jY89n9jziWE+YcLHeznFqdP26DMShJsLfVMLkhllVOsA6SKu5qyOucdfGgj+1Ce1",
+	"zBa3pjran8u/6bkUFRsjXzc83bUx/bbF65cVu9FpFBX7DI7iB8Tm7k/l/lT2n0qYEwtqgYoe0BNoQpC6",
+	"5p0kAkZPQ7DIKSROIeDFzGzCFiTIggjCUgIqVJCNr1eb4Lj73C2PYmYha+G11iQzl5fazZ/WwzojugR5",
+	"EIrrRXg3Ts1GovGwZo03TWrwERhGMnZ2jenUbpnmoT36K/vpT8DBTmoS3fOyPS9r8bKBvFUvq44UbyIG",
+	"fWgFWtI1YY6J+IB6SXKSKpKF/Cjx1u5GCKpxETQ+JnV0yThvGH1Ccw0OBVdgvqg9Wg7QMTOlSOBIC6Iq",
+	"waQxZesDXvI8d/H9ibdlQaoRxTnKuTYFcXSNKeR5SRA5WB6YZedYLGv+SAk4JsPSz/UuoxMsch6uPMYv",
+	"X1bNyNqbB7TW8wCHpRnwGAjl/MWHAk80+zO+B9Dfxn3+Yov+33+fmI6aDupevyjBq3lO5IpzBUzrZ8vR",
+	"gWggqvOXjMqrX5ZzyKcySyZKYCYXRPwisCK/FPNSui/rwk33YDZ7Pzr08w4jbe8k9vTJmIjT26wtU0+4",
+	"vcpMANxv+4IznytHbgmVw8w5xmtrTlwH0GnpU6aEYUG5tPwMo4dHM3Q+L420iHVM+DP9V04ZlKN+AL8f",
+	"PqgjxY2W1slHahXa8msItCmy/qsby+cAaUQb2gZyhbUOab5Bc65Wo4RN+SEcFHbZJa2yCme9/kmD10XY",
+	"2sMjYGPzoH+Iv639NVuEEcby8O3c94N5bC0rfiJuGwNl77Pwp+BakufrAafKp9Q+JGWB85xIZZ+uXurD",
+	"2W+VVDo7tWUFRmSkLr5rroVqmaACXzlPnYZkmjkHiIzgDORC0ENLhQU4QCvnn2ByQcEf6YpkVU4O0DGS",
+	"lC3d1BBGZh7WZhDOILSMMJ036J+IqxUR11SS0CcaFXxNkOJLor8eoJ90R7I2/xEbOzI27p7at8isCBVU",
+	"SiIbkDv/TXPIUCn4utUCUbYgWFKNLc/tNQ6gYIogWK8s6qWtd6k+Zad2vA/ioH7jgH0V+J1lbpBfDxil",
+	"5VyTRzFOqIVTl3XPPqPNGEd2gPt195aEGmQw+TnZRRgeK+46fE8eaaHt4fTwaHr4zavDrx/NZo9ms/9u",
+	"MPle2PQCIvy6T15+eNRg5bqpZeWaVuGgayL2IB1OZ0evZv9wIN2E7wNVfHKWf8nzyuzQnuH/CRj+VrOE",
+	"8werrJVf8KUgMpLT7zc+T0JvdFnlCnGWEpsMXpFs2DyxU5ng5sR/worBW598e6Xe31Kpt3aljpakL97M",
+	"SkiQ+gyZDu5YmHixwG/bZXYJlW4J4nlGpPUnPUDaF0AqQXDhQ/IFMeEq7vST5gTzjYtDcVJciZfExKfB",
+	"nzmWCkndRDMAmwgYEnOWgqdESpKhiimaawOmFsqKUm1iog44t67HVF8Cj1ajJDQcwiDGwURlG54eowB0",
+	"mAwyCp9xeBZhGiPSIVvQDLA60udwNrMyakEVCLosC/Mkj0+UHKZG/qS5kfUSL/CS7OWAv2GCGiDwFmN7",
+	"V3KhxoZVm9YfEFH9BAa4+2Dqxjx75/MGETR2fFQI9U7bnrSjGDmLFKu/jNDCHZQICab4FHHMY8lwz3H/",
+	"qhy3ddgCzrussMgEpvlY5us7fAD/febGuHsW3J5qz4VDwujs/ihGvCsJtM1DnXwWwibyIZmJQZBKS/5B",
+	"jklrzoKOVumGQHkmfQejO01zsC0peK7Q30lPGosYAd4+32/N8ilY/w7kv9e7faojF7BjyhZ8kAW/KAm7",
+	"XNGFqtN4o+NsTSXXr3nzGKVxX98zPfYd0hqM30tgnxrvgNkWrq0H5HRBSZ7JEe8ORZiEEATo4HhZ6Cok",
+	"yJJKRayBe9eL8cyB9FRPcGmQcadbFplvf0U26aZFJSPfKttJZXv+p6LkQrkKPHhJmEJlXi0pk6jkpakG",
+	"oQ2TxmcjKABk0z0taO672xCeOl+rdz2GITS1Mlz0XZi9hHn7t2Zsqk9xde56Nvb356c6jwFPBw30cG6F",
+	"unyKaRxTKl/YL3dGXHqCfUqCvswZWxMPN/ewJyHVhfl0FzxKD/0psv3CkvYJfj/TLC/6lx2S624hYtPO",
+	"EvFIw7cd6M8VjddH1Hu15N6ufkfXy7BDS0lSuqAk23ZCnxG1P57747k/nh/hRtU1tQjLsJD3/ig5z+GK",
+	"jT7DzavZhpkVJWYbnZ6VZniD3BjuPIKaeE5WFGIjXI1ZpMe3JXMZOju51O9om+rejiQRhVm0locsuCCg",
+	"w7axDtk/rXPvknK90FIQScCRxTUw7hwmtE6/zaHauHc5jj3BzaL0UTyxa/gMuE7S1YCEGAyQHJ9etxoE",
+	"YMSETRzzBSqreU5Tv1E9vjFmc0Znd/zOjGam21ahUpF3ypPrDRKEfDwVx56171n758DaV1gsiS4Z3p9i",
+	"AZqElkOSIbJY8DrUQw/ocxm4DK8+m63kaIEF1CX3iXBrDOiIYghPFijlUqGUMBWEK+sUuFRJKEAkITFm",
+	"gkpBgZNrn2aMBGRqwCKLanVrdn8AYwX5+ZHCS2sA1Sv0pfsqhqWkS0YyiIA+QMcSfX/54nmiYcQSnVy+",
+	"0f/6zx8v/xOim6nD/ZzmOWXLg7e98upJje7P8A55hZch2vX/7T5T6ZEE2zjfJPF9RHOfBbiH/S8Fr8rH",
+	"zey+hGlXyH9NYIhJMtGEMDWEMPm5DXgyeTfVHaZrLPSYQOQ1Yp+Z8V/YoTofTrhUJ3bowcwVNV1pevNR",
+	"UYCQBOVkoVDFHClqKmNcGUrru/h0gSwsslZq1p236UWldDl90y8B0tyOdjtLDOvAgpJJKtcat7l8tzPO",
+	"n8Lg35tx2j+fyHXk1/+Eee66vI+b06Y800wwHG7NsgNeEvauyA1+5JQvFnpHeVpBBgVZCoIzuSJEFfkB",
+	"/H9XsSKxUolc75Pd74WDP5dw4IqL3bhIpY1J36LNcXafk3rCz/B6bAcyNBcJkQxaSunLaWQ+fZqU3B6x",
+	"+zT1e87yWdgTz+pqhT3VBCUqsEpXTvB6c15XH0WUIQyHLcZejKB/RUjZPqY417f5Jloatfgn+KlDF0au",
+	"G90Esec+HvYdrOWz42I/33EB1nrtPrb4E1Rg7WNrf8/qq3ve9nlITff+8P8+y97fg0xq9/6gLCPv+nXo",
+	"51hcIcwg75rhbn2VYDPOCOIC3p3639FcPs6QXR9YRYrPUbqKFJaNTxzg9HYhuOCShp6AsAO0Jeslxjox",
+	"60GK3ttBqAZDVO+cWStSfJJqjn5H96Lnnj1/YvasBUi8JFtdzq8Juco3yLX3asHQ0CahChPJNJuQOjTA",
+	"5lWCliURlNf+x7qlFmb1uIhx094MLwc0xg7cP7+nA9x/W+1inOd+ze89EFgIvA+h2XOPT809TDhnf52f",
+	"d94BwqWUir1Q4c1ZCv4bSRUqMMNLU9VNaZaSIELVioCp6fwSXdhm/3n+o7U/YXRZYKFAGa2NUc4udf7q",
+	"DdIsw7MoiTBjXMEr13Mln9zcp/TV72gYw5vyqJIkX+gxU8w4oynOwcxwAONLZ3kDS0CJU4IKXJaat8ky",
+	"p2b5DhjZnAdSlCitlld1dxNDQTzuqKi/SQ3FKyIE1uzJYYChY2bys+m0K3POrw6Qwb1EC57ntsbR9rB1",
+	"PbF53ltjJQ2SlOiavTbVnEMNIwKtYA+0hVAvOSVC0YUmT5LYCSVKeUEcljKiIGEc9MCqEiTxIZfQ5Fc3",
+	"8JoIutj8GlMx2Djyz8Plbdgitc0A1T+vM0gV0p6NSTKRntQnyaRQ2pRk7VXKEcUkmWBDDSMNVwaZxg51",
+	"HswV/n4ZztvooNatX6zdK/zpVQBb+Puxg3PX25SniqipSQbUZH8thUfjvIaUACW0M7qEGtaazCnQKcxm",
+	"f58ko8xZIVzvinx3e1g4wAYX+cezqNme76arNB9CY81wmkXFLT7bDEyOwlxMdkkmK4IzOMl/TP5zemE4",
+	"wfTSsYqYD3ubnTioDPOBfZ5jSR7eR4SlXDM1TQumiIXZ6HYP/W9FC/3khVpSxvvB/F5P4yvR1RxPewVQ",
+	"jxboJmw1c0lUXatqK+s0PK/fOPJ+L+TthbyPJeQtMVNqINUby2w2tWe6oT4DQsXEvFCSy7DCtfRy+eYZ",
+	"ooV51kWffTDyn/6qD9mrcU555G7vlvOJXC9HXt6AmcbFG/xyuV7GvXqOnx8bDvc7KE0N1taUXLsUGXNs",
+	"g3XTSoGJ6ZqyjF8b44/SfMxX4LN3Z871NQuDYomuSZ4niJF3yrmRBd89fwzFbiOjG8YXw6Lu+d9GrVvj",
+	"0WcGnjypBC/JvQssqPzIYQmGOPXhARq+J9fL/3UDQWD/lt+z+U/L5hWR9/7Q/3t/D5c6ETjOB0r2aKEM",
+	"8YXm88tW5k1XU0xvMGFKL5BkNhla+2mJq4zC07LD+48BBmL4vyKfI/t/jmt2tjQwRma1X8ZHF9yR4UNj",
+	"8dhu7Kewe+yd/PeM7jNgdFcllb325ktr7vjh4sy+aw0rC0RZwZe2boMSOEwVdoBeBT08o/O5yJ2/zdzU",
+	"TJBIYKjrgNRKELnieYZwToTqyXyij88PF2d/YTcav8JPwJgu7C7tGdSeQX1iBhUo0vpe3ReCl1ySUP1W",
+	"52Or+7fjV4zNIZTUAicO8w5b8DyD4lu+wCERLhrJBqHoSCFnIaEijHqx+RUBOJzbTE9magAK/VDNiWDE",
+	"ZH3SemaIdBJEErEmvpAscgab6xWXrmvK85xmNvrVWVjCpVCJKpOwPQdui6QSWJHlRn+BSJREm1vMApvK",
+	"ONDEMc7IQLDS81C9+dlJopfGCi+VqFJQT3qcW7ORcPtiS+8adWYMj6OidxxuRweuevRdup7jQq50VJrf",
+	"SIg+6YFI4eUtR1F5kF/hpQugCn/bEjv13CfSzwnONJbt2dL7ov8MTYauLqilTrfiBB2OCWbSfU5JqVYN",
+	"DOyW//9CkAV95ynB0QqAaLOevp3gtCDTt5MeQEoY4pO59fu9OSVap76/yfc3+Se+yZ3ov9W7qhMlXBLj",
+	"IeCvRC0Xo4JgWYkgObG5ge1Dpe/m8qLtXz89zF6K35/9TxHUE8/Cqs9y43iDVczXGbOuRiYXC/hCrrCx",
+	"OHs2AAH1yqZ2OTBMgJHr3CsRsj4lgv5bV5CE+Rm3lmXKGQToW2VGtF44zP058o3bVzj8hNekyTL2Soc9",
+	"u/pbiiphzc+hbFa4duUkGbXOU7VjpnmeZxCw6JjCCksi0RXj18xZg0vjmFmnqdZLrPRonBH5T5doQyqd",
+	"4ap2IQ8Tq2RUpoKUmKWUNIsvOJ9OF6po8mMNJ7O6dMv/E/G6mxmZPx6Hczg1WN7zuD2P+9Q8boUFGZG9",
+	"AdpBZX1Zx7mUvM+tiZFrX6ayN5nDpZn7r/8Eg4XuEyvsD/xnlaidae9eqo8AKJGnkNxAn3AnkXh/tqGT",
+	"rp9jxk2uTp2GvTsvTqESlBGBFL8iYJPgdZ4UEG9ScjCQJh5Oz1/bwgtL/FQ56w1+97kR9uzps5FH7v0B",
+	"/z8bTtb/kqz5FdHPLy+cbJdNIsodPcrnxGgGMh/UK43PbNH2+UtDe0loz2o+MatZF1OrhO5V8Fh99Ypf",
+	"o5yzJdL6ZaO8cQeyZi58AQb6hs8vDK9TRkH86bGZzTu9NVTakMVFK3LM8GHK8oMBhfSbczvqX1dAenN+",
+	"oVFi1lm/oj6e0qYHgD3z2jOvT8i85L0/1sX7e0YtvL08Zjcdt8L6OTbfoCDgKZpMGxjSfGP+8Qi+GznE",
+	"dlICM7mwqZ2pvILiviZwnkGcfLM51FJwKnC+8POZR2I3n3QkIzjUhvAgm+wAGutQX9jrxguSUcw0X20s",
+	"myNZcuWXm/GCMqz0M5iqAWe3N+dPDKo/awFRYwMMpOBS1RN9sS52j724M95qsfp51drbs7dPy96A/9z7",
+	"g72/l9N1fyYmnb8Op2AUU5X1JdBdUVYJc6ClC9c0mqprLKaC88L1mHMsMmki3/VPwKRAyntzblyA9RA6",
+	"VYntAJzG5+8LAuNJjktJsqjVrQ6Mr4QgTKF5ztMrIuQAu9F2+B/p+vOM8fJunM6D2oWuWZ2gRtxhHBY2",
+	"LvndYV/yuzviQw7dl7DNe26050ZRbgThTfpU9L8YfX6n+mlYsycndHhOBck4FhoL7gg1G2vWA2KLHc1I",
+	"KTAG48pb8n0yYypQjqXjiEMvR03xr9xy9kzmjjNsNrD9kd+v43nb/vG656cfg5+usJrSxVAgfWEK6EuF",
+	"FwvIBLTCbGnDoeYVzbOpNjQWNCdScUaQzGkpUUGzqQ1GfYRyvEGukJNRx2nRzCZdyzLI5ItzlOISp1Rt",
+	"/BT2SdpK46kn1pOUJKunleHLEyYiLANXr7aHln5WIyrNG5caqdWJmnpYhHO9DCo9SzdNXRiXZvYGwKjX",
+	"lkMY6Nctzv7aJtOfVlidLT5VzL6Zfc9K96z0k7BSw+IsN11wQVIs+3WAT20DL31qpgWKumePu5lHC65V",
+	"f/+usFBGpWf/afMWXzyYQf+Lb2ZojlkmkbS8J/Mxsy1toyAFppDPzagV3WvYZwFoFQI8QE80W3QgUIkw",
+	"WuRYIcGvQV42mQt9qT39sH98ZnKjHkTf0wZfDg+ffdas2y3ZNi6y0yGnkTir+aOu0xaL6nxKhVSo4Eyt",
+	"HOIWVKY4RxuCTQznmudVAdfbvEqvINJjDpGcX3yPWYXF5ssRq4Ux/4tgcamwUOd6vsbaC/zOyvVHyafT",
+	"JLSJbV/fbX+7/KluF4EVmaZaL7rdLdiXwJSx5MmJtv6IjU5bLE2YVZpXGcmiHsEvbfHLrZbsF8ZP0UJg",
+	"x/bza2aR1XD18BL436eyebiVbjMlf4ID96mp0dPeCP/VepN9bnLIruiorVOl1T/JJC6IM4/FvE7dBk3u",
+	"5vnihv8UDp9+aXt/z8+O4KM8GGT8IS/IU/i9UebanoAOdZumAXWPFINjI/+5ojCGyH4vU+1lqru8xQb9",
+	"cmRJUrqgJIsess5Ldn9292d3f3Y/xYVs8rbd0/9d8JzyfuNFkP6dvCNppei6GZDgx9B/NrVvMl6DRm5Y",
+	"uhKc8Urmm0e18gwXSHGFc+uIUpuOjaMy2En1B0HlFeQO27jcGCzz1uK5TWVnspyFcoRLEneALnieh4EV",
+	"XpSuGc1vfD5YA9cGdLm124L7d2QgaM7ij+0YWfvo1qD4ns9jxHecpqTUGrAp+p7PUbqPstrzsY+i12mz",
+	"MP+06JVQQl5luhurpD7rVPrjDuWyCc7Q9YrmJOQT1LmpmEhSmzSvJAyS/3GBFpjmJItr7TusYqTIYziR",
+	"ntGVBhduhA+QfChTD+9PPrYyuYWDXhHo4/ItA01ja/e85O/ES2w22iG9ROb0EjpLLUldjJTrGddNXPqv",
+	"d5eC5XP08PzUO2x2pf+5Cgr/vq3THz/GxsEUe6X50OZt0ZjblnHR/NJ9vAuJ3AxuJvrYOm+7sL3G+/Oi",
+	"1u51Ml7X3UPI4SUyXl70g/259GL9ZL3Xiu0lwA+acAfJoKvI7jmbz4jaH8z9wdwfzDuT/WLxSK9L8Ebv",
+	"OZPm6+d2LO9K+jSr/egpP3u5gYHHM8w9Z9hzhhtzhksidE3aJzuL2/dMVMkU7F6/8fnWTBKmvbESSV1r",
+	"VitZtcq1wR28k7eArMO+SoPx8I4JBycwrjb2av3jX11GaK429jTtQfP+yP59jmzPnQ7u7zVRQOZvTPNN",
+	"42g201HZUtJacZ+b4ldsg0pB1pRXEk6vPq9U+ZNa6MV0zTIw9Wd6Um9fbGgs9FOEmm3lErAfJOtlynuh",
+	"Ys+hPoVQAWXfNQArgrMuB/tOG4s1T3jx5hiZtm1Oo5uc2S/DDCb7dKLAwOt+zPEYRc7byW8ruey6vWZH",
+	"tuzutBL5VmHR7y9aU4xev/yxXy10yq9ZznFmGg1uuemAaPanE/tKAXX4SAbYi/G0lz8ixVFmkREckL8X",
+	"J7//idSdW0mfrQlTXGx6M8BYjUvdMK50OQu+/2UFqPZSP1PVS7BZe3lpLy99HHlJCV7NcyJXnOu0TtOC",
+	"ZyQfYfw0GTcbfRH0jboO298qScQBOve+xjYzHQROLnCeozlOoe4DRgv6jmQmp11JBHpzftBjZn3VBOIc",
+	"4L/D0xyd73NL1PY3Mz9gKYmUhZ57q4XQEGkpSEZT5RQXpS5BXfvAtwkbyLCZN22IxGPS5Z5M92TaItO4",
+	"Vu3jkWmClMDU1L5BJZaqjgKRfVy6kgRSSFlPa74Yx6ovBw7A7ct7sak+hd5s1zO4d/36+McwEIWuyXzF",
+	"+dWIfBOuJfyR8QJTk2FcmcKWZTXPqdQlgBVP6iAlqEFlDyAVKCM6qTDUDGeZjUBwP1IiD9Cxmwc+wgHn",
+	"HBVaZ14309kosU5JpIMcMirxXA9TMUVzJEgmTODUcVZQRqUSWHFhKl/FgqP0An9yWLjLVJBmjicsKzll",
+	"6jN0pv34j5JPfSosrcWPhNE61FS3/YgEFMoXkXMCQr4dPrE3nlRIkJQwW7ExODr6AFRQiwTL+hKzZ4Yz",
+	"IuMkPkTgp/ViRqs+PLx2EVygNOdVZv68kUpke0quRp6ZNlqptOGWPRlm/Mdubi7PfybJxGByZI6uDgJP",
+	"g5E6H5/aoSNLOzeZshDzOXaD5bmorgQdzmYmKJQXVCnLL7EyBHM4m8161p7Tgqqe1Fyz2ewTJudqImmz",
+	"L+ayVwR9NkzeCg39geVPmJYxau5tE9rqQwmlojZgwO/IM0FaRs0tUc6XCeJ55gv0do61/gPDuyKB6pxW",
+	"iGKyKkw+Rnh4mFBQCzWSipfScIuAYTdkIwB3tEj00gxsT+xndVV8BA5lV78vRPD3ZhQrgnO16hX6zGdT",
+	"kCRmQc+ByMdZrgMY7Kw/A+QSlNrmzIHJd3Jv8v7n9///AQA8Z7r6KlEDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -26,6 +26,12 @@ const (
 	AssessmentSourceTypeSource    AssessmentSourceType = "source"
 )
 
+// Defines values for CashFlowGranularity.
+const (
+	CashFlowGranularityMonth   CashFlowGranularity = "month"
+	CashFlowGranularityQuarter CashFlowGranularity = "quarter"
+)
+
 // Defines values for ClusterRequirementsRequestControlPlaneNodeCount.
 const (
 	N1 ClusterRequirementsRequestControlPlaneNodeCount = 1
@@ -47,16 +53,123 @@ const (
 	MemoryOneToTwo  ClusterRequirementsRequestMemoryOverCommitRatio = "1:2"
 )
 
+// Defines values for ComplianceLabel.
+const (
+	ComplianceLabelEuResidency ComplianceLabel = "eu-residency"
+	ComplianceLabelHipaa       ComplianceLabel = "hipaa"
+	ComplianceLabelPci         ComplianceLabel = "pci"
+)
+
+// Defines values for Day2GapKind.
+const (
+	Day2GapKindDrRunbook  Day2GapKind = "dr-runbook"
+	Day2GapKindMonitoring Day2GapKind = "monitoring"
+	Day2GapKindVmBackup   Day2GapKind = "vm-backup"
+)
+
+// Defines values for DisplayGranularity.
+const (
+	DisplayGranularityDay     DisplayGranularity = "day"
+	DisplayGranularityHalfDay DisplayGranularity = "half-day"
+	DisplayGranularityHour    DisplayGranularity = "hour"
+)
+
+// Defines values for DistributionFamily.
+const (
+	DistributionFamilyGamma     DistributionFamily = "gamma"
+	DistributionFamilyLognormal DistributionFamily = "lognormal"
+)
+
+// Defines values for EstimationAnnotationKind.
+const (
+	EstimationAnnotationCapped  EstimationAnnotationKind = "capped"
+	EstimationAnnotationIgnored EstimationAnnotationKind = "ignored"
+	EstimationAnnotationUnused  EstimationAnnotationKind = "unused"
+)
+
+// Defines values for EstimationPriority.
+const (
+	EstimationPriorityBatch       EstimationPriority = "batch"
+	EstimationPriorityInteractive EstimationPriority = "interactive"
+)
+
+// Defines values for EventType.
+const (
+	EventActualRecorded EventType = "ActualRecorded"
+	EventParamsChanged  EventType = "ParamsChanged"
+	EventPlanCreated    EventType = "PlanCreated"
+	EventPlanDeleted    EventType = "PlanDeleted"
+	EventReportExported EventType = "ReportExported"
+	EventWaveCompleted  EventType = "WaveCompleted"
+)
+
+// Defines values for ExchangeRateSource.
+const (
+	ExchangeRateSourceApi    ExchangeRateSource = "api"
+	ExchangeRateSourceManual ExchangeRateSource = "manual"
+)
+
+// Defines values for FactoryRequirementResource.
+const (
+	FactoryResourceBandwidth FactoryRequirementResource = "bandwidth"
+	FactoryResourceEngineers FactoryRequirementResource = "engineers"
+	FactoryResourceWaveSize  FactoryRequirementResource = "wave-size"
+)
+
+// Defines values for HolidayRegion.
+const (
+	HolidayRegionDE HolidayRegion = "DE"
+	HolidayRegionIN HolidayRegion = "IN"
+	HolidayRegionJP HolidayRegion = "JP"
+	HolidayRegionUS HolidayRegion = "US"
+)
+
+// Defines values for InventoryFieldSource.
+const (
+	InventoryFieldSourceImport InventoryFieldSource = "import"
+	InventoryFieldSourcePlugin InventoryFieldSource = "plugin"
+)
+
+// Defines values for InventoryFieldType.
+const (
+	InventoryFieldTypeBoolean    InventoryFieldType = "boolean"
+	InventoryFieldTypeNumber     InventoryFieldType = "number"
+	InventoryFieldTypeString     InventoryFieldType = "string"
+	InventoryFieldTypeStringList InventoryFieldType = "stringList"
+)
+
 // Defines values for JobStatus.
 const (
 	Cancelled  JobStatus = "cancelled"
 	Completed  JobStatus = "completed"
+	Estimating JobStatus = "estimating"
 	Failed     JobStatus = "failed"
 	Parsing    JobStatus = "parsing"
 	Pending    JobStatus = "pending"
+	Rendering  JobStatus = "rendering"
 	Validating JobStatus = "validating"
 )
 
+// Defines values for KPIStatusKpi.
+const (
+	KPIP1Incidents  KPIStatusKpi = "p1-incidents"
+	KPIRollbackRate KPIStatusKpi = "rollback-rate"
+	KPIVmsPerWeek   KPIStatusKpi = "vms-per-week"
+)
+
+// Defines values for NamespaceIssueKind.
+const (
+	NamespaceIssueCollision   NamespaceIssueKind = "collision"
+	NamespaceIssueInvalidName NamespaceIssueKind = "invalid-name"
+)
+
+// Defines values for NamespaceStrategy.
+const (
+	NamespaceStrategyApplication NamespaceStrategy = "application"
+	NamespaceStrategyFolder      NamespaceStrategy = "folder"
+	NamespaceStrategyTag         NamespaceStrategy = "tag"
+)
+
 // Defines values for NetworkType.
 const (
 	Distributed NetworkType = "distributed"
@@ -65,6 +178,116 @@ const (
 	Unsupported NetworkType = "unsupported"
 )
 
+// Defines values for ParamLayer.
+const (
+	ParamLayerBuiltIn      ParamLayer = "built-in"
+	ParamLayerInventory    ParamLayer = "inventory"
+	ParamLayerOrganization ParamLayer = "organization"
+	ParamLayerPlan         ParamLayer = "plan"
+	ParamLayerRequest      ParamLayer = "request"
+	ParamLayerWave         ParamLayer = "wave"
+)
+
+// Defines values for PlanMode.
+const (
+	PlanModeParallel  PlanMode = "parallel"
+	PlanModePipelined PlanMode = "pipelined"
+	PlanModeSerial    PlanMode = "serial"
+)
+
+// Defines values for PortfolioPlanStatus.
+const (
+	PortfolioPlanCompleted  PortfolioPlanStatus = "completed"
+	PortfolioPlanInProgress PortfolioPlanStatus = "in-progress"
+	PortfolioPlanNotStarted PortfolioPlanStatus = "not-started"
+)
+
+// Defines values for PortfolioRiskKind.
+const (
+	PortfolioRiskKPIBreach PortfolioRiskKind = "kpi-breach"
+	PortfolioRiskOverdue   PortfolioRiskKind = "overdue"
+)
+
+// Defines values for ScheduleDiscrepancyKind.
+const (
+	DiscrepancyDependencyViolated ScheduleDiscrepancyKind = "dependency-violated"
+	DiscrepancyEffortShortened    ScheduleDiscrepancyKind = "effort-shortened"
+	DiscrepancyLeadTimeShortened  ScheduleDiscrepancyKind = "lead-time-shortened"
+	DiscrepancyMissingPhase       ScheduleDiscrepancyKind = "missing-phase"
+	DiscrepancyUnknownPhase       ScheduleDiscrepancyKind = "unknown-phase"
+)
+
+// Defines values for VMLiveState.
+const (
+	VMLiveStateCutover    VMLiveState = "cutover"
+	VMLiveStateDone       VMLiveState = "done"
+	VMLiveStateFailed     VMLiveState = "failed"
+	VMLiveStateSyncing    VMLiveState = "syncing"
+	VMLiveStateValidating VMLiveState = "validating"
+)
+
+// Defines values for VMPhaseEffortCategory.
+const (
+	EffortConversion      VMPhaseEffortCategory = "conversion"
+	EffortOther           VMPhaseEffortCategory = "other"
+	EffortTransfer        VMPhaseEffortCategory = "transfer"
+	EffortTroubleshooting VMPhaseEffortCategory = "troubleshooting"
+	EffortValidation      VMPhaseEffortCategory = "validation"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// Defines values for GetPlanChargebackParamsGroupBy.
+const (
+	ChargebackGroupByCostCenter GetPlanChargebackParamsGroupBy = "cost-center"
+	ChargebackGroupByOwner      GetPlanChargebackParamsGroupBy = "owner"
+)
+
+// Defines values for GetPlanChargebackParamsFormat.
+const (
+	ChargebackFormatCsv  GetPlanChargebackParamsFormat = "csv"
+	ChargebackFormatJson GetPlanChargebackParamsFormat = "json"
+	ChargebackFormatXlsx GetPlanChargebackParamsFormat = "xlsx"
+)
+
+// Defines values for ExportPlanParamsFormat.
+const (
+	ExportFormatAnsible    ExportPlanParamsFormat = "ansible"
+	ExportFormatJson       ExportPlanParamsFormat = "json"
+	ExportFormatMsproject  ExportPlanParamsFormat = "msproject"
+	ExportFormatMtv        ExportPlanParamsFormat = "mtv"
+	ExportFormatSmartsheet ExportPlanParamsFormat = "smartsheet"
+	ExportFormatTerraform  ExportPlanParamsFormat = "terraform"
+)
+
+// Defines values for GetPlanGanttParamsFormat.
+const (
+	GanttFormatJson GetPlanGanttParamsFormat = "json"
+	GanttFormatSvg  GetPlanGanttParamsFormat = "svg"
+)
+
+// Defines values for GetPlanNamespacesParamsTag.
+const (
+	NamespaceTagCostCenter GetPlanNamespacesParamsTag = "cost-center"
+	NamespaceTagOwner      GetPlanNamespacesParamsTag = "owner"
+)
+
+// Defines values for GetProgramForecastParamsFormat.
+const (
+	ForecastFormatCsv  GetProgramForecastParamsFormat = "csv"
+	ForecastFormatJson GetProgramForecastParamsFormat = "json"
+)
+
+// Defines values for ListWebhookDeliveriesParamsStatus.
+const (
+	WebhookDeliveriesDelivered ListWebhookDeliveriesParamsStatus = "delivered"
+	WebhookDeliveriesFailed    ListWebhookDeliveriesParamsStatus = "failed"
+)
+
 // Agent defines model for Agent.
 type Agent struct {
 	CreatedAt     time.Time          `json:"createdAt"`
@@ -86,6 +309,19 @@ type AgentProxy struct {
 	NoProxy  *string `json:"noProxy" validate:"omitnil,max=1000"`
 }
 
+// AlertWebhook defines model for AlertWebhook.
+type AlertWebhook struct {
+	UpdatedAt time.Time `json:"updatedAt"`
+	UpdatedBy *string   `json:"updatedBy,omitempty"`
+	Url       string    `json:"url"`
+}
+
+// AlertWebhookForm defines model for AlertWebhookForm.
+type AlertWebhookForm struct {
+	// Url Absolute http or https URL the alerts are posted to as JSON
+	Url string `json:"url"`
+}
+
 // Assessment defines model for Assessment.
 type Assessment struct {
 	CreatedAt time.Time          `json:"createdAt"`
@@ -135,6 +371,232 @@ type AssessmentUpdate struct {
 	Name *string `json:"name,omitempty" validate:"required,assessment_name"`
 }
 
+// BudgetPeriod Cost spent within a month or a fiscal quarter
+type BudgetPeriod struct {
+	// Cumulative Cost spent up to the end of the period
+	Cumulative float64   `json:"cumulative"`
+	End        time.Time `json:"end"`
+	Period     string    `json:"period"`
+
+	// Plans Cost of each plan within the period, keyed by plan ID
+	Plans map[string]float64 `json:"plans"`
+	Start time.Time          `json:"start"`
+	Total float64            `json:"total"`
+}
+
+// CalculatorDefaults defines model for CalculatorDefaults.
+type CalculatorDefaults struct {
+	// Engineers Number of engineers working on each task, the built-in counts of the calculators when 0
+	Engineers int `json:"engineers"`
+
+	// TransferRateMbps Bandwidth available to the migration, the built-in one of the calculators when 0
+	TransferRateMbps float64    `json:"transferRateMbps"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+	UpdatedBy        *string    `json:"updatedBy,omitempty"`
+
+	// WorkHoursPerDay Working day of the engineers, the built-in one of the calculators when 0
+	WorkHoursPerDay float64 `json:"workHoursPerDay"`
+}
+
+// CalculatorDefaultsForm defines model for CalculatorDefaultsForm.
+type CalculatorDefaultsForm struct {
+	// Engineers Number of engineers working on each task and capacity of the undeclared resource pools of the plans
+	Engineers *int `json:"engineers,omitempty"`
+
+	// TransferRateMbps Bandwidth available to the migration, the built-in one of the calculators when omitted
+	TransferRateMbps *float64 `json:"transferRateMbps,omitempty"`
+
+	// WorkHoursPerDay Working day of the engineers, the built-in one of the calculators when omitted
+	WorkHoursPerDay *float64 `json:"workHoursPerDay,omitempty"`
+}
+
+// CalculatorSpec defines model for CalculatorSpec.
+type CalculatorSpec struct {
+	// Config Settings of the calculator overriding its defaults, e.g. engineer_count
+	Config   *map[string]interface{} `json:"config,omitempty"`
+	Disabled *bool                   `json:"disabled,omitempty"`
+
+	// Id ID of the calculator, e.g. storage_migration
+	Id string `json:"id"`
+}
+
+// CapacityChange Change of the capacity of a resource pool from a date on
+type CapacityChange struct {
+	At time.Time `json:"at"`
+
+	// Delta Units added to the pool, negative when they leave
+	Delta int     `json:"delta"`
+	Note  *string `json:"note,omitempty"`
+	Pool  string  `json:"pool"`
+}
+
+// CashFlowGranularity Length of the budget periods of a cash flow, quarter when omitted:
+//   - `month` - Calendar months
+//   - `quarter` - Quarters of the fiscal year
+type CashFlowGranularity string
+
+// ChangeFreeze defines model for ChangeFreeze.
+type ChangeFreeze struct {
+	// End Last day of the freeze
+	End  openapi_types.Date `json:"end"`
+	Name string             `json:"name"`
+
+	// Start First day of the freeze
+	Start openapi_types.Date `json:"start"`
+}
+
+// ChangeRateJob defines model for ChangeRateJob.
+type ChangeRateJob struct {
+	Done            bool                    `json:"done"`
+	EndsAt          time.Time               `json:"endsAt"`
+	IntervalMinutes int                     `json:"intervalMinutes"`
+	Measurements    []ChangeRateMeasurement `json:"measurements"`
+	SourceId        openapi_types.UUID      `json:"sourceId"`
+	StartedAt       time.Time               `json:"startedAt"`
+	VmIds           []string                `json:"vmIds"`
+	WindowHours     int                     `json:"windowHours"`
+}
+
+// ChangeRateJobForm defines model for ChangeRateJobForm.
+type ChangeRateJobForm struct {
+	// IntervalMinutes Time between two samples of a VM, 60 when omitted
+	IntervalMinutes *int `json:"intervalMinutes,omitempty"`
+
+	// VmIds IDs of the VMs to sample, typically the candidates for warm migration
+	VmIds []string `json:"vmIds"`
+
+	// WindowHours Length of the sampling window, 168 (a week) when omitted
+	WindowHours *int `json:"windowHours,omitempty"`
+}
+
+// ChangeRateMeasurement defines model for ChangeRateMeasurement.
+type ChangeRateMeasurement struct {
+	CapacityBytes int64 `json:"capacityBytes"`
+	ChangedBytes  int64 `json:"changedBytes"`
+
+	// DailyChangeRatePercent Share of the VM disks rewritten per day. Omitted until two samples are recorded.
+	DailyChangeRatePercent *float64  `json:"dailyChangeRatePercent,omitempty"`
+	From                   time.Time `json:"from"`
+	Samples                int       `json:"samples"`
+	To                     time.Time `json:"to"`
+	VmId                   string    `json:"vmId"`
+}
+
+// ChangeRateSample defines model for ChangeRateSample.
+type ChangeRateSample struct {
+	CapacityBytes int64 `json:"capacityBytes"`
+
+	// ChangedBytes Size of the blocks changed since the previous sample of the VM, as reported by CBT
+	ChangedBytes int64     `json:"changedBytes"`
+	Timestamp    time.Time `json:"timestamp"`
+	VmId         string    `json:"vmId"`
+}
+
+// ChargebackLine defines model for ChargebackLine.
+type ChargebackLine struct {
+	ActualCost float64 `json:"actualCost"`
+
+	// ActualEffort Effort of the actuals recorded so far (formatted as duration string, e.g., "320h0m0s")
+	ActualEffort  string  `json:"actualEffort"`
+	EstimatedCost float64 `json:"estimatedCost"`
+
+	// EstimatedEffort Estimated effort (formatted as duration string, e.g., "320h0m0s")
+	EstimatedEffort string `json:"estimatedEffort"`
+
+	// Key Owner or cost center charged
+	Key string `json:"key"`
+	Vms int    `json:"vms"`
+}
+
+// ChargebackReport Effort and cost of a plan charged back by the owner or cost center of its VMs
+type ChargebackReport struct {
+	// Currency Currency of the costs, the one of the plan when set or else the one of the rate card
+	Currency *string `json:"currency,omitempty"`
+
+	// ExchangeRate Exchange rate a report converted its costs with, for auditability
+	ExchangeRate *ExchangeRate `json:"exchangeRate,omitempty"`
+	GroupBy      string        `json:"groupBy"`
+
+	// Lines Lines sorted by owner or cost center, the unassigned line last
+	Lines []ChargebackLine `json:"lines"`
+	Plan  string           `json:"plan"`
+
+	// RateCard Name and version of the rate card pricing the effort, empty when not priced
+	RateCard *string        `json:"rateCard,omitempty"`
+	Total    ChargebackLine `json:"total"`
+
+	// Unpriced Effort left out of the costs, e.g. worked by a role the rate card has no rate for
+	Unpriced *[]string `json:"unpriced,omitempty"`
+}
+
+// Checklist defines model for Checklist.
+type Checklist struct {
+	Id        openapi_types.UUID `json:"id"`
+	Items     []ChecklistItem    `json:"items"`
+	Template  string             `json:"template"`
+	UpdatedAt *time.Time         `json:"updatedAt,omitempty"`
+	Vm        ChecklistVM        `json:"vm"`
+	Wave      string             `json:"wave"`
+}
+
+// ChecklistInstantiation defines model for ChecklistInstantiation.
+type ChecklistInstantiation struct {
+	// Vms VMs cut over in the wave
+	Vms  []ChecklistVM `json:"vms"`
+	Wave string        `json:"wave"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CompletedBy *string    `json:"completedBy,omitempty"`
+	Done        bool       `json:"done"`
+	Name        string     `json:"name"`
+}
+
+// ChecklistItemForm defines model for ChecklistItemForm.
+type ChecklistItemForm struct {
+	Done bool `json:"done"`
+}
+
+// ChecklistList defines model for ChecklistList.
+type ChecklistList = []Checklist
+
+// ChecklistTemplate defines model for ChecklistTemplate.
+type ChecklistTemplate struct {
+	Applications     *[]string          `json:"applications,omitempty"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	CreatedBy        string             `json:"createdBy"`
+	Id               openapi_types.UUID `json:"id"`
+	Items            []string           `json:"items"`
+	Name             string             `json:"name"`
+	OperatingSystems *[]string          `json:"operatingSystems,omitempty"`
+}
+
+// ChecklistTemplateForm defines model for ChecklistTemplateForm.
+type ChecklistTemplateForm struct {
+	// Applications Applications of the VMs the template applies to
+	Applications *[]string `json:"applications,omitempty"`
+
+	// Items Checks to run on the VMs, e.g. "OS boots" or "Backup job rescheduled"
+	Items []string `json:"items"`
+	Name  string   `json:"name"`
+
+	// OperatingSystems Operating systems of the VMs the template applies to, matched as substrings. A template without applications nor operating systems applies to all the VMs.
+	OperatingSystems *[]string `json:"operatingSystems,omitempty"`
+}
+
+// ChecklistTemplateList defines model for ChecklistTemplateList.
+type ChecklistTemplateList = []ChecklistTemplate
+
+// ChecklistVM defines model for ChecklistVM.
+type ChecklistVM struct {
+	Application *string `json:"application,omitempty"`
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Os          *string `json:"os,omitempty"`
+}
+
 // ClusterRequirementsRequest Request payload for calculating cluster requirements
 type ClusterRequirementsRequest struct {
 	// ClusterId ID of the cluster to calculate requirements for
@@ -243,6 +705,62 @@ type ComplexityOSScoreEntry struct {
 	VmCount int `json:"vmCount"`
 }
 
+// ComplianceLabel Compliance regime of a workload:
+//   - `pci` - Processes cardholder data, in scope of PCI DSS
+//   - `hipaa` - Processes protected health information
+//   - `eu-residency` - Its data must stay in the European Union
+type ComplianceLabel string
+
+// ContingencyPolicy defines model for ContingencyPolicy.
+type ContingencyPolicy struct {
+	// MinimumPercent Share of the whole duration the buffers of the estimations and plans add up to at least
+	MinimumPercent float64           `json:"minimumPercent"`
+	Rules          []ContingencyRule `json:"rules"`
+	UpdatedAt      *time.Time        `json:"updatedAt,omitempty"`
+	UpdatedBy      *string           `json:"updatedBy,omitempty"`
+}
+
+// ContingencyPolicyForm defines model for ContingencyPolicyForm.
+type ContingencyPolicyForm struct {
+	// MinimumPercent Share of the whole duration the buffers of the estimations and plans add up to at least
+	MinimumPercent *float64           `json:"minimumPercent,omitempty"`
+	Rules          *[]ContingencyRule `json:"rules,omitempty"`
+}
+
+// ContingencyRule Buffer added to the phases the rule matches, reported as a line item of its own, e.g. 15% on the transfers or a day of change management per wave
+type ContingencyRule struct {
+	Name string `json:"name"`
+
+	// PerWave Time added once to each wave holding the phase (formatted as duration string, e.g., "24h")
+	PerWave *string `json:"perWave,omitempty"`
+
+	// Percent Share of the duration of the phases padded added as buffer
+	Percent *float64 `json:"percent,omitempty"`
+
+	// Phase Phase padded, or calculator of an estimation, all of them when omitted
+	Phase *string `json:"phase,omitempty"`
+}
+
+// CoverageGap Period of a UTC weekday no shift covers
+type CoverageGap struct {
+	Day  string `json:"day"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// CutoverWindow defines model for CutoverWindow.
+type CutoverWindow struct {
+	// End Closing of the window, with the offset of the site at that time
+	End time.Time `json:"end"`
+
+	// Local Span rendered in a time zone, with the offset in effect at each end
+	Local *LocalSpan `json:"local,omitempty"`
+	Name  string     `json:"name"`
+
+	// Start Opening of the window, with the offset of the site at that time
+	Start time.Time `json:"start"`
+}
+
 // Datastore defines model for Datastore.
 type Datastore struct {
 	DiskId                  string `json:"diskId"`
@@ -258,217 +776,1910 @@ type Datastore struct {
 	Vendor          string  `json:"vendor"`
 }
 
+// Day2Application defines model for Day2Application.
+type Day2Application struct {
+	// DrRunbook Whether the disaster recovery runbook of the application covers the target platform
+	DrRunbook bool   `json:"drRunbook"`
+	Name      string `json:"name"`
+}
+
+// Day2Gap defines model for Day2Gap.
+type Day2Gap struct {
+	Kind    Day2GapKind `json:"kind"`
+	Message string      `json:"message"`
+
+	// Subject Cluster or application the gap is about
+	Subject string `json:"subject"`
+}
+
+// Day2GapKind defines model for Day2Gap.Kind.
+type Day2GapKind string
+
+// Day2Readiness Day-2 gaps of the target declared in the request and the work to close them, so the VMs are not migrated onto a platform nobody can operate. Not part of the total duration.
+type Day2Readiness struct {
+	// Estimation Detailed estimation result from a single calculator
+	Estimation EstimationDetail `json:"estimation"`
+	Gaps       []Day2Gap        `json:"gaps"`
+}
+
+// Day2Target What the operations team declares about the target cluster, to assess whether it is ready to operate the migrated VMs
+type Day2Target struct {
+	Applications *[]Day2Application `json:"applications,omitempty"`
+
+	// BackupSolution Product protecting the KubeVirt VMs of the cluster, none when omitted
+	BackupSolution *string `json:"backupSolution,omitempty"`
+	Cluster        string  `json:"cluster"`
+
+	// MonitoringIntegrated Whether the metrics and alerts of the cluster reach the operations team
+	MonitoringIntegrated bool `json:"monitoringIntegrated"`
+}
+
+// DebugBundleRequest defines model for DebugBundleRequest.
+type DebugBundleRequest struct {
+	// JobId ID of a job to add the trace of
+	JobId *int64 `json:"jobId,omitempty"`
+
+	// PlanId ID of a plan to add, with its recent logs
+	PlanId *openapi_types.UUID `json:"planId,omitempty"`
+
+	// RequestId ID of a request, as returned in the X-Request-ID header, to add the recent logs of
+	RequestId *string `json:"requestId,omitempty"`
+}
+
+// DisplayGranularity Unit the durations and dates of a plan are rounded to:
+//   - `hour` - Reported as "1d 4h"
+//   - `half-day` - Reported as "2.5d"
+//   - `day` - Reported as "3d"
+type DisplayGranularity string
+
+// DistributionFamily defines model for DistributionFamily.
+type DistributionFamily string
+
+// DurationDistribution Distribution of the durations of a phase, in hours, fitted to its actuals. Phases without enough actuals have no fitted distribution.
+type DurationDistribution struct {
+	// Error Why no distribution could be fitted
+	Error     *string             `json:"error,omitempty"`
+	Family    *DistributionFamily `json:"family,omitempty"`
+	MeanHours *float64            `json:"meanHours,omitempty"`
+	P50Hours  *float64            `json:"p50Hours,omitempty"`
+	P90Hours  *float64            `json:"p90Hours,omitempty"`
+
+	// Params Fitted parameters by name, mu and sigma of a lognormal, shape and rate of a gamma
+	Params *map[string]float64 `json:"params,omitempty"`
+	Phase  string              `json:"phase"`
+
+	// Samples Number of actuals the distribution is fitted to
+	Samples int `json:"samples"`
+}
+
+// DurationDistributionList defines model for DurationDistributionList.
+type DurationDistributionList struct {
+	Distributions []DurationDistribution `json:"distributions"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Message Error message
 	Message string `json:"message"`
 }
 
-// EstimationDetail Detailed estimation result from a single calculator
-type EstimationDetail struct {
-	// Duration Estimated duration for this component (formatted as duration string)
-	Duration string `json:"duration"`
+// EstimationAnnotation Supplied param the estimation did not use as given
+type EstimationAnnotation struct {
+	// Applied Value used instead, none for an unused param
+	Applied *float64 `json:"applied,omitempty"`
 
-	// Reason Explanation of how the estimation was calculated
-	Reason string `json:"reason"`
+	// Calculator Name of the calculator which did not use the value, none for an unused param
+	Calculator *string `json:"calculator,omitempty"`
+
+	// Kind What the estimation did with the value: ignored it in favor of the default, capped it or did not read the param at all
+	Kind    EstimationAnnotationKind `json:"kind"`
+	Message string                   `json:"message"`
+	Param   string                   `json:"param"`
+
+	// Value Value supplied
+	Value interface{} `json:"value"`
 }
 
-// Histogram defines model for Histogram.
-type Histogram struct {
-	Data     []int `json:"data"`
-	MinValue int   `json:"minValue"`
-	Step     int   `json:"step"`
+// EstimationAnnotationKind What the estimation did with the value: ignored it in favor of the default, capped it or did not read the param at all
+type EstimationAnnotationKind string
+
+// EstimationBenchmark Outcome of the migration programs of similar environments, contributed anonymously by the organizations opting in. Only returned once enough programs were contributed.
+type EstimationBenchmark struct {
+	// AveragePlannedWeeks Average planned duration of the programs in weeks
+	AveragePlannedWeeks float64 `json:"averagePlannedWeeks"`
+
+	// AverageWeeks Average actual duration of the programs in weeks
+	AverageWeeks float64 `json:"averageWeeks"`
+	Network      string  `json:"network"`
+
+	// Samples Number of programs contributed
+	Samples int    `json:"samples"`
+	Summary string `json:"summary"`
+	VmBand  string `json:"vmBand"`
 }
 
-// Host defines model for Host.
-type Host struct {
-	// CpuCores Number of CPU cores
-	CpuCores *int `json:"cpuCores"`
+// EstimationComparison Comparison of the estimations of two scenarios, calculator by calculator
+type EstimationComparison struct {
+	// A Estimation of one of the scenarios of a comparison
+	A EstimationScenarioResult `json:"a"`
 
-	// CpuSockets Number of CPU sockets
-	CpuSockets *int `json:"cpuSockets"`
+	// B Estimation of one of the scenarios of a comparison
+	B EstimationScenarioResult `json:"b"`
 
-	// Id Unique identifier for this host
-	Id *string `json:"id,omitempty"`
+	// Calculators Deltas of the calculators, in calculator name order
+	Calculators []EstimationDelta `json:"calculators"`
 
-	// MemoryMB Host memory in MB
-	MemoryMB *int64 `json:"memoryMB"`
-	Model    string `json:"model"`
-	Vendor   string `json:"vendor"`
+	// PercentChange Total change relative to the total duration of A
+	PercentChange float64 `json:"percentChange"`
+
+	// TotalChange Total duration of B minus total duration of A
+	TotalChange string `json:"totalChange"`
 }
 
-// Info OpenShift Migration Advisor information
-type Info struct {
-	// AgentGitCommit Agent git commit hash
-	AgentGitCommit *string `json:"agentGitCommit"`
+// EstimationComparisonRequest Calculators run in two scenarios to compare
+type EstimationComparisonRequest struct {
+	// A Params of one of the scenarios of a comparison
+	A EstimationScenario `json:"a"`
 
-	// AgentVersionName Agent version name, based on git tag
-	AgentVersionName *string `json:"agentVersionName"`
+	// B Params of one of the scenarios of a comparison
+	B EstimationScenario `json:"b"`
 
-	// GitCommit Git commit hash
-	GitCommit string `json:"gitCommit"`
+	// Calculators Calculators to run, by ID, each with its optional configuration
+	Calculators []CalculatorSpec `json:"calculators"`
 
-	// VersionName Version name, based on git tag
-	VersionName string `json:"versionName"`
+	// Params Params shared by both scenarios, keyed by param
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// Priority Worker pool running the estimation, so UI recalculations never queue behind long runs:
+	//  * `interactive` - Recalculation a user waits for
+	//  * `batch` - Long-running estimation, e.g. a Monte Carlo run with thousands of trials
+	Priority *EstimationPriority `json:"priority,omitempty"`
 }
 
-// Infra defines model for Infra.
-type Infra struct {
-	ClustersPerDatacenter *[]int `json:"clustersPerDatacenter,omitempty"`
+// EstimationCost Cost of the work, in the currency of the rates given as params: engineer_hourly_rate prices the effort as labor, egress_cost_per_gb and temp_storage_cost_per_gb_day price the transfers. The costs of a planned estimation are converted into the currency of the plan. Only set when priced.
+type EstimationCost struct {
+	// EngineerHours Effort priced as labor
+	EngineerHours float64 `json:"engineerHours"`
 
-	// CpuOverCommitment CPU Overcommitment Ratio. Calculated as total Allocated vCPUs / Total Physical Cores
-	CpuOverCommitment *float64       `json:"cpuOverCommitment,omitempty"`
-	Datastores        []Datastore    `json:"datastores"`
-	HostPowerStates   map[string]int `json:"hostPowerStates"`
-	Hosts             *[]Host        `json:"hosts,omitempty"`
-	// Deprecated:
-	HostsPerCluster *[]int `json:"hostsPerCluster,omitempty"`
+	// Infrastructure Cost of the resources used, e.g. egress and temporary storage
+	Infrastructure float64 `json:"infrastructure"`
+	Labor          float64 `json:"labor"`
+	Total          float64 `json:"total"`
+}
 
-	// MemoryOverCommitment RAM memory Overcommitment Ratio. Calculated as total Allocated memory / Total memory available
-	MemoryOverCommitment *float64  `json:"memoryOverCommitment,omitempty"`
-	Networks             []Network `json:"networks"`
-	// Deprecated:
-	TotalClusters    *int `json:"totalClusters,omitempty"`
-	TotalDatacenters *int `json:"totalDatacenters,omitempty"`
-	TotalHosts       int  `json:"totalHosts"`
-	// Deprecated:
-	VmsPerCluster *[]int `json:"vmsPerCluster,omitempty"`
+// EstimationDelta How the estimation of a calculator differs from scenario A to scenario B
+type EstimationDelta struct {
+	// A Detailed estimation result from a single calculator
+	A *EstimationDetail `json:"a,omitempty"`
+
+	// B Detailed estimation result from a single calculator
+	B          *EstimationDetail `json:"b,omitempty"`
+	Calculator string            `json:"calculator"`
+
+	// Change Duration of B minus duration of A, a missing estimation counting as zero
+	Change string `json:"change"`
+
+	// PercentChange Change relative to the duration of A, zero when A took no time
+	PercentChange float64 `json:"percentChange"`
 }
 
-// Inventory defines model for Inventory.
+// EstimationDetail Detailed estimation result from a single calculator
+type EstimationDetail struct {
+	// Cost Cost of the work, in the currency of the rates given as params: engineer_hourly_rate prices the effort as labor, egress_cost_per_gb and temp_storage_cost_per_gb_day price the transfers. The costs of a planned estimation are converted into the currency of the plan. Only set when priced.
+	Cost *EstimationCost `json:"cost,omitempty"`
+
+	// Duration Estimated duration for this component (formatted as duration string)
+	Duration string `json:"duration"`
+
+	// End End of the work on the calendar, only set when a schedule was requested
+	End *time.Time `json:"end,omitempty"`
+
+	// Explanation Structured account of how an estimation was calculated, for clients to format per locale instead of parsing the reason
+	Explanation *EstimationExplanation `json:"explanation,omitempty"`
+
+	// LeadTime Calendar time that has to elapse regardless of the duration, e.g. a notice period or shipping (formatted as duration string)
+	LeadTime *string `json:"leadTime,omitempty"`
+
+	// Reason Explanation of how the estimation was calculated, as an English sentence for display
+	Reason string `json:"reason"`
+
+	// Start Start of the work on the calendar, only set when a schedule was requested
+	Start *time.Time `json:"start,omitempty"`
+}
+
+// EstimationExplanation Structured account of how an estimation was calculated, for clients to format per locale instead of parsing the reason
+type EstimationExplanation struct {
+	Assumptions []string `json:"assumptions"`
+
+	// Formula Duration and lead time in terms of the names of the quantities
+	Formula string `json:"formula"`
+
+	// Inputs Values the estimation used, named after their param
+	Inputs        []EstimationQuantity `json:"inputs"`
+	Intermediates []EstimationQuantity `json:"intermediates"`
+}
+
+// EstimationFreeze Estimation of a cluster frozen for a statement of work
+type EstimationFreeze struct {
+	AssessmentId openapi_types.UUID `json:"assessmentId"`
+
+	// Breakdown Breakdown of the estimation by calculator, only returned for a single freeze
+	Breakdown *map[string]EstimationDetail `json:"breakdown,omitempty"`
+	ClusterId string                       `json:"clusterId"`
+	CreatedAt time.Time                    `json:"createdAt"`
+	CreatedBy *string                      `json:"createdBy,omitempty"`
+
+	// Digest Digest of the recording of the freeze, to check it was not altered
+	Digest string             `json:"digest"`
+	Id     openapi_types.UUID `json:"id"`
+	Name   string             `json:"name"`
+
+	// Overrides Params given with the request, keyed by param
+	Overrides      *map[string]float64 `json:"overrides,omitempty"`
+	PlanId         *openapi_types.UUID `json:"planId,omitempty"`
+	PlannerVersion *string             `json:"plannerVersion,omitempty"`
+
+	// Recording Everything the estimation depended on, only returned for a single freeze
+	Recording     *map[string]interface{} `json:"recording,omitempty"`
+	SowId         string                  `json:"sowId"`
+	TotalDuration string                  `json:"totalDuration"`
+}
+
+// EstimationFreezeDiff defines model for EstimationFreezeDiff.
+type EstimationFreezeDiff struct {
+	// Current Migration time estimation results
+	Current MigrationEstimationResponse `json:"current"`
+
+	// Differences Explanations of the change, empty while the estimation stands as frozen
+	Differences []string `json:"differences"`
+
+	// Freeze Estimation of a cluster frozen for a statement of work
+	Freeze EstimationFreeze `json:"freeze"`
+
+	// TotalDurationChange Current total duration minus the frozen one
+	TotalDurationChange string `json:"totalDurationChange"`
+}
+
+// EstimationFreezeForm defines model for EstimationFreezeForm.
+type EstimationFreezeForm struct {
+	ClusterId string `json:"clusterId"`
+
+	// Name Name of the freeze, unique within the statement of work
+	Name string `json:"name"`
+
+	// Params Params overriding the ones derived from the inventory, keyed by param
+	Params *map[string]float64 `json:"params,omitempty"`
+
+	// PlanId Plan migrating the cluster, whose params come between the organization defaults and the params given
+	PlanId *openapi_types.UUID `json:"planId,omitempty"`
+
+	// SowId Identifier of the statement of work the freeze is attached to
+	SowId string `json:"sowId"`
+}
+
+// EstimationFreezeList defines model for EstimationFreezeList.
+type EstimationFreezeList = []EstimationFreeze
+
+// EstimationJob Background estimation run
+type EstimationJob struct {
+	// Error Error message if job failed
+	Error *string `json:"error,omitempty"`
+
+	// Id Job ID
+	Id       int64              `json:"id"`
+	Progress EstimationProgress `json:"progress"`
+
+	// RequestId ID of the request which submitted the job, found in the logs of the job
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Result Migration time estimation results
+	Result *MigrationEstimationResponse `json:"result,omitempty"`
+
+	// Status Job status:
+	//  * `pending` - Job is queued
+	//  * `parsing` - Parsing RVTools Excel file
+	//  * `validating` - Running OPA VM validations
+	//  * `rendering` - Rendering a report
+	//  * `estimating` - Running the calculators of an estimation
+	//  * `completed` - Assessment created, report rendered or estimation run successfully
+	//  * `failed` - Job failed with error
+	//  * `cancelled` - Job was cancelled
+	Status JobStatus `json:"status"`
+}
+
+// EstimationParamChange Change of a param
+type EstimationParamChange struct {
+	From  float64 `json:"from"`
+	Param string  `json:"param"`
+	To    float64 `json:"to"`
+}
+
+// EstimationPriority Worker pool running the estimation, so UI recalculations never queue behind long runs:
+//   - `interactive` - Recalculation a user waits for
+//   - `batch` - Long-running estimation, e.g. a Monte Carlo run with thousands of trials
+type EstimationPriority string
+
+// EstimationProgress defines model for EstimationProgress.
+type EstimationProgress struct {
+	// Completed Number of calculators run
+	Completed int `json:"completed"`
+
+	// Total Number of calculators to run
+	Total int `json:"total"`
+}
+
+// EstimationQuantity defines model for EstimationQuantity.
+type EstimationQuantity struct {
+	// Default Whether the default of the calculator was used because the param was not given
+	Default *bool   `json:"default,omitempty"`
+	Name    string  `json:"name"`
+	Unit    *string `json:"unit,omitempty"`
+	Value   float64 `json:"value"`
+}
+
+// EstimationRequest Params and calculators of an estimation run without an assessment
+type EstimationRequest struct {
+	// Async Run the estimation as a job, polled at /api/v1/estimations/{id}
+	Async *bool `json:"async,omitempty"`
+
+	// Calculators Calculators to run, by ID, each with its optional configuration
+	Calculators []CalculatorSpec `json:"calculators"`
+
+	// Params Params of the calculators keyed by param, e.g. vm_count or total_disk_gb. Values are numbers, or maps of numbers for the params given per name, e.g. os_breakdown.
+	Params map[string]interface{} `json:"params"`
+
+	// Priority Worker pool running the estimation, so UI recalculations never queue behind long runs:
+	//  * `interactive` - Recalculation a user waits for
+	//  * `batch` - Long-running estimation, e.g. a Monte Carlo run with thousands of trials
+	Priority *EstimationPriority `json:"priority,omitempty"`
+
+	// Schedule Work calendar the estimation is landed on, so each part of the breakdown gets the dates it would start and end on when started at the given time
+	Schedule *EstimationSchedule `json:"schedule,omitempty"`
+}
+
+// EstimationRun Estimation of a cluster of an assessment kept to be compared over time
+type EstimationRun struct {
+	AssessmentId openapi_types.UUID `json:"assessmentId"`
+
+	// Breakdown Breakdown of the estimation by calculator, only returned for a single run
+	Breakdown   *map[string]EstimationDetail `json:"breakdown,omitempty"`
+	Calculators []string                     `json:"calculators"`
+	ClusterId   string                       `json:"clusterId"`
+	CreatedAt   time.Time                    `json:"createdAt"`
+	CreatedBy   *string                      `json:"createdBy,omitempty"`
+	Id          openapi_types.UUID           `json:"id"`
+
+	// Overrides Params given with the request, keyed by param
+	Overrides *map[string]float64 `json:"overrides,omitempty"`
+
+	// PlannerVersion Version of the planner whose calculators ran
+	PlannerVersion *string `json:"plannerVersion,omitempty"`
+
+	// Recording Everything the estimation depended on, only returned for a single run. Saved to a file, it is replayed with `planner replay`.
+	Recording     *map[string]interface{} `json:"recording,omitempty"`
+	TotalDuration string                  `json:"totalDuration"`
+}
+
+// EstimationRunComparison defines model for EstimationRunComparison.
+type EstimationRunComparison struct {
+	// After Estimation of a cluster of an assessment kept to be compared over time
+	After EstimationRun `json:"after"`
+
+	// Before Estimation of a cluster of an assessment kept to be compared over time
+	Before EstimationRun `json:"before"`
+
+	// Differences Explanations of the change, empty when both runs estimated the same from the same inputs
+	Differences []string `json:"differences"`
+
+	// TotalDurationChange Total duration of the later run minus the one of the earlier run
+	TotalDurationChange string `json:"totalDurationChange"`
+}
+
+// EstimationRunList defines model for EstimationRunList.
+type EstimationRunList = []EstimationRun
+
+// EstimationScenario Params of one of the scenarios of a comparison
+type EstimationScenario struct {
+	// Name Name of the scenario, A or B by default
+	Name *string `json:"name,omitempty"`
+
+	// Params Params of the scenario keyed by param, replacing the shared ones
+	Params map[string]interface{} `json:"params"`
+}
+
+// EstimationScenarioResult Estimation of one of the scenarios of a comparison
+type EstimationScenarioResult struct {
+	// Cost Cost of the work, in the currency of the rates given as params: engineer_hourly_rate prices the effort as labor, egress_cost_per_gb and temp_storage_cost_per_gb_day price the transfers. The costs of a planned estimation are converted into the currency of the plan. Only set when priced.
+	Cost          *EstimationCost `json:"cost,omitempty"`
+	Name          string          `json:"name"`
+	ParamLineage  *[]ParamLineage `json:"paramLineage,omitempty"`
+	TotalDuration string          `json:"totalDuration"`
+}
+
+// EstimationSchedule Work calendar the estimation is landed on, so each part of the breakdown gets the dates it would start and end on when started at the given time
+type EstimationSchedule struct {
+	// Calendar Working calendar of a team. Weekends, the public holidays of the region, the company holidays and the change freezes are days off; manual phases of the pool do not progress on them.
+	Calendar *PoolCalendar `json:"calendar,omitempty"`
+	Start    time.Time     `json:"start"`
+
+	// WorkHoursPerDay Hours of work per working day, 8 when omitted
+	WorkHoursPerDay *float64 `json:"workHoursPerDay,omitempty"`
+
+	// WorkdayStart Time of day the work starts, 09:00 when omitted
+	WorkdayStart *string `json:"workdayStart,omitempty"`
+}
+
+// EstimationSolution What it takes for an estimation to end by a deadline
+type EstimationSolution struct {
+	// Changes Smallest changes meeting the deadline, none when it is met already. When the deadline is infeasible, every param with an effect at its best bound, the end date still missing it.
+	Changes  []EstimationParamChange `json:"changes"`
+	Deadline time.Time               `json:"deadline"`
+
+	// End End date of the estimation with the changes
+	End time.Time `json:"end"`
+
+	// Evaluations Number of estimations run by the search
+	Evaluations int `json:"evaluations"`
+
+	// Feasible The deadline is met within the bounds
+	Feasible bool   `json:"feasible"`
+	Message  string `json:"message"`
+}
+
+// EstimationSolveBound Param the solver may adjust, between its bounds
+type EstimationSolveBound struct {
+	// Integer The param takes whole values, e.g. a number of engineers
+	Integer *bool   `json:"integer,omitempty"`
+	Max     float64 `json:"max"`
+	Min     float64 `json:"min"`
+	Param   string  `json:"param"`
+}
+
+// EstimationSolveRequest Estimation to end by a deadline and the params that may change to meet it. The params to adjust must be given, or set by the calculator defaults of the organization.
+type EstimationSolveRequest struct {
+	Adjustable []EstimationSolveBound `json:"adjustable"`
+
+	// Calculators Calculators to run, by ID, each with its optional configuration
+	Calculators []CalculatorSpec `json:"calculators"`
+	Deadline    time.Time        `json:"deadline"`
+
+	// Params Params of the calculators keyed by param, e.g. vm_count or total_disk_gb
+	Params map[string]interface{} `json:"params"`
+
+	// Priority Worker pool running the estimation, so UI recalculations never queue behind long runs:
+	//  * `interactive` - Recalculation a user waits for
+	//  * `batch` - Long-running estimation, e.g. a Monte Carlo run with thousands of trials
+	Priority *EstimationPriority `json:"priority,omitempty"`
+
+	// Schedule Work calendar the estimation is landed on, so each part of the breakdown gets the dates it would start and end on when started at the given time
+	Schedule EstimationSchedule `json:"schedule"`
+}
+
+// EstimationWarning Param of an estimation flagged as implausible
+type EstimationWarning struct {
+	Message string  `json:"message"`
+	Param   string  `json:"param"`
+	Value   float64 `json:"value"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	AggregateId   string                 `json:"aggregateId"`
+	AggregateType string                 `json:"aggregateType"`
+	OccurredAt    time.Time              `json:"occurredAt"`
+	OrgId         string                 `json:"orgId"`
+	Payload       map[string]interface{} `json:"payload"`
+	Seq           int64                  `json:"seq"`
+
+	// Type Type of a domain event:
+	//  * `PlanCreated` - The payload is the plan as created
+	//  * `ParamsChanged` - The payload names the change (kpis, schedule, calendar) and holds the plan after it
+	//  * `ActualRecorded` - The payload is the progress recorded for a wave
+	//  * `WaveCompleted` - The payload is the progress of a wave recorded for the first time
+	//  * `PlanDeleted` - The payload is empty
+	//  * `ReportExported` - The payload holds the format of an export of the plan, the user exporting it and whether it was watermarked
+	Type     EventType `json:"type"`
+	Username string    `json:"username"`
+}
+
+// EventPage defines model for EventPage.
+type EventPage struct {
+	Events []Event `json:"events"`
+
+	// Next Sequence number to request the next page after
+	Next int64 `json:"next"`
+}
+
+// EventType Type of a domain event:
+//   - `PlanCreated` - The payload is the plan as created
+//   - `ParamsChanged` - The payload names the change (kpis, schedule, calendar) and holds the plan after it
+//   - `ActualRecorded` - The payload is the progress recorded for a wave
+//   - `WaveCompleted` - The payload is the progress of a wave recorded for the first time
+//   - `PlanDeleted` - The payload is empty
+//   - `ReportExported` - The payload holds the format of an export of the plan, the user exporting it and whether it was watermarked
+type EventType string
+
+// ExchangeRate Exchange rate a report converted its costs with, for auditability
+type ExchangeRate struct {
+	AsOf time.Time `json:"asOf"`
+	From string    `json:"from"`
+	Rate float64   `json:"rate"`
+
+	// Source Where the rate comes from:
+	//  * `manual` - A rate entered for the plan
+	//  * `api` - The exchange rate service of the server
+	Source ExchangeRateSource `json:"source"`
+	To     string             `json:"to"`
+}
+
+// ExchangeRateSource Where the rate comes from:
+//   - `manual` - A rate entered for the plan
+//   - `api` - The exchange rate service of the server
+type ExchangeRateSource string
+
+// ExportPolicy defines model for ExportPolicy.
+type ExportPolicy struct {
+	ContributeBenchmarks bool       `json:"contributeBenchmarks"`
+	RawExports           bool       `json:"rawExports"`
+	UpdatedAt            *time.Time `json:"updatedAt,omitempty"`
+	UpdatedBy            *string    `json:"updatedBy,omitempty"`
+	Watermark            bool       `json:"watermark"`
+}
+
+// ExportPolicyForm defines model for ExportPolicyForm.
+type ExportPolicyForm struct {
+	// ContributeBenchmarks Contribute the planned and actual duration of the completed plans to the benchmark dataset, anonymized down to a band of VM count and a class of network. Off when omitted.
+	ContributeBenchmarks *bool `json:"contributeBenchmarks,omitempty"`
+
+	// RawExports Allow the exports of the plan data as files for other tools (MS Project, Smartsheet, MTV) and the inventories of the sources and assessments. Without, the sources and assessments are returned without their inventory.
+	RawExports bool `json:"rawExports"`
+
+	// Watermark Stamp the rendered reports, the Gantt charts, the shared HTML reports and the PDF reports of the estimation freezes, with the organization, the user and the time of the export
+	Watermark bool `json:"watermark"`
+}
+
+// FactoryAssumptions defines model for FactoryAssumptions.
+type FactoryAssumptions struct {
+	DiskGb            float64 `json:"diskGb"`
+	EngineerMinsPerVm float64 `json:"engineerMinsPerVm"`
+	Engineers         int     `json:"engineers"`
+
+	// MaxWaveVms Largest wave the factory cuts over, 0 when waves are not limited
+	MaxWaveVms           int     `json:"maxWaveVms"`
+	TransferHoursPerWeek float64 `json:"transferHoursPerWeek"`
+	TransferRateMbps     float64 `json:"transferRateMbps"`
+	Vms                  int     `json:"vms"`
+	WavesPerWeek         int     `json:"wavesPerWeek"`
+	WorkDaysPerWeek      float64 `json:"workDaysPerWeek"`
+	WorkHoursPerDay      float64 `json:"workHoursPerDay"`
+}
+
+// FactoryRequirement What a throughput requires of a resource, against what the assumptions provide
+type FactoryRequirement struct {
+	Available float64 `json:"available"`
+	Feasible  bool    `json:"feasible"`
+
+	// MaxVmsPerWeek Throughput the available resource sustains
+	MaxVmsPerWeek float64                    `json:"maxVmsPerWeek"`
+	Message       string                     `json:"message"`
+	Required      float64                    `json:"required"`
+	Resource      FactoryRequirementResource `json:"resource"`
+	Unit          string                     `json:"unit"`
+}
+
+// FactoryRequirementResource defines model for FactoryRequirement.Resource.
+type FactoryRequirementResource string
+
+// FactoryThroughput Resources a migration factory requires to sustain a throughput
+type FactoryThroughput struct {
+	// AchievableVmsPerWeek Throughput the assumptions sustain, capped at the target
+	AchievableVmsPerWeek float64            `json:"achievableVmsPerWeek"`
+	Assumptions          FactoryAssumptions `json:"assumptions"`
+
+	// Bottleneck Resource sustaining the lowest throughput when the target is infeasible
+	Bottleneck       *string              `json:"bottleneck,omitempty"`
+	Feasible         bool                 `json:"feasible"`
+	Requirements     []FactoryRequirement `json:"requirements"`
+	TargetVmsPerWeek float64              `json:"targetVmsPerWeek"`
+
+	// WaveVms VMs of each wave cutting over the target
+	WaveVms int `json:"waveVms"`
+	Waves   int `json:"waves"`
+
+	// Weeks Weeks the factory takes to migrate every VM at the target
+	Weeks int `json:"weeks"`
+}
+
+// FactoryThroughputRequest Throughput targeted by a migration factory
+type FactoryThroughputRequest struct {
+	// ClusterId ID of the cluster migrated
+	ClusterId string `json:"clusterId" validate:"required"`
+
+	// Params Params overriding the current assumptions, keyed by param, e.g. transfer_rate_mbps, post_migration_engineers, work_days_per_week, transfer_hours_per_week, waves_per_week or max_wave_vms
+	Params *map[string]float64 `json:"params,omitempty"`
+
+	// VmsPerWeek VMs migrated per week targeted
+	VmsPerWeek float64 `json:"vmsPerWeek"`
+}
+
+// ForecastQuarter Volume forecast to be migrated within a fiscal quarter. P80 is the volume migrated in at least 80% of the trials.
+type ForecastQuarter struct {
+	ActualDiskGb float64 `json:"actualDiskGb"`
+
+	// ActualVms VMs of the waves recorded to have ended in the quarter
+	ActualVms           int       `json:"actualVms"`
+	CumulativeDiskGbP50 float64   `json:"cumulativeDiskGbP50"`
+	CumulativeDiskGbP80 float64   `json:"cumulativeDiskGbP80"`
+	CumulativeVmsP50    int       `json:"cumulativeVmsP50"`
+	CumulativeVmsP80    int       `json:"cumulativeVmsP80"`
+	DiskGbP50           float64   `json:"diskGbP50"`
+	DiskGbP80           float64   `json:"diskGbP80"`
+	End                 time.Time `json:"end"`
+	Quarter             string    `json:"quarter"`
+	Start               time.Time `json:"start"`
+	VmsP50              int       `json:"vmsP50"`
+	VmsP80              int       `json:"vmsP80"`
+}
+
+// Gantt Gantt chart of a migration plan
+type Gantt struct {
+	Bars         []GanttBar        `json:"bars"`
+	Dependencies []GanttDependency `json:"dependencies"`
+	End          time.Time         `json:"end"`
+
+	// Milestones Build-out milestones the waves depend on
+	Milestones *[]PlanMilestone `json:"milestones,omitempty"`
+
+	// Sources Span of each source of a multi-source plan
+	Sources *[]GanttSource `json:"sources,omitempty"`
+	Start   time.Time      `json:"start"`
+
+	// TimeZone Time zone of the viewer the local times are rendered in, only set when requested
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Today Current time, only set while the plan is running
+	Today *time.Time  `json:"today,omitempty"`
+	Waves []GanttWave `json:"waves"`
+}
+
+// GanttBar Phase of a wave on the calendar. `site` renders it in the local time of the site of its source and `local` in the time zone of the viewer, when requested.
+type GanttBar struct {
+	End time.Time `json:"end"`
+
+	// Local Span rendered in a time zone, with the offset in effect at each end
+	Local *LocalSpan `json:"local,omitempty"`
+	Phase string     `json:"phase"`
+	Pool  *string    `json:"pool,omitempty"`
+
+	// Released End of the effort; the rest of the bar is lead time
+	Released time.Time `json:"released"`
+
+	// Shifts Effort carried out by each shift, when the pool is worked in shifts
+	Shifts *[]ShiftWork `json:"shifts,omitempty"`
+
+	// Site Span rendered in a time zone, with the offset in effect at each end
+	Site  *LocalSpan `json:"site,omitempty"`
+	Start time.Time  `json:"start"`
+	Units *int       `json:"units,omitempty"`
+	Wave  string     `json:"wave"`
+}
+
+// GanttBarRef defines model for GanttBarRef.
+type GanttBarRef struct {
+	Phase string `json:"phase"`
+	Wave  string `json:"wave"`
+}
+
+// GanttDependency defines model for GanttDependency.
+type GanttDependency struct {
+	From GanttBarRef `json:"from"`
+	To   GanttBarRef `json:"to"`
+}
+
+// GanttSource defines model for GanttSource.
+type GanttSource struct {
+	// CutoverWindows Maintenance windows of the site opening over the plan, blackouts left out
+	CutoverWindows *[]CutoverWindow `json:"cutoverWindows,omitempty"`
+	End            time.Time        `json:"end"`
+	Name           string           `json:"name"`
+	Start          time.Time        `json:"start"`
+
+	// TimeZone IANA time zone of the site of the source
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Waves Number of waves migrating the source
+	Waves int `json:"waves"`
+}
+
+// GanttWave defines model for GanttWave.
+type GanttWave struct {
+	End  time.Time `json:"end"`
+	Name string    `json:"name"`
+
+	// Source Source migrated by the wave in a multi-source plan
+	Source *string   `json:"source,omitempty"`
+	Start  time.Time `json:"start"`
+}
+
+// GateApprovalForm defines model for GateApprovalForm.
+type GateApprovalForm struct {
+	// Comment Note recorded with the sign-off
+	Comment *string `json:"comment,omitempty"`
+}
+
+// GuardrailPolicy defines model for GuardrailPolicy.
+type GuardrailPolicy struct {
+	// MaxWorkHoursPerDay Longest plausible working day
+	MaxWorkHoursPerDay float64 `json:"maxWorkHoursPerDay"`
+
+	// TeamSize Number of engineers of the organization, the engineer counts of the estimations are not checked when 0
+	TeamSize  int        `json:"teamSize"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+}
+
+// GuardrailPolicyForm defines model for GuardrailPolicyForm.
+type GuardrailPolicyForm struct {
+	// MaxWorkHoursPerDay Longest plausible working day, 16 hours when omitted
+	MaxWorkHoursPerDay *float64 `json:"maxWorkHoursPerDay,omitempty"`
+
+	// TeamSize Number of engineers of the organization, the engineer counts of the estimations are not checked when omitted
+	TeamSize *int `json:"teamSize,omitempty"`
+}
+
+// Histogram defines model for Histogram.
+type Histogram struct {
+	Data     []int `json:"data"`
+	MinValue int   `json:"minValue"`
+	Step     int   `json:"step"`
+}
+
+// Holiday defines model for Holiday.
+type Holiday struct {
+	Date openapi_types.Date `json:"date"`
+	Name string             `json:"name"`
+}
+
+// HolidayRegion Preset of public holidays:
+//   - `US` - US federal holidays, observed on the closest weekday
+//   - `DE` - Public holidays of all German states
+//   - `IN` - National holidays of India
+//   - `JP` - National holidays of Japan, with substitute holidays
+type HolidayRegion string
+
+// Host defines model for Host.
+type Host struct {
+	// CpuCores Number of CPU cores
+	CpuCores *int `json:"cpuCores"`
+
+	// CpuSockets Number of CPU sockets
+	CpuSockets *int `json:"cpuSockets"`
+
+	// Id Unique identifier for this host
+	Id *string `json:"id,omitempty"`
+
+	// MemoryMB Host memory in MB
+	MemoryMB *int64 `json:"memoryMB"`
+	Model    string `json:"model"`
+
+	// NicSpeedsMbps Link speeds of the physical NICs of the host in Mbps, omitted when not collected
+	NicSpeedsMbps *[]int `json:"nicSpeedsMbps,omitempty"`
+	Vendor        string `json:"vendor"`
+
+	// Version ESXi version of the host, e.g. "7.0.3", omitted when not collected
+	Version *string `json:"version,omitempty"`
+}
+
+// Info OpenShift Migration Advisor information
+type Info struct {
+	// AgentGitCommit Agent git commit hash
+	AgentGitCommit *string `json:"agentGitCommit"`
+
+	// AgentVersionName Agent version name, based on git tag
+	AgentVersionName *string `json:"agentVersionName"`
+
+	// GitCommit Git commit hash
+	GitCommit string `json:"gitCommit"`
+
+	// VersionName Version name, based on git tag
+	VersionName string `json:"versionName"`
+}
+
+// Infra defines model for Infra.
+type Infra struct {
+	ClustersPerDatacenter *[]int `json:"clustersPerDatacenter,omitempty"`
+
+	// CpuOverCommitment CPU Overcommitment Ratio. Calculated as total Allocated vCPUs / Total Physical Cores
+	CpuOverCommitment *float64       `json:"cpuOverCommitment,omitempty"`
+	Datastores        []Datastore    `json:"datastores"`
+	HostPowerStates   map[string]int `json:"hostPowerStates"`
+	Hosts             *[]Host        `json:"hosts,omitempty"`
+	// Deprecated:
+	HostsPerCluster *[]int `json:"hostsPerCluster,omitempty"`
+
+	// MemoryOverCommitment RAM memory Overcommitment Ratio. Calculated as total Allocated memory / Total memory available
+	MemoryOverCommitment *float64  `json:"memoryOverCommitment,omitempty"`
+	Networks             []Network `json:"networks"`
+	// Deprecated:
+	TotalClusters    *int `json:"totalClusters,omitempty"`
+	TotalDatacenters *int `json:"totalDatacenters,omitempty"`
+	TotalHosts       int  `json:"totalHosts"`
+
+	// VcenterVersion Version of the vCenter managing the hosts, e.g. "8.0.3", omitted when not collected
+	VcenterVersion *string `json:"vcenterVersion,omitempty"`
+	// Deprecated:
+	VmsPerCluster *[]int `json:"vmsPerCluster,omitempty"`
+}
+
+// Inventory defines model for Inventory.
 type Inventory struct {
 	// Clusters Map of cluster names to their inventory data
 	Clusters map[string]InventoryData `json:"clusters"`
 	Vcenter  *InventoryData           `json:"vcenter,omitempty"`
 
-	// VcenterId ID of the vCenter
-	VcenterId string `json:"vcenter_id"`
+	// VcenterId ID of the vCenter
+	VcenterId string `json:"vcenter_id"`
+}
+
+// InventoryData defines model for InventoryData.
+type InventoryData struct {
+	Infra   Infra    `json:"infra"`
+	Vcenter *VCenter `json:"vcenter,omitempty"`
+	Vms     VMs      `json:"vms"`
+}
+
+// InventoryField Extension field of the VMs of an inventory
+type InventoryField struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Key of the field, <prefix>.<name> where the prefix of plugin fields is the plugin name
+	Name string `json:"name"`
+
+	// Source How the values are populated:
+	//  * `plugin` - Collected by an agent plugin
+	//  * `import` - Read by an importer, e.g. a spreadsheet column
+	Source InventoryFieldSource `json:"source"`
+	Type   InventoryFieldType   `json:"type"`
+}
+
+// InventoryFieldSource How the values are populated:
+//   - `plugin` - Collected by an agent plugin
+//   - `import` - Read by an importer, e.g. a spreadsheet column
+type InventoryFieldSource string
+
+// InventoryFieldType defines model for InventoryField.Type.
+type InventoryFieldType string
+
+// InventoryFieldSchema defines model for InventoryFieldSchema.
+type InventoryFieldSchema struct {
+	Fields    []InventoryField `json:"fields"`
+	UpdatedAt *time.Time       `json:"updatedAt,omitempty"`
+	UpdatedBy *string          `json:"updatedBy,omitempty"`
+}
+
+// InventoryFieldSchemaForm defines model for InventoryFieldSchemaForm.
+type InventoryFieldSchemaForm struct {
+	Fields []InventoryField `json:"fields"`
+}
+
+// InventoryTotals Inventory totals for the cluster
+type InventoryTotals struct {
+	// TotalCPU Total CPU cores across all VMs in the cluster
+	TotalCPU int `json:"totalCPU"`
+
+	// TotalMemory Total memory (GB) across all VMs in the cluster
+	TotalMemory int `json:"totalMemory"`
+
+	// TotalVMs Total number of VMs in the cluster
+	TotalVMs int `json:"totalVMs"`
+}
+
+// Ipv4Config defines model for Ipv4Config.
+type Ipv4Config struct {
+	DefaultGateway string `json:"defaultGateway" validate:"required,ip4_addr,max=15"`
+	Dns            string `json:"dns" validate:"required,ip4_addr,max=15"`
+	IpAddress      string `json:"ipAddress" validate:"required,ip4_addr,max=15"`
+	SubnetMask     string `json:"subnetMask" validate:"required,subnet_mask,max=2"`
+}
+
+// Job Background job for async assessment creation or report rendering
+type Job struct {
+	// AssessmentId Assessment ID when job completed successfully
+	AssessmentId *openapi_types.UUID `json:"assessmentId,omitempty"`
+
+	// Error Error message if job failed
+	Error *string `json:"error,omitempty"`
+
+	// Id Job ID
+	Id int64 `json:"id"`
+
+	// Status Job status:
+	//  * `pending` - Job is queued
+	//  * `parsing` - Parsing RVTools Excel file
+	//  * `validating` - Running OPA VM validations
+	//  * `rendering` - Rendering a report
+	//  * `estimating` - Running the calculators of an estimation
+	//  * `completed` - Assessment created, report rendered or estimation run successfully
+	//  * `failed` - Job failed with error
+	//  * `cancelled` - Job was cancelled
+	Status JobStatus `json:"status"`
+}
+
+// JobStatus Job status:
+//   - `pending` - Job is queued
+//   - `parsing` - Parsing RVTools Excel file
+//   - `validating` - Running OPA VM validations
+//   - `rendering` - Rendering a report
+//   - `estimating` - Running the calculators of an estimation
+//   - `completed` - Assessment created, report rendered or estimation run successfully
+//   - `failed` - Job failed with error
+//   - `cancelled` - Job was cancelled
+type JobStatus string
+
+// KPIStatus A KPI actual measured against its target
+type KPIStatus struct {
+	Actual   float64      `json:"actual"`
+	Breached bool         `json:"breached"`
+	Kpi      KPIStatusKpi `json:"kpi"`
+	Message  string       `json:"message"`
+	Target   float64      `json:"target"`
+
+	// Wave Wave the KPI is measured on, omitted for program-wide KPIs
+	Wave *string `json:"wave,omitempty"`
+}
+
+// KPIStatusKpi defines model for KPIStatus.Kpi.
+type KPIStatusKpi string
+
+// Label defines model for Label.
+type Label struct {
+	Key   string `json:"key" validate:"required,label"`
+	Value string `json:"value" validate:"required,label"`
+}
+
+// LocalSpan Span rendered in a time zone, with the offset in effect at each end
+type LocalSpan struct {
+	End   time.Time `json:"end"`
+	Start time.Time `json:"start"`
+}
+
+// MigrationComplexityRequest Request payload for calculating migration complexity estimation
+type MigrationComplexityRequest struct {
+	// ClusterId ID of the cluster to calculate complexity estimation for
+	ClusterId string `json:"clusterId" validate:"required"`
+}
+
+// MigrationComplexityResponse Migration complexity estimation results
+type MigrationComplexityResponse struct {
+	// ComplexityByDisk Disk-size complexity scores, one entry per score level (1-4). Score 1 is the least complex disk footprint; score 4 is the most complex. Scores correspond to provisioned disk size: 1 (<=10 TB), 2 (<=20 TB), 3 (<=50 TB), 4 (>50 TB). All four score levels are always present.
+	ComplexityByDisk []ComplexityDiskScoreEntry `json:"complexityByDisk"`
+
+	// ComplexityByOS OS complexity scores, one entry per score level (0-4). Score 1 indicates the least complex OS to migrate; score 0 indicates an OS that could not be classified. All five score levels are always present.
+	ComplexityByOS []ComplexityOSScoreEntry `json:"complexityByOS"`
+
+	// ComplexityByOSName Per-OS-name complexity breakdown. One entry per distinct OS name found in the cluster's inventory. Each entry carries the OS name string, its numeric complexity score (0–4), and the number of VMs running it.
+	ComplexityByOSName []ComplexityOSNameEntry `json:"complexityByOSName"`
+
+	// DiskSizeRatings Static lookup table mapping each disk-size tier label to its numeric complexity score. The content is identical for every cluster and reflects the DiskSizeScores configuration in the complexity package.
+	DiskSizeRatings map[string]int `json:"diskSizeRatings"`
+
+	// OsRatings Per-OS-name score for every OS found in this cluster's inventory. Keys are the OS name strings exactly as they appear in vms.osInfo; values are the numeric complexity score assigned by ClassifyOS (0 = unclassified, 1-4 = increasing complexity). The map contains one entry per distinct OS name in the cluster, regardless of how many VMs run it.
+	OsRatings map[string]int `json:"osRatings"`
+}
+
+// MigrationEstimationRequest Request payload for calculating migration time estimation
+type MigrationEstimationRequest struct {
+	// ClusterId ID of the cluster to calculate migration estimation for
+	ClusterId string `json:"clusterId" validate:"required"`
+
+	// Day2Target What the operations team declares about the target cluster, to assess whether it is ready to operate the migrated VMs
+	Day2Target *Day2Target `json:"day2Target,omitempty"`
+
+	// Params Params overriding the ones derived from the inventory, keyed by param, e.g. transfer_rate_mbps or post_migration_engineers
+	Params *map[string]float64 `json:"params,omitempty"`
+
+	// PlanId Plan migrating the cluster. Its transfer rate and the params of the wave listing the cluster come between the calculator defaults of the organization and the params given.
+	PlanId *openapi_types.UUID `json:"planId,omitempty"`
+
+	// Priority Worker pool running the estimation, so UI recalculations never queue behind long runs:
+	//  * `interactive` - Recalculation a user waits for
+	//  * `batch` - Long-running estimation, e.g. a Monte Carlo run with thousands of trials
+	Priority *EstimationPriority `json:"priority,omitempty"`
+
+	// Record Return the recording of the run: the inventory, overrides, organization profile and calculators it depended on
+	Record *bool `json:"record,omitempty"`
+
+	// Schedule Work calendar the estimation is landed on, so each part of the breakdown gets the dates it would start and end on when started at the given time
+	Schedule *EstimationSchedule `json:"schedule,omitempty"`
+}
+
+// MigrationEstimationResponse Migration time estimation results
+type MigrationEstimationResponse struct {
+	// Alternatives Estimations of alternative approaches to a part of the breakdown, e.g. a seeded transfer by shipping an appliance instead of the network transfer. They are not part of the total duration.
+	Alternatives *map[string]EstimationDetail `json:"alternatives,omitempty"`
+
+	// Annotations Supplied params the estimation did not use as given, e.g. a negative transfer rate ignored for the default, a rate capped by the link or a misspelled param no calculator reads.
+	Annotations *[]EstimationAnnotation `json:"annotations,omitempty"`
+
+	// Benchmark Outcome of the migration programs of similar environments, contributed anonymously by the organizations opting in. Only returned once enough programs were contributed.
+	Benchmark *EstimationBenchmark `json:"benchmark,omitempty"`
+
+	// Breakdown Breakdown of estimation by calculator. The buffers of the contingency policy of the organization are line items of their own, e.g. "Contingency (change management)".
+	Breakdown map[string]EstimationDetail `json:"breakdown"`
+
+	// Cost Cost of the work, in the currency of the rates given as params: engineer_hourly_rate prices the effort as labor, egress_cost_per_gb and temp_storage_cost_per_gb_day price the transfers. The costs of a planned estimation are converted into the currency of the plan. Only set when priced.
+	Cost *EstimationCost `json:"cost,omitempty"`
+
+	// Currency Currency of the costs, the one of the plan when the estimation is planned and the plan sets one, or else the one of the cost params of the plan
+	Currency *string `json:"currency,omitempty"`
+
+	// Day2Readiness Day-2 gaps of the target declared in the request and the work to close them, so the VMs are not migrated onto a platform nobody can operate. Not part of the total duration.
+	Day2Readiness *Day2Readiness `json:"day2Readiness,omitempty"`
+
+	// ExchangeRate Exchange rate a report converted its costs with, for auditability
+	ExchangeRate *ExchangeRate `json:"exchangeRate,omitempty"`
+
+	// ParamLineage How each param was resolved: the layer whose value won and the values of every layer setting it, e.g. the 620 Mbps built-in transfer rate replaced by the one of the plan.
+	ParamLineage *[]ParamLineage `json:"paramLineage,omitempty"`
+
+	// Recording Everything the estimation depended on, only returned when requested. Saved to a file, it is replayed with `planner replay` to tell why an estimate changed.
+	Recording *map[string]interface{} `json:"recording,omitempty"`
+
+	// RunId ID of the estimation kept, to compare it with the later ones of the cluster
+	RunId *openapi_types.UUID `json:"runId,omitempty"`
+
+	// TotalDuration Total estimated migration duration (formatted as duration string, e.g., "2h30m")
+	TotalDuration string `json:"totalDuration"`
+
+	// Warnings Params that look implausible for the environment, e.g. a transfer rate above the NIC speeds of the source hosts. The estimation is computed regardless.
+	Warnings *[]EstimationWarning `json:"warnings,omitempty"`
+}
+
+// MigrationIssue defines model for MigrationIssue.
+type MigrationIssue struct {
+	Assessment string  `json:"assessment"`
+	Count      int     `json:"count"`
+	Id         *string `json:"id,omitempty"`
+	Label      string  `json:"label"`
+}
+
+// MigrationIssues defines model for MigrationIssues.
+type MigrationIssues = []MigrationIssue
+
+// MilestoneImpact Effect of a milestone slip alone on the program
+type MilestoneImpact struct {
+	// Delay How much later the program ends because of the slip, zero when absorbed by slack
+	Delay string `json:"delay"`
+
+	// From Planned date of the milestone
+	From      time.Time `json:"from"`
+	Milestone string    `json:"milestone"`
+
+	// To Slipped date of the milestone
+	To time.Time `json:"to"`
+
+	// Waves Waves gated by the milestone
+	Waves []string `json:"waves"`
+}
+
+// MilestoneSlip Moves a build-out milestone to another date
+type MilestoneSlip struct {
+	Date      time.Time `json:"date"`
+	Milestone string    `json:"milestone"`
+}
+
+// NamespaceDesign Target namespaces proposed for the VMs of a plan
+type NamespaceDesign struct {
+	// Issues Names to fix in the mapping before the namespaces are exported
+	Issues []NamespaceIssue `json:"issues"`
+
+	// Mapping Maps the VMs the waves list to target OpenShift namespaces
+	Mapping PlanNamespaceMapping `json:"mapping"`
+
+	// Namespaces Namespaces sorted by name
+	Namespaces []PlanNamespace `json:"namespaces"`
+
+	// Unmapped IDs of the VMs the strategy names no namespace for
+	Unmapped []string `json:"unmapped"`
+}
+
+// NamespaceIssue defines model for NamespaceIssue.
+type NamespaceIssue struct {
+	// Kind What is wrong with the namespace:
+	//  * `invalid-name` - Kubernetes rejects or reserves the name
+	//  * `collision` - The name is proposed for several sources
+	Kind      NamespaceIssueKind `json:"kind"`
+	Message   string             `json:"message"`
+	Namespace string             `json:"namespace"`
+	Sources   *[]string          `json:"sources,omitempty"`
+}
+
+// NamespaceIssueKind What is wrong with the namespace:
+//   - `invalid-name` - Kubernetes rejects or reserves the name
+//   - `collision` - The name is proposed for several sources
+type NamespaceIssueKind string
+
+// NamespaceStrategy Source structure the target namespaces of the VMs are proposed from:
+//   - `folder` - The leading folders of the VMs, e.g. "finance" for "Finance/Payroll"
+//   - `tag` - The owner or cost-center tag of the VMs
+//   - `application` - The application of the VMs
+type NamespaceStrategy string
+
+// Network defines model for Network.
+type Network struct {
+	Dvswitch *string     `json:"dvswitch,omitempty"`
+	Name     string      `json:"name"`
+	Type     NetworkType `json:"type"`
+	VlanId   *string     `json:"vlanId,omitempty"`
+	VmsCount *int        `json:"vmsCount,omitempty"`
+}
+
+// NetworkType defines model for Network.Type.
+type NetworkType string
+
+// ParamLayer Source of a param value, from the highest precedence to the lowest: the request, the wave of the plan, the plan, the calculator defaults of the organization, the inventory and the built-in constants
+type ParamLayer string
+
+// ParamLayerValue Value a layer sets a param to
+type ParamLayerValue struct {
+	// Layer Source of a param value, from the highest precedence to the lowest: the request, the wave of the plan, the plan, the calculator defaults of the organization, the inventory and the built-in constants
+	Layer ParamLayer `json:"layer"`
+
+	// Value Value of the param, a number or a map of numbers
+	Value interface{} `json:"value"`
+}
+
+// ParamLineage Resolution of the effective value of a param
+type ParamLineage struct {
+	// Chain Values of the layers setting the param, from the highest precedence to the lowest
+	Chain []ParamLayerValue `json:"chain"`
+
+	// Layer Source of a param value, from the highest precedence to the lowest: the request, the wave of the plan, the plan, the calculator defaults of the organization, the inventory and the built-in constants
+	Layer ParamLayer `json:"layer"`
+	Param string     `json:"param"`
+
+	// Value Effective value of the param
+	Value interface{} `json:"value"`
+}
+
+// Plan defines model for Plan.
+type Plan struct {
+	// Approvals Recorded sign-offs of the gates
+	Approvals       *[]PlanApproval          `json:"approvals,omitempty"`
+	Calendars       *map[string]PoolCalendar `json:"calendars,omitempty"`
+	CapacityChanges *[]CapacityChange        `json:"capacityChanges,omitempty"`
+
+	// ContentDigest SHA-256 of the canonical JSON of the plan, as "sha256:<hex>". Plans with the same digest have the same content.
+	ContentDigest *string `json:"contentDigest,omitempty"`
+
+	// Contingency Contingency rules padding each wave of the plan, and the minimum buffer they add up to
+	Contingency *PlanContingency `json:"contingency,omitempty"`
+	CreatedAt   time.Time        `json:"createdAt"`
+
+	// Currency ISO 4217 code of the currency costs are displayed in
+	Currency *string `json:"currency,omitempty"`
+
+	// Display How the durations and dates of a plan are reported. The estimations are computed precisely and only rounded when reported; durations are kept as computed when omitted.
+	Display       *PlanDisplayPolicy  `json:"display,omitempty"`
+	ExchangeRates *[]PlanExchangeRate `json:"exchangeRates,omitempty"`
+
+	// Gates Sign-offs required between boundaries of the waves, holding the progress of the next one
+	Gates *[]PlanGate `json:"gates,omitempty"`
+
+	// Id Derived from the organization, the user and the content digest, so re-submitting the same plan yields the same ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Kpis KPI targets of a migration program. Omitted targets are not tracked.
+	Kpis *PlanKPIs `json:"kpis,omitempty"`
+
+	// LearningCurve Lowers the effort of the waves following the pilot as the team gets faster: each time the number of waves migrated doubles, a wave takes `rate` times the effort of the pilot, no lower than `floor` times its own effort.
+	LearningCurve *PlanLearningCurve `json:"learningCurve,omitempty"`
+
+	// Milestones Dated milestones of the target build-out the waves and targets depend on
+	Milestones *[]PlanMilestone `json:"milestones,omitempty"`
+
+	// Mode How the phases of consecutive waves are laid out:
+	//  * `serial` - A wave starts once the previous one is done
+	//  * `parallel` - All waves start together
+	//  * `pipelined` - Serial, except for the declared overlaps
+	Mode PlanMode `json:"mode"`
+	Name string   `json:"name"`
+
+	// Namespaces Maps the VMs the waves list to target OpenShift namespaces
+	Namespaces *PlanNamespaceMapping `json:"namespaces,omitempty"`
+	Overlaps   *[]PlanOverlap        `json:"overlaps,omitempty"`
+
+	// ParamsCurrency ISO 4217 code of the currency of the cost params of the plan
+	ParamsCurrency *string `json:"paramsCurrency,omitempty"`
+
+	// Placements Target of each source cluster or datacenter, validated against the target capacity. A wave does not start before the targets of its clusters are built.
+	Placements *[]PlanPlacement `json:"placements,omitempty"`
+	Pools      *map[string]int  `json:"pools,omitempty"`
+
+	// Schedule Dates imported from project management tools, overriding the computed ones
+	Schedule *[]ScheduledPhase   `json:"schedule,omitempty"`
+	Shifts   *map[string][]Shift `json:"shifts,omitempty"`
+	Sources  *[]PlanSource       `json:"sources,omitempty"`
+	Start    time.Time           `json:"start"`
+
+	// Targets Target clusters or availability zones the VMs land on
+	Targets          *[]PlanTarget `json:"targets,omitempty"`
+	TransferRateMbps *float64      `json:"transferRateMbps,omitempty"`
+	Waves            []PlanWave    `json:"waves"`
+}
+
+// PlanApproval Recorded sign-off of a gate
+type PlanApproval struct {
+	ApprovedAt time.Time `json:"approvedAt"`
+	ApprovedBy string    `json:"approvedBy"`
+	Comment    *string   `json:"comment,omitempty"`
+	Gate       string    `json:"gate"`
+	Role       string    `json:"role"`
+}
+
+// PlanBlackout Days no wave of the site is cut over, in the local time of the site
+type PlanBlackout struct {
+	// End Last day of the blackout
+	End  openapi_types.Date `json:"end"`
+	Name string             `json:"name"`
+
+	// Start First day of the blackout
+	Start openapi_types.Date `json:"start"`
+}
+
+// PlanBoundary End of a phase of a wave, or of the whole wave when the phase is omitted
+type PlanBoundary struct {
+	Phase *string `json:"phase,omitempty"`
+	Wave  string  `json:"wave"`
+}
+
+// PlanContingency Contingency rules padding each wave of the plan, and the minimum buffer they add up to
+type PlanContingency struct {
+	// MinimumPercent Share of the whole duration the buffers add up to at least
+	MinimumPercent *float64           `json:"minimumPercent,omitempty"`
+	Rules          *[]ContingencyRule `json:"rules,omitempty"`
+}
+
+// PlanDisplayPolicy How the durations and dates of a plan are reported. The estimations are computed precisely and only rounded when reported; durations are kept as computed when omitted.
+type PlanDisplayPolicy struct {
+	// AbsorbSubDay Merge the phases shorter than a day into the previous phase of their wave, or the next one for the first phase, instead of reporting them on their own
+	AbsorbSubDay *bool `json:"absorbSubDay,omitempty"`
+
+	// Granularity Unit the durations and dates of a plan are rounded to:
+	//  * `hour` - Reported as "1d 4h"
+	//  * `half-day` - Reported as "2.5d"
+	//  * `day` - Reported as "3d"
+	Granularity *DisplayGranularity `json:"granularity,omitempty"`
+
+	// MinPhase Shortest duration a phase is reported with (formatted as duration string, e.g., "4h")
+	MinPhase *string `json:"minPhase,omitempty"`
+}
+
+// PlanExchangeRate Rate converting the costs in a currency into the currency of the plan
+type PlanExchangeRate struct {
+	// AsOf Date the rate was taken on
+	AsOf time.Time `json:"asOf"`
+	From string    `json:"from"`
+
+	// Rate Amount in the currency of the plan of one unit of the currency converted
+	Rate float64 `json:"rate"`
+}
+
+// PlanForm defines model for PlanForm.
+type PlanForm struct {
+	// Calendars Working calendar of the team behind each resource pool
+	Calendars *map[string]PoolCalendar `json:"calendars,omitempty"`
+
+	// CapacityChanges Known staffing changes of the resource pools over the program
+	CapacityChanges *[]CapacityChange `json:"capacityChanges,omitempty"`
+
+	// ContentDigest Content digest of a plan exported before. The plan is rejected when its content does not match, e.g. when it was edited since the export.
+	ContentDigest *string `json:"contentDigest,omitempty"`
+
+	// Contingency Contingency rules padding each wave of the plan, and the minimum buffer they add up to
+	Contingency *PlanContingency `json:"contingency,omitempty"`
+
+	// Currency ISO 4217 code of the currency costs are displayed in
+	Currency *string `json:"currency,omitempty"`
+
+	// Display How the durations and dates of a plan are reported. The estimations are computed precisely and only rounded when reported; durations are kept as computed when omitted.
+	Display *PlanDisplayPolicy `json:"display,omitempty"`
+
+	// ExchangeRates Rates converting the costs into the currency of the plan, e.g. from the currency of a rate card. They take precedence over the rates of the exchange rate service of the server.
+	ExchangeRates *[]PlanExchangeRate `json:"exchangeRates,omitempty"`
+
+	// Gates Sign-offs required between boundaries of the waves, holding the progress of the next one
+	Gates *[]PlanGate `json:"gates,omitempty"`
+
+	// Kpis KPI targets of a migration program. Omitted targets are not tracked.
+	Kpis *PlanKPIs `json:"kpis,omitempty"`
+
+	// LearningCurve Lowers the effort of the waves following the pilot as the team gets faster: each time the number of waves migrated doubles, a wave takes `rate` times the effort of the pilot, no lower than `floor` times its own effort.
+	LearningCurve *PlanLearningCurve `json:"learningCurve,omitempty"`
+
+	// Milestones Dated milestones of the target build-out the waves and targets depend on
+	Milestones *[]PlanMilestone `json:"milestones,omitempty"`
+
+	// Mode How the phases of consecutive waves are laid out:
+	//  * `serial` - A wave starts once the previous one is done
+	//  * `parallel` - All waves start together
+	//  * `pipelined` - Serial, except for the declared overlaps
+	Mode *PlanMode `json:"mode,omitempty"`
+	Name string    `json:"name" validate:"required"`
+
+	// Namespaces Maps the VMs the waves list to target OpenShift namespaces
+	Namespaces *PlanNamespaceMapping `json:"namespaces,omitempty"`
+	Overlaps   *[]PlanOverlap        `json:"overlaps,omitempty"`
+
+	// ParamsCurrency ISO 4217 code of the currency of the cost params of the plan, e.g. engineer_hourly_rate. The costs of the planned estimations are converted from it into the currency of the plan. The currency of the plan when omitted.
+	ParamsCurrency *string `json:"paramsCurrency,omitempty"`
+
+	// Placements Target of each source cluster or datacenter, validated against the target capacity. A wave does not start before the targets of its clusters are built.
+	Placements *[]PlanPlacement `json:"placements,omitempty"`
+
+	// Pools Capacity of the resource pools shared by the waves
+	Pools *map[string]int `json:"pools,omitempty"`
+
+	// Shifts Shifts of the teams working each resource pool in turn, e.g. a follow-the-sun factory. They take precedence over the pool calendar.
+	Shifts *map[string][]Shift `json:"shifts,omitempty"`
+
+	// Sources Environments of a program spanning several inventory sources, e.g. vCenters or RHV instances. Each wave then migrates one of them.
+	Sources *[]PlanSource `json:"sources,omitempty"`
+
+	// Start Calendar date the first wave starts
+	Start time.Time `json:"start"`
+
+	// Targets Target clusters or availability zones the VMs land on
+	Targets *[]PlanTarget `json:"targets,omitempty"`
+
+	// TransferRateMbps Bandwidth available to the migration, used to compare the plan with similar environments
+	TransferRateMbps *float64   `json:"transferRateMbps,omitempty"`
+	Waves            []PlanWave `json:"waves"`
+}
+
+// PlanGate Sign-off of a role required between two boundaries, e.g. security approving the validation of wave 1 before the transfer of wave 2. The progress of the wave held can not be recorded until approved.
+type PlanGate struct {
+	// After End of a phase of a wave, or of the whole wave when the phase is omitted
+	After PlanBoundary `json:"after"`
+
+	// Approvers Users holding the role, any user may sign off when omitted
+	Approvers *[]string `json:"approvers,omitempty"`
+
+	// Before End of a phase of a wave, or of the whole wave when the phase is omitted
+	Before PlanBoundary `json:"before"`
+	Name   string       `json:"name"`
+
+	// Role Role signing off
+	Role string `json:"role"`
+}
+
+// PlanKPIs KPI targets of a migration program. Omitted targets are not tracked.
+type PlanKPIs struct {
+	// MaxP1IncidentsPerWave Maximum number of P1 incidents raised by a wave
+	MaxP1IncidentsPerWave *int `json:"maxP1IncidentsPerWave,omitempty"`
+
+	// MaxRollbackRatePercent Maximum percentage of the VMs cut over that are rolled back
+	MaxRollbackRatePercent *float64 `json:"maxRollbackRatePercent,omitempty"`
+
+	// MinVmsPerWeek Minimum number of VMs migrated per week over the program
+	MinVmsPerWeek *float64 `json:"minVmsPerWeek,omitempty"`
+}
+
+// PlanLearningCurve Lowers the effort of the waves following the pilot as the team gets faster: each time the number of waves migrated doubles, a wave takes `rate` times the effort of the pilot, no lower than `floor` times its own effort.
+type PlanLearningCurve struct {
+	// Floor Lowest share of their effort the waves are lowered to
+	Floor *float64 `json:"floor,omitempty"`
+
+	// Pilot Wave migrated first with conservative efforts, the first wave when omitted
+	Pilot *string `json:"pilot,omitempty"`
+
+	// PilotFactors Actual effort of each phase of the pilot over the planned one, measured from the VM actuals of the pilot. They scale the phase in the waves after the pilot on top of the rate.
+	PilotFactors *map[string]float64 `json:"pilotFactors,omitempty"`
+
+	// Rate Share of the effort left each time the number of waves migrated doubles, 1 for no learning
+	Rate float64 `json:"rate"`
+}
+
+// PlanList defines model for PlanList.
+type PlanList = []Plan
+
+// PlanMaintenanceWindow Recurring cutover window in the local time of the site
+type PlanMaintenanceWindow struct {
+	// Days Weekdays the window opens on, every day when omitted
+	Days *[]string `json:"days,omitempty"`
+
+	// End Local time of day the window closes; before the start when the window runs past midnight
+	End  string `json:"end"`
+	Name string `json:"name"`
+
+	// Start Local time of day the window opens
+	Start string `json:"start"`
+}
+
+// PlanMilestone Dated step of the target build-out. The waves depending on it do not start before its date.
+type PlanMilestone struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+	Note *string   `json:"note,omitempty"`
+}
+
+// PlanMode How the phases of consecutive waves are laid out:
+//   - `serial` - A wave starts once the previous one is done
+//   - `parallel` - All waves start together
+//   - `pipelined` - Serial, except for the declared overlaps
+type PlanMode string
+
+// PlanNamespace defines model for PlanNamespace.
+type PlanNamespace struct {
+	Name string `json:"name"`
+
+	// Sources Folder paths, tag values or applications whose VMs land in the namespace
+	Sources []string `json:"sources"`
+
+	// Vms IDs of the VMs landing in the namespace
+	Vms []string `json:"vms"`
+
+	// Waves Waves migrating VMs to the namespace
+	Waves []string `json:"waves"`
+}
+
+// PlanNamespaceMapping Maps the VMs the waves list to target OpenShift namespaces
+type PlanNamespaceMapping struct {
+	// Default Namespace of the VMs the strategy names none for, left unmapped when empty
+	Default *string `json:"default,omitempty"`
+
+	// FolderDepth Number of leading folders naming a namespace for the folder strategy, 1 when zero
+	FolderDepth *int `json:"folderDepth,omitempty"`
+
+	// Overrides Namespace of the VMs of a folder path, tag value or application, in place of the proposed name
+	Overrides *map[string]string `json:"overrides,omitempty"`
+
+	// Prefix Prefix of the proposed names, e.g. "acme-"
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Strategy Source structure the target namespaces of the VMs are proposed from:
+	//  * `folder` - The leading folders of the VMs, e.g. "finance" for "Finance/Payroll"
+	//  * `tag` - The owner or cost-center tag of the VMs
+	//  * `application` - The application of the VMs
+	Strategy NamespaceStrategy `json:"strategy"`
+
+	// Tag Tag the tag strategy reads, "owner" or "cost-center"
+	Tag *string `json:"tag,omitempty"`
+}
+
+// PlanOverlap Allows phase `next` of a wave to start while the previous wave runs phase `current`
+type PlanOverlap struct {
+	Current string `json:"current"`
+	Next    string `json:"next"`
+}
+
+// PlanPlacement Maps a source cluster or datacenter to the target its VMs land on
+type PlanPlacement struct {
+	// Cluster Source cluster or datacenter
+	Cluster string `json:"cluster"`
+
+	// Compliance Compliance regimes the VMs of the cluster are subject to, the target must comply with all of them
+	Compliance *[]ComplianceLabel `json:"compliance,omitempty"`
+
+	// CpuCores CPU cores requested by the VMs of the cluster
+	CpuCores *int `json:"cpuCores,omitempty"`
+
+	// MemoryGb Memory requested by the VMs of the cluster in GB
+	MemoryGb *int `json:"memoryGb,omitempty"`
+
+	// Source Plan source of the cluster in a multi-source plan
+	Source *string `json:"source,omitempty"`
+
+	// Target Name of the plan target
+	Target string `json:"target"`
+}
+
+// PlanProgress defines model for PlanProgress.
+type PlanProgress struct {
+	// Checklists Completion of the VM validation checklists per wave
+	Checklists *[]WaveChecklistProgress `json:"checklists,omitempty"`
+	Kpis       []KPIStatus              `json:"kpis"`
+	Waves      []WaveProgress           `json:"waves"`
+}
+
+// PlanShare defines model for PlanShare.
+type PlanShare struct {
+	CreatedAt    time.Time          `json:"createdAt"`
+	CreatedBy    string             `json:"createdBy"`
+	ExpiresAt    time.Time          `json:"expiresAt"`
+	Id           openapi_types.UUID `json:"id"`
+	LastViewedAt *time.Time         `json:"lastViewedAt,omitempty"`
+	RevokedAt    *time.Time         `json:"revokedAt,omitempty"`
+
+	// Url Public URL of the report. Only returned when the link is created.
+	Url   *string `json:"url,omitempty"`
+	Views int     `json:"views"`
+}
+
+// PlanShareForm defines model for PlanShareForm.
+type PlanShareForm struct {
+	// ExpiresInHours Hours the link stays valid, 168 (one week) when not set
+	ExpiresInHours *int `json:"expiresInHours,omitempty"`
+}
+
+// PlanShareList defines model for PlanShareList.
+type PlanShareList = []PlanShare
+
+// PlanSource Environment migrated by a multi-source plan, with its own parameters
+type PlanSource struct {
+	// Blackouts Days the waves of the environment may not be cut over
+	Blackouts *[]PlanBlackout `json:"blackouts,omitempty"`
+
+	// MaintenanceWindows Windows the waves of the environment may be cut over in
+	MaintenanceWindows *[]PlanMaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	Name               string                   `json:"name"`
+
+	// SourceId Source holding the inventory collected by the agent of the environment
+	SourceId *openapi_types.UUID `json:"sourceId,omitempty"`
+
+	// TimeZone IANA time zone of the datacenter of the environment, UTC when omitted. The maintenance windows and blackouts are given in its local time, daylight saving time included.
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// TransferRateMbps Bandwidth of the link between the environment and the target, the plan one when omitted
+	TransferRateMbps *float64 `json:"transferRateMbps,omitempty"`
+}
+
+// PlanStep defines model for PlanStep.
+type PlanStep struct {
+	// Effort Working time of the phase (formatted as duration string)
+	Effort string `json:"effort"`
+
+	// LeadTime Calendar time that has to pass regardless of effort (formatted as duration string)
+	LeadTime *string `json:"leadTime,omitempty"`
+	Phase    string  `json:"phase"`
+
+	// Pool Resource pool used by the phase
+	Pool *string `json:"pool,omitempty"`
+
+	// Units Units of the resource pool held by the phase
+	Units *int `json:"units,omitempty"`
+
+	// WorkHoursPerDay Hours per day spent on the effort, round the clock when omitted
+	WorkHoursPerDay *float64 `json:"workHoursPerDay,omitempty"`
+}
+
+// PlanTarget Target cluster or availability zone of a plan
+type PlanTarget struct {
+	// Certifications Compliance regimes the target is audited for
+	Certifications *[]ComplianceLabel `json:"certifications,omitempty"`
+
+	// CpuCores CPU cores available on the target, unconstrained when omitted
+	CpuCores *int `json:"cpuCores,omitempty"`
+
+	// MemoryGb Memory available on the target in GB, unconstrained when omitted
+	MemoryGb *int `json:"memoryGb,omitempty"`
+
+	// Milestones Build-out milestones the target waits for, e.g. a storage expansion
+	Milestones *[]string `json:"milestones,omitempty"`
+	Name       string    `json:"name"`
+
+	// Ready Calendar date the target is built, available from the plan start when omitted
+	Ready *time.Time `json:"ready,omitempty"`
+
+	// Residency Jurisdiction the data of the target stays in, EU for the European Union
+	Residency *string `json:"residency,omitempty"`
+
+	// Zone Availability zone of the target
+	Zone *string `json:"zone,omitempty"`
+}
+
+// PlanWave defines model for PlanWave.
+type PlanWave struct {
+	// Clusters Source clusters or datacenters whose VMs the wave migrates
+	Clusters *[]string `json:"clusters,omitempty"`
+
+	// DiskGb Disk capacity in GB the wave migrates
+	DiskGb *float64 `json:"diskGb,omitempty"`
+
+	// Folders Source folders or vApps whose VMs the wave migrates, e.g. "Finance/Payroll"
+	Folders *[]string `json:"folders,omitempty"`
+
+	// Members VMs the wave migrates when known, to decompose the effort of the plan by VM
+	Members *[]PlanWaveVM `json:"members,omitempty"`
+
+	// Milestones Build-out milestones the wave waits for, on top of the ones of its targets
+	Milestones *[]string `json:"milestones,omitempty"`
+	Name       string    `json:"name"`
+
+	// Source Name of the plan source migrated by the wave, required when the plan has sources
+	Source *string    `json:"source,omitempty"`
+	Steps  []PlanStep `json:"steps"`
+
+	// Vms Number of VMs the wave migrates, used to forecast the volume migrated over time
+	Vms *int `json:"vms,omitempty"`
+}
+
+// PlanWaveVM defines model for PlanWaveVM.
+type PlanWaveVM struct {
+	// Application Application the VM belongs to, whose team its effort is charged to
+	Application *string `json:"application,omitempty"`
+
+	// CostCenter Cost center tagged on the VM, its effort is charged back to
+	CostCenter *string  `json:"costCenter,omitempty"`
+	DiskGb     *float64 `json:"diskGb,omitempty"`
+
+	// Folder Path of the VM in the folder and vApp hierarchy of its source, within the folders of its wave when it has some
+	Folder *string `json:"folder,omitempty"`
+	Id     string  `json:"id"`
+	Name   *string `json:"name,omitempty"`
+
+	// Owner Application owner of the VM, its effort is charged back to
+	Owner *string `json:"owner,omitempty"`
+}
+
+// PlanWhatIf defines model for PlanWhatIf.
+type PlanWhatIf struct {
+	// BaselineEnd End of the program as planned
+	BaselineEnd time.Time `json:"baselineEnd"`
+
+	// Delay Difference between the two end dates as a duration, negative when the program ends earlier
+	Delay string `json:"delay"`
+
+	// End End of the program with the capacity changes and milestone slips
+	End time.Time `json:"end"`
+
+	// Gantt Gantt chart of a migration plan
+	Gantt            Gantt              `json:"gantt"`
+	MilestoneImpacts *[]MilestoneImpact `json:"milestoneImpacts,omitempty"`
+}
+
+// PlanWhatIfForm defines model for PlanWhatIfForm.
+type PlanWhatIfForm struct {
+	CapacityChanges []CapacityChange `json:"capacityChanges"`
+	MilestoneSlips  *[]MilestoneSlip `json:"milestoneSlips,omitempty"`
+}
+
+// PoolCalendar Working calendar of a team. Weekends, the public holidays of the region, the company holidays and the change freezes are days off; manual phases of the pool do not progress on them.
+type PoolCalendar struct {
+	// Freezes Change freezes, during which no work is done
+	Freezes *[]ChangeFreeze `json:"freezes,omitempty"`
+
+	// Holidays Company holidays, usually imported from an ICS file
+	Holidays *[]Holiday `json:"holidays,omitempty"`
+
+	// Region Preset of public holidays:
+	//  * `US` - US federal holidays, observed on the closest weekday
+	//  * `DE` - Public holidays of all German states
+	//  * `IN` - National holidays of India
+	//  * `JP` - National holidays of Japan, with substitute holidays
+	Region *HolidayRegion `json:"region,omitempty"`
 }
 
-// InventoryData defines model for InventoryData.
-type InventoryData struct {
-	Infra   Infra    `json:"infra"`
-	Vcenter *VCenter `json:"vcenter,omitempty"`
-	Vms     VMs      `json:"vms"`
+// PoolCoverage defines model for PoolCoverage.
+type PoolCoverage struct {
+	Gaps   []CoverageGap `json:"gaps"`
+	Pool   string        `json:"pool"`
+	Shifts []string      `json:"shifts"`
 }
 
-// InventoryTotals Inventory totals for the cluster
-type InventoryTotals struct {
-	// TotalCPU Total CPU cores across all VMs in the cluster
-	TotalCPU int `json:"totalCPU"`
+// PortfolioCost defines model for PortfolioCost.
+type PortfolioCost struct {
+	// CashFlow Total spread over the budget periods the priced phases span, each phase evenly over its calendar span. Periods without cost between the first and the last are listed.
+	CashFlow []BudgetPeriod `json:"cashFlow"`
 
-	// TotalMemory Total memory (GB) across all VMs in the cluster
-	TotalMemory int `json:"totalMemory"`
+	// Currency Currency of the plans, the one of the rate card when the plans set none
+	Currency string `json:"currency"`
 
-	// TotalVMs Total number of VMs in the cluster
-	TotalVMs int `json:"totalVMs"`
+	// ExchangeRates Rates the costs of the plans were converted with, sorted by source currency
+	ExchangeRates *[]ExchangeRate `json:"exchangeRates,omitempty"`
+
+	// Granularity Length of the budget periods of a cash flow, quarter when omitted:
+	//  * `month` - Calendar months
+	//  * `quarter` - Quarters of the fiscal year
+	Granularity CashFlowGranularity `json:"granularity"`
+
+	// RateCard Name and version of the rate card, e.g. "partner v2"
+	RateCard string  `json:"rateCard"`
+	Total    float64 `json:"total"`
+
+	// Unpriced Phases left out of the total, e.g. worked by a role the rate card has no rate for
+	Unpriced *[]string `json:"unpriced,omitempty"`
 }
 
-// Ipv4Config defines model for Ipv4Config.
-type Ipv4Config struct {
-	DefaultGateway string `json:"defaultGateway" validate:"required,ip4_addr,max=15"`
-	Dns            string `json:"dns" validate:"required,ip4_addr,max=15"`
-	IpAddress      string `json:"ipAddress" validate:"required,ip4_addr,max=15"`
-	SubnetMask     string `json:"subnetMask" validate:"required,subnet_mask,max=2"`
+// PortfolioPlan defines model for PortfolioPlan.
+type PortfolioPlan struct {
+	CompletedWaves int `json:"completedWaves"`
+
+	// Cost Labor cost of the plan in the currency of the report
+	Cost *float64 `json:"cost,omitempty"`
+
+	// Currency Currency of the plan, the one of the rate card when the plan sets none
+	Currency *string `json:"currency,omitempty"`
+
+	// Effort Effort of the plan (formatted as duration string, e.g., "80h0m0s")
+	Effort string    `json:"effort"`
+	End    time.Time `json:"end"`
+
+	// ExchangeRates Rates the cost of the plan was converted into its currency with
+	ExchangeRates *[]ExchangeRate    `json:"exchangeRates,omitempty"`
+	Id            openapi_types.UUID `json:"id"`
+	Name          string             `json:"name"`
+	P1Incidents   int                `json:"p1Incidents"`
+
+	// Risks Number of risks raised by the plan
+	Risks     int       `json:"risks"`
+	Rollbacks int       `json:"rollbacks"`
+	Start     time.Time `json:"start"`
+
+	// Status Status of the plan:
+	//  * `not-started` - The plan starts later and no progress was recorded
+	//  * `in-progress` - The plan started or progress was recorded against some of its waves
+	//  * `completed` - Progress was recorded against all its waves
+	Status      PortfolioPlanStatus `json:"status"`
+	VmsMigrated int                 `json:"vmsMigrated"`
+	Waves       int                 `json:"waves"`
 }
 
-// Job Background job for async assessment creation
-type Job struct {
-	// AssessmentId Assessment ID when job completed successfully
-	AssessmentId *openapi_types.UUID `json:"assessmentId,omitempty"`
+// PortfolioPlanStatus Status of the plan:
+//   - `not-started` - The plan starts later and no progress was recorded
+//   - `in-progress` - The plan started or progress was recorded against some of its waves
+//   - `completed` - Progress was recorded against all its waves
+type PortfolioPlanStatus string
 
-	// Error Error message if job failed
-	Error *string `json:"error,omitempty"`
+// PortfolioReport defines model for PortfolioReport.
+type PortfolioReport struct {
+	Cost *PortfolioCost `json:"cost,omitempty"`
 
-	// Id Job ID
-	Id int64 `json:"id"`
+	// End End of the last plan of the portfolio
+	End         time.Time `json:"end"`
+	GeneratedAt time.Time `json:"generatedAt"`
 
-	// Status Job status:
-	//  * `pending` - Job is queued
-	//  * `parsing` - Parsing RVTools Excel file
-	//  * `validating` - Running OPA VM validations
-	//  * `completed` - Assessment created successfully
-	//  * `failed` - Job failed with error
-	//  * `cancelled` - Job was cancelled
-	Status JobStatus `json:"status"`
+	// Plans Plans of the portfolio by start date
+	Plans []PortfolioPlan `json:"plans"`
+	Risks []PortfolioRisk `json:"risks"`
+
+	// Start Start of the first plan of the portfolio
+	Start  time.Time       `json:"start"`
+	Totals PortfolioTotals `json:"totals"`
 }
 
-// JobStatus Job status:
-//   - `pending` - Job is queued
-//   - `parsing` - Parsing RVTools Excel file
-//   - `validating` - Running OPA VM validations
-//   - `completed` - Assessment created successfully
-//   - `failed` - Job failed with error
-//   - `cancelled` - Job was cancelled
-type JobStatus string
+// PortfolioReportRequest defines model for PortfolioReportRequest.
+type PortfolioReportRequest struct {
+	// CashFlowGranularity Length of the budget periods of a cash flow, quarter when omitted:
+	//  * `month` - Calendar months
+	//  * `quarter` - Quarters of the fiscal year
+	CashFlowGranularity *CashFlowGranularity `json:"cashFlowGranularity,omitempty"`
 
-// Label defines model for Label.
-type Label struct {
-	Key   string `json:"key" validate:"required,label"`
-	Value string `json:"value" validate:"required,label"`
+	// FiscalYearStartMonth First month of the fiscal year the cost is bucketed by, 1 (January) when omitted
+	FiscalYearStartMonth *int `json:"fiscalYearStartMonth,omitempty"`
+
+	// PlanIds Plans of the organization to report on
+	PlanIds []openapi_types.UUID `json:"planIds"`
+
+	// RateCardId Rate card the effort of the plans is priced with. The steps of a resource pool are priced with the rate of the role named after the pool, the other steps with the rate of the `engineer` role. No cost is reported when omitted.
+	RateCardId *openapi_types.UUID `json:"rateCardId,omitempty"`
 }
 
-// MigrationComplexityRequest Request payload for calculating migration complexity estimation
-type MigrationComplexityRequest struct {
-	// ClusterId ID of the cluster to calculate complexity estimation for
-	ClusterId string `json:"clusterId" validate:"required"`
+// PortfolioRisk defines model for PortfolioRisk.
+type PortfolioRisk struct {
+	// Kind What raised the risk:
+	//  * `kpi-breach` - A KPI target of the plan is missed by the progress recorded so far
+	//  * `overdue` - A wave is due to be over and no progress was recorded against it
+	Kind    PortfolioRiskKind  `json:"kind"`
+	Message string             `json:"message"`
+	Plan    string             `json:"plan"`
+	PlanId  openapi_types.UUID `json:"planId"`
+
+	// Wave Wave the risk is about, omitted for the whole plan
+	Wave *string `json:"wave,omitempty"`
 }
 
-// MigrationComplexityResponse Migration complexity estimation results
-type MigrationComplexityResponse struct {
-	// ComplexityByDisk Disk-size complexity scores, one entry per score level (1-4). Score 1 is the least complex disk footprint; score 4 is the most complex. Scores correspond to provisioned disk size: 1 (<=10 TB), 2 (<=20 TB), 3 (<=50 TB), 4 (>50 TB). All four score levels are always present.
-	ComplexityByDisk []ComplexityDiskScoreEntry `json:"complexityByDisk"`
+// PortfolioRiskKind What raised the risk:
+//   - `kpi-breach` - A KPI target of the plan is missed by the progress recorded so far
+//   - `overdue` - A wave is due to be over and no progress was recorded against it
+type PortfolioRiskKind string
+
+// PortfolioTotals defines model for PortfolioTotals.
+type PortfolioTotals struct {
+	CompletedWaves int `json:"completedWaves"`
+
+	// Effort Effort of all the plans (formatted as duration string, e.g., "320h0m0s")
+	Effort      string `json:"effort"`
+	P1Incidents int    `json:"p1Incidents"`
+	Plans       int    `json:"plans"`
+	Rollbacks   int    `json:"rollbacks"`
+	VmsMigrated int    `json:"vmsMigrated"`
+	Waves       int    `json:"waves"`
+}
 
-	// ComplexityByOS OS complexity scores, one entry per score level (0-4). Score 1 indicates the least complex OS to migrate; score 0 indicates an OS that could not be classified. All five score levels are always present.
-	ComplexityByOS []ComplexityOSScoreEntry `json:"complexityByOS"`
+// ProgramForecast defines model for ProgramForecast.
+type ProgramForecast struct {
+	GeneratedAt time.Time          `json:"generatedAt"`
+	PlanId      openapi_types.UUID `json:"planId"`
+	PlanName    string             `json:"planName"`
+	Quarters    []ForecastQuarter  `json:"quarters"`
+	TotalDiskGb float64            `json:"totalDiskGb"`
+	TotalVms    int                `json:"totalVms"`
+
+	// Trials Number of simulation trials the bands are derived from
+	Trials int `json:"trials"`
+}
 
-	// ComplexityByOSName Per-OS-name complexity breakdown. One entry per distinct OS name found in the cluster's inventory. Each entry carries the OS name string, its numeric complexity score (0–4), and the number of VMs running it.
-	ComplexityByOSName []ComplexityOSNameEntry `json:"complexityByOSName"`
+// RateCard defines model for RateCard.
+type RateCard struct {
+	CreatedAt   time.Time          `json:"createdAt"`
+	Currency    string             `json:"currency"`
+	HourlyRates map[string]float64 `json:"hourlyRates"`
+	Id          openapi_types.UUID `json:"id"`
+	Name        string             `json:"name"`
+	Region      *string            `json:"region,omitempty"`
+	ValidFrom   time.Time          `json:"validFrom"`
+	ValidUntil  *time.Time         `json:"validUntil,omitempty"`
+	Version     int                `json:"version"`
+}
 
-	// DiskSizeRatings Static lookup table mapping each disk-size tier label to its numeric complexity score. The content is identical for every cluster and reflects the DiskSizeScores configuration in the complexity package.
-	DiskSizeRatings map[string]int `json:"diskSizeRatings"`
+// RateCardForm defines model for RateCardForm.
+type RateCardForm struct {
+	// Currency ISO 4217 code of the hourly rates
+	Currency string `json:"currency"`
 
-	// OsRatings Per-OS-name score for every OS found in this cluster's inventory. Keys are the OS name strings exactly as they appear in vms.osInfo; values are the numeric complexity score assigned by ClassifyOS (0 = unclassified, 1-4 = increasing complexity). The map contains one entry per distinct OS name in the cluster, regardless of how many VMs run it.
-	OsRatings map[string]int `json:"osRatings"`
+	// HourlyRates Hourly cost per role
+	HourlyRates map[string]float64 `json:"hourlyRates"`
+	Name        string             `json:"name" validate:"required"`
+	Region      *string            `json:"region,omitempty"`
+	ValidFrom   time.Time          `json:"validFrom"`
+
+	// ValidUntil End of the validity, exclusive. The card does not expire when omitted.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
 }
 
-// MigrationEstimationRequest Request payload for calculating migration time estimation
-type MigrationEstimationRequest struct {
-	// ClusterId ID of the cluster to calculate migration estimation for
-	ClusterId string `json:"clusterId" validate:"required"`
+// RateCardList defines model for RateCardList.
+type RateCardList = []RateCard
+
+// ScheduleDiscrepancy defines model for ScheduleDiscrepancy.
+type ScheduleDiscrepancy struct {
+	Kind    ScheduleDiscrepancyKind `json:"kind"`
+	Message string                  `json:"message"`
+	Phase   string                  `json:"phase"`
+	Wave    string                  `json:"wave"`
 }
 
-// MigrationEstimationResponse Migration time estimation results
-type MigrationEstimationResponse struct {
-	// Breakdown Breakdown of estimation by calculator
-	Breakdown map[string]EstimationDetail `json:"breakdown"`
+// ScheduleDiscrepancyKind defines model for ScheduleDiscrepancy.Kind.
+type ScheduleDiscrepancyKind string
 
-	// TotalDuration Total estimated migration duration (formatted as duration string, e.g., "2h30m")
-	TotalDuration string `json:"totalDuration"`
+// ScheduleImport defines model for ScheduleImport.
+type ScheduleImport struct {
+	Discrepancies []ScheduleDiscrepancy `json:"discrepancies"`
+	Plan          Plan                  `json:"plan"`
 }
 
-// MigrationIssue defines model for MigrationIssue.
-type MigrationIssue struct {
-	Assessment string  `json:"assessment"`
-	Count      int     `json:"count"`
-	Id         *string `json:"id,omitempty"`
-	Label      string  `json:"label"`
+// ScheduledPhase defines model for ScheduledPhase.
+type ScheduledPhase struct {
+	End   time.Time `json:"end"`
+	Phase string    `json:"phase"`
+	Start time.Time `json:"start"`
+	Wave  string    `json:"wave"`
 }
 
-// MigrationIssues defines model for MigrationIssues.
-type MigrationIssues = []MigrationIssue
+// Shift Working hours of a team in its time zone
+type Shift struct {
+	// Calendar Working calendar of a team. Weekends, the public holidays of the region, the company holidays and the change freezes are days off; manual phases of the pool do not progress on them.
+	Calendar *PoolCalendar `json:"calendar,omitempty"`
 
-// Network defines model for Network.
-type Network struct {
-	Dvswitch *string     `json:"dvswitch,omitempty"`
-	Name     string      `json:"name"`
-	Type     NetworkType `json:"type"`
-	VlanId   *string     `json:"vlanId,omitempty"`
-	VmsCount *int        `json:"vmsCount,omitempty"`
+	// Days Weekdays the shift starts on, Monday to Friday when omitted
+	Days *[]string `json:"days,omitempty"`
+
+	// End Local time of day the shift ends; before the start when the shift runs past midnight
+	End  string `json:"end"`
+	Name string `json:"name"`
+
+	// Start Local time of day the shift starts
+	Start string `json:"start"`
+
+	// TimeZone IANA time zone of the team, UTC when omitted
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
-// NetworkType defines model for Network.Type.
-type NetworkType string
+// ShiftWork defines model for ShiftWork.
+type ShiftWork struct {
+	Hours float64 `json:"hours"`
+	Shift string  `json:"shift"`
+}
 
 // SizingOverCommitRatio Over-commit ratios
 type SizingOverCommitRatio struct {
@@ -567,6 +2778,42 @@ type Status struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// TroubleshootingModel defines model for TroubleshootingModel.
+type TroubleshootingModel struct {
+	// AppTagMinutes Minutes added per application tag
+	AppTagMinutes *map[string]float64 `json:"appTagMinutes,omitempty"`
+
+	// Intercept Minutes of any VM
+	Intercept      float64 `json:"intercept"`
+	MinutesPerDisk float64 `json:"minutesPerDisk"`
+	Name           string  `json:"name"`
+
+	// OsMinutes Minutes added per operating system
+	OsMinutes *map[string]float64 `json:"osMinutes,omitempty"`
+
+	// SnapshotMinutes Minutes added for a VM with snapshots
+	SnapshotMinutes float64   `json:"snapshotMinutes"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+	UpdatedBy       string    `json:"updatedBy"`
+}
+
+// TroubleshootingModelForm Linear model of the troubleshooting time of a VM, in minutes. Operating systems and application tags without coefficient add nothing; predictions below zero count as zero.
+type TroubleshootingModelForm struct {
+	// AppTagMinutes Minutes added per application tag
+	AppTagMinutes *map[string]float64 `json:"appTagMinutes,omitempty"`
+
+	// Intercept Minutes of any VM
+	Intercept      float64 `json:"intercept"`
+	MinutesPerDisk float64 `json:"minutesPerDisk"`
+	Name           string  `json:"name"`
+
+	// OsMinutes Minutes added per operating system
+	OsMinutes *map[string]float64 `json:"osMinutes,omitempty"`
+
+	// SnapshotMinutes Minutes added for a VM with snapshots
+	SnapshotMinutes float64 `json:"snapshotMinutes"`
+}
+
 // UpdateInventory defines model for UpdateInventory.
 type UpdateInventory struct {
 	AgentId   openapi_types.UUID `json:"agentId"`
@@ -578,6 +2825,85 @@ type VCenter struct {
 	Id string `json:"id"`
 }
 
+// VMEffort Effort of a plan taken by one of its VMs
+type VMEffort struct {
+	// ByCategory Effort of the VM by kind of work (formatted as duration string), keyed by category
+	ByCategory map[string]string `json:"byCategory"`
+
+	// MedianRatio Effort of the VM over the median effort of the VMs of the plan
+	MedianRatio float64 `json:"medianRatio"`
+
+	// Outlier Whether the VM takes more than three times the median effort
+	Outlier bool            `json:"outlier"`
+	Phases  []VMPhaseEffort `json:"phases"`
+
+	// PlanShare Fraction of the effort of the plan taken by the VM
+	PlanShare float64 `json:"planShare"`
+
+	// Total Effort of the VM over all the phases (formatted as duration string)
+	Total string     `json:"total"`
+	Vm    PlanWaveVM `json:"vm"`
+	Wave  string     `json:"wave"`
+}
+
+// VMFolder A folder or vApp of the source hierarchy with the VMs under it, its subfolders included
+type VMFolder struct {
+	Children *[]VMFolder `json:"children,omitempty"`
+	DiskGB   float64     `json:"diskGB"`
+	Name     string      `json:"name"`
+
+	// Path Path of the folder from the VM folder of its datacenter, e.g. "Finance/Payroll"
+	Path string `json:"path"`
+
+	// VApp Whether the folder is a vApp
+	VApp    bool `json:"vApp"`
+	VmCount int  `json:"vmCount"`
+}
+
+// VMLiveState defines model for VMLiveState.
+type VMLiveState string
+
+// VMLiveStatus defines model for VMLiveStatus.
+type VMLiveStatus struct {
+	// Elapsed Time since the migration of the VM started, until it completed
+	Elapsed string      `json:"elapsed"`
+	Id      string      `json:"id"`
+	Name    string      `json:"name"`
+	State   VMLiveState `json:"state"`
+}
+
+// VMPhaseActual Actual duration of a phase of the migration of a VM
+type VMPhaseActual struct {
+	CompletedAt time.Time `json:"completedAt"`
+
+	// Phase Phase of the migration, e.g. DiskTransfer or Cutover
+	Phase     string    `json:"phase"`
+	StartedAt time.Time `json:"startedAt"`
+	VmId      string    `json:"vmId"`
+	Wave      *string   `json:"wave,omitempty"`
+}
+
+// VMPhaseActualList defines model for VMPhaseActualList.
+type VMPhaseActualList struct {
+	Actuals []VMPhaseActual `json:"actuals"`
+}
+
+// VMPhaseEffort defines model for VMPhaseEffort.
+type VMPhaseEffort struct {
+	// Category Kind of work of the phase, from its name
+	Category VMPhaseEffortCategory `json:"category"`
+
+	// Effort Effort of the phase taken by the VM (formatted as duration string)
+	Effort string `json:"effort"`
+	Phase  string `json:"phase"`
+
+	// Share Fraction of the effort of the phase in the wave taken by the VM
+	Share float64 `json:"share"`
+}
+
+// VMPhaseEffortCategory Kind of work of the phase, from its name
+type VMPhaseEffortCategory string
+
 // VMResourceBreakdown defines model for VMResourceBreakdown.
 type VMResourceBreakdown struct {
 	// Deprecated:
@@ -588,6 +2914,18 @@ type VMResourceBreakdown struct {
 	TotalForNotMigratable          int        `json:"totalForNotMigratable"`
 }
 
+// VMTracking Migration status of a VM reported by MTV
+type VMTracking struct {
+	Completed *time.Time `json:"completed,omitempty"`
+	Error     *string    `json:"error,omitempty"`
+	Id        string     `json:"id"`
+	Name      string     `json:"name"`
+
+	// Phase Current phase of the MTV pipeline of the VM, e.g. DiskTransfer or Cutover
+	Phase   *string    `json:"phase,omitempty"`
+	Started *time.Time `json:"started,omitempty"`
+}
+
 // VMs defines model for VMs.
 type VMs struct {
 	CpuCores     VMResourceBreakdown             `json:"cpuCores"`
@@ -606,10 +2944,13 @@ type VMs struct {
 	DistributionByMemoryTier *map[string]int `json:"distributionByMemoryTier,omitempty"`
 
 	// DistributionByNicCount Distribution of VMs by NIC count (e.g., "0", "1", "2", "3", "4+")
-	DistributionByNicCount *map[string]int      `json:"distributionByNicCount,omitempty"`
-	MigrationWarnings      []MigrationIssue     `json:"migrationWarnings"`
-	NicCount               *VMResourceBreakdown `json:"nicCount,omitempty"`
-	NotMigratableReasons   []MigrationIssue     `json:"notMigratableReasons"`
+	DistributionByNicCount *map[string]int `json:"distributionByNicCount,omitempty"`
+
+	// Folders Folder and vApp hierarchy of the VMs, absent when the source does not report it
+	Folders              *[]VMFolder          `json:"folders,omitempty"`
+	MigrationWarnings    []MigrationIssue     `json:"migrationWarnings"`
+	NicCount             *VMResourceBreakdown `json:"nicCount,omitempty"`
+	NotMigratableReasons []MigrationIssue     `json:"notMigratableReasons"`
 	// Deprecated:
 	Os                          *map[string]int     `json:"os,omitempty"`
 	OsInfo                      *map[string]OsInfo  `json:"osInfo,omitempty"`
@@ -640,6 +2981,119 @@ type VmNetwork struct {
 	Ipv4 *Ipv4Config `json:"ipv4,omitempty"`
 }
 
+// WaveBlocker defines model for WaveBlocker.
+type WaveBlocker struct {
+	// Reason Error of a failed migration or open item of a checklist
+	Reason string `json:"reason"`
+	Vm     string `json:"vm"`
+	VmId   string `json:"vmId"`
+}
+
+// WaveChecklistProgress Completion of the VM validation checklists of a wave
+type WaveChecklistProgress struct {
+	Items     int `json:"items"`
+	ItemsDone int `json:"itemsDone"`
+
+	// Vms Number of VMs with a checklist
+	Vms int `json:"vms"`
+
+	// VmsDone Number of VMs with all their checklists completed
+	VmsDone int    `json:"vmsDone"`
+	Wave    string `json:"wave"`
+}
+
+// WaveLiveStatus defines model for WaveLiveStatus.
+type WaveLiveStatus struct {
+	Blockers []WaveBlocker `json:"blockers"`
+
+	// Elapsed Time since the first VM of the wave started, until the last one completed
+	Elapsed string `json:"elapsed"`
+
+	// Estimated Duration of the wave as planned
+	Estimated string `json:"estimated"`
+
+	// States Number of VMs per state
+	States    map[string]int `json:"states"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+	Vms       []VMLiveStatus `json:"vms"`
+	Wave      string         `json:"wave"`
+}
+
+// WaveProgress Actuals of a completed wave
+type WaveProgress struct {
+	End         time.Time `json:"end"`
+	P1Incidents int       `json:"p1Incidents"`
+
+	// Rollbacks VMs cut over and rolled back to the source
+	Rollbacks int       `json:"rollbacks"`
+	Start     time.Time `json:"start"`
+
+	// VmsMigrated VMs cut over and kept on the target
+	VmsMigrated int    `json:"vmsMigrated"`
+	Wave        string `json:"wave"`
+}
+
+// WaveTracking defines model for WaveTracking.
+type WaveTracking struct {
+	Vms []VMTracking `json:"vms"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Number of calls to the webhook, redrives included
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Failure reason of the last attempt
+	Error *string `json:"error,omitempty"`
+
+	// EventSeq Sequence number of the event delivered
+	EventSeq  int64                 `json:"eventSeq"`
+	EventType string                `json:"eventType"`
+	OrgId     string                `json:"orgId"`
+	Status    WebhookDeliveryStatus `json:"status"`
+
+	// UpdatedAt Time of the last attempt
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList = []WebhookDelivery
+
+// WebhookEndpoint defines model for WebhookEndpoint.
+type WebhookEndpoint struct {
+	// ConsecutiveFailures Number of deliveries failed in a row
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	Delivered           int64      `json:"delivered"`
+	Disabled            bool       `json:"disabled"`
+	DisabledAt          *time.Time `json:"disabledAt,omitempty"`
+	Failed              int64      `json:"failed"`
+
+	// Id ID of the webhook, events or cloudevents
+	Id string `json:"id"`
+
+	// LastError Failure reason of the last failed delivery, while failing
+	LastError *string `json:"lastError,omitempty"`
+}
+
+// WebhookEndpointList defines model for WebhookEndpointList.
+type WebhookEndpointList = []WebhookEndpoint
+
+// WebhookRedrive defines model for WebhookRedrive.
+type WebhookRedrive struct {
+	Delivered int `json:"delivered"`
+
+	// Disabled The webhook was disabled again during the redrive, leaving the remaining deliveries failed
+	Disabled bool `json:"disabled"`
+	Failed   int  `json:"failed"`
+
+	// Redriven Number of failed deliveries replayed
+	Redriven int `json:"redriven"`
+}
+
 // DiskSizeTierSummary defines model for diskSizeTierSummary.
 type DiskSizeTierSummary struct {
 	// TotalSizeTB Total disk size in TB for this tier
@@ -682,6 +3136,144 @@ type ListAssessmentsParams struct {
 	SourceId *openapi_types.UUID `form:"sourceId,omitempty" json:"sourceId,omitempty"`
 }
 
+// ListEstimationRunsParams defines parameters for ListEstimationRuns.
+type ListEstimationRunsParams struct {
+	// ClusterId Only list the runs of the cluster
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// Limit Maximum number of runs listed, at most 100
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListDurationDistributionsParams defines parameters for ListDurationDistributions.
+type ListDurationDistributionsParams struct {
+	// Family Family of distributions to fit. By default, the family explaining the actuals best.
+	Family *DistributionFamily `form:"family,omitempty" json:"family,omitempty"`
+}
+
+// ListEstimationFreezesParams defines parameters for ListEstimationFreezes.
+type ListEstimationFreezesParams struct {
+	// SowId Only list the freezes of the statement of work
+	SowId *string `form:"sowId,omitempty" json:"sowId,omitempty"`
+}
+
+// CompareEstimationRunsParams defines parameters for CompareEstimationRuns.
+type CompareEstimationRunsParams struct {
+	// With ID of the estimation run to compare with
+	With openapi_types.UUID `form:"with" json:"with"`
+}
+
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// After Only return the events after this sequence number
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// Limit Maximum number of events returned, 100 when omitted and at most 1000
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ImportPlanCalendarParams defines parameters for ImportPlanCalendar.
+type ImportPlanCalendarParams struct {
+	// Region Regional preset of public holidays
+	Region *HolidayRegion `form:"region,omitempty" json:"region,omitempty"`
+}
+
+// GetPlanChargebackParams defines parameters for GetPlanChargeback.
+type GetPlanChargebackParams struct {
+	// GroupBy Tag of the VMs the effort is charged back by, the application owner by default
+	GroupBy *GetPlanChargebackParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// RateCardId ID of the rate card pricing the effort, left unpriced when not given
+	RateCardId *openapi_types.UUID `form:"rateCardId,omitempty" json:"rateCardId,omitempty"`
+
+	// Format Output format, JSON by default
+	Format *GetPlanChargebackParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetPlanChargebackParamsGroupBy defines parameters for GetPlanChargeback.
+type GetPlanChargebackParamsGroupBy string
+
+// GetPlanChargebackParamsFormat defines parameters for GetPlanChargeback.
+type GetPlanChargebackParamsFormat string
+
+// ListPlanChecklistsParams defines parameters for ListPlanChecklists.
+type ListPlanChecklistsParams struct {
+	// Wave Only return the checklists of this wave
+	Wave *string `form:"wave,omitempty" json:"wave,omitempty"`
+}
+
+// ExportPlanParams defines parameters for ExportPlan.
+type ExportPlanParams struct {
+	// Format Output format
+	Format ExportPlanParamsFormat `form:"format" json:"format"`
+}
+
+// ExportPlanParamsFormat defines parameters for ExportPlan.
+type ExportPlanParamsFormat string
+
+// GetPlanGanttParams defines parameters for GetPlanGantt.
+type GetPlanGanttParams struct {
+	// Format Output format
+	Format *GetPlanGanttParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// TimeZone IANA time zone of the viewer. The bars and cutover windows are then rendered in its local time as well, next to the local time of the site of their source.
+	TimeZone *string `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// GetPlanGanttParamsFormat defines parameters for GetPlanGantt.
+type GetPlanGanttParamsFormat string
+
+// GetPlanNamespacesParams defines parameters for GetPlanNamespaces.
+type GetPlanNamespacesParams struct {
+	// Strategy Source structure the namespaces are proposed from, the mapping of the plan when not given
+	Strategy *NamespaceStrategy `form:"strategy,omitempty" json:"strategy,omitempty"`
+
+	// Tag Tag of the VMs the tag strategy reads
+	Tag *GetPlanNamespacesParamsTag `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// FolderDepth Number of leading folders naming a namespace for the folder strategy, 1 by default
+	FolderDepth *int `form:"folderDepth,omitempty" json:"folderDepth,omitempty"`
+
+	// Prefix Prefix of the proposed names, e.g. "acme-"
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+}
+
+// GetPlanNamespacesParamsTag defines parameters for GetPlanNamespaces.
+type GetPlanNamespacesParamsTag string
+
+// GetProgramForecastParams defines parameters for GetProgramForecast.
+type GetProgramForecastParams struct {
+	// Format Output format, JSON by default
+	Format *GetProgramForecastParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// FiscalYearStartMonth First month of the fiscal year the volume is bucketed by, 1 (January) by default
+	FiscalYearStartMonth *int `form:"fiscalYearStartMonth,omitempty" json:"fiscalYearStartMonth,omitempty"`
+}
+
+// GetProgramForecastParamsFormat defines parameters for GetProgramForecast.
+type GetProgramForecastParamsFormat string
+
+// ListRateCardsParams defines parameters for ListRateCards.
+type ListRateCardsParams struct {
+	// Name Only list the versions of the named rate card
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Status Only list the deliveries of this status
+	Status *ListWebhookDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of deliveries returned, 100 when omitted and at most 1000
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListWebhookDeliveriesParamsStatus defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParamsStatus string
+
+// SetAlertWebhookJSONRequestBody defines body for SetAlertWebhook for application/json ContentType.
+type SetAlertWebhookJSONRequestBody = AlertWebhookForm
+
 // CreateAssessmentJSONRequestBody defines body for CreateAssessment for application/json ContentType.
 type CreateAssessmentJSONRequestBody = AssessmentForm
 
@@ -697,14 +3289,92 @@ type CalculateAssessmentClusterRequirementsJSONRequestBody = ClusterRequirements
 // CalculateMigrationComplexityJSONRequestBody defines body for CalculateMigrationComplexity for application/json ContentType.
 type CalculateMigrationComplexityJSONRequestBody = MigrationComplexityRequest
 
+// CreateEstimationFreezeJSONRequestBody defines body for CreateEstimationFreeze for application/json ContentType.
+type CreateEstimationFreezeJSONRequestBody = EstimationFreezeForm
+
+// CalculateFactoryThroughputJSONRequestBody defines body for CalculateFactoryThroughput for application/json ContentType.
+type CalculateFactoryThroughputJSONRequestBody = FactoryThroughputRequest
+
 // CalculateMigrationEstimationJSONRequestBody defines body for CalculateMigrationEstimation for application/json ContentType.
 type CalculateMigrationEstimationJSONRequestBody = MigrationEstimationRequest
 
+// SetCalculatorDefaultsJSONRequestBody defines body for SetCalculatorDefaults for application/json ContentType.
+type SetCalculatorDefaultsJSONRequestBody = CalculatorDefaultsForm
+
+// CreateChecklistTemplateJSONRequestBody defines body for CreateChecklistTemplate for application/json ContentType.
+type CreateChecklistTemplateJSONRequestBody = ChecklistTemplateForm
+
+// SetContingencyPolicyJSONRequestBody defines body for SetContingencyPolicy for application/json ContentType.
+type SetContingencyPolicyJSONRequestBody = ContingencyPolicyForm
+
+// CreateDebugBundleJSONRequestBody defines body for CreateDebugBundle for application/json ContentType.
+type CreateDebugBundleJSONRequestBody = DebugBundleRequest
+
+// RunEstimationJSONRequestBody defines body for RunEstimation for application/json ContentType.
+type RunEstimationJSONRequestBody = EstimationRequest
+
+// CompareEstimationsJSONRequestBody defines body for CompareEstimations for application/json ContentType.
+type CompareEstimationsJSONRequestBody = EstimationComparisonRequest
+
+// SolveEstimationDeadlineJSONRequestBody defines body for SolveEstimationDeadline for application/json ContentType.
+type SolveEstimationDeadlineJSONRequestBody = EstimationSolveRequest
+
+// SetExportPolicyJSONRequestBody defines body for SetExportPolicy for application/json ContentType.
+type SetExportPolicyJSONRequestBody = ExportPolicyForm
+
+// SetGuardrailPolicyJSONRequestBody defines body for SetGuardrailPolicy for application/json ContentType.
+type SetGuardrailPolicyJSONRequestBody = GuardrailPolicyForm
+
+// SetInventoryFieldSchemaJSONRequestBody defines body for SetInventoryFieldSchema for application/json ContentType.
+type SetInventoryFieldSchemaJSONRequestBody = InventoryFieldSchemaForm
+
+// CreatePlanJSONRequestBody defines body for CreatePlan for application/json ContentType.
+type CreatePlanJSONRequestBody = PlanForm
+
+// InstantiatePlanChecklistsJSONRequestBody defines body for InstantiatePlanChecklists for application/json ContentType.
+type InstantiatePlanChecklistsJSONRequestBody = ChecklistInstantiation
+
+// CompletePlanChecklistItemJSONRequestBody defines body for CompletePlanChecklistItem for application/json ContentType.
+type CompletePlanChecklistItemJSONRequestBody = ChecklistItemForm
+
+// ApprovePlanGateJSONRequestBody defines body for ApprovePlanGate for application/json ContentType.
+type ApprovePlanGateJSONRequestBody = GateApprovalForm
+
+// SetPlanKPIsJSONRequestBody defines body for SetPlanKPIs for application/json ContentType.
+type SetPlanKPIsJSONRequestBody = PlanKPIs
+
+// RecordPlanProgressJSONRequestBody defines body for RecordPlanProgress for application/json ContentType.
+type RecordPlanProgressJSONRequestBody = WaveProgress
+
+// CreatePlanShareJSONRequestBody defines body for CreatePlanShare for application/json ContentType.
+type CreatePlanShareJSONRequestBody = PlanShareForm
+
+// RecordPlanVMActualsJSONRequestBody defines body for RecordPlanVMActuals for application/json ContentType.
+type RecordPlanVMActualsJSONRequestBody = VMPhaseActualList
+
+// RecordPlanWaveTrackingJSONRequestBody defines body for RecordPlanWaveTracking for application/json ContentType.
+type RecordPlanWaveTrackingJSONRequestBody = WaveTracking
+
+// SimulatePlanStaffingJSONRequestBody defines body for SimulatePlanStaffing for application/json ContentType.
+type SimulatePlanStaffingJSONRequestBody = PlanWhatIfForm
+
+// CreateRateCardJSONRequestBody defines body for CreateRateCard for application/json ContentType.
+type CreateRateCardJSONRequestBody = RateCardForm
+
+// CreatePortfolioReportJSONRequestBody defines body for CreatePortfolioReport for application/json ContentType.
+type CreatePortfolioReportJSONRequestBody = PortfolioReportRequest
+
 // CreateSourceJSONRequestBody defines body for CreateSource for application/json ContentType.
 type CreateSourceJSONRequestBody = SourceCreate
 
 // UpdateSourceJSONRequestBody defines body for UpdateSource for application/json ContentType.
 type UpdateSourceJSONRequestBody = SourceUpdate
 
+// StartChangeRateJobJSONRequestBody defines body for StartChangeRateJob for application/json ContentType.
+type StartChangeRateJobJSONRequestBody = ChangeRateJobForm
+
 // UpdateInventoryJSONRequestBody defines body for UpdateInventory for application/json ContentType.
 type UpdateInventoryJSONRequestBody = UpdateInventory
+
+// SetTroubleshootingModelJSONRequestBody defines body for SetTroubleshootingModel for application/json ContentType.
+type SetTroubleshootingModelJSONRequestBody = TroubleshootingModelForm
@@ -19,9 +19,12 @@ import (
 
 	apiserver "github.com/kubev2v/migration-planner/internal/api_server"
 	"github.com/kubev2v/migration-planner/internal/config"
+	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/warehouse"
 	"github.com/kubev2v/migration-planner/pkg/log"
 	"github.com/kubev2v/migration-planner/pkg/migrations"
+	"github.com/kubev2v/migration-planner/pkg/tracing"
 	"github.com/kubev2v/migration-planner/pkg/version"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -55,6 +58,20 @@ var runCmd = &cobra.Command{
 		defer undo()
 
 		zap.S().Info("Starting API service...")
+		if err := cfg.Service.Admin.Validate(); err != nil {
+			zap.S().Fatalw("validating admin server", "error", err)
+		}
+		shutdownTracing, err := tracing.Init(context.Background(), cfg.Service.Tracing.Endpoint, cfg.Service.Tracing.ServiceName, cfg.Service.Tracing.SampleRatio)
+		if err != nil {
+			zap.S().Fatalw("initializing tracing", "error", err)
+		}
+		defer func() {
+			// the spans left are flushed once the servers and the jobs are stopped
+			if err := shutdownTracing(context.Background()); err != nil {
+				zap.S().Warnf("Error stopping tracing: %v", err)
+			}
+		}()
+
 		zap.S().Infow("Build from git", "commit", version.Get().GitCommit)
 		zap.S().Info("Initializing data store")
 		db, err := store.InitDB(cfg)
@@ -90,9 +107,26 @@ var runCmd = &cobra.Command{
 		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
 		var wg sync.WaitGroup // Responsible for keeping the main thread waiting for all goroutines to shut down gracefully
 
-		// Initialize River jobs client (required for RVTools processing)
+		exporter, err := newWarehouseExporter(cfg.Service.Warehouse, store)
+		if err != nil {
+			zap.S().Fatalw("initializing warehouse exporter", "error", err)
+		}
+
+		// The plan costs and the planned estimations of the jobs are converted with the same rates
+		rateProvider := apiserver.NewRateProvider(cfg.Service.ExchangeRates)
+
+		// Interactive and batch estimations run on separate worker pools, shared by the estimation jobs
+		estimationQueue := service.NewEstimationQueue(cfg.Service.Estimation.InteractiveWorkers, cfg.Service.Estimation.BatchWorkers)
+		estimationRunner := service.NewEstimationService(store).
+			WithQueue(estimationQueue).
+			WithContingencyPolicies(store.ContingencyPolicy()).
+			WithCalculatorDefaults(store.CalculatorDefaults()).
+			WithRateProvider(rateProvider)
+		planRunner := service.NewPlanService(store).WithRateProvider(rateProvider)
+
+		// Initialize River jobs client (required for RVTools processing, portfolio reports, estimation jobs and warehouse exports)
 		zap.S().Info("Initializing River jobs client...")
-		jobsClient, err := jobs.NewClient(ctx, cfg, store, opaValidator)
+		jobsClient, err := jobs.NewClient(ctx, cfg, store, opaValidator, planRunner, estimationRunner, exporter)
 		if err != nil {
 			zap.S().Fatalw("initializing River jobs client", "error", err)
 		}
@@ -113,7 +147,7 @@ var runCmd = &cobra.Command{
 		metrics.RegisterMetrics(store)
 
 		runServer(ctx, &wg, cancel, cfg.Service.Address, "api_server", func(l net.Listener) Server {
-			return apiserver.New(cfg, store, l, opaValidator, jobsClient)
+			return apiserver.New(cfg, store, l, opaValidator, jobsClient, estimationQueue)
 		})
 
 		runServer(ctx, &wg, cancel, cfg.Service.AgentEndpointAddress, "agent_server", func(l net.Listener) Server {
@@ -128,6 +162,12 @@ var runCmd = &cobra.Command{
 			return apiserver.NewMetricServer("0.0.0.0:8080", l)
 		})
 
+		if cfg.Service.Admin.Address != "" {
+			runServer(ctx, &wg, cancel, cfg.Service.Admin.Address, "admin_server", func(l net.Listener) Server {
+				return apiserver.NewAdminServer(cfg.Service.Admin, store, estimationQueue, l)
+			})
+		}
+
 		<-ctx.Done()
 		wg.Wait()
 
@@ -156,6 +196,23 @@ func ensureIsoExist(path string) error {
 	return nil
 }
 
+// newWarehouseExporter creates the exporter of the BI tables, or nil when no bucket is configured.
+func newWarehouseExporter(cfg config.Warehouse, s store.Store) (jobs.WarehouseExporter, error) {
+	if cfg.Bucket == "" {
+		return nil, nil
+	}
+	format, err := warehouse.ParseFormat(cfg.Format)
+	if err != nil {
+		return nil, err
+	}
+	uploader, err := warehouse.NewS3Uploader(cfg.Endpoint, cfg.Region, cfg.Bucket, cfg.AccessKey, cfg.SecretKey, cfg.UseSSL)
+	if err != nil {
+		return nil, fmt.Errorf("creating S3 client: %w", err)
+	}
+	zap.S().Infow("exporting warehouse tables", "bucket", cfg.Bucket, "prefix", cfg.Prefix, "format", format, "interval", cfg.Interval)
+	return warehouse.NewExporter(s, uploader, format, cfg.Prefix), nil
+}
+
 func runServer(ctx context.Context, wg *sync.WaitGroup, cancel context.CancelFunc,
 	address string, loggerName string, serverFactory func(net.Listener) Server) {
 
@@ -31,6 +31,8 @@ func NewPlannerCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdDeploy())
 	cmd.AddCommand(cli.NewCmdSSO())
 	cmd.AddCommand(cli.NewCmdE2E())
+	cmd.AddCommand(cli.NewCmdReplay())
+	cmd.AddCommand(cli.NewCmdVerify())
 
 	return cmd
 }
@@ -23,6 +23,12 @@ type ServerInterface interface {
 	// (PUT /api/v1/agents/{id}/status)
 	UpdateAgentStatus(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (GET /api/v1/sources/{id}/change-rate-job)
+	GetChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (PUT /api/v1/sources/{id}/change-rate-samples)
+	UploadChangeRateSamples(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
 	// (PUT /api/v1/sources/{id}/status)
 	UpdateSourceInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 }
@@ -36,6 +42,16 @@ func (_ Unimplemented) UpdateAgentStatus(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/sources/{id}/change-rate-job)
+func (_ Unimplemented) GetChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/sources/{id}/change-rate-samples)
+func (_ Unimplemented) UploadChangeRateSamples(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (PUT /api/v1/sources/{id}/status)
 func (_ Unimplemented) UpdateSourceInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -76,6 +92,58 @@ func (siw *ServerInterfaceWrapper) UpdateAgentStatus(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetChangeRateJob operation middleware
+func (siw *ServerInterfaceWrapper) GetChangeRateJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChangeRateJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UploadChangeRateSamples operation middleware
+func (siw *ServerInterfaceWrapper) UploadChangeRateSamples(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadChangeRateSamples(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // UpdateSourceInventory operation middleware
 func (siw *ServerInterfaceWrapper) UpdateSourceInventory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -218,6 +286,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/agents/{id}/status", wrapper.UpdateAgentStatus)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sources/{id}/change-rate-job", wrapper.GetChangeRateJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/sources/{id}/change-rate-samples", wrapper.UploadChangeRateSamples)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/sources/{id}/status", wrapper.UpdateSourceInventory)
 	})
@@ -295,6 +369,122 @@ func (response UpdateAgentStatus500JSONResponse) VisitUpdateAgentStatusResponse(
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetChangeRateJobRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetChangeRateJobResponseObject interface {
+	VisitGetChangeRateJobResponse(w http.ResponseWriter) error
+}
+
+type GetChangeRateJob200JSONResponse externalRef0.ChangeRateJob
+
+func (response GetChangeRateJob200JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob401JSONResponse externalRef0.Error
+
+func (response GetChangeRateJob401JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob403JSONResponse externalRef0.Error
+
+func (response GetChangeRateJob403JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob404JSONResponse externalRef0.Error
+
+func (response GetChangeRateJob404JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob500JSONResponse externalRef0.Error
+
+func (response GetChangeRateJob500JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadChangeRateSamplesRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UploadChangeRateSamplesJSONRequestBody
+}
+
+type UploadChangeRateSamplesResponseObject interface {
+	VisitUploadChangeRateSamplesResponse(w http.ResponseWriter) error
+}
+
+type UploadChangeRateSamples200JSONResponse externalRef0.ChangeRateJob
+
+func (response UploadChangeRateSamples200JSONResponse) VisitUploadChangeRateSamplesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadChangeRateSamples400JSONResponse externalRef0.Error
+
+func (response UploadChangeRateSamples400JSONResponse) VisitUploadChangeRateSamplesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadChangeRateSamples401JSONResponse externalRef0.Error
+
+func (response UploadChangeRateSamples401JSONResponse) VisitUploadChangeRateSamplesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadChangeRateSamples403JSONResponse externalRef0.Error
+
+func (response UploadChangeRateSamples403JSONResponse) VisitUploadChangeRateSamplesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadChangeRateSamples404JSONResponse externalRef0.Error
+
+func (response UploadChangeRateSamples404JSONResponse) VisitUploadChangeRateSamplesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadChangeRateSamples500JSONResponse externalRef0.Error
+
+func (response UploadChangeRateSamples500JSONResponse) VisitUploadChangeRateSamplesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type UpdateSourceInventoryRequestObject struct {
 	Id   openapi_types.UUID `json:"id"`
 	Body *UpdateSourceInventoryJSONRequestBody
@@ -364,6 +554,12 @@ type StrictServerInterface interface {
 	// (PUT /api/v1/agents/{id}/status)
 	UpdateAgentStatus(ctx context.Context, request UpdateAgentStatusRequestObject) (UpdateAgentStatusResponseObject, error)
 
+	// (GET /api/v1/sources/{id}/change-rate-job)
+	GetChangeRateJob(ctx context.Context, request GetChangeRateJobRequestObject) (GetChangeRateJobResponseObject, error)
+
+	// (PUT /api/v1/sources/{id}/change-rate-samples)
+	UploadChangeRateSamples(ctx context.Context, request UploadChangeRateSamplesRequestObject) (UploadChangeRateSamplesResponseObject, error)
+
 	// (PUT /api/v1/sources/{id}/status)
 	UpdateSourceInventory(ctx context.Context, request UpdateSourceInventoryRequestObject) (UpdateSourceInventoryResponseObject, error)
 }
@@ -430,6 +626,65 @@ func (sh *strictHandler) UpdateAgentStatus(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// GetChangeRateJob operation middleware
+func (sh *strictHandler) GetChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetChangeRateJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChangeRateJob(ctx, request.(GetChangeRateJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChangeRateJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetChangeRateJobResponseObject); ok {
+		if err := validResponse.VisitGetChangeRateJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UploadChangeRateSamples operation middleware
+func (sh *strictHandler) UploadChangeRateSamples(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UploadChangeRateSamplesRequestObject
+
+	request.Id = id
+
+	var body UploadChangeRateSamplesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadChangeRateSamples(ctx, request.(UploadChangeRateSamplesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadChangeRateSamples")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadChangeRateSamplesResponseObject); ok {
+		if err := validResponse.VisitUploadChangeRateSamplesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // UpdateSourceInventory operation middleware
 func (sh *strictHandler) UpdateSourceInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	var request UpdateSourceInventoryRequestObject
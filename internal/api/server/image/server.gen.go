@@ -25,6 +25,9 @@ type ServerInterface interface {
 	// (HEAD /api/v1/image/bytoken/{token}/{name})
 	HeadImageByToken(w http.ResponseWriter, r *http.Request, token string, name string)
 
+	// (GET /api/v1/shares/{token})
+	GetSharedReport(w http.ResponseWriter, r *http.Request, token string)
+
 	// (GET /health)
 	Health(w http.ResponseWriter, r *http.Request)
 }
@@ -43,6 +46,11 @@ func (_ Unimplemented) HeadImageByToken(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/shares/{token})
+func (_ Unimplemented) GetSharedReport(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /health)
 func (_ Unimplemented) Health(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -127,6 +135,32 @@ func (siw *ServerInterfaceWrapper) HeadImageByToken(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetSharedReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedReport(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Health operation middleware
 func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -261,6 +295,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Head(options.BaseURL+"/api/v1/image/bytoken/{token}/{name}", wrapper.HeadImageByToken)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/shares/{token}", wrapper.GetSharedReport)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.Health)
 	})
@@ -385,6 +422,51 @@ func (response HeadImageByToken500JSONResponse) VisitHeadImageByTokenResponse(w
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetSharedReportRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetSharedReportResponseObject interface {
+	VisitGetSharedReportResponse(w http.ResponseWriter) error
+}
+
+type GetSharedReport200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetSharedReport200TexthtmlResponse) VisitGetSharedReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetSharedReport404JSONResponse externalRef0.Error
+
+func (response GetSharedReport404JSONResponse) VisitGetSharedReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedReport500JSONResponse externalRef0.Error
+
+func (response GetSharedReport500JSONResponse) VisitGetSharedReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type HealthRequestObject struct {
 }
 
@@ -409,6 +491,9 @@ type StrictServerInterface interface {
 	// (HEAD /api/v1/image/bytoken/{token}/{name})
 	HeadImageByToken(ctx context.Context, request HeadImageByTokenRequestObject) (HeadImageByTokenResponseObject, error)
 
+	// (GET /api/v1/shares/{token})
+	GetSharedReport(ctx context.Context, request GetSharedReportRequestObject) (GetSharedReportResponseObject, error)
+
 	// (GET /health)
 	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
 }
@@ -496,6 +581,32 @@ func (sh *strictHandler) HeadImageByToken(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// GetSharedReport operation middleware
+func (sh *strictHandler) GetSharedReport(w http.ResponseWriter, r *http.Request, token string) {
+	var request GetSharedReportRequestObject
+
+	request.Token = token
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedReport(ctx, request.(GetSharedReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSharedReportResponseObject); ok {
+		if err := validResponse.VisitGetSharedReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Health operation middleware
 func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 	var request HealthRequestObject
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 
@@ -20,6 +21,15 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (DELETE /api/v1/alert-webhook)
+	DeleteAlertWebhook(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/alert-webhook)
+	GetAlertWebhook(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/alert-webhook)
+	SetAlertWebhook(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/v1/assessments)
 	ListAssessments(w http.ResponseWriter, r *http.Request, params ListAssessmentsParams)
 
@@ -50,12 +60,198 @@ type ServerInterface interface {
 	// (POST /api/v1/assessments/{id}/complexity-estimation)
 	CalculateMigrationComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (POST /api/v1/assessments/{id}/estimation-freezes)
+	CreateEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/assessments/{id}/estimation-runs)
+	ListEstimationRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListEstimationRunsParams)
+
+	// (POST /api/v1/assessments/{id}/factory-throughput)
+	CalculateFactoryThroughput(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
 	// (POST /api/v1/assessments/{id}/migration-estimation)
 	CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (GET /api/v1/calculator-defaults)
+	GetCalculatorDefaults(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/calculator-defaults)
+	SetCalculatorDefaults(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/checklist-templates)
+	ListChecklistTemplates(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/checklist-templates)
+	CreateChecklistTemplate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/checklist-templates/{id})
+	DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/contingency-policy)
+	GetContingencyPolicy(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/contingency-policy)
+	SetContingencyPolicy(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/debug/bundle)
+	CreateDebugBundle(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/duration-distributions)
+	ListDurationDistributions(w http.ResponseWriter, r *http.Request, params ListDurationDistributionsParams)
+
+	// (GET /api/v1/estimation-freezes)
+	ListEstimationFreezes(w http.ResponseWriter, r *http.Request, params ListEstimationFreezesParams)
+
+	// (GET /api/v1/estimation-freezes/{id})
+	GetEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/estimation-freezes/{id}/diff)
+	DiffEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/estimation-freezes/{id}/report)
+	GetEstimationFreezeReport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/estimation-runs/{id})
+	GetEstimationRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/estimation-runs/{id}/comparison)
+	CompareEstimationRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params CompareEstimationRunsParams)
+
+	// (POST /api/v1/estimations)
+	RunEstimation(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/estimations/comparison)
+	CompareEstimations(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/estimations/solve)
+	SolveEstimationDeadline(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/estimations/{id})
+	GetEstimationJob(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (GET /api/v1/events)
+	ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams)
+
+	// (GET /api/v1/export-policy)
+	GetExportPolicy(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/export-policy)
+	SetExportPolicy(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/guardrail-policy)
+	GetGuardrailPolicy(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/guardrail-policy)
+	SetGuardrailPolicy(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/v1/info)
 	GetInfo(w http.ResponseWriter, r *http.Request)
 
+	// (GET /api/v1/inventory-fields)
+	GetInventoryFieldSchema(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/inventory-fields)
+	SetInventoryFieldSchema(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/plans)
+	ListPlans(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/plans)
+	CreatePlan(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/plans/{id})
+	DeletePlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/plans/{id})
+	GetPlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (PUT /api/v1/plans/{id}/calendars/{pool})
+	ImportPlanCalendar(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, pool string, params ImportPlanCalendarParams)
+
+	// (GET /api/v1/plans/{id}/chargeback)
+	GetPlanChargeback(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanChargebackParams)
+
+	// (GET /api/v1/plans/{id}/checklists)
+	ListPlanChecklists(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListPlanChecklistsParams)
+
+	// (POST /api/v1/plans/{id}/checklists)
+	InstantiatePlanChecklists(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (PUT /api/v1/plans/{id}/checklists/{checklistId}/items/{index})
+	CompletePlanChecklistItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, checklistId openapi_types.UUID, index int)
+
+	// (GET /api/v1/plans/{id}/coverage)
+	GetPlanCoverage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/plans/{id}/export)
+	ExportPlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExportPlanParams)
+
+	// (GET /api/v1/plans/{id}/gantt)
+	GetPlanGantt(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanGanttParams)
+
+	// (POST /api/v1/plans/{id}/gates/{gate}/approvals)
+	ApprovePlanGate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, gate string)
+
+	// (PUT /api/v1/plans/{id}/kpis)
+	SetPlanKPIs(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/plans/{id}/namespaces)
+	GetPlanNamespaces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanNamespacesParams)
+
+	// (GET /api/v1/plans/{id}/progress)
+	GetPlanProgress(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (PUT /api/v1/plans/{id}/progress)
+	RecordPlanProgress(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (PUT /api/v1/plans/{id}/schedule)
+	ImportPlanSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/plans/{id}/shares)
+	ListPlanShares(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (POST /api/v1/plans/{id}/shares)
+	CreatePlanShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (DELETE /api/v1/plans/{id}/shares/{shareId})
+	RevokePlanShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, shareId openapi_types.UUID)
+
+	// (PUT /api/v1/plans/{id}/vm-actuals)
+	RecordPlanVMActuals(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/plans/{id}/vms/{vm}/effort)
+	GetPlanVMEffort(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, vm string)
+
+	// (GET /api/v1/plans/{id}/waves/{n}/live)
+	GetPlanWaveLive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, n int)
+
+	// (PUT /api/v1/plans/{id}/waves/{n}/tracking)
+	RecordPlanWaveTracking(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, n int)
+
+	// (POST /api/v1/plans/{id}/what-if)
+	SimulatePlanStaffing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/programs/{id}/forecast)
+	GetProgramForecast(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetProgramForecastParams)
+
+	// (GET /api/v1/rate-cards)
+	ListRateCards(w http.ResponseWriter, r *http.Request, params ListRateCardsParams)
+
+	// (POST /api/v1/rate-cards)
+	CreateRateCard(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/rate-cards/{id})
+	DeleteRateCard(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/rate-cards/{id})
+	GetRateCard(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (POST /api/v1/reports/portfolio)
+	CreatePortfolioReport(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/reports/portfolio/{id})
+	GetPortfolioReport(w http.ResponseWriter, r *http.Request, id int64)
+
 	// (DELETE /api/v1/sources)
 	DeleteSources(w http.ResponseWriter, r *http.Request)
 
@@ -74,6 +270,12 @@ type ServerInterface interface {
 	// (PUT /api/v1/sources/{id})
 	UpdateSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (GET /api/v1/sources/{id}/change-rate-job)
+	GetChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (PUT /api/v1/sources/{id}/change-rate-job)
+	StartChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
 	// (HEAD /api/v1/sources/{id}/image)
 	HeadImage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
@@ -83,6 +285,24 @@ type ServerInterface interface {
 	// (PUT /api/v1/sources/{id}/inventory)
 	UpdateInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (DELETE /api/v1/troubleshooting-model)
+	DeleteTroubleshootingModel(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/troubleshooting-model)
+	GetTroubleshootingModel(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/troubleshooting-model)
+	SetTroubleshootingModel(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/webhooks/{id}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id string, params ListWebhookDeliveriesParams)
+
+	// (POST /api/v1/webhooks/{id}/redrive)
+	RedriveWebhook(w http.ResponseWriter, r *http.Request, id string)
+
 	// (GET /health)
 	Health(w http.ResponseWriter, r *http.Request)
 }
@@ -91,6 +311,21 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// (DELETE /api/v1/alert-webhook)
+func (_ Unimplemented) DeleteAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/alert-webhook)
+func (_ Unimplemented) GetAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/alert-webhook)
+func (_ Unimplemented) SetAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/assessments)
 func (_ Unimplemented) ListAssessments(w http.ResponseWriter, r *http.Request, params ListAssessmentsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -141,332 +376,431 @@ func (_ Unimplemented) CalculateMigrationComplexity(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /api/v1/assessments/{id}/estimation-freezes)
+func (_ Unimplemented) CreateEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/assessments/{id}/estimation-runs)
+func (_ Unimplemented) ListEstimationRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListEstimationRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/assessments/{id}/factory-throughput)
+func (_ Unimplemented) CalculateFactoryThroughput(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (POST /api/v1/assessments/{id}/migration-estimation)
 func (_ Unimplemented) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (GET /api/v1/info)
-func (_ Unimplemented) GetInfo(w http.ResponseWriter, r *http.Request) {
+// (GET /api/v1/calculator-defaults)
+func (_ Unimplemented) GetCalculatorDefaults(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (DELETE /api/v1/sources)
-func (_ Unimplemented) DeleteSources(w http.ResponseWriter, r *http.Request) {
+// (PUT /api/v1/calculator-defaults)
+func (_ Unimplemented) SetCalculatorDefaults(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (GET /api/v1/sources)
-func (_ Unimplemented) ListSources(w http.ResponseWriter, r *http.Request) {
+// (GET /api/v1/checklist-templates)
+func (_ Unimplemented) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (POST /api/v1/sources)
-func (_ Unimplemented) CreateSource(w http.ResponseWriter, r *http.Request) {
+// (POST /api/v1/checklist-templates)
+func (_ Unimplemented) CreateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (DELETE /api/v1/sources/{id})
-func (_ Unimplemented) DeleteSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// (DELETE /api/v1/checklist-templates/{id})
+func (_ Unimplemented) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (GET /api/v1/sources/{id})
-func (_ Unimplemented) GetSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// (GET /api/v1/contingency-policy)
+func (_ Unimplemented) GetContingencyPolicy(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (PUT /api/v1/sources/{id})
-func (_ Unimplemented) UpdateSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// (PUT /api/v1/contingency-policy)
+func (_ Unimplemented) SetContingencyPolicy(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (HEAD /api/v1/sources/{id}/image)
-func (_ Unimplemented) HeadImage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// (POST /api/v1/debug/bundle)
+func (_ Unimplemented) CreateDebugBundle(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (GET /api/v1/sources/{id}/image-url)
-func (_ Unimplemented) GetSourceDownloadURL(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// (GET /api/v1/duration-distributions)
+func (_ Unimplemented) ListDurationDistributions(w http.ResponseWriter, r *http.Request, params ListDurationDistributionsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (PUT /api/v1/sources/{id}/inventory)
-func (_ Unimplemented) UpdateInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// (GET /api/v1/estimation-freezes)
+func (_ Unimplemented) ListEstimationFreezes(w http.ResponseWriter, r *http.Request, params ListEstimationFreezesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (GET /health)
-func (_ Unimplemented) Health(w http.ResponseWriter, r *http.Request) {
+// (GET /api/v1/estimation-freezes/{id})
+func (_ Unimplemented) GetEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// (GET /api/v1/estimation-freezes/{id}/diff)
+func (_ Unimplemented) DiffEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// (GET /api/v1/estimation-freezes/{id}/report)
+func (_ Unimplemented) GetEstimationFreezeReport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// ListAssessments operation middleware
-func (siw *ServerInterfaceWrapper) ListAssessments(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (GET /api/v1/estimation-runs/{id})
+func (_ Unimplemented) GetEstimationRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// (GET /api/v1/estimation-runs/{id}/comparison)
+func (_ Unimplemented) CompareEstimationRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params CompareEstimationRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAssessmentsParams
+// (POST /api/v1/estimations)
+func (_ Unimplemented) RunEstimation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "sourceId" -------------
+// (POST /api/v1/estimations/comparison)
+func (_ Unimplemented) CompareEstimations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "sourceId", r.URL.Query(), &params.SourceId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceId", Err: err})
-		return
-	}
+// (POST /api/v1/estimations/solve)
+func (_ Unimplemented) SolveEstimationDeadline(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAssessments(w, r, params)
-	}))
+// (GET /api/v1/estimations/{id})
+func (_ Unimplemented) GetEstimationJob(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (GET /api/v1/events)
+func (_ Unimplemented) ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (GET /api/v1/export-policy)
+func (_ Unimplemented) GetExportPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CreateAssessment operation middleware
-func (siw *ServerInterfaceWrapper) CreateAssessment(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (PUT /api/v1/export-policy)
+func (_ Unimplemented) SetExportPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateAssessment(w, r)
-	}))
+// (GET /api/v1/guardrail-policy)
+func (_ Unimplemented) GetGuardrailPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (PUT /api/v1/guardrail-policy)
+func (_ Unimplemented) SetGuardrailPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (GET /api/v1/info)
+func (_ Unimplemented) GetInfo(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CancelJob operation middleware
-func (siw *ServerInterfaceWrapper) CancelJob(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (GET /api/v1/inventory-fields)
+func (_ Unimplemented) GetInventoryFieldSchema(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// (PUT /api/v1/inventory-fields)
+func (_ Unimplemented) SetInventoryFieldSchema(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+// (GET /api/v1/plans)
+func (_ Unimplemented) ListPlans(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// (POST /api/v1/plans)
+func (_ Unimplemented) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelJob(w, r, id)
-	}))
+// (DELETE /api/v1/plans/{id})
+func (_ Unimplemented) DeletePlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (GET /api/v1/plans/{id})
+func (_ Unimplemented) GetPlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (PUT /api/v1/plans/{id}/calendars/{pool})
+func (_ Unimplemented) ImportPlanCalendar(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, pool string, params ImportPlanCalendarParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetJob operation middleware
-func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (GET /api/v1/plans/{id}/chargeback)
+func (_ Unimplemented) GetPlanChargeback(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanChargebackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// (GET /api/v1/plans/{id}/checklists)
+func (_ Unimplemented) ListPlanChecklists(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListPlanChecklistsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+// (POST /api/v1/plans/{id}/checklists)
+func (_ Unimplemented) InstantiatePlanChecklists(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// (PUT /api/v1/plans/{id}/checklists/{checklistId}/items/{index})
+func (_ Unimplemented) CompletePlanChecklistItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, checklistId openapi_types.UUID, index int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJob(w, r, id)
-	}))
+// (GET /api/v1/plans/{id}/coverage)
+func (_ Unimplemented) GetPlanCoverage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (GET /api/v1/plans/{id}/export)
+func (_ Unimplemented) ExportPlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExportPlanParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (GET /api/v1/plans/{id}/gantt)
+func (_ Unimplemented) GetPlanGantt(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanGanttParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CreateRVToolsAssessment operation middleware
-func (siw *ServerInterfaceWrapper) CreateRVToolsAssessment(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (POST /api/v1/plans/{id}/gates/{gate}/approvals)
+func (_ Unimplemented) ApprovePlanGate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, gate string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRVToolsAssessment(w, r)
-	}))
+// (PUT /api/v1/plans/{id}/kpis)
+func (_ Unimplemented) SetPlanKPIs(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (GET /api/v1/plans/{id}/namespaces)
+func (_ Unimplemented) GetPlanNamespaces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanNamespacesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (GET /api/v1/plans/{id}/progress)
+func (_ Unimplemented) GetPlanProgress(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// DeleteAssessment operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAssessment(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
+// (PUT /api/v1/plans/{id}/progress)
+func (_ Unimplemented) RecordPlanProgress(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+// (PUT /api/v1/plans/{id}/schedule)
+func (_ Unimplemented) ImportPlanSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// (GET /api/v1/plans/{id}/shares)
+func (_ Unimplemented) ListPlanShares(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAssessment(w, r, id)
-	}))
+// (POST /api/v1/plans/{id}/shares)
+func (_ Unimplemented) CreatePlanShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (DELETE /api/v1/plans/{id}/shares/{shareId})
+func (_ Unimplemented) RevokePlanShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, shareId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (PUT /api/v1/plans/{id}/vm-actuals)
+func (_ Unimplemented) RecordPlanVMActuals(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetAssessment operation middleware
-func (siw *ServerInterfaceWrapper) GetAssessment(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (GET /api/v1/plans/{id}/vms/{vm}/effort)
+func (_ Unimplemented) GetPlanVMEffort(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, vm string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// (GET /api/v1/plans/{id}/waves/{n}/live)
+func (_ Unimplemented) GetPlanWaveLive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, n int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+// (PUT /api/v1/plans/{id}/waves/{n}/tracking)
+func (_ Unimplemented) RecordPlanWaveTracking(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, n int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// (POST /api/v1/plans/{id}/what-if)
+func (_ Unimplemented) SimulatePlanStaffing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAssessment(w, r, id)
-	}))
+// (GET /api/v1/programs/{id}/forecast)
+func (_ Unimplemented) GetProgramForecast(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetProgramForecastParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (GET /api/v1/rate-cards)
+func (_ Unimplemented) ListRateCards(w http.ResponseWriter, r *http.Request, params ListRateCardsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (POST /api/v1/rate-cards)
+func (_ Unimplemented) CreateRateCard(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// UpdateAssessment operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAssessment(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (DELETE /api/v1/rate-cards/{id})
+func (_ Unimplemented) DeleteRateCard(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// (GET /api/v1/rate-cards/{id})
+func (_ Unimplemented) GetRateCard(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+// (POST /api/v1/reports/portfolio)
+func (_ Unimplemented) CreatePortfolioReport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// (GET /api/v1/reports/portfolio/{id})
+func (_ Unimplemented) GetPortfolioReport(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateAssessment(w, r, id)
-	}))
+// (DELETE /api/v1/sources)
+func (_ Unimplemented) DeleteSources(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (GET /api/v1/sources)
+func (_ Unimplemented) ListSources(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (POST /api/v1/sources)
+func (_ Unimplemented) CreateSource(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CalculateAssessmentClusterRequirements operation middleware
-func (siw *ServerInterfaceWrapper) CalculateAssessmentClusterRequirements(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (DELETE /api/v1/sources/{id})
+func (_ Unimplemented) DeleteSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// (GET /api/v1/sources/{id})
+func (_ Unimplemented) GetSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+// (PUT /api/v1/sources/{id})
+func (_ Unimplemented) UpdateSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// (GET /api/v1/sources/{id}/change-rate-job)
+func (_ Unimplemented) GetChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CalculateAssessmentClusterRequirements(w, r, id)
-	}))
+// (PUT /api/v1/sources/{id}/change-rate-job)
+func (_ Unimplemented) StartChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (HEAD /api/v1/sources/{id}/image)
+func (_ Unimplemented) HeadImage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (GET /api/v1/sources/{id}/image-url)
+func (_ Unimplemented) GetSourceDownloadURL(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CalculateMigrationComplexity operation middleware
-func (siw *ServerInterfaceWrapper) CalculateMigrationComplexity(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (PUT /api/v1/sources/{id}/inventory)
+func (_ Unimplemented) UpdateInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// (DELETE /api/v1/troubleshooting-model)
+func (_ Unimplemented) DeleteTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+// (GET /api/v1/troubleshooting-model)
+func (_ Unimplemented) GetTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// (PUT /api/v1/troubleshooting-model)
+func (_ Unimplemented) SetTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CalculateMigrationComplexity(w, r, id)
-	}))
+// (GET /api/v1/webhooks)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// (GET /api/v1/webhooks/{id}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id string, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// (POST /api/v1/webhooks/{id}/redrive)
+func (_ Unimplemented) RedriveWebhook(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CalculateMigrationEstimation operation middleware
-func (siw *ServerInterfaceWrapper) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// (GET /health)
+func (_ Unimplemented) Health(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+type MiddlewareFunc func(http.Handler) http.Handler
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// DeleteAlertWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CalculateMigrationEstimation(w, r, id)
+		siw.Handler.DeleteAlertWebhook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -476,12 +810,12 @@ func (siw *ServerInterfaceWrapper) CalculateMigrationEstimation(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
+// GetAlertWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInfo(w, r)
+		siw.Handler.GetAlertWebhook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -491,12 +825,12 @@ func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteSources operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSources(w http.ResponseWriter, r *http.Request) {
+// SetAlertWebhook operation middleware
+func (siw *ServerInterfaceWrapper) SetAlertWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSources(w, r)
+		siw.Handler.SetAlertWebhook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -506,12 +840,25 @@ func (siw *ServerInterfaceWrapper) DeleteSources(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListSources operation middleware
-func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
+// ListAssessments operation middleware
+func (siw *ServerInterfaceWrapper) ListAssessments(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAssessmentsParams
+
+	// ------------- Optional query parameter "sourceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sourceId", r.URL.Query(), &params.SourceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSources(w, r)
+		siw.Handler.ListAssessments(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -521,12 +868,12 @@ func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateSource operation middleware
-func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.Request) {
+// CreateAssessment operation middleware
+func (siw *ServerInterfaceWrapper) CreateAssessment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSource(w, r)
+		siw.Handler.CreateAssessment(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -536,14 +883,14 @@ func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteSource operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.Request) {
+// CancelJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id int64
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -552,7 +899,7 @@ func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSource(w, r, id)
+		siw.Handler.CancelJob(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -562,14 +909,14 @@ func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSource operation middleware
-func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+// GetJob operation middleware
+func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id int64
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -578,7 +925,7 @@ func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSource(w, r, id)
+		siw.Handler.GetJob(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -588,23 +935,38 @@ func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateSource operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.Request) {
+// CreateRVToolsAssessment operation middleware
+func (siw *ServerInterfaceWrapper) CreateRVToolsAssessment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRVToolsAssessment(w, r)
+	}))
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteAssessment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAssessment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSource(w, r, id)
+		siw.Handler.DeleteAssessment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -614,8 +976,8 @@ func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HeadImage operation middleware
-func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Request) {
+// GetAssessment operation middleware
+func (siw *ServerInterfaceWrapper) GetAssessment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -630,7 +992,7 @@ func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HeadImage(w, r, id)
+		siw.Handler.GetAssessment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -640,8 +1002,8 @@ func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSourceDownloadURL operation middleware
-func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r *http.Request) {
+// UpdateAssessment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAssessment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -656,7 +1018,7 @@ func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSourceDownloadURL(w, r, id)
+		siw.Handler.UpdateAssessment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -666,8 +1028,8 @@ func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateInventory operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *http.Request) {
+// CalculateAssessmentClusterRequirements operation middleware
+func (siw *ServerInterfaceWrapper) CalculateAssessmentClusterRequirements(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -682,7 +1044,7 @@ func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateInventory(w, r, id)
+		siw.Handler.CalculateAssessmentClusterRequirements(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -692,12 +1054,23 @@ func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Health operation middleware
-func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+// CalculateMigrationComplexity operation middleware
+func (siw *ServerInterfaceWrapper) CalculateMigrationComplexity(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Health(w, r)
+		siw.Handler.CalculateMigrationComplexity(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -707,1450 +1080,9903 @@ func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// CreateEstimationFreeze operation middleware
+func (siw *ServerInterfaceWrapper) CreateEstimationFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEstimationFreeze(w, r, id)
+	}))
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RequiredParamError struct {
-	ParamName string
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// ListEstimationRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListEstimationRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEstimationRunsParams
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "clusterId" -------------
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "clusterId", r.URL.Query(), &params.ClusterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterId", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// ------------- Optional query parameter "limit" -------------
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEstimationRuns(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// CalculateFactoryThroughput operation middleware
+func (siw *ServerInterfaceWrapper) CalculateFactoryThroughput(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CalculateFactoryThroughput(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// CalculateMigrationEstimation operation middleware
+func (siw *ServerInterfaceWrapper) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CalculateMigrationEstimation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// GetCalculatorDefaults operation middleware
+func (siw *ServerInterfaceWrapper) GetCalculatorDefaults(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalculatorDefaults(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// SetCalculatorDefaults operation middleware
+func (siw *ServerInterfaceWrapper) SetCalculatorDefaults(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	if r == nil {
-		r = chi.NewRouter()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetCalculatorDefaults(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListChecklistTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListChecklistTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateChecklistTemplate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/assessments", wrapper.ListAssessments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/assessments", wrapper.CreateAssessment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/assessments/jobs/{id}", wrapper.CancelJob)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/assessments/jobs/{id}", wrapper.GetJob)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/assessments/rvtools", wrapper.CreateRVToolsAssessment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/assessments/{id}", wrapper.DeleteAssessment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/assessments/{id}", wrapper.GetAssessment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/assessments/{id}", wrapper.UpdateAssessment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/assessments/{id}/cluster-requirements", wrapper.CalculateAssessmentClusterRequirements)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/assessments/{id}/complexity-estimation", wrapper.CalculateMigrationComplexity)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/assessments/{id}/migration-estimation", wrapper.CalculateMigrationEstimation)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/info", wrapper.GetInfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/sources", wrapper.DeleteSources)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/sources", wrapper.ListSources)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/sources", wrapper.CreateSource)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/sources/{id}", wrapper.DeleteSource)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/sources/{id}", wrapper.GetSource)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/sources/{id}", wrapper.UpdateSource)
-	})
-	r.Group(func(r chi.Router) {
-		r.Head(options.BaseURL+"/api/v1/sources/{id}/image", wrapper.HeadImage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/sources/{id}/image-url", wrapper.GetSourceDownloadURL)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/sources/{id}/inventory", wrapper.UpdateInventory)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.Health)
-	})
-
-	return r
-}
-
-type ListAssessmentsRequestObject struct {
-	Params ListAssessmentsParams
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListAssessmentsResponseObject interface {
-	VisitListAssessmentsResponse(w http.ResponseWriter) error
-}
+// DeleteChecklistTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type ListAssessments200JSONResponse AssessmentList
+	var err error
 
-func (response ListAssessments200JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListAssessments401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteChecklistTemplate(w, r, id)
+	}))
 
-func (response ListAssessments401JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListAssessments500JSONResponse Error
+// GetContingencyPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetContingencyPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response ListAssessments500JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContingencyPolicy(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateAssessmentRequestObject struct {
-	Body *CreateAssessmentJSONRequestBody
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateAssessmentResponseObject interface {
-	VisitCreateAssessmentResponse(w http.ResponseWriter) error
-}
+// SetContingencyPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetContingencyPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type CreateAssessment201JSONResponse Assessment
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetContingencyPolicy(w, r)
+	}))
 
-func (response CreateAssessment201JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateAssessment400JSONResponse Error
+// CreateDebugBundle operation middleware
+func (siw *ServerInterfaceWrapper) CreateDebugBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateAssessment400JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDebugBundle(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateAssessment401JSONResponse Error
+// ListDurationDistributions operation middleware
+func (siw *ServerInterfaceWrapper) ListDurationDistributions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateAssessment401JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDurationDistributionsParams
 
-type CreateAssessment500JSONResponse Error
+	// ------------- Optional query parameter "family" -------------
 
-func (response CreateAssessment500JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "family", r.URL.Query(), &params.Family)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "family", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDurationDistributions(w, r, params)
+	}))
 
-type CancelJobRequestObject struct {
-	Id int64 `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CancelJobResponseObject interface {
-	VisitCancelJobResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CancelJob200JSONResponse Job
+// ListEstimationFreezes operation middleware
+func (siw *ServerInterfaceWrapper) ListEstimationFreezes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CancelJob200JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEstimationFreezesParams
 
-type CancelJob400JSONResponse Error
+	// ------------- Optional query parameter "sowId" -------------
 
-func (response CancelJob400JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "sowId", r.URL.Query(), &params.SowId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sowId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEstimationFreezes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CancelJob401JSONResponse Error
+// GetEstimationFreeze operation middleware
+func (siw *ServerInterfaceWrapper) GetEstimationFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CancelJob401JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type CancelJob403JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CancelJob403JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEstimationFreeze(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CancelJob404JSONResponse Error
+// DiffEstimationFreeze operation middleware
+func (siw *ServerInterfaceWrapper) DiffEstimationFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CancelJob404JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type CancelJob409JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CancelJob409JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffEstimationFreeze(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CancelJob500JSONResponse Error
+// GetEstimationFreezeReport operation middleware
+func (siw *ServerInterfaceWrapper) GetEstimationFreezeReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CancelJob500JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetJobRequestObject struct {
-	Id int64 `json:"id"`
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type GetJobResponseObject interface {
-	VisitGetJobResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetJob200JSONResponse Job
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEstimationFreezeReport(w, r, id)
+	}))
 
-func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetJob400JSONResponse Error
-
-func (response GetJob400JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetEstimationRun operation middleware
+func (siw *ServerInterfaceWrapper) GetEstimationRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetJob401JSONResponse Error
+	var err error
 
-func (response GetJob401JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetJob403JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEstimationRun(w, r, id)
+	}))
 
-func (response GetJob403JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetJob404JSONResponse Error
+// CompareEstimationRuns operation middleware
+func (siw *ServerInterfaceWrapper) CompareEstimationRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type GetJob500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetJob500JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompareEstimationRunsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "with" -------------
 
-type CreateRVToolsAssessmentRequestObject struct {
-	Body *multipart.Reader
-}
+	if paramValue := r.URL.Query().Get("with"); paramValue != "" {
 
-type CreateRVToolsAssessmentResponseObject interface {
-	VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "with"})
+		return
+	}
 
-type CreateRVToolsAssessment202JSONResponse Job
+	err = runtime.BindQueryParameter("form", true, true, "with", r.URL.Query(), &params.With)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "with", Err: err})
+		return
+	}
 
-func (response CreateRVToolsAssessment202JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompareEstimationRuns(w, r, id, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateRVToolsAssessment400JSONResponse Error
+// RunEstimation operation middleware
+func (siw *ServerInterfaceWrapper) RunEstimation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateRVToolsAssessment400JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunEstimation(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateRVToolsAssessment401JSONResponse Error
+// CompareEstimations operation middleware
+func (siw *ServerInterfaceWrapper) CompareEstimations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateRVToolsAssessment401JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompareEstimations(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateRVToolsAssessment500JSONResponse Error
+// SolveEstimationDeadline operation middleware
+func (siw *ServerInterfaceWrapper) SolveEstimationDeadline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateRVToolsAssessment500JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SolveEstimationDeadline(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteAssessmentRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteAssessmentResponseObject interface {
-	VisitDeleteAssessmentResponse(w http.ResponseWriter) error
-}
+// GetEstimationJob operation middleware
+func (siw *ServerInterfaceWrapper) GetEstimationJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type DeleteAssessment200JSONResponse Assessment
+	var err error
 
-func (response DeleteAssessment200JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type DeleteAssessment400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEstimationJob(w, r, id)
+	}))
 
-func (response DeleteAssessment400JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteAssessment401JSONResponse Error
+// ListEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response DeleteAssessment401JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventsParams
 
-type DeleteAssessment403JSONResponse Error
+	// ------------- Optional query parameter "after" -------------
 
-func (response DeleteAssessment403JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type DeleteAssessment404JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response DeleteAssessment404JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEvents(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteAssessment500JSONResponse Error
+// GetExportPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetExportPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response DeleteAssessment500JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportPolicy(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetAssessmentRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetAssessmentResponseObject interface {
-	VisitGetAssessmentResponse(w http.ResponseWriter) error
-}
+// SetExportPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetExportPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetAssessment200JSONResponse Assessment
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetExportPolicy(w, r)
+	}))
 
-func (response GetAssessment200JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetAssessment400JSONResponse Error
+// GetGuardrailPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetGuardrailPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetAssessment400JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGuardrailPolicy(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetAssessment401JSONResponse Error
+// SetGuardrailPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetGuardrailPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetAssessment401JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetGuardrailPolicy(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetAssessment403JSONResponse Error
+// GetInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetAssessment403JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInfo(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetAssessment404JSONResponse Error
+// GetInventoryFieldSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetInventoryFieldSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetAssessment404JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInventoryFieldSchema(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetAssessment500JSONResponse Error
+// SetInventoryFieldSchema operation middleware
+func (siw *ServerInterfaceWrapper) SetInventoryFieldSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetAssessment500JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetInventoryFieldSchema(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateAssessmentRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateAssessmentJSONRequestBody
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UpdateAssessmentResponseObject interface {
-	VisitUpdateAssessmentResponse(w http.ResponseWriter) error
-}
+// ListPlans operation middleware
+func (siw *ServerInterfaceWrapper) ListPlans(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type UpdateAssessment200JSONResponse Assessment
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPlans(w, r)
+	}))
 
-func (response UpdateAssessment200JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UpdateAssessment400JSONResponse Error
+// CreatePlan operation middleware
+func (siw *ServerInterfaceWrapper) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response UpdateAssessment400JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePlan(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UpdateAssessment401JSONResponse Error
+// DeletePlan operation middleware
+func (siw *ServerInterfaceWrapper) DeletePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response UpdateAssessment401JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type UpdateAssessment403JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response UpdateAssessment403JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePlan(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UpdateAssessment404JSONResponse Error
+// GetPlan operation middleware
+func (siw *ServerInterfaceWrapper) GetPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response UpdateAssessment404JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlan(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UpdateAssessment500JSONResponse Error
+// ImportPlanCalendar operation middleware
+func (siw *ServerInterfaceWrapper) ImportPlanCalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response UpdateAssessment500JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "pool" -------------
+	var pool string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pool", chi.URLParam(r, "pool"), &pool, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pool", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportPlanCalendarParams
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", r.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "region", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportPlanCalendar(w, r, id, pool, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CalculateAssessmentClusterRequirementsRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CalculateAssessmentClusterRequirementsJSONRequestBody
+// GetPlanChargeback operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanChargeback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlanChargebackParams
+
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rateCardId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rateCardId", r.URL.Query(), &params.RateCardId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rateCardId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanChargeback(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CalculateAssessmentClusterRequirementsResponseObject interface {
-	VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error
+// ListPlanChecklists operation middleware
+func (siw *ServerInterfaceWrapper) ListPlanChecklists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPlanChecklistsParams
+
+	// ------------- Optional query parameter "wave" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wave", r.URL.Query(), &params.Wave)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wave", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPlanChecklists(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CalculateAssessmentClusterRequirements200JSONResponse ClusterRequirementsResponse
+// InstantiatePlanChecklists operation middleware
+func (siw *ServerInterfaceWrapper) InstantiatePlanChecklists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InstantiatePlanChecklists(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CompletePlanChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) CompletePlanChecklistItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", chi.URLParam(r, "checklistId"), &checklistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "checklistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", chi.URLParam(r, "index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompletePlanChecklistItem(w, r, id, checklistId, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPlanCoverage operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanCoverage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanCoverage(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportPlan operation middleware
+func (siw *ServerInterfaceWrapper) ExportPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportPlanParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPlan(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPlanGantt operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanGantt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlanGanttParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", r.URL.Query(), &params.TimeZone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeZone", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanGantt(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ApprovePlanGate operation middleware
+func (siw *ServerInterfaceWrapper) ApprovePlanGate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "gate" -------------
+	var gate string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gate", chi.URLParam(r, "gate"), &gate, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gate", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApprovePlanGate(w, r, id, gate)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetPlanKPIs operation middleware
+func (siw *ServerInterfaceWrapper) SetPlanKPIs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetPlanKPIs(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPlanNamespaces operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanNamespaces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlanNamespacesParams
+
+	// ------------- Optional query parameter "strategy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strategy", r.URL.Query(), &params.Strategy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "strategy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "folderDepth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "folderDepth", r.URL.Query(), &params.FolderDepth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folderDepth", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", r.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanNamespaces(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPlanProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanProgress(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RecordPlanProgress operation middleware
+func (siw *ServerInterfaceWrapper) RecordPlanProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordPlanProgress(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportPlanSchedule operation middleware
+func (siw *ServerInterfaceWrapper) ImportPlanSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportPlanSchedule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPlanShares operation middleware
+func (siw *ServerInterfaceWrapper) ListPlanShares(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPlanShares(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreatePlanShare operation middleware
+func (siw *ServerInterfaceWrapper) CreatePlanShare(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePlanShare(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RevokePlanShare operation middleware
+func (siw *ServerInterfaceWrapper) RevokePlanShare(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "shareId" -------------
+	var shareId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shareId", chi.URLParam(r, "shareId"), &shareId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shareId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokePlanShare(w, r, id, shareId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RecordPlanVMActuals operation middleware
+func (siw *ServerInterfaceWrapper) RecordPlanVMActuals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordPlanVMActuals(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPlanVMEffort operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanVMEffort(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "vm" -------------
+	var vm string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vm", chi.URLParam(r, "vm"), &vm, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vm", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanVMEffort(w, r, id, vm)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPlanWaveLive operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanWaveLive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "n" -------------
+	var n int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "n", chi.URLParam(r, "n"), &n, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "n", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanWaveLive(w, r, id, n)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RecordPlanWaveTracking operation middleware
+func (siw *ServerInterfaceWrapper) RecordPlanWaveTracking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "n" -------------
+	var n int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "n", chi.URLParam(r, "n"), &n, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "n", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordPlanWaveTracking(w, r, id, n)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SimulatePlanStaffing operation middleware
+func (siw *ServerInterfaceWrapper) SimulatePlanStaffing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SimulatePlanStaffing(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetProgramForecast operation middleware
+func (siw *ServerInterfaceWrapper) GetProgramForecast(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProgramForecastParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fiscalYearStartMonth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fiscalYearStartMonth", r.URL.Query(), &params.FiscalYearStartMonth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fiscalYearStartMonth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProgramForecast(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListRateCards operation middleware
+func (siw *ServerInterfaceWrapper) ListRateCards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRateCardsParams
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRateCards(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateRateCard operation middleware
+func (siw *ServerInterfaceWrapper) CreateRateCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRateCard(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteRateCard operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRateCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRateCard(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRateCard operation middleware
+func (siw *ServerInterfaceWrapper) GetRateCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRateCard(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreatePortfolioReport operation middleware
+func (siw *ServerInterfaceWrapper) CreatePortfolioReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePortfolioReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPortfolioReport operation middleware
+func (siw *ServerInterfaceWrapper) GetPortfolioReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPortfolioReport(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteSources operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSources(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListSources operation middleware
+func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSources(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateSource operation middleware
+func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSource(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteSource operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSource operation middleware
+func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSource operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetChangeRateJob operation middleware
+func (siw *ServerInterfaceWrapper) GetChangeRateJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChangeRateJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// StartChangeRateJob operation middleware
+func (siw *ServerInterfaceWrapper) StartChangeRateJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartChangeRateJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HeadImage operation middleware
+func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HeadImage(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSourceDownloadURL operation middleware
+func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSourceDownloadURL(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateInventory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateInventory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteTroubleshootingModel operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTroubleshootingModel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTroubleshootingModel operation middleware
+func (siw *ServerInterfaceWrapper) GetTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTroubleshootingModel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetTroubleshootingModel operation middleware
+func (siw *ServerInterfaceWrapper) SetTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTroubleshootingModel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RedriveWebhook operation middleware
+func (siw *ServerInterfaceWrapper) RedriveWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedriveWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/alert-webhook", wrapper.DeleteAlertWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/alert-webhook", wrapper.GetAlertWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/alert-webhook", wrapper.SetAlertWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments", wrapper.ListAssessments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments", wrapper.CreateAssessment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/assessments/jobs/{id}", wrapper.CancelJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/jobs/{id}", wrapper.GetJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/rvtools", wrapper.CreateRVToolsAssessment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/assessments/{id}", wrapper.DeleteAssessment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}", wrapper.GetAssessment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/assessments/{id}", wrapper.UpdateAssessment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/cluster-requirements", wrapper.CalculateAssessmentClusterRequirements)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/complexity-estimation", wrapper.CalculateMigrationComplexity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/estimation-freezes", wrapper.CreateEstimationFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}/estimation-runs", wrapper.ListEstimationRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/factory-throughput", wrapper.CalculateFactoryThroughput)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/migration-estimation", wrapper.CalculateMigrationEstimation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/calculator-defaults", wrapper.GetCalculatorDefaults)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/calculator-defaults", wrapper.SetCalculatorDefaults)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/checklist-templates", wrapper.ListChecklistTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/checklist-templates", wrapper.CreateChecklistTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/checklist-templates/{id}", wrapper.DeleteChecklistTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/contingency-policy", wrapper.GetContingencyPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/contingency-policy", wrapper.SetContingencyPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/debug/bundle", wrapper.CreateDebugBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/duration-distributions", wrapper.ListDurationDistributions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/estimation-freezes", wrapper.ListEstimationFreezes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/estimation-freezes/{id}", wrapper.GetEstimationFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/estimation-freezes/{id}/diff", wrapper.DiffEstimationFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/estimation-freezes/{id}/report", wrapper.GetEstimationFreezeReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/estimation-runs/{id}", wrapper.GetEstimationRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/estimation-runs/{id}/comparison", wrapper.CompareEstimationRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/estimations", wrapper.RunEstimation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/estimations/comparison", wrapper.CompareEstimations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/estimations/solve", wrapper.SolveEstimationDeadline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/estimations/{id}", wrapper.GetEstimationJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/events", wrapper.ListEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/export-policy", wrapper.GetExportPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/export-policy", wrapper.SetExportPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/guardrail-policy", wrapper.GetGuardrailPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/guardrail-policy", wrapper.SetGuardrailPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/info", wrapper.GetInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/inventory-fields", wrapper.GetInventoryFieldSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/inventory-fields", wrapper.SetInventoryFieldSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans", wrapper.ListPlans)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/plans", wrapper.CreatePlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/plans/{id}", wrapper.DeletePlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}", wrapper.GetPlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/plans/{id}/calendars/{pool}", wrapper.ImportPlanCalendar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/chargeback", wrapper.GetPlanChargeback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/checklists", wrapper.ListPlanChecklists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/plans/{id}/checklists", wrapper.InstantiatePlanChecklists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/plans/{id}/checklists/{checklistId}/items/{index}", wrapper.CompletePlanChecklistItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/coverage", wrapper.GetPlanCoverage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/export", wrapper.ExportPlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/gantt", wrapper.GetPlanGantt)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/plans/{id}/gates/{gate}/approvals", wrapper.ApprovePlanGate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/plans/{id}/kpis", wrapper.SetPlanKPIs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/namespaces", wrapper.GetPlanNamespaces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/progress", wrapper.GetPlanProgress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/plans/{id}/progress", wrapper.RecordPlanProgress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/plans/{id}/schedule", wrapper.ImportPlanSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/shares", wrapper.ListPlanShares)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/plans/{id}/shares", wrapper.CreatePlanShare)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/plans/{id}/shares/{shareId}", wrapper.RevokePlanShare)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/plans/{id}/vm-actuals", wrapper.RecordPlanVMActuals)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/vms/{vm}/effort", wrapper.GetPlanVMEffort)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/plans/{id}/waves/{n}/live", wrapper.GetPlanWaveLive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/plans/{id}/waves/{n}/tracking", wrapper.RecordPlanWaveTracking)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/plans/{id}/what-if", wrapper.SimulatePlanStaffing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/programs/{id}/forecast", wrapper.GetProgramForecast)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/rate-cards", wrapper.ListRateCards)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/rate-cards", wrapper.CreateRateCard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/rate-cards/{id}", wrapper.DeleteRateCard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/rate-cards/{id}", wrapper.GetRateCard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/reports/portfolio", wrapper.CreatePortfolioReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/reports/portfolio/{id}", wrapper.GetPortfolioReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/sources", wrapper.DeleteSources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sources", wrapper.ListSources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/sources", wrapper.CreateSource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/sources/{id}", wrapper.DeleteSource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sources/{id}", wrapper.GetSource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/sources/{id}", wrapper.UpdateSource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sources/{id}/change-rate-job", wrapper.GetChangeRateJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/sources/{id}/change-rate-job", wrapper.StartChangeRateJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Head(options.BaseURL+"/api/v1/sources/{id}/image", wrapper.HeadImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sources/{id}/image-url", wrapper.GetSourceDownloadURL)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/sources/{id}/inventory", wrapper.UpdateInventory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/troubleshooting-model", wrapper.DeleteTroubleshootingModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/troubleshooting-model", wrapper.GetTroubleshootingModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/troubleshooting-model", wrapper.SetTroubleshootingModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/webhooks/{id}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/webhooks/{id}/redrive", wrapper.RedriveWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.Health)
+	})
+
+	return r
+}
+
+type DeleteAlertWebhookRequestObject struct {
+}
+
+type DeleteAlertWebhookResponseObject interface {
+	VisitDeleteAlertWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteAlertWebhook200JSONResponse AlertWebhook
+
+func (response DeleteAlertWebhook200JSONResponse) VisitDeleteAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertWebhook401JSONResponse Error
+
+func (response DeleteAlertWebhook401JSONResponse) VisitDeleteAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertWebhook403JSONResponse Error
+
+func (response DeleteAlertWebhook403JSONResponse) VisitDeleteAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertWebhook404JSONResponse Error
+
+func (response DeleteAlertWebhook404JSONResponse) VisitDeleteAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAlertWebhook500JSONResponse Error
+
+func (response DeleteAlertWebhook500JSONResponse) VisitDeleteAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertWebhookRequestObject struct {
+}
+
+type GetAlertWebhookResponseObject interface {
+	VisitGetAlertWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetAlertWebhook200JSONResponse AlertWebhook
+
+func (response GetAlertWebhook200JSONResponse) VisitGetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertWebhook401JSONResponse Error
+
+func (response GetAlertWebhook401JSONResponse) VisitGetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertWebhook403JSONResponse Error
+
+func (response GetAlertWebhook403JSONResponse) VisitGetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertWebhook404JSONResponse Error
+
+func (response GetAlertWebhook404JSONResponse) VisitGetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlertWebhook500JSONResponse Error
+
+func (response GetAlertWebhook500JSONResponse) VisitGetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAlertWebhookRequestObject struct {
+	Body *SetAlertWebhookJSONRequestBody
+}
+
+type SetAlertWebhookResponseObject interface {
+	VisitSetAlertWebhookResponse(w http.ResponseWriter) error
+}
+
+type SetAlertWebhook200JSONResponse AlertWebhook
+
+func (response SetAlertWebhook200JSONResponse) VisitSetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAlertWebhook400JSONResponse Error
+
+func (response SetAlertWebhook400JSONResponse) VisitSetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAlertWebhook401JSONResponse Error
+
+func (response SetAlertWebhook401JSONResponse) VisitSetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAlertWebhook403JSONResponse Error
+
+func (response SetAlertWebhook403JSONResponse) VisitSetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAlertWebhook500JSONResponse Error
+
+func (response SetAlertWebhook500JSONResponse) VisitSetAlertWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentsRequestObject struct {
+	Params ListAssessmentsParams
+}
+
+type ListAssessmentsResponseObject interface {
+	VisitListAssessmentsResponse(w http.ResponseWriter) error
+}
+
+type ListAssessments200JSONResponse AssessmentList
+
+func (response ListAssessments200JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessments401JSONResponse Error
+
+func (response ListAssessments401JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessments500JSONResponse Error
+
+func (response ListAssessments500JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessmentRequestObject struct {
+	Body *CreateAssessmentJSONRequestBody
+}
+
+type CreateAssessmentResponseObject interface {
+	VisitCreateAssessmentResponse(w http.ResponseWriter) error
+}
+
+type CreateAssessment201JSONResponse Assessment
+
+func (response CreateAssessment201JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessment400JSONResponse Error
+
+func (response CreateAssessment400JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessment401JSONResponse Error
+
+func (response CreateAssessment401JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessment500JSONResponse Error
+
+func (response CreateAssessment500JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJobRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type CancelJobResponseObject interface {
+	VisitCancelJobResponse(w http.ResponseWriter) error
+}
+
+type CancelJob200JSONResponse Job
+
+func (response CancelJob200JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob400JSONResponse Error
+
+func (response CancelJob400JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob401JSONResponse Error
+
+func (response CancelJob401JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob403JSONResponse Error
+
+func (response CancelJob403JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob404JSONResponse Error
+
+func (response CancelJob404JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob409JSONResponse Error
+
+func (response CancelJob409JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob500JSONResponse Error
+
+func (response CancelJob500JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetJobResponseObject interface {
+	VisitGetJobResponse(w http.ResponseWriter) error
+}
+
+type GetJob200JSONResponse Job
+
+func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob400JSONResponse Error
+
+func (response GetJob400JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob401JSONResponse Error
+
+func (response GetJob401JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob403JSONResponse Error
+
+func (response GetJob403JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob404JSONResponse Error
+
+func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob500JSONResponse Error
+
+func (response GetJob500JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessmentRequestObject struct {
+	Body *multipart.Reader
+}
+
+type CreateRVToolsAssessmentResponseObject interface {
+	VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error
+}
+
+type CreateRVToolsAssessment202JSONResponse Job
+
+func (response CreateRVToolsAssessment202JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessment400JSONResponse Error
+
+func (response CreateRVToolsAssessment400JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessment401JSONResponse Error
+
+func (response CreateRVToolsAssessment401JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessment500JSONResponse Error
+
+func (response CreateRVToolsAssessment500JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessmentRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteAssessmentResponseObject interface {
+	VisitDeleteAssessmentResponse(w http.ResponseWriter) error
+}
+
+type DeleteAssessment200JSONResponse Assessment
+
+func (response DeleteAssessment200JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment400JSONResponse Error
+
+func (response DeleteAssessment400JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment401JSONResponse Error
+
+func (response DeleteAssessment401JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment403JSONResponse Error
+
+func (response DeleteAssessment403JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment404JSONResponse Error
+
+func (response DeleteAssessment404JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment500JSONResponse Error
+
+func (response DeleteAssessment500JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetAssessmentResponseObject interface {
+	VisitGetAssessmentResponse(w http.ResponseWriter) error
+}
+
+type GetAssessment200JSONResponse Assessment
+
+func (response GetAssessment200JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment400JSONResponse Error
+
+func (response GetAssessment400JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment401JSONResponse Error
+
+func (response GetAssessment401JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment403JSONResponse Error
+
+func (response GetAssessment403JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment404JSONResponse Error
+
+func (response GetAssessment404JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment500JSONResponse Error
+
+func (response GetAssessment500JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessmentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateAssessmentJSONRequestBody
+}
+
+type UpdateAssessmentResponseObject interface {
+	VisitUpdateAssessmentResponse(w http.ResponseWriter) error
+}
+
+type UpdateAssessment200JSONResponse Assessment
+
+func (response UpdateAssessment200JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment400JSONResponse Error
+
+func (response UpdateAssessment400JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment401JSONResponse Error
+
+func (response UpdateAssessment401JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment403JSONResponse Error
+
+func (response UpdateAssessment403JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment404JSONResponse Error
+
+func (response UpdateAssessment404JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment500JSONResponse Error
+
+func (response UpdateAssessment500JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirementsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateAssessmentClusterRequirementsJSONRequestBody
+}
+
+type CalculateAssessmentClusterRequirementsResponseObject interface {
+	VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error
+}
+
+type CalculateAssessmentClusterRequirements200JSONResponse ClusterRequirementsResponse
+
+func (response CalculateAssessmentClusterRequirements200JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements400JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements400JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements401JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements401JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements403JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements403JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements404JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements404JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements500JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements500JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements503JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements503JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexityRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateMigrationComplexityJSONRequestBody
+}
+
+type CalculateMigrationComplexityResponseObject interface {
+	VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error
+}
+
+type CalculateMigrationComplexity200JSONResponse MigrationComplexityResponse
+
+func (response CalculateMigrationComplexity200JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity400JSONResponse Error
+
+func (response CalculateMigrationComplexity400JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity401JSONResponse Error
+
+func (response CalculateMigrationComplexity401JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity403JSONResponse Error
+
+func (response CalculateMigrationComplexity403JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity404JSONResponse Error
+
+func (response CalculateMigrationComplexity404JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity500JSONResponse Error
+
+func (response CalculateMigrationComplexity500JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEstimationFreezeRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreateEstimationFreezeJSONRequestBody
+}
+
+type CreateEstimationFreezeResponseObject interface {
+	VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error
+}
+
+type CreateEstimationFreeze201JSONResponse EstimationFreeze
+
+func (response CreateEstimationFreeze201JSONResponse) VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEstimationFreeze400JSONResponse Error
+
+func (response CreateEstimationFreeze400JSONResponse) VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEstimationFreeze401JSONResponse Error
+
+func (response CreateEstimationFreeze401JSONResponse) VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEstimationFreeze403JSONResponse Error
+
+func (response CreateEstimationFreeze403JSONResponse) VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEstimationFreeze404JSONResponse Error
+
+func (response CreateEstimationFreeze404JSONResponse) VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEstimationFreeze409JSONResponse Error
+
+func (response CreateEstimationFreeze409JSONResponse) VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEstimationFreeze500JSONResponse Error
+
+func (response CreateEstimationFreeze500JSONResponse) VisitCreateEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationRunsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ListEstimationRunsParams
+}
+
+type ListEstimationRunsResponseObject interface {
+	VisitListEstimationRunsResponse(w http.ResponseWriter) error
+}
+
+type ListEstimationRuns200JSONResponse EstimationRunList
+
+func (response ListEstimationRuns200JSONResponse) VisitListEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationRuns401JSONResponse Error
+
+func (response ListEstimationRuns401JSONResponse) VisitListEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationRuns403JSONResponse Error
+
+func (response ListEstimationRuns403JSONResponse) VisitListEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationRuns404JSONResponse Error
+
+func (response ListEstimationRuns404JSONResponse) VisitListEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationRuns500JSONResponse Error
+
+func (response ListEstimationRuns500JSONResponse) VisitListEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateFactoryThroughputRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateFactoryThroughputJSONRequestBody
+}
+
+type CalculateFactoryThroughputResponseObject interface {
+	VisitCalculateFactoryThroughputResponse(w http.ResponseWriter) error
+}
+
+type CalculateFactoryThroughput200JSONResponse FactoryThroughput
+
+func (response CalculateFactoryThroughput200JSONResponse) VisitCalculateFactoryThroughputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateFactoryThroughput400JSONResponse Error
+
+func (response CalculateFactoryThroughput400JSONResponse) VisitCalculateFactoryThroughputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateFactoryThroughput401JSONResponse Error
+
+func (response CalculateFactoryThroughput401JSONResponse) VisitCalculateFactoryThroughputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateFactoryThroughput403JSONResponse Error
+
+func (response CalculateFactoryThroughput403JSONResponse) VisitCalculateFactoryThroughputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateFactoryThroughput404JSONResponse Error
+
+func (response CalculateFactoryThroughput404JSONResponse) VisitCalculateFactoryThroughputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateFactoryThroughput500JSONResponse Error
+
+func (response CalculateFactoryThroughput500JSONResponse) VisitCalculateFactoryThroughputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateMigrationEstimationJSONRequestBody
+}
+
+type CalculateMigrationEstimationResponseObject interface {
+	VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error
+}
+
+type CalculateMigrationEstimation200JSONResponse MigrationEstimationResponse
+
+func (response CalculateMigrationEstimation200JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation400JSONResponse Error
+
+func (response CalculateMigrationEstimation400JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation401JSONResponse Error
+
+func (response CalculateMigrationEstimation401JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation403JSONResponse Error
+
+func (response CalculateMigrationEstimation403JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation404JSONResponse Error
+
+func (response CalculateMigrationEstimation404JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation500JSONResponse Error
+
+func (response CalculateMigrationEstimation500JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculatorDefaultsRequestObject struct {
+}
+
+type GetCalculatorDefaultsResponseObject interface {
+	VisitGetCalculatorDefaultsResponse(w http.ResponseWriter) error
+}
+
+type GetCalculatorDefaults200JSONResponse CalculatorDefaults
+
+func (response GetCalculatorDefaults200JSONResponse) VisitGetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculatorDefaults401JSONResponse Error
+
+func (response GetCalculatorDefaults401JSONResponse) VisitGetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalculatorDefaults500JSONResponse Error
+
+func (response GetCalculatorDefaults500JSONResponse) VisitGetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCalculatorDefaultsRequestObject struct {
+	Body *SetCalculatorDefaultsJSONRequestBody
+}
+
+type SetCalculatorDefaultsResponseObject interface {
+	VisitSetCalculatorDefaultsResponse(w http.ResponseWriter) error
+}
+
+type SetCalculatorDefaults200JSONResponse CalculatorDefaults
+
+func (response SetCalculatorDefaults200JSONResponse) VisitSetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCalculatorDefaults400JSONResponse Error
+
+func (response SetCalculatorDefaults400JSONResponse) VisitSetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCalculatorDefaults401JSONResponse Error
+
+func (response SetCalculatorDefaults401JSONResponse) VisitSetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCalculatorDefaults403JSONResponse Error
+
+func (response SetCalculatorDefaults403JSONResponse) VisitSetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCalculatorDefaults500JSONResponse Error
+
+func (response SetCalculatorDefaults500JSONResponse) VisitSetCalculatorDefaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListChecklistTemplatesRequestObject struct {
+}
+
+type ListChecklistTemplatesResponseObject interface {
+	VisitListChecklistTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListChecklistTemplates200JSONResponse ChecklistTemplateList
+
+func (response ListChecklistTemplates200JSONResponse) VisitListChecklistTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListChecklistTemplates401JSONResponse Error
+
+func (response ListChecklistTemplates401JSONResponse) VisitListChecklistTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListChecklistTemplates500JSONResponse Error
+
+func (response ListChecklistTemplates500JSONResponse) VisitListChecklistTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistTemplateRequestObject struct {
+	Body *CreateChecklistTemplateJSONRequestBody
+}
+
+type CreateChecklistTemplateResponseObject interface {
+	VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklistTemplate201JSONResponse ChecklistTemplate
+
+func (response CreateChecklistTemplate201JSONResponse) VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistTemplate400JSONResponse Error
+
+func (response CreateChecklistTemplate400JSONResponse) VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistTemplate401JSONResponse Error
+
+func (response CreateChecklistTemplate401JSONResponse) VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistTemplate409JSONResponse Error
+
+func (response CreateChecklistTemplate409JSONResponse) VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistTemplate500JSONResponse Error
+
+func (response CreateChecklistTemplate500JSONResponse) VisitCreateChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistTemplateRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteChecklistTemplateResponseObject interface {
+	VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteChecklistTemplate200JSONResponse ChecklistTemplate
+
+func (response DeleteChecklistTemplate200JSONResponse) VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistTemplate401JSONResponse Error
+
+func (response DeleteChecklistTemplate401JSONResponse) VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistTemplate404JSONResponse Error
+
+func (response DeleteChecklistTemplate404JSONResponse) VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistTemplate500JSONResponse Error
+
+func (response DeleteChecklistTemplate500JSONResponse) VisitDeleteChecklistTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContingencyPolicyRequestObject struct {
+}
+
+type GetContingencyPolicyResponseObject interface {
+	VisitGetContingencyPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetContingencyPolicy200JSONResponse ContingencyPolicy
+
+func (response GetContingencyPolicy200JSONResponse) VisitGetContingencyPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContingencyPolicy401JSONResponse Error
+
+func (response GetContingencyPolicy401JSONResponse) VisitGetContingencyPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContingencyPolicy500JSONResponse Error
+
+func (response GetContingencyPolicy500JSONResponse) VisitGetContingencyPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetContingencyPolicyRequestObject struct {
+	Body *SetContingencyPolicyJSONRequestBody
+}
+
+type SetContingencyPolicyResponseObject interface {
+	VisitSetContingencyPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetContingencyPolicy200JSONResponse ContingencyPolicy
+
+func (response SetContingencyPolicy200JSONResponse) VisitSetContingencyPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetContingencyPolicy400JSONResponse Error
+
+func (response SetContingencyPolicy400JSONResponse) VisitSetContingencyPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetContingencyPolicy401JSONResponse Error
+
+func (response SetContingencyPolicy401JSONResponse) VisitSetContingencyPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetContingencyPolicy500JSONResponse Error
+
+func (response SetContingencyPolicy500JSONResponse) VisitSetContingencyPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebugBundleRequestObject struct {
+	Body *CreateDebugBundleJSONRequestBody
+}
+
+type CreateDebugBundleResponseObject interface {
+	VisitCreateDebugBundleResponse(w http.ResponseWriter) error
+}
+
+type CreateDebugBundle200ApplicationgzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response CreateDebugBundle200ApplicationgzipResponse) VisitCreateDebugBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type CreateDebugBundle400JSONResponse Error
+
+func (response CreateDebugBundle400JSONResponse) VisitCreateDebugBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebugBundle401JSONResponse Error
+
+func (response CreateDebugBundle401JSONResponse) VisitCreateDebugBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebugBundle403JSONResponse Error
+
+func (response CreateDebugBundle403JSONResponse) VisitCreateDebugBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebugBundle404JSONResponse Error
+
+func (response CreateDebugBundle404JSONResponse) VisitCreateDebugBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDebugBundle500JSONResponse Error
+
+func (response CreateDebugBundle500JSONResponse) VisitCreateDebugBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDurationDistributionsRequestObject struct {
+	Params ListDurationDistributionsParams
+}
+
+type ListDurationDistributionsResponseObject interface {
+	VisitListDurationDistributionsResponse(w http.ResponseWriter) error
+}
+
+type ListDurationDistributions200JSONResponse DurationDistributionList
+
+func (response ListDurationDistributions200JSONResponse) VisitListDurationDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDurationDistributions400JSONResponse Error
+
+func (response ListDurationDistributions400JSONResponse) VisitListDurationDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDurationDistributions401JSONResponse Error
+
+func (response ListDurationDistributions401JSONResponse) VisitListDurationDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDurationDistributions500JSONResponse Error
+
+func (response ListDurationDistributions500JSONResponse) VisitListDurationDistributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationFreezesRequestObject struct {
+	Params ListEstimationFreezesParams
+}
+
+type ListEstimationFreezesResponseObject interface {
+	VisitListEstimationFreezesResponse(w http.ResponseWriter) error
+}
+
+type ListEstimationFreezes200JSONResponse EstimationFreezeList
+
+func (response ListEstimationFreezes200JSONResponse) VisitListEstimationFreezesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationFreezes401JSONResponse Error
+
+func (response ListEstimationFreezes401JSONResponse) VisitListEstimationFreezesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEstimationFreezes500JSONResponse Error
+
+func (response ListEstimationFreezes500JSONResponse) VisitListEstimationFreezesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreezeRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetEstimationFreezeResponseObject interface {
+	VisitGetEstimationFreezeResponse(w http.ResponseWriter) error
+}
+
+type GetEstimationFreeze200JSONResponse EstimationFreeze
+
+func (response GetEstimationFreeze200JSONResponse) VisitGetEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreeze401JSONResponse Error
+
+func (response GetEstimationFreeze401JSONResponse) VisitGetEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreeze403JSONResponse Error
+
+func (response GetEstimationFreeze403JSONResponse) VisitGetEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreeze404JSONResponse Error
+
+func (response GetEstimationFreeze404JSONResponse) VisitGetEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreeze500JSONResponse Error
+
+func (response GetEstimationFreeze500JSONResponse) VisitGetEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEstimationFreezeRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DiffEstimationFreezeResponseObject interface {
+	VisitDiffEstimationFreezeResponse(w http.ResponseWriter) error
+}
+
+type DiffEstimationFreeze200JSONResponse EstimationFreezeDiff
+
+func (response DiffEstimationFreeze200JSONResponse) VisitDiffEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEstimationFreeze401JSONResponse Error
+
+func (response DiffEstimationFreeze401JSONResponse) VisitDiffEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEstimationFreeze403JSONResponse Error
+
+func (response DiffEstimationFreeze403JSONResponse) VisitDiffEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEstimationFreeze404JSONResponse Error
+
+func (response DiffEstimationFreeze404JSONResponse) VisitDiffEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEstimationFreeze500JSONResponse Error
+
+func (response DiffEstimationFreeze500JSONResponse) VisitDiffEstimationFreezeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreezeReportRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetEstimationFreezeReportResponseObject interface {
+	VisitGetEstimationFreezeReportResponse(w http.ResponseWriter) error
+}
+
+type GetEstimationFreezeReport200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEstimationFreezeReport200ApplicationpdfResponse) VisitGetEstimationFreezeReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEstimationFreezeReport401JSONResponse Error
+
+func (response GetEstimationFreezeReport401JSONResponse) VisitGetEstimationFreezeReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreezeReport403JSONResponse Error
+
+func (response GetEstimationFreezeReport403JSONResponse) VisitGetEstimationFreezeReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreezeReport404JSONResponse Error
+
+func (response GetEstimationFreezeReport404JSONResponse) VisitGetEstimationFreezeReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationFreezeReport500JSONResponse Error
+
+func (response GetEstimationFreezeReport500JSONResponse) VisitGetEstimationFreezeReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationRunRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetEstimationRunResponseObject interface {
+	VisitGetEstimationRunResponse(w http.ResponseWriter) error
+}
+
+type GetEstimationRun200JSONResponse EstimationRun
+
+func (response GetEstimationRun200JSONResponse) VisitGetEstimationRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationRun401JSONResponse Error
+
+func (response GetEstimationRun401JSONResponse) VisitGetEstimationRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationRun403JSONResponse Error
+
+func (response GetEstimationRun403JSONResponse) VisitGetEstimationRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationRun404JSONResponse Error
+
+func (response GetEstimationRun404JSONResponse) VisitGetEstimationRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationRun500JSONResponse Error
+
+func (response GetEstimationRun500JSONResponse) VisitGetEstimationRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimationRunsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params CompareEstimationRunsParams
+}
+
+type CompareEstimationRunsResponseObject interface {
+	VisitCompareEstimationRunsResponse(w http.ResponseWriter) error
+}
+
+type CompareEstimationRuns200JSONResponse EstimationRunComparison
+
+func (response CompareEstimationRuns200JSONResponse) VisitCompareEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimationRuns401JSONResponse Error
+
+func (response CompareEstimationRuns401JSONResponse) VisitCompareEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimationRuns403JSONResponse Error
+
+func (response CompareEstimationRuns403JSONResponse) VisitCompareEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimationRuns404JSONResponse Error
+
+func (response CompareEstimationRuns404JSONResponse) VisitCompareEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimationRuns500JSONResponse Error
+
+func (response CompareEstimationRuns500JSONResponse) VisitCompareEstimationRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEstimationRequestObject struct {
+	Body *RunEstimationJSONRequestBody
+}
+
+type RunEstimationResponseObject interface {
+	VisitRunEstimationResponse(w http.ResponseWriter) error
+}
+
+type RunEstimation200JSONResponse MigrationEstimationResponse
+
+func (response RunEstimation200JSONResponse) VisitRunEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEstimation202JSONResponse EstimationJob
+
+func (response RunEstimation202JSONResponse) VisitRunEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEstimation400JSONResponse Error
+
+func (response RunEstimation400JSONResponse) VisitRunEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEstimation401JSONResponse Error
+
+func (response RunEstimation401JSONResponse) VisitRunEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEstimation500JSONResponse Error
+
+func (response RunEstimation500JSONResponse) VisitRunEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimationsRequestObject struct {
+	Body *CompareEstimationsJSONRequestBody
+}
+
+type CompareEstimationsResponseObject interface {
+	VisitCompareEstimationsResponse(w http.ResponseWriter) error
+}
+
+type CompareEstimations200JSONResponse EstimationComparison
+
+func (response CompareEstimations200JSONResponse) VisitCompareEstimationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimations400JSONResponse Error
+
+func (response CompareEstimations400JSONResponse) VisitCompareEstimationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimations401JSONResponse Error
+
+func (response CompareEstimations401JSONResponse) VisitCompareEstimationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareEstimations500JSONResponse Error
+
+func (response CompareEstimations500JSONResponse) VisitCompareEstimationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SolveEstimationDeadlineRequestObject struct {
+	Body *SolveEstimationDeadlineJSONRequestBody
+}
+
+type SolveEstimationDeadlineResponseObject interface {
+	VisitSolveEstimationDeadlineResponse(w http.ResponseWriter) error
+}
+
+type SolveEstimationDeadline200JSONResponse EstimationSolution
+
+func (response SolveEstimationDeadline200JSONResponse) VisitSolveEstimationDeadlineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SolveEstimationDeadline400JSONResponse Error
+
+func (response SolveEstimationDeadline400JSONResponse) VisitSolveEstimationDeadlineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SolveEstimationDeadline401JSONResponse Error
+
+func (response SolveEstimationDeadline401JSONResponse) VisitSolveEstimationDeadlineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SolveEstimationDeadline500JSONResponse Error
+
+func (response SolveEstimationDeadline500JSONResponse) VisitSolveEstimationDeadlineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationJobRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetEstimationJobResponseObject interface {
+	VisitGetEstimationJobResponse(w http.ResponseWriter) error
+}
+
+type GetEstimationJob200JSONResponse EstimationJob
+
+func (response GetEstimationJob200JSONResponse) VisitGetEstimationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationJob401JSONResponse Error
+
+func (response GetEstimationJob401JSONResponse) VisitGetEstimationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationJob403JSONResponse Error
+
+func (response GetEstimationJob403JSONResponse) VisitGetEstimationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationJob404JSONResponse Error
+
+func (response GetEstimationJob404JSONResponse) VisitGetEstimationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEstimationJob500JSONResponse Error
+
+func (response GetEstimationJob500JSONResponse) VisitGetEstimationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventsRequestObject struct {
+	Params ListEventsParams
+}
+
+type ListEventsResponseObject interface {
+	VisitListEventsResponse(w http.ResponseWriter) error
+}
+
+type ListEvents200JSONResponse EventPage
+
+func (response ListEvents200JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvents400JSONResponse Error
+
+func (response ListEvents400JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvents401JSONResponse Error
+
+func (response ListEvents401JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvents403JSONResponse Error
+
+func (response ListEvents403JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvents500JSONResponse Error
+
+func (response ListEvents500JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportPolicyRequestObject struct {
+}
+
+type GetExportPolicyResponseObject interface {
+	VisitGetExportPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetExportPolicy200JSONResponse ExportPolicy
+
+func (response GetExportPolicy200JSONResponse) VisitGetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportPolicy401JSONResponse Error
+
+func (response GetExportPolicy401JSONResponse) VisitGetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportPolicy500JSONResponse Error
+
+func (response GetExportPolicy500JSONResponse) VisitGetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetExportPolicyRequestObject struct {
+	Body *SetExportPolicyJSONRequestBody
+}
+
+type SetExportPolicyResponseObject interface {
+	VisitSetExportPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetExportPolicy200JSONResponse ExportPolicy
+
+func (response SetExportPolicy200JSONResponse) VisitSetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetExportPolicy400JSONResponse Error
+
+func (response SetExportPolicy400JSONResponse) VisitSetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetExportPolicy401JSONResponse Error
+
+func (response SetExportPolicy401JSONResponse) VisitSetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetExportPolicy403JSONResponse Error
+
+func (response SetExportPolicy403JSONResponse) VisitSetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetExportPolicy500JSONResponse Error
+
+func (response SetExportPolicy500JSONResponse) VisitSetExportPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGuardrailPolicyRequestObject struct {
+}
+
+type GetGuardrailPolicyResponseObject interface {
+	VisitGetGuardrailPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetGuardrailPolicy200JSONResponse GuardrailPolicy
+
+func (response GetGuardrailPolicy200JSONResponse) VisitGetGuardrailPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGuardrailPolicy401JSONResponse Error
+
+func (response GetGuardrailPolicy401JSONResponse) VisitGetGuardrailPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGuardrailPolicy500JSONResponse Error
+
+func (response GetGuardrailPolicy500JSONResponse) VisitGetGuardrailPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetGuardrailPolicyRequestObject struct {
+	Body *SetGuardrailPolicyJSONRequestBody
+}
+
+type SetGuardrailPolicyResponseObject interface {
+	VisitSetGuardrailPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetGuardrailPolicy200JSONResponse GuardrailPolicy
+
+func (response SetGuardrailPolicy200JSONResponse) VisitSetGuardrailPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetGuardrailPolicy400JSONResponse Error
+
+func (response SetGuardrailPolicy400JSONResponse) VisitSetGuardrailPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetGuardrailPolicy401JSONResponse Error
+
+func (response SetGuardrailPolicy401JSONResponse) VisitSetGuardrailPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetGuardrailPolicy500JSONResponse Error
+
+func (response SetGuardrailPolicy500JSONResponse) VisitSetGuardrailPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInfoRequestObject struct {
+}
+
+type GetInfoResponseObject interface {
+	VisitGetInfoResponse(w http.ResponseWriter) error
+}
+
+type GetInfo200JSONResponse Info
+
+func (response GetInfo200JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInfo500JSONResponse Error
+
+func (response GetInfo500JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInventoryFieldSchemaRequestObject struct {
+}
+
+type GetInventoryFieldSchemaResponseObject interface {
+	VisitGetInventoryFieldSchemaResponse(w http.ResponseWriter) error
+}
+
+type GetInventoryFieldSchema200JSONResponse InventoryFieldSchema
+
+func (response GetInventoryFieldSchema200JSONResponse) VisitGetInventoryFieldSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInventoryFieldSchema401JSONResponse Error
+
+func (response GetInventoryFieldSchema401JSONResponse) VisitGetInventoryFieldSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInventoryFieldSchema500JSONResponse Error
+
+func (response GetInventoryFieldSchema500JSONResponse) VisitGetInventoryFieldSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetInventoryFieldSchemaRequestObject struct {
+	Body *SetInventoryFieldSchemaJSONRequestBody
+}
+
+type SetInventoryFieldSchemaResponseObject interface {
+	VisitSetInventoryFieldSchemaResponse(w http.ResponseWriter) error
+}
+
+type SetInventoryFieldSchema200JSONResponse InventoryFieldSchema
+
+func (response SetInventoryFieldSchema200JSONResponse) VisitSetInventoryFieldSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetInventoryFieldSchema400JSONResponse Error
+
+func (response SetInventoryFieldSchema400JSONResponse) VisitSetInventoryFieldSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetInventoryFieldSchema401JSONResponse Error
+
+func (response SetInventoryFieldSchema401JSONResponse) VisitSetInventoryFieldSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetInventoryFieldSchema500JSONResponse Error
+
+func (response SetInventoryFieldSchema500JSONResponse) VisitSetInventoryFieldSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlansRequestObject struct {
+}
+
+type ListPlansResponseObject interface {
+	VisitListPlansResponse(w http.ResponseWriter) error
+}
+
+type ListPlans200JSONResponse PlanList
+
+func (response ListPlans200JSONResponse) VisitListPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlans401JSONResponse Error
+
+func (response ListPlans401JSONResponse) VisitListPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlans500JSONResponse Error
+
+func (response ListPlans500JSONResponse) VisitListPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanRequestObject struct {
+	Body *CreatePlanJSONRequestBody
+}
+
+type CreatePlanResponseObject interface {
+	VisitCreatePlanResponse(w http.ResponseWriter) error
+}
+
+type CreatePlan201JSONResponse Plan
+
+func (response CreatePlan201JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlan400JSONResponse Error
+
+func (response CreatePlan400JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlan401JSONResponse Error
+
+func (response CreatePlan401JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlan500JSONResponse Error
+
+func (response CreatePlan500JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlanRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeletePlanResponseObject interface {
+	VisitDeletePlanResponse(w http.ResponseWriter) error
+}
+
+type DeletePlan200JSONResponse Plan
+
+func (response DeletePlan200JSONResponse) VisitDeletePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlan400JSONResponse Error
+
+func (response DeletePlan400JSONResponse) VisitDeletePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlan401JSONResponse Error
+
+func (response DeletePlan401JSONResponse) VisitDeletePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlan403JSONResponse Error
+
+func (response DeletePlan403JSONResponse) VisitDeletePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlan404JSONResponse Error
+
+func (response DeletePlan404JSONResponse) VisitDeletePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlan500JSONResponse Error
+
+func (response DeletePlan500JSONResponse) VisitDeletePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetPlanResponseObject interface {
+	VisitGetPlanResponse(w http.ResponseWriter) error
+}
+
+type GetPlan200JSONResponse Plan
+
+func (response GetPlan200JSONResponse) VisitGetPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlan400JSONResponse Error
+
+func (response GetPlan400JSONResponse) VisitGetPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlan401JSONResponse Error
+
+func (response GetPlan401JSONResponse) VisitGetPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlan403JSONResponse Error
+
+func (response GetPlan403JSONResponse) VisitGetPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlan404JSONResponse Error
+
+func (response GetPlan404JSONResponse) VisitGetPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlan500JSONResponse Error
+
+func (response GetPlan500JSONResponse) VisitGetPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanCalendarRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Pool   string             `json:"pool"`
+	Params ImportPlanCalendarParams
+	Body   io.Reader
+}
+
+type ImportPlanCalendarResponseObject interface {
+	VisitImportPlanCalendarResponse(w http.ResponseWriter) error
+}
+
+type ImportPlanCalendar200JSONResponse Plan
+
+func (response ImportPlanCalendar200JSONResponse) VisitImportPlanCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanCalendar400JSONResponse Error
+
+func (response ImportPlanCalendar400JSONResponse) VisitImportPlanCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanCalendar401JSONResponse Error
+
+func (response ImportPlanCalendar401JSONResponse) VisitImportPlanCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanCalendar403JSONResponse Error
+
+func (response ImportPlanCalendar403JSONResponse) VisitImportPlanCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanCalendar404JSONResponse Error
+
+func (response ImportPlanCalendar404JSONResponse) VisitImportPlanCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanCalendar500JSONResponse Error
+
+func (response ImportPlanCalendar500JSONResponse) VisitImportPlanCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanChargebackRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetPlanChargebackParams
+}
+
+type GetPlanChargebackResponseObject interface {
+	VisitGetPlanChargebackResponse(w http.ResponseWriter) error
+}
+
+type GetPlanChargeback200JSONResponse ChargebackReport
+
+func (response GetPlanChargeback200JSONResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanChargeback200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPlanChargeback200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPlanChargeback200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPlanChargeback200TextcsvResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPlanChargeback400JSONResponse Error
+
+func (response GetPlanChargeback400JSONResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanChargeback401JSONResponse Error
+
+func (response GetPlanChargeback401JSONResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanChargeback403JSONResponse Error
+
+func (response GetPlanChargeback403JSONResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanChargeback404JSONResponse Error
+
+func (response GetPlanChargeback404JSONResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanChargeback500JSONResponse Error
+
+func (response GetPlanChargeback500JSONResponse) VisitGetPlanChargebackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanChecklistsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ListPlanChecklistsParams
+}
+
+type ListPlanChecklistsResponseObject interface {
+	VisitListPlanChecklistsResponse(w http.ResponseWriter) error
+}
+
+type ListPlanChecklists200JSONResponse ChecklistList
+
+func (response ListPlanChecklists200JSONResponse) VisitListPlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanChecklists400JSONResponse Error
+
+func (response ListPlanChecklists400JSONResponse) VisitListPlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanChecklists401JSONResponse Error
+
+func (response ListPlanChecklists401JSONResponse) VisitListPlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanChecklists403JSONResponse Error
+
+func (response ListPlanChecklists403JSONResponse) VisitListPlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanChecklists404JSONResponse Error
+
+func (response ListPlanChecklists404JSONResponse) VisitListPlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanChecklists500JSONResponse Error
+
+func (response ListPlanChecklists500JSONResponse) VisitListPlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InstantiatePlanChecklistsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *InstantiatePlanChecklistsJSONRequestBody
+}
+
+type InstantiatePlanChecklistsResponseObject interface {
+	VisitInstantiatePlanChecklistsResponse(w http.ResponseWriter) error
+}
+
+type InstantiatePlanChecklists201JSONResponse ChecklistList
+
+func (response InstantiatePlanChecklists201JSONResponse) VisitInstantiatePlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InstantiatePlanChecklists400JSONResponse Error
+
+func (response InstantiatePlanChecklists400JSONResponse) VisitInstantiatePlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InstantiatePlanChecklists401JSONResponse Error
+
+func (response InstantiatePlanChecklists401JSONResponse) VisitInstantiatePlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InstantiatePlanChecklists403JSONResponse Error
+
+func (response InstantiatePlanChecklists403JSONResponse) VisitInstantiatePlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InstantiatePlanChecklists404JSONResponse Error
+
+func (response InstantiatePlanChecklists404JSONResponse) VisitInstantiatePlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InstantiatePlanChecklists500JSONResponse Error
+
+func (response InstantiatePlanChecklists500JSONResponse) VisitInstantiatePlanChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompletePlanChecklistItemRequestObject struct {
+	Id          openapi_types.UUID `json:"id"`
+	ChecklistId openapi_types.UUID `json:"checklistId"`
+	Index       int                `json:"index"`
+	Body        *CompletePlanChecklistItemJSONRequestBody
+}
+
+type CompletePlanChecklistItemResponseObject interface {
+	VisitCompletePlanChecklistItemResponse(w http.ResponseWriter) error
+}
+
+type CompletePlanChecklistItem200JSONResponse Checklist
+
+func (response CompletePlanChecklistItem200JSONResponse) VisitCompletePlanChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompletePlanChecklistItem400JSONResponse Error
+
+func (response CompletePlanChecklistItem400JSONResponse) VisitCompletePlanChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompletePlanChecklistItem401JSONResponse Error
+
+func (response CompletePlanChecklistItem401JSONResponse) VisitCompletePlanChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompletePlanChecklistItem403JSONResponse Error
+
+func (response CompletePlanChecklistItem403JSONResponse) VisitCompletePlanChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompletePlanChecklistItem404JSONResponse Error
+
+func (response CompletePlanChecklistItem404JSONResponse) VisitCompletePlanChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompletePlanChecklistItem500JSONResponse Error
+
+func (response CompletePlanChecklistItem500JSONResponse) VisitCompletePlanChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanCoverageRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetPlanCoverageResponseObject interface {
+	VisitGetPlanCoverageResponse(w http.ResponseWriter) error
+}
+
+type GetPlanCoverage200JSONResponse []PoolCoverage
+
+func (response GetPlanCoverage200JSONResponse) VisitGetPlanCoverageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanCoverage400JSONResponse Error
+
+func (response GetPlanCoverage400JSONResponse) VisitGetPlanCoverageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanCoverage401JSONResponse Error
+
+func (response GetPlanCoverage401JSONResponse) VisitGetPlanCoverageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanCoverage403JSONResponse Error
+
+func (response GetPlanCoverage403JSONResponse) VisitGetPlanCoverageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanCoverage404JSONResponse Error
+
+func (response GetPlanCoverage404JSONResponse) VisitGetPlanCoverageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanCoverage500JSONResponse Error
+
+func (response GetPlanCoverage500JSONResponse) VisitGetPlanCoverageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlanRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ExportPlanParams
+}
+
+type ExportPlanResponseObject interface {
+	VisitExportPlanResponse(w http.ResponseWriter) error
+}
+
+type ExportPlan200ResponseHeaders struct {
+	XPlannerSignature string
+}
+
+type ExportPlan200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       ExportPlan200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlan200ApplicationoctetStreamResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Planner-Signature", fmt.Sprint(response.Headers.XPlannerSignature))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlan200ApplicationxmlResponse struct {
+	Body          io.Reader
+	Headers       ExportPlan200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlan200ApplicationxmlResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Planner-Signature", fmt.Sprint(response.Headers.XPlannerSignature))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlan200ApplicationyamlResponse struct {
+	Body          io.Reader
+	Headers       ExportPlan200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlan200ApplicationyamlResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Planner-Signature", fmt.Sprint(response.Headers.XPlannerSignature))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlan200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       ExportPlan200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlan200TextcsvResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Planner-Signature", fmt.Sprint(response.Headers.XPlannerSignature))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlan200TextxHclResponse struct {
+	Body          io.Reader
+	Headers       ExportPlan200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlan200TextxHclResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/x-hcl")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Planner-Signature", fmt.Sprint(response.Headers.XPlannerSignature))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlan400JSONResponse Error
+
+func (response ExportPlan400JSONResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlan401JSONResponse Error
+
+func (response ExportPlan401JSONResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlan403JSONResponse Error
+
+func (response ExportPlan403JSONResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlan404JSONResponse Error
+
+func (response ExportPlan404JSONResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlan500JSONResponse Error
+
+func (response ExportPlan500JSONResponse) VisitExportPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanGanttRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetPlanGanttParams
+}
+
+type GetPlanGanttResponseObject interface {
+	VisitGetPlanGanttResponse(w http.ResponseWriter) error
+}
+
+type GetPlanGantt200JSONResponse Gantt
+
+func (response GetPlanGantt200JSONResponse) VisitGetPlanGanttResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanGantt200ImagesvgXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPlanGantt200ImagesvgXmlResponse) VisitGetPlanGanttResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPlanGantt400JSONResponse Error
+
+func (response GetPlanGantt400JSONResponse) VisitGetPlanGanttResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanGantt401JSONResponse Error
+
+func (response GetPlanGantt401JSONResponse) VisitGetPlanGanttResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanGantt403JSONResponse Error
+
+func (response GetPlanGantt403JSONResponse) VisitGetPlanGanttResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanGantt404JSONResponse Error
+
+func (response GetPlanGantt404JSONResponse) VisitGetPlanGanttResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanGantt500JSONResponse Error
+
+func (response GetPlanGantt500JSONResponse) VisitGetPlanGanttResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePlanGateRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Gate string             `json:"gate"`
+	Body *ApprovePlanGateJSONRequestBody
+}
+
+type ApprovePlanGateResponseObject interface {
+	VisitApprovePlanGateResponse(w http.ResponseWriter) error
+}
+
+type ApprovePlanGate200JSONResponse Plan
+
+func (response ApprovePlanGate200JSONResponse) VisitApprovePlanGateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePlanGate400JSONResponse Error
+
+func (response ApprovePlanGate400JSONResponse) VisitApprovePlanGateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePlanGate401JSONResponse Error
+
+func (response ApprovePlanGate401JSONResponse) VisitApprovePlanGateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePlanGate403JSONResponse Error
+
+func (response ApprovePlanGate403JSONResponse) VisitApprovePlanGateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePlanGate404JSONResponse Error
+
+func (response ApprovePlanGate404JSONResponse) VisitApprovePlanGateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePlanGate500JSONResponse Error
+
+func (response ApprovePlanGate500JSONResponse) VisitApprovePlanGateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPlanKPIsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SetPlanKPIsJSONRequestBody
+}
+
+type SetPlanKPIsResponseObject interface {
+	VisitSetPlanKPIsResponse(w http.ResponseWriter) error
+}
+
+type SetPlanKPIs200JSONResponse PlanProgress
+
+func (response SetPlanKPIs200JSONResponse) VisitSetPlanKPIsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPlanKPIs400JSONResponse Error
+
+func (response SetPlanKPIs400JSONResponse) VisitSetPlanKPIsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPlanKPIs401JSONResponse Error
+
+func (response SetPlanKPIs401JSONResponse) VisitSetPlanKPIsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPlanKPIs403JSONResponse Error
+
+func (response SetPlanKPIs403JSONResponse) VisitSetPlanKPIsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPlanKPIs404JSONResponse Error
+
+func (response SetPlanKPIs404JSONResponse) VisitSetPlanKPIsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetPlanKPIs500JSONResponse Error
+
+func (response SetPlanKPIs500JSONResponse) VisitSetPlanKPIsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanNamespacesRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetPlanNamespacesParams
+}
+
+type GetPlanNamespacesResponseObject interface {
+	VisitGetPlanNamespacesResponse(w http.ResponseWriter) error
+}
+
+type GetPlanNamespaces200JSONResponse NamespaceDesign
+
+func (response GetPlanNamespaces200JSONResponse) VisitGetPlanNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanNamespaces400JSONResponse Error
+
+func (response GetPlanNamespaces400JSONResponse) VisitGetPlanNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanNamespaces401JSONResponse Error
+
+func (response GetPlanNamespaces401JSONResponse) VisitGetPlanNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanNamespaces403JSONResponse Error
+
+func (response GetPlanNamespaces403JSONResponse) VisitGetPlanNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanNamespaces404JSONResponse Error
+
+func (response GetPlanNamespaces404JSONResponse) VisitGetPlanNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanNamespaces500JSONResponse Error
+
+func (response GetPlanNamespaces500JSONResponse) VisitGetPlanNamespacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanProgressRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetPlanProgressResponseObject interface {
+	VisitGetPlanProgressResponse(w http.ResponseWriter) error
+}
+
+type GetPlanProgress200JSONResponse PlanProgress
+
+func (response GetPlanProgress200JSONResponse) VisitGetPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanProgress400JSONResponse Error
+
+func (response GetPlanProgress400JSONResponse) VisitGetPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanProgress401JSONResponse Error
+
+func (response GetPlanProgress401JSONResponse) VisitGetPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanProgress403JSONResponse Error
+
+func (response GetPlanProgress403JSONResponse) VisitGetPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanProgress404JSONResponse Error
+
+func (response GetPlanProgress404JSONResponse) VisitGetPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanProgress500JSONResponse Error
+
+func (response GetPlanProgress500JSONResponse) VisitGetPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanProgressRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *RecordPlanProgressJSONRequestBody
+}
+
+type RecordPlanProgressResponseObject interface {
+	VisitRecordPlanProgressResponse(w http.ResponseWriter) error
+}
+
+type RecordPlanProgress200JSONResponse PlanProgress
+
+func (response RecordPlanProgress200JSONResponse) VisitRecordPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanProgress400JSONResponse Error
+
+func (response RecordPlanProgress400JSONResponse) VisitRecordPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanProgress401JSONResponse Error
+
+func (response RecordPlanProgress401JSONResponse) VisitRecordPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanProgress403JSONResponse Error
+
+func (response RecordPlanProgress403JSONResponse) VisitRecordPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanProgress404JSONResponse Error
+
+func (response RecordPlanProgress404JSONResponse) VisitRecordPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanProgress500JSONResponse Error
+
+func (response RecordPlanProgress500JSONResponse) VisitRecordPlanProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanScheduleRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body io.Reader
+}
+
+type ImportPlanScheduleResponseObject interface {
+	VisitImportPlanScheduleResponse(w http.ResponseWriter) error
+}
+
+type ImportPlanSchedule200JSONResponse ScheduleImport
+
+func (response ImportPlanSchedule200JSONResponse) VisitImportPlanScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanSchedule400JSONResponse Error
+
+func (response ImportPlanSchedule400JSONResponse) VisitImportPlanScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanSchedule401JSONResponse Error
+
+func (response ImportPlanSchedule401JSONResponse) VisitImportPlanScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanSchedule403JSONResponse Error
+
+func (response ImportPlanSchedule403JSONResponse) VisitImportPlanScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanSchedule404JSONResponse Error
+
+func (response ImportPlanSchedule404JSONResponse) VisitImportPlanScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlanSchedule500JSONResponse Error
+
+func (response ImportPlanSchedule500JSONResponse) VisitImportPlanScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanSharesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListPlanSharesResponseObject interface {
+	VisitListPlanSharesResponse(w http.ResponseWriter) error
+}
+
+type ListPlanShares200JSONResponse PlanShareList
+
+func (response ListPlanShares200JSONResponse) VisitListPlanSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanShares400JSONResponse Error
+
+func (response ListPlanShares400JSONResponse) VisitListPlanSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanShares401JSONResponse Error
+
+func (response ListPlanShares401JSONResponse) VisitListPlanSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanShares403JSONResponse Error
+
+func (response ListPlanShares403JSONResponse) VisitListPlanSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanShares404JSONResponse Error
+
+func (response ListPlanShares404JSONResponse) VisitListPlanSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlanShares500JSONResponse Error
+
+func (response ListPlanShares500JSONResponse) VisitListPlanSharesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanShareRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreatePlanShareJSONRequestBody
+}
+
+type CreatePlanShareResponseObject interface {
+	VisitCreatePlanShareResponse(w http.ResponseWriter) error
+}
+
+type CreatePlanShare201JSONResponse PlanShare
+
+func (response CreatePlanShare201JSONResponse) VisitCreatePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanShare400JSONResponse Error
+
+func (response CreatePlanShare400JSONResponse) VisitCreatePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanShare401JSONResponse Error
+
+func (response CreatePlanShare401JSONResponse) VisitCreatePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanShare403JSONResponse Error
+
+func (response CreatePlanShare403JSONResponse) VisitCreatePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanShare404JSONResponse Error
+
+func (response CreatePlanShare404JSONResponse) VisitCreatePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanShare500JSONResponse Error
+
+func (response CreatePlanShare500JSONResponse) VisitCreatePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokePlanShareRequestObject struct {
+	Id      openapi_types.UUID `json:"id"`
+	ShareId openapi_types.UUID `json:"shareId"`
+}
+
+type RevokePlanShareResponseObject interface {
+	VisitRevokePlanShareResponse(w http.ResponseWriter) error
+}
+
+type RevokePlanShare200JSONResponse PlanShare
+
+func (response RevokePlanShare200JSONResponse) VisitRevokePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokePlanShare400JSONResponse Error
+
+func (response RevokePlanShare400JSONResponse) VisitRevokePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokePlanShare401JSONResponse Error
+
+func (response RevokePlanShare401JSONResponse) VisitRevokePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokePlanShare403JSONResponse Error
+
+func (response RevokePlanShare403JSONResponse) VisitRevokePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokePlanShare404JSONResponse Error
+
+func (response RevokePlanShare404JSONResponse) VisitRevokePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokePlanShare500JSONResponse Error
+
+func (response RevokePlanShare500JSONResponse) VisitRevokePlanShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanVMActualsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *RecordPlanVMActualsJSONRequestBody
+}
+
+type RecordPlanVMActualsResponseObject interface {
+	VisitRecordPlanVMActualsResponse(w http.ResponseWriter) error
+}
+
+type RecordPlanVMActuals200JSONResponse VMPhaseActualList
+
+func (response RecordPlanVMActuals200JSONResponse) VisitRecordPlanVMActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanVMActuals400JSONResponse Error
+
+func (response RecordPlanVMActuals400JSONResponse) VisitRecordPlanVMActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanVMActuals401JSONResponse Error
+
+func (response RecordPlanVMActuals401JSONResponse) VisitRecordPlanVMActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanVMActuals403JSONResponse Error
+
+func (response RecordPlanVMActuals403JSONResponse) VisitRecordPlanVMActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanVMActuals404JSONResponse Error
+
+func (response RecordPlanVMActuals404JSONResponse) VisitRecordPlanVMActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanVMActuals500JSONResponse Error
+
+func (response RecordPlanVMActuals500JSONResponse) VisitRecordPlanVMActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanVMEffortRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+	Vm string             `json:"vm"`
+}
+
+type GetPlanVMEffortResponseObject interface {
+	VisitGetPlanVMEffortResponse(w http.ResponseWriter) error
+}
+
+type GetPlanVMEffort200JSONResponse VMEffort
+
+func (response GetPlanVMEffort200JSONResponse) VisitGetPlanVMEffortResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanVMEffort401JSONResponse Error
+
+func (response GetPlanVMEffort401JSONResponse) VisitGetPlanVMEffortResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanVMEffort403JSONResponse Error
+
+func (response GetPlanVMEffort403JSONResponse) VisitGetPlanVMEffortResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanVMEffort404JSONResponse Error
+
+func (response GetPlanVMEffort404JSONResponse) VisitGetPlanVMEffortResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanVMEffort500JSONResponse Error
+
+func (response GetPlanVMEffort500JSONResponse) VisitGetPlanVMEffortResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanWaveLiveRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+	N  int                `json:"n"`
+}
+
+type GetPlanWaveLiveResponseObject interface {
+	VisitGetPlanWaveLiveResponse(w http.ResponseWriter) error
+}
+
+type GetPlanWaveLive200JSONResponse WaveLiveStatus
+
+func (response GetPlanWaveLive200JSONResponse) VisitGetPlanWaveLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanWaveLive401JSONResponse Error
+
+func (response GetPlanWaveLive401JSONResponse) VisitGetPlanWaveLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanWaveLive403JSONResponse Error
+
+func (response GetPlanWaveLive403JSONResponse) VisitGetPlanWaveLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanWaveLive404JSONResponse Error
+
+func (response GetPlanWaveLive404JSONResponse) VisitGetPlanWaveLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanWaveLive500JSONResponse Error
+
+func (response GetPlanWaveLive500JSONResponse) VisitGetPlanWaveLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanWaveTrackingRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	N    int                `json:"n"`
+	Body *RecordPlanWaveTrackingJSONRequestBody
+}
+
+type RecordPlanWaveTrackingResponseObject interface {
+	VisitRecordPlanWaveTrackingResponse(w http.ResponseWriter) error
+}
+
+type RecordPlanWaveTracking200JSONResponse WaveLiveStatus
+
+func (response RecordPlanWaveTracking200JSONResponse) VisitRecordPlanWaveTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanWaveTracking400JSONResponse Error
+
+func (response RecordPlanWaveTracking400JSONResponse) VisitRecordPlanWaveTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanWaveTracking401JSONResponse Error
+
+func (response RecordPlanWaveTracking401JSONResponse) VisitRecordPlanWaveTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanWaveTracking403JSONResponse Error
+
+func (response RecordPlanWaveTracking403JSONResponse) VisitRecordPlanWaveTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanWaveTracking404JSONResponse Error
+
+func (response RecordPlanWaveTracking404JSONResponse) VisitRecordPlanWaveTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordPlanWaveTracking500JSONResponse Error
+
+func (response RecordPlanWaveTracking500JSONResponse) VisitRecordPlanWaveTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePlanStaffingRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SimulatePlanStaffingJSONRequestBody
+}
+
+type SimulatePlanStaffingResponseObject interface {
+	VisitSimulatePlanStaffingResponse(w http.ResponseWriter) error
+}
+
+type SimulatePlanStaffing200JSONResponse PlanWhatIf
+
+func (response SimulatePlanStaffing200JSONResponse) VisitSimulatePlanStaffingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePlanStaffing400JSONResponse Error
+
+func (response SimulatePlanStaffing400JSONResponse) VisitSimulatePlanStaffingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePlanStaffing401JSONResponse Error
+
+func (response SimulatePlanStaffing401JSONResponse) VisitSimulatePlanStaffingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePlanStaffing403JSONResponse Error
+
+func (response SimulatePlanStaffing403JSONResponse) VisitSimulatePlanStaffingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePlanStaffing404JSONResponse Error
+
+func (response SimulatePlanStaffing404JSONResponse) VisitSimulatePlanStaffingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulatePlanStaffing500JSONResponse Error
+
+func (response SimulatePlanStaffing500JSONResponse) VisitSimulatePlanStaffingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProgramForecastRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetProgramForecastParams
+}
+
+type GetProgramForecastResponseObject interface {
+	VisitGetProgramForecastResponse(w http.ResponseWriter) error
+}
+
+type GetProgramForecast200JSONResponse ProgramForecast
+
+func (response GetProgramForecast200JSONResponse) VisitGetProgramForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProgramForecast200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetProgramForecast200TextcsvResponse) VisitGetProgramForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetProgramForecast400JSONResponse Error
+
+func (response GetProgramForecast400JSONResponse) VisitGetProgramForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProgramForecast401JSONResponse Error
+
+func (response GetProgramForecast401JSONResponse) VisitGetProgramForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProgramForecast403JSONResponse Error
+
+func (response GetProgramForecast403JSONResponse) VisitGetProgramForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProgramForecast404JSONResponse Error
+
+func (response GetProgramForecast404JSONResponse) VisitGetProgramForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProgramForecast500JSONResponse Error
+
+func (response GetProgramForecast500JSONResponse) VisitGetProgramForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRateCardsRequestObject struct {
+	Params ListRateCardsParams
+}
+
+type ListRateCardsResponseObject interface {
+	VisitListRateCardsResponse(w http.ResponseWriter) error
+}
+
+type ListRateCards200JSONResponse RateCardList
+
+func (response ListRateCards200JSONResponse) VisitListRateCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRateCards401JSONResponse Error
+
+func (response ListRateCards401JSONResponse) VisitListRateCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRateCards500JSONResponse Error
+
+func (response ListRateCards500JSONResponse) VisitListRateCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRateCardRequestObject struct {
+	Body *CreateRateCardJSONRequestBody
+}
+
+type CreateRateCardResponseObject interface {
+	VisitCreateRateCardResponse(w http.ResponseWriter) error
+}
+
+type CreateRateCard201JSONResponse RateCard
+
+func (response CreateRateCard201JSONResponse) VisitCreateRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRateCard400JSONResponse Error
+
+func (response CreateRateCard400JSONResponse) VisitCreateRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRateCard401JSONResponse Error
+
+func (response CreateRateCard401JSONResponse) VisitCreateRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRateCard500JSONResponse Error
+
+func (response CreateRateCard500JSONResponse) VisitCreateRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRateCardRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteRateCardResponseObject interface {
+	VisitDeleteRateCardResponse(w http.ResponseWriter) error
+}
+
+type DeleteRateCard200JSONResponse RateCard
+
+func (response DeleteRateCard200JSONResponse) VisitDeleteRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRateCard400JSONResponse Error
+
+func (response DeleteRateCard400JSONResponse) VisitDeleteRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRateCard401JSONResponse Error
+
+func (response DeleteRateCard401JSONResponse) VisitDeleteRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRateCard403JSONResponse Error
+
+func (response DeleteRateCard403JSONResponse) VisitDeleteRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRateCard404JSONResponse Error
+
+func (response DeleteRateCard404JSONResponse) VisitDeleteRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRateCard500JSONResponse Error
+
+func (response DeleteRateCard500JSONResponse) VisitDeleteRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRateCardRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetRateCardResponseObject interface {
+	VisitGetRateCardResponse(w http.ResponseWriter) error
+}
+
+type GetRateCard200JSONResponse RateCard
+
+func (response GetRateCard200JSONResponse) VisitGetRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRateCard400JSONResponse Error
+
+func (response GetRateCard400JSONResponse) VisitGetRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRateCard401JSONResponse Error
+
+func (response GetRateCard401JSONResponse) VisitGetRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRateCard403JSONResponse Error
+
+func (response GetRateCard403JSONResponse) VisitGetRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRateCard404JSONResponse Error
+
+func (response GetRateCard404JSONResponse) VisitGetRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRateCard500JSONResponse Error
+
+func (response GetRateCard500JSONResponse) VisitGetRateCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortfolioReportRequestObject struct {
+	Body *CreatePortfolioReportJSONRequestBody
+}
+
+type CreatePortfolioReportResponseObject interface {
+	VisitCreatePortfolioReportResponse(w http.ResponseWriter) error
+}
+
+type CreatePortfolioReport202JSONResponse Job
+
+func (response CreatePortfolioReport202JSONResponse) VisitCreatePortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortfolioReport400JSONResponse Error
+
+func (response CreatePortfolioReport400JSONResponse) VisitCreatePortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortfolioReport401JSONResponse Error
+
+func (response CreatePortfolioReport401JSONResponse) VisitCreatePortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortfolioReport403JSONResponse Error
+
+func (response CreatePortfolioReport403JSONResponse) VisitCreatePortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortfolioReport404JSONResponse Error
+
+func (response CreatePortfolioReport404JSONResponse) VisitCreatePortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortfolioReport500JSONResponse Error
+
+func (response CreatePortfolioReport500JSONResponse) VisitCreatePortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortfolioReportRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetPortfolioReportResponseObject interface {
+	VisitGetPortfolioReportResponse(w http.ResponseWriter) error
+}
+
+type GetPortfolioReport200JSONResponse PortfolioReport
+
+func (response GetPortfolioReport200JSONResponse) VisitGetPortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortfolioReport202JSONResponse Job
+
+func (response GetPortfolioReport202JSONResponse) VisitGetPortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortfolioReport401JSONResponse Error
+
+func (response GetPortfolioReport401JSONResponse) VisitGetPortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortfolioReport403JSONResponse Error
+
+func (response GetPortfolioReport403JSONResponse) VisitGetPortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortfolioReport404JSONResponse Error
+
+func (response GetPortfolioReport404JSONResponse) VisitGetPortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortfolioReport500JSONResponse Error
+
+func (response GetPortfolioReport500JSONResponse) VisitGetPortfolioReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSourcesRequestObject struct {
+}
+
+type DeleteSourcesResponseObject interface {
+	VisitDeleteSourcesResponse(w http.ResponseWriter) error
+}
+
+type DeleteSources200JSONResponse Status
+
+func (response DeleteSources200JSONResponse) VisitDeleteSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSources401JSONResponse Error
+
+func (response DeleteSources401JSONResponse) VisitDeleteSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSources500JSONResponse Error
+
+func (response DeleteSources500JSONResponse) VisitDeleteSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourcesRequestObject struct {
+}
+
+type ListSourcesResponseObject interface {
+	VisitListSourcesResponse(w http.ResponseWriter) error
+}
+
+type ListSources200JSONResponse SourceList
+
+func (response ListSources200JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSources401JSONResponse Error
+
+func (response ListSources401JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSources500JSONResponse Error
+
+func (response ListSources500JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSourceRequestObject struct {
+	Body *CreateSourceJSONRequestBody
+}
+
+type CreateSourceResponseObject interface {
+	VisitCreateSourceResponse(w http.ResponseWriter) error
+}
+
+type CreateSource201JSONResponse Source
+
+func (response CreateSource201JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSource400JSONResponse Error
+
+func (response CreateSource400JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSource401JSONResponse Error
+
+func (response CreateSource401JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSource500JSONResponse Error
+
+func (response CreateSource500JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSourceRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteSourceResponseObject interface {
+	VisitDeleteSourceResponse(w http.ResponseWriter) error
+}
+
+type DeleteSource200JSONResponse Source
+
+func (response DeleteSource200JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSource400JSONResponse Error
+
+func (response DeleteSource400JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSource401JSONResponse Error
+
+func (response DeleteSource401JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSource403JSONResponse Error
+
+func (response DeleteSource403JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSource404JSONResponse Error
+
+func (response DeleteSource404JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSource500JSONResponse Error
+
+func (response DeleteSource500JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetSourceResponseObject interface {
+	VisitGetSourceResponse(w http.ResponseWriter) error
+}
+
+type GetSource200JSONResponse Source
+
+func (response GetSource200JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource400JSONResponse Error
+
+func (response GetSource400JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource401JSONResponse Error
+
+func (response GetSource401JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource403JSONResponse Error
+
+func (response GetSource403JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource404JSONResponse Error
+
+func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource500JSONResponse Error
+
+func (response GetSource500JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSourceRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateSourceJSONRequestBody
+}
+
+type UpdateSourceResponseObject interface {
+	VisitUpdateSourceResponse(w http.ResponseWriter) error
+}
+
+type UpdateSource200JSONResponse Source
+
+func (response UpdateSource200JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSource400JSONResponse Error
+
+func (response UpdateSource400JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSource401JSONResponse Error
+
+func (response UpdateSource401JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSource403JSONResponse Error
+
+func (response UpdateSource403JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSource404JSONResponse Error
+
+func (response UpdateSource404JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSource500JSONResponse Error
+
+func (response UpdateSource500JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJobRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetChangeRateJobResponseObject interface {
+	VisitGetChangeRateJobResponse(w http.ResponseWriter) error
+}
+
+type GetChangeRateJob200JSONResponse ChangeRateJob
+
+func (response GetChangeRateJob200JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob401JSONResponse Error
+
+func (response GetChangeRateJob401JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob403JSONResponse Error
+
+func (response GetChangeRateJob403JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob404JSONResponse Error
+
+func (response GetChangeRateJob404JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangeRateJob500JSONResponse Error
+
+func (response GetChangeRateJob500JSONResponse) VisitGetChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChangeRateJobRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *StartChangeRateJobJSONRequestBody
+}
+
+type StartChangeRateJobResponseObject interface {
+	VisitStartChangeRateJobResponse(w http.ResponseWriter) error
+}
+
+type StartChangeRateJob200JSONResponse ChangeRateJob
+
+func (response StartChangeRateJob200JSONResponse) VisitStartChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChangeRateJob400JSONResponse Error
+
+func (response StartChangeRateJob400JSONResponse) VisitStartChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChangeRateJob401JSONResponse Error
+
+func (response StartChangeRateJob401JSONResponse) VisitStartChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChangeRateJob403JSONResponse Error
+
+func (response StartChangeRateJob403JSONResponse) VisitStartChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChangeRateJob404JSONResponse Error
+
+func (response StartChangeRateJob404JSONResponse) VisitStartChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChangeRateJob500JSONResponse Error
+
+func (response StartChangeRateJob500JSONResponse) VisitStartChangeRateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadImageRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type HeadImageResponseObject interface {
+	VisitHeadImageResponse(w http.ResponseWriter) error
+}
+
+type HeadImage200Response struct {
+}
+
+func (response HeadImage200Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HeadImage400Response struct {
+}
+
+func (response HeadImage400Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type HeadImage401Response struct {
+}
+
+func (response HeadImage401Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type HeadImage403Response struct {
+}
+
+func (response HeadImage403Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type HeadImage404Response struct {
+}
+
+func (response HeadImage404Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type HeadImage500Response struct {
+}
+
+func (response HeadImage500Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetSourceDownloadURLRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetSourceDownloadURLResponseObject interface {
+	VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error
+}
+
+type GetSourceDownloadURL200JSONResponse PresignedUrl
+
+func (response GetSourceDownloadURL200JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDownloadURL400JSONResponse Error
+
+func (response GetSourceDownloadURL400JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDownloadURL401JSONResponse Error
+
+func (response GetSourceDownloadURL401JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDownloadURL404JSONResponse Error
+
+func (response GetSourceDownloadURL404JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInventoryRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateInventoryJSONRequestBody
+}
+
+type UpdateInventoryResponseObject interface {
+	VisitUpdateInventoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateInventory200JSONResponse Source
+
+func (response UpdateInventory200JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInventory400JSONResponse Error
+
+func (response UpdateInventory400JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInventory401JSONResponse Error
+
+func (response UpdateInventory401JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInventory403JSONResponse Error
+
+func (response UpdateInventory403JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInventory404JSONResponse Error
+
+func (response UpdateInventory404JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInventory500JSONResponse Error
+
+func (response UpdateInventory500JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTroubleshootingModelRequestObject struct {
+}
+
+type DeleteTroubleshootingModelResponseObject interface {
+	VisitDeleteTroubleshootingModelResponse(w http.ResponseWriter) error
+}
+
+type DeleteTroubleshootingModel200JSONResponse TroubleshootingModel
+
+func (response DeleteTroubleshootingModel200JSONResponse) VisitDeleteTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTroubleshootingModel401JSONResponse Error
+
+func (response DeleteTroubleshootingModel401JSONResponse) VisitDeleteTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTroubleshootingModel404JSONResponse Error
+
+func (response DeleteTroubleshootingModel404JSONResponse) VisitDeleteTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTroubleshootingModel500JSONResponse Error
+
+func (response DeleteTroubleshootingModel500JSONResponse) VisitDeleteTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTroubleshootingModelRequestObject struct {
+}
+
+type GetTroubleshootingModelResponseObject interface {
+	VisitGetTroubleshootingModelResponse(w http.ResponseWriter) error
+}
+
+type GetTroubleshootingModel200JSONResponse TroubleshootingModel
+
+func (response GetTroubleshootingModel200JSONResponse) VisitGetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTroubleshootingModel401JSONResponse Error
+
+func (response GetTroubleshootingModel401JSONResponse) VisitGetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTroubleshootingModel404JSONResponse Error
+
+func (response GetTroubleshootingModel404JSONResponse) VisitGetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTroubleshootingModel500JSONResponse Error
+
+func (response GetTroubleshootingModel500JSONResponse) VisitGetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTroubleshootingModelRequestObject struct {
+	Body *SetTroubleshootingModelJSONRequestBody
+}
+
+type SetTroubleshootingModelResponseObject interface {
+	VisitSetTroubleshootingModelResponse(w http.ResponseWriter) error
+}
+
+type SetTroubleshootingModel200JSONResponse TroubleshootingModel
+
+func (response SetTroubleshootingModel200JSONResponse) VisitSetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTroubleshootingModel400JSONResponse Error
+
+func (response SetTroubleshootingModel400JSONResponse) VisitSetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTroubleshootingModel401JSONResponse Error
+
+func (response SetTroubleshootingModel401JSONResponse) VisitSetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTroubleshootingModel500JSONResponse Error
+
+func (response SetTroubleshootingModel500JSONResponse) VisitSetTroubleshootingModelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse WebhookEndpointList
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401JSONResponse Error
+
+func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks403JSONResponse Error
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse Error
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	Id     string `json:"id"`
+	Params ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveryList
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries400JSONResponse Error
+
+func (response ListWebhookDeliveries400JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401JSONResponse Error
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries403JSONResponse Error
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse Error
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse Error
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedriveWebhookRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RedriveWebhookResponseObject interface {
+	VisitRedriveWebhookResponse(w http.ResponseWriter) error
+}
+
+type RedriveWebhook200JSONResponse WebhookRedrive
+
+func (response RedriveWebhook200JSONResponse) VisitRedriveWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedriveWebhook401JSONResponse Error
+
+func (response RedriveWebhook401JSONResponse) VisitRedriveWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedriveWebhook403JSONResponse Error
+
+func (response RedriveWebhook403JSONResponse) VisitRedriveWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedriveWebhook404JSONResponse Error
+
+func (response RedriveWebhook404JSONResponse) VisitRedriveWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedriveWebhook500JSONResponse Error
+
+func (response RedriveWebhook500JSONResponse) VisitRedriveWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200Response struct {
+}
+
+func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (DELETE /api/v1/alert-webhook)
+	DeleteAlertWebhook(ctx context.Context, request DeleteAlertWebhookRequestObject) (DeleteAlertWebhookResponseObject, error)
+
+	// (GET /api/v1/alert-webhook)
+	GetAlertWebhook(ctx context.Context, request GetAlertWebhookRequestObject) (GetAlertWebhookResponseObject, error)
+
+	// (PUT /api/v1/alert-webhook)
+	SetAlertWebhook(ctx context.Context, request SetAlertWebhookRequestObject) (SetAlertWebhookResponseObject, error)
+
+	// (GET /api/v1/assessments)
+	ListAssessments(ctx context.Context, request ListAssessmentsRequestObject) (ListAssessmentsResponseObject, error)
+
+	// (POST /api/v1/assessments)
+	CreateAssessment(ctx context.Context, request CreateAssessmentRequestObject) (CreateAssessmentResponseObject, error)
+
+	// (DELETE /api/v1/assessments/jobs/{id})
+	CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error)
+
+	// (GET /api/v1/assessments/jobs/{id})
+	GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error)
+
+	// (POST /api/v1/assessments/rvtools)
+	CreateRVToolsAssessment(ctx context.Context, request CreateRVToolsAssessmentRequestObject) (CreateRVToolsAssessmentResponseObject, error)
+
+	// (DELETE /api/v1/assessments/{id})
+	DeleteAssessment(ctx context.Context, request DeleteAssessmentRequestObject) (DeleteAssessmentResponseObject, error)
+
+	// (GET /api/v1/assessments/{id})
+	GetAssessment(ctx context.Context, request GetAssessmentRequestObject) (GetAssessmentResponseObject, error)
+
+	// (PUT /api/v1/assessments/{id})
+	UpdateAssessment(ctx context.Context, request UpdateAssessmentRequestObject) (UpdateAssessmentResponseObject, error)
+
+	// (POST /api/v1/assessments/{id}/cluster-requirements)
+	CalculateAssessmentClusterRequirements(ctx context.Context, request CalculateAssessmentClusterRequirementsRequestObject) (CalculateAssessmentClusterRequirementsResponseObject, error)
+
+	// (POST /api/v1/assessments/{id}/complexity-estimation)
+	CalculateMigrationComplexity(ctx context.Context, request CalculateMigrationComplexityRequestObject) (CalculateMigrationComplexityResponseObject, error)
+
+	// (POST /api/v1/assessments/{id}/estimation-freezes)
+	CreateEstimationFreeze(ctx context.Context, request CreateEstimationFreezeRequestObject) (CreateEstimationFreezeResponseObject, error)
+
+	// (GET /api/v1/assessments/{id}/estimation-runs)
+	ListEstimationRuns(ctx context.Context, request ListEstimationRunsRequestObject) (ListEstimationRunsResponseObject, error)
+
+	// (POST /api/v1/assessments/{id}/factory-throughput)
+	CalculateFactoryThroughput(ctx context.Context, request CalculateFactoryThroughputRequestObject) (CalculateFactoryThroughputResponseObject, error)
+
+	// (POST /api/v1/assessments/{id}/migration-estimation)
+	CalculateMigrationEstimation(ctx context.Context, request CalculateMigrationEstimationRequestObject) (CalculateMigrationEstimationResponseObject, error)
+
+	// (GET /api/v1/calculator-defaults)
+	GetCalculatorDefaults(ctx context.Context, request GetCalculatorDefaultsRequestObject) (GetCalculatorDefaultsResponseObject, error)
+
+	// (PUT /api/v1/calculator-defaults)
+	SetCalculatorDefaults(ctx context.Context, request SetCalculatorDefaultsRequestObject) (SetCalculatorDefaultsResponseObject, error)
+
+	// (GET /api/v1/checklist-templates)
+	ListChecklistTemplates(ctx context.Context, request ListChecklistTemplatesRequestObject) (ListChecklistTemplatesResponseObject, error)
+
+	// (POST /api/v1/checklist-templates)
+	CreateChecklistTemplate(ctx context.Context, request CreateChecklistTemplateRequestObject) (CreateChecklistTemplateResponseObject, error)
+
+	// (DELETE /api/v1/checklist-templates/{id})
+	DeleteChecklistTemplate(ctx context.Context, request DeleteChecklistTemplateRequestObject) (DeleteChecklistTemplateResponseObject, error)
+
+	// (GET /api/v1/contingency-policy)
+	GetContingencyPolicy(ctx context.Context, request GetContingencyPolicyRequestObject) (GetContingencyPolicyResponseObject, error)
+
+	// (PUT /api/v1/contingency-policy)
+	SetContingencyPolicy(ctx context.Context, request SetContingencyPolicyRequestObject) (SetContingencyPolicyResponseObject, error)
+
+	// (POST /api/v1/debug/bundle)
+	CreateDebugBundle(ctx context.Context, request CreateDebugBundleRequestObject) (CreateDebugBundleResponseObject, error)
+
+	// (GET /api/v1/duration-distributions)
+	ListDurationDistributions(ctx context.Context, request ListDurationDistributionsRequestObject) (ListDurationDistributionsResponseObject, error)
+
+	// (GET /api/v1/estimation-freezes)
+	ListEstimationFreezes(ctx context.Context, request ListEstimationFreezesRequestObject) (ListEstimationFreezesResponseObject, error)
+
+	// (GET /api/v1/estimation-freezes/{id})
+	GetEstimationFreeze(ctx context.Context, request GetEstimationFreezeRequestObject) (GetEstimationFreezeResponseObject, error)
+
+	// (GET /api/v1/estimation-freezes/{id}/diff)
+	DiffEstimationFreeze(ctx context.Context, request DiffEstimationFreezeRequestObject) (DiffEstimationFreezeResponseObject, error)
+
+	// (GET /api/v1/estimation-freezes/{id}/report)
+	GetEstimationFreezeReport(ctx context.Context, request GetEstimationFreezeReportRequestObject) (GetEstimationFreezeReportResponseObject, error)
+
+	// (GET /api/v1/estimation-runs/{id})
+	GetEstimationRun(ctx context.Context, request GetEstimationRunRequestObject) (GetEstimationRunResponseObject, error)
+
+	// (GET /api/v1/estimation-runs/{id}/comparison)
+	CompareEstimationRuns(ctx context.Context, request CompareEstimationRunsRequestObject) (CompareEstimationRunsResponseObject, error)
+
+	// (POST /api/v1/estimations)
+	RunEstimation(ctx context.Context, request RunEstimationRequestObject) (RunEstimationResponseObject, error)
+
+	// (POST /api/v1/estimations/comparison)
+	CompareEstimations(ctx context.Context, request CompareEstimationsRequestObject) (CompareEstimationsResponseObject, error)
+
+	// (POST /api/v1/estimations/solve)
+	SolveEstimationDeadline(ctx context.Context, request SolveEstimationDeadlineRequestObject) (SolveEstimationDeadlineResponseObject, error)
+
+	// (GET /api/v1/estimations/{id})
+	GetEstimationJob(ctx context.Context, request GetEstimationJobRequestObject) (GetEstimationJobResponseObject, error)
+
+	// (GET /api/v1/events)
+	ListEvents(ctx context.Context, request ListEventsRequestObject) (ListEventsResponseObject, error)
+
+	// (GET /api/v1/export-policy)
+	GetExportPolicy(ctx context.Context, request GetExportPolicyRequestObject) (GetExportPolicyResponseObject, error)
+
+	// (PUT /api/v1/export-policy)
+	SetExportPolicy(ctx context.Context, request SetExportPolicyRequestObject) (SetExportPolicyResponseObject, error)
+
+	// (GET /api/v1/guardrail-policy)
+	GetGuardrailPolicy(ctx context.Context, request GetGuardrailPolicyRequestObject) (GetGuardrailPolicyResponseObject, error)
+
+	// (PUT /api/v1/guardrail-policy)
+	SetGuardrailPolicy(ctx context.Context, request SetGuardrailPolicyRequestObject) (SetGuardrailPolicyResponseObject, error)
+
+	// (GET /api/v1/info)
+	GetInfo(ctx context.Context, request GetInfoRequestObject) (GetInfoResponseObject, error)
+
+	// (GET /api/v1/inventory-fields)
+	GetInventoryFieldSchema(ctx context.Context, request GetInventoryFieldSchemaRequestObject) (GetInventoryFieldSchemaResponseObject, error)
+
+	// (PUT /api/v1/inventory-fields)
+	SetInventoryFieldSchema(ctx context.Context, request SetInventoryFieldSchemaRequestObject) (SetInventoryFieldSchemaResponseObject, error)
+
+	// (GET /api/v1/plans)
+	ListPlans(ctx context.Context, request ListPlansRequestObject) (ListPlansResponseObject, error)
+
+	// (POST /api/v1/plans)
+	CreatePlan(ctx context.Context, request CreatePlanRequestObject) (CreatePlanResponseObject, error)
+
+	// (DELETE /api/v1/plans/{id})
+	DeletePlan(ctx context.Context, request DeletePlanRequestObject) (DeletePlanResponseObject, error)
+
+	// (GET /api/v1/plans/{id})
+	GetPlan(ctx context.Context, request GetPlanRequestObject) (GetPlanResponseObject, error)
+
+	// (PUT /api/v1/plans/{id}/calendars/{pool})
+	ImportPlanCalendar(ctx context.Context, request ImportPlanCalendarRequestObject) (ImportPlanCalendarResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/chargeback)
+	GetPlanChargeback(ctx context.Context, request GetPlanChargebackRequestObject) (GetPlanChargebackResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/checklists)
+	ListPlanChecklists(ctx context.Context, request ListPlanChecklistsRequestObject) (ListPlanChecklistsResponseObject, error)
+
+	// (POST /api/v1/plans/{id}/checklists)
+	InstantiatePlanChecklists(ctx context.Context, request InstantiatePlanChecklistsRequestObject) (InstantiatePlanChecklistsResponseObject, error)
+
+	// (PUT /api/v1/plans/{id}/checklists/{checklistId}/items/{index})
+	CompletePlanChecklistItem(ctx context.Context, request CompletePlanChecklistItemRequestObject) (CompletePlanChecklistItemResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/coverage)
+	GetPlanCoverage(ctx context.Context, request GetPlanCoverageRequestObject) (GetPlanCoverageResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/export)
+	ExportPlan(ctx context.Context, request ExportPlanRequestObject) (ExportPlanResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/gantt)
+	GetPlanGantt(ctx context.Context, request GetPlanGanttRequestObject) (GetPlanGanttResponseObject, error)
+
+	// (POST /api/v1/plans/{id}/gates/{gate}/approvals)
+	ApprovePlanGate(ctx context.Context, request ApprovePlanGateRequestObject) (ApprovePlanGateResponseObject, error)
+
+	// (PUT /api/v1/plans/{id}/kpis)
+	SetPlanKPIs(ctx context.Context, request SetPlanKPIsRequestObject) (SetPlanKPIsResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/namespaces)
+	GetPlanNamespaces(ctx context.Context, request GetPlanNamespacesRequestObject) (GetPlanNamespacesResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/progress)
+	GetPlanProgress(ctx context.Context, request GetPlanProgressRequestObject) (GetPlanProgressResponseObject, error)
+
+	// (PUT /api/v1/plans/{id}/progress)
+	RecordPlanProgress(ctx context.Context, request RecordPlanProgressRequestObject) (RecordPlanProgressResponseObject, error)
+
+	// (PUT /api/v1/plans/{id}/schedule)
+	ImportPlanSchedule(ctx context.Context, request ImportPlanScheduleRequestObject) (ImportPlanScheduleResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/shares)
+	ListPlanShares(ctx context.Context, request ListPlanSharesRequestObject) (ListPlanSharesResponseObject, error)
+
+	// (POST /api/v1/plans/{id}/shares)
+	CreatePlanShare(ctx context.Context, request CreatePlanShareRequestObject) (CreatePlanShareResponseObject, error)
+
+	// (DELETE /api/v1/plans/{id}/shares/{shareId})
+	RevokePlanShare(ctx context.Context, request RevokePlanShareRequestObject) (RevokePlanShareResponseObject, error)
+
+	// (PUT /api/v1/plans/{id}/vm-actuals)
+	RecordPlanVMActuals(ctx context.Context, request RecordPlanVMActualsRequestObject) (RecordPlanVMActualsResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/vms/{vm}/effort)
+	GetPlanVMEffort(ctx context.Context, request GetPlanVMEffortRequestObject) (GetPlanVMEffortResponseObject, error)
+
+	// (GET /api/v1/plans/{id}/waves/{n}/live)
+	GetPlanWaveLive(ctx context.Context, request GetPlanWaveLiveRequestObject) (GetPlanWaveLiveResponseObject, error)
+
+	// (PUT /api/v1/plans/{id}/waves/{n}/tracking)
+	RecordPlanWaveTracking(ctx context.Context, request RecordPlanWaveTrackingRequestObject) (RecordPlanWaveTrackingResponseObject, error)
+
+	// (POST /api/v1/plans/{id}/what-if)
+	SimulatePlanStaffing(ctx context.Context, request SimulatePlanStaffingRequestObject) (SimulatePlanStaffingResponseObject, error)
+
+	// (GET /api/v1/programs/{id}/forecast)
+	GetProgramForecast(ctx context.Context, request GetProgramForecastRequestObject) (GetProgramForecastResponseObject, error)
+
+	// (GET /api/v1/rate-cards)
+	ListRateCards(ctx context.Context, request ListRateCardsRequestObject) (ListRateCardsResponseObject, error)
+
+	// (POST /api/v1/rate-cards)
+	CreateRateCard(ctx context.Context, request CreateRateCardRequestObject) (CreateRateCardResponseObject, error)
+
+	// (DELETE /api/v1/rate-cards/{id})
+	DeleteRateCard(ctx context.Context, request DeleteRateCardRequestObject) (DeleteRateCardResponseObject, error)
+
+	// (GET /api/v1/rate-cards/{id})
+	GetRateCard(ctx context.Context, request GetRateCardRequestObject) (GetRateCardResponseObject, error)
+
+	// (POST /api/v1/reports/portfolio)
+	CreatePortfolioReport(ctx context.Context, request CreatePortfolioReportRequestObject) (CreatePortfolioReportResponseObject, error)
+
+	// (GET /api/v1/reports/portfolio/{id})
+	GetPortfolioReport(ctx context.Context, request GetPortfolioReportRequestObject) (GetPortfolioReportResponseObject, error)
+
+	// (DELETE /api/v1/sources)
+	DeleteSources(ctx context.Context, request DeleteSourcesRequestObject) (DeleteSourcesResponseObject, error)
+
+	// (GET /api/v1/sources)
+	ListSources(ctx context.Context, request ListSourcesRequestObject) (ListSourcesResponseObject, error)
+
+	// (POST /api/v1/sources)
+	CreateSource(ctx context.Context, request CreateSourceRequestObject) (CreateSourceResponseObject, error)
+
+	// (DELETE /api/v1/sources/{id})
+	DeleteSource(ctx context.Context, request DeleteSourceRequestObject) (DeleteSourceResponseObject, error)
+
+	// (GET /api/v1/sources/{id})
+	GetSource(ctx context.Context, request GetSourceRequestObject) (GetSourceResponseObject, error)
+
+	// (PUT /api/v1/sources/{id})
+	UpdateSource(ctx context.Context, request UpdateSourceRequestObject) (UpdateSourceResponseObject, error)
+
+	// (GET /api/v1/sources/{id}/change-rate-job)
+	GetChangeRateJob(ctx context.Context, request GetChangeRateJobRequestObject) (GetChangeRateJobResponseObject, error)
+
+	// (PUT /api/v1/sources/{id}/change-rate-job)
+	StartChangeRateJob(ctx context.Context, request StartChangeRateJobRequestObject) (StartChangeRateJobResponseObject, error)
+
+	// (HEAD /api/v1/sources/{id}/image)
+	HeadImage(ctx context.Context, request HeadImageRequestObject) (HeadImageResponseObject, error)
+
+	// (GET /api/v1/sources/{id}/image-url)
+	GetSourceDownloadURL(ctx context.Context, request GetSourceDownloadURLRequestObject) (GetSourceDownloadURLResponseObject, error)
+
+	// (PUT /api/v1/sources/{id}/inventory)
+	UpdateInventory(ctx context.Context, request UpdateInventoryRequestObject) (UpdateInventoryResponseObject, error)
+
+	// (DELETE /api/v1/troubleshooting-model)
+	DeleteTroubleshootingModel(ctx context.Context, request DeleteTroubleshootingModelRequestObject) (DeleteTroubleshootingModelResponseObject, error)
+
+	// (GET /api/v1/troubleshooting-model)
+	GetTroubleshootingModel(ctx context.Context, request GetTroubleshootingModelRequestObject) (GetTroubleshootingModelResponseObject, error)
+
+	// (PUT /api/v1/troubleshooting-model)
+	SetTroubleshootingModel(ctx context.Context, request SetTroubleshootingModelRequestObject) (SetTroubleshootingModelResponseObject, error)
+
+	// (GET /api/v1/webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+
+	// (GET /api/v1/webhooks/{id}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+
+	// (POST /api/v1/webhooks/{id}/redrive)
+	RedriveWebhook(ctx context.Context, request RedriveWebhookRequestObject) (RedriveWebhookResponseObject, error)
+
+	// (GET /health)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// DeleteAlertWebhook operation middleware
+func (sh *strictHandler) DeleteAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	var request DeleteAlertWebhookRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAlertWebhook(ctx, request.(DeleteAlertWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAlertWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAlertWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteAlertWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAlertWebhook operation middleware
+func (sh *strictHandler) GetAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	var request GetAlertWebhookRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAlertWebhook(ctx, request.(GetAlertWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAlertWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAlertWebhookResponseObject); ok {
+		if err := validResponse.VisitGetAlertWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetAlertWebhook operation middleware
+func (sh *strictHandler) SetAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	var request SetAlertWebhookRequestObject
+
+	var body SetAlertWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetAlertWebhook(ctx, request.(SetAlertWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetAlertWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetAlertWebhookResponseObject); ok {
+		if err := validResponse.VisitSetAlertWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAssessments operation middleware
+func (sh *strictHandler) ListAssessments(w http.ResponseWriter, r *http.Request, params ListAssessmentsParams) {
+	var request ListAssessmentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssessments(ctx, request.(ListAssessmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssessments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssessmentsResponseObject); ok {
+		if err := validResponse.VisitListAssessmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAssessment operation middleware
+func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request) {
+	var request CreateAssessmentRequestObject
+
+	var body CreateAssessmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAssessment(ctx, request.(CreateAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAssessment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAssessmentResponseObject); ok {
+		if err := validResponse.VisitCreateAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelJob operation middleware
+func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, id int64) {
+	var request CancelJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelJob(ctx, request.(CancelJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
+		if err := validResponse.VisitCancelJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJob operation middleware
+func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobResponseObject); ok {
+		if err := validResponse.VisitGetJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateRVToolsAssessment operation middleware
+func (sh *strictHandler) CreateRVToolsAssessment(w http.ResponseWriter, r *http.Request) {
+	var request CreateRVToolsAssessmentRequestObject
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRVToolsAssessment(ctx, request.(CreateRVToolsAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRVToolsAssessment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRVToolsAssessmentResponseObject); ok {
+		if err := validResponse.VisitCreateRVToolsAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAssessment operation middleware
+func (sh *strictHandler) DeleteAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAssessmentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAssessment(ctx, request.(DeleteAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAssessment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAssessmentResponseObject); ok {
+		if err := validResponse.VisitDeleteAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAssessment operation middleware
+func (sh *strictHandler) GetAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAssessmentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAssessment(ctx, request.(GetAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAssessment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssessmentResponseObject); ok {
+		if err := validResponse.VisitGetAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAssessment operation middleware
+func (sh *strictHandler) UpdateAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateAssessmentRequestObject
+
+	request.Id = id
+
+	var body UpdateAssessmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAssessment(ctx, request.(UpdateAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAssessment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAssessmentResponseObject); ok {
+		if err := validResponse.VisitUpdateAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CalculateAssessmentClusterRequirements operation middleware
+func (sh *strictHandler) CalculateAssessmentClusterRequirements(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateAssessmentClusterRequirementsRequestObject
+
+	request.Id = id
+
+	var body CalculateAssessmentClusterRequirementsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateAssessmentClusterRequirements(ctx, request.(CalculateAssessmentClusterRequirementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateAssessmentClusterRequirements")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateAssessmentClusterRequirementsResponseObject); ok {
+		if err := validResponse.VisitCalculateAssessmentClusterRequirementsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CalculateMigrationComplexity operation middleware
+func (sh *strictHandler) CalculateMigrationComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateMigrationComplexityRequestObject
+
+	request.Id = id
+
+	var body CalculateMigrationComplexityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateMigrationComplexity(ctx, request.(CalculateMigrationComplexityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateMigrationComplexity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateMigrationComplexityResponseObject); ok {
+		if err := validResponse.VisitCalculateMigrationComplexityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateEstimationFreeze operation middleware
+func (sh *strictHandler) CreateEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CreateEstimationFreezeRequestObject
+
+	request.Id = id
+
+	var body CreateEstimationFreezeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEstimationFreeze(ctx, request.(CreateEstimationFreezeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEstimationFreeze")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEstimationFreezeResponseObject); ok {
+		if err := validResponse.VisitCreateEstimationFreezeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEstimationRuns operation middleware
+func (sh *strictHandler) ListEstimationRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListEstimationRunsParams) {
+	var request ListEstimationRunsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEstimationRuns(ctx, request.(ListEstimationRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEstimationRuns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEstimationRunsResponseObject); ok {
+		if err := validResponse.VisitListEstimationRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CalculateFactoryThroughput operation middleware
+func (sh *strictHandler) CalculateFactoryThroughput(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateFactoryThroughputRequestObject
+
+	request.Id = id
+
+	var body CalculateFactoryThroughputJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateFactoryThroughput(ctx, request.(CalculateFactoryThroughputRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateFactoryThroughput")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateFactoryThroughputResponseObject); ok {
+		if err := validResponse.VisitCalculateFactoryThroughputResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CalculateMigrationEstimation operation middleware
+func (sh *strictHandler) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateMigrationEstimationRequestObject
+
+	request.Id = id
+
+	var body CalculateMigrationEstimationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateMigrationEstimation(ctx, request.(CalculateMigrationEstimationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateMigrationEstimation")
+	}
 
-func (response CalculateAssessmentClusterRequirements200JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateMigrationEstimationResponseObject); ok {
+		if err := validResponse.VisitCalculateMigrationEstimationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateAssessmentClusterRequirements400JSONResponse Error
+// GetCalculatorDefaults operation middleware
+func (sh *strictHandler) GetCalculatorDefaults(w http.ResponseWriter, r *http.Request) {
+	var request GetCalculatorDefaultsRequestObject
 
-func (response CalculateAssessmentClusterRequirements400JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalculatorDefaults(ctx, request.(GetCalculatorDefaultsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalculatorDefaults")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CalculateAssessmentClusterRequirements401JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCalculatorDefaultsResponseObject); ok {
+		if err := validResponse.VisitGetCalculatorDefaultsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response CalculateAssessmentClusterRequirements401JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// SetCalculatorDefaults operation middleware
+func (sh *strictHandler) SetCalculatorDefaults(w http.ResponseWriter, r *http.Request) {
+	var request SetCalculatorDefaultsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetCalculatorDefaultsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CalculateAssessmentClusterRequirements403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCalculatorDefaults(ctx, request.(SetCalculatorDefaultsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCalculatorDefaults")
+	}
 
-func (response CalculateAssessmentClusterRequirements403JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetCalculatorDefaultsResponseObject); ok {
+		if err := validResponse.VisitSetCalculatorDefaultsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateAssessmentClusterRequirements404JSONResponse Error
+// ListChecklistTemplates operation middleware
+func (sh *strictHandler) ListChecklistTemplates(w http.ResponseWriter, r *http.Request) {
+	var request ListChecklistTemplatesRequestObject
 
-func (response CalculateAssessmentClusterRequirements404JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListChecklistTemplates(ctx, request.(ListChecklistTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListChecklistTemplates")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CalculateAssessmentClusterRequirements500JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListChecklistTemplatesResponseObject); ok {
+		if err := validResponse.VisitListChecklistTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response CalculateAssessmentClusterRequirements500JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CreateChecklistTemplate operation middleware
+func (sh *strictHandler) CreateChecklistTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreateChecklistTemplateRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateChecklistTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CalculateAssessmentClusterRequirements503JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateChecklistTemplate(ctx, request.(CreateChecklistTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateChecklistTemplate")
+	}
 
-func (response CalculateAssessmentClusterRequirements503JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateChecklistTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateChecklistTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateMigrationComplexityRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CalculateMigrationComplexityJSONRequestBody
-}
+// DeleteChecklistTemplate operation middleware
+func (sh *strictHandler) DeleteChecklistTemplate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteChecklistTemplateRequestObject
 
-type CalculateMigrationComplexityResponseObject interface {
-	VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type CalculateMigrationComplexity200JSONResponse MigrationComplexityResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteChecklistTemplate(ctx, request.(DeleteChecklistTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteChecklistTemplate")
+	}
 
-func (response CalculateMigrationComplexity200JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteChecklistTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteChecklistTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateMigrationComplexity400JSONResponse Error
+// GetContingencyPolicy operation middleware
+func (sh *strictHandler) GetContingencyPolicy(w http.ResponseWriter, r *http.Request) {
+	var request GetContingencyPolicyRequestObject
 
-func (response CalculateMigrationComplexity400JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetContingencyPolicy(ctx, request.(GetContingencyPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetContingencyPolicy")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CalculateMigrationComplexity401JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetContingencyPolicyResponseObject); ok {
+		if err := validResponse.VisitGetContingencyPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response CalculateMigrationComplexity401JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// SetContingencyPolicy operation middleware
+func (sh *strictHandler) SetContingencyPolicy(w http.ResponseWriter, r *http.Request) {
+	var request SetContingencyPolicyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetContingencyPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CalculateMigrationComplexity403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetContingencyPolicy(ctx, request.(SetContingencyPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetContingencyPolicy")
+	}
 
-func (response CalculateMigrationComplexity403JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetContingencyPolicyResponseObject); ok {
+		if err := validResponse.VisitSetContingencyPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateMigrationComplexity404JSONResponse Error
-
-func (response CalculateMigrationComplexity404JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// CreateDebugBundle operation middleware
+func (sh *strictHandler) CreateDebugBundle(w http.ResponseWriter, r *http.Request) {
+	var request CreateDebugBundleRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateDebugBundleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CalculateMigrationComplexity500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDebugBundle(ctx, request.(CreateDebugBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDebugBundle")
+	}
 
-func (response CalculateMigrationComplexity500JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDebugBundleResponseObject); ok {
+		if err := validResponse.VisitCreateDebugBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateMigrationEstimationRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CalculateMigrationEstimationJSONRequestBody
-}
+// ListDurationDistributions operation middleware
+func (sh *strictHandler) ListDurationDistributions(w http.ResponseWriter, r *http.Request, params ListDurationDistributionsParams) {
+	var request ListDurationDistributionsRequestObject
 
-type CalculateMigrationEstimationResponseObject interface {
-	VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type CalculateMigrationEstimation200JSONResponse MigrationEstimationResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDurationDistributions(ctx, request.(ListDurationDistributionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDurationDistributions")
+	}
 
-func (response CalculateMigrationEstimation200JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDurationDistributionsResponseObject); ok {
+		if err := validResponse.VisitListDurationDistributionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateMigrationEstimation400JSONResponse Error
-
-func (response CalculateMigrationEstimation400JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ListEstimationFreezes operation middleware
+func (sh *strictHandler) ListEstimationFreezes(w http.ResponseWriter, r *http.Request, params ListEstimationFreezesParams) {
+	var request ListEstimationFreezesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type CalculateMigrationEstimation401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEstimationFreezes(ctx, request.(ListEstimationFreezesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEstimationFreezes")
+	}
 
-func (response CalculateMigrationEstimation401JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEstimationFreezesResponseObject); ok {
+		if err := validResponse.VisitListEstimationFreezesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateMigrationEstimation403JSONResponse Error
-
-func (response CalculateMigrationEstimation403JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetEstimationFreeze operation middleware
+func (sh *strictHandler) GetEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetEstimationFreezeRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type CalculateMigrationEstimation404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEstimationFreeze(ctx, request.(GetEstimationFreezeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEstimationFreeze")
+	}
 
-func (response CalculateMigrationEstimation404JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEstimationFreezeResponseObject); ok {
+		if err := validResponse.VisitGetEstimationFreezeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CalculateMigrationEstimation500JSONResponse Error
+// DiffEstimationFreeze operation middleware
+func (sh *strictHandler) DiffEstimationFreeze(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DiffEstimationFreezeRequestObject
 
-func (response CalculateMigrationEstimation500JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffEstimationFreeze(ctx, request.(DiffEstimationFreezeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffEstimationFreeze")
+	}
 
-type GetInfoRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetInfoResponseObject interface {
-	VisitGetInfoResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffEstimationFreezeResponseObject); ok {
+		if err := validResponse.VisitDiffEstimationFreezeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetInfo200JSONResponse Info
-
-func (response GetInfo200JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetEstimationFreezeReport operation middleware
+func (sh *strictHandler) GetEstimationFreezeReport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetEstimationFreezeReportRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetInfo500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEstimationFreezeReport(ctx, request.(GetEstimationFreezeReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEstimationFreezeReport")
+	}
 
-func (response GetInfo500JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEstimationFreezeReportResponseObject); ok {
+		if err := validResponse.VisitGetEstimationFreezeReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSourcesRequestObject struct {
-}
+// GetEstimationRun operation middleware
+func (sh *strictHandler) GetEstimationRun(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetEstimationRunRequestObject
 
-type DeleteSourcesResponseObject interface {
-	VisitDeleteSourcesResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type DeleteSources200JSONResponse Status
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEstimationRun(ctx, request.(GetEstimationRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEstimationRun")
+	}
 
-func (response DeleteSources200JSONResponse) VisitDeleteSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEstimationRunResponseObject); ok {
+		if err := validResponse.VisitGetEstimationRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSources401JSONResponse Error
-
-func (response DeleteSources401JSONResponse) VisitDeleteSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// CompareEstimationRuns operation middleware
+func (sh *strictHandler) CompareEstimationRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params CompareEstimationRunsParams) {
+	var request CompareEstimationRunsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type DeleteSources500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CompareEstimationRuns(ctx, request.(CompareEstimationRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompareEstimationRuns")
+	}
 
-func (response DeleteSources500JSONResponse) VisitDeleteSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CompareEstimationRunsResponseObject); ok {
+		if err := validResponse.VisitCompareEstimationRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListSourcesRequestObject struct {
-}
+// RunEstimation operation middleware
+func (sh *strictHandler) RunEstimation(w http.ResponseWriter, r *http.Request) {
+	var request RunEstimationRequestObject
 
-type ListSourcesResponseObject interface {
-	VisitListSourcesResponse(w http.ResponseWriter) error
-}
+	var body RunEstimationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListSources200JSONResponse SourceList
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunEstimation(ctx, request.(RunEstimationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunEstimation")
+	}
 
-func (response ListSources200JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunEstimationResponseObject); ok {
+		if err := validResponse.VisitRunEstimationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListSources401JSONResponse Error
-
-func (response ListSources401JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// CompareEstimations operation middleware
+func (sh *strictHandler) CompareEstimations(w http.ResponseWriter, r *http.Request) {
+	var request CompareEstimationsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CompareEstimationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListSources500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CompareEstimations(ctx, request.(CompareEstimationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompareEstimations")
+	}
 
-func (response ListSources500JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CompareEstimationsResponseObject); ok {
+		if err := validResponse.VisitCompareEstimationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateSourceRequestObject struct {
-	Body *CreateSourceJSONRequestBody
-}
+// SolveEstimationDeadline operation middleware
+func (sh *strictHandler) SolveEstimationDeadline(w http.ResponseWriter, r *http.Request) {
+	var request SolveEstimationDeadlineRequestObject
 
-type CreateSourceResponseObject interface {
-	VisitCreateSourceResponse(w http.ResponseWriter) error
-}
+	var body SolveEstimationDeadlineJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateSource201JSONResponse Source
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SolveEstimationDeadline(ctx, request.(SolveEstimationDeadlineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SolveEstimationDeadline")
+	}
 
-func (response CreateSource201JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SolveEstimationDeadlineResponseObject); ok {
+		if err := validResponse.VisitSolveEstimationDeadlineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateSource400JSONResponse Error
-
-func (response CreateSource400JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetEstimationJob operation middleware
+func (sh *strictHandler) GetEstimationJob(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetEstimationJobRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type CreateSource401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEstimationJob(ctx, request.(GetEstimationJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEstimationJob")
+	}
 
-func (response CreateSource401JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEstimationJobResponseObject); ok {
+		if err := validResponse.VisitGetEstimationJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateSource500JSONResponse Error
+// ListEvents operation middleware
+func (sh *strictHandler) ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams) {
+	var request ListEventsRequestObject
 
-func (response CreateSource500JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEvents(ctx, request.(ListEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEvents")
+	}
 
-type DeleteSourceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEventsResponseObject); ok {
+		if err := validResponse.VisitListEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSourceResponseObject interface {
-	VisitDeleteSourceResponse(w http.ResponseWriter) error
-}
+// GetExportPolicy operation middleware
+func (sh *strictHandler) GetExportPolicy(w http.ResponseWriter, r *http.Request) {
+	var request GetExportPolicyRequestObject
 
-type DeleteSource200JSONResponse Source
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportPolicy(ctx, request.(GetExportPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportPolicy")
+	}
 
-func (response DeleteSource200JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportPolicyResponseObject); ok {
+		if err := validResponse.VisitGetExportPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSource400JSONResponse Error
-
-func (response DeleteSource400JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// SetExportPolicy operation middleware
+func (sh *strictHandler) SetExportPolicy(w http.ResponseWriter, r *http.Request) {
+	var request SetExportPolicyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetExportPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteSource401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetExportPolicy(ctx, request.(SetExportPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetExportPolicy")
+	}
 
-func (response DeleteSource401JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetExportPolicyResponseObject); ok {
+		if err := validResponse.VisitSetExportPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSource403JSONResponse Error
+// GetGuardrailPolicy operation middleware
+func (sh *strictHandler) GetGuardrailPolicy(w http.ResponseWriter, r *http.Request) {
+	var request GetGuardrailPolicyRequestObject
 
-func (response DeleteSource403JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGuardrailPolicy(ctx, request.(GetGuardrailPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGuardrailPolicy")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGuardrailPolicyResponseObject); ok {
+		if err := validResponse.VisitGetGuardrailPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSource404JSONResponse Error
+// SetGuardrailPolicy operation middleware
+func (sh *strictHandler) SetGuardrailPolicy(w http.ResponseWriter, r *http.Request) {
+	var request SetGuardrailPolicyRequestObject
 
-func (response DeleteSource404JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body SetGuardrailPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetGuardrailPolicy(ctx, request.(SetGuardrailPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetGuardrailPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetGuardrailPolicyResponseObject); ok {
+		if err := validResponse.VisitSetGuardrailPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSource500JSONResponse Error
+// GetInfo operation middleware
+func (sh *strictHandler) GetInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetInfoRequestObject
 
-func (response DeleteSource500JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInfo(ctx, request.(GetInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInfo")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSourceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetInfoResponseObject); ok {
+		if err := validResponse.VisitGetInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSourceResponseObject interface {
-	VisitGetSourceResponse(w http.ResponseWriter) error
-}
+// GetInventoryFieldSchema operation middleware
+func (sh *strictHandler) GetInventoryFieldSchema(w http.ResponseWriter, r *http.Request) {
+	var request GetInventoryFieldSchemaRequestObject
 
-type GetSource200JSONResponse Source
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInventoryFieldSchema(ctx, request.(GetInventoryFieldSchemaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInventoryFieldSchema")
+	}
 
-func (response GetSource200JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetInventoryFieldSchemaResponseObject); ok {
+		if err := validResponse.VisitGetInventoryFieldSchemaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSource400JSONResponse Error
-
-func (response GetSource400JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// SetInventoryFieldSchema operation middleware
+func (sh *strictHandler) SetInventoryFieldSchema(w http.ResponseWriter, r *http.Request) {
+	var request SetInventoryFieldSchemaRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetInventoryFieldSchemaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSource401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetInventoryFieldSchema(ctx, request.(SetInventoryFieldSchemaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetInventoryFieldSchema")
+	}
 
-func (response GetSource401JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetInventoryFieldSchemaResponseObject); ok {
+		if err := validResponse.VisitSetInventoryFieldSchemaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSource403JSONResponse Error
+// ListPlans operation middleware
+func (sh *strictHandler) ListPlans(w http.ResponseWriter, r *http.Request) {
+	var request ListPlansRequestObject
 
-func (response GetSource403JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPlans(ctx, request.(ListPlansRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPlans")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSource404JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPlansResponseObject); ok {
+		if err := validResponse.VisitListPlansResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// CreatePlan operation middleware
+func (sh *strictHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	var request CreatePlanRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreatePlanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSource500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePlan(ctx, request.(CreatePlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePlan")
+	}
 
-func (response GetSource500JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePlanResponseObject); ok {
+		if err := validResponse.VisitCreatePlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSourceRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateSourceJSONRequestBody
-}
+// DeletePlan operation middleware
+func (sh *strictHandler) DeletePlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeletePlanRequestObject
 
-type UpdateSourceResponseObject interface {
-	VisitUpdateSourceResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type UpdateSource200JSONResponse Source
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePlan(ctx, request.(DeletePlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePlan")
+	}
 
-func (response UpdateSource200JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePlanResponseObject); ok {
+		if err := validResponse.VisitDeletePlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSource400JSONResponse Error
-
-func (response UpdateSource400JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetPlan operation middleware
+func (sh *strictHandler) GetPlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetPlanRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type UpdateSource401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlan(ctx, request.(GetPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlan")
+	}
 
-func (response UpdateSource401JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanResponseObject); ok {
+		if err := validResponse.VisitGetPlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSource403JSONResponse Error
+// ImportPlanCalendar operation middleware
+func (sh *strictHandler) ImportPlanCalendar(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, pool string, params ImportPlanCalendarParams) {
+	var request ImportPlanCalendarRequestObject
 
-func (response UpdateSource403JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Id = id
+	request.Pool = pool
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Body = r.Body
 
-type UpdateSource404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportPlanCalendar(ctx, request.(ImportPlanCalendarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportPlanCalendar")
+	}
 
-func (response UpdateSource404JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportPlanCalendarResponseObject); ok {
+		if err := validResponse.VisitImportPlanCalendarResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSource500JSONResponse Error
+// GetPlanChargeback operation middleware
+func (sh *strictHandler) GetPlanChargeback(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanChargebackParams) {
+	var request GetPlanChargebackRequestObject
 
-func (response UpdateSource500JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlanChargeback(ctx, request.(GetPlanChargebackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlanChargeback")
+	}
 
-type HeadImageRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type HeadImageResponseObject interface {
-	VisitHeadImageResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanChargebackResponseObject); ok {
+		if err := validResponse.VisitGetPlanChargebackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type HeadImage200Response struct {
-}
+// ListPlanChecklists operation middleware
+func (sh *strictHandler) ListPlanChecklists(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListPlanChecklistsParams) {
+	var request ListPlanChecklistsRequestObject
 
-func (response HeadImage200Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.Id = id
+	request.Params = params
 
-type HeadImage400Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPlanChecklists(ctx, request.(ListPlanChecklistsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPlanChecklists")
+	}
 
-func (response HeadImage400Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type HeadImage401Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPlanChecklistsResponseObject); ok {
+		if err := validResponse.VisitListPlanChecklistsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response HeadImage401Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// InstantiatePlanChecklists operation middleware
+func (sh *strictHandler) InstantiatePlanChecklists(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request InstantiatePlanChecklistsRequestObject
 
-type HeadImage403Response struct {
-}
+	request.Id = id
 
-func (response HeadImage403Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	var body InstantiatePlanChecklistsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type HeadImage404Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InstantiatePlanChecklists(ctx, request.(InstantiatePlanChecklistsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InstantiatePlanChecklists")
+	}
 
-func (response HeadImage404Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type HeadImage500Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InstantiatePlanChecklistsResponseObject); ok {
+		if err := validResponse.VisitInstantiatePlanChecklistsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response HeadImage500Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// CompletePlanChecklistItem operation middleware
+func (sh *strictHandler) CompletePlanChecklistItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, checklistId openapi_types.UUID, index int) {
+	var request CompletePlanChecklistItemRequestObject
 
-type GetSourceDownloadURLRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	request.Id = id
+	request.ChecklistId = checklistId
+	request.Index = index
 
-type GetSourceDownloadURLResponseObject interface {
-	VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error
-}
+	var body CompletePlanChecklistItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSourceDownloadURL200JSONResponse PresignedUrl
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CompletePlanChecklistItem(ctx, request.(CompletePlanChecklistItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompletePlanChecklistItem")
+	}
 
-func (response GetSourceDownloadURL200JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CompletePlanChecklistItemResponseObject); ok {
+		if err := validResponse.VisitCompletePlanChecklistItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSourceDownloadURL400JSONResponse Error
-
-func (response GetSourceDownloadURL400JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetPlanCoverage operation middleware
+func (sh *strictHandler) GetPlanCoverage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetPlanCoverageRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetSourceDownloadURL401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlanCoverage(ctx, request.(GetPlanCoverageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlanCoverage")
+	}
 
-func (response GetSourceDownloadURL401JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanCoverageResponseObject); ok {
+		if err := validResponse.VisitGetPlanCoverageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSourceDownloadURL404JSONResponse Error
+// ExportPlan operation middleware
+func (sh *strictHandler) ExportPlan(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExportPlanParams) {
+	var request ExportPlanRequestObject
 
-func (response GetSourceDownloadURL404JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportPlan(ctx, request.(ExportPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportPlan")
+	}
 
-type UpdateInventoryRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateInventoryJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdateInventoryResponseObject interface {
-	VisitUpdateInventoryResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportPlanResponseObject); ok {
+		if err := validResponse.VisitExportPlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateInventory200JSONResponse Source
-
-func (response UpdateInventory200JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetPlanGantt operation middleware
+func (sh *strictHandler) GetPlanGantt(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanGanttParams) {
+	var request GetPlanGanttRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type UpdateInventory400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlanGantt(ctx, request.(GetPlanGanttRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlanGantt")
+	}
 
-func (response UpdateInventory400JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanGanttResponseObject); ok {
+		if err := validResponse.VisitGetPlanGanttResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateInventory401JSONResponse Error
+// ApprovePlanGate operation middleware
+func (sh *strictHandler) ApprovePlanGate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, gate string) {
+	var request ApprovePlanGateRequestObject
 
-func (response UpdateInventory401JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Id = id
+	request.Gate = gate
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ApprovePlanGateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateInventory403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApprovePlanGate(ctx, request.(ApprovePlanGateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApprovePlanGate")
+	}
 
-func (response UpdateInventory403JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApprovePlanGateResponseObject); ok {
+		if err := validResponse.VisitApprovePlanGateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateInventory404JSONResponse Error
+// SetPlanKPIs operation middleware
+func (sh *strictHandler) SetPlanKPIs(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SetPlanKPIsRequestObject
 
-func (response UpdateInventory404JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetPlanKPIsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateInventory500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetPlanKPIs(ctx, request.(SetPlanKPIsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetPlanKPIs")
+	}
 
-func (response UpdateInventory500JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetPlanKPIsResponseObject); ok {
+		if err := validResponse.VisitSetPlanKPIsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type HealthRequestObject struct {
-}
+// GetPlanNamespaces operation middleware
+func (sh *strictHandler) GetPlanNamespaces(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetPlanNamespacesParams) {
+	var request GetPlanNamespacesRequestObject
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type Health200Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlanNamespaces(ctx, request.(GetPlanNamespacesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlanNamespaces")
+	}
 
-func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanNamespacesResponseObject); ok {
+		if err := validResponse.VisitGetPlanNamespacesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /api/v1/assessments)
-	ListAssessments(ctx context.Context, request ListAssessmentsRequestObject) (ListAssessmentsResponseObject, error)
+// GetPlanProgress operation middleware
+func (sh *strictHandler) GetPlanProgress(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetPlanProgressRequestObject
 
-	// (POST /api/v1/assessments)
-	CreateAssessment(ctx context.Context, request CreateAssessmentRequestObject) (CreateAssessmentResponseObject, error)
+	request.Id = id
 
-	// (DELETE /api/v1/assessments/jobs/{id})
-	CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlanProgress(ctx, request.(GetPlanProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlanProgress")
+	}
 
-	// (GET /api/v1/assessments/jobs/{id})
-	GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /api/v1/assessments/rvtools)
-	CreateRVToolsAssessment(ctx context.Context, request CreateRVToolsAssessmentRequestObject) (CreateRVToolsAssessmentResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanProgressResponseObject); ok {
+		if err := validResponse.VisitGetPlanProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/assessments/{id})
-	DeleteAssessment(ctx context.Context, request DeleteAssessmentRequestObject) (DeleteAssessmentResponseObject, error)
+// RecordPlanProgress operation middleware
+func (sh *strictHandler) RecordPlanProgress(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RecordPlanProgressRequestObject
 
-	// (GET /api/v1/assessments/{id})
-	GetAssessment(ctx context.Context, request GetAssessmentRequestObject) (GetAssessmentResponseObject, error)
+	request.Id = id
 
-	// (PUT /api/v1/assessments/{id})
-	UpdateAssessment(ctx context.Context, request UpdateAssessmentRequestObject) (UpdateAssessmentResponseObject, error)
+	var body RecordPlanProgressJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (POST /api/v1/assessments/{id}/cluster-requirements)
-	CalculateAssessmentClusterRequirements(ctx context.Context, request CalculateAssessmentClusterRequirementsRequestObject) (CalculateAssessmentClusterRequirementsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RecordPlanProgress(ctx, request.(RecordPlanProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecordPlanProgress")
+	}
 
-	// (POST /api/v1/assessments/{id}/complexity-estimation)
-	CalculateMigrationComplexity(ctx context.Context, request CalculateMigrationComplexityRequestObject) (CalculateMigrationComplexityResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /api/v1/assessments/{id}/migration-estimation)
-	CalculateMigrationEstimation(ctx context.Context, request CalculateMigrationEstimationRequestObject) (CalculateMigrationEstimationResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RecordPlanProgressResponseObject); ok {
+		if err := validResponse.VisitRecordPlanProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /api/v1/info)
-	GetInfo(ctx context.Context, request GetInfoRequestObject) (GetInfoResponseObject, error)
+// ImportPlanSchedule operation middleware
+func (sh *strictHandler) ImportPlanSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ImportPlanScheduleRequestObject
 
-	// (DELETE /api/v1/sources)
-	DeleteSources(ctx context.Context, request DeleteSourcesRequestObject) (DeleteSourcesResponseObject, error)
+	request.Id = id
 
-	// (GET /api/v1/sources)
-	ListSources(ctx context.Context, request ListSourcesRequestObject) (ListSourcesResponseObject, error)
+	request.Body = r.Body
 
-	// (POST /api/v1/sources)
-	CreateSource(ctx context.Context, request CreateSourceRequestObject) (CreateSourceResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportPlanSchedule(ctx, request.(ImportPlanScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportPlanSchedule")
+	}
 
-	// (DELETE /api/v1/sources/{id})
-	DeleteSource(ctx context.Context, request DeleteSourceRequestObject) (DeleteSourceResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/sources/{id})
-	GetSource(ctx context.Context, request GetSourceRequestObject) (GetSourceResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportPlanScheduleResponseObject); ok {
+		if err := validResponse.VisitImportPlanScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (PUT /api/v1/sources/{id})
-	UpdateSource(ctx context.Context, request UpdateSourceRequestObject) (UpdateSourceResponseObject, error)
+// ListPlanShares operation middleware
+func (sh *strictHandler) ListPlanShares(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListPlanSharesRequestObject
 
-	// (HEAD /api/v1/sources/{id}/image)
-	HeadImage(ctx context.Context, request HeadImageRequestObject) (HeadImageResponseObject, error)
+	request.Id = id
 
-	// (GET /api/v1/sources/{id}/image-url)
-	GetSourceDownloadURL(ctx context.Context, request GetSourceDownloadURLRequestObject) (GetSourceDownloadURLResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPlanShares(ctx, request.(ListPlanSharesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPlanShares")
+	}
 
-	// (PUT /api/v1/sources/{id}/inventory)
-	UpdateInventory(ctx context.Context, request UpdateInventoryRequestObject) (UpdateInventoryResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /health)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPlanSharesResponseObject); ok {
+		if err := validResponse.VisitListPlanSharesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// CreatePlanShare operation middleware
+func (sh *strictHandler) CreatePlanShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CreatePlanShareRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Id = id
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	var body CreatePlanShareJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePlanShare(ctx, request.(CreatePlanShareRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePlanShare")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePlanShareResponseObject); ok {
+		if err := validResponse.VisitCreatePlanShareResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListAssessments operation middleware
-func (sh *strictHandler) ListAssessments(w http.ResponseWriter, r *http.Request, params ListAssessmentsParams) {
-	var request ListAssessmentsRequestObject
+// RevokePlanShare operation middleware
+func (sh *strictHandler) RevokePlanShare(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, shareId openapi_types.UUID) {
+	var request RevokePlanShareRequestObject
 
-	request.Params = params
+	request.Id = id
+	request.ShareId = shareId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAssessments(ctx, request.(ListAssessmentsRequestObject))
+		return sh.ssi.RevokePlanShare(ctx, request.(RevokePlanShareRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAssessments")
+		handler = middleware(handler, "RevokePlanShare")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAssessmentsResponseObject); ok {
-		if err := validResponse.VisitListAssessmentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokePlanShareResponseObject); ok {
+		if err := validResponse.VisitRevokePlanShareResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2158,11 +10984,13 @@ func (sh *strictHandler) ListAssessments(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateAssessment operation middleware
-func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request) {
-	var request CreateAssessmentRequestObject
+// RecordPlanVMActuals operation middleware
+func (sh *strictHandler) RecordPlanVMActuals(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RecordPlanVMActualsRequestObject
 
-	var body CreateAssessmentJSONRequestBody
+	request.Id = id
+
+	var body RecordPlanVMActualsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2170,18 +10998,18 @@ func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAssessment(ctx, request.(CreateAssessmentRequestObject))
+		return sh.ssi.RecordPlanVMActuals(ctx, request.(RecordPlanVMActualsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAssessment")
+		handler = middleware(handler, "RecordPlanVMActuals")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAssessmentResponseObject); ok {
-		if err := validResponse.VisitCreateAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(RecordPlanVMActualsResponseObject); ok {
+		if err := validResponse.VisitRecordPlanVMActualsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2189,25 +11017,26 @@ func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CancelJob operation middleware
-func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, id int64) {
-	var request CancelJobRequestObject
+// GetPlanVMEffort operation middleware
+func (sh *strictHandler) GetPlanVMEffort(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, vm string) {
+	var request GetPlanVMEffortRequestObject
 
 	request.Id = id
+	request.Vm = vm
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelJob(ctx, request.(CancelJobRequestObject))
+		return sh.ssi.GetPlanVMEffort(ctx, request.(GetPlanVMEffortRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelJob")
+		handler = middleware(handler, "GetPlanVMEffort")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
-		if err := validResponse.VisitCancelJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPlanVMEffortResponseObject); ok {
+		if err := validResponse.VisitGetPlanVMEffortResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2215,25 +11044,60 @@ func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, id in
 	}
 }
 
-// GetJob operation middleware
-func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetJobRequestObject
+// GetPlanWaveLive operation middleware
+func (sh *strictHandler) GetPlanWaveLive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, n int) {
+	var request GetPlanWaveLiveRequestObject
+
+	request.Id = id
+	request.N = n
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlanWaveLive(ctx, request.(GetPlanWaveLiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlanWaveLive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanWaveLiveResponseObject); ok {
+		if err := validResponse.VisitGetPlanWaveLiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RecordPlanWaveTracking operation middleware
+func (sh *strictHandler) RecordPlanWaveTracking(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, n int) {
+	var request RecordPlanWaveTrackingRequestObject
 
 	request.Id = id
+	request.N = n
+
+	var body RecordPlanWaveTrackingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
+		return sh.ssi.RecordPlanWaveTracking(ctx, request.(RecordPlanWaveTrackingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetJob")
+		handler = middleware(handler, "RecordPlanWaveTracking")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetJobResponseObject); ok {
-		if err := validResponse.VisitGetJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(RecordPlanWaveTrackingResponseObject); ok {
+		if err := validResponse.VisitRecordPlanWaveTrackingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2241,30 +11105,32 @@ func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id int64
 	}
 }
 
-// CreateRVToolsAssessment operation middleware
-func (sh *strictHandler) CreateRVToolsAssessment(w http.ResponseWriter, r *http.Request) {
-	var request CreateRVToolsAssessmentRequestObject
+// SimulatePlanStaffing operation middleware
+func (sh *strictHandler) SimulatePlanStaffing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SimulatePlanStaffingRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+	request.Id = id
+
+	var body SimulatePlanStaffingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
-	} else {
-		request.Body = reader
 	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRVToolsAssessment(ctx, request.(CreateRVToolsAssessmentRequestObject))
+		return sh.ssi.SimulatePlanStaffing(ctx, request.(SimulatePlanStaffingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRVToolsAssessment")
+		handler = middleware(handler, "SimulatePlanStaffing")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateRVToolsAssessmentResponseObject); ok {
-		if err := validResponse.VisitCreateRVToolsAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(SimulatePlanStaffingResponseObject); ok {
+		if err := validResponse.VisitSimulatePlanStaffingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2272,25 +11138,26 @@ func (sh *strictHandler) CreateRVToolsAssessment(w http.ResponseWriter, r *http.
 	}
 }
 
-// DeleteAssessment operation middleware
-func (sh *strictHandler) DeleteAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteAssessmentRequestObject
+// GetProgramForecast operation middleware
+func (sh *strictHandler) GetProgramForecast(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetProgramForecastParams) {
+	var request GetProgramForecastRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteAssessment(ctx, request.(DeleteAssessmentRequestObject))
+		return sh.ssi.GetProgramForecast(ctx, request.(GetProgramForecastRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteAssessment")
+		handler = middleware(handler, "GetProgramForecast")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAssessmentResponseObject); ok {
-		if err := validResponse.VisitDeleteAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProgramForecastResponseObject); ok {
+		if err := validResponse.VisitGetProgramForecastResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2298,25 +11165,25 @@ func (sh *strictHandler) DeleteAssessment(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetAssessment operation middleware
-func (sh *strictHandler) GetAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetAssessmentRequestObject
+// ListRateCards operation middleware
+func (sh *strictHandler) ListRateCards(w http.ResponseWriter, r *http.Request, params ListRateCardsParams) {
+	var request ListRateCardsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAssessment(ctx, request.(GetAssessmentRequestObject))
+		return sh.ssi.ListRateCards(ctx, request.(ListRateCardsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAssessment")
+		handler = middleware(handler, "ListRateCards")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAssessmentResponseObject); ok {
-		if err := validResponse.VisitGetAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListRateCardsResponseObject); ok {
+		if err := validResponse.VisitListRateCardsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2324,13 +11191,11 @@ func (sh *strictHandler) GetAssessment(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// UpdateAssessment operation middleware
-func (sh *strictHandler) UpdateAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateAssessmentRequestObject
-
-	request.Id = id
+// CreateRateCard operation middleware
+func (sh *strictHandler) CreateRateCard(w http.ResponseWriter, r *http.Request) {
+	var request CreateRateCardRequestObject
 
-	var body UpdateAssessmentJSONRequestBody
+	var body CreateRateCardJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2338,18 +11203,18 @@ func (sh *strictHandler) UpdateAssessment(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAssessment(ctx, request.(UpdateAssessmentRequestObject))
+		return sh.ssi.CreateRateCard(ctx, request.(CreateRateCardRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAssessment")
+		handler = middleware(handler, "CreateRateCard")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateAssessmentResponseObject); ok {
-		if err := validResponse.VisitUpdateAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateRateCardResponseObject); ok {
+		if err := validResponse.VisitCreateRateCardResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2357,32 +11222,25 @@ func (sh *strictHandler) UpdateAssessment(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CalculateAssessmentClusterRequirements operation middleware
-func (sh *strictHandler) CalculateAssessmentClusterRequirements(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CalculateAssessmentClusterRequirementsRequestObject
+// DeleteRateCard operation middleware
+func (sh *strictHandler) DeleteRateCard(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteRateCardRequestObject
 
 	request.Id = id
 
-	var body CalculateAssessmentClusterRequirementsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateAssessmentClusterRequirements(ctx, request.(CalculateAssessmentClusterRequirementsRequestObject))
+		return sh.ssi.DeleteRateCard(ctx, request.(DeleteRateCardRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateAssessmentClusterRequirements")
+		handler = middleware(handler, "DeleteRateCard")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateAssessmentClusterRequirementsResponseObject); ok {
-		if err := validResponse.VisitCalculateAssessmentClusterRequirementsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteRateCardResponseObject); ok {
+		if err := validResponse.VisitDeleteRateCardResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2390,32 +11248,25 @@ func (sh *strictHandler) CalculateAssessmentClusterRequirements(w http.ResponseW
 	}
 }
 
-// CalculateMigrationComplexity operation middleware
-func (sh *strictHandler) CalculateMigrationComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CalculateMigrationComplexityRequestObject
+// GetRateCard operation middleware
+func (sh *strictHandler) GetRateCard(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetRateCardRequestObject
 
 	request.Id = id
 
-	var body CalculateMigrationComplexityJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateMigrationComplexity(ctx, request.(CalculateMigrationComplexityRequestObject))
+		return sh.ssi.GetRateCard(ctx, request.(GetRateCardRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateMigrationComplexity")
+		handler = middleware(handler, "GetRateCard")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateMigrationComplexityResponseObject); ok {
-		if err := validResponse.VisitCalculateMigrationComplexityResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRateCardResponseObject); ok {
+		if err := validResponse.VisitGetRateCardResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2423,13 +11274,11 @@ func (sh *strictHandler) CalculateMigrationComplexity(w http.ResponseWriter, r *
 	}
 }
 
-// CalculateMigrationEstimation operation middleware
-func (sh *strictHandler) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CalculateMigrationEstimationRequestObject
-
-	request.Id = id
+// CreatePortfolioReport operation middleware
+func (sh *strictHandler) CreatePortfolioReport(w http.ResponseWriter, r *http.Request) {
+	var request CreatePortfolioReportRequestObject
 
-	var body CalculateMigrationEstimationJSONRequestBody
+	var body CreatePortfolioReportJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2437,18 +11286,18 @@ func (sh *strictHandler) CalculateMigrationEstimation(w http.ResponseWriter, r *
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateMigrationEstimation(ctx, request.(CalculateMigrationEstimationRequestObject))
+		return sh.ssi.CreatePortfolioReport(ctx, request.(CreatePortfolioReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateMigrationEstimation")
+		handler = middleware(handler, "CreatePortfolioReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateMigrationEstimationResponseObject); ok {
-		if err := validResponse.VisitCalculateMigrationEstimationResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePortfolioReportResponseObject); ok {
+		if err := validResponse.VisitCreatePortfolioReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2456,23 +11305,25 @@ func (sh *strictHandler) CalculateMigrationEstimation(w http.ResponseWriter, r *
 	}
 }
 
-// GetInfo operation middleware
-func (sh *strictHandler) GetInfo(w http.ResponseWriter, r *http.Request) {
-	var request GetInfoRequestObject
+// GetPortfolioReport operation middleware
+func (sh *strictHandler) GetPortfolioReport(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetPortfolioReportRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInfo(ctx, request.(GetInfoRequestObject))
+		return sh.ssi.GetPortfolioReport(ctx, request.(GetPortfolioReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInfo")
+		handler = middleware(handler, "GetPortfolioReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetInfoResponseObject); ok {
-		if err := validResponse.VisitGetInfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPortfolioReportResponseObject); ok {
+		if err := validResponse.VisitGetPortfolioReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2644,6 +11495,65 @@ func (sh *strictHandler) UpdateSource(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
+// GetChangeRateJob operation middleware
+func (sh *strictHandler) GetChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetChangeRateJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChangeRateJob(ctx, request.(GetChangeRateJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChangeRateJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetChangeRateJobResponseObject); ok {
+		if err := validResponse.VisitGetChangeRateJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StartChangeRateJob operation middleware
+func (sh *strictHandler) StartChangeRateJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request StartChangeRateJobRequestObject
+
+	request.Id = id
+
+	var body StartChangeRateJobJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartChangeRateJob(ctx, request.(StartChangeRateJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartChangeRateJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartChangeRateJobResponseObject); ok {
+		if err := validResponse.VisitStartChangeRateJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // HeadImage operation middleware
 func (sh *strictHandler) HeadImage(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	var request HeadImageRequestObject
@@ -2729,6 +11639,162 @@ func (sh *strictHandler) UpdateInventory(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// DeleteTroubleshootingModel operation middleware
+func (sh *strictHandler) DeleteTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	var request DeleteTroubleshootingModelRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTroubleshootingModel(ctx, request.(DeleteTroubleshootingModelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTroubleshootingModel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTroubleshootingModelResponseObject); ok {
+		if err := validResponse.VisitDeleteTroubleshootingModelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTroubleshootingModel operation middleware
+func (sh *strictHandler) GetTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	var request GetTroubleshootingModelRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTroubleshootingModel(ctx, request.(GetTroubleshootingModelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTroubleshootingModel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTroubleshootingModelResponseObject); ok {
+		if err := validResponse.VisitGetTroubleshootingModelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTroubleshootingModel operation middleware
+func (sh *strictHandler) SetTroubleshootingModel(w http.ResponseWriter, r *http.Request) {
+	var request SetTroubleshootingModelRequestObject
+
+	var body SetTroubleshootingModelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTroubleshootingModel(ctx, request.(SetTroubleshootingModelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTroubleshootingModel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTroubleshootingModelResponseObject); ok {
+		if err := validResponse.VisitSetTroubleshootingModelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request ListWebhooksRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id string, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RedriveWebhook operation middleware
+func (sh *strictHandler) RedriveWebhook(w http.ResponseWriter, r *http.Request, id string) {
+	var request RedriveWebhookRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RedriveWebhook(ctx, request.(RedriveWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RedriveWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RedriveWebhookResponseObject); ok {
+		if err := validResponse.VisitRedriveWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Health operation middleware
 func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 	var request HealthRequestObject
@@ -0,0 +1,91 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	runtimepprof "runtime/pprof"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/kubev2v/migration-planner/internal/config"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// AdminServer serves the runtime diagnostics of the service: pprof profiles, expvar variables and
+// dumps of the goroutines and of the job queues, to diagnose slow requests in production without
+// redeploying.
+type AdminServer struct {
+	bindAddress string
+	httpServer  *http.Server
+	listener    net.Listener
+}
+
+// jobQueues is the dump of the job queues.
+type jobQueues struct {
+	Estimations map[service.EstimationPriority]service.EstimationPoolStats `json:"estimations"`
+	Jobs        []store.JobCount                                           `json:"jobs"`
+}
+
+// NewAdminServer creates an AdminServer reporting the load of the estimation queue and of the
+// background jobs of the store.
+func NewAdminServer(cfg config.Admin, s store.Store, estimationQueue *service.EstimationQueue, listener net.Listener) *AdminServer {
+	router := chi.NewRouter()
+	router.Use(middleware.BearerToken(cfg.Token))
+
+	router.HandleFunc("/debug/pprof/*", pprof.Index)
+	router.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	router.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	router.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	router.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	router.Handle("/debug/vars", expvar.Handler())
+
+	router.Get("/debug/goroutines", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_ = runtimepprof.Lookup("goroutine").WriteTo(w, 2)
+	})
+
+	router.Get("/debug/queues", func(w http.ResponseWriter, r *http.Request) {
+		counts, err := s.Job().Count(r.Context())
+		if err != nil {
+			zap.S().Named("admin_server").Errorw("failed to count jobs", "error", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(jobQueues{Estimations: estimationQueue.Stats(), Jobs: counts})
+	})
+
+	return &AdminServer{
+		bindAddress: cfg.Address,
+		listener:    listener,
+		httpServer: &http.Server{
+			Addr:    cfg.Address,
+			Handler: router,
+		},
+	}
+}
+
+func (a *AdminServer) Run(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		ctxTimeout, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+		defer cancel()
+
+		a.httpServer.SetKeepAlivesEnabled(false)
+		_ = a.httpServer.Shutdown(ctxTimeout)
+		zap.S().Named("admin_server").Info("admin server terminated")
+	}()
+
+	zap.S().Named("admin_server").Infof("serving diagnostics: %s", a.bindAddress)
+	if err := a.httpServer.Serve(a.listener); err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
+}
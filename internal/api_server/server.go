@@ -9,6 +9,9 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/netip"
+	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -27,8 +30,12 @@ import (
 	"github.com/kubev2v/migration-planner/internal/rvtools/jobs"
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/log"
 	"github.com/kubev2v/migration-planner/pkg/metrics"
 	"github.com/kubev2v/migration-planner/pkg/middleware"
+	"github.com/kubev2v/migration-planner/pkg/replay"
+	"github.com/kubev2v/migration-planner/pkg/signing"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
 	"go.uber.org/zap"
 )
@@ -43,6 +50,8 @@ type Server struct {
 	listener     net.Listener
 	opaValidator *opa.Validator
 	jobsClient   *jobs.Client
+	// estimationQueue is shared with the admin server, which reports its load.
+	estimationQueue *service.EstimationQueue
 }
 
 // New returns a new instance of a migration-planner server.
@@ -52,13 +61,15 @@ func New(
 	listener net.Listener,
 	opaValidator *opa.Validator,
 	jobsClient *jobs.Client,
+	estimationQueue *service.EstimationQueue,
 ) *Server {
 	return &Server{
-		cfg:          cfg,
-		store:        store,
-		listener:     listener,
-		opaValidator: opaValidator,
-		jobsClient:   jobsClient,
+		cfg:             cfg,
+		store:           store,
+		listener:        listener,
+		opaValidator:    opaValidator,
+		jobsClient:      jobsClient,
+		estimationQueue: estimationQueue,
 	}
 }
 
@@ -112,6 +123,89 @@ func detectOldSchemaMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// organization tells the organization of the authenticated user of the request.
+func organization(r *http.Request) (string, bool) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok || user.Organization == "" {
+		return "", false
+	}
+	return user.Organization, true
+}
+
+// newTrafficCapture appends the anonymized requests to the file, to replay them in soak tests. It
+// lets the requests through when no file is set.
+func newTrafficCapture(path string) (func(http.Handler) http.Handler, error) {
+	if path == "" {
+		return func(next http.Handler) http.Handler { return next }, nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open traffic capture file: %w", err)
+	}
+	zap.S().Named("api_server").Infof("capturing traffic to %s", path)
+	return replay.NewRecorder(f).Handler, nil
+}
+
+// newConcurrencyQuota caps the discoveries, report renders and simulations each organization runs at once.
+func newConcurrencyQuota(cfg config.Quota) (*middleware.ConcurrencyQuota, error) {
+	policy := middleware.QuotaPolicy(cfg.Policy)
+	switch policy {
+	case middleware.QuotaPolicyReject, middleware.QuotaPolicyQueue:
+	default:
+		return nil, fmt.Errorf("invalid quota policy %q", cfg.Policy)
+	}
+	queueTimeout, err := time.ParseDuration(cfg.QueueTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quota queue timeout: %w", err)
+	}
+
+	return middleware.NewConcurrencyQuota(policy, queueTimeout, organization,
+		middleware.QuotaRule{Operation: "discovery", Method: http.MethodPost, Path: regexp.MustCompile(`^/api/v1/assessments(/rvtools)?/?$`), Limit: cfg.Discoveries},
+		middleware.QuotaRule{Operation: "report", Method: http.MethodGet, Path: regexp.MustCompile(`^/api/v1/plans/[^/]+/(export|gantt)/?$`), Limit: cfg.Reports},
+		middleware.QuotaRule{Operation: "simulation", Method: http.MethodPost, Path: regexp.MustCompile(`^/api/v1/plans/[^/]+/what-if/?$`), Limit: cfg.Simulations},
+		middleware.QuotaRule{Operation: "simulation", Method: http.MethodPost, Path: regexp.MustCompile(`^/api/v1/assessments/[^/]+/(migration|complexity)-estimation/?$`), Limit: cfg.Simulations},
+	), nil
+}
+
+// username tells the authenticated user of the request.
+func username(r *http.Request) (string, bool) {
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok || user.Username == "" {
+		return "", false
+	}
+	return user.Username, true
+}
+
+// newRateLimiter limits the request rate of each client, tighter on the estimation routes.
+func newRateLimiter(cfg config.RateLimit) (*middleware.RateLimiter, error) {
+	proxies := make([]netip.Prefix, 0, len(cfg.TrustedProxies))
+	for _, p := range cfg.TrustedProxies {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(p)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		proxies = append(proxies, prefix)
+	}
+
+	return middleware.NewRateLimiter(organization, username, []middleware.RateRule{
+		{
+			Route:  "estimation",
+			Method: http.MethodPost,
+			Path:   regexp.MustCompile(`^/api/v1/(estimations(/comparison|/solve)?|assessments/[^/]+/(migration|complexity)-estimation|plans/[^/]+/what-if)/?$`),
+			Rate:   cfg.EstimationRate,
+			Burst:  cfg.EstimationBurst,
+		},
+		{Route: "api", Path: regexp.MustCompile(`^/api/`), Rate: cfg.APIRate, Burst: cfg.APIBurst},
+	}, middleware.WithTrustedProxies(proxies...)), nil
+}
+
 // Middleware to inject ResponseWriter into context
 func WithResponseWriter(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -142,6 +236,21 @@ func (s *Server) Run(ctx context.Context) error {
 
 	router := chi.NewRouter()
 
+	quota, err := newConcurrencyQuota(s.cfg.Service.Quota)
+	if err != nil {
+		return err
+	}
+
+	capture, err := newTrafficCapture(s.cfg.Service.TrafficCaptureFile)
+	if err != nil {
+		return err
+	}
+
+	rateLimiter, err := newRateLimiter(s.cfg.Service.RateLimit)
+	if err != nil {
+		return err
+	}
+
 	metricMiddleware := metrics.NewMiddleware("api_server")
 	metricMiddleware.MustRegisterDefault()
 
@@ -157,8 +266,16 @@ func (s *Server) Run(ctx context.Context) error {
 		}),
 		authenticator.Authenticator,
 		middleware.RequestID,
-		middleware.Logger(),
+		middleware.Tracing,
+		middleware.LogFields(organization),
+		middleware.AccessLog(zap.L().Named("access"), middleware.AccessLogSampling{
+			Path:  regexp.MustCompile(`^/health/?$`),
+			Every: s.cfg.Service.AccessLog.HealthSampling,
+		}),
+		capture,
 		chiMiddleware.Recoverer,
+		rateLimiter.Handler,
+		quota.Handler,
 		detectOldSchemaMiddleware,
 		oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts),
 		WithResponseWriter,
@@ -172,13 +289,67 @@ func (s *Server) Run(ctx context.Context) error {
 	}
 	sizerClient := client.NewSizerClient(s.cfg.Service.Sizer.ServiceURL, sizerTimeout)
 
+	// Initialize notification client for KPI threshold alerts, posted to the alert webhook of the
+	// organization of the plan
+	notificationTimeout, err := time.ParseDuration(s.cfg.Service.Notification.Timeout)
+	if err != nil {
+		zap.S().Named("api_server").Warnf("Invalid notification timeout, using default 10s: %v", err)
+		notificationTimeout = 10 * time.Second
+	}
+	notificationClient := client.NewNotificationClient("", notificationTimeout)
+	// Domain events are published to their own webhook, e.g. an analytics pipeline, and as CloudEvents
+	eventClient := client.NewNotificationClient(s.cfg.Service.Notification.EventWebhookURL, notificationTimeout)
+	cloudEvents := s.cfg.Service.CloudEvents
+	cloudEventsClient, err := client.NewCloudEventsClient(client.CloudEventsTransport(cloudEvents.Transport), cloudEvents.URL, cloudEvents.Topic, cloudEvents.Source, notificationTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to create cloud events client: %w", err)
+	}
+
+	signer, err := newExportSigner(s.cfg.Service.Signing)
+	if err != nil {
+		return fmt.Errorf("failed to create export signer: %w", err)
+	}
+
+	// The deliveries of the domain events are recorded per webhook, to be replayed once a failing
+	// consumer is fixed
+	webhookService := service.NewWebhookService(s.store, s.cfg.Service.Notification.DisableThreshold)
+	planService := service.NewPlanService(s.store).
+		WithNotifier(notificationClient, notificationTimeout).
+		WithShareBaseURL(s.cfg.Service.BaseImageEndpointUrl).
+		WithSigner(signer)
+	// The plan costs and the planned estimations are converted into the plan currency with the same rates
+	rateProvider := NewRateProvider(s.cfg.Service.ExchangeRates)
+	planService.WithRateProvider(rateProvider)
+	if s.cfg.Service.Notification.EventWebhookURL != "" {
+		planService.WithEventPublisher(webhookService.Track(service.WebhookEvents, eventClient))
+	}
+	if cloudEvents.Transport != "" {
+		planService.WithEventPublisher(webhookService.Track(service.WebhookCloudEvents, cloudEventsClient))
+	}
+
+	estimationService := service.NewEstimationService(s.store).
+		WithQueue(s.estimationQueue).
+		WithBenchmarks(s.store.Benchmark()).
+		WithTroubleshootingModels(s.store.TroubleshootingModel()).
+		WithContingencyPolicies(s.store.ContingencyPolicy()).
+		WithCalculatorDefaults(s.store.CalculatorDefaults()).
+		WithEstimationRuns(s.store.EstimationRun()).
+		WithEstimationFreezes(s.store.EstimationFreeze()).
+		WithGuardrailPolicies(s.store.GuardrailPolicy()).
+		WithRateProvider(rateProvider)
+
 	h := handlers.NewServiceHandler(
 		service.NewSourceService(s.store, s.opaValidator),
 		service.NewAssessmentService(s.store, s.opaValidator),
 		service.NewJobService(s.store, s.jobsClient.RiverClient),
 		service.NewSizerService(sizerClient, s.store),
-		service.NewEstimationService(s.store),
-	)
+		estimationService,
+		planService,
+		service.NewRateCardService(s.store),
+	).WithDebugService(
+		service.NewDebugService(s.store, s.cfg, log.Recent()).
+			WithCalculators(estimationService.Calculators()),
+	).WithWebhookService(webhookService)
 	server.HandlerFromMux(server.NewStrictHandler(h, nil), router)
 	srv := http.Server{Addr: s.cfg.Service.Address, Handler: router}
 
@@ -200,3 +371,32 @@ func (s *Server) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// newExportSigner loads the key and certificate signing the exports, none when no key is set.
+func newExportSigner(cfg config.Signing) (*signing.Signer, error) {
+	if cfg.KeyFile == "" {
+		return nil, nil
+	}
+	key, err := os.ReadFile(cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := os.ReadFile(cfg.CertFile)
+	if err != nil {
+		return nil, err
+	}
+	return signing.NewSigner(key, cert)
+}
+
+// NewRateProvider creates the client of the exchange rate service, or nil when no URL is configured.
+func NewRateProvider(cfg config.ExchangeRates) cost.RateProvider {
+	if cfg.URL == "" {
+		return nil
+	}
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil {
+		zap.S().Named("api_server").Warnf("Invalid exchange rates timeout, using default 10s: %v", err)
+		timeout = 10 * time.Second
+	}
+	return client.NewExchangeRateClient(cfg.URL, timeout)
+}
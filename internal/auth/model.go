@@ -22,12 +22,11 @@ func UserFromContext(ctx context.Context) (User, bool) {
 	return val.(User), true
 }
 
+// AgentFromContext returns the JWT of the agent authenticated by the request. It is false when the
+// request is not authenticated as an agent, e.g. without authentication.
 func AgentFromContext(ctx context.Context) (AgentJWT, bool) {
-	val := ctx.Value(tokenKey)
-	if val == nil {
-		return AgentJWT{}, false
-	}
-	return val.(AgentJWT), true
+	agent, ok := ctx.Value(tokenKey).(AgentJWT)
+	return agent, ok
 }
 
 func MustHaveUser(ctx context.Context) User {
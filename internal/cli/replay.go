@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/replay"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type ReplayOptions struct {
+	GlobalOptions
+	Speedup float64
+	Timeout time.Duration
+}
+
+func DefaultReplayOptions() *ReplayOptions {
+	return &ReplayOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Speedup:       1,
+		Timeout:       time.Minute,
+	}
+}
+
+func NewCmdReplay() *cobra.Command {
+	o := DefaultReplayOptions()
+	cmd := &cobra.Command{
+		Use:   "replay CAPTURE_FILE|RECORDING",
+		Short: "Replay captured API traffic against a Planner instance, or a recorded estimation",
+		Long: "Replay the requests captured by a Planner instance run with MIGRATION_PLANNER_TRAFFIC_CAPTURE_FILE " +
+			"against another instance, e.g. a staging one, keeping their relative timing divided by the speed-up, " +
+			"and compare the latencies with the capture.\n\n" +
+			"Given the recording of an estimation instead, requested with \"record\": true, run the estimation again " +
+			"with the calculators of this build and list how the result differs from the recorded one.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *ReplayOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.Float64Var(&o.Speedup, "speedup", o.Speedup, "Factor dividing the time between the requests. 0 sends them back to back.")
+	fs.DurationVar(&o.Timeout, "request-timeout", o.Timeout, "Timeout of each request")
+}
+
+func (o *ReplayOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if o.Speedup < 0 {
+		return fmt.Errorf("speedup must not be negative")
+	}
+	return nil
+}
+
+func (o *ReplayOptions) Run(ctx context.Context, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open capture file: %w", err)
+	}
+	if rec, err := service.ReadEstimationRecording(data); err == nil {
+		return o.replayEstimation(ctx, rec)
+	}
+	records, err := replay.Read(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	httpClient := &http.Client{Timeout: o.Timeout}
+	if o.ProxyUrl != "" {
+		if err := o.WithProxy(httpClient); err != nil {
+			return err
+		}
+	}
+	replayer := replay.NewReplayer(o.ServerUrl, httpClient, o.Speedup).
+		WithRequestEditor(func(req *http.Request) {
+			if o.Token != "" {
+				req.Header.Set("X-Authorization", fmt.Sprintf("Bearer %s", o.Token))
+			}
+		})
+
+	fmt.Printf("Replaying %d requests against %s (speed-up %g)\n", len(records), o.ServerUrl, o.Speedup)
+	report, results := replayer.Run(ctx, records)
+	for _, res := range results {
+		if res.Err != nil {
+			fmt.Printf("  %s %s: %v\n", res.Record.Method, res.Record.Path, res.Err)
+		}
+	}
+
+	fmt.Printf("Sent: %d, skipped: %d, failed: %d, status mismatches: %d\n", report.Sent, report.Skipped, report.Failed, report.Mismatched)
+	fmt.Printf("  %-9s %12s %12s %12s %12s\n", "", "p50", "p95", "p99", "max")
+	for _, row := range []struct {
+		name string
+		l    replay.Latencies
+	}{{"captured", report.Recorded}, {"replayed", report.Replayed}} {
+		fmt.Printf("  %-9s %12s %12s %12s %12s\n", row.name, row.l.P50, row.l.P95, row.l.P99, row.l.Max)
+	}
+	return nil
+}
+
+// replayEstimation runs a recorded estimation again, locally: it depends on nothing but the recording.
+func (o *ReplayOptions) replayEstimation(ctx context.Context, rec service.EstimationRecording) error {
+	res, err := service.NewEstimationService(nil).Replay(ctx, rec)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Replayed the estimation of cluster %s of assessment %s recorded at %s\n", rec.ClusterID, rec.AssessmentID, rec.RecordedAt.Format(time.RFC3339))
+	fmt.Printf("Total: recorded %s, replayed %s\n", rec.TotalDuration, res.Result.TotalDuration)
+	if len(res.Differences) == 0 {
+		fmt.Println("The replay reproduces the recording.")
+		return nil
+	}
+	fmt.Printf("%d differences:\n", len(res.Differences))
+	for _, d := range res.Differences {
+		fmt.Printf("  %s\n", d)
+	}
+	return nil
+}
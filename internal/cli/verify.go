@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/signing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type VerifyOptions struct {
+	// CAFile is the PEM bundle of the certificates trusted to issue the signing certificates.
+	CAFile string
+	// Signer pins the signing certificate by its SHA-256 fingerprint. CAFile, Signer or both must be set.
+	Signer string
+}
+
+func DefaultVerifyOptions() *VerifyOptions {
+	return &VerifyOptions{}
+}
+
+func NewCmdVerify() *cobra.Command {
+	o := DefaultVerifyOptions()
+	cmd := &cobra.Command{
+		Use:   "verify EXPORT SIGNATURE",
+		Short: "Verify the signature of a plan export",
+		Long: "Verify an export of a plan was not modified since the Planner signed it. SIGNATURE is the file holding " +
+			"the X-Planner-Signature header returned with the export. The signing certificate must chain up to a " +
+			"certificate of --ca, be the one pinned with --signer, or both.",
+		Example: "verify plan.xml plan.xml.sig --ca planner-ca.pem\n" +
+			"verify plan.xml plan.xml.sig --signer sha256:3f1c...",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.Run(args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *VerifyOptions) Bind(fs *pflag.FlagSet) {
+	fs.StringVar(&o.CAFile, "ca", o.CAFile, "PEM file of the certificates trusted to issue the signing certificates.")
+	fs.StringVar(&o.Signer, "signer", o.Signer, "SHA-256 fingerprint of the signing certificate, sha256:<hex>.")
+}
+
+func (o *VerifyOptions) Run(args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read export: %w", err)
+	}
+	encoded, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to read signature: %w", err)
+	}
+	if o.CAFile == "" && o.Signer == "" {
+		return fmt.Errorf("--ca or --signer is required to trust the signer")
+	}
+	sig, err := signing.Decode(string(encoded))
+	if err != nil {
+		return err
+	}
+
+	opts := signing.VerifyOptions{Fingerprint: o.Signer}
+	if o.CAFile != "" {
+		bundle, err := os.ReadFile(o.CAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read CA file: %w", err)
+		}
+		opts.Roots = x509.NewCertPool()
+		if !opts.Roots.AppendCertsFromPEM(bundle) {
+			return fmt.Errorf("no certificate found in %s", o.CAFile)
+		}
+	}
+
+	signer, err := signing.Verify(data, sig, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Verified: %s was signed by %s at %s\n", args[0], signer.Subject, sig.SignedAt.Format(time.RFC3339))
+	fmt.Printf("Digest: %s\n", sig.Digest)
+	fmt.Printf("Signer: %s\n", signing.Fingerprint(signer))
+	return nil
+}
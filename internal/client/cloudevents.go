@@ -0,0 +1,172 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/tracing"
+)
+
+// CloudEventsTransport is how a CloudEventsClient delivers the events.
+type CloudEventsTransport string
+
+const (
+	// CloudEventsHTTP posts each event to an HTTP CloudEvents sink in structured content mode.
+	CloudEventsHTTP CloudEventsTransport = "http"
+	// CloudEventsKafka produces each event to a Kafka topic through a Kafka REST proxy (v2 API),
+	// keyed by aggregate so the events of a plan stay ordered within a partition.
+	CloudEventsKafka CloudEventsTransport = "kafka"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsTypePrefix  = "io.kubev2v.migration-planner."
+
+	DefaultCloudEventsSource = "/migration-planner"
+	DefaultCloudEventsTopic  = "migration-planner.events"
+)
+
+// CloudEvent is a domain event in the CloudEvents 1.0 JSON format.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+	// Extension attributes
+	Sequence     string `json:"sequence"`
+	PartitionKey string `json:"partitionkey"`
+	OrgID        string `json:"orgid"`
+}
+
+// CloudEventsClient publishes domain events as CloudEvents, so integration platforms can react to
+// the milestones of the migrations without polling. A client without URL drops them.
+type CloudEventsClient struct {
+	transport  CloudEventsTransport
+	sinkURL    string
+	topic      string
+	source     string
+	httpClient *http.Client
+}
+
+// NewCloudEventsClient creates a CloudEventsClient for the transport. The topic is only used by
+// the Kafka transport; empty topic and source get their defaults.
+func NewCloudEventsClient(transport CloudEventsTransport, sinkURL, topic, source string, timeout time.Duration) (*CloudEventsClient, error) {
+	switch transport {
+	case CloudEventsHTTP, CloudEventsKafka:
+	case "":
+		sinkURL = ""
+	default:
+		return nil, fmt.Errorf("unknown CloudEvents transport %q", transport)
+	}
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	if topic == "" {
+		topic = DefaultCloudEventsTopic
+	}
+	if source == "" {
+		source = DefaultCloudEventsSource
+	}
+	return &CloudEventsClient{
+		transport: transport,
+		sinkURL:   sinkURL,
+		topic:     topic,
+		source:    source,
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: tracing.Transport(nil),
+		},
+	}, nil
+}
+
+// ToCloudEvent converts a domain event to a CloudEvent of the source.
+func ToCloudEvent(source string, event Event) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              strconv.FormatInt(event.Seq, 10),
+		Source:          source,
+		Type:            cloudEventsTypePrefix + event.Type,
+		Subject:         event.AggregateType + "/" + event.AggregateID,
+		Time:            event.OccurredAt,
+		DataContentType: "application/json",
+		Data:            event.Payload,
+		Sequence:        strconv.FormatInt(event.Seq, 10),
+		PartitionKey:    event.AggregateID,
+		OrgID:           event.OrgID,
+	}
+}
+
+// Publish delivers a domain event to the sink.
+func (c *CloudEventsClient) Publish(ctx context.Context, event Event) error {
+	if c.sinkURL == "" {
+		return nil
+	}
+
+	ce := ToCloudEvent(c.source, event)
+	if c.transport == CloudEventsKafka {
+		return c.produce(ctx, ce)
+	}
+
+	body, err := json.Marshal(ce)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cloud event: %w", err)
+	}
+	return c.post(ctx, c.sinkURL, "application/cloudevents+json", body)
+}
+
+type kafkaRecord struct {
+	Key   string     `json:"key"`
+	Value CloudEvent `json:"value"`
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// produce sends the event to the topic through the REST proxy.
+func (c *CloudEventsClient) produce(ctx context.Context, ce CloudEvent) error {
+	body, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: ce.PartitionKey, Value: ce}}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal kafka records: %w", err)
+	}
+	endpoint, err := url.JoinPath(c.sinkURL, "topics", c.topic)
+	if err != nil {
+		return fmt.Errorf("invalid kafka rest proxy url: %w", err)
+	}
+	return c.post(ctx, endpoint, "application/vnd.kafka.json.v2+json", body)
+}
+
+func (c *CloudEventsClient) post(ctx context.Context, endpoint, contentType string, body []byte) error {
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", contentType)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call cloud events sink: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		bodyBytes, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return fmt.Errorf("cloud events sink returned status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
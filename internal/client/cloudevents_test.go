@@ -0,0 +1,96 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cloud events client", func() {
+	event := client.Event{
+		Seq:           42,
+		Type:          "WaveCompleted",
+		OrgID:         "acme",
+		AggregateType: "plan",
+		AggregateID:   "b7f3c0e2",
+		Payload:       json.RawMessage(`{"wave":"wave-1"}`),
+		OccurredAt:    time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+	}
+
+	It("posts the event to an HTTP sink in structured mode", func() {
+		var received client.CloudEvent
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/cloudevents+json"))
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer srv.Close()
+
+		c, err := client.NewCloudEventsClient(client.CloudEventsHTTP, srv.URL, "", "", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Publish(context.Background(), event)).To(Succeed())
+
+		Expect(received.SpecVersion).To(Equal("1.0"))
+		Expect(received.ID).To(Equal("42"))
+		Expect(received.Source).To(Equal(client.DefaultCloudEventsSource))
+		Expect(received.Type).To(Equal("io.kubev2v.migration-planner.WaveCompleted"))
+		Expect(received.Subject).To(Equal("plan/b7f3c0e2"))
+		Expect(received.OrgID).To(Equal("acme"))
+		Expect(string(received.Data)).To(MatchJSON(`{"wave":"wave-1"}`))
+	})
+
+	It("produces the event to a Kafka topic keyed by aggregate", func() {
+		var received struct {
+			Records []struct {
+				Key   string            `json:"key"`
+				Value client.CloudEvent `json:"value"`
+			} `json:"records"`
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/topics/migrations"))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/vnd.kafka.json.v2+json"))
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		c, err := client.NewCloudEventsClient(client.CloudEventsKafka, srv.URL, "migrations", "/planner/eu", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Publish(context.Background(), event)).To(Succeed())
+
+		Expect(received.Records).To(HaveLen(1))
+		Expect(received.Records[0].Key).To(Equal("b7f3c0e2"))
+		Expect(received.Records[0].Value.Source).To(Equal("/planner/eu"))
+		Expect(received.Records[0].Value.Sequence).To(Equal("42"))
+	})
+
+	It("returns an error when the sink fails", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		c, err := client.NewCloudEventsClient(client.CloudEventsHTTP, srv.URL, "", "", 0)
+		Expect(err).NotTo(HaveOccurred())
+		err = c.Publish(context.Background(), event)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("503"))
+	})
+
+	It("drops events without transport", func() {
+		c, err := client.NewCloudEventsClient("", "http://unused", "", "", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Publish(context.Background(), event)).To(Succeed())
+	})
+
+	It("rejects an unknown transport", func() {
+		_, err := client.NewCloudEventsClient("amqp", "http://broker", "", "", 0)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/tracing"
+)
+
+// ExchangeRateClient fetches the latest exchange rates from a service with the API of Frankfurter,
+// serving the reference rates of the European Central Bank.
+type ExchangeRateClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// Make sure we conform to the RateProvider interface
+var _ cost.RateProvider = (*ExchangeRateClient)(nil)
+
+func NewExchangeRateClient(baseURL string, timeout time.Duration) *ExchangeRateClient {
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	return &ExchangeRateClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: tracing.Transport(nil),
+		},
+	}
+}
+
+// latestRates is the response of GET /latest.
+type latestRates struct {
+	Base string `json:"base"`
+	// Date is the day the rates were published, e.g. "2026-03-02".
+	Date  string             `json:"date"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+// Rate returns the latest rate converting from into to, as of the day it was published.
+func (c *ExchangeRateClient) Rate(ctx context.Context, from, to string) (cost.ExchangeRate, error) {
+	query := url.Values{"base": {from}, "symbols": {to}}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/latest?"+query.Encode(), nil)
+	if err != nil {
+		return cost.ExchangeRate{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return cost.ExchangeRate{}, fmt.Errorf("failed to call exchange rate service: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return cost.ExchangeRate{}, fmt.Errorf("exchange rate service returned status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var latest latestRates
+	if err := json.NewDecoder(resp.Body).Decode(&latest); err != nil {
+		return cost.ExchangeRate{}, fmt.Errorf("failed to decode exchange rates: %w", err)
+	}
+	rate, ok := latest.Rates[to]
+	if !ok {
+		return cost.ExchangeRate{}, fmt.Errorf("exchange rate service has no rate from %s to %s", from, to)
+	}
+	asOf, err := time.Parse(time.DateOnly, latest.Date)
+	if err != nil {
+		return cost.ExchangeRate{}, fmt.Errorf("invalid exchange rate date %q: %w", latest.Date, err)
+	}
+	return cost.ExchangeRate{From: from, To: to, Rate: rate, AsOf: asOf, Source: cost.RateSourceAPI}, nil
+}
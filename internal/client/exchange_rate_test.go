@@ -0,0 +1,56 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("exchange rate client", func() {
+	It("fetches the latest rate as of its publication day", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/latest"))
+			Expect(r.URL.Query().Get("base")).To(Equal("EUR"))
+			Expect(r.URL.Query().Get("symbols")).To(Equal("USD"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"amount":1.0,"base":"EUR","date":"2026-03-02","rates":{"USD":1.0846}}`))
+		}))
+		defer srv.Close()
+
+		rate, err := client.NewExchangeRateClient(srv.URL+"/", 0).Rate(context.Background(), "EUR", "USD")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rate).To(Equal(cost.ExchangeRate{
+			From:   "EUR",
+			To:     "USD",
+			Rate:   1.0846,
+			AsOf:   time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			Source: cost.RateSourceAPI,
+		}))
+	})
+
+	It("returns an error when the service has no rate for the pair", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"base":"EUR","date":"2026-03-02","rates":{}}`))
+		}))
+		defer srv.Close()
+
+		_, err := client.NewExchangeRateClient(srv.URL, 0).Rate(context.Background(), "EUR", "XAF")
+		Expect(err).To(MatchError(ContainSubstring("no rate from EUR to XAF")))
+	})
+
+	It("returns an error when the service fails", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		_, err := client.NewExchangeRateClient(srv.URL, 0).Rate(context.Background(), "EUR", "USD")
+		Expect(err).To(MatchError(ContainSubstring("404")))
+	})
+})
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/tracing"
+)
+
+// NotificationClient posts alerts or domain events as JSON to a webhook, such as a Slack or Teams
+// incoming webhook relay, an alert manager or an analytics pipeline. A client without URL drops them,
+// unless they are posted to a URL of their own, e.g. the alert webhook of an organization.
+type NotificationClient struct {
+	webhookURL string
+	httpClient *http.Client
+}
+
+func NewNotificationClient(webhookURL string, timeout time.Duration) *NotificationClient {
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	return &NotificationClient{
+		webhookURL: webhookURL,
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: tracing.Transport(nil),
+		},
+	}
+}
+
+type Alert struct {
+	// Source is the kind of resource raising the alert, e.g. "plan".
+	Source   string    `json:"source"`
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Subject  string    `json:"subject"`
+	Message  string    `json:"message"`
+	RaisedAt time.Time `json:"raisedAt"`
+}
+
+// Event is a domain event, e.g. a plan created or the actuals of a wave recorded, as published to
+// downstream systems.
+type Event struct {
+	Seq           int64           `json:"seq"`
+	Type          string          `json:"type"`
+	OrgID         string          `json:"orgId"`
+	Username      string          `json:"username"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+}
+
+func (c *NotificationClient) Notify(ctx context.Context, alert Alert) error {
+	return c.post(ctx, c.webhookURL, "alert", alert)
+}
+
+// NotifyTo posts an alert to the webhook URL in place of the webhook of the client.
+func (c *NotificationClient) NotifyTo(ctx context.Context, webhookURL string, alert Alert) error {
+	return c.post(ctx, webhookURL, "alert", alert)
+}
+
+// Publish posts a domain event to the webhook.
+func (c *NotificationClient) Publish(ctx context.Context, event Event) error {
+	return c.post(ctx, c.webhookURL, "event", event)
+}
+
+func (c *NotificationClient) post(ctx context.Context, webhookURL, kind string, v any) error {
+	if webhookURL == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", kind, err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to call notification webhook: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		bodyBytes, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return fmt.Errorf("notification webhook returned status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
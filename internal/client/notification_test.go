@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("notification client", func() {
+	It("posts the alert to the webhook", func() {
+		var received client.Alert
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		c := client.NewNotificationClient(srv.URL, 0)
+		err := c.Notify(context.Background(), client.Alert{Source: "plan", Subject: "rollback-rate", Message: "breached"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received.Subject).To(Equal("rollback-rate"))
+	})
+
+	It("returns an error when the webhook fails", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		err := client.NewNotificationClient(srv.URL, 0).Notify(context.Background(), client.Alert{})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("502"))
+	})
+
+	It("publishes domain events to the webhook", func() {
+		var received client.Event
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer srv.Close()
+
+		event := client.Event{Seq: 7, Type: "WaveCompleted", AggregateType: "plan", Payload: json.RawMessage(`{"wave":"wave-1"}`)}
+		Expect(client.NewNotificationClient(srv.URL, 0).Publish(context.Background(), event)).To(Succeed())
+		Expect(received.Seq).To(Equal(int64(7)))
+		Expect(string(received.Payload)).To(MatchJSON(`{"wave":"wave-1"}`))
+	})
+
+	It("posts the alert to the webhook given in place of its own", func() {
+		var received client.Alert
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		err := client.NewNotificationClient("", 0).NotifyTo(context.Background(), srv.URL, client.Alert{Subject: "velocity"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received.Subject).To(Equal("velocity"))
+	})
+
+	It("drops alerts without webhook", func() {
+		Expect(client.NewNotificationClient("", 0).Notify(context.Background(), client.Alert{})).To(Succeed())
+	})
+})
@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/tracing"
 )
 
 // SizerClient is an HTTP client for the sizer service
@@ -23,7 +25,8 @@ func NewSizerClient(baseURL string, timeout time.Duration) *SizerClient {
 	return &SizerClient{
 		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: tracing.Transport(nil),
 		},
 	}
 }
@@ -1,9 +1,16 @@
 package config
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
+// redacted replaces the secrets of a redacted configuration.
+const redacted = "REDACTED"
+
 var singleConfig *Config = nil
 
 type Config struct {
@@ -33,6 +40,20 @@ type svcConfig struct {
 	OpaPoliciesFolder    string `envconfig:"MIGRATION_PLANNER_OPA_POLICIES_FOLDER" default:"/app/policies"`
 	IsoPath              string `envconfig:"MIGRATION_PLANNER_ISO_PATH" default:"rhcos-live-iso.x86_64.iso"`
 	Sizer                Sizer
+	Notification         Notification
+	ExchangeRates        ExchangeRates
+	Estimation           Estimation
+	Quota                Quota
+	RateLimit            RateLimit
+	CloudEvents          CloudEvents
+	Admin                Admin
+	Warehouse            Warehouse
+	Signing              Signing
+	Tracing              Tracing
+	AccessLog            AccessLog
+	// TrafficCaptureFile is the file the anonymized API requests are appended to, to replay them in
+	// soak tests. Empty disables the capture.
+	TrafficCaptureFile string `envconfig:"MIGRATION_PLANNER_TRAFFIC_CAPTURE_FILE" default:""`
 }
 
 type Auth struct {
@@ -40,6 +61,8 @@ type Auth struct {
 	JwkCertURL                 string `envconfig:"MIGRATION_PLANNER_JWK_URL" default:""`
 	LocalPrivateKey            string `envconfig:"MIGRATION_PLANNER_PRIVATE_KEY" default:""`
 	AgentAuthenticationEnabled bool   `envconfig:"MIGRATION_PLANNER_AGENT_AUTH_ENABLED" default:"true"`
+	// Admins are the usernames allowed to use the administration endpoints, e.g. the debug bundle.
+	Admins []string `envconfig:"MIGRATION_PLANNER_ADMINS" default:""`
 }
 
 type Sizer struct {
@@ -47,6 +70,118 @@ type Sizer struct {
 	Timeout    string `envconfig:"SIZER_SERVICE_TIMEOUT" default:"60s"`
 }
 
+type Notification struct {
+	EventWebhookURL string `envconfig:"MIGRATION_PLANNER_EVENT_WEBHOOK_URL" default:""`
+	Timeout         string `envconfig:"MIGRATION_PLANNER_NOTIFICATION_TIMEOUT" default:"10s"`
+	// DisableThreshold is the number of deliveries an event webhook fails in a row before it is
+	// disabled until redriven.
+	DisableThreshold int `envconfig:"MIGRATION_PLANNER_WEBHOOK_DISABLE_THRESHOLD" default:"10"`
+}
+
+// ExchangeRates is the exchange rate service converting the plan costs into the plan currency when
+// the plan has no rate of its own, an API serving the ECB reference rates such as Frankfurter. An
+// empty URL disables it.
+type ExchangeRates struct {
+	URL     string `envconfig:"MIGRATION_PLANNER_EXCHANGE_RATES_URL" default:""`
+	Timeout string `envconfig:"MIGRATION_PLANNER_EXCHANGE_RATES_TIMEOUT" default:"10s"`
+}
+
+type Estimation struct {
+	InteractiveWorkers int `envconfig:"MIGRATION_PLANNER_ESTIMATION_INTERACTIVE_WORKERS" default:"8"`
+	BatchWorkers       int `envconfig:"MIGRATION_PLANNER_ESTIMATION_BATCH_WORKERS" default:"2"`
+}
+
+// Quota caps the concurrent expensive operations per organization. Zero disables a cap.
+type Quota struct {
+	Policy       string `envconfig:"MIGRATION_PLANNER_QUOTA_POLICY" default:"reject"`
+	QueueTimeout string `envconfig:"MIGRATION_PLANNER_QUOTA_QUEUE_TIMEOUT" default:"30s"`
+	Discoveries  int    `envconfig:"MIGRATION_PLANNER_QUOTA_DISCOVERIES" default:"2"`
+	Reports      int    `envconfig:"MIGRATION_PLANNER_QUOTA_REPORTS" default:"4"`
+	Simulations  int    `envconfig:"MIGRATION_PLANNER_QUOTA_SIMULATIONS" default:"4"`
+}
+
+// RateLimit limits the request rate of each client, its authenticated user or else its IP address,
+// with a token bucket per route: Rate requests per second on average, in bursts of Burst. The
+// estimation routes have their own limit, the rest of the API shares one. A zero rate disables a limit.
+// The IP address is the one of the connection, or the one forwarded by the trusted proxies, given as
+// CIDRs or addresses.
+type RateLimit struct {
+	EstimationRate  float64  `envconfig:"MIGRATION_PLANNER_RATE_LIMIT_ESTIMATION_RATE" default:"0.5"`
+	EstimationBurst int      `envconfig:"MIGRATION_PLANNER_RATE_LIMIT_ESTIMATION_BURST" default:"5"`
+	APIRate         float64  `envconfig:"MIGRATION_PLANNER_RATE_LIMIT_API_RATE" default:"20"`
+	APIBurst        int      `envconfig:"MIGRATION_PLANNER_RATE_LIMIT_API_BURST" default:"40"`
+	TrustedProxies  []string `envconfig:"MIGRATION_PLANNER_RATE_LIMIT_TRUSTED_PROXIES" default:""`
+}
+
+// CloudEvents publishes the domain events as CloudEvents to an HTTP sink or, through a Kafka REST
+// proxy, to a Kafka topic. An empty transport disables it.
+type CloudEvents struct {
+	Transport string `envconfig:"MIGRATION_PLANNER_CLOUDEVENTS_TRANSPORT" default:""`
+	URL       string `envconfig:"MIGRATION_PLANNER_CLOUDEVENTS_URL" default:""`
+	Topic     string `envconfig:"MIGRATION_PLANNER_CLOUDEVENTS_TOPIC" default:"migration-planner.events"`
+	Source    string `envconfig:"MIGRATION_PLANNER_CLOUDEVENTS_SOURCE" default:"/migration-planner"`
+}
+
+// Admin serves the runtime diagnostics (pprof, expvar, goroutine and job queue dumps) on a separate
+// listener, bound to the loopback interface by default. An empty address disables it. When a token
+// is set, the requests must carry it as bearer token; it is required on other addresses than loopback.
+type Admin struct {
+	Address string `envconfig:"MIGRATION_PLANNER_ADMIN_ADDRESS" default:"localhost:6060"`
+	Token   string `envconfig:"MIGRATION_PLANNER_ADMIN_TOKEN" default:""`
+}
+
+// Validate checks the diagnostics are not served without token beyond the loopback interface.
+func (a Admin) Validate() error {
+	if a.Address == "" || a.Token != "" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(a.Address)
+	if err != nil {
+		return fmt.Errorf("invalid admin address %q: %w", a.Address, err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return fmt.Errorf("admin address %q is not a loopback address: set MIGRATION_PLANNER_ADMIN_TOKEN to serve the diagnostics on it", a.Address)
+	}
+	return nil
+}
+
+// Warehouse exports the plans, inventory summaries, progress and actuals as CSV or Parquet tables to
+// an S3 bucket every interval. An empty bucket disables it. Empty keys fall back to the credentials
+// of the environment.
+type Warehouse struct {
+	Endpoint  string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_ENDPOINT" default:"s3.amazonaws.com"`
+	Region    string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_REGION" default:""`
+	Bucket    string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_BUCKET" default:""`
+	Prefix    string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_PREFIX" default:"migration-planner"`
+	AccessKey string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_ACCESS_KEY" default:""`
+	SecretKey string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_SECRET_KEY" default:""`
+	UseSSL    bool   `envconfig:"MIGRATION_PLANNER_WAREHOUSE_USE_SSL" default:"true"`
+	Format    string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_FORMAT" default:"parquet"`
+	Interval  string `envconfig:"MIGRATION_PLANNER_WAREHOUSE_INTERVAL" default:"24h"`
+}
+
+// Signing signs the plan exports with the PEM encoded private key and the certificate chain of the
+// key, for the exports to be verified with `planner verify`. An empty key file disables it.
+type Signing struct {
+	KeyFile  string `envconfig:"MIGRATION_PLANNER_SIGNING_KEY_FILE" default:""`
+	CertFile string `envconfig:"MIGRATION_PLANNER_SIGNING_CERT_FILE" default:""`
+}
+
+// Tracing exports the spans of the requests and the estimation runs to the OTLP HTTP endpoint, e.g.
+// http://otel-collector:4318. An empty endpoint disables the export, the trace context of the
+// callers is still propagated. SampleRatio is the ratio of the traces started by the planner sampled.
+type Tracing struct {
+	Endpoint    string  `envconfig:"MIGRATION_PLANNER_TRACING_ENDPOINT" default:""`
+	ServiceName string  `envconfig:"MIGRATION_PLANNER_TRACING_SERVICE_NAME" default:"migration-planner"`
+	SampleRatio float64 `envconfig:"MIGRATION_PLANNER_TRACING_SAMPLE_RATIO" default:"1"`
+}
+
+// AccessLog logs one record per API request. The health checks are logged one in HealthSampling, 1
+// logs them all; the failed ones are always logged.
+type AccessLog struct {
+	HealthSampling int `envconfig:"MIGRATION_PLANNER_ACCESS_LOG_HEALTH_SAMPLING" default:"100"`
+}
+
 func New() (*Config, error) {
 	if singleConfig == nil {
 		singleConfig = new(Config)
@@ -56,3 +191,53 @@ func New() (*Config, error) {
 	}
 	return singleConfig, nil
 }
+
+// Redacted returns a copy of the configuration without its secrets, safe to share in a support bundle.
+func (c *Config) Redacted() Config {
+	res := Config{}
+	if c.Database != nil {
+		db := *c.Database
+		if db.Password != "" {
+			db.Password = redacted
+		}
+		res.Database = &db
+	}
+	if c.Service != nil {
+		svc := *c.Service
+		if svc.Auth.LocalPrivateKey != "" {
+			svc.Auth.LocalPrivateKey = redacted
+		}
+		if svc.Admin.Token != "" {
+			svc.Admin.Token = redacted
+		}
+		if svc.Warehouse.SecretKey != "" {
+			svc.Warehouse.SecretKey = redacted
+		}
+		svc.Auth.Admins = append([]string{}, svc.Auth.Admins...)
+		svc.RateLimit.TrustedProxies = append([]string{}, svc.RateLimit.TrustedProxies...)
+		svc.Notification.EventWebhookURL = redactURL(svc.Notification.EventWebhookURL)
+		svc.ExchangeRates.URL = redactURL(svc.ExchangeRates.URL)
+		svc.CloudEvents.URL = redactURL(svc.CloudEvents.URL)
+		svc.Tracing.Endpoint = redactURL(svc.Tracing.Endpoint)
+		res.Service = &svc
+	}
+	return res
+}
+
+// redactURL removes the credentials and the query of a URL, both may carry tokens.
+func redactURL(raw string) string {
+	if raw == "" {
+		return raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redacted
+	}
+	if u.User != nil {
+		u.User = url.User(redacted)
+	}
+	if u.RawQuery != "" {
+		u.RawQuery = redacted
+	}
+	return u.String()
+}
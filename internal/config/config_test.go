@@ -0,0 +1,37 @@
+package config
+
+import "testing"
+
+func TestAdmin_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		admin   Admin
+		wantErr bool
+	}{
+		{name: "disabled", admin: Admin{}},
+		{name: "localhost without token", admin: Admin{Address: "localhost:6060"}},
+		{name: "IPv4 loopback without token", admin: Admin{Address: "127.0.0.1:6060"}},
+		{name: "IPv6 loopback without token", admin: Admin{Address: "[::1]:6060"}},
+		{name: "all interfaces without token", admin: Admin{Address: "0.0.0.0:6060"}, wantErr: true},
+		{name: "empty host without token", admin: Admin{Address: ":6060"}, wantErr: true},
+		{name: "hostname without token", admin: Admin{Address: "planner.example.com:6060"}, wantErr: true},
+		{name: "all interfaces with token", admin: Admin{Address: "0.0.0.0:6060", Token: "s3cr3t"}},
+		{name: "address without port", admin: Admin{Address: "localhost"}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.admin.Validate()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error for %q", tc.admin.Address)
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error for %q, got: %v", tc.admin.Address, err)
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	v1alpha1 "github.com/kubev2v/migration-planner/api/v1alpha1/agent"
 	agentServer "github.com/kubev2v/migration-planner/internal/api/server/agent"
+	"github.com/kubev2v/migration-planner/internal/auth"
 	apiMappers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
 	"github.com/kubev2v/migration-planner/internal/handlers/validator"
 	"github.com/kubev2v/migration-planner/internal/service"
@@ -96,3 +98,45 @@ func (h *AgentHandler) UpdateAgentStatus(ctx context.Context, request agentServe
 	}
 	return agentServer.UpdateAgentStatus200Response{}, nil
 }
+
+// GetChangeRateJob returns the change rate sampling job of the source
+func (h *AgentHandler) GetChangeRateJob(ctx context.Context, request agentServer.GetChangeRateJobRequestObject) (agentServer.GetChangeRateJobResponseObject, error) {
+	if agent, ok := auth.AgentFromContext(ctx); ok && agent.SourceID != request.Id.String() {
+		return agentServer.GetChangeRateJob403JSONResponse{Message: fmt.Sprintf("agent of source %s is not allowed to read the change rate job of source %s", agent.SourceID, request.Id)}, nil
+	}
+
+	job, err := h.srv.GetChangeRateJob(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return agentServer.GetChangeRateJob404JSONResponse{Message: err.Error()}, nil
+		default:
+			return agentServer.GetChangeRateJob500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
+	return agentServer.GetChangeRateJob200JSONResponse(apiMappers.ChangeRateJobToApi(request.Id, job, time.Now())), nil
+}
+
+// UploadChangeRateSamples records the CBT samples taken by the agent for the change rate sampling job
+func (h *AgentHandler) UploadChangeRateSamples(ctx context.Context, request agentServer.UploadChangeRateSamplesRequestObject) (agentServer.UploadChangeRateSamplesResponseObject, error) {
+	if request.Body == nil {
+		return agentServer.UploadChangeRateSamples400JSONResponse{Message: "empty body"}, nil
+	}
+
+	job, err := h.srv.RecordChangeRateSamples(ctx, request.Id, request.Body.AgentId, apiMappers.ChangeRateSamplesFromApi(request.Body.Samples))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			return agentServer.UploadChangeRateSamples400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrAgentUpdateForbidden:
+			return agentServer.UploadChangeRateSamples403JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			return agentServer.UploadChangeRateSamples404JSONResponse{Message: err.Error()}, nil
+		default:
+			return agentServer.UploadChangeRateSamples500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
+	return agentServer.UploadChangeRateSamples200JSONResponse(apiMappers.ChangeRateJobToApi(request.Id, job, time.Now())), nil
+}
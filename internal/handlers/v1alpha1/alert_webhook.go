@@ -0,0 +1,114 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/alert-webhook)
+func (h *ServiceHandler) GetAlertWebhook(ctx context.Context, request server.GetAlertWebhookRequestObject) (server.GetAlertWebhookResponseObject, error) {
+	logger := log.NewDebugLogger("webhook_handler").
+		WithContext(ctx).
+		Operation("get_alert_webhook").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	// the URL of a webhook often carries its credentials
+	if !h.isWebhookAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.GetAlertWebhook403JSONResponse{Message: err.Error()}, nil
+	}
+
+	webhook, err := h.webhookSrv.AlertWebhook(ctx, user.Organization)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetAlertWebhook404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetAlertWebhook500JSONResponse{Message: fmt.Sprintf("failed to get alert webhook: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.GetAlertWebhook200JSONResponse(mappers.AlertWebhookToApi(*webhook)), nil
+}
+
+// (PUT /api/v1/alert-webhook)
+func (h *ServiceHandler) SetAlertWebhook(ctx context.Context, request server.SetAlertWebhookRequestObject) (server.SetAlertWebhookResponseObject, error) {
+	logger := log.NewDebugLogger("webhook_handler").
+		WithContext(ctx).
+		Operation("set_alert_webhook").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if !h.isWebhookAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.SetAlertWebhook403JSONResponse{Message: err.Error()}, nil
+	}
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SetAlertWebhook400JSONResponse{Message: "empty body"}, nil
+	}
+
+	webhook, err := h.webhookSrv.SetAlertWebhook(ctx, user.Organization, user.Username, request.Body.Url)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SetAlertWebhook400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetAlertWebhook500JSONResponse{Message: fmt.Sprintf("failed to set alert webhook: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.SetAlertWebhook200JSONResponse(mappers.AlertWebhookToApi(*webhook)), nil
+}
+
+// (DELETE /api/v1/alert-webhook)
+func (h *ServiceHandler) DeleteAlertWebhook(ctx context.Context, request server.DeleteAlertWebhookRequestObject) (server.DeleteAlertWebhookResponseObject, error) {
+	logger := log.NewDebugLogger("webhook_handler").
+		WithContext(ctx).
+		Operation("delete_alert_webhook").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if !h.isWebhookAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.DeleteAlertWebhook403JSONResponse{Message: err.Error()}, nil
+	}
+
+	webhook, err := h.webhookSrv.DeleteAlertWebhook(ctx, user.Organization)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.DeleteAlertWebhook404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.DeleteAlertWebhook500JSONResponse{Message: fmt.Sprintf("failed to delete alert webhook: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.DeleteAlertWebhook200JSONResponse(mappers.AlertWebhookToApi(*webhook)), nil
+}
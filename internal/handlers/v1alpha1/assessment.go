@@ -44,6 +44,16 @@ func (h *ServiceHandler) ListAssessments(ctx context.Context, request server.Lis
 	if err != nil {
 		return server.ListAssessments500JSONResponse{Message: fmt.Sprintf("failed to list assessments: %v", err)}, nil
 	}
+	raw, err := h.rawExportsAllowed(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListAssessments500JSONResponse{Message: fmt.Sprintf("failed to get export policy: %v", err)}, nil
+	}
+	if !raw {
+		for i := range apiAssessments {
+			withoutInventory(&apiAssessments[i])
+		}
+	}
 
 	return server.ListAssessments200JSONResponse(apiAssessments), nil
 }
@@ -154,6 +164,14 @@ func (h *ServiceHandler) GetAssessment(ctx context.Context, request server.GetAs
 	if err != nil {
 		return server.GetAssessment500JSONResponse{Message: fmt.Sprintf("failed to get assessment: %v", err)}, nil
 	}
+	raw, err := h.rawExportsAllowed(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetAssessment500JSONResponse{Message: fmt.Sprintf("failed to get export policy: %v", err)}, nil
+	}
+	if !raw {
+		withoutInventory(&apiAssessment)
+	}
 
 	return server.GetAssessment200JSONResponse(apiAssessment), nil
 }
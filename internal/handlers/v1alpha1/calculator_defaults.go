@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/calculator-defaults)
+func (h *ServiceHandler) GetCalculatorDefaults(ctx context.Context, request server.GetCalculatorDefaultsRequestObject) (server.GetCalculatorDefaultsResponseObject, error) {
+	logger := log.NewDebugLogger("calculator_defaults_handler").
+		WithContext(ctx).
+		Operation("get_calculator_defaults").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	constants, err := h.estimationSrv.GetCalculatorDefaults(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetCalculatorDefaults500JSONResponse{Message: fmt.Sprintf("failed to get calculator defaults: %v", err)}, nil
+	}
+
+	apiDefaults, err := mappers.CalculatorDefaultsToApi(*constants)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetCalculatorDefaults500JSONResponse{Message: fmt.Sprintf("failed to map calculator defaults: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetCalculatorDefaults200JSONResponse(apiDefaults), nil
+}
+
+// (PUT /api/v1/calculator-defaults)
+func (h *ServiceHandler) SetCalculatorDefaults(ctx context.Context, request server.SetCalculatorDefaultsRequestObject) (server.SetCalculatorDefaultsResponseObject, error) {
+	logger := log.NewDebugLogger("calculator_defaults_handler").
+		WithContext(ctx).
+		Operation("set_calculator_defaults").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	// the defaults shape every estimation and plan of the organization: only the administrators set them
+	if h.debugSrv == nil || !h.debugSrv.IsAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.SetCalculatorDefaults403JSONResponse{Message: err.Error()}, nil
+	}
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SetCalculatorDefaults400JSONResponse{Message: "empty body"}, nil
+	}
+
+	doc := mappers.CalculatorDefaultsFromApi(*request.Body)
+
+	constants, err := h.estimationSrv.SetCalculatorDefaults(ctx, user.Organization, user.Username, doc)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SetCalculatorDefaults400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetCalculatorDefaults500JSONResponse{Message: fmt.Sprintf("failed to set calculator defaults: %v", err)}, nil
+		}
+	}
+
+	apiDefaults, err := mappers.CalculatorDefaultsToApi(*constants)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetCalculatorDefaults500JSONResponse{Message: fmt.Sprintf("failed to map calculator defaults: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.SetCalculatorDefaults200JSONResponse(apiDefaults), nil
+}
@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/sources/{id}/change-rate-job)
+func (s *ServiceHandler) GetChangeRateJob(ctx context.Context, request server.GetChangeRateJobRequestObject) (server.GetChangeRateJobResponseObject, error) {
+	logger := log.NewDebugLogger("change_rate_handler").
+		WithContext(ctx).
+		Operation("get_change_rate_job").
+		WithUUID("source_id", request.Id).
+		Build()
+
+	source, err := s.sourceSrv.GetSource(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetChangeRateJob404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetChangeRateJob500JSONResponse{Message: fmt.Sprintf("failed to get source: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != source.Username || user.Organization != source.OrgID {
+		message := fmt.Sprintf("forbidden to access source %s by user with org_id %s", request.Id, user.Organization)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("org_id", user.Organization).Log()
+		return server.GetChangeRateJob403JSONResponse{Message: message}, nil
+	}
+
+	job, err := s.sourceSrv.GetChangeRateJob(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetChangeRateJob404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetChangeRateJob500JSONResponse{Message: fmt.Sprintf("failed to get change rate job: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("measured_vms", len(job.Measurements)).Log()
+	return server.GetChangeRateJob200JSONResponse(mappers.ChangeRateJobToApi(request.Id, job, time.Now())), nil
+}
+
+// (PUT /api/v1/sources/{id}/change-rate-job)
+func (s *ServiceHandler) StartChangeRateJob(ctx context.Context, request server.StartChangeRateJobRequestObject) (server.StartChangeRateJobResponseObject, error) {
+	logger := log.NewDebugLogger("change_rate_handler").
+		WithContext(ctx).
+		Operation("start_change_rate_job").
+		WithUUID("source_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.StartChangeRateJob400JSONResponse{Message: "empty body"}, nil
+	}
+
+	source, err := s.sourceSrv.GetSource(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.StartChangeRateJob404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.StartChangeRateJob500JSONResponse{Message: fmt.Sprintf("failed to get source: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != source.Username || user.Organization != source.OrgID {
+		message := fmt.Sprintf("forbidden to update source %s by user with org_id %s", request.Id, user.Organization)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("org_id", user.Organization).Log()
+		return server.StartChangeRateJob403JSONResponse{Message: message}, nil
+	}
+
+	now := time.Now()
+	job, err := s.sourceSrv.StartChangeRateJob(ctx, request.Id, mappers.ChangeRateJobFormToJob(*request.Body, now))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).WithString("step", "validate_input").Log()
+			return server.StartChangeRateJob400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.StartChangeRateJob404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.StartChangeRateJob500JSONResponse{Message: fmt.Sprintf("failed to start change rate job: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("vm_count", len(job.VMIDs)).Log()
+	return server.StartChangeRateJob200JSONResponse(mappers.ChangeRateJobToApi(request.Id, job, now)), nil
+}
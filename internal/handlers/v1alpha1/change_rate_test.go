@@ -0,0 +1,179 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	apiAgent "github.com/kubev2v/migration-planner/api/v1alpha1/agent"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	agentServer "github.com/kubev2v/migration-planner/internal/api/server/agent"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/config"
+	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("change rate handler", Ordered, func() {
+	var (
+		s        store.Store
+		gormdb   *gorm.DB
+		srv      *handlers.ServiceHandler
+		agentSrv *handlers.AgentHandler
+		ctx      context.Context
+	)
+
+	BeforeAll(func() {
+		cfg, err := config.New()
+		Expect(err).To(BeNil())
+		db, err := store.InitDB(cfg)
+		Expect(err).To(BeNil())
+
+		s = store.NewStore(db)
+		gormdb = db
+		srv = handlers.NewServiceHandler(service.NewSourceService(s, nil), nil, nil, nil, nil, nil, nil)
+		agentSrv = handlers.NewAgentHandler(service.NewAgentService(s))
+		ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "admin", Organization: "admin"})
+	})
+
+	AfterAll(func() {
+		_ = s.Close()
+	})
+
+	AfterEach(func() {
+		gormdb.Exec("DELETE FROM change_rate_jobs;")
+		gormdb.Exec("DELETE FROM agents;")
+		gormdb.Exec("DELETE FROM sources;")
+	})
+
+	It("measures the rate from the samples uploaded by the agent", func() {
+		sourceID, agentID := uuid.New(), uuid.New()
+		tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+		Expect(tx.Error).To(BeNil())
+		tx = gormdb.Exec(fmt.Sprintf(insertAgentStm, agentID, "up-to-date", "status-info-1", "cred_url-1", sourceID))
+		Expect(tx.Error).To(BeNil())
+
+		window := 24
+		resp, err := srv.StartChangeRateJob(ctx, server.StartChangeRateJobRequestObject{
+			Id:   sourceID,
+			Body: &v1alpha1.StartChangeRateJobJSONRequestBody{VmIds: []string{"vm-1"}, WindowHours: &window},
+		})
+		Expect(err).To(BeNil())
+		started := resp.(server.StartChangeRateJob200JSONResponse)
+		Expect(started.IntervalMinutes).To(Equal(60))
+		Expect(started.Done).To(BeFalse())
+
+		job, err := agentSrv.GetChangeRateJob(ctx, agentServer.GetChangeRateJobRequestObject{Id: sourceID})
+		Expect(err).To(BeNil())
+		Expect(job.(agentServer.GetChangeRateJob200JSONResponse).VmIds).To(Equal([]string{"vm-1"}))
+
+		at := started.StartedAt.Add(time.Minute)
+		uploaded, err := agentSrv.UploadChangeRateSamples(ctx, agentServer.UploadChangeRateSamplesRequestObject{
+			Id: sourceID,
+			Body: &apiAgent.UploadChangeRateSamplesJSONRequestBody{
+				AgentId: agentID,
+				Samples: []v1alpha1.ChangeRateSample{
+					{VmId: "vm-1", Timestamp: at, CapacityBytes: 100 << 30},
+					{VmId: "vm-1", Timestamp: at.Add(12 * time.Hour), ChangedBytes: 5 << 30, CapacityBytes: 100 << 30},
+				},
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(uploaded.(agentServer.UploadChangeRateSamples200JSONResponse).Measurements).To(HaveLen(1))
+
+		got, err := srv.GetChangeRateJob(ctx, server.GetChangeRateJobRequestObject{Id: sourceID})
+		Expect(err).To(BeNil())
+		measurements := got.(server.GetChangeRateJob200JSONResponse).Measurements
+		Expect(measurements).To(HaveLen(1))
+		Expect(measurements[0].Samples).To(Equal(2))
+		Expect(*measurements[0].DailyChangeRatePercent).To(BeNumerically("~", 10, 1e-9))
+	})
+
+	It("rejects samples from an agent of another source", func() {
+		sourceID, otherSourceID, agentID := uuid.New(), uuid.New(), uuid.New()
+		tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+		Expect(tx.Error).To(BeNil())
+		tx = gormdb.Exec(fmt.Sprintf(insertSourceOnPremisesStm, otherSourceID, "other", "admin", "admin"))
+		Expect(tx.Error).To(BeNil())
+		tx = gormdb.Exec(fmt.Sprintf(insertAgentStm, agentID, "up-to-date", "status-info-1", "cred_url-1", otherSourceID))
+		Expect(tx.Error).To(BeNil())
+
+		_, err := srv.StartChangeRateJob(ctx, server.StartChangeRateJobRequestObject{
+			Id:   sourceID,
+			Body: &v1alpha1.StartChangeRateJobJSONRequestBody{VmIds: []string{"vm-1"}},
+		})
+		Expect(err).To(BeNil())
+
+		resp, err := agentSrv.UploadChangeRateSamples(ctx, agentServer.UploadChangeRateSamplesRequestObject{
+			Id: sourceID,
+			Body: &apiAgent.UploadChangeRateSamplesJSONRequestBody{
+				AgentId: agentID,
+				Samples: []v1alpha1.ChangeRateSample{{VmId: "vm-1", Timestamp: time.Now(), CapacityBytes: 1}},
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(agentServer.UploadChangeRateSamples403JSONResponse{}).String()))
+	})
+
+	It("forbids an agent to read the job of another source", func() {
+		sourceID, otherSourceID := uuid.New(), uuid.New()
+		tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+		Expect(tx.Error).To(BeNil())
+
+		_, err := srv.StartChangeRateJob(ctx, server.StartChangeRateJobRequestObject{
+			Id:   sourceID,
+			Body: &v1alpha1.StartChangeRateJobJSONRequestBody{VmIds: []string{"vm-1"}},
+		})
+		Expect(err).To(BeNil())
+
+		agentCtx := auth.NewTokenContext(context.TODO(), auth.AgentJWT{OrgID: "admin", SourceID: otherSourceID.String()})
+		resp, err := agentSrv.GetChangeRateJob(agentCtx, agentServer.GetChangeRateJobRequestObject{Id: sourceID})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(agentServer.GetChangeRateJob403JSONResponse{}).String()))
+
+		agentCtx = auth.NewTokenContext(context.TODO(), auth.AgentJWT{OrgID: "admin", SourceID: sourceID.String()})
+		resp, err = agentSrv.GetChangeRateJob(agentCtx, agentServer.GetChangeRateJobRequestObject{Id: sourceID})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(agentServer.GetChangeRateJob200JSONResponse{}).String()))
+	})
+
+	It("rejects a job without VMs", func() {
+		sourceID := uuid.New()
+		tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+		Expect(tx.Error).To(BeNil())
+
+		resp, err := srv.StartChangeRateJob(ctx, server.StartChangeRateJobRequestObject{
+			Id:   sourceID,
+			Body: &v1alpha1.StartChangeRateJobJSONRequestBody{VmIds: []string{}},
+		})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.StartChangeRateJob400JSONResponse{}).String()))
+	})
+
+	It("returns 404 before a job is started", func() {
+		sourceID := uuid.New()
+		tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+		Expect(tx.Error).To(BeNil())
+
+		resp, err := srv.GetChangeRateJob(ctx, server.GetChangeRateJobRequestObject{Id: sourceID})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetChangeRateJob404JSONResponse{}).String()))
+	})
+
+	It("forbids reading the job of another user", func() {
+		sourceID := uuid.New()
+		tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "batman", "batman"))
+		Expect(tx.Error).To(BeNil())
+
+		resp, err := srv.GetChangeRateJob(ctx, server.GetChangeRateJobRequestObject{Id: sourceID})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetChangeRateJob403JSONResponse{}).String()))
+	})
+})
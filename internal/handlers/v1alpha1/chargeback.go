@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/chargeback"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/plans/{id}/chargeback)
+func (h *ServiceHandler) GetPlanChargeback(ctx context.Context, request server.GetPlanChargebackRequestObject) (server.GetPlanChargebackResponseObject, error) {
+	format := v1alpha1.ChargebackFormatJson
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	groupBy := chargeback.GroupByOwner
+	if request.Params.GroupBy != nil {
+		groupBy = chargeback.GroupBy(*request.Params.GroupBy)
+	}
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan_chargeback").
+		WithUUID("plan_id", request.Id).
+		WithString("group_by", string(groupBy)).
+		WithString("format", string(format)).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanChargeback404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanChargeback500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlanChargeback403JSONResponse{Message: message}, nil
+	}
+
+	report, err := h.planSrv.Chargeback(ctx, *p, groupBy, request.Params.RateCardId)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanChargeback404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.GetPlanChargeback400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanChargeback500JSONResponse{Message: fmt.Sprintf("failed to charge plan back: %v", err)}, nil
+		}
+	}
+
+	switch format {
+	case v1alpha1.ChargebackFormatCsv:
+		out, err := report.CSV()
+		if err != nil {
+			logger.Error(err).Log()
+			return server.GetPlanChargeback500JSONResponse{Message: fmt.Sprintf("failed to render chargeback: %v", err)}, nil
+		}
+		logger.Success().WithInt("lines", len(report.Lines)).Log()
+		return server.GetPlanChargeback200TextcsvResponse{Body: bytes.NewReader(out), ContentLength: int64(len(out))}, nil
+	case v1alpha1.ChargebackFormatXlsx:
+		out, err := report.XLSX()
+		if err != nil {
+			logger.Error(err).Log()
+			return server.GetPlanChargeback500JSONResponse{Message: fmt.Sprintf("failed to render chargeback: %v", err)}, nil
+		}
+		logger.Success().WithInt("lines", len(report.Lines)).Log()
+		return server.GetPlanChargeback200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse{Body: bytes.NewReader(out), ContentLength: int64(len(out))}, nil
+	case v1alpha1.ChargebackFormatJson:
+		logger.Success().WithInt("lines", len(report.Lines)).Log()
+		return server.GetPlanChargeback200JSONResponse(mappers.ChargebackToApi(report)), nil
+	default:
+		return server.GetPlanChargeback400JSONResponse{Message: fmt.Sprintf("unknown format %q", format)}, nil
+	}
+}
@@ -0,0 +1,273 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/checklist-templates)
+func (h *ServiceHandler) ListChecklistTemplates(ctx context.Context, request server.ListChecklistTemplatesRequestObject) (server.ListChecklistTemplatesResponseObject, error) {
+	logger := log.NewDebugLogger("checklist_handler").
+		WithContext(ctx).
+		Operation("list_checklist_templates").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	templates, err := h.planSrv.ListChecklistTemplates(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListChecklistTemplates500JSONResponse{Message: fmt.Sprintf("failed to list checklist templates: %v", err)}, nil
+	}
+
+	apiTemplates, err := mappers.ChecklistTemplateListToApi(templates)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListChecklistTemplates500JSONResponse{Message: fmt.Sprintf("failed to map checklist templates: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(apiTemplates)).Log()
+	return server.ListChecklistTemplates200JSONResponse(apiTemplates), nil
+}
+
+// (POST /api/v1/checklist-templates)
+func (h *ServiceHandler) CreateChecklistTemplate(ctx context.Context, request server.CreateChecklistTemplateRequestObject) (server.CreateChecklistTemplateResponseObject, error) {
+	logger := log.NewDebugLogger("checklist_handler").
+		WithContext(ctx).
+		Operation("create_checklist_template").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CreateChecklistTemplate400JSONResponse{Message: "empty body"}, nil
+	}
+
+	template, err := h.planSrv.CreateChecklistTemplate(ctx, user.Organization, user.Username, mappers.ChecklistTemplateFormToTemplate(v1alpha1.ChecklistTemplateForm(*request.Body)))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.CreateChecklistTemplate400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrDuplicateKey:
+			logger.Error(err).Log()
+			return server.CreateChecklistTemplate409JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreateChecklistTemplate500JSONResponse{Message: fmt.Sprintf("failed to create checklist template: %v", err)}, nil
+		}
+	}
+
+	apiTemplate, err := mappers.ChecklistTemplateToApi(*template)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CreateChecklistTemplate500JSONResponse{Message: fmt.Sprintf("failed to map checklist template: %v", err)}, nil
+	}
+
+	logger.Success().WithUUID("checklist_template_id", template.ID).Log()
+	return server.CreateChecklistTemplate201JSONResponse(apiTemplate), nil
+}
+
+// (DELETE /api/v1/checklist-templates/{id})
+func (h *ServiceHandler) DeleteChecklistTemplate(ctx context.Context, request server.DeleteChecklistTemplateRequestObject) (server.DeleteChecklistTemplateResponseObject, error) {
+	logger := log.NewDebugLogger("checklist_handler").
+		WithContext(ctx).
+		Operation("delete_checklist_template").
+		WithUUID("checklist_template_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	template, err := h.planSrv.DeleteChecklistTemplate(ctx, user.Organization, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.DeleteChecklistTemplate404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.DeleteChecklistTemplate500JSONResponse{Message: fmt.Sprintf("failed to delete checklist template: %v", err)}, nil
+		}
+	}
+
+	apiTemplate, err := mappers.ChecklistTemplateToApi(*template)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.DeleteChecklistTemplate500JSONResponse{Message: fmt.Sprintf("failed to map checklist template: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.DeleteChecklistTemplate200JSONResponse(apiTemplate), nil
+}
+
+// (GET /api/v1/plans/{id}/checklists)
+func (h *ServiceHandler) ListPlanChecklists(ctx context.Context, request server.ListPlanChecklistsRequestObject) (server.ListPlanChecklistsResponseObject, error) {
+	logger := log.NewDebugLogger("checklist_handler").
+		WithContext(ctx).
+		Operation("list_plan_checklists").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ListPlanChecklists404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ListPlanChecklists500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.ListPlanChecklists403JSONResponse{Message: message}, nil
+	}
+
+	var wave string
+	if request.Params.Wave != nil {
+		wave = *request.Params.Wave
+	}
+
+	checklists, err := h.planSrv.ListChecklists(ctx, *p, wave)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListPlanChecklists500JSONResponse{Message: fmt.Sprintf("failed to list checklists: %v", err)}, nil
+	}
+
+	apiChecklists, err := mappers.ChecklistListToApi(checklists)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListPlanChecklists500JSONResponse{Message: fmt.Sprintf("failed to map checklists: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(apiChecklists)).Log()
+	return server.ListPlanChecklists200JSONResponse(apiChecklists), nil
+}
+
+// (POST /api/v1/plans/{id}/checklists)
+func (h *ServiceHandler) InstantiatePlanChecklists(ctx context.Context, request server.InstantiatePlanChecklistsRequestObject) (server.InstantiatePlanChecklistsResponseObject, error) {
+	logger := log.NewDebugLogger("checklist_handler").
+		WithContext(ctx).
+		Operation("instantiate_plan_checklists").
+		WithUUID("plan_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.InstantiatePlanChecklists404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.InstantiatePlanChecklists500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.InstantiatePlanChecklists403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.InstantiatePlanChecklists400JSONResponse{Message: "empty body"}, nil
+	}
+
+	checklists, err := h.planSrv.InstantiateChecklists(ctx, *p, request.Body.Wave, mappers.ChecklistVMsFromApi(request.Body.Vms))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.InstantiatePlanChecklists400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.InstantiatePlanChecklists500JSONResponse{Message: fmt.Sprintf("failed to instantiate checklists: %v", err)}, nil
+		}
+	}
+
+	apiChecklists, err := mappers.ChecklistListToApi(checklists)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.InstantiatePlanChecklists500JSONResponse{Message: fmt.Sprintf("failed to map checklists: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(apiChecklists)).Log()
+	return server.InstantiatePlanChecklists201JSONResponse(apiChecklists), nil
+}
+
+// (PUT /api/v1/plans/{id}/checklists/{checklistId}/items/{index})
+func (h *ServiceHandler) CompletePlanChecklistItem(ctx context.Context, request server.CompletePlanChecklistItemRequestObject) (server.CompletePlanChecklistItemResponseObject, error) {
+	logger := log.NewDebugLogger("checklist_handler").
+		WithContext(ctx).
+		Operation("complete_plan_checklist_item").
+		WithUUID("plan_id", request.Id).
+		WithUUID("checklist_id", request.ChecklistId).
+		WithInt("index", request.Index).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.CompletePlanChecklistItem404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CompletePlanChecklistItem500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.CompletePlanChecklistItem403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.CompletePlanChecklistItem400JSONResponse{Message: "empty body"}, nil
+	}
+
+	updated, err := h.planSrv.CompleteChecklistItem(ctx, *p, request.ChecklistId, request.Index, request.Body.Done, user.Username)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.CompletePlanChecklistItem400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.CompletePlanChecklistItem404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CompletePlanChecklistItem500JSONResponse{Message: fmt.Sprintf("failed to update checklist: %v", err)}, nil
+		}
+	}
+
+	apiChecklist, err := mappers.ChecklistToApi(*updated)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CompletePlanChecklistItem500JSONResponse{Message: fmt.Sprintf("failed to map checklist: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.CompletePlanChecklistItem200JSONResponse(apiChecklist), nil
+}
@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/contingency-policy)
+func (h *ServiceHandler) GetContingencyPolicy(ctx context.Context, request server.GetContingencyPolicyRequestObject) (server.GetContingencyPolicyResponseObject, error) {
+	logger := log.NewDebugLogger("contingency_policy_handler").
+		WithContext(ctx).
+		Operation("get_contingency_policy").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	policy, err := h.estimationSrv.GetContingencyPolicy(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetContingencyPolicy500JSONResponse{Message: fmt.Sprintf("failed to get contingency policy: %v", err)}, nil
+	}
+
+	apiPolicy, err := mappers.ContingencyPolicyToApi(*policy)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetContingencyPolicy500JSONResponse{Message: fmt.Sprintf("failed to map contingency policy: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetContingencyPolicy200JSONResponse(apiPolicy), nil
+}
+
+// (PUT /api/v1/contingency-policy)
+func (h *ServiceHandler) SetContingencyPolicy(ctx context.Context, request server.SetContingencyPolicyRequestObject) (server.SetContingencyPolicyResponseObject, error) {
+	logger := log.NewDebugLogger("contingency_policy_handler").
+		WithContext(ctx).
+		Operation("set_contingency_policy").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SetContingencyPolicy400JSONResponse{Message: "empty body"}, nil
+	}
+
+	doc, err := mappers.ContingencyPolicyFromApi(request.Body.Rules, request.Body.MinimumPercent)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetContingencyPolicy400JSONResponse{Message: err.Error()}, nil
+	}
+
+	policy, err := h.estimationSrv.SetContingencyPolicy(ctx, user.Organization, user.Username, doc)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SetContingencyPolicy400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetContingencyPolicy500JSONResponse{Message: fmt.Sprintf("failed to set contingency policy: %v", err)}, nil
+		}
+	}
+
+	apiPolicy, err := mappers.ContingencyPolicyToApi(*policy)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetContingencyPolicy500JSONResponse{Message: fmt.Sprintf("failed to map contingency policy: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.SetContingencyPolicy200JSONResponse(apiPolicy), nil
+}
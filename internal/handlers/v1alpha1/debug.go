@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (POST /api/v1/debug/bundle)
+func (h *ServiceHandler) CreateDebugBundle(ctx context.Context, request server.CreateDebugBundleRequestObject) (server.CreateDebugBundleResponseObject, error) {
+	logger := log.NewDebugLogger("debug_handler").
+		WithContext(ctx).
+		Operation("create_debug_bundle").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if h.debugSrv == nil || !h.debugSrv.IsAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.CreateDebugBundle403JSONResponse{Message: err.Error()}, nil
+	}
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CreateDebugBundle400JSONResponse{Message: "empty body"}, nil
+	}
+
+	req := service.DebugBundleRequest{PlanID: request.Body.PlanId, JobID: request.Body.JobId}
+	if request.Body.RequestId != nil {
+		req.RequestID = *request.Body.RequestId
+	}
+
+	out, err := h.debugSrv.Bundle(ctx, req)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound, *service.ErrJobNotFound:
+			logger.Error(err).Log()
+			return server.CreateDebugBundle404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreateDebugBundle500JSONResponse{Message: fmt.Sprintf("failed to create debug bundle: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("bytes", len(out)).Log()
+	return server.CreateDebugBundle200ApplicationgzipResponse{Body: bytes.NewReader(out), ContentLength: int64(len(out))}, nil
+}
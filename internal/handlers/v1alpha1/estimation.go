@@ -7,6 +7,7 @@ import (
 	"github.com/kubev2v/migration-planner/internal/api/server"
 	"github.com/kubev2v/migration-planner/internal/auth"
 	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/rvtools/jobs"
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/pkg/log"
 )
@@ -125,19 +126,81 @@ func (h *ServiceHandler) CalculateMigrationEstimation(ctx context.Context, reque
 		WithString("username", user.Username).
 		Log()
 
-	// Call estimation service
-	result, err := h.estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+	priority := service.EstimationPriorityInteractive
+	if request.Body.Priority != nil {
+		priority = service.EstimationPriority(*request.Body.Priority)
+	}
+
+	var overrides map[string]float64
+	if request.Body.Params != nil {
+		overrides = *request.Body.Params
+	}
+
+	// Call estimation service, recording the estimation to keep the run when runs are kept
+	var (
+		result    *service.MigrationAssessmentResult
+		recording *service.EstimationRecording
+	)
+	record := request.Body.Record != nil && *request.Body.Record
+	switch {
+	case request.Body.PlanId != nil:
+		p, planErr := h.planSrv.GetPlan(ctx, *request.Body.PlanId)
+		if planErr != nil {
+			switch planErr.(type) {
+			case *service.ErrResourceNotFound:
+				logger.Error(planErr).Log()
+				return server.CalculateMigrationEstimation404JSONResponse{Message: planErr.Error()}, nil
+			default:
+				logger.Error(planErr).Log()
+				return server.CalculateMigrationEstimation500JSONResponse{Message: "failed to get plan"}, nil
+			}
+		}
+		if user.Username != p.Username || user.Organization != p.OrgID {
+			message := fmt.Sprintf("forbidden to access plan %s by user %s", p.ID, user.Username)
+			logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+			return server.CalculateMigrationEstimation403JSONResponse{Message: message}, nil
+		}
+		result, recording, err = h.estimationSrv.CalculatePlannedMigrationEstimation(ctx, *p, assessmentID, clusterID, priority, overrides, record || h.estimationSrv.KeepsRuns())
+	case record || h.estimationSrv.KeepsRuns():
+		result, recording, err = h.estimationSrv.RecordMigrationEstimation(ctx, assessmentID, clusterID, priority, overrides)
+	default:
+		result, err = h.estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, priority, overrides)
+	}
 	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
 			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
 			return server.CalculateMigrationEstimation404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.CalculateMigrationEstimation400JSONResponse{Message: err.Error()}, nil
 		default:
 			logger.Error(err).Log()
 			return server.CalculateMigrationEstimation500JSONResponse{Message: "failed to calculate migration estimation"}, nil
 		}
 	}
 
+	if request.Body.Day2Target != nil {
+		report, err := h.estimationSrv.Day2Readiness(ctx, mappers.Day2TargetFromApi(*request.Body.Day2Target))
+		if err != nil {
+			logger.Error(err).Log()
+			return server.CalculateMigrationEstimation500JSONResponse{Message: "failed to assess day-2 readiness"}, nil
+		}
+		result.Day2 = &report
+	}
+
+	if request.Body.Schedule != nil {
+		schedule, err := mappers.EstimationScheduleFromApi(*request.Body.Schedule)
+		if err != nil {
+			logger.Error(err).Log()
+			return server.CalculateMigrationEstimation400JSONResponse{Message: err.Error()}, nil
+		}
+		if err := h.estimationSrv.Schedule(ctx, result, schedule); err != nil {
+			logger.Error(err).Log()
+			return server.CalculateMigrationEstimation400JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
 	logger.Success().
 		WithString("org_id", user.Organization).
 		WithString("username", user.Username).
@@ -146,5 +209,243 @@ func (h *ServiceHandler) CalculateMigrationEstimation(ctx context.Context, reque
 
 	// Convert domain model to API response
 	apiResponse := mappers.MigrationEstimationResultToAPI(*result)
+	if recording != nil && h.estimationSrv.KeepsRuns() {
+		// keeping the run is best effort: the estimation is returned regardless
+		if run, err := h.estimationSrv.SaveEstimationRun(ctx, user.Username, user.Organization, *recording); err != nil {
+			logger.Error(err).WithString("step", "save_estimation_run").Log()
+		} else {
+			apiResponse.RunId = &run.ID
+		}
+	}
+	if recording != nil && record {
+		doc, err := mappers.EstimationRecordingToAPI(*recording)
+		if err != nil {
+			logger.Error(err).Log()
+			return server.CalculateMigrationEstimation500JSONResponse{Message: "failed to record the estimation"}, nil
+		}
+		apiResponse.Recording = &doc
+	}
 	return server.CalculateMigrationEstimation200JSONResponse(apiResponse), nil
 }
+
+// (POST /api/v1/estimations)
+func (h *ServiceHandler) RunEstimation(ctx context.Context, request server.RunEstimationRequestObject) (server.RunEstimationResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_handler").
+		WithContext(ctx).
+		Operation("run_estimation").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.RunEstimation400JSONResponse{Message: "empty body"}, nil
+	}
+	if len(request.Body.Calculators) == 0 {
+		logger.Error(fmt.Errorf("no calculator selected")).Log()
+		return server.RunEstimation400JSONResponse{Message: "at least one calculator is required"}, nil
+	}
+
+	priority := service.EstimationPriorityInteractive
+	if request.Body.Priority != nil {
+		priority = service.EstimationPriority(*request.Body.Priority)
+	}
+
+	if request.Body.Async != nil && *request.Body.Async {
+		args := jobs.EstimationJobArgs{
+			Calculators: mappers.CalculatorSpecsFromApi(request.Body.Calculators),
+			Params:      request.Body.Params,
+			Priority:    string(priority),
+			OrgID:       user.Organization,
+			Username:    user.Username,
+		}
+		if request.Body.Schedule != nil {
+			schedule, err := mappers.EstimationScheduleFromApi(*request.Body.Schedule)
+			if err != nil {
+				logger.Error(err).Log()
+				return server.RunEstimation400JSONResponse{Message: err.Error()}, nil
+			}
+			args.Schedule = &jobs.EstimationScheduleArgs{Start: schedule.Start, Calendar: schedule.Calendar, Workday: schedule.Workday}
+		}
+
+		job, err := h.jobSrv.CreateEstimationJob(ctx, args)
+		if err != nil {
+			switch err.(type) {
+			case *service.ErrInvalidRequest:
+				logger.Error(err).Log()
+				return server.RunEstimation400JSONResponse{Message: err.Error()}, nil
+			default:
+				logger.Error(err).Log()
+				return server.RunEstimation500JSONResponse{Message: fmt.Sprintf("failed to create job: %v", err)}, nil
+			}
+		}
+
+		logger.Success().WithParam("job_id", job.Job.Id).Log()
+		return server.RunEstimation202JSONResponse(mappers.EstimationJobToAPI(*job)), nil
+	}
+
+	result, err := h.estimationSrv.RunEstimation(ctx, user.Organization, priority,
+		mappers.CalculatorSpecsFromApi(request.Body.Calculators),
+		mappers.EstimationParamsFromApi(request.Body.Params))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.RunEstimation400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RunEstimation500JSONResponse{Message: "failed to run estimation"}, nil
+		}
+	}
+
+	if request.Body.Schedule != nil {
+		schedule, err := mappers.EstimationScheduleFromApi(*request.Body.Schedule)
+		if err != nil {
+			logger.Error(err).Log()
+			return server.RunEstimation400JSONResponse{Message: err.Error()}, nil
+		}
+		if err := h.estimationSrv.Schedule(ctx, result, schedule); err != nil {
+			logger.Error(err).Log()
+			return server.RunEstimation400JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("org_id", user.Organization).
+		WithString("username", user.Username).
+		WithString("total_duration", result.TotalDuration.String()).
+		Log()
+
+	return server.RunEstimation200JSONResponse(mappers.MigrationEstimationResultToAPI(*result)), nil
+}
+
+// (POST /api/v1/estimations/comparison)
+func (h *ServiceHandler) CompareEstimations(ctx context.Context, request server.CompareEstimationsRequestObject) (server.CompareEstimationsResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_handler").
+		WithContext(ctx).
+		Operation("compare_estimations").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CompareEstimations400JSONResponse{Message: "empty body"}, nil
+	}
+	if len(request.Body.Calculators) == 0 {
+		logger.Error(fmt.Errorf("no calculator selected")).Log()
+		return server.CompareEstimations400JSONResponse{Message: "at least one calculator is required"}, nil
+	}
+
+	priority := service.EstimationPriorityInteractive
+	if request.Body.Priority != nil {
+		priority = service.EstimationPriority(*request.Body.Priority)
+	}
+
+	comparison, err := h.estimationSrv.CompareScenarios(ctx, user.Organization, priority,
+		mappers.CalculatorSpecsFromApi(request.Body.Calculators),
+		mappers.EstimationScenarioFromApi(request.Body.Params, request.Body.A, "A"),
+		mappers.EstimationScenarioFromApi(request.Body.Params, request.Body.B, "B"))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.CompareEstimations400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CompareEstimations500JSONResponse{Message: "failed to compare estimations"}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("org_id", user.Organization).
+		WithString("total_change", comparison.Change.String()).
+		Log()
+
+	return server.CompareEstimations200JSONResponse(mappers.EstimationComparisonToAPI(*comparison)), nil
+}
+
+// (POST /api/v1/estimations/solve)
+func (h *ServiceHandler) SolveEstimationDeadline(ctx context.Context, request server.SolveEstimationDeadlineRequestObject) (server.SolveEstimationDeadlineResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_handler").
+		WithContext(ctx).
+		Operation("solve_estimation_deadline").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SolveEstimationDeadline400JSONResponse{Message: "empty body"}, nil
+	}
+	if len(request.Body.Calculators) == 0 {
+		logger.Error(fmt.Errorf("no calculator selected")).Log()
+		return server.SolveEstimationDeadline400JSONResponse{Message: "at least one calculator is required"}, nil
+	}
+
+	priority := service.EstimationPriorityInteractive
+	if request.Body.Priority != nil {
+		priority = service.EstimationPriority(*request.Body.Priority)
+	}
+	schedule, err := mappers.EstimationScheduleFromApi(request.Body.Schedule)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SolveEstimationDeadline400JSONResponse{Message: err.Error()}, nil
+	}
+
+	result, err := h.estimationSrv.SolveDeadline(ctx, user.Organization, priority,
+		mappers.CalculatorSpecsFromApi(request.Body.Calculators),
+		mappers.EstimationParamsFromApi(request.Body.Params),
+		schedule,
+		request.Body.Deadline,
+		mappers.EstimationSolveBoundsFromApi(request.Body.Adjustable))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SolveEstimationDeadline400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SolveEstimationDeadline500JSONResponse{Message: "failed to solve estimation deadline"}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("org_id", user.Organization).
+		WithBool("feasible", result.Feasible).
+		WithInt("change_count", len(result.Changes)).
+		Log()
+
+	return server.SolveEstimationDeadline200JSONResponse(mappers.EstimationSolutionToApi(result)), nil
+}
+
+// (GET /api/v1/estimations/{id})
+func (h *ServiceHandler) GetEstimationJob(ctx context.Context, request server.GetEstimationJobRequestObject) (server.GetEstimationJobResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_handler").
+		WithContext(ctx).
+		Operation("get_estimation_job").
+		WithParam("job_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	job, err := h.jobSrv.GetEstimationJob(ctx, request.Id, user.Organization, user.Username)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrJobNotFound:
+			logger.Error(err).Log()
+			return server.GetEstimationJob404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrJobForbidden:
+			logger.Error(err).Log()
+			return server.GetEstimationJob403JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetEstimationJob500JSONResponse{Message: fmt.Sprintf("failed to get estimation job: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithString("status", string(job.Job.Status)).Log()
+	return server.GetEstimationJob200JSONResponse(mappers.EstimationJobToAPI(*job)), nil
+}
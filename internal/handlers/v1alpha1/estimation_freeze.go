@@ -0,0 +1,293 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// ownsEstimationFreeze tells whether the freeze was made by the user.
+func ownsEstimationFreeze(user auth.User, f *model.EstimationFreeze) bool {
+	return f.Username == user.Username && f.OrgID == user.Organization
+}
+
+// (POST /api/v1/assessments/{id}/estimation-freezes)
+func (h *ServiceHandler) CreateEstimationFreeze(ctx context.Context, request server.CreateEstimationFreezeRequestObject) (server.CreateEstimationFreezeResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_freeze_handler").
+		WithContext(ctx).
+		Operation("create_estimation_freeze").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CreateEstimationFreeze400JSONResponse{Message: "empty body"}, nil
+	}
+	if request.Body.ClusterId == "" {
+		logger.Error(fmt.Errorf("clusterId is required")).Log()
+		return server.CreateEstimationFreeze400JSONResponse{Message: "clusterId is required"}, nil
+	}
+
+	assessment, err := h.assessmentSrv.GetAssessment(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.CreateEstimationFreeze404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreateEstimationFreeze500JSONResponse{Message: "failed to get assessment"}, nil
+		}
+	}
+	if user.Username != assessment.Username || user.Organization != assessment.OrgID {
+		message := fmt.Sprintf("forbidden to access assessment %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+		return server.CreateEstimationFreeze403JSONResponse{Message: message}, nil
+	}
+
+	req := service.EstimationFreezeRequest{
+		Name:         request.Body.Name,
+		SOWID:        request.Body.SowId,
+		AssessmentID: request.Id,
+		ClusterID:    request.Body.ClusterId,
+	}
+	if request.Body.Params != nil {
+		req.Overrides = *request.Body.Params
+	}
+	if request.Body.PlanId != nil {
+		p, err := h.planSrv.GetPlan(ctx, *request.Body.PlanId)
+		if err != nil {
+			switch err.(type) {
+			case *service.ErrResourceNotFound:
+				logger.Error(err).Log()
+				return server.CreateEstimationFreeze404JSONResponse{Message: err.Error()}, nil
+			default:
+				logger.Error(err).Log()
+				return server.CreateEstimationFreeze500JSONResponse{Message: "failed to get plan"}, nil
+			}
+		}
+		if user.Username != p.Username || user.Organization != p.OrgID {
+			message := fmt.Sprintf("forbidden to access plan %s by user %s", p.ID, user.Username)
+			logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+			return server.CreateEstimationFreeze403JSONResponse{Message: message}, nil
+		}
+		req.Plan = p
+	}
+
+	logger.Step("freeze_estimation").
+		WithString("sow_id", req.SOWID).
+		WithString("name", req.Name).
+		WithString("cluster_id", req.ClusterID).
+		Log()
+
+	freeze, err := h.estimationSrv.FreezeEstimation(ctx, user.Username, user.Organization, req)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.CreateEstimationFreeze404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.CreateEstimationFreeze400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrDuplicateKey:
+			logger.Error(err).Log()
+			return server.CreateEstimationFreeze409JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreateEstimationFreeze500JSONResponse{Message: "failed to freeze estimation"}, nil
+		}
+	}
+
+	apiFreeze, err := mappers.EstimationFreezeToAPI(*freeze, true)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CreateEstimationFreeze500JSONResponse{Message: fmt.Sprintf("failed to map estimation freeze: %v", err)}, nil
+	}
+
+	logger.Success().WithUUID("freeze_id", freeze.ID).Log()
+	return server.CreateEstimationFreeze201JSONResponse(apiFreeze), nil
+}
+
+// (GET /api/v1/estimation-freezes)
+func (h *ServiceHandler) ListEstimationFreezes(ctx context.Context, request server.ListEstimationFreezesRequestObject) (server.ListEstimationFreezesResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_freeze_handler").
+		WithContext(ctx).
+		Operation("list_estimation_freezes").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	var sowID string
+	if request.Params.SowId != nil {
+		sowID = *request.Params.SowId
+	}
+
+	freezes, err := h.estimationSrv.ListEstimationFreezes(ctx, user.Username, user.Organization, sowID)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListEstimationFreezes500JSONResponse{Message: fmt.Sprintf("failed to list estimation freezes: %v", err)}, nil
+	}
+
+	apiFreezes, err := mappers.EstimationFreezesToAPI(freezes)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListEstimationFreezes500JSONResponse{Message: fmt.Sprintf("failed to map estimation freezes: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(freezes)).Log()
+	return server.ListEstimationFreezes200JSONResponse(apiFreezes), nil
+}
+
+// (GET /api/v1/estimation-freezes/{id})
+func (h *ServiceHandler) GetEstimationFreeze(ctx context.Context, request server.GetEstimationFreezeRequestObject) (server.GetEstimationFreezeResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_freeze_handler").
+		WithContext(ctx).
+		Operation("get_estimation_freeze").
+		WithUUID("freeze_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	freeze, err := h.estimationSrv.GetEstimationFreeze(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetEstimationFreeze404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetEstimationFreeze500JSONResponse{Message: fmt.Sprintf("failed to get estimation freeze: %v", err)}, nil
+		}
+	}
+	if !ownsEstimationFreeze(user, freeze) {
+		message := fmt.Sprintf("forbidden to access estimation freeze %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+		return server.GetEstimationFreeze403JSONResponse{Message: message}, nil
+	}
+
+	apiFreeze, err := mappers.EstimationFreezeToAPI(*freeze, true)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetEstimationFreeze500JSONResponse{Message: fmt.Sprintf("failed to map estimation freeze: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetEstimationFreeze200JSONResponse(apiFreeze), nil
+}
+
+// (GET /api/v1/estimation-freezes/{id}/report)
+func (h *ServiceHandler) GetEstimationFreezeReport(ctx context.Context, request server.GetEstimationFreezeReportRequestObject) (server.GetEstimationFreezeReportResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_freeze_handler").
+		WithContext(ctx).
+		Operation("get_estimation_freeze_report").
+		WithUUID("freeze_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	freeze, err := h.estimationSrv.GetEstimationFreeze(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetEstimationFreezeReport404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetEstimationFreezeReport500JSONResponse{Message: fmt.Sprintf("failed to get estimation freeze: %v", err)}, nil
+		}
+	}
+	if !ownsEstimationFreeze(user, freeze) {
+		message := fmt.Sprintf("forbidden to access estimation freeze %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+		return server.GetEstimationFreezeReport403JSONResponse{Message: message}, nil
+	}
+
+	report := freeze.Report
+	if h.planSrv != nil {
+		if report, err = h.planSrv.StampReport(ctx, user.Organization, user.Username, freeze.Report); err != nil {
+			logger.Error(err).Log()
+			return server.GetEstimationFreezeReport500JSONResponse{Message: fmt.Sprintf("failed to watermark report: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("size", len(report)).Log()
+	return server.GetEstimationFreezeReport200ApplicationpdfResponse{Body: bytes.NewReader(report), ContentLength: int64(len(report))}, nil
+}
+
+// (GET /api/v1/estimation-freezes/{id}/diff)
+func (h *ServiceHandler) DiffEstimationFreeze(ctx context.Context, request server.DiffEstimationFreezeRequestObject) (server.DiffEstimationFreezeResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_freeze_handler").
+		WithContext(ctx).
+		Operation("diff_estimation_freeze").
+		WithUUID("freeze_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	freeze, err := h.estimationSrv.GetEstimationFreeze(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.DiffEstimationFreeze404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.DiffEstimationFreeze500JSONResponse{Message: fmt.Sprintf("failed to get estimation freeze: %v", err)}, nil
+		}
+	}
+	if !ownsEstimationFreeze(user, freeze) {
+		message := fmt.Sprintf("forbidden to access estimation freeze %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+		return server.DiffEstimationFreeze403JSONResponse{Message: message}, nil
+	}
+
+	// the plan the freeze was made for may have changed or been deleted since: it is estimated as it is now
+	var p *model.Plan
+	if freeze.PlanID != nil {
+		p, err = h.planSrv.GetPlan(ctx, *freeze.PlanID)
+		if err != nil {
+			switch err.(type) {
+			case *service.ErrResourceNotFound:
+				p = nil
+			default:
+				logger.Error(err).Log()
+				return server.DiffEstimationFreeze500JSONResponse{Message: "failed to get plan"}, nil
+			}
+		}
+	}
+
+	diff, err := h.estimationSrv.DiffEstimationFreeze(ctx, *freeze, p)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.DiffEstimationFreeze404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.DiffEstimationFreeze500JSONResponse{Message: "failed to estimate again"}, nil
+		}
+	}
+
+	apiDiff, err := mappers.EstimationFreezeDiffToAPI(diff)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.DiffEstimationFreeze500JSONResponse{Message: fmt.Sprintf("failed to map estimation freeze diff: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("differences", len(diff.Differences)).Log()
+	return server.DiffEstimationFreeze200JSONResponse(apiDiff), nil
+}
@@ -0,0 +1,159 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// ownsEstimationRun tells whether the run was kept for the user.
+func ownsEstimationRun(user auth.User, run *model.EstimationRun) bool {
+	return run.Username == user.Username && run.OrgID == user.Organization
+}
+
+// (GET /api/v1/assessments/{id}/estimation-runs)
+func (h *ServiceHandler) ListEstimationRuns(ctx context.Context, request server.ListEstimationRunsRequestObject) (server.ListEstimationRunsResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_run_handler").
+		WithContext(ctx).
+		Operation("list_estimation_runs").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	assessment, err := h.assessmentSrv.GetAssessment(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ListEstimationRuns404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ListEstimationRuns500JSONResponse{Message: "failed to get assessment"}, nil
+		}
+	}
+	if user.Username != assessment.Username || user.Organization != assessment.OrgID {
+		message := fmt.Sprintf("forbidden to access assessment %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+		return server.ListEstimationRuns403JSONResponse{Message: message}, nil
+	}
+
+	var clusterID string
+	if request.Params.ClusterId != nil {
+		clusterID = *request.Params.ClusterId
+	}
+	var limit int
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	runs, err := h.estimationSrv.ListEstimationRuns(ctx, request.Id, clusterID, limit)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListEstimationRuns500JSONResponse{Message: fmt.Sprintf("failed to list estimation runs: %v", err)}, nil
+	}
+
+	apiRuns, err := mappers.EstimationRunsToAPI(runs)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListEstimationRuns500JSONResponse{Message: fmt.Sprintf("failed to map estimation runs: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(runs)).Log()
+	return server.ListEstimationRuns200JSONResponse(apiRuns), nil
+}
+
+// (GET /api/v1/estimation-runs/{id})
+func (h *ServiceHandler) GetEstimationRun(ctx context.Context, request server.GetEstimationRunRequestObject) (server.GetEstimationRunResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_run_handler").
+		WithContext(ctx).
+		Operation("get_estimation_run").
+		WithUUID("run_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	run, err := h.estimationSrv.GetEstimationRun(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetEstimationRun404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetEstimationRun500JSONResponse{Message: fmt.Sprintf("failed to get estimation run: %v", err)}, nil
+		}
+	}
+	if !ownsEstimationRun(user, run) {
+		message := fmt.Sprintf("forbidden to access estimation run %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+		return server.GetEstimationRun403JSONResponse{Message: message}, nil
+	}
+
+	apiRun, err := mappers.EstimationRunToAPI(*run, true)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetEstimationRun500JSONResponse{Message: fmt.Sprintf("failed to map estimation run: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetEstimationRun200JSONResponse(apiRun), nil
+}
+
+// (GET /api/v1/estimation-runs/{id}/comparison)
+func (h *ServiceHandler) CompareEstimationRuns(ctx context.Context, request server.CompareEstimationRunsRequestObject) (server.CompareEstimationRunsResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_run_handler").
+		WithContext(ctx).
+		Operation("compare_estimation_runs").
+		WithUUID("run_id", request.Id).
+		WithUUID("with", request.Params.With).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	runs := make([]model.EstimationRun, 0, 2)
+	for _, id := range []uuid.UUID{request.Id, request.Params.With} {
+		run, err := h.estimationSrv.GetEstimationRun(ctx, id)
+		if err != nil {
+			switch err.(type) {
+			case *service.ErrResourceNotFound:
+				logger.Error(err).Log()
+				return server.CompareEstimationRuns404JSONResponse{Message: err.Error()}, nil
+			default:
+				logger.Error(err).Log()
+				return server.CompareEstimationRuns500JSONResponse{Message: fmt.Sprintf("failed to get estimation run: %v", err)}, nil
+			}
+		}
+		if !ownsEstimationRun(user, run) {
+			message := fmt.Sprintf("forbidden to access estimation run %s by user %s", id, user.Username)
+			logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+			return server.CompareEstimationRuns403JSONResponse{Message: message}, nil
+		}
+		runs = append(runs, *run)
+	}
+
+	comparison, err := service.CompareEstimationRuns(runs[0], runs[1])
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CompareEstimationRuns500JSONResponse{Message: fmt.Sprintf("failed to compare estimation runs: %v", err)}, nil
+	}
+
+	apiComparison, err := mappers.EstimationRunComparisonToAPI(comparison)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CompareEstimationRuns500JSONResponse{Message: fmt.Sprintf("failed to map estimation run comparison: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("difference_count", len(comparison.Differences)).Log()
+	return server.CompareEstimationRuns200JSONResponse(apiComparison), nil
+}
@@ -13,6 +13,7 @@ import (
 	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/store/model"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -141,6 +142,8 @@ var _ = Describe("estimation handler", func() {
 					nil, // jobService
 					nil, // sizerService
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -169,6 +172,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -189,6 +194,57 @@ var _ = Describe("estimation handler", func() {
 					Expect(detail.Duration).NotTo(BeEmpty(), "calculator %s should have duration", calcName)
 					Expect(detail.Reason).NotTo(BeEmpty(), "calculator %s should have reason", calcName)
 				}
+				Expect(response.Breakdown["Storage Migration"].Explanation).NotTo(BeNil())
+				Expect(response.Breakdown["Storage Migration"].Explanation.Formula).To(ContainSubstring("total_disk_gb"))
+			})
+
+			It("lands the breakdown on the work calendar when a schedule is requested", func() {
+				start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+				freezes := []api.ChangeFreeze{{
+					Start: openapi_types.Date{Time: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)},
+					End:   openapi_types.Date{Time: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)},
+					Name:  "release",
+				}}
+				request := &api.MigrationEstimationRequest{
+					ClusterId: clusterID,
+					Schedule: &api.EstimationSchedule{
+						Start:    start,
+						Calendar: &api.PoolCalendar{Freezes: &freezes},
+					},
+				}
+
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimationHandler(assessmentID, user.Username, user.Organization, clusterID)
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
+
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{Id: assessmentID, Body: request})
+				Expect(err).To(BeNil())
+				response, ok := resp.(server.CalculateMigrationEstimation200JSONResponse)
+				Expect(ok).To(BeTrue())
+
+				for calcName, detail := range response.Breakdown {
+					Expect(detail.Start).NotTo(BeNil(), "calculator %s should have a start date", calcName)
+					Expect(detail.End).NotTo(BeNil(), "calculator %s should have an end date", calcName)
+					Expect(*detail.Start).NotTo(BeTemporally("<", start), calcName)
+					Expect(*detail.End).NotTo(BeTemporally("<", *detail.Start), calcName)
+					// the frozen Tuesday is skipped
+					Expect(detail.Start.Weekday()).NotTo(Equal(time.Tuesday), calcName)
+				}
+			})
+
+			It("returns 400 for an invalid workday start", func() {
+				workdayStart := "nine"
+				request := &api.MigrationEstimationRequest{
+					ClusterId: clusterID,
+					Schedule:  &api.EstimationSchedule{Start: time.Now(), WorkdayStart: &workdayStart},
+				}
+
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimationHandler(assessmentID, user.Username, user.Organization, clusterID)
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
+
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{Id: assessmentID, Body: request})
+				Expect(err).To(BeNil())
+				_, ok := resp.(server.CalculateMigrationEstimation400JSONResponse)
+				Expect(ok).To(BeTrue())
 			})
 		})
 
@@ -200,6 +256,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -224,6 +282,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -249,6 +309,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -275,6 +337,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -300,6 +364,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -327,6 +393,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -353,6 +421,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -378,6 +448,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -404,6 +476,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -436,6 +510,8 @@ var _ = Describe("estimation handler", func() {
 					nil,
 					nil,
 					service.NewEstimationService(mockStore),
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
@@ -455,7 +531,7 @@ var _ = Describe("estimation handler", func() {
 			It("returns 200 with complexityByDisk (4 entries) and complexityByOS (5 entries)", func() {
 				request := &api.MigrationComplexityRequest{ClusterId: clusterID}
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -472,7 +548,7 @@ var _ = Describe("estimation handler", func() {
 			It("returns diskSizeRatings with range-only keys and correct scores", func() {
 				request := &api.MigrationComplexityRequest{ClusterId: clusterID}
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -491,7 +567,7 @@ var _ = Describe("estimation handler", func() {
 			It("returns osRatings with one entry per OS in the cluster inventory", func() {
 				request := &api.MigrationComplexityRequest{ClusterId: clusterID}
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -510,7 +586,7 @@ var _ = Describe("estimation handler", func() {
 			It("returns disk scores in canonical order 1 through 4", func() {
 				request := &api.MigrationComplexityRequest{ClusterId: clusterID}
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -527,7 +603,7 @@ var _ = Describe("estimation handler", func() {
 			It("returns OS scores in canonical order 0 through 4", func() {
 				request := &api.MigrationComplexityRequest{ClusterId: clusterID}
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -544,7 +620,7 @@ var _ = Describe("estimation handler", func() {
 			It("returns complexityByOSName with one entry per distinct OS name", func() {
 				request := &api.MigrationComplexityRequest{ClusterId: clusterID}
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -560,7 +636,7 @@ var _ = Describe("estimation handler", func() {
 			It("returns complexityByOSName with correct osName, score and vmCount for a known OS", func() {
 				request := &api.MigrationComplexityRequest{ClusterId: clusterID}
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -585,7 +661,7 @@ var _ = Describe("estimation handler", func() {
 
 		Context("request validation errors", func() {
 			It("returns 400 when request body is nil", func() {
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -599,7 +675,7 @@ var _ = Describe("estimation handler", func() {
 			})
 
 			It("returns 400 when clusterId is empty", func() {
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -615,7 +691,7 @@ var _ = Describe("estimation handler", func() {
 
 		Context("assessment not found errors", func() {
 			It("returns 404 when assessment does not exist", func() {
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   uuid.New(),
@@ -629,7 +705,7 @@ var _ = Describe("estimation handler", func() {
 
 			It("returns 500 when store returns a non-NotFound error", func() {
 				mockStore.getError = errors.New("database error")
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -645,7 +721,7 @@ var _ = Describe("estimation handler", func() {
 		Context("authorization errors", func() {
 			It("returns 403 when user has a different username", func() {
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, "other-user", user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -660,7 +736,7 @@ var _ = Describe("estimation handler", func() {
 
 			It("returns 403 when user belongs to a different organisation", func() {
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, "other-org", clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -677,7 +753,7 @@ var _ = Describe("estimation handler", func() {
 		Context("complexity service errors", func() {
 			It("returns 404 when cluster ID is not found in inventory", func() {
 				mockStore.assessments[assessmentID] = createTestAssessmentForComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -698,7 +774,7 @@ var _ = Describe("estimation handler", func() {
 					Username:  user.Username,
 					Snapshots: []model.Snapshot{},
 				}
-				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore))
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil)
 
 				resp, err := handler.CalculateMigrationComplexity(ctx, server.CalculateMigrationComplexityRequestObject{
 					Id:   assessmentID,
@@ -0,0 +1,53 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/events)
+func (h *ServiceHandler) ListEvents(ctx context.Context, request server.ListEventsRequestObject) (server.ListEventsResponseObject, error) {
+	logger := log.NewDebugLogger("event_handler").
+		WithContext(ctx).
+		Operation("list_events").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	var after int64
+	if request.Params.After != nil {
+		after = *request.Params.After
+	}
+	limit := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	events, err := h.planSrv.Events(ctx, user.Organization, user.Username, after, limit)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.ListEvents400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ListEvents500JSONResponse{Message: fmt.Sprintf("failed to list events: %v", err)}, nil
+		}
+	}
+
+	page, err := mappers.EventPageToApi(events, after)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListEvents500JSONResponse{Message: fmt.Sprintf("failed to map events: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(page.Events)).Log()
+	return server.ListEvents200JSONResponse(page), nil
+}
@@ -0,0 +1,88 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/export-policy)
+func (h *ServiceHandler) GetExportPolicy(ctx context.Context, request server.GetExportPolicyRequestObject) (server.GetExportPolicyResponseObject, error) {
+	logger := log.NewDebugLogger("export_policy_handler").
+		WithContext(ctx).
+		Operation("get_export_policy").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	policy, err := h.planSrv.GetExportPolicy(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetExportPolicy500JSONResponse{Message: fmt.Sprintf("failed to get export policy: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetExportPolicy200JSONResponse(mappers.ExportPolicyToApi(*policy)), nil
+}
+
+// (PUT /api/v1/export-policy)
+func (h *ServiceHandler) SetExportPolicy(ctx context.Context, request server.SetExportPolicyRequestObject) (server.SetExportPolicyResponseObject, error) {
+	logger := log.NewDebugLogger("export_policy_handler").
+		WithContext(ctx).
+		Operation("set_export_policy").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	// the policy protects the data of the whole organization from its users: only the administrators set it
+	if h.debugSrv == nil || !h.debugSrv.IsAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.SetExportPolicy403JSONResponse{Message: err.Error()}, nil
+	}
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SetExportPolicy400JSONResponse{Message: "empty body"}, nil
+	}
+
+	policy, err := h.planSrv.SetExportPolicy(ctx, model.ExportPolicy{
+		OrgID:      user.Organization,
+		Watermark:  request.Body.Watermark,
+		RawExports: request.Body.RawExports,
+		Benchmarks: request.Body.ContributeBenchmarks != nil && *request.Body.ContributeBenchmarks,
+		UpdatedBy:  user.Username,
+	})
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetExportPolicy500JSONResponse{Message: fmt.Sprintf("failed to set export policy: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.SetExportPolicy200JSONResponse(mappers.ExportPolicyToApi(*policy)), nil
+}
+
+// rawExportsAllowed tells whether the export policy of the organization lets the inventories out,
+// always without plan service to keep the policies.
+func (h *ServiceHandler) rawExportsAllowed(ctx context.Context, orgID string) (bool, error) {
+	if h.planSrv == nil {
+		return true, nil
+	}
+	return h.planSrv.RawExportsAllowed(ctx, orgID)
+}
+
+// withoutInventory leaves the inventories out of the snapshots of the assessment.
+func withoutInventory(a *v1alpha1.Assessment) {
+	for i := range a.Snapshots {
+		a.Snapshots[i].Inventory = v1alpha1.Inventory{Clusters: map[string]v1alpha1.InventoryData{}}
+	}
+}
@@ -0,0 +1,74 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (POST /api/v1/assessments/{id}/factory-throughput)
+func (h *ServiceHandler) CalculateFactoryThroughput(ctx context.Context, request server.CalculateFactoryThroughputRequestObject) (server.CalculateFactoryThroughputResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_handler").
+		WithContext(ctx).
+		Operation("calculate_factory_throughput").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CalculateFactoryThroughput400JSONResponse{Message: "empty body"}, nil
+	}
+	if request.Body.ClusterId == "" {
+		logger.Error(fmt.Errorf("clusterId is required")).Log()
+		return server.CalculateFactoryThroughput400JSONResponse{Message: "clusterId is required"}, nil
+	}
+
+	user := auth.MustHaveUser(ctx)
+	assessment, err := h.assessmentSrv.GetAssessment(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.CalculateFactoryThroughput404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CalculateFactoryThroughput500JSONResponse{Message: "failed to get assessment"}, nil
+		}
+	}
+	if user.Username != assessment.Username || user.Organization != assessment.OrgID {
+		message := fmt.Sprintf("forbidden to access assessment %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).Log()
+		return server.CalculateFactoryThroughput403JSONResponse{Message: message}, nil
+	}
+
+	var overrides map[string]float64
+	if request.Body.Params != nil {
+		overrides = *request.Body.Params
+	}
+
+	m, err := h.estimationSrv.FactoryThroughput(ctx, request.Id, request.Body.ClusterId, request.Body.VmsPerWeek, overrides)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.CalculateFactoryThroughput404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.CalculateFactoryThroughput400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CalculateFactoryThroughput500JSONResponse{Message: "failed to calculate factory throughput"}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("cluster_id", request.Body.ClusterId).
+		WithString("bottleneck", string(m.Bottleneck)).
+		Log()
+	return server.CalculateFactoryThroughput200JSONResponse(mappers.FactoryThroughputToApi(m)), nil
+}
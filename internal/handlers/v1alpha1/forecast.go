@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/forecast"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/programs/{id}/forecast)
+func (h *ServiceHandler) GetProgramForecast(ctx context.Context, request server.GetProgramForecastRequestObject) (server.GetProgramForecastResponseObject, error) {
+	format := v1alpha1.ForecastFormatJson
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_program_forecast").
+		WithUUID("plan_id", request.Id).
+		WithString("format", string(format)).
+		Build()
+
+	var opts []forecast.Option
+	if m := request.Params.FiscalYearStartMonth; m != nil {
+		cal, err := cost.NewFiscalCalendar(time.Month(*m))
+		if err != nil {
+			return server.GetProgramForecast400JSONResponse{Message: err.Error()}, nil
+		}
+		opts = append(opts, forecast.WithFiscalCalendar(cal))
+	}
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetProgramForecast404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetProgramForecast500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetProgramForecast403JSONResponse{Message: message}, nil
+	}
+
+	f, err := h.planSrv.Forecast(ctx, *p, time.Now(), opts...)
+	if err != nil {
+		// the stored plan laid out before, so it only fails on a corrupted document or the store
+		logger.Error(err).Log()
+		return server.GetProgramForecast500JSONResponse{Message: fmt.Sprintf("failed to forecast plan: %v", err)}, nil
+	}
+
+	switch format {
+	case v1alpha1.ForecastFormatCsv:
+		out, err := f.CSV()
+		if err != nil {
+			logger.Error(err).Log()
+			return server.GetProgramForecast500JSONResponse{Message: fmt.Sprintf("failed to render forecast: %v", err)}, nil
+		}
+		logger.Success().WithInt("quarters", len(f.Quarters)).Log()
+		return server.GetProgramForecast200TextcsvResponse{Body: bytes.NewReader(out), ContentLength: int64(len(out))}, nil
+	case v1alpha1.ForecastFormatJson:
+		logger.Success().WithInt("quarters", len(f.Quarters)).Log()
+		return server.GetProgramForecast200JSONResponse(mappers.ForecastToApi(p.ID, f)), nil
+	default:
+		return server.GetProgramForecast400JSONResponse{Message: fmt.Sprintf("unknown format %q", format)}, nil
+	}
+}
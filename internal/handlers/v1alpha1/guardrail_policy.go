@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/guardrail-policy)
+func (h *ServiceHandler) GetGuardrailPolicy(ctx context.Context, request server.GetGuardrailPolicyRequestObject) (server.GetGuardrailPolicyResponseObject, error) {
+	logger := log.NewDebugLogger("guardrail_policy_handler").
+		WithContext(ctx).
+		Operation("get_guardrail_policy").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	policy, err := h.estimationSrv.GetGuardrailPolicy(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetGuardrailPolicy500JSONResponse{Message: fmt.Sprintf("failed to get guardrail policy: %v", err)}, nil
+	}
+
+	apiPolicy, err := mappers.GuardrailPolicyToApi(*policy)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetGuardrailPolicy500JSONResponse{Message: fmt.Sprintf("failed to map guardrail policy: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetGuardrailPolicy200JSONResponse(apiPolicy), nil
+}
+
+// (PUT /api/v1/guardrail-policy)
+func (h *ServiceHandler) SetGuardrailPolicy(ctx context.Context, request server.SetGuardrailPolicyRequestObject) (server.SetGuardrailPolicyResponseObject, error) {
+	logger := log.NewDebugLogger("guardrail_policy_handler").
+		WithContext(ctx).
+		Operation("set_guardrail_policy").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SetGuardrailPolicy400JSONResponse{Message: "empty body"}, nil
+	}
+
+	doc := mappers.GuardrailPolicyFromApi(*request.Body)
+
+	policy, err := h.estimationSrv.SetGuardrailPolicy(ctx, user.Organization, user.Username, doc)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SetGuardrailPolicy400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetGuardrailPolicy500JSONResponse{Message: fmt.Sprintf("failed to set guardrail policy: %v", err)}, nil
+		}
+	}
+
+	apiPolicy, err := mappers.GuardrailPolicyToApi(*policy)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetGuardrailPolicy500JSONResponse{Message: fmt.Sprintf("failed to map guardrail policy: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.SetGuardrailPolicy200JSONResponse(apiPolicy), nil
+}
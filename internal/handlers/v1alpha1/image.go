@@ -15,6 +15,7 @@ import (
 	"github.com/kubev2v/migration-planner/internal/auth"
 	"github.com/kubev2v/migration-planner/internal/config"
 	"github.com/kubev2v/migration-planner/internal/image"
+	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/store/model"
 	"github.com/kubev2v/migration-planner/pkg/metrics"
@@ -23,8 +24,9 @@ import (
 )
 
 type ImageHandler struct {
-	store store.Store
-	cfg   *config.Config
+	store   store.Store
+	cfg     *config.Config
+	planSrv *service.PlanService
 }
 
 // Make sure we conform to servers Service interface
@@ -32,8 +34,9 @@ var _ imageServer.Service = (*ImageHandler)(nil)
 
 func NewImageHandler(store store.Store, cfg *config.Config) *ImageHandler {
 	return &ImageHandler{
-		store: store,
-		cfg:   cfg,
+		store:   store,
+		cfg:     cfg,
+		planSrv: service.NewPlanService(store),
 	}
 }
 
@@ -123,6 +126,23 @@ func (h *ImageHandler) GetImageByToken(ctx context.Context, req imageServer.GetI
 	return imageServer.GetImageByToken200ApplicationovfResponse{Body: bytes.NewReader([]byte{})}, nil
 }
 
+// (GET /api/v1/shares/{token})
+func (h *ImageHandler) GetSharedReport(ctx context.Context, req imageServer.GetSharedReportRequestObject) (imageServer.GetSharedReportResponseObject, error) {
+	share, err := h.planSrv.ViewShare(ctx, req.Token)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return imageServer.GetSharedReport404JSONResponse{Message: err.Error()}, nil
+		default:
+			zap.S().Named("image_service").Errorw("failed to view shared report", "error", err)
+			return imageServer.GetSharedReport500JSONResponse{Message: "failed to get shared report"}, nil
+		}
+	}
+
+	zap.S().Named("image_service").Infow("shared report viewed", "share_id", share.ID, "plan_id", share.PlanID, "views", share.Views)
+	return imageServer.GetSharedReport200TexthtmlResponse{Body: bytes.NewReader(share.Report), ContentLength: int64(len(share.Report))}, nil
+}
+
 func generateAndSetAgentToken(ctx context.Context, source *model.Source, storeInstance store.Store, imageBuilder *image.ImageBuilder) error {
 	// get the key associated with source orgID to generate agent token
 	key, err := storeInstance.PrivateKey().Get(ctx, source.OrgID)
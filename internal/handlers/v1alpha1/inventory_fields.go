@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/inventory-fields)
+func (h *ServiceHandler) GetInventoryFieldSchema(ctx context.Context, request server.GetInventoryFieldSchemaRequestObject) (server.GetInventoryFieldSchemaResponseObject, error) {
+	logger := log.NewDebugLogger("inventory_fields_handler").
+		WithContext(ctx).
+		Operation("get_inventory_field_schema").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	schema, err := h.assessmentSrv.GetInventoryFieldSchema(ctx, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetInventoryFieldSchema500JSONResponse{Message: fmt.Sprintf("failed to get inventory field schema: %v", err)}, nil
+	}
+
+	apiSchema, err := mappers.InventoryFieldSchemaToApi(*schema)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetInventoryFieldSchema500JSONResponse{Message: fmt.Sprintf("failed to map inventory field schema: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetInventoryFieldSchema200JSONResponse(apiSchema), nil
+}
+
+// (PUT /api/v1/inventory-fields)
+func (h *ServiceHandler) SetInventoryFieldSchema(ctx context.Context, request server.SetInventoryFieldSchemaRequestObject) (server.SetInventoryFieldSchemaResponseObject, error) {
+	logger := log.NewDebugLogger("inventory_fields_handler").
+		WithContext(ctx).
+		Operation("set_inventory_field_schema").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SetInventoryFieldSchema400JSONResponse{Message: "empty body"}, nil
+	}
+
+	doc := mappers.InventoryFieldSchemaFromApi(*request.Body)
+
+	schema, err := h.assessmentSrv.SetInventoryFieldSchema(ctx, user.Organization, user.Username, doc)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SetInventoryFieldSchema400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetInventoryFieldSchema500JSONResponse{Message: fmt.Sprintf("failed to set inventory field schema: %v", err)}, nil
+		}
+	}
+
+	apiSchema, err := mappers.InventoryFieldSchemaToApi(*schema)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetInventoryFieldSchema500JSONResponse{Message: fmt.Sprintf("failed to map inventory field schema: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.SetInventoryFieldSchema200JSONResponse(apiSchema), nil
+}
@@ -2,13 +2,36 @@ package mappers
 
 import (
 	"encoding/json"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/kubev2v/migration-planner/api/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
+	"github.com/kubev2v/migration-planner/internal/store/model"
 	"github.com/kubev2v/migration-planner/internal/util"
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/changerate"
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+	"github.com/kubev2v/migration-planner/pkg/estimations/compliance"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/day2"
+	"github.com/kubev2v/migration-planner/pkg/estimations/defaults"
+	"github.com/kubev2v/migration-planner/pkg/estimations/display"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/guardrails"
+	"github.com/kubev2v/migration-planner/pkg/estimations/live"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+	"github.com/kubev2v/migration-planner/pkg/estimations/solver"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
 )
 
 // mapLabels converts API labels to map[string]string
@@ -191,3 +214,696 @@ func InventoryToForm(inventory v1alpha1.Inventory) mappers.InventoryForm {
 		Data: inventory,
 	}
 }
+
+// PlanFormToPlan converts the API plan form to a plan, parsing the step durations.
+func PlanFormToPlan(form v1alpha1.PlanForm) (plan.Plan, error) {
+	p := plan.Plan{
+		Name:  form.Name,
+		Start: form.Start,
+		Mode:  scheduling.ModeSerial,
+		Waves: make([]plan.Wave, 0, len(form.Waves)),
+	}
+	if form.Mode != nil {
+		p.Mode = scheduling.Mode(*form.Mode)
+	}
+	if form.Currency != nil {
+		p.Currency = *form.Currency
+	}
+	if form.ParamsCurrency != nil {
+		p.ParamsCurrency = *form.ParamsCurrency
+	}
+	if form.ExchangeRates != nil {
+		for _, r := range *form.ExchangeRates {
+			p.ExchangeRates = append(p.ExchangeRates, plan.ExchangeRate{From: r.From, Rate: r.Rate, AsOf: r.AsOf})
+		}
+	}
+	if form.Display != nil {
+		policy, err := PlanDisplayPolicyFromApi(*form.Display)
+		if err != nil {
+			return plan.Plan{}, err
+		}
+		p.Display = policy
+	}
+	if form.TransferRateMbps != nil {
+		p.TransferRateMbps = *form.TransferRateMbps
+	}
+	if form.Overlaps != nil {
+		for _, o := range *form.Overlaps {
+			p.Overlaps = append(p.Overlaps, plan.Overlap{Current: o.Current, Next: o.Next})
+		}
+	}
+	if form.Pools != nil {
+		p.Pools = *form.Pools
+	}
+	if form.CapacityChanges != nil {
+		p.CapacityChanges = CapacityChangesFromApi(*form.CapacityChanges)
+	}
+	if form.Calendars != nil {
+		p.Calendars = make(map[string]calendar.Calendar, len(*form.Calendars))
+		for pool, c := range *form.Calendars {
+			p.Calendars[pool] = PoolCalendarFromApi(c)
+		}
+	}
+	if form.Shifts != nil {
+		p.Shifts = make(map[string][]plan.Shift, len(*form.Shifts))
+		for pool, shifts := range *form.Shifts {
+			p.Shifts[pool] = ShiftsFromApi(shifts)
+		}
+	}
+	if form.Kpis != nil {
+		kpis := PlanKPIsFromApi(*form.Kpis)
+		p.KPIs = &kpis
+	}
+	if form.Namespaces != nil {
+		mapping := PlanNamespaceMappingFromApi(*form.Namespaces)
+		p.Namespaces = &mapping
+	}
+	if form.Sources != nil {
+		p.Sources = PlanSourcesFromApi(*form.Sources)
+	}
+	if form.Targets != nil {
+		p.Targets = PlanTargetsFromApi(*form.Targets)
+	}
+	if form.Placements != nil {
+		p.Placements = PlanPlacementsFromApi(*form.Placements)
+	}
+	if form.Milestones != nil {
+		for _, m := range *form.Milestones {
+			milestone := plan.Milestone{Name: m.Name, Date: m.Date}
+			if m.Note != nil {
+				milestone.Note = *m.Note
+			}
+			p.Milestones = append(p.Milestones, milestone)
+		}
+	}
+	if form.LearningCurve != nil {
+		p.LearningCurve = PlanLearningCurveFromApi(*form.LearningCurve)
+	}
+	if form.Contingency != nil {
+		policy, err := ContingencyPolicyFromApi(form.Contingency.Rules, form.Contingency.MinimumPercent)
+		if err != nil {
+			return plan.Plan{}, err
+		}
+		p.Contingency = &policy
+	}
+	if form.Gates != nil {
+		p.Gates = PlanGatesFromApi(*form.Gates)
+	}
+
+	for _, w := range form.Waves {
+		wave := plan.Wave{Name: w.Name, Steps: make([]plan.Step, 0, len(w.Steps))}
+		if w.Source != nil {
+			wave.Source = *w.Source
+		}
+		if w.Clusters != nil {
+			wave.Clusters = *w.Clusters
+		}
+		if w.Folders != nil {
+			wave.Folders = *w.Folders
+		}
+		if w.Milestones != nil {
+			wave.Milestones = *w.Milestones
+		}
+		if w.Vms != nil {
+			wave.VMs = *w.Vms
+		}
+		if w.DiskGb != nil {
+			wave.DiskGB = *w.DiskGb
+		}
+		if w.Members != nil {
+			for _, m := range *w.Members {
+				wave.Members = append(wave.Members, WaveVMFromApi(m))
+			}
+		}
+		for _, s := range w.Steps {
+			step := plan.Step{Phase: s.Phase}
+			effort, err := time.ParseDuration(s.Effort)
+			if err != nil {
+				return plan.Plan{}, fmt.Errorf("wave %q phase %q: invalid effort: %w", w.Name, s.Phase, err)
+			}
+			step.Effort = effort
+			if s.LeadTime != nil {
+				leadTime, err := time.ParseDuration(*s.LeadTime)
+				if err != nil {
+					return plan.Plan{}, fmt.Errorf("wave %q phase %q: invalid lead time: %w", w.Name, s.Phase, err)
+				}
+				step.LeadTime = leadTime
+			}
+			if s.WorkHoursPerDay != nil {
+				step.WorkHoursPerDay = *s.WorkHoursPerDay
+			}
+			if s.Pool != nil {
+				step.Pool = *s.Pool
+			}
+			if s.Units != nil {
+				step.Units = *s.Units
+			}
+			wave.Steps = append(wave.Steps, step)
+		}
+		p.Waves = append(p.Waves, wave)
+	}
+
+	return p, nil
+}
+
+func PlanSourcesFromApi(sources []v1alpha1.PlanSource) []plan.Source {
+	result := make([]plan.Source, 0, len(sources))
+	for _, s := range sources {
+		source := plan.Source{Name: s.Name, SourceID: s.SourceId}
+		if s.TransferRateMbps != nil {
+			source.TransferRateMbps = *s.TransferRateMbps
+		}
+		if s.TimeZone != nil {
+			source.TimeZone = *s.TimeZone
+		}
+		if s.MaintenanceWindows != nil {
+			for _, w := range *s.MaintenanceWindows {
+				window := plan.MaintenanceWindow{Name: w.Name, Start: w.Start, End: w.End}
+				if w.Days != nil {
+					window.Days = *w.Days
+				}
+				source.MaintenanceWindows = append(source.MaintenanceWindows, window)
+			}
+		}
+		if s.Blackouts != nil {
+			for _, b := range *s.Blackouts {
+				source.Blackouts = append(source.Blackouts, plan.Blackout{Name: b.Name, Start: b.Start.String(), End: b.End.String()})
+			}
+		}
+		result = append(result, source)
+	}
+	return result
+}
+
+func MilestoneSlipsFromApi(slips []v1alpha1.MilestoneSlip) []plan.MilestoneSlip {
+	result := make([]plan.MilestoneSlip, 0, len(slips))
+	for _, s := range slips {
+		result = append(result, plan.MilestoneSlip{Milestone: s.Milestone, Date: s.Date})
+	}
+	return result
+}
+
+func PlanTargetsFromApi(targets []v1alpha1.PlanTarget) []plan.Target {
+	result := make([]plan.Target, 0, len(targets))
+	for _, t := range targets {
+		target := plan.Target{Name: t.Name, Ready: t.Ready}
+		if t.Zone != nil {
+			target.Zone = *t.Zone
+		}
+		if t.CpuCores != nil {
+			target.CPUCores = *t.CpuCores
+		}
+		if t.MemoryGb != nil {
+			target.MemoryGB = *t.MemoryGb
+		}
+		if t.Milestones != nil {
+			target.Milestones = *t.Milestones
+		}
+		if t.Residency != nil {
+			target.Residency = *t.Residency
+		}
+		if t.Certifications != nil {
+			target.Certifications = complianceLabelsFromApi(*t.Certifications)
+		}
+		result = append(result, target)
+	}
+	return result
+}
+
+func PlanPlacementsFromApi(placements []v1alpha1.PlanPlacement) []plan.Placement {
+	result := make([]plan.Placement, 0, len(placements))
+	for _, pl := range placements {
+		placement := plan.Placement{Cluster: pl.Cluster, Target: pl.Target}
+		if pl.Source != nil {
+			placement.Source = *pl.Source
+		}
+		if pl.CpuCores != nil {
+			placement.CPUCores = *pl.CpuCores
+		}
+		if pl.MemoryGb != nil {
+			placement.MemoryGB = *pl.MemoryGb
+		}
+		if pl.Compliance != nil {
+			placement.Compliance = complianceLabelsFromApi(*pl.Compliance)
+		}
+		result = append(result, placement)
+	}
+	return result
+}
+
+func PlanDisplayPolicyFromApi(d v1alpha1.PlanDisplayPolicy) (*display.Policy, error) {
+	var policy display.Policy
+	if d.Granularity != nil {
+		policy.Granularity = display.Granularity(*d.Granularity)
+	}
+	if d.MinPhase != nil {
+		minPhase, err := time.ParseDuration(*d.MinPhase)
+		if err != nil {
+			return nil, fmt.Errorf("invalid minimum phase duration: %w", err)
+		}
+		policy.MinPhase = minPhase
+	}
+	if d.AbsorbSubDay != nil {
+		policy.AbsorbSubDay = *d.AbsorbSubDay
+	}
+	return &policy, nil
+}
+
+func PlanLearningCurveFromApi(lc v1alpha1.PlanLearningCurve) *plan.LearningCurve {
+	curve := plan.LearningCurve{Rate: lc.Rate}
+	if lc.Pilot != nil {
+		curve.Pilot = *lc.Pilot
+	}
+	if lc.Floor != nil {
+		curve.Floor = *lc.Floor
+	}
+	if lc.PilotFactors != nil {
+		curve.PilotFactors = *lc.PilotFactors
+	}
+	return &curve
+}
+
+func PlanGatesFromApi(gates []v1alpha1.PlanGate) []plan.Gate {
+	result := make([]plan.Gate, 0, len(gates))
+	for _, g := range gates {
+		gate := plan.Gate{Name: g.Name, Role: g.Role, After: planBoundaryFromApi(g.After), Before: planBoundaryFromApi(g.Before)}
+		if g.Approvers != nil {
+			gate.Approvers = *g.Approvers
+		}
+		result = append(result, gate)
+	}
+	return result
+}
+
+func planBoundaryFromApi(b v1alpha1.PlanBoundary) plan.Boundary {
+	boundary := plan.Boundary{Wave: b.Wave}
+	if b.Phase != nil {
+		boundary.Phase = *b.Phase
+	}
+	return boundary
+}
+
+func complianceLabelsFromApi(labels []v1alpha1.ComplianceLabel) []compliance.Label {
+	result := make([]compliance.Label, 0, len(labels))
+	for _, l := range labels {
+		result = append(result, compliance.Label(l))
+	}
+	return result
+}
+
+func PoolCalendarFromApi(c v1alpha1.PoolCalendar) calendar.Calendar {
+	result := calendar.Calendar{}
+	if c.Region != nil {
+		result.Region = calendar.Region(*c.Region)
+	}
+	if c.Holidays != nil {
+		for _, h := range *c.Holidays {
+			result.Holidays = append(result.Holidays, calendar.Holiday{Date: calendar.Day(h.Date.Time), Name: h.Name})
+		}
+	}
+	if c.Freezes != nil {
+		for _, f := range *c.Freezes {
+			result.Freezes = append(result.Freezes, calendar.Freeze{Start: calendar.Day(f.Start.Time), End: calendar.Day(f.End.Time), Name: f.Name})
+		}
+	}
+	return result
+}
+
+// EstimationScheduleFromApi converts the work calendar an estimation is landed on, filling in the
+// default working hours.
+func EstimationScheduleFromApi(s v1alpha1.EstimationSchedule) (service.EstimationSchedule, error) {
+	result := service.EstimationSchedule{Start: s.Start, Workday: calendar.DefaultWorkday}
+	if s.Calendar != nil {
+		result.Calendar = PoolCalendarFromApi(*s.Calendar)
+	}
+	if s.WorkdayStart != nil {
+		start, err := time.Parse("15:04", *s.WorkdayStart)
+		if err != nil {
+			return service.EstimationSchedule{}, fmt.Errorf("invalid workday start %q: expected HH:MM", *s.WorkdayStart)
+		}
+		result.Workday.Start = time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+	}
+	if s.WorkHoursPerDay != nil {
+		result.Workday.Hours = *s.WorkHoursPerDay
+	}
+	return result, nil
+}
+
+// CalculatorSpecsFromApi converts the calculators selected for an estimation run.
+func CalculatorSpecsFromApi(specs []v1alpha1.CalculatorSpec) []calculators.Spec {
+	result := make([]calculators.Spec, 0, len(specs))
+	for _, s := range specs {
+		spec := calculators.Spec{ID: s.Id}
+		if s.Disabled != nil {
+			spec.Disabled = *s.Disabled
+		}
+		if s.Config != nil {
+			spec.Config = calculators.Config(*s.Config)
+		}
+		result = append(result, spec)
+	}
+	return result
+}
+
+// EstimationParamsFromApi converts the params of an estimation run, sorted by key.
+func EstimationParamsFromApi(params map[string]interface{}) []estimation.Param {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]estimation.Param, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, estimation.Param{Key: key, Value: params[key]})
+	}
+	return result
+}
+
+// EstimationScenarioFromApi converts a scenario of a comparison, its params replacing the shared
+// ones, named after the fallback when it has no name.
+func EstimationScenarioFromApi(shared *map[string]interface{}, s v1alpha1.EstimationScenario, fallback string) service.EstimationScenario {
+	params := make(map[string]interface{}, len(s.Params))
+	if shared != nil {
+		maps.Copy(params, *shared)
+	}
+	maps.Copy(params, s.Params)
+
+	name := fallback
+	if s.Name != nil && *s.Name != "" {
+		name = *s.Name
+	}
+	return service.EstimationScenario{Name: name, Params: EstimationParamsFromApi(params)}
+}
+
+func ShiftsFromApi(shifts []v1alpha1.Shift) []plan.Shift {
+	result := make([]plan.Shift, 0, len(shifts))
+	for _, s := range shifts {
+		shift := plan.Shift{Name: s.Name, Start: s.Start, End: s.End}
+		if s.TimeZone != nil {
+			shift.TimeZone = *s.TimeZone
+		}
+		if s.Days != nil {
+			shift.Days = *s.Days
+		}
+		if s.Calendar != nil {
+			c := PoolCalendarFromApi(*s.Calendar)
+			shift.Calendar = &c
+		}
+		result = append(result, shift)
+	}
+	return result
+}
+
+func CapacityChangesFromApi(changes []v1alpha1.CapacityChange) []plan.CapacityChange {
+	result := make([]plan.CapacityChange, 0, len(changes))
+	for _, c := range changes {
+		change := plan.CapacityChange{Pool: c.Pool, At: c.At, Delta: c.Delta}
+		if c.Note != nil {
+			change.Note = *c.Note
+		}
+		result = append(result, change)
+	}
+	return result
+}
+
+func PlanKPIsFromApi(kpis v1alpha1.PlanKPIs) plan.KPIs {
+	return plan.KPIs{
+		MinVMsPerWeek:          kpis.MinVmsPerWeek,
+		MaxRollbackRatePercent: kpis.MaxRollbackRatePercent,
+		MaxP1IncidentsPerWave:  kpis.MaxP1IncidentsPerWave,
+	}
+}
+
+func PlanNamespaceMappingFromApi(m v1alpha1.PlanNamespaceMapping) plan.NamespaceMapping {
+	mapping := plan.NamespaceMapping{Strategy: plan.NamespaceStrategy(m.Strategy)}
+	if m.Tag != nil {
+		mapping.Tag = *m.Tag
+	}
+	if m.FolderDepth != nil {
+		mapping.FolderDepth = *m.FolderDepth
+	}
+	if m.Prefix != nil {
+		mapping.Prefix = *m.Prefix
+	}
+	if m.Default != nil {
+		mapping.Default = *m.Default
+	}
+	if m.Overrides != nil {
+		mapping.Overrides = *m.Overrides
+	}
+	return mapping
+}
+
+func WaveProgressFromApi(wp v1alpha1.WaveProgress) plan.WaveProgress {
+	return plan.WaveProgress{
+		Wave:        wp.Wave,
+		Start:       wp.Start,
+		End:         wp.End,
+		VMsMigrated: wp.VmsMigrated,
+		Rollbacks:   wp.Rollbacks,
+		P1Incidents: wp.P1Incidents,
+	}
+}
+
+func RateCardFormToRateCard(form v1alpha1.RateCardForm) cost.RateCard {
+	card := cost.RateCard{
+		Name:        form.Name,
+		Currency:    form.Currency,
+		ValidFrom:   form.ValidFrom,
+		ValidUntil:  form.ValidUntil,
+		HourlyRates: form.HourlyRates,
+	}
+	if form.Region != nil {
+		card.Region = *form.Region
+	}
+	return card
+}
+
+// ChangeRateJobFormToJob builds a sampling job starting at now. Omitted window and interval take the defaults.
+func ChangeRateJobFormToJob(form v1alpha1.ChangeRateJobForm, now time.Time) changerate.Job {
+	var window, interval time.Duration
+	if form.WindowHours != nil {
+		window = time.Duration(*form.WindowHours) * time.Hour
+	}
+	if form.IntervalMinutes != nil {
+		interval = time.Duration(*form.IntervalMinutes) * time.Minute
+	}
+	return changerate.NewJob(form.VmIds, window, interval, now)
+}
+
+func ChangeRateSamplesFromApi(samples []v1alpha1.ChangeRateSample) []changerate.Sample {
+	res := make([]changerate.Sample, 0, len(samples))
+	for _, s := range samples {
+		res = append(res, changerate.Sample{
+			VMID:          s.VmId,
+			At:            s.Timestamp,
+			ChangedBytes:  s.ChangedBytes,
+			CapacityBytes: s.CapacityBytes,
+		})
+	}
+	return res
+}
+
+func ChecklistTemplateFormToTemplate(form v1alpha1.ChecklistTemplateForm) checklist.Template {
+	template := checklist.Template{
+		Name:  form.Name,
+		Items: form.Items,
+	}
+	if form.Applications != nil {
+		template.Applications = *form.Applications
+	}
+	if form.OperatingSystems != nil {
+		template.OperatingSystems = *form.OperatingSystems
+	}
+	return template
+}
+
+func ChecklistVMsFromApi(vms []v1alpha1.ChecklistVM) []checklist.VM {
+	res := make([]checklist.VM, 0, len(vms))
+	for _, vm := range vms {
+		v := checklist.VM{ID: vm.Id, Name: vm.Name}
+		if vm.Application != nil {
+			v.Application = *vm.Application
+		}
+		if vm.Os != nil {
+			v.OS = *vm.Os
+		}
+		res = append(res, v)
+	}
+	return res
+}
+
+func WaveTrackingFromApi(tracking v1alpha1.WaveTracking) []live.Tracking {
+	res := make([]live.Tracking, 0, len(tracking.Vms))
+	for _, vm := range tracking.Vms {
+		t := live.Tracking{
+			VMID:      vm.Id,
+			Name:      vm.Name,
+			Started:   vm.Started,
+			Completed: vm.Completed,
+		}
+		if vm.Phase != nil {
+			t.Phase = *vm.Phase
+		}
+		if vm.Error != nil {
+			t.Error = *vm.Error
+		}
+		res = append(res, t)
+	}
+	return res
+}
+
+func VMPhaseActualsFromApi(actuals v1alpha1.VMPhaseActualList) model.VMPhaseActualList {
+	res := make(model.VMPhaseActualList, 0, len(actuals.Actuals))
+	for _, a := range actuals.Actuals {
+		actual := model.VMPhaseActual{
+			VMID:        a.VmId,
+			Phase:       a.Phase,
+			StartedAt:   a.StartedAt,
+			CompletedAt: a.CompletedAt,
+		}
+		if a.Wave != nil {
+			actual.Wave = *a.Wave
+		}
+		res = append(res, actual)
+	}
+	return res
+}
+
+func TroubleshootingModelFormToModel(form v1alpha1.TroubleshootingModelForm) calculators.LinearTroubleshootingModel {
+	m := calculators.LinearTroubleshootingModel{
+		ModelName:       form.Name,
+		Intercept:       form.Intercept,
+		MinutesPerDisk:  form.MinutesPerDisk,
+		SnapshotMinutes: form.SnapshotMinutes,
+	}
+	if form.OsMinutes != nil {
+		m.OSMinutes = *form.OsMinutes
+	}
+	if form.AppTagMinutes != nil {
+		m.AppTagMinutes = *form.AppTagMinutes
+	}
+	return m
+}
+
+// ContingencyPolicyFromApi converts the API contingency rules and minimum buffer, of a plan or an
+// organization, to a contingency policy, parsing the times per wave.
+func ContingencyPolicyFromApi(rules *[]v1alpha1.ContingencyRule, minimumPercent *float64) (contingency.Policy, error) {
+	var policy contingency.Policy
+	if minimumPercent != nil {
+		policy.MinimumPercent = *minimumPercent
+	}
+	if rules == nil {
+		return policy, nil
+	}
+	for _, r := range *rules {
+		rule := contingency.Rule{Name: r.Name}
+		if r.Phase != nil {
+			rule.Phase = *r.Phase
+		}
+		if r.Percent != nil {
+			rule.Percent = *r.Percent
+		}
+		if r.PerWave != nil {
+			perWave, err := time.ParseDuration(*r.PerWave)
+			if err != nil {
+				return contingency.Policy{}, fmt.Errorf("contingency rule %q: invalid time per wave: %w", r.Name, err)
+			}
+			rule.PerWave = perWave
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+	return policy, nil
+}
+
+func Day2TargetFromApi(t v1alpha1.Day2Target) day2.Target {
+	target := day2.Target{Cluster: t.Cluster, MonitoringIntegrated: t.MonitoringIntegrated}
+	if t.BackupSolution != nil {
+		target.BackupSolution = *t.BackupSolution
+	}
+	if t.Applications != nil {
+		for _, a := range *t.Applications {
+			target.Applications = append(target.Applications, day2.Application{Name: a.Name, DRRunbook: a.DrRunbook})
+		}
+	}
+	return target
+}
+
+// GuardrailPolicyFromApi converts the API guardrail policy form to the policy of an organization.
+func GuardrailPolicyFromApi(f v1alpha1.GuardrailPolicyForm) guardrails.Policy {
+	var policy guardrails.Policy
+	if f.TeamSize != nil {
+		policy.TeamSize = *f.TeamSize
+	}
+	if f.MaxWorkHoursPerDay != nil {
+		policy.MaxWorkHoursPerDay = *f.MaxWorkHoursPerDay
+	}
+	return policy
+}
+
+// CalculatorDefaultsFromApi converts the API calculator defaults form to the defaults of an organization.
+func CalculatorDefaultsFromApi(f v1alpha1.CalculatorDefaultsForm) defaults.Constants {
+	var constants defaults.Constants
+	if f.WorkHoursPerDay != nil {
+		constants.WorkHoursPerDay = *f.WorkHoursPerDay
+	}
+	if f.Engineers != nil {
+		constants.Engineers = *f.Engineers
+	}
+	if f.TransferRateMbps != nil {
+		constants.TransferRateMbps = *f.TransferRateMbps
+	}
+	return constants
+}
+
+// InventoryFieldSchemaFromApi converts the API inventory field schema form to the schema of an organization.
+func InventoryFieldSchemaFromApi(f v1alpha1.InventoryFieldSchemaForm) inventory.Schema {
+	schema := inventory.Schema{Fields: make([]inventory.Field, 0, len(f.Fields))}
+	for _, field := range f.Fields {
+		schema.Fields = append(schema.Fields, inventory.Field{
+			Name:        field.Name,
+			Type:        inventory.FieldType(field.Type),
+			Source:      inventory.FieldSource(field.Source),
+			Description: util.DerefString(field.Description),
+		})
+	}
+	return schema
+}
+
+// WaveVMFromApi converts a VM of a wave.
+func WaveVMFromApi(vm v1alpha1.PlanWaveVM) plan.WaveVM {
+	res := plan.WaveVM{ID: vm.Id}
+	if vm.Name != nil {
+		res.Name = *vm.Name
+	}
+	if vm.Application != nil {
+		res.Application = *vm.Application
+	}
+	if vm.Owner != nil {
+		res.Owner = *vm.Owner
+	}
+	if vm.CostCenter != nil {
+		res.CostCenter = *vm.CostCenter
+	}
+	if vm.Folder != nil {
+		res.Folder = *vm.Folder
+	}
+	if vm.DiskGb != nil {
+		res.DiskGB = *vm.DiskGb
+	}
+	return res
+}
+
+// EstimationSolveBoundsFromApi converts the params a deadline solver may adjust.
+func EstimationSolveBoundsFromApi(bounds []v1alpha1.EstimationSolveBound) []solver.Bound {
+	res := make([]solver.Bound, 0, len(bounds))
+	for _, b := range bounds {
+		bound := solver.Bound{Key: b.Param, Min: b.Min, Max: b.Max}
+		if b.Integer != nil {
+			bound.Integer = *b.Integer
+		}
+		res = append(res, bound)
+	}
+	return res
+}
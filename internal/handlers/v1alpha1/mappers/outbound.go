@@ -4,12 +4,34 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"time"
 
+	"github.com/google/uuid"
 	api "github.com/kubev2v/migration-planner/api/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
 	"github.com/kubev2v/migration-planner/internal/store/model"
 	"github.com/kubev2v/migration-planner/internal/util"
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/changerate"
+	"github.com/kubev2v/migration-planner/pkg/estimations/chargeback"
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+	"github.com/kubev2v/migration-planner/pkg/estimations/compliance"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/display"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/export"
+	"github.com/kubev2v/migration-planner/pkg/estimations/factory"
+	"github.com/kubev2v/migration-planner/pkg/estimations/forecast"
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/guardrails"
+	"github.com/kubev2v/migration-planner/pkg/estimations/live"
+	"github.com/kubev2v/migration-planner/pkg/estimations/namespaces"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/portfolio"
+	"github.com/kubev2v/migration-planner/pkg/estimations/solver"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // normalizeInventoryData ensures all nil maps and slices are initialized to empty ones
@@ -367,19 +389,1732 @@ func MigrationComplexityResultToAPI(result service.MigrationComplexityResult) ap
 	}
 }
 
+// EstimationDetailToAPI converts the estimation of a calculator to its API form.
+func EstimationDetailToAPI(e estimation.Estimation) api.EstimationDetail {
+	detail := api.EstimationDetail{
+		Duration: e.Duration.String(),
+		Reason:   e.Reason,
+	}
+	if e.LeadTime > 0 {
+		detail.LeadTime = util.ToStrPtr(e.LeadTime.String())
+	}
+	if x := e.Explanation; x != nil {
+		explanation := api.EstimationExplanation{
+			Formula:       x.Formula,
+			Inputs:        quantitiesToAPI(x.Inputs),
+			Intermediates: quantitiesToAPI(x.Intermediates),
+			Assumptions:   append([]string{}, x.Assumptions...),
+		}
+		detail.Explanation = &explanation
+	}
+	if e.Cost != nil {
+		cost := EstimationCostToAPI(*e.Cost)
+		detail.Cost = &cost
+	}
+	return detail
+}
+
+func EstimationCostToAPI(c estimation.Cost) api.EstimationCost {
+	return api.EstimationCost{
+		EngineerHours:  c.EngineerHours,
+		Labor:          c.Labor,
+		Infrastructure: c.Infrastructure,
+		Total:          c.Total(),
+	}
+}
+
+func quantitiesToAPI(quantities []estimation.Quantity) []api.EstimationQuantity {
+	res := make([]api.EstimationQuantity, 0, len(quantities))
+	for _, q := range quantities {
+		quantity := api.EstimationQuantity{Name: q.Name, Value: q.Value}
+		if q.Unit != "" {
+			quantity.Unit = util.ToStrPtr(string(q.Unit))
+		}
+		if q.Default {
+			quantity.Default = util.BoolPtr(true)
+		}
+		res = append(res, quantity)
+	}
+	return res
+}
+
+// EstimationRecordingToAPI converts the recording of an estimation to the free-form document returned
+// by the API, read back by ReadEstimationRecording.
+func EstimationRecordingToAPI(rec service.EstimationRecording) (map[string]interface{}, error) {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode estimation recording: %w", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode estimation recording: %w", err)
+	}
+	return doc, nil
+}
+
+// EstimationRunToAPI converts a run kept, with its breakdown and recording when detailed.
+func EstimationRunToAPI(run model.EstimationRun, detailed bool) (api.EstimationRun, error) {
+	rec, err := service.EstimationRunDocument(run)
+	if err != nil {
+		return api.EstimationRun{}, err
+	}
+	result := api.EstimationRun{
+		Id:            run.ID,
+		AssessmentId:  run.AssessmentID,
+		ClusterId:     run.ClusterID,
+		CreatedAt:     run.CreatedAt,
+		CreatedBy:     util.ToStrPtr(run.Username),
+		Calculators:   rec.Calculators,
+		TotalDuration: run.TotalDuration.String(),
+	}
+	if run.PlannerVersion != "" {
+		result.PlannerVersion = &run.PlannerVersion
+	}
+	if len(rec.Overrides) > 0 {
+		result.Overrides = &rec.Overrides
+	}
+	if !detailed {
+		return result, nil
+	}
+	breakdown := make(map[string]api.EstimationDetail, len(rec.Breakdown))
+	for name, est := range rec.Breakdown {
+		breakdown[name] = EstimationDetailToAPI(est)
+	}
+	result.Breakdown = &breakdown
+	doc, err := EstimationRecordingToAPI(rec)
+	if err != nil {
+		return api.EstimationRun{}, err
+	}
+	result.Recording = &doc
+	return result, nil
+}
+
+// EstimationRunsToAPI converts the runs kept, without their breakdown.
+func EstimationRunsToAPI(runs model.EstimationRunList) (api.EstimationRunList, error) {
+	result := make(api.EstimationRunList, 0, len(runs))
+	for _, run := range runs {
+		r, err := EstimationRunToAPI(run, false)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// EstimationRunComparisonToAPI converts the comparison of two runs.
+func EstimationRunComparisonToAPI(c service.EstimationRunComparison) (api.EstimationRunComparison, error) {
+	before, err := EstimationRunToAPI(c.Before, false)
+	if err != nil {
+		return api.EstimationRunComparison{}, err
+	}
+	after, err := EstimationRunToAPI(c.After, false)
+	if err != nil {
+		return api.EstimationRunComparison{}, err
+	}
+	differences := c.Differences
+	if differences == nil {
+		differences = []string{}
+	}
+	return api.EstimationRunComparison{
+		Before:              before,
+		After:               after,
+		TotalDurationChange: c.TotalDurationChange.String(),
+		Differences:         differences,
+	}, nil
+}
+
+// EstimationFreezeToAPI converts a freeze, with its breakdown and recording when detailed.
+func EstimationFreezeToAPI(f model.EstimationFreeze, detailed bool) (api.EstimationFreeze, error) {
+	rec, err := service.EstimationFreezeDocument(f)
+	if err != nil {
+		return api.EstimationFreeze{}, err
+	}
+	result := api.EstimationFreeze{
+		Id:            f.ID,
+		Name:          f.Name,
+		SowId:         f.SOWID,
+		AssessmentId:  f.AssessmentID,
+		ClusterId:     f.ClusterID,
+		PlanId:        f.PlanID,
+		CreatedAt:     f.CreatedAt,
+		CreatedBy:     util.ToStrPtr(f.Username),
+		Digest:        f.Digest,
+		TotalDuration: f.TotalDuration.String(),
+	}
+	if rec.PlannerVersion != "" {
+		result.PlannerVersion = &rec.PlannerVersion
+	}
+	if len(rec.Overrides) > 0 {
+		result.Overrides = &rec.Overrides
+	}
+	if !detailed {
+		return result, nil
+	}
+	breakdown := make(map[string]api.EstimationDetail, len(rec.Breakdown))
+	for name, est := range rec.Breakdown {
+		breakdown[name] = EstimationDetailToAPI(est)
+	}
+	result.Breakdown = &breakdown
+	doc, err := EstimationRecordingToAPI(rec)
+	if err != nil {
+		return api.EstimationFreeze{}, err
+	}
+	result.Recording = &doc
+	return result, nil
+}
+
+// EstimationFreezesToAPI converts freezes, without their breakdown.
+func EstimationFreezesToAPI(freezes model.EstimationFreezeList) (api.EstimationFreezeList, error) {
+	result := make(api.EstimationFreezeList, 0, len(freezes))
+	for _, f := range freezes {
+		r, err := EstimationFreezeToAPI(f, false)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// EstimationFreezeDiffToAPI converts the difference between the current and a frozen estimation.
+func EstimationFreezeDiffToAPI(d service.EstimationFreezeDiff) (api.EstimationFreezeDiff, error) {
+	freeze, err := EstimationFreezeToAPI(d.Freeze, false)
+	if err != nil {
+		return api.EstimationFreezeDiff{}, err
+	}
+	differences := d.Differences
+	if differences == nil {
+		differences = []string{}
+	}
+	return api.EstimationFreezeDiff{
+		Freeze:              freeze,
+		Current:             MigrationEstimationResultToAPI(*d.Current),
+		TotalDurationChange: d.TotalDurationChange.String(),
+		Differences:         differences,
+	}, nil
+}
+
+// EstimationJobToAPI converts an estimation job, with its result once completed.
+func EstimationJobToAPI(job service.EstimationJob) api.EstimationJob {
+	result := api.EstimationJob{
+		Id:       job.Job.Id,
+		Status:   job.Job.Status,
+		Error:    job.Job.Error,
+		Progress: api.EstimationProgress{Completed: job.Completed, Total: job.Total},
+	}
+	if job.RequestID != "" {
+		result.RequestId = &job.RequestID
+	}
+	if job.Result != nil {
+		response := MigrationEstimationResultToAPI(*job.Result)
+		result.Result = &response
+	}
+	return result
+}
+
 // MigrationEstimationResultToAPI converts service MigrationAssessmentResult to API response
 func MigrationEstimationResultToAPI(result service.MigrationAssessmentResult) api.MigrationEstimationResponse {
 	breakdown := make(map[string]api.EstimationDetail)
 
-	for name, estimation := range result.Breakdown {
-		breakdown[name] = api.EstimationDetail{
-			Duration: estimation.Duration.String(),
-			Reason:   estimation.Reason,
+	for name, est := range result.Breakdown {
+		detail := EstimationDetailToAPI(est)
+		if span, ok := result.Dates[name]; ok {
+			detail.Start = &span.Start
+			detail.End = &span.End
 		}
+		breakdown[name] = detail
 	}
 
-	return api.MigrationEstimationResponse{
+	response := api.MigrationEstimationResponse{
 		TotalDuration: result.TotalDuration.String(),
 		Breakdown:     breakdown,
 	}
+	if len(result.Alternatives) > 0 {
+		alternatives := make(map[string]api.EstimationDetail, len(result.Alternatives))
+		for name, est := range result.Alternatives {
+			alternatives[name] = EstimationDetailToAPI(est)
+		}
+		response.Alternatives = &alternatives
+	}
+	if b := result.Benchmark; b != nil {
+		response.Benchmark = &api.EstimationBenchmark{
+			VmBand:              b.Cohort.VMBand,
+			Network:             b.Cohort.Network,
+			Samples:             b.Samples,
+			AverageWeeks:        b.ActualWeeks,
+			AveragePlannedWeeks: b.PlannedWeeks,
+			Summary:             b.String(),
+		}
+	}
+	if r := result.Day2; r != nil {
+		gaps := make([]api.Day2Gap, 0, len(r.Gaps))
+		for _, g := range r.Gaps {
+			gaps = append(gaps, api.Day2Gap{Kind: api.Day2GapKind(g.Kind), Subject: g.Subject, Message: g.Message})
+		}
+		response.Day2Readiness = &api.Day2Readiness{Gaps: gaps, Estimation: EstimationDetailToAPI(r.Estimation)}
+	}
+	if len(result.Warnings) > 0 {
+		warnings := make([]api.EstimationWarning, 0, len(result.Warnings))
+		for _, w := range result.Warnings {
+			warnings = append(warnings, api.EstimationWarning{Param: w.Param, Value: w.Value, Message: w.Message})
+		}
+		response.Warnings = &warnings
+	}
+	if result.Cost != nil {
+		cost := EstimationCostToAPI(*result.Cost)
+		response.Cost = &cost
+	}
+	if result.Currency != "" {
+		response.Currency = util.ToStrPtr(result.Currency)
+	}
+	if rate := result.ExchangeRate; rate != nil {
+		apiRate := exchangeRateToApi(*rate)
+		response.ExchangeRate = &apiRate
+	}
+	if len(result.Lineage) > 0 {
+		lineage := ParamLineageToAPI(result.Lineage)
+		response.ParamLineage = &lineage
+	}
+	if len(result.Annotations) > 0 {
+		annotations := EstimationAnnotationsToAPI(result.Annotations)
+		response.Annotations = &annotations
+	}
+	return response
+}
+
+// EstimationAnnotationsToAPI converts the annotations of the params an estimation did not use as given.
+func EstimationAnnotationsToAPI(annotations []estimation.Annotation) []api.EstimationAnnotation {
+	res := make([]api.EstimationAnnotation, 0, len(annotations))
+	for _, a := range annotations {
+		annotation := api.EstimationAnnotation{
+			Param:   a.Param,
+			Kind:    api.EstimationAnnotationKind(a.Kind),
+			Value:   a.Value,
+			Applied: a.Applied,
+			Message: a.Message,
+		}
+		if a.Calculator != "" {
+			annotation.Calculator = &a.Calculator
+		}
+		res = append(res, annotation)
+	}
+	return res
+}
+
+func ParamLineageToAPI(lineage []estimation.Lineage) []api.ParamLineage {
+	res := make([]api.ParamLineage, 0, len(lineage))
+	for _, l := range lineage {
+		chain := make([]api.ParamLayerValue, 0, len(l.Chain))
+		for _, v := range l.Chain {
+			chain = append(chain, api.ParamLayerValue{Layer: api.ParamLayer(v.Layer), Value: v.Value})
+		}
+		res = append(res, api.ParamLineage{Param: l.Key, Value: l.Value, Layer: api.ParamLayer(l.Layer), Chain: chain})
+	}
+	return res
+}
+
+// EstimationComparisonToAPI converts the comparison of two scenarios to its API response
+func EstimationComparisonToAPI(c service.ScenarioComparison) api.EstimationComparison {
+	scenario := func(s service.EstimationScenario, result *service.MigrationAssessmentResult) api.EstimationScenarioResult {
+		res := api.EstimationScenarioResult{Name: s.Name, TotalDuration: result.TotalDuration.String()}
+		if result.Cost != nil {
+			cost := EstimationCostToAPI(*result.Cost)
+			res.Cost = &cost
+		}
+		if len(result.Lineage) > 0 {
+			lineage := ParamLineageToAPI(result.Lineage)
+			res.ParamLineage = &lineage
+		}
+		return res
+	}
+
+	deltas := make([]api.EstimationDelta, 0, len(c.Deltas))
+	for _, d := range c.Deltas {
+		delta := api.EstimationDelta{
+			Calculator:    d.Calculator,
+			Change:        d.Change.String(),
+			PercentChange: d.PercentChange(),
+		}
+		if d.A != nil {
+			detail := EstimationDetailToAPI(*d.A)
+			delta.A = &detail
+		}
+		if d.B != nil {
+			detail := EstimationDetailToAPI(*d.B)
+			delta.B = &detail
+		}
+		deltas = append(deltas, delta)
+	}
+
+	return api.EstimationComparison{
+		A:             scenario(c.A, c.ResultA),
+		B:             scenario(c.B, c.ResultB),
+		Calculators:   deltas,
+		TotalChange:   c.Change.String(),
+		PercentChange: c.PercentChange(),
+	}
+}
+
+func PlanToApi(p model.Plan) (api.Plan, error) {
+	doc, err := service.PlanDocument(p)
+	if err != nil {
+		return api.Plan{}, err
+	}
+
+	digest, err := doc.Digest()
+	if err != nil {
+		return api.Plan{}, err
+	}
+
+	apiPlan := api.Plan{
+		Id:            p.ID,
+		Name:          p.Name,
+		ContentDigest: &digest,
+		CreatedAt:     p.CreatedAt,
+		Start:         doc.Start,
+		Mode:          api.PlanMode(doc.Mode),
+		Waves:         make([]api.PlanWave, 0, len(doc.Waves)),
+	}
+	if doc.Currency != "" {
+		apiPlan.Currency = util.ToStrPtr(doc.Currency)
+	}
+	if doc.ParamsCurrency != "" {
+		apiPlan.ParamsCurrency = util.ToStrPtr(doc.ParamsCurrency)
+	}
+	if len(doc.ExchangeRates) > 0 {
+		rates := make([]api.PlanExchangeRate, 0, len(doc.ExchangeRates))
+		for _, r := range doc.ExchangeRates {
+			rates = append(rates, api.PlanExchangeRate{From: r.From, Rate: r.Rate, AsOf: r.AsOf})
+		}
+		apiPlan.ExchangeRates = &rates
+	}
+	if d := doc.Display; d != nil {
+		policy := api.PlanDisplayPolicy{}
+		if d.Granularity != display.GranularityNone {
+			granularity := api.DisplayGranularity(d.Granularity)
+			policy.Granularity = &granularity
+		}
+		if d.MinPhase > 0 {
+			policy.MinPhase = util.ToStrPtr(d.MinPhase.String())
+		}
+		if d.AbsorbSubDay {
+			policy.AbsorbSubDay = util.BoolPtr(true)
+		}
+		apiPlan.Display = &policy
+	}
+	if doc.TransferRateMbps > 0 {
+		apiPlan.TransferRateMbps = util.FloatPtr(doc.TransferRateMbps)
+	}
+	if len(doc.Overlaps) > 0 {
+		overlaps := make([]api.PlanOverlap, 0, len(doc.Overlaps))
+		for _, o := range doc.Overlaps {
+			overlaps = append(overlaps, api.PlanOverlap{Current: o.Current, Next: o.Next})
+		}
+		apiPlan.Overlaps = &overlaps
+	}
+	if len(doc.Pools) > 0 {
+		pools := doc.Pools
+		apiPlan.Pools = &pools
+	}
+	if len(doc.CapacityChanges) > 0 {
+		changes := make([]api.CapacityChange, 0, len(doc.CapacityChanges))
+		for _, c := range doc.CapacityChanges {
+			change := api.CapacityChange{Pool: c.Pool, At: c.At, Delta: c.Delta}
+			if c.Note != "" {
+				change.Note = util.ToStrPtr(c.Note)
+			}
+			changes = append(changes, change)
+		}
+		apiPlan.CapacityChanges = &changes
+	}
+	if len(doc.Calendars) > 0 {
+		calendars := make(map[string]api.PoolCalendar, len(doc.Calendars))
+		for pool, c := range doc.Calendars {
+			calendars[pool] = poolCalendarToApi(c)
+		}
+		apiPlan.Calendars = &calendars
+	}
+	if len(doc.Shifts) > 0 {
+		shifts := make(map[string][]api.Shift, len(doc.Shifts))
+		for pool, poolShifts := range doc.Shifts {
+			apiShifts := make([]api.Shift, 0, len(poolShifts))
+			for _, s := range poolShifts {
+				shift := api.Shift{Name: s.Name, Start: s.Start, End: s.End}
+				if s.TimeZone != "" {
+					shift.TimeZone = util.ToStrPtr(s.TimeZone)
+				}
+				if len(s.Days) > 0 {
+					days := s.Days
+					shift.Days = &days
+				}
+				if s.Calendar != nil {
+					c := poolCalendarToApi(*s.Calendar)
+					shift.Calendar = &c
+				}
+				apiShifts = append(apiShifts, shift)
+			}
+			shifts[pool] = apiShifts
+		}
+		apiPlan.Shifts = &shifts
+	}
+
+	if len(doc.Sources) > 0 {
+		sources := make([]api.PlanSource, 0, len(doc.Sources))
+		for _, src := range doc.Sources {
+			source := api.PlanSource{Name: src.Name, SourceId: src.SourceID}
+			if src.TransferRateMbps > 0 {
+				source.TransferRateMbps = util.FloatPtr(src.TransferRateMbps)
+			}
+			if src.TimeZone != "" {
+				source.TimeZone = util.ToStrPtr(src.TimeZone)
+			}
+			if len(src.MaintenanceWindows) > 0 {
+				windows := make([]api.PlanMaintenanceWindow, 0, len(src.MaintenanceWindows))
+				for _, w := range src.MaintenanceWindows {
+					window := api.PlanMaintenanceWindow{Name: w.Name, Start: w.Start, End: w.End}
+					if len(w.Days) > 0 {
+						days := append([]string{}, w.Days...)
+						window.Days = &days
+					}
+					windows = append(windows, window)
+				}
+				source.MaintenanceWindows = &windows
+			}
+			if len(src.Blackouts) > 0 {
+				blackouts := make([]api.PlanBlackout, 0, len(src.Blackouts))
+				for _, b := range src.Blackouts {
+					// validated when the plan was stored
+					start, _ := time.Parse(time.DateOnly, b.Start)
+					end, _ := time.Parse(time.DateOnly, b.End)
+					blackouts = append(blackouts, api.PlanBlackout{Name: b.Name, Start: openapi_types.Date{Time: start}, End: openapi_types.Date{Time: end}})
+				}
+				source.Blackouts = &blackouts
+			}
+			sources = append(sources, source)
+		}
+		apiPlan.Sources = &sources
+	}
+	if len(doc.Targets) > 0 {
+		targets := make([]api.PlanTarget, 0, len(doc.Targets))
+		for _, t := range doc.Targets {
+			target := api.PlanTarget{Name: t.Name, Ready: t.Ready}
+			if t.Zone != "" {
+				target.Zone = util.ToStrPtr(t.Zone)
+			}
+			if t.CPUCores > 0 {
+				target.CpuCores = util.IntPtr(t.CPUCores)
+			}
+			if t.MemoryGB > 0 {
+				target.MemoryGb = util.IntPtr(t.MemoryGB)
+			}
+			if len(t.Milestones) > 0 {
+				milestones := t.Milestones
+				target.Milestones = &milestones
+			}
+			if t.Residency != "" {
+				target.Residency = util.ToStrPtr(t.Residency)
+			}
+			if len(t.Certifications) > 0 {
+				certifications := complianceLabelsToApi(t.Certifications)
+				target.Certifications = &certifications
+			}
+			targets = append(targets, target)
+		}
+		apiPlan.Targets = &targets
+	}
+	if len(doc.Placements) > 0 {
+		placements := make([]api.PlanPlacement, 0, len(doc.Placements))
+		for _, pl := range doc.Placements {
+			placement := api.PlanPlacement{Cluster: pl.Cluster, Target: pl.Target}
+			if pl.Source != "" {
+				placement.Source = util.ToStrPtr(pl.Source)
+			}
+			if pl.CPUCores > 0 {
+				placement.CpuCores = util.IntPtr(pl.CPUCores)
+			}
+			if pl.MemoryGB > 0 {
+				placement.MemoryGb = util.IntPtr(pl.MemoryGB)
+			}
+			if len(pl.Compliance) > 0 {
+				labels := complianceLabelsToApi(pl.Compliance)
+				placement.Compliance = &labels
+			}
+			placements = append(placements, placement)
+		}
+		apiPlan.Placements = &placements
+	}
+	if len(doc.Milestones) > 0 {
+		milestones := make([]api.PlanMilestone, 0, len(doc.Milestones))
+		for _, m := range doc.Milestones {
+			milestone := api.PlanMilestone{Name: m.Name, Date: m.Date}
+			if m.Note != "" {
+				milestone.Note = util.ToStrPtr(m.Note)
+			}
+			milestones = append(milestones, milestone)
+		}
+		apiPlan.Milestones = &milestones
+	}
+
+	for _, w := range doc.Waves {
+		wave := api.PlanWave{Name: w.Name, Steps: make([]api.PlanStep, 0, len(w.Steps))}
+		if w.Source != "" {
+			wave.Source = util.ToStrPtr(w.Source)
+		}
+		if len(w.Clusters) > 0 {
+			clusters := w.Clusters
+			wave.Clusters = &clusters
+		}
+		if len(w.Folders) > 0 {
+			folders := w.Folders
+			wave.Folders = &folders
+		}
+		if len(w.Milestones) > 0 {
+			milestones := w.Milestones
+			wave.Milestones = &milestones
+		}
+		if w.VMs > 0 {
+			wave.Vms = util.IntPtr(w.VMs)
+		}
+		if w.DiskGB > 0 {
+			wave.DiskGb = util.FloatPtr(w.DiskGB)
+		}
+		if len(w.Members) > 0 {
+			members := make([]api.PlanWaveVM, 0, len(w.Members))
+			for _, m := range w.Members {
+				members = append(members, WaveVMToApi(m))
+			}
+			wave.Members = &members
+		}
+		for _, s := range w.Steps {
+			step := api.PlanStep{Phase: s.Phase, Effort: s.Effort.String()}
+			if s.LeadTime > 0 {
+				step.LeadTime = util.ToStrPtr(s.LeadTime.String())
+			}
+			if s.WorkHoursPerDay > 0 {
+				hours := s.WorkHoursPerDay
+				step.WorkHoursPerDay = &hours
+			}
+			if s.Pool != "" {
+				step.Pool = util.ToStrPtr(s.Pool)
+			}
+			if s.Units > 0 {
+				units := s.Units
+				step.Units = &units
+			}
+			wave.Steps = append(wave.Steps, step)
+		}
+		apiPlan.Waves = append(apiPlan.Waves, wave)
+	}
+	if lc := doc.LearningCurve; lc != nil {
+		curve := api.PlanLearningCurve{Rate: lc.Rate}
+		if lc.Pilot != "" {
+			curve.Pilot = util.ToStrPtr(lc.Pilot)
+		}
+		if lc.Floor > 0 {
+			curve.Floor = util.FloatPtr(lc.Floor)
+		}
+		if len(lc.PilotFactors) > 0 {
+			factors := lc.PilotFactors
+			curve.PilotFactors = &factors
+		}
+		apiPlan.LearningCurve = &curve
+	}
+	if c := doc.Contingency; c != nil {
+		rules := contingencyRulesToApi(c.Rules)
+		apiPlan.Contingency = &api.PlanContingency{Rules: &rules}
+		if c.MinimumPercent > 0 {
+			apiPlan.Contingency.MinimumPercent = util.FloatPtr(c.MinimumPercent)
+		}
+	}
+	if len(doc.Schedule) > 0 {
+		schedule := make([]api.ScheduledPhase, 0, len(doc.Schedule))
+		for _, sp := range doc.Schedule {
+			schedule = append(schedule, api.ScheduledPhase{Wave: sp.Wave, Phase: sp.Phase, Start: sp.Start, End: sp.End})
+		}
+		apiPlan.Schedule = &schedule
+	}
+	if len(doc.Gates) > 0 {
+		gates := make([]api.PlanGate, 0, len(doc.Gates))
+		for _, g := range doc.Gates {
+			gate := api.PlanGate{Name: g.Name, Role: g.Role, After: planBoundaryToApi(g.After), Before: planBoundaryToApi(g.Before)}
+			if len(g.Approvers) > 0 {
+				approvers := g.Approvers
+				gate.Approvers = &approvers
+			}
+			gates = append(gates, gate)
+		}
+		apiPlan.Gates = &gates
+	}
+	if len(doc.Approvals) > 0 {
+		approvals := make([]api.PlanApproval, 0, len(doc.Approvals))
+		for _, a := range doc.Approvals {
+			approval := api.PlanApproval{Gate: a.Gate, Role: a.Role, ApprovedBy: a.ApprovedBy, ApprovedAt: a.ApprovedAt}
+			if a.Comment != "" {
+				approval.Comment = util.ToStrPtr(a.Comment)
+			}
+			approvals = append(approvals, approval)
+		}
+		apiPlan.Approvals = &approvals
+	}
+	if doc.KPIs != nil {
+		apiPlan.Kpis = &api.PlanKPIs{
+			MinVmsPerWeek:          doc.KPIs.MinVMsPerWeek,
+			MaxRollbackRatePercent: doc.KPIs.MaxRollbackRatePercent,
+			MaxP1IncidentsPerWave:  doc.KPIs.MaxP1IncidentsPerWave,
+		}
+	}
+	if doc.Namespaces != nil {
+		mapping := planNamespaceMappingToApi(*doc.Namespaces)
+		apiPlan.Namespaces = &mapping
+	}
+
+	return apiPlan, nil
+}
+
+func planBoundaryToApi(b plan.Boundary) api.PlanBoundary {
+	boundary := api.PlanBoundary{Wave: b.Wave}
+	if b.Phase != "" {
+		boundary.Phase = util.ToStrPtr(b.Phase)
+	}
+	return boundary
+}
+
+func complianceLabelsToApi(labels []compliance.Label) []api.ComplianceLabel {
+	result := make([]api.ComplianceLabel, 0, len(labels))
+	for _, l := range labels {
+		result = append(result, api.ComplianceLabel(l))
+	}
+	return result
+}
+
+func PlanListToApi(plans []model.Plan) (api.PlanList, error) {
+	planList := make([]api.Plan, len(plans))
+	for i, p := range plans {
+		apiPlan, err := PlanToApi(p)
+		if err != nil {
+			return api.PlanList{}, err
+		}
+		planList[i] = apiPlan
+	}
+	return planList, nil
+}
+
+// ScheduleImportToApi converts an imported plan and the discrepancies of its schedule to their API representation
+func ScheduleImportToApi(p model.Plan, discrepancies []export.Discrepancy) (api.ScheduleImport, error) {
+	apiPlan, err := PlanToApi(p)
+	if err != nil {
+		return api.ScheduleImport{}, err
+	}
+
+	result := api.ScheduleImport{Plan: apiPlan, Discrepancies: make([]api.ScheduleDiscrepancy, 0, len(discrepancies))}
+	for _, d := range discrepancies {
+		result.Discrepancies = append(result.Discrepancies, api.ScheduleDiscrepancy{
+			Wave:    d.Wave,
+			Phase:   d.Phase,
+			Kind:    api.ScheduleDiscrepancyKind(d.Kind),
+			Message: d.Message,
+		})
+	}
+	return result, nil
+}
+
+// PlanProgressToApi converts the actuals recorded on a plan, the KPI statuses measured from them and the
+// completion of its VM validation checklists to their API representation
+func PlanProgressToApi(p model.Plan, statuses []plan.KPIStatus, checklists []checklist.WaveProgress) (api.PlanProgress, error) {
+	doc, err := service.PlanDocument(p)
+	if err != nil {
+		return api.PlanProgress{}, err
+	}
+
+	progress := api.PlanProgress{
+		Waves: make([]api.WaveProgress, 0, len(doc.Progress)),
+		Kpis:  make([]api.KPIStatus, 0, len(statuses)),
+	}
+	for _, wp := range doc.Progress {
+		progress.Waves = append(progress.Waves, api.WaveProgress{
+			Wave:        wp.Wave,
+			Start:       wp.Start,
+			End:         wp.End,
+			VmsMigrated: wp.VMsMigrated,
+			Rollbacks:   wp.Rollbacks,
+			P1Incidents: wp.P1Incidents,
+		})
+	}
+	for _, s := range statuses {
+		status := api.KPIStatus{
+			Kpi:      api.KPIStatusKpi(s.KPI),
+			Target:   s.Target,
+			Actual:   s.Actual,
+			Breached: s.Breached,
+			Message:  s.Message,
+		}
+		if s.Wave != "" {
+			status.Wave = util.ToStrPtr(s.Wave)
+		}
+		progress.Kpis = append(progress.Kpis, status)
+	}
+	if len(checklists) > 0 {
+		waves := make([]api.WaveChecklistProgress, 0, len(checklists))
+		for _, c := range checklists {
+			waves = append(waves, api.WaveChecklistProgress{
+				Wave:      c.Wave,
+				Vms:       c.VMs,
+				VmsDone:   c.VMsDone,
+				Items:     c.Items,
+				ItemsDone: c.ItemsDone,
+			})
+		}
+		progress.Checklists = &waves
+	}
+	return progress, nil
+}
+
+// PlanWhatIfToApi compares the chart of a staffing scenario with the chart of the plan as it is,
+// the delays formatted under the display policy of the plan
+func PlanWhatIfToApi(baseline, scenario gantt.Chart, impacts []plan.MilestoneImpact, policy display.Policy) api.PlanWhatIf {
+	result := api.PlanWhatIf{
+		BaselineEnd: baseline.End,
+		End:         scenario.End,
+		Delay:       policy.Format(scenario.End.Sub(baseline.End)),
+		Gantt:       GanttToApi(scenario, nil),
+	}
+	if len(impacts) > 0 {
+		apiImpacts := make([]api.MilestoneImpact, 0, len(impacts))
+		for _, i := range impacts {
+			waves := append([]string{}, i.Waves...)
+			apiImpacts = append(apiImpacts, api.MilestoneImpact{
+				Milestone: i.Milestone,
+				From:      i.From,
+				To:        i.To,
+				Waves:     waves,
+				Delay:     policy.Format(i.Delay),
+			})
+		}
+		result.MilestoneImpacts = &apiImpacts
+	}
+	return result
+}
+
+func poolCalendarToApi(c calendar.Calendar) api.PoolCalendar {
+	apiCalendar := api.PoolCalendar{}
+	if c.Region != "" {
+		region := api.HolidayRegion(c.Region)
+		apiCalendar.Region = &region
+	}
+	if len(c.Holidays) > 0 {
+		holidays := make([]api.Holiday, 0, len(c.Holidays))
+		for _, h := range c.Holidays {
+			holidays = append(holidays, api.Holiday{Date: openapi_types.Date{Time: h.Date}, Name: h.Name})
+		}
+		apiCalendar.Holidays = &holidays
+	}
+	if len(c.Freezes) > 0 {
+		freezes := make([]api.ChangeFreeze, 0, len(c.Freezes))
+		for _, f := range c.Freezes {
+			freezes = append(freezes, api.ChangeFreeze{Start: openapi_types.Date{Time: f.Start}, End: openapi_types.Date{Time: f.End}, Name: f.Name})
+		}
+		apiCalendar.Freezes = &freezes
+	}
+	return apiCalendar
+}
+
+// PoolCoverageToApi converts the weekly coverage of the pools worked in shifts to its API representation
+func PoolCoverageToApi(coverage []plan.PoolCoverage) []api.PoolCoverage {
+	result := make([]api.PoolCoverage, 0, len(coverage))
+	for _, c := range coverage {
+		apiCoverage := api.PoolCoverage{Pool: c.Pool, Shifts: c.Shifts, Gaps: make([]api.CoverageGap, 0, len(c.Gaps))}
+		for _, g := range c.Gaps {
+			apiCoverage.Gaps = append(apiCoverage.Gaps, api.CoverageGap{Day: g.Day.String(), From: clock(g.From), To: clock(g.To)})
+		}
+		result = append(result, apiCoverage)
+	}
+	return result
+}
+
+// clock formats an offset from midnight as a time of day, 24:00 being the end of the day.
+func clock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// GanttToApi converts a Gantt chart to its API representation. The bars and cutover windows of
+// the sources with a site are rendered in the local time of the site, and in the time zone of the
+// viewer when given.
+func GanttToApi(c gantt.Chart, viewer *time.Location) api.Gantt {
+	g := api.Gantt{
+		Start:        c.Start,
+		End:          c.End,
+		Today:        c.Today,
+		Waves:        make([]api.GanttWave, 0, len(c.Waves)),
+		Bars:         make([]api.GanttBar, 0, len(c.Bars)),
+		Dependencies: make([]api.GanttDependency, 0, len(c.Dependencies)),
+	}
+	if viewer != nil {
+		g.TimeZone = util.ToStrPtr(viewer.String())
+	}
+	for _, w := range c.Waves {
+		wave := api.GanttWave{Name: w.Name, Start: w.Start, End: w.End}
+		if w.Source != "" {
+			wave.Source = util.ToStrPtr(w.Source)
+		}
+		g.Waves = append(g.Waves, wave)
+	}
+	if len(c.Sources) > 0 {
+		sources := make([]api.GanttSource, 0, len(c.Sources))
+		for _, src := range c.Sources {
+			source := api.GanttSource{Name: src.Name, Start: src.Start, End: src.End, Waves: src.Waves}
+			for _, site := range c.Sites {
+				if site.Source != src.Name {
+					continue
+				}
+				source.TimeZone = util.ToStrPtr(site.Location.String())
+				windows := make([]api.CutoverWindow, 0, len(site.Windows))
+				for _, w := range site.Windows {
+					windows = append(windows, api.CutoverWindow{
+						Name:  w.Name,
+						Start: w.Start.In(site.Location),
+						End:   w.End.In(site.Location),
+						Local: localSpan(w.Start, w.End, viewer),
+					})
+				}
+				source.CutoverWindows = &windows
+			}
+			sources = append(sources, source)
+		}
+		g.Sources = &sources
+	}
+	if len(c.Milestones) > 0 {
+		milestones := make([]api.PlanMilestone, 0, len(c.Milestones))
+		for _, m := range c.Milestones {
+			milestones = append(milestones, api.PlanMilestone{Name: m.Name, Date: m.At})
+		}
+		g.Milestones = &milestones
+	}
+	for _, b := range c.Bars {
+		bar := api.GanttBar{Wave: b.Wave, Phase: b.Phase, Start: b.Start, End: b.End, Released: b.Released}
+		if b.Pool != "" {
+			bar.Pool = util.ToStrPtr(b.Pool)
+			units := b.Units
+			bar.Units = &units
+		}
+		if len(b.Shifts) > 0 {
+			shifts := make([]api.ShiftWork, 0, len(b.Shifts))
+			for _, w := range b.Shifts {
+				shifts = append(shifts, api.ShiftWork{Shift: w.Shift, Hours: w.Work.Hours()})
+			}
+			bar.Shifts = &shifts
+		}
+		bar.Site = localSpan(b.Start, b.End, c.Location(b.Source))
+		bar.Local = localSpan(b.Start, b.End, viewer)
+		g.Bars = append(g.Bars, bar)
+	}
+	for _, d := range c.Dependencies {
+		g.Dependencies = append(g.Dependencies, api.GanttDependency{
+			From: api.GanttBarRef{Wave: d.From.Wave, Phase: d.From.Phase},
+			To:   api.GanttBarRef{Wave: d.To.Wave, Phase: d.To.Phase},
+		})
+	}
+	return g
+}
+
+// localSpan renders the span in the time zone, nil when there is none.
+func localSpan(start, end time.Time, loc *time.Location) *api.LocalSpan {
+	if loc == nil {
+		return nil
+	}
+	return &api.LocalSpan{Start: start.In(loc), End: end.In(loc)}
+}
+
+// EventPageToApi converts a page of events replayed after a sequence number to its API representation
+func EventPageToApi(events model.EventList, after int64) (api.EventPage, error) {
+	page := api.EventPage{Events: make([]api.Event, 0, len(events)), Next: after}
+	for _, e := range events {
+		payload := map[string]interface{}{}
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			return api.EventPage{}, fmt.Errorf("failed to decode event %d: %w", e.Seq, err)
+		}
+		page.Events = append(page.Events, api.Event{
+			Seq:           e.Seq,
+			Type:          api.EventType(e.Type),
+			OrgId:         e.OrgID,
+			Username:      e.Username,
+			AggregateType: e.AggregateType,
+			AggregateId:   e.AggregateID,
+			Payload:       payload,
+			OccurredAt:    e.OccurredAt,
+		})
+		page.Next = e.Seq
+	}
+	return page, nil
+}
+
+// AlertWebhookToApi converts the alert webhook of an organization to its API representation
+func AlertWebhookToApi(w model.AlertWebhook) api.AlertWebhook {
+	webhook := api.AlertWebhook{
+		Url:       w.URL,
+		UpdatedAt: w.UpdatedAt,
+	}
+	if w.UpdatedBy != "" {
+		webhook.UpdatedBy = util.ToStrPtr(w.UpdatedBy)
+	}
+	return webhook
+}
+
+// WebhookEndpointsToApi converts the status of the webhooks to their API representation
+func WebhookEndpointsToApi(endpoints []service.WebhookEndpointStatus) api.WebhookEndpointList {
+	result := make(api.WebhookEndpointList, 0, len(endpoints))
+	for _, e := range endpoints {
+		endpoint := api.WebhookEndpoint{
+			Id:                  e.ID,
+			Disabled:            e.Disabled(),
+			DisabledAt:          e.DisabledAt,
+			ConsecutiveFailures: e.ConsecutiveFailures,
+			Delivered:           e.Delivered,
+			Failed:              e.Failed,
+		}
+		if e.LastError != "" {
+			endpoint.LastError = util.ToStrPtr(e.LastError)
+		}
+		result = append(result, endpoint)
+	}
+	return result
+}
+
+// WebhookDeliveriesToApi converts the deliveries to a webhook to their API representation
+func WebhookDeliveriesToApi(deliveries model.WebhookDeliveryList) api.WebhookDeliveryList {
+	result := make(api.WebhookDeliveryList, 0, len(deliveries))
+	for _, d := range deliveries {
+		delivery := api.WebhookDelivery{
+			EventSeq:  d.EventSeq,
+			EventType: d.EventType,
+			OrgId:     d.OrgID,
+			Status:    api.WebhookDeliveryStatus(d.Status),
+			Attempts:  d.Attempts,
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+		}
+		if d.LastError != "" {
+			delivery.Error = util.ToStrPtr(d.LastError)
+		}
+		result = append(result, delivery)
+	}
+	return result
+}
+
+// PlanShareToApi converts a share link to its API representation. The URL is only known when the link is created.
+func PlanShareToApi(s model.Share, url string) api.PlanShare {
+	share := api.PlanShare{
+		Id:           s.ID,
+		CreatedAt:    s.CreatedAt,
+		CreatedBy:    s.Username,
+		ExpiresAt:    s.ExpiresAt,
+		RevokedAt:    s.RevokedAt,
+		Views:        s.Views,
+		LastViewedAt: s.LastViewedAt,
+	}
+	if url != "" {
+		share.Url = util.ToStrPtr(url)
+	}
+	return share
+}
+
+func PlanShareListToApi(shares model.ShareList) api.PlanShareList {
+	list := make(api.PlanShareList, len(shares))
+	for i, s := range shares {
+		list[i] = PlanShareToApi(s, "")
+	}
+	return list
+}
+
+// ExportPolicyToApi converts the export policy of an organization to its API representation.
+// The default policy has never been updated.
+func ExportPolicyToApi(p model.ExportPolicy) api.ExportPolicy {
+	policy := api.ExportPolicy{
+		Watermark:            p.Watermark,
+		RawExports:           p.RawExports,
+		ContributeBenchmarks: p.Benchmarks,
+	}
+	if !p.UpdatedAt.IsZero() {
+		policy.UpdatedAt = &p.UpdatedAt
+		policy.UpdatedBy = util.ToStrPtr(p.UpdatedBy)
+	}
+	return policy
+}
+
+func ChecklistTemplateToApi(t model.ChecklistTemplate) (api.ChecklistTemplate, error) {
+	doc, err := service.ChecklistTemplateDocument(t)
+	if err != nil {
+		return api.ChecklistTemplate{}, err
+	}
+
+	template := api.ChecklistTemplate{
+		Id:        t.ID,
+		Name:      doc.Name,
+		Items:     doc.Items,
+		CreatedAt: t.CreatedAt,
+		CreatedBy: t.Username,
+	}
+	if len(doc.Applications) > 0 {
+		template.Applications = &doc.Applications
+	}
+	if len(doc.OperatingSystems) > 0 {
+		template.OperatingSystems = &doc.OperatingSystems
+	}
+	return template, nil
+}
+
+func ChecklistTemplateListToApi(templates model.ChecklistTemplateList) (api.ChecklistTemplateList, error) {
+	list := make(api.ChecklistTemplateList, 0, len(templates))
+	for _, t := range templates {
+		template, err := ChecklistTemplateToApi(t)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, template)
+	}
+	return list, nil
+}
+
+func ChecklistToApi(c model.Checklist) (api.Checklist, error) {
+	doc, err := service.ChecklistDocument(c)
+	if err != nil {
+		return api.Checklist{}, err
+	}
+
+	apiChecklist := api.Checklist{
+		Id:       c.ID,
+		Wave:     doc.Wave,
+		Template: doc.Template,
+		Vm: api.ChecklistVM{
+			Id:   doc.VM.ID,
+			Name: doc.VM.Name,
+		},
+		Items:     make([]api.ChecklistItem, 0, len(doc.Items)),
+		UpdatedAt: c.UpdatedAt,
+	}
+	if doc.VM.Application != "" {
+		apiChecklist.Vm.Application = util.ToStrPtr(doc.VM.Application)
+	}
+	if doc.VM.OS != "" {
+		apiChecklist.Vm.Os = util.ToStrPtr(doc.VM.OS)
+	}
+	for _, item := range doc.Items {
+		apiItem := api.ChecklistItem{
+			Name:        item.Name,
+			Done:        item.Done,
+			CompletedAt: item.CompletedAt,
+		}
+		if item.CompletedBy != "" {
+			apiItem.CompletedBy = util.ToStrPtr(item.CompletedBy)
+		}
+		apiChecklist.Items = append(apiChecklist.Items, apiItem)
+	}
+	return apiChecklist, nil
+}
+
+func ChecklistListToApi(checklists model.ChecklistList) (api.ChecklistList, error) {
+	list := make(api.ChecklistList, 0, len(checklists))
+	for _, c := range checklists {
+		apiChecklist, err := ChecklistToApi(c)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, apiChecklist)
+	}
+	return list, nil
+}
+
+// WaveLiveToApi converts the board of a wave to its API representation, the durations formatted
+// under the display policy of the plan
+func WaveLiveToApi(b live.Board, policy display.Policy) api.WaveLiveStatus {
+	status := api.WaveLiveStatus{
+		Wave:      b.Wave,
+		Estimated: policy.Format(b.Estimated),
+		Elapsed:   policy.Format(b.Elapsed),
+		States:    make(map[string]int, len(b.States)),
+		Vms:       make([]api.VMLiveStatus, 0, len(b.VMs)),
+		Blockers:  make([]api.WaveBlocker, 0, len(b.Blockers)),
+		UpdatedAt: b.At,
+	}
+	for state, count := range b.States {
+		status.States[string(state)] = count
+	}
+	for _, vm := range b.VMs {
+		status.Vms = append(status.Vms, api.VMLiveStatus{
+			Id:      vm.ID,
+			Name:    vm.Name,
+			State:   api.VMLiveState(vm.State),
+			Elapsed: policy.Format(vm.Elapsed),
+		})
+	}
+	for _, blocker := range b.Blockers {
+		status.Blockers = append(status.Blockers, api.WaveBlocker{
+			VmId:   blocker.VMID,
+			Vm:     blocker.VM,
+			Reason: blocker.Reason,
+		})
+	}
+	return status
+}
+
+func VMPhaseActualListToApi(actuals model.VMPhaseActualList) api.VMPhaseActualList {
+	res := api.VMPhaseActualList{Actuals: make([]api.VMPhaseActual, 0, len(actuals))}
+	for _, a := range actuals {
+		actual := api.VMPhaseActual{
+			VmId:        a.VMID,
+			Phase:       a.Phase,
+			StartedAt:   a.StartedAt,
+			CompletedAt: a.CompletedAt,
+		}
+		if a.Wave != "" {
+			actual.Wave = &a.Wave
+		}
+		res.Actuals = append(res.Actuals, actual)
+	}
+	return res
+}
+
+func DurationDistributionListToApi(fitted []service.PhaseDistribution) api.DurationDistributionList {
+	res := api.DurationDistributionList{Distributions: make([]api.DurationDistribution, 0, len(fitted))}
+	for _, f := range fitted {
+		d := api.DurationDistribution{Phase: f.Phase, Samples: f.Samples}
+		if f.Distribution == nil {
+			if f.Err != nil {
+				d.Error = util.ToStrPtr(f.Err.Error())
+			}
+			res.Distributions = append(res.Distributions, d)
+			continue
+		}
+		family := api.DistributionFamily(f.Distribution.Family())
+		params := f.Distribution.Params()
+		mean, p50, p90 := f.Distribution.Mean(), f.Distribution.Quantile(0.5), f.Distribution.Quantile(0.9)
+		d.Family = &family
+		d.Params = &params
+		d.MeanHours = &mean
+		d.P50Hours = &p50
+		d.P90Hours = &p90
+		res.Distributions = append(res.Distributions, d)
+	}
+	return res
+}
+
+func TroubleshootingModelToApi(m model.TroubleshootingModel) (api.TroubleshootingModel, error) {
+	doc, err := service.TroubleshootingModelDocument(m)
+	if err != nil {
+		return api.TroubleshootingModel{}, err
+	}
+	res := api.TroubleshootingModel{
+		Name:            doc.ModelName,
+		Intercept:       doc.Intercept,
+		MinutesPerDisk:  doc.MinutesPerDisk,
+		SnapshotMinutes: doc.SnapshotMinutes,
+		UpdatedAt:       m.UpdatedAt,
+		UpdatedBy:       m.UpdatedBy,
+	}
+	if len(doc.OSMinutes) > 0 {
+		res.OsMinutes = &doc.OSMinutes
+	}
+	if len(doc.AppTagMinutes) > 0 {
+		res.AppTagMinutes = &doc.AppTagMinutes
+	}
+	return res, nil
+}
+
+// ContingencyPolicyToApi converts the contingency policy of an organization to its API representation.
+// The empty policy of an organization which did not set one has never been updated.
+func ContingencyPolicyToApi(p model.ContingencyPolicy) (api.ContingencyPolicy, error) {
+	doc, err := service.ContingencyPolicyDocument(p)
+	if err != nil {
+		return api.ContingencyPolicy{}, err
+	}
+	policy := api.ContingencyPolicy{
+		Rules:          contingencyRulesToApi(doc.Rules),
+		MinimumPercent: doc.MinimumPercent,
+	}
+	if !p.UpdatedAt.IsZero() {
+		policy.UpdatedAt = &p.UpdatedAt
+		policy.UpdatedBy = util.ToStrPtr(p.UpdatedBy)
+	}
+	return policy, nil
+}
+
+// GuardrailPolicyToApi converts the guardrail policy of an organization to its API representation,
+// reporting the working day the estimations are checked against.
+func GuardrailPolicyToApi(p model.GuardrailPolicy) (api.GuardrailPolicy, error) {
+	doc, err := service.GuardrailPolicyDocument(p)
+	if err != nil {
+		return api.GuardrailPolicy{}, err
+	}
+	policy := api.GuardrailPolicy{
+		TeamSize:           doc.TeamSize,
+		MaxWorkHoursPerDay: doc.MaxWorkHoursPerDay,
+	}
+	if policy.MaxWorkHoursPerDay == 0 {
+		policy.MaxWorkHoursPerDay = guardrails.DefaultMaxWorkHoursPerDay
+	}
+	if !p.UpdatedAt.IsZero() {
+		policy.UpdatedAt = &p.UpdatedAt
+		policy.UpdatedBy = util.ToStrPtr(p.UpdatedBy)
+	}
+	return policy, nil
+}
+
+// CalculatorDefaultsToApi converts the calculator defaults of an organization to their API
+// representation. The empty defaults of an organization which did not set any have never been updated.
+func CalculatorDefaultsToApi(d model.CalculatorDefaults) (api.CalculatorDefaults, error) {
+	doc, err := service.CalculatorDefaultsDocument(d)
+	if err != nil {
+		return api.CalculatorDefaults{}, err
+	}
+	constants := api.CalculatorDefaults{
+		WorkHoursPerDay:  doc.WorkHoursPerDay,
+		Engineers:        doc.Engineers,
+		TransferRateMbps: doc.TransferRateMbps,
+	}
+	if !d.UpdatedAt.IsZero() {
+		constants.UpdatedAt = &d.UpdatedAt
+		constants.UpdatedBy = util.ToStrPtr(d.UpdatedBy)
+	}
+	return constants, nil
+}
+
+// InventoryFieldSchemaToApi converts the extension fields of an organization to their API representation.
+func InventoryFieldSchemaToApi(s model.InventoryFieldSchema) (api.InventoryFieldSchema, error) {
+	doc, err := service.InventoryFieldSchemaDocument(s)
+	if err != nil {
+		return api.InventoryFieldSchema{}, err
+	}
+	schema := api.InventoryFieldSchema{Fields: make([]api.InventoryField, 0, len(doc.Fields))}
+	for _, f := range doc.Fields {
+		field := api.InventoryField{
+			Name:   f.Name,
+			Type:   api.InventoryFieldType(f.Type),
+			Source: api.InventoryFieldSource(f.Source),
+		}
+		if f.Description != "" {
+			field.Description = util.ToStrPtr(f.Description)
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+	if !s.UpdatedAt.IsZero() {
+		schema.UpdatedAt = &s.UpdatedAt
+		schema.UpdatedBy = util.ToStrPtr(s.UpdatedBy)
+	}
+	return schema, nil
+}
+
+func contingencyRulesToApi(rules []contingency.Rule) []api.ContingencyRule {
+	res := make([]api.ContingencyRule, 0, len(rules))
+	for _, r := range rules {
+		rule := api.ContingencyRule{Name: r.Name}
+		if r.Phase != "" {
+			rule.Phase = util.ToStrPtr(r.Phase)
+		}
+		if r.Percent > 0 {
+			rule.Percent = util.FloatPtr(r.Percent)
+		}
+		if r.PerWave > 0 {
+			rule.PerWave = util.ToStrPtr(r.PerWave.String())
+		}
+		res = append(res, rule)
+	}
+	return res
+}
+
+func RateCardToApi(r model.RateCard) (api.RateCard, error) {
+	card, err := service.RateCardFromModel(r)
+	if err != nil {
+		return api.RateCard{}, err
+	}
+
+	apiCard := api.RateCard{
+		Id:          r.ID,
+		Name:        card.Name,
+		Version:     card.Version,
+		Currency:    card.Currency,
+		ValidFrom:   card.ValidFrom,
+		ValidUntil:  card.ValidUntil,
+		HourlyRates: card.HourlyRates,
+		CreatedAt:   r.CreatedAt,
+	}
+	if card.Region != "" {
+		apiCard.Region = util.ToStrPtr(card.Region)
+	}
+	return apiCard, nil
+}
+
+func RateCardListToApi(cards []model.RateCard) (api.RateCardList, error) {
+	cardList := make([]api.RateCard, len(cards))
+	for i, c := range cards {
+		apiCard, err := RateCardToApi(c)
+		if err != nil {
+			return api.RateCardList{}, err
+		}
+		cardList[i] = apiCard
+	}
+	return cardList, nil
+}
+
+// ChangeRateJobToApi maps the sampling job of a source. Measurements follow the order of the sampled VMs.
+func ChangeRateJobToApi(sourceID uuid.UUID, job changerate.Job, now time.Time) api.ChangeRateJob {
+	res := api.ChangeRateJob{
+		SourceId:        sourceID,
+		VmIds:           job.VMIDs,
+		WindowHours:     int(job.Window.Hours()),
+		IntervalMinutes: int(job.Interval.Minutes()),
+		StartedAt:       job.StartedAt,
+		EndsAt:          job.Ends(),
+		Done:            job.Done(now),
+		Measurements:    []api.ChangeRateMeasurement{},
+	}
+	for _, id := range job.VMIDs {
+		m, ok := job.Measurements[id]
+		if !ok {
+			continue
+		}
+		measurement := api.ChangeRateMeasurement{
+			VmId:          id,
+			Samples:       m.Samples,
+			From:          m.First,
+			To:            m.Last,
+			ChangedBytes:  m.ChangedBytes,
+			CapacityBytes: m.CapacityBytes,
+		}
+		if rate, ok := m.DailyChangeRatePercent(); ok {
+			measurement.DailyChangeRatePercent = &rate
+		}
+		res.Measurements = append(res.Measurements, measurement)
+	}
+	return res
+}
+
+func PortfolioReportToApi(r portfolio.Report) (api.PortfolioReport, error) {
+	res := api.PortfolioReport{
+		GeneratedAt: r.GeneratedAt,
+		Start:       r.Start,
+		End:         r.End,
+		Totals: api.PortfolioTotals{
+			Plans:          r.Totals.Plans,
+			Waves:          r.Totals.Waves,
+			CompletedWaves: r.Totals.CompletedWaves,
+			VmsMigrated:    r.Totals.VMsMigrated,
+			Rollbacks:      r.Totals.Rollbacks,
+			P1Incidents:    r.Totals.P1Incidents,
+			Effort:         r.Totals.Effort.String(),
+		},
+		Plans: make([]api.PortfolioPlan, 0, len(r.Plans)),
+		Risks: make([]api.PortfolioRisk, 0, len(r.Risks)),
+	}
+	for _, p := range r.Plans {
+		id, err := uuid.Parse(p.ID)
+		if err != nil {
+			return api.PortfolioReport{}, fmt.Errorf("invalid plan id %q: %w", p.ID, err)
+		}
+		apiPlan := api.PortfolioPlan{
+			Id:             id,
+			Name:           p.Name,
+			Status:         api.PortfolioPlanStatus(p.Status),
+			Start:          p.Start,
+			End:            p.End,
+			Waves:          p.Waves,
+			CompletedWaves: p.CompletedWaves,
+			VmsMigrated:    p.VMsMigrated,
+			Rollbacks:      p.Rollbacks,
+			P1Incidents:    p.P1Incidents,
+			Effort:         p.Effort.String(),
+			Risks:          p.Risks,
+			Cost:           p.Cost,
+			ExchangeRates:  exchangeRatesToApi(p.ExchangeRates),
+		}
+		if p.Currency != "" {
+			apiPlan.Currency = util.ToStrPtr(p.Currency)
+		}
+		res.Plans = append(res.Plans, apiPlan)
+	}
+	for _, risk := range r.Risks {
+		id, err := uuid.Parse(risk.PlanID)
+		if err != nil {
+			return api.PortfolioReport{}, fmt.Errorf("invalid plan id %q: %w", risk.PlanID, err)
+		}
+		apiRisk := api.PortfolioRisk{
+			PlanId:  id,
+			Plan:    risk.Plan,
+			Kind:    api.PortfolioRiskKind(risk.Kind),
+			Message: risk.Message,
+		}
+		if risk.Wave != "" {
+			apiRisk.Wave = util.ToStrPtr(risk.Wave)
+		}
+		res.Risks = append(res.Risks, apiRisk)
+	}
+	if r.Cost != nil {
+		res.Cost = &api.PortfolioCost{
+			RateCard:      r.Cost.RateCard,
+			Currency:      r.Cost.Currency,
+			ExchangeRates: exchangeRatesToApi(r.Cost.ExchangeRates),
+			Total:         r.Cost.Total,
+			Granularity:   api.CashFlowGranularity(r.Cost.Granularity),
+			CashFlow:      make([]api.BudgetPeriod, 0, len(r.Cost.CashFlow)),
+		}
+		if len(r.Cost.Unpriced) > 0 {
+			res.Cost.Unpriced = &r.Cost.Unpriced
+		}
+		for _, p := range r.Cost.CashFlow {
+			res.Cost.CashFlow = append(res.Cost.CashFlow, api.BudgetPeriod{
+				Period:     p.Period,
+				Start:      p.Start,
+				End:        p.End,
+				Plans:      p.Plans,
+				Total:      p.Total,
+				Cumulative: p.Cumulative,
+			})
+		}
+	}
+	return res, nil
+}
+
+func ForecastToApi(id uuid.UUID, f forecast.Forecast) api.ProgramForecast {
+	res := api.ProgramForecast{
+		PlanId:      id,
+		PlanName:    f.Plan,
+		GeneratedAt: f.GeneratedAt,
+		Trials:      f.Trials,
+		TotalVms:    f.TotalVMs,
+		TotalDiskGb: f.TotalDiskGB,
+		Quarters:    make([]api.ForecastQuarter, 0, len(f.Quarters)),
+	}
+	for _, q := range f.Quarters {
+		res.Quarters = append(res.Quarters, api.ForecastQuarter{
+			Quarter:             q.Quarter,
+			Start:               q.Start,
+			End:                 q.End,
+			ActualVms:           q.ActualVMs,
+			ActualDiskGb:        q.ActualDiskGB,
+			VmsP50:              q.VMsP50,
+			VmsP80:              q.VMsP80,
+			DiskGbP50:           q.DiskGBP50,
+			DiskGbP80:           q.DiskGBP80,
+			CumulativeVmsP50:    q.CumulativeVMsP50,
+			CumulativeVmsP80:    q.CumulativeVMsP80,
+			CumulativeDiskGbP50: q.CumulativeDiskGBP50,
+			CumulativeDiskGbP80: q.CumulativeDiskGBP80,
+		})
+	}
+	return res
+}
+
+// WaveVMToApi converts a VM of a wave.
+func WaveVMToApi(vm plan.WaveVM) api.PlanWaveVM {
+	res := api.PlanWaveVM{Id: vm.ID}
+	if vm.Name != "" {
+		res.Name = util.ToStrPtr(vm.Name)
+	}
+	if vm.Application != "" {
+		res.Application = util.ToStrPtr(vm.Application)
+	}
+	if vm.Owner != "" {
+		res.Owner = util.ToStrPtr(vm.Owner)
+	}
+	if vm.CostCenter != "" {
+		res.CostCenter = util.ToStrPtr(vm.CostCenter)
+	}
+	if vm.Folder != "" {
+		res.Folder = util.ToStrPtr(vm.Folder)
+	}
+	if vm.DiskGB > 0 {
+		res.DiskGb = util.FloatPtr(vm.DiskGB)
+	}
+	return res
+}
+
+// VMEffortToApi converts the effort of a plan taken by a VM.
+func VMEffortToApi(e plan.VMEffort) api.VMEffort {
+	res := api.VMEffort{
+		Vm:          WaveVMToApi(e.VM),
+		Wave:        e.Wave,
+		Phases:      make([]api.VMPhaseEffort, 0, len(e.Phases)),
+		Total:       e.Total.String(),
+		ByCategory:  make(map[string]string, len(e.ByCategory)),
+		PlanShare:   e.PlanShare,
+		MedianRatio: e.MedianRatio,
+		Outlier:     e.Outlier,
+	}
+	for _, p := range e.Phases {
+		res.Phases = append(res.Phases, api.VMPhaseEffort{
+			Phase:    p.Phase,
+			Category: api.VMPhaseEffortCategory(p.Category),
+			Share:    p.Share,
+			Effort:   p.Effort.String(),
+		})
+	}
+	for category, effort := range e.ByCategory {
+		res.ByCategory[string(category)] = effort.String()
+	}
+	return res
+}
+
+// ChargebackToApi converts the chargeback of a plan.
+func ChargebackToApi(r chargeback.Report) api.ChargebackReport {
+	res := api.ChargebackReport{
+		Plan:    r.Plan,
+		GroupBy: string(r.GroupBy),
+		Lines:   make([]api.ChargebackLine, 0, len(r.Lines)),
+		Total:   chargebackLineToApi(r.Total),
+	}
+	if r.RateCard != "" {
+		res.RateCard = util.ToStrPtr(r.RateCard)
+		res.Currency = util.ToStrPtr(r.Currency)
+	}
+	if rate := r.ExchangeRate; rate != nil {
+		apiRate := exchangeRateToApi(*rate)
+		res.ExchangeRate = &apiRate
+	}
+	for _, l := range r.Lines {
+		res.Lines = append(res.Lines, chargebackLineToApi(l))
+	}
+	if len(r.Unpriced) > 0 {
+		res.Unpriced = &r.Unpriced
+	}
+	return res
+}
+
+func chargebackLineToApi(l chargeback.Line) api.ChargebackLine {
+	return api.ChargebackLine{
+		Key:             l.Key,
+		Vms:             l.VMs,
+		EstimatedEffort: l.EstimatedEffort.String(),
+		ActualEffort:    l.ActualEffort.String(),
+		EstimatedCost:   l.EstimatedCost,
+		ActualCost:      l.ActualCost,
+	}
+}
+
+// NamespaceDesignToApi converts the target namespaces proposed for a plan.
+func NamespaceDesignToApi(d namespaces.Design) api.NamespaceDesign {
+	res := api.NamespaceDesign{
+		Mapping:    planNamespaceMappingToApi(d.Mapping),
+		Namespaces: make([]api.PlanNamespace, 0, len(d.Namespaces)),
+		Unmapped:   make([]string, 0, len(d.Unmapped)),
+		Issues:     make([]api.NamespaceIssue, 0, len(d.Issues)),
+	}
+	for _, ns := range d.Namespaces {
+		vms := make([]string, 0, len(ns.VMs))
+		for _, vm := range ns.VMs {
+			vms = append(vms, vm.ID)
+		}
+		sources := ns.Sources
+		if sources == nil {
+			sources = []string{}
+		}
+		res.Namespaces = append(res.Namespaces, api.PlanNamespace{Name: ns.Name, Sources: sources, Waves: ns.Waves, Vms: vms})
+	}
+	for _, vm := range d.Unmapped {
+		res.Unmapped = append(res.Unmapped, vm.ID)
+	}
+	for _, i := range d.Issues {
+		issue := api.NamespaceIssue{Kind: api.NamespaceIssueKind(i.Kind), Namespace: i.Namespace, Message: i.Message}
+		if len(i.Sources) > 0 {
+			issue.Sources = &i.Sources
+		}
+		res.Issues = append(res.Issues, issue)
+	}
+	return res
+}
+
+func planNamespaceMappingToApi(m plan.NamespaceMapping) api.PlanNamespaceMapping {
+	mapping := api.PlanNamespaceMapping{Strategy: api.NamespaceStrategy(m.Strategy)}
+	if m.Tag != "" {
+		mapping.Tag = util.ToStrPtr(m.Tag)
+	}
+	if m.FolderDepth != 0 {
+		mapping.FolderDepth = &m.FolderDepth
+	}
+	if m.Prefix != "" {
+		mapping.Prefix = util.ToStrPtr(m.Prefix)
+	}
+	if m.Default != "" {
+		mapping.Default = util.ToStrPtr(m.Default)
+	}
+	if len(m.Overrides) > 0 {
+		mapping.Overrides = &m.Overrides
+	}
+	return mapping
+}
+
+// FactoryThroughputToApi converts the factory a throughput requires.
+func FactoryThroughputToApi(m factory.Model) api.FactoryThroughput {
+	a := m.Assumptions
+	res := api.FactoryThroughput{
+		TargetVmsPerWeek: m.TargetVMsPerWeek,
+		Assumptions: api.FactoryAssumptions{
+			Vms:                  a.VMs,
+			DiskGb:               a.DiskGB,
+			TransferRateMbps:     a.TransferRateMbps,
+			TransferHoursPerWeek: a.TransferHoursPerWeek,
+			Engineers:            a.Engineers,
+			EngineerMinsPerVm:    a.EngineerMinsPerVM,
+			WorkHoursPerDay:      a.WorkHoursPerDay,
+			WorkDaysPerWeek:      a.WorkDaysPerWeek,
+			WavesPerWeek:         a.WavesPerWeek,
+			MaxWaveVms:           a.MaxWaveVMs,
+		},
+		Weeks:                m.Weeks,
+		WaveVms:              m.WaveVMs,
+		Waves:                m.Waves,
+		Requirements:         make([]api.FactoryRequirement, 0, len(m.Requirements)),
+		Feasible:             m.Feasible(),
+		AchievableVmsPerWeek: m.AchievableVMsPerWeek,
+	}
+	for _, r := range m.Requirements {
+		res.Requirements = append(res.Requirements, api.FactoryRequirement{
+			Resource:      api.FactoryRequirementResource(r.Resource),
+			Unit:          string(r.Unit),
+			Required:      r.Required,
+			Available:     r.Available,
+			MaxVmsPerWeek: r.MaxVMsPerWeek,
+			Feasible:      r.Feasible,
+			Message:       r.Message,
+		})
+	}
+	if m.Bottleneck != "" {
+		res.Bottleneck = util.ToStrPtr(string(m.Bottleneck))
+	}
+	return res
+}
+
+// EstimationSolutionToApi converts what it takes for an estimation to end by a deadline.
+func EstimationSolutionToApi(r solver.Result) api.EstimationSolution {
+	res := api.EstimationSolution{
+		Deadline:    r.Deadline,
+		Feasible:    r.Feasible,
+		Changes:     make([]api.EstimationParamChange, 0, len(r.Changes)),
+		End:         r.End,
+		Message:     r.Message,
+		Evaluations: r.Evaluations,
+	}
+	for _, c := range r.Changes {
+		res.Changes = append(res.Changes, api.EstimationParamChange{Param: c.Key, From: c.From, To: c.To})
+	}
+	return res
+}
+
+func exchangeRateToApi(r cost.ExchangeRate) api.ExchangeRate {
+	return api.ExchangeRate{
+		From:   r.From,
+		To:     r.To,
+		Rate:   r.Rate,
+		AsOf:   r.AsOf,
+		Source: api.ExchangeRateSource(r.Source),
+	}
+}
+
+func exchangeRatesToApi(rates []cost.ExchangeRate) *[]api.ExchangeRate {
+	if len(rates) == 0 {
+		return nil
+	}
+	res := make([]api.ExchangeRate, 0, len(rates))
+	for _, r := range rates {
+		res = append(res, exchangeRateToApi(r))
+	}
+	return &res
 }
@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/plans/{id}/namespaces)
+func (h *ServiceHandler) GetPlanNamespaces(ctx context.Context, request server.GetPlanNamespacesRequestObject) (server.GetPlanNamespacesResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan_namespaces").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanNamespaces404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanNamespaces500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlanNamespaces403JSONResponse{Message: message}, nil
+	}
+
+	// a strategy given in the query replaces the mapping of the plan
+	var mapping *plan.NamespaceMapping
+	if params := request.Params; params.Strategy != nil {
+		mapping = &plan.NamespaceMapping{Strategy: plan.NamespaceStrategy(*params.Strategy)}
+		if params.Tag != nil {
+			mapping.Tag = string(*params.Tag)
+		}
+		if params.FolderDepth != nil {
+			mapping.FolderDepth = *params.FolderDepth
+		}
+		if params.Prefix != nil {
+			mapping.Prefix = *params.Prefix
+		}
+	}
+
+	design, err := h.planSrv.Namespaces(ctx, *p, mapping)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.GetPlanNamespaces400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanNamespaces500JSONResponse{Message: fmt.Sprintf("failed to propose namespaces: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("namespaces", len(design.Namespaces)).WithInt("issues", len(design.Issues)).Log()
+	return server.GetPlanNamespaces200JSONResponse(mappers.NamespaceDesignToApi(design)), nil
+}
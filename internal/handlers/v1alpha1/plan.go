@@ -0,0 +1,1152 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/distribution"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+const (
+	// maxScheduleSize bounds the size of an imported MS Project document.
+	maxScheduleSize = 10 << 20
+	// maxCalendarSize bounds the size of an imported ICS holiday calendar.
+	maxCalendarSize = 1 << 20
+)
+
+// (GET /api/v1/plans)
+func (h *ServiceHandler) ListPlans(ctx context.Context, request server.ListPlansRequestObject) (server.ListPlansResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("list_plans").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	plans, err := h.planSrv.ListPlans(ctx, user.Username, user.Organization)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListPlans500JSONResponse{Message: fmt.Sprintf("failed to list plans: %v", err)}, nil
+	}
+
+	apiPlans, err := mappers.PlanListToApi(plans)
+	if err != nil {
+		return server.ListPlans500JSONResponse{Message: fmt.Sprintf("failed to list plans: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(plans)).Log()
+	return server.ListPlans200JSONResponse(apiPlans), nil
+}
+
+// (POST /api/v1/plans)
+func (h *ServiceHandler) CreatePlan(ctx context.Context, request server.CreatePlanRequestObject) (server.CreatePlanResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("create_plan").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CreatePlan400JSONResponse{Message: "empty body"}, nil
+	}
+
+	p, err := mappers.PlanFormToPlan(v1alpha1.PlanForm(*request.Body))
+	if err != nil {
+		logger.Error(err).WithString("step", "validation").Log()
+		return server.CreatePlan400JSONResponse{Message: err.Error()}, nil
+	}
+	if digest := request.Body.ContentDigest; digest != nil {
+		if err := p.VerifyDigest(*digest); err != nil {
+			logger.Error(err).WithString("step", "verify_digest").Log()
+			return server.CreatePlan400JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
+	created, err := h.planSrv.CreatePlan(ctx, user.Username, user.Organization, p)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest, *service.ErrDuplicateKey:
+			logger.Error(err).WithString("step", "validate_input").Log()
+			return server.CreatePlan400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreatePlan500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
+	apiPlan, err := mappers.PlanToApi(*created)
+	if err != nil {
+		return server.CreatePlan500JSONResponse{Message: err.Error()}, nil
+	}
+
+	logger.Success().WithUUID("plan_id", created.ID).WithString("plan_name", created.Name).Log()
+	return server.CreatePlan201JSONResponse(apiPlan), nil
+}
+
+// (GET /api/v1/plans/{id})
+func (h *ServiceHandler) GetPlan(ctx context.Context, request server.GetPlanRequestObject) (server.GetPlanResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlan404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlan500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlan403JSONResponse{Message: message}, nil
+	}
+
+	apiPlan, err := mappers.PlanToApi(*p)
+	if err != nil {
+		return server.GetPlan500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+	}
+
+	logger.Success().WithString("plan_name", p.Name).Log()
+	return server.GetPlan200JSONResponse(apiPlan), nil
+}
+
+// (DELETE /api/v1/plans/{id})
+func (h *ServiceHandler) DeletePlan(ctx context.Context, request server.DeletePlanRequestObject) (server.DeletePlanResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("delete_plan").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).WithString("step", "get_for_delete").Log()
+			return server.DeletePlan404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).WithString("step", "get_for_delete").Log()
+			return server.DeletePlan500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to delete plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("username", user.Username).WithString("plan_username", p.Username).Log()
+		return server.DeletePlan403JSONResponse{Message: message}, nil
+	}
+
+	if err := h.planSrv.DeletePlan(ctx, request.Id); err != nil {
+		logger.Error(err).Log()
+		return server.DeletePlan500JSONResponse{Message: fmt.Sprintf("failed to delete plan: %v", err)}, nil
+	}
+
+	logger.Success().WithString("deleted_plan_name", p.Name).Log()
+	return server.DeletePlan200JSONResponse{}, nil
+}
+
+// (GET /api/v1/plans/{id}/gantt)
+func (h *ServiceHandler) GetPlanGantt(ctx context.Context, request server.GetPlanGanttRequestObject) (server.GetPlanGanttResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan_gantt").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	format := v1alpha1.GanttFormatJson
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+
+	var viewer *time.Location
+	if request.Params.TimeZone != nil {
+		loc, err := time.LoadLocation(*request.Params.TimeZone)
+		if err != nil {
+			return server.GetPlanGantt400JSONResponse{Message: fmt.Sprintf("invalid time zone %q", *request.Params.TimeZone)}, nil
+		}
+		viewer = loc
+	}
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanGantt404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanGantt500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlanGantt403JSONResponse{Message: message}, nil
+	}
+
+	switch format {
+	case v1alpha1.GanttFormatSvg:
+		// a rendered report: subject to the export policy of the organization
+		svg, err := h.planSrv.Export(ctx, *p, user.Username, service.ExportGanttSVG)
+		if err != nil {
+			logger.Error(err).Log()
+			return server.GetPlanGantt500JSONResponse{Message: fmt.Sprintf("failed to render gantt: %v", err)}, nil
+		}
+		logger.Success().WithString("format", string(format)).WithInt("bytes", len(svg)).Log()
+		return server.GetPlanGantt200ImagesvgXmlResponse{Body: bytes.NewReader(svg), ContentLength: int64(len(svg))}, nil
+	case v1alpha1.GanttFormatJson:
+		chart, err := h.planSrv.Gantt(*p, time.Now())
+		if err != nil {
+			logger.Error(err).Log()
+			return server.GetPlanGantt500JSONResponse{Message: fmt.Sprintf("failed to render gantt: %v", err)}, nil
+		}
+		logger.Success().WithString("format", string(format)).WithInt("bars", len(chart.Bars)).Log()
+		return server.GetPlanGantt200JSONResponse(mappers.GanttToApi(chart, viewer)), nil
+	default:
+		return server.GetPlanGantt400JSONResponse{Message: fmt.Sprintf("unsupported format %q", format)}, nil
+	}
+}
+
+// (GET /api/v1/plans/{id}/export)
+func (h *ServiceHandler) ExportPlan(ctx context.Context, request server.ExportPlanRequestObject) (server.ExportPlanResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("export_plan").
+		WithUUID("plan_id", request.Id).
+		WithString("format", string(request.Params.Format)).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ExportPlan404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ExportPlan500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.ExportPlan403JSONResponse{Message: message}, nil
+	}
+
+	format := service.ExportFormat(request.Params.Format)
+	out, err := h.planSrv.Export(ctx, *p, user.Username, format)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.ExportPlan400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrExportForbidden:
+			logger.Error(err).Log()
+			return server.ExportPlan403JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ExportPlan500JSONResponse{Message: fmt.Sprintf("failed to export plan: %v", err)}, nil
+		}
+	}
+
+	var headers server.ExportPlan200ResponseHeaders
+	sig, err := h.planSrv.SignExport(ctx, out)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ExportPlan500JSONResponse{Message: err.Error()}, nil
+	}
+	if sig != nil {
+		if headers.XPlannerSignature, err = sig.Encode(); err != nil {
+			logger.Error(err).Log()
+			return server.ExportPlan500JSONResponse{Message: fmt.Sprintf("failed to encode signature: %v", err)}, nil
+		}
+	}
+	logger.Success().WithInt("bytes", len(out)).WithBool("signed", sig != nil).Log()
+
+	switch format {
+	case service.ExportMSProject:
+		return server.ExportPlan200ApplicationxmlResponse{Body: bytes.NewReader(out), Headers: headers, ContentLength: int64(len(out))}, nil
+	case service.ExportSmartsheet:
+		return server.ExportPlan200TextcsvResponse{Body: bytes.NewReader(out), Headers: headers, ContentLength: int64(len(out))}, nil
+	case service.ExportJSON:
+		return server.ExportPlan200ApplicationoctetStreamResponse{Body: bytes.NewReader(out), Headers: headers, ContentLength: int64(len(out))}, nil
+	case service.ExportTerraform:
+		return server.ExportPlan200TextxHclResponse{Body: bytes.NewReader(out), Headers: headers, ContentLength: int64(len(out))}, nil
+	default:
+		return server.ExportPlan200ApplicationyamlResponse{Body: bytes.NewReader(out), Headers: headers, ContentLength: int64(len(out))}, nil
+	}
+}
+
+// (PUT /api/v1/plans/{id}/schedule)
+func (h *ServiceHandler) ImportPlanSchedule(ctx context.Context, request server.ImportPlanScheduleRequestObject) (server.ImportPlanScheduleResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("import_plan_schedule").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ImportPlanSchedule404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ImportPlanSchedule500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.ImportPlanSchedule403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.ImportPlanSchedule400JSONResponse{Message: "empty body"}, nil
+	}
+	data, err := io.ReadAll(io.LimitReader(request.Body, maxScheduleSize+1))
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ImportPlanSchedule400JSONResponse{Message: fmt.Sprintf("failed to read schedule: %v", err)}, nil
+	}
+	if len(data) > maxScheduleSize {
+		return server.ImportPlanSchedule400JSONResponse{Message: fmt.Sprintf("schedule exceeds %d bytes", maxScheduleSize)}, nil
+	}
+
+	updated, discrepancies, err := h.planSrv.ImportSchedule(ctx, *p, data)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.ImportPlanSchedule400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ImportPlanSchedule404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ImportPlanSchedule500JSONResponse{Message: fmt.Sprintf("failed to import schedule: %v", err)}, nil
+		}
+	}
+
+	result, err := mappers.ScheduleImportToApi(*updated, discrepancies)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ImportPlanSchedule500JSONResponse{Message: fmt.Sprintf("failed to map plan: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("discrepancies", len(discrepancies)).Log()
+	return server.ImportPlanSchedule200JSONResponse(result), nil
+}
+
+// (PUT /api/v1/plans/{id}/kpis)
+func (h *ServiceHandler) SetPlanKPIs(ctx context.Context, request server.SetPlanKPIsRequestObject) (server.SetPlanKPIsResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("set_plan_kpis").
+		WithUUID("plan_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.SetPlanKPIs404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetPlanKPIs500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.SetPlanKPIs403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.SetPlanKPIs400JSONResponse{Message: "empty body"}, nil
+	}
+
+	updated, statuses, err := h.planSrv.SetKPIs(ctx, *p, mappers.PlanKPIsFromApi(v1alpha1.PlanKPIs(*request.Body)))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SetPlanKPIs400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.SetPlanKPIs404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetPlanKPIs500JSONResponse{Message: fmt.Sprintf("failed to set KPIs: %v", err)}, nil
+		}
+	}
+
+	checklists, err := h.planSrv.ChecklistProgress(ctx, *updated)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetPlanKPIs500JSONResponse{Message: fmt.Sprintf("failed to measure checklist progress: %v", err)}, nil
+	}
+
+	progress, err := mappers.PlanProgressToApi(*updated, statuses, checklists)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetPlanKPIs500JSONResponse{Message: fmt.Sprintf("failed to map plan progress: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("kpis", len(statuses)).Log()
+	return server.SetPlanKPIs200JSONResponse(progress), nil
+}
+
+// (GET /api/v1/plans/{id}/progress)
+func (h *ServiceHandler) GetPlanProgress(ctx context.Context, request server.GetPlanProgressRequestObject) (server.GetPlanProgressResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan_progress").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanProgress404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlanProgress403JSONResponse{Message: message}, nil
+	}
+
+	statuses, err := h.planSrv.KPIStatus(*p)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to measure KPIs: %v", err)}, nil
+	}
+
+	checklists, err := h.planSrv.ChecklistProgress(ctx, *p)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to measure checklist progress: %v", err)}, nil
+	}
+
+	progress, err := mappers.PlanProgressToApi(*p, statuses, checklists)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to map plan progress: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("waves", len(progress.Waves)).Log()
+	return server.GetPlanProgress200JSONResponse(progress), nil
+}
+
+// (GET /api/v1/plans/{id}/coverage)
+func (h *ServiceHandler) GetPlanCoverage(ctx context.Context, request server.GetPlanCoverageRequestObject) (server.GetPlanCoverageResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan_coverage").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanCoverage404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanCoverage500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlanCoverage403JSONResponse{Message: message}, nil
+	}
+
+	coverage, err := h.planSrv.Coverage(*p)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetPlanCoverage500JSONResponse{Message: fmt.Sprintf("failed to compute coverage: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("pools", len(coverage)).Log()
+	return server.GetPlanCoverage200JSONResponse(mappers.PoolCoverageToApi(coverage)), nil
+}
+
+// (GET /api/v1/plans/{id}/vms/{vm}/effort)
+func (h *ServiceHandler) GetPlanVMEffort(ctx context.Context, request server.GetPlanVMEffortRequestObject) (server.GetPlanVMEffortResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan_vm_effort").
+		WithUUID("plan_id", request.Id).
+		WithString("vm", request.Vm).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanVMEffort404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanVMEffort500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlanVMEffort403JSONResponse{Message: message}, nil
+	}
+
+	effort, err := h.planSrv.VMEffort(*p, request.Vm)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanVMEffort404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanVMEffort500JSONResponse{Message: fmt.Sprintf("failed to compute VM effort: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithString("total", effort.Total.String()).Log()
+	return server.GetPlanVMEffort200JSONResponse(mappers.VMEffortToApi(effort)), nil
+}
+
+// (PUT /api/v1/plans/{id}/progress)
+func (h *ServiceHandler) RecordPlanProgress(ctx context.Context, request server.RecordPlanProgressRequestObject) (server.RecordPlanProgressResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("record_plan_progress").
+		WithUUID("plan_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RecordPlanProgress404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RecordPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.RecordPlanProgress403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.RecordPlanProgress400JSONResponse{Message: "empty body"}, nil
+	}
+
+	updated, statuses, err := h.planSrv.RecordProgress(ctx, *p, mappers.WaveProgressFromApi(v1alpha1.WaveProgress(*request.Body)))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.RecordPlanProgress400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RecordPlanProgress404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RecordPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to record progress: %v", err)}, nil
+		}
+	}
+
+	checklists, err := h.planSrv.ChecklistProgress(ctx, *updated)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.RecordPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to measure checklist progress: %v", err)}, nil
+	}
+
+	progress, err := mappers.PlanProgressToApi(*updated, statuses, checklists)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.RecordPlanProgress500JSONResponse{Message: fmt.Sprintf("failed to map plan progress: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("waves", len(progress.Waves)).Log()
+	return server.RecordPlanProgress200JSONResponse(progress), nil
+}
+
+// (POST /api/v1/plans/{id}/gates/{gate}/approvals)
+func (h *ServiceHandler) ApprovePlanGate(ctx context.Context, request server.ApprovePlanGateRequestObject) (server.ApprovePlanGateResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("approve_plan_gate").
+		WithUUID("plan_id", request.Id).
+		WithString("gate", request.Gate).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ApprovePlanGate404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ApprovePlanGate500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	// Approvers sign off the plans of their organization, not only the ones they own
+	user := auth.MustHaveUser(ctx)
+	if user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_org_id", p.OrgID).Log()
+		return server.ApprovePlanGate403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.ApprovePlanGate400JSONResponse{Message: "empty body"}, nil
+	}
+	comment := ""
+	if request.Body.Comment != nil {
+		comment = *request.Body.Comment
+	}
+
+	updated, err := h.planSrv.ApproveGate(ctx, *p, request.Gate, user.Username, comment)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.ApprovePlanGate400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrGateApprovalForbidden:
+			logger.Error(err).Log()
+			return server.ApprovePlanGate403JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ApprovePlanGate404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ApprovePlanGate500JSONResponse{Message: fmt.Sprintf("failed to approve gate: %v", err)}, nil
+		}
+	}
+
+	apiPlan, err := mappers.PlanToApi(*updated)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ApprovePlanGate500JSONResponse{Message: fmt.Sprintf("failed to map plan: %v", err)}, nil
+	}
+
+	logger.Success().WithString("user", user.Username).Log()
+	return server.ApprovePlanGate200JSONResponse(apiPlan), nil
+}
+
+// (POST /api/v1/plans/{id}/what-if)
+func (h *ServiceHandler) SimulatePlanStaffing(ctx context.Context, request server.SimulatePlanStaffingRequestObject) (server.SimulatePlanStaffingResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("simulate_plan_staffing").
+		WithUUID("plan_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.SimulatePlanStaffing404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SimulatePlanStaffing500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.SimulatePlanStaffing403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.SimulatePlanStaffing400JSONResponse{Message: "empty body"}, nil
+	}
+
+	var slips []plan.MilestoneSlip
+	if request.Body.MilestoneSlips != nil {
+		slips = mappers.MilestoneSlipsFromApi(*request.Body.MilestoneSlips)
+	}
+
+	baseline, scenario, impacts, err := h.planSrv.WhatIf(*p, mappers.CapacityChangesFromApi(request.Body.CapacityChanges), slips, time.Now())
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SimulatePlanStaffing400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SimulatePlanStaffing500JSONResponse{Message: fmt.Sprintf("failed to simulate staffing: %v", err)}, nil
+		}
+	}
+
+	doc, err := service.PlanDocument(*p)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SimulatePlanStaffing500JSONResponse{Message: fmt.Sprintf("failed to read plan: %v", err)}, nil
+	}
+
+	result := mappers.PlanWhatIfToApi(baseline, scenario, impacts, doc.DisplayPolicy())
+	logger.Success().WithString("delay", result.Delay).Log()
+	return server.SimulatePlanStaffing200JSONResponse(result), nil
+}
+
+// (PUT /api/v1/plans/{id}/calendars/{pool})
+func (h *ServiceHandler) ImportPlanCalendar(ctx context.Context, request server.ImportPlanCalendarRequestObject) (server.ImportPlanCalendarResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("import_plan_calendar").
+		WithUUID("plan_id", request.Id).
+		WithString("pool", request.Pool).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ImportPlanCalendar404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ImportPlanCalendar500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.ImportPlanCalendar403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.ImportPlanCalendar400JSONResponse{Message: "empty body"}, nil
+	}
+	data, err := io.ReadAll(io.LimitReader(request.Body, maxCalendarSize+1))
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ImportPlanCalendar400JSONResponse{Message: fmt.Sprintf("failed to read calendar: %v", err)}, nil
+	}
+	if len(data) > maxCalendarSize {
+		return server.ImportPlanCalendar400JSONResponse{Message: fmt.Sprintf("calendar exceeds %d bytes", maxCalendarSize)}, nil
+	}
+
+	var region *calendar.Region
+	if request.Params.Region != nil {
+		r := calendar.Region(*request.Params.Region)
+		region = &r
+	}
+
+	updated, err := h.planSrv.ImportCalendar(ctx, *p, request.Pool, region, data)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.ImportPlanCalendar400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ImportPlanCalendar404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ImportPlanCalendar500JSONResponse{Message: fmt.Sprintf("failed to import calendar: %v", err)}, nil
+		}
+	}
+
+	apiPlan, err := mappers.PlanToApi(*updated)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ImportPlanCalendar500JSONResponse{Message: fmt.Sprintf("failed to map plan: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.ImportPlanCalendar200JSONResponse(apiPlan), nil
+}
+
+// (GET /api/v1/plans/{id}/shares)
+func (h *ServiceHandler) ListPlanShares(ctx context.Context, request server.ListPlanSharesRequestObject) (server.ListPlanSharesResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("list_plan_shares").
+		WithUUID("plan_id", request.Id).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ListPlanShares404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ListPlanShares500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.ListPlanShares403JSONResponse{Message: message}, nil
+	}
+
+	shares, err := h.planSrv.ListShares(ctx, *p)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListPlanShares500JSONResponse{Message: fmt.Sprintf("failed to list shares: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(shares)).Log()
+	return server.ListPlanShares200JSONResponse(mappers.PlanShareListToApi(shares)), nil
+}
+
+// (POST /api/v1/plans/{id}/shares)
+func (h *ServiceHandler) CreatePlanShare(ctx context.Context, request server.CreatePlanShareRequestObject) (server.CreatePlanShareResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("create_plan_share").
+		WithUUID("plan_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.CreatePlanShare404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreatePlanShare500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.CreatePlanShare403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.CreatePlanShare400JSONResponse{Message: "empty body"}, nil
+	}
+
+	var ttl time.Duration
+	if hours := request.Body.ExpiresInHours; hours != nil {
+		// bounded before the conversion, which overflows on large values
+		if maxHours := int(service.MaxShareTTL / time.Hour); *hours < 1 || *hours > maxHours {
+			err := fmt.Errorf("expiresInHours must be between 1 and %d", maxHours)
+			logger.Error(err).Log()
+			return server.CreatePlanShare400JSONResponse{Message: err.Error()}, nil
+		}
+		ttl = time.Duration(*hours) * time.Hour
+	}
+
+	share, url, err := h.planSrv.CreateShare(ctx, *p, user.Username, ttl)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.CreatePlanShare400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreatePlanShare500JSONResponse{Message: fmt.Sprintf("failed to create share: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithUUID("share_id", share.ID).Log()
+	return server.CreatePlanShare201JSONResponse(mappers.PlanShareToApi(*share, url)), nil
+}
+
+// (DELETE /api/v1/plans/{id}/shares/{shareId})
+func (h *ServiceHandler) RevokePlanShare(ctx context.Context, request server.RevokePlanShareRequestObject) (server.RevokePlanShareResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("revoke_plan_share").
+		WithUUID("plan_id", request.Id).
+		WithUUID("share_id", request.ShareId).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RevokePlanShare404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RevokePlanShare500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.RevokePlanShare403JSONResponse{Message: message}, nil
+	}
+
+	share, err := h.planSrv.RevokeShare(ctx, *p, request.ShareId)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RevokePlanShare404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RevokePlanShare500JSONResponse{Message: fmt.Sprintf("failed to revoke share: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.RevokePlanShare200JSONResponse(mappers.PlanShareToApi(*share, "")), nil
+}
+
+// (GET /api/v1/plans/{id}/waves/{n}/live)
+func (h *ServiceHandler) GetPlanWaveLive(ctx context.Context, request server.GetPlanWaveLiveRequestObject) (server.GetPlanWaveLiveResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("get_plan_wave_live").
+		WithUUID("plan_id", request.Id).
+		WithInt("wave", request.N).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanWaveLive404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanWaveLive500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.GetPlanWaveLive403JSONResponse{Message: message}, nil
+	}
+
+	board, err := h.planSrv.WaveLive(ctx, *p, request.N, time.Now())
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetPlanWaveLive404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPlanWaveLive500JSONResponse{Message: fmt.Sprintf("failed to build wave status: %v", err)}, nil
+		}
+	}
+
+	doc, err := service.PlanDocument(*p)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetPlanWaveLive500JSONResponse{Message: fmt.Sprintf("failed to read plan: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("vms", len(board.VMs)).WithInt("blockers", len(board.Blockers)).Log()
+	return server.GetPlanWaveLive200JSONResponse(mappers.WaveLiveToApi(*board, doc.DisplayPolicy())), nil
+}
+
+// (PUT /api/v1/plans/{id}/waves/{n}/tracking)
+func (h *ServiceHandler) RecordPlanWaveTracking(ctx context.Context, request server.RecordPlanWaveTrackingRequestObject) (server.RecordPlanWaveTrackingResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("record_plan_wave_tracking").
+		WithUUID("plan_id", request.Id).
+		WithInt("wave", request.N).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RecordPlanWaveTracking404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RecordPlanWaveTracking500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.RecordPlanWaveTracking403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.RecordPlanWaveTracking400JSONResponse{Message: "empty body"}, nil
+	}
+
+	board, err := h.planSrv.RecordTracking(ctx, *p, request.N, mappers.WaveTrackingFromApi(v1alpha1.WaveTracking(*request.Body)))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.RecordPlanWaveTracking400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RecordPlanWaveTracking404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RecordPlanWaveTracking500JSONResponse{Message: fmt.Sprintf("failed to record wave tracking: %v", err)}, nil
+		}
+	}
+
+	doc, err := service.PlanDocument(*p)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.RecordPlanWaveTracking500JSONResponse{Message: fmt.Sprintf("failed to read plan: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("vms", len(board.VMs)).Log()
+	return server.RecordPlanWaveTracking200JSONResponse(mappers.WaveLiveToApi(*board, doc.DisplayPolicy())), nil
+}
+
+// (PUT /api/v1/plans/{id}/vm-actuals)
+func (h *ServiceHandler) RecordPlanVMActuals(ctx context.Context, request server.RecordPlanVMActualsRequestObject) (server.RecordPlanVMActualsResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("record_plan_vm_actuals").
+		WithUUID("plan_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	p, err := h.planSrv.GetPlan(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RecordPlanVMActuals404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RecordPlanVMActuals500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+		}
+	}
+
+	user := auth.MustHaveUser(ctx)
+	if user.Username != p.Username || user.Organization != p.OrgID {
+		message := fmt.Sprintf("forbidden to access plan %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("user", user.Username).WithString("plan_username", p.Username).Log()
+		return server.RecordPlanVMActuals403JSONResponse{Message: message}, nil
+	}
+
+	if request.Body == nil {
+		return server.RecordPlanVMActuals400JSONResponse{Message: "empty body"}, nil
+	}
+
+	actuals, err := h.planSrv.RecordPhaseActuals(ctx, *p, mappers.VMPhaseActualsFromApi(v1alpha1.VMPhaseActualList(*request.Body)))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.RecordPlanVMActuals400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RecordPlanVMActuals500JSONResponse{Message: fmt.Sprintf("failed to record VM actuals: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("count", len(actuals)).Log()
+	return server.RecordPlanVMActuals200JSONResponse(mappers.VMPhaseActualListToApi(actuals)), nil
+}
+
+// (GET /api/v1/duration-distributions)
+func (h *ServiceHandler) ListDurationDistributions(ctx context.Context, request server.ListDurationDistributionsRequestObject) (server.ListDurationDistributionsResponseObject, error) {
+	logger := log.NewDebugLogger("plan_handler").
+		WithContext(ctx).
+		Operation("list_duration_distributions").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	var fitters []distribution.Fitter
+	if request.Params.Family != nil {
+		fitter, ok := distribution.FitterOf(distribution.Family(*request.Params.Family))
+		if !ok {
+			return server.ListDurationDistributions400JSONResponse{Message: fmt.Sprintf("unknown distribution family %q", *request.Params.Family)}, nil
+		}
+		fitters = append(fitters, fitter)
+	}
+
+	fitted, err := h.planSrv.DurationDistributions(ctx, user.Organization, fitters...)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListDurationDistributions500JSONResponse{Message: fmt.Sprintf("failed to fit duration distributions: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(fitted)).Log()
+	return server.ListDurationDistributions200JSONResponse(mappers.DurationDistributionListToApi(fitted)), nil
+}
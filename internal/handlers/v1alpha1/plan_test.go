@@ -0,0 +1,1018 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	imageServer "github.com/kubev2v/migration-planner/internal/api/server/image"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/client"
+	"github.com/kubev2v/migration-planner/internal/config"
+	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/internal/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+func newPlanForm(name string) *v1alpha1.CreatePlanJSONRequestBody {
+	mode := v1alpha1.PlanModePipelined
+	overlaps := []v1alpha1.PlanOverlap{{Current: "Validation", Next: "Storage Migration"}}
+	wave := func(name string) v1alpha1.PlanWave {
+		return v1alpha1.PlanWave{
+			Name: name,
+			Steps: []v1alpha1.PlanStep{
+				{Phase: "Storage Migration", Effort: "24h"},
+				{Phase: "Validation", Effort: "48h"},
+			},
+		}
+	}
+	return &v1alpha1.CreatePlanJSONRequestBody{
+		Name:     name,
+		Start:    time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Mode:     &mode,
+		Overlaps: &overlaps,
+		Waves:    []v1alpha1.PlanWave{wave("wave-1"), wave("wave-2")},
+	}
+}
+
+// recordingNotifier keeps the alerts and events instead of delivering them. The alerts are sent in
+// the background.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	alerts []client.Alert
+	urls   []string
+	events []client.Event
+}
+
+func (n *recordingNotifier) NotifyTo(_ context.Context, webhookURL string, alert client.Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	n.urls = append(n.urls, webhookURL)
+	return nil
+}
+
+func (n *recordingNotifier) Alerts() []client.Alert {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return slices.Clone(n.alerts)
+}
+
+func (n *recordingNotifier) Publish(_ context.Context, event client.Event) error {
+	n.events = append(n.events, event)
+	return nil
+}
+
+var _ = Describe("plan handler", Ordered, func() {
+	var (
+		s        store.Store
+		gormdb   *gorm.DB
+		srv      *handlers.ServiceHandler
+		notifier *recordingNotifier
+		ctx      context.Context
+	)
+
+	BeforeAll(func() {
+		cfg, err := config.New()
+		Expect(err).To(BeNil())
+		db, err := store.InitDB(cfg)
+		Expect(err).To(BeNil())
+
+		s = store.NewStore(db)
+		gormdb = db
+		notifier = &recordingNotifier{}
+		cfg.Service.Auth.Admins = []string{"admin"}
+		srv = handlers.NewServiceHandler(nil, nil, nil, nil, nil, service.NewPlanService(s).WithNotifier(notifier, time.Second).WithEventPublisher(notifier).WithShareBaseURL("https://planner.example.com"), nil).
+			WithDebugService(service.NewDebugService(s, cfg, nil))
+		ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "admin", Organization: "admin"})
+	})
+
+	AfterAll(func() {
+		_ = s.Close()
+	})
+
+	AfterEach(func() {
+		gormdb.Exec("DELETE FROM plans;")
+		gormdb.Exec("DELETE FROM events;")
+		gormdb.Exec("DELETE FROM export_policies;")
+		gormdb.Exec("DELETE FROM checklist_templates;")
+		gormdb.Exec("DELETE FROM benchmark_samples;")
+		gormdb.Exec("DELETE FROM alert_webhooks;")
+		notifier.mu.Lock()
+		notifier.alerts = nil
+		notifier.urls = nil
+		notifier.mu.Unlock()
+		notifier.events = nil
+	})
+
+	createPlan := func(name string) v1alpha1.Plan {
+		resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: newPlanForm(name)})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreatePlan201JSONResponse{}).String()))
+		return v1alpha1.Plan(resp.(server.CreatePlan201JSONResponse))
+	}
+
+	Context("create", func() {
+		It("successfully creates a plan", func() {
+			plan := createPlan("exit")
+			Expect(plan.Name).To(Equal("exit"))
+			Expect(plan.Mode).To(Equal(v1alpha1.PlanModePipelined))
+			Expect(plan.Waves).To(HaveLen(2))
+			Expect(plan.Waves[0].Steps[1].Effort).To(Equal("48h0m0s"))
+		})
+
+		It("rejects an invalid duration", func() {
+			form := newPlanForm("exit")
+			form.Waves[0].Steps[0].Effort = "two days"
+
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreatePlan400JSONResponse{}).String()))
+		})
+
+		It("rejects a duplicate name", func() {
+			createPlan("exit")
+
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: newPlanForm("exit")})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreatePlan400JSONResponse{}).String()))
+		})
+	})
+
+	Context("gantt", func() {
+		It("renders the plan as JSON by default", func() {
+			plan := createPlan("exit")
+
+			resp, err := srv.GetPlanGantt(ctx, server.GetPlanGanttRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetPlanGantt200JSONResponse{}).String()))
+
+			gantt := resp.(server.GetPlanGantt200JSONResponse)
+			Expect(gantt.Bars).To(HaveLen(4))
+			Expect(gantt.Waves).To(HaveLen(2))
+			Expect(gantt.Dependencies).To(HaveLen(4))
+			Expect(gantt.End).To(Equal(plan.Start.Add(5 * 24 * time.Hour)))
+		})
+
+		It("renders the plan as SVG", func() {
+			plan := createPlan("exit")
+			format := v1alpha1.GanttFormatSvg
+
+			resp, err := srv.GetPlanGantt(ctx, server.GetPlanGanttRequestObject{Id: plan.Id, Params: v1alpha1.GetPlanGanttParams{Format: &format}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetPlanGantt200ImagesvgXmlResponse{}).String()))
+
+			body, err := io.ReadAll(resp.(server.GetPlanGantt200ImagesvgXmlResponse).Body)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(HavePrefix("<svg"))
+			Expect(string(body)).To(ContainSubstring("wave-2"))
+		})
+
+		It("renders the schedule in site-local and viewer-local time", func() {
+			form := newPlanForm("exit")
+			nightly := []v1alpha1.PlanMaintenanceWindow{{Name: "nightly", Start: "22:00", End: "04:00"}}
+			form.Sources = &[]v1alpha1.PlanSource{{Name: "vcenter-east", TimeZone: util.ToStrPtr("America/New_York"), MaintenanceWindows: &nightly}}
+			form.Waves[0].Source = util.ToStrPtr("vcenter-east")
+			form.Waves[1].Source = util.ToStrPtr("vcenter-east")
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			plan := v1alpha1.Plan(resp.(server.CreatePlan201JSONResponse))
+
+			viewer := "Europe/Paris"
+			resp2, err := srv.GetPlanGantt(ctx, server.GetPlanGanttRequestObject{Id: plan.Id, Params: v1alpha1.GetPlanGanttParams{TimeZone: &viewer}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp2).String()).To(Equal(reflect.TypeOf(server.GetPlanGantt200JSONResponse{}).String()))
+
+			gantt := resp2.(server.GetPlanGantt200JSONResponse)
+			Expect(*gantt.TimeZone).To(Equal(viewer))
+			bar := gantt.Bars[0]
+			Expect(bar.Site).NotTo(BeNil())
+			Expect(bar.Site.Start.Location().String()).To(Equal("America/New_York"))
+			Expect(bar.Site.Start.Equal(bar.Start)).To(BeTrue())
+			Expect(bar.Local.Start.Location().String()).To(Equal(viewer))
+
+			source := (*gantt.Sources)[0]
+			Expect(*source.TimeZone).To(Equal("America/New_York"))
+			// the 5 days of the plan, the first window opened the evening before it starts
+			Expect(*source.CutoverWindows).To(HaveLen(5))
+			window := (*source.CutoverWindows)[0]
+			Expect(window.Start.Hour()).To(Equal(22))
+			Expect(window.Start.Before(gantt.Start)).To(BeTrue())
+			Expect(window.Local.Start.Hour()).To(Equal(4))
+		})
+
+		It("rejects an unknown viewer time zone", func() {
+			plan := createPlan("exit")
+			viewer := "Europe/Atlantis"
+
+			resp, err := srv.GetPlanGantt(ctx, server.GetPlanGanttRequestObject{Id: plan.Id, Params: v1alpha1.GetPlanGanttParams{TimeZone: &viewer}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetPlanGantt400JSONResponse{}).String()))
+		})
+
+		It("returns 404 for an unknown plan", func() {
+			resp, err := srv.GetPlanGantt(ctx, server.GetPlanGanttRequestObject{Id: uuid.New()})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetPlanGantt404JSONResponse{}).String()))
+		})
+
+		It("returns 403 for a plan of another user", func() {
+			plan := createPlan("exit")
+			other := auth.NewTokenContext(context.TODO(), auth.User{Username: "batman", Organization: "batman"})
+
+			resp, err := srv.GetPlanGantt(other, server.GetPlanGanttRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetPlanGantt403JSONResponse{}).String()))
+		})
+	})
+
+	Context("export", func() {
+		It("exports the plan as MS Project XML", func() {
+			plan := createPlan("exit")
+
+			resp, err := srv.ExportPlan(ctx, server.ExportPlanRequestObject{Id: plan.Id, Params: v1alpha1.ExportPlanParams{Format: v1alpha1.ExportFormatMsproject}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.ExportPlan200ApplicationxmlResponse{}).String()))
+
+			body, err := io.ReadAll(resp.(server.ExportPlan200ApplicationxmlResponse).Body)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(ContainSubstring(`<Project xmlns="http://schemas.microsoft.com/project">`))
+			Expect(string(body)).To(ContainSubstring("<Name>exit</Name>"))
+		})
+
+		It("exports the plan as Smartsheet CSV", func() {
+			plan := createPlan("exit")
+
+			resp, err := srv.ExportPlan(ctx, server.ExportPlanRequestObject{Id: plan.Id, Params: v1alpha1.ExportPlanParams{Format: v1alpha1.ExportFormatSmartsheet}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.ExportPlan200TextcsvResponse{}).String()))
+
+			body, err := io.ReadAll(resp.(server.ExportPlan200TextcsvResponse).Body)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(HavePrefix("Task Name,Wave,Level"))
+			Expect(string(body)).To(ContainSubstring("wave-2"))
+		})
+
+		It("exports the waves as MTV plans annotated with their estimate", func() {
+			plan := createPlan("exit")
+
+			resp, err := srv.ExportPlan(ctx, server.ExportPlanRequestObject{Id: plan.Id, Params: v1alpha1.ExportPlanParams{Format: v1alpha1.ExportFormatMtv}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.ExportPlan200ApplicationyamlResponse{}).String()))
+
+			body, err := io.ReadAll(resp.(server.ExportPlan200ApplicationyamlResponse).Body)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(ContainSubstring("kind: Plan"))
+			Expect(string(body)).To(ContainSubstring("migration-planner.kubev2v.io/plan-id: " + plan.Id.String()))
+			Expect(string(body)).To(ContainSubstring(`"wave":"wave-2"`))
+		})
+
+		It("returns 403 for a plan of another user", func() {
+			plan := createPlan("exit")
+			other := auth.NewTokenContext(context.TODO(), auth.User{Username: "batman", Organization: "batman"})
+
+			resp, err := srv.ExportPlan(other, server.ExportPlanRequestObject{Id: plan.Id, Params: v1alpha1.ExportPlanParams{Format: v1alpha1.ExportFormatSmartsheet}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.ExportPlan403JSONResponse{}).String()))
+		})
+	})
+
+	Context("import schedule", func() {
+		It("stores the imported dates and reports the discrepancies", func() {
+			plan := createPlan("exit")
+
+			exported, err := srv.ExportPlan(ctx, server.ExportPlanRequestObject{Id: plan.Id, Params: v1alpha1.ExportPlanParams{Format: v1alpha1.ExportFormatMsproject}})
+			Expect(err).To(BeNil())
+			doc, err := io.ReadAll(exported.(server.ExportPlan200ApplicationxmlResponse).Body)
+			Expect(err).To(BeNil())
+
+			// the PM gives wave-1 storage migration a single hour
+			edited := strings.Replace(string(doc), "<Finish>2026-03-03T00:00:00</Finish>", "<Finish>2026-03-02T01:00:00</Finish>", 1)
+			Expect(edited).NotTo(Equal(string(doc)))
+
+			resp, err := srv.ImportPlanSchedule(ctx, server.ImportPlanScheduleRequestObject{Id: plan.Id, Body: strings.NewReader(edited)})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.ImportPlanSchedule200JSONResponse{}).String()))
+
+			result := resp.(server.ImportPlanSchedule200JSONResponse)
+			Expect(result.Plan.Schedule).NotTo(BeNil())
+			Expect(*result.Plan.Schedule).To(HaveLen(4))
+			Expect(result.Discrepancies).To(ContainElement(HaveField("Kind", v1alpha1.DiscrepancyEffortShortened)))
+		})
+
+		It("returns 400 for an invalid document", func() {
+			plan := createPlan("exit")
+
+			resp, err := srv.ImportPlanSchedule(ctx, server.ImportPlanScheduleRequestObject{Id: plan.Id, Body: strings.NewReader("wave-1,Pre-Copy")})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.ImportPlanSchedule400JSONResponse{}).String()))
+		})
+	})
+
+	Context("import calendar", func() {
+		It("sets the holidays of the pool team", func() {
+			form := newPlanForm("exit")
+			for i := range form.Waves {
+				pool := "engineers"
+				form.Waves[i].Steps[1].Pool = &pool
+			}
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			plan := resp.(server.CreatePlan201JSONResponse)
+
+			ics := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20260305\r\nSUMMARY:Company day\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+			region := v1alpha1.HolidayRegionDE
+			got, err := srv.ImportPlanCalendar(ctx, server.ImportPlanCalendarRequestObject{
+				Id:     plan.Id,
+				Pool:   "engineers",
+				Params: v1alpha1.ImportPlanCalendarParams{Region: &region},
+				Body:   strings.NewReader(ics),
+			})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(got).String()).To(Equal(reflect.TypeOf(server.ImportPlanCalendar200JSONResponse{}).String()))
+
+			calendars := *got.(server.ImportPlanCalendar200JSONResponse).Calendars
+			Expect(calendars).To(HaveKey("engineers"))
+			Expect(*calendars["engineers"].Region).To(Equal(v1alpha1.HolidayRegionDE))
+			Expect(*calendars["engineers"].Holidays).To(HaveLen(1))
+		})
+
+		It("returns 400 for an unknown pool", func() {
+			plan := createPlan("exit")
+
+			ics := "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+			got, err := srv.ImportPlanCalendar(ctx, server.ImportPlanCalendarRequestObject{Id: plan.Id, Pool: "contractors", Body: strings.NewReader(ics)})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(got).String()).To(Equal(reflect.TypeOf(server.ImportPlanCalendar400JSONResponse{}).String()))
+		})
+	})
+
+	Context("what-if", func() {
+		It("recomputes the end date with time-varying capacity", func() {
+			form := newPlanForm("exit")
+			pools := map[string]int{"engineers": 2}
+			form.Pools = &pools
+			for i := range form.Waves {
+				pool, units := "engineers", 2
+				form.Waves[i].Steps[1].Pool = &pool
+				form.Waves[i].Steps[1].Units = &units
+			}
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			plan := resp.(server.CreatePlan201JSONResponse)
+
+			// an engineer is away from the first day of validation for three days
+			start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+			got, err := srv.SimulatePlanStaffing(ctx, server.SimulatePlanStaffingRequestObject{Id: plan.Id, Body: &v1alpha1.SimulatePlanStaffingJSONRequestBody{
+				CapacityChanges: []v1alpha1.CapacityChange{
+					{Pool: "engineers", At: start.Add(24 * time.Hour), Delta: -1},
+					{Pool: "engineers", At: start.Add(96 * time.Hour), Delta: 1},
+				},
+			}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(got).String()).To(Equal(reflect.TypeOf(server.SimulatePlanStaffing200JSONResponse{}).String()))
+
+			result := got.(server.SimulatePlanStaffing200JSONResponse)
+			Expect(result.BaselineEnd).To(Equal(start.Add(120 * time.Hour)))
+			Expect(result.End).To(Equal(start.Add(192 * time.Hour)))
+			Expect(result.Delay).To(Equal("72h0m0s"))
+
+			stored, err := srv.GetPlan(ctx, server.GetPlanRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			Expect(stored.(server.GetPlan200JSONResponse).CapacityChanges).To(BeNil())
+		})
+
+		It("returns 400 for a change of an undeclared pool", func() {
+			plan := createPlan("exit")
+
+			got, err := srv.SimulatePlanStaffing(ctx, server.SimulatePlanStaffingRequestObject{Id: plan.Id, Body: &v1alpha1.SimulatePlanStaffingJSONRequestBody{
+				CapacityChanges: []v1alpha1.CapacityChange{{Pool: "contractors", At: plan.Start, Delta: 3}},
+			}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(got).String()).To(Equal(reflect.TypeOf(server.SimulatePlanStaffing400JSONResponse{}).String()))
+		})
+
+		It("reports the impact of a build-out milestone slip", func() {
+			form := newPlanForm("exit")
+			milestones := []v1alpha1.PlanMilestone{{Name: "cluster-a ready", Date: form.Start}}
+			form.Milestones = &milestones
+			gated := []string{"cluster-a ready"}
+			form.Waves[0].Milestones = &gated
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			plan := resp.(server.CreatePlan201JSONResponse)
+
+			slips := []v1alpha1.MilestoneSlip{{Milestone: "cluster-a ready", Date: form.Start.AddDate(0, 0, 7)}}
+			got, err := srv.SimulatePlanStaffing(ctx, server.SimulatePlanStaffingRequestObject{Id: plan.Id, Body: &v1alpha1.SimulatePlanStaffingJSONRequestBody{
+				CapacityChanges: []v1alpha1.CapacityChange{},
+				MilestoneSlips:  &slips,
+			}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(got).String()).To(Equal(reflect.TypeOf(server.SimulatePlanStaffing200JSONResponse{}).String()))
+
+			result := got.(server.SimulatePlanStaffing200JSONResponse)
+			Expect(result.Delay).To(Equal("168h0m0s"))
+			Expect(result.MilestoneImpacts).NotTo(BeNil())
+			Expect(*result.MilestoneImpacts).To(HaveLen(1))
+			Expect((*result.MilestoneImpacts)[0].Waves).To(Equal([]string{form.Waves[0].Name}))
+			Expect((*result.MilestoneImpacts)[0].Delay).To(Equal("168h0m0s"))
+		})
+	})
+
+	Context("progress", func() {
+		It("tracks the actuals against the KPIs and alerts the breaches once", func() {
+			_, err := s.Webhook().SaveAlertWebhook(ctx, model.AlertWebhook{OrgID: "admin", URL: "https://alerts.example.com/admin", UpdatedAt: time.Now()})
+			Expect(err).To(BeNil())
+			_, err = s.Webhook().SaveAlertWebhook(ctx, model.AlertWebhook{OrgID: "other", URL: "https://alerts.example.com/other", UpdatedAt: time.Now()})
+			Expect(err).To(BeNil())
+			plan := createPlan("exit")
+
+			velocity, p1 := 10.0, 0
+			resp, err := srv.SetPlanKPIs(ctx, server.SetPlanKPIsRequestObject{Id: plan.Id, Body: &v1alpha1.SetPlanKPIsJSONRequestBody{
+				MinVmsPerWeek:         &velocity,
+				MaxP1IncidentsPerWave: &p1,
+			}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.SetPlanKPIs200JSONResponse{}).String()))
+
+			wave := v1alpha1.RecordPlanProgressJSONRequestBody{
+				Wave:        "wave-1",
+				Start:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+				End:         time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+				VmsMigrated: 12,
+				P1Incidents: 1,
+			}
+			for range 2 {
+				resp, err := srv.RecordPlanProgress(ctx, server.RecordPlanProgressRequestObject{Id: plan.Id, Body: &wave})
+				Expect(err).To(BeNil())
+				Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.RecordPlanProgress200JSONResponse{}).String()))
+			}
+			Eventually(notifier.Alerts).Should(HaveLen(1))
+			Expect(notifier.Alerts()[0].Subject).To(Equal("p1-incidents (wave-1)"))
+			Expect(notifier.urls).To(Equal([]string{"https://alerts.example.com/admin"}))
+
+			got, err := srv.GetPlanProgress(ctx, server.GetPlanProgressRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			progress := got.(server.GetPlanProgress200JSONResponse)
+			Expect(progress.Waves).To(HaveLen(1))
+			Expect(progress.Kpis).To(HaveLen(2))
+			Expect(progress.Kpis[0].Kpi).To(Equal(v1alpha1.KPIVmsPerWeek))
+			Expect(progress.Kpis[0].Breached).To(BeFalse())
+			Expect(progress.Kpis[1].Breached).To(BeTrue())
+		})
+
+		It("drops the alerts of an organization without alert webhook", func() {
+			plan := createPlan("exit")
+
+			p1 := 0
+			resp, err := srv.SetPlanKPIs(ctx, server.SetPlanKPIsRequestObject{Id: plan.Id, Body: &v1alpha1.SetPlanKPIsJSONRequestBody{MaxP1IncidentsPerWave: &p1}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.SetPlanKPIs200JSONResponse{}).String()))
+
+			progress, err := srv.RecordPlanProgress(ctx, server.RecordPlanProgressRequestObject{Id: plan.Id, Body: &v1alpha1.RecordPlanProgressJSONRequestBody{
+				Wave:        "wave-1",
+				Start:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+				End:         time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+				VmsMigrated: 12,
+				P1Incidents: 1,
+			}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(progress).String()).To(Equal(reflect.TypeOf(server.RecordPlanProgress200JSONResponse{}).String()))
+			Consistently(notifier.Alerts, 200*time.Millisecond).Should(BeEmpty())
+		})
+
+		It("returns 400 for an unknown wave", func() {
+			plan := createPlan("exit")
+
+			resp, err := srv.RecordPlanProgress(ctx, server.RecordPlanProgressRequestObject{Id: plan.Id, Body: &v1alpha1.RecordPlanProgressJSONRequestBody{
+				Wave:  "wave-9",
+				Start: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+			}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.RecordPlanProgress400JSONResponse{}).String()))
+		})
+	})
+
+	Context("coverage", func() {
+		It("lists the gaps of the pools worked in shifts", func() {
+			form := newPlanForm("exit")
+			for i := range form.Waves {
+				pool := "engineers"
+				form.Waves[i].Steps[1].Pool = &pool
+			}
+			apac, emea := "Asia/Kolkata", "UTC"
+			shifts := map[string][]v1alpha1.Shift{"engineers": {
+				{Name: "apac", TimeZone: &apac, Start: "09:30", End: "17:30"},
+				{Name: "emea", TimeZone: &emea, Start: "12:00", End: "20:00"},
+			}}
+			form.Shifts = &shifts
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			plan := resp.(server.CreatePlan201JSONResponse)
+			Expect(*plan.Shifts).To(HaveKey("engineers"))
+
+			got, err := srv.GetPlanCoverage(ctx, server.GetPlanCoverageRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(got).String()).To(Equal(reflect.TypeOf(server.GetPlanCoverage200JSONResponse{}).String()))
+
+			coverage := got.(server.GetPlanCoverage200JSONResponse)
+			Expect(coverage).To(HaveLen(1))
+			Expect(coverage[0].Shifts).To(Equal([]string{"apac", "emea"}))
+			Expect(coverage[0].Gaps).To(HaveLen(10))
+			Expect(coverage[0].Gaps[1]).To(Equal(v1alpha1.CoverageGap{Day: "Monday", From: "20:00", To: "24:00"}))
+		})
+
+		It("returns 400 for shifts with an unknown time zone", func() {
+			form := newPlanForm("exit")
+			zone := "Europe/Atlantis"
+			shifts := map[string][]v1alpha1.Shift{"engineers": {{Name: "emea", TimeZone: &zone, Start: "08:00", End: "16:00"}}}
+			pools := map[string]int{"engineers": 2}
+			form.Pools = &pools
+			form.Shifts = &shifts
+
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreatePlan400JSONResponse{}).String()))
+		})
+	})
+
+	Context("shares", func() {
+		It("shares the report with a link until it is revoked", func() {
+			plan := createPlan("exit")
+			images := handlers.NewImageHandler(s, nil)
+
+			hours := 24
+			resp, err := srv.CreatePlanShare(ctx, server.CreatePlanShareRequestObject{Id: plan.Id, Body: &v1alpha1.PlanShareForm{ExpiresInHours: &hours}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreatePlanShare201JSONResponse{}).String()))
+			share := resp.(server.CreatePlanShare201JSONResponse)
+			Expect(share.Url).NotTo(BeNil())
+			Expect(*share.Url).To(HavePrefix("https://planner.example.com/api/v1/shares/"))
+			Expect(share.ExpiresAt).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+			token := strings.TrimPrefix(*share.Url, "https://planner.example.com/api/v1/shares/")
+
+			view, err := images.GetSharedReport(context.TODO(), imageServer.GetSharedReportRequestObject{Token: token})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(view).String()).To(Equal(reflect.TypeOf(imageServer.GetSharedReport200TexthtmlResponse{}).String()))
+			body, err := io.ReadAll(view.(imageServer.GetSharedReport200TexthtmlResponse).Body)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(ContainSubstring("<title>exit</title>"))
+
+			list, err := srv.ListPlanShares(ctx, server.ListPlanSharesRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			shares := list.(server.ListPlanShares200JSONResponse)
+			Expect(shares).To(HaveLen(1))
+			Expect(shares[0].Views).To(Equal(1))
+			Expect(shares[0].Url).To(BeNil())
+
+			revoked, err := srv.RevokePlanShare(ctx, server.RevokePlanShareRequestObject{Id: plan.Id, ShareId: share.Id})
+			Expect(err).To(BeNil())
+			Expect(revoked.(server.RevokePlanShare200JSONResponse).RevokedAt).NotTo(BeNil())
+
+			view, err = images.GetSharedReport(context.TODO(), imageServer.GetSharedReportRequestObject{Token: token})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(view).String()).To(Equal(reflect.TypeOf(imageServer.GetSharedReport404JSONResponse{}).String()))
+		})
+
+		It("does not serve unknown tokens", func() {
+			view, err := handlers.NewImageHandler(s, nil).GetSharedReport(context.TODO(), imageServer.GetSharedReportRequestObject{Token: "unknown"})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(view).String()).To(Equal(reflect.TypeOf(imageServer.GetSharedReport404JSONResponse{}).String()))
+		})
+
+		It("rejects links valid for too long", func() {
+			plan := createPlan("exit")
+
+			// math.MaxInt hours overflow a time.Duration
+			for _, hours := range []int{24 * 365, 0, -1, math.MaxInt} {
+				resp, err := srv.CreatePlanShare(ctx, server.CreatePlanShareRequestObject{Id: plan.Id, Body: &v1alpha1.PlanShareForm{ExpiresInHours: &hours}})
+				Expect(err).To(BeNil())
+				Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreatePlanShare400JSONResponse{}).String()), "%d hours", hours)
+			}
+		})
+
+		It("returns 404 when revoking the share of another plan", func() {
+			plan := createPlan("exit")
+			other := createPlan("other")
+
+			resp, err := srv.CreatePlanShare(ctx, server.CreatePlanShareRequestObject{Id: plan.Id, Body: &v1alpha1.PlanShareForm{}})
+			Expect(err).To(BeNil())
+			share := resp.(server.CreatePlanShare201JSONResponse)
+
+			revoked, err := srv.RevokePlanShare(ctx, server.RevokePlanShareRequestObject{Id: other.Id, ShareId: share.Id})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(revoked).String()).To(Equal(reflect.TypeOf(server.RevokePlanShare404JSONResponse{}).String()))
+		})
+	})
+
+	Context("checklists", func() {
+		createTemplate := func(form v1alpha1.ChecklistTemplateForm) v1alpha1.ChecklistTemplate {
+			resp, err := srv.CreateChecklistTemplate(ctx, server.CreateChecklistTemplateRequestObject{Body: &form})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreateChecklistTemplate201JSONResponse{}).String()))
+			return v1alpha1.ChecklistTemplate(resp.(server.CreateChecklistTemplate201JSONResponse))
+		}
+
+		It("instantiates the templates per VM at cutover and rolls their completion into the progress", func() {
+			plan := createPlan("exit")
+			createTemplate(v1alpha1.ChecklistTemplateForm{Name: "base", Items: []string{"OS boots", "Monitoring restored"}})
+			createTemplate(v1alpha1.ChecklistTemplateForm{Name: "billing", Items: []string{"App responds on port 8443"}, Applications: &[]string{"billing"}})
+			createTemplate(v1alpha1.ChecklistTemplateForm{Name: "windows", Items: []string{"Backup job rescheduled"}, OperatingSystems: &[]string{"windows"}})
+
+			vms := []v1alpha1.ChecklistVM{
+				{Id: "vm-1", Name: "billing-db", Application: util.ToStrPtr("billing"), Os: util.ToStrPtr("Red Hat Enterprise Linux 9")},
+				{Id: "vm-2", Name: "ad-1", Os: util.ToStrPtr("Microsoft Windows Server 2019")},
+			}
+			resp, err := srv.InstantiatePlanChecklists(ctx, server.InstantiatePlanChecklistsRequestObject{Id: plan.Id, Body: &v1alpha1.ChecklistInstantiation{Wave: "wave-1", Vms: vms}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.InstantiatePlanChecklists201JSONResponse{}).String()))
+			Expect(resp.(server.InstantiatePlanChecklists201JSONResponse)).To(HaveLen(4))
+
+			// instantiating again keeps the existing checklists
+			resp, err = srv.InstantiatePlanChecklists(ctx, server.InstantiatePlanChecklistsRequestObject{Id: plan.Id, Body: &v1alpha1.ChecklistInstantiation{Wave: "wave-1", Vms: vms}})
+			Expect(err).To(BeNil())
+			Expect(resp.(server.InstantiatePlanChecklists201JSONResponse)).To(BeEmpty())
+
+			list, err := srv.ListPlanChecklists(ctx, server.ListPlanChecklistsRequestObject{Id: plan.Id, Params: v1alpha1.ListPlanChecklistsParams{Wave: util.ToStrPtr("wave-1")}})
+			Expect(err).To(BeNil())
+			checklists := list.(server.ListPlanChecklists200JSONResponse)
+			Expect(checklists).To(HaveLen(4))
+
+			for _, c := range checklists {
+				if c.Vm.Id != "vm-2" {
+					continue
+				}
+				for i := range c.Items {
+					updated, err := srv.CompletePlanChecklistItem(ctx, server.CompletePlanChecklistItemRequestObject{Id: plan.Id, ChecklistId: c.Id, Index: i, Body: &v1alpha1.ChecklistItemForm{Done: true}})
+					Expect(err).To(BeNil())
+					Expect(reflect.TypeOf(updated).String()).To(Equal(reflect.TypeOf(server.CompletePlanChecklistItem200JSONResponse{}).String()))
+					item := updated.(server.CompletePlanChecklistItem200JSONResponse).Items[i]
+					Expect(item.Done).To(BeTrue())
+					Expect(*item.CompletedBy).To(Equal("admin"))
+				}
+			}
+
+			progress, err := srv.GetPlanProgress(ctx, server.GetPlanProgressRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			waves := progress.(server.GetPlanProgress200JSONResponse).Checklists
+			Expect(waves).NotTo(BeNil())
+			Expect(*waves).To(Equal([]v1alpha1.WaveChecklistProgress{{Wave: "wave-1", Vms: 2, VmsDone: 1, Items: 6, ItemsDone: 3}}))
+		})
+
+		It("rejects an unknown wave and an item out of range", func() {
+			plan := createPlan("exit")
+			createTemplate(v1alpha1.ChecklistTemplateForm{Name: "base", Items: []string{"OS boots"}})
+
+			resp, err := srv.InstantiatePlanChecklists(ctx, server.InstantiatePlanChecklistsRequestObject{Id: plan.Id, Body: &v1alpha1.ChecklistInstantiation{Wave: "wave-9", Vms: []v1alpha1.ChecklistVM{{Id: "vm-1", Name: "web"}}}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.InstantiatePlanChecklists400JSONResponse{}).String()))
+
+			resp, err = srv.InstantiatePlanChecklists(ctx, server.InstantiatePlanChecklistsRequestObject{Id: plan.Id, Body: &v1alpha1.ChecklistInstantiation{Wave: "wave-1", Vms: []v1alpha1.ChecklistVM{{Id: "vm-1", Name: "web"}}}})
+			Expect(err).To(BeNil())
+			created := resp.(server.InstantiatePlanChecklists201JSONResponse)
+			Expect(created).To(HaveLen(1))
+
+			updated, err := srv.CompletePlanChecklistItem(ctx, server.CompletePlanChecklistItemRequestObject{Id: plan.Id, ChecklistId: created[0].Id, Index: 1, Body: &v1alpha1.ChecklistItemForm{Done: true}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(updated).String()).To(Equal(reflect.TypeOf(server.CompletePlanChecklistItem400JSONResponse{}).String()))
+
+			other := createPlan("other")
+			updated, err = srv.CompletePlanChecklistItem(ctx, server.CompletePlanChecklistItemRequestObject{Id: other.Id, ChecklistId: created[0].Id, Index: 0, Body: &v1alpha1.ChecklistItemForm{Done: true}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(updated).String()).To(Equal(reflect.TypeOf(server.CompletePlanChecklistItem404JSONResponse{}).String()))
+		})
+
+		It("rejects a duplicate template and deletes templates of the organization only", func() {
+			template := createTemplate(v1alpha1.ChecklistTemplateForm{Name: "base", Items: []string{"OS boots"}})
+
+			resp, err := srv.CreateChecklistTemplate(ctx, server.CreateChecklistTemplateRequestObject{Body: &v1alpha1.ChecklistTemplateForm{Name: "base", Items: []string{"OS boots"}}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreateChecklistTemplate409JSONResponse{}).String()))
+
+			otherCtx := auth.NewTokenContext(context.TODO(), auth.User{Username: "batman", Organization: "gotham"})
+			deleted, err := srv.DeleteChecklistTemplate(otherCtx, server.DeleteChecklistTemplateRequestObject{Id: template.Id})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(deleted).String()).To(Equal(reflect.TypeOf(server.DeleteChecklistTemplate404JSONResponse{}).String()))
+
+			deleted, err = srv.DeleteChecklistTemplate(ctx, server.DeleteChecklistTemplateRequestObject{Id: template.Id})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(deleted).String()).To(Equal(reflect.TypeOf(server.DeleteChecklistTemplate200JSONResponse{}).String()))
+
+			list, err := srv.ListChecklistTemplates(ctx, server.ListChecklistTemplatesRequestObject{})
+			Expect(err).To(BeNil())
+			Expect(list.(server.ListChecklistTemplates200JSONResponse)).To(BeEmpty())
+		})
+	})
+
+	Context("wave live status", func() {
+		It("derives the state of the VMs from MTV and their checklists", func() {
+			plan := createPlan("exit")
+			_, err := srv.CreateChecklistTemplate(ctx, server.CreateChecklistTemplateRequestObject{Body: &v1alpha1.ChecklistTemplateForm{Name: "base", Items: []string{"OS boots"}}})
+			Expect(err).To(BeNil())
+			_, err = srv.InstantiatePlanChecklists(ctx, server.InstantiatePlanChecklistsRequestObject{Id: plan.Id, Body: &v1alpha1.ChecklistInstantiation{Wave: "wave-2", Vms: []v1alpha1.ChecklistVM{{Id: "vm-1", Name: "web-1"}}}})
+			Expect(err).To(BeNil())
+
+			started := time.Now().Add(-2 * time.Hour)
+			completed := time.Now().Add(-time.Hour)
+			resp, err := srv.RecordPlanWaveTracking(ctx, server.RecordPlanWaveTrackingRequestObject{Id: plan.Id, N: 2, Body: &v1alpha1.WaveTracking{Vms: []v1alpha1.VMTracking{
+				{Id: "vm-1", Name: "web-1", Phase: util.ToStrPtr("Completed"), Started: &started, Completed: &completed},
+				{Id: "vm-2", Name: "db-1", Phase: util.ToStrPtr("ImageConversion"), Started: &started, Error: util.ToStrPtr("virt-v2v failed")},
+				{Id: "vm-3", Name: "app-1", Phase: util.ToStrPtr("DiskTransfer"), Started: &started},
+			}}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.RecordPlanWaveTracking200JSONResponse{}).String()))
+
+			live, err := srv.GetPlanWaveLive(ctx, server.GetPlanWaveLiveRequestObject{Id: plan.Id, N: 2})
+			Expect(err).To(BeNil())
+			status := live.(server.GetPlanWaveLive200JSONResponse)
+			Expect(status.Wave).To(Equal("wave-2"))
+			Expect(status.Estimated).NotTo(Equal("0s"))
+			Expect(status.Vms).To(HaveLen(3))
+			Expect(status.Vms[0].Name).To(Equal("app-1"))
+			Expect(status.Vms[0].State).To(Equal(v1alpha1.VMLiveStateSyncing))
+			Expect(status.Vms[1].State).To(Equal(v1alpha1.VMLiveStateFailed))
+			Expect(status.Vms[2].State).To(Equal(v1alpha1.VMLiveStateValidating))
+			Expect(status.Blockers).To(ConsistOf(
+				v1alpha1.WaveBlocker{VmId: "vm-2", Vm: "db-1", Reason: "virt-v2v failed"},
+				v1alpha1.WaveBlocker{VmId: "vm-1", Vm: "web-1", Reason: "base: OS boots"},
+			))
+
+			// completing the checklist clears the blocker
+			list, err := srv.ListPlanChecklists(ctx, server.ListPlanChecklistsRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			checklist := list.(server.ListPlanChecklists200JSONResponse)[0]
+			_, err = srv.CompletePlanChecklistItem(ctx, server.CompletePlanChecklistItemRequestObject{Id: plan.Id, ChecklistId: checklist.Id, Index: 0, Body: &v1alpha1.ChecklistItemForm{Done: true}})
+			Expect(err).To(BeNil())
+
+			live, err = srv.GetPlanWaveLive(ctx, server.GetPlanWaveLiveRequestObject{Id: plan.Id, N: 2})
+			Expect(err).To(BeNil())
+			status = live.(server.GetPlanWaveLive200JSONResponse)
+			Expect(status.Vms[2].State).To(Equal(v1alpha1.VMLiveStateDone))
+			Expect(status.Blockers).To(HaveLen(1))
+			Expect(status.States).To(Equal(map[string]int{"syncing": 1, "failed": 1, "done": 1}))
+		})
+
+		It("returns 404 for an unknown wave", func() {
+			plan := createPlan("exit")
+
+			live, err := srv.GetPlanWaveLive(ctx, server.GetPlanWaveLiveRequestObject{Id: plan.Id, N: 3})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(live).String()).To(Equal(reflect.TypeOf(server.GetPlanWaveLive404JSONResponse{}).String()))
+		})
+	})
+
+	Context("duration distributions", func() {
+		It("fits a distribution to the actuals of each phase", func() {
+			plan := createPlan("exit")
+
+			start := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+			actuals := []v1alpha1.VMPhaseActual{}
+			for i, hours := range []float64{2, 3, 2.5, 4, 6, 3.5} {
+				actuals = append(actuals, v1alpha1.VMPhaseActual{
+					VmId:        fmt.Sprintf("vm-%d", i),
+					Phase:       "DiskTransfer",
+					StartedAt:   start,
+					CompletedAt: start.Add(time.Duration(hours * float64(time.Hour))),
+				})
+			}
+			actuals = append(actuals, v1alpha1.VMPhaseActual{VmId: "vm-0", Phase: "Cutover", Wave: util.ToStrPtr("wave-1"), StartedAt: start, CompletedAt: start.Add(time.Hour)})
+
+			resp, err := srv.RecordPlanVMActuals(ctx, server.RecordPlanVMActualsRequestObject{Id: plan.Id, Body: &v1alpha1.VMPhaseActualList{Actuals: actuals}})
+			Expect(err).To(BeNil())
+			Expect(resp.(server.RecordPlanVMActuals200JSONResponse).Actuals).To(HaveLen(7))
+
+			list, err := srv.ListDurationDistributions(ctx, server.ListDurationDistributionsRequestObject{})
+			Expect(err).To(BeNil())
+			distributions := list.(server.ListDurationDistributions200JSONResponse).Distributions
+			Expect(distributions).To(HaveLen(2))
+			Expect(distributions[0].Phase).To(Equal("Cutover"))
+			Expect(distributions[0].Family).To(BeNil())
+			Expect(*distributions[0].Error).To(ContainSubstring("at least"))
+			Expect(distributions[1].Phase).To(Equal("DiskTransfer"))
+			Expect(distributions[1].Samples).To(Equal(6))
+			Expect(distributions[1].Family).NotTo(BeNil())
+			Expect(*distributions[1].P50Hours).To(BeNumerically("~", 3.3, 0.5))
+
+			family := v1alpha1.DistributionFamilyGamma
+			list, err = srv.ListDurationDistributions(ctx, server.ListDurationDistributionsRequestObject{Params: v1alpha1.ListDurationDistributionsParams{Family: &family}})
+			Expect(err).To(BeNil())
+			distributions = list.(server.ListDurationDistributions200JSONResponse).Distributions
+			Expect(*distributions[1].Family).To(Equal(v1alpha1.DistributionFamilyGamma))
+			Expect(*distributions[1].Params).To(HaveKey("shape"))
+		})
+
+		It("rejects a phase completing before it started", func() {
+			plan := createPlan("exit")
+
+			start := time.Now()
+			resp, err := srv.RecordPlanVMActuals(ctx, server.RecordPlanVMActualsRequestObject{Id: plan.Id, Body: &v1alpha1.VMPhaseActualList{Actuals: []v1alpha1.VMPhaseActual{
+				{VmId: "vm-1", Phase: "Cutover", StartedAt: start, CompletedAt: start.Add(-time.Hour)},
+			}}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.RecordPlanVMActuals400JSONResponse{}).String()))
+		})
+
+		It("rejects an unknown family", func() {
+			family := v1alpha1.DistributionFamily("weibull")
+			resp, err := srv.ListDurationDistributions(ctx, server.ListDurationDistributionsRequestObject{Params: v1alpha1.ListDurationDistributionsParams{Family: &family}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.ListDurationDistributions400JSONResponse{}).String()))
+		})
+	})
+
+	Context("export policy", func() {
+		It("allows raw exports without watermark by default", func() {
+			resp, err := srv.GetExportPolicy(ctx, server.GetExportPolicyRequestObject{})
+			Expect(err).To(BeNil())
+			policy := resp.(server.GetExportPolicy200JSONResponse)
+			Expect(policy.RawExports).To(BeTrue())
+			Expect(policy.Watermark).To(BeFalse())
+			Expect(policy.UpdatedBy).To(BeNil())
+		})
+
+		It("watermarks the reports, forbids raw exports and records the exports", func() {
+			plan := createPlan("exit")
+
+			colleague := auth.NewTokenContext(context.TODO(), auth.User{Username: "colleague", Organization: "admin"})
+			forbidden, err := srv.SetExportPolicy(colleague, server.SetExportPolicyRequestObject{Body: &v1alpha1.ExportPolicyForm{Watermark: false, RawExports: true}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(forbidden).String()).To(Equal(reflect.TypeOf(server.SetExportPolicy403JSONResponse{}).String()))
+
+			resp, err := srv.SetExportPolicy(ctx, server.SetExportPolicyRequestObject{Body: &v1alpha1.ExportPolicyForm{Watermark: true, RawExports: false}})
+			Expect(err).To(BeNil())
+			policy := resp.(server.SetExportPolicy200JSONResponse)
+			Expect(*policy.UpdatedBy).To(Equal("admin"))
+
+			exported, err := srv.ExportPlan(ctx, server.ExportPlanRequestObject{Id: plan.Id, Params: v1alpha1.ExportPlanParams{Format: v1alpha1.ExportFormatMsproject}})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(exported).String()).To(Equal(reflect.TypeOf(server.ExportPlan403JSONResponse{}).String()))
+
+			format := v1alpha1.GanttFormatSvg
+			gantt, err := srv.GetPlanGantt(ctx, server.GetPlanGanttRequestObject{Id: plan.Id, Params: v1alpha1.GetPlanGanttParams{Format: &format}})
+			Expect(err).To(BeNil())
+			body, err := io.ReadAll(gantt.(server.GetPlanGantt200ImagesvgXmlResponse).Body)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(ContainSubstring("admin · admin · "))
+
+			events, err := srv.ListEvents(ctx, server.ListEventsRequestObject{})
+			Expect(err).To(BeNil())
+			last := events.(server.ListEvents200JSONResponse).Events
+			Expect(last[len(last)-1].Type).To(Equal(v1alpha1.EventReportExported))
+			Expect(last[len(last)-1].Payload).To(HaveKeyWithValue("format", "svg"))
+			Expect(last[len(last)-1].Payload).To(HaveKeyWithValue("watermarked", true))
+		})
+
+		It("contributes the anonymized outcome of completed plans once opted in", func() {
+			form := newPlanForm("exit")
+			rate := 10000.0
+			form.TransferRateMbps = &rate
+			resp, err := srv.CreatePlan(ctx, server.CreatePlanRequestObject{Body: form})
+			Expect(err).To(BeNil())
+			plan := resp.(server.CreatePlan201JSONResponse)
+			Expect(*plan.TransferRateMbps).To(Equal(10000.0))
+
+			record := func(wave string, start time.Time) {
+				_, err := srv.RecordPlanProgress(ctx, server.RecordPlanProgressRequestObject{Id: plan.Id, Body: &v1alpha1.RecordPlanProgressJSONRequestBody{
+					Wave:        wave,
+					Start:       start,
+					End:         start.Add(14 * 24 * time.Hour),
+					VmsMigrated: 300,
+				}})
+				Expect(err).To(BeNil())
+			}
+			countSamples := func() int64 {
+				var count int64
+				Expect(gormdb.Table("benchmark_samples").Count(&count).Error).To(BeNil())
+				return count
+			}
+
+			// not opted in
+			record("wave-1", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+			record("wave-2", time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC))
+			Expect(countSamples()).To(BeZero())
+
+			contribute := true
+			policy, err := srv.SetExportPolicy(ctx, server.SetExportPolicyRequestObject{Body: &v1alpha1.ExportPolicyForm{RawExports: true, ContributeBenchmarks: &contribute}})
+			Expect(err).To(BeNil())
+			Expect(policy.(server.SetExportPolicy200JSONResponse).ContributeBenchmarks).To(BeTrue())
+
+			// recording a wave again replaces the contribution of the plan
+			for range 2 {
+				record("wave-2", time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC))
+			}
+			Expect(countSamples()).To(Equal(int64(1)))
+
+			var sample struct {
+				VMBand      string
+				Network     string
+				ActualWeeks float64
+			}
+			Expect(gormdb.Table("benchmark_samples").Select("vm_band, network, actual_weeks").Scan(&sample).Error).To(BeNil())
+			Expect(sample.VMBand).To(Equal("500–1000 VMs"))
+			Expect(sample.Network).To(Equal("10GbE"))
+			Expect(sample.ActualWeeks).To(Equal(4.0))
+		})
+	})
+
+	Context("events", func() {
+		It("records and replays the domain events of the plans", func() {
+			plan := createPlan("exit")
+
+			velocity := 10.0
+			_, err := srv.SetPlanKPIs(ctx, server.SetPlanKPIsRequestObject{Id: plan.Id, Body: &v1alpha1.SetPlanKPIsJSONRequestBody{MinVmsPerWeek: &velocity}})
+			Expect(err).To(BeNil())
+			wave := v1alpha1.RecordPlanProgressJSONRequestBody{
+				Wave:        "wave-1",
+				Start:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+				End:         time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+				VmsMigrated: 12,
+			}
+			for range 2 {
+				_, err := srv.RecordPlanProgress(ctx, server.RecordPlanProgressRequestObject{Id: plan.Id, Body: &wave})
+				Expect(err).To(BeNil())
+			}
+			_, err = srv.DeletePlan(ctx, server.DeletePlanRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+
+			want := []v1alpha1.EventType{
+				v1alpha1.EventPlanCreated,
+				v1alpha1.EventParamsChanged,
+				v1alpha1.EventActualRecorded,
+				v1alpha1.EventWaveCompleted,
+				v1alpha1.EventActualRecorded,
+				v1alpha1.EventPlanDeleted,
+			}
+			Expect(notifier.events).To(HaveLen(len(want)))
+
+			// replay two events at a time
+			var replayed []v1alpha1.EventType
+			var after int64
+			limit := 2
+			for {
+				resp, err := srv.ListEvents(ctx, server.ListEventsRequestObject{Params: v1alpha1.ListEventsParams{After: &after, Limit: &limit}})
+				Expect(err).To(BeNil())
+				page := resp.(server.ListEvents200JSONResponse)
+				if len(page.Events) == 0 {
+					break
+				}
+				for _, e := range page.Events {
+					Expect(e.AggregateId).To(Equal(plan.Id.String()))
+					replayed = append(replayed, e.Type)
+				}
+				after = page.Next
+			}
+			Expect(replayed).To(Equal(want))
+		})
+
+		It("does not replay the events of other organizations", func() {
+			createPlan("exit")
+
+			other := auth.NewTokenContext(context.TODO(), auth.User{Username: "admin", Organization: "other"})
+			resp, err := srv.ListEvents(other, server.ListEventsRequestObject{})
+			Expect(err).To(BeNil())
+			Expect(resp.(server.ListEvents200JSONResponse).Events).To(BeEmpty())
+		})
+
+		It("does not replay the events of the plans of other users of the organization", func() {
+			createPlan("exit")
+
+			colleague := auth.NewTokenContext(context.TODO(), auth.User{Username: "colleague", Organization: "admin"})
+			resp, err := srv.ListEvents(colleague, server.ListEventsRequestObject{})
+			Expect(err).To(BeNil())
+			Expect(resp.(server.ListEvents200JSONResponse).Events).To(BeEmpty())
+
+			resp, err = srv.ListEvents(ctx, server.ListEventsRequestObject{})
+			Expect(err).To(BeNil())
+			Expect(resp.(server.ListEvents200JSONResponse).Events).NotTo(BeEmpty())
+		})
+	})
+
+	Context("delete", func() {
+		It("successfully deletes a plan", func() {
+			plan := createPlan("exit")
+
+			resp, err := srv.DeletePlan(ctx, server.DeletePlanRequestObject{Id: plan.Id})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.DeletePlan200JSONResponse{}).String()))
+
+			list, err := srv.ListPlans(ctx, server.ListPlansRequestObject{})
+			Expect(err).To(BeNil())
+			Expect(list.(server.ListPlans200JSONResponse)).To(HaveLen(0))
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/rvtools/jobs"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (POST /api/v1/reports/portfolio)
+func (h *ServiceHandler) CreatePortfolioReport(ctx context.Context, request server.CreatePortfolioReportRequestObject) (server.CreatePortfolioReportResponseObject, error) {
+	logger := log.NewDebugLogger("portfolio_handler").
+		WithContext(ctx).
+		Operation("create_portfolio_report").
+		Build()
+
+	if request.Body == nil {
+		return server.CreatePortfolioReport400JSONResponse{Message: "empty body"}, nil
+	}
+	form := *request.Body
+	if len(form.PlanIds) == 0 {
+		return server.CreatePortfolioReport400JSONResponse{Message: "at least one plan is required"}, nil
+	}
+	if m := form.FiscalYearStartMonth; m != nil && (*m < 1 || *m > 12) {
+		return server.CreatePortfolioReport400JSONResponse{Message: fmt.Sprintf("fiscal year start month must be between 1 and 12, got %d", *m)}, nil
+	}
+	var granularity cost.Granularity
+	if g := form.CashFlowGranularity; g != nil {
+		switch *g {
+		case v1alpha1.CashFlowGranularityMonth, v1alpha1.CashFlowGranularityQuarter:
+			granularity = cost.Granularity(*g)
+		default:
+			return server.CreatePortfolioReport400JSONResponse{Message: fmt.Sprintf("unknown cash flow granularity %q", *g)}, nil
+		}
+	}
+
+	// plans and rate cards of the organization are checked now, the job renders them later
+	user := auth.MustHaveUser(ctx)
+	for _, id := range form.PlanIds {
+		p, err := h.planSrv.GetPlan(ctx, id)
+		if err != nil {
+			switch err.(type) {
+			case *service.ErrResourceNotFound:
+				logger.Error(err).Log()
+				return server.CreatePortfolioReport404JSONResponse{Message: err.Error()}, nil
+			default:
+				logger.Error(err).Log()
+				return server.CreatePortfolioReport500JSONResponse{Message: fmt.Sprintf("failed to get plan: %v", err)}, nil
+			}
+		}
+		if user.Organization != p.OrgID {
+			message := fmt.Sprintf("forbidden to access plan %s by user %s", id, user.Username)
+			logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("org_id", user.Organization).Log()
+			return server.CreatePortfolioReport403JSONResponse{Message: message}, nil
+		}
+	}
+
+	if form.RateCardId != nil {
+		card, err := h.rateCardSrv.GetRateCard(ctx, *form.RateCardId)
+		if err != nil {
+			switch err.(type) {
+			case *service.ErrResourceNotFound:
+				logger.Error(err).Log()
+				return server.CreatePortfolioReport404JSONResponse{Message: err.Error()}, nil
+			default:
+				logger.Error(err).Log()
+				return server.CreatePortfolioReport500JSONResponse{Message: fmt.Sprintf("failed to get rate card: %v", err)}, nil
+			}
+		}
+		if user.Organization != card.OrgID {
+			message := fmt.Sprintf("forbidden to access rate card %s by user %s", *form.RateCardId, user.Username)
+			logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("org_id", user.Organization).Log()
+			return server.CreatePortfolioReport403JSONResponse{Message: message}, nil
+		}
+	}
+
+	args := jobs.PortfolioReportJobArgs{
+		PlanIDs:     form.PlanIds,
+		RateCardID:  form.RateCardId,
+		Granularity: granularity,
+		OrgID:       user.Organization,
+		Username:    user.Username,
+	}
+	if form.FiscalYearStartMonth != nil {
+		args.FiscalYearStart = time.Month(*form.FiscalYearStartMonth)
+	}
+	job, err := h.jobSrv.CreatePortfolioReportJob(ctx, args)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CreatePortfolioReport500JSONResponse{Message: fmt.Sprintf("failed to create job: %v", err)}, nil
+	}
+
+	logger.Success().WithParam("job_id", job.Id).Log()
+	return server.CreatePortfolioReport202JSONResponse(*job), nil
+}
+
+// (GET /api/v1/reports/portfolio/{id})
+func (h *ServiceHandler) GetPortfolioReport(ctx context.Context, request server.GetPortfolioReportRequestObject) (server.GetPortfolioReportResponseObject, error) {
+	logger := log.NewDebugLogger("portfolio_handler").
+		WithContext(ctx).
+		Operation("get_portfolio_report").
+		WithParam("job_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	report, job, err := h.jobSrv.GetPortfolioReport(ctx, request.Id, user.Organization, user.Username)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrJobNotFound:
+			logger.Error(err).Log()
+			return server.GetPortfolioReport404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrJobForbidden:
+			logger.Error(err).Log()
+			return server.GetPortfolioReport403JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetPortfolioReport500JSONResponse{Message: fmt.Sprintf("failed to get portfolio report: %v", err)}, nil
+		}
+	}
+	if report == nil {
+		logger.Success().WithString("status", string(job.Status)).Log()
+		return server.GetPortfolioReport202JSONResponse(*job), nil
+	}
+
+	apiReport, err := mappers.PortfolioReportToApi(*report)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetPortfolioReport500JSONResponse{Message: fmt.Sprintf("failed to map portfolio report: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("plans", len(apiReport.Plans)).Log()
+	return server.GetPortfolioReport200JSONResponse(apiReport), nil
+}
@@ -0,0 +1,157 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/rate-cards)
+func (h *ServiceHandler) ListRateCards(ctx context.Context, request server.ListRateCardsRequestObject) (server.ListRateCardsResponseObject, error) {
+	logger := log.NewDebugLogger("rate_card_handler").
+		WithContext(ctx).
+		Operation("list_rate_cards").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	name := ""
+	if request.Params.Name != nil {
+		name = *request.Params.Name
+	}
+
+	cards, err := h.rateCardSrv.ListRateCards(ctx, user.Organization, name)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListRateCards500JSONResponse{Message: fmt.Sprintf("failed to list rate cards: %v", err)}, nil
+	}
+
+	apiCards, err := mappers.RateCardListToApi(cards)
+	if err != nil {
+		return server.ListRateCards500JSONResponse{Message: fmt.Sprintf("failed to list rate cards: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(cards)).Log()
+	return server.ListRateCards200JSONResponse(apiCards), nil
+}
+
+// (POST /api/v1/rate-cards)
+func (h *ServiceHandler) CreateRateCard(ctx context.Context, request server.CreateRateCardRequestObject) (server.CreateRateCardResponseObject, error) {
+	logger := log.NewDebugLogger("rate_card_handler").
+		WithContext(ctx).
+		Operation("create_rate_card").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CreateRateCard400JSONResponse{Message: "empty body"}, nil
+	}
+
+	card := mappers.RateCardFormToRateCard(v1alpha1.RateCardForm(*request.Body))
+
+	created, err := h.rateCardSrv.CreateRateCard(ctx, user.Organization, user.Username, card)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest, *service.ErrDuplicateKey:
+			logger.Error(err).WithString("step", "validate_input").Log()
+			return server.CreateRateCard400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.CreateRateCard500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
+	apiCard, err := mappers.RateCardToApi(*created)
+	if err != nil {
+		return server.CreateRateCard500JSONResponse{Message: err.Error()}, nil
+	}
+
+	logger.Success().WithUUID("rate_card_id", created.ID).WithInt("version", created.Version).Log()
+	return server.CreateRateCard201JSONResponse(apiCard), nil
+}
+
+// (GET /api/v1/rate-cards/{id})
+func (h *ServiceHandler) GetRateCard(ctx context.Context, request server.GetRateCardRequestObject) (server.GetRateCardResponseObject, error) {
+	logger := log.NewDebugLogger("rate_card_handler").
+		WithContext(ctx).
+		Operation("get_rate_card").
+		WithUUID("rate_card_id", request.Id).
+		Build()
+
+	card, err := h.rateCardSrv.GetRateCard(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetRateCard404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetRateCard500JSONResponse{Message: fmt.Sprintf("failed to get rate card: %v", err)}, nil
+		}
+	}
+
+	// rate cards are shared within the organization
+	user := auth.MustHaveUser(ctx)
+	if user.Organization != card.OrgID {
+		message := fmt.Sprintf("forbidden to access rate card %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("org_id", user.Organization).Log()
+		return server.GetRateCard403JSONResponse{Message: message}, nil
+	}
+
+	apiCard, err := mappers.RateCardToApi(*card)
+	if err != nil {
+		return server.GetRateCard500JSONResponse{Message: fmt.Sprintf("failed to get rate card: %v", err)}, nil
+	}
+
+	logger.Success().WithString("rate_card_name", card.Name).Log()
+	return server.GetRateCard200JSONResponse(apiCard), nil
+}
+
+// (DELETE /api/v1/rate-cards/{id})
+func (h *ServiceHandler) DeleteRateCard(ctx context.Context, request server.DeleteRateCardRequestObject) (server.DeleteRateCardResponseObject, error) {
+	logger := log.NewDebugLogger("rate_card_handler").
+		WithContext(ctx).
+		Operation("delete_rate_card").
+		WithUUID("rate_card_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	card, err := h.rateCardSrv.GetRateCard(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).WithString("step", "get_for_delete").Log()
+			return server.DeleteRateCard404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).WithString("step", "get_for_delete").Log()
+			return server.DeleteRateCard500JSONResponse{Message: fmt.Sprintf("failed to get rate card: %v", err)}, nil
+		}
+	}
+
+	if user.Organization != card.OrgID {
+		message := fmt.Sprintf("forbidden to delete rate card %s by user %s", request.Id, user.Username)
+		logger.Error(fmt.Errorf("authorization failed: %s", message)).WithString("org_id", user.Organization).Log()
+		return server.DeleteRateCard403JSONResponse{Message: message}, nil
+	}
+
+	if err := h.rateCardSrv.DeleteRateCard(ctx, request.Id); err != nil {
+		logger.Error(err).Log()
+		return server.DeleteRateCard500JSONResponse{Message: fmt.Sprintf("failed to delete rate card: %v", err)}, nil
+	}
+
+	logger.Success().WithString("deleted_rate_card_name", card.Name).WithInt("version", card.Version).Log()
+	return server.DeleteRateCard200JSONResponse{}, nil
+}
@@ -0,0 +1,107 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/config"
+	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+func newRateCardForm(name string, engineerRate float64) *v1alpha1.CreateRateCardJSONRequestBody {
+	return &v1alpha1.CreateRateCardJSONRequestBody{
+		Name:        name,
+		Currency:    "EUR",
+		ValidFrom:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		HourlyRates: map[string]float64{"migration-engineer": engineerRate},
+	}
+}
+
+var _ = Describe("rate card handler", Ordered, func() {
+	var (
+		s      store.Store
+		gormdb *gorm.DB
+		srv    *handlers.ServiceHandler
+		ctx    context.Context
+	)
+
+	BeforeAll(func() {
+		cfg, err := config.New()
+		Expect(err).To(BeNil())
+		db, err := store.InitDB(cfg)
+		Expect(err).To(BeNil())
+
+		s = store.NewStore(db)
+		gormdb = db
+		srv = handlers.NewServiceHandler(nil, nil, nil, nil, nil, nil, service.NewRateCardService(s))
+		ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "admin", Organization: "admin"})
+	})
+
+	AfterAll(func() {
+		_ = s.Close()
+	})
+
+	AfterEach(func() {
+		gormdb.Exec("DELETE FROM rate_cards;")
+	})
+
+	It("creates a new version for an existing name", func() {
+		resp, err := srv.CreateRateCard(ctx, server.CreateRateCardRequestObject{Body: newRateCardForm("partner", 120)})
+		Expect(err).To(BeNil())
+		Expect(resp.(server.CreateRateCard201JSONResponse).Version).To(Equal(1))
+
+		resp, err = srv.CreateRateCard(ctx, server.CreateRateCardRequestObject{Body: newRateCardForm("partner", 130)})
+		Expect(err).To(BeNil())
+		second := resp.(server.CreateRateCard201JSONResponse)
+		Expect(second.Version).To(Equal(2))
+		Expect(second.HourlyRates["migration-engineer"]).To(Equal(130.0))
+
+		name := "partner"
+		list, err := srv.ListRateCards(ctx, server.ListRateCardsRequestObject{Params: v1alpha1.ListRateCardsParams{Name: &name}})
+		Expect(err).To(BeNil())
+		cards := list.(server.ListRateCards200JSONResponse)
+		Expect(cards).To(HaveLen(2))
+		Expect(cards[0].Version).To(Equal(2))
+	})
+
+	It("rejects a card without a valid currency", func() {
+		form := newRateCardForm("partner", 120)
+		form.Currency = "euro"
+
+		resp, err := srv.CreateRateCard(ctx, server.CreateRateCardRequestObject{Body: form})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreateRateCard400JSONResponse{}).String()))
+	})
+
+	It("shares cards within the organization only", func() {
+		resp, err := srv.CreateRateCard(ctx, server.CreateRateCardRequestObject{Body: newRateCardForm("internal", 90)})
+		Expect(err).To(BeNil())
+		id := resp.(server.CreateRateCard201JSONResponse).Id
+
+		colleague := auth.NewTokenContext(context.TODO(), auth.User{Username: "colleague", Organization: "admin"})
+		get, err := srv.GetRateCard(colleague, server.GetRateCardRequestObject{Id: id})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(get).String()).To(Equal(reflect.TypeOf(server.GetRateCard200JSONResponse{}).String()))
+
+		other := auth.NewTokenContext(context.TODO(), auth.User{Username: "batman", Organization: "batman"})
+		get, err = srv.GetRateCard(other, server.GetRateCardRequestObject{Id: id})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(get).String()).To(Equal(reflect.TypeOf(server.GetRateCard403JSONResponse{}).String()))
+	})
+
+	It("returns 404 for an unknown card", func() {
+		resp, err := srv.GetRateCard(ctx, server.GetRateCardRequestObject{Id: uuid.New()})
+		Expect(err).To(BeNil())
+		Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetRateCard404JSONResponse{}).String()))
+	})
+})
@@ -54,6 +54,82 @@ func (m *MockStore) Job() store.Job {
 	panic("Job() not implemented in MockStore for this test")
 }
 
+func (m *MockStore) Plan() store.Plan {
+	panic("Plan() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) RateCard() store.RateCard {
+	panic("RateCard() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) ChangeRateJob() store.ChangeRateJob {
+	panic("ChangeRateJob() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) Event() store.Event {
+	panic("Event() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) Share() store.Share {
+	panic("Share() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) ExportPolicy() store.ExportPolicy {
+	panic("ExportPolicy() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) ChecklistTemplate() store.ChecklistTemplate {
+	panic("ChecklistTemplate() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) Checklist() store.Checklist {
+	panic("Checklist() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) VMTracking() store.VMTracking {
+	panic("VMTracking() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) Benchmark() store.Benchmark {
+	panic("Benchmark() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) VMPhaseActual() store.VMPhaseActual {
+	panic("VMPhaseActual() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) TroubleshootingModel() store.TroubleshootingModel {
+	panic("TroubleshootingModel() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) ContingencyPolicy() store.ContingencyPolicy {
+	panic("ContingencyPolicy() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) CalculatorDefaults() store.CalculatorDefaults {
+	panic("CalculatorDefaults() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) EstimationRun() store.EstimationRun {
+	panic("EstimationRun() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) EstimationFreeze() store.EstimationFreeze {
+	panic("EstimationFreeze() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) GuardrailPolicy() store.GuardrailPolicy {
+	panic("GuardrailPolicy() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) InventoryFieldSchema() store.InventoryFieldSchema {
+	panic("InventoryFieldSchema() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) Webhook() store.Webhook {
+	panic("Webhook() not implemented in MockStore for this test")
+}
+
 func (m *MockStore) PrivateKey() store.PrivateKey {
 	panic("PrivateKey() not implemented in MockStore for this test")
 }
@@ -186,6 +262,8 @@ func setupTestHandler(store *MockStore, sizerResponse *client.SizerResponse, ass
 		nil,
 		service.NewSizerService(sizerClient, store),
 		nil,
+		nil,
+		nil,
 	)
 	return handler, testServer
 }
@@ -287,6 +365,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -319,6 +399,8 @@ var _ = Describe("sizer handler", func() {
 					nil,
 					service.NewSizerService(sizerClient, mockStore),
 					nil,
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -387,6 +469,8 @@ var _ = Describe("sizer handler", func() {
 						nil,
 						service.NewSizerService(sizerClient, mockStore),
 						nil,
+						nil,
+						nil,
 					)
 
 					resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -526,6 +610,8 @@ var _ = Describe("sizer handler", func() {
 					nil,
 					service.NewSizerService(sizerClient, mockStore),
 					nil,
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -551,6 +637,8 @@ var _ = Describe("sizer handler", func() {
 					nil,
 					service.NewSizerService(sizerClient, mockStore),
 					nil,
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -580,6 +668,8 @@ var _ = Describe("sizer handler", func() {
 					nil,
 					service.NewSizerService(sizerClient, mockStore),
 					nil,
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -611,6 +701,8 @@ var _ = Describe("sizer handler", func() {
 					nil,
 					service.NewSizerService(sizerClient, mockStore),
 					nil,
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -641,6 +733,8 @@ var _ = Describe("sizer handler", func() {
 					nil,
 					service.NewSizerService(sizerClient, mockStore),
 					nil,
+					nil,
+					nil,
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -672,6 +766,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -702,6 +798,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -733,6 +831,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -767,6 +867,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -801,6 +903,8 @@ var _ = Describe("sizer handler", func() {
 						nil,
 						service.NewSizerService(sizerClient, mockStore),
 						nil,
+						nil,
+						nil,
 					)
 
 					resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -836,6 +940,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -870,6 +976,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -902,6 +1010,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -933,6 +1043,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -965,6 +1077,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -999,6 +1113,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -1034,6 +1150,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -1065,6 +1183,8 @@ var _ = Describe("sizer handler", func() {
 					nil, // jobService
 					service.NewSizerService(sizerClient, mockStore),
 					nil, // estimationService
+					nil, // planService
+					nil, // rateCardService
 				)
 
 				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
@@ -21,6 +21,10 @@ type ServiceHandler struct {
 	jobSrv        *service.JobService
 	sizerSrv      *service.SizerService
 	estimationSrv *service.EstimationService
+	planSrv       *service.PlanService
+	rateCardSrv   *service.RateCardService
+	debugSrv      *service.DebugService
+	webhookSrv    *service.WebhookService
 }
 
 func NewServiceHandler(
@@ -29,6 +33,8 @@ func NewServiceHandler(
 	j *service.JobService,
 	sizer *service.SizerService,
 	estimation *service.EstimationService,
+	plan *service.PlanService,
+	rateCard *service.RateCardService,
 ) *ServiceHandler {
 	return &ServiceHandler{
 		sourceSrv:     sourceService,
@@ -36,9 +42,23 @@ func NewServiceHandler(
 		jobSrv:        j,
 		sizerSrv:      sizer,
 		estimationSrv: estimation,
+		planSrv:       plan,
+		rateCardSrv:   rateCard,
 	}
 }
 
+// WithDebugService enables the support bundles. Without it, nobody is allowed to download them.
+func (s *ServiceHandler) WithDebugService(debug *service.DebugService) *ServiceHandler {
+	s.debugSrv = debug
+	return s
+}
+
+// WithWebhookService enables the webhook delivery dashboard and redrive for the administrators.
+func (s *ServiceHandler) WithWebhookService(webhooks *service.WebhookService) *ServiceHandler {
+	s.webhookSrv = webhooks
+	return s
+}
+
 // validateSourceData validates the source data using the source validation rules
 func validateSourceData(data interface{}) error {
 	v := validator.NewValidator()
@@ -56,8 +76,18 @@ func (s *ServiceHandler) ListSources(ctx context.Context, request server.ListSou
 	if err != nil {
 		return server.ListSources500JSONResponse{}, nil
 	}
+	raw, err := s.rawExportsAllowed(ctx, user.Organization)
+	if err != nil {
+		return server.ListSources500JSONResponse{Message: fmt.Sprintf("failed to get export policy: %v", err)}, nil
+	}
 
-	return server.ListSources200JSONResponse(mappers.SourceListToApi(sources)), nil
+	response := mappers.SourceListToApi(sources)
+	if !raw {
+		for i := range response {
+			response[i].Inventory = nil
+		}
+	}
+	return server.ListSources200JSONResponse(response), nil
 }
 
 // (POST /api/v1/sources)
@@ -146,10 +176,18 @@ func (s *ServiceHandler) GetSource(ctx context.Context, request server.GetSource
 		return server.GetSource403JSONResponse{Message: message}, nil
 	}
 
+	raw, err := s.rawExportsAllowed(ctx, user.Organization)
+	if err != nil {
+		return server.GetSource500JSONResponse{Message: fmt.Sprintf("failed to get export policy: %v", err)}, nil
+	}
+
 	response, err := mappers.SourceToApi(*source)
 	if err != nil {
 		return server.GetSource500JSONResponse{Message: fmt.Sprintf("failed to map source to api: %v", err)}, nil
 	}
+	if !raw {
+		response.Inventory = nil
+	}
 
 	return server.GetSource200JSONResponse(response), nil
 }
@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/troubleshooting-model)
+func (h *ServiceHandler) GetTroubleshootingModel(ctx context.Context, request server.GetTroubleshootingModelRequestObject) (server.GetTroubleshootingModelResponseObject, error) {
+	logger := log.NewDebugLogger("troubleshooting_model_handler").
+		WithContext(ctx).
+		Operation("get_troubleshooting_model").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	m, err := h.estimationSrv.GetTroubleshootingModel(ctx, user.Organization)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetTroubleshootingModel404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetTroubleshootingModel500JSONResponse{Message: fmt.Sprintf("failed to get troubleshooting model: %v", err)}, nil
+		}
+	}
+
+	apiModel, err := mappers.TroubleshootingModelToApi(*m)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetTroubleshootingModel500JSONResponse{Message: fmt.Sprintf("failed to map troubleshooting model: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.GetTroubleshootingModel200JSONResponse(apiModel), nil
+}
+
+// (PUT /api/v1/troubleshooting-model)
+func (h *ServiceHandler) SetTroubleshootingModel(ctx context.Context, request server.SetTroubleshootingModelRequestObject) (server.SetTroubleshootingModelResponseObject, error) {
+	logger := log.NewDebugLogger("troubleshooting_model_handler").
+		WithContext(ctx).
+		Operation("set_troubleshooting_model").
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.SetTroubleshootingModel400JSONResponse{Message: "empty body"}, nil
+	}
+
+	m, err := h.estimationSrv.SetTroubleshootingModel(ctx, user.Organization, user.Username, mappers.TroubleshootingModelFormToModel(v1alpha1.TroubleshootingModelForm(*request.Body)))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.SetTroubleshootingModel400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.SetTroubleshootingModel500JSONResponse{Message: fmt.Sprintf("failed to set troubleshooting model: %v", err)}, nil
+		}
+	}
+
+	apiModel, err := mappers.TroubleshootingModelToApi(*m)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.SetTroubleshootingModel500JSONResponse{Message: fmt.Sprintf("failed to map troubleshooting model: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.SetTroubleshootingModel200JSONResponse(apiModel), nil
+}
+
+// (DELETE /api/v1/troubleshooting-model)
+func (h *ServiceHandler) DeleteTroubleshootingModel(ctx context.Context, request server.DeleteTroubleshootingModelRequestObject) (server.DeleteTroubleshootingModelResponseObject, error) {
+	logger := log.NewDebugLogger("troubleshooting_model_handler").
+		WithContext(ctx).
+		Operation("delete_troubleshooting_model").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	m, err := h.estimationSrv.DeleteTroubleshootingModel(ctx, user.Organization)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.DeleteTroubleshootingModel404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.DeleteTroubleshootingModel500JSONResponse{Message: fmt.Sprintf("failed to delete troubleshooting model: %v", err)}, nil
+		}
+	}
+
+	apiModel, err := mappers.TroubleshootingModelToApi(*m)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.DeleteTroubleshootingModel500JSONResponse{Message: fmt.Sprintf("failed to map troubleshooting model: %v", err)}, nil
+	}
+
+	logger.Success().Log()
+	return server.DeleteTroubleshootingModel200JSONResponse(apiModel), nil
+}
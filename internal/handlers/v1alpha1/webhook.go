@@ -0,0 +1,125 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// isWebhookAdmin tells whether the user administers the webhooks: only the administrators do, once
+// the webhook service is enabled.
+func (h *ServiceHandler) isWebhookAdmin(user auth.User) bool {
+	return h.webhookSrv != nil && h.debugSrv != nil && h.debugSrv.IsAdmin(user)
+}
+
+// (GET /api/v1/webhooks)
+func (h *ServiceHandler) ListWebhooks(ctx context.Context, request server.ListWebhooksRequestObject) (server.ListWebhooksResponseObject, error) {
+	logger := log.NewDebugLogger("webhook_handler").
+		WithContext(ctx).
+		Operation("list_webhooks").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	if !h.isWebhookAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.ListWebhooks403JSONResponse{Message: err.Error()}, nil
+	}
+
+	endpoints, err := h.webhookSrv.Endpoints(ctx)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListWebhooks500JSONResponse{Message: fmt.Sprintf("failed to list webhooks: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(endpoints)).Log()
+	return server.ListWebhooks200JSONResponse(mappers.WebhookEndpointsToApi(endpoints)), nil
+}
+
+// (GET /api/v1/webhooks/{id}/deliveries)
+func (h *ServiceHandler) ListWebhookDeliveries(ctx context.Context, request server.ListWebhookDeliveriesRequestObject) (server.ListWebhookDeliveriesResponseObject, error) {
+	logger := log.NewDebugLogger("webhook_handler").
+		WithContext(ctx).
+		Operation("list_webhook_deliveries").
+		WithString("endpoint_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	if !h.isWebhookAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.ListWebhookDeliveries403JSONResponse{Message: err.Error()}, nil
+	}
+
+	var status string
+	if request.Params.Status != nil {
+		status = string(*request.Params.Status)
+	}
+	var limit int
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	deliveries, err := h.webhookSrv.Deliveries(ctx, request.Id, status, limit)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ListWebhookDeliveries404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.ListWebhookDeliveries400JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ListWebhookDeliveries500JSONResponse{Message: fmt.Sprintf("failed to list deliveries: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("count", len(deliveries)).Log()
+	return server.ListWebhookDeliveries200JSONResponse(mappers.WebhookDeliveriesToApi(deliveries)), nil
+}
+
+// (POST /api/v1/webhooks/{id}/redrive)
+func (h *ServiceHandler) RedriveWebhook(ctx context.Context, request server.RedriveWebhookRequestObject) (server.RedriveWebhookResponseObject, error) {
+	logger := log.NewDebugLogger("webhook_handler").
+		WithContext(ctx).
+		Operation("redrive_webhook").
+		WithString("endpoint_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	if !h.isWebhookAdmin(user) {
+		err := fmt.Errorf("user %s is not an administrator", user.Username)
+		logger.Error(err).Log()
+		return server.RedriveWebhook403JSONResponse{Message: err.Error()}, nil
+	}
+
+	result, err := h.webhookSrv.Redrive(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.RedriveWebhook404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.RedriveWebhook500JSONResponse{Message: fmt.Sprintf("failed to redrive webhook: %v", err)}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("username", user.Username).
+		WithInt("redriven", result.Redriven).
+		WithInt("failed", result.Failed).
+		Log()
+	return server.RedriveWebhook200JSONResponse{
+		Redriven:  result.Redriven,
+		Delivered: result.Delivered,
+		Failed:    result.Failed,
+		Disabled:  result.Disabled,
+	}, nil
+}
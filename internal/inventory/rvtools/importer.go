@@ -0,0 +1,282 @@
+// Package rvtools imports an RVTools export, as an xlsx workbook or a CSV export of its vInfo tab,
+// into the per-VM inventory model and the params of the calculators. It is the way in for the
+// customers who share RVTools output but no vCenter credentials.
+package rvtools
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Tabs of the RVTools workbook read by the importer. Only vInfo is required.
+const (
+	TabVInfo     = "vInfo"
+	TabVDisk     = "vDisk"
+	TabVNetwork  = "vNetwork"
+	TabVSnapshot = "vSnapshot"
+	TabVHost     = "vHost"
+	TabVUSB      = "vUSB"
+	TabVTools    = "vTools"
+)
+
+const mibPerGB = 1024
+
+// ErrMissingVInfo is the error of an export without the vInfo tab, or a vInfo tab without the VM
+// column.
+var ErrMissingVInfo = errors.New("RVTools export has no vInfo tab with a VM column")
+
+// ImportXLSX reads an RVTools workbook. The VMs come from the vInfo tab, completed with the disks of
+// vDisk, the adapters of vNetwork, the snapshots of vSnapshot, the USB devices of vUSB and the
+// VMware Tools states of vTools when the workbook has them.
+func ImportXLSX(r io.Reader) (Inventory, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return Inventory{}, fmt.Errorf("reading RVTools workbook: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	tabs := make(map[string]tab)
+	for _, name := range []string{TabVInfo, TabVDisk, TabVNetwork, TabVSnapshot, TabVHost, TabVUSB, TabVTools} {
+		if idx, _ := f.GetSheetIndex(name); idx < 0 {
+			continue
+		}
+		rows, err := f.GetRows(name)
+		if err != nil {
+			return Inventory{}, fmt.Errorf("reading tab %s: %w", name, err)
+		}
+		tabs[name] = newTab(rows)
+	}
+	return build(tabs)
+}
+
+// ImportCSV reads the CSV export of the vInfo tab, comma or semicolon separated. The CSV export has
+// no disk, network or snapshot details: the disk size comes from the provisioned capacity of the VMs.
+func ImportCSV(r io.Reader) (Inventory, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(4096)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return Inventory{}, fmt.Errorf("reading RVTools CSV: %w", err)
+	}
+
+	reader := csv.NewReader(br)
+	reader.FieldsPerRecord = -1
+	if line, _, _ := bytes.Cut(header, []byte("\n")); bytes.Count(line, []byte(";")) > bytes.Count(line, []byte(",")) {
+		reader.Comma = ';'
+	}
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return Inventory{}, fmt.Errorf("reading RVTools CSV: %w", err)
+	}
+	return build(map[string]tab{TabVInfo: newTab(rows)})
+}
+
+func build(tabs map[string]tab) (Inventory, error) {
+	vInfo, ok := tabs[TabVInfo]
+	if !ok || !vInfo.has("VM") {
+		return Inventory{}, ErrMissingVInfo
+	}
+
+	var inv Inventory
+	index := make(map[string]int)
+	for _, row := range vInfo.rows {
+		name := vInfo.get(row, "VM")
+		if name == "" || parseBool(vInfo.get(row, "Template")) {
+			continue
+		}
+		vm := inventory.VM{
+			ID:                    vInfo.get(row, "VM ID"),
+			Name:                  name,
+			Datacenter:            vInfo.get(row, "Datacenter"),
+			Cluster:               vInfo.get(row, "Cluster"),
+			VApp:                  vInfo.get(row, "vApp"),
+			GuestOS:               firstOf(vInfo.get(row, "OS according to the configuration file"), vInfo.get(row, "OS according to the VMware Tools")),
+			PowerState:            vInfo.get(row, "Powerstate"),
+			CPUCount:              int(parseNumber(vInfo.get(row, "CPUs"))),
+			MemoryMB:              int(parseNumber(vInfo.get(row, "Memory"))),
+			ChangeTrackingEnabled: parseBool(vInfo.get(row, "CBT")),
+			Firmware:              strings.ToLower(vInfo.get(row, "Firmware")),
+			SecureBoot:            parseBool(vInfo.get(row, "EFI Secure boot")),
+			TPM:                   parseBool(vInfo.get(row, "vTPM")),
+		}
+		// the folder path as exported, e.g. "/DC1/vm/Finance/Payroll"
+		vm.Folder = inventory.NormalizeFolder(vInfo.get(row, "Folder"), vm.Datacenter)
+		// the provisioned capacity, replaced by the disks of vDisk when the workbook has them
+		vm.DiskGB = parseNumber(firstOf(vInfo.get(row, "Total disk capacity MiB"), vInfo.get(row, "Provisioned MiB"))) / mibPerGB
+		index[key(vm.ID, vm.Name)] = len(inv.VMs)
+		inv.VMs = append(inv.VMs, vm)
+		if inv.VCenterVersion == "" {
+			inv.VCenterVersion = vInfo.get(row, "VI SDK API Version")
+		}
+	}
+	inv.Snapshots = make([]int, len(inv.VMs))
+
+	lookup := func(t tab, row []string) (int, bool) {
+		i, ok := index[key(t.get(row, "VM ID"), t.get(row, "VM"))]
+		return i, ok
+	}
+
+	if vDisk, ok := tabs[TabVDisk]; ok {
+		seen := make(map[int]bool)
+		for _, row := range vDisk.rows {
+			i, ok := lookup(vDisk, row)
+			if !ok {
+				continue
+			}
+			if !seen[i] {
+				inv.VMs[i].DiskGB = 0
+				seen[i] = true
+			}
+			disk := inventory.Disk{
+				Controller: vDisk.get(row, "Controller"),
+				CapacityGB: parseNumber(vDisk.get(row, "Capacity MiB")) / mibPerGB,
+				RDM:        parseBool(vDisk.get(row, "Raw")),
+				Mode:       vDisk.get(row, "Disk Mode"),
+			}
+			disk.Bus = bus(disk.Controller)
+			inv.VMs[i].Disks = append(inv.VMs[i].Disks, disk)
+			inv.VMs[i].DiskGB += disk.CapacityGB
+		}
+	}
+
+	if vNetwork, ok := tabs[TabVNetwork]; ok {
+		for _, row := range vNetwork.rows {
+			if i, ok := lookup(vNetwork, row); ok {
+				inv.VMs[i].NICs = append(inv.VMs[i].NICs, inventory.NIC{Adapter: vNetwork.get(row, "Adapter")})
+			}
+		}
+	}
+
+	if vSnapshot, ok := tabs[TabVSnapshot]; ok {
+		for _, row := range vSnapshot.rows {
+			if i, ok := lookup(vSnapshot, row); ok {
+				inv.Snapshots[i]++
+			}
+		}
+	}
+
+	if vUSB, ok := tabs[TabVUSB]; ok {
+		for _, row := range vUSB.rows {
+			if i, ok := lookup(vUSB, row); ok {
+				inv.VMs[i].USBDevices++
+			}
+		}
+	}
+
+	if vTools, ok := tabs[TabVTools]; ok {
+		for _, row := range vTools.rows {
+			if i, ok := lookup(vTools, row); ok {
+				inv.VMs[i].ToolsStatus = vTools.get(row, "Tools")
+			}
+		}
+	}
+
+	if vHost, ok := tabs[TabVHost]; ok {
+		for _, row := range vHost.rows {
+			name := vHost.get(row, "Host")
+			if name == "" {
+				continue
+			}
+			inv.Hosts = append(inv.Hosts, inventory.Host{
+				ID:      firstOf(vHost.get(row, "Object ID"), name),
+				Vendor:  vHost.get(row, "Vendor"),
+				Model:   vHost.get(row, "Model"),
+				Version: vHost.get(row, "ESX Version"),
+			})
+		}
+	}
+
+	return inv, nil
+}
+
+// key identifies a VM across the tabs: by its ID when the export has the VM ID columns, by its name
+// otherwise.
+func key(id, name string) string {
+	if id != "" {
+		return "id:" + id
+	}
+	return "name:" + name
+}
+
+// bus returns the bus of a controller from its RVTools label, e.g. "SCSI controller 0".
+func bus(controller string) string {
+	c := strings.ToLower(controller)
+	for _, b := range []string{"nvme", "sata", "scsi", "ide"} {
+		if strings.Contains(c, b) {
+			return b
+		}
+	}
+	return ""
+}
+
+// tab is a tab of the export: its rows and the index of its columns by header.
+type tab struct {
+	columns map[string]int
+	rows    [][]string
+}
+
+func newTab(rows [][]string) tab {
+	t := tab{columns: make(map[string]int)}
+	if len(rows) == 0 {
+		return t
+	}
+	for i, name := range rows[0] {
+		// the first cell of a CSV export may start with a byte order mark
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if _, ok := t.columns[name]; !ok {
+			t.columns[name] = i
+		}
+	}
+	t.rows = rows[1:]
+	return t
+}
+
+func (t tab) has(column string) bool {
+	_, ok := t.columns[column]
+	return ok
+}
+
+// get returns the trimmed value of the column in the row, empty when the tab has no such column.
+func (t tab) get(row []string, column string) string {
+	i, ok := t.columns[column]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+// parseNumber parses a number written with or without thousands separators, zero when it is not one.
+func parseNumber(s string) float64 {
+	v, err := strconv.ParseFloat(strings.ReplaceAll(s, ",", ""), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func parseBool(s string) bool {
+	switch strings.ToLower(s) {
+	case "true", "1", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
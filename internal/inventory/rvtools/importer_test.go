@@ -0,0 +1,197 @@
+package rvtools
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func workbook(t *testing.T, tabs map[string][][]string) *bytes.Buffer {
+	t.Helper()
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	for name, rows := range tabs {
+		if _, err := f.NewSheet(name); err != nil {
+			t.Fatalf("creating tab %s: %v", name, err)
+		}
+		for i, row := range rows {
+			cell, _ := excelize.CoordinatesToCellName(1, i+1)
+			values := make([]any, len(row))
+			for j, v := range row {
+				values[j] = v
+			}
+			if err := f.SetSheetRow(name, cell, &values); err != nil {
+				t.Fatalf("writing tab %s: %v", name, err)
+			}
+		}
+	}
+	_ = f.DeleteSheet("Sheet1")
+
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		t.Fatalf("writing workbook: %v", err)
+	}
+	return &buf
+}
+
+func TestImportXLSX(t *testing.T) {
+	t.Parallel()
+	buf := workbook(t, map[string][][]string{
+		TabVInfo: {
+			{"VM", "VM ID", "Powerstate", "Template", "CPUs", "Memory", "Provisioned MiB", "Firmware", "EFI Secure boot", "vTPM", "CBT", "Cluster", "OS according to the configuration file"},
+			{"web01", "vm-1", "poweredOn", "False", "2", "4096", "102400", "efi", "False", "False", "True", "prod", "Red Hat Enterprise Linux 9 (64-bit)"},
+			{"sql01", "vm-2", "poweredOn", "False", "8", "32768", "512000", "bios", "False", "True", "False", "prod", "Microsoft Windows Server 2019 (64-bit)"},
+			{"golden", "vm-3", "poweredOff", "True", "2", "4096", "51200", "bios", "False", "False", "False", "prod", "Ubuntu Linux (64-bit)"},
+		},
+		TabVDisk: {
+			{"VM", "VM ID", "Disk", "Capacity MiB", "Controller", "Raw", "Disk Mode"},
+			{"web01", "vm-1", "Hard disk 1", "51200", "SCSI controller 0", "False", "persistent"},
+			{"web01", "vm-1", "Hard disk 2", "20480", "SCSI controller 0", "True", "independent_persistent"},
+		},
+		TabVNetwork: {
+			{"VM", "VM ID", "Adapter"},
+			{"sql01", "vm-2", "E1000E"},
+		},
+		TabVSnapshot: {
+			{"VM", "VM ID", "Name"},
+			{"sql01", "vm-2", "before-patch"},
+			{"sql01", "vm-2", "after-patch"},
+		},
+		TabVUSB: {
+			{"VM", "VM ID", "Device Type"},
+			{"sql01", "vm-2", "HID"},
+		},
+		TabVHost: {
+			{"Host", "Cluster", "ESX Version"},
+			{"esx01", "prod", "VMware ESXi 6.0.0 build-3620759"},
+			{"esx02", "prod", "VMware ESXi 7.0.3 build-20328353"},
+		},
+		TabVTools: {
+			{"VM", "VM ID", "Tools"},
+			{"web01", "vm-1", "toolsOld"},
+			{"sql01", "vm-2", "toolsOk"},
+		},
+	})
+
+	inv, err := ImportXLSX(buf)
+	if err != nil {
+		t.Fatalf("importing: %v", err)
+	}
+	if len(inv.VMs) != 2 {
+		t.Fatalf("expected the 2 VMs which are not templates, got %d", len(inv.VMs))
+	}
+	web, sql := inv.VMs[0], inv.VMs[1]
+	// the disks of vDisk replace the provisioned capacity
+	if web.DiskGB != 70 || len(web.Disks) != 2 || web.Disks[0].Bus != "scsi" {
+		t.Errorf("unexpected disks of web01: %g GB, %+v", web.DiskGB, web.Disks)
+	}
+	if web.Disks[0].RDM || !web.Disks[1].RDM || web.Disks[1].Mode != inventory.DiskModeIndependentPersistent {
+		t.Errorf("unexpected disk modes of web01: %+v", web.Disks)
+	}
+	if web.USBDevices != 0 || sql.USBDevices != 1 {
+		t.Errorf("expected the USB device of sql01, got %d and %d", web.USBDevices, sql.USBDevices)
+	}
+	if sql.DiskGB != 500 || sql.CPUCount != 8 || sql.MemoryMB != 32768 || len(sql.NICs) != 1 {
+		t.Errorf("unexpected sql01: %+v", sql)
+	}
+	if !web.ChangeTrackingEnabled || web.Firmware != "efi" || web.SecureBoot || web.Cluster != "prod" {
+		t.Errorf("unexpected web01: %+v", web)
+	}
+	if web.TPM || !sql.TPM {
+		t.Errorf("expected the TPM of sql01 only, got %t and %t", web.TPM, sql.TPM)
+	}
+	if web.ToolsStatus != inventory.ToolsStatusOld || sql.ToolsStatus != inventory.ToolsStatusOK {
+		t.Errorf("unexpected VMware Tools states %q and %q", web.ToolsStatus, sql.ToolsStatus)
+	}
+	if len(inv.Hosts) != 2 || inv.Hosts[1].ID != "esx02" || inv.Hosts[1].Version != "VMware ESXi 7.0.3 build-20328353" {
+		t.Errorf("unexpected hosts %+v", inv.Hosts)
+	}
+
+	params := make(map[string]any)
+	for _, p := range inv.Params() {
+		params[p.Key] = p.Value
+	}
+	breakdown := params[calculators.ParamOSBreakdown].(map[string]int)
+	if breakdown[calculators.OSFamilyRHEL] != 1 || breakdown[calculators.OSFamilyWindows] != 1 {
+		t.Errorf("unexpected OS breakdown %v", breakdown)
+	}
+	if params[calculators.ParamVMCount] != 2 || params[calculators.ParamTotalDiskGB] != 570.0 ||
+		params[calculators.ParamVMsWithSnapshots] != 1 || params[calculators.ParamAvgSnapshotChainLength] != 2.0 ||
+		params[calculators.ParamEFIBootVMs] != 1 || params[calculators.ParamTPMVMs] != 1 || params[calculators.ParamHostCount] != 2 {
+		t.Errorf("unexpected params %v", params)
+	}
+	// ESXi 6.0 is below the minimum supported version, vCenter is unknown and the VMs migrate cold
+	if params[calculators.ParamESXiHostUpgrades] != 1 || params[calculators.ParamToolsUpgrades] != 1 ||
+		params[calculators.ParamVCenterUpgrades] != 0 || params[calculators.ParamCBTEnablements] != 0 {
+		t.Errorf("unexpected prerequisite params %v", params)
+	}
+}
+
+func TestImportXLSX_Example(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("../../../test/e2e/data/example_rvtools_files/example1.xlsx")
+	if err != nil {
+		t.Fatalf("opening example: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	inv, err := ImportXLSX(f)
+	if err != nil {
+		t.Fatalf("importing: %v", err)
+	}
+	var secureBoot []string
+	for _, vm := range inv.VMs {
+		if vm.SecureBoot {
+			if vm.Firmware != inventory.FirmwareEFI {
+				t.Errorf("expected secure boot on UEFI VMs only, got %+v", vm)
+			}
+			secureBoot = append(secureBoot, vm.ID)
+		}
+	}
+	if strings.Join(secureBoot, ",") != "vm-65987,vm-65988,vm-65989,vm-65990,vm-107051" {
+		t.Errorf("expected the secure boot VMs of the example, got %v", secureBoot)
+	}
+
+	params := make(map[string]any)
+	for _, p := range inv.Params() {
+		params[p.Key] = p.Value
+	}
+	if inv.VCenterVersion != "8.0.3.0" {
+		t.Errorf("expected the vCenter version of the example, got %q", inv.VCenterVersion)
+	}
+	if params[calculators.ParamSecureBootVMs] != 5 || params[calculators.ParamEFIBootVMs] != 1 || params[calculators.ParamTPMVMs] != 0 {
+		t.Errorf("unexpected firmware params %v", params)
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	t.Parallel()
+	export := "\ufeffVM;VM ID;Powerstate;Template;Provisioned MiB;OS according to the VMware Tools;Datacenter;Folder;vApp\n" +
+		"app01;vm-7;poweredOn;False;1,024;SUSE Linux Enterprise 15 (64-bit);DC1;/DC1/vm/Finance/Payroll;payroll\n" +
+		"app02;vm-8;poweredOff;False;2048;Debian GNU/Linux 12 (64-bit);DC1;/DC1/vm;\n"
+
+	inv, err := ImportCSV(strings.NewReader(export))
+	if err != nil {
+		t.Fatalf("importing: %v", err)
+	}
+	if len(inv.VMs) != 2 || inv.VMs[0].ID != "vm-7" || inv.VMs[0].DiskGB != 1 || inv.VMs[1].DiskGB != 2 {
+		t.Errorf("unexpected VMs %+v", inv.VMs)
+	}
+	if inv.VMs[0].Folder != "Finance/Payroll" || inv.VMs[0].VApp != "payroll" || inv.VMs[1].Folder != "" {
+		t.Errorf("unexpected folders %q/%q and %q", inv.VMs[0].Folder, inv.VMs[0].VApp, inv.VMs[1].Folder)
+	}
+	if OSFamily(inv.VMs[0].GuestOS) != calculators.OSFamilySLES || OSFamily(inv.VMs[1].GuestOS) != calculators.OSFamilyDebian {
+		t.Errorf("unexpected guest OSes %q and %q", inv.VMs[0].GuestOS, inv.VMs[1].GuestOS)
+	}
+
+	if _, err := ImportCSV(strings.NewReader("Host,Cluster\nesx01,prod\n")); !errors.Is(err, ErrMissingVInfo) {
+		t.Errorf("expected ErrMissingVInfo, got: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package rvtools
+
+import (
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/devices"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/firmware"
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/estimations/prerequisites"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// OSFamilyOther is the OS family of the guests of no known family.
+const OSFamilyOther = "other"
+
+// Inventory is the inventory of an RVTools export.
+type Inventory struct {
+	// VMs are the VMs of the export, templates left out.
+	VMs []inventory.VM
+	// Snapshots is the number of snapshots of each VM, in the order of VMs.
+	Snapshots []int
+	// Hosts are the ESXi hosts, none when the export has no vHost tab.
+	Hosts []inventory.Host
+	// VCenterVersion is the version of the vCenter exported, empty when unknown.
+	VCenterVersion string
+}
+
+// OSFamily returns the OS family of a guest OS as RVTools reports it, e.g. "Red Hat Enterprise
+// Linux 9 (64-bit)", as keyed in the os_breakdown param.
+func OSFamily(guestOS string) string {
+	os := strings.ToLower(guestOS)
+	switch {
+	case strings.Contains(os, "windows"):
+		return calculators.OSFamilyWindows
+	case strings.Contains(os, "red hat"), strings.Contains(os, "centos"), strings.Contains(os, "oracle linux"),
+		strings.Contains(os, "rocky"), strings.Contains(os, "alma"):
+		return calculators.OSFamilyRHEL
+	case strings.Contains(os, "suse"):
+		return calculators.OSFamilySLES
+	case strings.Contains(os, "debian"), strings.Contains(os, "ubuntu"):
+		return calculators.OSFamilyDebian
+	default:
+		return OSFamilyOther
+	}
+}
+
+// Params returns the params of the calculators describing the VMs of the export: the VM count, the
+// disk size, the OS breakdown, the snapshot counts, the firmware counts, the driver changes, the
+// prerequisites to remediate and the host count when known. The VMs are checked as migrated cold.
+func (inv Inventory) Params() []estimation.Param {
+	var diskGB float64
+	var withSnapshots, snapshots int
+	breakdown := make(map[string]int)
+	for i, vm := range inv.VMs {
+		diskGB += vm.DiskGB
+		breakdown[OSFamily(vm.GuestOS)]++
+		if i < len(inv.Snapshots) && inv.Snapshots[i] > 0 {
+			withSnapshots++
+			snapshots += inv.Snapshots[i]
+		}
+	}
+
+	var avgChainLength float64
+	if withSnapshots > 0 {
+		avgChainLength = float64(snapshots) / float64(withSnapshots)
+	}
+	params := []estimation.Param{
+		{Key: calculators.ParamVMCount, Value: len(inv.VMs)},
+		{Key: calculators.ParamTotalDiskGB, Value: diskGB},
+		{Key: calculators.ParamOSBreakdown, Value: breakdown},
+		{Key: calculators.ParamVMsWithSnapshots, Value: withSnapshots},
+		{Key: calculators.ParamAvgSnapshotChainLength, Value: avgChainLength},
+	}
+	params = append(params, firmware.Params(firmware.Analyze(inv.VMs))...)
+	analyses := make([]devices.Analysis, 0, len(inv.VMs))
+	for _, vm := range inv.VMs {
+		analyses = append(analyses, devices.Analyze(vm))
+	}
+	params = append(params, devices.Params(analyses)...)
+
+	src := prerequisites.Source{VCenterVersion: inv.VCenterVersion, Hosts: inv.Hosts}
+	for _, vm := range inv.VMs {
+		src.Assignments = append(src.Assignments, method.Assignment{Candidate: method.Candidate{VM: vm}, Method: method.Cold})
+	}
+	params = append(params, prerequisites.Params(prerequisites.NewChecker().Check(src))...)
+	if len(inv.Hosts) > 0 {
+		params = append(params, estimation.Param{Key: calculators.ParamHostCount, Value: len(inv.Hosts)})
+	}
+	return params
+}
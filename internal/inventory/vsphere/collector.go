@@ -0,0 +1,229 @@
+// Package vsphere collects the inventory of a vCenter with govmomi: its VMs, datastores and
+// networks. The inventory is turned into the params of the calculators, so the VM count, disk size,
+// OS breakdown and snapshot counts of thousands of VMs are not typed in by hand.
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const bytesPerGB = 1024 * 1024 * 1024
+
+// Config is the vCenter to collect the inventory of.
+type Config struct {
+	// URL is the URL of the SDK endpoint of the vCenter, e.g. "https://vcenter.example.com/sdk".
+	URL      string
+	Username string
+	Password string
+	// Insecure skips the verification of the certificate of the vCenter.
+	Insecure bool
+}
+
+// Collector collects the inventory of a vCenter.
+type Collector struct {
+	client *vim25.Client
+	logout func(context.Context) error
+}
+
+// NewCollector logs in to the vCenter. Close logs out.
+func NewCollector(ctx context.Context, cfg Config) (*Collector, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vCenter URL: %w", err)
+	}
+	u.User = url.UserPassword(cfg.Username, cfg.Password)
+
+	client, err := govmomi.NewClient(ctx, u, cfg.Insecure)
+	if err != nil {
+		return nil, fmt.Errorf("logging in to %s: %w", u.Host, err)
+	}
+	return &Collector{client: client.Client, logout: client.Logout}, nil
+}
+
+// NewCollectorFromClient creates a collector using a client already logged in, e.g. to a simulator.
+func NewCollectorFromClient(client *vim25.Client) *Collector {
+	return &Collector{client: client}
+}
+
+// Close logs out of the vCenter when the collector logged in.
+func (c *Collector) Close(ctx context.Context) error {
+	if c.logout == nil {
+		return nil
+	}
+	return c.logout(ctx)
+}
+
+// Collect enumerates the VMs, datastores and networks of the vCenter. Templates are left out of the
+// VMs as they are not migrated.
+func (c *Collector) Collect(ctx context.Context) (Inventory, error) {
+	var inv Inventory
+
+	h, err := c.hierarchy(ctx)
+	if err != nil {
+		return Inventory{}, err
+	}
+	var vms []mo.VirtualMachine
+	if err := c.retrieve(ctx, "VirtualMachine", []string{"name", "parent", "parentVApp", "resourcePool", "config", "snapshot", "runtime.powerState"}, &vms); err != nil {
+		return Inventory{}, err
+	}
+	for _, vm := range vms {
+		if vm.Config == nil || vm.Config.Template {
+			continue
+		}
+		res := newVM(vm)
+		// the resource pool of a VM in a vApp is the vApp
+		vApp := vm.ParentVApp
+		if vApp == nil && vm.ResourcePool != nil && vm.ResourcePool.Type == "VirtualApp" {
+			vApp = vm.ResourcePool
+		}
+		if vApp != nil {
+			res.Folder, res.VApp = h.vAppPath(*vApp)
+		} else if vm.Parent != nil {
+			res.Folder = h.folderPath(*vm.Parent)
+		}
+		inv.VMs = append(inv.VMs, res)
+	}
+
+	var datastores []mo.Datastore
+	if err := c.retrieve(ctx, "Datastore", []string{"summary"}, &datastores); err != nil {
+		return Inventory{}, err
+	}
+	for _, ds := range datastores {
+		inv.Datastores = append(inv.Datastores, Datastore{
+			Name:       ds.Summary.Name,
+			Type:       ds.Summary.Type,
+			CapacityGB: float64(ds.Summary.Capacity) / bytesPerGB,
+			FreeGB:     float64(ds.Summary.FreeSpace) / bytesPerGB,
+		})
+	}
+
+	// the distributed port groups are networks too
+	var networks []mo.Network
+	if err := c.retrieve(ctx, "Network", []string{"name"}, &networks); err != nil {
+		return Inventory{}, err
+	}
+	for _, n := range networks {
+		inv.Networks = append(inv.Networks, Network{Name: n.Name, Type: n.Self.Type})
+	}
+
+	return inv, nil
+}
+
+// retrieve reads the properties of all the managed objects of the kind under the root folder.
+func (c *Collector) retrieve(ctx context.Context, kind string, props []string, dst any) error {
+	v, err := view.NewManager(c.client).CreateContainerView(ctx, c.client.ServiceContent.RootFolder, []string{kind}, true)
+	if err != nil {
+		return fmt.Errorf("creating %s view: %w", kind, err)
+	}
+	defer func() { _ = v.Destroy(ctx) }()
+
+	if err := v.Retrieve(ctx, []string{kind}, props, dst); err != nil {
+		return fmt.Errorf("retrieving %s objects: %w", kind, err)
+	}
+	return nil
+}
+
+// hierarchy is the folders and vApps of the vCenter, keyed by reference, to resolve the paths of the
+// VMs in them.
+type hierarchy struct {
+	folders map[string]mo.Folder
+	vApps   map[string]mo.VirtualApp
+}
+
+func (c *Collector) hierarchy(ctx context.Context) (hierarchy, error) {
+	var folders []mo.Folder
+	if err := c.retrieve(ctx, "Folder", []string{"name", "parent"}, &folders); err != nil {
+		return hierarchy{}, err
+	}
+	var vApps []mo.VirtualApp
+	if err := c.retrieve(ctx, "VirtualApp", []string{"name", "parentFolder", "parentVApp"}, &vApps); err != nil {
+		return hierarchy{}, err
+	}
+
+	h := hierarchy{folders: make(map[string]mo.Folder, len(folders)), vApps: make(map[string]mo.VirtualApp, len(vApps))}
+	for _, f := range folders {
+		h.folders[f.Self.Value] = f
+	}
+	for _, app := range vApps {
+		h.vApps[app.Self.Value] = app
+	}
+	return h, nil
+}
+
+// folderPath returns the path of the folder from the VM folder of its datacenter, the folder whose
+// parent is the datacenter.
+func (h hierarchy) folderPath(ref types.ManagedObjectReference) string {
+	var segments []string
+	for {
+		f, ok := h.folders[ref.Value]
+		if !ok || f.Parent == nil || f.Parent.Type != "Folder" {
+			break
+		}
+		segments = append(segments, f.Name)
+		ref = *f.Parent
+	}
+	slices.Reverse(segments)
+	return strings.Join(segments, "/")
+}
+
+// vAppPath returns the path of the folder of the outermost vApp holding the vApp, and the path of the
+// vApp from it.
+func (h hierarchy) vAppPath(ref types.ManagedObjectReference) (folder, vApp string) {
+	var segments []string
+	for {
+		app, ok := h.vApps[ref.Value]
+		if !ok {
+			break
+		}
+		segments = append(segments, app.Name)
+		if app.ParentVApp == nil || app.ParentVApp.Type != "VirtualApp" {
+			if app.ParentFolder != nil {
+				folder = h.folderPath(*app.ParentFolder)
+			}
+			break
+		}
+		ref = *app.ParentVApp
+	}
+	slices.Reverse(segments)
+	return folder, strings.Join(segments, "/")
+}
+
+func newVM(vm mo.VirtualMachine) VM {
+	res := VM{
+		ID:            vm.Config.InstanceUuid,
+		Name:          vm.Name,
+		GuestID:       vm.Config.GuestId,
+		GuestFullName: vm.Config.GuestFullName,
+		PowerState:    string(vm.Runtime.PowerState),
+		CPUCount:      int(vm.Config.Hardware.NumCPU),
+		MemoryMB:      int(vm.Config.Hardware.MemoryMB),
+	}
+	for _, device := range vm.Config.Hardware.Device {
+		if disk, ok := device.(*types.VirtualDisk); ok {
+			res.DiskGB += float64(disk.CapacityInBytes) / bytesPerGB
+		}
+	}
+	if vm.Snapshot != nil {
+		res.Snapshots = countSnapshots(vm.Snapshot.RootSnapshotList)
+	}
+	return res
+}
+
+// countSnapshots counts the snapshots of a snapshot tree, each merged into its parent on consolidation.
+func countSnapshots(tree []types.VirtualMachineSnapshotTree) int {
+	count := len(tree)
+	for _, node := range tree {
+		count += countSnapshots(node.ChildSnapshotList)
+	}
+	return count
+}
@@ -0,0 +1,176 @@
+package vsphere
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+	model := simulator.VPX()
+	model.Machine = 3
+	defer model.Remove()
+	if err := model.Create(); err != nil {
+		t.Fatalf("creating the simulator: %v", err)
+	}
+
+	err := model.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+		task, err := vm.CreateSnapshot(ctx, "before-migration", "", false, false)
+		if err != nil {
+			return err
+		}
+		if err := task.Wait(ctx); err != nil {
+			return err
+		}
+
+		collector := NewCollectorFromClient(c)
+		defer func() { _ = collector.Close(ctx) }()
+		inv, err := collector.Collect(ctx)
+		if err != nil {
+			t.Fatalf("collecting: %v", err)
+		}
+
+		// 3 VMs on the standalone host and 3 on each of the 3 hosts of the cluster
+		if len(inv.VMs) != 6 {
+			t.Errorf("expected 6 VMs, got %d", len(inv.VMs))
+		}
+		if len(inv.Datastores) == 0 || len(inv.Networks) == 0 {
+			t.Errorf("expected datastores and networks, got %+v and %+v", inv.Datastores, inv.Networks)
+		}
+		var snapshots int
+		for _, vm := range inv.VMs {
+			if vm.DiskGB <= 0 {
+				t.Errorf("%s: expected the capacity of its disks, got %g GB", vm.Name, vm.DiskGB)
+			}
+			snapshots += vm.Snapshots
+		}
+		if snapshots != 1 {
+			t.Errorf("expected a single snapshot, got %d", snapshots)
+		}
+
+		params := make(map[string]estimation.Param)
+		for _, p := range inv.Params() {
+			params[p.Key] = p
+		}
+		if params[calculators.ParamVMCount].Value != 6 || params[calculators.ParamVMsWithSnapshots].Value != 1 {
+			t.Errorf("unexpected params %+v", params)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollect_Folders(t *testing.T) {
+	t.Parallel()
+	model := simulator.VPX()
+	model.App = 1
+	defer model.Remove()
+	if err := model.Create(); err != nil {
+		t.Fatalf("creating the simulator: %v", err)
+	}
+
+	err := model.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		dc, err := finder.Datacenter(ctx, "DC0")
+		if err != nil {
+			return err
+		}
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			return err
+		}
+		finance, err := folders.VmFolder.CreateFolder(ctx, "Finance")
+		if err != nil {
+			return err
+		}
+		payroll, err := finance.CreateFolder(ctx, "Payroll")
+		if err != nil {
+			return err
+		}
+		finder.SetDatacenter(dc)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+		task, err := payroll.MoveInto(ctx, []types.ManagedObjectReference{vm.Reference()})
+		if err != nil {
+			return err
+		}
+		if err := task.Wait(ctx); err != nil {
+			return err
+		}
+
+		inv, err := NewCollectorFromClient(c).Collect(ctx)
+		if err != nil {
+			t.Fatalf("collecting: %v", err)
+		}
+		byName := make(map[string]VM)
+		for _, vm := range inv.VMs {
+			byName[vm.Name] = vm
+		}
+		if got := byName["DC0_H0_VM0"]; got.Folder != "Finance/Payroll" || got.VApp != "" {
+			t.Errorf("expected the VM in Finance/Payroll, got %q %q", got.Folder, got.VApp)
+		}
+		if got := byName["DC0_H0_VM1"]; got.Folder != "" {
+			t.Errorf("expected the VM in the VM folder of the datacenter, got %q", got.Folder)
+		}
+		var inVApp bool
+		for _, vm := range inv.VMs {
+			inVApp = inVApp || vm.VApp == "DC0_C0_APP0"
+		}
+		if !inVApp {
+			t.Errorf("expected VMs in the vApp, got %+v", inv.VMs)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParams(t *testing.T) {
+	t.Parallel()
+	inv := Inventory{VMs: []VM{
+		{Name: "web01", GuestID: "rhel9_64Guest", DiskGB: 100, Snapshots: 2},
+		{Name: "web02", GuestID: "centos8_64Guest", DiskGB: 100},
+		{Name: "sql01", GuestID: "windows2019srv_64Guest", DiskGB: 500, Snapshots: 4},
+		{Name: "app01", GuestID: "ubuntu64Guest", DiskGB: 50},
+		{Name: "fw01", GuestID: "freebsd13_64Guest", DiskGB: 10},
+	}}
+
+	engine := estimation.NewEngine()
+	engine.Register(calculators.NewGuestOSRemediation())
+	engine.Register(calculators.NewSnapshotConsolidation())
+	for name, est := range engine.Run(inv.Params()) {
+		if est.Duration <= 0 {
+			t.Errorf("%s: expected a duration from the params, got %+v", name, est)
+		}
+	}
+
+	params := make(map[string]any)
+	for _, p := range inv.Params() {
+		params[p.Key] = p.Value
+	}
+	breakdown := params[calculators.ParamOSBreakdown].(map[string]int)
+	if breakdown[calculators.OSFamilyRHEL] != 2 || breakdown[calculators.OSFamilyWindows] != 1 ||
+		breakdown[calculators.OSFamilyDebian] != 1 || breakdown[OSFamilyOther] != 1 {
+		t.Errorf("unexpected OS breakdown %v", breakdown)
+	}
+	if params[calculators.ParamTotalDiskGB] != 760.0 || params[calculators.ParamAvgSnapshotChainLength] != 3.0 {
+		t.Errorf("unexpected params %v", params)
+	}
+}
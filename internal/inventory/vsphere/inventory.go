@@ -0,0 +1,99 @@
+package vsphere
+
+import (
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// OSFamilyOther is the OS family of the guests of no known family.
+const OSFamilyOther = "other"
+
+// Inventory is the inventory of a vCenter.
+type Inventory struct {
+	VMs        []VM
+	Datastores []Datastore
+	Networks   []Network
+}
+
+// VM is a VM of the inventory.
+type VM struct {
+	ID   string
+	Name string
+	// Folder is the path of the VM's folder from the VM folder of its datacenter, e.g.
+	// "Finance/Payroll", and VApp the path of its vApp, nested vApps "/"-separated.
+	Folder string
+	VApp   string
+	// GuestID is the guest OS configured, e.g. "rhel9_64Guest".
+	GuestID       string
+	GuestFullName string
+	PowerState    string
+	CPUCount      int
+	MemoryMB      int
+	// DiskGB is the capacity of the virtual disks of the VM.
+	DiskGB float64
+	// Snapshots is the number of snapshots of the VM.
+	Snapshots int
+}
+
+// Datastore is a datastore of the inventory.
+type Datastore struct {
+	Name       string
+	Type       string
+	CapacityGB float64
+	FreeGB     float64
+}
+
+// Network is a network of the inventory, a standard network or a distributed port group.
+type Network struct {
+	Name string
+	// Type is the type of the managed object, e.g. "Network" or "DistributedVirtualPortgroup".
+	Type string
+}
+
+// OSFamily returns the OS family of a guest ID, as keyed in the os_breakdown param.
+func OSFamily(guestID string) string {
+	id := strings.ToLower(guestID)
+	switch {
+	case strings.HasPrefix(id, "windows"):
+		return calculators.OSFamilyWindows
+	case strings.HasPrefix(id, "rhel"), strings.HasPrefix(id, "centos"), strings.HasPrefix(id, "oracle"),
+		strings.HasPrefix(id, "rocky"), strings.HasPrefix(id, "almalinux"):
+		return calculators.OSFamilyRHEL
+	case strings.HasPrefix(id, "sles"):
+		return calculators.OSFamilySLES
+	case strings.HasPrefix(id, "debian"), strings.HasPrefix(id, "ubuntu"):
+		return calculators.OSFamilyDebian
+	default:
+		return OSFamilyOther
+	}
+}
+
+// Params returns the params of the calculators describing the VMs of the inventory: the VM count, the
+// disk size, the OS breakdown and the snapshot counts.
+func (inv Inventory) Params() []estimation.Param {
+	var diskGB float64
+	var withSnapshots, snapshots int
+	breakdown := make(map[string]int)
+	for _, vm := range inv.VMs {
+		diskGB += vm.DiskGB
+		breakdown[OSFamily(vm.GuestID)]++
+		if vm.Snapshots > 0 {
+			withSnapshots++
+			snapshots += vm.Snapshots
+		}
+	}
+
+	var avgChainLength float64
+	if withSnapshots > 0 {
+		avgChainLength = float64(snapshots) / float64(withSnapshots)
+	}
+	return []estimation.Param{
+		{Key: calculators.ParamVMCount, Value: len(inv.VMs)},
+		{Key: calculators.ParamTotalDiskGB, Value: diskGB},
+		{Key: calculators.ParamOSBreakdown, Value: breakdown},
+		{Key: calculators.ParamVMsWithSnapshots, Value: withSnapshots},
+		{Key: calculators.ParamAvgSnapshotChainLength, Value: avgChainLength},
+	}
+}
@@ -0,0 +1,12 @@
+// Package waves groups the VMs of an inventory into migration waves and estimates each of them.
+//
+// A Planner packs the VMs into as few waves as the Constraints allow: a wave holds at most a number
+// of VMs and of terabytes, and the VMs of an affinity group, e.g. the tiers of an application, or of a
+// source folder always move in the same wave. Each wave is then estimated on its own by running the calculators of the
+// Planner on its VMs, instead of a single aggregate number for the whole inventory, and its risk scored
+// with the mitigations of the risks found. The VMs which can not be migrated as-is are flagged first.
+//
+// Quotas sums the resources each wave lands in its target namespaces and QuotaManifests renders them
+// as ResourceQuota and LimitRange manifests, aligning the governance of the target with the plan. The
+// target namespaces can follow the source folders with NamespaceByFolder.
+package waves
@@ -0,0 +1,221 @@
+package waves
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/dnslabel"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// LabelWave is the label of the generated manifests holding the name of their wave.
+	LabelWave = "migration-planner.kubev2v.io/wave"
+
+	// quotaVMCount is the object count quota of KubeVirt VMs.
+	quotaVMCount = "count/virtualmachines.kubevirt.io"
+)
+
+// QuotaOptions configure the quota manifests of the waves.
+type QuotaOptions struct {
+	// Namespace maps a VM to its target namespace. Nil lands all the VMs of a wave in a namespace
+	// named after the wave.
+	Namespace func(inventory.VM) string
+	// Headroom is the fraction added on top of the resources of the VMs, e.g. 0.2 for 20% more.
+	Headroom float64
+}
+
+// Validate checks the headroom is non-negative.
+func (o QuotaOptions) Validate() error {
+	if o.Headroom < 0 {
+		return errors.New("quota headroom must be non-negative")
+	}
+	return nil
+}
+
+// NamespaceByFolder maps the VMs to their target namespace by folder, for QuotaOptions.Namespace: a
+// VM lands in the namespace of the deepest folder or vApp of the mapping it is in, e.g. "payroll" for
+// "Finance/Payroll" over "finance" for "Finance". The namespace keyed by "" takes the VMs in none of
+// the folders, which otherwise map to no namespace.
+func NamespaceByFolder(namespaces map[string]string) func(inventory.VM) string {
+	return func(vm inventory.VM) string {
+		ns, depth := namespaces[""], -1
+		for folder, n := range namespaces {
+			if d := strings.Count(strings.Trim(folder, "/"), "/"); d > depth && vm.InFolder(folder) {
+				ns, depth = n, d
+			}
+		}
+		return ns
+	}
+}
+
+// NamespaceQuota is the resources a wave lands in a target namespace.
+type NamespaceQuota struct {
+	Wave      string
+	Namespace string
+	VMs       int
+	Disks     int
+	CPUs      int
+	MemoryMB  int
+	DiskGB    float64
+	// MaxCPUs, MaxMemoryMB and MaxDiskGB are the largest VM and disk, bounding a single workload.
+	MaxCPUs     int
+	MaxMemoryMB int
+	MaxDiskGB   float64
+}
+
+// Quotas sums the resources of the VMs of each wave per target namespace, in the order of the waves
+// and then of the namespaces. It fails when a VM maps to an empty namespace.
+func Quotas(waves []Wave, opts QuotaOptions) ([]NamespaceQuota, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	var res []NamespaceQuota
+	for _, w := range waves {
+		byNamespace := make(map[string]*NamespaceQuota)
+		for _, vm := range w.VMs {
+			ns := dnslabel.From(w.Name)
+			if opts.Namespace != nil {
+				ns = opts.Namespace(vm)
+			}
+			if ns == "" {
+				return nil, fmt.Errorf("VM %s of %s maps to no namespace", vm.ID, w.Name)
+			}
+			q, ok := byNamespace[ns]
+			if !ok {
+				q = &NamespaceQuota{Wave: w.Name, Namespace: ns}
+				byNamespace[ns] = q
+			}
+			q.add(vm)
+		}
+
+		namespaces := make([]string, 0, len(byNamespace))
+		for ns := range byNamespace {
+			namespaces = append(namespaces, ns)
+		}
+		sort.Strings(namespaces)
+		for _, ns := range namespaces {
+			res = append(res, byNamespace[ns].withHeadroom(opts.Headroom))
+		}
+	}
+	return res, nil
+}
+
+// add accounts for the VM in the quota. A VM without known disks still claims a volume for its disk size.
+func (q *NamespaceQuota) add(vm inventory.VM) {
+	q.VMs++
+	q.CPUs += vm.CPUCount
+	q.MemoryMB += vm.MemoryMB
+	q.DiskGB += vm.DiskGB
+	q.MaxCPUs = max(q.MaxCPUs, vm.CPUCount)
+	q.MaxMemoryMB = max(q.MaxMemoryMB, vm.MemoryMB)
+	if len(vm.Disks) == 0 {
+		q.Disks++
+		q.MaxDiskGB = max(q.MaxDiskGB, vm.DiskGB)
+		return
+	}
+	q.Disks += len(vm.Disks)
+	for _, d := range vm.Disks {
+		q.MaxDiskGB = max(q.MaxDiskGB, d.CapacityGB)
+	}
+}
+
+// withHeadroom scales the totals, not the per-workload maximums, up by the headroom.
+func (q NamespaceQuota) withHeadroom(headroom float64) NamespaceQuota {
+	scale := func(v float64) float64 {
+		return math.Ceil(v * (1 + headroom))
+	}
+	q.CPUs = int(scale(float64(q.CPUs)))
+	q.MemoryMB = int(scale(float64(q.MemoryMB)))
+	q.DiskGB = scale(q.DiskGB)
+	return q
+}
+
+type manifest struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Metadata   metadata `json:"metadata"`
+	Spec       any      `json:"spec"`
+}
+
+type metadata struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels"`
+}
+
+type resourceQuotaSpec struct {
+	Hard map[string]string `json:"hard"`
+}
+
+type limitRangeSpec struct {
+	Limits []limitRangeItem `json:"limits"`
+}
+
+type limitRangeItem struct {
+	Type string            `json:"type"`
+	Max  map[string]string `json:"max"`
+}
+
+// QuotaManifests renders a ResourceQuota and a LimitRange for each quota, as a multi-document YAML
+// stream ready to be applied to the target cluster. The quota caps the namespace at the resources of
+// the VMs it receives; the limit range caps a single VM and volume at the largest of them.
+func QuotaManifests(quotas []NamespaceQuota) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, q := range quotas {
+		name := dnslabel.From(q.Wave)
+		labels := map[string]string{LabelWave: name}
+		docs := []manifest{
+			{
+				APIVersion: "v1",
+				Kind:       "ResourceQuota",
+				Metadata:   metadata{Name: name, Namespace: q.Namespace, Labels: labels},
+				Spec: resourceQuotaSpec{Hard: map[string]string{
+					"requests.cpu":           cpu(q.CPUs),
+					"requests.memory":        memory(q.MemoryMB),
+					"requests.storage":       storage(q.DiskGB),
+					"persistentvolumeclaims": fmt.Sprint(q.Disks),
+					quotaVMCount:             fmt.Sprint(q.VMs),
+				}},
+			},
+			{
+				APIVersion: "v1",
+				Kind:       "LimitRange",
+				Metadata:   metadata{Name: name, Namespace: q.Namespace, Labels: labels},
+				Spec: limitRangeSpec{Limits: []limitRangeItem{
+					{Type: "Container", Max: map[string]string{"cpu": cpu(q.MaxCPUs), "memory": memory(q.MaxMemoryMB)}},
+					{Type: "PersistentVolumeClaim", Max: map[string]string{"storage": storage(q.MaxDiskGB)}},
+				}},
+			},
+		}
+		for _, d := range docs {
+			out, err := yaml.Marshal(d)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render %s of %s in %s: %w", d.Kind, q.Wave, q.Namespace, err)
+			}
+			if buf.Len() > 0 {
+				buf.WriteString("---\n")
+			}
+			buf.Write(out)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func cpu(cores int) string {
+	return fmt.Sprint(cores)
+}
+
+func memory(mb int) string {
+	return fmt.Sprintf("%dMi", mb)
+}
+
+func storage(gb float64) string {
+	return fmt.Sprintf("%dGi", int64(math.Ceil(gb)))
+}
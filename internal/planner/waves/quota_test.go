@@ -0,0 +1,170 @@
+package waves
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+	"sigs.k8s.io/yaml"
+)
+
+func sizedVM(id, cluster string, cpus, memoryMB int, disks ...float64) inventory.VM {
+	v := inventory.VM{ID: id, Name: id, Cluster: cluster, CPUCount: cpus, MemoryMB: memoryMB}
+	for _, gb := range disks {
+		v.Disks = append(v.Disks, inventory.Disk{CapacityGB: gb})
+		v.DiskGB += gb
+	}
+	return v
+}
+
+func TestQuotas(t *testing.T) {
+	t.Parallel()
+	waves := []Wave{
+		{Name: "Wave 1", VMs: []inventory.VM{sizedVM("a", "prod", 4, 8192, 100, 50), sizedVM("b", "dev", 2, 4096, 40), sizedVM("c", "prod", 8, 16384, 200)}},
+		{Name: "Wave 2", VMs: []inventory.VM{{ID: "d", CPUCount: 1, MemoryMB: 1024, DiskGB: 30}}},
+	}
+
+	t.Run("namespace per wave by default", func(t *testing.T) {
+		t.Parallel()
+		quotas, err := Quotas(waves, QuotaOptions{})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if len(quotas) != 2 || quotas[0].Namespace != "wave-1" || quotas[1].Namespace != "wave-2" {
+			t.Fatalf("expected a quota per wave, got %+v", quotas)
+		}
+		q := quotas[0]
+		if q.VMs != 3 || q.Disks != 4 || q.CPUs != 14 || q.MemoryMB != 28672 || q.DiskGB != 390 {
+			t.Errorf("unexpected totals: %+v", q)
+		}
+		if q.MaxCPUs != 8 || q.MaxMemoryMB != 16384 || q.MaxDiskGB != 200 {
+			t.Errorf("unexpected maximums: %+v", q)
+		}
+		// a VM without known disks claims a volume for its disk size
+		if q := quotas[1]; q.Disks != 1 || q.MaxDiskGB != 30 {
+			t.Errorf("expected a single 30 GB volume, got %+v", q)
+		}
+	})
+
+	t.Run("mapped namespaces with headroom", func(t *testing.T) {
+		t.Parallel()
+		quotas, err := Quotas(waves[:1], QuotaOptions{
+			Namespace: func(vm inventory.VM) string { return vm.Cluster },
+			Headroom:  0.25,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if len(quotas) != 2 || quotas[0].Namespace != "dev" || quotas[1].Namespace != "prod" {
+			t.Fatalf("expected the dev and prod namespaces, got %+v", quotas)
+		}
+		// 12 CPUs, 24576 MB and 350 GB + 25%, the maximums untouched
+		if q := quotas[1]; q.CPUs != 15 || q.MemoryMB != 30720 || q.DiskGB != 438 || q.MaxCPUs != 8 {
+			t.Errorf("unexpected prod quota: %+v", q)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		if _, err := Quotas(waves, QuotaOptions{Headroom: -1}); err == nil || !strings.Contains(err.Error(), "non-negative") {
+			t.Errorf("expected a headroom error, got: %v", err)
+		}
+		_, err := Quotas(waves, QuotaOptions{Namespace: func(inventory.VM) string { return "" }})
+		if err == nil || !strings.Contains(err.Error(), "VM a of Wave 1") {
+			t.Errorf("expected a namespace error, got: %v", err)
+		}
+	})
+}
+
+func TestNamespaceByFolder(t *testing.T) {
+	t.Parallel()
+	namespace := NamespaceByFolder(map[string]string{
+		"Finance":         "finance",
+		"Finance/Payroll": "payroll",
+	})
+	cases := []struct {
+		vm   inventory.VM
+		want string
+	}{
+		{vm: inventory.VM{Folder: "Finance/Payroll", VApp: "web"}, want: "payroll"},
+		{vm: inventory.VM{Folder: "Finance/Ledger"}, want: "finance"},
+		{vm: inventory.VM{Folder: "HR"}, want: ""},
+	}
+	for _, tc := range cases {
+		if got := namespace(tc.vm); got != tc.want {
+			t.Errorf("%s: expected namespace %q, got %q", tc.vm.Hierarchy(), tc.want, got)
+		}
+	}
+
+	// the VMs in none of the folders land in the namespace keyed by ""
+	if got := NamespaceByFolder(map[string]string{"": "shared", "Finance": "finance"})(inventory.VM{Folder: "HR"}); got != "shared" {
+		t.Errorf("expected the shared namespace, got %q", got)
+	}
+}
+
+func TestQuotaManifests(t *testing.T) {
+	t.Parallel()
+	out, err := QuotaManifests([]NamespaceQuota{
+		{Wave: "Wave 1", Namespace: "prod", VMs: 2, Disks: 3, CPUs: 12, MemoryMB: 24576, DiskGB: 349.5, MaxCPUs: 8, MaxMemoryMB: 16384, MaxDiskGB: 200},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	docs := bytes.Split(out, []byte("---\n"))
+	if len(docs) != 2 {
+		t.Fatalf("expected a ResourceQuota and a LimitRange, got:\n%s", out)
+	}
+	var quota struct {
+		Kind     string
+		Metadata struct {
+			Name      string
+			Namespace string
+			Labels    map[string]string
+		}
+		Spec struct {
+			Hard map[string]string
+		}
+	}
+	if err := yaml.Unmarshal(docs[0], &quota); err != nil {
+		t.Fatalf("expected valid YAML, got: %v", err)
+	}
+	if quota.Kind != "ResourceQuota" || quota.Metadata.Name != "wave-1" || quota.Metadata.Namespace != "prod" || quota.Metadata.Labels[LabelWave] != "wave-1" {
+		t.Errorf("unexpected quota header: %+v", quota)
+	}
+	want := map[string]string{
+		"requests.cpu":                      "12",
+		"requests.memory":                   "24576Mi",
+		"requests.storage":                  "350Gi",
+		"persistentvolumeclaims":            "3",
+		"count/virtualmachines.kubevirt.io": "2",
+	}
+	for k, v := range want {
+		if quota.Spec.Hard[k] != v {
+			t.Errorf("expected %s to be %s, got %q", k, v, quota.Spec.Hard[k])
+		}
+	}
+
+	var limits struct {
+		Kind string
+		Spec struct {
+			Limits []struct {
+				Type string
+				Max  map[string]string
+			}
+		}
+	}
+	if err := yaml.Unmarshal(docs[1], &limits); err != nil {
+		t.Fatalf("expected valid YAML, got: %v", err)
+	}
+	if limits.Kind != "LimitRange" || len(limits.Spec.Limits) != 2 {
+		t.Fatalf("unexpected limit range: %+v", limits)
+	}
+	if m := limits.Spec.Limits[0].Max; m["cpu"] != "8" || m["memory"] != "16384Mi" {
+		t.Errorf("expected the largest VM as container max, got %v", m)
+	}
+	if m := limits.Spec.Limits[1].Max; m["storage"] != "200Gi" {
+		t.Errorf("expected the largest disk as volume max, got %v", m)
+	}
+}
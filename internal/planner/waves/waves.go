@@ -0,0 +1,272 @@
+package waves
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/risk"
+	"github.com/kubev2v/migration-planner/pkg/estimations/blockers"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+const gbPerTB = 1024.0
+
+// Constraints bound the waves. Zero limits are unbounded.
+type Constraints struct {
+	// MaxVMs is the number of VMs a wave holds at most.
+	MaxVMs int
+	// MaxDiskTB is the disk size, in TB, a wave holds at most.
+	MaxDiskTB float64
+	// AffinityGroups are the IDs of the VMs that must move in the same wave, e.g. the tiers of an
+	// application. Groups sharing a VM move together.
+	AffinityGroups [][]string
+	// Folders are the source folders or vApps whose VMs must move in the same wave, e.g.
+	// "Finance/Payroll" for an organization drawing its application boundaries in its folders. A
+	// folder listed with one of its subfolders moves with it.
+	Folders []string
+}
+
+// Validate checks the limits are non-negative and the folders are named.
+func (c Constraints) Validate() error {
+	if c.MaxVMs < 0 {
+		return errors.New("max VMs per wave must be non-negative")
+	}
+	if c.MaxDiskTB < 0 {
+		return errors.New("max TB per wave must be non-negative")
+	}
+	if slices.ContainsFunc(c.Folders, func(f string) bool { return strings.Trim(f, "/ ") == "" }) {
+		return errors.New("folder path must not be empty")
+	}
+	return nil
+}
+
+// Wave is a group of VMs migrated together and its estimation.
+type Wave struct {
+	Name   string
+	VMs    []inventory.VM
+	DiskGB float64
+	// Folders are the folders of the constraints whose VMs the wave migrates.
+	Folders []string
+	// Estimations are the results of the calculators on the VMs of the wave, keyed by calculator name.
+	Estimations map[string]estimation.Estimation
+	// Duration is the sum of the estimations.
+	Duration time.Duration
+	// Risk is the risk of migrating the VMs of the wave, with the mitigation of each risk found.
+	Risk risk.Report
+	// Blockers are the reasons VMs of the wave can not be migrated as-is, to rework before the wave.
+	Blockers []blockers.Blocker
+}
+
+// Planner groups VMs into waves and estimates them.
+type Planner struct {
+	engine *estimation.Engine
+	params []estimation.Param
+	scorer *risk.Scorer
+}
+
+// Option is a functional option for configuring a Planner.
+type Option func(*Planner)
+
+// WithEngine sets the engine estimating each wave.
+func WithEngine(e *estimation.Engine) Option {
+	return func(p *Planner) {
+		p.engine = e
+	}
+}
+
+// WithParams sets the params shared by the estimations of all waves, e.g. the transfer rate. The VM
+// count and disk size of a wave take precedence over the ones given.
+func WithParams(params ...estimation.Param) Option {
+	return func(p *Planner) {
+		p.params = append(p.params, params...)
+	}
+}
+
+// WithScorer sets the scorer of the risk of each wave.
+func WithScorer(s *risk.Scorer) Option {
+	return func(p *Planner) {
+		p.scorer = s
+	}
+}
+
+// NewPlanner creates a Planner estimating the storage migration and the post-migration checks of each
+// wave and scoring its risk with the default rules, with settings that can be overridden by options.
+func NewPlanner(opts ...Option) *Planner {
+	engine := estimation.NewEngine()
+	engine.Register(calculators.NewStorageMigration())
+	engine.Register(calculators.NewPostMigrationTroubleShooting())
+
+	p := Planner{engine: engine, scorer: risk.NewScorer()}
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return &p
+}
+
+// unit is a set of VMs placed in the same wave: an affinity group or a VM of its own.
+type unit struct {
+	vms    []inventory.VM
+	diskGB float64
+}
+
+// String names the unit in errors after its first VM.
+func (u unit) String() string {
+	if len(u.vms) == 1 {
+		return fmt.Sprintf("VM %s", u.vms[0].ID)
+	}
+	return fmt.Sprintf("affinity group of VM %s", u.vms[0].ID)
+}
+
+// Plan groups the VMs into waves honoring the constraints, flags the VMs of each wave that can not be
+// migrated as-is, estimates each wave and scores its risk. Units, affinity
+// groups or single VMs, are placed largest first into the first wave with room left, so the waves are
+// few and filled evenly. It fails when an affinity group names a VM missing from vms or a unit does
+// not fit in a wave on its own.
+func (p *Planner) Plan(vms []inventory.VM, c Constraints) ([]Wave, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	groups := slices.Clone(c.AffinityGroups)
+	for _, f := range c.Folders {
+		var ids []string
+		for _, vm := range vms {
+			if vm.InFolder(f) {
+				ids = append(ids, vm.ID)
+			}
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("folder %q holds none of the VMs", f)
+		}
+		groups = append(groups, ids)
+	}
+	units, err := groupUnits(vms, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDiskGB := c.MaxDiskTB * gbPerTB
+	for _, u := range units {
+		if c.MaxVMs > 0 && len(u.vms) > c.MaxVMs {
+			return nil, fmt.Errorf("%s holds %d VMs, more than the %d of a wave", u, len(u.vms), c.MaxVMs)
+		}
+		if maxDiskGB > 0 && u.diskGB > maxDiskGB {
+			return nil, fmt.Errorf("%s holds %.2f TB, more than the %.2f TB of a wave", u, u.diskGB/gbPerTB, c.MaxDiskTB)
+		}
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		return units[i].diskGB > units[j].diskGB
+	})
+
+	var waves []Wave
+	for _, u := range units {
+		placed := false
+		for i := range waves {
+			w := &waves[i]
+			if c.MaxVMs > 0 && len(w.VMs)+len(u.vms) > c.MaxVMs {
+				continue
+			}
+			if maxDiskGB > 0 && w.DiskGB+u.diskGB > maxDiskGB {
+				continue
+			}
+			w.VMs = append(w.VMs, u.vms...)
+			w.DiskGB += u.diskGB
+			placed = true
+			break
+		}
+		if !placed {
+			waves = append(waves, Wave{
+				Name:   fmt.Sprintf("Wave %d", len(waves)+1),
+				VMs:    append([]inventory.VM(nil), u.vms...),
+				DiskGB: u.diskGB,
+			})
+		}
+	}
+
+	for i := range waves {
+		for _, f := range c.Folders {
+			if slices.ContainsFunc(waves[i].VMs, func(vm inventory.VM) bool { return vm.InFolder(f) }) {
+				waves[i].Folders = append(waves[i].Folders, f)
+			}
+		}
+		waves[i].Blockers = blockers.Detect(waves[i].VMs)
+		p.estimate(&waves[i])
+		waves[i].Risk = p.scorer.Score(waves[i].Name, waves[i].VMs)
+	}
+	return waves, nil
+}
+
+// estimate runs the calculators on the VMs of the wave.
+func (p *Planner) estimate(w *Wave) {
+	params := make([]estimation.Param, 0, len(p.params)+2)
+	params = append(params, p.params...)
+	params = append(params,
+		estimation.Param{Key: calculators.ParamVMCount, Value: len(w.VMs)},
+		estimation.Param{Key: calculators.ParamTotalDiskGB, Value: w.DiskGB},
+	)
+	w.Estimations = p.engine.Run(params)
+	w.Duration = 0
+	for _, est := range w.Estimations {
+		w.Duration += est.Duration
+	}
+}
+
+// groupUnits returns the units of the VMs, in the order of their first VM: the affinity groups merged
+// when they share a VM, and a unit of its own for each VM in no group.
+func groupUnits(vms []inventory.VM, groups [][]string) ([]unit, error) {
+	index := make(map[string]int, len(vms))
+	for i, vm := range vms {
+		index[vm.ID] = i
+	}
+
+	// parent is a union-find over the positions of the VMs
+	parent := make([]int, len(vms))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for g, group := range groups {
+		first := -1
+		for _, id := range group {
+			i, ok := index[id]
+			if !ok {
+				return nil, fmt.Errorf("affinity group %d names unknown VM %q", g+1, id)
+			}
+			if first < 0 {
+				first = i
+				continue
+			}
+			if a, b := find(first), find(i); a != b {
+				parent[max(a, b)] = min(a, b)
+			}
+		}
+	}
+
+	var units []unit
+	byRoot := make(map[int]int)
+	for i, vm := range vms {
+		root := find(i)
+		u, ok := byRoot[root]
+		if !ok {
+			u = len(units)
+			byRoot[root] = u
+			units = append(units, unit{})
+		}
+		units[u].vms = append(units[u].vms, vm)
+		units[u].diskGB += vm.DiskGB
+	}
+	return units, nil
+}
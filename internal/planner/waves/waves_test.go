@@ -0,0 +1,206 @@
+package waves
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/risk"
+	"github.com/kubev2v/migration-planner/pkg/estimations/blockers"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func vm(id string, diskGB float64) inventory.VM {
+	return inventory.VM{ID: id, Name: id, DiskGB: diskGB}
+}
+
+func ids(w Wave) []string {
+	res := make([]string, 0, len(w.VMs))
+	for _, v := range w.VMs {
+		res = append(res, v.ID)
+	}
+	return res
+}
+
+func TestPlanner_Plan_Constraints(t *testing.T) {
+	t.Parallel()
+	vms := []inventory.VM{vm("a", 100), vm("b", 600), vm("c", 300), vm("d", 500), vm("e", 200)}
+
+	tests := []struct {
+		name        string
+		constraints Constraints
+		want        [][]string
+	}{
+		{
+			name: "unbounded",
+			want: [][]string{{"b", "d", "c", "e", "a"}},
+		},
+		{
+			name:        "max VMs per wave",
+			constraints: Constraints{MaxVMs: 2},
+			want:        [][]string{{"b", "d"}, {"c", "e"}, {"a"}},
+		},
+		{
+			name:        "max TB per wave",
+			constraints: Constraints{MaxDiskTB: 1},
+			want:        [][]string{{"b", "c", "a"}, {"d", "e"}},
+		},
+		{
+			name:        "affinity groups move together",
+			constraints: Constraints{MaxVMs: 3, AffinityGroups: [][]string{{"a", "e"}, {"e", "c"}}},
+			want:        [][]string{{"a", "c", "e"}, {"b", "d"}},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			waves, err := NewPlanner().Plan(vms, tc.constraints)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if len(waves) != len(tc.want) {
+				t.Fatalf("expected %d waves, got %d: %+v", len(tc.want), len(waves), waves)
+			}
+			for i, w := range waves {
+				if got := strings.Join(ids(w), ","); got != strings.Join(tc.want[i], ",") {
+					t.Errorf("expected wave %d to hold %v, got %s", i+1, tc.want[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanner_Plan_Folders(t *testing.T) {
+	t.Parallel()
+	inFolder := func(id, folder string, diskGB float64) inventory.VM {
+		v := vm(id, diskGB)
+		v.Folder = folder
+		return v
+	}
+	vms := []inventory.VM{
+		inFolder("db", "Finance/Payroll", 500),
+		inFolder("web", "Finance/Payroll/web", 100),
+		inFolder("ledger", "Finance", 400),
+		inFolder("hr", "HR", 300),
+		vm("loose", 200),
+	}
+
+	waves, err := NewPlanner().Plan(vms, Constraints{MaxVMs: 3, Folders: []string{"Finance/Payroll"}})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(waves) != 2 {
+		t.Fatalf("expected 2 waves, got %+v", waves)
+	}
+	// the payroll VMs and the ones of its web subfolder move together
+	if got := strings.Join(ids(waves[0]), ","); got != "db,web,ledger" {
+		t.Errorf("expected the payroll folder in the first wave, got %s", got)
+	}
+	if len(waves[0].Folders) != 1 || waves[0].Folders[0] != "Finance/Payroll" || len(waves[1].Folders) != 0 {
+		t.Errorf("expected the payroll folder reported on the first wave only, got %v and %v", waves[0].Folders, waves[1].Folders)
+	}
+}
+
+func TestPlanner_Plan_EstimatesEachWave(t *testing.T) {
+	t.Parallel()
+	planner := NewPlanner(WithParams(estimation.Param{Key: calculators.ParamPostMigrationEngineers, Value: 1}))
+
+	waves, err := planner.Plan([]inventory.VM{vm("a", 500), vm("b", 500), vm("c", 500)}, Constraints{MaxVMs: 2})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(waves) != 2 || waves[0].Name != "Wave 1" || waves[1].Name != "Wave 2" {
+		t.Fatalf("expected Wave 1 and Wave 2, got %+v", waves)
+	}
+
+	// 2 VMs @ 60 mins / 1 engineer
+	if got := waves[0].Estimations["Post-Migration Checks"].Duration; got != 2*time.Hour {
+		t.Errorf("expected 2h of checks in the first wave, got %v", got)
+	}
+	if got := waves[1].Estimations["Post-Migration Checks"].Duration; got != time.Hour {
+		t.Errorf("expected 1h of checks in the second wave, got %v", got)
+	}
+	for _, w := range waves {
+		var total time.Duration
+		for _, est := range w.Estimations {
+			total += est.Duration
+		}
+		if w.Duration != total || w.Duration == 0 {
+			t.Errorf("expected %s to last the sum of its estimations %v, got %v", w.Name, total, w.Duration)
+		}
+	}
+}
+
+func TestPlanner_Plan_ScoresEachWave(t *testing.T) {
+	t.Parallel()
+	rdm := vm("b", 500)
+	rdm.Disks = []inventory.Disk{{CapacityGB: 500, RDM: true}}
+
+	waves, err := NewPlanner(WithScorer(risk.NewScorer(risk.WithMaxWaveTB(1)))).
+		Plan([]inventory.VM{vm("a", 600), rdm, vm("c", 300), vm("d", 200)}, Constraints{MaxVMs: 2})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(waves) != 2 {
+		t.Fatalf("expected 2 waves, got %+v", waves)
+	}
+	// a and b move 1.07 TB and b has a raw device mapping, c and d move less than 1 TB
+	if r := waves[0].Risk; r.Wave != "Wave 1" || r.Level != risk.LevelHigh || len(r.Risks) != 2 {
+		t.Errorf("expected the first wave at high risk, got %+v", r)
+	}
+	if r := waves[1].Risk; r.Level != risk.LevelNone || len(r.Risks) != 0 {
+		t.Errorf("expected the second wave without risk, got %+v", r)
+	}
+}
+
+func TestPlanner_Plan_FlagsBlockers(t *testing.T) {
+	t.Parallel()
+	usb := vm("b", 100)
+	usb.USBDevices = 1
+
+	waves, err := NewPlanner().Plan([]inventory.VM{vm("a", 500), usb, vm("c", 50)}, Constraints{MaxVMs: 2})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(waves) != 2 || len(waves[0].Blockers) != 1 || len(waves[1].Blockers) != 0 {
+		t.Fatalf("expected a blocker in the first wave only, got %+v", waves)
+	}
+	if b := waves[0].Blockers[0]; b.VM != "b" || b.Kind != blockers.KindUSB {
+		t.Errorf("expected the USB device of b, got %+v", b)
+	}
+	// the blocked VM is still estimated with its wave
+	if waves[0].Duration == 0 {
+		t.Errorf("expected the first wave estimated")
+	}
+}
+
+func TestPlanner_Plan_ErrorCases(t *testing.T) {
+	t.Parallel()
+	vms := []inventory.VM{vm("a", 100), vm("b", 2048), vm("c", 300)}
+
+	cases := []struct {
+		name        string
+		constraints Constraints
+		wantErr     string
+	}{
+		{name: "negative max VMs", constraints: Constraints{MaxVMs: -1}, wantErr: "non-negative"},
+		{name: "unknown VM", constraints: Constraints{AffinityGroups: [][]string{{"a", "z"}}}, wantErr: `unknown VM "z"`},
+		{name: "group above max VMs", constraints: Constraints{MaxVMs: 1, AffinityGroups: [][]string{{"a", "c"}}}, wantErr: "affinity group of VM a"},
+		{name: "VM above max TB", constraints: Constraints{MaxDiskTB: 1}, wantErr: "VM b holds 2.00 TB"},
+		{name: "empty folder path", constraints: Constraints{Folders: []string{"/"}}, wantErr: "folder path must not be empty"},
+		{name: "folder of no VM", constraints: Constraints{Folders: []string{"HR"}}, wantErr: `folder "HR" holds none of the VMs`},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewPlanner().Plan(vms, tc.constraints)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
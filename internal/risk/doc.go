@@ -0,0 +1,7 @@
+// Package risk scores the risk of migrating the waves of a plan.
+//
+// A Scorer inspects the VMs of a wave against a set of rules, e.g. more than 50 TB moved in one wave,
+// guest operating systems unsupported on the target or disks mapped to raw devices, and reports each
+// risk found with its level and a note on how to mitigate it. The level of a wave is the highest of
+// its risks and its score their sum, so waves can be ranked and the riskiest reworked first.
+package risk
@@ -0,0 +1,189 @@
+package risk
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+const gbPerTB = 1024.0
+
+// concernUnsupportedOS is the concern the validation policies attach to a VM whose guest operating
+// system is not supported on the target.
+const concernUnsupportedOS = "vmware.os.unsupported"
+
+// Level is how much a risk threatens the migration of a wave.
+type Level string
+
+const (
+	LevelNone   Level = "none"
+	LevelLow    Level = "low"
+	LevelMedium Level = "medium"
+	LevelHigh   Level = "high"
+)
+
+// weight returns the points a risk of the level adds to the score of a wave.
+func (l Level) weight() int {
+	switch l {
+	case LevelLow:
+		return 1
+	case LevelMedium:
+		return 3
+	case LevelHigh:
+		return 5
+	default:
+		return 0
+	}
+}
+
+// Rules found by a Scorer.
+const (
+	RuleWaveSize      = "wave-size"
+	RuleUnsupportedOS = "unsupported-os"
+	RuleRDM           = "rdm-disks"
+)
+
+// Risk is a risk found in a wave.
+type Risk struct {
+	Rule        string
+	Level       Level
+	Description string
+	// Mitigation is a note on how to lower the risk.
+	Mitigation string
+	// VMs are the IDs of the VMs at risk, none when the risk is the wave as a whole.
+	VMs []string
+}
+
+// Report is the risk of a wave.
+type Report struct {
+	Wave string
+	// Level is the highest level of the risks, LevelNone without risk.
+	Level Level
+	// Score is the sum of the weights of the risks.
+	Score int
+	Risks []Risk
+}
+
+// Highest returns the highest level of the reports, the level of the plan they are the waves of.
+func Highest(reports ...Report) Level {
+	res := LevelNone
+	for _, r := range reports {
+		if r.Level.weight() > res.weight() {
+			res = r.Level
+		}
+	}
+	return res
+}
+
+// Scorer scores the risk of waves.
+type Scorer struct {
+	maxWaveTB float64
+}
+
+// Option is a functional option for configuring a Scorer.
+type Option func(*Scorer)
+
+// WithMaxWaveTB sets the disk size, in TB, above which moving a wave is at risk. The risk is high
+// above twice the size.
+func WithMaxWaveTB(tb float64) Option {
+	return func(s *Scorer) {
+		s.maxWaveTB = tb
+	}
+}
+
+// NewScorer creates a Scorer flagging the waves of more than 50 TB, with settings that can be
+// overridden by options.
+func NewScorer(opts ...Option) *Scorer {
+	s := Scorer{maxWaveTB: 50}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return &s
+}
+
+// Score scores the risk of migrating the VMs of a wave.
+func (s *Scorer) Score(wave string, vms []inventory.VM) Report {
+	report := Report{Wave: wave, Level: LevelNone}
+	for _, r := range []*Risk{s.waveSize(vms), unsupportedOS(vms), rdmDisks(vms)} {
+		if r == nil {
+			continue
+		}
+		report.Risks = append(report.Risks, *r)
+		report.Score += r.Level.weight()
+		if r.Level.weight() > report.Level.weight() {
+			report.Level = r.Level
+		}
+	}
+	return report
+}
+
+// waveSize flags a wave moving more data than a cutover window can hold.
+func (s *Scorer) waveSize(vms []inventory.VM) *Risk {
+	if s.maxWaveTB <= 0 {
+		return nil
+	}
+	var diskGB float64
+	for _, vm := range vms {
+		diskGB += vm.DiskGB
+	}
+	tb := diskGB / gbPerTB
+	if tb <= s.maxWaveTB {
+		return nil
+	}
+	level := LevelMedium
+	if tb > 2*s.maxWaveTB {
+		level = LevelHigh
+	}
+	return &Risk{
+		Rule:        RuleWaveSize,
+		Level:       level,
+		Description: fmt.Sprintf("the wave moves %.1f TB, more than %.0f TB", tb, s.maxWaveTB),
+		Mitigation:  fmt.Sprintf("split the wave into waves of at most %.0f TB, or pre-copy its disks with a warm migration", s.maxWaveTB),
+	}
+}
+
+// unsupportedOS flags the VMs whose guest operating system is not supported on the target.
+func unsupportedOS(vms []inventory.VM) *Risk {
+	var ids []string
+	for _, vm := range vms {
+		for _, c := range vm.Concerns {
+			if c.ID == concernUnsupportedOS {
+				ids = append(ids, vm.ID)
+				break
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return &Risk{
+		Rule:        RuleUnsupportedOS,
+		Level:       LevelMedium,
+		Description: fmt.Sprintf("%d VMs run a guest operating system unsupported on the target", len(ids)),
+		Mitigation:  "upgrade the guest operating systems to a supported release before the wave, or accept running them unsupported",
+		VMs:         ids,
+	}
+}
+
+// rdmDisks flags the VMs with disks mapped to raw devices, which are not migrated with the VM.
+func rdmDisks(vms []inventory.VM) *Risk {
+	var ids []string
+	for _, vm := range vms {
+		for _, d := range vm.Disks {
+			if d.RDM {
+				ids = append(ids, vm.ID)
+				break
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return &Risk{
+		Rule:        RuleRDM,
+		Level:       LevelHigh,
+		Description: fmt.Sprintf("%d VMs have raw device mapped disks", len(ids)),
+		Mitigation:  "convert the raw device mappings to virtual disks before the wave, or present the LUNs to the target cluster and attach them after the cutover",
+		VMs:         ids,
+	}
+}
@@ -0,0 +1,75 @@
+package risk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestScorer_Score(t *testing.T) {
+	t.Parallel()
+	vms := []inventory.VM{
+		{ID: "db", DiskGB: 30 * gbPerTB, Disks: []inventory.Disk{{CapacityGB: 100}, {CapacityGB: 2048, RDM: true}}},
+		{ID: "legacy", DiskGB: 25 * gbPerTB, Concerns: []inventory.Concern{{ID: concernUnsupportedOS, Category: inventory.ConcernCategoryWarning}}},
+		{ID: "web", DiskGB: 100},
+	}
+
+	report := NewScorer().Score("Wave 1", vms)
+
+	if report.Wave != "Wave 1" || report.Level != LevelHigh || report.Score != 11 {
+		t.Fatalf("expected a high risk wave scoring 11, got %+v", report)
+	}
+	rules := make(map[string]Risk, len(report.Risks))
+	for _, r := range report.Risks {
+		if r.Mitigation == "" {
+			t.Errorf("expected a mitigation for %s", r.Rule)
+		}
+		rules[r.Rule] = r
+	}
+	if r := rules[RuleWaveSize]; r.Level != LevelMedium || r.VMs != nil {
+		t.Errorf("expected the wave of 55 TB at medium risk, got %+v", r)
+	}
+	if r := rules[RuleUnsupportedOS]; r.Level != LevelMedium || !reflect.DeepEqual(r.VMs, []string{"legacy"}) {
+		t.Errorf("expected the legacy VM at medium risk, got %+v", r)
+	}
+	if r := rules[RuleRDM]; r.Level != LevelHigh || !reflect.DeepEqual(r.VMs, []string{"db"}) {
+		t.Errorf("expected the db VM at high risk, got %+v", r)
+	}
+}
+
+func TestScorer_Score_WaveSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		diskTB float64
+		opts   []Option
+		want   Level
+	}{
+		{name: "within the size", diskTB: 50, want: LevelNone},
+		{name: "above the size", diskTB: 51, want: LevelMedium},
+		{name: "above twice the size", diskTB: 101, want: LevelHigh},
+		{name: "custom size", diskTB: 21, opts: []Option{WithMaxWaveTB(10)}, want: LevelHigh},
+		{name: "unbounded", diskTB: 500, opts: []Option{WithMaxWaveTB(0)}, want: LevelNone},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			report := NewScorer(tc.opts...).Score("w", []inventory.VM{{ID: "a", DiskGB: tc.diskTB * gbPerTB}})
+			if report.Level != tc.want {
+				t.Errorf("expected %s, got %+v", tc.want, report)
+			}
+		})
+	}
+}
+
+func TestHighest(t *testing.T) {
+	t.Parallel()
+	if got := Highest(); got != LevelNone {
+		t.Errorf("expected no risk without waves, got %s", got)
+	}
+	if got := Highest(Report{Level: LevelMedium}, Report{Level: LevelNone}, Report{Level: LevelLow}); got != LevelMedium {
+		t.Errorf("expected medium, got %s", got)
+	}
+}
@@ -22,8 +22,25 @@ type Client struct {
 	Worker      *RVToolsWorker
 }
 
-// NewClient creates a new River client with the RVTools worker registered.
-func NewClient(ctx context.Context, cfg *config.Config, s store.Store, opaValidator *opa.Validator) (*Client, error) {
+// NewClient creates a new River client with the RVTools, portfolio report and estimation workers
+// registered. When an exporter is given, the warehouse export runs periodically too, on the leader
+// client only.
+func NewClient(ctx context.Context, cfg *config.Config, s store.Store, opaValidator *opa.Validator, renderer PortfolioRenderer, runner EstimationRunner, exporter WarehouseExporter) (*Client, error) {
+	var periodicJobs []*river.PeriodicJob
+	if exporter != nil {
+		interval, err := time.ParseDuration(cfg.Service.Warehouse.Interval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid warehouse export interval %q", cfg.Service.Warehouse.Interval)
+		}
+		periodicJobs = append(periodicJobs, river.NewPeriodicJob(
+			river.PeriodicInterval(interval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return WarehouseExportJobArgs{}, nil
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		))
+	}
+
 	pool, err := createPgxPool(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("creating pgx pool: %w", err)
@@ -35,12 +52,18 @@ func NewClient(ctx context.Context, cfg *config.Config, s store.Store, opaValida
 
 	workers := river.NewWorkers()
 	river.AddWorker(workers, worker)
+	river.AddWorker(workers, NewPortfolioReportWorker(s, renderer))
+	river.AddWorker(workers, NewEstimationWorker(s, runner))
+	if exporter != nil {
+		river.AddWorker(workers, NewWarehouseExportWorker(exporter))
+	}
 
 	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
 		Queues: map[string]river.QueueConfig{
 			river.QueueDefault: {MaxWorkers: 5, FetchPollInterval: 1 * time.Second},
 		},
-		Workers: workers,
+		Workers:      workers,
+		PeriodicJobs: periodicJobs,
 	})
 	if err != nil {
 		pool.Close()
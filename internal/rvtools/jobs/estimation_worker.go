@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+	"github.com/kubev2v/migration-planner/pkg/requestid"
+	"github.com/kubev2v/migration-planner/pkg/tracing"
+)
+
+// EstimationRunner runs the estimations of the estimation jobs, e.g. the estimation service. It calls
+// progress after each calculator and returns the JSON encoded result.
+type EstimationRunner interface {
+	RunEstimationJob(ctx context.Context, args EstimationJobArgs, progress func(completed, total int)) (json.RawMessage, error)
+}
+
+// EstimationWorker processes estimation jobs. The progress and the result are kept in the job metadata.
+type EstimationWorker struct {
+	river.WorkerDefaults[EstimationJobArgs]
+	store  store.Store
+	runner EstimationRunner
+}
+
+// NewEstimationWorker creates a new estimation worker.
+func NewEstimationWorker(store store.Store, runner EstimationRunner) *EstimationWorker {
+	return &EstimationWorker{
+		store:  store,
+		runner: runner,
+	}
+}
+
+func (w *EstimationWorker) Timeout(_ *river.Job[EstimationJobArgs]) time.Duration {
+	return 30 * time.Minute
+}
+
+// Work runs an estimation.
+func (w *EstimationWorker) Work(ctx context.Context, job *river.Job[EstimationJobArgs]) error {
+	// the logs of the job are correlated with the request which submitted it
+	ctx = logctx.WithJobID(logctx.WithOrgID(requestid.ToContext(ctx, job.Args.RequestID), job.Args.OrgID), job.ID)
+	// the span of the job continues the trace of the request
+	ctx, span := tracing.Tracer().Start(tracing.Extract(ctx, job.Args.TraceContext), "estimation_job",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			tracing.RequestIDKey.String(job.Args.RequestID),
+			attribute.Int64("job.id", job.ID),
+			attribute.Int("estimation.calculators", len(job.Args.Calculators)),
+		),
+	)
+	err := w.work(ctx, job)
+	tracing.End(span, err)
+	return err
+}
+
+func (w *EstimationWorker) work(ctx context.Context, job *river.Job[EstimationJobArgs]) error {
+	logger := log.NewDebugLogger("estimation_worker").
+		WithContext(ctx).
+		Operation("process_estimation_job").
+		WithInt("calculators", len(job.Args.Calculators)).
+		WithString("priority", job.Args.Priority).
+		Build()
+
+	logger.Step("job_started").Log()
+
+	if err := w.updateJobStatus(ctx, job.ID, model.EstimationJobMetadata{Status: model.JobStatusEstimating, Total: len(job.Args.Calculators)}); err != nil {
+		logger.Error(err).WithString("step", "update_estimating_status").Log()
+	}
+
+	result, err := w.runner.RunEstimationJob(ctx, job.Args, func(completed, total int) {
+		if err := w.updateJobStatus(ctx, job.ID, model.EstimationJobMetadata{Status: model.JobStatusEstimating, Completed: completed, Total: total}); err != nil {
+			logger.Error(err).WithString("step", "update_progress").Log()
+		}
+	})
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "run_estimation", err, fmt.Sprintf("failed to run estimation: %v", err))
+	}
+
+	if err := w.updateJobStatus(ctx, job.ID, model.EstimationJobMetadata{Status: model.JobStatusCompleted, Completed: len(job.Args.Calculators), Total: len(job.Args.Calculators), Result: result}); err != nil {
+		// the result is lost without its metadata: fail the job so it is not reported completed
+		return w.failJob(ctx, logger, job.ID, "update_completed_status", err, fmt.Sprintf("failed to store estimation result: %v", err))
+	}
+
+	logger.Success().Log()
+	return nil
+}
+
+// failJob logs an error, updates job status to failed, and returns the error.
+func (w *EstimationWorker) failJob(ctx context.Context, logger *log.OperationTracer, jobID int64, step string, err error, errMsg string) error {
+	logger.Error(err).WithString("step", step).Log()
+	if updateErr := w.updateJobStatus(ctx, jobID, model.EstimationJobMetadata{Status: model.JobStatusFailed, Error: errMsg}); updateErr != nil {
+		logger.Error(updateErr).WithString("step", "update_failed_status").Log()
+	}
+	return err
+}
+
+// updateJobStatus updates the job's metadata using job store.
+func (w *EstimationWorker) updateJobStatus(ctx context.Context, jobID int64, metadata model.EstimationJobMetadata) error {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshaling metadata: %w", err)
+	}
+
+	return w.store.Job().UpdateMetadata(ctx, jobID, metadataJSON)
+}
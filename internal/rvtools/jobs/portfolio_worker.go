@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/portfolio"
+	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+)
+
+// PortfolioRenderer renders the executive report of plans of an organization, e.g. the plan service.
+type PortfolioRenderer interface {
+	PortfolioReport(ctx context.Context, orgID string, planIDs []uuid.UUID, rateCardID *uuid.UUID, now time.Time, opts ...portfolio.Option) (portfolio.Report, error)
+}
+
+// PortfolioReportWorker processes portfolio report jobs. The rendered report is kept in the job metadata.
+type PortfolioReportWorker struct {
+	river.WorkerDefaults[PortfolioReportJobArgs]
+	store    store.Store
+	renderer PortfolioRenderer
+}
+
+// NewPortfolioReportWorker creates a new portfolio report worker.
+func NewPortfolioReportWorker(store store.Store, renderer PortfolioRenderer) *PortfolioReportWorker {
+	return &PortfolioReportWorker{
+		store:    store,
+		renderer: renderer,
+	}
+}
+
+func (w *PortfolioReportWorker) Timeout(_ *river.Job[PortfolioReportJobArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+// Work renders a portfolio report.
+func (w *PortfolioReportWorker) Work(ctx context.Context, job *river.Job[PortfolioReportJobArgs]) error {
+	ctx = logctx.WithJobID(logctx.WithOrgID(ctx, job.Args.OrgID), job.ID)
+	logger := log.NewDebugLogger("portfolio_report_worker").
+		WithContext(ctx).
+		Operation("process_portfolio_report_job").
+		WithInt("plans", len(job.Args.PlanIDs)).
+		Build()
+
+	logger.Step("job_started").Log()
+
+	if err := w.updateJobStatus(ctx, job.ID, model.PortfolioReportJobMetadata{Status: model.JobStatusRendering}); err != nil {
+		logger.Error(err).WithString("step", "update_rendering_status").Log()
+	}
+
+	var opts []portfolio.Option
+	if job.Args.FiscalYearStart != 0 {
+		cal, err := cost.NewFiscalCalendar(job.Args.FiscalYearStart)
+		if err != nil {
+			return w.failJob(ctx, logger, job.ID, "render_report", err, fmt.Sprintf("failed to render portfolio report: %v", err))
+		}
+		opts = append(opts, portfolio.WithFiscalCalendar(cal))
+	}
+	if job.Args.Granularity != "" {
+		opts = append(opts, portfolio.WithGranularity(job.Args.Granularity))
+	}
+
+	report, err := w.renderer.PortfolioReport(ctx, job.Args.OrgID, job.Args.PlanIDs, job.Args.RateCardID, time.Now(), opts...)
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "render_report", err, fmt.Sprintf("failed to render portfolio report: %v", err))
+	}
+
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "marshal_report", err, fmt.Sprintf("error marshaling portfolio report: %v", err))
+	}
+
+	if err := w.updateJobStatus(ctx, job.ID, model.PortfolioReportJobMetadata{Status: model.JobStatusCompleted, Report: reportJSON}); err != nil {
+		// the report is lost without its metadata: fail the job so it is not reported completed
+		return w.failJob(ctx, logger, job.ID, "update_completed_status", err, fmt.Sprintf("failed to store portfolio report: %v", err))
+	}
+
+	logger.Success().WithInt("risks", len(report.Risks)).Log()
+	return nil
+}
+
+// failJob logs an error, updates job status to failed, and returns the error.
+func (w *PortfolioReportWorker) failJob(ctx context.Context, logger *log.OperationTracer, jobID int64, step string, err error, errMsg string) error {
+	logger.Error(err).WithString("step", step).Log()
+	if updateErr := w.updateJobStatus(ctx, jobID, model.PortfolioReportJobMetadata{Status: model.JobStatusFailed, Error: errMsg}); updateErr != nil {
+		logger.Error(updateErr).WithString("step", "update_failed_status").Log()
+	}
+	return err
+}
+
+// updateJobStatus updates the job's metadata using job store.
+func (w *PortfolioReportWorker) updateJobStatus(ctx context.Context, jobID int64, metadata model.PortfolioReportJobMetadata) error {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshaling metadata: %w", err)
+	}
+
+	return w.store.Job().UpdateMetadata(ctx, jobID, metadataJSON)
+}
@@ -1,7 +1,15 @@
 package jobs
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
 )
 
 // RVToolsJobArgs contains the arguments for an RVTools assessment job.
@@ -27,3 +35,84 @@ func (RVToolsJobArgs) InsertOpts() river.InsertOpts {
 		MaxAttempts: 1,
 	}
 }
+
+// PortfolioReportJobArgs contains the arguments for a portfolio report job.
+// OrgID and Username share their keys with RVToolsJobArgs to verify the ownership of any job.
+type PortfolioReportJobArgs struct {
+	PlanIDs    []uuid.UUID `json:"plan_ids"`
+	RateCardID *uuid.UUID  `json:"rate_card_id,omitempty"`
+	// FiscalYearStart and Granularity set the budget periods of the cash flow, the defaults of the
+	// portfolio report when zero.
+	FiscalYearStart time.Month       `json:"fiscal_year_start,omitempty"`
+	Granularity     cost.Granularity `json:"granularity,omitempty"`
+	OrgID           string           `json:"org_id"`
+	Username        string           `json:"username"`
+}
+
+// Kind returns the job kind for River registration.
+func (PortfolioReportJobArgs) Kind() string {
+	return "portfolio_report"
+}
+
+// InsertOpts returns the default insert options for this job type.
+func (PortfolioReportJobArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "default",
+		MaxAttempts: 1,
+	}
+}
+
+// EstimationJobArgs contains the arguments for an estimation job, an estimation run too long for the
+// request submitting it, e.g. over a 10k VM inventory or with Monte Carlo calculators.
+// OrgID and Username share their keys with RVToolsJobArgs to verify the ownership of any job.
+type EstimationJobArgs struct {
+	Calculators []calculators.Spec      `json:"calculators"`
+	Params      map[string]any          `json:"params"`
+	Priority    string                  `json:"priority,omitempty"`
+	Schedule    *EstimationScheduleArgs `json:"schedule,omitempty"`
+	OrgID       string                  `json:"org_id"`
+	Username    string                  `json:"username"`
+	// RequestID is the ID of the request which submitted the job, carried by the logs of the job.
+	RequestID string `json:"request_id,omitempty"`
+	// TraceContext is the trace context of the request which submitted the job, continued by the
+	// span of the job.
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+}
+
+// EstimationScheduleArgs is the work calendar the result of an estimation job is landed on.
+type EstimationScheduleArgs struct {
+	Start    time.Time         `json:"start"`
+	Calendar calendar.Calendar `json:"calendar"`
+	Workday  calendar.Workday  `json:"workday"`
+}
+
+// Kind returns the job kind for River registration.
+func (EstimationJobArgs) Kind() string {
+	return "estimation"
+}
+
+// InsertOpts returns the default insert options for this job type.
+func (EstimationJobArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "default",
+		MaxAttempts: 1,
+	}
+}
+
+// WarehouseExportJobArgs contains the arguments for a warehouse export job, enqueued periodically.
+type WarehouseExportJobArgs struct{}
+
+// Kind returns the job kind for River registration.
+func (WarehouseExportJobArgs) Kind() string {
+	return "warehouse_export"
+}
+
+// InsertOpts returns the default insert options for this job type. A single export is pending at a
+// time, so a late export is not piled up with the next ones.
+func (WarehouseExportJobArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "default",
+		MaxAttempts: 3,
+		UniqueOpts:  river.UniqueOpts{ByState: []rivertype.JobState{rivertype.JobStateAvailable, rivertype.JobStatePending, rivertype.JobStateRunning, rivertype.JobStateRetryable, rivertype.JobStateScheduled}},
+	}
+}
@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+)
+
+// WarehouseExporter exports the BI tables, e.g. the warehouse exporter, and returns the keys written.
+type WarehouseExporter interface {
+	Export(ctx context.Context, now time.Time) ([]string, error)
+}
+
+// WarehouseExportWorker processes the periodic warehouse export jobs.
+type WarehouseExportWorker struct {
+	river.WorkerDefaults[WarehouseExportJobArgs]
+	exporter WarehouseExporter
+}
+
+// NewWarehouseExportWorker creates a new warehouse export worker.
+func NewWarehouseExportWorker(exporter WarehouseExporter) *WarehouseExportWorker {
+	return &WarehouseExportWorker{exporter: exporter}
+}
+
+func (w *WarehouseExportWorker) Timeout(_ *river.Job[WarehouseExportJobArgs]) time.Duration {
+	return 30 * time.Minute
+}
+
+// Work exports the tables. A failed export is retried by River.
+func (w *WarehouseExportWorker) Work(ctx context.Context, job *river.Job[WarehouseExportJobArgs]) error {
+	ctx = logctx.WithJobID(ctx, job.ID)
+	logger := log.NewDebugLogger("warehouse_export_worker").
+		WithContext(ctx).
+		Operation("process_warehouse_export_job").
+		WithInt("attempt", job.Attempt).
+		Build()
+
+	logger.Step("job_started").Log()
+
+	keys, err := w.exporter.Export(ctx, time.Now())
+	if err != nil {
+		logger.Error(err).WithInt("tables_written", len(keys)).Log()
+		return err
+	}
+
+	logger.Success().WithInt("tables_written", len(keys)).Log()
+	return nil
+}
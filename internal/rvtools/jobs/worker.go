@@ -18,6 +18,7 @@ import (
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
 	"github.com/kubev2v/migration-planner/pkg/inventory/converters"
 	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/logctx"
 )
 
 // RVToolsWorker processes RVTools assessment jobs.
@@ -70,10 +71,10 @@ func (w *RVToolsWorker) failJob(ctx context.Context, logger *log.OperationTracer
 
 // Work processes an RVTools assessment job.
 func (w *RVToolsWorker) Work(ctx context.Context, job *river.Job[RVToolsJobArgs]) error {
+	ctx = logctx.WithJobID(logctx.WithOrgID(ctx, job.Args.OrgID), job.ID)
 	logger := log.NewDebugLogger("rvtools_worker").
 		WithContext(ctx).
 		Operation("process_rvtools_job").
-		WithParam("job_id", job.ID).
 		WithString("assessment_name", job.Args.Name).
 		Build()
 
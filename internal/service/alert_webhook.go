@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// AlertWebhook returns the webhook the organization receives the alerts of its plans on.
+func (ws *WebhookService) AlertWebhook(ctx context.Context, orgID string) (*model.AlertWebhook, error) {
+	webhook, err := ws.store.Webhook().GetAlertWebhook(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrAlertWebhookNotFound(orgID)
+		}
+		return nil, fmt.Errorf("failed to get alert webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// SetAlertWebhook replaces the webhook the organization receives the alerts of its plans on. The URL
+// must be an absolute http or https URL.
+func (ws *WebhookService) SetAlertWebhook(ctx context.Context, orgID, username, webhookURL string) (*model.AlertWebhook, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("alert webhook %q is not an http or https URL", webhookURL))
+	}
+	saved, err := ws.store.Webhook().SaveAlertWebhook(ctx, model.AlertWebhook{
+		OrgID:     orgID,
+		URL:       webhookURL,
+		UpdatedAt: time.Now(),
+		UpdatedBy: username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save alert webhook: %w", err)
+	}
+	return saved, nil
+}
+
+// DeleteAlertWebhook removes the alert webhook of the organization and returns it: the alerts of its
+// plans are dropped from now on.
+func (ws *WebhookService) DeleteAlertWebhook(ctx context.Context, orgID string) (*model.AlertWebhook, error) {
+	webhook, err := ws.AlertWebhook(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ws.store.Webhook().DeleteAlertWebhook(ctx, orgID); err != nil {
+		return nil, fmt.Errorf("failed to delete alert webhook: %w", err)
+	}
+	return webhook, nil
+}
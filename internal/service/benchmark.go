@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/benchmark"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// contributeBenchmark contributes the anonymized outcome of the plan to the benchmark dataset when
+// all its waves are recorded and its organization opted in. Contributing is best effort: it never
+// fails the recording of the progress.
+func (ps *PlanService) contributeBenchmark(ctx context.Context, p model.Plan) {
+	tracer := ps.logger.WithContext(ctx).Operation("contribute_benchmark").
+		WithUUID("plan_id", p.ID).
+		Build()
+
+	policy, err := ps.GetExportPolicy(ctx, p.OrgID)
+	if err != nil {
+		tracer.Error(err).Log()
+		return
+	}
+	if !policy.Benchmarks {
+		return
+	}
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		tracer.Error(err).Log()
+		return
+	}
+	if !completed(doc) {
+		return
+	}
+
+	chart, err := ps.Gantt(p, time.Now())
+	if err != nil {
+		tracer.Error(err).Log()
+		return
+	}
+
+	env := benchmark.Environment{TransferRateMbps: doc.TransferRateMbps}
+	start, end := doc.Progress[0].Start, doc.Progress[0].End
+	for _, wp := range doc.Progress {
+		env.VMs += wp.VMsMigrated
+		if wp.Start.Before(start) {
+			start = wp.Start
+		}
+		if wp.End.After(end) {
+			end = wp.End
+		}
+	}
+
+	sample, err := benchmark.NewSample(env, chart.End.Sub(chart.Start), end.Sub(start))
+	if err != nil {
+		tracer.Step("skip").WithString("reason", err.Error()).Log()
+		return
+	}
+	if _, err := ps.store.Benchmark().Save(ctx, model.BenchmarkSample{
+		ID:            benchmarkSampleID(p),
+		VMBand:        sample.Cohort.VMBand,
+		Network:       sample.Cohort.Network,
+		PlannedWeeks:  sample.PlannedWeeks,
+		ActualWeeks:   sample.ActualWeeks,
+		ContributedAt: time.Now(),
+	}); err != nil {
+		tracer.Error(fmt.Errorf("failed to save benchmark sample: %w", err)).Log()
+		return
+	}
+
+	tracer.Success().WithString("cohort", sample.Cohort.String()).Log()
+}
+
+// completed tells whether the actuals of all the waves of the plan are recorded.
+func completed(doc plan.Plan) bool {
+	for _, w := range doc.Waves {
+		if !slices.ContainsFunc(doc.Progress, func(wp plan.WaveProgress) bool { return wp.Wave == w.Name }) {
+			return false
+		}
+	}
+	return len(doc.Progress) > 0
+}
+
+// benchmarkSampleID derives the ID of the sample of a plan from its ID, so the plan replaces its
+// own contribution while the sample does not lead back to it.
+func benchmarkSampleID(p model.Plan) string {
+	sum := sha256.Sum256([]byte("benchmark:" + p.ID.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// WithBenchmarks sets the benchmark dataset the estimates are compared with.
+func (es *EstimationService) WithBenchmarks(b store.Benchmark) *EstimationService {
+	es.benchmarks = b
+	return es
+}
+
+// benchmark summarizes the programs of the environments of the cohort of env. It returns nil when
+// the cohort is too small. Benchmarks are best effort: they never fail an estimation.
+func (es *EstimationService) benchmark(ctx context.Context, env benchmark.Environment) *benchmark.Summary {
+	if es.benchmarks == nil {
+		return nil
+	}
+	cohort := benchmark.CohortOf(env)
+	stored, err := es.benchmarks.List(ctx, store.NewBenchmarkQueryFilter().WithCohort(cohort.VMBand, cohort.Network))
+	if err != nil {
+		es.logger.WithContext(ctx).Operation("benchmark").Build().Error(err).Log()
+		return nil
+	}
+	samples := make([]benchmark.Sample, 0, len(stored))
+	for _, s := range stored {
+		samples = append(samples, benchmark.Sample{
+			Cohort:       benchmark.Cohort{VMBand: s.VMBand, Network: s.Network},
+			PlannedWeeks: s.PlannedWeeks,
+			ActualWeeks:  s.ActualWeeks,
+		})
+	}
+	summary, ok := benchmark.Summarize(cohort, samples)
+	if !ok {
+		return nil
+	}
+	return &summary
+}
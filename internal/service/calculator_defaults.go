@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/defaults"
+)
+
+// WithCalculatorDefaults sets the constants of the organizations replacing the built-in ones of the
+// calculators. Without them the estimations run on the built-in constants.
+func (es *EstimationService) WithCalculatorDefaults(d store.CalculatorDefaults) *EstimationService {
+	es.defaults = d
+	return es
+}
+
+// GetCalculatorDefaults returns the calculator defaults of the organization, empty ones leaving the
+// built-in constants when the organization did not set any.
+func (es *EstimationService) GetCalculatorDefaults(ctx context.Context, orgID string) (*model.CalculatorDefaults, error) {
+	d, err := es.store.CalculatorDefaults().Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return &model.CalculatorDefaults{OrgID: orgID, Document: []byte("{}")}, nil
+		}
+		return nil, fmt.Errorf("failed to get calculator defaults: %w", err)
+	}
+	return d, nil
+}
+
+// SetCalculatorDefaults replaces the calculator defaults of the organization. They apply to the
+// estimations from now on and to the plans created afterwards, see defaults.Apply.
+func (es *EstimationService) SetCalculatorDefaults(ctx context.Context, orgID, username string, doc defaults.Constants) (*model.CalculatorDefaults, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode calculator defaults: %w", err)
+	}
+	saved, err := es.store.CalculatorDefaults().Save(ctx, model.CalculatorDefaults{
+		OrgID:     orgID,
+		Document:  document,
+		UpdatedAt: time.Now(),
+		UpdatedBy: username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save calculator defaults: %w", err)
+	}
+	return saved, nil
+}
+
+// CalculatorDefaultsDocument decodes the defaults stored for an organization.
+func CalculatorDefaultsDocument(d model.CalculatorDefaults) (defaults.Constants, error) {
+	var doc defaults.Constants
+	if err := json.Unmarshal(d.Document, &doc); err != nil {
+		return defaults.Constants{}, fmt.Errorf("failed to decode calculator defaults: %w", err)
+	}
+	return doc, nil
+}
+
+// orgCalculatorDefaults returns the calculator defaults of the organization, empty ones when it did
+// not set any.
+func orgCalculatorDefaults(ctx context.Context, d store.CalculatorDefaults, orgID string) (defaults.Constants, error) {
+	stored, err := d.Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return defaults.Constants{}, nil
+		}
+		return defaults.Constants{}, fmt.Errorf("failed to get calculator defaults: %w", err)
+	}
+	return CalculatorDefaultsDocument(*stored)
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/changerate"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// StartChangeRateJob starts sampling the change rate of VMs of the source. It replaces any previous
+// job of the source, measurements included.
+func (s *SourceService) StartChangeRateJob(ctx context.Context, sourceID uuid.UUID, job changerate.Job) (changerate.Job, error) {
+	if _, err := s.GetSource(ctx, sourceID); err != nil {
+		return changerate.Job{}, err
+	}
+
+	if err := job.Validate(); err != nil {
+		return changerate.Job{}, NewErrInvalidRequest(err.Error())
+	}
+
+	return saveChangeRateJob(ctx, s.store, sourceID, job)
+}
+
+// GetChangeRateJob returns the sampling job of the source with the measurements recorded so far.
+func (s *SourceService) GetChangeRateJob(ctx context.Context, sourceID uuid.UUID) (changerate.Job, error) {
+	return getChangeRateJob(ctx, s.store, sourceID)
+}
+
+// GetChangeRateJob returns the sampling job the agent of the source has to carry out.
+func (as *AgentService) GetChangeRateJob(ctx context.Context, sourceID uuid.UUID) (changerate.Job, error) {
+	return getChangeRateJob(ctx, as.store, sourceID)
+}
+
+// RecordChangeRateSamples adds the samples uploaded by the agent to the job of its source.
+func (as *AgentService) RecordChangeRateSamples(ctx context.Context, sourceID, agentID uuid.UUID, samples []changerate.Sample) (changerate.Job, error) {
+	agent, err := as.store.Agent().Get(ctx, agentID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return changerate.Job{}, NewErrAgentNotFound(agentID)
+		}
+		return changerate.Job{}, fmt.Errorf("failed to fetch the agent: %s", err)
+	}
+
+	// don't allow samples of sources not associated with this agent
+	if agent.SourceID != sourceID {
+		return changerate.Job{}, NewErrAgentUpdateForbidden(sourceID, agentID)
+	}
+
+	job, err := getChangeRateJob(ctx, as.store, sourceID)
+	if err != nil {
+		return changerate.Job{}, err
+	}
+
+	if err := job.Record(samples); err != nil {
+		return changerate.Job{}, NewErrInvalidRequest(err.Error())
+	}
+
+	return saveChangeRateJob(ctx, as.store, sourceID, job)
+}
+
+// measuredParams returns the params measured on the source of the assessment: the daily change rate
+// of its VMs once the sampling job of the source measured one, sizing the warm migration. None for an
+// assessment without source.
+func (es *EstimationService) measuredParams(ctx context.Context, assessment *model.Assessment) (map[string]float64, error) {
+	if assessment.SourceID == nil {
+		return nil, nil
+	}
+	job, err := getChangeRateJob(ctx, es.store, *assessment.SourceID)
+	if err != nil {
+		if _, ok := err.(*ErrResourceNotFound); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	rate, ok := job.ChangeRatePercent()
+	if !ok {
+		return nil, nil
+	}
+	return map[string]float64{calculators.ParamChangeRatePercent: rate}, nil
+}
+
+func getChangeRateJob(ctx context.Context, s store.Store, sourceID uuid.UUID) (changerate.Job, error) {
+	m, err := s.ChangeRateJob().Get(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return changerate.Job{}, NewErrChangeRateJobNotFound(sourceID)
+		}
+		return changerate.Job{}, fmt.Errorf("failed to get change rate job: %w", err)
+	}
+
+	var job changerate.Job
+	if err := json.Unmarshal(m.Document, &job); err != nil {
+		return changerate.Job{}, fmt.Errorf("failed to decode change rate job of source %s: %w", sourceID, err)
+	}
+	return job, nil
+}
+
+func saveChangeRateJob(ctx context.Context, s store.Store, sourceID uuid.UUID, job changerate.Job) (changerate.Job, error) {
+	doc, err := json.Marshal(job)
+	if err != nil {
+		return changerate.Job{}, fmt.Errorf("failed to encode change rate job: %w", err)
+	}
+
+	now := time.Now()
+	if _, err := s.ChangeRateJob().Save(ctx, model.ChangeRateJob{SourceID: sourceID, UpdatedAt: &now, Document: doc}); err != nil {
+		return changerate.Job{}, fmt.Errorf("failed to save change rate job: %w", err)
+	}
+	return job, nil
+}
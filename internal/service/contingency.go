@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// WithContingencyPolicies sets the contingency policies of the organizations padding the estimations.
+// Without them the estimations are not padded.
+func (es *EstimationService) WithContingencyPolicies(p store.ContingencyPolicy) *EstimationService {
+	es.contingency = p
+	return es
+}
+
+// GetContingencyPolicy returns the contingency policy of the organization, an empty one padding
+// nothing when the organization did not set any.
+func (es *EstimationService) GetContingencyPolicy(ctx context.Context, orgID string) (*model.ContingencyPolicy, error) {
+	policy, err := es.store.ContingencyPolicy().Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return &model.ContingencyPolicy{OrgID: orgID, Document: []byte("{}")}, nil
+		}
+		return nil, fmt.Errorf("failed to get contingency policy: %w", err)
+	}
+	return policy, nil
+}
+
+// SetContingencyPolicy replaces the contingency policy of the organization. It pads the estimations
+// from now on and the plans created afterwards, see contingency.Floor.
+func (es *EstimationService) SetContingencyPolicy(ctx context.Context, orgID, username string, doc contingency.Policy) (*model.ContingencyPolicy, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode contingency policy: %w", err)
+	}
+	saved, err := es.store.ContingencyPolicy().Save(ctx, model.ContingencyPolicy{
+		OrgID:     orgID,
+		Document:  document,
+		UpdatedAt: time.Now(),
+		UpdatedBy: username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save contingency policy: %w", err)
+	}
+	return saved, nil
+}
+
+// ContingencyPolicyDocument decodes the policy stored for an organization.
+func ContingencyPolicyDocument(p model.ContingencyPolicy) (contingency.Policy, error) {
+	var doc contingency.Policy
+	if err := json.Unmarshal(p.Document, &doc); err != nil {
+		return contingency.Policy{}, fmt.Errorf("failed to decode contingency policy: %w", err)
+	}
+	return doc, nil
+}
+
+// orgContingency returns the contingency policy of the organization, the empty one when it did not
+// set any.
+func orgContingency(ctx context.Context, policies store.ContingencyPolicy, orgID string) (contingency.Policy, error) {
+	p, err := policies.Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return contingency.Policy{}, nil
+		}
+		return contingency.Policy{}, fmt.Errorf("failed to get contingency policy: %w", err)
+	}
+	return ContingencyPolicyDocument(*p)
+}
+
+// contingencyBuffers returns the buffers the policy of the profile adds to the estimations of the
+// calculators, none when the organization has no policy.
+func contingencyBuffers(profile EstimationProfile, results map[string]estimation.Estimation) map[string]estimation.Estimation {
+	if profile.Contingency == nil {
+		return nil
+	}
+	return profile.Contingency.Breakdown(results)
+}
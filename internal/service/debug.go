@@ -0,0 +1,241 @@
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/config"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+	"github.com/kubev2v/migration-planner/pkg/version"
+)
+
+// redactedValue replaces the secrets found in a support bundle.
+const redactedValue = "REDACTED"
+
+// sensitiveKeys are the substrings of the names of the log fields and job arguments left out of
+// a support bundle.
+var sensitiveKeys = []string{"password", "secret", "token", "credential", "authorization", "private_key", "file_content"}
+
+// DebugBundleRequest selects what a support bundle holds besides the configuration and versions.
+type DebugBundleRequest struct {
+	RequestID string
+	PlanID    *uuid.UUID
+	JobID     *int64
+}
+
+// DebugService assembles the support bundles attached to support cases.
+type DebugService struct {
+	store       store.Store
+	cfg         *config.Config
+	recorder    *log.Recorder
+	calculators []string
+	logger      *log.StructuredLogger
+}
+
+// NewDebugService creates a DebugService reading the recent logs from the recorder.
+func NewDebugService(store store.Store, cfg *config.Config, recorder *log.Recorder) *DebugService {
+	return &DebugService{
+		store:    store,
+		cfg:      cfg,
+		recorder: recorder,
+		logger:   log.NewDebugLogger("debug_service"),
+	}
+}
+
+// WithCalculators sets the names of the calculators the estimations run, reported by the bundles.
+func (ds *DebugService) WithCalculators(names []string) *DebugService {
+	ds.calculators = names
+	return ds
+}
+
+// IsAdmin tells whether the user is allowed to use the administration endpoints, e.g. download
+// support bundles or redrive webhooks.
+func (ds *DebugService) IsAdmin(user auth.User) bool {
+	if ds.cfg == nil || ds.cfg.Service == nil {
+		return false
+	}
+	return slices.Contains(ds.cfg.Service.Auth.Admins, user.Username)
+}
+
+type bundleVersion struct {
+	Build       version.Info `json:"build"`
+	Calculators []string     `json:"calculators"`
+}
+
+type bundlePlan struct {
+	ID        uuid.UUID       `json:"id"`
+	Name      string          `json:"name"`
+	OrgID     string          `json:"orgId"`
+	Username  string          `json:"username"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt *time.Time      `json:"updatedAt,omitempty"`
+	Document  json.RawMessage `json:"document"`
+}
+
+type bundleJob struct {
+	ID       int64           `json:"id"`
+	State    string          `json:"state"`
+	Args     map[string]any  `json:"args"`
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+	Logs     []log.Entry     `json:"logs"`
+}
+
+// Bundle assembles a gzipped tarball holding the configuration without its secrets, the versions
+// of the build and of the calculators and, when requested, the recent logs of a request, a plan
+// and the trace of a job.
+func (ds *DebugService) Bundle(ctx context.Context, req DebugBundleRequest) ([]byte, error) {
+	tracer := ds.logger.WithContext(ctx).Operation("debug_bundle").
+		WithString("bundle_request_id", req.RequestID).
+		Build()
+
+	files := map[string]any{
+		"version.json": bundleVersion{Build: version.Get(), Calculators: ds.calculators},
+	}
+	if ds.cfg != nil {
+		files["config.json"] = ds.cfg.Redacted()
+	}
+	if req.RequestID != "" {
+		files["logs/request.json"] = ds.logs(logctx.KeyRequestID, req.RequestID)
+	}
+
+	if req.PlanID != nil {
+		p, err := ds.store.Plan().Get(ctx, *req.PlanID)
+		if err != nil {
+			if errors.Is(err, store.ErrRecordNotFound) {
+				return nil, NewErrPlanNotFound(*req.PlanID)
+			}
+			return nil, fmt.Errorf("failed to get plan: %w", err)
+		}
+		files["plan.json"] = bundlePlan{
+			ID:        p.ID,
+			Name:      p.Name,
+			OrgID:     p.OrgID,
+			Username:  p.Username,
+			CreatedAt: p.CreatedAt,
+			UpdatedAt: p.UpdatedAt,
+			Document:  p.Document,
+		}
+		files["logs/plan.json"] = ds.logs(logctx.KeyPlanID, p.ID.String())
+	}
+
+	if req.JobID != nil {
+		job, err := ds.store.Job().Get(ctx, *req.JobID)
+		if err != nil {
+			if errors.Is(err, store.ErrRecordNotFound) {
+				return nil, NewErrJobNotFound(*req.JobID)
+			}
+			return nil, fmt.Errorf("failed to get job: %w", err)
+		}
+		var args map[string]any
+		if err := json.Unmarshal(job.ArgsJSON, &args); err != nil {
+			return nil, fmt.Errorf("failed to decode job arguments: %w", err)
+		}
+		files["job.json"] = bundleJob{
+			ID:       job.ID,
+			State:    string(job.State),
+			Args:     redactFields(args),
+			Metadata: job.MetadataJSON,
+			Logs:     ds.logs(logctx.KeyJobID, strconv.FormatInt(job.ID, 10)),
+		}
+	}
+
+	out, err := writeBundle(files)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer.Success().WithInt("files", len(files)).Log()
+	return out, nil
+}
+
+// logs returns the recent log entries having the field, without their secrets.
+func (ds *DebugService) logs(key, value string) []log.Entry {
+	if ds.recorder == nil {
+		return []log.Entry{}
+	}
+	entries := ds.recorder.Find(key, value)
+	if entries == nil {
+		return []log.Entry{}
+	}
+	for i := range entries {
+		entries[i].Fields = redactFields(entries[i].Fields)
+	}
+	return entries
+}
+
+// redactFields returns a copy of the fields with the values of the sensitive ones replaced.
+func redactFields(fields map[string]any) map[string]any {
+	if fields == nil {
+		return nil
+	}
+	res := make(map[string]any, len(fields))
+	for k, v := range fields {
+		if isSensitive(k) {
+			res[k] = redactedValue
+			continue
+		}
+		if nested, ok := v.(map[string]any); ok {
+			v = redactFields(nested)
+		}
+		res[k] = v
+	}
+	return res
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeBundle writes the files as indented JSON to a gzipped tarball, in name order.
+func writeBundle(files map[string]any) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	now := time.Now()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		content, err := json.MarshalIndent(files[name], "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), ModTime: now}); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress bundle: %w", err)
+	}
+	return buf.Bytes(), nil
+}
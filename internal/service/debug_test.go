@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/config"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/log"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// debugStore serves a single job.
+type debugStore struct {
+	*MockStore
+	job *store.JobRow
+}
+
+func (s *debugStore) Job() store.Job {
+	return &debugJobStore{job: s.job}
+}
+
+type debugJobStore struct {
+	job *store.JobRow
+}
+
+func (s *debugJobStore) Get(_ context.Context, id int64) (*store.JobRow, error) {
+	if s.job == nil || s.job.ID != id {
+		return nil, store.ErrRecordNotFound
+	}
+	return s.job, nil
+}
+
+func (s *debugJobStore) UpdateMetadata(context.Context, int64, []byte) error {
+	return errors.New("not implemented")
+}
+
+func (s *debugJobStore) Count(context.Context) ([]store.JobCount, error) {
+	return nil, errors.New("not implemented")
+}
+
+// untar returns the files of a gzipped tarball.
+func untar(bundle []byte) map[string][]byte {
+	gz, err := gzip.NewReader(bytes.NewReader(bundle))
+	Expect(err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gz)
+
+	files := map[string][]byte{}
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		Expect(err).NotTo(HaveOccurred())
+		content, err := io.ReadAll(tr)
+		Expect(err).NotTo(HaveOccurred())
+		files[h.Name] = content
+	}
+}
+
+var _ = Describe("debug service", func() {
+	var (
+		cfg      *config.Config
+		recorder *log.Recorder
+		srv      *service.DebugService
+	)
+
+	BeforeEach(func() {
+		defaults, err := config.New()
+		Expect(err).NotTo(HaveOccurred())
+		db, svc := *defaults.Database, *defaults.Service
+		db.Password = "s3cr3t"
+		svc.Auth.Admins = []string{"support"}
+		cfg = &config.Config{Database: &db, Service: &svc}
+
+		recorder = log.NewRecorder(10, zapcore.DebugLevel)
+		logger := zap.New(recorder.Core())
+		logger.Info("plan created", zap.String("request_id", "req-1"), zap.String("password", "s3cr3t"))
+		logger.Info("other request", zap.String("request_id", "req-2"))
+		logger.Info("job running", zap.String("job_id", "7"))
+
+		srv = service.NewDebugService(&debugStore{
+			MockStore: NewMockStore(),
+			job: &store.JobRow{
+				ID:           7,
+				State:        "running",
+				ArgsJSON:     []byte(`{"name":"inventory","file_content":"UEsDBA==","org_id":"acme"}`),
+				MetadataJSON: []byte(`{"status":"parsing"}`),
+			},
+		}, cfg, recorder).WithCalculators([]string{"Storage Migration"})
+	})
+
+	It("only allows the administrators", func() {
+		Expect(srv.IsAdmin(auth.User{Username: "support"})).To(BeTrue())
+		Expect(srv.IsAdmin(auth.User{Username: "admin"})).To(BeFalse())
+	})
+
+	It("bundles the logs of a request and the trace of a job without their secrets", func() {
+		jobID := int64(7)
+		bundle, err := srv.Bundle(context.TODO(), service.DebugBundleRequest{RequestID: "req-1", JobID: &jobID})
+		Expect(err).NotTo(HaveOccurred())
+
+		files := untar(bundle)
+		Expect(files).To(HaveKey("config.json"))
+		Expect(string(files["config.json"])).NotTo(ContainSubstring("s3cr3t"))
+		Expect(files).To(HaveKey("version.json"))
+		Expect(string(files["version.json"])).To(ContainSubstring("Storage Migration"))
+
+		var logs []log.Entry
+		Expect(json.Unmarshal(files["logs/request.json"], &logs)).To(Succeed())
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Message).To(Equal("plan created"))
+		Expect(logs[0].Fields).To(HaveKeyWithValue("password", "REDACTED"))
+
+		var job struct {
+			State string         `json:"state"`
+			Args  map[string]any `json:"args"`
+			Logs  []log.Entry    `json:"logs"`
+		}
+		Expect(json.Unmarshal(files["job.json"], &job)).To(Succeed())
+		Expect(job.State).To(Equal("running"))
+		Expect(job.Args).To(HaveKeyWithValue("org_id", "acme"))
+		Expect(job.Args).To(HaveKeyWithValue("file_content", "REDACTED"))
+		Expect(job.Logs).To(HaveLen(1))
+	})
+
+	It("returns an error for an unknown job", func() {
+		jobID := int64(8)
+		_, err := srv.Bundle(context.TODO(), service.DebugBundleRequest{JobID: &jobID})
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrJobNotFound{}))
+	})
+})
@@ -31,6 +31,14 @@ func NewErrAgentNotFound(id uuid.UUID) *ErrResourceNotFound {
 	return NewErrResourceNotFound(id, "agent")
 }
 
+func NewErrWaveNotFound(planID uuid.UUID, number int) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("wave %d not found in plan %s", number, planID)}
+}
+
+func NewErrPlanVMNotFound(planID uuid.UUID, vm string) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("VM %s not found in the waves of plan %s", vm, planID)}
+}
+
 type ErrFileCorrupted struct {
 	error
 }
@@ -142,3 +150,72 @@ type ErrInvalidRequest struct {
 func NewErrInvalidRequest(message string) *ErrInvalidRequest {
 	return &ErrInvalidRequest{errors.New(message)}
 }
+
+func NewErrPlanNotFound(id uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(id, "plan")
+}
+
+func NewErrEstimationRunNotFound(id uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(id, "estimation run")
+}
+
+func NewErrEstimationFreezeNotFound(id uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(id, "estimation freeze")
+}
+
+func NewErrPlanDuplicateName(name string) *ErrDuplicateKey {
+	return NewErrDuplicateKey("plan", name)
+}
+
+func NewErrRateCardNotFound(id uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(id, "rate card")
+}
+
+func NewErrChangeRateJobNotFound(sourceID uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(sourceID, "change rate job of source")
+}
+
+func NewErrShareNotFound(id uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(id, "share")
+}
+
+// NewErrSharedReportNotFound does not tell unknown, expired and revoked links apart.
+func NewErrSharedReportNotFound() *ErrResourceNotFound {
+	return &ErrResourceNotFound{errors.New("shared report not found")}
+}
+
+type ErrExportForbidden struct {
+	error
+}
+
+func NewErrExportForbidden(format ExportFormat, orgID string) *ErrExportForbidden {
+	return &ErrExportForbidden{fmt.Errorf("the export policy of organization %s does not allow %s exports", orgID, format)}
+}
+
+func NewErrChecklistTemplateNotFound(id uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(id, "checklist template")
+}
+
+func NewErrChecklistNotFound(id uuid.UUID) *ErrResourceNotFound {
+	return NewErrResourceNotFound(id, "checklist")
+}
+
+func NewErrGateNotFound(planID uuid.UUID, gate string) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("gate %q not found in plan %s", gate, planID)}
+}
+
+type ErrGateApprovalForbidden struct {
+	error
+}
+
+func NewErrGateApprovalForbidden(gate, username string) *ErrGateApprovalForbidden {
+	return &ErrGateApprovalForbidden{fmt.Errorf("%s is not an approver of gate %q", username, gate)}
+}
+
+func NewErrWebhookNotFound(id string) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("webhook %s not found", id)}
+}
+
+func NewErrAlertWebhookNotFound(orgID string) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("organization %s has no alert webhook", orgID)}
+}
@@ -5,15 +5,28 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/rvtools/jobs"
 	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/benchmark"
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
 	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/day2"
+	"github.com/kubev2v/migration-planner/pkg/estimations/devices"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/firmware"
+	"github.com/kubev2v/migration-planner/pkg/estimations/guardrails"
+	"github.com/kubev2v/migration-planner/pkg/estimations/prerequisites"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
 	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/version"
 )
 
 // MigrationComplexityResult holds the output of a complexity estimation run.
@@ -29,6 +42,37 @@ type MigrationComplexityResult struct {
 type MigrationAssessmentResult struct {
 	TotalDuration time.Duration
 	Breakdown     map[string]estimation.Estimation
+	// Alternatives are the estimations of alternative approaches to parts of the breakdown, e.g. a
+	// seeded transfer instead of the network transfer. They are not part of the total duration.
+	Alternatives map[string]estimation.Estimation
+	// Benchmark summarizes the programs of similar environments, when enough of them were contributed.
+	Benchmark *benchmark.Summary
+	// Day2 is the day-2 readiness of the target, when declared. It is not part of the total duration.
+	Day2 *day2.Report
+	// Warnings flag the params that look implausible for the environment. The estimation is computed regardless.
+	Warnings []guardrails.Warning
+	// Dates are the calendar dates of the breakdown, keyed by calculator name, when a schedule was requested.
+	Dates map[string]calendar.Span
+	// Lineage tells which layer each param was resolved from, e.g. the request or the defaults of the organization.
+	Lineage []estimation.Lineage
+	// Cost is the cost of the breakdown, nil when no calculator was given the rates to price it.
+	Cost *estimation.Cost
+	// Currency is the currency of the costs, the currency of the plan when the estimation is planned
+	// and the plan sets one, none otherwise.
+	Currency string
+	// ExchangeRate is the rate the costs were converted from the currency of the cost params of the
+	// plan with, nil when they were not converted.
+	ExchangeRate *cost.ExchangeRate
+	// Annotations flag the supplied params the calculators ignored, capped or did not read, so a
+	// mistyped param or an out of range value does not silently fall back to a default.
+	Annotations []estimation.Annotation
+}
+
+// EstimationSchedule is the work calendar an estimation is landed on.
+type EstimationSchedule struct {
+	Start    time.Time
+	Calendar calendar.Calendar
+	Workday  calendar.Workday
 }
 
 // EstimationService orchestrates the migration time estimation workflow.
@@ -37,7 +81,28 @@ type MigrationAssessmentResult struct {
 type EstimationService struct {
 	store  store.Store
 	engine *estimation.Engine
-	logger *log.StructuredLogger
+	// alternatives runs the calculators of the alternative approaches.
+	alternatives *estimation.Engine
+	// day2 sizes the work to close the day-2 gaps of the target.
+	day2  estimation.Calculator
+	queue *EstimationQueue
+	// benchmarks is the dataset the estimates are compared with, none when nil.
+	benchmarks store.Benchmark
+	// models are the troubleshooting models of the organizations, none when nil.
+	models store.TroubleshootingModel
+	// contingency holds the contingency policies of the organizations, none when nil.
+	contingency store.ContingencyPolicy
+	// guardrails holds the guardrail policies of the organizations, none when nil.
+	guardrails store.GuardrailPolicy
+	// defaults holds the calculator defaults of the organizations, none when nil.
+	defaults store.CalculatorDefaults
+	// runs keeps the estimations of the assessments, none when nil.
+	runs store.EstimationRun
+	// freezes keeps the estimations frozen for statements of work, none when nil.
+	freezes store.EstimationFreeze
+	// rateProvider converts the costs of the planned estimations into the plan currency, none when nil.
+	rateProvider cost.RateProvider
+	logger       *log.StructuredLogger
 }
 
 // NewEstimationService creates an EstimationService with the default set of calculators registered.
@@ -47,75 +112,207 @@ func NewEstimationService(store store.Store) *EstimationService {
 	// Register calculators
 	// TODO: later phases can make this configurable by the user
 	engine.Register(calculators.NewStorageMigration())
-	engine.Register(calculators.NewPostMigrationTroubleShooting())
+	engine.Register(calculators.NewPredictedTroubleshooting(nil, calculators.NewPostMigrationTroubleShooting()))
+	engine.Register(calculators.NewGuestOSReconfiguration())
+	engine.Register(calculators.NewFirmwareRemediation())
+	engine.Register(calculators.NewSourceRemediation())
+
+	alternatives := estimation.NewEngine()
+	alternatives.Register(calculators.NewSeededTransfer())
+	alternatives.Register(calculators.NewWarmMigrationDeltaSync())
 
 	return &EstimationService{
-		store:  store,
-		engine: engine,
-		logger: log.NewDebugLogger("estimation_service"),
+		store:        store,
+		engine:       engine,
+		alternatives: alternatives,
+		day2:         calculators.NewDay2Readiness(),
+		queue:        NewEstimationQueue(DefaultInteractiveEstimationWorkers, DefaultBatchEstimationWorkers),
+		logger:       log.NewDebugLogger("estimation_service"),
 	}
 }
 
+// Calculators returns the names of the calculators the estimations run.
+func (es *EstimationService) Calculators() []string {
+	return es.engine.Calculators()
+}
+
+// WithQueue sets the queue the estimations run on.
+func (es *EstimationService) WithQueue(q *EstimationQueue) *EstimationService {
+	es.queue = q
+	return es
+}
+
+// WithRateProvider sets the exchange rate service converting the costs of the planned estimations
+// into the plan currency for the currencies the plan has no rate of its own for.
+func (es *EstimationService) WithRateProvider(p cost.RateProvider) *EstimationService {
+	es.rateProvider = p
+	return es
+}
+
 // CalculateMigrationEstimation calculates migration time estimation for a given assessment and cluster
+// on the worker pool of the priority. overrides replace the params derived from the inventory, keyed
+// by param; the implausible params are reported as warnings.
 func (es *EstimationService) CalculateMigrationEstimation(
 	ctx context.Context,
 	assessmentID uuid.UUID,
 	clusterID string,
+	priority EstimationPriority,
+	overrides map[string]float64,
 ) (*MigrationAssessmentResult, error) {
+	result, _, err := es.calculateMigrationEstimation(ctx, assessmentID, clusterID, priority, overrides, nil, false)
+	return result, err
+}
+
+// RecordMigrationEstimation calculates a migration time estimation the way CalculateMigrationEstimation
+// does, and records everything it depends on so it can be replayed, see Replay.
+func (es *EstimationService) RecordMigrationEstimation(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	clusterID string,
+	priority EstimationPriority,
+	overrides map[string]float64,
+) (*MigrationAssessmentResult, *EstimationRecording, error) {
+	return es.calculateMigrationEstimation(ctx, assessmentID, clusterID, priority, overrides, nil, true)
+}
+
+func (es *EstimationService) calculateMigrationEstimation(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	clusterID string,
+	priority EstimationPriority,
+	overrides map[string]float64,
+	layers *EstimationPlanLayers,
+	record bool,
+) (*MigrationAssessmentResult, *EstimationRecording, error) {
 	logger := es.logger.WithContext(ctx)
 	tracer := logger.Operation("calculate_migration_estimation").
 		WithUUID("assessment_id", assessmentID).
 		WithString("cluster_id", clusterID).
+		WithString("priority", string(priority)).
 		Build()
 
+	assessment, clusterInventory, err := es.clusterInventory(ctx, assessmentID, clusterID)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, nil, err
+	}
+
+	measured, err := es.measuredParams(ctx, assessment)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, nil, err
+	}
+
+	profile := es.profile(ctx, assessment.OrgID)
+	result, params, err := es.estimate(ctx, priority, clusterInventory, measured, overrides, profile, layers)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, nil, err
+	}
+	result.Benchmark = es.benchmark(ctx, benchmark.Environment{
+		VMs:              clusterInventory.Vms.Total,
+		TransferRateMbps: transferRateMbps(params),
+	})
+
+	tracer.Success().
+		WithString("total_duration", result.TotalDuration.String()).
+		WithInt("calculator_count", len(result.Breakdown)).
+		WithInt("warning_count", len(result.Warnings)).
+		WithInt("annotation_count", len(result.Annotations)).
+		Log()
+
+	if !record {
+		return result, nil, nil
+	}
+	recording := &EstimationRecording{
+		Kind:           EstimationRecordingKind,
+		Version:        EstimationRecordingVersion,
+		RecordedAt:     time.Now().UTC(),
+		PlannerVersion: version.Get().String(),
+		AssessmentID:   assessmentID,
+		ClusterID:      clusterID,
+		Calculators:    es.engine.Calculators(),
+		Alternatives:   es.alternatives.Calculators(),
+		Inventory:      clusterInventory,
+		Measured:       measured,
+		Overrides:      overrides,
+		Profile:        profile,
+		Plan:           layers,
+		Params:         recordParams(params),
+		TotalDuration:  result.TotalDuration,
+		Breakdown:      result.Breakdown,
+	}
+	return result, recording, nil
+}
+
+// clusterInventory returns the assessment and the inventory of its cluster, as of its latest snapshot.
+func (es *EstimationService) clusterInventory(ctx context.Context, assessmentID uuid.UUID, clusterID string) (*model.Assessment, api.InventoryData, error) {
 	assessment, err := es.store.Assessment().Get(ctx, assessmentID)
 	if err != nil {
 		if errors.Is(err, store.ErrRecordNotFound) {
-			tracer.Error(err).Log()
-			return nil, NewErrAssessmentNotFound(assessmentID)
+			return nil, api.InventoryData{}, NewErrAssessmentNotFound(assessmentID)
 		}
-		tracer.Error(err).Log()
-		return nil, fmt.Errorf("failed to get assessment: %w", err)
+		return nil, api.InventoryData{}, fmt.Errorf("failed to get assessment: %w", err)
 	}
 
 	if len(assessment.Snapshots) == 0 {
-		err := fmt.Errorf("assessment has no snapshots")
-		tracer.Error(err).Log()
-		return nil, err
+		return nil, api.InventoryData{}, fmt.Errorf("assessment has no snapshots")
 	}
 
 	// assuming each assessment has one snapshot at most
 	latestSnapshot := assessment.Snapshots[0]
 	if len(latestSnapshot.Inventory) == 0 {
-		err := fmt.Errorf("latest snapshot has empty inventory")
-		tracer.Error(err).Log()
-		return nil, err
+		return nil, api.InventoryData{}, fmt.Errorf("latest snapshot has empty inventory")
 	}
 
 	var inventory api.Inventory
 	if err := json.Unmarshal(latestSnapshot.Inventory, &inventory); err != nil {
-		tracer.Error(err).Log()
-		return nil, fmt.Errorf("failed to parse inventory: %w", err)
+		return nil, api.InventoryData{}, fmt.Errorf("failed to parse inventory: %w", err)
 	}
 
 	if len(inventory.Clusters) == 0 {
-		err := fmt.Errorf("inventory has no clusters")
-		tracer.Error(err).Log()
-		return nil, err
+		return nil, api.InventoryData{}, fmt.Errorf("inventory has no clusters")
 	}
 
 	clusterInventory, exists := inventory.Clusters[clusterID]
 	if !exists {
-		err := NewErrClusterNotFound(clusterID, assessmentID)
-		tracer.Error(err).Log()
-		return nil, err
+		return nil, api.InventoryData{}, NewErrClusterNotFound(clusterID, assessmentID)
 	}
+	return assessment, clusterInventory, nil
+}
 
-	params := es.mapClusterToParams(clusterInventory)
+// estimate runs the calculators over the params derived from the inventory of a cluster and measured
+// on its source, the profile of its organization and the plan migrating it, when given. It depends on nothing else, so a
+// recording replays it exactly.
+func (es *EstimationService) estimate(
+	ctx context.Context,
+	priority EstimationPriority,
+	clusterInventory api.InventoryData,
+	measured map[string]float64,
+	overrides map[string]float64,
+	profile EstimationProfile,
+	layers *EstimationPlanLayers,
+) (*MigrationAssessmentResult, []estimation.Param, error) {
+	resolver := es.paramResolver(clusterInventory, measured, overrides, profile, layers)
+	params := append(resolver.Params(), troubleshootingParams(profile, clusterInventory)...)
+
+	warnings := guardrailWarnings(profile, params, clusterInventory)
+
+	var results, alternatives map[string]estimation.Estimation
+	if err := es.queue.Do(ctx, priority, func() error {
+		results = es.engine.Run(params)
+		alternatives = es.alternatives.Run(params)
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
 
-	tracer.Step("mapped_params").WithInt("param_count", len(params)).Log()
+	// The buffers are line items of their own, part of the total duration
+	for name, buffer := range contingencyBuffers(profile, results) {
+		results[name] = buffer
+	}
 
-	results := es.engine.Run(params)
+	lineage := resolver.Lineage()
 
 	// Calculate total duration (simple sum for now)
 	totalDuration := time.Duration(0)
@@ -123,17 +320,172 @@ func (es *EstimationService) CalculateMigrationEstimation(
 		totalDuration += est.Duration
 	}
 
+	return &MigrationAssessmentResult{
+		TotalDuration: totalDuration,
+		Breakdown:     results,
+		Alternatives:  alternatives,
+		Warnings:      warnings,
+		Lineage:       lineage,
+		Cost:          estimation.TotalCost(results),
+		Annotations:   estimationAnnotations(results, lineage, es.engine, es.alternatives),
+	}, params, nil
+}
+
+// RunEstimation runs the calculators of the specs, built from calculators.DefaultRegistry, over the
+// params on the worker pool of the priority, for a caller with no assessment, e.g. the UI sizing a
+// program by hand. The calculator defaults of the organization fill the params not given and the
+// buffers of its contingency policy are added to the breakdown.
+func (es *EstimationService) RunEstimation(
+	ctx context.Context,
+	orgID string,
+	priority EstimationPriority,
+	specs []calculators.Spec,
+	params []estimation.Param,
+) (*MigrationAssessmentResult, error) {
+	return es.runEstimation(ctx, orgID, priority, specs, params, nil)
+}
+
+// RunEstimationJob runs the estimation of an estimation job like RunEstimation, calling progress after
+// each calculator, and lands it on the schedule of the job when given. It returns the JSON encoded
+// MigrationAssessmentResult.
+func (es *EstimationService) RunEstimationJob(ctx context.Context, args jobs.EstimationJobArgs, progress func(completed, total int)) (json.RawMessage, error) {
+	keys := make([]string, 0, len(args.Params))
+	for key := range args.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	params := make([]estimation.Param, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, estimation.Param{Key: key, Value: args.Params[key]})
+	}
+
+	result, err := es.runEstimation(ctx, args.OrgID, EstimationPriority(args.Priority), args.Calculators, params, progress)
+	if err != nil {
+		return nil, err
+	}
+	if args.Schedule != nil {
+		schedule := EstimationSchedule{Start: args.Schedule.Start, Calendar: args.Schedule.Calendar, Workday: args.Schedule.Workday}
+		if err := es.Schedule(ctx, result, schedule); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(result)
+}
+
+func (es *EstimationService) runEstimation(
+	ctx context.Context,
+	orgID string,
+	priority EstimationPriority,
+	specs []calculators.Spec,
+	params []estimation.Param,
+	progress func(completed, total int),
+) (*MigrationAssessmentResult, error) {
+	tracer := es.logger.WithContext(ctx).Operation("run_estimation").
+		WithString("org_id", orgID).
+		WithString("priority", string(priority)).
+		WithInt("calculator_count", len(specs)).
+		Build()
+
+	engine, err := calculators.DefaultRegistry.Engine(specs...)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+
+	// the calculator defaults of the organization fill the params not given
+	profile := es.profile(ctx, orgID)
+	resolver := estimation.NewResolver()
+	if profile.Defaults != nil {
+		resolver.SetAll(estimation.LayerOrganization, profile.Defaults.Params())
+	}
+	for _, p := range params {
+		resolver.Set(estimation.LayerRequest, p.Key, p.Value)
+	}
+	params = resolver.Params()
+
+	var results map[string]estimation.Estimation
+	if err := es.queue.Do(ctx, priority, func() error {
+		results = engine.RunWithProgress(params, progress)
+		return nil
+	}); err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+
+	for name, buffer := range contingencyBuffers(profile, results) {
+		results[name] = buffer
+	}
+
+	durations := make([]time.Duration, 0, len(results))
+	for _, est := range results {
+		durations = append(durations, est.Duration)
+	}
+	total, err := estimation.Sum(durations...)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+
+	lineage := resolver.Lineage()
+	annotations := estimationAnnotations(results, lineage, engine)
+
 	tracer.Success().
-		WithString("total_duration", totalDuration.String()).
-		WithInt("calculator_count", len(results)).
+		WithString("total_duration", total.String()).
+		WithInt("annotation_count", len(annotations)).
 		Log()
-
 	return &MigrationAssessmentResult{
-		TotalDuration: totalDuration,
+		TotalDuration: total,
 		Breakdown:     results,
+		Lineage:       lineage,
+		Cost:          estimation.TotalCost(results),
+		Annotations:   annotations,
 	}, nil
 }
 
+// Day2Readiness assesses the day-2 gaps of the declared target and estimates closing them.
+func (es *EstimationService) Day2Readiness(ctx context.Context, target day2.Target) (day2.Report, error) {
+	tracer := es.logger.WithContext(ctx).Operation("day2_readiness").
+		WithString("cluster", target.Cluster).
+		Build()
+
+	report, err := day2.ReportReadiness(target, es.day2)
+	if err != nil {
+		tracer.Error(err).Log()
+		return day2.Report{}, err
+	}
+
+	tracer.Success().WithInt("gap_count", len(report.Gaps)).Log()
+	return report, nil
+}
+
+// Schedule lands each part of the breakdown on the calendar as if started at the start of the schedule.
+func (es *EstimationService) Schedule(ctx context.Context, result *MigrationAssessmentResult, schedule EstimationSchedule) error {
+	tracer := es.logger.WithContext(ctx).Operation("schedule_estimation").
+		WithString("start", schedule.Start.Format(time.RFC3339)).
+		Build()
+
+	if err := schedule.Calendar.Validate(); err != nil {
+		return NewErrInvalidRequest(err.Error())
+	}
+	if err := schedule.Workday.Validate(); err != nil {
+		return NewErrInvalidRequest(err.Error())
+	}
+
+	dates := make(map[string]calendar.Span, len(result.Breakdown))
+	for name, est := range result.Breakdown {
+		span, err := schedule.Calendar.Dates(schedule.Start, est, schedule.Workday)
+		if err != nil {
+			tracer.Error(err).Log()
+			return NewErrInvalidRequest(fmt.Sprintf("cannot schedule %s: %v", name, err))
+		}
+		dates[name] = span
+	}
+	result.Dates = dates
+
+	tracer.Success().Log()
+	return nil
+}
+
 // CalculateMigrationComplexity calculates OS and disk complexity breakdowns
 // for the given cluster within the assessment's inventory.
 func (es *EstimationService) CalculateMigrationComplexity(
@@ -231,7 +583,7 @@ func (es *EstimationService) buildComplexityResult(clusterInventory api.Inventor
 	}, nil
 }
 
-// mapClusterToParams converts cluster inventory data to estimation parameters
+// mapClusterToParams converts cluster inventory data to the estimation parameters of the inventory layer
 func (es *EstimationService) mapClusterToParams(clusterInventory api.InventoryData) []estimation.Param {
 	params := []estimation.Param{}
 
@@ -249,17 +601,60 @@ func (es *EstimationService) mapClusterToParams(clusterInventory api.InventoryDa
 		Value: totalVMs,
 	})
 
-	// TODO: expose via API request body in a future phase
-	params = append(params, estimation.Param{
-		Key:   calculators.ParamTransferRateMbps,
-		Value: calculators.DefaultTransferRateMbps,
-	})
-
-	// TODO: expose via API request body in a future phase
-	params = append(params, estimation.Param{
-		Key:   calculators.ParamWorkHoursPerDay,
-		Value: calculators.DefaultWorkHoursPerDay,
-	})
+	// The warnings of the assessment count the VMs needing work on their guest drivers, firmware and
+	// VMware Tools, the vCenter and hosts are checked here
+	warnings := warningCounts(clusterInventory)
+	params = append(params, devices.ConcernParams(warnings)...)
+	params = append(params, firmware.ConcernParams(warnings)...)
+	params = append(params, prerequisites.ConcernParams(prerequisites.NewChecker().Check(prerequisiteSource(clusterInventory.Infra)), warnings)...)
 
 	return params
 }
+
+// prerequisiteSource returns the vCenter and hosts of the inventory to check the prerequisites of.
+func prerequisiteSource(infra api.Infra) prerequisites.Source {
+	var src prerequisites.Source
+	if infra.VcenterVersion != nil {
+		src.VCenterVersion = *infra.VcenterVersion
+	}
+	if infra.Hosts != nil {
+		for _, h := range *infra.Hosts {
+			host := inventory.Host{Vendor: h.Vendor, Model: h.Model}
+			if h.Id != nil {
+				host.ID = *h.Id
+			}
+			if h.Version != nil {
+				host.Version = *h.Version
+			}
+			src.Hosts = append(src.Hosts, host)
+		}
+	}
+	return src
+}
+
+// warningCounts returns the number of VMs of each migration warning of the inventory, keyed by concern ID.
+func warningCounts(clusterInventory api.InventoryData) map[string]int {
+	counts := make(map[string]int, len(clusterInventory.Vms.MigrationWarnings))
+	for _, w := range clusterInventory.Vms.MigrationWarnings {
+		if w.Id != nil {
+			counts[*w.Id] += w.Count
+		}
+	}
+	return counts
+}
+
+// transferRateMbps returns the transfer rate of the params, the default one when they hold none.
+func transferRateMbps(params []estimation.Param) float64 {
+	for _, p := range params {
+		if p.Key != calculators.ParamTransferRateMbps {
+			continue
+		}
+		switch v := p.Value.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		}
+	}
+	return calculators.DefaultTransferRateMbps
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/pdf"
+	"github.com/kubev2v/migration-planner/pkg/signing"
+)
+
+// WithEstimationFreezes keeps the estimations frozen for statements of work. Without it no estimation
+// can be frozen.
+func (es *EstimationService) WithEstimationFreezes(f store.EstimationFreeze) *EstimationService {
+	es.freezes = f
+	return es
+}
+
+// EstimationFreezeRequest is an estimation of a cluster to freeze for a statement of work.
+type EstimationFreezeRequest struct {
+	Name string
+	// SOWID is the identifier of the statement of work in the tooling of the organization.
+	SOWID        string
+	AssessmentID uuid.UUID
+	ClusterID    string
+	Overrides    map[string]float64
+	// Plan is the plan the estimation is made for, none when nil.
+	Plan *model.Plan
+}
+
+// FreezeEstimation estimates the cluster and freezes the estimation for the statement of work of the
+// user: its inputs, its outputs and its PDF report are kept as they are now, whatever happens to the
+// assessment, the plan or the calculators later on.
+func (es *EstimationService) FreezeEstimation(ctx context.Context, username, orgID string, req EstimationFreezeRequest) (*model.EstimationFreeze, error) {
+	if es.freezes == nil {
+		return nil, errors.New("estimation freezes are not kept")
+	}
+	if req.Name == "" || req.SOWID == "" {
+		return nil, NewErrInvalidRequest("a freeze needs a name and a statement of work ID")
+	}
+
+	var (
+		result *MigrationAssessmentResult
+		rec    *EstimationRecording
+		err    error
+	)
+	if req.Plan != nil {
+		result, rec, err = es.CalculatePlannedMigrationEstimation(ctx, *req.Plan, req.AssessmentID, req.ClusterID, EstimationPriorityInteractive, req.Overrides, true)
+	} else {
+		result, rec, err = es.RecordMigrationEstimation(ctx, req.AssessmentID, req.ClusterID, EstimationPriorityInteractive, req.Overrides)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode estimation freeze: %w", err)
+	}
+	freeze := model.EstimationFreeze{
+		ID:            uuid.New(),
+		CreatedAt:     rec.RecordedAt,
+		OrgID:         orgID,
+		Username:      username,
+		Name:          req.Name,
+		SOWID:         req.SOWID,
+		AssessmentID:  req.AssessmentID,
+		ClusterID:     req.ClusterID,
+		TotalDuration: rec.TotalDuration,
+		Digest:        signing.Digest(document),
+		Document:      document,
+	}
+	if req.Plan != nil {
+		freeze.PlanID = &req.Plan.ID
+	}
+	freeze.Report = freezeReport(freeze, *rec, result)
+
+	created, err := es.freezes.Create(ctx, freeze)
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return nil, NewErrDuplicateKey(fmt.Sprintf("estimation freeze of statement of work %q", req.SOWID), req.Name)
+		}
+		return nil, fmt.Errorf("failed to save estimation freeze: %w", err)
+	}
+	return created, nil
+}
+
+// ListEstimationFreezes returns the freezes of the user, the most recent first, only those of the
+// statement of work when sowID is set.
+func (es *EstimationService) ListEstimationFreezes(ctx context.Context, username, orgID, sowID string) (model.EstimationFreezeList, error) {
+	if es.freezes == nil {
+		return model.EstimationFreezeList{}, nil
+	}
+	filter := store.NewEstimationFreezeQueryFilter().WithUsername(username).WithOrgID(orgID)
+	if sowID != "" {
+		filter = filter.WithSOWID(sowID)
+	}
+	freezes, err := es.freezes.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list estimation freezes: %w", err)
+	}
+	return freezes, nil
+}
+
+// GetEstimationFreeze returns a freeze with its report.
+func (es *EstimationService) GetEstimationFreeze(ctx context.Context, id uuid.UUID) (*model.EstimationFreeze, error) {
+	if es.freezes == nil {
+		return nil, NewErrEstimationFreezeNotFound(id)
+	}
+	freeze, err := es.freezes.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrEstimationFreezeNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get estimation freeze: %w", err)
+	}
+	return freeze, nil
+}
+
+// EstimationFreezeDocument decodes the recording of a freeze.
+func EstimationFreezeDocument(f model.EstimationFreeze) (EstimationRecording, error) {
+	var rec EstimationRecording
+	if err := json.Unmarshal(f.Document, &rec); err != nil {
+		return EstimationRecording{}, fmt.Errorf("failed to decode estimation freeze: %w", err)
+	}
+	return rec, nil
+}
+
+// EstimationFreezeDiff tells how the current estimation differs from a frozen one.
+type EstimationFreezeDiff struct {
+	Freeze  model.EstimationFreeze
+	Current *MigrationAssessmentResult
+	// TotalDurationChange is the current total duration minus the frozen one.
+	TotalDurationChange time.Duration
+	// Differences explain the change, none while the estimation stands as frozen.
+	Differences []string
+}
+
+// DiffEstimationFreeze estimates the cluster of the freeze again, with its overrides and the current
+// inventory, profile of the organization, calculators and plan, and compares the outcome with the
+// frozen one. p is the plan the freeze was made for as it is now, nil when it was deleted.
+func (es *EstimationService) DiffEstimationFreeze(ctx context.Context, f model.EstimationFreeze, p *model.Plan) (EstimationFreezeDiff, error) {
+	frozen, err := EstimationFreezeDocument(f)
+	if err != nil {
+		return EstimationFreezeDiff{}, err
+	}
+
+	var (
+		current *MigrationAssessmentResult
+		rec     *EstimationRecording
+		diffs   []string
+	)
+	switch {
+	case p != nil:
+		current, rec, err = es.CalculatePlannedMigrationEstimation(ctx, *p, f.AssessmentID, f.ClusterID, EstimationPriorityInteractive, frozen.Overrides, true)
+	default:
+		if f.PlanID != nil {
+			diffs = append(diffs, fmt.Sprintf("plan %s no longer exists, estimated without it", *f.PlanID))
+		}
+		current, rec, err = es.RecordMigrationEstimation(ctx, f.AssessmentID, f.ClusterID, EstimationPriorityInteractive, frozen.Overrides)
+	}
+	if err != nil {
+		return EstimationFreezeDiff{}, err
+	}
+
+	return EstimationFreezeDiff{
+		Freeze:              f,
+		Current:             current,
+		TotalDurationChange: rec.TotalDuration - frozen.TotalDuration,
+		Differences:         append(diffs, diffRecordings(frozen, *rec, "frozen", "current")...),
+	}, nil
+}
+
+// freezeReport renders the PDF report of a freeze: what it is for, the estimate and its breakdown,
+// the params it did not use as given and the inputs it was made from.
+func freezeReport(f model.EstimationFreeze, rec EstimationRecording, result *MigrationAssessmentResult) []byte {
+	doc := pdf.New(fmt.Sprintf("Migration estimate %s", f.Name)).
+		Textf("Statement of work: %s", f.SOWID).
+		Textf("Frozen on %s by %s", f.CreatedAt.UTC().Format(time.RFC1123), f.Username).
+		Textf("Assessment %s, cluster %s", f.AssessmentID, f.ClusterID)
+	if f.PlanID != nil {
+		doc.Textf("Plan %s", *f.PlanID)
+	}
+	if rec.PlannerVersion != "" {
+		doc.Textf("Planner version %s", rec.PlannerVersion)
+	}
+	doc.Textf("Digest %s", f.Digest)
+
+	doc.Heading("Estimate").Textf("Total duration: %s", rec.TotalDuration)
+	if result != nil && result.Cost != nil {
+		doc.Textf("Cost: %.2f (labor %.2f, infrastructure %.2f, %.1f engineer hours)",
+			result.Cost.Total(), result.Cost.Labor, result.Cost.Infrastructure, result.Cost.EngineerHours)
+		if result.Currency != "" {
+			doc.Textf("Currency: %s", result.Currency)
+		}
+		if r := result.ExchangeRate; r != nil {
+			doc.Textf("Converted from %s at %g as of %s (%s)", r.From, r.Rate, r.AsOf.Format(time.DateOnly), r.Source)
+		}
+	}
+
+	doc.Heading("Breakdown")
+	for _, name := range slices.Sorted(maps.Keys(rec.Breakdown)) {
+		est := rec.Breakdown[name]
+		line := fmt.Sprintf("%s: %s", name, est.Duration)
+		if est.LeadTime > 0 {
+			line += fmt.Sprintf(" (lead time %s)", est.LeadTime)
+		}
+		if est.Reason != "" {
+			line += " - " + est.Reason
+		}
+		doc.Text(line)
+	}
+
+	if result != nil && len(result.Annotations) > 0 {
+		doc.Heading("Annotations")
+		for _, a := range result.Annotations {
+			doc.Text(a.Message)
+		}
+	}
+
+	doc.Heading("Inputs")
+	for _, key := range slices.Sorted(maps.Keys(rec.Params)) {
+		doc.Textf("%s: %s", key, rec.Params[key])
+	}
+	if len(rec.Overrides) > 0 {
+		doc.Heading("Overrides")
+		for _, key := range slices.Sorted(maps.Keys(rec.Overrides)) {
+			doc.Textf("%s: %v", key, rec.Overrides[key])
+		}
+	}
+	return doc.Bytes()
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// EstimationPlanLayers are the params a plan sets for the estimation of a cluster it migrates: those
+// of the plan and those of the wave listing the cluster.
+type EstimationPlanLayers struct {
+	PlanID uuid.UUID `json:"planId"`
+	// Wave is the wave migrating the cluster, none when no wave lists it.
+	Wave       string             `json:"wave,omitempty"`
+	PlanParams map[string]float64 `json:"planParams,omitempty"`
+	WaveParams map[string]float64 `json:"waveParams,omitempty"`
+}
+
+// PlanLayers returns the params the plan sets for the estimation of the cluster. The plan sets the
+// transfer rate; the wave migrating the cluster sets the transfer rate of its source and the working
+// day its steps share.
+func PlanLayers(p model.Plan, clusterID string) (EstimationPlanLayers, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return EstimationPlanLayers{}, err
+	}
+
+	layers := EstimationPlanLayers{PlanID: p.ID, PlanParams: map[string]float64{}, WaveParams: map[string]float64{}}
+	if doc.TransferRateMbps > 0 {
+		layers.PlanParams[calculators.ParamTransferRateMbps] = doc.TransferRateMbps
+	}
+
+	i := slices.IndexFunc(doc.Waves, func(w plan.Wave) bool { return slices.Contains(w.Clusters, clusterID) })
+	if i < 0 {
+		return layers, nil
+	}
+	wave := doc.Waves[i]
+	layers.Wave = wave.Name
+	for _, s := range doc.Sources {
+		if s.Name == wave.Source && s.TransferRateMbps > 0 {
+			layers.WaveParams[calculators.ParamTransferRateMbps] = s.TransferRateMbps
+		}
+	}
+	if hours := sharedWorkHours(wave.Steps); hours > 0 {
+		layers.WaveParams[calculators.ParamWorkHoursPerDay] = hours
+	}
+	return layers, nil
+}
+
+// sharedWorkHours returns the working day of the steps when they all share one, zero otherwise.
+func sharedWorkHours(steps []plan.Step) float64 {
+	if len(steps) == 0 {
+		return 0
+	}
+	hours := steps[0].WorkHoursPerDay
+	for _, s := range steps[1:] {
+		if s.WorkHoursPerDay != hours {
+			return 0
+		}
+	}
+	return hours
+}
+
+// CalculatePlannedMigrationEstimation calculates a migration time estimation the way
+// CalculateMigrationEstimation does for a cluster the plan migrates: the params of the plan and of
+// its wave come between the calculator defaults of the organization and the overrides. The recording
+// is returned when record is set. The costs are converted from the currency of the cost params of the
+// plan into the plan currency, with the rates of the plan or else of the exchange rate service.
+func (es *EstimationService) CalculatePlannedMigrationEstimation(
+	ctx context.Context,
+	p model.Plan,
+	assessmentID uuid.UUID,
+	clusterID string,
+	priority EstimationPriority,
+	overrides map[string]float64,
+	record bool,
+) (*MigrationAssessmentResult, *EstimationRecording, error) {
+	layers, err := PlanLayers(p, clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, recording, err := es.calculateMigrationEstimation(ctx, assessmentID, clusterID, priority, overrides, &layers, record)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := es.convertCosts(ctx, doc, result); err != nil {
+		return nil, nil, err
+	}
+	return result, recording, nil
+}
+
+// convertCosts converts the costs of the result from the currency of the cost params of the plan into
+// the plan currency. The result is left in the currency of the params when the plan sets no currency.
+func (es *EstimationService) convertCosts(ctx context.Context, doc plan.Plan, result *MigrationAssessmentResult) error {
+	from, to := doc.ParamsCurrency, doc.Currency
+	if to == "" {
+		to = from
+	}
+	result.Currency = to
+	if from == "" || from == to {
+		return nil
+	}
+
+	rates, err := planRates(doc, es.rateProvider)
+	if err != nil {
+		return err
+	}
+	rate, err := rates.Lookup(ctx, from, to)
+	if err != nil {
+		if errors.Is(err, cost.ErrNoExchangeRate) {
+			return NewErrInvalidRequest(err.Error())
+		}
+		return err
+	}
+	result.ExchangeRate = &rate
+
+	// The breakdown is shared with the recording, which keeps the costs in the currency of the params
+	scale := func(results map[string]estimation.Estimation) map[string]estimation.Estimation {
+		res := make(map[string]estimation.Estimation, len(results))
+		for name, est := range results {
+			if est.Cost != nil {
+				c := est.Cost.Scale(rate.Rate)
+				est.Cost = &c
+			}
+			res[name] = est
+		}
+		return res
+	}
+	result.Breakdown = scale(result.Breakdown)
+	result.Alternatives = scale(result.Alternatives)
+	if result.Cost != nil {
+		c := result.Cost.Scale(rate.Rate)
+		result.Cost = &c
+	}
+	return nil
+}
+
+// paramResolver layers the params of an estimation of a cluster, from the lowest precedence to the
+// highest: the built-in constants, the inventory and the params measured on its source, the calculator
+// defaults of the organization, the plan and its wave, and the overrides.
+func (es *EstimationService) paramResolver(clusterInventory api.InventoryData, measured map[string]float64, overrides map[string]float64, profile EstimationProfile, layers *EstimationPlanLayers) *estimation.Resolver {
+	r := estimation.NewResolver()
+	for _, p := range builtInParams() {
+		r.Set(estimation.LayerBuiltIn, p.Key, p.Value)
+	}
+	for _, p := range es.mapClusterToParams(clusterInventory) {
+		r.Set(estimation.LayerInventory, p.Key, p.Value)
+	}
+	r.SetAll(estimation.LayerInventory, measured)
+	if profile.Defaults != nil {
+		r.SetAll(estimation.LayerOrganization, profile.Defaults.Params())
+	}
+	if layers != nil {
+		r.SetAll(estimation.LayerPlan, layers.PlanParams)
+		r.SetAll(estimation.LayerWave, layers.WaveParams)
+	}
+	return r.SetAll(estimation.LayerRequest, overrides)
+}
+
+// builtInParams are the constants the calculators run with when no layer sets them.
+func builtInParams() []estimation.Param {
+	return []estimation.Param{
+		{Key: calculators.ParamTransferRateMbps, Value: calculators.DefaultTransferRateMbps},
+		{Key: calculators.ParamWorkHoursPerDay, Value: calculators.DefaultWorkHoursPerDay},
+	}
+}
+
+// suppliedLayers are the layers whose params the user supplied, annotated when no calculator reads them.
+var suppliedLayers = []estimation.Layer{estimation.LayerRequest, estimation.LayerWave, estimation.LayerPlan}
+
+// estimationAnnotations gathers the annotations of the breakdown, by calculator name, and annotates
+// the supplied params which none of the calculators of the engines reads.
+func estimationAnnotations(results map[string]estimation.Estimation, lineage []estimation.Lineage, engines ...*estimation.Engine) []estimation.Annotation {
+	var res []estimation.Annotation
+	for _, name := range slices.Sorted(maps.Keys(results)) {
+		res = append(res, results[name].Annotations...)
+	}
+
+	var supplied []estimation.Param
+	for _, l := range lineage {
+		if slices.Contains(suppliedLayers, l.Layer) {
+			supplied = append(supplied, estimation.Param{Key: l.Key, Value: l.Value})
+		}
+	}
+	// a param is unused when no engine reads it
+	var unused []estimation.Annotation
+	for _, e := range engines {
+		unused = e.Unused(supplied)
+		supplied = make([]estimation.Param, 0, len(unused))
+		for _, a := range unused {
+			supplied = append(supplied, estimation.Param{Key: a.Param, Value: a.Value})
+		}
+	}
+	res = append(res, unused...)
+	return res
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kubev2v/migration-planner/pkg/tracing"
+)
+
+// EstimationPriority tells the EstimationQueue which worker pool runs an estimation.
+type EstimationPriority string
+
+const (
+	// EstimationPriorityInteractive is for recalculations a user waits for in the UI.
+	EstimationPriorityInteractive EstimationPriority = "interactive"
+	// EstimationPriorityBatch is for long-running work such as Monte Carlo runs with thousands of trials.
+	EstimationPriorityBatch EstimationPriority = "batch"
+)
+
+const (
+	DefaultInteractiveEstimationWorkers = 8
+	DefaultBatchEstimationWorkers       = 2
+)
+
+// EstimationQueue runs estimations on a separate worker pool per priority, so interactive
+// recalculations never wait behind batch runs submitted by other users. Estimations of the same
+// priority queue for a free worker until their context is done.
+type EstimationQueue struct {
+	workers map[EstimationPriority]chan struct{}
+}
+
+// NewEstimationQueue creates an EstimationQueue with the given number of workers per pool.
+// Pools with less than one worker get one.
+func NewEstimationQueue(interactiveWorkers, batchWorkers int) *EstimationQueue {
+	return &EstimationQueue{
+		workers: map[EstimationPriority]chan struct{}{
+			EstimationPriorityInteractive: make(chan struct{}, max(interactiveWorkers, 1)),
+			EstimationPriorityBatch:       make(chan struct{}, max(batchWorkers, 1)),
+		},
+	}
+}
+
+// Do runs fn on a worker of the pool of the priority, waiting for one to be free. An empty priority
+// is interactive. It fails without running fn for an unknown priority or once ctx is done. The run is
+// traced in a span of the trace of ctx, the wait for a worker being an event of the span.
+func (q *EstimationQueue) Do(ctx context.Context, priority EstimationPriority, fn func() error) (err error) {
+	if priority == "" {
+		priority = EstimationPriorityInteractive
+	}
+	workers, ok := q.workers[priority]
+	if !ok {
+		return NewErrInvalidRequest(fmt.Sprintf("unknown estimation priority %q", priority))
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "estimation_run", trace.WithAttributes(attribute.String("estimation.priority", string(priority))))
+	defer func() { tracing.End(span, err) }()
+
+	select {
+	case workers <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("estimation queued with %s priority: %w", priority, ctx.Err())
+	}
+	defer func() { <-workers }()
+	span.AddEvent("worker_acquired")
+
+	return fn()
+}
+
+// Busy returns the number of workers of the pool of the priority running an estimation.
+func (q *EstimationQueue) Busy(priority EstimationPriority) int {
+	return len(q.workers[priority])
+}
+
+// EstimationPoolStats is the load of a worker pool of an EstimationQueue.
+type EstimationPoolStats struct {
+	Workers int `json:"workers"`
+	Busy    int `json:"busy"`
+}
+
+// Stats returns the load of the worker pool of each priority.
+func (q *EstimationQueue) Stats() map[EstimationPriority]EstimationPoolStats {
+	stats := make(map[EstimationPriority]EstimationPoolStats, len(q.workers))
+	for priority, workers := range q.workers {
+		stats[priority] = EstimationPoolStats{Workers: cap(workers), Busy: len(workers)}
+	}
+	return stats
+}
@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EstimationQueue", func() {
+	var queue *service.EstimationQueue
+
+	BeforeEach(func() {
+		queue = service.NewEstimationQueue(1, 1)
+	})
+
+	It("runs interactive estimations while the batch pool is busy", func() {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := queue.Do(context.Background(), service.EstimationPriorityBatch, func() error {
+				close(started)
+				<-release
+				return nil
+			})
+			Expect(err).To(BeNil())
+		}()
+		<-started
+		defer close(release)
+
+		ran := false
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		Expect(queue.Do(ctx, service.EstimationPriorityInteractive, func() error {
+			ran = true
+			return nil
+		})).To(Succeed())
+		Expect(ran).To(BeTrue())
+		Expect(queue.Busy(service.EstimationPriorityBatch)).To(Equal(1))
+		Expect(queue.Stats()).To(HaveKeyWithValue(service.EstimationPriorityBatch, service.EstimationPoolStats{Workers: 1, Busy: 1}))
+	})
+
+	It("queues estimations of the same priority until their context is done", func() {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(queue.Do(context.Background(), service.EstimationPriorityBatch, func() error {
+				close(started)
+				<-release
+				return nil
+			})).To(Succeed())
+		}()
+		<-started
+		defer close(release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := queue.Do(ctx, service.EstimationPriorityBatch, func() error {
+			Fail("expected the estimation to wait for a worker")
+			return nil
+		})
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	It("rejects an unknown priority", func() {
+		err := queue.Do(context.Background(), service.EstimationPriority("urgent"), func() error { return nil })
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+	})
+})
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/defaults"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/guardrails"
+)
+
+const (
+	// EstimationRecordingKind tells a recording apart from other files, e.g. traffic captures.
+	EstimationRecordingKind = "EstimationRecording"
+	// EstimationRecordingVersion is the version of the recording format.
+	EstimationRecordingVersion = "v1"
+)
+
+// EstimationProfile holds what an estimation depends on besides the inventory: the models and
+// policies of the organization. Each is nil when the organization has none.
+type EstimationProfile struct {
+	TroubleshootingModel *calculators.LinearTroubleshootingModel `json:"troubleshootingModel,omitempty"`
+	Contingency          *contingency.Policy                     `json:"contingency,omitempty"`
+	Guardrails           *guardrails.Policy                      `json:"guardrails,omitempty"`
+	Defaults             *defaults.Constants                     `json:"defaults,omitempty"`
+}
+
+// profile loads the profile of the organization. Policies are best effort: one failing to load is
+// left out and the estimation goes on without it.
+func (es *EstimationService) profile(ctx context.Context, orgID string) EstimationProfile {
+	var profile EstimationProfile
+	if es.models != nil {
+		m, err := es.models.Get(ctx, orgID)
+		if err == nil {
+			var doc calculators.LinearTroubleshootingModel
+			if doc, err = TroubleshootingModelDocument(*m); err == nil {
+				profile.TroubleshootingModel = &doc
+			}
+		}
+		if err != nil && !errors.Is(err, store.ErrRecordNotFound) {
+			es.logger.WithContext(ctx).Operation("troubleshooting_model").Build().Error(err).Log()
+		}
+	}
+	if es.contingency != nil {
+		policy, err := orgContingency(ctx, es.contingency, orgID)
+		if err != nil {
+			es.logger.WithContext(ctx).Operation("contingency_policy").Build().Error(err).Log()
+		} else {
+			profile.Contingency = &policy
+		}
+	}
+	if es.guardrails != nil {
+		p, err := es.guardrails.Get(ctx, orgID)
+		if err == nil {
+			var policy guardrails.Policy
+			if policy, err = GuardrailPolicyDocument(*p); err == nil {
+				profile.Guardrails = &policy
+			}
+		}
+		if err != nil && !errors.Is(err, store.ErrRecordNotFound) {
+			es.logger.WithContext(ctx).Operation("guardrail_policy").Build().Error(err).Log()
+		}
+	}
+	if es.defaults != nil {
+		constants, err := orgCalculatorDefaults(ctx, es.defaults, orgID)
+		if err != nil {
+			es.logger.WithContext(ctx).Operation("calculator_defaults").Build().Error(err).Log()
+		} else {
+			profile.Defaults = &constants
+		}
+	}
+	return profile
+}
+
+// EstimationRecording holds everything an estimation run depends on, the inventory of the cluster,
+// the overrides and the profile of the organization, along with the calculators registered, the
+// params they were given and the result, so the run can be replayed and compared.
+type EstimationRecording struct {
+	Kind       string    `json:"kind"`
+	Version    string    `json:"version"`
+	RecordedAt time.Time `json:"recordedAt"`
+	// PlannerVersion is the version of the build whose calculators ran.
+	PlannerVersion string            `json:"plannerVersion,omitempty"`
+	AssessmentID   uuid.UUID         `json:"assessmentId"`
+	ClusterID      string            `json:"clusterId"`
+	Calculators    []string          `json:"calculators"`
+	Alternatives   []string          `json:"alternatives,omitempty"`
+	Inventory      api.InventoryData `json:"inventory"`
+	// Measured are the params measured on the source of the assessment, e.g. the change rate of its VMs.
+	Measured  map[string]float64 `json:"measured,omitempty"`
+	Overrides map[string]float64 `json:"overrides,omitempty"`
+	Profile   EstimationProfile  `json:"profile"`
+	// Plan holds the params of the plan migrating the cluster, when the estimation was made for one.
+	Plan *EstimationPlanLayers `json:"plan,omitempty"`
+	// Params are the inputs of the calculators, keyed by param. They are derived again on replay.
+	Params        map[string]json.RawMessage       `json:"params"`
+	TotalDuration time.Duration                    `json:"totalDuration"`
+	Breakdown     map[string]estimation.Estimation `json:"breakdown"`
+}
+
+// ReadEstimationRecording decodes a recording, failing on other files or formats.
+func ReadEstimationRecording(data []byte) (EstimationRecording, error) {
+	var rec EstimationRecording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return EstimationRecording{}, fmt.Errorf("invalid estimation recording: %w", err)
+	}
+	if rec.Kind != EstimationRecordingKind {
+		return EstimationRecording{}, fmt.Errorf("not an estimation recording: kind %q", rec.Kind)
+	}
+	if rec.Version != EstimationRecordingVersion {
+		return EstimationRecording{}, fmt.Errorf("unsupported estimation recording version %q", rec.Version)
+	}
+	return rec, nil
+}
+
+// recordParams encodes the params. A param failing to encode is recorded as its error, it is only
+// read by humans.
+func recordParams(params []estimation.Param) map[string]json.RawMessage {
+	res := make(map[string]json.RawMessage, len(params))
+	for _, p := range params {
+		data, err := json.Marshal(p.Value)
+		if err != nil {
+			data, _ = json.Marshal(fmt.Sprintf("unrecorded: %v", err))
+		}
+		res[p.Key] = data
+	}
+	return res
+}
+
+// EstimationReplay is the outcome of the replay of a recording.
+type EstimationReplay struct {
+	Result *MigrationAssessmentResult
+	// Differences explain how the replay differs from the recording, none when it reproduced it.
+	Differences []string
+}
+
+// Replay runs a recorded estimation again with the calculators of this build, from the inventory,
+// overrides, profile and plan layers of the recording, and compares the outcome with the recorded one.
+func (es *EstimationService) Replay(ctx context.Context, rec EstimationRecording) (EstimationReplay, error) {
+	result, params, err := es.estimate(ctx, EstimationPriorityBatch, rec.Inventory, rec.Measured, rec.Overrides, rec.Profile, rec.Plan)
+	if err != nil {
+		return EstimationReplay{}, err
+	}
+
+	var diffs []string
+	calculators := es.engine.Calculators()
+	for _, name := range rec.Calculators {
+		if !slices.Contains(calculators, name) {
+			diffs = append(diffs, fmt.Sprintf("calculator %q is no longer registered", name))
+		}
+	}
+	for _, name := range calculators {
+		if !slices.Contains(rec.Calculators, name) {
+			diffs = append(diffs, fmt.Sprintf("calculator %q was not registered when recorded", name))
+		}
+	}
+
+	diffs = append(diffs, diffOutcomes(
+		estimationOutcome{Params: rec.Params, Breakdown: rec.Breakdown, TotalDuration: rec.TotalDuration},
+		estimationOutcome{Params: recordParams(params), Breakdown: result.Breakdown, TotalDuration: result.TotalDuration},
+		"recorded", "replayed",
+	)...)
+
+	return EstimationReplay{Result: result, Differences: diffs}, nil
+}
+
+// estimationOutcome is what two runs of an estimation are compared on.
+type estimationOutcome struct {
+	Params        map[string]json.RawMessage
+	Breakdown     map[string]estimation.Estimation
+	TotalDuration time.Duration
+}
+
+// diffOutcomes explains how the outcome b differs from the outcome a, calling them by their labels,
+// e.g. recorded and replayed.
+func diffOutcomes(a, b estimationOutcome, aLabel, bLabel string) []string {
+	var diffs []string
+	for _, key := range unionKeys(a.Params, b.Params) {
+		if before, after := a.Params[key], b.Params[key]; !bytes.Equal(before, after) {
+			diffs = append(diffs, fmt.Sprintf("param %s: %s %s, %s %s", key, aLabel, orNone(before), bLabel, orNone(after)))
+		}
+	}
+
+	for _, name := range unionKeys(a.Breakdown, b.Breakdown) {
+		before, inA := a.Breakdown[name]
+		after, inB := b.Breakdown[name]
+		switch {
+		case !inB:
+			diffs = append(diffs, fmt.Sprintf("%s: %s %v, %s none", name, aLabel, before.Duration, bLabel))
+		case !inA:
+			diffs = append(diffs, fmt.Sprintf("%s: %s none, %s %v", name, aLabel, bLabel, after.Duration))
+		case before.Duration != after.Duration || before.LeadTime != after.LeadTime:
+			diffs = append(diffs, fmt.Sprintf("%s: %s %v (lead time %v), %s %v (lead time %v)", name, aLabel, before.Duration, before.LeadTime, bLabel, after.Duration, after.LeadTime))
+		case before.Reason != after.Reason:
+			diffs = append(diffs, fmt.Sprintf("%s: %s reason %q, %s %q", name, aLabel, before.Reason, bLabel, after.Reason))
+		}
+	}
+	if a.TotalDuration != b.TotalDuration {
+		diffs = append(diffs, fmt.Sprintf("total: %s %v, %s %v", aLabel, a.TotalDuration, bLabel, b.TotalDuration))
+	}
+	return diffs
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func orNone(data json.RawMessage) string {
+	if data == nil {
+		return "none"
+	}
+	return string(data)
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// MaxEstimationRuns bounds the number of runs listed at once.
+const MaxEstimationRuns = 100
+
+// WithEstimationRuns keeps the estimations of the assessments so they can be listed and compared
+// over time. Without it the runs are not kept.
+func (es *EstimationService) WithEstimationRuns(r store.EstimationRun) *EstimationService {
+	es.runs = r
+	return es
+}
+
+// KeepsRuns tells whether the estimations of the assessments are kept, see SaveEstimationRun.
+func (es *EstimationService) KeepsRuns() bool {
+	return es.runs != nil
+}
+
+// SaveEstimationRun keeps the recording of an estimation of the user along with the version of the
+// calculators.
+func (es *EstimationService) SaveEstimationRun(ctx context.Context, username, orgID string, rec EstimationRecording) (*model.EstimationRun, error) {
+	if es.runs == nil {
+		return nil, errors.New("estimation runs are not kept")
+	}
+	document, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode estimation run: %w", err)
+	}
+	run, err := es.runs.Create(ctx, model.EstimationRun{
+		ID:             uuid.New(),
+		CreatedAt:      rec.RecordedAt,
+		OrgID:          orgID,
+		Username:       username,
+		AssessmentID:   rec.AssessmentID,
+		ClusterID:      rec.ClusterID,
+		PlannerVersion: rec.PlannerVersion,
+		TotalDuration:  rec.TotalDuration,
+		Document:       document,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save estimation run: %w", err)
+	}
+	return run, nil
+}
+
+// ListEstimationRuns returns the runs of a cluster of the assessment, the most recent first, all its
+// clusters when clusterID is empty. limit is bounded by MaxEstimationRuns.
+func (es *EstimationService) ListEstimationRuns(ctx context.Context, assessmentID uuid.UUID, clusterID string, limit int) (model.EstimationRunList, error) {
+	if es.runs == nil {
+		return model.EstimationRunList{}, nil
+	}
+	if limit <= 0 || limit > MaxEstimationRuns {
+		limit = MaxEstimationRuns
+	}
+	filter := store.NewEstimationRunQueryFilter().WithAssessmentID(assessmentID).WithLimit(limit)
+	if clusterID != "" {
+		filter = filter.WithClusterID(clusterID)
+	}
+	runs, err := es.runs.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list estimation runs: %w", err)
+	}
+	return runs, nil
+}
+
+// GetEstimationRun returns a run.
+func (es *EstimationService) GetEstimationRun(ctx context.Context, id uuid.UUID) (*model.EstimationRun, error) {
+	if es.runs == nil {
+		return nil, NewErrEstimationRunNotFound(id)
+	}
+	run, err := es.runs.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrEstimationRunNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get estimation run: %w", err)
+	}
+	return run, nil
+}
+
+// EstimationRunDocument decodes the recording of a run.
+func EstimationRunDocument(r model.EstimationRun) (EstimationRecording, error) {
+	var rec EstimationRecording
+	if err := json.Unmarshal(r.Document, &rec); err != nil {
+		return EstimationRecording{}, fmt.Errorf("failed to decode estimation run: %w", err)
+	}
+	return rec, nil
+}
+
+// EstimationRunComparison tells how a run differs from an earlier one.
+type EstimationRunComparison struct {
+	Before model.EstimationRun
+	After  model.EstimationRun
+	// TotalDurationChange is the total duration of After minus the one of Before.
+	TotalDurationChange time.Duration
+	// Differences explain the change, none when both runs estimated the same from the same inputs.
+	Differences []string
+}
+
+// CompareEstimationRuns compares two runs, the earlier one taken as the reference: the calculators
+// and their version, the overrides, the params derived from the inventory and the breakdown.
+func CompareEstimationRuns(a, b model.EstimationRun) (EstimationRunComparison, error) {
+	if b.CreatedAt.Before(a.CreatedAt) {
+		a, b = b, a
+	}
+	before, err := EstimationRunDocument(a)
+	if err != nil {
+		return EstimationRunComparison{}, err
+	}
+	after, err := EstimationRunDocument(b)
+	if err != nil {
+		return EstimationRunComparison{}, err
+	}
+
+	return EstimationRunComparison{
+		Before:              a,
+		After:               b,
+		TotalDurationChange: after.TotalDuration - before.TotalDuration,
+		Differences:         diffRecordings(before, after, "before", "after"),
+	}, nil
+}
+
+// diffRecordings explains how the recording b differs from the recording a, calling them by their
+// labels, e.g. before and after: the cluster, the calculators and their version, the overrides, the
+// params and the breakdown.
+func diffRecordings(a, b EstimationRecording, aLabel, bLabel string) []string {
+	var diffs []string
+	if a.AssessmentID != b.AssessmentID || a.ClusterID != b.ClusterID {
+		diffs = append(diffs, fmt.Sprintf("cluster: %s %s of assessment %s, %s %s of assessment %s",
+			aLabel, a.ClusterID, a.AssessmentID, bLabel, b.ClusterID, b.AssessmentID))
+	}
+	if a.PlannerVersion != b.PlannerVersion {
+		diffs = append(diffs, fmt.Sprintf("planner version: %s %s, %s %s", aLabel, a.PlannerVersion, bLabel, b.PlannerVersion))
+	}
+	for _, name := range a.Calculators {
+		if !slices.Contains(b.Calculators, name) {
+			diffs = append(diffs, fmt.Sprintf("calculator %q was removed", name))
+		}
+	}
+	for _, name := range b.Calculators {
+		if !slices.Contains(a.Calculators, name) {
+			diffs = append(diffs, fmt.Sprintf("calculator %q was added", name))
+		}
+	}
+	for _, key := range unionKeys(a.Overrides, b.Overrides) {
+		was, wasSet := a.Overrides[key]
+		now, isSet := b.Overrides[key]
+		switch {
+		case !isSet:
+			diffs = append(diffs, fmt.Sprintf("override %s: %s %v, %s none", key, aLabel, was, bLabel))
+		case !wasSet:
+			diffs = append(diffs, fmt.Sprintf("override %s: %s none, %s %v", key, aLabel, bLabel, now))
+		case was != now:
+			diffs = append(diffs, fmt.Sprintf("override %s: %s %v, %s %v", key, aLabel, was, bLabel, now))
+		}
+	}
+	return append(diffs, diffOutcomes(
+		estimationOutcome{Params: a.Params, Breakdown: a.Breakdown, TotalDuration: a.TotalDuration},
+		estimationOutcome{Params: b.Params, Breakdown: b.Breakdown, TotalDuration: b.TotalDuration},
+		aLabel, bLabel,
+	)...)
+}
@@ -3,17 +3,178 @@ package service_test
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/rvtools/jobs"
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/changerate"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/defaults"
+	"github.com/kubev2v/migration-planner/pkg/estimations/devices"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/factory"
+	"github.com/kubev2v/migration-planner/pkg/estimations/firmware"
+	"github.com/kubev2v/migration-planner/pkg/estimations/guardrails"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/prerequisites"
+	"github.com/kubev2v/migration-planner/pkg/estimations/solver"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
+// benchmarkSamples serves the samples of every cohort: the service filters them again.
+type benchmarkSamples struct {
+	list model.BenchmarkSampleList
+}
+
+func (b *benchmarkSamples) List(_ context.Context, _ *store.BenchmarkQueryFilter) (model.BenchmarkSampleList, error) {
+	return b.list, nil
+}
+
+func (b *benchmarkSamples) Save(_ context.Context, sample model.BenchmarkSample) (*model.BenchmarkSample, error) {
+	b.list = append(b.list, sample)
+	return &sample, nil
+}
+
+// troubleshootingModels serves the models of the organizations.
+type troubleshootingModels struct {
+	models map[string]model.TroubleshootingModel
+}
+
+func (t *troubleshootingModels) Get(_ context.Context, orgID string) (*model.TroubleshootingModel, error) {
+	m, ok := t.models[orgID]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return &m, nil
+}
+
+func (t *troubleshootingModels) Save(_ context.Context, m model.TroubleshootingModel) (*model.TroubleshootingModel, error) {
+	t.models[m.OrgID] = m
+	return &m, nil
+}
+
+func (t *troubleshootingModels) Delete(_ context.Context, orgID string) error {
+	delete(t.models, orgID)
+	return nil
+}
+
+// contingencyPolicies serves the contingency policies of the organizations.
+type contingencyPolicies struct {
+	policies map[string]model.ContingencyPolicy
+}
+
+func (c *contingencyPolicies) Get(_ context.Context, orgID string) (*model.ContingencyPolicy, error) {
+	p, ok := c.policies[orgID]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return &p, nil
+}
+
+func (c *contingencyPolicies) Save(_ context.Context, p model.ContingencyPolicy) (*model.ContingencyPolicy, error) {
+	c.policies[p.OrgID] = p
+	return &p, nil
+}
+
+// guardrailPolicies serves the guardrail policies of the organizations.
+type guardrailPolicies struct {
+	policies map[string]model.GuardrailPolicy
+}
+
+func (g *guardrailPolicies) Get(_ context.Context, orgID string) (*model.GuardrailPolicy, error) {
+	p, ok := g.policies[orgID]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return &p, nil
+}
+
+func (g *guardrailPolicies) Save(_ context.Context, p model.GuardrailPolicy) (*model.GuardrailPolicy, error) {
+	g.policies[p.OrgID] = p
+	return &p, nil
+}
+
+// calculatorDefaults serves the calculator defaults of the organizations.
+type calculatorDefaults struct {
+	defaults map[string]model.CalculatorDefaults
+}
+
+func (c *calculatorDefaults) Get(_ context.Context, orgID string) (*model.CalculatorDefaults, error) {
+	d, ok := c.defaults[orgID]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return &d, nil
+}
+
+func (c *calculatorDefaults) Save(_ context.Context, d model.CalculatorDefaults) (*model.CalculatorDefaults, error) {
+	c.defaults[d.OrgID] = d
+	return &d, nil
+}
+
+// estimationRuns keeps the runs in memory. It ignores the filters and lists every run, the most recent
+// first.
+type estimationRuns struct {
+	runs model.EstimationRunList
+}
+
+func (e *estimationRuns) List(context.Context, *store.EstimationRunQueryFilter) (model.EstimationRunList, error) {
+	list := slices.Clone(e.runs)
+	slices.Reverse(list)
+	return list, nil
+}
+
+func (e *estimationRuns) Get(_ context.Context, id uuid.UUID) (*model.EstimationRun, error) {
+	for _, r := range e.runs {
+		if r.ID == id {
+			return &r, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (e *estimationRuns) Create(_ context.Context, r model.EstimationRun) (*model.EstimationRun, error) {
+	e.runs = append(e.runs, r)
+	return &r, nil
+}
+
+// estimationFreezes keeps the freezes in memory, one per name and statement of work. It ignores the
+// filters and lists every freeze.
+type estimationFreezes struct {
+	freezes model.EstimationFreezeList
+}
+
+func (e *estimationFreezes) List(context.Context, *store.EstimationFreezeQueryFilter) (model.EstimationFreezeList, error) {
+	return slices.Clone(e.freezes), nil
+}
+
+func (e *estimationFreezes) Get(_ context.Context, id uuid.UUID) (*model.EstimationFreeze, error) {
+	for _, f := range e.freezes {
+		if f.ID == id {
+			return &f, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (e *estimationFreezes) Create(_ context.Context, f model.EstimationFreeze) (*model.EstimationFreeze, error) {
+	for _, existing := range e.freezes {
+		if existing.OrgID == f.OrgID && existing.SOWID == f.SOWID && existing.Name == f.Name {
+			return nil, store.ErrDuplicateKey
+		}
+	}
+	e.freezes = append(e.freezes, f)
+	return &f, nil
+}
+
 // helpers for complexity tests
 
 func buildOsInfo(entries map[string]int) *map[string]api.OsInfo {
@@ -108,6 +269,24 @@ func createTestAssessmentForEstimation(id uuid.UUID, username, orgID, clusterID
 	}
 }
 
+// changeRateJobs keeps the change rate jobs of the sources in memory.
+type changeRateJobs struct {
+	jobs map[uuid.UUID]model.ChangeRateJob
+}
+
+func (c *changeRateJobs) Get(_ context.Context, sourceID uuid.UUID) (*model.ChangeRateJob, error) {
+	j, ok := c.jobs[sourceID]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return &j, nil
+}
+
+func (c *changeRateJobs) Save(_ context.Context, j model.ChangeRateJob) (*model.ChangeRateJob, error) {
+	c.jobs[j.SourceID] = j
+	return &j, nil
+}
+
 var _ = Describe("EstimationService", func() {
 	var (
 		mockStore     *MockStore
@@ -310,6 +489,81 @@ var _ = Describe("EstimationService", func() {
 			})
 		})
 
+		Context("troubleshooting models", func() {
+			It("predicts the troubleshooting time with the model of the organization", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				document, err := json.Marshal(calculators.LinearTroubleshootingModel{ModelName: "fy26", Intercept: 30})
+				Expect(err).To(BeNil())
+				estimationSrv.WithTroubleshootingModels(&troubleshootingModels{models: map[string]model.TroubleshootingModel{
+					testOrgID: {OrgID: testOrgID, Document: document},
+				}})
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				// 10 VMs @ 30 mins / 10 engineers
+				Expect(result.Breakdown["Post-Migration Checks"].Duration).To(Equal(30 * time.Minute))
+				Expect(result.Breakdown["Post-Migration Checks"].Reason).To(ContainSubstring("predicted by model fy26"))
+			})
+
+			It("falls back to the formula for an organization without model", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				estimationSrv.WithTroubleshootingModels(&troubleshootingModels{models: map[string]model.TroubleshootingModel{}})
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				// 10 VMs @ 60 mins / 10 engineers
+				Expect(result.Breakdown["Post-Migration Checks"].Duration).To(Equal(time.Hour))
+				Expect(result.Breakdown["Post-Migration Checks"].Reason).NotTo(ContainSubstring("model"))
+			})
+		})
+
+		Context("contingency policies", func() {
+			It("pads the estimation with the buffers of the organization as line items", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				document, err := json.Marshal(contingency.Policy{Rules: []contingency.Rule{
+					{Name: "checks", Phase: "Post-Migration Checks", Percent: 50},
+				}})
+				Expect(err).To(BeNil())
+				estimationSrv.WithContingencyPolicies(&contingencyPolicies{policies: map[string]model.ContingencyPolicy{
+					testOrgID: {OrgID: testOrgID, Document: document},
+				}})
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				// 50% of 10 VMs @ 60 mins / 10 engineers
+				Expect(result.Breakdown["Contingency (checks)"].Duration).To(Equal(30 * time.Minute))
+				Expect(result.Breakdown["Contingency (checks)"].Reason).To(ContainSubstring("50% of Post-Migration Checks"))
+				var total time.Duration
+				for _, est := range result.Breakdown {
+					total += est.Duration
+				}
+				Expect(result.TotalDuration).To(Equal(total))
+			})
+
+			It("leaves the estimation unpadded for an organization without policy", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				estimationSrv.WithContingencyPolicies(&contingencyPolicies{policies: map[string]model.ContingencyPolicy{}})
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				for name := range result.Breakdown {
+					Expect(name).NotTo(HavePrefix("Contingency"))
+				}
+			})
+		})
+
 		Context("assessment not found", func() {
 			It("returns ErrResourceNotFound when assessment does not exist", func() {
 				result, err := estimationSrv.CalculateMigrationComplexity(ctx, uuid.New(), clusterID)
@@ -414,7 +668,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
 				)
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(err).To(BeNil())
 				Expect(result).NotTo(BeNil())
@@ -427,7 +681,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 20, 2000,
 				)
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(err).To(BeNil())
 				Expect(result.Breakdown).To(HaveKey("Storage Migration"))
@@ -439,7 +693,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
 				)
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(err).To(BeNil())
 
@@ -456,7 +710,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 15, 750,
 				)
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(err).To(BeNil())
 				for calcName, est := range result.Breakdown {
@@ -466,11 +720,483 @@ var _ = Describe("EstimationService", func() {
 			})
 		})
 
+		Context("driver changes", func() {
+			It("sizes the guest reconfiguration with the driver change warnings of the assessment", func() {
+				assessment := createTestAssessmentForEstimation(assessmentID, testUsername, testOrgID, clusterID, 10, 1000)
+				var inventory api.Inventory
+				Expect(json.Unmarshal(assessment.Snapshots[0].Inventory, &inventory)).To(Succeed())
+				storage, network, cbt := devices.ConcernStorageDriver, devices.ConcernNetworkDriver, "vmware.cbt.disabled"
+				cluster := inventory.Clusters[clusterID]
+				cluster.Vms.MigrationWarnings = []api.MigrationIssue{
+					{Id: &storage, Label: "Disk bus requires another guest driver", Count: 4},
+					{Id: &network, Label: "NIC model requires another guest driver", Count: 2},
+					{Id: &cbt, Label: "Changed Block Tracking (CBT) not enabled", Count: 7},
+				}
+				inventory.Clusters[clusterID] = cluster
+				data, err := json.Marshal(inventory)
+				Expect(err).To(BeNil())
+				assessment.Snapshots[0].Inventory = data
+				mockStore.assessments[assessmentID] = assessment
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.Breakdown).To(HaveKey("Guest OS Reconfiguration"))
+				reconfiguration := result.Breakdown["Guest OS Reconfiguration"]
+				Expect(reconfiguration.Reason).To(ContainSubstring("4 storage driver changes"))
+				Expect(reconfiguration.Reason).To(ContainSubstring("2 network driver changes"))
+				// (4 * 30 + 2 * 20) minutes shared by the 10 default engineers
+				Expect(reconfiguration.Duration).To(Equal(16 * time.Minute))
+			})
+		})
+
+		Context("firmware", func() {
+			It("sizes the firmware remediation with the firmware warnings of the assessment", func() {
+				assessment := createTestAssessmentForEstimation(assessmentID, testUsername, testOrgID, clusterID, 10, 1000)
+				var inventory api.Inventory
+				Expect(json.Unmarshal(assessment.Snapshots[0].Inventory, &inventory)).To(Succeed())
+				secureBoot, tpm := firmware.ConcernSecureBoot, firmware.ConcernTPM
+				cluster := inventory.Clusters[clusterID]
+				cluster.Vms.MigrationWarnings = []api.MigrationIssue{
+					{Id: &secureBoot, Label: "Secure boot enabled", Count: 5},
+					{Id: &tpm, Label: "Virtual TPM state not migrated", Count: 2},
+				}
+				inventory.Clusters[clusterID] = cluster
+				data, err := json.Marshal(inventory)
+				Expect(err).To(BeNil())
+				assessment.Snapshots[0].Inventory = data
+				mockStore.assessments[assessmentID] = assessment
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.Breakdown).To(HaveKey("Firmware Remediation"))
+				remediation := result.Breakdown["Firmware Remediation"]
+				Expect(remediation.Reason).To(ContainSubstring("5 secure boot VMs"))
+				Expect(remediation.Reason).To(ContainSubstring("2 TPM VMs"))
+				// (5 * 30 + 2 * 45) minutes shared by the 10 default engineers
+				Expect(remediation.Duration).To(Equal(24 * time.Minute))
+			})
+		})
+
+		Context("prerequisites", func() {
+			It("sizes the source remediation with the vCenter, the hosts and the VMware Tools warnings of the assessment", func() {
+				assessment := createTestAssessmentForEstimation(assessmentID, testUsername, testOrgID, clusterID, 10, 1000)
+				var inventory api.Inventory
+				Expect(json.Unmarshal(assessment.Snapshots[0].Inventory, &inventory)).To(Succeed())
+				vcenter, old, current, tools := "6.0.0", "VMware ESXi 6.0.0 build-3620759", "7.0.3", prerequisites.ConcernToolsOutdated
+				cluster := inventory.Clusters[clusterID]
+				cluster.Infra.VcenterVersion = &vcenter
+				cluster.Infra.Hosts = &[]api.Host{{Vendor: "Dell", Model: "R740", Version: &old}, {Vendor: "Dell", Model: "R740", Version: &current}}
+				cluster.Vms.MigrationWarnings = []api.MigrationIssue{
+					{Id: &tools, Label: "VMware Tools out of date", Count: 3},
+				}
+				inventory.Clusters[clusterID] = cluster
+				data, err := json.Marshal(inventory)
+				Expect(err).To(BeNil())
+				assessment.Snapshots[0].Inventory = data
+				mockStore.assessments[assessmentID] = assessment
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.Breakdown).To(HaveKey("Source Remediation"))
+				remediation := result.Breakdown["Source Remediation"]
+				Expect(remediation.Reason).To(ContainSubstring("1 vCenter upgrades"))
+				Expect(remediation.Reason).To(ContainSubstring("1 ESXi hosts"))
+				Expect(remediation.Reason).To(ContainSubstring("3 VMware Tools upgrades"))
+			})
+		})
+
+		Context("change rates", func() {
+			It("sizes the warm migration with the change rate measured on the source", func() {
+				sourceID := uuid.New()
+				assessment := createTestAssessmentForEstimation(assessmentID, testUsername, testOrgID, clusterID, 10, 1000)
+				assessment.SourceID = &sourceID
+				mockStore.assessments[assessmentID] = assessment
+
+				start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+				job := changerate.NewJob([]string{"vm-1"}, 0, 0, start)
+				Expect(job.Record([]changerate.Sample{
+					{VMID: "vm-1", At: start, CapacityBytes: 100 << 30},
+					{VMID: "vm-1", At: start.Add(24 * time.Hour), ChangedBytes: 12 << 30, CapacityBytes: 100 << 30},
+				})).To(Succeed())
+				document, err := json.Marshal(job)
+				Expect(err).To(BeNil())
+				mockStore.changeRateJobs = &changeRateJobs{jobs: map[uuid.UUID]model.ChangeRateJob{
+					sourceID: {SourceID: sourceID, Document: document},
+				}}
+
+				result, recording, err := estimationSrv.RecordMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(recording.Measured).To(HaveKeyWithValue(calculators.ParamChangeRatePercent, BeNumerically("~", 12, 1e-9)))
+				Expect(result.Alternatives).To(HaveKey("Warm Migration Delta Sync"))
+				Expect(result.Alternatives["Warm Migration Delta Sync"].Reason).To(ContainSubstring("12"))
+
+				replay, err := estimationSrv.Replay(ctx, *recording)
+				Expect(err).To(BeNil())
+				Expect(replay.Differences).To(BeEmpty())
+			})
+
+			It("falls back to the default change rate without sampling job", func() {
+				sourceID := uuid.New()
+				assessment := createTestAssessmentForEstimation(assessmentID, testUsername, testOrgID, clusterID, 10, 1000)
+				assessment.SourceID = &sourceID
+				mockStore.assessments[assessmentID] = assessment
+				mockStore.changeRateJobs = &changeRateJobs{jobs: map[uuid.UUID]model.ChangeRateJob{}}
+
+				result, recording, err := estimationSrv.RecordMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(recording.Measured).To(BeEmpty())
+				Expect(result.Alternatives).To(HaveKey("Warm Migration Delta Sync"))
+			})
+		})
+
+		Context("benchmarks", func() {
+			sample := func(band string, weeks float64) model.BenchmarkSample {
+				return model.BenchmarkSample{ID: uuid.NewString(), VMBand: band, Network: "1GbE", PlannedWeeks: 10, ActualWeeks: weeks}
+			}
+
+			It("compares the estimate with the programs of similar environments", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 640, 1000,
+				)
+				samples := &benchmarkSamples{}
+				for _, w := range []float64{12, 13, 14, 15, 16} {
+					samples.list = append(samples.list, sample("500–1000 VMs", w))
+				}
+				estimationSrv.WithBenchmarks(samples)
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.Benchmark).NotTo(BeNil())
+				Expect(result.Benchmark.Samples).To(Equal(5))
+				Expect(result.Benchmark.String()).To(Equal("environments like yours (500–1000 VMs, 1GbE) averaged 14 weeks"))
+			})
+
+			It("omits the benchmark of a cohort too small", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 640, 1000,
+				)
+				estimationSrv.WithBenchmarks(&benchmarkSamples{list: []model.BenchmarkSample{sample("500–1000 VMs", 12)}})
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.Benchmark).To(BeNil())
+			})
+		})
+
+		Context("guardrails", func() {
+			It("flags engineers above the team size of the organization and estimates regardless", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				document, err := json.Marshal(guardrails.Policy{TeamSize: 10})
+				Expect(err).To(BeNil())
+				estimationSrv.WithGuardrailPolicies(&guardrailPolicies{policies: map[string]model.GuardrailPolicy{
+					testOrgID: {OrgID: testOrgID, Document: document},
+				}})
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive,
+					map[string]float64{calculators.ParamPostMigrationEngineers: 40})
+
+				Expect(err).To(BeNil())
+				Expect(result.Warnings).To(HaveLen(1))
+				Expect(result.Warnings[0].Param).To(Equal(calculators.ParamPostMigrationEngineers))
+				Expect(result.Warnings[0].Message).To(ContainSubstring("team of 10"))
+				// 10 VMs @ 60 mins / 40 engineers
+				Expect(result.Breakdown["Post-Migration Checks"].Duration).To(Equal(15 * time.Minute))
+			})
+
+			It("flags a working day too long without a policy", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive,
+					map[string]float64{calculators.ParamWorkHoursPerDay: 20, calculators.ParamPostMigrationEngineers: 40})
+
+				Expect(err).To(BeNil())
+				Expect(result.Warnings).To(HaveLen(1))
+				Expect(result.Warnings[0].Param).To(Equal(calculators.ParamWorkHoursPerDay))
+			})
+
+			It("reports no warning for the params derived from the inventory", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.Warnings).To(BeEmpty())
+			})
+		})
+
+		Context("calculator defaults", func() {
+			BeforeEach(func() {
+				document, err := json.Marshal(defaults.Constants{Engineers: 5, TransferRateMbps: 1000})
+				Expect(err).To(BeNil())
+				estimationSrv.WithCalculatorDefaults(&calculatorDefaults{defaults: map[string]model.CalculatorDefaults{
+					testOrgID: {OrgID: testOrgID, Document: document},
+				}})
+			})
+
+			It("replaces the built-in constants with the defaults of the organization", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+
+				Expect(err).To(BeNil())
+				// 10 VMs @ 60 mins / 5 engineers
+				Expect(result.Breakdown["Post-Migration Checks"].Duration).To(Equal(2 * time.Hour))
+			})
+
+			It("keeps the overrides of the request over the defaults", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive,
+					map[string]float64{calculators.ParamPostMigrationEngineers: 20})
+
+				Expect(err).To(BeNil())
+				// 10 VMs @ 60 mins / 20 engineers
+				Expect(result.Breakdown["Post-Migration Checks"].Duration).To(Equal(30 * time.Minute))
+			})
+
+			It("fills the params not given to the selected calculators", func() {
+				result, err := estimationSrv.RunEstimation(ctx, testOrgID, service.EstimationPriorityInteractive,
+					[]calculators.Spec{{ID: "post_migration_troubleshooting"}},
+					[]estimation.Param{{Key: calculators.ParamVMCount, Value: 10.0}})
+				Expect(err).To(BeNil())
+				Expect(result.Breakdown["Post-Migration Checks"].Duration).To(Equal(2 * time.Hour))
+
+				result, err = estimationSrv.RunEstimation(ctx, testOrgID, service.EstimationPriorityInteractive,
+					[]calculators.Spec{{ID: "post_migration_troubleshooting"}},
+					[]estimation.Param{
+						{Key: calculators.ParamVMCount, Value: 10.0},
+						{Key: calculators.ParamPostMigrationEngineers, Value: 10.0},
+					})
+				Expect(err).To(BeNil())
+				Expect(result.Breakdown["Post-Migration Checks"].Duration).To(Equal(time.Hour))
+			})
+
+			It("traces each param to the layer it was resolved from", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				document, err := json.Marshal(plan.Plan{
+					Name:             "datacenter exit",
+					TransferRateMbps: 800,
+					Sources:          []plan.Source{{Name: "dc1", TransferRateMbps: 400}},
+					Waves: []plan.Wave{{
+						Name:     "pilot",
+						Source:   "dc1",
+						Clusters: []string{clusterID},
+						Steps:    []plan.Step{{Phase: "cutover", Effort: time.Hour, WorkHoursPerDay: 10}},
+					}},
+				})
+				Expect(err).To(BeNil())
+				p := model.Plan{ID: uuid.New(), Document: document}
+
+				result, recording, err := estimationSrv.CalculatePlannedMigrationEstimation(ctx, p, assessmentID, clusterID, service.EstimationPriorityInteractive,
+					map[string]float64{calculators.ParamTransferRateMbps: 250}, true)
+				Expect(err).To(BeNil())
+				Expect(recording.Plan.Wave).To(Equal("pilot"))
+
+				lineage := map[string]estimation.Lineage{}
+				for _, l := range result.Lineage {
+					lineage[l.Key] = l
+				}
+				Expect(lineage[calculators.ParamTransferRateMbps].Layer).To(Equal(estimation.LayerRequest))
+				Expect(lineage[calculators.ParamTransferRateMbps].Chain).To(Equal([]estimation.LayerValue{
+					{Layer: estimation.LayerRequest, Value: 250.0},
+					{Layer: estimation.LayerWave, Value: 400.0},
+					{Layer: estimation.LayerPlan, Value: 800.0},
+					{Layer: estimation.LayerOrganization, Value: 1000.0},
+					{Layer: estimation.LayerBuiltIn, Value: calculators.DefaultTransferRateMbps},
+				}))
+				Expect(lineage[calculators.ParamWorkHoursPerDay].Layer).To(Equal(estimation.LayerWave))
+				Expect(lineage[calculators.ParamWorkHoursPerDay].Value).To(Equal(10.0))
+				Expect(lineage[calculators.ParamPostMigrationEngineers].Layer).To(Equal(estimation.LayerOrganization))
+				Expect(lineage[calculators.ParamVMCount].Layer).To(Equal(estimation.LayerInventory))
+			})
+		})
+
+		Context("recording", func() {
+			It("replays a recording bit for bit", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				document, err := json.Marshal(calculators.LinearTroubleshootingModel{ModelName: "fy26", Intercept: 30})
+				Expect(err).To(BeNil())
+				estimationSrv.WithTroubleshootingModels(&troubleshootingModels{models: map[string]model.TroubleshootingModel{
+					testOrgID: {OrgID: testOrgID, Document: document},
+				}})
+
+				result, recording, err := estimationSrv.RecordMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive,
+					map[string]float64{calculators.ParamTransferRateMbps: 400})
+				Expect(err).To(BeNil())
+				Expect(recording.Profile.TroubleshootingModel.ModelName).To(Equal("fy26"))
+
+				// through a file, with neither the store nor the model of the organization
+				data, err := json.Marshal(recording)
+				Expect(err).To(BeNil())
+				read, err := service.ReadEstimationRecording(data)
+				Expect(err).To(BeNil())
+				replay, err := service.NewEstimationService(nil).Replay(ctx, read)
+
+				Expect(err).To(BeNil())
+				Expect(replay.Differences).To(BeEmpty())
+				Expect(replay.Result.TotalDuration).To(Equal(result.TotalDuration))
+				Expect(replay.Result.Breakdown).To(Equal(result.Breakdown))
+			})
+
+			It("explains how the replay differs from the recording", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				_, recording, err := estimationSrv.RecordMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+				Expect(err).To(BeNil())
+
+				// as if recorded by a build with another calculator and another default rate
+				recording.Calculators = append(recording.Calculators, "Cutover Downtime")
+				recording.Params[calculators.ParamTransferRateMbps] = []byte("800")
+				storage := recording.Breakdown["Storage Migration"]
+				storage.Duration /= 2
+				recording.Breakdown["Storage Migration"] = storage
+				recording.TotalDuration -= storage.Duration
+
+				replay, err := estimationSrv.Replay(ctx, *recording)
+
+				Expect(err).To(BeNil())
+				Expect(replay.Differences).To(ContainElements(
+					`calculator "Cutover Downtime" is no longer registered`,
+					"param transfer_rate_mbps: recorded 800, replayed 620",
+					ContainSubstring("Storage Migration: recorded"),
+					ContainSubstring("total: recorded"),
+				))
+			})
+
+			It("keeps the runs and explains how a later one differs", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				runs := &estimationRuns{}
+				estimationSrv.WithEstimationRuns(runs)
+				Expect(estimationSrv.KeepsRuns()).To(BeTrue())
+
+				_, first, err := estimationSrv.RecordMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
+				Expect(err).To(BeNil())
+				before, err := estimationSrv.SaveEstimationRun(ctx, testUsername, testOrgID, *first)
+				Expect(err).To(BeNil())
+				_, second, err := estimationSrv.RecordMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive,
+					map[string]float64{calculators.ParamTransferRateMbps: 1240})
+				Expect(err).To(BeNil())
+				second.RecordedAt = first.RecordedAt.Add(time.Hour)
+				after, err := estimationSrv.SaveEstimationRun(ctx, testUsername, testOrgID, *second)
+				Expect(err).To(BeNil())
+
+				listed, err := estimationSrv.ListEstimationRuns(ctx, assessmentID, clusterID, 0)
+				Expect(err).To(BeNil())
+				Expect(listed).To(HaveLen(2))
+				Expect(listed[0].ID).To(Equal(after.ID))
+				Expect(listed[0].AssessmentID).To(Equal(assessmentID))
+				Expect(listed[0].TotalDuration).To(Equal(second.TotalDuration))
+
+				// the order the runs are given in does not matter
+				comparison, err := service.CompareEstimationRuns(*after, *before)
+				Expect(err).To(BeNil())
+				Expect(comparison.Before.ID).To(Equal(before.ID))
+				Expect(comparison.TotalDurationChange).To(BeNumerically("<", 0))
+				Expect(comparison.Differences).To(ContainElements(
+					"override transfer_rate_mbps: before none, after 1240",
+					"param transfer_rate_mbps: before 620, after 1240",
+					ContainSubstring("Storage Migration: before"),
+					ContainSubstring("total: before"),
+				))
+
+				comparison, err = service.CompareEstimationRuns(*before, *before)
+				Expect(err).To(BeNil())
+				Expect(comparison.Differences).To(BeEmpty())
+			})
+
+			It("freezes an estimation for a statement of work and explains how the current one differs", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+				)
+				freezes := &estimationFreezes{}
+				estimationSrv.WithEstimationFreezes(freezes)
+
+				freeze, err := estimationSrv.FreezeEstimation(ctx, testUsername, testOrgID, service.EstimationFreezeRequest{
+					Name:         "baseline",
+					SOWID:        "SOW-1234",
+					AssessmentID: assessmentID,
+					ClusterID:    clusterID,
+					Overrides:    map[string]float64{calculators.ParamTransferRateMbps: 400},
+				})
+				Expect(err).To(BeNil())
+				Expect(freeze.Digest).NotTo(BeEmpty())
+				Expect(freeze.TotalDuration).To(BeNumerically(">", 0))
+				Expect(string(freeze.Report)).To(HavePrefix("%PDF-"))
+				Expect(string(freeze.Report)).To(ContainSubstring("(Statement of work: SOW-1234) Tj"))
+
+				_, err = estimationSrv.FreezeEstimation(ctx, testUsername, testOrgID, service.EstimationFreezeRequest{
+					Name: "baseline", SOWID: "SOW-1234", AssessmentID: assessmentID, ClusterID: clusterID,
+				})
+				Expect(err).To(BeAssignableToTypeOf(&service.ErrDuplicateKey{}))
+				_, err = estimationSrv.FreezeEstimation(ctx, testUsername, testOrgID, service.EstimationFreezeRequest{
+					Name: "baseline", AssessmentID: assessmentID, ClusterID: clusterID,
+				})
+				Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+
+				diff, err := estimationSrv.DiffEstimationFreeze(ctx, *freeze, nil)
+				Expect(err).To(BeNil())
+				Expect(diff.Differences).To(BeEmpty())
+				Expect(diff.TotalDurationChange).To(BeZero())
+
+				// the inventory grows after the freeze
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 20, 2000,
+				)
+				got, err := estimationSrv.GetEstimationFreeze(ctx, freeze.ID)
+				Expect(err).To(BeNil())
+				Expect(got.TotalDuration).To(Equal(freeze.TotalDuration))
+
+				diff, err = estimationSrv.DiffEstimationFreeze(ctx, *got, nil)
+				Expect(err).To(BeNil())
+				Expect(diff.TotalDurationChange).To(BeNumerically(">", 0))
+				Expect(diff.Current.TotalDuration).To(Equal(freeze.TotalDuration + diff.TotalDurationChange))
+				Expect(diff.Differences).To(ContainElements(
+					ContainSubstring("Storage Migration: frozen"),
+					ContainSubstring("total: frozen"),
+				))
+				Expect(diff.Differences).NotTo(ContainElement(ContainSubstring("override")))
+			})
+
+			It("does not find a freeze when none are kept", func() {
+				_, err := estimationSrv.GetEstimationFreeze(ctx, uuid.New())
+				Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+			})
+
+			It("rejects a file that is not a recording", func() {
+				_, err := service.ReadEstimationRecording([]byte(`{"method":"GET","path":"/api/v1/sources"}`))
+				Expect(err).To(MatchError(ContainSubstring("not an estimation recording")))
+			})
+		})
+
 		Context("assessment not found", func() {
 			It("returns ErrResourceNotFound when assessment does not exist", func() {
 				nonExistentID := uuid.New()
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, nonExistentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, nonExistentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -481,7 +1207,7 @@ var _ = Describe("EstimationService", func() {
 			It("returns error when store returns error", func() {
 				mockStore.getError = store.ErrRecordNotFound
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -499,7 +1225,7 @@ var _ = Describe("EstimationService", func() {
 					Snapshots: []model.Snapshot{}, // Empty snapshots
 				}
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -524,7 +1250,7 @@ var _ = Describe("EstimationService", func() {
 					},
 				}
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -550,7 +1276,7 @@ var _ = Describe("EstimationService", func() {
 					},
 				}
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -579,7 +1305,7 @@ var _ = Describe("EstimationService", func() {
 					},
 				}
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -593,7 +1319,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, "different-cluster", 10, 1000,
 				)
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, "non-existent-cluster")
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, "non-existent-cluster", service.EstimationPriorityInteractive, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -608,7 +1334,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 0, 0,
 				)
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(err).To(BeNil())
 				Expect(result).NotTo(BeNil())
@@ -621,7 +1347,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 10000, 500000,
 				)
 
-				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, service.EstimationPriorityInteractive, nil)
 
 				Expect(err).To(BeNil())
 				Expect(result).NotTo(BeNil())
@@ -629,4 +1355,216 @@ var _ = Describe("EstimationService", func() {
 			})
 		})
 	})
+
+	Describe("RunEstimation", func() {
+		It("runs the selected calculators over the params", func() {
+			result, err := estimationSrv.RunEstimation(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{
+					{ID: "storage_migration"},
+					{ID: "post_migration_troubleshooting", Config: calculators.Config{"engineer_count": 4.0}},
+				},
+				[]estimation.Param{
+					{Key: calculators.ParamVMCount, Value: 100.0},
+					{Key: calculators.ParamTotalDiskGB, Value: 1000.0},
+					{Key: calculators.ParamTransferRateMbps, Value: 1000.0},
+				})
+
+			Expect(err).To(BeNil())
+			Expect(result.Breakdown).To(HaveLen(2))
+			var total time.Duration
+			for _, est := range result.Breakdown {
+				Expect(est.Duration).To(BeNumerically(">", 0))
+				total += est.Duration
+			}
+			Expect(result.TotalDuration).To(Equal(total))
+		})
+
+		It("prices the breakdown with the rates given", func() {
+			result, err := estimationSrv.RunEstimation(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "storage_migration"}, {ID: "post_migration_troubleshooting"}},
+				[]estimation.Param{
+					{Key: calculators.ParamVMCount, Value: 10.0},
+					{Key: calculators.ParamTotalDiskGB, Value: 1000.0},
+					{Key: calculators.ParamEngineerHourlyRate, Value: 100.0},
+					{Key: calculators.ParamEgressCostPerGB, Value: 0.1},
+				})
+
+			Expect(err).To(BeNil())
+			// 10 VMs @ 60 mins priced at 100 an hour, 1000 GB sent at 0.1 a GB
+			Expect(result.Breakdown["Post-Migration Checks"].Cost.Labor).To(Equal(1000.0))
+			Expect(result.Breakdown["Storage Migration"].Cost.Infrastructure).To(BeNumerically("~", 100.0, 1e-9))
+			Expect(result.Cost.Total()).To(BeNumerically("~", 1100.0, 1e-9))
+		})
+
+		It("skips the disabled calculators", func() {
+			result, err := estimationSrv.RunEstimation(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "storage_migration", Disabled: true}, {ID: "post_migration_troubleshooting"}},
+				[]estimation.Param{{Key: calculators.ParamVMCount, Value: 10.0}})
+
+			Expect(err).To(BeNil())
+			Expect(result.Breakdown).To(HaveLen(1))
+		})
+
+		It("annotates the params ignored or not read", func() {
+			result, err := estimationSrv.RunEstimation(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "storage_migration"}, {ID: "post_migration_troubleshooting"}},
+				[]estimation.Param{
+					{Key: calculators.ParamVMCount, Value: 10.0},
+					{Key: calculators.ParamTotalDiskGB, Value: 1000.0},
+					{Key: calculators.ParamTransferRateMbps, Value: -50.0},
+					{Key: "transfer_rate_mpbs", Value: 1000.0},
+				})
+
+			Expect(err).To(BeNil())
+			Expect(result.Annotations).To(HaveLen(2))
+			Expect(result.Annotations[0].Kind).To(Equal(estimation.AnnotationIgnored))
+			Expect(result.Annotations[0].Calculator).To(Equal("Storage Migration"))
+			Expect(*result.Annotations[0].Applied).To(Equal(calculators.DefaultTransferRateMbps))
+			Expect(result.Annotations[1].Kind).To(Equal(estimation.AnnotationUnused))
+			Expect(result.Annotations[1].Param).To(Equal("transfer_rate_mpbs"))
+		})
+
+		It("rejects an unknown calculator", func() {
+			_, err := estimationSrv.RunEstimation(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "teleportation"}}, nil)
+
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		})
+	})
+
+	Describe("CompareScenarios", func() {
+		It("diffs the durations of the calculators between the scenarios", func() {
+			scenario := func(name string, engineers float64) service.EstimationScenario {
+				return service.EstimationScenario{Name: name, Params: []estimation.Param{
+					{Key: calculators.ParamVMCount, Value: 10.0},
+					{Key: calculators.ParamPostMigrationEngineers, Value: engineers},
+				}}
+			}
+
+			comparison, err := estimationSrv.CompareScenarios(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "post_migration_troubleshooting"}},
+				scenario("5 engineers", 5), scenario("10 engineers", 10))
+
+			Expect(err).To(BeNil())
+			Expect(comparison.A.Name).To(Equal("5 engineers"))
+			Expect(comparison.TotalA).To(Equal(2 * time.Hour))
+			Expect(comparison.TotalB).To(Equal(time.Hour))
+			Expect(comparison.PercentChange()).To(Equal(-50.0))
+			Expect(comparison.Deltas).To(HaveLen(1))
+			Expect(comparison.Deltas[0].Calculator).To(Equal("Post-Migration Checks"))
+			Expect(comparison.Deltas[0].Change).To(Equal(-time.Hour))
+		})
+
+		It("rejects an unknown calculator", func() {
+			_, err := estimationSrv.CompareScenarios(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "teleportation"}}, service.EstimationScenario{}, service.EstimationScenario{})
+
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		})
+	})
+
+	Describe("SolveDeadline", func() {
+		schedule := service.EstimationSchedule{Start: time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC), Workday: calendar.DefaultWorkday}
+		params := []estimation.Param{
+			{Key: calculators.ParamVMCount, Value: 10.0},
+			{Key: calculators.ParamPostMigrationEngineers, Value: 2.0},
+		}
+		bounds := []solver.Bound{{Key: calculators.ParamPostMigrationEngineers, Min: 2, Max: 10, Integer: true}}
+
+		It("finds the engineers meeting the deadline", func() {
+			result, err := estimationSrv.SolveDeadline(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "post_migration_troubleshooting"}}, params, schedule,
+				schedule.Start.Add(2*time.Hour), bounds)
+
+			Expect(err).To(BeNil())
+			Expect(result.Feasible).To(BeTrue())
+			Expect(result.Changes).To(Equal([]solver.Change{{Key: calculators.ParamPostMigrationEngineers, From: 2, To: 5}}))
+			Expect(result.End).To(Equal(schedule.Start.Add(2 * time.Hour)))
+		})
+
+		It("proves a deadline infeasible within the bounds", func() {
+			result, err := estimationSrv.SolveDeadline(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "post_migration_troubleshooting"}}, params, schedule,
+				schedule.Start.Add(30*time.Minute), bounds)
+
+			Expect(err).To(BeNil())
+			Expect(result.Feasible).To(BeFalse())
+			Expect(result.Changes).To(Equal([]solver.Change{{Key: calculators.ParamPostMigrationEngineers, From: 2, To: 10}}))
+			Expect(result.End).To(Equal(schedule.Start.Add(time.Hour)))
+			Expect(result.Message).To(HavePrefix("infeasible within the bounds"))
+		})
+
+		It("rejects a param to adjust without value", func() {
+			_, err := estimationSrv.SolveDeadline(ctx, testOrgID, service.EstimationPriorityInteractive,
+				[]calculators.Spec{{ID: "post_migration_troubleshooting"}}, params, schedule,
+				schedule.Start.Add(2*time.Hour), []solver.Bound{{Key: calculators.ParamTroubleshootMinsPerVM, Min: 10, Max: 60}})
+
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		})
+	})
+
+	Describe("RunEstimationJob", func() {
+		It("reports the progress and returns the encoded result", func() {
+			var progress [][2]int
+			data, err := estimationSrv.RunEstimationJob(ctx, jobs.EstimationJobArgs{
+				Calculators: []calculators.Spec{{ID: "storage_migration"}, {ID: "post_migration_troubleshooting"}},
+				Params: map[string]any{
+					calculators.ParamVMCount:          100.0,
+					calculators.ParamTotalDiskGB:      1000.0,
+					calculators.ParamTransferRateMbps: 1000.0,
+				},
+				Priority: string(service.EstimationPriorityBatch),
+				OrgID:    testOrgID,
+			}, func(completed, total int) {
+				progress = append(progress, [2]int{completed, total})
+			})
+
+			Expect(err).To(BeNil())
+			Expect(progress).To(Equal([][2]int{{1, 2}, {2, 2}}))
+			var result service.MigrationAssessmentResult
+			Expect(json.Unmarshal(data, &result)).To(Succeed())
+			Expect(result.Breakdown).To(HaveLen(2))
+			Expect(result.TotalDuration).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("FactoryThroughput", func() {
+		BeforeEach(func() {
+			document, err := json.Marshal(defaults.Constants{Engineers: 5, TransferRateMbps: 1000})
+			Expect(err).To(BeNil())
+			estimationSrv.WithCalculatorDefaults(&calculatorDefaults{defaults: map[string]model.CalculatorDefaults{
+				testOrgID: {OrgID: testOrgID, Document: document},
+			}})
+			mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+				assessmentID, testUsername, testOrgID, clusterID, 1000, 100000,
+			)
+		})
+
+		It("finds the engineers of the organization short of the target", func() {
+			m, err := estimationSrv.FactoryThroughput(ctx, assessmentID, clusterID, 300, map[string]float64{factory.ParamWavesPerWeek: 3})
+
+			Expect(err).To(BeNil())
+			Expect(m.Feasible()).To(BeFalse())
+			// 5 engineers working 40h a week, an hour per VM
+			Expect(m.Bottleneck).To(Equal(factory.ResourceEngineers))
+			Expect(m.AchievableVMsPerWeek).To(Equal(200.0))
+			Expect(m.Assumptions.TransferRateMbps).To(Equal(1000.0))
+			Expect(m.WaveVMs).To(Equal(100))
+			Expect(m.Weeks).To(Equal(4))
+		})
+
+		It("meets the target with the engineers given", func() {
+			m, err := estimationSrv.FactoryThroughput(ctx, assessmentID, clusterID, 300,
+				map[string]float64{calculators.ParamPostMigrationEngineers: 8})
+
+			Expect(err).To(BeNil())
+			Expect(m.Feasible()).To(BeTrue())
+		})
+
+		It("rejects a target of no VMs", func() {
+			_, err := estimationSrv.FactoryThroughput(ctx, assessmentID, clusterID, 0, nil)
+
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		})
+	})
 })
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/pkg/estimations/factory"
+)
+
+// FactoryThroughput back-computes the resources migrating the cluster at vmsPerWeek VMs per week.
+// The current assumptions are the params an estimation of the cluster resolves: the inventory, the
+// calculator defaults of the organization and the overrides, which also set the params of the
+// factory, e.g. factory.ParamWavesPerWeek.
+func (es *EstimationService) FactoryThroughput(ctx context.Context, assessmentID uuid.UUID, clusterID string, vmsPerWeek float64, overrides map[string]float64) (factory.Model, error) {
+	tracer := es.logger.WithContext(ctx).Operation("factory_throughput").
+		WithUUID("assessment_id", assessmentID).
+		WithString("cluster_id", clusterID).
+		Build()
+
+	assessment, clusterInventory, err := es.clusterInventory(ctx, assessmentID, clusterID)
+	if err != nil {
+		tracer.Error(err).Log()
+		return factory.Model{}, err
+	}
+
+	measured, err := es.measuredParams(ctx, assessment)
+	if err != nil {
+		tracer.Error(err).Log()
+		return factory.Model{}, err
+	}
+
+	profile := es.profile(ctx, assessment.OrgID)
+	assumptions, err := factory.NewAssumptions(es.paramResolver(clusterInventory, measured, overrides, profile, nil).Params())
+	if err != nil {
+		return factory.Model{}, NewErrInvalidRequest(err.Error())
+	}
+	m, err := factory.Solve(vmsPerWeek, assumptions)
+	if err != nil {
+		return factory.Model{}, NewErrInvalidRequest(err.Error())
+	}
+
+	tracer.Success().WithString("bottleneck", string(m.Bottleneck)).Log()
+	return m, nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/distribution"
+	"github.com/kubev2v/migration-planner/pkg/estimations/forecast"
+)
+
+// Forecast forecasts the volume a stored plan migrates quarter by quarter as of now, configured by
+// the options, e.g. to bucket the volume by fiscal quarter. The effort of the phases with enough
+// actuals recorded in the organization is drawn from the distributions fitted to them. The trials are seeded with the plan ID, so the forecast only changes when the
+// plan, its progress or the actuals do.
+func (ps *PlanService) Forecast(ctx context.Context, p model.Plan, now time.Time, opts ...forecast.Option) (forecast.Forecast, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return forecast.Forecast{}, err
+	}
+	phases, err := ps.DurationDistributions(ctx, p.OrgID)
+	if err != nil {
+		return forecast.Forecast{}, err
+	}
+	// the phases with too few actuals keep the spread of the forecast
+	fits := make(map[string]distribution.Distribution, len(phases))
+	for _, pd := range phases {
+		if pd.Distribution != nil {
+			fits[pd.Phase] = pd.Distribution
+		}
+	}
+	opts = append(opts, forecast.WithSeed(binary.BigEndian.Uint64(p.ID[:8])), forecast.WithDistributions(fits))
+	return forecast.New(doc, now, opts...)
+}
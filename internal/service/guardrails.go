@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/guardrails"
+)
+
+// WithGuardrailPolicies sets the guardrail policies of the organizations the params of the estimations
+// are checked against. Without them only the checks needing no declaration run.
+func (es *EstimationService) WithGuardrailPolicies(p store.GuardrailPolicy) *EstimationService {
+	es.guardrails = p
+	return es
+}
+
+// GetGuardrailPolicy returns the guardrail policy of the organization, an empty one declaring nothing
+// when the organization did not set any.
+func (es *EstimationService) GetGuardrailPolicy(ctx context.Context, orgID string) (*model.GuardrailPolicy, error) {
+	policy, err := es.store.GuardrailPolicy().Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return &model.GuardrailPolicy{OrgID: orgID, Document: []byte("{}")}, nil
+		}
+		return nil, fmt.Errorf("failed to get guardrail policy: %w", err)
+	}
+	return policy, nil
+}
+
+// SetGuardrailPolicy replaces the guardrail policy of the organization. The estimations are checked
+// against it from now on.
+func (es *EstimationService) SetGuardrailPolicy(ctx context.Context, orgID, username string, doc guardrails.Policy) (*model.GuardrailPolicy, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode guardrail policy: %w", err)
+	}
+	saved, err := es.store.GuardrailPolicy().Save(ctx, model.GuardrailPolicy{
+		OrgID:     orgID,
+		Document:  document,
+		UpdatedAt: time.Now(),
+		UpdatedBy: username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save guardrail policy: %w", err)
+	}
+	return saved, nil
+}
+
+// GuardrailPolicyDocument decodes the policy stored for an organization.
+func GuardrailPolicyDocument(p model.GuardrailPolicy) (guardrails.Policy, error) {
+	var doc guardrails.Policy
+	if err := json.Unmarshal(p.Document, &doc); err != nil {
+		return guardrails.Policy{}, fmt.Errorf("failed to decode guardrail policy: %w", err)
+	}
+	return doc, nil
+}
+
+// guardrailWarnings flags the implausible params of an estimation of the cluster. Without a policy in
+// the profile only the checks needing no declaration run.
+func guardrailWarnings(profile EstimationProfile, params []estimation.Param, cluster api.InventoryData) []guardrails.Warning {
+	var policy guardrails.Policy
+	if profile.Guardrails != nil {
+		policy = *profile.Guardrails
+	}
+	return guardrails.Check(params, policy, nicSpeeds(cluster.Infra))
+}
+
+// nicSpeeds returns the link speeds of the NICs of the hosts of the inventory, none when the agent
+// did not collect them.
+func nicSpeeds(infra api.Infra) []int {
+	if infra.Hosts == nil {
+		return nil
+	}
+	var speeds []int
+	for _, h := range *infra.Hosts {
+		if h.NicSpeedsMbps != nil {
+			speeds = append(speeds, *h.NicSpeedsMbps...)
+		}
+	}
+	return speeds
+}
+
+// withOverrides returns the params with the values of the overrides, replacing the params of the same
+// key and adding the others in key order.
+func withOverrides(params []estimation.Param, overrides map[string]float64) []estimation.Param {
+	if len(overrides) == 0 {
+		return params
+	}
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := make([]estimation.Param, 0, len(params)+len(keys))
+	for _, p := range params {
+		if _, ok := overrides[p.Key]; !ok {
+			res = append(res, p)
+		}
+	}
+	for _, key := range keys {
+		res = append(res, estimation.Param{Key: key, Value: overrides[key]})
+	}
+	return res
+}
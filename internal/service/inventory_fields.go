@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// GetInventoryFieldSchema returns the extension fields registered by the organization, an empty schema
+// when the organization did not register any.
+func (as *AssessmentService) GetInventoryFieldSchema(ctx context.Context, orgID string) (*model.InventoryFieldSchema, error) {
+	schema, err := as.store.InventoryFieldSchema().Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return &model.InventoryFieldSchema{OrgID: orgID, Document: []byte(`{"fields":[]}`)}, nil
+		}
+		return nil, fmt.Errorf("failed to get inventory field schema: %w", err)
+	}
+	return schema, nil
+}
+
+// SetInventoryFieldSchema replaces the extension fields of the organization. The extensions populated
+// by importers and agent plugins are checked against it from now on.
+func (as *AssessmentService) SetInventoryFieldSchema(ctx context.Context, orgID, username string, doc inventory.Schema) (*model.InventoryFieldSchema, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode inventory field schema: %w", err)
+	}
+	saved, err := as.store.InventoryFieldSchema().Save(ctx, model.InventoryFieldSchema{
+		OrgID:     orgID,
+		Document:  document,
+		UpdatedAt: time.Now(),
+		UpdatedBy: username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save inventory field schema: %w", err)
+	}
+	return saved, nil
+}
+
+// InventoryFieldSchemaDocument decodes the schema stored for an organization.
+func InventoryFieldSchemaDocument(s model.InventoryFieldSchema) (inventory.Schema, error) {
+	var doc inventory.Schema
+	if err := json.Unmarshal(s.Document, &doc); err != nil {
+		return inventory.Schema{}, fmt.Errorf("failed to decode inventory field schema: %w", err)
+	}
+	return doc, nil
+}
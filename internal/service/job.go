@@ -14,7 +14,11 @@ import (
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/portfolio"
 	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/requestid"
+	"github.com/kubev2v/migration-planner/pkg/tracing"
 )
 
 // JobService handles job-related operations.
@@ -59,33 +63,140 @@ func (s *JobService) CreateRVToolsJob(ctx context.Context, args jobs.RVToolsJobA
 	return jobForm.ToAPIJob(), nil
 }
 
-// GetJob retrieves a job by ID.
-func (s *JobService) GetJob(ctx context.Context, jobID int64, orgID, username string) (*v1alpha1.Job, error) {
+// CreatePortfolioReportJob creates a new portfolio report rendering job.
+func (s *JobService) CreatePortfolioReportJob(ctx context.Context, args jobs.PortfolioReportJobArgs) (*v1alpha1.Job, error) {
 	logger := s.logger.WithContext(ctx)
-	tracer := logger.Operation("get_job").
-		WithParam("job_id", jobID).
+	tracer := logger.Operation("create_portfolio_report_job").
+		WithInt("plans", len(args.PlanIDs)).
+		WithString("org_id", args.OrgID).
+		WithString("username", args.Username).
 		Build()
 
-	// Query job from store
-	jobRow, err := s.jobStore.Get(ctx, jobID)
+	insertedJob, err := s.riverClient.Insert(ctx, args, nil)
 	if err != nil {
-		if errors.Is(err, store.ErrRecordNotFound) {
-			return nil, NewErrJobNotFound(jobID)
-		}
 		tracer.Error(err).Log()
-		return nil, fmt.Errorf("querying job: %w", err)
+		return nil, fmt.Errorf("inserting job: %w", err)
 	}
 
-	// Parse args to verify ownership
-	var args jobs.RVToolsJobArgs
+	tracer.Success().WithParam("job_id", insertedJob.Job.ID).Log()
+
+	jobForm := mappers.JobForm{
+		ID:       insertedJob.Job.ID,
+		State:    insertedJob.Job.State,
+		Metadata: model.RVToolsJobMetadata{},
+	}
+	return jobForm.ToAPIJob(), nil
+}
+
+// EstimationJob is an estimation job: the job, the request which submitted it, its progress and its
+// result once completed.
+type EstimationJob struct {
+	Job       v1alpha1.Job
+	RequestID string
+	// Completed is the number of calculators run out of Total.
+	Completed int
+	Total     int
+	Result    *MigrationAssessmentResult
+}
+
+// CreateEstimationJob creates a new estimation job, correlated with the request of the context.
+// The calculators and priority are checked before the job is queued.
+func (s *JobService) CreateEstimationJob(ctx context.Context, args jobs.EstimationJobArgs) (*EstimationJob, error) {
+	logger := s.logger.WithContext(ctx)
+	tracer := logger.Operation("create_estimation_job").
+		WithInt("calculators", len(args.Calculators)).
+		WithString("org_id", args.OrgID).
+		WithString("username", args.Username).
+		Build()
+
+	if _, err := calculators.DefaultRegistry.Engine(args.Calculators...); err != nil {
+		tracer.Error(err).Log()
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	switch EstimationPriority(args.Priority) {
+	case "", EstimationPriorityInteractive, EstimationPriorityBatch:
+	default:
+		return nil, NewErrInvalidRequest(fmt.Sprintf("unknown estimation priority %q", args.Priority))
+	}
+	args.RequestID = requestid.FromContext(ctx)
+	args.TraceContext = tracing.Inject(ctx)
+
+	insertedJob, err := s.riverClient.Insert(ctx, args, nil)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, fmt.Errorf("inserting job: %w", err)
+	}
+
+	tracer.Success().WithParam("job_id", insertedJob.Job.ID).Log()
+
+	jobForm := mappers.JobForm{
+		ID:       insertedJob.Job.ID,
+		State:    insertedJob.Job.State,
+		Metadata: model.RVToolsJobMetadata{},
+	}
+	return &EstimationJob{Job: *jobForm.ToAPIJob(), RequestID: args.RequestID, Total: len(args.Calculators)}, nil
+}
+
+// GetEstimationJob retrieves an estimation job by ID, with its result once completed.
+func (s *JobService) GetEstimationJob(ctx context.Context, jobID int64, orgID, username string) (*EstimationJob, error) {
+	logger := s.logger.WithContext(ctx)
+	tracer := logger.Operation("get_estimation_job").
+		WithParam("job_id", jobID).
+		Build()
+
+	jobRow, err := s.ownedJob(ctx, tracer, jobID, orgID, username)
+	if err != nil {
+		return nil, err
+	}
+	if jobRow.Kind != (jobs.EstimationJobArgs{}).Kind() {
+		return nil, NewErrJobNotFound(jobID)
+	}
+
+	var args jobs.EstimationJobArgs
 	if err := json.Unmarshal(jobRow.ArgsJSON, &args); err != nil {
 		tracer.Error(err).Log()
 		return nil, fmt.Errorf("parsing job args: %w", err)
 	}
+	var metadata model.EstimationJobMetadata
+	if len(jobRow.MetadataJSON) > 0 {
+		// Ignore errors, use empty metadata if parsing fails
+		_ = json.Unmarshal(jobRow.MetadataJSON, &metadata)
+	}
 
-	// Verify ownership
-	if args.OrgID != orgID || args.Username != username {
-		return nil, NewErrJobForbidden(jobID)
+	jobForm := mappers.JobForm{
+		ID:       jobRow.ID,
+		State:    jobRow.State,
+		Metadata: model.RVToolsJobMetadata{Status: metadata.Status, Error: metadata.Error},
+	}
+	job := &EstimationJob{
+		Job:       *jobForm.ToAPIJob(),
+		RequestID: args.RequestID,
+		Completed: metadata.Completed,
+		Total:     len(args.Calculators),
+	}
+	if job.Job.Status == v1alpha1.Completed && len(metadata.Result) > 0 {
+		var result MigrationAssessmentResult
+		if err := json.Unmarshal(metadata.Result, &result); err != nil {
+			tracer.Error(err).Log()
+			return nil, fmt.Errorf("parsing estimation result: %w", err)
+		}
+		job.Result = &result
+	}
+
+	tracer.Success().WithString("status", string(job.Job.Status)).Log()
+	return job, nil
+}
+
+// GetJob retrieves a job by ID.
+func (s *JobService) GetJob(ctx context.Context, jobID int64, orgID, username string) (*v1alpha1.Job, error) {
+	logger := s.logger.WithContext(ctx)
+	tracer := logger.Operation("get_job").
+		WithParam("job_id", jobID).
+		Build()
+
+	jobRow, err := s.ownedJob(ctx, tracer, jobID, orgID, username)
+	if err != nil {
+		return nil, err
 	}
 
 	// Parse metadata
@@ -106,6 +217,75 @@ func (s *JobService) GetJob(ctx context.Context, jobID int64, orgID, username st
 	return job, nil
 }
 
+// GetPortfolioReport retrieves the report rendered by a portfolio report job. The job is returned
+// instead while the report is not rendered.
+func (s *JobService) GetPortfolioReport(ctx context.Context, jobID int64, orgID, username string) (*portfolio.Report, *v1alpha1.Job, error) {
+	logger := s.logger.WithContext(ctx)
+	tracer := logger.Operation("get_portfolio_report").
+		WithParam("job_id", jobID).
+		Build()
+
+	jobRow, err := s.ownedJob(ctx, tracer, jobID, orgID, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	if jobRow.Kind != (jobs.PortfolioReportJobArgs{}).Kind() {
+		return nil, nil, NewErrJobNotFound(jobID)
+	}
+
+	var metadata model.PortfolioReportJobMetadata
+	if len(jobRow.MetadataJSON) > 0 {
+		// Ignore errors, use empty metadata if parsing fails
+		_ = json.Unmarshal(jobRow.MetadataJSON, &metadata)
+	}
+
+	if metadata.Status == model.JobStatusCompleted && len(metadata.Report) > 0 {
+		var report portfolio.Report
+		if err := json.Unmarshal(metadata.Report, &report); err != nil {
+			tracer.Error(err).Log()
+			return nil, nil, fmt.Errorf("parsing portfolio report: %w", err)
+		}
+		tracer.Success().WithInt("plans", len(report.Plans)).Log()
+		return &report, nil, nil
+	}
+
+	jobForm := mappers.JobForm{
+		ID:       jobRow.ID,
+		State:    jobRow.State,
+		Metadata: model.RVToolsJobMetadata{Status: metadata.Status, Error: metadata.Error},
+	}
+	job := jobForm.ToAPIJob()
+	tracer.Success().WithString("status", string(job.Status)).Log()
+
+	return nil, job, nil
+}
+
+// ownedJob queries a job and verifies it was created by the user.
+func (s *JobService) ownedJob(ctx context.Context, tracer *log.OperationTracer, jobID int64, orgID, username string) (*store.JobRow, error) {
+	// Query job from store
+	jobRow, err := s.jobStore.Get(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrJobNotFound(jobID)
+		}
+		tracer.Error(err).Log()
+		return nil, fmt.Errorf("querying job: %w", err)
+	}
+
+	// Parse args to verify ownership, every kind of job shares the keys of RVToolsJobArgs
+	var args jobs.RVToolsJobArgs
+	if err := json.Unmarshal(jobRow.ArgsJSON, &args); err != nil {
+		tracer.Error(err).Log()
+		return nil, fmt.Errorf("parsing job args: %w", err)
+	}
+
+	// Verify ownership
+	if args.OrgID != orgID || args.Username != username {
+		return nil, NewErrJobForbidden(jobID)
+	}
+	return jobRow, nil
+}
+
 // CancelJob cancels a job by ID.
 func (s *JobService) CancelJob(ctx context.Context, jobID int64, orgID, username string) (*v1alpha1.Job, error) {
 	logger := s.logger.WithContext(ctx)
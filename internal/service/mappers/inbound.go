@@ -289,6 +289,10 @@ func mapRiverStateToStatus(state rivertype.JobState, metadata model.RVToolsJobMe
 			return v1alpha1.Cancelled
 		case model.JobStatusParsing:
 			return v1alpha1.Parsing
+		case model.JobStatusRendering:
+			return v1alpha1.Rendering
+		case model.JobStatusEstimating:
+			return v1alpha1.Estimating
 		default:
 			return v1alpha1.Parsing
 		}
@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/defaults"
+	"github.com/kubev2v/migration-planner/pkg/estimations/export"
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/signing"
+)
+
+// PlanService manages migration plans: the waves of a program laid out on the calendar.
+type PlanService struct {
+	store    store.Store
+	notifier Notifier
+	// notifyTimeout bounds the delivery of the alerts of an update.
+	notifyTimeout time.Duration
+	publishers    []EventPublisher
+	// rateProvider converts the costs into the plan currency, none when nil.
+	rateProvider cost.RateProvider
+	// shareBaseURL is the public URL of the server serving the shared reports.
+	shareBaseURL string
+	// signer signs the exports, none when nil.
+	signer *signing.Signer
+	logger *log.StructuredLogger
+}
+
+func NewPlanService(store store.Store) *PlanService {
+	return &PlanService{
+		store:  store,
+		logger: log.NewDebugLogger("plan_service"),
+	}
+}
+
+func (ps *PlanService) ListPlans(ctx context.Context, username, orgID string) ([]model.Plan, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("list_plans").
+		WithString("username", username).
+		WithString("org_id", orgID).
+		Build()
+
+	plans, err := ps.store.Plan().List(ctx, store.NewPlanQueryFilter().WithUsername(username).WithOrgID(orgID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+
+	tracer.Success().WithInt("count", len(plans)).Log()
+	return plans, nil
+}
+
+func (ps *PlanService) GetPlan(ctx context.Context, id uuid.UUID) (*model.Plan, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("get_plan").
+		WithUUID("plan_id", id).
+		Build()
+
+	p, err := ps.store.Plan().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrPlanNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	tracer.Success().WithString("plan_name", p.Name).Log()
+	return p, nil
+}
+
+// CreatePlan validates the plan, including its layout, and stores it for the user. The plan is padded
+// under the contingency policy of the organization, see contingency.Floor, and starts from its
+// calculator defaults, see defaults.Apply.
+func (ps *PlanService) CreatePlan(ctx context.Context, username, orgID string, p plan.Plan) (*model.Plan, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("create_plan").
+		WithString("org_id", orgID).
+		WithString("name", p.Name).
+		Build()
+
+	org, err := orgContingency(ctx, ps.store.ContingencyPolicy(), orgID)
+	if err != nil {
+		return nil, err
+	}
+	p.Contingency = contingency.Floor(p.Contingency, org)
+
+	constants, err := orgCalculatorDefaults(ctx, ps.store.CalculatorDefaults(), orgID)
+	if err != nil {
+		return nil, err
+	}
+	p = defaults.Apply(p, constants)
+
+	if _, err := p.Timeline(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	if err := ps.checkSources(ctx, orgID, p.Sources); err != nil {
+		return nil, err
+	}
+
+	document, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plan: %w", err)
+	}
+	digest, err := p.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	// re-submitting a plan returns the plan created the first time
+	id := PlanID(orgID, username, digest)
+	if existing, err := ps.store.Plan().Get(ctx, id); err == nil {
+		if doc, err := PlanDocument(*existing); err == nil && doc.VerifyDigest(digest) == nil {
+			tracer.Success().WithUUID("plan_id", id).WithString("step", "resubmitted").Log()
+			return existing, nil
+		}
+	} else if !errors.Is(err, store.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	ctx, err = ps.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	created, err := ps.store.Plan().Create(ctx, model.Plan{
+		ID:       id,
+		Name:     p.Name,
+		OrgID:    orgID,
+		Username: username,
+		Document: document,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return nil, NewErrPlanDuplicateName(p.Name)
+		}
+		return nil, fmt.Errorf("failed to create plan: %w", err)
+	}
+
+	events, err := ps.record(ctx, *created, planEvent{Type: EventPlanCreated, Payload: p})
+	if err != nil {
+		return nil, err
+	}
+	if ctx, err = store.Commit(ctx); err != nil {
+		return nil, err
+	}
+	ps.publish(ctx, events)
+
+	tracer.Success().WithUUID("plan_id", created.ID).Log()
+	return created, nil
+}
+
+func (ps *PlanService) DeletePlan(ctx context.Context, id uuid.UUID) error {
+	tracer := ps.logger.WithContext(ctx).Operation("delete_plan").
+		WithUUID("plan_id", id).
+		Build()
+
+	ctx, err := ps.store.NewTransactionContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	p, err := ps.store.Plan().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get plan: %w", err)
+	}
+	if err := ps.store.Plan().Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete plan: %w", err)
+	}
+
+	events, err := ps.record(ctx, *p, planEvent{Type: EventPlanDeleted, Payload: struct{}{}})
+	if err != nil {
+		return err
+	}
+	if ctx, err = store.Commit(ctx); err != nil {
+		return err
+	}
+	ps.publish(ctx, events)
+
+	tracer.Success().Log()
+	return nil
+}
+
+// Gantt lays out a stored plan and anchors it on the calendar, marking today when the program is running.
+// Phases with imported dates are moved to them and the chart is reported under the display policy of the plan.
+func (ps *PlanService) Gantt(p model.Plan, today time.Time) (gantt.Chart, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return gantt.Chart{}, err
+	}
+
+	chart, err := layout(p, doc, today)
+	if err != nil {
+		return gantt.Chart{}, err
+	}
+
+	for _, s := range doc.Schedule {
+		chart.Move(scheduling.BarID{Wave: s.Wave, Phase: s.Phase}, s.Start, s.End)
+	}
+	doc.DisplayPolicy().Apply(&chart)
+	// the moves may have changed the span of the program
+	chart.Today = nil
+	if !today.Before(chart.Start) && !today.After(chart.End) {
+		chart.Today = &today
+	}
+	if chart.Sites, err = sites(doc, chart.Start, chart.End); err != nil {
+		return gantt.Chart{}, err
+	}
+	return chart, nil
+}
+
+// sites returns the time zone of each source of the plan and its cutover windows from start to end.
+func sites(doc plan.Plan, start, end time.Time) ([]gantt.Site, error) {
+	res := make([]gantt.Site, 0, len(doc.Sources))
+	for _, s := range doc.Sources {
+		windows, err := s.CutoverWindows(start, end)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, gantt.Site{Source: s.Name, Location: s.Location(), Windows: windows})
+	}
+	return res, nil
+}
+
+// WhatIf lays out a stored plan as it is and with additional capacity changes, e.g. engineers leaving
+// or contractors joining mid-program, and build-out milestone slips, so the end dates can be compared.
+// The impact of each slip alone on the end date is returned along. Neither layout uses imported
+// dates: the scenario recomputes the schedule from the estimates. Both are reported under the
+// display policy of the plan. The plan is not changed.
+func (ps *PlanService) WhatIf(p model.Plan, changes []plan.CapacityChange, slips []plan.MilestoneSlip, today time.Time) (baseline, scenario gantt.Chart, impacts []plan.MilestoneImpact, err error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return gantt.Chart{}, gantt.Chart{}, nil, err
+	}
+
+	baseline, err = layout(p, doc, today)
+	if err != nil {
+		return gantt.Chart{}, gantt.Chart{}, nil, err
+	}
+
+	impacts, err = doc.SlipImpacts(slips)
+	if err != nil {
+		return gantt.Chart{}, gantt.Chart{}, nil, NewErrInvalidRequest(err.Error())
+	}
+	slipped, err := doc.WhatIf(changes).Slip(slips)
+	if err != nil {
+		return gantt.Chart{}, gantt.Chart{}, nil, NewErrInvalidRequest(err.Error())
+	}
+	scenario, err = layout(p, slipped, today)
+	if err != nil {
+		return gantt.Chart{}, gantt.Chart{}, nil, NewErrInvalidRequest(err.Error())
+	}
+	policy := doc.DisplayPolicy()
+	policy.Apply(&baseline)
+	policy.Apply(&scenario)
+	return baseline, scenario, impacts, nil
+}
+
+// Coverage returns the weekly coverage of the pools of a stored plan worked in shifts.
+func (ps *PlanService) Coverage(p model.Plan) ([]plan.PoolCoverage, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, err
+	}
+	return doc.Coverage()
+}
+
+// VMEffort returns the effort of a stored plan taken by one of the VMs its waves list, by ID or name.
+func (ps *PlanService) VMEffort(p model.Plan, vm string) (plan.VMEffort, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return plan.VMEffort{}, err
+	}
+	effort, ok := doc.VMEffort(vm)
+	if !ok {
+		return plan.VMEffort{}, NewErrPlanVMNotFound(p.ID, vm)
+	}
+	return effort, nil
+}
+
+// ImportSchedule reads back an MS Project document edited by a PM and stores the dates of the phases
+// it knows onto the plan. Effort stays with the plan: the discrepancies between the imported dates and
+// the estimates are returned for the PM to review.
+func (ps *PlanService) ImportSchedule(ctx context.Context, p model.Plan, data []byte) (*model.Plan, []export.Discrepancy, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("import_plan_schedule").
+		WithUUID("plan_id", p.ID).
+		Build()
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the document was exported with the dates of the chart, in the location of the plan start
+	schedule, err := export.ParseMSProject(data, doc.Start.Location())
+	if err != nil {
+		return nil, nil, NewErrInvalidRequest(err.Error())
+	}
+	chart, err := layout(p, doc, doc.Start)
+	if err != nil {
+		return nil, nil, err
+	}
+	discrepancies := export.Reconcile(chart, schedule)
+
+	known := make(map[scheduling.BarID]bool, len(chart.Bars))
+	for _, b := range chart.Bars {
+		known[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}] = true
+	}
+	doc.Schedule = doc.Schedule[:0]
+	for _, s := range schedule {
+		if known[scheduling.BarID{Wave: s.Wave, Phase: s.Phase}] {
+			doc.Schedule = append(doc.Schedule, s)
+		}
+	}
+
+	updated, err := ps.save(ctx, p, doc, planEvent{Type: EventParamsChanged, Payload: ParamsChange{Change: "schedule", Plan: doc}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracer.Success().WithInt("phases", len(doc.Schedule)).WithInt("discrepancies", len(discrepancies)).Log()
+	return updated, discrepancies, nil
+}
+
+// ImportCalendar sets the working calendar of a resource pool of the plan from a company holiday
+// calendar in ICS format, replacing the holidays imported before. The regional preset of the pool is
+// replaced when region is set and kept otherwise.
+func (ps *PlanService) ImportCalendar(ctx context.Context, p model.Plan, pool string, region *calendar.Region, data []byte) (*model.Plan, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("import_plan_calendar").
+		WithUUID("plan_id", p.ID).
+		WithString("pool", pool).
+		Build()
+
+	holidays, err := calendar.ParseICS(data)
+	if err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Calendars == nil {
+		doc.Calendars = make(map[string]calendar.Calendar)
+	}
+	c := doc.Calendars[pool]
+	if region != nil {
+		c.Region = *region
+	}
+	c.Holidays = holidays
+	doc.Calendars[pool] = c
+
+	if _, err := doc.Timeline(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+
+	updated, err := ps.save(ctx, p, doc, planEvent{Type: EventParamsChanged, Payload: ParamsChange{Change: "calendar", Plan: doc}})
+	if err != nil {
+		return nil, err
+	}
+
+	tracer.Success().WithInt("holidays", len(holidays)).Log()
+	return updated, nil
+}
+
+// layout anchors the timeline computed from the estimates of the plan on the calendar, grouping the
+// waves by source for a multi-source plan and marking the build-out milestones.
+func layout(p model.Plan, doc plan.Plan, today time.Time) (gantt.Chart, error) {
+	tl, err := doc.Timeline()
+	if err != nil {
+		return gantt.Chart{}, fmt.Errorf("failed to lay out plan %s: %w", p.ID, err)
+	}
+	chart := gantt.New(doc.Start, tl, today)
+	chart.AssignSources(doc.WaveSources())
+	for _, m := range doc.Milestones {
+		chart.Milestones = append(chart.Milestones, gantt.Milestone{Name: m.Name, At: m.Date})
+	}
+	return chart, nil
+}
+
+// checkSources checks the inventory sources referenced by the plan sources belong to the organization.
+func (ps *PlanService) checkSources(ctx context.Context, orgID string, sources []plan.Source) error {
+	for _, s := range sources {
+		if s.SourceID == nil {
+			continue
+		}
+		source, err := ps.store.Source().Get(ctx, *s.SourceID)
+		if err != nil && !errors.Is(err, store.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get source %s: %w", *s.SourceID, err)
+		}
+		if source == nil || source.OrgID != orgID {
+			return NewErrInvalidRequest(fmt.Sprintf("plan source %q references unknown source %s", s.Name, *s.SourceID))
+		}
+	}
+	return nil
+}
+
+// planNamespace is the namespace of the name-based UUIDs of the plans.
+var planNamespace = uuid.MustParse("5b0f8a8e-4c1d-4f57-9a0e-2f6c1c9d7e31")
+
+// PlanID returns the ID of the plan of the user with the content digest: the same plan submitted
+// twice by a user gets the same ID.
+func PlanID(orgID, username, digest string) uuid.UUID {
+	return uuid.NewSHA1(planNamespace, []byte(orgID+"\x00"+username+"\x00"+digest))
+}
+
+// PlanDocument decodes the plan stored in the model.
+func PlanDocument(p model.Plan) (plan.Plan, error) {
+	var doc plan.Plan
+	if err := json.Unmarshal(p.Document, &doc); err != nil {
+		return plan.Plan{}, fmt.Errorf("failed to decode plan %s: %w", p.ID, err)
+	}
+	return doc, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/distribution"
+)
+
+// PhaseDistribution is the distribution of the durations of a phase fitted to its actuals.
+type PhaseDistribution struct {
+	Phase   string
+	Samples int
+	// Distribution is nil when it could not be fitted, e.g. too few actuals, with Err telling why.
+	Distribution distribution.Distribution
+	Err          error
+}
+
+// RecordPhaseActuals stores how long the phases of the migration of VMs of the plan took and
+// returns all the actuals of the plan. Actuals already recorded for a VM and phase are replaced.
+// Actuals of the pilot wave of a plan with a learning curve calibrate its pilot factors.
+func (ps *PlanService) RecordPhaseActuals(ctx context.Context, p model.Plan, actuals model.VMPhaseActualList) (model.VMPhaseActualList, error) {
+	records := make(model.VMPhaseActualList, 0, len(actuals))
+	for _, a := range actuals {
+		if a.VMID == "" {
+			return nil, NewErrInvalidRequest("VM id is required")
+		}
+		if a.Phase == "" {
+			return nil, NewErrInvalidRequest(fmt.Sprintf("phase of VM %s is required", a.VMID))
+		}
+		if !a.CompletedAt.After(a.StartedAt) {
+			return nil, NewErrInvalidRequest(fmt.Sprintf("phase %s of VM %s must complete after it started", a.Phase, a.VMID))
+		}
+		a.PlanID = p.ID
+		a.OrgID = p.OrgID
+		records = append(records, a)
+	}
+
+	if _, err := ps.store.VMPhaseActual().Save(ctx, records); err != nil {
+		return nil, fmt.Errorf("failed to save phase actuals: %w", err)
+	}
+	saved, err := ps.store.VMPhaseActual().List(ctx, store.NewVMPhaseActualQueryFilter().WithPlanID(p.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list phase actuals: %w", err)
+	}
+	if err := ps.calibrateLearningCurve(ctx, p, saved); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// calibrateLearningCurve sets the pilot factors of the learning curve of the plan from the time
+// each phase of the pilot actually took, summed over its VMs. Plans without a learning curve or
+// pilot actuals are left unchanged.
+func (ps *PlanService) calibrateLearningCurve(ctx context.Context, p model.Plan, actuals model.VMPhaseActualList) error {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return err
+	}
+	pilot := doc.PilotWave()
+	if pilot == "" {
+		return nil
+	}
+
+	durations := make(map[string]time.Duration)
+	for _, a := range actuals {
+		if a.Wave == pilot {
+			durations[a.Phase] += a.Duration()
+		}
+	}
+	if len(durations) == 0 {
+		return nil
+	}
+	factors, err := doc.PilotFactors(durations)
+	if err != nil {
+		return err
+	}
+	if maps.Equal(factors, doc.LearningCurve.PilotFactors) {
+		return nil
+	}
+
+	doc.LearningCurve.PilotFactors = factors
+	_, err = ps.save(ctx, p, doc, planEvent{Type: EventParamsChanged, Payload: ParamsChange{Change: "learningCurve", Plan: doc}})
+	return err
+}
+
+// DurationDistributions fits a distribution to the actual durations of each phase recorded for the
+// VMs of the organization, in hours, so simulations sample phase durations from them. With several
+// fitters, each phase gets the distribution explaining its actuals best.
+func (ps *PlanService) DurationDistributions(ctx context.Context, orgID string, fitters ...distribution.Fitter) ([]PhaseDistribution, error) {
+	if len(fitters) == 0 {
+		fitters = distribution.DefaultFitters()
+	}
+
+	actuals, err := ps.store.VMPhaseActual().List(ctx, store.NewVMPhaseActualQueryFilter().WithOrgID(orgID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list phase actuals: %w", err)
+	}
+
+	durations := make(map[string][]time.Duration)
+	for _, a := range actuals {
+		durations[a.Phase] = append(durations[a.Phase], a.Duration())
+	}
+	phases := make([]string, 0, len(durations))
+	for phase := range durations {
+		phases = append(phases, phase)
+	}
+	slices.Sort(phases)
+
+	fitted := make([]PhaseDistribution, 0, len(phases))
+	for _, phase := range phases {
+		d, err := distribution.Best(distribution.Hours(durations[phase]), fitters...)
+		fitted = append(fitted, PhaseDistribution{Phase: phase, Samples: len(durations[phase]), Distribution: d, Err: err})
+	}
+	return fitted, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/chargeback"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// WithRateProvider sets the exchange rate service converting the plan costs into the plan currency
+// for the currencies the plan has no rate of its own for.
+func (ps *PlanService) WithRateProvider(p cost.RateProvider) *PlanService {
+	ps.rateProvider = p
+	return ps
+}
+
+// Chargeback charges the estimated effort of the plan and the actuals recorded so far back by the
+// owner or cost center of its VMs, priced with the rate card when given. Cards of other
+// organizations are not found. The costs are converted into the plan currency when it is set.
+func (ps *PlanService) Chargeback(ctx context.Context, p model.Plan, by chargeback.GroupBy, rateCardID *uuid.UUID) (chargeback.Report, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("plan_chargeback").
+		WithUUID("plan_id", p.ID).
+		WithString("group_by", string(by)).
+		Build()
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return chargeback.Report{}, err
+	}
+
+	var card *cost.RateCard
+	if rateCardID != nil {
+		m, err := ps.store.RateCard().Get(ctx, *rateCardID)
+		if err != nil && !errors.Is(err, store.ErrRecordNotFound) {
+			return chargeback.Report{}, fmt.Errorf("failed to get rate card: %w", err)
+		}
+		if m == nil || m.OrgID != p.OrgID {
+			return chargeback.Report{}, NewErrRateCardNotFound(*rateCardID)
+		}
+		c, err := RateCardFromModel(*m)
+		if err != nil {
+			return chargeback.Report{}, err
+		}
+		card = &c
+	}
+
+	records, err := ps.store.VMPhaseActual().List(ctx, store.NewVMPhaseActualQueryFilter().WithPlanID(p.ID))
+	if err != nil {
+		return chargeback.Report{}, fmt.Errorf("failed to list phase actuals: %w", err)
+	}
+	actuals := make([]chargeback.Actual, 0, len(records))
+	for _, a := range records {
+		actuals = append(actuals, chargeback.Actual{VM: a.VMID, Phase: a.Phase, Effort: a.Duration()})
+	}
+
+	report, err := chargeback.Build(doc, by, card, actuals)
+	if err != nil {
+		return chargeback.Report{}, NewErrInvalidRequest(err.Error())
+	}
+	if doc.Currency != "" {
+		rates, err := planRates(doc, ps.rateProvider)
+		if err != nil {
+			return chargeback.Report{}, err
+		}
+		if report, err = report.Convert(ctx, doc.Currency, rates); err != nil {
+			if errors.Is(err, cost.ErrNoExchangeRate) {
+				return chargeback.Report{}, NewErrInvalidRequest(err.Error())
+			}
+			return chargeback.Report{}, err
+		}
+	}
+
+	tracer.Success().WithInt("lines", len(report.Lines)).Log()
+	return report, nil
+}
+
+// planRates returns the exchange rates entered for the plan, backed by the exchange rate service
+// when given.
+func planRates(doc plan.Plan, provider cost.RateProvider) (*cost.Rates, error) {
+	opts := make([]cost.RatesOption, 0, len(doc.ExchangeRates)+1)
+	for _, r := range doc.ExchangeRates {
+		opts = append(opts, cost.WithRate(r.From, doc.Currency, r.Rate, r.AsOf))
+	}
+	if provider != nil {
+		opts = append(opts, cost.WithRateProvider(provider))
+	}
+	rates, err := cost.NewRates(opts...)
+	if err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	return rates, nil
+}
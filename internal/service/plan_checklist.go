@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// ListChecklistTemplates returns the checklist templates of the organization by name.
+func (ps *PlanService) ListChecklistTemplates(ctx context.Context, orgID string) (model.ChecklistTemplateList, error) {
+	templates, err := ps.store.ChecklistTemplate().List(ctx, store.NewChecklistTemplateQueryFilter().WithOrgID(orgID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklist templates: %w", err)
+	}
+	return templates, nil
+}
+
+// CreateChecklistTemplate stores a checklist template of the organization.
+func (ps *PlanService) CreateChecklistTemplate(ctx context.Context, orgID, username string, t checklist.Template) (*model.ChecklistTemplate, error) {
+	if err := t.Validate(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	document, err := json.Marshal(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode checklist template: %w", err)
+	}
+
+	created, err := ps.store.ChecklistTemplate().Create(ctx, model.ChecklistTemplate{
+		ID:       uuid.New(),
+		Name:     t.Name,
+		OrgID:    orgID,
+		Username: username,
+		Document: document,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return nil, NewErrDuplicateKey("checklist template", t.Name)
+		}
+		return nil, fmt.Errorf("failed to create checklist template: %w", err)
+	}
+	return created, nil
+}
+
+// DeleteChecklistTemplate deletes a checklist template of the organization. The checklists
+// already instantiated from it are kept.
+func (ps *PlanService) DeleteChecklistTemplate(ctx context.Context, orgID string, id uuid.UUID) (*model.ChecklistTemplate, error) {
+	template, err := ps.store.ChecklistTemplate().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrChecklistTemplateNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get checklist template: %w", err)
+	}
+	if template.OrgID != orgID {
+		return nil, NewErrChecklistTemplateNotFound(id)
+	}
+
+	if err := ps.store.ChecklistTemplate().Delete(ctx, id); err != nil {
+		return nil, fmt.Errorf("failed to delete checklist template: %w", err)
+	}
+	return template, nil
+}
+
+// ChecklistTemplateDocument decodes the template stored in the model.
+func ChecklistTemplateDocument(t model.ChecklistTemplate) (checklist.Template, error) {
+	var doc checklist.Template
+	if err := json.Unmarshal(t.Document, &doc); err != nil {
+		return checklist.Template{}, fmt.Errorf("failed to decode checklist template %s: %w", t.ID, err)
+	}
+	return doc, nil
+}
+
+// ChecklistDocument decodes the checklist stored in the model.
+func ChecklistDocument(c model.Checklist) (checklist.Checklist, error) {
+	var doc checklist.Checklist
+	if err := json.Unmarshal(c.Document, &doc); err != nil {
+		return checklist.Checklist{}, fmt.Errorf("failed to decode checklist %s: %w", c.ID, err)
+	}
+	return doc, nil
+}
+
+// InstantiateChecklists creates the checklists of the VMs cut over in a wave of the plan from the
+// templates of its organization. VMs keep the checklists already instantiated for them: only the
+// new ones are returned.
+func (ps *PlanService) InstantiateChecklists(ctx context.Context, p model.Plan, wave string, vms []checklist.VM) (model.ChecklistList, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(doc.Waves, func(w plan.Wave) bool { return w.Name == wave }) {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("plan %s has no wave %s", p.ID, wave))
+	}
+	for _, vm := range vms {
+		if vm.ID == "" {
+			return nil, NewErrInvalidRequest("VM id is required")
+		}
+	}
+
+	stored, err := ps.ListChecklistTemplates(ctx, p.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	templates := make([]checklist.Template, 0, len(stored))
+	for _, t := range stored {
+		template, err := ChecklistTemplateDocument(t)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	existing, err := ps.store.Checklist().List(ctx, store.NewChecklistQueryFilter().WithPlanID(p.ID).WithWave(wave))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklists: %w", err)
+	}
+	instantiated := make(map[[2]string]bool, len(existing))
+	for _, c := range existing {
+		instantiated[[2]string{c.VMID, c.Template}] = true
+	}
+
+	checklists := model.ChecklistList{}
+	for _, c := range checklist.Instantiate(wave, templates, vms) {
+		if instantiated[[2]string{c.VM.ID, c.Template}] {
+			continue
+		}
+		document, err := json.Marshal(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode checklist: %w", err)
+		}
+		checklists = append(checklists, model.Checklist{
+			ID:       uuid.New(),
+			PlanID:   p.ID,
+			Wave:     wave,
+			VMID:     c.VM.ID,
+			Template: c.Template,
+			Document: document,
+		})
+	}
+
+	created, err := ps.store.Checklist().Create(ctx, checklists)
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return nil, NewErrInvalidRequest("checklists of the wave are being instantiated concurrently")
+		}
+		return nil, fmt.Errorf("failed to create checklists: %w", err)
+	}
+	return created, nil
+}
+
+// ListChecklists returns the checklists of the plan, of a single wave when wave is set.
+func (ps *PlanService) ListChecklists(ctx context.Context, p model.Plan, wave string) (model.ChecklistList, error) {
+	filter := store.NewChecklistQueryFilter().WithPlanID(p.ID)
+	if wave != "" {
+		filter = filter.WithWave(wave)
+	}
+	checklists, err := ps.store.Checklist().List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklists: %w", err)
+	}
+	return checklists, nil
+}
+
+// CompleteChecklistItem marks the item at index of a checklist of the plan done, or not done, by the user.
+func (ps *PlanService) CompleteChecklistItem(ctx context.Context, p model.Plan, id uuid.UUID, index int, done bool, username string) (*model.Checklist, error) {
+	stored, err := ps.store.Checklist().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrChecklistNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	if stored.PlanID != p.ID {
+		return nil, NewErrChecklistNotFound(id)
+	}
+
+	doc, err := ChecklistDocument(*stored)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := doc.Complete(index, done, username, now); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	if stored.Document, err = json.Marshal(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode checklist: %w", err)
+	}
+	stored.UpdatedAt = &now
+
+	updated, err := ps.store.Checklist().Update(ctx, *stored)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrChecklistNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to update checklist: %w", err)
+	}
+	return updated, nil
+}
+
+// ChecklistProgress rolls the checklists of the plan up per wave.
+func (ps *PlanService) ChecklistProgress(ctx context.Context, p model.Plan) ([]checklist.WaveProgress, error) {
+	stored, err := ps.ListChecklists(ctx, p, "")
+	if err != nil {
+		return nil, err
+	}
+	checklists := make([]checklist.Checklist, 0, len(stored))
+	for _, c := range stored {
+		doc, err := ChecklistDocument(c)
+		if err != nil {
+			return nil, err
+		}
+		checklists = append(checklists, doc)
+	}
+	return checklist.Progress(checklists), nil
+}
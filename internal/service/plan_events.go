@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// EventType is the type of a domain event.
+type EventType string
+
+const (
+	// EventPlanCreated carries the plan document as created.
+	EventPlanCreated EventType = "PlanCreated"
+	// EventParamsChanged carries the plan document after a change of its parameters, e.g. KPI
+	// targets, imported dates or calendars.
+	EventParamsChanged EventType = "ParamsChanged"
+	// EventActualRecorded carries the actuals recorded for a wave.
+	EventActualRecorded EventType = "ActualRecorded"
+	// EventWaveCompleted carries the actuals of a wave recorded for the first time.
+	EventWaveCompleted EventType = "WaveCompleted"
+	// EventPlanDeleted has no payload.
+	EventPlanDeleted EventType = "PlanDeleted"
+	// EventReportExported carries the format of an export of the plan and the user exporting it.
+	EventReportExported EventType = "ReportExported"
+	// EventGateApproved carries the sign-off of a gate of the plan.
+	EventGateApproved EventType = "GateApproved"
+)
+
+const (
+	planAggregate = "plan"
+
+	// DefaultEventPageSize is the number of events returned by a replay page when not set.
+	DefaultEventPageSize = 100
+	// MaxEventPageSize bounds the number of events returned by a replay page.
+	MaxEventPageSize = 1000
+)
+
+// EventPublisher delivers domain events to downstream systems, e.g. an analytics pipeline.
+type EventPublisher interface {
+	Publish(ctx context.Context, event client.Event) error
+}
+
+// ParamsChange is the payload of EventParamsChanged.
+type ParamsChange struct {
+	// Change names the parameters changed: "kpis", "schedule", "calendar" or "learningCurve".
+	Change string    `json:"change"`
+	Plan   plan.Plan `json:"plan"`
+}
+
+// planEvent is an event to record along with a change of a plan.
+type planEvent struct {
+	Type    EventType
+	Payload any
+}
+
+// WithEventPublisher adds an integration domain events are published to once recorded, e.g. a
+// webhook or a CloudEvents sink.
+func (ps *PlanService) WithEventPublisher(p EventPublisher) *PlanService {
+	ps.publishers = append(ps.publishers, p)
+	return ps
+}
+
+// Events returns the events of the plans of the user in the organization after the sequence number,
+// oldest first, so downstream systems can rebuild the state of the plans by replaying them page after
+// page. The payloads carry whole plan documents: the events of the plans of other users are not
+// returned, as their plans are not.
+func (ps *PlanService) Events(ctx context.Context, orgID, username string, after int64, limit int) (model.EventList, error) {
+	if after < 0 {
+		return nil, NewErrInvalidRequest("after must not be negative")
+	}
+	if limit <= 0 {
+		limit = DefaultEventPageSize
+	}
+	limit = min(limit, MaxEventPageSize)
+
+	events, err := ps.store.Event().List(ctx, store.NewEventQueryFilter().WithOrgID(orgID).WithUsername(username).WithAfter(after).WithLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	return events, nil
+}
+
+// save stores the plan document and records the events in a single transaction, then publishes the events.
+func (ps *PlanService) save(ctx context.Context, p model.Plan, doc plan.Plan, events ...planEvent) (*model.Plan, error) {
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plan: %w", err)
+	}
+	p.Document = document
+
+	ctx, err = ps.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	updated, err := ps.store.Plan().Update(ctx, p)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrPlanNotFound(p.ID)
+		}
+		return nil, fmt.Errorf("failed to update plan: %w", err)
+	}
+
+	recorded, err := ps.record(ctx, p, events...)
+	if err != nil {
+		return nil, err
+	}
+	if ctx, err = store.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	ps.publish(ctx, recorded)
+	return updated, nil
+}
+
+// record appends the events of a plan to the event log.
+func (ps *PlanService) record(ctx context.Context, p model.Plan, events ...planEvent) (model.EventList, error) {
+	recorded := make(model.EventList, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s event: %w", e.Type, err)
+		}
+		event, err := ps.store.Event().Append(ctx, model.Event{
+			Type:          string(e.Type),
+			OrgID:         p.OrgID,
+			Username:      p.Username,
+			AggregateType: planAggregate,
+			AggregateID:   p.ID.String(),
+			Payload:       payload,
+			OccurredAt:    time.Now(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to record %s event: %w", e.Type, err)
+		}
+		recorded = append(recorded, *event)
+	}
+	return recorded, nil
+}
+
+// publish delivers the recorded events. Publishing is best effort: the event log stays the
+// reference and downstream systems catch up by replaying it.
+func (ps *PlanService) publish(ctx context.Context, events model.EventList) {
+	for _, publisher := range ps.publishers {
+		for _, e := range events {
+			if err := publisher.Publish(ctx, EventToClient(e)); err != nil {
+				ps.logger.WithContext(ctx).Operation("publish_event").
+					WithString("event_type", e.Type).
+					Build().
+					Error(err).Log()
+			}
+		}
+	}
+}
+
+// EventToClient converts a recorded event to its published form.
+func EventToClient(e model.Event) client.Event {
+	return client.Event{
+		Seq:           e.Seq,
+		Type:          e.Type,
+		OrgID:         e.OrgID,
+		Username:      e.Username,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		Payload:       e.Payload,
+		OccurredAt:    e.OccurredAt,
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/export"
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/pdf"
+	"github.com/kubev2v/migration-planner/pkg/signing"
+)
+
+// ExportFormat is a format a plan leaves the planner in.
+type ExportFormat string
+
+const (
+	ExportMSProject  ExportFormat = "msproject"
+	ExportSmartsheet ExportFormat = "smartsheet"
+	ExportMTV        ExportFormat = "mtv"
+	ExportGanttSVG   ExportFormat = "svg"
+	ExportShare      ExportFormat = "share"
+	ExportJSON       ExportFormat = "json"
+	// ExportTerraform and ExportAnsible create the target namespaces of a plan with a namespace mapping.
+	ExportTerraform ExportFormat = "terraform"
+	ExportAnsible   ExportFormat = "ansible"
+)
+
+// raw tells whether the format carries the plan data as files for other tools rather than a
+// rendered report.
+func (f ExportFormat) raw() bool {
+	switch f {
+	case ExportMSProject, ExportSmartsheet, ExportMTV, ExportJSON, ExportTerraform, ExportAnsible:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReportExport is the payload of EventReportExported.
+type ReportExport struct {
+	Format      ExportFormat `json:"format"`
+	Username    string       `json:"username"`
+	Watermarked bool         `json:"watermarked"`
+	// Digest is the digest of the export, for the exports to be matched with the audit trail later on.
+	Digest string `json:"digest,omitempty"`
+}
+
+// DefaultExportPolicy is the policy of the organizations which did not set one: raw exports
+// allowed and no watermark.
+func DefaultExportPolicy(orgID string) model.ExportPolicy {
+	return model.ExportPolicy{OrgID: orgID, RawExports: true}
+}
+
+// GetExportPolicy returns the export policy of the organization.
+func (ps *PlanService) GetExportPolicy(ctx context.Context, orgID string) (*model.ExportPolicy, error) {
+	policy, err := ps.store.ExportPolicy().Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			defaults := DefaultExportPolicy(orgID)
+			return &defaults, nil
+		}
+		return nil, fmt.Errorf("failed to get export policy: %w", err)
+	}
+	return policy, nil
+}
+
+// SetExportPolicy replaces the export policy of the organization.
+func (ps *PlanService) SetExportPolicy(ctx context.Context, policy model.ExportPolicy) (*model.ExportPolicy, error) {
+	policy.UpdatedAt = time.Now()
+	saved, err := ps.store.ExportPolicy().Save(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save export policy: %w", err)
+	}
+	return saved, nil
+}
+
+// Export renders the plan in the format under the export policy of its organization, on behalf
+// of the user, and records the export in the event log.
+func (ps *PlanService) Export(ctx context.Context, p model.Plan, username string, format ExportFormat) ([]byte, error) {
+	chart, err := ps.exportChart(ctx, p, username, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	switch format {
+	case ExportMSProject, ExportMTV:
+		doc, err := PlanDocument(p)
+		if err != nil {
+			return nil, err
+		}
+		if format == ExportMTV {
+			var opts []export.MTVOption
+			design, err := namespaceDesign(doc)
+			if err != nil {
+				return nil, err
+			}
+			if design != nil {
+				opts = append(opts, export.WithNamespaces(*design))
+			}
+			out, err = export.MTVPlans(p.ID.String(), doc.Name, chart, opts...)
+			if err != nil {
+				return nil, err
+			}
+		} else if out, err = export.MSProject(doc.Name, chart, doc.Pools); err != nil {
+			return nil, err
+		}
+	case ExportTerraform, ExportAnsible:
+		doc, err := PlanDocument(p)
+		if err != nil {
+			return nil, err
+		}
+		design, err := namespaceDesign(doc)
+		if err != nil {
+			return nil, err
+		}
+		if design == nil {
+			return nil, NewErrInvalidRequest(fmt.Sprintf("plan has no namespace mapping to export as %s", format))
+		}
+		if format == ExportTerraform {
+			out, err = export.TerraformNamespaces(p.ID.String(), *design)
+		} else {
+			out, err = export.AnsibleNamespaces(p.ID.String(), doc.Name, *design)
+		}
+		if err != nil {
+			return nil, err
+		}
+	case ExportSmartsheet:
+		if out, err = export.SmartsheetCSV(chart); err != nil {
+			return nil, err
+		}
+	case ExportGanttSVG:
+		out = chart.SVG()
+	case ExportJSON:
+		doc, err := PlanDocument(p)
+		if err != nil {
+			return nil, err
+		}
+		if out, err = doc.CanonicalJSON(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, NewErrInvalidRequest(fmt.Sprintf("unsupported export format %q", format))
+	}
+
+	if err := ps.recordExport(ctx, p, username, format, chart.Watermark != "", signing.Digest(out)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WithSigner sets the signer of the exports, none when nil.
+func (ps *PlanService) WithSigner(s *signing.Signer) *PlanService {
+	ps.signer = s
+	return ps
+}
+
+// SignExport returns the detached signature of an export, nil when the planner has no signer.
+func (ps *PlanService) SignExport(ctx context.Context, out []byte) (*signing.Signature, error) {
+	if ps.signer == nil {
+		return nil, nil
+	}
+	tracer := ps.logger.WithContext(ctx).Operation("sign_export").Build()
+
+	sig, err := ps.signer.Sign(out)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, fmt.Errorf("failed to sign export: %w", err)
+	}
+	tracer.Success().WithString("digest", sig.Digest).Log()
+	return &sig, nil
+}
+
+// exportChart lays out the plan for an export allowed by the policy of its organization,
+// watermarked when the policy asks for it.
+func (ps *PlanService) exportChart(ctx context.Context, p model.Plan, username string, format ExportFormat) (gantt.Chart, error) {
+	policy, err := ps.GetExportPolicy(ctx, p.OrgID)
+	if err != nil {
+		return gantt.Chart{}, err
+	}
+	if format.raw() && !policy.RawExports {
+		return gantt.Chart{}, NewErrExportForbidden(format, p.OrgID)
+	}
+
+	now := time.Now()
+	chart, err := ps.Gantt(p, now)
+	if err != nil {
+		return gantt.Chart{}, err
+	}
+	chart.Watermark = exportWatermark(*policy, username, now)
+	return chart, nil
+}
+
+// StampReport watermarks a PDF report downloaded by the user when the export policy of the
+// organization asks for it, and returns it as is otherwise.
+func (ps *PlanService) StampReport(ctx context.Context, orgID, username string, report []byte) ([]byte, error) {
+	policy, err := ps.GetExportPolicy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	watermark := exportWatermark(*policy, username, time.Now())
+	if watermark == "" {
+		return report, nil
+	}
+	stamped, err := pdf.Stamp(report, watermark)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watermark report: %w", err)
+	}
+	return stamped, nil
+}
+
+// RawExportsAllowed tells whether the export policy of the organization lets the raw data out, e.g.
+// the inventories of its sources and assessments.
+func (ps *PlanService) RawExportsAllowed(ctx context.Context, orgID string) (bool, error) {
+	policy, err := ps.GetExportPolicy(ctx, orgID)
+	if err != nil {
+		return false, err
+	}
+	return policy.RawExports, nil
+}
+
+// exportWatermark returns the watermark of an export by the user at the time, the organization, the
+// user and the time, empty when the policy does not ask for one.
+func exportWatermark(policy model.ExportPolicy, username string, now time.Time) string {
+	if !policy.Watermark {
+		return ""
+	}
+	return fmt.Sprintf("%s · %s · %s", policy.OrgID, username, now.UTC().Format(time.RFC3339))
+}
+
+// recordExport appends the export to the event log, the audit trail of the exports: an export
+// which can not be recorded fails.
+func (ps *PlanService) recordExport(ctx context.Context, p model.Plan, username string, format ExportFormat, watermarked bool, digest string) error {
+	recorded, err := ps.record(ctx, p, planEvent{
+		Type:    EventReportExported,
+		Payload: ReportExport{Format: format, Username: username, Watermarked: watermarked, Digest: digest},
+	})
+	if err != nil {
+		return err
+	}
+	ps.publish(ctx, recorded)
+	return nil
+}
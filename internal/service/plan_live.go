@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+	"github.com/kubev2v/migration-planner/pkg/estimations/live"
+)
+
+// RecordTracking stores the migration status of the VMs of the wave numbered n, from 1, as reported
+// by MTV and returns the board of the wave updated with it. VMs not reported keep their last status.
+// The wave can not be tracked while a gate holding it awaits its sign-off.
+func (ps *PlanService) RecordTracking(ctx context.Context, p model.Plan, n int, tracking []live.Tracking) (*live.Board, error) {
+	wave, err := waveName(p, n)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, err
+	}
+	if pending := doc.PendingGates(wave); len(pending) > 0 {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("wave %q is held by gate %q awaiting the sign-off of %s", wave, pending[0].Name, pending[0].Role))
+	}
+
+	now := time.Now()
+	records := make(model.VMTrackingList, 0, len(tracking))
+	for _, t := range tracking {
+		if t.VMID == "" {
+			return nil, NewErrInvalidRequest("VM id is required")
+		}
+		if t.Started != nil && t.Completed != nil && t.Completed.Before(*t.Started) {
+			return nil, NewErrInvalidRequest(fmt.Sprintf("VM %s completed before it started", t.VMID))
+		}
+		records = append(records, model.VMTracking{
+			PlanID:      p.ID,
+			Wave:        wave,
+			VMID:        t.VMID,
+			Name:        t.Name,
+			Phase:       t.Phase,
+			StartedAt:   t.Started,
+			CompletedAt: t.Completed,
+			Error:       t.Error,
+			UpdatedAt:   now,
+		})
+	}
+
+	if _, err := ps.store.VMTracking().Save(ctx, records); err != nil {
+		return nil, fmt.Errorf("failed to save VM tracking: %w", err)
+	}
+	return ps.WaveLive(ctx, p, n, now)
+}
+
+// WaveLive builds the board of the wave numbered n, from 1, at now: the state of its VMs from their
+// MTV status and checklists, the time elapsed against the estimate of the wave and its blockers.
+func (ps *PlanService) WaveLive(ctx context.Context, p model.Plan, n int, now time.Time) (*live.Board, error) {
+	wave, err := waveName(p, n)
+	if err != nil {
+		return nil, err
+	}
+
+	chart, err := ps.Gantt(p, now)
+	if err != nil {
+		return nil, err
+	}
+	var estimated time.Duration
+	for _, w := range chart.Waves {
+		if w.Name == wave {
+			estimated = w.End.Sub(w.Start)
+		}
+	}
+
+	records, err := ps.store.VMTracking().List(ctx, store.NewVMTrackingQueryFilter().WithPlanID(p.ID).WithWave(wave))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VM tracking: %w", err)
+	}
+	tracking := make([]live.Tracking, 0, len(records))
+	for _, r := range records {
+		tracking = append(tracking, live.Tracking{
+			VMID:      r.VMID,
+			Name:      r.Name,
+			Phase:     r.Phase,
+			Started:   r.StartedAt,
+			Completed: r.CompletedAt,
+			Error:     r.Error,
+		})
+	}
+
+	stored, err := ps.ListChecklists(ctx, p, wave)
+	if err != nil {
+		return nil, err
+	}
+	checklists := make([]checklist.Checklist, 0, len(stored))
+	for _, c := range stored {
+		doc, err := ChecklistDocument(c)
+		if err != nil {
+			return nil, err
+		}
+		checklists = append(checklists, doc)
+	}
+
+	board := live.Build(wave, estimated, tracking, checklists, now)
+	return &board, nil
+}
+
+// waveName returns the name of the wave numbered n, from 1, in the order of the plan.
+func waveName(p model.Plan, n int) (string, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return "", err
+	}
+	if n < 1 || n > len(doc.Waves) {
+		return "", NewErrWaveNotFound(p.ID, n)
+	}
+	return doc.Waves[n-1].Name, nil
+}
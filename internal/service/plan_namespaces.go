@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/namespaces"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// Namespaces proposes the target namespaces of the VMs the waves of the plan list with the mapping,
+// the mapping of the plan when nil, or by folder when the plan has none.
+func (ps *PlanService) Namespaces(ctx context.Context, p model.Plan, mapping *plan.NamespaceMapping) (namespaces.Design, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("plan_namespaces").
+		WithUUID("plan_id", p.ID).
+		Build()
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return namespaces.Design{}, err
+	}
+
+	m := plan.NamespaceMapping{Strategy: plan.NamespaceByFolder}
+	switch {
+	case mapping != nil:
+		m = *mapping
+	case doc.Namespaces != nil:
+		m = *doc.Namespaces
+	}
+	design, err := namespaces.Propose(doc, m)
+	if err != nil {
+		return namespaces.Design{}, NewErrInvalidRequest(err.Error())
+	}
+
+	tracer.Success().
+		WithString("strategy", string(m.Strategy)).
+		WithInt("namespaces", len(design.Namespaces)).
+		WithInt("issues", len(design.Issues)).
+		Log()
+	return design, nil
+}
+
+// namespaceDesign proposes the target namespaces of the plan document for its exports, nil when it
+// has no mapping. A design with issues fails.
+func namespaceDesign(doc plan.Plan) (*namespaces.Design, error) {
+	if doc.Namespaces == nil {
+		return nil, nil
+	}
+	design, err := namespaces.Propose(doc, *doc.Namespaces)
+	if err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	if err := design.Err(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	return &design, nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// DefaultNotifyTimeout bounds the delivery of the alerts of an update when not set.
+const DefaultNotifyTimeout = 10 * time.Second
+
+// Notifier delivers alerts to a webhook, the alert webhook of the organization of the plan.
+type Notifier interface {
+	NotifyTo(ctx context.Context, webhookURL string, alert client.Alert) error
+}
+
+// WithNotifier sets the client KPI threshold alerts are sent to the alert webhooks of the
+// organizations through, in the background and within timeout, DefaultNotifyTimeout when not positive.
+func (ps *PlanService) WithNotifier(n Notifier, timeout time.Duration) *PlanService {
+	if timeout <= 0 {
+		timeout = DefaultNotifyTimeout
+	}
+	ps.notifier = n
+	ps.notifyTimeout = timeout
+	return ps
+}
+
+// KPIStatus measures the progress recorded on a stored plan against its KPI targets.
+func (ps *PlanService) KPIStatus(p model.Plan) ([]plan.KPIStatus, error) {
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, err
+	}
+	return doc.KPIStatus(), nil
+}
+
+// SetKPIs replaces the KPI targets of the plan. Targets the recorded progress already breaches are alerted.
+func (ps *PlanService) SetKPIs(ctx context.Context, p model.Plan, kpis plan.KPIs) (*model.Plan, []plan.KPIStatus, error) {
+	return ps.track(ctx, "set_plan_kpis", p, func(doc *plan.Plan) []planEvent {
+		doc.KPIs = &kpis
+		return []planEvent{{Type: EventParamsChanged, Payload: ParamsChange{Change: "kpis", Plan: *doc}}}
+	})
+}
+
+// RecordProgress records the actuals of a wave of the plan, replacing what was recorded for it before,
+// and alerts the KPIs it breaches. Once all the waves are recorded, the outcome of the plan is
+// contributed to the benchmark dataset when its organization opted in.
+func (ps *PlanService) RecordProgress(ctx context.Context, p model.Plan, wp plan.WaveProgress) (*model.Plan, []plan.KPIStatus, error) {
+	updated, statuses, err := ps.track(ctx, "record_plan_progress", p, func(doc *plan.Plan) []planEvent {
+		events := []planEvent{{Type: EventActualRecorded, Payload: wp}}
+		if !slices.ContainsFunc(doc.Progress, func(recorded plan.WaveProgress) bool { return recorded.Wave == wp.Wave }) {
+			events = append(events, planEvent{Type: EventWaveCompleted, Payload: wp})
+		}
+		doc.RecordProgress(wp)
+		return events
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps.contributeBenchmark(ctx, *updated)
+	return updated, statuses, nil
+}
+
+// ApproveGate records the sign-off of a gate of the plan by the user, releasing the progress of the
+// wave it holds once its other gates are approved too. The approval is recorded in the event log,
+// the audit trail of the sign-offs.
+func (ps *PlanService) ApproveGate(ctx context.Context, p model.Plan, gate, username, comment string) (*model.Plan, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("approve_plan_gate").
+		WithUUID("plan_id", p.ID).
+		WithString("gate", gate).
+		Build()
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, err
+	}
+	approval, err := doc.Approve(gate, username, time.Now(), comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, plan.ErrGateNotFound):
+			return nil, NewErrGateNotFound(p.ID, gate)
+		case errors.Is(err, plan.ErrNotApprover):
+			return nil, NewErrGateApprovalForbidden(gate, username)
+		default:
+			return nil, NewErrInvalidRequest(err.Error())
+		}
+	}
+
+	updated, err := ps.save(ctx, p, doc, planEvent{Type: EventGateApproved, Payload: approval})
+	if err != nil {
+		return nil, err
+	}
+
+	tracer.Success().WithString("role", approval.Role).Log()
+	return updated, nil
+}
+
+// track applies update to the plan, stores it with the events update returns and alerts the KPIs
+// newly breached.
+func (ps *PlanService) track(ctx context.Context, operation string, p model.Plan, update func(*plan.Plan) []planEvent) (*model.Plan, []plan.KPIStatus, error) {
+	tracer := ps.logger.WithContext(ctx).Operation(operation).
+		WithUUID("plan_id", p.ID).
+		Build()
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	previous := doc.KPIStatus()
+
+	events := update(&doc)
+	if err := doc.Validate(); err != nil {
+		return nil, nil, NewErrInvalidRequest(err.Error())
+	}
+	current := doc.KPIStatus()
+
+	updated, err := ps.save(ctx, p, doc, events...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	breaches := plan.NewBreaches(previous, current)
+	alerts := make([]client.Alert, 0, len(breaches))
+	for _, b := range breaches {
+		subject := string(b.KPI)
+		if b.Wave != "" {
+			subject = fmt.Sprintf("%s (%s)", b.KPI, b.Wave)
+		}
+		alerts = append(alerts, client.Alert{
+			Source:   "plan",
+			ID:       p.ID.String(),
+			Name:     p.Name,
+			Subject:  subject,
+			Message:  b.Message,
+			RaisedAt: time.Now(),
+		})
+	}
+	ps.notify(ctx, p.OrgID, alerts)
+
+	tracer.Success().WithInt("waves", len(doc.Progress)).WithInt("breaches", len(breaches)).Log()
+	return updated, current, nil
+}
+
+// notify sends the alerts to the alert webhook of the organization, if it set one. They are sent in
+// the background so a slow webhook does not hold the update, and are best effort: failing to deliver
+// them is logged only.
+func (ps *PlanService) notify(ctx context.Context, orgID string, alerts []client.Alert) {
+	if ps.notifier == nil || len(alerts) == 0 {
+		return
+	}
+	tracer := ps.logger.WithContext(ctx).Operation("notify").
+		WithString("org_id", orgID).
+		Build()
+
+	webhook, err := ps.store.Webhook().GetAlertWebhook(ctx, orgID)
+	if err != nil {
+		if !errors.Is(err, store.ErrRecordNotFound) {
+			tracer.Error(err).Log()
+		}
+		return
+	}
+
+	// the request may be over before the alerts are delivered
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ps.notifyTimeout)
+	go func() {
+		defer cancel()
+		for _, alert := range alerts {
+			if err := ps.notifier.NotifyTo(ctx, webhook.URL, alert); err != nil {
+				tracer.Step("deliver").WithString("subject", alert.Subject).WithString("error", err.Error()).Log()
+			}
+		}
+	}()
+}
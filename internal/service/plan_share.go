@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+const (
+	// DefaultShareTTL is how long a share link stays valid when not set.
+	DefaultShareTTL = 7 * 24 * time.Hour
+	// MaxShareTTL bounds how long a share link stays valid.
+	MaxShareTTL = 90 * 24 * time.Hour
+
+	shareTokenBytes   = 32
+	sharedReportsPath = "/api/v1/shares/"
+)
+
+// WithShareBaseURL sets the public URL of the server serving the shared reports.
+func (ps *PlanService) WithShareBaseURL(baseURL string) *PlanService {
+	ps.shareBaseURL = baseURL
+	return ps
+}
+
+// CreateShare renders the report of the plan under the export policy of its organization and
+// creates a read-only link to it, valid for ttl. The report is rendered once: viewers see the
+// deliverable as it was shared. It returns the share
+// and its public URL, the only time the token is available.
+func (ps *PlanService) CreateShare(ctx context.Context, p model.Plan, username string, ttl time.Duration) (*model.Share, string, error) {
+	if ttl == 0 {
+		ttl = DefaultShareTTL
+	}
+	if ttl < 0 || ttl > MaxShareTTL {
+		return nil, "", NewErrInvalidRequest(fmt.Sprintf("share links expire after at most %s", MaxShareTTL))
+	}
+
+	doc, err := PlanDocument(p)
+	if err != nil {
+		return nil, "", err
+	}
+	chart, err := ps.exportChart(ctx, p, username, ExportShare)
+	if err != nil {
+		return nil, "", err
+	}
+	report, err := chart.HTML(doc.Name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	ctx, err = ps.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	share, err := ps.store.Share().Create(ctx, model.Share{
+		ID:          uuid.New(),
+		PlanID:      p.ID,
+		OrgID:       p.OrgID,
+		Username:    username,
+		TokenHash:   HashShareToken(token),
+		ContentType: "text/html",
+		Report:      report,
+		ExpiresAt:   time.Now().Add(ttl),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create share: %w", err)
+	}
+	recorded, err := ps.record(ctx, p, planEvent{
+		Type:    EventReportExported,
+		Payload: ReportExport{Format: ExportShare, Username: username, Watermarked: chart.Watermark != ""},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if ctx, err = store.Commit(ctx); err != nil {
+		return nil, "", err
+	}
+	ps.publish(ctx, recorded)
+
+	link, err := url.JoinPath(ps.shareBaseURL, sharedReportsPath, token)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid share base url: %w", err)
+	}
+	return share, link, nil
+}
+
+// ListShares returns the share links of the plan, newest first.
+func (ps *PlanService) ListShares(ctx context.Context, p model.Plan) (model.ShareList, error) {
+	shares, err := ps.store.Share().List(ctx, store.NewShareQueryFilter().WithPlanID(p.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	return shares, nil
+}
+
+// RevokeShare revokes a share link of the plan. Revoking it again keeps the first revocation time.
+func (ps *PlanService) RevokeShare(ctx context.Context, p model.Plan, shareID uuid.UUID) (*model.Share, error) {
+	share, err := ps.store.Share().Get(ctx, shareID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrShareNotFound(shareID)
+		}
+		return nil, fmt.Errorf("failed to get share: %w", err)
+	}
+	if share.PlanID != p.ID {
+		return nil, NewErrShareNotFound(shareID)
+	}
+
+	share, err = ps.store.Share().Revoke(ctx, shareID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke share: %w", err)
+	}
+	return share, nil
+}
+
+// ViewShare returns the shared report of the token and counts the view. Unknown, expired and
+// revoked links are all not found.
+func (ps *PlanService) ViewShare(ctx context.Context, token string) (*model.Share, error) {
+	share, err := ps.store.Share().GetByTokenHash(ctx, HashShareToken(token))
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrSharedReportNotFound()
+		}
+		return nil, fmt.Errorf("failed to get share: %w", err)
+	}
+	now := time.Now()
+	if share.RevokedAt != nil || !now.Before(share.ExpiresAt) {
+		return nil, NewErrSharedReportNotFound()
+	}
+
+	if err := ps.store.Share().CountView(ctx, share.ID, now); err != nil {
+		return nil, fmt.Errorf("failed to count view: %w", err)
+	}
+	share.Views++
+	share.LastViewedAt = &now
+	return share, nil
+}
+
+// HashShareToken returns the hash a share token is stored as.
+func HashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/portfolio"
+)
+
+// PortfolioReport renders the executive report of plans of the organization as of now, pricing
+// their effort with the rate card when given and bucketing the cost by the budget periods of the
+// options. The cost of a plan with a currency is converted with the rates of the plan, as its
+// chargeback is. The plans are laid out as their Gantt charts are, so the report agrees with the charts
+// the PMs read. Plans and cards of other organizations are not found.
+func (ps *PlanService) PortfolioReport(ctx context.Context, orgID string, planIDs []uuid.UUID, rateCardID *uuid.UUID, now time.Time, opts ...portfolio.Option) (portfolio.Report, error) {
+	tracer := ps.logger.WithContext(ctx).Operation("render_portfolio_report").
+		WithString("org_id", orgID).
+		WithInt("plans", len(planIDs)).
+		Build()
+
+	entries := make([]portfolio.Entry, 0, len(planIDs))
+	for _, id := range planIDs {
+		p, err := ps.store.Plan().Get(ctx, id)
+		if err != nil && !errors.Is(err, store.ErrRecordNotFound) {
+			return portfolio.Report{}, fmt.Errorf("failed to get plan: %w", err)
+		}
+		if p == nil || p.OrgID != orgID {
+			return portfolio.Report{}, NewErrPlanNotFound(id)
+		}
+
+		doc, err := PlanDocument(*p)
+		if err != nil {
+			return portfolio.Report{}, err
+		}
+		chart, err := ps.Gantt(*p, now)
+		if err != nil {
+			return portfolio.Report{}, err
+		}
+		entry := portfolio.Entry{ID: p.ID.String(), Plan: doc, Chart: chart}
+		if doc.Currency != "" {
+			if entry.Rates, err = planRates(doc, ps.rateProvider); err != nil {
+				return portfolio.Report{}, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	var card *cost.RateCard
+	if rateCardID != nil {
+		m, err := ps.store.RateCard().Get(ctx, *rateCardID)
+		if err != nil && !errors.Is(err, store.ErrRecordNotFound) {
+			return portfolio.Report{}, fmt.Errorf("failed to get rate card: %w", err)
+		}
+		if m == nil || m.OrgID != orgID {
+			return portfolio.Report{}, NewErrRateCardNotFound(*rateCardID)
+		}
+		c, err := RateCardFromModel(*m)
+		if err != nil {
+			return portfolio.Report{}, err
+		}
+		card = &c
+	}
+
+	report, err := portfolio.Build(ctx, entries, card, now, opts...)
+	if err != nil {
+		if errors.Is(err, cost.ErrNoExchangeRate) {
+			return portfolio.Report{}, NewErrInvalidRequest(err.Error())
+		}
+		return portfolio.Report{}, err
+	}
+
+	tracer.Success().WithInt("risks", len(report.Risks)).Log()
+	return report, nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// RateCardService manages the rate cards of an organization. Cards are versioned: creating a card
+// with the name of an existing one adds a new version and leaves the previous ones untouched.
+type RateCardService struct {
+	store  store.Store
+	logger *log.StructuredLogger
+}
+
+func NewRateCardService(store store.Store) *RateCardService {
+	return &RateCardService{
+		store:  store,
+		logger: log.NewDebugLogger("rate_card_service"),
+	}
+}
+
+// ListRateCards returns every version of the organization cards, optionally only those named name.
+func (rs *RateCardService) ListRateCards(ctx context.Context, orgID, name string) ([]model.RateCard, error) {
+	tracer := rs.logger.WithContext(ctx).Operation("list_rate_cards").
+		WithString("org_id", orgID).
+		WithString("name", name).
+		Build()
+
+	filter := store.NewRateCardQueryFilter().WithOrgID(orgID)
+	if name != "" {
+		filter = filter.WithName(name)
+	}
+
+	cards, err := rs.store.RateCard().List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rate cards: %w", err)
+	}
+
+	tracer.Success().WithInt("count", len(cards)).Log()
+	return cards, nil
+}
+
+func (rs *RateCardService) GetRateCard(ctx context.Context, id uuid.UUID) (*model.RateCard, error) {
+	tracer := rs.logger.WithContext(ctx).Operation("get_rate_card").
+		WithUUID("rate_card_id", id).
+		Build()
+
+	card, err := rs.store.RateCard().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrRateCardNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get rate card: %w", err)
+	}
+
+	tracer.Success().WithString("rate_card_name", card.Name).WithInt("version", card.Version).Log()
+	return card, nil
+}
+
+// CreateRateCard stores the card as the next version of the organization card with the same name.
+func (rs *RateCardService) CreateRateCard(ctx context.Context, orgID, username string, card cost.RateCard) (*model.RateCard, error) {
+	tracer := rs.logger.WithContext(ctx).Operation("create_rate_card").
+		WithString("org_id", orgID).
+		WithString("name", card.Name).
+		Build()
+
+	if err := card.Validate(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+
+	rates, err := json.Marshal(card.HourlyRates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode hourly rates: %w", err)
+	}
+
+	versions, err := rs.store.RateCard().List(ctx, store.NewRateCardQueryFilter().WithOrgID(orgID).WithName(card.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rate card versions: %w", err)
+	}
+	version := 1
+	for _, v := range versions {
+		if v.Version >= version {
+			version = v.Version + 1
+		}
+	}
+	tracer.Step("next_version").WithInt("version", version).Log()
+
+	created, err := rs.store.RateCard().Create(ctx, model.RateCard{
+		ID:          uuid.New(),
+		Name:        card.Name,
+		Version:     version,
+		OrgID:       orgID,
+		Username:    username,
+		Currency:    card.Currency,
+		Region:      card.Region,
+		ValidFrom:   card.ValidFrom,
+		ValidUntil:  card.ValidUntil,
+		HourlyRates: rates,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			// another version was created concurrently
+			return nil, NewErrDuplicateKey("rate card version", fmt.Sprintf("%s v%d", card.Name, version))
+		}
+		return nil, fmt.Errorf("failed to create rate card: %w", err)
+	}
+
+	tracer.Success().WithUUID("rate_card_id", created.ID).Log()
+	return created, nil
+}
+
+func (rs *RateCardService) DeleteRateCard(ctx context.Context, id uuid.UUID) error {
+	tracer := rs.logger.WithContext(ctx).Operation("delete_rate_card").
+		WithUUID("rate_card_id", id).
+		Build()
+
+	if err := rs.store.RateCard().Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete rate card: %w", err)
+	}
+
+	tracer.Success().Log()
+	return nil
+}
+
+// RateCardFromModel decodes a stored rate card.
+func RateCardFromModel(m model.RateCard) (cost.RateCard, error) {
+	card := cost.RateCard{
+		Name:       m.Name,
+		Version:    m.Version,
+		Currency:   m.Currency,
+		Region:     m.Region,
+		ValidFrom:  m.ValidFrom,
+		ValidUntil: m.ValidUntil,
+	}
+	if err := json.Unmarshal(m.HourlyRates, &card.HourlyRates); err != nil {
+		return cost.RateCard{}, fmt.Errorf("failed to decode rate card %s: %w", m.ID, err)
+	}
+	return card, nil
+}
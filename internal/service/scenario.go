@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// EstimationScenario is a named set of params an estimation runs with, e.g. "10 Gbps link".
+type EstimationScenario struct {
+	Name   string
+	Params []estimation.Param
+}
+
+// ScenarioComparison is how the estimation of a scenario B differs from the one of a scenario A.
+type ScenarioComparison struct {
+	estimation.Comparison
+	A, B             EstimationScenario
+	ResultA, ResultB *MigrationAssessmentResult
+}
+
+// CompareScenarios runs the calculators of the specs with the params of each scenario the way
+// RunEstimation does, and compares the results calculator by calculator. The buffers of the
+// contingency policy of the organization are compared as line items of their own.
+func (es *EstimationService) CompareScenarios(
+	ctx context.Context,
+	orgID string,
+	priority EstimationPriority,
+	specs []calculators.Spec,
+	a, b EstimationScenario,
+) (*ScenarioComparison, error) {
+	tracer := es.logger.WithContext(ctx).Operation("compare_scenarios").
+		WithString("org_id", orgID).
+		WithString("scenario_a", a.Name).
+		WithString("scenario_b", b.Name).
+		Build()
+
+	resultA, err := es.runEstimation(ctx, orgID, priority, specs, a.Params, nil)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+	resultB, err := es.runEstimation(ctx, orgID, priority, specs, b.Params, nil)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+
+	comparison, err := estimation.Compare(resultA.Breakdown, resultB.Breakdown)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+
+	tracer.Success().
+		WithString("change", comparison.Change.String()).
+		Log()
+	return &ScenarioComparison{Comparison: comparison, A: a, B: b, ResultA: resultA, ResultB: resultB}, nil
+}
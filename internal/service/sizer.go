@@ -15,6 +15,8 @@ import (
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/util"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
 	"github.com/kubev2v/migration-planner/pkg/log"
 )
 
@@ -679,6 +681,15 @@ func (s *SizerService) transformSizerResponse(sizerResponse *client.SizerRespons
 	}
 }
 
+// ProcurementParams converts the node counts of a sizing into the params of the HardwareProcurement
+// calculator. The failover nodes are ordered along with the workers they are counted in.
+func ProcurementParams(sizing mappers.ClusterSizingForm) []estimation.Param {
+	return []estimation.Param{
+		{Key: calculators.ParamControlPlaneNodeCount, Value: sizing.ControlPlaneNodes},
+		{Key: calculators.ParamWorkerNodeCount, Value: sizing.WorkerNodes},
+	}
+}
+
 func calculateFailoverNodes(workerNodes int) int {
 	if workerNodes == 0 {
 		return 0
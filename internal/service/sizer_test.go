@@ -21,8 +21,9 @@ import (
 
 // MockStore is a mock implementation of store.Store
 type MockStore struct {
-	assessments map[uuid.UUID]*model.Assessment
-	getError    error
+	assessments    map[uuid.UUID]*model.Assessment
+	changeRateJobs store.ChangeRateJob
+	getError       error
 }
 
 func NewMockStore() *MockStore {
@@ -51,6 +52,82 @@ func (m *MockStore) Job() store.Job {
 	return nil
 }
 
+func (m *MockStore) Plan() store.Plan {
+	return nil
+}
+
+func (m *MockStore) RateCard() store.RateCard {
+	return nil
+}
+
+func (m *MockStore) ChangeRateJob() store.ChangeRateJob {
+	return m.changeRateJobs
+}
+
+func (m *MockStore) Event() store.Event {
+	return nil
+}
+
+func (m *MockStore) Share() store.Share {
+	return nil
+}
+
+func (m *MockStore) ExportPolicy() store.ExportPolicy {
+	return nil
+}
+
+func (m *MockStore) ChecklistTemplate() store.ChecklistTemplate {
+	return nil
+}
+
+func (m *MockStore) Checklist() store.Checklist {
+	return nil
+}
+
+func (m *MockStore) VMTracking() store.VMTracking {
+	return nil
+}
+
+func (m *MockStore) Benchmark() store.Benchmark {
+	return nil
+}
+
+func (m *MockStore) VMPhaseActual() store.VMPhaseActual {
+	return nil
+}
+
+func (m *MockStore) TroubleshootingModel() store.TroubleshootingModel {
+	return nil
+}
+
+func (m *MockStore) ContingencyPolicy() store.ContingencyPolicy {
+	return nil
+}
+
+func (m *MockStore) CalculatorDefaults() store.CalculatorDefaults {
+	return nil
+}
+
+func (m *MockStore) EstimationRun() store.EstimationRun {
+	return nil
+}
+
+func (m *MockStore) EstimationFreeze() store.EstimationFreeze {
+	return nil
+}
+
+func (m *MockStore) GuardrailPolicy() store.GuardrailPolicy {
+	return nil
+}
+
+func (m *MockStore) InventoryFieldSchema() store.InventoryFieldSchema {
+	panic("InventoryFieldSchema() not implemented in MockStore for this test")
+}
+
+func (m *MockStore) Webhook() store.Webhook {
+	panic("Webhook() not implemented in MockStore for this test")
+}
+
 func (m *MockStore) PrivateKey() store.PrivateKey {
 	return nil
 }
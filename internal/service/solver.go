@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/solver"
+)
+
+// SolveDeadline searches the smallest changes of the bounded params making the estimation of the
+// calculators of the specs end by the deadline, when started at the start of the schedule. Each
+// estimation runs the way RunEstimation does and ends with the last part of its breakdown landed on
+// the schedule. The search runs on a single worker of the pool of the priority.
+func (es *EstimationService) SolveDeadline(
+	ctx context.Context,
+	orgID string,
+	priority EstimationPriority,
+	specs []calculators.Spec,
+	params []estimation.Param,
+	schedule EstimationSchedule,
+	deadline time.Time,
+	bounds []solver.Bound,
+) (solver.Result, error) {
+	tracer := es.logger.WithContext(ctx).Operation("solve_deadline").
+		WithString("org_id", orgID).
+		WithString("deadline", deadline.Format(time.RFC3339)).
+		WithInt("bound_count", len(bounds)).
+		Build()
+
+	if err := schedule.Calendar.Validate(); err != nil {
+		return solver.Result{}, NewErrInvalidRequest(err.Error())
+	}
+	if err := schedule.Workday.Validate(); err != nil {
+		return solver.Result{}, NewErrInvalidRequest(err.Error())
+	}
+	if !deadline.After(schedule.Start) {
+		return solver.Result{}, NewErrInvalidRequest("the deadline must be after the start of the schedule")
+	}
+	engine, err := calculators.DefaultRegistry.Engine(specs...)
+	if err != nil {
+		tracer.Error(err).Log()
+		return solver.Result{}, NewErrInvalidRequest(err.Error())
+	}
+
+	// the calculator defaults of the organization fill the params not given, so the current value of
+	// a param to adjust may be the default of the organization
+	profile := es.profile(ctx, orgID)
+	resolver := estimation.NewResolver()
+	if profile.Defaults != nil {
+		resolver.SetAll(estimation.LayerOrganization, profile.Defaults.Params())
+	}
+	for _, p := range params {
+		resolver.Set(estimation.LayerRequest, p.Key, p.Value)
+	}
+
+	evaluate := func(params []estimation.Param) (time.Time, error) {
+		results := engine.Run(params)
+		for name, buffer := range contingencyBuffers(profile, results) {
+			results[name] = buffer
+		}
+		end := schedule.Start
+		for name, est := range results {
+			span, err := schedule.Calendar.Dates(schedule.Start, est, schedule.Workday)
+			if err != nil {
+				return time.Time{}, NewErrInvalidRequest(fmt.Sprintf("cannot schedule %s: %v", name, err))
+			}
+			if span.End.After(end) {
+				end = span.End
+			}
+		}
+		return end, nil
+	}
+
+	var result solver.Result
+	var solveErr error
+	if err := es.queue.Do(ctx, priority, func() error {
+		result, solveErr = solver.Solve(resolver.Params(), deadline, bounds, evaluate)
+		return nil
+	}); err != nil {
+		tracer.Error(err).Log()
+		return solver.Result{}, err
+	}
+	if solveErr != nil {
+		// the bounds are invalid or the calendar has no working day left
+		tracer.Error(solveErr).Log()
+		if _, ok := solveErr.(*ErrInvalidRequest); ok {
+			return solver.Result{}, solveErr
+		}
+		return solver.Result{}, NewErrInvalidRequest(solveErr.Error())
+	}
+
+	tracer.Success().
+		WithBool("feasible", result.Feasible).
+		WithInt("change_count", len(result.Changes)).
+		WithInt("evaluations", result.Evaluations).
+		Log()
+	return result, nil
+}
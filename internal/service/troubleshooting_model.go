@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// NewErrTroubleshootingModelNotFound is returned when the organization has no trained model.
+func NewErrTroubleshootingModelNotFound(orgID string) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("troubleshooting model of organization %s not found", orgID)}
+}
+
+// WithTroubleshootingModels sets the models the troubleshooting time of the VMs is predicted with.
+// Without them, or for organizations without model, the troubleshooting time comes from the formula.
+func (es *EstimationService) WithTroubleshootingModels(m store.TroubleshootingModel) *EstimationService {
+	es.models = m
+	return es
+}
+
+// GetTroubleshootingModel returns the troubleshooting model of the organization.
+func (es *EstimationService) GetTroubleshootingModel(ctx context.Context, orgID string) (*model.TroubleshootingModel, error) {
+	m, err := es.store.TroubleshootingModel().Get(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrTroubleshootingModelNotFound(orgID)
+		}
+		return nil, fmt.Errorf("failed to get troubleshooting model: %w", err)
+	}
+	return m, nil
+}
+
+// SetTroubleshootingModel replaces the troubleshooting model of the organization with a model
+// trained outside the planner, e.g. by a regression over past migrations.
+func (es *EstimationService) SetTroubleshootingModel(ctx context.Context, orgID, username string, doc calculators.LinearTroubleshootingModel) (*model.TroubleshootingModel, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode troubleshooting model: %w", err)
+	}
+	saved, err := es.store.TroubleshootingModel().Save(ctx, model.TroubleshootingModel{
+		OrgID:     orgID,
+		Document:  document,
+		UpdatedAt: time.Now(),
+		UpdatedBy: username,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save troubleshooting model: %w", err)
+	}
+	return saved, nil
+}
+
+// DeleteTroubleshootingModel removes the troubleshooting model of the organization, whose
+// estimations fall back to the formula.
+func (es *EstimationService) DeleteTroubleshootingModel(ctx context.Context, orgID string) (*model.TroubleshootingModel, error) {
+	m, err := es.GetTroubleshootingModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if err := es.store.TroubleshootingModel().Delete(ctx, orgID); err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrTroubleshootingModelNotFound(orgID)
+		}
+		return nil, fmt.Errorf("failed to delete troubleshooting model: %w", err)
+	}
+	return m, nil
+}
+
+// TroubleshootingModelDocument decodes the model stored for an organization.
+func TroubleshootingModelDocument(m model.TroubleshootingModel) (calculators.LinearTroubleshootingModel, error) {
+	var doc calculators.LinearTroubleshootingModel
+	if err := json.Unmarshal(m.Document, &doc); err != nil {
+		return calculators.LinearTroubleshootingModel{}, fmt.Errorf("failed to decode troubleshooting model: %w", err)
+	}
+	return doc, nil
+}
+
+// troubleshootingParams returns the model of the profile and the features of the VMs of the cluster
+// to predict their troubleshooting time, none when the organization has no model.
+func troubleshootingParams(profile EstimationProfile, clusterInventory api.InventoryData) []estimation.Param {
+	if profile.TroubleshootingModel == nil {
+		return nil
+	}
+	return []estimation.Param{
+		{Key: calculators.ParamTroubleshootingPredictor, Value: calculators.TroubleshootingPredictor(*profile.TroubleshootingModel)},
+		{Key: calculators.ParamVMFeatures, Value: vmFeatures(clusterInventory)},
+	}
+}
+
+// vmFeatures derives the features of the VMs of the cluster from its inventory. The inventory only
+// holds aggregates: the VMs get the operating systems as counted and share the disks evenly, which
+// keeps the total predicted by a linear model exact. Snapshots and application tags are not
+// inventoried and are left out.
+func vmFeatures(clusterInventory api.InventoryData) []calculators.VMFeatures {
+	total := clusterInventory.Vms.Total
+	features := make([]calculators.VMFeatures, 0, total)
+	if clusterInventory.Vms.Os != nil {
+		names := make([]string, 0, len(*clusterInventory.Vms.Os))
+		for name := range *clusterInventory.Vms.Os {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			for range (*clusterInventory.Vms.Os)[name] {
+				if len(features) < total {
+					features = append(features, calculators.VMFeatures{OS: name})
+				}
+			}
+		}
+	}
+	for len(features) < total {
+		features = append(features, calculators.VMFeatures{})
+	}
+
+	if total > 0 {
+		disks := clusterInventory.Vms.DiskCount.Total
+		for i := range features {
+			features[i].DiskCount = disks / total
+			if i < disks%total {
+				features[i].DiskCount++
+			}
+		}
+	}
+	return features
+}
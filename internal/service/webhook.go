@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// IDs of the webhooks of the service the domain events are published to.
+const (
+	WebhookEvents      = "events"
+	WebhookCloudEvents = "cloudevents"
+)
+
+const (
+	// DefaultWebhookDisableThreshold is the number of deliveries an endpoint fails in a row before
+	// it is disabled, when not set.
+	DefaultWebhookDisableThreshold = 10
+	// DefaultWebhookDeliveryPageSize is the number of deliveries listed when not set.
+	DefaultWebhookDeliveryPageSize = 100
+	// MaxWebhookDeliveryPageSize bounds the number of deliveries listed, and redriven at once.
+	MaxWebhookDeliveryPageSize = 1000
+)
+
+// WebhookEndpointStatus is the delivery state of a webhook endpoint and the count of its deliveries.
+type WebhookEndpointStatus struct {
+	model.WebhookEndpoint
+	Delivered int64
+	Failed    int64
+}
+
+// Disabled tells whether the endpoint stopped being called after failing too many deliveries.
+func (s WebhookEndpointStatus) Disabled() bool {
+	return s.DisabledAt != nil
+}
+
+// WebhookRedrive is the outcome of a redrive of the failed deliveries of an endpoint.
+type WebhookRedrive struct {
+	Redriven  int
+	Delivered int
+	Failed    int
+	// Disabled tells the endpoint was disabled again during the redrive, leaving the remaining
+	// deliveries failed.
+	Disabled bool
+}
+
+// WebhookService records the deliveries of the domain events to the webhooks of the service, so the
+// failed ones can be listed and replayed from the event log once the consumer is fixed. An endpoint
+// failing too many deliveries in a row is disabled: its deliveries are recorded failed without
+// calling it until it is redriven.
+type WebhookService struct {
+	store     store.Store
+	endpoints map[string]EventPublisher
+	ids       []string
+	threshold int
+	// mu serializes the updates of the delivery state of the endpoints.
+	mu     sync.Mutex
+	logger *log.StructuredLogger
+}
+
+// NewWebhookService creates a webhook service disabling the endpoints after threshold failed
+// deliveries in a row, DefaultWebhookDisableThreshold when not positive.
+func NewWebhookService(store store.Store, threshold int) *WebhookService {
+	if threshold <= 0 {
+		threshold = DefaultWebhookDisableThreshold
+	}
+	return &WebhookService{
+		store:     store,
+		endpoints: make(map[string]EventPublisher),
+		threshold: threshold,
+		logger:    log.NewDebugLogger("webhook_service"),
+	}
+}
+
+// Track registers the publisher as the endpoint of the ID and returns the publisher recording its
+// deliveries, to be given to PlanService.WithEventPublisher.
+func (ws *WebhookService) Track(id string, p EventPublisher) EventPublisher {
+	if _, ok := ws.endpoints[id]; !ok {
+		ws.ids = append(ws.ids, id)
+	}
+	ws.endpoints[id] = p
+	return &trackedPublisher{service: ws, endpointID: id}
+}
+
+type trackedPublisher struct {
+	service    *WebhookService
+	endpointID string
+}
+
+func (t *trackedPublisher) Publish(ctx context.Context, event client.Event) error {
+	_, err := t.service.deliver(ctx, t.endpointID, event, 0)
+	return err
+}
+
+// Endpoints returns the status of the endpoints in the order they were registered.
+func (ws *WebhookService) Endpoints(ctx context.Context) ([]WebhookEndpointStatus, error) {
+	statuses := make([]WebhookEndpointStatus, 0, len(ws.ids))
+	for _, id := range ws.ids {
+		status, err := ws.Endpoint(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, *status)
+	}
+	return statuses, nil
+}
+
+// Endpoint returns the status of the endpoint.
+func (ws *WebhookService) Endpoint(ctx context.Context, id string) (*WebhookEndpointStatus, error) {
+	if _, ok := ws.endpoints[id]; !ok {
+		return nil, NewErrWebhookNotFound(id)
+	}
+	endpoint, err := ws.endpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := ws.store.Webhook().CountDeliveries(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count deliveries: %w", err)
+	}
+	return &WebhookEndpointStatus{
+		WebhookEndpoint: *endpoint,
+		Delivered:       counts[model.WebhookDeliveryDelivered],
+		Failed:          counts[model.WebhookDeliveryFailed],
+	}, nil
+}
+
+// Deliveries returns the deliveries to the endpoint, the most recent first, optionally only those of
+// a status.
+func (ws *WebhookService) Deliveries(ctx context.Context, id, status string, limit int) (model.WebhookDeliveryList, error) {
+	if _, ok := ws.endpoints[id]; !ok {
+		return nil, NewErrWebhookNotFound(id)
+	}
+	switch status {
+	case "", model.WebhookDeliveryDelivered, model.WebhookDeliveryFailed:
+	default:
+		return nil, NewErrInvalidRequest(fmt.Sprintf("unknown delivery status %q", status))
+	}
+	if limit <= 0 {
+		limit = DefaultWebhookDeliveryPageSize
+	}
+	limit = min(limit, MaxWebhookDeliveryPageSize)
+
+	filter := store.NewWebhookDeliveryQueryFilter().WithEndpointID(id).WithNewestFirst().WithLimit(limit)
+	if status != "" {
+		filter = filter.WithStatus(status)
+	}
+	deliveries, err := ws.store.Webhook().ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Redrive enables the endpoint again and replays its failed deliveries, oldest first, up to
+// MaxWebhookDeliveryPageSize of them. It stops when the endpoint is disabled again.
+func (ws *WebhookService) Redrive(ctx context.Context, id string) (WebhookRedrive, error) {
+	tracer := ws.logger.WithContext(ctx).Operation("redrive_webhook").
+		WithString("endpoint_id", id).
+		Build()
+
+	if _, ok := ws.endpoints[id]; !ok {
+		return WebhookRedrive{}, NewErrWebhookNotFound(id)
+	}
+
+	ws.mu.Lock()
+	endpoint, err := ws.endpoint(ctx, id)
+	if err == nil {
+		endpoint.ConsecutiveFailures = 0
+		endpoint.DisabledAt = nil
+		endpoint.UpdatedAt = time.Now()
+		_, err = ws.store.Webhook().SaveEndpoint(ctx, *endpoint)
+	}
+	ws.mu.Unlock()
+	if err != nil {
+		tracer.Error(err).Log()
+		return WebhookRedrive{}, fmt.Errorf("failed to enable endpoint: %w", err)
+	}
+
+	failed, err := ws.store.Webhook().ListDeliveries(ctx, store.NewWebhookDeliveryQueryFilter().
+		WithEndpointID(id).
+		WithStatus(model.WebhookDeliveryFailed).
+		WithOldestFirst().
+		WithLimit(MaxWebhookDeliveryPageSize))
+	if err != nil {
+		tracer.Error(err).Log()
+		return WebhookRedrive{}, fmt.Errorf("failed to list deliveries: %w", err)
+	}
+	if len(failed) == 0 {
+		tracer.Success().WithInt("redriven", 0).Log()
+		return WebhookRedrive{}, nil
+	}
+
+	seqs := make([]int64, 0, len(failed))
+	for _, d := range failed {
+		seqs = append(seqs, d.EventSeq)
+	}
+	events, err := ws.store.Event().List(ctx, store.NewEventQueryFilter().WithSeqs(seqs))
+	if err != nil {
+		tracer.Error(err).Log()
+		return WebhookRedrive{}, fmt.Errorf("failed to list events: %w", err)
+	}
+	bySeq := make(map[int64]model.Event, len(events))
+	for _, e := range events {
+		bySeq[e.Seq] = e
+	}
+
+	var result WebhookRedrive
+	for _, d := range failed {
+		event, ok := bySeq[d.EventSeq]
+		if !ok {
+			continue
+		}
+		result.Redriven++
+		disabled, err := ws.deliver(ctx, id, EventToClient(event), d.Attempts)
+		if err == nil {
+			result.Delivered++
+			continue
+		}
+		result.Failed++
+		if disabled {
+			result.Disabled = true
+			break
+		}
+	}
+
+	tracer.Success().
+		WithInt("redriven", result.Redriven).
+		WithInt("delivered", result.Delivered).
+		WithInt("failed", result.Failed).
+		Log()
+	return result, nil
+}
+
+// deliver publishes the event to the endpoint, unless disabled, and records the delivery, previously
+// attempted the given number of times. It returns whether the endpoint is disabled.
+func (ws *WebhookService) deliver(ctx context.Context, id string, event client.Event, attempts int) (bool, error) {
+	ws.mu.Lock()
+	endpoint, err := ws.endpoint(ctx, id)
+	ws.mu.Unlock()
+	if err != nil {
+		return false, err
+	}
+
+	var deliveryErr error
+	if endpoint.DisabledAt != nil {
+		deliveryErr = fmt.Errorf("endpoint %s disabled after %d failed deliveries in a row", id, endpoint.ConsecutiveFailures)
+	} else {
+		attempts++
+		deliveryErr = ws.endpoints[id].Publish(ctx, event)
+	}
+
+	delivery := model.WebhookDelivery{
+		EndpointID: id,
+		EventSeq:   event.Seq,
+		OrgID:      event.OrgID,
+		EventType:  event.Type,
+		Status:     model.WebhookDeliveryDelivered,
+		Attempts:   attempts,
+	}
+	if deliveryErr != nil {
+		delivery.Status = model.WebhookDeliveryFailed
+		delivery.LastError = deliveryErr.Error()
+	}
+	if _, err := ws.store.Webhook().SaveDelivery(ctx, delivery); err != nil {
+		return false, errors.Join(deliveryErr, fmt.Errorf("failed to record delivery: %w", err))
+	}
+	if endpoint.DisabledAt != nil {
+		return true, deliveryErr
+	}
+
+	disabled, err := ws.recordOutcome(ctx, id, deliveryErr)
+	if err != nil {
+		return disabled, errors.Join(deliveryErr, err)
+	}
+	return disabled, deliveryErr
+}
+
+// recordOutcome updates the consecutive failures of the endpoint after a delivery and disables it
+// once they reach the threshold. It returns whether the endpoint is disabled.
+func (ws *WebhookService) recordOutcome(ctx context.Context, id string, deliveryErr error) (bool, error) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	endpoint, err := ws.endpoint(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if deliveryErr == nil {
+		if endpoint.ConsecutiveFailures == 0 && endpoint.LastError == "" {
+			return endpoint.DisabledAt != nil, nil
+		}
+		endpoint.ConsecutiveFailures = 0
+		endpoint.LastError = ""
+	} else {
+		endpoint.ConsecutiveFailures++
+		endpoint.LastError = deliveryErr.Error()
+		if endpoint.DisabledAt == nil && endpoint.ConsecutiveFailures >= ws.threshold {
+			disabledAt := time.Now()
+			endpoint.DisabledAt = &disabledAt
+			ws.logger.WithContext(ctx).Operation("disable_webhook").
+				WithString("endpoint_id", id).
+				WithInt("consecutive_failures", endpoint.ConsecutiveFailures).
+				Build().
+				Error(deliveryErr).Log()
+		}
+	}
+	endpoint.UpdatedAt = time.Now()
+	if _, err := ws.store.Webhook().SaveEndpoint(ctx, *endpoint); err != nil {
+		return false, fmt.Errorf("failed to update endpoint: %w", err)
+	}
+	return endpoint.DisabledAt != nil, nil
+}
+
+// endpoint returns the delivery state of the endpoint, a new one when none was recorded yet.
+func (ws *WebhookService) endpoint(ctx context.Context, id string) (*model.WebhookEndpoint, error) {
+	endpoint, err := ws.store.Webhook().GetEndpoint(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return &model.WebhookEndpoint{ID: id}, nil
+		}
+		return nil, fmt.Errorf("failed to get endpoint: %w", err)
+	}
+	return endpoint, nil
+}
@@ -0,0 +1,231 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// webhookStore keeps the webhooks and the events in memory.
+type webhookStore struct {
+	*MockStore
+	webhooks *memoryWebhookStore
+	events   model.EventList
+}
+
+func (s *webhookStore) Webhook() store.Webhook {
+	return s.webhooks
+}
+
+func (s *webhookStore) Event() store.Event {
+	return &memoryEventStore{events: s.events}
+}
+
+type memoryEventStore struct {
+	events model.EventList
+}
+
+func (s *memoryEventStore) Append(context.Context, model.Event) (*model.Event, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *memoryEventStore) List(context.Context, *store.EventQueryFilter) (model.EventList, error) {
+	return s.events, nil
+}
+
+type deliveryKey struct {
+	endpoint string
+	seq      int64
+}
+
+// memoryWebhookStore ignores the filters: it lists every delivery, oldest first.
+type memoryWebhookStore struct {
+	endpoints  map[string]model.WebhookEndpoint
+	deliveries map[deliveryKey]model.WebhookDelivery
+	alerts     map[string]model.AlertWebhook
+}
+
+func (s *memoryWebhookStore) GetEndpoint(_ context.Context, id string) (*model.WebhookEndpoint, error) {
+	e, ok := s.endpoints[id]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return &e, nil
+}
+
+func (s *memoryWebhookStore) SaveEndpoint(_ context.Context, e model.WebhookEndpoint) (*model.WebhookEndpoint, error) {
+	s.endpoints[e.ID] = e
+	return &e, nil
+}
+
+func (s *memoryWebhookStore) SaveDelivery(_ context.Context, d model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	s.deliveries[deliveryKey{d.EndpointID, d.EventSeq}] = d
+	return &d, nil
+}
+
+func (s *memoryWebhookStore) ListDeliveries(context.Context, *store.WebhookDeliveryQueryFilter) (model.WebhookDeliveryList, error) {
+	var list model.WebhookDeliveryList
+	for _, d := range s.deliveries {
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].EventSeq < list[j].EventSeq })
+	return list, nil
+}
+
+func (s *memoryWebhookStore) CountDeliveries(_ context.Context, id string) (map[string]int64, error) {
+	counts := map[string]int64{}
+	for _, d := range s.deliveries {
+		if d.EndpointID == id {
+			counts[d.Status]++
+		}
+	}
+	return counts, nil
+}
+
+func (s *memoryWebhookStore) GetAlertWebhook(_ context.Context, orgID string) (*model.AlertWebhook, error) {
+	w, ok := s.alerts[orgID]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return &w, nil
+}
+
+func (s *memoryWebhookStore) SaveAlertWebhook(_ context.Context, w model.AlertWebhook) (*model.AlertWebhook, error) {
+	s.alerts[w.OrgID] = w
+	return &w, nil
+}
+
+func (s *memoryWebhookStore) DeleteAlertWebhook(_ context.Context, orgID string) error {
+	delete(s.alerts, orgID)
+	return nil
+}
+
+// consumer is a webhook failing while broken.
+type consumer struct {
+	broken bool
+	calls  int
+}
+
+func (c *consumer) Publish(context.Context, client.Event) error {
+	c.calls++
+	if c.broken {
+		return errors.New("webhook returned status 503")
+	}
+	return nil
+}
+
+var _ = Describe("webhook service", func() {
+	var (
+		ctx       context.Context
+		st        *webhookStore
+		srv       *service.WebhookService
+		hook      *consumer
+		publisher service.EventPublisher
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		st = &webhookStore{
+			MockStore: NewMockStore(),
+			webhooks: &memoryWebhookStore{
+				endpoints:  map[string]model.WebhookEndpoint{},
+				deliveries: map[deliveryKey]model.WebhookDelivery{},
+				alerts:     map[string]model.AlertWebhook{},
+			},
+		}
+		for seq := int64(1); seq <= 4; seq++ {
+			st.events = append(st.events, model.Event{Seq: seq, Type: "PlanCreated", OrgID: "org"})
+		}
+		srv = service.NewWebhookService(st, 2)
+		hook = &consumer{broken: true}
+		publisher = srv.Track(service.WebhookEvents, hook)
+	})
+
+	It("disables an endpoint failing too many deliveries in a row", func() {
+		for _, e := range st.events[:3] {
+			Expect(publisher.Publish(ctx, service.EventToClient(e))).NotTo(Succeed())
+		}
+
+		// the third delivery did not call the disabled endpoint
+		Expect(hook.calls).To(Equal(2))
+		endpoints, err := srv.Endpoints(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(1))
+		Expect(endpoints[0].Disabled()).To(BeTrue())
+		Expect(endpoints[0].Failed).To(Equal(int64(3)))
+		Expect(endpoints[0].LastError).To(ContainSubstring("503"))
+
+		deliveries, err := srv.Deliveries(ctx, service.WebhookEvents, "", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deliveries).To(HaveLen(3))
+		Expect(deliveries[2].Attempts).To(Equal(0))
+		Expect(deliveries[2].LastError).To(ContainSubstring("disabled"))
+	})
+
+	It("replays the failed deliveries once the consumer is fixed", func() {
+		for _, e := range st.events[:3] {
+			_ = publisher.Publish(ctx, service.EventToClient(e))
+		}
+		hook.broken = false
+
+		result, err := srv.Redrive(ctx, service.WebhookEvents)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(service.WebhookRedrive{Redriven: 3, Delivered: 3}))
+
+		status, err := srv.Endpoint(ctx, service.WebhookEvents)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Disabled()).To(BeFalse())
+		Expect(status.Delivered).To(Equal(int64(3)))
+		Expect(status.ConsecutiveFailures).To(BeZero())
+		Expect(st.webhooks.deliveries[deliveryKey{service.WebhookEvents, 1}].Attempts).To(Equal(2))
+
+		Expect(publisher.Publish(ctx, service.EventToClient(st.events[3]))).To(Succeed())
+	})
+
+	It("stops a redrive when the endpoint is disabled again", func() {
+		for _, e := range st.events {
+			_ = publisher.Publish(ctx, service.EventToClient(e))
+		}
+
+		result, err := srv.Redrive(ctx, service.WebhookEvents)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(service.WebhookRedrive{Redriven: 2, Failed: 2, Disabled: true}))
+	})
+
+	It("does not know the webhooks not tracked", func() {
+		_, err := srv.Redrive(ctx, "pager")
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+	})
+
+	It("keeps an alert webhook per organization", func() {
+		_, err := srv.SetAlertWebhook(ctx, "org", "admin", "https://alerts.example.com/org")
+		Expect(err).NotTo(HaveOccurred())
+
+		webhook, err := srv.AlertWebhook(ctx, "org")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(webhook.URL).To(Equal("https://alerts.example.com/org"))
+		Expect(webhook.UpdatedBy).To(Equal("admin"))
+		_, err = srv.AlertWebhook(ctx, "other")
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+
+		deleted, err := srv.DeleteAlertWebhook(ctx, "org")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted.URL).To(Equal("https://alerts.example.com/org"))
+		_, err = srv.AlertWebhook(ctx, "org")
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+	})
+
+	It("rejects an alert webhook that is not an http URL", func() {
+		for _, u := range []string{"", "alerts.example.com", "ftp://alerts.example.com", "https://"} {
+			_, err := srv.SetAlertWebhook(ctx, "org", "admin", u)
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}), u)
+		}
+	})
+})
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type Benchmark interface {
+	List(ctx context.Context, filter *BenchmarkQueryFilter) (model.BenchmarkSampleList, error)
+	// Save creates or replaces a sample.
+	Save(ctx context.Context, sample model.BenchmarkSample) (*model.BenchmarkSample, error)
+}
+
+type BenchmarkStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to Benchmark interface
+var _ Benchmark = (*BenchmarkStore)(nil)
+
+func NewBenchmarkStore(db *gorm.DB) Benchmark {
+	return &BenchmarkStore{db: db}
+}
+
+func (b *BenchmarkStore) List(ctx context.Context, filter *BenchmarkQueryFilter) (model.BenchmarkSampleList, error) {
+	var samples model.BenchmarkSampleList
+	tx := b.getDB(ctx).Model(&samples).Order("contributed_at")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&samples)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return samples, nil
+}
+
+func (b *BenchmarkStore) Save(ctx context.Context, sample model.BenchmarkSample) (*model.BenchmarkSample, error) {
+	result := b.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"vm_band", "network", "planned_weeks", "actual_weeks", "contributed_at"}),
+	}).Create(&sample)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &sample, nil
+}
+
+func (b *BenchmarkStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return b.db
+}
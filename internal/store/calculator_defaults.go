@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type CalculatorDefaults interface {
+	Get(ctx context.Context, orgID string) (*model.CalculatorDefaults, error)
+	// Save creates or replaces the defaults of the organization.
+	Save(ctx context.Context, defaults model.CalculatorDefaults) (*model.CalculatorDefaults, error)
+}
+
+type CalculatorDefaultsStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to CalculatorDefaults interface
+var _ CalculatorDefaults = (*CalculatorDefaultsStore)(nil)
+
+func NewCalculatorDefaultsStore(db *gorm.DB) CalculatorDefaults {
+	return &CalculatorDefaultsStore{db: db}
+}
+
+func (c *CalculatorDefaultsStore) Get(ctx context.Context, orgID string) (*model.CalculatorDefaults, error) {
+	var defaults model.CalculatorDefaults
+	result := c.getDB(ctx).First(&defaults, "org_id = ?", orgID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &defaults, nil
+}
+
+func (c *CalculatorDefaultsStore) Save(ctx context.Context, defaults model.CalculatorDefaults) (*model.CalculatorDefaults, error) {
+	result := c.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at", "updated_by"}),
+	}).Create(&defaults)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &defaults, nil
+}
+
+func (c *CalculatorDefaultsStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return c.db
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type ChangeRateJob interface {
+	Get(ctx context.Context, sourceID uuid.UUID) (*model.ChangeRateJob, error)
+	// Save creates the job of the source or replaces the existing one.
+	Save(ctx context.Context, job model.ChangeRateJob) (*model.ChangeRateJob, error)
+}
+
+type ChangeRateJobStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to ChangeRateJob interface
+var _ ChangeRateJob = (*ChangeRateJobStore)(nil)
+
+func NewChangeRateJobStore(db *gorm.DB) ChangeRateJob {
+	return &ChangeRateJobStore{db: db}
+}
+
+func (c *ChangeRateJobStore) Get(ctx context.Context, sourceID uuid.UUID) (*model.ChangeRateJob, error) {
+	var job model.ChangeRateJob
+	result := c.getDB(ctx).First(&job, "source_id = ?", sourceID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &job, nil
+}
+
+func (c *ChangeRateJobStore) Save(ctx context.Context, job model.ChangeRateJob) (*model.ChangeRateJob, error) {
+	result := c.getDB(ctx).Clauses(
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "source_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at"}),
+		},
+		clause.Returning{},
+	).Create(&job)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &job, nil
+}
+
+func (c *ChangeRateJobStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return c.db
+}
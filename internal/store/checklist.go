@@ -0,0 +1,166 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type ChecklistTemplate interface {
+	List(ctx context.Context, filter *ChecklistTemplateQueryFilter) (model.ChecklistTemplateList, error)
+	Create(ctx context.Context, template model.ChecklistTemplate) (*model.ChecklistTemplate, error)
+	Get(ctx context.Context, id uuid.UUID) (*model.ChecklistTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type Checklist interface {
+	List(ctx context.Context, filter *ChecklistQueryFilter) (model.ChecklistList, error)
+	Create(ctx context.Context, checklists model.ChecklistList) (model.ChecklistList, error)
+	Get(ctx context.Context, id uuid.UUID) (*model.Checklist, error)
+	Update(ctx context.Context, checklist model.Checklist) (*model.Checklist, error)
+}
+
+type ChecklistTemplateStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to ChecklistTemplate interface
+var _ ChecklistTemplate = (*ChecklistTemplateStore)(nil)
+
+func NewChecklistTemplateStore(db *gorm.DB) ChecklistTemplate {
+	return &ChecklistTemplateStore{db: db}
+}
+
+func (t *ChecklistTemplateStore) List(ctx context.Context, filter *ChecklistTemplateQueryFilter) (model.ChecklistTemplateList, error) {
+	var templates model.ChecklistTemplateList
+	tx := t.getDB(ctx).Model(&templates).Order("name")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&templates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return templates, nil
+}
+
+func (t *ChecklistTemplateStore) Create(ctx context.Context, template model.ChecklistTemplate) (*model.ChecklistTemplate, error) {
+	result := t.getDB(ctx).Clauses(clause.Returning{}).Create(&template)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, result.Error
+	}
+	return &template, nil
+}
+
+func (t *ChecklistTemplateStore) Get(ctx context.Context, id uuid.UUID) (*model.ChecklistTemplate, error) {
+	var template model.ChecklistTemplate
+	result := t.getDB(ctx).First(&template, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &template, nil
+}
+
+func (t *ChecklistTemplateStore) Delete(ctx context.Context, id uuid.UUID) error {
+	result := t.getDB(ctx).Unscoped().Delete(&model.ChecklistTemplate{}, "id = ?", id.String())
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return result.Error
+	}
+	return nil
+}
+
+func (t *ChecklistTemplateStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return t.db
+}
+
+type ChecklistStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to Checklist interface
+var _ Checklist = (*ChecklistStore)(nil)
+
+func NewChecklistStore(db *gorm.DB) Checklist {
+	return &ChecklistStore{db: db}
+}
+
+func (c *ChecklistStore) List(ctx context.Context, filter *ChecklistQueryFilter) (model.ChecklistList, error) {
+	var checklists model.ChecklistList
+	tx := c.getDB(ctx).Model(&checklists).Order("created_at, vm_id, template")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&checklists)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return checklists, nil
+}
+
+func (c *ChecklistStore) Create(ctx context.Context, checklists model.ChecklistList) (model.ChecklistList, error) {
+	if len(checklists) == 0 {
+		return checklists, nil
+	}
+	result := c.getDB(ctx).Clauses(clause.Returning{}).Create(&checklists)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, result.Error
+	}
+	return checklists, nil
+}
+
+func (c *ChecklistStore) Get(ctx context.Context, id uuid.UUID) (*model.Checklist, error) {
+	var checklist model.Checklist
+	result := c.getDB(ctx).First(&checklist, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &checklist, nil
+}
+
+func (c *ChecklistStore) Update(ctx context.Context, checklist model.Checklist) (*model.Checklist, error) {
+	result := c.getDB(ctx).Model(&checklist).Clauses(clause.Returning{}).Select("document", "updated_at").Updates(&checklist)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrRecordNotFound
+	}
+	return &checklist, nil
+}
+
+func (c *ChecklistStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return c.db
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type ContingencyPolicy interface {
+	Get(ctx context.Context, orgID string) (*model.ContingencyPolicy, error)
+	// Save creates or replaces the policy of the organization.
+	Save(ctx context.Context, policy model.ContingencyPolicy) (*model.ContingencyPolicy, error)
+}
+
+type ContingencyPolicyStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to ContingencyPolicy interface
+var _ ContingencyPolicy = (*ContingencyPolicyStore)(nil)
+
+func NewContingencyPolicyStore(db *gorm.DB) ContingencyPolicy {
+	return &ContingencyPolicyStore{db: db}
+}
+
+func (c *ContingencyPolicyStore) Get(ctx context.Context, orgID string) (*model.ContingencyPolicy, error) {
+	var policy model.ContingencyPolicy
+	result := c.getDB(ctx).First(&policy, "org_id = ?", orgID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &policy, nil
+}
+
+func (c *ContingencyPolicyStore) Save(ctx context.Context, policy model.ContingencyPolicy) (*model.ContingencyPolicy, error) {
+	result := c.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at", "updated_by"}),
+	}).Create(&policy)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &policy, nil
+}
+
+func (c *ContingencyPolicyStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return c.db
+}
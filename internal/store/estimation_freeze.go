@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// EstimationFreeze keeps the frozen estimations. They are immutable: there is no way to update or
+// delete one.
+type EstimationFreeze interface {
+	// List returns the freezes matching the filter, the most recent first.
+	List(ctx context.Context, filter *EstimationFreezeQueryFilter) (model.EstimationFreezeList, error)
+	Get(ctx context.Context, id uuid.UUID) (*model.EstimationFreeze, error)
+	Create(ctx context.Context, freeze model.EstimationFreeze) (*model.EstimationFreeze, error)
+}
+
+type EstimationFreezeStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to EstimationFreeze interface
+var _ EstimationFreeze = (*EstimationFreezeStore)(nil)
+
+func NewEstimationFreezeStore(db *gorm.DB) EstimationFreeze {
+	return &EstimationFreezeStore{db: db}
+}
+
+func (e *EstimationFreezeStore) List(ctx context.Context, filter *EstimationFreezeQueryFilter) (model.EstimationFreezeList, error) {
+	var freezes model.EstimationFreezeList
+	// the reports are only read one at a time
+	tx := e.getDB(ctx).Model(&freezes).Omit("report").Order("created_at DESC")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&freezes)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return freezes, nil
+}
+
+func (e *EstimationFreezeStore) Get(ctx context.Context, id uuid.UUID) (*model.EstimationFreeze, error) {
+	var freeze model.EstimationFreeze
+	result := e.getDB(ctx).First(&freeze, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &freeze, nil
+}
+
+func (e *EstimationFreezeStore) Create(ctx context.Context, freeze model.EstimationFreeze) (*model.EstimationFreeze, error) {
+	result := e.getDB(ctx).Clauses(clause.Returning{}).Create(&freeze)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, result.Error
+	}
+	return &freeze, nil
+}
+
+func (e *EstimationFreezeStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return e.db
+}
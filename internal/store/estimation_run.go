@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type EstimationRun interface {
+	// List returns the runs matching the filter, the most recent first.
+	List(ctx context.Context, filter *EstimationRunQueryFilter) (model.EstimationRunList, error)
+	Get(ctx context.Context, id uuid.UUID) (*model.EstimationRun, error)
+	Create(ctx context.Context, run model.EstimationRun) (*model.EstimationRun, error)
+}
+
+type EstimationRunStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to EstimationRun interface
+var _ EstimationRun = (*EstimationRunStore)(nil)
+
+func NewEstimationRunStore(db *gorm.DB) EstimationRun {
+	return &EstimationRunStore{db: db}
+}
+
+func (e *EstimationRunStore) List(ctx context.Context, filter *EstimationRunQueryFilter) (model.EstimationRunList, error) {
+	var runs model.EstimationRunList
+	tx := e.getDB(ctx).Model(&runs).Order("created_at DESC")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&runs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return runs, nil
+}
+
+func (e *EstimationRunStore) Get(ctx context.Context, id uuid.UUID) (*model.EstimationRun, error) {
+	var run model.EstimationRun
+	result := e.getDB(ctx).First(&run, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &run, nil
+}
+
+func (e *EstimationRunStore) Create(ctx context.Context, run model.EstimationRun) (*model.EstimationRun, error) {
+	result := e.getDB(ctx).Clauses(clause.Returning{}).Create(&run)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &run, nil
+}
+
+func (e *EstimationRunStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return e.db
+}
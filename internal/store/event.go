@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type Event interface {
+	// Append stores the event, setting its sequence number.
+	Append(ctx context.Context, event model.Event) (*model.Event, error)
+	// List returns the events matching the filter in sequence order.
+	List(ctx context.Context, filter *EventQueryFilter) (model.EventList, error)
+}
+
+type EventStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to Event interface
+var _ Event = (*EventStore)(nil)
+
+func NewEventStore(db *gorm.DB) Event {
+	return &EventStore{db: db}
+}
+
+// eventLogLock is the key of the advisory lock serializing the appends to the event log.
+const eventLogLock = 0x6576656e74 // "event"
+
+// Append takes the sequence number of the event under a lock held until the transaction commits, so
+// the events are committed in sequence order: a consumer paging after the last sequence number it
+// read can not miss an event committed later with a lower one. The lock is held from the append to
+// the commit of the transaction of the caller, if any.
+func (e *EventStore) Append(ctx context.Context, event model.Event) (*model.Event, error) {
+	appendEvent := func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", eventLogLock).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(&event).Error
+	}
+
+	var err error
+	if tx := FromContext(ctx); tx != nil {
+		err = appendEvent(tx)
+	} else {
+		err = e.db.WithContext(ctx).Transaction(appendEvent)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (e *EventStore) List(ctx context.Context, filter *EventQueryFilter) (model.EventList, error) {
+	var events model.EventList
+	tx := e.getDB(ctx).Model(&events).Order("seq ASC")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&events)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return events, nil
+}
+
+func (e *EventStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return e.db
+}
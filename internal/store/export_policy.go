@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type ExportPolicy interface {
+	Get(ctx context.Context, orgID string) (*model.ExportPolicy, error)
+	// Save creates or replaces the policy of the organization.
+	Save(ctx context.Context, policy model.ExportPolicy) (*model.ExportPolicy, error)
+}
+
+type ExportPolicyStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to ExportPolicy interface
+var _ ExportPolicy = (*ExportPolicyStore)(nil)
+
+func NewExportPolicyStore(db *gorm.DB) ExportPolicy {
+	return &ExportPolicyStore{db: db}
+}
+
+func (e *ExportPolicyStore) Get(ctx context.Context, orgID string) (*model.ExportPolicy, error) {
+	var policy model.ExportPolicy
+	result := e.getDB(ctx).First(&policy, "org_id = ?", orgID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &policy, nil
+}
+
+func (e *ExportPolicyStore) Save(ctx context.Context, policy model.ExportPolicy) (*model.ExportPolicy, error) {
+	result := e.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"watermark", "raw_exports", "benchmarks", "updated_at", "updated_by"}),
+	}).Create(&policy)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &policy, nil
+}
+
+func (e *ExportPolicyStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return e.db
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type GuardrailPolicy interface {
+	Get(ctx context.Context, orgID string) (*model.GuardrailPolicy, error)
+	// Save creates or replaces the policy of the organization.
+	Save(ctx context.Context, policy model.GuardrailPolicy) (*model.GuardrailPolicy, error)
+}
+
+type GuardrailPolicyStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to GuardrailPolicy interface
+var _ GuardrailPolicy = (*GuardrailPolicyStore)(nil)
+
+func NewGuardrailPolicyStore(db *gorm.DB) GuardrailPolicy {
+	return &GuardrailPolicyStore{db: db}
+}
+
+func (c *GuardrailPolicyStore) Get(ctx context.Context, orgID string) (*model.GuardrailPolicy, error) {
+	var policy model.GuardrailPolicy
+	result := c.getDB(ctx).First(&policy, "org_id = ?", orgID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &policy, nil
+}
+
+func (c *GuardrailPolicyStore) Save(ctx context.Context, policy model.GuardrailPolicy) (*model.GuardrailPolicy, error) {
+	result := c.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at", "updated_by"}),
+	}).Create(&policy)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &policy, nil
+}
+
+func (c *GuardrailPolicyStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return c.db
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type InventoryFieldSchema interface {
+	Get(ctx context.Context, orgID string) (*model.InventoryFieldSchema, error)
+	// Save creates or replaces the field schema of the organization.
+	Save(ctx context.Context, schema model.InventoryFieldSchema) (*model.InventoryFieldSchema, error)
+}
+
+type InventoryFieldSchemaStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to InventoryFieldSchema interface
+var _ InventoryFieldSchema = (*InventoryFieldSchemaStore)(nil)
+
+func NewInventoryFieldSchemaStore(db *gorm.DB) InventoryFieldSchema {
+	return &InventoryFieldSchemaStore{db: db}
+}
+
+func (c *InventoryFieldSchemaStore) Get(ctx context.Context, orgID string) (*model.InventoryFieldSchema, error) {
+	var schema model.InventoryFieldSchema
+	result := c.getDB(ctx).First(&schema, "org_id = ?", orgID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &schema, nil
+}
+
+func (c *InventoryFieldSchemaStore) Save(ctx context.Context, schema model.InventoryFieldSchema) (*model.InventoryFieldSchema, error) {
+	result := c.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at", "updated_by"}),
+	}).Create(&schema)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &schema, nil
+}
+
+func (c *InventoryFieldSchemaStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return c.db
+}
@@ -12,6 +12,7 @@ import (
 // JobRow represents a row from the river_job table
 type JobRow struct {
 	ID           int64              `gorm:"column:id;primaryKey"`
+	Kind         string             `gorm:"column:kind"`
 	State        rivertype.JobState `gorm:"column:state"`
 	ArgsJSON     []byte             `gorm:"column:args"`
 	MetadataJSON []byte             `gorm:"column:metadata"`
@@ -22,10 +23,19 @@ func (JobRow) TableName() string {
 	return "river_job"
 }
 
+// JobCount is the number of jobs of a kind in a state on a queue.
+type JobCount struct {
+	Queue string             `gorm:"column:queue" json:"queue"`
+	Kind  string             `gorm:"column:kind" json:"kind"`
+	State rivertype.JobState `gorm:"column:state" json:"state"`
+	Count int64              `gorm:"column:count" json:"count"`
+}
+
 // Job interface for job-related database operations
 type Job interface {
 	Get(ctx context.Context, id int64) (*JobRow, error)
 	UpdateMetadata(ctx context.Context, id int64, metadataJSON []byte) error
+	Count(ctx context.Context) ([]JobCount, error)
 }
 
 // JobStore implements the Job interface
@@ -68,6 +78,21 @@ func (s *JobStore) UpdateMetadata(ctx context.Context, id int64, metadataJSON []
 	return nil
 }
 
+// Count returns the number of jobs per queue, kind and state
+func (s *JobStore) Count(ctx context.Context) ([]JobCount, error) {
+	var counts []JobCount
+	result := s.getDB(ctx).Model(&JobRow{}).
+		Select("queue, kind, state, count(*) AS count").
+		Group("queue, kind, state").
+		Order("queue, kind, state").
+		Scan(&counts)
+	if result.Error != nil {
+		return nil, fmt.Errorf("counting jobs: %w", result.Error)
+	}
+
+	return counts, nil
+}
+
 func (s *JobStore) getDB(ctx context.Context) *gorm.DB {
 	tx := FromContext(ctx)
 	if tx != nil {
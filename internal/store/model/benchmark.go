@@ -0,0 +1,24 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// BenchmarkSample is the anonymized outcome of a completed plan. ID is a one-way hash of the plan
+// ID so a plan replaces its own contribution without the sample leading back to it.
+type BenchmarkSample struct {
+	ID            string    `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	VMBand        string    `gorm:"column:vm_band;not null;index:benchmark_samples_cohort"`
+	Network       string    `gorm:"not null;index:benchmark_samples_cohort"`
+	PlannedWeeks  float64   `gorm:"not null"`
+	ActualWeeks   float64   `gorm:"not null"`
+	ContributedAt time.Time `gorm:"not null"`
+}
+
+type BenchmarkSampleList []BenchmarkSample
+
+func (s BenchmarkSample) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CalculatorDefaults are the constants an organization sets in place of the built-in ones of the
+// calculators. Document holds the JSON encoded defaults.Constants.
+type CalculatorDefaults struct {
+	OrgID     string `gorm:"primaryKey;column:org_id"`
+	Document  []byte `gorm:"type:jsonb;not null"`
+	UpdatedAt time.Time
+	UpdatedBy string `gorm:"type:VARCHAR(255)"`
+}
+
+func (d CalculatorDefaults) String() string {
+	val, _ := json.Marshal(d)
+	return string(val)
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangeRateJob is the change rate sampling job of a source. Document holds the JSON encoded changerate.Job.
+type ChangeRateJob struct {
+	SourceID  uuid.UUID `gorm:"primaryKey;column:source_id;type:TEXT;"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	UpdatedAt *time.Time
+	Document  []byte `gorm:"type:jsonb;not null"`
+}
+
+func (j ChangeRateJob) String() string {
+	val, _ := json.Marshal(j)
+	return string(val)
+}
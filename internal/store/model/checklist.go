@@ -0,0 +1,46 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChecklistTemplate is a checklist template of an organization. Document holds the JSON
+// encoded checklist.Template.
+type ChecklistTemplate struct {
+	ID        uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	Name      string    `gorm:"not null;uniqueIndex:checklist_templates_org_id_name"`
+	OrgID     string    `gorm:"not null;uniqueIndex:checklist_templates_org_id_name"`
+	Username  string    `gorm:"type:VARCHAR(255)"`
+	Document  []byte    `gorm:"type:jsonb;not null"`
+}
+
+type ChecklistTemplateList []ChecklistTemplate
+
+func (t ChecklistTemplate) String() string {
+	val, _ := json.Marshal(t)
+	return string(val)
+}
+
+// Checklist is a checklist template instantiated for a VM of a wave of a plan. Document holds
+// the JSON encoded checklist.Checklist.
+type Checklist struct {
+	ID        uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	UpdatedAt *time.Time
+	PlanID    uuid.UUID `gorm:"not null;type:VARCHAR(255);uniqueIndex:checklists_plan_id_wave_vm_id_template"`
+	Wave      string    `gorm:"not null;uniqueIndex:checklists_plan_id_wave_vm_id_template"`
+	VMID      string    `gorm:"column:vm_id;not null;uniqueIndex:checklists_plan_id_wave_vm_id_template"`
+	Template  string    `gorm:"not null;uniqueIndex:checklists_plan_id_wave_vm_id_template"`
+	Document  []byte    `gorm:"type:jsonb;not null"`
+}
+
+type ChecklistList []Checklist
+
+func (c Checklist) String() string {
+	val, _ := json.Marshal(c)
+	return string(val)
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ContingencyPolicy is the contingency policy of an organization padding its estimations and plans.
+// Document holds the JSON encoded contingency.Policy.
+type ContingencyPolicy struct {
+	OrgID     string `gorm:"primaryKey;column:org_id"`
+	Document  []byte `gorm:"type:jsonb;not null"`
+	UpdatedAt time.Time
+	UpdatedBy string `gorm:"type:VARCHAR(255)"`
+}
+
+func (p ContingencyPolicy) String() string {
+	val, _ := json.Marshal(p)
+	return string(val)
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EstimationFreeze is an estimation of a cluster frozen for a statement of work: it is never changed
+// once created. Document holds the JSON encoded recording of the estimation, its inputs and outputs,
+// and Report the PDF report rendered when it was frozen. Digest is the digest of the document.
+type EstimationFreeze struct {
+	ID           uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	CreatedAt    time.Time `gorm:"not null;default:now()"`
+	OrgID        string    `gorm:"not null"`
+	Username     string    `gorm:"type:VARCHAR(255)"`
+	Name         string    `gorm:"not null"`
+	SOWID        string    `gorm:"column:sow_id;not null"`
+	AssessmentID uuid.UUID `gorm:"not null;type:VARCHAR(255)"`
+	ClusterID    string    `gorm:"not null"`
+	// PlanID is the plan the estimation was made for, nil when none.
+	PlanID        *uuid.UUID    `gorm:"type:VARCHAR(255)"`
+	TotalDuration time.Duration `gorm:"not null"`
+	Digest        string        `gorm:"not null"`
+	Document      []byte        `gorm:"type:jsonb;not null"`
+	Report        []byte        `gorm:"type:bytea;not null" json:"-"`
+}
+
+type EstimationFreezeList []EstimationFreeze
+
+func (f EstimationFreeze) String() string {
+	val, _ := json.Marshal(f)
+	return string(val)
+}
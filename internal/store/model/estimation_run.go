@@ -0,0 +1,31 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EstimationRun is an estimation of a cluster of an assessment kept to be listed and compared over
+// time. Document holds the JSON encoded recording of the run: its inputs, the calculators and the
+// result.
+type EstimationRun struct {
+	ID           uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	CreatedAt    time.Time `gorm:"not null;default:now()"`
+	OrgID        string    `gorm:"not null"`
+	Username     string    `gorm:"type:VARCHAR(255)"`
+	AssessmentID uuid.UUID `gorm:"not null;type:VARCHAR(255);index:estimation_runs_assessment_id_idx"`
+	ClusterID    string    `gorm:"not null;index:estimation_runs_assessment_id_idx"`
+	// PlannerVersion is the version of the build whose calculators ran.
+	PlannerVersion string
+	TotalDuration  time.Duration `gorm:"not null"`
+	Document       []byte        `gorm:"type:jsonb;not null"`
+}
+
+type EstimationRunList []EstimationRun
+
+func (r EstimationRun) String() string {
+	val, _ := json.Marshal(r)
+	return string(val)
+}
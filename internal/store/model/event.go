@@ -0,0 +1,26 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is a domain event, e.g. a plan created or the actuals of a wave recorded. Seq orders the
+// events of all organizations; Payload holds the JSON encoded event data.
+type Event struct {
+	Seq           int64     `gorm:"primaryKey;column:seq;autoIncrement"`
+	Type          string    `gorm:"not null"`
+	OrgID         string    `gorm:"not null"`
+	Username      string    `gorm:"not null"`
+	AggregateType string    `gorm:"not null"`
+	AggregateID   string    `gorm:"not null"`
+	Payload       []byte    `gorm:"type:jsonb;not null"`
+	OccurredAt    time.Time `gorm:"not null;default:now()"`
+}
+
+type EventList []Event
+
+func (e Event) String() string {
+	val, _ := json.Marshal(e)
+	return string(val)
+}
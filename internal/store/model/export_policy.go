@@ -0,0 +1,27 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ExportPolicy controls how the reports and data of the plans of an organization leave the planner.
+type ExportPolicy struct {
+	OrgID string `gorm:"primaryKey;column:org_id"`
+	// Watermark stamps the rendered reports, the SVG and HTML charts and the PDF reports, with the
+	// organization, the user and the time of the export.
+	Watermark bool `gorm:"not null"`
+	// RawExports allows the exports of the plan data as files for other tools and the inventories of
+	// the sources and assessments.
+	RawExports bool `gorm:"not null"`
+	// Benchmarks opts the organization in the contribution of the anonymized outcome of its
+	// completed plans to the benchmark dataset.
+	Benchmarks bool `gorm:"not null"`
+	UpdatedAt  time.Time
+	UpdatedBy  string `gorm:"type:VARCHAR(255)"`
+}
+
+func (p ExportPolicy) String() string {
+	val, _ := json.Marshal(p)
+	return string(val)
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// GuardrailPolicy is the guardrail policy of an organization checking the params of its estimations.
+// Document holds the JSON encoded guardrails.Policy.
+type GuardrailPolicy struct {
+	OrgID     string `gorm:"primaryKey;column:org_id"`
+	Document  []byte `gorm:"type:jsonb;not null"`
+	UpdatedAt time.Time
+	UpdatedBy string `gorm:"type:VARCHAR(255)"`
+}
+
+func (p GuardrailPolicy) String() string {
+	val, _ := json.Marshal(p)
+	return string(val)
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// InventoryFieldSchema is the set of extension fields registered by an organization for the VMs of its
+// inventories. Document holds the JSON encoded inventory.Schema.
+type InventoryFieldSchema struct {
+	OrgID     string `gorm:"primaryKey;column:org_id"`
+	Document  []byte `gorm:"type:jsonb;not null"`
+	UpdatedAt time.Time
+	UpdatedBy string `gorm:"type:VARCHAR(255)"`
+}
+
+func (s InventoryFieldSchema) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
 
 // RVToolsJobMetadata is stored in river_job.metadata to track progress and results.
 type RVToolsJobMetadata struct {
@@ -9,11 +13,32 @@ type RVToolsJobMetadata struct {
 	AssessmentID *uuid.UUID `json:"assessment_id,omitempty"` // set when completed
 }
 
+// PortfolioReportJobMetadata is stored in river_job.metadata by the portfolio report jobs. Status and
+// Error are shared with RVToolsJobMetadata, Report holds the JSON encoded portfolio.Report once rendered.
+type PortfolioReportJobMetadata struct {
+	Status string          `json:"status,omitempty"` // rendering
+	Error  string          `json:"error,omitempty"`
+	Report json.RawMessage `json:"report,omitempty"`
+}
+
+// EstimationJobMetadata is stored in river_job.metadata by the estimation jobs. Status and Error are
+// shared with RVToolsJobMetadata, Result holds the JSON encoded result once estimated.
+type EstimationJobMetadata struct {
+	Status string `json:"status,omitempty"` // estimating
+	Error  string `json:"error,omitempty"`
+	// Completed is the number of calculators run out of Total.
+	Completed int             `json:"completed,omitempty"`
+	Total     int             `json:"total,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+}
+
 // Job status constants
 const (
 	JobStatusPending    = "pending"
 	JobStatusParsing    = "parsing"
 	JobStatusValidating = "validating"
+	JobStatusRendering  = "rendering"
+	JobStatusEstimating = "estimating"
 	JobStatusCompleted  = "completed"
 	JobStatusFailed     = "failed"
 	JobStatusCancelled  = "cancelled"
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Plan is a migration program. Document holds the JSON encoded plan.Plan.
+type Plan struct {
+	ID        uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	UpdatedAt *time.Time
+	Name      string `gorm:"not null;uniqueIndex:plans_org_id_user_name"`
+	OrgID     string `gorm:"not null;uniqueIndex:plans_org_id_user_name;index:plans_org_id_idx"`
+	Username  string `gorm:"type:VARCHAR(255);uniqueIndex:plans_org_id_user_name"`
+	Document  []byte `gorm:"type:jsonb;not null"`
+}
+
+type PlanList []Plan
+
+func (p Plan) String() string {
+	val, _ := json.Marshal(p)
+	return string(val)
+}
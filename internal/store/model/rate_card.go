@@ -0,0 +1,31 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RateCard is a version of an organization rate card. Versions are never updated: a change
+// to a card creates the next version.
+type RateCard struct {
+	ID          uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	CreatedAt   time.Time `gorm:"not null;default:now()"`
+	Name        string    `gorm:"not null;uniqueIndex:rate_cards_org_id_name_version"`
+	Version     int       `gorm:"not null;uniqueIndex:rate_cards_org_id_name_version"`
+	OrgID       string    `gorm:"not null;uniqueIndex:rate_cards_org_id_name_version;index:rate_cards_org_id_idx"`
+	Username    string    `gorm:"type:VARCHAR(255)"`
+	Currency    string    `gorm:"not null;type:VARCHAR(3)"`
+	Region      string
+	ValidFrom   time.Time `gorm:"not null"`
+	ValidUntil  *time.Time
+	HourlyRates []byte `gorm:"type:jsonb;not null"`
+}
+
+type RateCardList []RateCard
+
+func (r RateCard) String() string {
+	val, _ := json.Marshal(r)
+	return string(val)
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Share is a read-only link to a report rendered from a plan. Only the SHA-256 hash of the
+// token is stored; Report holds the report as rendered when the link was created.
+type Share struct {
+	ID           uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	CreatedAt    time.Time `gorm:"not null;default:now()"`
+	PlanID       uuid.UUID `gorm:"not null;type:VARCHAR(255);index:shares_plan_id_idx"`
+	OrgID        string    `gorm:"not null"`
+	Username     string    `gorm:"type:VARCHAR(255)"`
+	TokenHash    string    `gorm:"not null;uniqueIndex:shares_token_hash"`
+	ContentType  string    `gorm:"not null"`
+	Report       []byte    `gorm:"not null" json:"-"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	RevokedAt    *time.Time
+	Views        int `gorm:"not null;default:0"`
+	LastViewedAt *time.Time
+}
+
+type ShareList []Share
+
+func (s Share) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
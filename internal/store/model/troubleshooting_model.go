@@ -0,0 +1,20 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TroubleshootingModel is the model trained by an organization to predict the troubleshooting time
+// of its VMs after their migration. Document holds the JSON encoded calculators.LinearTroubleshootingModel.
+type TroubleshootingModel struct {
+	OrgID     string `gorm:"primaryKey;column:org_id"`
+	Document  []byte `gorm:"type:jsonb;not null"`
+	UpdatedAt time.Time
+	UpdatedBy string `gorm:"type:VARCHAR(255)"`
+}
+
+func (m TroubleshootingModel) String() string {
+	val, _ := json.Marshal(m)
+	return string(val)
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VMPhaseActual is how long a phase of the migration of a VM of a plan actually took.
+type VMPhaseActual struct {
+	PlanID      uuid.UUID `gorm:"primaryKey;type:VARCHAR(255)"`
+	VMID        string    `gorm:"primaryKey;column:vm_id"`
+	Phase       string    `gorm:"primaryKey"`
+	OrgID       string    `gorm:"not null"`
+	Wave        string
+	StartedAt   time.Time `gorm:"not null"`
+	CompletedAt time.Time `gorm:"not null"`
+}
+
+type VMPhaseActualList []VMPhaseActual
+
+func (a VMPhaseActual) String() string {
+	val, _ := json.Marshal(a)
+	return string(val)
+}
+
+// Duration is the time the phase took.
+func (a VMPhaseActual) Duration() time.Duration {
+	return a.CompletedAt.Sub(a.StartedAt)
+}
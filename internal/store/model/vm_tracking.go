@@ -0,0 +1,28 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VMTracking is the migration status of a VM of a wave of a plan as last reported by MTV.
+type VMTracking struct {
+	PlanID      uuid.UUID `gorm:"primaryKey;type:VARCHAR(255)"`
+	Wave        string    `gorm:"primaryKey"`
+	VMID        string    `gorm:"primaryKey;column:vm_id"`
+	Name        string    `gorm:"not null"`
+	Phase       string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Error       string
+	UpdatedAt   time.Time
+}
+
+type VMTrackingList []VMTracking
+
+func (t VMTracking) String() string {
+	val, _ := json.Marshal(t)
+	return string(val)
+}
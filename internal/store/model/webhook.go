@@ -0,0 +1,65 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Delivery statuses of a WebhookDelivery.
+const (
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookEndpoint is the delivery state of a webhook the events are published to. The webhooks are
+// configured with the service, the ID names one of them, e.g. "events".
+type WebhookEndpoint struct {
+	ID                  string `gorm:"primaryKey;column:id"`
+	ConsecutiveFailures int    `gorm:"not null;default:0"`
+	LastError           string
+	// DisabledAt is set once the endpoint failed too many deliveries in a row, until it is redriven.
+	DisabledAt *time.Time
+	UpdatedAt  time.Time
+}
+
+func (e WebhookEndpoint) String() string {
+	val, _ := json.Marshal(e)
+	return string(val)
+}
+
+// AlertWebhook is the webhook an organization receives the alerts of its plans on, e.g. a KPI
+// breached, such as a Slack or Teams incoming webhook relay or an alert manager.
+type AlertWebhook struct {
+	OrgID     string `gorm:"primaryKey;column:org_id"`
+	URL       string `gorm:"column:url;not null"`
+	UpdatedAt time.Time
+	UpdatedBy string `gorm:"type:VARCHAR(255)"`
+}
+
+func (w AlertWebhook) String() string {
+	val, _ := json.Marshal(w)
+	return string(val)
+}
+
+// WebhookDelivery is the delivery of an event to a webhook endpoint. A redriven delivery is updated
+// in place, Attempts counts the calls to the endpoint.
+type WebhookDelivery struct {
+	ID         int64  `gorm:"primaryKey;autoIncrement"`
+	EndpointID string `gorm:"not null;uniqueIndex:webhook_deliveries_endpoint_id_event_seq_key"`
+	EventSeq   int64  `gorm:"not null;uniqueIndex:webhook_deliveries_endpoint_id_event_seq_key"`
+	OrgID      string `gorm:"not null"`
+	EventType  string `gorm:"not null"`
+	Status     string `gorm:"not null"`
+	Attempts   int    `gorm:"not null;default:0"`
+	// LastError is the failure reason of the last attempt, empty once delivered.
+	LastError string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDeliveryList []WebhookDelivery
+
+func (d WebhookDelivery) String() string {
+	val, _ := json.Marshal(d)
+	return string(val)
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -159,3 +160,363 @@ func (o *AssessmentQueryOptions) WithOrder(order string) *AssessmentQueryOptions
 	})
 	return o
 }
+
+type PlanQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewPlanQueryFilter() *PlanQueryFilter {
+	return &PlanQueryFilter{}
+}
+
+// Filter by username
+func (f *PlanQueryFilter) WithUsername(username string) *PlanQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("username = ?", username)
+	})
+	return f
+}
+
+// Filter by organization ID
+func (f *PlanQueryFilter) WithOrgID(orgID string) *PlanQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("org_id = ?", orgID)
+	})
+	return f
+}
+
+type RateCardQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewRateCardQueryFilter() *RateCardQueryFilter {
+	return &RateCardQueryFilter{}
+}
+
+// Filter by organization ID
+func (f *RateCardQueryFilter) WithOrgID(orgID string) *RateCardQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("org_id = ?", orgID)
+	})
+	return f
+}
+
+// Filter by name
+func (f *RateCardQueryFilter) WithName(name string) *RateCardQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("name = ?", name)
+	})
+	return f
+}
+
+type EventQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewEventQueryFilter() *EventQueryFilter {
+	return &EventQueryFilter{}
+}
+
+// Filter by organization ID
+func (f *EventQueryFilter) WithOrgID(orgID string) *EventQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("org_id = ?", orgID)
+	})
+	return f
+}
+
+// Filter by the user owning the aggregate, e.g. the owner of a plan
+func (f *EventQueryFilter) WithUsername(username string) *EventQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("username = ?", username)
+	})
+	return f
+}
+
+// Filter by aggregate, e.g. the events of a plan
+func (f *EventQueryFilter) WithAggregate(aggregateType, aggregateID string) *EventQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("aggregate_type = ? AND aggregate_id = ?", aggregateType, aggregateID)
+	})
+	return f
+}
+
+// Only events after the sequence number
+func (f *EventQueryFilter) WithAfter(seq int64) *EventQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("seq > ?", seq)
+	})
+	return f
+}
+
+// Limit the number of events
+func (f *EventQueryFilter) WithLimit(limit int) *EventQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Limit(limit)
+	})
+	return f
+}
+
+// Only the events of the sequence numbers
+func (f *EventQueryFilter) WithSeqs(seqs []int64) *EventQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("seq IN ?", seqs)
+	})
+	return f
+}
+
+type WebhookDeliveryQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewWebhookDeliveryQueryFilter() *WebhookDeliveryQueryFilter {
+	return &WebhookDeliveryQueryFilter{}
+}
+
+// Filter by endpoint ID
+func (f *WebhookDeliveryQueryFilter) WithEndpointID(id string) *WebhookDeliveryQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("endpoint_id = ?", id)
+	})
+	return f
+}
+
+// Filter by status, e.g. the failed deliveries
+func (f *WebhookDeliveryQueryFilter) WithStatus(status string) *WebhookDeliveryQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("status = ?", status)
+	})
+	return f
+}
+
+// Order the deliveries by event, the most recent first
+func (f *WebhookDeliveryQueryFilter) WithNewestFirst() *WebhookDeliveryQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("event_seq DESC")
+	})
+	return f
+}
+
+// Order the deliveries by event, the oldest first
+func (f *WebhookDeliveryQueryFilter) WithOldestFirst() *WebhookDeliveryQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("event_seq ASC")
+	})
+	return f
+}
+
+// Limit the number of deliveries
+func (f *WebhookDeliveryQueryFilter) WithLimit(limit int) *WebhookDeliveryQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Limit(limit)
+	})
+	return f
+}
+
+type EstimationRunQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewEstimationRunQueryFilter() *EstimationRunQueryFilter {
+	return &EstimationRunQueryFilter{}
+}
+
+// Filter by username
+func (f *EstimationRunQueryFilter) WithUsername(username string) *EstimationRunQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("username = ?", username)
+	})
+	return f
+}
+
+// Filter by organization ID
+func (f *EstimationRunQueryFilter) WithOrgID(orgID string) *EstimationRunQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("org_id = ?", orgID)
+	})
+	return f
+}
+
+// Filter by assessment ID
+func (f *EstimationRunQueryFilter) WithAssessmentID(assessmentID uuid.UUID) *EstimationRunQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("assessment_id = ?", assessmentID)
+	})
+	return f
+}
+
+// Filter by cluster ID
+func (f *EstimationRunQueryFilter) WithClusterID(clusterID string) *EstimationRunQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("cluster_id = ?", clusterID)
+	})
+	return f
+}
+
+// Limit the number of runs
+func (f *EstimationRunQueryFilter) WithLimit(limit int) *EstimationRunQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Limit(limit)
+	})
+	return f
+}
+
+type EstimationFreezeQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewEstimationFreezeQueryFilter() *EstimationFreezeQueryFilter {
+	return &EstimationFreezeQueryFilter{}
+}
+
+// Filter by organization ID
+func (f *EstimationFreezeQueryFilter) WithOrgID(orgID string) *EstimationFreezeQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("org_id = ?", orgID)
+	})
+	return f
+}
+
+// Filter by username
+func (f *EstimationFreezeQueryFilter) WithUsername(username string) *EstimationFreezeQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("username = ?", username)
+	})
+	return f
+}
+
+// Filter by statement of work ID
+func (f *EstimationFreezeQueryFilter) WithSOWID(sowID string) *EstimationFreezeQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("sow_id = ?", sowID)
+	})
+	return f
+}
+
+// Filter by assessment ID
+func (f *EstimationFreezeQueryFilter) WithAssessmentID(assessmentID uuid.UUID) *EstimationFreezeQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("assessment_id = ?", assessmentID)
+	})
+	return f
+}
+
+type ShareQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewShareQueryFilter() *ShareQueryFilter {
+	return &ShareQueryFilter{}
+}
+
+// Filter by plan ID
+func (f *ShareQueryFilter) WithPlanID(planID uuid.UUID) *ShareQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("plan_id = ?", planID)
+	})
+	return f
+}
+
+type ChecklistTemplateQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewChecklistTemplateQueryFilter() *ChecklistTemplateQueryFilter {
+	return &ChecklistTemplateQueryFilter{}
+}
+
+// Filter by organization ID
+func (f *ChecklistTemplateQueryFilter) WithOrgID(orgID string) *ChecklistTemplateQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("org_id = ?", orgID)
+	})
+	return f
+}
+
+type ChecklistQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewChecklistQueryFilter() *ChecklistQueryFilter {
+	return &ChecklistQueryFilter{}
+}
+
+// Filter by plan ID
+func (f *ChecklistQueryFilter) WithPlanID(planID uuid.UUID) *ChecklistQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("plan_id = ?", planID)
+	})
+	return f
+}
+
+// Filter by wave
+func (f *ChecklistQueryFilter) WithWave(wave string) *ChecklistQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("wave = ?", wave)
+	})
+	return f
+}
+
+type VMTrackingQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewVMTrackingQueryFilter() *VMTrackingQueryFilter {
+	return &VMTrackingQueryFilter{}
+}
+
+// Filter by plan ID
+func (f *VMTrackingQueryFilter) WithPlanID(planID uuid.UUID) *VMTrackingQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("plan_id = ?", planID)
+	})
+	return f
+}
+
+// Filter by wave
+func (f *VMTrackingQueryFilter) WithWave(wave string) *VMTrackingQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("wave = ?", wave)
+	})
+	return f
+}
+
+type BenchmarkQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewBenchmarkQueryFilter() *BenchmarkQueryFilter {
+	return &BenchmarkQueryFilter{}
+}
+
+// Filter by cohort
+func (f *BenchmarkQueryFilter) WithCohort(vmBand, network string) *BenchmarkQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("vm_band = ? AND network = ?", vmBand, network)
+	})
+	return f
+}
+
+type VMPhaseActualQueryFilter struct {
+	QueryFn []func(*gorm.DB) *gorm.DB
+}
+
+func NewVMPhaseActualQueryFilter() *VMPhaseActualQueryFilter {
+	return &VMPhaseActualQueryFilter{}
+}
+
+// Filter by organization
+func (f *VMPhaseActualQueryFilter) WithOrgID(orgID string) *VMPhaseActualQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("org_id = ?", orgID)
+	})
+	return f
+}
+
+// Filter by plan ID
+func (f *VMPhaseActualQueryFilter) WithPlanID(planID uuid.UUID) *VMPhaseActualQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("plan_id = ?", planID)
+	})
+	return f
+}
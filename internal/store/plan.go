@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type Plan interface {
+	List(ctx context.Context, filter *PlanQueryFilter) (model.PlanList, error)
+	Get(ctx context.Context, id uuid.UUID) (*model.Plan, error)
+	Create(ctx context.Context, plan model.Plan) (*model.Plan, error)
+	Update(ctx context.Context, plan model.Plan) (*model.Plan, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type PlanStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to Plan interface
+var _ Plan = (*PlanStore)(nil)
+
+func NewPlanStore(db *gorm.DB) Plan {
+	return &PlanStore{db: db}
+}
+
+func (p *PlanStore) List(ctx context.Context, filter *PlanQueryFilter) (model.PlanList, error) {
+	var plans model.PlanList
+	tx := p.getDB(ctx).Model(&plans).Order("created_at DESC")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&plans)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return plans, nil
+}
+
+func (p *PlanStore) Get(ctx context.Context, id uuid.UUID) (*model.Plan, error) {
+	var plan model.Plan
+	result := p.getDB(ctx).First(&plan, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &plan, nil
+}
+
+func (p *PlanStore) Create(ctx context.Context, plan model.Plan) (*model.Plan, error) {
+	result := p.getDB(ctx).Clauses(clause.Returning{}).Create(&plan)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, result.Error
+	}
+	return &plan, nil
+}
+
+func (p *PlanStore) Update(ctx context.Context, plan model.Plan) (*model.Plan, error) {
+	result := p.getDB(ctx).Model(&plan).Clauses(clause.Returning{}).Updates(&plan)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrRecordNotFound
+	}
+	return &plan, nil
+}
+
+func (p *PlanStore) Delete(ctx context.Context, id uuid.UUID) error {
+	result := p.getDB(ctx).Unscoped().Delete(&model.Plan{}, "id = ?", id.String())
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return result.Error
+	}
+	return nil
+}
+
+func (p *PlanStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return p.db
+}
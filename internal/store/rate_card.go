@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type RateCard interface {
+	List(ctx context.Context, filter *RateCardQueryFilter) (model.RateCardList, error)
+	Get(ctx context.Context, id uuid.UUID) (*model.RateCard, error)
+	Create(ctx context.Context, card model.RateCard) (*model.RateCard, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type RateCardStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to RateCard interface
+var _ RateCard = (*RateCardStore)(nil)
+
+func NewRateCardStore(db *gorm.DB) RateCard {
+	return &RateCardStore{db: db}
+}
+
+func (r *RateCardStore) List(ctx context.Context, filter *RateCardQueryFilter) (model.RateCardList, error) {
+	var cards model.RateCardList
+	tx := r.getDB(ctx).Model(&cards).Order("name, version DESC")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&cards)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return cards, nil
+}
+
+func (r *RateCardStore) Get(ctx context.Context, id uuid.UUID) (*model.RateCard, error) {
+	var card model.RateCard
+	result := r.getDB(ctx).First(&card, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &card, nil
+}
+
+func (r *RateCardStore) Create(ctx context.Context, card model.RateCard) (*model.RateCard, error) {
+	result := r.getDB(ctx).Clauses(clause.Returning{}).Create(&card)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, result.Error
+	}
+	return &card, nil
+}
+
+func (r *RateCardStore) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.getDB(ctx).Unscoped().Delete(&model.RateCard{}, "id = ?", id.String())
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *RateCardStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return r.db
+}
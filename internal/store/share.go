@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type Share interface {
+	List(ctx context.Context, filter *ShareQueryFilter) (model.ShareList, error)
+	Get(ctx context.Context, id uuid.UUID) (*model.Share, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*model.Share, error)
+	Create(ctx context.Context, share model.Share) (*model.Share, error)
+	// Revoke sets the revocation time of the share, keeping the first one.
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) (*model.Share, error)
+	// CountView increments the view count of the share.
+	CountView(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+type ShareStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to Share interface
+var _ Share = (*ShareStore)(nil)
+
+func NewShareStore(db *gorm.DB) Share {
+	return &ShareStore{db: db}
+}
+
+func (s *ShareStore) List(ctx context.Context, filter *ShareQueryFilter) (model.ShareList, error) {
+	var shares model.ShareList
+	tx := s.getDB(ctx).Model(&shares).Omit("report").Order("created_at DESC")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&shares)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return shares, nil
+}
+
+func (s *ShareStore) Get(ctx context.Context, id uuid.UUID) (*model.Share, error) {
+	return s.first(ctx, "id = ?", id)
+}
+
+func (s *ShareStore) GetByTokenHash(ctx context.Context, tokenHash string) (*model.Share, error) {
+	return s.first(ctx, "token_hash = ?", tokenHash)
+}
+
+func (s *ShareStore) Create(ctx context.Context, share model.Share) (*model.Share, error) {
+	result := s.getDB(ctx).Clauses(clause.Returning{}).Create(&share)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, result.Error
+	}
+	return &share, nil
+}
+
+func (s *ShareStore) Revoke(ctx context.Context, id uuid.UUID, at time.Time) (*model.Share, error) {
+	result := s.getDB(ctx).Model(&model.Share{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return s.Get(ctx, id)
+}
+
+func (s *ShareStore) CountView(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result := s.getDB(ctx).Model(&model.Share{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"views": gorm.Expr("views + 1"), "last_viewed_at": at})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *ShareStore) first(ctx context.Context, query string, arg any) (*model.Share, error) {
+	var share model.Share
+	result := s.getDB(ctx).First(&share, query, arg)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &share, nil
+}
+
+func (s *ShareStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
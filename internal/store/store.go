@@ -16,31 +16,88 @@ type Store interface {
 	Label() Label
 	Assessment() Assessment
 	Job() Job
+	Plan() Plan
+	RateCard() RateCard
+	ChangeRateJob() ChangeRateJob
+	Event() Event
+	Share() Share
+	ExportPolicy() ExportPolicy
+	ChecklistTemplate() ChecklistTemplate
+	Checklist() Checklist
+	VMTracking() VMTracking
+	Benchmark() Benchmark
+	VMPhaseActual() VMPhaseActual
+	TroubleshootingModel() TroubleshootingModel
+	ContingencyPolicy() ContingencyPolicy
+	CalculatorDefaults() CalculatorDefaults
+	EstimationRun() EstimationRun
+	EstimationFreeze() EstimationFreeze
+	GuardrailPolicy() GuardrailPolicy
+	InventoryFieldSchema() InventoryFieldSchema
+	Webhook() Webhook
 	Statistics(ctx context.Context) (model.InventoryStats, error)
 	Close() error
 }
 
 type DataStore struct {
-	agent      Agent
-	db         *gorm.DB
-	source     Source
-	imageInfra ImageInfra
-	privateKey PrivateKey
-	label      Label
-	assessment Assessment
-	job        Job
+	agent       Agent
+	db          *gorm.DB
+	source      Source
+	imageInfra  ImageInfra
+	privateKey  PrivateKey
+	label       Label
+	assessment  Assessment
+	job         Job
+	plan        Plan
+	rateCard    RateCard
+	changeRate  ChangeRateJob
+	event       Event
+	share       Share
+	export      ExportPolicy
+	templates   ChecklistTemplate
+	checklists  Checklist
+	tracking    VMTracking
+	benchmark   Benchmark
+	actuals     VMPhaseActual
+	models      TroubleshootingModel
+	contingency ContingencyPolicy
+	defaults    CalculatorDefaults
+	runs        EstimationRun
+	freezes     EstimationFreeze
+	guardrails  GuardrailPolicy
+	fields      InventoryFieldSchema
+	webhooks    Webhook
 }
 
 func NewStore(db *gorm.DB) Store {
 	return &DataStore{
-		agent:      NewAgentSource(db),
-		source:     NewSource(db),
-		imageInfra: NewImageInfraStore(db),
-		privateKey: NewCacheKeyStore(NewPrivateKey(db)),
-		label:      NewLabelStore(db),
-		assessment: NewAssessmentStore(db),
-		job:        NewJobStore(db),
-		db:         db,
+		agent:       NewAgentSource(db),
+		source:      NewSource(db),
+		imageInfra:  NewImageInfraStore(db),
+		privateKey:  NewCacheKeyStore(NewPrivateKey(db)),
+		label:       NewLabelStore(db),
+		assessment:  NewAssessmentStore(db),
+		job:         NewJobStore(db),
+		plan:        NewPlanStore(db),
+		rateCard:    NewRateCardStore(db),
+		changeRate:  NewChangeRateJobStore(db),
+		event:       NewEventStore(db),
+		share:       NewShareStore(db),
+		export:      NewExportPolicyStore(db),
+		templates:   NewChecklistTemplateStore(db),
+		checklists:  NewChecklistStore(db),
+		tracking:    NewVMTrackingStore(db),
+		benchmark:   NewBenchmarkStore(db),
+		actuals:     NewVMPhaseActualStore(db),
+		models:      NewTroubleshootingModelStore(db),
+		contingency: NewContingencyPolicyStore(db),
+		defaults:    NewCalculatorDefaultsStore(db),
+		runs:        NewEstimationRunStore(db),
+		freezes:     NewEstimationFreezeStore(db),
+		guardrails:  NewGuardrailPolicyStore(db),
+		fields:      NewInventoryFieldSchemaStore(db),
+		webhooks:    NewWebhookStore(db),
+		db:          db,
 	}
 }
 
@@ -76,6 +133,82 @@ func (s *DataStore) Job() Job {
 	return s.job
 }
 
+func (s *DataStore) Plan() Plan {
+	return s.plan
+}
+
+func (s *DataStore) RateCard() RateCard {
+	return s.rateCard
+}
+
+func (s *DataStore) ChangeRateJob() ChangeRateJob {
+	return s.changeRate
+}
+
+func (s *DataStore) Event() Event {
+	return s.event
+}
+
+func (s *DataStore) Share() Share {
+	return s.share
+}
+
+func (s *DataStore) ExportPolicy() ExportPolicy {
+	return s.export
+}
+
+func (s *DataStore) ChecklistTemplate() ChecklistTemplate {
+	return s.templates
+}
+
+func (s *DataStore) Checklist() Checklist {
+	return s.checklists
+}
+
+func (s *DataStore) VMTracking() VMTracking {
+	return s.tracking
+}
+
+func (s *DataStore) Benchmark() Benchmark {
+	return s.benchmark
+}
+
+func (s *DataStore) VMPhaseActual() VMPhaseActual {
+	return s.actuals
+}
+
+func (s *DataStore) TroubleshootingModel() TroubleshootingModel {
+	return s.models
+}
+
+func (s *DataStore) ContingencyPolicy() ContingencyPolicy {
+	return s.contingency
+}
+
+func (s *DataStore) CalculatorDefaults() CalculatorDefaults {
+	return s.defaults
+}
+
+func (s *DataStore) EstimationRun() EstimationRun {
+	return s.runs
+}
+
+func (s *DataStore) EstimationFreeze() EstimationFreeze {
+	return s.freezes
+}
+
+func (s *DataStore) GuardrailPolicy() GuardrailPolicy {
+	return s.guardrails
+}
+
+func (s *DataStore) InventoryFieldSchema() InventoryFieldSchema {
+	return s.fields
+}
+
+func (s *DataStore) Webhook() Webhook {
+	return s.webhooks
+}
+
 func (s *DataStore) Statistics(ctx context.Context) (model.InventoryStats, error) {
 	assessments, err := s.Assessment().List(ctx, NewAssessmentQueryFilter())
 	if err != nil {
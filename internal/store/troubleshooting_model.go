@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type TroubleshootingModel interface {
+	Get(ctx context.Context, orgID string) (*model.TroubleshootingModel, error)
+	// Save creates or replaces the model of the organization.
+	Save(ctx context.Context, m model.TroubleshootingModel) (*model.TroubleshootingModel, error)
+	Delete(ctx context.Context, orgID string) error
+}
+
+type TroubleshootingModelStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to TroubleshootingModel interface
+var _ TroubleshootingModel = (*TroubleshootingModelStore)(nil)
+
+func NewTroubleshootingModelStore(db *gorm.DB) TroubleshootingModel {
+	return &TroubleshootingModelStore{db: db}
+}
+
+func (t *TroubleshootingModelStore) Get(ctx context.Context, orgID string) (*model.TroubleshootingModel, error) {
+	var m model.TroubleshootingModel
+	result := t.getDB(ctx).First(&m, "org_id = ?", orgID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &m, nil
+}
+
+func (t *TroubleshootingModelStore) Save(ctx context.Context, m model.TroubleshootingModel) (*model.TroubleshootingModel, error) {
+	result := t.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at", "updated_by"}),
+	}).Create(&m)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &m, nil
+}
+
+func (t *TroubleshootingModelStore) Delete(ctx context.Context, orgID string) error {
+	result := t.getDB(ctx).Delete(&model.TroubleshootingModel{}, "org_id = ?", orgID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+func (t *TroubleshootingModelStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return t.db
+}
@@ -0,0 +1,66 @@
+package store
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type VMPhaseActual interface {
+	List(ctx context.Context, filter *VMPhaseActualQueryFilter) (model.VMPhaseActualList, error)
+	// Save creates or replaces the actuals of the phases of the VMs.
+	Save(ctx context.Context, actuals model.VMPhaseActualList) (model.VMPhaseActualList, error)
+}
+
+type VMPhaseActualStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to VMPhaseActual interface
+var _ VMPhaseActual = (*VMPhaseActualStore)(nil)
+
+func NewVMPhaseActualStore(db *gorm.DB) VMPhaseActual {
+	return &VMPhaseActualStore{db: db}
+}
+
+func (a *VMPhaseActualStore) List(ctx context.Context, filter *VMPhaseActualQueryFilter) (model.VMPhaseActualList, error) {
+	var actuals model.VMPhaseActualList
+	tx := a.getDB(ctx).Model(&actuals).Order("phase, started_at, vm_id")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&actuals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return actuals, nil
+}
+
+func (a *VMPhaseActualStore) Save(ctx context.Context, actuals model.VMPhaseActualList) (model.VMPhaseActualList, error) {
+	if len(actuals) == 0 {
+		return actuals, nil
+	}
+	result := a.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "plan_id"}, {Name: "vm_id"}, {Name: "phase"}},
+		DoUpdates: clause.AssignmentColumns([]string{"wave", "started_at", "completed_at"}),
+	}).Create(&actuals)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return actuals, nil
+}
+
+func (a *VMPhaseActualStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return a.db
+}
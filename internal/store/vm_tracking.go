@@ -0,0 +1,66 @@
+package store
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type VMTracking interface {
+	List(ctx context.Context, filter *VMTrackingQueryFilter) (model.VMTrackingList, error)
+	// Save creates or replaces the status of the VMs.
+	Save(ctx context.Context, tracking model.VMTrackingList) (model.VMTrackingList, error)
+}
+
+type VMTrackingStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to VMTracking interface
+var _ VMTracking = (*VMTrackingStore)(nil)
+
+func NewVMTrackingStore(db *gorm.DB) VMTracking {
+	return &VMTrackingStore{db: db}
+}
+
+func (t *VMTrackingStore) List(ctx context.Context, filter *VMTrackingQueryFilter) (model.VMTrackingList, error) {
+	var tracking model.VMTrackingList
+	tx := t.getDB(ctx).Model(&tracking).Order("name, vm_id")
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&tracking)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return tracking, nil
+}
+
+func (t *VMTrackingStore) Save(ctx context.Context, tracking model.VMTrackingList) (model.VMTrackingList, error) {
+	if len(tracking) == 0 {
+		return tracking, nil
+	}
+	result := t.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "plan_id"}, {Name: "wave"}, {Name: "vm_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "phase", "started_at", "completed_at", "error", "updated_at"}),
+	}).Create(&tracking)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return tracking, nil
+}
+
+func (t *VMTrackingStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return t.db
+}
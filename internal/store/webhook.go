@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type Webhook interface {
+	GetEndpoint(ctx context.Context, id string) (*model.WebhookEndpoint, error)
+	// SaveEndpoint creates or replaces the delivery state of the endpoint.
+	SaveEndpoint(ctx context.Context, endpoint model.WebhookEndpoint) (*model.WebhookEndpoint, error)
+	// SaveDelivery creates the delivery, or replaces the delivery of the event to the endpoint.
+	SaveDelivery(ctx context.Context, delivery model.WebhookDelivery) (*model.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, filter *WebhookDeliveryQueryFilter) (model.WebhookDeliveryList, error)
+	// CountDeliveries returns the number of deliveries to the endpoint by status.
+	CountDeliveries(ctx context.Context, endpointID string) (map[string]int64, error)
+	GetAlertWebhook(ctx context.Context, orgID string) (*model.AlertWebhook, error)
+	// SaveAlertWebhook creates or replaces the alert webhook of the organization.
+	SaveAlertWebhook(ctx context.Context, webhook model.AlertWebhook) (*model.AlertWebhook, error)
+	DeleteAlertWebhook(ctx context.Context, orgID string) error
+}
+
+type WebhookStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to Webhook interface
+var _ Webhook = (*WebhookStore)(nil)
+
+func NewWebhookStore(db *gorm.DB) Webhook {
+	return &WebhookStore{db: db}
+}
+
+func (w *WebhookStore) GetEndpoint(ctx context.Context, id string) (*model.WebhookEndpoint, error) {
+	var endpoint model.WebhookEndpoint
+	result := w.getDB(ctx).First(&endpoint, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &endpoint, nil
+}
+
+func (w *WebhookStore) SaveEndpoint(ctx context.Context, endpoint model.WebhookEndpoint) (*model.WebhookEndpoint, error) {
+	result := w.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"consecutive_failures", "last_error", "disabled_at", "updated_at"}),
+	}).Create(&endpoint)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &endpoint, nil
+}
+
+func (w *WebhookStore) SaveDelivery(ctx context.Context, delivery model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	result := w.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "endpoint_id"}, {Name: "event_seq"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "attempts", "last_error", "updated_at"}),
+	}).Create(&delivery)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &delivery, nil
+}
+
+func (w *WebhookStore) ListDeliveries(ctx context.Context, filter *WebhookDeliveryQueryFilter) (model.WebhookDeliveryList, error) {
+	var deliveries model.WebhookDeliveryList
+	tx := w.getDB(ctx).Model(&deliveries)
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	result := tx.Find(&deliveries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return deliveries, nil
+}
+
+func (w *WebhookStore) CountDeliveries(ctx context.Context, endpointID string) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	result := w.getDB(ctx).Model(&model.WebhookDelivery{}).
+		Select("status, count(*) AS count").
+		Where("endpoint_id = ?", endpointID).
+		Group("status").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		counts[r.Status] = r.Count
+	}
+	return counts, nil
+}
+
+func (w *WebhookStore) GetAlertWebhook(ctx context.Context, orgID string) (*model.AlertWebhook, error) {
+	var webhook model.AlertWebhook
+	result := w.getDB(ctx).First(&webhook, "org_id = ?", orgID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &webhook, nil
+}
+
+func (w *WebhookStore) SaveAlertWebhook(ctx context.Context, webhook model.AlertWebhook) (*model.AlertWebhook, error) {
+	result := w.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"url", "updated_at", "updated_by"}),
+	}).Create(&webhook)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &webhook, nil
+}
+
+func (w *WebhookStore) DeleteAlertWebhook(ctx context.Context, orgID string) error {
+	result := w.getDB(ctx).Delete(&model.AlertWebhook{}, "org_id = ?", orgID)
+	return result.Error
+}
+
+func (w *WebhookStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return w.db
+}
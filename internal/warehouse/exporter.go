@@ -0,0 +1,82 @@
+// Package warehouse exports the plans, inventory summaries, progress and actuals of all the
+// organizations as flat tables to an S3 bucket, in CSV or Parquet, so BI teams join the migration
+// data with their own datasets without calling the API.
+package warehouse
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+)
+
+// Uploader stores the exported files, e.g. in an S3 bucket.
+type Uploader interface {
+	Upload(ctx context.Context, key string, data []byte, contentType string) error
+}
+
+// Exporter exports the tables to an Uploader. Every export writes each table under a partition of
+// the day, <prefix>/<table>/dt=<YYYY-MM-DD>/<table>.<format>, replacing an earlier export of the day.
+type Exporter struct {
+	store    store.Store
+	uploader Uploader
+	format   Format
+	prefix   string
+}
+
+// NewExporter creates an exporter writing the tables in the format under the key prefix.
+func NewExporter(s store.Store, uploader Uploader, format Format, prefix string) *Exporter {
+	return &Exporter{store: s, uploader: uploader, format: format, prefix: prefix}
+}
+
+// Tables reads the exported tables from the store.
+func (e *Exporter) Tables(ctx context.Context) ([]Table, error) {
+	plans, err := e.store.Plan().List(ctx, store.NewPlanQueryFilter())
+	if err != nil {
+		return nil, fmt.Errorf("listing plans: %w", err)
+	}
+	planTable, progressTable, err := PlanTables(plans)
+	if err != nil {
+		return nil, err
+	}
+
+	assessments, err := e.store.Assessment().List(ctx, store.NewAssessmentQueryFilter())
+	if err != nil {
+		return nil, fmt.Errorf("listing assessments: %w", err)
+	}
+
+	actuals, err := e.store.VMPhaseActual().List(ctx, store.NewVMPhaseActualQueryFilter())
+	if err != nil {
+		return nil, fmt.Errorf("listing actuals: %w", err)
+	}
+
+	return []Table{planTable, InventorySummaryTable(assessments), progressTable, ActualsTable(actuals)}, nil
+}
+
+// Export exports the tables as of now and returns the keys written.
+func (e *Exporter) Export(ctx context.Context, now time.Time) ([]string, error) {
+	tables, err := e.Tables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(tables))
+	for _, t := range tables {
+		data, err := t.Encode(e.format)
+		if err != nil {
+			return keys, fmt.Errorf("encoding table %s: %w", t.Name, err)
+		}
+		key := Key(e.prefix, t.Name, e.format, now)
+		if err := e.uploader.Upload(ctx, key, data, e.format.ContentType()); err != nil {
+			return keys, fmt.Errorf("uploading table %s: %w", t.Name, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Key returns the key of the file of a table exported at the time, partitioned by day in UTC.
+func Key(prefix, table string, format Format, at time.Time) string {
+	return path.Join(prefix, table, "dt="+at.UTC().Format(time.DateOnly), table+"."+string(format))
+}
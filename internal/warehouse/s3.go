@@ -0,0 +1,39 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Uploader uploads the exported files to a bucket of an S3-compatible object store.
+type S3Uploader struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3Uploader creates an uploader to the bucket of the object store at the endpoint, e.g.
+// "s3.amazonaws.com". Empty keys fall back to the credentials of the environment (AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY) and then to the IAM role of the instance.
+func NewS3Uploader(endpoint, region, bucket, accessKey, secretKey string, useSSL bool) (*S3Uploader, error) {
+	creds := credentials.NewStaticV4(accessKey, secretKey, "")
+	if accessKey == "" {
+		creds = credentials.NewChainCredentials([]credentials.Provider{&credentials.EnvAWS{}, &credentials.IAM{}})
+	}
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: useSSL,
+		Region: region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &S3Uploader{client: client, bucket: bucket}, nil
+}
+
+func (u *S3Uploader) Upload(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := u.client.PutObject(ctx, u.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
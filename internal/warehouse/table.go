@@ -0,0 +1,143 @@
+package warehouse
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/marcboeker/go-duckdb/v2" // DuckDB driver
+)
+
+// Format is the file format the tables are exported in.
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat parses the name of a format, case-insensitively.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatCSV, FormatParquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown export format %q, expected %s or %s", s, FormatCSV, FormatParquet)
+	}
+}
+
+// ContentType returns the media type of the files of the format.
+func (f Format) ContentType() string {
+	if f == FormatParquet {
+		return "application/vnd.apache.parquet"
+	}
+	return "text/csv"
+}
+
+// Column is a column of a table. Type is the DuckDB type of the column, kept in the Parquet schema.
+type Column struct {
+	Name string
+	Type string
+}
+
+const (
+	typeText      = "VARCHAR"
+	typeInteger   = "BIGINT"
+	typeDecimal   = "DOUBLE"
+	typeTimestamp = "TIMESTAMPTZ"
+)
+
+// Table is a flat table of rows of formatted values, one value per column. An empty value is NULL.
+type Table struct {
+	Name    string
+	Columns []Column
+	Rows    [][]string
+}
+
+// Encode encodes the table in the format.
+func (t Table) Encode(f Format) ([]byte, error) {
+	if f == FormatParquet {
+		return t.Parquet()
+	}
+	return t.CSV()
+}
+
+// CSV renders the table as CSV, the rows under a header row of the column names.
+func (t Table) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = c.Name
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(t.Rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Parquet renders the table as Parquet, converting its CSV with an in-memory DuckDB instance so the
+// columns keep their types.
+func (t Table) Parquet() ([]byte, error) {
+	dir, err := os.MkdirTemp("", "warehouse-"+t.Name)
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	data, err := t.CSV()
+	if err != nil {
+		return nil, err
+	}
+	in := filepath.Join(dir, t.Name+".csv")
+	if err := os.WriteFile(in, data, 0o600); err != nil {
+		return nil, fmt.Errorf("writing %s: %w", in, err)
+	}
+
+	db, err := sql.Open("duckdb", "")
+	if err != nil {
+		return nil, fmt.Errorf("opening duckdb: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	columns := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		columns[i] = fmt.Sprintf("%s: %s", quote(c.Name), quote(c.Type))
+	}
+	out := filepath.Join(dir, t.Name+".parquet")
+	query := fmt.Sprintf("COPY (SELECT * FROM read_csv(%s, header = true, columns = {%s})) TO %s (FORMAT PARQUET)",
+		quote(in), strings.Join(columns, ", "), quote(out))
+	if _, err := db.Exec(query); err != nil {
+		return nil, fmt.Errorf("converting %s to parquet: %w", t.Name, err)
+	}
+	return os.ReadFile(out)
+}
+
+// quote quotes a string literal of DuckDB.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func formatInt(i int) string {
+	return strconv.Itoa(i)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
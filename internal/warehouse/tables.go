@@ -0,0 +1,131 @@
+package warehouse
+
+import (
+	"encoding/json"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/internal/util"
+)
+
+// The names of the exported tables.
+const (
+	TablePlans            = "plans"
+	TableInventorySummary = "inventory_summary"
+	TableProgress         = "progress"
+	TableActuals          = "actuals"
+)
+
+// PlanTables returns the plans table, one row per plan, and the progress table, one row per wave
+// with progress recorded. The planned end is NULL when the plan cannot be laid out.
+func PlanTables(plans model.PlanList) (Table, Table, error) {
+	planTable := Table{Name: TablePlans, Columns: []Column{
+		{"plan_id", typeText}, {"org_id", typeText}, {"name", typeText}, {"mode", typeText},
+		{"start", typeTimestamp}, {"planned_end", typeTimestamp}, {"waves", typeInteger},
+		{"vms", typeInteger}, {"disk_gb", typeDecimal}, {"created_at", typeTimestamp}, {"updated_at", typeTimestamp},
+	}}
+	progressTable := Table{Name: TableProgress, Columns: []Column{
+		{"plan_id", typeText}, {"org_id", typeText}, {"wave", typeText}, {"start", typeTimestamp}, {"end", typeTimestamp},
+		{"vms_migrated", typeInteger}, {"rollbacks", typeInteger}, {"p1_incidents", typeInteger},
+	}}
+
+	for _, p := range plans {
+		doc, err := service.PlanDocument(p)
+		if err != nil {
+			return Table{}, Table{}, err
+		}
+
+		var vms int
+		var diskGB float64
+		for _, w := range doc.Waves {
+			vms += w.VMs
+			diskGB += w.DiskGB
+		}
+		var plannedEnd string
+		if tl, err := doc.Timeline(); err == nil {
+			plannedEnd = formatTime(doc.Start.Add(tl.Total))
+		}
+		var updatedAt string
+		if p.UpdatedAt != nil {
+			updatedAt = formatTime(*p.UpdatedAt)
+		}
+		planTable.Rows = append(planTable.Rows, []string{
+			p.ID.String(), p.OrgID, doc.Name, string(doc.Mode),
+			formatTime(doc.Start), plannedEnd, formatInt(len(doc.Waves)),
+			formatInt(vms), formatFloat(diskGB), formatTime(p.CreatedAt), updatedAt,
+		})
+
+		for _, wp := range doc.Progress {
+			progressTable.Rows = append(progressTable.Rows, []string{
+				p.ID.String(), p.OrgID, wp.Wave, formatTime(wp.Start), formatTime(wp.End),
+				formatInt(wp.VMsMigrated), formatInt(wp.Rollbacks), formatInt(wp.P1Incidents),
+			})
+		}
+	}
+	return planTable, progressTable, nil
+}
+
+// InventorySummaryTable returns one row per assessment with the totals of its latest snapshot.
+// Assessments without snapshot or with an unreadable inventory are skipped.
+func InventorySummaryTable(assessments model.AssessmentList) Table {
+	t := Table{Name: TableInventorySummary, Columns: []Column{
+		{"assessment_id", typeText}, {"org_id", typeText}, {"name", typeText}, {"source_type", typeText},
+		{"snapshot_at", typeTimestamp}, {"clusters", typeInteger}, {"hosts", typeInteger}, {"datastores", typeInteger},
+		{"vms", typeInteger}, {"vms_migratable", typeInteger}, {"cpu_cores", typeInteger},
+		{"ram_gb", typeInteger}, {"disk_gb", typeInteger}, {"disk_count", typeInteger},
+	}}
+	for _, a := range assessments {
+		if len(a.Snapshots) == 0 {
+			continue
+		}
+		// List orders the snapshots by created_at, latest first
+		snapshot := a.Snapshots[0]
+		data, clusters, ok := inventoryData(snapshot.Inventory)
+		if !ok {
+			continue
+		}
+		t.Rows = append(t.Rows, []string{
+			a.ID.String(), a.OrgID, a.Name, a.SourceType,
+			formatTime(snapshot.CreatedAt), formatInt(clusters), formatInt(data.Infra.TotalHosts), formatInt(len(data.Infra.Datastores)),
+			formatInt(data.Vms.Total), formatInt(data.Vms.TotalMigratable), formatInt(data.Vms.CpuCores.Total),
+			formatInt(data.Vms.RamGB.Total), formatInt(data.Vms.DiskGB.Total), formatInt(data.Vms.DiskCount.Total),
+		})
+	}
+	return t
+}
+
+// inventoryData returns the vCenter-wide data of an inventory of any version and its number of clusters.
+func inventoryData(raw []byte) (api.InventoryData, int, bool) {
+	if util.GetInventoryVersion(raw) == model.SnapshotVersionV1 {
+		var data api.InventoryData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return api.InventoryData{}, 0, false
+		}
+		var clusters int
+		if data.Infra.TotalClusters != nil {
+			clusters = *data.Infra.TotalClusters
+		}
+		return data, clusters, true
+	}
+	var inventory api.Inventory
+	if err := json.Unmarshal(raw, &inventory); err != nil || inventory.Vcenter == nil {
+		return api.InventoryData{}, 0, false
+	}
+	return *inventory.Vcenter, len(inventory.Clusters), true
+}
+
+// ActualsTable returns one row per phase of a VM recorded as done.
+func ActualsTable(actuals model.VMPhaseActualList) Table {
+	t := Table{Name: TableActuals, Columns: []Column{
+		{"plan_id", typeText}, {"org_id", typeText}, {"wave", typeText}, {"vm_id", typeText}, {"phase", typeText},
+		{"started_at", typeTimestamp}, {"completed_at", typeTimestamp}, {"duration_minutes", typeDecimal},
+	}}
+	for _, a := range actuals {
+		t.Rows = append(t.Rows, []string{
+			a.PlanID.String(), a.OrgID, a.Wave, a.VMID, a.Phase,
+			formatTime(a.StartedAt), formatTime(a.CompletedAt), formatFloat(a.Duration().Minutes()),
+		})
+	}
+	return t
+}
@@ -0,0 +1,138 @@
+package warehouse
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+func testPlan(t *testing.T) model.Plan {
+	t.Helper()
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	doc, err := json.Marshal(plan.Plan{
+		Name:  "datacenter-exit",
+		Start: start,
+		Mode:  scheduling.ModeSerial,
+		Waves: []plan.Wave{
+			{Name: "wave-1", VMs: 100, DiskGB: 4000.5, Steps: []plan.Step{{Phase: "Cutover", Effort: 48 * time.Hour}}},
+			{Name: "wave-2", VMs: 50, DiskGB: 1000, Steps: []plan.Step{{Phase: "Cutover", Effort: 24 * time.Hour}}},
+		},
+		Progress: []plan.WaveProgress{{Wave: "wave-1", Start: start, End: start.AddDate(0, 0, 3), VMsMigrated: 98, Rollbacks: 2}},
+	})
+	if err != nil {
+		t.Fatalf("encoding plan: %v", err)
+	}
+	return model.Plan{
+		ID:        uuid.MustParse("6c3a3c1e-3c52-4c55-9d55-0f3c1f2b0a01"),
+		CreatedAt: start,
+		Name:      "datacenter-exit",
+		OrgID:     "org-1",
+		Document:  doc,
+	}
+}
+
+func TestPlanTables(t *testing.T) {
+	t.Parallel()
+
+	plans, progress, err := PlanTables(model.PlanList{testPlan(t)})
+	if err != nil {
+		t.Fatalf("building tables: %v", err)
+	}
+	want := []string{
+		"6c3a3c1e-3c52-4c55-9d55-0f3c1f2b0a01", "org-1", "datacenter-exit", "serial",
+		"2026-01-05T00:00:00Z", "2026-01-08T00:00:00Z", "2", "150", "5000.5", "2026-01-05T00:00:00Z", "",
+	}
+	if len(plans.Rows) != 1 || strings.Join(plans.Rows[0], ",") != strings.Join(want, ",") {
+		t.Errorf("plans = %v, want %v", plans.Rows, want)
+	}
+	if len(progress.Rows) != 1 || progress.Rows[0][2] != "wave-1" || progress.Rows[0][5] != "98" || progress.Rows[0][6] != "2" {
+		t.Errorf("progress = %v, want the progress of wave-1", progress.Rows)
+	}
+	for _, table := range []Table{plans, progress} {
+		for _, row := range table.Rows {
+			if len(row) != len(table.Columns) {
+				t.Errorf("%s: row of %d values for %d columns", table.Name, len(row), len(table.Columns))
+			}
+		}
+	}
+}
+
+func TestInventorySummaryTable(t *testing.T) {
+	t.Parallel()
+
+	inventory := []byte(`{"vcenter_id": "vc-1", "clusters": {"c1": {}, "c2": {}},
+		"vcenter": {"infra": {"totalHosts": 4, "datastores": [{}, {}]}, "vms": {"total": 12, "totalMigratable": 10, "cpuCores": {"total": 48}, "ramGB": {"total": 96}, "diskGB": {"total": 1200}, "diskCount": {"total": 20}}}}`)
+	assessments := model.AssessmentList{
+		{ID: uuid.New(), OrgID: "org-1", Name: "lab", SourceType: "rvtools", Snapshots: []model.Snapshot{{Inventory: inventory}}},
+		{ID: uuid.New(), OrgID: "org-1", Name: "empty", SourceType: "agent"},
+	}
+
+	table := InventorySummaryTable(assessments)
+	if len(table.Rows) != 1 {
+		t.Fatalf("got %d rows, want the assessment with a snapshot only", len(table.Rows))
+	}
+	if got := strings.Join(table.Rows[0][5:], ","); got != "2,4,2,12,10,48,96,1200,20" {
+		t.Errorf("totals = %s", got)
+	}
+}
+
+func TestTableEncode(t *testing.T) {
+	t.Parallel()
+
+	table := ActualsTable(model.VMPhaseActualList{{
+		PlanID:      uuid.MustParse("6c3a3c1e-3c52-4c55-9d55-0f3c1f2b0a01"),
+		OrgID:       "org-1",
+		Wave:        "wave-1",
+		VMID:        "vm-1",
+		Phase:       "Cutover",
+		StartedAt:   time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC),
+		CompletedAt: time.Date(2026, 1, 5, 9, 30, 0, 0, time.UTC),
+	}})
+
+	csv, err := table.Encode(FormatCSV)
+	if err != nil {
+		t.Fatalf("encoding CSV: %v", err)
+	}
+	want := "plan_id,org_id,wave,vm_id,phase,started_at,completed_at,duration_minutes\n" +
+		"6c3a3c1e-3c52-4c55-9d55-0f3c1f2b0a01,org-1,wave-1,vm-1,Cutover,2026-01-05T08:00:00Z,2026-01-05T09:30:00Z,90\n"
+	if string(csv) != want {
+		t.Errorf("CSV = %q, want %q", csv, want)
+	}
+
+	parquet, err := table.Encode(FormatParquet)
+	if err != nil {
+		t.Fatalf("encoding Parquet: %v", err)
+	}
+	if !bytes.HasPrefix(parquet, []byte("PAR1")) || !bytes.HasSuffix(parquet, []byte("PAR1")) {
+		t.Errorf("not a Parquet file: %q", parquet[:min(len(parquet), 16)])
+	}
+}
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+
+	// partitioned by the day in UTC
+	at := time.Date(2026, 1, 7, 0, 30, 0, 0, time.FixedZone("CET", 3600))
+	if key := Key("bi/planner", TableActuals, FormatParquet, at); key != "bi/planner/actuals/dt=2026-01-06/actuals.parquet" {
+		t.Errorf("key = %s", key)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	if f, err := ParseFormat("Parquet"); err != nil || f != FormatParquet {
+		t.Errorf("ParseFormat(Parquet) = %q, %v", f, err)
+	}
+	if _, err := ParseFormat("xlsx"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,32 @@
+// Package dnslabel derives and checks DNS-1123 labels, the names Kubernetes accepts for namespaces
+// and most objects.
+package dnslabel
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxLength is the maximum length of a DNS-1123 label.
+const MaxLength = 63
+
+var (
+	label    = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	notLabel = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// From derives a DNS-1123 label from a name, e.g. "finance-payroll" from "Finance/Payroll", truncated
+// to MaxLength. It is empty when the name has no letter or digit.
+func From(name string) string {
+	res := notLabel.ReplaceAllString(strings.ToLower(name), "-")
+	res = strings.Trim(res, "-")
+	if len(res) > MaxLength {
+		res = strings.TrimRight(res[:MaxLength], "-")
+	}
+	return res
+}
+
+// Valid reports whether the name is a DNS-1123 label.
+func Valid(name string) bool {
+	return len(name) <= MaxLength && label.MatchString(name)
+}
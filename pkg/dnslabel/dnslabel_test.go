@@ -0,0 +1,33 @@
+package dnslabel
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFrom(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"Wave 1":                       "wave-1",
+		"  SAP  ERP (prod) ":           "sap-erp-prod",
+		"***":                          "",
+		strings.Repeat("a", 62) + "-b": strings.Repeat("a", 62),
+	}
+	for name, want := range cases {
+		if got := From(name); got != want {
+			t.Errorf("From(%q): expected %q, got %q", name, want, got)
+		}
+		if want != "" && !Valid(want) {
+			t.Errorf("expected %q to be valid", want)
+		}
+	}
+}
+
+func TestValid(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"", "-wave", "wave-", "Wave", "wave_1", strings.Repeat("a", 64)} {
+		if Valid(name) {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
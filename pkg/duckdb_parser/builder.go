@@ -219,6 +219,14 @@ func (b *QueryBuilder) DiskTypeSummaryQuery(filters Filters) (string, error) {
 	return b.buildQuery("disk_type_summary_query", mustGetTemplate("disk_type_summary_query"), params)
 }
 
+// FolderQuery builds the per folder and vApp VM count query.
+func (b *QueryBuilder) FolderQuery(filters Filters) (string, error) {
+	params := queryParams{
+		ClusterFilter: filters.Cluster,
+	}
+	return b.buildQuery("folder_query", mustGetTemplate("folder_query"), params)
+}
+
 // ResourceTotalsQuery builds the resource totals query.
 func (b *QueryBuilder) ResourceTotalsQuery(filters Filters) (string, error) {
 	params := queryParams{
@@ -323,6 +331,11 @@ func (b *QueryBuilder) VCenterQuery() (string, error) {
 	return b.buildQuery("vcenter_query", mustGetTemplate("vcenter_query"), nil)
 }
 
+// VCenterVersionQuery builds the vCenter version query.
+func (b *QueryBuilder) VCenterVersionQuery() (string, error) {
+	return b.buildQuery("vcenter_version_query", mustGetTemplate("vcenter_version_query"), nil)
+}
+
 // VMsWithSharedDisksCountQuery builds the VMs with shared disks count query.
 func (b *QueryBuilder) VMsWithSharedDisksCountQuery(filters Filters) (string, error) {
 	params := queryParams{
@@ -7,6 +7,14 @@ import (
 	"strings"
 
 	"go.uber.org/zap"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/estimations/devices"
+	"github.com/kubev2v/migration-planner/pkg/estimations/firmware"
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/estimations/prerequisites"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+	"github.com/kubev2v/migration-planner/pkg/inventory/converters"
 )
 
 var stmtRegex = regexp.MustCompile(`(?s)(CREATE|INSERT|UPDATE|DROP|ALTER|WITH|INSTALL|LOAD|ATTACH|DETACH|DELETE).*?;`)
@@ -147,12 +155,9 @@ func (p *Parser) populateComplexity() error {
 	return nil
 }
 
-// validateVMs runs the configured VM validator (e.g., OPA) to populate the concerns table.
+// validateVMs populates the concerns table with the concerns of the configured VM validator (e.g.,
+// OPA), if any, and those the planner derives from the VMs themselves, see analysisConcerns.
 func (p *Parser) validateVMs(ctx context.Context) error {
-	if p.validator == nil {
-		return nil
-	}
-
 	vms, err := p.VMs(ctx, Filters{}, Options{})
 	if err != nil {
 		return fmt.Errorf("getting VMs for validation: %w", err)
@@ -160,6 +165,10 @@ func (p *Parser) validateVMs(ctx context.Context) error {
 
 	builder := NewConcernValuesBuilder()
 	for _, vm := range vms {
+		builder.Append(vm.ID, analysisConcerns(vm)...)
+		if p.validator == nil {
+			continue
+		}
 		concerns, err := p.validator.Validate(ctx, vm)
 		if err != nil {
 			zap.S().Warnw("validation failed for VM", "vm_id", vm.ID, "error", err)
@@ -170,3 +179,29 @@ func (p *Parser) validateVMs(ctx context.Context) error {
 
 	return InsertConcerns(ctx, p.db, builder)
 }
+
+// analysisConcerns returns the concerns of the analyses of the VM: the guest drivers the disks and
+// NICs need on the target, the firmware issues and the VMware Tools to upgrade. The VM is checked as
+// migrated cold: the CBT a warm migration needs is reported by the validation policies.
+func analysisConcerns(vm models.VM) []models.Concern {
+	v := converters.VMFromModel(vm)
+	found := devices.Analyze(v).Concerns()
+	for _, f := range firmware.Analyze([]inventory.VM{v}) {
+		found = append(found, f.Concern())
+	}
+	src := prerequisites.Source{Assignments: []method.Assignment{{Candidate: method.Candidate{VM: v}, Method: method.Cold}}}
+	for _, f := range prerequisites.NewChecker().Check(src) {
+		found = append(found, f.Concern())
+	}
+
+	var concerns []models.Concern
+	for _, c := range found {
+		concerns = append(concerns, models.Concern{
+			Id:         c.ID,
+			Label:      c.Label,
+			Category:   c.Category,
+			Assessment: c.Assessment,
+		})
+	}
+	return concerns
+}
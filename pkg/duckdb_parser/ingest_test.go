@@ -0,0 +1,97 @@
+package duckdb_parser
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// forkliftVMColumns are the columns of the VM table of the forklift SQLite databases the ingestion
+// reads, SecureBoot and TpmEnabled aside.
+const forkliftVMColumns = `ID TEXT, Name TEXT, Folder TEXT, Host TEXT, UUID TEXT, Firmware TEXT, PowerState TEXT,
+	ConnectionState TEXT, FaultToleranceEnabled INTEGER, CpuCount INTEGER, MemoryMB INTEGER, GuestName TEXT,
+	GuestNameFromVmwareTools TEXT, HostName TEXT, IpAddress TEXT, StorageUsed INTEGER, IsTemplate INTEGER,
+	ChangeTrackingEnabled INTEGER, DiskEnableUuid INTEGER, ToolsStatus TEXT, CpuHotAddEnabled INTEGER,
+	CpuHotRemoveEnabled INTEGER, CoresPerSocket INTEGER, MemoryHotAddEnabled INTEGER, BalloonedMemory INTEGER,
+	Disks TEXT, NICs TEXT`
+
+// createForkliftSqlite writes a forklift SQLite database with two VMs on a host of a cluster. The VM
+// table has the SecureBoot and TpmEnabled columns of the recent forklift models only with secureBoot.
+func createForkliftSqlite(t *testing.T, secureBoot bool) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "inventory.db")
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	require.NoError(t, err)
+	defer func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	}()
+
+	vmColumns, vmSecurity := forkliftVMColumns, ""
+	if secureBoot {
+		vmColumns += ", SecureBoot INTEGER, TpmEnabled INTEGER"
+		vmSecurity = ", 1, 1"
+	}
+	stmts := []string{
+		`CREATE TABLE About (InstanceUuid TEXT, APIVersion TEXT)`,
+		`INSERT INTO About VALUES ('vcenter-uuid', '8.0.2')`,
+		`CREATE TABLE Datacenter (ID TEXT, Name TEXT)`,
+		`INSERT INTO Datacenter VALUES ('datacenter-1', 'dc1')`,
+		`CREATE TABLE Folder (ID TEXT, Name TEXT, Parent TEXT, Datacenter TEXT)`,
+		`INSERT INTO Folder VALUES ('group-h1', 'host', '{"kind":"Datacenter","id":"datacenter-1"}', 'datacenter-1')`,
+		`INSERT INTO Folder VALUES ('group-v1', 'vm', '{"kind":"Datacenter","id":"datacenter-1"}', 'datacenter-1')`,
+		`CREATE TABLE Cluster (ID TEXT, Name TEXT, Parent TEXT)`,
+		`INSERT INTO Cluster VALUES ('domain-c1', 'cluster1', '{"kind":"Folder","id":"group-h1"}')`,
+		`CREATE TABLE Host (ID TEXT, Cluster TEXT, CpuCores INTEGER, CpuSockets INTEGER, MemoryBytes INTEGER,
+			Model TEXT, Vendor TEXT, ProductVersion TEXT, Datastores TEXT)`,
+		`INSERT INTO Host VALUES ('host-1', 'domain-c1', 16, 2, 68719476736, 'PowerEdge', 'Dell', '8.0.2', '[]')`,
+		`CREATE TABLE Datastore (ID TEXT, Name TEXT, Free INTEGER, Capacity INTEGER, MaintenanceMode TEXT, Type TEXT)`,
+		`CREATE TABLE Network (ID TEXT, Name TEXT, DVSwitch TEXT, VlanId TEXT)`,
+		`CREATE TABLE VM (` + vmColumns + `)`,
+	}
+	for _, id := range []string{"vm-1", "vm-2"} {
+		stmts = append(stmts, `INSERT INTO VM VALUES ('`+id+`', '`+strings.ToUpper(id)+`', 'group-v1', 'host-1', 'uuid-`+id+`', 'efi',
+			'poweredOn', 'connected', 0, 2, 4096, 'rhel9_64Guest', 'Red Hat Enterprise Linux 9', '', '', 0, 0,
+			1, 1, 'toolsOk', 0, 0, 1, 0, 0, '[]', '[]'`+vmSecurity+`)`)
+	}
+	for _, stmt := range stmts {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return path
+}
+
+func TestIngestSqlite_SecureBootAndTPM(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		secureBoot bool
+	}{
+		// the older forklift models have no SecureBoot and TpmEnabled columns
+		{name: "older forklift database", secureBoot: false},
+		{name: "recent forklift database", secureBoot: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parser, _, cleanup := setupTestParser(t, nil)
+			defer cleanup()
+
+			ctx := context.Background()
+			result, err := parser.IngestSqlite(ctx, createForkliftSqlite(t, tc.secureBoot))
+			require.NoError(t, err)
+			require.False(t, result.HasErrors(), "unexpected validation errors %+v", result)
+
+			vms, err := parser.VMs(ctx, Filters{}, Options{})
+			require.NoError(t, err)
+			require.Len(t, vms, 2)
+			for _, vm := range vms {
+				assert.Equal(t, tc.secureBoot, vm.SecureBoot, "secure boot of %s", vm.ID)
+				assert.Equal(t, tc.secureBoot, vm.TPM, "TPM of %s", vm.ID)
+				assert.Equal(t, "toolsOk", vm.ToolsStatus, "tools of %s", vm.ID)
+			}
+		})
+	}
+}
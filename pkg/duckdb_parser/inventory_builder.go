@@ -223,6 +223,14 @@ func (p *Parser) buildVMsData(ctx context.Context, filters Filters) (*inventory.
 		zap.S().Named("duckdb_parser").Warnf("Failed to get disk type summary: %v", err)
 	}
 
+	// Get the folder hierarchy
+	folders, err := p.FolderCounts(ctx, filters)
+	if err == nil {
+		vmsData.Folders = inventory.BuildFolderTree(folders)
+	} else {
+		zap.S().Named("duckdb_parser").Warnf("Failed to get folders: %v", err)
+	}
+
 	// Get migration issues (returns inventory types directly)
 	migrationWarnings, err := p.MigrationIssues(ctx, filters, "Warning")
 	if err == nil {
@@ -263,6 +271,7 @@ func (p *Parser) buildInfraData(ctx context.Context, filters Filters) (*inventor
 				CpuCores:   h.CpuCores,
 				CpuSockets: h.CpuSockets,
 				MemoryMB:   h.MemoryMB,
+				Version:    h.Version,
 			})
 		}
 		infraData.Hosts = hosts
@@ -350,6 +359,14 @@ func (p *Parser) buildInfraData(ctx context.Context, filters Filters) (*inventor
 		}
 	}
 
+	// The vCenter manages the hosts of every cluster
+	vcenterVersion, err := p.VCenterVersion(ctx)
+	if err == nil {
+		infraData.VCenterVersion = vcenterVersion
+	} else {
+		zap.S().Named("duckdb_parser").Warnf("Failed to get vCenter version: %v", err)
+	}
+
 	// Calculate overcommitment ratios (rounded to 2 decimal places)
 	allocatedVCPUs, err := p.AllocatedVCPUs(ctx, filters)
 	if err == nil {
@@ -85,7 +85,7 @@ var (
 	vInfoHeaders = []string{
 		"VM", "VM ID", "VI SDK UUID", "Host", "CPUs", "Memory", "Powerstate",
 		"Cluster", "Datacenter", "Template", "CBT", "Firmware", "Connection state",
-		"FT State", "EnableUUID", "Folder", "DNS Name", "Primary IP Address",
+		"FT State", "EnableUUID", "Folder", "vApp", "DNS Name", "Primary IP Address",
 		"In Use MiB", "HW version", "Provisioned MiB", "Resource pool",
 		"OS according to the configuration file", "OS according to the VMware Tools",
 		"VM UUID", "Total disk capacity MiB",
@@ -295,6 +295,48 @@ func TestBuildInventory_PowerStates(t *testing.T) {
 	assert.Equal(t, 1, inv.VCenter.VMs.PowerStates["poweredOff"])
 }
 
+func TestBuildInventory_Folders(t *testing.T) {
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	vms := []map[string]string{
+		{"VM": "vm-1", "VM ID": "vm-001", "Host": "esxi-host-1", "Cluster": "cluster1", "Datacenter": "dc1", "Folder": "/dc1/vm/Finance/Payroll"},
+		{"VM": "vm-2", "VM ID": "vm-002", "Host": "esxi-host-1", "Cluster": "cluster1", "Datacenter": "dc1", "Folder": "/dc1/vm/Finance/Payroll", "vApp": "web"},
+		{"VM": "vm-3", "VM ID": "vm-003", "Host": "esxi-host-1", "Cluster": "cluster1", "Datacenter": "dc1", "Folder": "/dc1/vm/HR"},
+		{"VM": "vm-4", "VM ID": "vm-004", "Host": "esxi-host-1", "Cluster": "cluster1", "Datacenter": "dc1", "Folder": "/dc1/vm"},
+	}
+	hosts := []map[string]string{
+		{"Datacenter": "dc1", "Cluster": "cluster1", "# Cores": "8", "# CPU": "2", "Object ID": "host-001", "# Memory": "32768", "Model": "ESXi", "Vendor": "VMware", "Host": "esxi-host-1", "Config status": "green"},
+	}
+
+	tmpFile := createTestExcel(t, defaultStandardSheets(vms, hosts)...)
+
+	ctx := context.Background()
+	_, err := parser.IngestRvTools(ctx, tmpFile)
+	require.NoError(t, err)
+
+	inv, err := parser.BuildInventory(ctx)
+	require.NoError(t, err)
+
+	// the VMs in the VM folder of the datacenter are in no folder
+	folders := inv.VCenter.VMs.Folders
+	require.Len(t, folders, 2)
+	assert.Equal(t, "Finance", folders[0].Name)
+	assert.Equal(t, 2, folders[0].VMs)
+	require.Len(t, folders[0].Children, 1)
+	payroll := folders[0].Children[0]
+	assert.Equal(t, "Finance/Payroll", payroll.Path)
+	require.Len(t, payroll.Children, 1)
+	assert.True(t, payroll.Children[0].VApp)
+	assert.Equal(t, "HR", folders[1].Path)
+
+	parsed, err := parser.VMs(ctx, Filters{VmId: "vm-002"}, Options{})
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+	assert.Equal(t, "/dc1/vm/Finance/Payroll", parsed[0].FolderPath)
+	assert.Equal(t, "web", parsed[0].VApp)
+}
+
 func TestBuildInventory_MigrationIssues(t *testing.T) {
 	// Use warning validator to populate concerns
 	parser, _, cleanup := setupTestParser(t, &testWarningValidator{})
@@ -831,3 +873,76 @@ func TestBuildInventory_VMsWithSharedDisksCount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, countCluster2, "VMs with shared disks in cluster2 only")
 }
+
+// TestBuildInventory_DeviceConcerns ingests Excel with vDisk data and asserts the disks needing
+// another guest driver on the target are reported as warnings, without validator.
+func TestBuildInventory_DeviceConcerns(t *testing.T) {
+	parser, _, cleanup := setupTestParser(t, nil)
+	defer cleanup()
+
+	vms := []map[string]string{
+		{"VM": "vm-1", "VM ID": "vm-001", "VI SDK UUID": "uuid-1", "Host": "esxi-host-1", "CPUs": "4", "Memory": "8192", "Powerstate": "poweredOn", "Cluster": "cluster1", "Datacenter": "dc1"},
+		{"VM": "vm-2", "VM ID": "vm-002", "VI SDK UUID": "uuid-2", "Host": "esxi-host-1", "CPUs": "2", "Memory": "4096", "Powerstate": "poweredOn", "Cluster": "cluster1", "Datacenter": "dc1"},
+	}
+	hosts := []map[string]string{
+		{"Datacenter": "dc1", "Cluster": "cluster1", "# Cores": "8", "# CPU": "2", "Object ID": "host-001", "# Memory": "32768", "Model": "ESXi", "Vendor": "VMware", "Host": "esxi-host-1", "Config status": "green"},
+	}
+	// vm-001: PVSCSI disk -> virtio, another driver; vm-002: IDE disk -> SATA, same driver
+	vDiskHeaders := []string{"VM ID", "Disk Key", "Unit #", "Path", "Capacity MiB", "Controller"}
+	disks := []map[string]string{
+		{"VM ID": "vm-001", "Disk Key": "2000", "Unit #": "0", "Path": "[ds1] vm-1/disk.vmdk", "Capacity MiB": "10240", "Controller": "VMware Paravirtual"},
+		{"VM ID": "vm-002", "Disk Key": "2001", "Unit #": "0", "Path": "[ds1] vm-2/disk.vmdk", "Capacity MiB": "8192", "Controller": "IDE 0"},
+	}
+
+	tmpFile := createTestExcel(t, append(defaultStandardSheets(vms, hosts), NewExcelSheet("vDisk", vDiskHeaders, disks))...)
+	defer func() { _ = os.Remove(tmpFile) }()
+
+	ctx := context.Background()
+	_, err := parser.IngestRvTools(ctx, tmpFile)
+	require.NoError(t, err)
+
+	inv, err := parser.BuildInventory(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, inv.VCenter.VMs.MigrationWarnings, 1)
+	assert.Equal(t, "devices.storage-driver", inv.VCenter.VMs.MigrationWarnings[0].ID)
+	assert.Equal(t, 1, inv.VCenter.VMs.MigrationWarnings[0].Count)
+	assert.Equal(t, 1, inv.VCenter.VMs.TotalMigratableWithWarnings)
+}
+
+func TestBuildInventory_PrerequisiteConcerns(t *testing.T) {
+	parser, _, cleanup := setupTestParser(t, nil)
+	defer cleanup()
+
+	vms := []map[string]string{
+		{"VM": "vm-1", "VM ID": "vm-001", "VI SDK UUID": "uuid-1", "VI SDK API Version": "6.0.0", "Host": "esxi-host-1", "CPUs": "4", "Memory": "8192", "Powerstate": "poweredOn", "Cluster": "cluster1", "Datacenter": "dc1"},
+		{"VM": "vm-2", "VM ID": "vm-002", "VI SDK UUID": "uuid-1", "VI SDK API Version": "6.0.0", "Host": "esxi-host-1", "CPUs": "2", "Memory": "4096", "Powerstate": "poweredOn", "Cluster": "cluster1", "Datacenter": "dc1"},
+	}
+	hosts := []map[string]string{
+		{"Datacenter": "dc1", "Cluster": "cluster1", "# Cores": "8", "# CPU": "2", "Object ID": "host-001", "# Memory": "32768", "Model": "ESXi", "Vendor": "VMware", "Host": "esxi-host-1", "ESX Version": "VMware ESXi 6.0.0 build-3620759", "Config status": "green"},
+	}
+	tools := []map[string]string{
+		{"VM": "vm-1", "VM ID": "vm-001", "Tools": "toolsOld"},
+		{"VM": "vm-2", "VM ID": "vm-002", "Tools": "toolsOk"},
+	}
+
+	sheets := defaultStandardSheets(vms, hosts)
+	sheets[0] = NewExcelSheet("vInfo", append(append([]string{}, vInfoHeaders...), "VI SDK API Version"), vms)
+	sheets[1] = NewExcelSheet("vHost", append(append([]string{}, vHostHeaders...), "ESX Version"), hosts)
+	tmpFile := createTestExcel(t, append(sheets, NewExcelSheet("vTools", []string{"VM", "VM ID", "Tools"}, tools))...)
+	defer func() { _ = os.Remove(tmpFile) }()
+
+	ctx := context.Background()
+	_, err := parser.IngestRvTools(ctx, tmpFile)
+	require.NoError(t, err)
+
+	inv, err := parser.BuildInventory(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "6.0.0", inv.VCenter.Infra.VCenterVersion)
+	require.Len(t, inv.VCenter.Infra.Hosts, 1)
+	assert.Equal(t, "VMware ESXi 6.0.0 build-3620759", inv.VCenter.Infra.Hosts[0].Version)
+	require.Len(t, inv.VCenter.VMs.MigrationWarnings, 1)
+	assert.Equal(t, "prerequisite.tools-outdated", inv.VCenter.VMs.MigrationWarnings[0].ID)
+	assert.Equal(t, 1, inv.VCenter.VMs.MigrationWarnings[0].Count)
+}
@@ -31,6 +31,7 @@ type Host struct {
 	MemoryMB   int    `db:"memoryMB" json:"memoryMB"`
 	Model      string `db:"model" json:"model"`
 	Vendor     string `db:"vendor" json:"vendor"`
+	Version    string `db:"version" json:"version"`
 }
 
 // Network represents a VMware network.
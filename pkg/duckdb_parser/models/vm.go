@@ -10,9 +10,13 @@ type VM struct {
 	ID                       string   `json:"id" db:"VM ID"`
 	Name                     string   `json:"name" db:"VM"`
 	Folder                   string   `json:"folder" db:"Folder ID"`
+	FolderPath               string   `json:"folderPath" db:"Folder"` // as reported by the source, e.g. "/DC1/vm/Finance"
+	VApp                     string   `json:"vApp" db:"vApp"`
 	Host                     string   `json:"host" db:"Host"`
 	UUID                     string   `json:"uuid" db:"SMBIOS UUID"`
 	Firmware                 string   `json:"firmware" db:"Firmware"`
+	SecureBoot               bool     `json:"secureBoot" db:"EFI Secure boot"`
+	TPM                      bool     `json:"tpm" db:"vTPM"`
 	PowerState               string   `json:"powerState" db:"Powerstate"`
 	ConnectionState          string   `json:"connectionState" db:"Connection state"`
 	CpuHotAddEnabled         bool     `json:"cpuHotAddEnabled" db:"Hot Add"`       // vcpu
@@ -25,6 +29,7 @@ type VM struct {
 	MemoryMB                 int32    `json:"memoryMB" db:"Memory"`                // vinfo
 	GuestName                string   `json:"guestName" db:"OS according to the configuration file"`
 	GuestNameFromVmwareTools string   `json:"guestNameFromVmwareTools" db:"OS according to the VMware Tools"`
+	ToolsStatus              string   `json:"toolsStatus" db:"Tools"` // vtools
 	HostName                 string   `json:"hostName" db:"DNS Name"`
 	BalloonedMemory          int32    `json:"balloonedMemory" db:"Ballooned"` // vmemory
 	IpAddress                string   `json:"ipAddress" db:"Primary IP Address"`
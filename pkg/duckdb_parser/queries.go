@@ -2,6 +2,8 @@ package duckdb_parser
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/georgysavva/scany/v2/sqlscan"
@@ -258,6 +260,31 @@ func (p *Parser) DiskTypeSummary(ctx context.Context, filters Filters) ([]invent
 	return results, rows.Err()
 }
 
+// FolderCounts returns the VM count and disk size per folder and vApp, the folder paths
+// normalized from the VM folder of their datacenter.
+func (p *Parser) FolderCounts(ctx context.Context, filters Filters) ([]inventory.FolderCount, error) {
+	q, err := p.builder.FolderQuery(filters)
+	if err != nil {
+		return nil, fmt.Errorf("building folder query: %w", err)
+	}
+	var results []inventory.FolderCount
+	rows, err := p.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("querying folders: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var datacenter string
+		var c inventory.FolderCount
+		if err := rows.Scan(&datacenter, &c.Folder, &c.VApp, &c.VMs, &c.DiskGB); err != nil {
+			return nil, fmt.Errorf("scanning folder: %w", err)
+		}
+		c.Folder = inventory.NormalizeFolder(c.Folder, datacenter)
+		results = append(results, c)
+	}
+	return results, rows.Err()
+}
+
 // TotalResources returns aggregated resource totals.
 func (p *Parser) TotalResources(ctx context.Context, filters Filters) (ResourceTotals, error) {
 	q, err := p.builder.ResourceTotalsQuery(filters)
@@ -423,6 +450,22 @@ func (p *Parser) VCenterID(ctx context.Context) (string, error) {
 	return vcenterID, nil
 }
 
+// VCenterVersion returns the version of the vCenter, empty when the source does not report it.
+func (p *Parser) VCenterVersion(ctx context.Context) (string, error) {
+	q, err := p.builder.VCenterVersionQuery()
+	if err != nil {
+		return "", fmt.Errorf("building vcenter version query: %w", err)
+	}
+	var version string
+	if err := p.db.QueryRowContext(ctx, q).Scan(&version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("scanning vcenter version: %w", err)
+	}
+	return version, nil
+}
+
 // DatacenterCount returns count of unique datacenters.
 func (p *Parser) DatacenterCount(ctx context.Context) (int, error) {
 	q, err := p.builder.DatacenterCountQuery()
@@ -506,9 +549,13 @@ func (p *Parser) readVMs(ctx context.Context, query string) ([]models.VM, error)
 			&vm.ID,
 			&vm.Name,
 			&vm.Folder,
+			&vm.FolderPath,
+			&vm.VApp,
 			&vm.Host,
 			&vm.UUID,
 			&vm.Firmware,
+			&vm.SecureBoot,
+			&vm.TPM,
 			&vm.PowerState,
 			&vm.ConnectionState,
 			&vm.FaultToleranceEnabled,
@@ -516,6 +563,7 @@ func (p *Parser) readVMs(ctx context.Context, query string) ([]models.VM, error)
 			&vm.MemoryMB,
 			&vm.GuestName,
 			&vm.GuestNameFromVmwareTools,
+			&vm.ToolsStatus,
 			&vm.HostName,
 			&vm.IpAddress,
 			&vm.StorageUsed,
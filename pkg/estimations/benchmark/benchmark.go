@@ -0,0 +1,147 @@
+package benchmark
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// MinSamples is the number of samples a cohort needs before it is summarized.
+const MinSamples = 5
+
+const week = 7 * 24 * time.Hour
+
+// vmBands are the upper bounds, exclusive, of the VM count bands. The last band is open.
+var vmBands = []struct {
+	below int
+	label string
+}{
+	{100, "<100 VMs"},
+	{500, "100–500 VMs"},
+	{1000, "500–1000 VMs"},
+	{5000, "1000–5000 VMs"},
+	{math.MaxInt, "5000+ VMs"},
+}
+
+// networkClasses are the upper bounds, inclusive, of the network classes in Mbps. The last class is open.
+var networkClasses = []struct {
+	upTo  float64
+	label string
+}{
+	{1000, "1GbE"},
+	{10000, "10GbE"},
+	{25000, "25GbE"},
+	{math.MaxFloat64, "40GbE+"},
+}
+
+// UnknownNetwork is the network class of the environments without known transfer rate.
+const UnknownNetwork = "unknown network"
+
+// Environment describes the source environment of a migration.
+type Environment struct {
+	VMs              int
+	TransferRateMbps float64
+}
+
+// Cohort groups similar environments.
+type Cohort struct {
+	VMBand  string
+	Network string
+}
+
+func (c Cohort) String() string {
+	return fmt.Sprintf("%s, %s", c.VMBand, c.Network)
+}
+
+// CohortOf returns the cohort of the environment.
+func CohortOf(env Environment) Cohort {
+	cohort := Cohort{Network: UnknownNetwork}
+	for _, b := range vmBands {
+		if env.VMs < b.below {
+			cohort.VMBand = b.label
+			break
+		}
+	}
+	if env.TransferRateMbps > 0 {
+		for _, c := range networkClasses {
+			if env.TransferRateMbps <= c.upTo {
+				cohort.Network = c.label
+				break
+			}
+		}
+	}
+	return cohort
+}
+
+// Sample is the anonymized outcome of a completed migration program.
+type Sample struct {
+	Cohort       Cohort
+	PlannedWeeks float64
+	ActualWeeks  float64
+}
+
+// NewSample anonymizes the outcome of a program: only the cohort of its environment is kept and
+// the durations are rounded to a tenth of a week.
+func NewSample(env Environment, planned, actual time.Duration) (Sample, error) {
+	if env.VMs <= 0 {
+		return Sample{}, fmt.Errorf("environment has no VM")
+	}
+	if planned <= 0 || actual <= 0 {
+		return Sample{}, fmt.Errorf("planned and actual durations must be positive")
+	}
+	return Sample{
+		Cohort:       CohortOf(env),
+		PlannedWeeks: weeks(planned),
+		ActualWeeks:  weeks(actual),
+	}, nil
+}
+
+// Summary is the outcome of the programs of a cohort.
+type Summary struct {
+	Cohort  Cohort
+	Samples int
+	// ActualWeeks and PlannedWeeks are the average durations of the programs.
+	ActualWeeks  float64
+	PlannedWeeks float64
+}
+
+// Summarize averages the samples of the cohort. It returns false when the cohort holds fewer than
+// MinSamples samples.
+func Summarize(cohort Cohort, samples []Sample) (Summary, bool) {
+	summary := Summary{Cohort: cohort}
+	var actual, planned float64
+	for _, s := range samples {
+		if s.Cohort != cohort {
+			continue
+		}
+		summary.Samples++
+		actual += s.ActualWeeks
+		planned += s.PlannedWeeks
+	}
+	if summary.Samples < MinSamples {
+		return Summary{}, false
+	}
+	summary.ActualWeeks = round(actual / float64(summary.Samples))
+	summary.PlannedWeeks = round(planned / float64(summary.Samples))
+	return summary, true
+}
+
+// String reads e.g. "environments like yours (500–1000 VMs, 10GbE) averaged 14 weeks".
+func (s Summary) String() string {
+	return fmt.Sprintf("environments like yours (%s) averaged %s weeks", s.Cohort, formatWeeks(s.ActualWeeks))
+}
+
+func weeks(d time.Duration) float64 {
+	return round(float64(d) / float64(week))
+}
+
+func round(v float64) float64 {
+	return math.Round(v*10) / 10
+}
+
+func formatWeeks(w float64) string {
+	if w == math.Trunc(w) {
+		return fmt.Sprintf("%.0f", w)
+	}
+	return fmt.Sprintf("%.1f", w)
+}
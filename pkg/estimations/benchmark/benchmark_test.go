@@ -0,0 +1,96 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCohortOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		env  Environment
+		want Cohort
+	}{
+		{name: "small environment on 1GbE", env: Environment{VMs: 40, TransferRateMbps: 1000}, want: Cohort{VMBand: "<100 VMs", Network: "1GbE"}},
+		{name: "band lower bound", env: Environment{VMs: 500, TransferRateMbps: 10000}, want: Cohort{VMBand: "500–1000 VMs", Network: "10GbE"}},
+		{name: "band upper bound", env: Environment{VMs: 999, TransferRateMbps: 2500}, want: Cohort{VMBand: "500–1000 VMs", Network: "10GbE"}},
+		{name: "large environment on 100GbE", env: Environment{VMs: 12000, TransferRateMbps: 100000}, want: Cohort{VMBand: "5000+ VMs", Network: "40GbE+"}},
+		{name: "unknown network", env: Environment{VMs: 1200}, want: Cohort{VMBand: "1000–5000 VMs", Network: UnknownNetwork}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := CohortOf(tt.env); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewSample(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewSample(Environment{VMs: 640, TransferRateMbps: 10000}, 12*week, 14*week+time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Cohort != (Cohort{VMBand: "500–1000 VMs", Network: "10GbE"}) {
+		t.Errorf("unexpected cohort %v", s.Cohort)
+	}
+	if s.PlannedWeeks != 12 || s.ActualWeeks != 14 {
+		t.Errorf("expected 12 planned and 14 actual weeks, got %v and %v", s.PlannedWeeks, s.ActualWeeks)
+	}
+
+	tests := []struct {
+		name    string
+		env     Environment
+		planned time.Duration
+		actual  time.Duration
+	}{
+		{name: "no VM", env: Environment{}, planned: week, actual: week},
+		{name: "no planned duration", env: Environment{VMs: 10}, actual: week},
+		{name: "no actual duration", env: Environment{VMs: 10}, planned: week},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := NewSample(tt.env, tt.planned, tt.actual); err == nil {
+				t.Errorf("expected error for case %q, got nil", tt.name)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	cohort := Cohort{VMBand: "500–1000 VMs", Network: "10GbE"}
+	other := Cohort{VMBand: "<100 VMs", Network: "1GbE"}
+	samples := []Sample{{Cohort: other, PlannedWeeks: 2, ActualWeeks: 3}}
+	for _, w := range []float64{12, 13, 14, 15} {
+		samples = append(samples, Sample{Cohort: cohort, PlannedWeeks: 12, ActualWeeks: w})
+	}
+
+	if _, ok := Summarize(cohort, samples); ok {
+		t.Fatalf("expected a cohort of %d samples not to be summarized", MinSamples-1)
+	}
+
+	samples = append(samples, Sample{Cohort: cohort, PlannedWeeks: 12, ActualWeeks: 16})
+	summary, ok := Summarize(cohort, samples)
+	if !ok {
+		t.Fatalf("expected a cohort of %d samples to be summarized", MinSamples)
+	}
+	if summary.Samples != 5 || summary.ActualWeeks != 14 || summary.PlannedWeeks != 12 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if got, want := summary.String(), "environments like yours (500–1000 VMs, 10GbE) averaged 14 weeks"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	summary.ActualWeeks = 13.5
+	if got, want := summary.String(), "environments like yours (500–1000 VMs, 10GbE) averaged 13.5 weeks"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
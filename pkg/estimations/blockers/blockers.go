@@ -0,0 +1,93 @@
+package blockers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/devices"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Kind is the reason a VM can not be migrated as-is.
+type Kind string
+
+const (
+	KindIndependentDisk Kind = "independent-disk"
+	KindSRIOV           Kind = "sriov"
+	KindUSB             Kind = "usb-passthrough"
+	KindEncrypted       Kind = "encrypted"
+)
+
+var labels = map[Kind]string{
+	KindIndependentDisk: "Independent persistent disk",
+	KindSRIOV:           "SR-IOV network adapter",
+	KindUSB:             "USB passthrough",
+	KindEncrypted:       "Encrypted VM",
+}
+
+// Blocker is a reason a VM can not be migrated as-is.
+type Blocker struct {
+	Kind Kind
+	// VM and Name are the ID and name of the VM.
+	VM      string
+	Name    string
+	Message string
+}
+
+// Concern returns the blocker as an assessment concern. The VM can not be migrated until it is
+// reworked, so the concern is critical.
+func (b Blocker) Concern() inventory.Concern {
+	return inventory.Concern{
+		ID:         "blockers." + string(b.Kind),
+		Label:      labels[b.Kind],
+		Category:   inventory.ConcernCategoryCritical,
+		Assessment: b.Message,
+	}
+}
+
+// Check returns the blockers of vm.
+func Check(vm inventory.VM) []Blocker {
+	var res []Blocker
+	add := func(kind Kind, format string, args ...any) {
+		res = append(res, Blocker{Kind: kind, VM: vm.ID, Name: vm.Name, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var independent []string
+	for i, d := range vm.Disks {
+		if strings.EqualFold(d.Mode, inventory.DiskModeIndependentPersistent) {
+			independent = append(independent, fmt.Sprintf("disk %d", i+1))
+		}
+	}
+	if len(independent) > 0 {
+		add(KindIndependentDisk, "%s in independent persistent mode, left out of the snapshots the copy is made from: switch them to dependent mode",
+			strings.Join(independent, ", "))
+	}
+
+	var sriov int
+	for _, n := range vm.NICs {
+		if devices.NICAdapter(n) == devices.AdapterSRIOV {
+			sriov++
+		}
+	}
+	if sriov > 0 {
+		add(KindSRIOV, "%d SR-IOV network adapters: replace them with VMXNET3 adapters or plan SR-IOV networks on the target", sriov)
+	}
+
+	if vm.USBDevices > 0 {
+		add(KindUSB, "%d USB devices passed through: remove them or move the devices to a network attached alternative", vm.USBDevices)
+	}
+
+	if vm.Encrypted {
+		add(KindEncrypted, "encrypted with vSphere VM Encryption: decrypt the VM before the migration and encrypt it on the target")
+	}
+	return res
+}
+
+// Detect returns the blockers of the VMs, in the order of the VMs.
+func Detect(vms []inventory.VM) []Blocker {
+	var res []Blocker
+	for _, vm := range vms {
+		res = append(res, Check(vm)...)
+	}
+	return res
+}
@@ -0,0 +1,91 @@
+package blockers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func kinds(blockers []Blocker) []Kind {
+	res := make([]Kind, 0, len(blockers))
+	for _, b := range blockers {
+		res = append(res, b.Kind)
+	}
+	return res
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		vm   inventory.VM
+		want []Kind
+	}{
+		{
+			name: "migrates as-is",
+			vm: inventory.VM{
+				Disks: []inventory.Disk{{Mode: "persistent"}, {Mode: inventory.DiskModeIndependentNonPersistent}},
+				NICs:  []inventory.NIC{{Adapter: "Vmxnet3"}},
+			},
+			want: []Kind{},
+		},
+		{
+			name: "independent persistent disk",
+			vm:   inventory.VM{Disks: []inventory.Disk{{Mode: "persistent"}, {Mode: "Independent_Persistent"}}},
+			want: []Kind{KindIndependentDisk},
+		},
+		{
+			name: "every blocker",
+			vm: inventory.VM{
+				Disks:      []inventory.Disk{{Mode: inventory.DiskModeIndependentPersistent}},
+				NICs:       []inventory.NIC{{Adapter: "Vmxnet3"}, {Adapter: "SR-IOV passthrough"}},
+				USBDevices: 2,
+				Encrypted:  true,
+			},
+			want: []Kind{KindIndependentDisk, KindSRIOV, KindUSB, KindEncrypted},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := kinds(Check(tc.vm)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+	vms := []inventory.VM{
+		{ID: "vm-1", Name: "web01"},
+		{ID: "vm-2", Name: "scanner", USBDevices: 1},
+		{ID: "vm-3", Name: "vault", Encrypted: true, Disks: []inventory.Disk{{Mode: "persistent"}, {Mode: inventory.DiskModeIndependentPersistent}}},
+	}
+
+	got := Detect(vms)
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 blockers, got %+v", got)
+	}
+	if got[0].VM != "vm-2" || got[0].Name != "scanner" || got[0].Kind != KindUSB {
+		t.Errorf("unexpected first blocker %+v", got[0])
+	}
+	if got[1].VM != "vm-3" || !strings.Contains(got[1].Message, "disk 2 in independent persistent mode") {
+		t.Errorf("expected the second disk of vault named, got %+v", got[1])
+	}
+
+	c := got[2].Concern()
+	if c.ID != "blockers.encrypted" || c.Label != "Encrypted VM" || c.Category != inventory.ConcernCategoryCritical {
+		t.Errorf("unexpected concern %+v", c)
+	}
+	vm := vms[2]
+	vm.Concerns = append(vm.Concerns, c)
+	if concern, ok := vm.CriticalConcern(); !ok || concern.ID != c.ID {
+		t.Errorf("expected the blocker to keep the VM from migrating as-is")
+	}
+}
@@ -0,0 +1,9 @@
+// Package blockers flags the VMs that can not be migrated as-is: independent persistent disks, which
+// are left out of snapshots and so of the copy, SR-IOV network adapters and USB devices passed
+// through to the guest, which have no equivalent on the target, and VMs encrypted with vSphere VM
+// Encryption, whose disks can not be read without the keys of the source.
+//
+// Detect is a validation pass over the per-VM inventory, run before the estimation so the plan
+// surfaces the VMs to rework before their wave. The blockers are reported as critical assessment
+// concerns.
+package blockers
@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Holiday is a non-working day. Date is midnight UTC of the day.
+type Holiday struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+// Freeze is a change freeze, e.g. over the year-end sales, during which no change is made. Start
+// and End are midnight UTC of its first and last day.
+type Freeze struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Name  string    `json:"name"`
+}
+
+// Calendar is the working calendar of a team: Saturdays, Sundays, the public holidays of its Region,
+// its company Holidays and the days of its change Freezes are not working days.
+type Calendar struct {
+	// Region is the preset of public holidays. Empty means none.
+	Region   Region    `json:"region,omitempty"`
+	Holidays []Holiday `json:"holidays,omitempty"`
+	Freezes  []Freeze  `json:"freezes,omitempty"`
+}
+
+// Validate checks the region is a known preset and the freezes end after they start.
+func (c Calendar) Validate() error {
+	for _, f := range c.Freezes {
+		if f.End.Before(f.Start) {
+			return fmt.Errorf("change freeze %q ends before it starts", f.Name)
+		}
+	}
+	if c.Region == "" {
+		return nil
+	}
+	if _, ok := presets[c.Region]; !ok {
+		return fmt.Errorf("unknown holiday region %q", c.Region)
+	}
+	return nil
+}
+
+// IsWorkingDay reports whether the day of t, in the location of t, is a working day.
+func (c Calendar) IsWorkingDay(t time.Time) bool {
+	switch t.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	day := Day(t)
+	for _, f := range c.Freezes {
+		if !day.Before(f.Start) && !day.After(f.End) {
+			return false
+		}
+	}
+	for _, h := range c.Holidays {
+		if h.Date.Equal(day) {
+			return false
+		}
+	}
+	for _, h := range RegionHolidays(c.Region, t.Year()) {
+		if h.Date.Equal(day) {
+			return false
+		}
+	}
+	return true
+}
+
+// HolidaysIn returns the regional and company holidays of a year in date order.
+func (c Calendar) HolidaysIn(year int) []Holiday {
+	holidays := RegionHolidays(c.Region, year)
+	for _, h := range c.Holidays {
+		if h.Date.Year() == year {
+			holidays = append(holidays, h)
+		}
+	}
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+// Day returns midnight UTC of the day of t in the location of t.
+func Day(t time.Time) time.Time {
+	return date(t.Year(), t.Month(), t.Day())
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,81 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegionHolidays(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		region Region
+		date   time.Time
+		name   string
+	}{
+		{region: RegionUS, date: date(2026, time.November, 26), name: "Thanksgiving Day"},
+		// July 4th 2026 is a Saturday
+		{region: RegionUS, date: date(2026, time.July, 3), name: "Independence Day"},
+		{region: RegionUS, date: date(2026, time.May, 25), name: "Memorial Day"},
+		{region: RegionDE, date: date(2026, time.April, 3), name: "Good Friday"},
+		{region: RegionDE, date: date(2026, time.May, 25), name: "Whit Monday"},
+		{region: RegionIN, date: date(2026, time.January, 26), name: "Republic Day"},
+		{region: RegionJP, date: date(2026, time.March, 20), name: "Vernal Equinox Day"},
+		{region: RegionJP, date: date(2026, time.September, 23), name: "Autumnal Equinox Day"},
+		// Constitution Memorial Day 2026 is a Sunday, the next two days are holidays already
+		{region: RegionJP, date: date(2026, time.May, 6), name: "Substitute Holiday"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(string(tc.region)+" "+tc.name, func(t *testing.T) {
+			t.Parallel()
+			for _, h := range RegionHolidays(tc.region, tc.date.Year()) {
+				if h.Date.Equal(tc.date) {
+					if h.Name != tc.name {
+						t.Errorf("expected %s on %s, got %s", tc.name, tc.date.Format(time.DateOnly), h.Name)
+					}
+					return
+				}
+			}
+			t.Errorf("expected %s on %s, got no holiday", tc.name, tc.date.Format(time.DateOnly))
+		})
+	}
+}
+
+func TestCalendar_IsWorkingDay(t *testing.T) {
+	t.Parallel()
+	c := Calendar{
+		Region:   RegionDE,
+		Holidays: []Holiday{{Date: date(2026, time.December, 24), Name: "Christmas Eve"}},
+	}
+
+	cases := []struct {
+		day  time.Time
+		want bool
+	}{
+		{day: time.Date(2026, time.April, 2, 15, 0, 0, 0, time.UTC), want: true},
+		{day: time.Date(2026, time.April, 3, 9, 0, 0, 0, time.UTC), want: false},
+		{day: date(2026, time.April, 4), want: false},
+		{day: date(2026, time.December, 24), want: false},
+		{day: date(2026, time.December, 28), want: true},
+	}
+	for _, tc := range cases {
+		if got := c.IsWorkingDay(tc.day); got != tc.want {
+			t.Errorf("expected working day %t on %s, got %t", tc.want, tc.day.Format(time.DateOnly), got)
+		}
+	}
+
+	if got := len(c.HolidaysIn(2026)); got != 10 {
+		t.Errorf("expected 10 holidays in 2026, got %d", got)
+	}
+}
+
+func TestCalendar_Validate(t *testing.T) {
+	t.Parallel()
+	if err := (Calendar{Region: RegionJP}).Validate(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	if err := (Calendar{Region: "FR"}).Validate(); err == nil {
+		t.Error("expected error for an unknown region, got nil")
+	}
+}
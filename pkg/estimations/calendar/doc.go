@@ -0,0 +1,10 @@
+// Package calendar tells the working days of a delivery team apart from weekends and holidays.
+//
+// A Calendar combines the public holidays of a built-in regional preset (US, DE, IN, JP) with the
+// company holidays imported from an ICS file and the change freezes of the organization. Calendars
+// are assigned to resource pools, so the manual phases of a multi-region program stop on the
+// holidays of the team doing them; see scheduling.WithPoolCalendar.
+//
+// Dates lands an estimation on real dates: its effort is worked during the working hours of a
+// Workday, e.g. 40 work hours started on a Monday morning end on Friday evening.
+package calendar
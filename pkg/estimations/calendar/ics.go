@@ -0,0 +1,151 @@
+package calendar
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxEventDays bounds the number of days of a single imported event.
+const maxEventDays = 366
+
+// ParseICS reads the holidays of an iCalendar (RFC 5545) file, as exported by Outlook or Google
+// Calendar: every day covered by an event is a holiday named after the event summary. Events spanning
+// several days cover their start day up to, but excluding, their end day unless they end after midnight.
+// Recurrence rules are not expanded: holiday calendars list each occurrence.
+func ParseICS(data []byte) ([]Holiday, error) {
+	lines, err := unfold(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, errors.New("not an iCalendar file")
+	}
+
+	var (
+		holidays   []Holiday
+		inEvent    bool
+		start, end string
+		summary    string
+	)
+	for _, line := range lines {
+		name, value, ok := property(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			inEvent, start, end, summary = true, "", "", ""
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			inEvent = false
+			days, err := eventDays(start, end)
+			if err != nil {
+				return nil, fmt.Errorf("event %q: %w", summary, err)
+			}
+			for _, d := range days {
+				holidays = append(holidays, Holiday{Date: d, Name: summary})
+			}
+		case !inEvent:
+		case name == "DTSTART":
+			start = value
+		case name == "DTEND":
+			end = value
+		case name == "SUMMARY":
+			summary = unescape(value)
+		}
+	}
+	return holidays, nil
+}
+
+// unfold splits the content in lines, joining the lines folded by a leading space or tab.
+func unfold(data []byte) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read iCalendar file: %w", err)
+	}
+	return lines, nil
+}
+
+// property splits a content line in its upper-case name, without parameters, and its value.
+func property(line string) (string, string, bool) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return "", "", false
+	}
+	name := line[:i]
+	if j := strings.Index(name, ";"); j >= 0 {
+		name = name[:j]
+	}
+	return strings.ToUpper(name), line[i+1:], true
+}
+
+// eventDays returns the days covered by an event.
+func eventDays(start, end string) ([]time.Time, error) {
+	if start == "" {
+		return nil, errors.New("missing DTSTART")
+	}
+	first, _, err := parseDate(start)
+	if err != nil {
+		return nil, err
+	}
+	if end == "" {
+		return []time.Time{first}, nil
+	}
+	last, midnight, err := parseDate(end)
+	if err != nil {
+		return nil, err
+	}
+	if !midnight {
+		last = last.AddDate(0, 0, 1)
+	}
+	if !last.After(first) {
+		return []time.Time{first}, nil
+	}
+	if last.Sub(first) > maxEventDays*24*time.Hour {
+		return nil, fmt.Errorf("event spans more than %d days", maxEventDays)
+	}
+
+	var days []time.Time
+	for d := first; d.Before(last); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	return days, nil
+}
+
+// parseDate reads the day of a DATE or DATE-TIME value and whether it is at midnight. Times are taken
+// as they are written: holidays are days of the team calendar, not instants.
+func parseDate(value string) (time.Time, bool, error) {
+	if len(value) < 8 {
+		return time.Time{}, false, fmt.Errorf("invalid date %q", value)
+	}
+	d, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date %q", value)
+	}
+	if len(value) == 8 {
+		return d, true, nil
+	}
+	if _, err := time.Parse("20060102T150405", strings.TrimSuffix(value, "Z")); err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time %q", value)
+	}
+	return d, strings.HasPrefix(value[8:], "T000000"), nil
+}
+
+func unescape(s string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(s)
+}
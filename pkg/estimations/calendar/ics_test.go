@@ -0,0 +1,77 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const companyCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example Corp//Holidays//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20261224\r\n" +
+	"DTEND;VALUE=DATE:20261227\r\n" +
+	"SUMMARY:Winter shutdown\\, all sites\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20260612T090000Z\r\n" +
+	"DTEND:20260612T170000Z\r\n" +
+	"SUMMARY:Company\r\n" +
+	"  day\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20260101\r\n" +
+	"SUMMARY:New Year\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICS(t *testing.T) {
+	t.Parallel()
+	holidays, err := ParseICS([]byte(companyCalendar))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	want := []Holiday{
+		{Date: date(2026, time.December, 24), Name: "Winter shutdown, all sites"},
+		{Date: date(2026, time.December, 25), Name: "Winter shutdown, all sites"},
+		{Date: date(2026, time.December, 26), Name: "Winter shutdown, all sites"},
+		{Date: date(2026, time.June, 12), Name: "Company day"},
+		{Date: date(2026, time.January, 1), Name: "New Year"},
+	}
+	if len(holidays) != len(want) {
+		t.Fatalf("expected %d holidays, got %d: %+v", len(want), len(holidays), holidays)
+	}
+	for i, h := range holidays {
+		if !h.Date.Equal(want[i].Date) || h.Name != want[i].Name {
+			t.Errorf("expected %+v, got %+v", want[i], h)
+		}
+	}
+}
+
+func TestParseICS_Errors(t *testing.T) {
+	t.Parallel()
+	event := func(lines ...string) string {
+		return "BEGIN:VCALENDAR\nBEGIN:VEVENT\n" + strings.Join(lines, "\n") + "\nEND:VEVENT\nEND:VCALENDAR\n"
+	}
+	cases := []struct {
+		name string
+		data string
+	}{
+		{name: "not a calendar", data: "wave-1,Pre-Copy"},
+		{name: "missing start", data: event("SUMMARY:Holiday")},
+		{name: "invalid start", data: event("DTSTART;VALUE=DATE:2026-12-24")},
+		{name: "endless event", data: event("DTSTART;VALUE=DATE:20260101", "DTEND;VALUE=DATE:20300101")},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := ParseICS([]byte(tc.data)); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
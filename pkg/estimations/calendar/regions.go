@@ -0,0 +1,166 @@
+package calendar
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Region is a preset of public holidays.
+type Region string
+
+const (
+	// RegionUS are the US federal holidays, moved to the Friday or Monday when they fall on a weekend.
+	RegionUS Region = "US"
+	// RegionDE are the public holidays observed in all German states.
+	RegionDE Region = "DE"
+	// RegionIN are the national holidays of India. Festivals following the lunar calendar vary per
+	// state and company and are expected to be imported from the company calendar.
+	RegionIN Region = "IN"
+	// RegionJP are the national holidays of Japan, with substitute holidays for those falling on a Sunday.
+	RegionJP Region = "JP"
+)
+
+var presets = map[Region]func(year int) []Holiday{
+	RegionUS: usHolidays,
+	RegionDE: deHolidays,
+	RegionIN: inHolidays,
+	RegionJP: jpHolidays,
+}
+
+// RegionHolidays returns the public holidays of a region in a year in date order. Unknown and empty
+// regions have none.
+func RegionHolidays(r Region, year int) []Holiday {
+	preset, ok := presets[r]
+	if !ok {
+		return nil
+	}
+	holidays := preset(year)
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+func usHolidays(year int) []Holiday {
+	// fixed-date holidays falling on a weekend are observed on the closest weekday
+	observed := func(d time.Time, name string) Holiday {
+		switch d.Weekday() {
+		case time.Saturday:
+			d = d.AddDate(0, 0, -1)
+		case time.Sunday:
+			d = d.AddDate(0, 0, 1)
+		}
+		return Holiday{Date: d, Name: name}
+	}
+	return []Holiday{
+		observed(date(year, time.January, 1), "New Year's Day"),
+		{Date: nthWeekday(year, time.January, time.Monday, 3), Name: "Martin Luther King Jr. Day"},
+		{Date: nthWeekday(year, time.February, time.Monday, 3), Name: "Washington's Birthday"},
+		{Date: lastWeekday(year, time.May, time.Monday), Name: "Memorial Day"},
+		observed(date(year, time.June, 19), "Juneteenth National Independence Day"),
+		observed(date(year, time.July, 4), "Independence Day"),
+		{Date: nthWeekday(year, time.September, time.Monday, 1), Name: "Labor Day"},
+		{Date: nthWeekday(year, time.October, time.Monday, 2), Name: "Columbus Day"},
+		observed(date(year, time.November, 11), "Veterans Day"),
+		{Date: nthWeekday(year, time.November, time.Thursday, 4), Name: "Thanksgiving Day"},
+		observed(date(year, time.December, 25), "Christmas Day"),
+	}
+}
+
+func deHolidays(year int) []Holiday {
+	easter := easterSunday(year)
+	return []Holiday{
+		{Date: date(year, time.January, 1), Name: "New Year's Day"},
+		{Date: easter.AddDate(0, 0, -2), Name: "Good Friday"},
+		{Date: easter.AddDate(0, 0, 1), Name: "Easter Monday"},
+		{Date: date(year, time.May, 1), Name: "Labour Day"},
+		{Date: easter.AddDate(0, 0, 39), Name: "Ascension Day"},
+		{Date: easter.AddDate(0, 0, 50), Name: "Whit Monday"},
+		{Date: date(year, time.October, 3), Name: "German Unity Day"},
+		{Date: date(year, time.December, 25), Name: "Christmas Day"},
+		{Date: date(year, time.December, 26), Name: "Second Day of Christmas"},
+	}
+}
+
+func inHolidays(year int) []Holiday {
+	return []Holiday{
+		{Date: date(year, time.January, 26), Name: "Republic Day"},
+		{Date: date(year, time.August, 15), Name: "Independence Day"},
+		{Date: date(year, time.October, 2), Name: "Gandhi Jayanti"},
+	}
+}
+
+func jpHolidays(year int) []Holiday {
+	holidays := []Holiday{
+		{Date: date(year, time.January, 1), Name: "New Year's Day"},
+		{Date: nthWeekday(year, time.January, time.Monday, 2), Name: "Coming of Age Day"},
+		{Date: date(year, time.February, 11), Name: "National Foundation Day"},
+		{Date: date(year, time.February, 23), Name: "Emperor's Birthday"},
+		{Date: date(year, time.March, equinoxDay(year, 20.8431)), Name: "Vernal Equinox Day"},
+		{Date: date(year, time.April, 29), Name: "Showa Day"},
+		{Date: date(year, time.May, 3), Name: "Constitution Memorial Day"},
+		{Date: date(year, time.May, 4), Name: "Greenery Day"},
+		{Date: date(year, time.May, 5), Name: "Children's Day"},
+		{Date: nthWeekday(year, time.July, time.Monday, 3), Name: "Marine Day"},
+		{Date: date(year, time.August, 11), Name: "Mountain Day"},
+		{Date: nthWeekday(year, time.September, time.Monday, 3), Name: "Respect for the Aged Day"},
+		{Date: date(year, time.September, equinoxDay(year, 23.2488)), Name: "Autumnal Equinox Day"},
+		{Date: nthWeekday(year, time.October, time.Monday, 2), Name: "Sports Day"},
+		{Date: date(year, time.November, 3), Name: "Culture Day"},
+		{Date: date(year, time.November, 23), Name: "Labor Thanksgiving Day"},
+	}
+
+	// a holiday falling on a Sunday is substituted by the next day that is not a holiday
+	taken := make(map[time.Time]bool, len(holidays))
+	for _, h := range holidays {
+		taken[h.Date] = true
+	}
+	for _, h := range holidays {
+		if h.Date.Weekday() != time.Sunday {
+			continue
+		}
+		d := h.Date.AddDate(0, 0, 1)
+		for taken[d] {
+			d = d.AddDate(0, 0, 1)
+		}
+		taken[d] = true
+		holidays = append(holidays, Holiday{Date: d, Name: "Substitute Holiday"})
+	}
+	return holidays
+}
+
+// nthWeekday returns the n-th weekday of a month.
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := date(year, month, 1)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+// lastWeekday returns the last weekday of a month.
+func lastWeekday(year int, month time.Month, weekday time.Weekday) time.Time {
+	last := date(year, month+1, 0)
+	offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+	return last.AddDate(0, 0, -offset)
+}
+
+// easterSunday returns Easter Sunday of the Gregorian calendar (anonymous Gregorian algorithm).
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
+
+// equinoxDay approximates the day of the equinox in Japan Standard Time; base is the day of the
+// equinox in 1980. The approximation holds from 1980 to 2099.
+func equinoxDay(year int, base float64) int {
+	n := float64(year - 1980)
+	return int(math.Floor(base + 0.242194*n - math.Floor(n/4)))
+}
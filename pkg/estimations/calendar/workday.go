@@ -0,0 +1,94 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// maxIdleDays bounds the days off in a row before a calendar is deemed to have no working day left.
+const maxIdleDays = 366
+
+// Workday is the working hours of a working day: Hours of work from Start, an offset from midnight.
+type Workday struct {
+	Start time.Duration
+	Hours float64
+}
+
+// DefaultWorkday is 8 hours of work from 9:00.
+var DefaultWorkday = Workday{Start: 9 * time.Hour, Hours: 8}
+
+// Validate checks the working hours fit in a day.
+func (d Workday) Validate() error {
+	if d.Start < 0 || d.Start >= 24*time.Hour {
+		return fmt.Errorf("workday start %v is not within a day", d.Start)
+	}
+	if d.Hours <= 0 || d.Start+d.length() > 24*time.Hour {
+		return fmt.Errorf("%g work hours from %v do not fit in a day", d.Hours, d.Start)
+	}
+	return nil
+}
+
+func (d Workday) length() time.Duration {
+	return time.Duration(d.Hours * float64(time.Hour))
+}
+
+// Span is when an estimation runs on the calendar.
+type Span struct {
+	Start time.Time
+	End   time.Time
+}
+
+// AddWork lands work started at from on the working hours of the working days, in the location of
+// from. Start is the first working hour at or after from. It fails when the calendar has no working
+// day left, e.g. a change freeze without end.
+func (c Calendar) AddWork(from time.Time, work time.Duration, day Workday) (Span, error) {
+	if err := day.Validate(); err != nil {
+		return Span{}, err
+	}
+	var span Span
+	t, idle, remaining := from, 0, work
+	for {
+		midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		if c.IsWorkingDay(t) {
+			open := midnight.Add(day.Start)
+			closing := open.Add(day.length())
+			if t.Before(open) {
+				t = open
+			}
+			if t.Before(closing) {
+				if span.Start.IsZero() {
+					span.Start = t
+				}
+				if available := closing.Sub(t); available >= remaining {
+					span.End = t.Add(remaining)
+					return span, nil
+				}
+				remaining -= closing.Sub(t)
+			}
+			idle = 0
+		} else if idle++; idle > maxIdleDays {
+			return Span{}, errors.New("no working day left in the calendar")
+		}
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// Dates lands an estimation started at from on the calendar. The effort is worked during the working
+// hours, while the lead time is calendar time elapsing from from regardless, so the later of the two
+// ends the estimation.
+func (c Calendar) Dates(from time.Time, e estimation.Estimation, day Workday) (Span, error) {
+	span, err := c.AddWork(from, e.Duration, day)
+	if err != nil {
+		return Span{}, err
+	}
+	if e.Duration == 0 && e.LeadTime > 0 {
+		span.Start = from
+	}
+	if end := from.Add(e.LeadTime); end.After(span.End) {
+		span.End = end
+	}
+	return span, nil
+}
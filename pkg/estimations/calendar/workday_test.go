@@ -0,0 +1,89 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestCalendar_Dates(t *testing.T) {
+	t.Parallel()
+	monday := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	freeze := Calendar{Freezes: []Freeze{{Start: date(2026, time.March, 4), End: date(2026, time.March, 5), Name: "quarter close"}}}
+
+	cases := []struct {
+		name      string
+		calendar  Calendar
+		from      time.Time
+		est       estimation.Estimation
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "a work week",
+			from:      monday,
+			est:       estimation.Estimation{Duration: 40 * time.Hour},
+			wantStart: monday,
+			wantEnd:   time.Date(2026, time.March, 6, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "change freeze",
+			calendar:  freeze,
+			from:      monday,
+			est:       estimation.Estimation{Duration: 40 * time.Hour},
+			wantStart: monday,
+			wantEnd:   time.Date(2026, time.March, 10, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "started on a weekend evening",
+			from:      time.Date(2026, time.February, 28, 20, 0, 0, 0, time.UTC),
+			est:       estimation.Estimation{Duration: 10 * time.Hour},
+			wantStart: monday,
+			wantEnd:   time.Date(2026, time.March, 3, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "holiday",
+			calendar:  Calendar{Region: RegionDE},
+			from:      time.Date(2026, time.April, 2, 13, 0, 0, 0, time.UTC),
+			est:       estimation.Estimation{Duration: 8 * time.Hour},
+			wantStart: time.Date(2026, time.April, 2, 13, 0, 0, 0, time.UTC),
+			// Good Friday, the weekend and Easter Monday are days off
+			wantEnd: time.Date(2026, time.April, 7, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "lead time longer than the effort",
+			from:      monday,
+			est:       estimation.Estimation{Duration: 4 * time.Hour, LeadTime: 14 * 24 * time.Hour},
+			wantStart: monday,
+			wantEnd:   monday.Add(14 * 24 * time.Hour),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			span, err := tc.calendar.Dates(tc.from, tc.est, DefaultWorkday)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if !span.Start.Equal(tc.wantStart) || !span.End.Equal(tc.wantEnd) {
+				t.Errorf("expected %v to %v, got %v to %v", tc.wantStart, tc.wantEnd, span.Start, span.End)
+			}
+		})
+	}
+}
+
+func TestCalendar_AddWork_Errors(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	frozen := Calendar{Freezes: []Freeze{{Start: date(2026, time.January, 1), End: date(2030, time.January, 1), Name: "forever"}}}
+	if _, err := frozen.AddWork(from, time.Hour, DefaultWorkday); err == nil {
+		t.Errorf("expected an error for a calendar without working days")
+	}
+	if _, err := (Calendar{}).AddWork(from, time.Hour, Workday{Start: 20 * time.Hour, Hours: 6}); err == nil {
+		t.Errorf("expected an error for working hours past midnight")
+	}
+	if err := (Calendar{Freezes: []Freeze{{Start: date(2026, time.March, 5), End: date(2026, time.March, 4)}}}).Validate(); err == nil {
+		t.Errorf("expected an error for a freeze ending before it starts")
+	}
+}
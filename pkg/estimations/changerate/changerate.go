@@ -0,0 +1,209 @@
+package changerate
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+const (
+	// DefaultWindow is the sampling window, a full week so that weekly jobs (backups, batch runs) are seen.
+	DefaultWindow = 7 * 24 * time.Hour
+	// DefaultInterval is the time between two samples of a VM.
+	DefaultInterval = time.Hour
+
+	// MinWindow is the shortest window giving a daily rate.
+	MinWindow = 24 * time.Hour
+	// MaxWindow is the longest window, so that the rates are available in time to plan the waves.
+	MaxWindow = 30 * 24 * time.Hour
+
+	bytesPerGB = 1024 * 1024 * 1024
+)
+
+// Sample is the CBT reading of one VM.
+type Sample struct {
+	VMID string    `json:"vmId"`
+	At   time.Time `json:"at"`
+	// ChangedBytes is the size of the blocks changed since the previous sample of the VM. The first
+	// sample of a VM only sets the CBT baseline: its changed bytes are ignored.
+	ChangedBytes  int64 `json:"changedBytes"`
+	CapacityBytes int64 `json:"capacityBytes"`
+}
+
+// Measurement accumulates the samples of one VM.
+type Measurement struct {
+	First         time.Time `json:"first"`
+	Last          time.Time `json:"last"`
+	ChangedBytes  int64     `json:"changedBytes"`
+	CapacityBytes int64     `json:"capacityBytes"`
+	Samples       int       `json:"samples"`
+}
+
+// Add accumulates s. Samples taken at or before the last one are ignored, so that an agent can
+// resend a batch it is not sure was received.
+func (m *Measurement) Add(s Sample) {
+	if m.Samples > 0 && !s.At.After(m.Last) {
+		return
+	}
+	if m.Samples == 0 {
+		m.First = s.At
+	} else {
+		m.ChangedBytes += s.ChangedBytes
+	}
+	m.Last = s.At
+	m.CapacityBytes = s.CapacityBytes
+	m.Samples++
+}
+
+// Period is the time covered by the measurement.
+func (m Measurement) Period() time.Duration {
+	return m.Last.Sub(m.First)
+}
+
+// DailyChangeRatePercent returns the share of the VM disks rewritten per day, extrapolated from the
+// measured period. It is false until two samples of a VM with a known capacity are recorded.
+func (m Measurement) DailyChangeRatePercent() (float64, bool) {
+	if m.Period() <= 0 || m.CapacityBytes <= 0 {
+		return 0, false
+	}
+	days := m.Period().Hours() / 24
+	return float64(m.ChangedBytes) / float64(m.CapacityBytes) / days * 100, true
+}
+
+// Job is a sampling job of a source.
+type Job struct {
+	VMIDs     []string      `json:"vmIds"`
+	Window    time.Duration `json:"window"`
+	Interval  time.Duration `json:"interval"`
+	StartedAt time.Time     `json:"startedAt"`
+	// Measurements are keyed by VM ID.
+	Measurements map[string]Measurement `json:"measurements,omitempty"`
+}
+
+// NewJob creates a job sampling vmIDs from now on. A zero window or interval takes the default.
+func NewJob(vmIDs []string, window, interval time.Duration, now time.Time) Job {
+	if window == 0 {
+		window = DefaultWindow
+	}
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	return Job{VMIDs: vmIDs, Window: window, Interval: interval, StartedAt: now}
+}
+
+// Validate checks the job can be carried out.
+func (j Job) Validate() error {
+	if len(j.VMIDs) == 0 {
+		return errors.New("no VM to sample")
+	}
+	if j.Window < MinWindow || j.Window > MaxWindow {
+		return fmt.Errorf("window must be between %s and %s", MinWindow, MaxWindow)
+	}
+	if j.Interval <= 0 || j.Interval > j.Window/2 {
+		return errors.New("interval must be positive and fit at least twice in the window")
+	}
+	return nil
+}
+
+// Ends returns the end of the sampling window.
+func (j Job) Ends() time.Time {
+	return j.StartedAt.Add(j.Window)
+}
+
+// Done reports whether the window is over.
+func (j Job) Done(now time.Time) bool {
+	return !now.Before(j.Ends())
+}
+
+// Record accumulates samples into the measurements of their VM. Samples of VMs the job does not
+// sample are an error; samples taken outside the window are ignored.
+func (j *Job) Record(samples []Sample) error {
+	sampled := make(map[string]bool, len(j.VMIDs))
+	for _, id := range j.VMIDs {
+		sampled[id] = true
+	}
+	for _, s := range samples {
+		if !sampled[s.VMID] {
+			return fmt.Errorf("VM %s is not sampled by the job", s.VMID)
+		}
+		if s.ChangedBytes < 0 || s.CapacityBytes < 0 {
+			return fmt.Errorf("sample of VM %s has a negative size", s.VMID)
+		}
+	}
+
+	if j.Measurements == nil {
+		j.Measurements = make(map[string]Measurement, len(j.VMIDs))
+	}
+	for _, s := range samples {
+		if s.At.Before(j.StartedAt) || s.At.After(j.Ends()) {
+			continue
+		}
+		m := j.Measurements[s.VMID]
+		m.Add(s)
+		j.Measurements[s.VMID] = m
+	}
+	return nil
+}
+
+// Rates returns the measured daily change rates, keyed by VM ID. VMs without a rate yet are absent.
+func (j Job) Rates() map[string]float64 {
+	rates := make(map[string]float64, len(j.Measurements))
+	for id, m := range j.Measurements {
+		if rate, ok := m.DailyChangeRatePercent(); ok {
+			rates[id] = rate
+		}
+	}
+	return rates
+}
+
+// ChangeRatePercent returns the daily change rate of the sampled VMs weighted by their disk
+// capacity, the way method.Summarize weighs the VMs migrated warm. It is false until a rate is
+// measured.
+func (j Job) ChangeRatePercent() (float64, bool) {
+	candidates := make([]method.Candidate, 0, len(j.Measurements))
+	for _, id := range slices.Sorted(maps.Keys(j.Measurements)) {
+		vm := inventory.VM{ID: id, DiskGB: float64(j.Measurements[id].CapacityBytes) / bytesPerGB}
+		candidates = append(candidates, method.Candidate{VM: vm})
+	}
+
+	candidates = Apply(candidates, j.Rates())
+	assignments := make([]method.Assignment, 0, len(candidates))
+	for _, c := range candidates {
+		assignments = append(assignments, method.Assignment{Candidate: c, Method: method.Warm})
+	}
+	rate := method.Summarize(assignments)[method.Warm].ChangeRatePercent
+	return rate, rate > 0
+}
+
+// Targets returns the IDs of the VMs worth sampling: the powered-on VMs with CBT enabled that the
+// business has not already decided to retire or replatform.
+func Targets(candidates []method.Candidate) []string {
+	var ids []string
+	for _, c := range candidates {
+		if c.Retire || c.Replatform {
+			continue
+		}
+		if c.VM.PowerState != inventory.PowerStateOn || !c.VM.ChangeTrackingEnabled {
+			continue
+		}
+		ids = append(ids, c.VM.ID)
+	}
+	return ids
+}
+
+// Apply returns a copy of candidates whose change rate is the measured one when there is one.
+func Apply(candidates []method.Candidate, rates map[string]float64) []method.Candidate {
+	res := make([]method.Candidate, len(candidates))
+	for i, c := range candidates {
+		if rate, ok := rates[c.VM.ID]; ok {
+			c.DailyChangeRatePercent = rate
+		}
+		res[i] = c
+	}
+	return res
+}
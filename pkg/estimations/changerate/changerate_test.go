@@ -0,0 +1,158 @@
+package changerate
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+const gib = int64(1) << 30
+
+func TestMeasurement_DailyChangeRatePercent(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	var m Measurement
+	if _, ok := m.DailyChangeRatePercent(); ok {
+		t.Errorf("expected no rate without samples")
+	}
+
+	// the baseline sample is ignored
+	m.Add(Sample{At: start, ChangedBytes: 500 * gib, CapacityBytes: 100 * gib})
+	if _, ok := m.DailyChangeRatePercent(); ok {
+		t.Errorf("expected no rate with a single sample")
+	}
+	m.Add(Sample{At: start.Add(12 * time.Hour), ChangedBytes: 5 * gib, CapacityBytes: 100 * gib})
+	m.Add(Sample{At: start.Add(48 * time.Hour), ChangedBytes: 15 * gib, CapacityBytes: 100 * gib})
+	// a resent sample is ignored
+	m.Add(Sample{At: start.Add(48 * time.Hour), ChangedBytes: 15 * gib, CapacityBytes: 100 * gib})
+
+	rate, ok := m.DailyChangeRatePercent()
+	if !ok {
+		t.Fatalf("expected a rate")
+	}
+	// 20 GiB of 100 GiB over 2 days
+	if math.Abs(rate-10) > 1e-9 {
+		t.Errorf("expected 10%% per day, got %v", rate)
+	}
+	if m.Samples != 3 {
+		t.Errorf("expected 3 samples, got %d", m.Samples)
+	}
+}
+
+func TestJob_Validate(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	if err := NewJob([]string{"vm-1"}, 0, 0, now).Validate(); err != nil {
+		t.Errorf("expected default job to be valid, got: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		job  Job
+	}{
+		{name: "no VMs", job: NewJob(nil, 0, 0, now)},
+		{name: "window too short", job: NewJob([]string{"vm-1"}, time.Hour, time.Minute, now)},
+		{name: "window too long", job: NewJob([]string{"vm-1"}, 60*24*time.Hour, 0, now)},
+		{name: "interval longer than half the window", job: NewJob([]string{"vm-1"}, MinWindow, 13*time.Hour, now)},
+		{name: "negative interval", job: NewJob([]string{"vm-1"}, 0, -time.Hour, now)},
+	}
+	for _, tc := range cases {
+		if err := tc.job.Validate(); err == nil {
+			t.Errorf("expected error for case %q, got nil", tc.name)
+		}
+	}
+}
+
+func TestJob_Record(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	job := NewJob([]string{"vm-1", "vm-2"}, MinWindow, 0, start)
+
+	err := job.Record([]Sample{
+		{VMID: "vm-1", At: start, CapacityBytes: 100 * gib},
+		{VMID: "vm-1", At: start.Add(12 * time.Hour), ChangedBytes: 2 * gib, CapacityBytes: 100 * gib},
+		{VMID: "vm-2", At: start.Add(time.Hour), CapacityBytes: 10 * gib},
+		// outside the window
+		{VMID: "vm-1", At: start.Add(48 * time.Hour), ChangedBytes: 50 * gib, CapacityBytes: 100 * gib},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	rates := job.Rates()
+	if len(rates) != 1 || math.Abs(rates["vm-1"]-4) > 1e-9 {
+		t.Errorf("expected only vm-1 at 4%% per day, got %v", rates)
+	}
+	if job.Done(start.Add(23*time.Hour)) || !job.Done(start.Add(24*time.Hour)) {
+		t.Errorf("expected the job to be done at the end of its window")
+	}
+
+	if err := job.Record([]Sample{{VMID: "vm-3", At: start}}); err == nil {
+		t.Errorf("expected error for a VM not sampled by the job, got nil")
+	}
+	if err := job.Record([]Sample{{VMID: "vm-2", At: start, ChangedBytes: -1}}); err == nil {
+		t.Errorf("expected error for a negative size, got nil")
+	}
+}
+
+func TestTargetsAndApply(t *testing.T) {
+	t.Parallel()
+	candidate := func(id, power string, cbt, retire bool) method.Candidate {
+		return method.Candidate{
+			VM:     inventory.VM{ID: id, PowerState: power, ChangeTrackingEnabled: cbt},
+			Retire: retire,
+		}
+	}
+	candidates := []method.Candidate{
+		candidate("vm-1", inventory.PowerStateOn, true, false),
+		candidate("vm-2", inventory.PowerStateOff, true, false),
+		candidate("vm-3", inventory.PowerStateOn, false, false),
+		candidate("vm-4", inventory.PowerStateOn, true, true),
+	}
+
+	targets := Targets(candidates)
+	if len(targets) != 1 || targets[0] != "vm-1" {
+		t.Errorf("expected only vm-1 to be sampled, got %v", targets)
+	}
+
+	applied := Apply(candidates, map[string]float64{"vm-1": 12.5})
+	if applied[0].DailyChangeRatePercent != 12.5 || applied[1].DailyChangeRatePercent != 0 {
+		t.Errorf("expected the measured rate on vm-1 only, got %+v", applied)
+	}
+	if candidates[0].DailyChangeRatePercent != 0 {
+		t.Errorf("expected the input candidates to be left untouched")
+	}
+}
+
+func TestJob_ChangeRatePercent(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	job := NewJob([]string{"vm-1", "vm-2", "vm-3"}, 0, 0, start)
+	if _, ok := job.ChangeRatePercent(); ok {
+		t.Errorf("expected no rate before any sample")
+	}
+
+	if err := job.Record([]Sample{
+		{VMID: "vm-1", At: start, CapacityBytes: 100 * gib},
+		{VMID: "vm-1", At: start.Add(24 * time.Hour), ChangedBytes: 10 * gib, CapacityBytes: 100 * gib},
+		{VMID: "vm-2", At: start, CapacityBytes: 300 * gib},
+		{VMID: "vm-2", At: start.Add(24 * time.Hour), ChangedBytes: 6 * gib, CapacityBytes: 300 * gib},
+		// a single sample gives no rate yet
+		{VMID: "vm-3", At: start, CapacityBytes: 1000 * gib},
+	}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	rate, ok := job.ChangeRatePercent()
+	if !ok {
+		t.Fatalf("expected a rate")
+	}
+	// 10% of 100 GiB and 2% of 300 GiB
+	if math.Abs(rate-4) > 1e-9 {
+		t.Errorf("expected 4%% per day, got %v", rate)
+	}
+}
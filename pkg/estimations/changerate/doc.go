@@ -0,0 +1,8 @@
+// Package changerate measures the daily change rate of VM disks, the amount of data a warm
+// migration has to copy again at every incremental sync.
+//
+// The agent running next to vCenter carries out a sampling Job: every interval of the window, it
+// asks CBT for the blocks changed on each VM since its previous sample and uploads the Samples.
+// The samples are accumulated into one Measurement per VM, whose daily rate replaces the guessed
+// change rate of the method.Candidate when the migration methods are selected.
+package changerate
@@ -0,0 +1,288 @@
+package chargeback
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/portfolio"
+)
+
+// GroupBy is the tag of the VMs their effort is charged back by.
+type GroupBy string
+
+const (
+	GroupByOwner      GroupBy = "owner"
+	GroupByCostCenter GroupBy = "cost-center"
+)
+
+// Unassigned is the line of the VMs without the tag, and of the actuals of VMs of no wave.
+const Unassigned = "unassigned"
+
+// Sheet is the name of the sheet of the XLSX export.
+const Sheet = "Chargeback"
+
+// Actual is the time a phase of the migration of a VM actually took.
+type Actual struct {
+	VM     string
+	Phase  string
+	Effort time.Duration
+}
+
+// Line is the effort and cost charged to an owner or a cost center.
+type Line struct {
+	Key             string
+	VMs             int
+	EstimatedEffort time.Duration
+	ActualEffort    time.Duration
+	// EstimatedCost and ActualCost are in the currency of the report, zero without rate card.
+	EstimatedCost float64
+	ActualCost    float64
+}
+
+// Report is the chargeback of a plan.
+type Report struct {
+	Plan    string
+	GroupBy GroupBy
+	// RateCard and Currency are empty when the effort is not priced. Currency is the one of the
+	// rate card until the report is converted.
+	RateCard string
+	Currency string
+	// ExchangeRate converted the costs from the currency of the rate card, nil when not converted.
+	ExchangeRate *cost.ExchangeRate
+	// Lines are sorted by key, the unassigned line last.
+	Lines []Line
+	Total Line
+	// Unpriced explains the effort left out of the costs, e.g. worked by a role the card has no rate for.
+	Unpriced []string
+}
+
+// Build charges the effort of the plan back by the tag of its VMs, pricing it with the card when
+// given. The effort of a phase is priced as the portfolio report prices it: at the rate of the role
+// named after the pool of the step, portfolio.DefaultRole without pool, for each of its units.
+// Actuals are priced the same way as the estimate of their phase.
+func Build(p plan.Plan, by GroupBy, card *cost.RateCard, actuals []Actual) (Report, error) {
+	if by != GroupByOwner && by != GroupByCostCenter {
+		return Report{}, fmt.Errorf("unknown chargeback grouping %q", by)
+	}
+	r := Report{Plan: p.Name, GroupBy: by, Total: Line{Key: "total"}}
+	if card != nil {
+		if !card.ValidAt(p.Start) {
+			return Report{}, fmt.Errorf("rate card %q v%d is not valid on %s", card.Name, card.Version, p.Start.Format(time.DateOnly))
+		}
+		r.RateCard = fmt.Sprintf("%s v%d", card.Name, card.Version)
+		r.Currency = card.Currency
+	}
+
+	steps := make(map[string]map[string]plan.Step, len(p.Waves))
+	for _, w := range p.Waves {
+		steps[w.Name] = make(map[string]plan.Step, len(w.Steps))
+		for _, s := range w.Steps {
+			steps[w.Name][s.Phase] = s
+		}
+	}
+
+	unpriced := make(map[string]bool)
+	price := func(s plan.Step, effort time.Duration) float64 {
+		if card == nil {
+			return 0
+		}
+		role := s.Pool
+		if role == "" {
+			role = portfolio.DefaultRole
+		}
+		rate, err := card.HourlyRate(role)
+		if err != nil {
+			unpriced[err.Error()] = true
+			return 0
+		}
+		return effort.Hours() * float64(max(s.Units, 1)) * rate
+	}
+
+	lines := make(map[string]*Line)
+	line := func(key string) *Line {
+		if key == "" {
+			key = Unassigned
+		}
+		if _, ok := lines[key]; !ok {
+			lines[key] = &Line{Key: key}
+		}
+		return lines[key]
+	}
+
+	members := make(map[string]plan.VMEffort)
+	for _, e := range p.EffortLedger() {
+		members[e.VM.ID] = e
+		l := line(tag(e.VM, by))
+		l.VMs++
+		for _, ph := range e.Phases {
+			l.EstimatedEffort += ph.Effort
+			l.EstimatedCost += price(steps[e.Wave][ph.Phase], ph.Effort)
+		}
+	}
+
+	for _, a := range actuals {
+		e, ok := members[a.VM]
+		if !ok {
+			l := line(Unassigned)
+			l.ActualEffort += a.Effort
+			l.ActualCost += price(plan.Step{}, a.Effort)
+			continue
+		}
+		l := line(tag(e.VM, by))
+		l.ActualEffort += a.Effort
+		l.ActualCost += price(steps[e.Wave][a.Phase], a.Effort)
+	}
+
+	for _, l := range lines {
+		r.Lines = append(r.Lines, *l)
+		r.Total.VMs += l.VMs
+		r.Total.EstimatedEffort += l.EstimatedEffort
+		r.Total.ActualEffort += l.ActualEffort
+		r.Total.EstimatedCost += l.EstimatedCost
+		r.Total.ActualCost += l.ActualCost
+	}
+	slices.SortFunc(r.Lines, func(a, b Line) int {
+		switch {
+		case a.Key == Unassigned:
+			return 1
+		case b.Key == Unassigned:
+			return -1
+		default:
+			return cmp.Compare(a.Key, b.Key)
+		}
+	})
+	for message := range unpriced {
+		r.Unpriced = append(r.Unpriced, message)
+	}
+	slices.Sort(r.Unpriced)
+	return r, nil
+}
+
+// Convert returns the report with its costs in currency, converted with the rates. A report not
+// priced or already in currency is returned as is.
+func (r Report) Convert(ctx context.Context, currency string, rates *cost.Rates) (Report, error) {
+	if r.Currency == "" || r.Currency == currency {
+		return r, nil
+	}
+	rate, err := rates.Lookup(ctx, r.Currency, currency)
+	if err != nil {
+		return Report{}, err
+	}
+
+	convert := func(l Line) Line {
+		l.EstimatedCost *= rate.Rate
+		l.ActualCost *= rate.Rate
+		return l
+	}
+	lines := make([]Line, 0, len(r.Lines))
+	for _, l := range r.Lines {
+		lines = append(lines, convert(l))
+	}
+	r.Lines = lines
+	r.Total = convert(r.Total)
+	r.Currency = currency
+	r.ExchangeRate = &rate
+	return r, nil
+}
+
+func tag(vm plan.WaveVM, by GroupBy) string {
+	if by == GroupByCostCenter {
+		return vm.CostCenter
+	}
+	return vm.Owner
+}
+
+// header is the first row of the exports. The exchange rate of a converted report and its as-of
+// date are added for auditability.
+func (r Report) header() []string {
+	header := []string{string(r.GroupBy), "vms", "estimated_effort_hours", "actual_effort_hours", "estimated_cost", "actual_cost", "currency"}
+	if r.ExchangeRate != nil {
+		header = append(header, "exchange_rate_from", "exchange_rate", "exchange_rate_as_of")
+	}
+	return header
+}
+
+// rows are the lines of the exports, the total last.
+func (r Report) rows() [][]any {
+	rows := make([][]any, 0, len(r.Lines)+1)
+	for _, l := range append(slices.Clone(r.Lines), r.Total) {
+		row := []any{
+			l.Key, l.VMs, round(l.EstimatedEffort.Hours()), round(l.ActualEffort.Hours()),
+			round(l.EstimatedCost), round(l.ActualCost), r.Currency,
+		}
+		if rate := r.ExchangeRate; rate != nil {
+			// the rate is kept exact, the figures are rounded to the cent
+			row = append(row, rate.From, strconv.FormatFloat(rate.Rate, 'f', -1, 64), rate.AsOf.Format(time.DateOnly))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// CSV renders the report as CSV, one row per line under a header row and the total last.
+func (r Report) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{r.header()}
+	for _, row := range r.rows() {
+		record := make([]string, 0, len(row))
+		for _, v := range row {
+			switch v := v.(type) {
+			case float64:
+				record = append(record, strconv.FormatFloat(v, 'f', 2, 64))
+			default:
+				record = append(record, fmt.Sprint(v))
+			}
+		}
+		records = append(records, record)
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// XLSX renders the report as a workbook with the rows of the CSV export in a single sheet, the
+// figures as numbers.
+func (r Report) XLSX() ([]byte, error) {
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+
+	if err := f.SetSheetName(f.GetSheetName(0), Sheet); err != nil {
+		return nil, err
+	}
+	header := make([]any, 0, len(r.header()))
+	for _, h := range r.header() {
+		header = append(header, h)
+	}
+	for i, row := range append([][]any{header}, r.rows()...) {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, err
+		}
+		if err := f.SetSheetRow(Sheet, cell, &row); err != nil {
+			return nil, err
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
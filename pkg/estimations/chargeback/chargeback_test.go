@@ -0,0 +1,192 @@
+package chargeback
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+func testPlan() plan.Plan {
+	return plan.Plan{
+		Name:  "billing",
+		Start: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Waves: []plan.Wave{
+			{
+				Name: "wave-1",
+				Members: []plan.WaveVM{
+					{ID: "vm-1", Owner: "alice", CostCenter: "cc-100", DiskGB: 300},
+					{ID: "vm-2", Owner: "bob", CostCenter: "cc-100", DiskGB: 100},
+					{ID: "vm-3", CostCenter: "cc-200"},
+				},
+				Steps: []plan.Step{
+					{Phase: "Storage Migration", Effort: 8 * time.Hour},
+					{Phase: "Validation", Effort: 6 * time.Hour, Pool: "qa", Units: 2},
+				},
+			},
+		},
+	}
+}
+
+func testCard() *cost.RateCard {
+	return &cost.RateCard{
+		Name:        "internal",
+		Version:     2,
+		Currency:    "EUR",
+		ValidFrom:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		HourlyRates: map[string]float64{"engineer": 100, "qa": 50},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+	actuals := []Actual{
+		{VM: "vm-1", Phase: "Validation", Effort: 3 * time.Hour},
+		{VM: "vm-9", Phase: "Storage Migration", Effort: time.Hour},
+	}
+
+	r, err := Build(testPlan(), GroupByOwner, testCard(), actuals)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(r.Lines) != 3 || r.Lines[0].Key != "alice" || r.Lines[1].Key != "bob" || r.Lines[2].Key != Unassigned {
+		t.Fatalf("expected alice, bob and the unassigned VMs, got %+v", r.Lines)
+	}
+	// alice: 6h of the transfer at 100, 2h of validation by 2 QA engineers at 50
+	alice := r.Lines[0]
+	if alice.EstimatedEffort != 8*time.Hour || alice.EstimatedCost != 800 {
+		t.Errorf("unexpected estimate for alice %+v", alice)
+	}
+	if alice.ActualEffort != 3*time.Hour || alice.ActualCost != 300 {
+		t.Errorf("unexpected actuals for alice %+v", alice)
+	}
+	// vm-3 has no disk and no owner, vm-9 is in no wave
+	if u := r.Lines[2]; u.VMs != 1 || u.ActualEffort != time.Hour || u.ActualCost != 100 {
+		t.Errorf("unexpected unassigned line %+v", u)
+	}
+	if r.Total.VMs != 3 || r.Total.EstimatedEffort != 14*time.Hour || r.Total.EstimatedCost != 1400 {
+		t.Errorf("unexpected total %+v", r.Total)
+	}
+	if r.RateCard != "internal v2" || r.Currency != "EUR" || len(r.Unpriced) != 0 {
+		t.Errorf("unexpected pricing %q %q %v", r.RateCard, r.Currency, r.Unpriced)
+	}
+}
+
+func TestBuild_CostCenter(t *testing.T) {
+	t.Parallel()
+	card := testCard()
+	delete(card.HourlyRates, "qa")
+
+	r, err := Build(testPlan(), GroupByCostCenter, card, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(r.Lines) != 2 || r.Lines[0].Key != "cc-100" || r.Lines[0].VMs != 2 {
+		t.Fatalf("expected the two VMs of cc-100 first, got %+v", r.Lines)
+	}
+	if r.Lines[0].EstimatedCost != 800 {
+		t.Errorf("expected only the transfer priced, got %v", r.Lines[0].EstimatedCost)
+	}
+	if len(r.Unpriced) != 1 || !strings.Contains(r.Unpriced[0], `no rate for role "qa"`) {
+		t.Errorf("expected the validation unpriced, got %v", r.Unpriced)
+	}
+}
+
+func TestBuild_Errors(t *testing.T) {
+	t.Parallel()
+	if _, err := Build(testPlan(), "team", nil, nil); err == nil {
+		t.Errorf("expected an error for an unknown grouping")
+	}
+	card := testCard()
+	card.ValidFrom = time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := Build(testPlan(), GroupByOwner, card, nil); err == nil || !strings.Contains(err.Error(), "not valid on 2026-03-02") {
+		t.Errorf("expected an error for an expired card, got %v", err)
+	}
+}
+
+func TestReport_Exports(t *testing.T) {
+	t.Parallel()
+	r, err := Build(testPlan(), GroupByCostCenter, testCard(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	out, err := r.CSV()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "cost-center,vms,") || lines[3] != "total,3,14.00,0.00,1400.00,0.00,EUR" {
+		t.Errorf("unexpected CSV %q", out)
+	}
+
+	out, err = r.XLSX()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	f, err := excelize.OpenReader(bytes.NewReader(out))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	defer func() { _ = f.Close() }()
+	rows, err := f.GetRows(Sheet)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(rows) != 4 || rows[1][0] != "cc-100" || rows[3][4] != "1400" {
+		t.Errorf("unexpected workbook rows %v", rows)
+	}
+}
+
+func TestReport_Convert(t *testing.T) {
+	t.Parallel()
+	r, err := Build(testPlan(), GroupByCostCenter, testCard(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	asOf := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	rates, err := cost.NewRates(cost.WithRate("EUR", "USD", 1.085, asOf))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	converted, err := r.Convert(context.Background(), "USD", rates)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if converted.Currency != "USD" || math.Abs(converted.Total.EstimatedCost-1519) > 1e-9 || math.Abs(converted.Lines[0].EstimatedCost-1302) > 1e-9 {
+		t.Errorf("expected the costs in USD, got %+v", converted)
+	}
+	if rate := converted.ExchangeRate; rate == nil || rate.From != "EUR" || rate.Rate != 1.085 || !rate.AsOf.Equal(asOf) {
+		t.Errorf("expected the EUR rate reported, got %+v", rate)
+	}
+	if r.Currency != "EUR" || r.Total.EstimatedCost != 1400 {
+		t.Errorf("expected the report left in EUR, got %+v", r)
+	}
+
+	out, err := converted.CSV()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if !strings.HasSuffix(lines[0], ",currency,exchange_rate_from,exchange_rate,exchange_rate_as_of") || lines[3] != "total,3,14.00,0.00,1519.00,0.00,USD,EUR,1.085,2026-03-01" {
+		t.Errorf("unexpected CSV %q", out)
+	}
+
+	if same, err := r.Convert(context.Background(), "EUR", rates); err != nil || same.ExchangeRate != nil {
+		t.Errorf("expected a report in EUR left as is, got %+v %v", same, err)
+	}
+	if _, err := r.Convert(context.Background(), "GBP", rates); !errors.Is(err, cost.ErrNoExchangeRate) {
+		t.Errorf("expected ErrNoExchangeRate without GBP rate, got %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+// Package chargeback charges the effort and cost of a migration plan back to whoever owns its VMs:
+// the application owner or the cost center tagged on them. The estimated effort comes from the
+// effort ledger of the plan, the actual effort from the phase actuals recorded against its VMs, and
+// both are priced with a rate card when given.
+//
+// The report is exported as CSV or as an XLSX workbook for internal billing.
+package chargeback
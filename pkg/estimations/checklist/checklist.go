@@ -0,0 +1,171 @@
+package checklist
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Template lists the checks to run on the VMs it applies to.
+type Template struct {
+	Name  string   `json:"name"`
+	Items []string `json:"items"`
+	// Applications and OperatingSystems select the VMs the template applies to: a VM matches
+	// when its application is listed or its operating system contains one of the listed ones,
+	// e.g. "windows". A template without selector applies to all the VMs.
+	Applications     []string `json:"applications,omitempty"`
+	OperatingSystems []string `json:"operatingSystems,omitempty"`
+}
+
+// VM is a VM cut over in a wave.
+type VM struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Application string `json:"application,omitempty"`
+	OS          string `json:"os,omitempty"`
+}
+
+// Item is a check of a checklist.
+type Item struct {
+	Name        string     `json:"name"`
+	Done        bool       `json:"done"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CompletedBy string     `json:"completedBy,omitempty"`
+}
+
+// Checklist is a template instantiated for a VM of a wave.
+type Checklist struct {
+	Wave     string `json:"wave"`
+	VM       VM     `json:"vm"`
+	Template string `json:"template"`
+	Items    []Item `json:"items"`
+}
+
+// WaveProgress is the completion of the checklists of a wave.
+type WaveProgress struct {
+	Wave string
+	// VMs is the number of VMs with a checklist and VMsDone the number of them with all their
+	// checklists completed.
+	VMs       int
+	VMsDone   int
+	Items     int
+	ItemsDone int
+}
+
+// Validate checks the template can be instantiated.
+func (t Template) Validate() error {
+	if t.Name == "" {
+		return errors.New("checklist template name is required")
+	}
+	if len(t.Items) == 0 {
+		return fmt.Errorf("checklist template %s has no item", t.Name)
+	}
+	seen := make(map[string]bool, len(t.Items))
+	for _, item := range t.Items {
+		if strings.TrimSpace(item) == "" {
+			return fmt.Errorf("checklist template %s has an empty item", t.Name)
+		}
+		if seen[item] {
+			return fmt.Errorf("checklist template %s lists item %q twice", t.Name, item)
+		}
+		seen[item] = true
+	}
+	return nil
+}
+
+// Matches tells whether the template applies to the VM.
+func (t Template) Matches(vm VM) bool {
+	if len(t.Applications) == 0 && len(t.OperatingSystems) == 0 {
+		return true
+	}
+	for _, app := range t.Applications {
+		if vm.Application != "" && strings.EqualFold(app, vm.Application) {
+			return true
+		}
+	}
+	os := strings.ToLower(vm.OS)
+	for _, selector := range t.OperatingSystems {
+		if os != "" && strings.Contains(os, strings.ToLower(selector)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Instantiate creates a checklist per VM of the wave and template matching it, in the order of
+// the VMs then of the templates.
+func Instantiate(wave string, templates []Template, vms []VM) []Checklist {
+	var checklists []Checklist
+	for _, vm := range vms {
+		for _, t := range templates {
+			if !t.Matches(vm) {
+				continue
+			}
+			c := Checklist{Wave: wave, VM: vm, Template: t.Name, Items: make([]Item, len(t.Items))}
+			for i, name := range t.Items {
+				c.Items[i] = Item{Name: name}
+			}
+			checklists = append(checklists, c)
+		}
+	}
+	return checklists
+}
+
+// Complete marks the item at index done, or not done, by a user.
+func (c *Checklist) Complete(index int, done bool, by string, at time.Time) error {
+	if index < 0 || index >= len(c.Items) {
+		return fmt.Errorf("checklist %s of VM %s has no item %d", c.Template, c.VM.Name, index)
+	}
+	item := &c.Items[index]
+	item.Done = done
+	item.CompletedAt, item.CompletedBy = nil, ""
+	if done {
+		item.CompletedAt, item.CompletedBy = &at, by
+	}
+	return nil
+}
+
+// Done tells whether all the items of the checklist are done.
+func (c Checklist) Done() bool {
+	for _, item := range c.Items {
+		if !item.Done {
+			return false
+		}
+	}
+	return true
+}
+
+// Progress rolls the checklists up per wave, in the order of first appearance of the waves.
+func Progress(checklists []Checklist) []WaveProgress {
+	var progress []WaveProgress
+	waves := make(map[string]int)
+	vms := make(map[string]map[string]bool) // wave -> VM -> all checklists done
+	for _, c := range checklists {
+		i, ok := waves[c.Wave]
+		if !ok {
+			i = len(progress)
+			waves[c.Wave] = i
+			progress = append(progress, WaveProgress{Wave: c.Wave})
+			vms[c.Wave] = make(map[string]bool)
+		}
+		done, seen := vms[c.Wave][c.VM.ID]
+		vms[c.Wave][c.VM.ID] = (done || !seen) && c.Done()
+
+		progress[i].Items += len(c.Items)
+		for _, item := range c.Items {
+			if item.Done {
+				progress[i].ItemsDone++
+			}
+		}
+	}
+	for i := range progress {
+		for _, done := range vms[progress[i].Wave] {
+			progress[i].VMs++
+			if done {
+				progress[i].VMsDone++
+			}
+		}
+	}
+	return progress
+}
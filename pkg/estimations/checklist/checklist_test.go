@@ -0,0 +1,111 @@
+package checklist
+
+import (
+	"testing"
+	"time"
+)
+
+var templates = []Template{
+	{Name: "baseline", Items: []string{"OS boots", "Monitoring restored"}},
+	{Name: "windows", Items: []string{"Backup job rescheduled"}, OperatingSystems: []string{"windows"}},
+	{Name: "billing", Items: []string{"App responds on port 8443"}, Applications: []string{"Billing"}},
+}
+
+var vms = []VM{
+	{ID: "vm-1", Name: "db-01", OS: "Microsoft Windows Server 2019 (64-bit)"},
+	{ID: "vm-2", Name: "app-01", OS: "Red Hat Enterprise Linux 9", Application: "billing"},
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		template Template
+	}{
+		{name: "no name", template: Template{Items: []string{"OS boots"}}},
+		{name: "no item", template: Template{Name: "baseline"}},
+		{name: "empty item", template: Template{Name: "baseline", Items: []string{" "}}},
+		{name: "duplicate item", template: Template{Name: "baseline", Items: []string{"OS boots", "OS boots"}}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tc.template.Validate(); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	t.Parallel()
+	checklists := Instantiate("wave-1", templates, vms)
+
+	got := make([]string, len(checklists))
+	for i, c := range checklists {
+		got[i] = c.VM.ID + "/" + c.Template
+	}
+	want := []string{"vm-1/baseline", "vm-1/windows", "vm-2/baseline", "vm-2/billing"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, got)
+			break
+		}
+	}
+	if c := checklists[0]; c.Wave != "wave-1" || len(c.Items) != 2 || c.Items[1].Name != "Monitoring restored" || c.Done() {
+		t.Errorf("unexpected checklist %+v", c)
+	}
+}
+
+func TestChecklist_Complete(t *testing.T) {
+	t.Parallel()
+	c := Instantiate("wave-1", templates[:1], vms[:1])[0]
+	at := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+
+	if err := c.Complete(2, true, "alice", at); err == nil {
+		t.Errorf("expected error for case %q, got nil", "unknown item")
+	}
+	for i := range c.Items {
+		if err := c.Complete(i, true, "alice", at); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+	}
+	if !c.Done() || c.Items[0].CompletedBy != "alice" || !c.Items[0].CompletedAt.Equal(at) {
+		t.Errorf("expected a completed checklist, got %+v", c)
+	}
+
+	if err := c.Complete(0, false, "bob", at); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if c.Done() || c.Items[0].CompletedAt != nil || c.Items[0].CompletedBy != "" {
+		t.Errorf("expected the item reopened, got %+v", c.Items[0])
+	}
+}
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+	checklists := append(Instantiate("wave-1", templates, vms), Instantiate("wave-2", templates[:1], vms[:1])...)
+	at := time.Now()
+	// vm-2 completes both its checklists, vm-1 only its baseline
+	for _, i := range []int{0, 2, 3} {
+		for j := range checklists[i].Items {
+			_ = checklists[i].Complete(j, true, "alice", at)
+		}
+	}
+
+	progress := Progress(checklists)
+	if len(progress) != 2 {
+		t.Fatalf("expected the progress of 2 waves, got %+v", progress)
+	}
+	if p := progress[0]; p.Wave != "wave-1" || p.VMs != 2 || p.VMsDone != 1 || p.Items != 6 || p.ItemsDone != 5 {
+		t.Errorf("unexpected progress of wave-1 %+v", p)
+	}
+	if p := progress[1]; p.Wave != "wave-2" || p.VMs != 1 || p.VMsDone != 0 || p.ItemsDone != 0 {
+		t.Errorf("unexpected progress of wave-2 %+v", p)
+	}
+}
@@ -0,0 +1,6 @@
+// Package checklist tracks the validation of the VMs of a wave after their cutover: templates
+// list the checks to run, e.g. the OS boots or monitoring is restored, and select the VMs they
+// apply to by application or operating system. At cutover a checklist is instantiated per VM
+// and matching template, and the completion of its items is rolled up per wave into the
+// progress of the plan.
+package checklist
@@ -0,0 +1,168 @@
+package compliance
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Label is a compliance regime a workload is subject to.
+type Label string
+
+const (
+	// LabelPCI is a workload processing cardholder data, in scope of PCI DSS.
+	LabelPCI Label = "pci"
+	// LabelHIPAA is a workload processing protected health information.
+	LabelHIPAA Label = "hipaa"
+	// LabelEUResidency is a workload whose data must stay in the European Union.
+	LabelEUResidency Label = "eu-residency"
+)
+
+// Labels are the supported labels.
+var Labels = []Label{LabelPCI, LabelHIPAA, LabelEUResidency}
+
+// ResidencyEU is the residency of a target whose data stays in the European Union.
+const ResidencyEU = "EU"
+
+// Validate checks the label is supported.
+func (l Label) Validate() error {
+	if !slices.Contains(Labels, l) {
+		return fmt.Errorf("unknown compliance label %q", l)
+	}
+	return nil
+}
+
+// Site is a target the labeled workloads may land on.
+type Site struct {
+	Name string
+	// Residency is the jurisdiction the data of the site stays in, e.g. ResidencyEU. Empty when unknown.
+	Residency string
+	// Certifications are the regimes the site is audited for, e.g. LabelPCI.
+	Certifications []Label
+}
+
+// Violation is a placement breaking the compliance regime of a workload.
+type Violation struct {
+	Label Label
+	// Subject is the cluster, application or VM placed.
+	Subject string
+	Site    string
+	Message string
+}
+
+// Concern returns the violation as an assessment concern. The workload can not be migrated to the
+// site, so the concern is critical.
+func (v Violation) Concern() inventory.Concern {
+	labels := map[Label]string{
+		LabelPCI:         "Target not certified for PCI DSS",
+		LabelHIPAA:       "Target not certified for HIPAA",
+		LabelEUResidency: "Target outside the EU",
+	}
+	return inventory.Concern{
+		ID:         "compliance." + string(v.Label),
+		Label:      labels[v.Label],
+		Category:   inventory.ConcernCategoryCritical,
+		Assessment: v.Message,
+	}
+}
+
+// Check returns the violations of placing a workload carrying the labels on the site.
+// A site of unknown residency violates the residency of the workload.
+func Check(subject string, labels []Label, site Site) []Violation {
+	var violations []Violation
+	for _, l := range labels {
+		var message string
+		switch l {
+		case LabelEUResidency:
+			if site.Residency != ResidencyEU {
+				message = fmt.Sprintf("the data of %s must stay in the EU, target %s is not located there", subject, site.Name)
+			}
+		default:
+			if !slices.Contains(site.Certifications, l) {
+				message = fmt.Sprintf("%s is in scope of %s, target %s is not certified for it", subject, l, site.Name)
+			}
+		}
+		if message != "" {
+			violations = append(violations, Violation{Label: l, Subject: subject, Site: site.Name, Message: message})
+		}
+	}
+	return violations
+}
+
+// Workload is a labeled application and its VMs.
+type Workload struct {
+	Name   string
+	Labels []Label
+	VMs    []inventory.VM
+}
+
+// Params counts the VMs of each label into the params of the ComplianceReview calculator. A VM of
+// several workloads with the same label is only counted once for it.
+func Params(workloads []Workload) []estimation.Param {
+	seen := make(map[Label]map[string]bool, len(Labels))
+	counts := make(map[Label]int, len(Labels))
+	for _, w := range workloads {
+		for _, l := range w.Labels {
+			if seen[l] == nil {
+				seen[l] = make(map[string]bool)
+			}
+			for _, vm := range w.VMs {
+				if vm.ID != "" && seen[l][vm.ID] {
+					continue
+				}
+				seen[l][vm.ID] = true
+				counts[l]++
+			}
+		}
+	}
+	return []estimation.Param{
+		{Key: calculators.ParamPCIVMCount, Value: counts[LabelPCI]},
+		{Key: calculators.ParamHIPAAVMCount, Value: counts[LabelHIPAA]},
+		{Key: calculators.ParamEUResidencyVMCount, Value: counts[LabelEUResidency]},
+	}
+}
+
+// validationItems are the checks a migrated VM of each label goes through on top of the usual ones.
+var validationItems = map[Label][]string{
+	LabelPCI: {
+		"Network segmentation of the cardholder data environment verified",
+		"Audit logging forwarded to the SIEM",
+		"Encryption at rest verified on the target storage",
+	},
+	LabelHIPAA: {
+		"Access to protected health information restricted to authorized roles",
+		"Audit logging forwarded to the SIEM",
+		"Encryption at rest verified on the target storage",
+	},
+	LabelEUResidency: {
+		"Disks, snapshots and backups stored in the EU",
+		"DR replica located in the EU",
+	},
+}
+
+// Templates returns a checklist template per label carried by the workloads, applying to the VMs of
+// the workloads carrying it, in the order of Labels.
+func Templates(workloads []Workload) []checklist.Template {
+	var templates []checklist.Template
+	for _, l := range Labels {
+		var apps []string
+		for _, w := range workloads {
+			if slices.Contains(w.Labels, l) {
+				apps = append(apps, w.Name)
+			}
+		}
+		if len(apps) == 0 {
+			continue
+		}
+		templates = append(templates, checklist.Template{
+			Name:         "compliance-" + string(l),
+			Items:        slices.Clone(validationItems[l]),
+			Applications: apps,
+		})
+	}
+	return templates
+}
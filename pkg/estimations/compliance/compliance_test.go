@@ -0,0 +1,108 @@
+package compliance
+
+import (
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	frankfurt := Site{Name: "ocp-frankfurt", Residency: ResidencyEU, Certifications: []Label{LabelPCI}}
+	virginia := Site{Name: "ocp-virginia", Residency: "US", Certifications: []Label{LabelPCI, LabelHIPAA}}
+
+	cases := []struct {
+		name   string
+		labels []Label
+		site   Site
+		want   []Label
+	}{
+		{name: "unlabeled workload lands anywhere", site: Site{Name: "lab"}},
+		{name: "certified and resident", labels: []Label{LabelPCI, LabelEUResidency}, site: frankfurt},
+		{name: "outside the EU", labels: []Label{LabelPCI, LabelEUResidency}, site: virginia, want: []Label{LabelEUResidency}},
+		{name: "not certified", labels: []Label{LabelHIPAA}, site: frankfurt, want: []Label{LabelHIPAA}},
+		{name: "unknown residency", labels: []Label{LabelEUResidency}, site: Site{Name: "lab"}, want: []Label{LabelEUResidency}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := Check("billing", tc.labels, tc.site)
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected violations of %v, got %v", tc.want, got)
+			}
+			for i, v := range got {
+				if v.Label != tc.want[i] || v.Subject != "billing" || v.Site != tc.site.Name {
+					t.Errorf("unexpected violation %+v", v)
+				}
+				if c := v.Concern(); c.Category != inventory.ConcernCategoryCritical || c.Label == "" {
+					t.Errorf("expected a critical concern, got %+v", c)
+				}
+			}
+		})
+	}
+}
+
+func TestLabel_Validate(t *testing.T) {
+	t.Parallel()
+	for _, l := range Labels {
+		if err := l.Validate(); err != nil {
+			t.Errorf("expected %s to be valid, got: %v", l, err)
+		}
+	}
+	if err := Label("sox").Validate(); err == nil {
+		t.Error("expected error for an unknown label")
+	}
+}
+
+var workloads = []Workload{
+	{Name: "payments", Labels: []Label{LabelPCI, LabelEUResidency}, VMs: []inventory.VM{{ID: "vm-1"}, {ID: "vm-2"}}},
+	{Name: "clearing", Labels: []Label{LabelPCI}, VMs: []inventory.VM{{ID: "vm-2"}, {ID: "vm-3"}}},
+	{Name: "intranet", VMs: []inventory.VM{{ID: "vm-4"}}},
+}
+
+func TestParams(t *testing.T) {
+	t.Parallel()
+	got := make(map[string]any)
+	for _, p := range Params(workloads) {
+		got[p.Key] = p.Value
+	}
+	want := map[string]any{
+		// vm-2 belongs to both PCI workloads
+		calculators.ParamPCIVMCount:         3,
+		calculators.ParamHIPAAVMCount:       0,
+		calculators.ParamEUResidencyVMCount: 2,
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("%s: expected %v, got %v", key, value, got[key])
+		}
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	t.Parallel()
+	templates := Templates(workloads)
+	if len(templates) != 2 || templates[0].Name != "compliance-pci" || templates[1].Name != "compliance-eu-residency" {
+		t.Fatalf("expected the PCI and residency templates, got %+v", templates)
+	}
+	for _, tmpl := range templates {
+		if err := tmpl.Validate(); err != nil {
+			t.Errorf("expected a valid template, got: %v", err)
+		}
+	}
+
+	vms := []checklist.VM{{ID: "vm-1", Application: "Payments"}, {ID: "vm-4", Application: "intranet"}}
+	checklists := checklist.Instantiate("wave-1", templates, vms)
+	if len(checklists) != 2 {
+		t.Fatalf("expected both templates for the payments VM only, got %d checklists", len(checklists))
+	}
+	for _, c := range checklists {
+		if c.VM.ID != "vm-1" {
+			t.Errorf("unexpected checklist %s for VM %s", c.Template, c.VM.ID)
+		}
+	}
+}
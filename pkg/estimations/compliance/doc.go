@@ -0,0 +1,9 @@
+// Package compliance checks the placement of regulated workloads: VMs and applications carrying a
+// compliance label (PCI, HIPAA, EU-only data residency) may only land on targets certified for the
+// regime, or located in the jurisdiction, and their migration is validated and signed off on top of
+// the usual checks.
+//
+// The violations are reported as assessment concerns and reject the placements of a plan. The
+// labeled VMs are counted into the params of the ComplianceReview calculator, which sizes the review,
+// and Templates lists the extra validation steps as checklist templates.
+package compliance
@@ -0,0 +1,184 @@
+package contingency
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// MinimumBuffer is the rule name of the buffer topping the others up to the minimum buffer.
+const MinimumBuffer = "minimum buffer"
+
+// Rule adds a buffer to the phases it matches.
+type Rule struct {
+	// Name labels the buffer, e.g. "change management".
+	Name string `json:"name"`
+	// Phase is the phase padded, or the calculator for an estimation, all of them when empty.
+	Phase string `json:"phase,omitempty"`
+	// Percent is the share of the duration of the phases padded added as buffer, e.g. 15.
+	Percent float64 `json:"percent,omitempty"`
+	// PerWave is the time added once to each wave holding the phase, e.g. a day of change management.
+	// An estimation counts as a single wave.
+	PerWave time.Duration `json:"perWave,omitempty"`
+}
+
+// Policy is the set of contingency rules of a plan or an organization.
+type Policy struct {
+	Rules []Rule `json:"rules,omitempty"`
+	// MinimumPercent is the share of the whole duration the buffers add up to at least. When the rules
+	// add less, a buffer of its own tops them up.
+	MinimumPercent float64 `json:"minimumPercent,omitempty"`
+}
+
+// Item is a phase of a wave, or the estimation of a calculator, that can be padded.
+type Item struct {
+	Name     string
+	Duration time.Duration
+}
+
+// Buffer is the time a rule adds, reported apart from the phases it pads.
+type Buffer struct {
+	// Rule is the name of the rule adding the buffer, MinimumBuffer for the top-up.
+	Rule     string
+	Duration time.Duration
+	Reason   string
+}
+
+// Name returns the name the buffer is reported under, e.g. "Contingency (change management)".
+func (b Buffer) Name() string {
+	return fmt.Sprintf("Contingency (%s)", b.Rule)
+}
+
+// Validate checks the rules are named uniquely and add a buffer, and the minimum buffer is a share.
+func (p Policy) Validate() error {
+	names := make(map[string]bool, len(p.Rules))
+	for _, r := range p.Rules {
+		if r.Name == "" {
+			return errors.New("contingency rule name is required")
+		}
+		if r.Name == MinimumBuffer {
+			return fmt.Errorf("contingency rule name %q is reserved", MinimumBuffer)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate contingency rule %q", r.Name)
+		}
+		names[r.Name] = true
+		if r.Percent < 0 {
+			return fmt.Errorf("contingency rule %q percent must be non-negative", r.Name)
+		}
+		if r.PerWave < 0 {
+			return fmt.Errorf("contingency rule %q time per wave must be non-negative", r.Name)
+		}
+		if r.Percent == 0 && r.PerWave == 0 {
+			return fmt.Errorf("contingency rule %q adds no buffer", r.Name)
+		}
+	}
+	if p.MinimumPercent < 0 || p.MinimumPercent > 100 {
+		return fmt.Errorf("minimum buffer %.4g%% must be in [0, 100]", p.MinimumPercent)
+	}
+	return nil
+}
+
+// IsZero reports whether the policy adds no buffer.
+func (p Policy) IsZero() bool {
+	return len(p.Rules) == 0 && p.MinimumPercent == 0
+}
+
+// Pad returns the buffers of the items of a wave, or of an estimation: one per rule matching an item,
+// in the order of the rules, then the top-up to the minimum buffer when the rules add less.
+func (p Policy) Pad(items []Item) []Buffer {
+	var total, padded time.Duration
+	for _, it := range items {
+		total += it.Duration
+	}
+
+	var buffers []Buffer
+	for _, r := range p.Rules {
+		var matched time.Duration
+		found := false
+		for _, it := range items {
+			if r.Phase == "" || it.Name == r.Phase {
+				matched += it.Duration
+				found = true
+			}
+		}
+		if !found {
+			continue
+		}
+		d := time.Duration(float64(matched)*r.Percent/100) + r.PerWave
+		if d <= 0 {
+			continue
+		}
+		buffers = append(buffers, Buffer{Rule: r.Name, Duration: d, Reason: r.reason(matched)})
+		padded += d
+	}
+
+	if minimum := time.Duration(float64(total) * p.MinimumPercent / 100); padded < minimum {
+		buffers = append(buffers, Buffer{
+			Rule:     MinimumBuffer,
+			Duration: minimum - padded,
+			Reason:   fmt.Sprintf("tops the buffers of %v up to %.4g%% of %v", padded, p.MinimumPercent, total),
+		})
+	}
+	return buffers
+}
+
+// reason explains the buffer the rule adds to the phases it matches, whose duration is matched.
+func (r Rule) reason(matched time.Duration) string {
+	subject := "all phases"
+	if r.Phase != "" {
+		subject = r.Phase
+	}
+	parts := make([]string, 0, 2)
+	if r.Percent > 0 {
+		parts = append(parts, fmt.Sprintf("%.4g%% of %s (%v)", r.Percent, subject, matched))
+	}
+	if r.PerWave > 0 {
+		parts = append(parts, fmt.Sprintf("%v per wave", r.PerWave))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// Breakdown returns the buffers of the estimations of the calculators, keyed by calculator name, as
+// estimations keyed by the name of the buffer, to be reported next to them.
+func (p Policy) Breakdown(results map[string]estimation.Estimation) map[string]estimation.Estimation {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]Item, 0, len(names))
+	for _, name := range names {
+		items = append(items, Item{Name: name, Duration: results[name].Duration})
+	}
+
+	buffers := p.Pad(items)
+	res := make(map[string]estimation.Estimation, len(buffers))
+	for _, b := range buffers {
+		res[b.Name()] = estimation.Estimation{Duration: b.Duration, Reason: b.Reason}
+	}
+	return res
+}
+
+// Floor returns the policy of a plan under the policy of its organization: the plan keeps its own
+// rules, or takes the ones of the organization when it has none, and its minimum buffer is no lower
+// than the one of the organization. It returns nil when neither pads the plan.
+func Floor(plan *Policy, org Policy) *Policy {
+	if plan == nil {
+		if org.IsZero() {
+			return nil
+		}
+		p := org
+		p.Rules = slices.Clone(org.Rules)
+		return &p
+	}
+	p := *plan
+	p.MinimumPercent = max(p.MinimumPercent, org.MinimumPercent)
+	return &p
+}
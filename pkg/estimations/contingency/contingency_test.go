@@ -0,0 +1,137 @@
+package contingency
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestPolicy_Pad(t *testing.T) {
+	t.Parallel()
+	items := []Item{{Name: "Transfer", Duration: 40 * time.Hour}, {Name: "Cutover", Duration: 10 * time.Hour}}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   map[string]time.Duration
+	}{
+		{
+			name:   "percent of a phase",
+			policy: Policy{Rules: []Rule{{Name: "transfer", Phase: "Transfer", Percent: 15}}},
+			want:   map[string]time.Duration{"transfer": 6 * time.Hour},
+		},
+		{
+			name:   "time per wave",
+			policy: Policy{Rules: []Rule{{Name: "change management", PerWave: 24 * time.Hour}}},
+			want:   map[string]time.Duration{"change management": 24 * time.Hour},
+		},
+		{
+			name:   "rule of a phase the wave does not hold",
+			policy: Policy{Rules: []Rule{{Name: "validation", Phase: "Validation", PerWave: time.Hour}}},
+			want:   map[string]time.Duration{},
+		},
+		{
+			name: "minimum tops the rules up",
+			policy: Policy{
+				Rules:          []Rule{{Name: "transfer", Phase: "Transfer", Percent: 10}},
+				MinimumPercent: 20,
+			},
+			want: map[string]time.Duration{"transfer": 4 * time.Hour, MinimumBuffer: 6 * time.Hour},
+		},
+		{
+			name: "minimum already met",
+			policy: Policy{
+				Rules:          []Rule{{Name: "transfer", Phase: "Transfer", Percent: 50}},
+				MinimumPercent: 20,
+			},
+			want: map[string]time.Duration{"transfer": 20 * time.Hour},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			buffers := tc.policy.Pad(items)
+			if len(buffers) != len(tc.want) {
+				t.Fatalf("expected %d buffers, got %+v", len(tc.want), buffers)
+			}
+			for _, b := range buffers {
+				if want, ok := tc.want[b.Rule]; !ok || b.Duration != want {
+					t.Errorf("expected buffer %q of %v, got %v", b.Rule, want, b.Duration)
+				}
+				if b.Reason == "" {
+					t.Errorf("expected buffer %q to be explained", b.Rule)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicy_Breakdown(t *testing.T) {
+	t.Parallel()
+	policy := Policy{Rules: []Rule{{Name: "transfer", Phase: "Storage Migration", Percent: 15}}}
+	buffers := policy.Breakdown(map[string]estimation.Estimation{
+		"Storage Migration":              {Duration: 20 * time.Hour},
+		"Post-Migration Troubleshooting": {Duration: 5 * time.Hour},
+	})
+
+	b, ok := buffers["Contingency (transfer)"]
+	if !ok || len(buffers) != 1 {
+		t.Fatalf("expected a single transfer buffer, got %+v", buffers)
+	}
+	if b.Duration != 3*time.Hour {
+		t.Errorf("expected 3h of buffer, got %v", b.Duration)
+	}
+	if !strings.Contains(b.Reason, "15% of Storage Migration") {
+		t.Errorf("expected reason to explain the buffer, got %q", b.Reason)
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		policy  Policy
+		wantErr string
+	}{
+		{name: "unnamed rule", policy: Policy{Rules: []Rule{{Percent: 10}}}, wantErr: "name is required"},
+		{name: "reserved name", policy: Policy{Rules: []Rule{{Name: MinimumBuffer, Percent: 10}}}, wantErr: "reserved"},
+		{name: "duplicate rule", policy: Policy{Rules: []Rule{{Name: "a", Percent: 10}, {Name: "a", Percent: 5}}}, wantErr: "duplicate"},
+		{name: "negative percent", policy: Policy{Rules: []Rule{{Name: "a", Percent: -1}}}, wantErr: "non-negative"},
+		{name: "empty rule", policy: Policy{Rules: []Rule{{Name: "a"}}}, wantErr: "adds no buffer"},
+		{name: "minimum above 100", policy: Policy{MinimumPercent: 120}, wantErr: "minimum buffer"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.policy.Validate()
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFloor(t *testing.T) {
+	t.Parallel()
+	org := Policy{Rules: []Rule{{Name: "change management", PerWave: 24 * time.Hour}}, MinimumPercent: 10}
+
+	if got := Floor(nil, Policy{}); got != nil {
+		t.Errorf("expected no policy, got %+v", got)
+	}
+	if got := Floor(nil, org); got == nil || len(got.Rules) != 1 || got.MinimumPercent != 10 {
+		t.Errorf("expected the plan to take the policy of the organization, got %+v", got)
+	}
+
+	own := &Policy{Rules: []Rule{{Name: "transfer", Phase: "Transfer", Percent: 15}}, MinimumPercent: 5}
+	got := Floor(own, org)
+	if len(got.Rules) != 1 || got.Rules[0].Name != "transfer" {
+		t.Errorf("expected the plan to keep its rules, got %+v", got.Rules)
+	}
+	if got.MinimumPercent != 10 {
+		t.Errorf("expected the minimum buffer of the organization, got %v", got.MinimumPercent)
+	}
+}
@@ -0,0 +1,6 @@
+// Package contingency pads the estimations with buffers kept apart from them: rules add a share of
+// the effort of some phases, e.g. 15% on the transfers, or a fixed time to each wave, e.g. a day of
+// change management, and a minimum buffer tops them up to a share of the whole effort. The buffers
+// are reported as line items of their own, so padded numbers can be explained instead of being
+// hidden in tweaked rates.
+package contingency
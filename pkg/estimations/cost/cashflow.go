@@ -0,0 +1,221 @@
+package cost
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Granularity is the length of a budget period.
+type Granularity string
+
+const (
+	GranularityMonth   Granularity = "month"
+	GranularityQuarter Granularity = "quarter"
+)
+
+// Item is a cost spent evenly between Start and End. An Item without End is spent at Start.
+type Item struct {
+	Name   string
+	Amount float64
+	// Currency is the ISO 4217 code of the amount, e.g. the currency of the rate card it comes from.
+	Currency string
+	Start    time.Time
+	End      time.Time
+}
+
+// Period is a budget period, [Start, End).
+type Period struct {
+	Label string
+	Start time.Time
+	End   time.Time
+}
+
+// Row is the cost of every item within a period.
+type Row struct {
+	Period Period
+	// Items maps item names to the part of their amount spent within the period.
+	Items      map[string]float64
+	Total      float64
+	Cumulative float64
+}
+
+// CashFlow is the cost of a program bucketed by budget period. Periods without costs between
+// the first and the last spending are included so the table has no gaps.
+type CashFlow struct {
+	Granularity Granularity
+	Currency    string
+	Rows        []Row
+	Total       float64
+	// ExchangeRates are the rates used to convert the items to Currency.
+	ExchangeRates []ExchangeRate
+}
+
+// FiscalCalendar buckets costs by budget period.
+type FiscalCalendar struct {
+	// StartMonth is the first month of the fiscal year. Zero means January.
+	StartMonth time.Month
+}
+
+// NewFiscalCalendar returns a calendar whose fiscal year starts on startMonth.
+func NewFiscalCalendar(startMonth time.Month) (FiscalCalendar, error) {
+	if startMonth < time.January || startMonth > time.December {
+		return FiscalCalendar{}, fmt.Errorf("invalid fiscal year start month %d", startMonth)
+	}
+	return FiscalCalendar{StartMonth: startMonth}, nil
+}
+
+func (f FiscalCalendar) startMonth() time.Month {
+	if f.StartMonth == 0 {
+		return time.January
+	}
+	return f.StartMonth
+}
+
+// FiscalYear returns the fiscal year of t, named after the calendar year in which it ends.
+func (f FiscalCalendar) FiscalYear(t time.Time) int {
+	if f.startMonth() == time.January || t.Month() < f.startMonth() {
+		return t.Year()
+	}
+	return t.Year() + 1
+}
+
+// Period returns the budget period of the given granularity containing t.
+func (f FiscalCalendar) Period(t time.Time, g Granularity) (Period, error) {
+	monthStart := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	switch g {
+	case GranularityMonth:
+		return Period{
+			Label: monthStart.Format("2006-01"),
+			Start: monthStart,
+			End:   monthStart.AddDate(0, 1, 0),
+		}, nil
+	case GranularityQuarter:
+		offset := (int(t.Month()) - int(f.startMonth()) + 12) % 12
+		start := monthStart.AddDate(0, -(offset % 3), 0)
+		return Period{
+			Label: fmt.Sprintf("FY%d Q%d", f.FiscalYear(t), offset/3+1),
+			Start: start,
+			End:   start.AddDate(0, 3, 0),
+		}, nil
+	default:
+		return Period{}, fmt.Errorf("unknown granularity %q", g)
+	}
+}
+
+// CashFlow spreads every item over the budget periods it spans, in proportion of the time spent in each.
+// All the items must be in the same currency; see CashFlowIn to convert them first.
+func (f FiscalCalendar) CashFlow(items []Item, g Granularity) (CashFlow, error) {
+	cf := CashFlow{Granularity: g}
+	if len(items) == 0 {
+		return cf, nil
+	}
+
+	items = append([]Item(nil), items...)
+	for i := range items {
+		if items[i].End.IsZero() {
+			items[i].End = items[i].Start
+		}
+	}
+
+	first, last := items[0].Start, items[0].End
+	for _, it := range items {
+		if it.Name == "" {
+			return CashFlow{}, errors.New("cost item name is required")
+		}
+		if math.IsNaN(it.Amount) || math.IsInf(it.Amount, 0) {
+			return CashFlow{}, fmt.Errorf("cost item %q has an invalid amount", it.Name)
+		}
+		if it.End.Before(it.Start) {
+			return CashFlow{}, fmt.Errorf("cost item %q ends before it starts", it.Name)
+		}
+		if it.Currency != items[0].Currency {
+			return CashFlow{}, fmt.Errorf("cost item %q is in %q, expected %q", it.Name, it.Currency, items[0].Currency)
+		}
+		if it.Start.Before(first) {
+			first = it.Start
+		}
+		if it.End.After(last) {
+			last = it.End
+		}
+	}
+
+	cf.Currency = items[0].Currency
+
+	period, err := f.Period(first, g)
+	if err != nil {
+		return CashFlow{}, err
+	}
+	for {
+		row := Row{Period: period, Items: map[string]float64{}}
+		for _, it := range items {
+			if amount := it.amountWithin(period); amount != 0 {
+				row.Items[it.Name] += amount
+				row.Total += amount
+			}
+		}
+		cf.Total += row.Total
+		row.Cumulative = cf.Total
+		cf.Rows = append(cf.Rows, row)
+
+		// a one-time cost on the boundary belongs to the next period
+		if period.End.After(last) || (period.End.Equal(last) && !instantAt(items, last)) {
+			break
+		}
+		if period, err = f.Period(period.End, g); err != nil {
+			return CashFlow{}, err
+		}
+	}
+
+	return cf, nil
+}
+
+// Names returns the item names found in the cash flow, sorted.
+func (cf CashFlow) Names() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, r := range cf.Rows {
+		for name := range r.Items {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// amountWithin returns the part of the item spent within the period.
+func (it Item) amountWithin(p Period) float64 {
+	if !it.End.After(it.Start) {
+		if !it.Start.Before(p.Start) && it.Start.Before(p.End) {
+			return it.Amount
+		}
+		return 0
+	}
+
+	from, to := it.Start, it.End
+	if p.Start.After(from) {
+		from = p.Start
+	}
+	if p.End.Before(to) {
+		to = p.End
+	}
+	if !to.After(from) {
+		return 0
+	}
+	return it.Amount * float64(to.Sub(from)) / float64(it.End.Sub(it.Start))
+}
+
+// instantAt reports whether an item without duration is spent at t.
+func instantAt(items []Item, t time.Time) bool {
+	for _, it := range items {
+		if it.Start.Equal(it.End) && it.Start.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package cost
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFiscalCalendar_Period(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name      string
+		start     time.Month
+		at        time.Time
+		g         Granularity
+		wantLabel string
+		wantStart time.Time
+	}{
+		{name: "month", start: time.October, at: date(2026, 2, 14), g: GranularityMonth, wantLabel: "2026-02", wantStart: date(2026, 2, 1)},
+		{name: "calendar quarter", start: time.January, at: date(2026, 5, 20), g: GranularityQuarter, wantLabel: "FY2026 Q2", wantStart: date(2026, 4, 1)},
+		{name: "first fiscal quarter", start: time.October, at: date(2025, 11, 3), g: GranularityQuarter, wantLabel: "FY2026 Q1", wantStart: date(2025, 10, 1)},
+		{name: "fiscal quarter across new year", start: time.October, at: date(2026, 2, 14), g: GranularityQuarter, wantLabel: "FY2026 Q2", wantStart: date(2026, 1, 1)},
+		{name: "last fiscal quarter", start: time.April, at: date(2027, 3, 31), g: GranularityQuarter, wantLabel: "FY2027 Q4", wantStart: date(2027, 1, 1)},
+		{name: "quarter not aligned on calendar", start: time.February, at: date(2026, 1, 10), g: GranularityQuarter, wantLabel: "FY2026 Q4", wantStart: date(2025, 11, 1)},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cal, err := NewFiscalCalendar(tc.start)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			p, err := cal.Period(tc.at, tc.g)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if p.Label != tc.wantLabel || !p.Start.Equal(tc.wantStart) {
+				t.Errorf("expected %s starting %v, got %s starting %v", tc.wantLabel, tc.wantStart, p.Label, p.Start)
+			}
+		})
+	}
+}
+
+func TestFiscalCalendar_CashFlow_ByMonth(t *testing.T) {
+	t.Parallel()
+	items := []Item{
+		// 31 days in January, 28 in February
+		{Name: "labor", Amount: 5900, Start: date(2026, 1, 1), End: date(2026, 3, 1)},
+		{Name: "licenses", Amount: 1000, Start: date(2026, 3, 1)},
+	}
+
+	cf, err := FiscalCalendar{}.CashFlow(items, GranularityMonth)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(cf.Rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(cf.Rows))
+	}
+	want := []float64{3100, 2800, 1000}
+	for i, row := range cf.Rows {
+		if math.Abs(row.Total-want[i]) > 1e-6 {
+			t.Errorf("expected %s total %.2f, got %.2f", row.Period.Label, want[i], row.Total)
+		}
+	}
+	if cf.Rows[2].Cumulative != 6900 || cf.Total != 6900 {
+		t.Errorf("expected cumulative total 6900, got %.2f / %.2f", cf.Rows[2].Cumulative, cf.Total)
+	}
+	if names := cf.Names(); len(names) != 2 || names[0] != "labor" || names[1] != "licenses" {
+		t.Errorf("unexpected names %v", names)
+	}
+}
+
+func TestFiscalCalendar_CashFlow_ByFiscalQuarterIncludesEmptyPeriods(t *testing.T) {
+	t.Parallel()
+	cal, _ := NewFiscalCalendar(time.July)
+	items := []Item{
+		{Name: "hardware", Amount: 200, Start: date(2026, 7, 15)},
+		{Name: "decommission", Amount: 300, Start: date(2027, 2, 1)},
+	}
+
+	cf, err := cal.CashFlow(items, GranularityQuarter)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	labels := []string{"FY2027 Q1", "FY2027 Q2", "FY2027 Q3"}
+	if len(cf.Rows) != len(labels) {
+		t.Fatalf("expected %d rows, got %d", len(labels), len(cf.Rows))
+	}
+	for i, row := range cf.Rows {
+		if row.Period.Label != labels[i] {
+			t.Errorf("expected row %d to be %s, got %s", i, labels[i], row.Period.Label)
+		}
+	}
+	if cf.Rows[1].Total != 0 || cf.Rows[2].Items["decommission"] != 300 {
+		t.Errorf("unexpected rows %+v", cf.Rows)
+	}
+}
+
+func TestFiscalCalendar_CashFlow_InvalidInput(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name  string
+		items []Item
+		g     Granularity
+	}{
+		{name: "unknown granularity", items: []Item{{Name: "labor", Amount: 1, Start: date(2026, 1, 1)}}, g: "week"},
+		{name: "missing name", items: []Item{{Amount: 1, Start: date(2026, 1, 1)}}, g: GranularityMonth},
+		{name: "ends before start", items: []Item{{Name: "labor", Amount: 1, Start: date(2026, 2, 1), End: date(2026, 1, 1)}}, g: GranularityMonth},
+		{name: "NaN amount", items: []Item{{Name: "labor", Amount: math.NaN(), Start: date(2026, 1, 1)}}, g: GranularityMonth},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := (FiscalCalendar{}).CashFlow(tc.items, tc.g); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+
+	if _, err := NewFiscalCalendar(13); err == nil {
+		t.Error("expected error for invalid fiscal year start month")
+	}
+}
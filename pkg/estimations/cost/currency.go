@@ -0,0 +1,175 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ErrNoExchangeRate is returned when neither a manual rate nor the provider converts a pair.
+var ErrNoExchangeRate = errors.New("no exchange rate")
+
+// Sources of exchange rates.
+const (
+	RateSourceManual = "manual"
+	RateSourceAPI    = "api"
+)
+
+// ExchangeRate converts an amount in From to To: amount in To = amount in From * Rate.
+// AsOf and Source are reported along with the costs for auditability.
+type ExchangeRate struct {
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Rate   float64   `json:"rate"`
+	AsOf   time.Time `json:"asOf"`
+	Source string    `json:"source"`
+}
+
+// RateProvider fetches exchange rates from an external service.
+type RateProvider interface {
+	Rate(ctx context.Context, from, to string) (ExchangeRate, error)
+}
+
+// Rates holds the exchange rates available to a report. Rates entered manually take precedence
+// over the provider, which is only asked for pairs that are not known.
+type Rates struct {
+	rates    map[string]ExchangeRate
+	provider RateProvider
+}
+
+// RatesOption is a functional option for configuring Rates.
+type RatesOption func(*Rates)
+
+// WithRate adds a manually entered exchange rate.
+func WithRate(from, to string, rate float64, asOf time.Time) RatesOption {
+	return func(r *Rates) {
+		r.rates[pair(from, to)] = ExchangeRate{From: from, To: to, Rate: rate, AsOf: asOf, Source: RateSourceManual}
+	}
+}
+
+// WithRateProvider sets the provider used for pairs without a manual rate.
+func WithRateProvider(p RateProvider) RatesOption {
+	return func(r *Rates) {
+		r.provider = p
+	}
+}
+
+// NewRates creates the set of exchange rates, validating the manual ones.
+func NewRates(opts ...RatesOption) (*Rates, error) {
+	r := Rates{rates: make(map[string]ExchangeRate)}
+	for _, opt := range opts {
+		opt(&r)
+	}
+	for _, rate := range r.rates {
+		if err := rate.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+// Lookup returns the rate converting from into to. The inverse of a known rate is used when
+// only the opposite pair is known.
+func (r *Rates) Lookup(ctx context.Context, from, to string) (ExchangeRate, error) {
+	if from == to {
+		return ExchangeRate{From: from, To: to, Rate: 1}, nil
+	}
+	if rate, ok := r.rates[pair(from, to)]; ok {
+		return rate, nil
+	}
+	if rate, ok := r.rates[pair(to, from)]; ok {
+		return ExchangeRate{From: from, To: to, Rate: 1 / rate.Rate, AsOf: rate.AsOf, Source: rate.Source}, nil
+	}
+	if r.provider == nil {
+		return ExchangeRate{}, fmt.Errorf("%w from %s to %s", ErrNoExchangeRate, from, to)
+	}
+
+	rate, err := r.provider.Rate(ctx, from, to)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("failed to fetch exchange rate from %s to %s: %w", from, to, err)
+	}
+	rate.From, rate.To = from, to
+	if rate.Source == "" {
+		rate.Source = RateSourceAPI
+	}
+	if err := rate.validate(); err != nil {
+		return ExchangeRate{}, err
+	}
+	r.rates[pair(from, to)] = rate
+	return rate, nil
+}
+
+// Convert returns the items in currency along with the exchange rates used, sorted by source currency.
+// Items without currency are already in the display currency.
+func (r *Rates) Convert(ctx context.Context, items []Item, currency string) ([]Item, []ExchangeRate, error) {
+	if !currencyCode.MatchString(currency) {
+		return nil, nil, fmt.Errorf("invalid currency %q", currency)
+	}
+
+	used := map[string]ExchangeRate{}
+	converted := make([]Item, 0, len(items))
+	for _, it := range items {
+		if it.Currency != "" && it.Currency != currency {
+			rate, err := r.Lookup(ctx, it.Currency, currency)
+			if err != nil {
+				return nil, nil, fmt.Errorf("cost item %q: %w", it.Name, err)
+			}
+			used[it.Currency] = rate
+			it.Amount *= rate.Rate
+		}
+		it.Currency = currency
+		converted = append(converted, it)
+	}
+
+	rates := make([]ExchangeRate, 0, len(used))
+	for _, rate := range used {
+		rates = append(rates, rate)
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i].From < rates[j].From })
+	return converted, rates, nil
+}
+
+// CashFlowIn converts the items to currency and buckets them, recording the exchange rates in the cash flow.
+func (f FiscalCalendar) CashFlowIn(ctx context.Context, items []Item, g Granularity, currency string, rates *Rates) (CashFlow, error) {
+	converted, used, err := rates.Convert(ctx, items, currency)
+	if err != nil {
+		return CashFlow{}, err
+	}
+	cf, err := f.CashFlow(converted, g)
+	if err != nil {
+		return CashFlow{}, err
+	}
+	cf.Currency = currency
+	cf.ExchangeRates = used
+	return cf, nil
+}
+
+// Equal reports whether the rates convert the same pair at the same rate, taken on the same date
+// from the same source.
+func (e ExchangeRate) Equal(o ExchangeRate) bool {
+	return e.From == o.From && e.To == o.To && e.Rate == o.Rate && e.AsOf.Equal(o.AsOf) && e.Source == o.Source
+}
+
+func (e ExchangeRate) validate() error {
+	if !currencyCode.MatchString(e.From) || !currencyCode.MatchString(e.To) {
+		return fmt.Errorf("invalid currency pair %s/%s", e.From, e.To)
+	}
+	if e.Rate <= 0 || math.IsNaN(e.Rate) || math.IsInf(e.Rate, 0) {
+		return fmt.Errorf("exchange rate from %s to %s must be a positive number", e.From, e.To)
+	}
+	if e.AsOf.IsZero() {
+		return fmt.Errorf("exchange rate from %s to %s has no as-of date", e.From, e.To)
+	}
+	return nil
+}
+
+func pair(from, to string) string {
+	return strings.Join([]string{from, to}, "/")
+}
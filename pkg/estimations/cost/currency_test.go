@@ -0,0 +1,128 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+type fakeProvider struct {
+	rate  ExchangeRate
+	err   error
+	calls int
+}
+
+func (p *fakeProvider) Rate(_ context.Context, from, to string) (ExchangeRate, error) {
+	p.calls++
+	return p.rate, p.err
+}
+
+func TestRates_Lookup(t *testing.T) {
+	t.Parallel()
+	asOf := date(2026, 1, 2)
+	provider := &fakeProvider{rate: ExchangeRate{Rate: 0.0067, AsOf: asOf}}
+	rates, err := NewRates(WithRate("EUR", "USD", 1.25, asOf), WithRateProvider(provider))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	cases := []struct {
+		from, to   string
+		wantRate   float64
+		wantSource string
+	}{
+		{from: "EUR", to: "USD", wantRate: 1.25, wantSource: RateSourceManual},
+		{from: "USD", to: "EUR", wantRate: 0.8, wantSource: RateSourceManual},
+		{from: "USD", to: "USD", wantRate: 1},
+		{from: "JPY", to: "USD", wantRate: 0.0067, wantSource: RateSourceAPI},
+	}
+	for _, tc := range cases {
+		rate, err := rates.Lookup(context.Background(), tc.from, tc.to)
+		if err != nil {
+			t.Fatalf("%s/%s: expected no error, got: %v", tc.from, tc.to, err)
+		}
+		if math.Abs(rate.Rate-tc.wantRate) > 1e-9 || rate.Source != tc.wantSource {
+			t.Errorf("%s/%s: expected %v from %q, got %v from %q", tc.from, tc.to, tc.wantRate, tc.wantSource, rate.Rate, rate.Source)
+		}
+	}
+
+	// fetched rates are kept for the rest of the report
+	if _, err := rates.Lookup(context.Background(), "JPY", "USD"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if provider.calls != 1 {
+		t.Errorf("expected the provider to be called once, got %d", provider.calls)
+	}
+}
+
+func TestRates_LookupErrors(t *testing.T) {
+	t.Parallel()
+	rates, _ := NewRates()
+	if _, err := rates.Lookup(context.Background(), "EUR", "USD"); !errors.Is(err, ErrNoExchangeRate) {
+		t.Errorf("expected ErrNoExchangeRate without rate nor provider, got %v", err)
+	}
+
+	failing, _ := NewRates(WithRateProvider(&fakeProvider{err: errors.New("unavailable")}))
+	if _, err := failing.Lookup(context.Background(), "EUR", "USD"); err == nil {
+		t.Error("expected error when the provider fails")
+	}
+
+	undated, _ := NewRates(WithRateProvider(&fakeProvider{rate: ExchangeRate{Rate: 1.1}}))
+	if _, err := undated.Lookup(context.Background(), "EUR", "USD"); err == nil {
+		t.Error("expected error when the provider returns no as-of date")
+	}
+
+	invalid, _ := NewRates(WithRateProvider(&fakeProvider{rate: ExchangeRate{Rate: math.NaN(), AsOf: date(2026, 1, 1)}}))
+	if _, err := invalid.Lookup(context.Background(), "EUR", "USD"); err == nil {
+		t.Error("expected error when the provider returns NaN")
+	}
+
+	if _, err := NewRates(WithRate("EUR", "USD", -1, date(2026, 1, 1))); err == nil {
+		t.Error("expected error for negative rate")
+	}
+	for _, rate := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := NewRates(WithRate("EUR", "USD", rate, date(2026, 1, 1))); err == nil {
+			t.Errorf("expected error for rate %v", rate)
+		}
+	}
+	if _, err := NewRates(WithRate("euro", "USD", 1, date(2026, 1, 1))); err == nil {
+		t.Error("expected error for invalid currency")
+	}
+}
+
+func TestFiscalCalendar_CashFlowIn(t *testing.T) {
+	t.Parallel()
+	asOf := date(2026, 1, 2)
+	rates, _ := NewRates(WithRate("EUR", "USD", 1.25, asOf))
+	items := []Item{
+		{Name: "partner labor", Amount: 1000, Currency: "EUR", Start: date(2026, 1, 10)},
+		{Name: "internal labor", Amount: 500, Currency: "USD", Start: date(2026, 1, 20)},
+		{Name: "licenses", Amount: 100, Start: date(2026, 2, 1)},
+	}
+
+	cf, err := FiscalCalendar{}.CashFlowIn(context.Background(), items, GranularityMonth, "USD", rates)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if cf.Currency != "USD" || cf.Total != 1850 {
+		t.Errorf("expected 1850 USD, got %v %s", cf.Total, cf.Currency)
+	}
+	if cf.Rows[0].Items["partner labor"] != 1250 {
+		t.Errorf("expected partner labor converted to 1250 USD, got %v", cf.Rows[0].Items["partner labor"])
+	}
+	if len(cf.ExchangeRates) != 1 || cf.ExchangeRates[0].From != "EUR" || !cf.ExchangeRates[0].AsOf.Equal(asOf) {
+		t.Errorf("expected the EUR rate to be recorded, got %+v", cf.ExchangeRates)
+	}
+}
+
+func TestFiscalCalendar_CashFlow_MixedCurrencies(t *testing.T) {
+	t.Parallel()
+	items := []Item{
+		{Name: "partner labor", Amount: 1000, Currency: "EUR", Start: date(2026, 1, 10)},
+		{Name: "internal labor", Amount: 500, Currency: "USD", Start: date(2026, 1, 20)},
+	}
+	if _, err := (FiscalCalendar{}).CashFlow(items, GranularityMonth); err == nil {
+		t.Error("expected error for mixed currencies")
+	}
+}
@@ -0,0 +1,9 @@
+// Package cost turns the cost items of a migration program into a cash-flow table.
+//
+// An Item is an amount spent evenly over a period of time (or at once when it has no duration),
+// in the currency of the rate card it comes from. Rates converts items to a display currency
+// using manual or provider-fed exchange rates, whose as-of dates are kept in the CashFlow.
+// A FiscalCalendar buckets the items by calendar month or by fiscal quarter, the fiscal year
+// starting on a configurable month, so finance stakeholders get one row per budget period
+// instead of a lump sum.
+package cost
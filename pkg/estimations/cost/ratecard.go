@@ -0,0 +1,90 @@
+package cost
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// RateCard is a versioned price list: the hourly cost of each role for a region, valid over a period.
+// Partner and internal delivery use the same calculators with different cards.
+type RateCard struct {
+	Name    string
+	Version int
+	// Currency is the ISO 4217 code of the hourly rates.
+	Currency  string
+	Region    string
+	ValidFrom time.Time
+	// ValidUntil is the end of the validity, exclusive. Nil means the card does not expire.
+	ValidUntil *time.Time
+	// HourlyRates maps a role to its hourly cost.
+	HourlyRates map[string]float64
+}
+
+// Validate checks the card can price labor.
+func (c RateCard) Validate() error {
+	if c.Name == "" {
+		return errors.New("rate card name is required")
+	}
+	if !currencyCode.MatchString(c.Currency) {
+		return fmt.Errorf("rate card %q has invalid currency %q", c.Name, c.Currency)
+	}
+	if c.ValidUntil != nil && !c.ValidUntil.After(c.ValidFrom) {
+		return fmt.Errorf("rate card %q expires before it becomes valid", c.Name)
+	}
+	if len(c.HourlyRates) == 0 {
+		return fmt.Errorf("rate card %q has no rates", c.Name)
+	}
+	for role, rate := range c.HourlyRates {
+		if role == "" {
+			return fmt.Errorf("rate card %q has a rate without role", c.Name)
+		}
+		if rate < 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+			return fmt.Errorf("rate card %q has invalid rate %v for role %q", c.Name, rate, role)
+		}
+	}
+	return nil
+}
+
+// ValidAt reports whether the card applies at t.
+func (c RateCard) ValidAt(t time.Time) bool {
+	if t.Before(c.ValidFrom) {
+		return false
+	}
+	return c.ValidUntil == nil || t.Before(*c.ValidUntil)
+}
+
+// HourlyRate returns the hourly cost of role.
+func (c RateCard) HourlyRate(role string) (float64, error) {
+	rate, ok := c.HourlyRates[role]
+	if !ok {
+		return 0, fmt.Errorf("rate card %q v%d has no rate for role %q", c.Name, c.Version, role)
+	}
+	return rate, nil
+}
+
+// Labor prices the work of a calculator result: engineers of the given role busy for the estimated
+// duration, spread between start and start+elapsed. The card has to be valid when the work starts.
+func (c RateCard) Labor(name, role string, est estimation.Estimation, engineers int, start time.Time, elapsed time.Duration) (Item, error) {
+	if engineers < 0 {
+		return Item{}, fmt.Errorf("%s: negative engineer count %d", name, engineers)
+	}
+	if !c.ValidAt(start) {
+		return Item{}, fmt.Errorf("%s: rate card %q v%d is not valid on %s", name, c.Name, c.Version, start.Format(time.DateOnly))
+	}
+	rate, err := c.HourlyRate(role)
+	if err != nil {
+		return Item{}, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return Item{
+		Name:     name,
+		Amount:   est.Duration.Hours() * float64(engineers) * rate,
+		Currency: c.Currency,
+		Start:    start,
+		End:      start.Add(elapsed),
+	}, nil
+}
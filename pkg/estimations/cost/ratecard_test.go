@@ -0,0 +1,84 @@
+package cost
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func partnerCard() RateCard {
+	until := date(2027, 1, 1)
+	return RateCard{
+		Name:        "partner",
+		Version:     2,
+		Currency:    "EUR",
+		Region:      "emea",
+		ValidFrom:   date(2026, 1, 1),
+		ValidUntil:  &until,
+		HourlyRates: map[string]float64{"migration-engineer": 120, "project-manager": 150},
+	}
+}
+
+func TestRateCard_Labor(t *testing.T) {
+	t.Parallel()
+	card := partnerCard()
+	est := estimation.Estimation{Duration: 5 * time.Hour}
+
+	item, err := card.Labor("Post-Migration Checks", "migration-engineer", est, 4, date(2026, 3, 2), 2*24*time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 5h * 4 engineers * 120 EUR/h
+	if item.Amount != 2400 || item.Currency != "EUR" {
+		t.Errorf("expected 2400 EUR, got %v %s", item.Amount, item.Currency)
+	}
+	if !item.End.Equal(date(2026, 3, 4)) {
+		t.Errorf("expected the cost to be spread over 2 days, got %v", item.End)
+	}
+}
+
+func TestRateCard_LaborErrors(t *testing.T) {
+	t.Parallel()
+	card := partnerCard()
+	est := estimation.Estimation{Duration: time.Hour}
+
+	if _, err := card.Labor("checks", "architect", est, 1, date(2026, 3, 2), time.Hour); err == nil {
+		t.Error("expected error for unknown role")
+	}
+	if _, err := card.Labor("checks", "migration-engineer", est, 1, date(2027, 1, 1), time.Hour); err == nil {
+		t.Error("expected error for expired card")
+	}
+	if _, err := card.Labor("checks", "migration-engineer", est, -1, date(2026, 3, 2), time.Hour); err == nil {
+		t.Error("expected error for negative engineer count")
+	}
+}
+
+func TestRateCard_Validate(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		modify func(*RateCard)
+	}{
+		{name: "missing name", modify: func(c *RateCard) { c.Name = "" }},
+		{name: "invalid currency", modify: func(c *RateCard) { c.Currency = "euro" }},
+		{name: "expires before valid", modify: func(c *RateCard) { c.ValidUntil = &c.ValidFrom }},
+		{name: "no rates", modify: func(c *RateCard) { c.HourlyRates = nil }},
+		{name: "negative rate", modify: func(c *RateCard) { c.HourlyRates = map[string]float64{"engineer": -1} }},
+	}
+
+	if err := partnerCard().Validate(); err != nil {
+		t.Fatalf("expected valid card, got: %v", err)
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := partnerCard()
+			tc.modify(&c)
+			if err := c.Validate(); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package day2
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Kind identifies a day-2 gap.
+type Kind string
+
+const (
+	// KindMonitoring is a target cluster whose metrics and alerts do not reach the operations team.
+	KindMonitoring Kind = "monitoring"
+	// KindVMBackup is a target cluster without a backup solution protecting KubeVirt VMs.
+	KindVMBackup Kind = "vm-backup"
+	// KindDRRunbook is an application without a disaster recovery runbook for the target.
+	KindDRRunbook Kind = "dr-runbook"
+)
+
+// Gap is a day-2 capability the target lacks.
+type Gap struct {
+	Kind Kind
+	// Subject is the cluster or application the gap is about.
+	Subject string
+	Message string
+}
+
+// Concern returns the gap as an assessment concern. A missing backup leaves the migrated VMs
+// unprotected and is critical; the other gaps are work to do before the first wave.
+func (g Gap) Concern() inventory.Concern {
+	labels := map[Kind]string{
+		KindMonitoring: "Target not integrated with monitoring",
+		KindVMBackup:   "No backup solution for KubeVirt VMs",
+		KindDRRunbook:  "No disaster recovery runbook",
+	}
+	category := inventory.ConcernCategoryWarning
+	if g.Kind == KindVMBackup {
+		category = inventory.ConcernCategoryCritical
+	}
+	return inventory.Concern{
+		ID:         "day2." + string(g.Kind),
+		Label:      labels[g.Kind],
+		Category:   category,
+		Assessment: g.Message,
+	}
+}
+
+// Application is an application migrated to the target.
+type Application struct {
+	Name string
+	// DRRunbook tells whether its disaster recovery runbook covers the target platform.
+	DRRunbook bool
+}
+
+// Target is what the operations team declares about a target cluster.
+type Target struct {
+	Cluster string
+	// MonitoringIntegrated tells whether the metrics and alerts of the cluster reach the operations team.
+	MonitoringIntegrated bool
+	// BackupSolution is the product protecting the KubeVirt VMs of the cluster, e.g. OADP. Empty when none.
+	BackupSolution string
+	Applications   []Application
+}
+
+// Assess returns the day-2 gaps of the target.
+func Assess(t Target) []Gap {
+	var gaps []Gap
+	if !t.MonitoringIntegrated {
+		gaps = append(gaps, Gap{
+			Kind:    KindMonitoring,
+			Subject: t.Cluster,
+			Message: "the metrics and alerts of the cluster do not reach the operations monitoring",
+		})
+	}
+	if t.BackupSolution == "" {
+		gaps = append(gaps, Gap{
+			Kind:    KindVMBackup,
+			Subject: t.Cluster,
+			Message: "no backup solution protects the KubeVirt VMs of the cluster",
+		})
+	}
+	for _, a := range t.Applications {
+		if !a.DRRunbook {
+			gaps = append(gaps, Gap{
+				Kind:    KindDRRunbook,
+				Subject: a.Name,
+				Message: fmt.Sprintf("the disaster recovery runbook of %s does not cover the target platform", a.Name),
+			})
+		}
+	}
+	return gaps
+}
+
+// Params counts the gaps into the params of the Day2Readiness calculator.
+func Params(gaps []Gap) []estimation.Param {
+	counts := make(map[Kind]int, 3)
+	for _, g := range gaps {
+		counts[g.Kind]++
+	}
+	return []estimation.Param{
+		{Key: calculators.ParamMonitoringIntegrations, Value: counts[KindMonitoring]},
+		{Key: calculators.ParamVMBackupSetups, Value: counts[KindVMBackup]},
+		{Key: calculators.ParamDRRunbooks, Value: counts[KindDRRunbook]},
+	}
+}
+
+// Report is the day-2 readiness section of an estimation: the gaps of the target and the work to
+// close them.
+type Report struct {
+	Gaps       []Gap
+	Estimation estimation.Estimation
+}
+
+// ReportReadiness assesses the target and estimates closing its gaps with calc. extra params, e.g.
+// the number of engineers, are passed to the calculator.
+func ReportReadiness(t Target, calc estimation.Calculator, extra ...estimation.Param) (Report, error) {
+	gaps := Assess(t)
+	params := append(Params(gaps), extra...)
+	paramMap := make(map[string]estimation.Param, len(params))
+	for _, p := range params {
+		paramMap[p.Key] = p
+	}
+
+	est, err := calc.Calculate(paramMap)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to estimate %s: %w", calc.Name(), err)
+	}
+	return Report{Gaps: gaps, Estimation: est}, nil
+}
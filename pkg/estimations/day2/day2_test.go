@@ -0,0 +1,75 @@
+package day2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestAssess(t *testing.T) {
+	t.Parallel()
+	target := Target{
+		Cluster:        "ocp-east",
+		BackupSolution: "OADP",
+		Applications: []Application{
+			{Name: "billing", DRRunbook: true},
+			{Name: "crm"},
+			{Name: "intranet"},
+		},
+	}
+
+	got := make(map[Kind][]string)
+	for _, g := range Assess(target) {
+		got[g.Kind] = append(got[g.Kind], g.Subject)
+	}
+	want := map[Kind][]string{
+		KindMonitoring: {"ocp-east"},
+		KindDRRunbook:  {"crm", "intranet"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected gaps %v, got %v", want, got)
+	}
+
+	ready := Target{Cluster: "ocp-east", MonitoringIntegrated: true, BackupSolution: "OADP"}
+	if gaps := Assess(ready); len(gaps) != 0 {
+		t.Errorf("expected no gap on a ready target, got %v", gaps)
+	}
+}
+
+func TestGap_Concern(t *testing.T) {
+	t.Parallel()
+	c := Gap{Kind: KindVMBackup, Subject: "ocp-east", Message: "unprotected"}.Concern()
+	if c.ID != "day2.vm-backup" || c.Category != inventory.ConcernCategoryCritical || c.Assessment != "unprotected" {
+		t.Errorf("unexpected concern %+v", c)
+	}
+	if c := (Gap{Kind: KindDRRunbook}).Concern(); c.Category != inventory.ConcernCategoryWarning {
+		t.Errorf("expected a missing runbook to be a warning, got %s", c.Category)
+	}
+}
+
+func TestReportReadiness(t *testing.T) {
+	t.Parallel()
+	target := Target{Cluster: "ocp-east", Applications: []Application{{Name: "crm"}, {Name: "billing"}}}
+
+	report, err := ReportReadiness(target, calculators.NewDay2Readiness(),
+		estimation.Param{Key: calculators.ParamDay2Engineers, Value: 4})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(report.Gaps) != 4 {
+		t.Errorf("expected 4 gaps, got %v", report.Gaps)
+	}
+	// (40 h monitoring + 80 h backup + 2 * 16 h runbooks) / 4 engineers = 38 h
+	if report.Estimation.Duration != 38*time.Hour {
+		t.Errorf("expected duration 38h, got %v", report.Estimation.Duration)
+	}
+
+	if _, err := ReportReadiness(target, calculators.NewDay2Readiness(),
+		estimation.Param{Key: calculators.ParamDay2Engineers, Value: 0}); err == nil {
+		t.Errorf("expected error without engineers, got nil")
+	}
+}
@@ -0,0 +1,8 @@
+// Package day2 checks the target platform is ready to operate the migrated VMs: the clusters are
+// integrated with the monitoring of the operations team, a backup solution protects KubeVirt VMs and
+// each application has a disaster recovery runbook for the target. Migrating onto a platform
+// missing any of them leaves the VMs migrated but unsupported.
+//
+// The gaps are reported as assessment concerns and counted into the params of the Day2Readiness
+// calculator, which sizes the work to close them.
+package day2
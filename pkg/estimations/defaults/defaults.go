@@ -0,0 +1,100 @@
+package defaults
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/guardrails"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// Constants are the defaults of an organization. Each is left to the calculators when zero.
+type Constants struct {
+	// WorkHoursPerDay is the working day of the engineers.
+	WorkHoursPerDay float64 `json:"workHoursPerDay,omitempty"`
+	// Engineers is the number of engineers working on each task, and the capacity of the resource
+	// pools of a plan.
+	Engineers int `json:"engineers,omitempty"`
+	// TransferRateMbps is the bandwidth available to the migration.
+	TransferRateMbps float64 `json:"transferRateMbps,omitempty"`
+}
+
+// Validate checks the constants are plausible themselves.
+func (c Constants) Validate() error {
+	if c.WorkHoursPerDay < 0 || c.WorkHoursPerDay > 24 {
+		return fmt.Errorf("work hours per day %.4g must be in [0, 24]", c.WorkHoursPerDay)
+	}
+	if c.Engineers < 0 {
+		return errors.New("engineers must be non-negative")
+	}
+	if c.TransferRateMbps < 0 {
+		return errors.New("transfer rate must be non-negative")
+	}
+	return nil
+}
+
+// Params returns the values of the calculator params the constants set, keyed by param. The
+// engineer count sets every param counting engineers, see guardrails.EngineerParams.
+func (c Constants) Params() map[string]float64 {
+	params := make(map[string]float64)
+	if c.WorkHoursPerDay > 0 {
+		params[calculators.ParamWorkHoursPerDay] = c.WorkHoursPerDay
+	}
+	if c.Engineers > 0 {
+		for _, key := range guardrails.EngineerParams {
+			params[key] = float64(c.Engineers)
+		}
+	}
+	if c.TransferRateMbps > 0 {
+		params[calculators.ParamTransferRateMbps] = c.TransferRateMbps
+	}
+	return params
+}
+
+// Apply fills what the plan left unset with the constants: its transfer rate, the working day of
+// the steps and the capacity of the pools the steps use without declaring them. A step working round
+// the clock under constants setting a working day then says so with 24 hours per day.
+func Apply(p plan.Plan, c Constants) plan.Plan {
+	if p.TransferRateMbps == 0 {
+		p.TransferRateMbps = c.TransferRateMbps
+	}
+
+	if c.WorkHoursPerDay > 0 {
+		waves := make([]plan.Wave, len(p.Waves))
+		for i, w := range p.Waves {
+			steps := make([]plan.Step, len(w.Steps))
+			for j, s := range w.Steps {
+				if s.WorkHoursPerDay == 0 {
+					s.WorkHoursPerDay = c.WorkHoursPerDay
+				}
+				steps[j] = s
+			}
+			w.Steps = steps
+			waves[i] = w
+		}
+		p.Waves = waves
+	}
+
+	if c.Engineers > 0 {
+		var pools map[string]int
+		for _, w := range p.Waves {
+			for _, s := range w.Steps {
+				if _, ok := p.Pools[s.Pool]; s.Pool == "" || ok {
+					continue
+				}
+				if pools == nil {
+					pools = make(map[string]int, len(p.Pools)+1)
+					for name, capacity := range p.Pools {
+						pools[name] = capacity
+					}
+				}
+				pools[s.Pool] = c.Engineers
+			}
+		}
+		if pools != nil {
+			p.Pools = pools
+		}
+	}
+	return p
+}
@@ -0,0 +1,87 @@
+package defaults
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	for _, c := range []Constants{
+		{WorkHoursPerDay: 25},
+		{WorkHoursPerDay: -1},
+		{Engineers: -2},
+		{TransferRateMbps: -100},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("expected %+v to be rejected", c)
+		}
+	}
+	if err := (Constants{}).Validate(); err != nil {
+		t.Errorf("unexpected error for the empty constants: %v", err)
+	}
+}
+
+func TestParams(t *testing.T) {
+	t.Parallel()
+	params := Constants{WorkHoursPerDay: 7.5, Engineers: 4}.Params()
+
+	if params[calculators.ParamWorkHoursPerDay] != 7.5 {
+		t.Errorf("work hours per day: got %v", params[calculators.ParamWorkHoursPerDay])
+	}
+	if params[calculators.ParamPostMigrationEngineers] != 4 || params[calculators.ParamBackupEngineers] != 4 {
+		t.Errorf("expected the engineer params to be set, got %v", params)
+	}
+	if _, ok := params[calculators.ParamTransferRateMbps]; ok {
+		t.Error("expected the transfer rate left unset to be left to the calculators")
+	}
+	if len(Constants{}.Params()) != 0 {
+		t.Error("expected the empty constants to set no param")
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+	p := plan.Plan{
+		Name:  "datacenter exit",
+		Start: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Pools: map[string]int{"storage": 2},
+		Waves: []plan.Wave{{
+			Name: "pilot",
+			Steps: []plan.Step{
+				{Phase: "transfer", Effort: 8 * time.Hour, Pool: "storage", Units: 1},
+				{Phase: "checks", Effort: 4 * time.Hour, Pool: "engineers", Units: 1, WorkHoursPerDay: 24},
+			},
+		}},
+	}
+
+	applied := Apply(p, Constants{WorkHoursPerDay: 6, Engineers: 3, TransferRateMbps: 1000})
+
+	if applied.TransferRateMbps != 1000 {
+		t.Errorf("transfer rate: got %v", applied.TransferRateMbps)
+	}
+	if got := applied.Waves[0].Steps[0].WorkHoursPerDay; got != 6 {
+		t.Errorf("expected the working day of the organization, got %v", got)
+	}
+	if got := applied.Waves[0].Steps[1].WorkHoursPerDay; got != 24 {
+		t.Errorf("expected the working day of the step to be kept, got %v", got)
+	}
+	if applied.Pools["storage"] != 2 || applied.Pools["engineers"] != 3 {
+		t.Errorf("expected the undeclared pool to get the engineers of the organization, got %v", applied.Pools)
+	}
+
+	// the plan given is left untouched
+	if p.Waves[0].Steps[0].WorkHoursPerDay != 0 || len(p.Pools) != 1 {
+		t.Error("expected the plan given to be left untouched")
+	}
+	if _, err := applied.Timeline(); err != nil {
+		t.Errorf("laying out the plan: %v", err)
+	}
+
+	if kept := Apply(plan.Plan{TransferRateMbps: 200}, Constants{TransferRateMbps: 1000}); kept.TransferRateMbps != 200 {
+		t.Errorf("expected the transfer rate of the plan to be kept, got %v", kept.TransferRateMbps)
+	}
+}
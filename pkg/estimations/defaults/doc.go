@@ -0,0 +1,5 @@
+// Package defaults holds the constants an organization sets in place of the built-in ones of the
+// calculators, e.g. an 8-hour working day or a 620 Mbps transfer rate. They sit beneath everything
+// else: a plan or an estimation request setting its own value keeps it, so the constants only fill
+// what was left unset and every new plan starts from the reality of the organization.
+package defaults
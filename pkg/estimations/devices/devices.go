@@ -0,0 +1,224 @@
+package devices
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Source disk controllers.
+const (
+	ControllerIDE      = "ide"
+	ControllerSATA     = "sata"
+	ControllerBusLogic = "buslogic"
+	ControllerLSILogic = "lsilogic"
+	ControllerPVSCSI   = "pvscsi"
+	ControllerNVMe     = "nvme"
+	// ControllerSCSI is a SCSI controller of unknown type.
+	ControllerSCSI    = "scsi"
+	ControllerUnknown = "unknown"
+)
+
+// Source network adapters.
+const (
+	AdapterVmxnet3 = "vmxnet3"
+	AdapterE1000   = "e1000"
+	AdapterE1000E  = "e1000e"
+	AdapterPCNet   = "pcnet"
+	AdapterSRIOV   = "sriov"
+	AdapterUnknown = "unknown"
+)
+
+// KubeVirt disk buses and NIC models.
+const (
+	TargetVirtio = "virtio"
+	TargetSATA   = "sata"
+	TargetE1000  = "e1000"
+	TargetE1000E = "e1000e"
+	TargetPCNet  = "pcnet"
+	TargetSRIOV  = "sriov"
+)
+
+// IDs of the concerns of the driver changes.
+const (
+	ConcernStorageDriver = "devices.storage-driver"
+	ConcernNetworkDriver = "devices.network-driver"
+)
+
+// Mapping is the predicted target of a source device.
+type Mapping struct {
+	// Source is the source controller or adapter, one of the Controller or Adapter constants.
+	Source string
+	// Target is the KubeVirt disk bus or NIC model, one of the Target constants.
+	Target string
+	// DriverChange reports whether the guest needs another driver for the target.
+	DriverChange bool
+}
+
+// Disks and NICs without virtio counterpart keep an emulated device: IDE disks are not supported by
+// KubeVirt and move to SATA, as do BusLogic disks, whose guests are too old for virtio drivers.
+var (
+	diskMappings = map[string]Mapping{
+		ControllerIDE:      {Source: ControllerIDE, Target: TargetSATA},
+		ControllerSATA:     {Source: ControllerSATA, Target: TargetSATA},
+		ControllerBusLogic: {Source: ControllerBusLogic, Target: TargetSATA, DriverChange: true},
+		ControllerLSILogic: {Source: ControllerLSILogic, Target: TargetVirtio, DriverChange: true},
+		ControllerPVSCSI:   {Source: ControllerPVSCSI, Target: TargetVirtio, DriverChange: true},
+		ControllerNVMe:     {Source: ControllerNVMe, Target: TargetVirtio, DriverChange: true},
+		ControllerSCSI:     {Source: ControllerSCSI, Target: TargetVirtio, DriverChange: true},
+		ControllerUnknown:  {Source: ControllerUnknown, Target: TargetVirtio, DriverChange: true},
+	}
+	nicMappings = map[string]Mapping{
+		AdapterVmxnet3: {Source: AdapterVmxnet3, Target: TargetVirtio, DriverChange: true},
+		AdapterE1000:   {Source: AdapterE1000, Target: TargetE1000},
+		AdapterE1000E:  {Source: AdapterE1000E, Target: TargetE1000E},
+		AdapterPCNet:   {Source: AdapterPCNet, Target: TargetPCNet},
+		AdapterSRIOV:   {Source: AdapterSRIOV, Target: TargetSRIOV, DriverChange: true},
+		AdapterUnknown: {Source: AdapterUnknown, Target: TargetVirtio, DriverChange: true},
+	}
+)
+
+// DiskController returns the controller of a disk, from its controller type when vSphere reports it
+// and from its bus otherwise.
+func DiskController(d inventory.Disk) string {
+	c := strings.ToLower(d.Controller)
+	switch {
+	case strings.Contains(c, "paravirtual"), strings.Contains(c, "pvscsi"):
+		return ControllerPVSCSI
+	case strings.Contains(c, "buslogic"):
+		return ControllerBusLogic
+	case strings.Contains(c, "lsi"):
+		return ControllerLSILogic
+	case strings.Contains(c, "nvme"):
+		return ControllerNVMe
+	case strings.Contains(c, "sata"), strings.Contains(c, "ahci"):
+		return ControllerSATA
+	case strings.Contains(c, "ide"):
+		return ControllerIDE
+	}
+	switch strings.ToLower(d.Bus) {
+	case ControllerIDE, ControllerSATA, ControllerNVMe, ControllerSCSI:
+		return strings.ToLower(d.Bus)
+	}
+	return ControllerUnknown
+}
+
+// NICAdapter returns the adapter of a NIC.
+func NICAdapter(n inventory.NIC) string {
+	a := strings.ToLower(n.Adapter)
+	switch {
+	case strings.Contains(a, "vmxnet"):
+		return AdapterVmxnet3
+	case strings.Contains(a, "e1000e"):
+		return AdapterE1000E
+	case strings.Contains(a, "e1000"):
+		return AdapterE1000
+	case strings.Contains(a, "pcnet"), strings.Contains(a, "vlance"), strings.Contains(a, "flexible"):
+		return AdapterPCNet
+	case strings.Contains(a, "sriov"), strings.Contains(a, "sr-iov"):
+		return AdapterSRIOV
+	}
+	return AdapterUnknown
+}
+
+// Analysis is the predicted device mapping of a VM.
+type Analysis struct {
+	VM    string
+	Disks []Mapping
+	NICs  []Mapping
+}
+
+// StorageDriverChange reports whether a disk of the VM needs another guest driver.
+func (a Analysis) StorageDriverChange() bool {
+	return driverChange(a.Disks)
+}
+
+// NetworkDriverChange reports whether a NIC of the VM needs another guest driver.
+func (a Analysis) NetworkDriverChange() bool {
+	return driverChange(a.NICs)
+}
+
+// InGuestIntervention reports whether the guest needs work after the migration.
+func (a Analysis) InGuestIntervention() bool {
+	return a.StorageDriverChange() || a.NetworkDriverChange()
+}
+
+// Concerns returns the driver changes as assessment concerns. They do not block the migration.
+func (a Analysis) Concerns() []inventory.Concern {
+	var concerns []inventory.Concern
+	if a.StorageDriverChange() {
+		concerns = append(concerns, inventory.Concern{
+			ID:         ConcernStorageDriver,
+			Label:      "Disk bus requires another guest driver",
+			Category:   inventory.ConcernCategoryWarning,
+			Assessment: "disks move to " + describe(a.Disks) + ": the guest must boot with the target storage driver",
+		})
+	}
+	if a.NetworkDriverChange() {
+		concerns = append(concerns, inventory.Concern{
+			ID:         ConcernNetworkDriver,
+			Label:      "NIC model requires another guest driver",
+			Category:   inventory.ConcernCategoryWarning,
+			Assessment: "NICs move to " + describe(a.NICs) + ": interface names and static network settings must be reapplied",
+		})
+	}
+	return concerns
+}
+
+// Analyze predicts the device mapping of vm.
+func Analyze(vm inventory.VM) Analysis {
+	a := Analysis{VM: vm.Name}
+	for _, d := range vm.Disks {
+		a.Disks = append(a.Disks, diskMappings[DiskController(d)])
+	}
+	for _, n := range vm.NICs {
+		a.NICs = append(a.NICs, nicMappings[NICAdapter(n)])
+	}
+	return a
+}
+
+// Params counts the VMs with driver changes into the params of the GuestOSReconfiguration calculator.
+func Params(analyses []Analysis) []estimation.Param {
+	vms := make(map[string]int, 2)
+	for _, a := range analyses {
+		for _, c := range a.Concerns() {
+			vms[c.ID]++
+		}
+	}
+	return ConcernParams(vms)
+}
+
+// ConcernParams is Params for the VM counts of the concerns of an assessment, keyed by concern ID,
+// once its VMs are aggregated away.
+func ConcernParams(vms map[string]int) []estimation.Param {
+	return []estimation.Param{
+		{Key: calculators.ParamStorageDriverChanges, Value: vms[ConcernStorageDriver]},
+		{Key: calculators.ParamNetworkDriverChanges, Value: vms[ConcernNetworkDriver]},
+	}
+}
+
+func driverChange(mappings []Mapping) bool {
+	for _, m := range mappings {
+		if m.DriverChange {
+			return true
+		}
+	}
+	return false
+}
+
+// describe lists the distinct driver changes of mappings, e.g. "pvscsi -> virtio".
+func describe(mappings []Mapping) string {
+	var parts []string
+	seen := make(map[Mapping]bool)
+	for _, m := range mappings {
+		if !m.DriverChange || seen[m] {
+			continue
+		}
+		seen[m] = true
+		parts = append(parts, fmt.Sprintf("%s -> %s", m.Source, m.Target))
+	}
+	return strings.Join(parts, ", ")
+}
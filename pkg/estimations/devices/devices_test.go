@@ -0,0 +1,106 @@
+package devices
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestDiskController(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		disk inventory.Disk
+		want string
+	}{
+		{disk: inventory.Disk{Controller: "VMware Paravirtual", Bus: "scsi"}, want: ControllerPVSCSI},
+		{disk: inventory.Disk{Controller: "BusLogic Parallel"}, want: ControllerBusLogic},
+		{disk: inventory.Disk{Controller: "LSI Logic SAS"}, want: ControllerLSILogic},
+		{disk: inventory.Disk{Controller: "NVMe controller 0"}, want: ControllerNVMe},
+		{disk: inventory.Disk{Controller: "IDE 1"}, want: ControllerIDE},
+		{disk: inventory.Disk{Controller: "SCSI controller 0", Bus: "scsi"}, want: ControllerSCSI},
+		{disk: inventory.Disk{}, want: ControllerUnknown},
+	}
+
+	for _, tc := range cases {
+		if got := DiskController(tc.disk); got != tc.want {
+			t.Errorf("expected %q for %+v, got %q", tc.want, tc.disk, got)
+		}
+	}
+}
+
+func TestNICAdapter(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"Vmxnet3":     AdapterVmxnet3,
+		"E1000E":      AdapterE1000E,
+		"E1000":       AdapterE1000,
+		"Vlance":      AdapterPCNet,
+		"SR-IOV":      AdapterSRIOV,
+		"":            AdapterUnknown,
+		"Passthrough": AdapterUnknown,
+	}
+
+	for adapter, want := range cases {
+		if got := NICAdapter(inventory.NIC{Adapter: adapter}); got != want {
+			t.Errorf("expected %q for %q, got %q", want, adapter, got)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+	legacy := Analyze(inventory.VM{
+		Name:  "legacy01",
+		Disks: []inventory.Disk{{Controller: "IDE 0", Bus: "ide"}},
+		NICs:  []inventory.NIC{{Adapter: "E1000"}},
+	})
+	if legacy.InGuestIntervention() {
+		t.Errorf("expected IDE and E1000 to keep their drivers, got %+v", legacy)
+	}
+	if len(legacy.Concerns()) != 0 {
+		t.Errorf("expected no concerns, got %v", legacy.Concerns())
+	}
+
+	modern := Analyze(inventory.VM{
+		Name:  "db01",
+		Disks: []inventory.Disk{{Controller: "VMware Paravirtual"}, {Controller: "VMware Paravirtual"}},
+		NICs:  []inventory.NIC{{Adapter: "Vmxnet3"}},
+	})
+	want := []Mapping{
+		{Source: ControllerPVSCSI, Target: TargetVirtio, DriverChange: true},
+		{Source: ControllerPVSCSI, Target: TargetVirtio, DriverChange: true},
+	}
+	if !reflect.DeepEqual(modern.Disks, want) {
+		t.Errorf("expected disk mappings %v, got %v", want, modern.Disks)
+	}
+	concerns := modern.Concerns()
+	if len(concerns) != 2 || concerns[0].ID != "devices.storage-driver" || concerns[1].ID != "devices.network-driver" {
+		t.Fatalf("expected storage and network concerns, got %v", concerns)
+	}
+	if concerns[0].Assessment != "disks move to pvscsi -> virtio: the guest must boot with the target storage driver" {
+		t.Errorf("unexpected assessment %q", concerns[0].Assessment)
+	}
+}
+
+func TestParams(t *testing.T) {
+	t.Parallel()
+	analyses := []Analysis{
+		{Disks: []Mapping{diskMappings[ControllerPVSCSI]}, NICs: []Mapping{nicMappings[AdapterVmxnet3]}},
+		{Disks: []Mapping{diskMappings[ControllerLSILogic]}, NICs: []Mapping{nicMappings[AdapterE1000]}},
+		{Disks: []Mapping{diskMappings[ControllerIDE]}},
+	}
+
+	got := make(map[string]interface{})
+	for _, p := range Params(analyses) {
+		got[p.Key] = p.Value
+	}
+	want := map[string]interface{}{
+		calculators.ParamStorageDriverChanges: 2,
+		calculators.ParamNetworkDriverChanges: 1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected params %v, got %v", want, got)
+	}
+}
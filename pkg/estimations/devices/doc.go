@@ -0,0 +1,7 @@
+// Package devices predicts how the disk controllers and network adapters of the source VMs map to
+// the buses and NIC models of KubeVirt, and flags the VMs whose guest needs another driver: those
+// need in-guest work after the migration.
+//
+// The analysis is reported as assessment concerns and counted into the params of the
+// GuestOSReconfiguration calculator.
+package devices
@@ -0,0 +1,104 @@
+package display
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+)
+
+// Granularity is the unit durations and dates are rounded to.
+type Granularity string
+
+const (
+	// GranularityNone keeps the durations as computed.
+	GranularityNone    Granularity = ""
+	GranularityHour    Granularity = "hour"
+	GranularityHalfDay Granularity = "half-day"
+	GranularityDay     Granularity = "day"
+)
+
+const day = 24 * time.Hour
+
+// Unit returns the duration of the granularity, zero for GranularityNone.
+func (g Granularity) Unit() time.Duration {
+	switch g {
+	case GranularityHour:
+		return time.Hour
+	case GranularityHalfDay:
+		return day / 2
+	case GranularityDay:
+		return day
+	}
+	return 0
+}
+
+// Policy is how the durations and dates of a plan are reported.
+type Policy struct {
+	Granularity Granularity `json:"granularity,omitempty"`
+	// MinPhase is the shortest duration a phase is reported with. Zero reports phases as rounded.
+	MinPhase time.Duration `json:"minPhase,omitempty"`
+	// AbsorbSubDay merges the phases shorter than a day into the previous phase of their wave, or the
+	// next one for the first phase, instead of reporting them on their own.
+	AbsorbSubDay bool `json:"absorbSubDay,omitempty"`
+}
+
+// Validate checks the granularity is known and the minimum phase duration not negative.
+func (p Policy) Validate() error {
+	switch p.Granularity {
+	case GranularityNone, GranularityHour, GranularityHalfDay, GranularityDay:
+	default:
+		return fmt.Errorf("unknown display granularity %q", p.Granularity)
+	}
+	if p.MinPhase < 0 {
+		return fmt.Errorf("minimum phase duration must not be negative")
+	}
+	return nil
+}
+
+// Round rounds the duration to the nearest unit of the granularity.
+func (p Policy) Round(d time.Duration) time.Duration {
+	unit := p.Granularity.Unit()
+	if unit == 0 {
+		return d
+	}
+	return d.Round(unit)
+}
+
+// Format renders the rounded duration for people: "1d 4h" by the hour, "2.5d" by the half-day
+// or the day, and as time.Duration does without granularity.
+func (p Policy) Format(d time.Duration) string {
+	unit := p.Granularity.Unit()
+	if unit == 0 {
+		return d.String()
+	}
+	d = p.Round(d)
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	if unit >= day/2 {
+		return sign + strconv.FormatFloat(d.Hours()/24, 'f', -1, 64) + "d"
+	}
+
+	days, hours := int(d/day), int((d%day)/time.Hour)
+	switch {
+	case days == 0:
+		return fmt.Sprintf("%s%dh", sign, hours)
+	case hours == 0:
+		return fmt.Sprintf("%s%dd", sign, days)
+	default:
+		return fmt.Sprintf("%s%dd %dh", sign, days, hours)
+	}
+}
+
+// Apply reports the chart under the policy: the phases shorter than a day are absorbed when asked
+// to, then the dates rounded and the phases stretched to the minimum duration.
+func (p Policy) Apply(c *gantt.Chart) {
+	if p.AbsorbSubDay {
+		c.Absorb(day)
+	}
+	c.Round(p.Granularity.Unit())
+	c.Stretch(p.MinPhase)
+}
@@ -0,0 +1,81 @@
+package display
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+func TestPolicy_Format(t *testing.T) {
+	t.Parallel()
+	d := 29*time.Hour + 40*time.Minute
+
+	tests := []struct {
+		name   string
+		policy Policy
+		d      time.Duration
+		want   string
+	}{
+		{name: "no granularity", policy: Policy{}, d: d, want: "29h40m0s"},
+		{name: "by the hour", policy: Policy{Granularity: GranularityHour}, d: d, want: "1d 6h"},
+		{name: "by the hour under a day", policy: Policy{Granularity: GranularityHour}, d: 20 * time.Minute, want: "0h"},
+		{name: "by the hour in whole days", policy: Policy{Granularity: GranularityHour}, d: 48 * time.Hour, want: "2d"},
+		{name: "by the half-day", policy: Policy{Granularity: GranularityHalfDay}, d: d, want: "1d"},
+		{name: "by the half-day rounding up", policy: Policy{Granularity: GranularityHalfDay}, d: 31 * time.Hour, want: "1.5d"},
+		{name: "by the day", policy: Policy{Granularity: GranularityDay}, d: 60 * time.Hour, want: "3d"},
+		{name: "negative", policy: Policy{Granularity: GranularityHour}, d: -26 * time.Hour, want: "-1d 2h"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.policy.Format(tc.d); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	t.Parallel()
+	if err := (Policy{Granularity: GranularityHalfDay, MinPhase: 12 * time.Hour, AbsorbSubDay: true}).Validate(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	if err := (Policy{Granularity: "week"}).Validate(); err == nil {
+		t.Error("expected an error for an unknown granularity")
+	}
+	if err := (Policy{MinPhase: -time.Hour}).Validate(); err == nil {
+		t.Error("expected an error for a negative minimum phase duration")
+	}
+}
+
+func TestPolicy_Apply(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	step := func(name string, effort time.Duration) scheduling.Step {
+		return scheduling.Step{Phase: scheduling.Phase{Name: name, Effort: effort}}
+	}
+	tl, err := scheduling.NewPipeline(scheduling.ModeSerial).Layout([]scheduling.WavePlan{
+		{Wave: "wave-1", Steps: []scheduling.Step{step("Transfer", 50*time.Hour), step("Cutover", 3*time.Hour)}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	raw := gantt.New(start, tl, start)
+	Policy{}.Apply(&raw)
+	if len(raw.Bars) != 2 || !raw.End.Equal(start.Add(53*time.Hour)) {
+		t.Errorf("expected the zero policy to leave the chart as computed, got %d bars ending %v", len(raw.Bars), raw.End)
+	}
+
+	c := gantt.New(start, tl, start)
+	Policy{Granularity: GranularityDay, AbsorbSubDay: true}.Apply(&c)
+	if len(c.Bars) != 1 {
+		t.Fatalf("expected the cutover absorbed into the transfer, got %+v", c.Bars)
+	}
+	if !c.End.Equal(start.Add(48 * time.Hour)) {
+		t.Errorf("expected the chart to end on day 2, got %v", c.End)
+	}
+}
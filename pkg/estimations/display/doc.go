@@ -0,0 +1,6 @@
+// Package display rounds the durations and dates of a plan for the people reading it: estimates
+// come out of the calculators to the nanosecond, while a steering committee reads programs in hours,
+// half-days or days. A Policy sets the rounding granularity, the shortest phase reported and whether
+// phases shorter than a day are absorbed into the buffer of a neighbouring phase. The zero Policy
+// reports the durations as computed.
+package display
@@ -0,0 +1,114 @@
+package distribution
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// MinSamples is the number of samples a fit needs.
+const MinSamples = 3
+
+// Family names a family of distributions.
+type Family string
+
+const (
+	FamilyLogNormal Family = "lognormal"
+	FamilyGamma     Family = "gamma"
+)
+
+// Distribution is a fitted distribution of durations, in hours.
+type Distribution interface {
+	Family() Family
+	// Params are the fitted parameters by name, e.g. mu and sigma.
+	Params() map[string]float64
+	Mean() float64
+	// Quantile returns the value below which a share p of the durations fall.
+	Quantile(p float64) float64
+	// LogLikelihood measures how well the distribution explains the samples.
+	LogLikelihood(samples []float64) float64
+	// Sample draws a duration.
+	Sample(r *rand.Rand) float64
+}
+
+// Fitter fits a family of distributions to samples.
+type Fitter interface {
+	Family() Family
+	Fit(samples []float64) (Distribution, error)
+}
+
+// DefaultFitters returns the fitters of the families supported out of the box.
+func DefaultFitters() []Fitter {
+	return []Fitter{LogNormalFitter{}, GammaFitter{}}
+}
+
+// FitterOf returns the fitter of the family among the default ones.
+func FitterOf(family Family) (Fitter, bool) {
+	for _, f := range DefaultFitters() {
+		if f.Family() == family {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// Best fits each family to the samples and returns the distribution with the highest likelihood.
+// All the families have two parameters, so the likelihoods compare as is.
+func Best(samples []float64, fitters ...Fitter) (Distribution, error) {
+	if len(fitters) == 0 {
+		return nil, errors.New("no fitter")
+	}
+	var (
+		best     Distribution
+		bestLL   float64
+		failures []error
+	)
+	for _, f := range fitters {
+		d, err := f.Fit(samples)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", f.Family(), err))
+			continue
+		}
+		if ll := d.LogLikelihood(samples); best == nil || ll > bestLL {
+			best, bestLL = d, ll
+		}
+	}
+	if best == nil {
+		return nil, errors.Join(failures...)
+	}
+	return best, nil
+}
+
+// Hours converts durations to the hours distributions are fitted on.
+func Hours(durations []time.Duration) []float64 {
+	hours := make([]float64, 0, len(durations))
+	for _, d := range durations {
+		hours = append(hours, d.Hours())
+	}
+	return hours
+}
+
+// SampleDuration draws a duration from the distribution.
+func SampleDuration(d Distribution, r *rand.Rand) time.Duration {
+	return time.Duration(d.Sample(r) * float64(time.Hour))
+}
+
+// validate checks the samples can be fitted by a family with a positive support.
+func validate(samples []float64) error {
+	if len(samples) < MinSamples {
+		return fmt.Errorf("%d samples, at least %d needed", len(samples), MinSamples)
+	}
+	for _, x := range samples {
+		if x <= 0 || math.IsNaN(x) || math.IsInf(x, 0) {
+			return fmt.Errorf("sample %v is not a positive duration", x)
+		}
+	}
+	for _, x := range samples[1:] {
+		if x != samples[0] {
+			return nil
+		}
+	}
+	return errors.New("samples have no variance")
+}
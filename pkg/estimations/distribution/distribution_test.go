@@ -0,0 +1,137 @@
+package distribution
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+func draw(d Distribution, n int) []float64 {
+	r := rand.New(rand.NewPCG(1, 2))
+	samples := make([]float64, n)
+	for i := range samples {
+		samples[i] = d.Sample(r)
+	}
+	return samples
+}
+
+func within(got, want, tolerance float64) bool {
+	return math.Abs(got-want) <= tolerance*math.Abs(want)
+}
+
+func TestLogNormalFit(t *testing.T) {
+	t.Parallel()
+
+	want := LogNormal{Mu: math.Log(8), Sigma: 0.4}
+	got, err := LogNormalFitter{}.Fit(draw(want, 5000))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fitted := got.(LogNormal)
+	if !within(fitted.Mu, want.Mu, 0.02) || !within(fitted.Sigma, want.Sigma, 0.05) {
+		t.Errorf("expected %+v, got %+v", want, fitted)
+	}
+	if median := fitted.Quantile(0.5); !within(median, 8, 0.03) {
+		t.Errorf("expected a median of 8h, got %v", median)
+	}
+}
+
+func TestGammaFit(t *testing.T) {
+	t.Parallel()
+
+	for _, want := range []Gamma{{Shape: 3, Rate: 0.5}, {Shape: 0.7, Rate: 2}} {
+		got, err := GammaFitter{}.Fit(draw(want, 5000))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		fitted := got.(Gamma)
+		if !within(fitted.Shape, want.Shape, 0.08) || !within(fitted.Rate, want.Rate, 0.08) {
+			t.Errorf("expected %+v, got %+v", want, fitted)
+		}
+	}
+}
+
+func TestGammaQuantile(t *testing.T) {
+	t.Parallel()
+
+	// a Gamma of shape 1 is exponential: its quantiles have a closed form
+	d := Gamma{Shape: 1, Rate: 0.25}
+	for _, p := range []float64{0.1, 0.5, 0.9, 0.99} {
+		want := -math.Log(1-p) / d.Rate
+		if got := d.Quantile(p); !within(got, want, 1e-6) {
+			t.Errorf("expected quantile %v to be %v, got %v", p, want, got)
+		}
+	}
+	// and a Gamma of shape 2 the sum of two exponentials
+	d = Gamma{Shape: 2, Rate: 1}
+	if got := d.cdf(d.Quantile(0.8)); !within(got, 0.8, 1e-6) {
+		t.Errorf("expected the cdf to invert the quantile, got %v", got)
+	}
+}
+
+func TestBest(t *testing.T) {
+	t.Parallel()
+
+	lognormal := draw(LogNormal{Mu: 1, Sigma: 1.2}, 2000)
+	d, err := Best(lognormal, DefaultFitters()...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Family() != FamilyLogNormal {
+		t.Errorf("expected lognormal samples to fit a lognormal, got %s", d.Family())
+	}
+
+	gamma := draw(Gamma{Shape: 6, Rate: 1}, 2000)
+	if d, err = Best(gamma, DefaultFitters()...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Family() != FamilyGamma {
+		t.Errorf("expected gamma samples to fit a gamma, got %s", d.Family())
+	}
+
+	if d, err = Best(gamma, GammaFitter{}); err != nil || d.Family() != FamilyGamma {
+		t.Errorf("expected the only fitter given to be used, got %v, %v", d, err)
+	}
+}
+
+func TestFitErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		samples []float64
+	}{
+		{name: "too few samples", samples: []float64{1, 2}},
+		{name: "zero duration", samples: []float64{1, 0, 2}},
+		{name: "negative duration", samples: []float64{1, -2, 2}},
+		{name: "no variance", samples: []float64{4, 4, 4, 4}},
+		{name: "not a number", samples: []float64{1, math.NaN(), 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for _, f := range DefaultFitters() {
+				if _, err := f.Fit(tt.samples); err == nil {
+					t.Errorf("expected error for case %q, got nil", tt.name)
+				}
+			}
+			if _, err := Best(tt.samples, DefaultFitters()...); err == nil {
+				t.Errorf("expected error for case %q, got nil", tt.name)
+			}
+		})
+	}
+}
+
+func TestHours(t *testing.T) {
+	t.Parallel()
+
+	hours := Hours([]time.Duration{90 * time.Minute, 2 * time.Hour})
+	if hours[0] != 1.5 || hours[1] != 2 {
+		t.Errorf("unexpected hours %v", hours)
+	}
+	d := LogNormal{Mu: math.Log(2), Sigma: 0}
+	if got := SampleDuration(d, rand.New(rand.NewPCG(1, 2))); got != 2*time.Hour {
+		t.Errorf("expected 2h, got %s", got)
+	}
+}
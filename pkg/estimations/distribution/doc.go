@@ -0,0 +1,5 @@
+// Package distribution fits probability distributions to the actual durations recorded for the
+// VMs of a phase, so simulations sample durations from what migrations took rather than from
+// hand-tuned variance assumptions. Fitters are pluggable: Best keeps the distribution of the
+// fitters given that explains the samples best.
+package distribution
@@ -0,0 +1,192 @@
+package distribution
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+const (
+	// newtonIterations bounds the refinement of the shape of a Gamma fit.
+	newtonIterations = 50
+	epsilon          = 1e-12
+)
+
+// Gamma is the distribution of a duration with shape Shape and rate Rate. It suits durations made of
+// a sum of exponential delays.
+type Gamma struct {
+	Shape float64
+	Rate  float64
+}
+
+var _ Distribution = Gamma{}
+
+func (d Gamma) Family() Family {
+	return FamilyGamma
+}
+
+func (d Gamma) Params() map[string]float64 {
+	return map[string]float64{"shape": d.Shape, "rate": d.Rate}
+}
+
+func (d Gamma) Mean() float64 {
+	return d.Shape / d.Rate
+}
+
+// Quantile inverts the cumulative distribution function by bisection.
+func (d Gamma) Quantile(p float64) float64 {
+	if p <= 0 {
+		return 0
+	}
+	if p >= 1 {
+		return math.Inf(1)
+	}
+	lo, hi := 0.0, d.Mean()
+	for d.cdf(hi) < p {
+		lo, hi = hi, 2*hi
+	}
+	for range 100 {
+		mid := (lo + hi) / 2
+		if d.cdf(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+func (d Gamma) LogLikelihood(samples []float64) float64 {
+	lgamma, _ := math.Lgamma(d.Shape)
+	var ll float64
+	for _, x := range samples {
+		ll += d.Shape*math.Log(d.Rate) - lgamma + (d.Shape-1)*math.Log(x) - d.Rate*x
+	}
+	return ll
+}
+
+// Sample draws with the method of Marsaglia and Tsang.
+func (d Gamma) Sample(r *rand.Rand) float64 {
+	shape, boost := d.Shape, 1.0
+	if shape < 1 {
+		// X(k) = X(k+1) * U^(1/k)
+		boost = math.Pow(r.Float64(), 1/shape)
+		shape++
+	}
+	dd := shape - 1.0/3
+	c := 1 / math.Sqrt(9*dd)
+	for {
+		x := r.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := r.Float64()
+		if math.Log(u) < x*x/2+dd-dd*v+dd*math.Log(v) {
+			return boost * dd * v / d.Rate
+		}
+	}
+}
+
+func (d Gamma) cdf(x float64) float64 {
+	return lowerRegularizedGamma(d.Shape, d.Rate*x)
+}
+
+// GammaFitter fits a Gamma by maximum likelihood: the shape starts from the approximation of Minka
+// and is refined by Newton's method.
+type GammaFitter struct{}
+
+func (GammaFitter) Family() Family {
+	return FamilyGamma
+}
+
+func (GammaFitter) Fit(samples []float64) (Distribution, error) {
+	if err := validate(samples); err != nil {
+		return nil, err
+	}
+	n := float64(len(samples))
+	var mean, meanLog float64
+	for _, x := range samples {
+		mean += x
+		meanLog += math.Log(x)
+	}
+	mean /= n
+	meanLog /= n
+
+	s := math.Log(mean) - meanLog
+	k := (3 - s + math.Sqrt((s-3)*(s-3)+24*s)) / (12 * s)
+	for range newtonIterations {
+		step := (math.Log(k) - digamma(k) - s) / (1/k - trigamma(k))
+		k -= step
+		if math.Abs(step) < epsilon*k {
+			break
+		}
+	}
+	return Gamma{Shape: k, Rate: k / mean}, nil
+}
+
+// digamma is the logarithmic derivative of the gamma function.
+func digamma(x float64) float64 {
+	var result float64
+	for ; x < 6; x++ {
+		result -= 1 / x
+	}
+	f := 1 / (x * x)
+	return result + math.Log(x) - 0.5/x - f*(1.0/12-f*(1.0/120-f*(1.0/252)))
+}
+
+// trigamma is the derivative of digamma.
+func trigamma(x float64) float64 {
+	var result float64
+	for ; x < 6; x++ {
+		result += 1 / (x * x)
+	}
+	f := 1 / (x * x)
+	return result + 1/x + f/2 + f/x*(1.0/6-f*(1.0/30-f*(1.0/42-f/30)))
+}
+
+// lowerRegularizedGamma is P(a, x), by its series below a+1 and its continued fraction above.
+func lowerRegularizedGamma(a, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	lgamma, _ := math.Lgamma(a)
+	prefix := math.Exp(-x + a*math.Log(x) - lgamma)
+	if x < a+1 {
+		sum, term := 1/a, 1/a
+		for n := 1.0; n < 1000; n++ {
+			term *= x / (a + n)
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*epsilon {
+				break
+			}
+		}
+		return sum * prefix
+	}
+
+	// modified Lentz's method
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1.0; i < 1000; i++ {
+		an := -i * (i - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return 1 - prefix*h
+}
@@ -0,0 +1,69 @@
+package distribution
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// LogNormal is the distribution of a duration whose logarithm is normally distributed with mean Mu
+// and standard deviation Sigma. It suits durations made of multiplicative delays.
+type LogNormal struct {
+	Mu    float64
+	Sigma float64
+}
+
+var _ Distribution = LogNormal{}
+
+func (d LogNormal) Family() Family {
+	return FamilyLogNormal
+}
+
+func (d LogNormal) Params() map[string]float64 {
+	return map[string]float64{"mu": d.Mu, "sigma": d.Sigma}
+}
+
+func (d LogNormal) Mean() float64 {
+	return math.Exp(d.Mu + d.Sigma*d.Sigma/2)
+}
+
+func (d LogNormal) Quantile(p float64) float64 {
+	return math.Exp(d.Mu + d.Sigma*math.Sqrt2*math.Erfinv(2*p-1))
+}
+
+func (d LogNormal) LogLikelihood(samples []float64) float64 {
+	var ll float64
+	for _, x := range samples {
+		z := (math.Log(x) - d.Mu) / d.Sigma
+		ll += -math.Log(x*d.Sigma*math.Sqrt(2*math.Pi)) - z*z/2
+	}
+	return ll
+}
+
+func (d LogNormal) Sample(r *rand.Rand) float64 {
+	return math.Exp(d.Mu + d.Sigma*r.NormFloat64())
+}
+
+// LogNormalFitter fits a LogNormal by maximum likelihood.
+type LogNormalFitter struct{}
+
+func (LogNormalFitter) Family() Family {
+	return FamilyLogNormal
+}
+
+func (LogNormalFitter) Fit(samples []float64) (Distribution, error) {
+	if err := validate(samples); err != nil {
+		return nil, err
+	}
+	n := float64(len(samples))
+	var mu float64
+	for _, x := range samples {
+		mu += math.Log(x)
+	}
+	mu /= n
+	var variance float64
+	for _, x := range samples {
+		d := math.Log(x) - mu
+		variance += d * d
+	}
+	return LogNormal{Mu: mu, Sigma: math.Sqrt(variance / n)}, nil
+}
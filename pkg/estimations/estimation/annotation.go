@@ -0,0 +1,102 @@
+package estimation
+
+import (
+	"fmt"
+	"math"
+)
+
+// AnnotationKind tells what the estimation did with a supplied value instead of using it as given.
+type AnnotationKind string
+
+const (
+	// AnnotationIgnored is a value rejected in favor of the default, e.g. a negative transfer rate.
+	AnnotationIgnored AnnotationKind = "ignored"
+	// AnnotationCapped is a value limited by others, e.g. a transfer rate above what the link sustains.
+	AnnotationCapped AnnotationKind = "capped"
+	// AnnotationUnused is a param none of the calculators run reads.
+	AnnotationUnused AnnotationKind = "unused"
+)
+
+// Annotation flags a supplied param the estimation did not use as given, so the user errors surface
+// instead of silently falling back to defaults.
+type Annotation struct {
+	Param string         `json:"param"`
+	Kind  AnnotationKind `json:"kind"`
+	// Calculator is the name of the calculator which did not use the value, empty for an unused param.
+	Calculator string `json:"calculator,omitempty"`
+	// Value is the value supplied and Applied the one used instead, nil for an unused param.
+	Value   interface{} `json:"value"`
+	Applied *float64    `json:"applied,omitempty"`
+	Message string      `json:"message"`
+}
+
+// Ignored annotates the value of the param rejected in favor of applied.
+func Ignored(param string, value interface{}, applied float64, reason string) Annotation {
+	return Annotation{
+		Param:   param,
+		Kind:    AnnotationIgnored,
+		Value:   value,
+		Applied: &applied,
+		Message: fmt.Sprintf("%s %v ignored: %s, %g used instead", param, value, reason, applied),
+	}
+}
+
+// Capped annotates the value of the param limited to applied.
+func Capped(param string, value interface{}, applied float64, reason string) Annotation {
+	applied = math.Round(applied*100) / 100
+	return Annotation{
+		Param:   param,
+		Kind:    AnnotationCapped,
+		Value:   value,
+		Applied: &applied,
+		Message: fmt.Sprintf("%s %v capped to %g by %s", param, value, applied, reason),
+	}
+}
+
+// OptionalKeyer is implemented by the calculators telling the optional params they read besides
+// their Keys. A calculator which does not is assumed to read every param.
+type OptionalKeyer interface {
+	OptionalKeys() []string
+}
+
+// Unused annotates the params none of the registered calculators reads, e.g. a misspelled key or the
+// param of a calculator not selected. Nothing is annotated when a calculator does not tell its
+// optional params.
+func (e *Engine) Unused(params []Param) []Annotation {
+	read := make(map[string]bool)
+	for _, c := range e.calculators {
+		optional, ok := optionalKeys(c)
+		if !ok {
+			return nil
+		}
+		for _, key := range append(c.Keys(), optional...) {
+			read[key] = true
+		}
+	}
+
+	var res []Annotation
+	for _, p := range params {
+		if read[p.Key] {
+			continue
+		}
+		res = append(res, Annotation{
+			Param:   p.Key,
+			Kind:    AnnotationUnused,
+			Value:   p.Value,
+			Message: fmt.Sprintf("%s is not read by any of the calculators run", p.Key),
+		})
+	}
+	return res
+}
+
+// optionalKeys returns the optional params of the calculator, false when it does not tell them.
+func optionalKeys(c Calculator) ([]string, bool) {
+	if s, ok := c.(*Spread); ok {
+		return optionalKeys(s.calc)
+	}
+	o, ok := c.(OptionalKeyer)
+	if !ok {
+		return nil, false
+	}
+	return o.OptionalKeys(), true
+}
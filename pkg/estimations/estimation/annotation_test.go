@@ -0,0 +1,57 @@
+package estimation
+
+import (
+	"testing"
+)
+
+// keyedCalculator is a mockCalculator telling its optional keys.
+type keyedCalculator struct {
+	mockCalculator
+	keys     []string
+	optional []string
+}
+
+func (k *keyedCalculator) Keys() []string         { return k.keys }
+func (k *keyedCalculator) OptionalKeys() []string { return k.optional }
+
+func TestIgnoredAndCapped(t *testing.T) {
+	t.Parallel()
+	ignored := Ignored("transfer_rate_mbps", -50.0, 620, "must be positive")
+	if ignored.Kind != AnnotationIgnored || *ignored.Applied != 620 ||
+		ignored.Message != "transfer_rate_mbps -50 ignored: must be positive, 620 used instead" {
+		t.Errorf("unexpected annotation %+v", ignored)
+	}
+	capped := Capped("transfer_rate_mbps", 1000.0, 83.88608, "the throughput of the TCP streams")
+	if capped.Kind != AnnotationCapped || *capped.Applied != 83.89 ||
+		capped.Message != "transfer_rate_mbps 1000 capped to 83.89 by the throughput of the TCP streams" {
+		t.Errorf("unexpected annotation %+v", capped)
+	}
+}
+
+func TestEngine_Unused(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&keyedCalculator{mockCalculator: mockCalculator{name: "A"}, keys: []string{"vm_count"}, optional: []string{"engineers"}})
+	e.Register(NewSpread(&keyedCalculator{mockCalculator: mockCalculator{name: "B"}, keys: []string{"total_disk_gb"}}, 0.8, 1.5))
+
+	res := e.Unused([]Param{
+		{Key: "vm_count", Value: 10.0},
+		{Key: "engineers", Value: 2.0},
+		{Key: "total_disk_gb", Value: 100.0},
+		{Key: "enginers", Value: 3.0},
+	})
+	if len(res) != 1 || res[0].Param != "enginers" || res[0].Kind != AnnotationUnused || res[0].Value != 3.0 {
+		t.Errorf("expected the misspelled param unused, got %+v", res)
+	}
+}
+
+func TestEngine_Unused_UnknownOptionalKeys(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&keyedCalculator{mockCalculator: mockCalculator{name: "A"}, keys: []string{"vm_count"}})
+	e.Register(&mockCalculator{name: "B"})
+
+	if res := e.Unused([]Param{{Key: "enginers", Value: 3.0}}); res != nil {
+		t.Errorf("expected nothing annotated when a calculator does not tell its optional keys, got %+v", res)
+	}
+}
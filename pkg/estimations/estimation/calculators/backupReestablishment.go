@@ -0,0 +1,199 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamBackupPolicyHoursPerVM is the estimation.Param key for the effort, in hours, to attach a migrated VM to
+	// its backup policy on the target and validate a first restore.
+	ParamBackupPolicyHoursPerVM = "backup_policy_hours_per_vm"
+	// ParamBackupSeedGBPerHour is the estimation.Param key for the rate, in GB per hour, of the first full backup
+	// of the migrated disks.
+	ParamBackupSeedGBPerHour = "backup_seed_gb_per_hour"
+	// ParamBackupEngineers is the estimation.Param key for the number of engineers reattaching the backup policies.
+	ParamBackupEngineers = "backup_engineers"
+
+	DefaultBackupPolicyHoursPerVM = 0.5
+	// DefaultBackupSeedGBPerHour is about 280 MB/s, the sustained ingest of a single backup repository.
+	DefaultBackupSeedGBPerHour = 1000.0
+	DefaultBackupEngineerCount = 1
+)
+
+// Compile-time assertion that BackupReestablishment implements the Calculator interface.
+var _ estimation.Calculator = (*BackupReestablishment)(nil)
+
+// BackupReestablishment estimates re-establishing the backup of the migrated VMs: the backup jobs of
+// the source do not follow the VMs, so each VM is attached to a policy of the target backup solution,
+// then a full backup seeds the repository before incremental backups can run.
+// The seeding is modeled as calendar lead time: Duration is the effort to reattach the policies while
+// LeadTime is the calendar time until the last full backup completes.
+type BackupReestablishment struct {
+	policyHoursPerVM float64
+	seedGBPerHour    float64
+	engineerCount    int
+}
+
+// BackupReestablishmentOption is a functional option for configuring a BackupReestablishment calculator.
+type BackupReestablishmentOption func(*BackupReestablishment)
+
+// WithBackupPolicyHoursPerVM sets the effort, in hours, to attach a VM to its backup policy.
+func WithBackupPolicyHoursPerVM(hours float64) BackupReestablishmentOption {
+	return func(b *BackupReestablishment) {
+		b.policyHoursPerVM = hours
+	}
+}
+
+// WithBackupSeedGBPerHour sets the rate of the first full backup. Non-positive values are ignored.
+func WithBackupSeedGBPerHour(gbPerHour float64) BackupReestablishmentOption {
+	return func(b *BackupReestablishment) {
+		if gbPerHour > 0 {
+			b.seedGBPerHour = gbPerHour
+		}
+	}
+}
+
+// WithBackupEngineerCount sets the number of engineers reattaching the backup policies in parallel.
+func WithBackupEngineerCount(count int) BackupReestablishmentOption {
+	return func(b *BackupReestablishment) {
+		b.engineerCount = count
+	}
+}
+
+// NewBackupReestablishment creates a BackupReestablishment calculator with default settings that can be overridden by options.
+func NewBackupReestablishment(opts ...BackupReestablishmentOption) *BackupReestablishment {
+	res := BackupReestablishment{
+		policyHoursPerVM: DefaultBackupPolicyHoursPerVM,
+		seedGBPerHour:    DefaultBackupSeedGBPerHour,
+		engineerCount:    DefaultBackupEngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("backup_reestablishment", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []BackupReestablishmentOption
+		s.float("policy_hours_per_vm", func(v float64) { opts = append(opts, WithBackupPolicyHoursPerVM(v)) })
+		s.float("seed_gb_per_hour", func(v float64) { opts = append(opts, WithBackupSeedGBPerHour(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithBackupEngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewBackupReestablishment(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *BackupReestablishment) Name() string { return "Backup Re-establishment" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// backup_policy_hours_per_vm, backup_seed_gb_per_hour and backup_engineers are optional.
+func (c *BackupReestablishment) Keys() []string {
+	return []string{ParamVMCount, ParamTotalDiskGB}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *BackupReestablishment) OptionalKeys() []string {
+	return []string{ParamBackupEngineers, ParamBackupPolicyHoursPerVM, ParamBackupSeedGBPerHour}
+}
+
+// Calculate estimates the policy reattachment as vmCount * policy hours / engineers, and returns it
+// followed by the seeding of totalDiskGB at backup_seed_gb_per_hour as LeadTime.
+func (c *BackupReestablishment) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	vmParam, ok := params[ParamVMCount]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamVMCount)
+	}
+	vmCount, err := getInt(vmParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if vmCount < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamVMCount)
+	}
+
+	diskParam, ok := params[ParamTotalDiskGB]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamTotalDiskGB)
+	}
+	totalGB, err := getFloat(diskParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if totalGB < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamTotalDiskGB)
+	}
+
+	policyHours := c.policyHoursPerVM
+	if p, exists := params[ParamBackupPolicyHoursPerVM]; exists {
+		if policyHours, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if policyHours < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamBackupPolicyHoursPerVM)
+	}
+
+	seedRate := c.seedGBPerHour
+	if p, exists := params[ParamBackupSeedGBPerHour]; exists {
+		if seedRate, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if seedRate <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be positive", ParamBackupSeedGBPerHour)
+	}
+
+	engineerCount := c.engineerCount
+	if p, exists := params[ParamBackupEngineers]; exists {
+		if engineerCount, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	realTimeHours := float64(vmCount) * policyHours / float64(engineerCount)
+	duration, err := estimation.FromHours(realTimeHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	seedHours := totalGB / seedRate
+	// The full backups run round the clock once the policies are attached
+	leadTime, err := estimation.FromHours(realTimeHours*24/DefaultWorkHoursPerDay + seedHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		LeadTime: leadTime,
+		Reason: fmt.Sprintf("%d VMs attached to backup policies @ %.2f h / %d engineers, %.2f GB full backup seeded at %.0f GB/h (%.1f h)",
+			vmCount, policyHours, engineerCount, totalGB, seedRate, seedHours),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = vm_count * backup_policy_hours_per_vm / backup_engineers; " +
+				"lead_time = calendar(duration) + seed_hours, seed_hours = total_disk_gb / backup_seed_gb_per_hour",
+			Inputs: []estimation.Quantity{
+				input(params, ParamVMCount, float64(vmCount), estimation.UnitCount),
+				input(params, ParamTotalDiskGB, totalGB, estimation.UnitGB),
+				input(params, ParamBackupPolicyHoursPerVM, policyHours, estimation.UnitHours),
+				input(params, ParamBackupSeedGBPerHour, seedRate, estimation.UnitGBPerHour),
+				input(params, ParamBackupEngineers, float64(engineerCount), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{quantity("seed_hours", seedHours, estimation.UnitHours)},
+			Assumptions: []string{
+				fmt.Sprintf("policies are attached %.0f h per day", DefaultWorkHoursPerDay),
+				"full backups run round the clock once the policies are attached",
+			},
+		},
+	}, nil
+}
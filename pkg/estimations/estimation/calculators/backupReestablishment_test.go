@@ -0,0 +1,128 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestBackupReestablishment_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewBackupReestablishment()
+
+	params := map[string]estimation.Param{
+		ParamVMCount:     {Key: ParamVMCount, Value: 40},
+		ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 4000.0},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 40 VMs * 0.5 h / 1 engineer
+	if result.Duration != 20*time.Hour {
+		t.Errorf("expected duration 20h, got %v", result.Duration)
+	}
+	// 20 h of work over 8 h days, then 4000 GB at 1000 GB/h
+	if result.LeadTime != 64*time.Hour {
+		t.Errorf("expected lead time 64h, got %v", result.LeadTime)
+	}
+	if !strings.Contains(result.Reason, "40 VMs") {
+		t.Errorf("expected reason to mention the VMs, got %q", result.Reason)
+	}
+}
+
+func TestBackupReestablishment_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewBackupReestablishment(
+		WithBackupPolicyHoursPerVM(1),
+		WithBackupSeedGBPerHour(100),
+		WithBackupEngineerCount(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamVMCount:                {Key: ParamVMCount, Value: 40},
+		ParamTotalDiskGB:            {Key: ParamTotalDiskGB, Value: 4000.0},
+		ParamBackupPolicyHoursPerVM: {Key: ParamBackupPolicyHoursPerVM, Value: 0.25},
+		ParamBackupSeedGBPerHour:    {Key: ParamBackupSeedGBPerHour, Value: 2000.0},
+		ParamBackupEngineers:        {Key: ParamBackupEngineers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 40 VMs * 0.25 h / 2 engineers
+	if result.Duration != 5*time.Hour {
+		t.Errorf("expected duration 5h, got %v", result.Duration)
+	}
+	if result.LeadTime != 17*time.Hour {
+		t.Errorf("expected lead time 17h, got %v", result.LeadTime)
+	}
+}
+
+func TestBackupReestablishment_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "missing vm_count param",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 100.0},
+			},
+		},
+		{
+			name: "missing total_disk_gb param",
+			params: map[string]estimation.Param{
+				ParamVMCount: {Key: ParamVMCount, Value: 1},
+			},
+		},
+		{
+			name: "negative vm_count",
+			params: map[string]estimation.Param{
+				ParamVMCount:     {Key: ParamVMCount, Value: -1},
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 100.0},
+			},
+		},
+		{
+			name: "negative total_disk_gb",
+			params: map[string]estimation.Param{
+				ParamVMCount:     {Key: ParamVMCount, Value: 1},
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: -100.0},
+			},
+		},
+		{
+			name: "zero seed rate",
+			params: map[string]estimation.Param{
+				ParamVMCount:             {Key: ParamVMCount, Value: 1},
+				ParamTotalDiskGB:         {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamBackupSeedGBPerHour: {Key: ParamBackupSeedGBPerHour, Value: 0},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamVMCount:         {Key: ParamVMCount, Value: 1},
+				ParamTotalDiskGB:     {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamBackupEngineers: {Key: ParamBackupEngineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewBackupReestablishment().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package calculators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamPCIVMCount is the estimation.Param key for the number of VMs in scope of PCI DSS.
+	ParamPCIVMCount = "pci_vm_count"
+	// ParamHIPAAVMCount is the estimation.Param key for the number of VMs processing protected health information.
+	ParamHIPAAVMCount = "hipaa_vm_count"
+	// ParamEUResidencyVMCount is the estimation.Param key for the number of VMs whose data must stay in the EU.
+	ParamEUResidencyVMCount = "eu_residency_vm_count"
+	// ParamComplianceReviewers is the estimation.Param key for the number of people running the compliance review.
+	ParamComplianceReviewers = "compliance_reviewers"
+
+	DefaultPCISignOffHours         = 40.0
+	DefaultPCIHoursPerVM           = 2.0
+	DefaultHIPAASignOffHours       = 32.0
+	DefaultHIPAAHoursPerVM         = 1.5
+	DefaultResidencySignOffHours   = 8.0
+	DefaultResidencyHoursPerVM     = 0.5
+	DefaultComplianceReviewerCount = 1
+)
+
+// Compile-time assertion that ComplianceReview implements the Calculator interface.
+var _ estimation.Calculator = (*ComplianceReview)(nil)
+
+// ComplianceReview estimates the review of the regulated VMs migrated: each VM is validated against
+// the controls of its regimes, then the evidence of each regime in scope is signed off once.
+type ComplianceReview struct {
+	reviews       map[string]complianceHours
+	reviewerCount int
+}
+
+// complianceHours is the review effort of a regime.
+type complianceHours struct {
+	signOff float64
+	perVM   float64
+}
+
+// ComplianceReviewOption is a functional option for configuring a ComplianceReview calculator.
+type ComplianceReviewOption func(*ComplianceReview)
+
+// WithPCIReviewHours sets the effort, in hours, to sign off PCI DSS and to validate a VM in its scope.
+func WithPCIReviewHours(signOff, perVM float64) ComplianceReviewOption {
+	return func(c *ComplianceReview) {
+		c.reviews[ParamPCIVMCount] = complianceHours{signOff: signOff, perVM: perVM}
+	}
+}
+
+// WithHIPAAReviewHours sets the effort, in hours, to sign off HIPAA and to validate a VM in its scope.
+func WithHIPAAReviewHours(signOff, perVM float64) ComplianceReviewOption {
+	return func(c *ComplianceReview) {
+		c.reviews[ParamHIPAAVMCount] = complianceHours{signOff: signOff, perVM: perVM}
+	}
+}
+
+// WithResidencyReviewHours sets the effort, in hours, to sign off the EU data residency and to verify
+// where the data of a VM is stored.
+func WithResidencyReviewHours(signOff, perVM float64) ComplianceReviewOption {
+	return func(c *ComplianceReview) {
+		c.reviews[ParamEUResidencyVMCount] = complianceHours{signOff: signOff, perVM: perVM}
+	}
+}
+
+// WithComplianceReviewerCount sets the number of people running the review in parallel.
+func WithComplianceReviewerCount(count int) ComplianceReviewOption {
+	return func(c *ComplianceReview) {
+		c.reviewerCount = count
+	}
+}
+
+// NewComplianceReview creates a ComplianceReview calculator with default settings that can be overridden by options.
+func NewComplianceReview(opts ...ComplianceReviewOption) *ComplianceReview {
+	res := ComplianceReview{
+		reviews: map[string]complianceHours{
+			ParamPCIVMCount:         {signOff: DefaultPCISignOffHours, perVM: DefaultPCIHoursPerVM},
+			ParamHIPAAVMCount:       {signOff: DefaultHIPAASignOffHours, perVM: DefaultHIPAAHoursPerVM},
+			ParamEUResidencyVMCount: {signOff: DefaultResidencySignOffHours, perVM: DefaultResidencyHoursPerVM},
+		},
+		reviewerCount: DefaultComplianceReviewerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("compliance_review", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		// the sign-off and per VM hours of a regime are set together, the one missing keeps its default
+		pci := complianceHours{signOff: DefaultPCISignOffHours, perVM: DefaultPCIHoursPerVM}
+		hipaa := complianceHours{signOff: DefaultHIPAASignOffHours, perVM: DefaultHIPAAHoursPerVM}
+		residency := complianceHours{signOff: DefaultResidencySignOffHours, perVM: DefaultResidencyHoursPerVM}
+		s.float("pci_sign_off_hours", func(v float64) { pci.signOff = v })
+		s.float("pci_hours_per_vm", func(v float64) { pci.perVM = v })
+		s.float("hipaa_sign_off_hours", func(v float64) { hipaa.signOff = v })
+		s.float("hipaa_hours_per_vm", func(v float64) { hipaa.perVM = v })
+		s.float("residency_sign_off_hours", func(v float64) { residency.signOff = v })
+		s.float("residency_hours_per_vm", func(v float64) { residency.perVM = v })
+		opts := []ComplianceReviewOption{
+			WithPCIReviewHours(pci.signOff, pci.perVM),
+			WithHIPAAReviewHours(hipaa.signOff, hipaa.perVM),
+			WithResidencyReviewHours(residency.signOff, residency.perVM),
+		}
+		s.int("reviewer_count", func(v int) { opts = append(opts, WithComplianceReviewerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewComplianceReview(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *ComplianceReview) Name() string { return "Compliance Review" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// All of them are optional: a missing count means no VM in scope of the regime.
+func (c *ComplianceReview) Keys() []string {
+	return []string{ParamPCIVMCount, ParamHIPAAVMCount, ParamEUResidencyVMCount}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *ComplianceReview) OptionalKeys() []string {
+	return []string{ParamComplianceReviewers, ParamEngineerHourlyRate}
+}
+
+// Calculate estimates the effort as the sum, over the regimes with VMs in scope, of the sign-off hours
+// + VMs * hours per VM, divided by the reviewers.
+func (c *ComplianceReview) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	counts := make(map[string]int, 3)
+	for _, key := range c.Keys() {
+		p, exists := params[key]
+		if !exists {
+			continue
+		}
+		count, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if count < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		counts[key] = count
+	}
+
+	reviewerCount := c.reviewerCount
+	if p, exists := params[ParamComplianceReviewers]; exists {
+		var err error
+		if reviewerCount, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if reviewerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("reviewers must be > 0")
+	}
+
+	explanation := &estimation.Explanation{
+		Formula:     "duration = sum over the regimes with VMs of (<regime>_sign_off_hours + <regime>_vm_count * <regime>_hours_per_vm) / compliance_reviewers",
+		Assumptions: []string{"regimes without VMs in scope are not signed off"},
+	}
+	var effortHours float64
+	for _, key := range c.Keys() {
+		count := counts[key]
+		h := c.reviews[key]
+		regime := strings.TrimSuffix(key, "_vm_count")
+		explanation.Inputs = append(explanation.Inputs,
+			input(params, key, float64(count), estimation.UnitCount),
+			quantity(regime+"_sign_off_hours", h.signOff, estimation.UnitHours),
+			quantity(regime+"_hours_per_vm", h.perVM, estimation.UnitHours),
+		)
+		if count == 0 {
+			continue
+		}
+		hours := h.signOff + float64(count)*h.perVM
+		explanation.Intermediates = append(explanation.Intermediates, quantity(regime+"_hours", hours, estimation.UnitHours))
+		effortHours += hours
+	}
+	explanation.Inputs = append(explanation.Inputs, input(params, ParamComplianceReviewers, float64(reviewerCount), estimation.UnitCount))
+	explanation.Intermediates = append(explanation.Intermediates, quantity("effort_hours", effortHours, estimation.UnitHours))
+	realTimeHours := effortHours / float64(reviewerCount)
+
+	duration, err := estimation.FromHours(realTimeHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, effortHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		Reason: fmt.Sprintf("%d PCI + %d HIPAA + %d EU residency VMs reviewed (%.1f h) / %d reviewers",
+			counts[ParamPCIVMCount], counts[ParamHIPAAVMCount], counts[ParamEUResidencyVMCount], effortHours, reviewerCount),
+		Explanation: explanation,
+	}, nil
+}
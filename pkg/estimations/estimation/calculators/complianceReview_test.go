@@ -0,0 +1,101 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestComplianceReview_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewComplianceReview()
+
+	params := map[string]estimation.Param{
+		ParamPCIVMCount:         {Key: ParamPCIVMCount, Value: 10},
+		ParamEUResidencyVMCount: {Key: ParamEUResidencyVMCount, Value: 16},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// PCI: 40 h + 10 * 2 h, residency: 8 h + 16 * 0.5 h, no HIPAA sign-off without VMs
+	if result.Duration != 76*time.Hour {
+		t.Errorf("expected duration 76h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "10 PCI") {
+		t.Errorf("expected reason to mention the PCI VMs, got %q", result.Reason)
+	}
+
+	result, err = calc.Calculate(map[string]estimation.Param{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Duration != 0 {
+		t.Errorf("expected no review without regulated VMs, got %v", result.Duration)
+	}
+}
+
+func TestComplianceReview_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewComplianceReview(
+		WithHIPAAReviewHours(20, 1),
+		WithComplianceReviewerCount(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamHIPAAVMCount:        {Key: ParamHIPAAVMCount, Value: 20},
+		ParamComplianceReviewers: {Key: ParamComplianceReviewers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (20 h + 20 * 1 h) / 2 reviewers
+	if result.Duration != 20*time.Hour {
+		t.Errorf("expected duration 20h, got %v", result.Duration)
+	}
+}
+
+func TestComplianceReview_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "negative pci_vm_count",
+			params: map[string]estimation.Param{
+				ParamPCIVMCount: {Key: ParamPCIVMCount, Value: -1},
+			},
+		},
+		{
+			name: "count is not a number",
+			params: map[string]estimation.Param{
+				ParamHIPAAVMCount: {Key: ParamHIPAAVMCount, Value: "ten"},
+			},
+		},
+		{
+			name: "zero reviewers",
+			params: map[string]estimation.Param{
+				ParamComplianceReviewers: {Key: ParamComplianceReviewers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewComplianceReview().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package calculators
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// The rates pricing the estimations, in the currency of the caller. Calculators given none of them
+// return no cost.
+const (
+	// ParamEngineerHourlyRate is the hourly cost of an engineer, pricing the effort of the calculators as labor.
+	ParamEngineerHourlyRate = "engineer_hourly_rate"
+	// ParamEgressCostPerGB is the cost of a GB leaving the source, e.g. the egress fee of a cloud provider.
+	ParamEgressCostPerGB = "egress_cost_per_gb"
+	// ParamTempStorageCostPerGBDay is the cost of a GB of temporary storage held for a day, e.g. the
+	// staging copy of the disks during a transfer.
+	ParamTempStorageCostPerGBDay = "temp_storage_cost_per_gb_day"
+)
+
+// laborCost prices the effort with the engineer hourly rate of the params, nil when they hold none.
+func laborCost(params map[string]estimation.Param, effortHours float64) (*estimation.Cost, error) {
+	rate, ok, err := costRate(params, ParamEngineerHourlyRate)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &estimation.Cost{EngineerHours: effortHours, Labor: effortHours * rate}, nil
+}
+
+// transferCost prices the GB sent over the egress fee and the GB staged on temporary storage for as
+// long as the transfer lasts, nil when the params hold neither rate.
+func transferCost(params map[string]estimation.Param, sentGB, stagedGB float64, d time.Duration) (*estimation.Cost, error) {
+	egress, hasEgress, err := costRate(params, ParamEgressCostPerGB)
+	if err != nil {
+		return nil, err
+	}
+	storage, hasStorage, err := costRate(params, ParamTempStorageCostPerGBDay)
+	if err != nil {
+		return nil, err
+	}
+	if !hasEgress && !hasStorage {
+		return nil, nil
+	}
+	return &estimation.Cost{Infrastructure: sentGB*egress + stagedGB*d.Hours()/24*storage}, nil
+}
+
+// costRate returns the rate of the param, false when not given.
+func costRate(params map[string]estimation.Param, key string) (float64, bool, error) {
+	p, ok := params[key]
+	if !ok {
+		return 0, false, nil
+	}
+	rate, err := getFloat(p)
+	if err != nil {
+		return 0, false, err
+	}
+	if rate < 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return 0, false, fmt.Errorf("%s must be non-negative", key)
+	}
+	return rate, true, nil
+}
@@ -0,0 +1,64 @@
+package calculators
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestLaborCost(t *testing.T) {
+	t.Parallel()
+	calc := NewPostMigrationTroubleShooting()
+	params := map[string]estimation.Param{
+		ParamVMCount:                {Key: ParamVMCount, Value: 100},
+		ParamPostMigrationEngineers: {Key: ParamPostMigrationEngineers, Value: 5},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Cost != nil {
+		t.Errorf("expected no cost without rate, got %+v", result.Cost)
+	}
+
+	params[ParamEngineerHourlyRate] = estimation.Param{Key: ParamEngineerHourlyRate, Value: 90.0}
+	result, err = calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 100 VMs @ 60 mins: 100 engineer-hours whatever the number of engineers
+	if result.Cost == nil || result.Cost.EngineerHours != 100 || result.Cost.Labor != 9000 || result.Cost.Infrastructure != 0 {
+		t.Errorf("expected 100 engineer-hours costing 9000, got %+v", result.Cost)
+	}
+
+	params[ParamEngineerHourlyRate] = estimation.Param{Key: ParamEngineerHourlyRate, Value: -1.0}
+	if _, err := calc.Calculate(params); err == nil {
+		t.Error("expected an error for a negative rate")
+	}
+}
+
+func TestTransferCost(t *testing.T) {
+	t.Parallel()
+	calc := NewStorageMigration()
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB:             {Key: ParamTotalDiskGB, Value: 2000.0},
+		ParamCompressionRatio:        {Key: ParamCompressionRatio, Value: 2.0},
+		ParamEgressCostPerGB:         {Key: ParamEgressCostPerGB, Value: 0.09},
+		ParamTempStorageCostPerGBDay: {Key: ParamTempStorageCostPerGBDay, Value: 0.01},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Cost == nil {
+		t.Fatal("expected a cost")
+	}
+	// 1000 GB sent, 2000 GB staged for the days of the transfer
+	want := 1000*0.09 + 2000*result.Duration.Hours()/24*0.01
+	if math.Abs(result.Cost.Infrastructure-want) > 1e-9 || result.Cost.Labor != 0 {
+		t.Errorf("expected an infrastructure cost of %v, got %+v", want, result.Cost)
+	}
+}
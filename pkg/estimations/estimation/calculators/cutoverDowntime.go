@@ -0,0 +1,265 @@
+package calculators
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamPowerOffMinutes is the estimation.Param key for the minutes to shut down a source VM cleanly.
+	ParamPowerOffMinutes = "power_off_minutes"
+	// ParamFinalSyncMinutes is the estimation.Param key for the minutes of the copy made while the VM is down:
+	// the cutover pass of a warm migration, see WarmMigrationDeltaSync.FinalSync, or the whole copy of a cold one.
+	ParamFinalSyncMinutes = "final_sync_minutes"
+	// ParamReattachMinutes is the estimation.Param key for the minutes to attach the copied disks to the target VM.
+	ParamReattachMinutes = "reattach_minutes"
+	// ParamIPReplumbMinutes is the estimation.Param key for the minutes to move the IP addresses, DNS records and
+	// firewall rules of a VM to the target.
+	ParamIPReplumbMinutes = "ip_replumb_minutes"
+	// ParamBootMinutes is the estimation.Param key for the minutes for the target VM to boot and start its services.
+	ParamBootMinutes = "boot_minutes"
+	// ParamCutoverValidationMinutes is the estimation.Param key for the minutes of smoke tests before the VM is
+	// handed back to its users.
+	ParamCutoverValidationMinutes = "cutover_validation_minutes"
+	// ParamParallelCutovers is the estimation.Param key for the number of VMs cut over at the same time.
+	ParamParallelCutovers = "parallel_cutovers"
+
+	DefaultPowerOffMinutes          = 5.0
+	DefaultFinalSyncMinutes         = 15.0
+	DefaultReattachMinutes          = 5.0
+	DefaultIPReplumbMinutes         = 10.0
+	DefaultBootMinutes              = 5.0
+	DefaultCutoverValidationMinutes = 15.0
+	DefaultParallelCutovers         = 10
+)
+
+// cutoverSteps are the steps of a cutover, in the order they run, by param key.
+var cutoverSteps = []struct {
+	key, name string
+}{
+	{ParamPowerOffMinutes, "power-off"},
+	{ParamFinalSyncMinutes, "final sync"},
+	{ParamReattachMinutes, "reattach"},
+	{ParamIPReplumbMinutes, "IP re-plumb"},
+	{ParamBootMinutes, "boot"},
+	{ParamCutoverValidationMinutes, "validation"},
+}
+
+// Compile-time assertion that CutoverDowntime implements the Calculator interface.
+var _ estimation.Calculator = (*CutoverDowntime)(nil)
+
+// CutoverDowntime estimates the outage of the cutover, as negotiated for the maintenance windows: a VM
+// is down from its power-off at the source to the end of its validation at the target. VMs are cut
+// over in batches of parallel_cutovers, so Duration is the window holding all the batches while the
+// Reason gives the outage of each VM, see VMDowntime.
+type CutoverDowntime struct {
+	stepMinutes      map[string]float64
+	parallelCutovers int
+}
+
+// CutoverDowntimeOption is a functional option for configuring a CutoverDowntime calculator.
+type CutoverDowntimeOption func(*CutoverDowntime)
+
+// WithPowerOffMinutes sets the minutes to shut down a source VM.
+func WithPowerOffMinutes(minutes float64) CutoverDowntimeOption {
+	return func(c *CutoverDowntime) {
+		c.stepMinutes[ParamPowerOffMinutes] = minutes
+	}
+}
+
+// WithFinalSyncMinutes sets the minutes of the copy made while the VM is down.
+func WithFinalSyncMinutes(minutes float64) CutoverDowntimeOption {
+	return func(c *CutoverDowntime) {
+		c.stepMinutes[ParamFinalSyncMinutes] = minutes
+	}
+}
+
+// WithReattachMinutes sets the minutes to attach the copied disks to the target VM.
+func WithReattachMinutes(minutes float64) CutoverDowntimeOption {
+	return func(c *CutoverDowntime) {
+		c.stepMinutes[ParamReattachMinutes] = minutes
+	}
+}
+
+// WithIPReplumbMinutes sets the minutes to move the network identity of a VM to the target.
+func WithIPReplumbMinutes(minutes float64) CutoverDowntimeOption {
+	return func(c *CutoverDowntime) {
+		c.stepMinutes[ParamIPReplumbMinutes] = minutes
+	}
+}
+
+// WithBootMinutes sets the minutes for the target VM to boot and start its services.
+func WithBootMinutes(minutes float64) CutoverDowntimeOption {
+	return func(c *CutoverDowntime) {
+		c.stepMinutes[ParamBootMinutes] = minutes
+	}
+}
+
+// WithCutoverValidationMinutes sets the minutes of smoke tests before the VM is handed back.
+func WithCutoverValidationMinutes(minutes float64) CutoverDowntimeOption {
+	return func(c *CutoverDowntime) {
+		c.stepMinutes[ParamCutoverValidationMinutes] = minutes
+	}
+}
+
+// WithParallelCutovers sets the number of VMs cut over at the same time. Non-positive values are ignored.
+func WithParallelCutovers(count int) CutoverDowntimeOption {
+	return func(c *CutoverDowntime) {
+		if count > 0 {
+			c.parallelCutovers = count
+		}
+	}
+}
+
+// NewCutoverDowntime creates a CutoverDowntime calculator with default settings that can be overridden by options.
+func NewCutoverDowntime(opts ...CutoverDowntimeOption) *CutoverDowntime {
+	res := CutoverDowntime{
+		stepMinutes: map[string]float64{
+			ParamPowerOffMinutes:          DefaultPowerOffMinutes,
+			ParamFinalSyncMinutes:         DefaultFinalSyncMinutes,
+			ParamReattachMinutes:          DefaultReattachMinutes,
+			ParamIPReplumbMinutes:         DefaultIPReplumbMinutes,
+			ParamBootMinutes:              DefaultBootMinutes,
+			ParamCutoverValidationMinutes: DefaultCutoverValidationMinutes,
+		},
+		parallelCutovers: DefaultParallelCutovers,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("cutover_downtime", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []CutoverDowntimeOption
+		s.float("power_off_minutes", func(v float64) { opts = append(opts, WithPowerOffMinutes(v)) })
+		s.float("final_sync_minutes", func(v float64) { opts = append(opts, WithFinalSyncMinutes(v)) })
+		s.float("reattach_minutes", func(v float64) { opts = append(opts, WithReattachMinutes(v)) })
+		s.float("ip_replumb_minutes", func(v float64) { opts = append(opts, WithIPReplumbMinutes(v)) })
+		s.float("boot_minutes", func(v float64) { opts = append(opts, WithBootMinutes(v)) })
+		s.float("validation_minutes", func(v float64) { opts = append(opts, WithCutoverValidationMinutes(v)) })
+		s.int("parallel_cutovers", func(v int) { opts = append(opts, WithParallelCutovers(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewCutoverDowntime(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *CutoverDowntime) Name() string { return "Cutover Downtime" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// The minutes of each step and parallel_cutovers are optional.
+func (c *CutoverDowntime) Keys() []string {
+	return []string{ParamVMCount}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *CutoverDowntime) OptionalKeys() []string {
+	return []string{ParamBootMinutes, ParamCutoverValidationMinutes, ParamFinalSyncMinutes, ParamIPReplumbMinutes, ParamParallelCutovers, ParamPowerOffMinutes, ParamReattachMinutes}
+}
+
+// Calculate estimates the cutover window as ceil(vmCount / parallel_cutovers) batches of the outage
+// of a VM, the sum of the minutes of its steps.
+func (c *CutoverDowntime) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	vmParam, ok := params[ParamVMCount]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamVMCount)
+	}
+	vmCount, err := getInt(vmParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if vmCount < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamVMCount)
+	}
+
+	parallel := c.parallelCutovers
+	if p, exists := params[ParamParallelCutovers]; exists {
+		if parallel, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if parallel <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be > 0", ParamParallelCutovers)
+	}
+
+	steps, err := c.steps(params)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	var downtimeMinutes float64
+	parts := make([]string, 0, len(steps))
+	inputs := []estimation.Quantity{input(params, ParamVMCount, float64(vmCount), estimation.UnitCount)}
+	for i, minutes := range steps {
+		downtimeMinutes += minutes
+		parts = append(parts, fmt.Sprintf("%.0f min %s", minutes, cutoverSteps[i].name))
+		inputs = append(inputs, input(params, cutoverSteps[i].key, minutes, estimation.UnitMinutes))
+	}
+	inputs = append(inputs, input(params, ParamParallelCutovers, float64(parallel), estimation.UnitCount))
+
+	batches := int(math.Ceil(float64(vmCount) / float64(parallel)))
+	windowMinutes := float64(batches) * downtimeMinutes
+
+	duration, err := estimation.FromMinutes(windowMinutes)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Reason: fmt.Sprintf("%.0f min downtime per VM (%s), %d VMs in %d batches of %d",
+			downtimeMinutes, strings.Join(parts, " + "), vmCount, batches, parallel),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = batches * downtime_minutes_per_vm, batches = ceil(vm_count / parallel_cutovers), " +
+				"downtime_minutes_per_vm = sum of the minutes of the cutover steps",
+			Inputs: inputs,
+			Intermediates: []estimation.Quantity{
+				quantity("downtime_minutes_per_vm", downtimeMinutes, estimation.UnitMinutes),
+				quantity("batches", float64(batches), estimation.UnitCount),
+			},
+			Assumptions: []string{"the VMs of a batch are cut over in parallel, the batches one after the other"},
+		},
+	}, nil
+}
+
+// VMDowntime returns the outage of a single VM, from its power-off to the end of its validation.
+func (c *CutoverDowntime) VMDowntime(params map[string]estimation.Param) (time.Duration, error) {
+	steps, err := c.steps(params)
+	if err != nil {
+		return 0, err
+	}
+	var minutes float64
+	for _, m := range steps {
+		minutes += m
+	}
+	return estimation.FromMinutes(minutes)
+}
+
+// steps returns the minutes of the cutover steps, in the order of cutoverSteps.
+func (c *CutoverDowntime) steps(params map[string]estimation.Param) ([]float64, error) {
+	res := make([]float64, 0, len(cutoverSteps))
+	for _, step := range cutoverSteps {
+		minutes := c.stepMinutes[step.key]
+		if p, exists := params[step.key]; exists {
+			var err error
+			if minutes, err = getFloat(p); err != nil {
+				return nil, err
+			}
+		}
+		if minutes < 0 {
+			return nil, fmt.Errorf("%s must be non-negative", step.key)
+		}
+		res = append(res, minutes)
+	}
+	return res, nil
+}
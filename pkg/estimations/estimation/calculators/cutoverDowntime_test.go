@@ -0,0 +1,142 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestCutoverDowntime_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewCutoverDowntime()
+
+	params := map[string]estimation.Param{
+		ParamVMCount: {Key: ParamVMCount, Value: 25},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 5 + 15 + 5 + 10 + 5 + 15 = 55 min per VM, 25 VMs in 3 batches of 10
+	if result.Duration != 165*time.Minute {
+		t.Errorf("expected duration 165m, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "55 min downtime per VM") {
+		t.Errorf("expected reason to give the downtime per VM, got %q", result.Reason)
+	}
+
+	downtime, err := calc.VMDowntime(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if downtime != 55*time.Minute {
+		t.Errorf("expected 55m of downtime per VM, got %v", downtime)
+	}
+}
+
+func TestCutoverDowntime_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewCutoverDowntime(
+		WithPowerOffMinutes(1),
+		WithFinalSyncMinutes(60),
+		WithReattachMinutes(1),
+		WithIPReplumbMinutes(0),
+		WithBootMinutes(10),
+		WithCutoverValidationMinutes(0),
+		WithParallelCutovers(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamVMCount:          {Key: ParamVMCount, Value: 4},
+		ParamFinalSyncMinutes: {Key: ParamFinalSyncMinutes, Value: 8.0},
+		ParamParallelCutovers: {Key: ParamParallelCutovers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 1 + 8 + 1 + 0 + 10 + 0 = 20 min per VM, 4 VMs in 2 batches of 2
+	if result.Duration != 40*time.Minute {
+		t.Errorf("expected duration 40m, got %v", result.Duration)
+	}
+}
+
+func TestCutoverDowntime_Calculate_WarmFinalSync(t *testing.T) {
+	t.Parallel()
+	params := map[string]estimation.Param{
+		ParamVMCount:     {Key: ParamVMCount, Value: 1},
+		ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 1000.0},
+	}
+	finalSync, err := NewWarmMigrationDeltaSync().FinalSync(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	params[ParamFinalSyncMinutes] = estimation.Param{Key: ParamFinalSyncMinutes, Value: finalSync.Minutes()}
+
+	downtime, err := NewCutoverDowntime().VMDowntime(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want := time.Duration((DefaultPowerOffMinutes+DefaultReattachMinutes+DefaultIPReplumbMinutes+
+		DefaultBootMinutes+DefaultCutoverValidationMinutes)*float64(time.Minute)) + finalSync
+	if diff := downtime - want; diff < -time.Millisecond || diff > time.Millisecond {
+		t.Errorf("expected the downtime to include the warm final sync, got %v want %v", downtime, want)
+	}
+}
+
+func TestCutoverDowntime_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name:   "missing vm_count param",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "negative vm_count",
+			params: map[string]estimation.Param{
+				ParamVMCount: {Key: ParamVMCount, Value: -1},
+			},
+		},
+		{
+			name: "negative boot_minutes",
+			params: map[string]estimation.Param{
+				ParamVMCount:     {Key: ParamVMCount, Value: 1},
+				ParamBootMinutes: {Key: ParamBootMinutes, Value: -5.0},
+			},
+		},
+		{
+			name: "final_sync_minutes is not a number",
+			params: map[string]estimation.Param{
+				ParamVMCount:          {Key: ParamVMCount, Value: 1},
+				ParamFinalSyncMinutes: {Key: ParamFinalSyncMinutes, Value: "fast"},
+			},
+		},
+		{
+			name: "zero parallel_cutovers",
+			params: map[string]estimation.Param{
+				ParamVMCount:          {Key: ParamVMCount, Value: 1},
+				ParamParallelCutovers: {Key: ParamParallelCutovers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewCutoverDowntime().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamMonitoringIntegrations is the estimation.Param key for the number of target clusters to integrate with
+	// the monitoring and alerting of the operations team.
+	ParamMonitoringIntegrations = "monitoring_integrations"
+	// ParamVMBackupSetups is the estimation.Param key for the number of target clusters without a backup solution
+	// protecting KubeVirt VMs.
+	ParamVMBackupSetups = "vm_backup_setups"
+	// ParamDRRunbooks is the estimation.Param key for the number of applications without a disaster recovery
+	// runbook for the target platform.
+	ParamDRRunbooks = "dr_runbooks"
+	// ParamDay2Engineers is the estimation.Param key for the number of engineers closing the day-2 gaps.
+	ParamDay2Engineers = "day2_engineers"
+
+	DefaultMonitoringIntegrationHours = 40.0
+	DefaultVMBackupSetupHours         = 80.0
+	DefaultDRRunbookHours             = 16.0
+	DefaultDay2EngineerCount          = 2
+)
+
+// Compile-time assertion that Day2Readiness implements the Calculator interface.
+var _ estimation.Calculator = (*Day2Readiness)(nil)
+
+// Day2Readiness estimates the work to operate the migrated VMs on the target: integrating the
+// clusters with the monitoring of the operations team, setting up a backup solution for KubeVirt
+// VMs and writing the disaster recovery runbooks of the applications.
+type Day2Readiness struct {
+	monitoringIntegrationHours float64
+	vmBackupSetupHours         float64
+	drRunbookHours             float64
+	engineerCount              int
+}
+
+// Day2ReadinessOption is a functional option for configuring a Day2Readiness calculator.
+type Day2ReadinessOption func(*Day2Readiness)
+
+// WithMonitoringIntegrationHours sets the effort, in hours, to integrate a cluster with the monitoring.
+func WithMonitoringIntegrationHours(hours float64) Day2ReadinessOption {
+	return func(d *Day2Readiness) {
+		d.monitoringIntegrationHours = hours
+	}
+}
+
+// WithVMBackupSetupHours sets the effort, in hours, to set up and validate a backup solution for the VMs of a cluster.
+func WithVMBackupSetupHours(hours float64) Day2ReadinessOption {
+	return func(d *Day2Readiness) {
+		d.vmBackupSetupHours = hours
+	}
+}
+
+// WithDRRunbookHours sets the effort, in hours, to write and rehearse the disaster recovery runbook of an application.
+func WithDRRunbookHours(hours float64) Day2ReadinessOption {
+	return func(d *Day2Readiness) {
+		d.drRunbookHours = hours
+	}
+}
+
+// WithDay2EngineerCount sets the number of engineers closing the day-2 gaps in parallel.
+func WithDay2EngineerCount(count int) Day2ReadinessOption {
+	return func(d *Day2Readiness) {
+		d.engineerCount = count
+	}
+}
+
+// NewDay2Readiness creates a Day2Readiness calculator with default settings that can be overridden by options.
+func NewDay2Readiness(opts ...Day2ReadinessOption) *Day2Readiness {
+	res := Day2Readiness{
+		monitoringIntegrationHours: DefaultMonitoringIntegrationHours,
+		vmBackupSetupHours:         DefaultVMBackupSetupHours,
+		drRunbookHours:             DefaultDRRunbookHours,
+		engineerCount:              DefaultDay2EngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("day2_readiness", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []Day2ReadinessOption
+		s.float("monitoring_integration_hours", func(v float64) { opts = append(opts, WithMonitoringIntegrationHours(v)) })
+		s.float("vm_backup_setup_hours", func(v float64) { opts = append(opts, WithVMBackupSetupHours(v)) })
+		s.float("dr_runbook_hours", func(v float64) { opts = append(opts, WithDRRunbookHours(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithDay2EngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewDay2Readiness(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *Day2Readiness) Name() string { return "Day-2 Readiness" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// All of them are optional: a missing count means no work of that kind.
+func (c *Day2Readiness) Keys() []string {
+	return []string{ParamMonitoringIntegrations, ParamVMBackupSetups, ParamDRRunbooks}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *Day2Readiness) OptionalKeys() []string {
+	return []string{ParamDay2Engineers, ParamEngineerHourlyRate}
+}
+
+// Calculate estimates the effort as (monitoring integrations * integration hours + backup setups *
+// setup hours + runbooks * runbook hours) / engineers.
+func (c *Day2Readiness) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	counts := make(map[string]int, 3)
+	for _, key := range c.Keys() {
+		p, exists := params[key]
+		if !exists {
+			continue
+		}
+		count, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if count < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		counts[key] = count
+	}
+
+	engineerCount := c.engineerCount
+	if p, exists := params[ParamDay2Engineers]; exists {
+		var err error
+		if engineerCount, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	effortHours := float64(counts[ParamMonitoringIntegrations])*c.monitoringIntegrationHours +
+		float64(counts[ParamVMBackupSetups])*c.vmBackupSetupHours +
+		float64(counts[ParamDRRunbooks])*c.drRunbookHours
+	realTimeHours := effortHours / float64(engineerCount)
+
+	duration, err := estimation.FromHours(realTimeHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, effortHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		Reason: fmt.Sprintf("%d monitoring integrations @ %.1f h + %d VM backup setups @ %.1f h + %d DR runbooks @ %.1f h / %d engineers",
+			counts[ParamMonitoringIntegrations], c.monitoringIntegrationHours,
+			counts[ParamVMBackupSetups], c.vmBackupSetupHours,
+			counts[ParamDRRunbooks], c.drRunbookHours, engineerCount),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = (monitoring_integrations * monitoring_integration_hours + vm_backup_setups * vm_backup_setup_hours + " +
+				"dr_runbooks * dr_runbook_hours) / day2_engineers",
+			Inputs: []estimation.Quantity{
+				input(params, ParamMonitoringIntegrations, float64(counts[ParamMonitoringIntegrations]), estimation.UnitCount),
+				quantity("monitoring_integration_hours", c.monitoringIntegrationHours, estimation.UnitHours),
+				input(params, ParamVMBackupSetups, float64(counts[ParamVMBackupSetups]), estimation.UnitCount),
+				quantity("vm_backup_setup_hours", c.vmBackupSetupHours, estimation.UnitHours),
+				input(params, ParamDRRunbooks, float64(counts[ParamDRRunbooks]), estimation.UnitCount),
+				quantity("dr_runbook_hours", c.drRunbookHours, estimation.UnitHours),
+				input(params, ParamDay2Engineers, float64(engineerCount), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{quantity("effort_hours", effortHours, estimation.UnitHours)},
+		},
+	}, nil
+}
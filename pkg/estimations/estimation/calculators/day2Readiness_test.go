@@ -0,0 +1,108 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestDay2Readiness_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewDay2Readiness()
+
+	params := map[string]estimation.Param{
+		ParamMonitoringIntegrations: {Key: ParamMonitoringIntegrations, Value: 1},
+		ParamVMBackupSetups:         {Key: ParamVMBackupSetups, Value: 1},
+		ParamDRRunbooks:             {Key: ParamDRRunbooks, Value: 5},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (40 h + 80 h + 5 * 16 h) / 2 engineers = 100 h
+	if result.Duration != 100*time.Hour {
+		t.Errorf("expected duration 100h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "5 DR runbooks") {
+		t.Errorf("expected reason to mention the runbooks, got %q", result.Reason)
+	}
+}
+
+func TestDay2Readiness_Calculate_NoGaps(t *testing.T) {
+	t.Parallel()
+	result, err := NewDay2Readiness().Calculate(map[string]estimation.Param{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Duration != 0 {
+		t.Errorf("expected no duration without gaps, got %v", result.Duration)
+	}
+}
+
+func TestDay2Readiness_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewDay2Readiness(
+		WithMonitoringIntegrationHours(10),
+		WithVMBackupSetupHours(20),
+		WithDRRunbookHours(4),
+		WithDay2EngineerCount(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamVMBackupSetups: {Key: ParamVMBackupSetups, Value: 2},
+		ParamDRRunbooks:     {Key: ParamDRRunbooks, Value: 10},
+		ParamDay2Engineers:  {Key: ParamDay2Engineers, Value: 4},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (2 * 20 h + 10 * 4 h) / 4 engineers = 20 h
+	if result.Duration != 20*time.Hour {
+		t.Errorf("expected duration 20h, got %v", result.Duration)
+	}
+}
+
+func TestDay2Readiness_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "negative dr_runbooks",
+			params: map[string]estimation.Param{
+				ParamDRRunbooks: {Key: ParamDRRunbooks, Value: -1},
+			},
+		},
+		{
+			name: "invalid monitoring_integrations type",
+			params: map[string]estimation.Param{
+				ParamMonitoringIntegrations: {Key: ParamMonitoringIntegrations, Value: "one"},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamDay2Engineers: {Key: ParamDay2Engineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewDay2Readiness().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamArchiveGBPerHour is the estimation.Param key for the throughput of the data archival target in GB per hour.
+	ParamArchiveGBPerHour = "archive_gb_per_hour"
+	// ParamDecommissionEngineers is the estimation.Param key for the number of engineers retiring VMs in parallel.
+	ParamDecommissionEngineers = "decommission_engineers"
+	// ParamSignOffMinsPerVM is the estimation.Param key for the minutes spent per VM obtaining stakeholder sign-off.
+	ParamSignOffMinsPerVM = "sign_off_mins_per_vm"
+	// ParamDNSCleanupMinsPerVM is the estimation.Param key for the minutes spent per VM removing DNS records.
+	ParamDNSCleanupMinsPerVM = "dns_cleanup_mins_per_vm"
+	// ParamLicenseReclaimMinsPerVM is the estimation.Param key for the minutes spent per VM reclaiming software licenses.
+	ParamLicenseReclaimMinsPerVM = "license_reclaim_mins_per_vm"
+
+	DefaultArchiveGBPerHour        = 500.0
+	DefaultSignOffMinsPerVM        = 30.0
+	DefaultDNSCleanupMinsPerVM     = 10.0
+	DefaultLicenseReclaimMinsPerVM = 15.0
+)
+
+// Compile-time assertion that Decommission implements the Calculator interface.
+var _ estimation.Calculator = (*Decommission)(nil)
+
+// Decommission estimates the effort to retire VMs instead of migrating them:
+// archiving their data, collecting stakeholder sign-off, cleaning up DNS and reclaiming licenses.
+type Decommission struct {
+	archiveGBPerHour        float64
+	signOffMinsPerVM        float64
+	dnsCleanupMinsPerVM     float64
+	licenseReclaimMinsPerVM float64
+	engineerCount           int
+}
+
+// DecommissionOption is a functional option for configuring a Decommission calculator.
+type DecommissionOption func(*Decommission)
+
+// WithArchiveGBPerHour sets the archival throughput. Non-positive values are ignored.
+func WithArchiveGBPerHour(gbPerHour float64) DecommissionOption {
+	return func(d *Decommission) {
+		if gbPerHour > 0 {
+			d.archiveGBPerHour = gbPerHour
+		}
+	}
+}
+
+// WithSignOffMinsPerVM sets the minutes spent per VM obtaining stakeholder sign-off.
+func WithSignOffMinsPerVM(mins float64) DecommissionOption {
+	return func(d *Decommission) {
+		d.signOffMinsPerVM = mins
+	}
+}
+
+// WithDNSCleanupMinsPerVM sets the minutes spent per VM removing DNS records.
+func WithDNSCleanupMinsPerVM(mins float64) DecommissionOption {
+	return func(d *Decommission) {
+		d.dnsCleanupMinsPerVM = mins
+	}
+}
+
+// WithLicenseReclaimMinsPerVM sets the minutes spent per VM reclaiming software licenses.
+func WithLicenseReclaimMinsPerVM(mins float64) DecommissionOption {
+	return func(d *Decommission) {
+		d.licenseReclaimMinsPerVM = mins
+	}
+}
+
+// WithDecommissionEngineerCount sets the number of engineers working on the decommission in parallel.
+func WithDecommissionEngineerCount(count int) DecommissionOption {
+	return func(d *Decommission) {
+		d.engineerCount = count
+	}
+}
+
+// NewDecommission creates a Decommission calculator with default settings that can be overridden by options.
+func NewDecommission(opts ...DecommissionOption) *Decommission {
+	res := Decommission{
+		archiveGBPerHour:        DefaultArchiveGBPerHour,
+		signOffMinsPerVM:        DefaultSignOffMinsPerVM,
+		dnsCleanupMinsPerVM:     DefaultDNSCleanupMinsPerVM,
+		licenseReclaimMinsPerVM: DefaultLicenseReclaimMinsPerVM,
+		engineerCount:           DefaultEngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("decommission", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []DecommissionOption
+		s.float("archive_gb_per_hour", func(v float64) { opts = append(opts, WithArchiveGBPerHour(v)) })
+		s.float("sign_off_mins_per_vm", func(v float64) { opts = append(opts, WithSignOffMinsPerVM(v)) })
+		s.float("dns_cleanup_mins_per_vm", func(v float64) { opts = append(opts, WithDNSCleanupMinsPerVM(v)) })
+		s.float("license_reclaim_mins_per_vm", func(v float64) { opts = append(opts, WithLicenseReclaimMinsPerVM(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithDecommissionEngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewDecommission(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *Decommission) Name() string { return "Decommission" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// total_disk_gb, archive_gb_per_hour, decommission_engineers and the per-VM minutes are optional.
+func (c *Decommission) Keys() []string {
+	return []string{ParamVMCount}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *Decommission) OptionalKeys() []string {
+	return []string{ParamArchiveGBPerHour, ParamDNSCleanupMinsPerVM, ParamDecommissionEngineers, ParamLicenseReclaimMinsPerVM, ParamSignOffMinsPerVM, ParamTotalDiskGB}
+}
+
+// Calculate estimates the decommission duration: the data archival time plus the manual
+// per-VM work (sign-off, DNS cleanup, license reclamation) divided across engineers.
+func (c *Decommission) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	vmParam, ok := params[ParamVMCount]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamVMCount)
+	}
+	vmCount, err := getInt(vmParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if vmCount < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamVMCount)
+	}
+
+	totalGB := 0.0
+	if diskParam, exists := params[ParamTotalDiskGB]; exists {
+		totalGB, err = getFloat(diskParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if totalGB < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamTotalDiskGB)
+		}
+	}
+
+	var notes []estimation.Annotation
+	archiveGBPerHour, err := positiveParam(params, ParamArchiveGBPerHour, c.archiveGBPerHour, &notes)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	inputs := []estimation.Quantity{
+		input(params, ParamVMCount, float64(vmCount), estimation.UnitCount),
+		input(params, ParamTotalDiskGB, totalGB, estimation.UnitGB),
+		input(params, ParamArchiveGBPerHour, archiveGBPerHour, estimation.UnitGBPerHour),
+	}
+	manualMinsPerVM := 0.0
+	for _, m := range []struct {
+		key      string
+		fallback float64
+	}{
+		{ParamSignOffMinsPerVM, c.signOffMinsPerVM},
+		{ParamDNSCleanupMinsPerVM, c.dnsCleanupMinsPerVM},
+		{ParamLicenseReclaimMinsPerVM, c.licenseReclaimMinsPerVM},
+	} {
+		mins := m.fallback
+		if minsParam, exists := params[m.key]; exists {
+			mins, err = getFloat(minsParam)
+			if err != nil {
+				return estimation.Estimation{}, err
+			}
+			if mins < 0 {
+				return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", m.key)
+			}
+		}
+		manualMinsPerVM += mins
+		inputs = append(inputs, input(params, m.key, mins, estimation.UnitMinutes))
+	}
+
+	engineerCount := c.engineerCount
+	if engParam, exists := params[ParamDecommissionEngineers]; exists {
+		engineerCount, err = getInt(engParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	archiveMins := totalGB / archiveGBPerHour * 60
+	manualMins := float64(vmCount) * manualMinsPerVM / float64(engineerCount)
+	totalMins := archiveMins + manualMins
+
+	duration, err := estimation.FromMinutes(totalMins)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Reason: fmt.Sprintf("%d VMs: archive %.2f GB at %.0f GB/h (%.0f min) + %.1f mins each for sign-off, DNS and license cleanup / %d engineers (%.0f min)",
+			vmCount, totalGB, archiveGBPerHour, archiveMins, manualMinsPerVM, engineerCount, manualMins),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = archive_minutes + manual_minutes, archive_minutes = total_disk_gb / archive_gb_per_hour * 60, " +
+				"manual_minutes = vm_count * manual_minutes_per_vm / decommission_engineers, " +
+				"manual_minutes_per_vm = sign_off_mins_per_vm + dns_cleanup_mins_per_vm + license_reclaim_mins_per_vm",
+			Inputs: append(inputs, input(params, ParamDecommissionEngineers, float64(engineerCount), estimation.UnitCount)),
+			Intermediates: []estimation.Quantity{
+				quantity("archive_minutes", archiveMins, estimation.UnitMinutes),
+				quantity("manual_minutes_per_vm", manualMinsPerVM, estimation.UnitMinutes),
+				quantity("manual_minutes", manualMins, estimation.UnitMinutes),
+			},
+			Assumptions: []string{"the data is archived before the manual work starts"},
+		},
+		Annotations: notes,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package calculators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestDecommission_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewDecommission()
+
+	params := map[string]estimation.Param{
+		ParamVMCount:     {Key: ParamVMCount, Value: 20},
+		ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 1000.0},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// archive: 1000 GB / 500 GB/h = 120 min
+	// manual: 20 VMs * (30 + 10 + 15) mins / 10 engineers = 110 min
+	expectedDuration := 230 * time.Minute
+	if result.Duration != expectedDuration {
+		t.Errorf("expected duration %v, got %v", expectedDuration, result.Duration)
+	}
+	if result.Reason == "" {
+		t.Error("expected non-empty reason")
+	}
+}
+
+func TestDecommission_Calculate_WithoutDiskSize(t *testing.T) {
+	t.Parallel()
+	calc := NewDecommission(
+		WithSignOffMinsPerVM(60),
+		WithDNSCleanupMinsPerVM(0),
+		WithLicenseReclaimMinsPerVM(0),
+		WithDecommissionEngineerCount(2),
+	)
+
+	params := map[string]estimation.Param{
+		ParamVMCount: {Key: ParamVMCount, Value: 4},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 4 VMs * 60 mins / 2 engineers = 120 min, no archival
+	if result.Duration != 120*time.Minute {
+		t.Errorf("expected duration 2h, got %v", result.Duration)
+	}
+}
+
+func TestDecommission_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewDecommission(WithArchiveGBPerHour(100), WithDecommissionEngineerCount(1))
+
+	params := map[string]estimation.Param{
+		ParamVMCount:               {Key: ParamVMCount, Value: 0},
+		ParamTotalDiskGB:           {Key: ParamTotalDiskGB, Value: 600.0},
+		ParamArchiveGBPerHour:      {Key: ParamArchiveGBPerHour, Value: 300.0},
+		ParamDecommissionEngineers: {Key: ParamDecommissionEngineers, Value: 5},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 600 GB / 300 GB/h = 2h, no VMs so no manual work
+	if result.Duration != 2*time.Hour {
+		t.Errorf("expected duration 2h, got %v", result.Duration)
+	}
+}
+
+func TestDecommission_Calculate_ManualMinutesFromParams(t *testing.T) {
+	t.Parallel()
+	calc := NewDecommission(WithDecommissionEngineerCount(1))
+
+	params := map[string]estimation.Param{
+		ParamVMCount:                 {Key: ParamVMCount, Value: 3},
+		ParamSignOffMinsPerVM:        {Key: ParamSignOffMinsPerVM, Value: 20.0},
+		ParamDNSCleanupMinsPerVM:     {Key: ParamDNSCleanupMinsPerVM, Value: 0},
+		ParamLicenseReclaimMinsPerVM: {Key: ParamLicenseReclaimMinsPerVM, Value: 0},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 3 VMs * 20 mins / 1 engineer = 1h
+	if result.Duration != time.Hour {
+		t.Errorf("expected duration 1h, got %v", result.Duration)
+	}
+}
+
+func TestDecommission_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		calcOpts []DecommissionOption
+		params   map[string]estimation.Param
+	}{
+		{
+			name:   "missing vm_count param",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "negative vm_count",
+			params: map[string]estimation.Param{
+				ParamVMCount: {Key: ParamVMCount, Value: -1},
+			},
+		},
+		{
+			name: "negative disk size",
+			params: map[string]estimation.Param{
+				ParamVMCount:     {Key: ParamVMCount, Value: 1},
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: -1.0},
+			},
+		},
+		{
+			name: "negative sign-off minutes",
+			params: map[string]estimation.Param{
+				ParamVMCount:          {Key: ParamVMCount, Value: 1},
+				ParamSignOffMinsPerVM: {Key: ParamSignOffMinsPerVM, Value: -5.0},
+			},
+		},
+		{
+			name:     "zero engineers",
+			calcOpts: []DecommissionOption{WithDecommissionEngineerCount(0)},
+			params: map[string]estimation.Param{
+				ParamVMCount: {Key: ParamVMCount, Value: 1},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewDecommission(tc.calcOpts...).Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
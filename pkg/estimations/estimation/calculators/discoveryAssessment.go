@@ -0,0 +1,180 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamApplicationCount is the estimation.Param key for the number of applications running on the VMs in scope.
+	ParamApplicationCount = "application_count"
+	// ParamStakeholderCount is the estimation.Param key for the number of application owners and stakeholders to interview.
+	ParamStakeholderCount = "stakeholder_count"
+	// ParamDiscoveryAnalysts is the estimation.Param key for the number of analysts running the discovery.
+	ParamDiscoveryAnalysts = "discovery_analysts"
+
+	DefaultInventoryMinsPerVM    = 5.0
+	DefaultClassifyHoursPerApp   = 2.0
+	DefaultInterviewHours        = 1.5
+	DefaultDiscoveryAnalystCount = 2
+)
+
+// Compile-time assertion that DiscoveryAssessment implements the Calculator interface.
+var _ estimation.Calculator = (*DiscoveryAssessment)(nil)
+
+// DiscoveryAssessment estimates the up-front discovery before the first VM is copied: reconciling the
+// inventory of each VM with its owner, classifying each application, e.g. its dependencies, downtime
+// tolerance and migration method, and interviewing the application owners and stakeholders.
+type DiscoveryAssessment struct {
+	inventoryMinsPerVM  float64
+	classifyHoursPerApp float64
+	interviewHours      float64
+	analystCount        int
+}
+
+// DiscoveryAssessmentOption is a functional option for configuring a DiscoveryAssessment calculator.
+type DiscoveryAssessmentOption func(*DiscoveryAssessment)
+
+// WithInventoryMinsPerVM sets the minutes to reconcile the inventory of a VM with its owner.
+func WithInventoryMinsPerVM(mins float64) DiscoveryAssessmentOption {
+	return func(d *DiscoveryAssessment) {
+		d.inventoryMinsPerVM = mins
+	}
+}
+
+// WithClassifyHoursPerApp sets the hours to classify an application: its dependencies, downtime tolerance
+// and migration method.
+func WithClassifyHoursPerApp(hours float64) DiscoveryAssessmentOption {
+	return func(d *DiscoveryAssessment) {
+		d.classifyHoursPerApp = hours
+	}
+}
+
+// WithInterviewHours sets the hours spent per stakeholder interview, preparation and write-up included.
+func WithInterviewHours(hours float64) DiscoveryAssessmentOption {
+	return func(d *DiscoveryAssessment) {
+		d.interviewHours = hours
+	}
+}
+
+// WithDiscoveryAnalystCount sets the number of analysts running the discovery in parallel.
+func WithDiscoveryAnalystCount(count int) DiscoveryAssessmentOption {
+	return func(d *DiscoveryAssessment) {
+		d.analystCount = count
+	}
+}
+
+// NewDiscoveryAssessment creates a DiscoveryAssessment calculator with default settings that can be overridden by options.
+func NewDiscoveryAssessment(opts ...DiscoveryAssessmentOption) *DiscoveryAssessment {
+	res := DiscoveryAssessment{
+		inventoryMinsPerVM:  DefaultInventoryMinsPerVM,
+		classifyHoursPerApp: DefaultClassifyHoursPerApp,
+		interviewHours:      DefaultInterviewHours,
+		analystCount:        DefaultDiscoveryAnalystCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("discovery_assessment", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []DiscoveryAssessmentOption
+		s.float("inventory_mins_per_vm", func(v float64) { opts = append(opts, WithInventoryMinsPerVM(v)) })
+		s.float("classify_hours_per_app", func(v float64) { opts = append(opts, WithClassifyHoursPerApp(v)) })
+		s.float("interview_hours", func(v float64) { opts = append(opts, WithInterviewHours(v)) })
+		s.int("analyst_count", func(v int) { opts = append(opts, WithDiscoveryAnalystCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewDiscoveryAssessment(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *DiscoveryAssessment) Name() string { return "Discovery Assessment" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// discovery_analysts is optional.
+func (c *DiscoveryAssessment) Keys() []string {
+	return []string{ParamVMCount, ParamApplicationCount, ParamStakeholderCount}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *DiscoveryAssessment) OptionalKeys() []string {
+	return []string{ParamDiscoveryAnalysts, ParamEngineerHourlyRate}
+}
+
+// Calculate estimates the discovery as (VMs * inventory minutes + applications * classification hours +
+// stakeholders * interview hours) / analysts. discovery_analysts overrides the analyst count.
+func (c *DiscoveryAssessment) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	counts := make(map[string]int, 3)
+	for _, key := range c.Keys() {
+		p, ok := params[key]
+		if !ok {
+			return estimation.Estimation{}, fmt.Errorf("missing %s", key)
+		}
+		count, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if count < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		counts[key] = count
+	}
+
+	analysts := c.analystCount
+	if p, exists := params[ParamDiscoveryAnalysts]; exists {
+		var err error
+		if analysts, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if analysts <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be > 0", ParamDiscoveryAnalysts)
+	}
+
+	totalHours := float64(counts[ParamVMCount])*c.inventoryMinsPerVM/60 +
+		float64(counts[ParamApplicationCount])*c.classifyHoursPerApp +
+		float64(counts[ParamStakeholderCount])*c.interviewHours
+	realTimeHours := totalHours / float64(analysts)
+
+	duration, err := estimation.FromHours(realTimeHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, totalHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		Reason: fmt.Sprintf("(%d VMs @ %.1f mins + %d applications @ %.1f h + %d stakeholder interviews @ %.1f h) / %d analysts",
+			counts[ParamVMCount], c.inventoryMinsPerVM,
+			counts[ParamApplicationCount], c.classifyHoursPerApp,
+			counts[ParamStakeholderCount], c.interviewHours, analysts),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = (vm_count * inventory_mins_per_vm / 60 + application_count * classify_hours_per_app + " +
+				"stakeholder_count * interview_hours) / discovery_analysts",
+			Inputs: []estimation.Quantity{
+				input(params, ParamVMCount, float64(counts[ParamVMCount]), estimation.UnitCount),
+				quantity("inventory_mins_per_vm", c.inventoryMinsPerVM, estimation.UnitMinutes),
+				input(params, ParamApplicationCount, float64(counts[ParamApplicationCount]), estimation.UnitCount),
+				quantity("classify_hours_per_app", c.classifyHoursPerApp, estimation.UnitHours),
+				input(params, ParamStakeholderCount, float64(counts[ParamStakeholderCount]), estimation.UnitCount),
+				quantity("interview_hours", c.interviewHours, estimation.UnitHours),
+				input(params, ParamDiscoveryAnalysts, float64(analysts), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{quantity("effort_hours", totalHours, estimation.UnitHours)},
+		},
+	}, nil
+}
@@ -0,0 +1,119 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestDiscoveryAssessment_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewDiscoveryAssessment()
+
+	params := map[string]estimation.Param{
+		ParamVMCount:          {Key: ParamVMCount, Value: 600},
+		ParamApplicationCount: {Key: ParamApplicationCount, Value: 40},
+		ParamStakeholderCount: {Key: ParamStakeholderCount, Value: 20},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (600 * 5 / 60 + 40 * 2 + 20 * 1.5) / 2 = (50 + 80 + 30) / 2 = 80h
+	if result.Duration != 80*time.Hour {
+		t.Errorf("expected duration 80h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "20 stakeholder interviews") {
+		t.Errorf("expected reason to mention the interviews, got %q", result.Reason)
+	}
+}
+
+func TestDiscoveryAssessment_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewDiscoveryAssessment(
+		WithInventoryMinsPerVM(6),
+		WithClassifyHoursPerApp(4),
+		WithInterviewHours(2),
+		WithDiscoveryAnalystCount(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamVMCount:           {Key: ParamVMCount, Value: 100.0},
+		ParamApplicationCount:  {Key: ParamApplicationCount, Value: 10.0},
+		ParamStakeholderCount:  {Key: ParamStakeholderCount, Value: 5.0},
+		ParamDiscoveryAnalysts: {Key: ParamDiscoveryAnalysts, Value: 3},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (100 * 6 / 60 + 10 * 4 + 5 * 2) / 3 = 60 / 3 = 20h
+	if result.Duration != 20*time.Hour {
+		t.Errorf("expected duration 20h, got %v", result.Duration)
+	}
+}
+
+func TestDiscoveryAssessment_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	valid := func() map[string]estimation.Param {
+		return map[string]estimation.Param{
+			ParamVMCount:          {Key: ParamVMCount, Value: 10},
+			ParamApplicationCount: {Key: ParamApplicationCount, Value: 2},
+			ParamStakeholderCount: {Key: ParamStakeholderCount, Value: 2},
+		}
+	}
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "missing application_count",
+			params: func() map[string]estimation.Param {
+				p := valid()
+				delete(p, ParamApplicationCount)
+				return p
+			}(),
+		},
+		{
+			name: "negative stakeholder_count",
+			params: func() map[string]estimation.Param {
+				p := valid()
+				p[ParamStakeholderCount] = estimation.Param{Key: ParamStakeholderCount, Value: -1}
+				return p
+			}(),
+		},
+		{
+			name: "non-numeric vm_count",
+			params: func() map[string]estimation.Param {
+				p := valid()
+				p[ParamVMCount] = estimation.Param{Key: ParamVMCount, Value: "many"}
+				return p
+			}(),
+		},
+		{
+			name: "zero analysts",
+			params: func() map[string]estimation.Param {
+				p := valid()
+				p[ParamDiscoveryAnalysts] = estimation.Param{Key: ParamDiscoveryAnalysts, Value: 0}
+				return p
+			}(),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewDiscoveryAssessment().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -3,4 +3,7 @@
 // Each calculator estimates the time required for one specific phase of a VM migration
 // (e.g. storage data transfer, post-migration troubleshooting). Calculators are designed
 // to be composed via the estimation.Engine and accept input through estimation.Param slices.
+//
+// Each calculator registers itself in DefaultRegistry under a unique ID, e.g. "storage_migration",
+// so callers select and configure calculators by name instead of calling their constructors.
 package calculators
@@ -0,0 +1,237 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamDRContinuousApps is the estimation.Param key for the number of applications whose RPO requires
+	// continuous replication to the DR site.
+	ParamDRContinuousApps = "dr_continuous_apps"
+	// ParamDRContinuousDiskGB is the estimation.Param key for the disk size, in GB, of the applications replicated
+	// continuously.
+	ParamDRContinuousDiskGB = "dr_continuous_disk_gb"
+	// ParamDRScheduledApps is the estimation.Param key for the number of applications whose RPO is met by
+	// replicating snapshots on a schedule.
+	ParamDRScheduledApps = "dr_scheduled_apps"
+	// ParamDRScheduledDiskGB is the estimation.Param key for the disk size, in GB, of the applications replicated
+	// on a schedule.
+	ParamDRScheduledDiskGB = "dr_scheduled_disk_gb"
+	// ParamDRReplicationRateMbps is the estimation.Param key for the rate of the link to the DR site.
+	ParamDRReplicationRateMbps = "dr_replication_rate_mbps"
+	// ParamDREngineers is the estimation.Param key for the number of engineers setting up the replication.
+	ParamDREngineers = "dr_engineers"
+
+	DefaultContinuousReplicationSetupHours = 24.0
+	DefaultScheduledReplicationSetupHours  = 8.0
+	DefaultDREngineerCount                 = 1
+)
+
+// Compile-time assertion that DRReplication implements the Calculator interface.
+var _ estimation.Calculator = (*DRReplication)(nil)
+
+// DRReplication estimates re-establishing the replication of the migrated applications to the DR site:
+// the replication of the source, e.g. vSphere Replication, does not cover the target, so it is set up
+// again per application, continuous or scheduled depending on its RPO, then the disks are replicated
+// in full once before the RPO holds.
+// The initial replication is modeled as calendar lead time: Duration is the setup effort while LeadTime
+// is the calendar time until the last application is protected.
+type DRReplication struct {
+	continuousSetupHours float64
+	scheduledSetupHours  float64
+	replicationRateMbps  float64
+	engineerCount        int
+}
+
+// DRReplicationOption is a functional option for configuring a DRReplication calculator.
+type DRReplicationOption func(*DRReplication)
+
+// WithContinuousReplicationSetupHours sets the effort, in hours, to set up and test the continuous replication of an application.
+func WithContinuousReplicationSetupHours(hours float64) DRReplicationOption {
+	return func(d *DRReplication) {
+		d.continuousSetupHours = hours
+	}
+}
+
+// WithScheduledReplicationSetupHours sets the effort, in hours, to set up and test the scheduled replication of an application.
+func WithScheduledReplicationSetupHours(hours float64) DRReplicationOption {
+	return func(d *DRReplication) {
+		d.scheduledSetupHours = hours
+	}
+}
+
+// WithDRReplicationRateMbps sets the rate of the link to the DR site. Non-positive values are ignored.
+func WithDRReplicationRateMbps(mbps float64) DRReplicationOption {
+	return func(d *DRReplication) {
+		if mbps > 0 {
+			d.replicationRateMbps = mbps
+		}
+	}
+}
+
+// WithDREngineerCount sets the number of engineers setting up the replication in parallel.
+func WithDREngineerCount(count int) DRReplicationOption {
+	return func(d *DRReplication) {
+		d.engineerCount = count
+	}
+}
+
+// NewDRReplication creates a DRReplication calculator with default settings that can be overridden by options.
+func NewDRReplication(opts ...DRReplicationOption) *DRReplication {
+	res := DRReplication{
+		continuousSetupHours: DefaultContinuousReplicationSetupHours,
+		scheduledSetupHours:  DefaultScheduledReplicationSetupHours,
+		replicationRateMbps:  DefaultTransferRateMbps,
+		engineerCount:        DefaultDREngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("dr_replication", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []DRReplicationOption
+		s.float("continuous_setup_hours", func(v float64) { opts = append(opts, WithContinuousReplicationSetupHours(v)) })
+		s.float("scheduled_setup_hours", func(v float64) { opts = append(opts, WithScheduledReplicationSetupHours(v)) })
+		s.float("replication_rate_mbps", func(v float64) { opts = append(opts, WithDRReplicationRateMbps(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithDREngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewDRReplication(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *DRReplication) Name() string { return "DR Replication" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// All of them are optional: a missing tier means no application in it. dr_replication_rate_mbps and
+// dr_engineers are optional too.
+func (c *DRReplication) Keys() []string {
+	return []string{ParamDRContinuousApps, ParamDRContinuousDiskGB, ParamDRScheduledApps, ParamDRScheduledDiskGB}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *DRReplication) OptionalKeys() []string {
+	return []string{ParamDREngineers, ParamDRReplicationRateMbps, ParamEngineerHourlyRate}
+}
+
+// Calculate estimates the setup as (continuous apps * continuous setup hours + scheduled apps *
+// scheduled setup hours) / engineers, and returns it followed by the initial replication of the disks
+// of both tiers at dr_replication_rate_mbps as LeadTime.
+func (c *DRReplication) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	apps := make(map[string]int, 2)
+	for _, key := range []string{ParamDRContinuousApps, ParamDRScheduledApps} {
+		p, exists := params[key]
+		if !exists {
+			continue
+		}
+		count, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if count < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		apps[key] = count
+	}
+
+	diskGB := make(map[string]float64, 2)
+	for _, key := range []string{ParamDRContinuousDiskGB, ParamDRScheduledDiskGB} {
+		p, exists := params[key]
+		if !exists {
+			continue
+		}
+		gb, err := getFloat(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if gb < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		diskGB[key] = gb
+	}
+
+	var notes []estimation.Annotation
+	rateMbps, err := positiveParam(params, ParamDRReplicationRateMbps, c.replicationRateMbps, &notes)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	engineerCount := c.engineerCount
+	if p, exists := params[ParamDREngineers]; exists {
+		var err error
+		if engineerCount, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	effortHours := float64(apps[ParamDRContinuousApps])*c.continuousSetupHours +
+		float64(apps[ParamDRScheduledApps])*c.scheduledSetupHours
+	realTimeHours := effortHours / float64(engineerCount)
+	duration, err := estimation.FromHours(realTimeHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	replicatedGB := diskGB[ParamDRContinuousDiskGB] + diskGB[ParamDRScheduledDiskGB]
+	replicationMinutes := (replicatedGB * 1024) / (rateMbps / 8) / 60
+	replication, err := estimation.FromMinutes(replicationMinutes)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	leadTime, err := estimation.FromHours(realTimeHours*24/DefaultWorkHoursPerDay + replicationMinutes/60)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, effortHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		LeadTime: leadTime,
+		Reason: fmt.Sprintf("%d continuous @ %.1f h + %d scheduled @ %.1f h replications set up / %d engineers, %.2f GB initially replicated at %.0f Mbps (%.1f h)",
+			apps[ParamDRContinuousApps], c.continuousSetupHours, apps[ParamDRScheduledApps], c.scheduledSetupHours,
+			engineerCount, replicatedGB, rateMbps, replication.Hours()),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = (dr_continuous_apps * continuous_setup_hours + dr_scheduled_apps * scheduled_setup_hours) / dr_engineers; " +
+				"lead_time = calendar(duration) + replication_hours, replication_hours = replicated_gb * 1024 / (dr_replication_rate_mbps / 8) / 3600, " +
+				"replicated_gb = dr_continuous_disk_gb + dr_scheduled_disk_gb",
+			Inputs: []estimation.Quantity{
+				input(params, ParamDRContinuousApps, float64(apps[ParamDRContinuousApps]), estimation.UnitCount),
+				quantity("continuous_setup_hours", c.continuousSetupHours, estimation.UnitHours),
+				input(params, ParamDRContinuousDiskGB, diskGB[ParamDRContinuousDiskGB], estimation.UnitGB),
+				input(params, ParamDRScheduledApps, float64(apps[ParamDRScheduledApps]), estimation.UnitCount),
+				quantity("scheduled_setup_hours", c.scheduledSetupHours, estimation.UnitHours),
+				input(params, ParamDRScheduledDiskGB, diskGB[ParamDRScheduledDiskGB], estimation.UnitGB),
+				input(params, ParamDRReplicationRateMbps, rateMbps, estimation.UnitMbps),
+				input(params, ParamDREngineers, float64(engineerCount), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{
+				quantity("effort_hours", effortHours, estimation.UnitHours),
+				quantity("replicated_gb", replicatedGB, estimation.UnitGB),
+				quantity("replication_hours", replication.Hours(), estimation.UnitHours),
+			},
+			Assumptions: []string{
+				fmt.Sprintf("replications are set up %.0f h per day", DefaultWorkHoursPerDay),
+				"the initial replication runs round the clock once set up",
+			},
+		},
+		Annotations: notes,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestDRReplication_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewDRReplication()
+
+	params := map[string]estimation.Param{
+		ParamDRContinuousApps: {Key: ParamDRContinuousApps, Value: 2},
+		ParamDRScheduledApps:  {Key: ParamDRScheduledApps, Value: 3},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 2 * 24 h + 3 * 8 h / 1 engineer
+	if result.Duration != 72*time.Hour {
+		t.Errorf("expected duration 72h, got %v", result.Duration)
+	}
+	// nothing to replicate: the lead time is the setup in calendar time
+	if result.LeadTime != 9*24*time.Hour {
+		t.Errorf("expected lead time of 9 days, got %v", result.LeadTime)
+	}
+	if !strings.Contains(result.Reason, "2 continuous") || !strings.Contains(result.Reason, "3 scheduled") {
+		t.Errorf("expected reason to mention both tiers, got %q", result.Reason)
+	}
+}
+
+func TestDRReplication_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	// 1 GB per second
+	const oneGBPerSecondMbps = 8192.0
+	calc := NewDRReplication(
+		WithContinuousReplicationSetupHours(16),
+		WithScheduledReplicationSetupHours(4),
+		WithDRReplicationRateMbps(100),
+		WithDREngineerCount(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamDRContinuousApps:      {Key: ParamDRContinuousApps, Value: 2},
+		ParamDRContinuousDiskGB:    {Key: ParamDRContinuousDiskGB, Value: 600.0},
+		ParamDRScheduledApps:       {Key: ParamDRScheduledApps, Value: 4},
+		ParamDRScheduledDiskGB:     {Key: ParamDRScheduledDiskGB, Value: 900.0},
+		ParamDRReplicationRateMbps: {Key: ParamDRReplicationRateMbps, Value: oneGBPerSecondMbps},
+		ParamDREngineers:           {Key: ParamDREngineers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (2 * 16 h + 4 * 4 h) / 2 engineers
+	if result.Duration != 24*time.Hour {
+		t.Errorf("expected duration 24h, got %v", result.Duration)
+	}
+	// 3 working days, then 1500 GB at 1 GB/s
+	if want := 72*time.Hour + 25*time.Minute; result.LeadTime != want {
+		t.Errorf("expected lead time %v, got %v", want, result.LeadTime)
+	}
+}
+
+func TestDRReplication_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "negative continuous apps",
+			params: map[string]estimation.Param{
+				ParamDRContinuousApps: {Key: ParamDRContinuousApps, Value: -1},
+			},
+		},
+		{
+			name: "negative scheduled disk size",
+			params: map[string]estimation.Param{
+				ParamDRScheduledDiskGB: {Key: ParamDRScheduledDiskGB, Value: -10.0},
+			},
+		},
+		{
+			name: "apps count is not a number",
+			params: map[string]estimation.Param{
+				ParamDRScheduledApps: {Key: ParamDRScheduledApps, Value: "three"},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamDREngineers: {Key: ParamDREngineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewDRReplication().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
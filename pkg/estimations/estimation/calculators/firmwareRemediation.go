@@ -0,0 +1,179 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamEFIBootVMs is the estimation.Param key for the number of UEFI VMs without secure boot.
+	ParamEFIBootVMs = "efi_boot_vms"
+	// ParamSecureBootVMs is the estimation.Param key for the number of UEFI VMs with secure boot.
+	ParamSecureBootVMs = "secure_boot_vms"
+	// ParamTPMVMs is the estimation.Param key for the number of VMs with a virtual TPM.
+	ParamTPMVMs = "tpm_vms"
+
+	DefaultEFIBootMins    = 10.0
+	DefaultSecureBootMins = 30.0
+	DefaultTPMMins        = 45.0
+)
+
+// Compile-time assertion that FirmwareRemediation implements the Calculator interface.
+var _ estimation.Calculator = (*FirmwareRemediation)(nil)
+
+// FirmwareRemediation estimates the extra work for VMs whose firmware needs special handling on KubeVirt:
+// restoring the boot entries of UEFI VMs, which are kept in the source NVRAM, enabling secure boot with
+// signed drivers and recovering the secrets sealed in a virtual TPM, e.g. BitLocker keys.
+// A VM with several issues is counted once per issue.
+type FirmwareRemediation struct {
+	efiBootMins    float64
+	secureBootMins float64
+	tpmMins        float64
+	engineerCount  int
+}
+
+// FirmwareRemediationOption is a functional option for configuring a FirmwareRemediation calculator.
+type FirmwareRemediationOption func(*FirmwareRemediation)
+
+// WithEFIBootMins sets the effort, in minutes, to restore the boot entries of a UEFI VM.
+func WithEFIBootMins(mins float64) FirmwareRemediationOption {
+	return func(f *FirmwareRemediation) {
+		f.efiBootMins = mins
+	}
+}
+
+// WithSecureBootMins sets the effort, in minutes, to bring up a secure boot VM on the target.
+func WithSecureBootMins(mins float64) FirmwareRemediationOption {
+	return func(f *FirmwareRemediation) {
+		f.secureBootMins = mins
+	}
+}
+
+// WithTPMMins sets the effort, in minutes, to recover and re-seal the secrets of a VM with a virtual TPM.
+func WithTPMMins(mins float64) FirmwareRemediationOption {
+	return func(f *FirmwareRemediation) {
+		f.tpmMins = mins
+	}
+}
+
+// WithFirmwareEngineerCount sets the number of engineers remediating firmware issues in parallel.
+func WithFirmwareEngineerCount(count int) FirmwareRemediationOption {
+	return func(f *FirmwareRemediation) {
+		f.engineerCount = count
+	}
+}
+
+// NewFirmwareRemediation creates a FirmwareRemediation calculator with default settings that can be overridden by options.
+func NewFirmwareRemediation(opts ...FirmwareRemediationOption) *FirmwareRemediation {
+	res := FirmwareRemediation{
+		efiBootMins:    DefaultEFIBootMins,
+		secureBootMins: DefaultSecureBootMins,
+		tpmMins:        DefaultTPMMins,
+		engineerCount:  DefaultEngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("firmware_remediation", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []FirmwareRemediationOption
+		s.float("efi_boot_mins", func(v float64) { opts = append(opts, WithEFIBootMins(v)) })
+		s.float("secure_boot_mins", func(v float64) { opts = append(opts, WithSecureBootMins(v)) })
+		s.float("tpm_mins", func(v float64) { opts = append(opts, WithTPMMins(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithFirmwareEngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewFirmwareRemediation(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *FirmwareRemediation) Name() string { return "Firmware Remediation" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// All counts are optional: a missing count means no VM has that issue.
+func (c *FirmwareRemediation) Keys() []string {
+	return []string{}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *FirmwareRemediation) OptionalKeys() []string {
+	return []string{ParamEFIBootVMs, ParamEngineerHourlyRate, ParamRemediationEngineers, ParamSecureBootVMs, ParamTPMVMs}
+}
+
+// Calculate estimates the remediation effort as the sum of the VMs per issue times the minutes per issue,
+// divided by the engineers. remediation_engineers overrides the engineer count.
+func (c *FirmwareRemediation) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	counts := make(map[string]int, 3)
+	for _, key := range []string{ParamEFIBootVMs, ParamSecureBootVMs, ParamTPMVMs} {
+		p, exists := params[key]
+		if !exists {
+			continue
+		}
+		count, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if count < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		counts[key] = count
+	}
+
+	engineerCount := c.engineerCount
+	if engParam, exists := params[ParamRemediationEngineers]; exists {
+		var err error
+		engineerCount, err = getInt(engParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	totalMins := float64(counts[ParamEFIBootVMs])*c.efiBootMins +
+		float64(counts[ParamSecureBootVMs])*c.secureBootMins +
+		float64(counts[ParamTPMVMs])*c.tpmMins
+	realTimeMins := totalMins / float64(engineerCount)
+
+	duration, err := estimation.FromMinutes(realTimeMins)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, totalMins/60)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		Reason: fmt.Sprintf("(%d UEFI VMs @ %.1f mins + %d secure boot VMs @ %.1f mins + %d TPM VMs @ %.1f mins) / %d engineers",
+			counts[ParamEFIBootVMs], c.efiBootMins,
+			counts[ParamSecureBootVMs], c.secureBootMins,
+			counts[ParamTPMVMs], c.tpmMins, engineerCount),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = (efi_boot_vms * efi_boot_mins + secure_boot_vms * secure_boot_mins + tpm_vms * tpm_mins) / remediation_engineers",
+			Inputs: []estimation.Quantity{
+				input(params, ParamEFIBootVMs, float64(counts[ParamEFIBootVMs]), estimation.UnitCount),
+				quantity("efi_boot_mins", c.efiBootMins, estimation.UnitMinutes),
+				input(params, ParamSecureBootVMs, float64(counts[ParamSecureBootVMs]), estimation.UnitCount),
+				quantity("secure_boot_mins", c.secureBootMins, estimation.UnitMinutes),
+				input(params, ParamTPMVMs, float64(counts[ParamTPMVMs]), estimation.UnitCount),
+				quantity("tpm_mins", c.tpmMins, estimation.UnitMinutes),
+				input(params, ParamRemediationEngineers, float64(engineerCount), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{quantity("effort_minutes", totalMins, estimation.UnitMinutes)},
+		},
+	}, nil
+}
@@ -0,0 +1,88 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestFirmwareRemediation_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewFirmwareRemediation()
+
+	params := map[string]estimation.Param{
+		ParamEFIBootVMs:    {Key: ParamEFIBootVMs, Value: 30},
+		ParamSecureBootVMs: {Key: ParamSecureBootVMs, Value: 20},
+		ParamTPMVMs:        {Key: ParamTPMVMs, Value: 4},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (30 * 10 + 20 * 30 + 4 * 45 mins) / 10 engineers = 108 mins
+	if result.Duration != 108*time.Minute {
+		t.Errorf("expected duration 108m, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "4 TPM VMs") {
+		t.Errorf("expected reason to mention TPM VMs, got %q", result.Reason)
+	}
+}
+
+func TestFirmwareRemediation_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewFirmwareRemediation(WithEFIBootMins(0), WithSecureBootMins(60), WithTPMMins(60), WithFirmwareEngineerCount(1))
+
+	params := map[string]estimation.Param{
+		ParamEFIBootVMs:           {Key: ParamEFIBootVMs, Value: 100},
+		ParamSecureBootVMs:        {Key: ParamSecureBootVMs, Value: 2},
+		ParamTPMVMs:               {Key: ParamTPMVMs, Value: 2},
+		ParamRemediationEngineers: {Key: ParamRemediationEngineers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (2 * 60 + 2 * 60 mins) / 2 engineers = 2 h
+	if result.Duration != 2*time.Hour {
+		t.Errorf("expected duration 2h, got %v", result.Duration)
+	}
+}
+
+func TestFirmwareRemediation_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "negative tpm_vms",
+			params: map[string]estimation.Param{
+				ParamTPMVMs: {Key: ParamTPMVMs, Value: -1},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamSecureBootVMs:        {Key: ParamSecureBootVMs, Value: 1},
+				ParamRemediationEngineers: {Key: ParamRemediationEngineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewFirmwareRemediation().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamStorageDriverChanges is the estimation.Param key for the number of VMs whose disks move to a bus needing another guest driver.
+	ParamStorageDriverChanges = "storage_driver_changes"
+	// ParamNetworkDriverChanges is the estimation.Param key for the number of VMs whose NICs move to a model needing another guest driver.
+	ParamNetworkDriverChanges = "network_driver_changes"
+
+	DefaultStorageDriverChangeMins = 30.0
+	DefaultNetworkDriverChangeMins = 20.0
+)
+
+// Compile-time assertion that GuestOSReconfiguration implements the Calculator interface.
+var _ estimation.Calculator = (*GuestOSReconfiguration)(nil)
+
+// GuestOSReconfiguration estimates the in-guest work after a driver change: checking the guest boots
+// from its new disk bus and reapplying the network configuration bound to the old adapter, e.g.
+// static IPs and interface names. A VM with both changes is counted once per change.
+type GuestOSReconfiguration struct {
+	storageDriverChangeMins float64
+	networkDriverChangeMins float64
+	engineerCount           int
+}
+
+// GuestOSReconfigurationOption is a functional option for configuring a GuestOSReconfiguration calculator.
+type GuestOSReconfigurationOption func(*GuestOSReconfiguration)
+
+// WithStorageDriverChangeMins sets the effort, in minutes, to fix up a guest whose disks changed bus.
+func WithStorageDriverChangeMins(mins float64) GuestOSReconfigurationOption {
+	return func(g *GuestOSReconfiguration) {
+		g.storageDriverChangeMins = mins
+	}
+}
+
+// WithNetworkDriverChangeMins sets the effort, in minutes, to reconfigure a guest whose NICs changed model.
+func WithNetworkDriverChangeMins(mins float64) GuestOSReconfigurationOption {
+	return func(g *GuestOSReconfiguration) {
+		g.networkDriverChangeMins = mins
+	}
+}
+
+// WithGuestEngineerCount sets the number of engineers reconfiguring guests in parallel.
+func WithGuestEngineerCount(count int) GuestOSReconfigurationOption {
+	return func(g *GuestOSReconfiguration) {
+		g.engineerCount = count
+	}
+}
+
+// NewGuestOSReconfiguration creates a GuestOSReconfiguration calculator with default settings that can be overridden by options.
+func NewGuestOSReconfiguration(opts ...GuestOSReconfigurationOption) *GuestOSReconfiguration {
+	res := GuestOSReconfiguration{
+		storageDriverChangeMins: DefaultStorageDriverChangeMins,
+		networkDriverChangeMins: DefaultNetworkDriverChangeMins,
+		engineerCount:           DefaultEngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("guest_os_reconfiguration", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []GuestOSReconfigurationOption
+		s.float("storage_driver_change_mins", func(v float64) { opts = append(opts, WithStorageDriverChangeMins(v)) })
+		s.float("network_driver_change_mins", func(v float64) { opts = append(opts, WithNetworkDriverChangeMins(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithGuestEngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewGuestOSReconfiguration(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *GuestOSReconfiguration) Name() string { return "Guest OS Reconfiguration" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// All counts are optional: a missing count means no VM has that driver change.
+func (c *GuestOSReconfiguration) Keys() []string {
+	return []string{}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *GuestOSReconfiguration) OptionalKeys() []string {
+	return []string{ParamEngineerHourlyRate, ParamNetworkDriverChanges, ParamPostMigrationEngineers, ParamStorageDriverChanges}
+}
+
+// Calculate estimates the reconfiguration effort as (storage changes * storage minutes + network changes *
+// network minutes) / engineers. post_migration_engineers overrides the engineer count, as the work is done
+// by the team checking the migrated VMs.
+func (c *GuestOSReconfiguration) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	counts := make(map[string]int, 2)
+	for _, key := range []string{ParamStorageDriverChanges, ParamNetworkDriverChanges} {
+		p, exists := params[key]
+		if !exists {
+			continue
+		}
+		count, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if count < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		counts[key] = count
+	}
+
+	engineerCount := c.engineerCount
+	if engParam, exists := params[ParamPostMigrationEngineers]; exists {
+		var err error
+		engineerCount, err = getInt(engParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	totalMins := float64(counts[ParamStorageDriverChanges])*c.storageDriverChangeMins +
+		float64(counts[ParamNetworkDriverChanges])*c.networkDriverChangeMins
+	realTimeMins := totalMins / float64(engineerCount)
+
+	duration, err := estimation.FromMinutes(realTimeMins)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, totalMins/60)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		Reason: fmt.Sprintf("(%d storage driver changes @ %.1f mins + %d network driver changes @ %.1f mins) / %d engineers",
+			counts[ParamStorageDriverChanges], c.storageDriverChangeMins,
+			counts[ParamNetworkDriverChanges], c.networkDriverChangeMins, engineerCount),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = (storage_driver_changes * storage_driver_change_mins + network_driver_changes * network_driver_change_mins) / post_migration_engineers",
+			Inputs: []estimation.Quantity{
+				input(params, ParamStorageDriverChanges, float64(counts[ParamStorageDriverChanges]), estimation.UnitCount),
+				quantity("storage_driver_change_mins", c.storageDriverChangeMins, estimation.UnitMinutes),
+				input(params, ParamNetworkDriverChanges, float64(counts[ParamNetworkDriverChanges]), estimation.UnitCount),
+				quantity("network_driver_change_mins", c.networkDriverChangeMins, estimation.UnitMinutes),
+				input(params, ParamPostMigrationEngineers, float64(engineerCount), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{quantity("effort_minutes", totalMins, estimation.UnitMinutes)},
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestGuestOSReconfiguration_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewGuestOSReconfiguration()
+
+	params := map[string]estimation.Param{
+		ParamStorageDriverChanges: {Key: ParamStorageDriverChanges, Value: 40},
+		ParamNetworkDriverChanges: {Key: ParamNetworkDriverChanges, Value: 60},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (40 * 30 + 60 * 20 mins) / 10 engineers = 4 h
+	if result.Duration != 4*time.Hour {
+		t.Errorf("expected duration 4h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "60 network driver changes") {
+		t.Errorf("expected reason to mention network driver changes, got %q", result.Reason)
+	}
+}
+
+func TestGuestOSReconfiguration_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewGuestOSReconfiguration(WithStorageDriverChangeMins(60), WithNetworkDriverChangeMins(0), WithGuestEngineerCount(1))
+
+	params := map[string]estimation.Param{
+		ParamStorageDriverChanges:   {Key: ParamStorageDriverChanges, Value: 4},
+		ParamNetworkDriverChanges:   {Key: ParamNetworkDriverChanges, Value: 100},
+		ParamPostMigrationEngineers: {Key: ParamPostMigrationEngineers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 4 * 60 mins / 2 engineers = 2 h
+	if result.Duration != 2*time.Hour {
+		t.Errorf("expected duration 2h, got %v", result.Duration)
+	}
+}
+
+func TestGuestOSReconfiguration_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "negative storage_driver_changes",
+			params: map[string]estimation.Param{
+				ParamStorageDriverChanges: {Key: ParamStorageDriverChanges, Value: -1},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamNetworkDriverChanges:   {Key: ParamNetworkDriverChanges, Value: 1},
+				ParamPostMigrationEngineers: {Key: ParamPostMigrationEngineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewGuestOSReconfiguration().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package calculators
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamOSBreakdown is the estimation.Param key for the number of VMs per OS family, e.g.
+	// {"windows": 40, "rhel": 25}.
+	ParamOSBreakdown = "os_breakdown"
+
+	OSFamilyWindows = "windows"
+	OSFamilyRHEL    = "rhel"
+	OSFamilySLES    = "sles"
+	OSFamilyDebian  = "debian"
+
+	DefaultWindowsRemediationMins = 45.0
+	DefaultRHELRemediationMins    = 20.0
+	DefaultSLESRemediationMins    = 25.0
+	DefaultDebianRemediationMins  = 25.0
+	DefaultOtherOSRemediationMins = 60.0
+)
+
+// Compile-time assertion that GuestOSRemediation implements the Calculator interface.
+var _ estimation.Calculator = (*GuestOSRemediation)(nil)
+
+// GuestOSRemediation estimates the in-guest remediation of the migrated VMs per OS family: removing
+// VMware Tools, installing the virtio drivers and cleaning up the fstab and udev rules bound to the
+// source devices. The effort differs per family, e.g. Windows needs the virtio drivers injected while
+// recent RHEL guests ship them. Families without minutes of their own take the minutes of other OSes.
+type GuestOSRemediation struct {
+	familyMins    map[string]float64
+	otherMins     float64
+	engineerCount int
+}
+
+// GuestOSRemediationOption is a functional option for configuring a GuestOSRemediation calculator.
+type GuestOSRemediationOption func(*GuestOSRemediation)
+
+// WithOSFamilyRemediationMins sets the effort, in minutes, to remediate a VM of an OS family.
+func WithOSFamilyRemediationMins(family string, mins float64) GuestOSRemediationOption {
+	return func(g *GuestOSRemediation) {
+		g.familyMins[strings.ToLower(family)] = mins
+	}
+}
+
+// WithOtherOSRemediationMins sets the effort, in minutes, to remediate a VM of a family without minutes of its own.
+func WithOtherOSRemediationMins(mins float64) GuestOSRemediationOption {
+	return func(g *GuestOSRemediation) {
+		g.otherMins = mins
+	}
+}
+
+// WithGuestRemediationEngineerCount sets the number of engineers remediating guests in parallel.
+func WithGuestRemediationEngineerCount(count int) GuestOSRemediationOption {
+	return func(g *GuestOSRemediation) {
+		g.engineerCount = count
+	}
+}
+
+// NewGuestOSRemediation creates a GuestOSRemediation calculator with default settings that can be overridden by options.
+func NewGuestOSRemediation(opts ...GuestOSRemediationOption) *GuestOSRemediation {
+	res := GuestOSRemediation{
+		familyMins: map[string]float64{
+			OSFamilyWindows: DefaultWindowsRemediationMins,
+			OSFamilyRHEL:    DefaultRHELRemediationMins,
+			OSFamilySLES:    DefaultSLESRemediationMins,
+			OSFamilyDebian:  DefaultDebianRemediationMins,
+		},
+		otherMins:     DefaultOtherOSRemediationMins,
+		engineerCount: DefaultEngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("guest_os_remediation", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []GuestOSRemediationOption
+		s.floats("os_family_mins", func(name string, v float64) { opts = append(opts, WithOSFamilyRemediationMins(name, v)) })
+		s.float("other_os_mins", func(v float64) { opts = append(opts, WithOtherOSRemediationMins(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithGuestRemediationEngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewGuestOSRemediation(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *GuestOSRemediation) Name() string { return "Guest OS Remediation" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// remediation_engineers is optional.
+func (c *GuestOSRemediation) Keys() []string {
+	return []string{ParamOSBreakdown}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *GuestOSRemediation) OptionalKeys() []string {
+	return []string{ParamEngineerHourlyRate, ParamRemediationEngineers}
+}
+
+// Calculate estimates the remediation effort as the sum of the VMs per OS family times the minutes per
+// family, divided by the engineers. remediation_engineers overrides the engineer count.
+func (c *GuestOSRemediation) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	p, ok := params[ParamOSBreakdown]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamOSBreakdown)
+	}
+	breakdown, err := getCounts(p)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	engineerCount := c.engineerCount
+	if engParam, exists := params[ParamRemediationEngineers]; exists {
+		engineerCount, err = getInt(engParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	families := make([]string, 0, len(breakdown))
+	for family := range breakdown {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+
+	var totalMins float64
+	terms := make([]string, 0, len(families))
+	inputs := make([]estimation.Quantity, 0, 2*len(families)+1)
+	var assumptions []string
+	for _, family := range families {
+		mins, ok := c.familyMins[family]
+		if !ok {
+			mins = c.otherMins
+			assumptions = append(assumptions, fmt.Sprintf("%s VMs take the minutes of other OS families", family))
+		}
+		totalMins += float64(breakdown[family]) * mins
+		terms = append(terms, fmt.Sprintf("%d %s VMs @ %.1f mins", breakdown[family], family, mins))
+		inputs = append(inputs,
+			quantity(ParamOSBreakdown+"."+family, float64(breakdown[family]), estimation.UnitCount),
+			quantity(family+"_mins", mins, estimation.UnitMinutes),
+		)
+	}
+	realTimeMins := totalMins / float64(engineerCount)
+
+	duration, err := estimation.FromMinutes(realTimeMins)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, totalMins/60)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		Reason:   fmt.Sprintf("(%s) / %d engineers", strings.Join(terms, " + "), engineerCount),
+		Explanation: &estimation.Explanation{
+			Formula:       "duration = sum over the OS families of (os_breakdown.<family> * <family>_mins) / remediation_engineers",
+			Inputs:        append(inputs, input(params, ParamRemediationEngineers, float64(engineerCount), estimation.UnitCount)),
+			Intermediates: []estimation.Quantity{quantity("effort_minutes", totalMins, estimation.UnitMinutes)},
+			Assumptions:   assumptions,
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestGuestOSRemediation_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewGuestOSRemediation()
+
+	params := map[string]estimation.Param{
+		ParamOSBreakdown: {Key: ParamOSBreakdown, Value: map[string]int{"windows": 10, "rhel": 20, "solaris": 1}},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (10 * 45 + 20 * 20 + 1 * 60) / 10 = 91 mins
+	if result.Duration != 91*time.Minute {
+		t.Errorf("expected duration 1h31m, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "1 solaris VMs @ 60.0 mins") {
+		t.Errorf("expected reason to price solaris as another OS, got %q", result.Reason)
+	}
+}
+
+func TestGuestOSRemediation_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewGuestOSRemediation(
+		WithOSFamilyRemediationMins("Windows", 60),
+		WithOtherOSRemediationMins(30),
+		WithGuestRemediationEngineerCount(5),
+	)
+
+	// JSON decodes the breakdown as a map of float64 values
+	params := map[string]estimation.Param{
+		ParamOSBreakdown:          {Key: ParamOSBreakdown, Value: map[string]interface{}{"WINDOWS": 4.0, "freebsd": 2.0}},
+		ParamRemediationEngineers: {Key: ParamRemediationEngineers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (4 * 60 + 2 * 30) / 2 = 150 mins
+	if result.Duration != 150*time.Minute {
+		t.Errorf("expected duration 2h30m, got %v", result.Duration)
+	}
+}
+
+func TestGuestOSRemediation_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name:   "missing os_breakdown",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "os_breakdown not a map",
+			params: map[string]estimation.Param{
+				ParamOSBreakdown: {Key: ParamOSBreakdown, Value: 12},
+			},
+		},
+		{
+			name: "non-numeric count",
+			params: map[string]estimation.Param{
+				ParamOSBreakdown: {Key: ParamOSBreakdown, Value: map[string]interface{}{"rhel": "ten"}},
+			},
+		},
+		{
+			name: "negative count",
+			params: map[string]estimation.Param{
+				ParamOSBreakdown: {Key: ParamOSBreakdown, Value: map[string]float64{"rhel": -1}},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamOSBreakdown:          {Key: ParamOSBreakdown, Value: map[string]int{"rhel": 1}},
+				ParamRemediationEngineers: {Key: ParamRemediationEngineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewGuestOSRemediation().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package calculators
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamControlPlaneNodeCount is the estimation.Param key for the number of control plane nodes of the target
+	// cluster, as sized by the sizer.
+	ParamControlPlaneNodeCount = "control_plane_node_count"
+	// ParamWorkerNodeCount is the estimation.Param key for the number of worker nodes of the target cluster,
+	// failover nodes included, as sized by the sizer.
+	ParamWorkerNodeCount = "worker_node_count"
+	// ParamControlPlaneSKU is the estimation.Param key for the hardware SKU the control plane nodes are ordered as.
+	ParamControlPlaneSKU = "control_plane_sku"
+	// ParamWorkerSKU is the estimation.Param key for the hardware SKU the worker nodes are ordered as.
+	ParamWorkerSKU = "worker_sku"
+	// ParamInstallDaysPerNode is the estimation.Param key for the working days to rack, stack and cable a node.
+	ParamInstallDaysPerNode = "install_days_per_node"
+	// ParamInstallCrews is the estimation.Param key for the number of crews racking nodes in parallel.
+	ParamInstallCrews = "install_crews"
+
+	DefaultProcurementLeadDays = 60
+	DefaultInstallDaysPerNode  = 0.5
+	DefaultInstallCrews        = 1
+)
+
+// Compile-time assertion that HardwareProcurement implements the Calculator interface.
+var _ estimation.Calculator = (*HardwareProcurement)(nil)
+
+// HardwareProcurement estimates the build-out of the target hardware from the node counts of the sizer:
+// ordering the nodes and racking, stacking and cabling them once delivered.
+// The delivery is modeled as calendar lead time: Duration is the rack and stack effort while LeadTime
+// is the calendar time from the order to the last node installed. The orders of the SKUs are placed
+// together, so the slowest one drives the delivery.
+type HardwareProcurement struct {
+	skuLeadDays        map[string]int
+	defaultLeadDays    int
+	installDaysPerNode float64
+	installCrews       int
+}
+
+// HardwareProcurementOption is a functional option for configuring a HardwareProcurement calculator.
+type HardwareProcurementOption func(*HardwareProcurement)
+
+// WithSKULeadDays sets the calendar days from the order to the delivery of a hardware SKU.
+func WithSKULeadDays(sku string, days int) HardwareProcurementOption {
+	return func(h *HardwareProcurement) {
+		h.skuLeadDays[sku] = days
+	}
+}
+
+// WithDefaultProcurementLeadDays sets the delivery lead time of the SKUs without their own, and of the
+// nodes ordered without a SKU.
+func WithDefaultProcurementLeadDays(days int) HardwareProcurementOption {
+	return func(h *HardwareProcurement) {
+		h.defaultLeadDays = days
+	}
+}
+
+// WithInstallDaysPerNode sets the working days to rack, stack and cable a node. Non-positive values are ignored.
+func WithInstallDaysPerNode(days float64) HardwareProcurementOption {
+	return func(h *HardwareProcurement) {
+		if days > 0 {
+			h.installDaysPerNode = days
+		}
+	}
+}
+
+// WithInstallCrews sets the number of crews racking nodes in parallel.
+func WithInstallCrews(count int) HardwareProcurementOption {
+	return func(h *HardwareProcurement) {
+		h.installCrews = count
+	}
+}
+
+// NewHardwareProcurement creates a HardwareProcurement calculator with default settings that can be overridden by options.
+func NewHardwareProcurement(opts ...HardwareProcurementOption) *HardwareProcurement {
+	res := HardwareProcurement{
+		skuLeadDays:        make(map[string]int),
+		defaultLeadDays:    DefaultProcurementLeadDays,
+		installDaysPerNode: DefaultInstallDaysPerNode,
+		installCrews:       DefaultInstallCrews,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("hardware_procurement", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []HardwareProcurementOption
+		s.floats("sku_lead_days", func(name string, v float64) { opts = append(opts, WithSKULeadDays(name, int(v))) })
+		s.int("default_lead_days", func(v int) { opts = append(opts, WithDefaultProcurementLeadDays(v)) })
+		s.float("install_days_per_node", func(v float64) { opts = append(opts, WithInstallDaysPerNode(v)) })
+		s.int("install_crews", func(v int) { opts = append(opts, WithInstallCrews(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewHardwareProcurement(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *HardwareProcurement) Name() string { return "Hardware Procurement" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// control_plane_node_count, control_plane_sku, worker_sku, install_days_per_node and install_crews are optional.
+func (c *HardwareProcurement) Keys() []string {
+	return []string{ParamWorkerNodeCount}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *HardwareProcurement) OptionalKeys() []string {
+	return []string{ParamControlPlaneNodeCount, ParamControlPlaneSKU, ParamInstallCrews, ParamInstallDaysPerNode, ParamWorkerSKU}
+}
+
+// Calculate estimates the rack and stack effort as nodes * install days per node / crews working days,
+// and returns the delivery of the slowest SKU ordered followed by the rack and stack as LeadTime.
+func (c *HardwareProcurement) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	workerParam, ok := params[ParamWorkerNodeCount]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamWorkerNodeCount)
+	}
+	workers, err := getInt(workerParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if workers < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamWorkerNodeCount)
+	}
+
+	controlPlanes := 0
+	if p, exists := params[ParamControlPlaneNodeCount]; exists {
+		if controlPlanes, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+		if controlPlanes < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamControlPlaneNodeCount)
+		}
+	}
+
+	leadDays := 0
+	for _, order := range []struct {
+		key   string
+		nodes int
+	}{{ParamControlPlaneSKU, controlPlanes}, {ParamWorkerSKU, workers}} {
+		if order.nodes == 0 {
+			continue
+		}
+		days, err := c.leadDays(params, order.key)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		leadDays = max(leadDays, days)
+	}
+	if leadDays < 0 {
+		return estimation.Estimation{}, fmt.Errorf("procurement lead days must be non-negative")
+	}
+
+	installDays := c.installDaysPerNode
+	if p, exists := params[ParamInstallDaysPerNode]; exists {
+		if installDays, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if installDays < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamInstallDaysPerNode)
+	}
+
+	crews := c.installCrews
+	if p, exists := params[ParamInstallCrews]; exists {
+		if crews, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if crews <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be > 0", ParamInstallCrews)
+	}
+
+	nodes := controlPlanes + workers
+	// A crew racks its share of the nodes one after the other
+	workingDays := math.Ceil(float64(nodes)/float64(crews)) * installDays
+	duration, err := estimation.FromHours(workingDays * DefaultWorkHoursPerDay)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	// A working day of the crews is a calendar day
+	leadTime, err := estimation.FromDays(float64(leadDays) + workingDays)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		LeadTime: leadTime,
+		Reason: fmt.Sprintf("%d control plane + %d worker nodes delivered in %d calendar days, racked at %.1f days per node by %d crews (%.1f working days)",
+			controlPlanes, workers, leadDays, installDays, crews, workingDays),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = working_days * work_hours_per_day, working_days = ceil((control_plane_node_count + worker_node_count) / install_crews) * install_days_per_node; " +
+				"lead_time = lead_days + calendar(duration)",
+			Inputs: []estimation.Quantity{
+				input(params, ParamControlPlaneNodeCount, float64(controlPlanes), estimation.UnitCount),
+				input(params, ParamWorkerNodeCount, float64(workers), estimation.UnitCount),
+				input(params, ParamInstallDaysPerNode, installDays, estimation.UnitDays),
+				input(params, ParamInstallCrews, float64(crews), estimation.UnitCount),
+				quantity("work_hours_per_day", DefaultWorkHoursPerDay, estimation.UnitHoursPerDay),
+			},
+			Intermediates: []estimation.Quantity{
+				quantity("lead_days", float64(leadDays), estimation.UnitDays),
+				quantity("working_days", workingDays, estimation.UnitDays),
+			},
+			Assumptions: []string{
+				"lead_days is the delivery of the slowest SKU ordered",
+				"a crew racks its share of the nodes one after the other",
+			},
+		},
+	}, nil
+}
+
+// leadDays returns the delivery lead time of the SKU given by the param key, the default one when the
+// SKU is not given or has no lead time of its own.
+func (c *HardwareProcurement) leadDays(params map[string]estimation.Param, key string) (int, error) {
+	p, exists := params[key]
+	if !exists {
+		return c.defaultLeadDays, nil
+	}
+	sku, ok := p.Value.(string)
+	if !ok {
+		return 0, fmt.Errorf("param %s is not a string (type: %T)", p.Key, p.Value)
+	}
+	if days, ok := c.skuLeadDays[sku]; ok {
+		return days, nil
+	}
+	return c.defaultLeadDays, nil
+}
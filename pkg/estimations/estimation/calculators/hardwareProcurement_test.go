@@ -0,0 +1,141 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestHardwareProcurement_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewHardwareProcurement()
+
+	params := map[string]estimation.Param{
+		ParamControlPlaneNodeCount: {Key: ParamControlPlaneNodeCount, Value: 3},
+		ParamWorkerNodeCount:       {Key: ParamWorkerNodeCount, Value: 9},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 12 nodes * 0.5 day / 1 crew = 6 working days of 8 h
+	if result.Duration != 48*time.Hour {
+		t.Errorf("expected duration 48h, got %v", result.Duration)
+	}
+	// 60 calendar days of delivery, then 6 days of racking
+	if result.LeadTime != 66*24*time.Hour {
+		t.Errorf("expected lead time of 66 days, got %v", result.LeadTime)
+	}
+	if got := result.Elapsed(DefaultWorkHoursPerDay); got != result.LeadTime {
+		t.Errorf("expected elapsed time to be driven by the lead time, got %v", got)
+	}
+	if !strings.Contains(result.Reason, "60 calendar days") {
+		t.Errorf("expected reason to mention the delivery, got %q", result.Reason)
+	}
+}
+
+func TestHardwareProcurement_Calculate_SlowestSKU(t *testing.T) {
+	t.Parallel()
+	calc := NewHardwareProcurement(
+		WithSKULeadDays("r760", 45),
+		WithSKULeadDays("r660", 90),
+		WithDefaultProcurementLeadDays(30),
+		WithInstallDaysPerNode(1),
+		WithInstallCrews(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamControlPlaneNodeCount: {Key: ParamControlPlaneNodeCount, Value: 3},
+		ParamControlPlaneSKU:       {Key: ParamControlPlaneSKU, Value: "r660"},
+		ParamWorkerNodeCount:       {Key: ParamWorkerNodeCount, Value: 10},
+		ParamWorkerSKU:             {Key: ParamWorkerSKU, Value: "r760"},
+		ParamInstallCrews:          {Key: ParamInstallCrews, Value: 4},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 13 nodes over 4 crews is 4 nodes per crew at 1 day each
+	if result.Duration != 32*time.Hour {
+		t.Errorf("expected duration 32h, got %v", result.Duration)
+	}
+	// the control plane SKU is the slowest to deliver
+	if result.LeadTime != 94*24*time.Hour {
+		t.Errorf("expected lead time of 94 days, got %v", result.LeadTime)
+	}
+
+	// a SKU without nodes to order does not hold the delivery
+	params[ParamControlPlaneNodeCount] = estimation.Param{Key: ParamControlPlaneNodeCount, Value: 0}
+	params[ParamWorkerSKU] = estimation.Param{Key: ParamWorkerSKU, Value: "unknown"}
+	result, err = calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.LeadTime != 33*24*time.Hour {
+		t.Errorf("expected lead time of the default 30 days plus 3 days of racking, got %v", result.LeadTime)
+	}
+}
+
+func TestHardwareProcurement_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name:   "missing worker_node_count param",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "negative worker_node_count",
+			params: map[string]estimation.Param{
+				ParamWorkerNodeCount: {Key: ParamWorkerNodeCount, Value: -1},
+			},
+		},
+		{
+			name: "negative control_plane_node_count",
+			params: map[string]estimation.Param{
+				ParamWorkerNodeCount:       {Key: ParamWorkerNodeCount, Value: 3},
+				ParamControlPlaneNodeCount: {Key: ParamControlPlaneNodeCount, Value: -3},
+			},
+		},
+		{
+			name: "sku is not a string",
+			params: map[string]estimation.Param{
+				ParamWorkerNodeCount: {Key: ParamWorkerNodeCount, Value: 3},
+				ParamWorkerSKU:       {Key: ParamWorkerSKU, Value: 760},
+			},
+		},
+		{
+			name: "negative install_days_per_node",
+			params: map[string]estimation.Param{
+				ParamWorkerNodeCount:    {Key: ParamWorkerNodeCount, Value: 3},
+				ParamInstallDaysPerNode: {Key: ParamInstallDaysPerNode, Value: -1},
+			},
+		},
+		{
+			name: "zero crews",
+			params: map[string]estimation.Param{
+				ParamWorkerNodeCount: {Key: ParamWorkerNodeCount, Value: 3},
+				ParamInstallCrews:    {Key: ParamInstallCrews, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewHardwareProcurement().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
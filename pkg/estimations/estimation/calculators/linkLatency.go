@@ -0,0 +1,98 @@
+package calculators
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamRTTMs is the estimation.Param key for the round-trip time of the link to the target in milliseconds.
+	// Without it, the raw transfer rate is trusted.
+	ParamRTTMs = "rtt_ms"
+	// ParamTCPWindowKB is the estimation.Param key for the TCP window size of each stream in kilobytes.
+	ParamTCPWindowKB = "tcp_window_kb"
+	// ParamParallelStreams is the estimation.Param key for the number of TCP streams transferring at once.
+	ParamParallelStreams = "parallel_streams"
+	// DefaultTCPWindowKB is the default TCP window size, the maximum receive buffer of a Linux host
+	// with window scaling.
+	DefaultTCPWindowKB = 4096.0
+	// DefaultParallelStreams is the default number of TCP streams transferring at once.
+	DefaultParallelStreams = 1
+)
+
+// LinkModel is the bandwidth-delay-product model of a long-haul link: a TCP stream cannot have
+// more than a window of data in flight per round trip, whatever the link speed.
+type LinkModel struct {
+	RTTMs    float64
+	WindowKB float64
+	Streams  int
+}
+
+// StreamThroughputMbps returns the throughput of a single stream, window / RTT. It is infinite
+// without latency.
+func (m LinkModel) StreamThroughputMbps() float64 {
+	if m.RTTMs <= 0 {
+		return math.Inf(1)
+	}
+	return m.WindowKB * 1024 * 8 / (m.RTTMs / 1000) / 1e6
+}
+
+// Cap returns the throughput achievable on a link of the raw rate, the lower of the raw rate and
+// the throughput of the parallel streams.
+func (m LinkModel) Cap(rawMbps float64) float64 {
+	return math.Min(rawMbps, m.StreamThroughputMbps()*float64(max(m.Streams, 1)))
+}
+
+// WithLinkModel sets the round-trip time, window size and parallel streams capping the transfer rate.
+// Non-positive values are ignored and the defaults are kept.
+func WithLinkModel(rttMs, windowKB float64, streams int) StorageMigrationOption {
+	return func(s *StorageMigration) {
+		if rttMs > 0 {
+			s.link.RTTMs = rttMs
+		}
+		if windowKB > 0 {
+			s.link.WindowKB = windowKB
+		}
+		if streams > 0 {
+			s.link.Streams = streams
+		}
+	}
+}
+
+// linkModelParam returns the link model of the params, falling back to the fields of the default.
+func linkModelParam(params map[string]estimation.Param, def LinkModel) (LinkModel, error) {
+	m := def
+	if p, ok := params[ParamRTTMs]; ok {
+		rtt, err := getFloat(p)
+		if err != nil {
+			return LinkModel{}, err
+		}
+		if rtt < 0 {
+			return LinkModel{}, fmt.Errorf("%s must be non-negative", ParamRTTMs)
+		}
+		m.RTTMs = rtt
+	}
+	if p, ok := params[ParamTCPWindowKB]; ok {
+		window, err := getFloat(p)
+		if err != nil {
+			return LinkModel{}, err
+		}
+		if window <= 0 {
+			return LinkModel{}, fmt.Errorf("%s must be positive", ParamTCPWindowKB)
+		}
+		m.WindowKB = window
+	}
+	if p, ok := params[ParamParallelStreams]; ok {
+		streams, err := getInt(p)
+		if err != nil {
+			return LinkModel{}, err
+		}
+		if streams <= 0 {
+			return LinkModel{}, fmt.Errorf("%s must be positive", ParamParallelStreams)
+		}
+		m.Streams = streams
+	}
+	return m, nil
+}
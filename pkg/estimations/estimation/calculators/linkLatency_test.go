@@ -0,0 +1,84 @@
+package calculators
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestLinkModel_Cap(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		model    LinkModel
+		rawMbps  float64
+		expected float64
+	}{
+		{name: "no latency", model: LinkModel{WindowKB: 64, Streams: 1}, rawMbps: 1000, expected: 1000},
+		// 1024 KB * 8 / 100 ms = 83.89 Mbps per stream
+		{name: "latency limited", model: LinkModel{RTTMs: 100, WindowKB: 1024, Streams: 1}, rawMbps: 1000, expected: 83.88608},
+		{name: "parallel streams", model: LinkModel{RTTMs: 100, WindowKB: 1024, Streams: 4}, rawMbps: 1000, expected: 335.54432},
+		{name: "link limited", model: LinkModel{RTTMs: 1, WindowKB: 1024, Streams: 1}, rawMbps: 1000, expected: 1000},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.model.Cap(tc.rawMbps); math.Abs(got-tc.expected) > 1e-6 {
+				t.Errorf("expected %v Mbps for case %q, got %v", tc.expected, tc.name, got)
+			}
+		})
+	}
+}
+
+func TestStorageMigration_Calculate_WithLinkLatency(t *testing.T) {
+	t.Parallel()
+	calc := NewStorageMigration(WithTransferRateMbps(10000), WithLinkModel(100, 1024, 0))
+
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB:     {Key: ParamTotalDiskGB, Value: 1000.0},
+		ParamParallelStreams: {Key: ParamParallelStreams, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	cappedMbps := 2 * 1024.0 * 1024 * 8 / 0.1 / 1e6
+	expectedMins := (1000.0 * 1024.0) / (cappedMbps / 8) / 60.0
+	expectedDuration := time.Duration(expectedMins * float64(time.Minute))
+	if result.Duration != expectedDuration {
+		t.Errorf("expected duration %v, got %v", expectedDuration, result.Duration)
+	}
+	if !strings.Contains(result.Reason, "capped from 10000 Mbps by 100 ms RTT with 2 stream(s) of 1024 KB window") {
+		t.Errorf("expected the cap in the reason, got %q", result.Reason)
+	}
+}
+
+func TestStorageMigration_Calculate_LinkLatencyErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{name: "negative rtt", params: map[string]estimation.Param{ParamRTTMs: {Key: ParamRTTMs, Value: -1.0}}},
+		{name: "zero window", params: map[string]estimation.Param{ParamTCPWindowKB: {Key: ParamTCPWindowKB, Value: 0.0}}},
+		{name: "zero streams", params: map[string]estimation.Param{ParamParallelStreams: {Key: ParamParallelStreams, Value: 0}}},
+		{name: "invalid rtt type", params: map[string]estimation.Param{ParamRTTMs: {Key: ParamRTTMs, Value: "far"}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.params[ParamTotalDiskGB] = estimation.Param{Key: ParamTotalDiskGB, Value: 100.0}
+			_, err := NewStorageMigration().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
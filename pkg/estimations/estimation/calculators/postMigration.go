@@ -3,7 +3,6 @@ package calculators
 import (
 	"fmt"
 	"math"
-	"time"
 
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
 )
@@ -79,6 +78,20 @@ func NewPostMigrationTroubleShooting(opts ...PostMigrationTroubleshootingOption)
 	return &res
 }
 
+func init() {
+	DefaultRegistry.Register("post_migration_troubleshooting", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []PostMigrationTroubleshootingOption
+		s.float("troubleshoot_mins_per_vm", func(v float64) { opts = append(opts, WithTroubleshootMinsPerVM(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithEngineerCount(v)) })
+		s.float("work_hours_per_day", func(v float64) { opts = append(opts, WithWorkHoursPerDay(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewPostMigrationTroubleShooting(opts...), nil
+	})
+}
+
 // Name returns the human-readable name of this calculator.
 func (c *PostMigrationTroubleShooting) Name() string { return "Post-Migration Checks" }
 
@@ -87,6 +100,11 @@ func (c *PostMigrationTroubleShooting) Keys() []string {
 	return []string{ParamVMCount}
 }
 
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *PostMigrationTroubleShooting) OptionalKeys() []string {
+	return []string{ParamEngineerHourlyRate, ParamPostMigrationEngineers, ParamTroubleshootMinsPerVM, ParamWorkHoursPerDay}
+}
+
 // Calculate estimates the post-migration troubleshooting duration based on VM count and engineer availability.
 // ParamTroubleshootMinsPerVM, ParamPostMigrationEngineers, and ParamWorkHoursPerDay are optional and fall back to the struct defaults.
 func (c *PostMigrationTroubleShooting) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
@@ -129,15 +147,10 @@ func (c *PostMigrationTroubleShooting) Calculate(params map[string]estimation.Pa
 	}
 
 	// Extract work hours per day (optional - falls back to struct field/default)
-	workHoursPerDay := c.workHoursPerDay
-	if hoursParam, exists := params[ParamWorkHoursPerDay]; exists {
-		paramHours, err := getFloat(hoursParam)
-		if err != nil {
-			return estimation.Estimation{}, err
-		}
-		if paramHours > 0 {
-			workHoursPerDay = paramHours
-		}
+	var notes []estimation.Annotation
+	workHoursPerDay, err := positiveParam(params, ParamWorkHoursPerDay, c.workHoursPerDay, &notes)
+	if err != nil {
+		return estimation.Estimation{}, err
 	}
 
 	// Calculate total man-minutes and divide by engineers
@@ -147,9 +160,34 @@ func (c *PostMigrationTroubleShooting) Calculate(params map[string]estimation.Pa
 	// Derive work-day count for the reason string (display only, does not affect duration)
 	workDays := int(math.Ceil(realTimeMins / (workHoursPerDay * 60)))
 
+	duration, err := estimation.FromMinutes(realTimeMins)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, totalManMins/60)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
 	return estimation.Estimation{
-		Duration: time.Duration(realTimeMins * float64(time.Minute)),
+		Duration: duration,
+		Cost:     cost,
 		Reason: fmt.Sprintf("%d VMs @ %.1f mins each / %d engineers working %.0f h/day for a total of %d work days",
 			vmCount, minsPerVM, engineerCount, workHoursPerDay, workDays),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = vm_count * troubleshoot_mins_per_vm / post_migration_engineers",
+			Inputs: []estimation.Quantity{
+				input(params, ParamVMCount, float64(vmCount), estimation.UnitCount),
+				input(params, ParamTroubleshootMinsPerVM, minsPerVM, estimation.UnitMinutes),
+				input(params, ParamPostMigrationEngineers, float64(engineerCount), estimation.UnitCount),
+				input(params, ParamWorkHoursPerDay, workHoursPerDay, estimation.UnitHoursPerDay),
+			},
+			Intermediates: []estimation.Quantity{
+				quantity("effort_minutes", totalManMins, estimation.UnitMinutes),
+				quantity("work_days", float64(workDays), estimation.UnitDays),
+			},
+		},
+		Annotations: notes,
 	}, nil
 }
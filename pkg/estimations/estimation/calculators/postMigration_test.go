@@ -1,15 +1,26 @@
 package calculators
 
 import (
-	"fmt"
 	"math"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
 )
 
+// workDays returns the work days of the explanation of the estimation.
+func workDays(t *testing.T, result estimation.Estimation) float64 {
+	t.Helper()
+	if result.Explanation == nil {
+		t.Fatal("expected an explanation")
+	}
+	days, ok := result.Explanation.Intermediate("work_days")
+	if !ok {
+		t.Fatalf("expected work days in the explanation, got %+v", result.Explanation.Intermediates)
+	}
+	return days.Value
+}
+
 func TestPostMigrationTroubleShooting_NameAndKeys(t *testing.T) {
 	t.Parallel()
 	calc := NewPostMigrationTroubleShooting()
@@ -54,10 +65,11 @@ func TestPostMigrationTroubleShooting_Calculate_WithDefaults(t *testing.T) {
 	}
 
 	// 60 mins / (8 h/day * 60) = 0.125 → ceil = 1 work day
-	expectedWorkDays := 1
-	expectedWorkDayStr := fmt.Sprintf("%d work days", expectedWorkDays)
-	if !strings.Contains(result.Reason, expectedWorkDayStr) {
-		t.Errorf("expected reason to contain %q, got: %q", expectedWorkDayStr, result.Reason)
+	if got := workDays(t, result); got != 1 {
+		t.Errorf("expected 1 work day, got %v", got)
+	}
+	if engineers, ok := result.Explanation.Input(ParamPostMigrationEngineers); !ok || engineers.Value != DefaultEngineerCount || !engineers.Default {
+		t.Errorf("expected the default engineer count as input, got %+v", engineers)
 	}
 }
 
@@ -110,9 +122,8 @@ func TestPostMigrationTroubleShooting_Calculate_WorkDaysCustomHours(t *testing.T
 		t.Errorf("expected duration %v, got %v", expectedDuration, result.Duration)
 	}
 
-	expectedWorkDayStr := "3 work days"
-	if !strings.Contains(result.Reason, expectedWorkDayStr) {
-		t.Errorf("expected reason to contain %q, got: %q", expectedWorkDayStr, result.Reason)
+	if got := workDays(t, result); got != 3 {
+		t.Errorf("expected 3 work days, got %v", got)
 	}
 }
 
@@ -132,10 +143,9 @@ func TestPostMigrationTroubleShooting_Calculate_WorkDaysBoundaryExact(t *testing
 	}
 
 	realTimeMins := 480.0
-	expectedWorkDays := int(math.Ceil(realTimeMins / (DefaultWorkHoursPerDay * 60)))
-	expectedWorkDayStr := fmt.Sprintf("%d work days", expectedWorkDays)
-	if !strings.Contains(result.Reason, expectedWorkDayStr) {
-		t.Errorf("expected reason to contain %q, got: %q", expectedWorkDayStr, result.Reason)
+	expectedWorkDays := math.Ceil(realTimeMins / (DefaultWorkHoursPerDay * 60))
+	if got := workDays(t, result); got != expectedWorkDays {
+		t.Errorf("expected %v work days, got %v", expectedWorkDays, got)
 	}
 }
 
@@ -154,8 +164,8 @@ func TestPostMigrationTroubleShooting_Calculate_WorkDaysSubDay(t *testing.T) {
 		t.Fatalf("expected no error, got: %v", err)
 	}
 
-	if !strings.Contains(result.Reason, "1 work days") {
-		t.Errorf("expected reason to contain \"1 work days\", got: %q", result.Reason)
+	if got := workDays(t, result); got != 1 {
+		t.Errorf("expected 1 work day, got %v", got)
 	}
 }
 
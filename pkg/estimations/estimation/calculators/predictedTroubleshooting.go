@@ -0,0 +1,200 @@
+package calculators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamVMFeatures features of the VMs to troubleshoot, as []VMFeatures
+	ParamVMFeatures = "vm_features"
+	// ParamTroubleshootingPredictor trained model predicting the troubleshooting time of a VM, as a TroubleshootingPredictor
+	ParamTroubleshootingPredictor = "troubleshooting_predictor"
+)
+
+// VMFeatures describes a VM to predict its troubleshooting time from.
+type VMFeatures struct {
+	OS           string
+	DiskCount    int
+	AppTags      []string
+	HasSnapshots bool
+}
+
+// TroubleshootingPredictor predicts the troubleshooting time of a VM after its migration, e.g. a
+// model trained on the troubleshooting time recorded for past migrations.
+type TroubleshootingPredictor interface {
+	// Name identifies the model in the reason of the estimations.
+	Name() string
+	PredictMinutes(vm VMFeatures) (float64, error)
+}
+
+// LinearTroubleshootingModel predicts the troubleshooting time of a VM as a sum of minutes: a base,
+// minutes per disk, minutes for snapshots and minutes per operating system and application tag.
+// Operating systems and tags without coefficient add nothing.
+type LinearTroubleshootingModel struct {
+	ModelName       string             `json:"name"`
+	Intercept       float64            `json:"intercept"`
+	MinutesPerDisk  float64            `json:"minutesPerDisk"`
+	SnapshotMinutes float64            `json:"snapshotMinutes"`
+	OSMinutes       map[string]float64 `json:"osMinutes,omitempty"`
+	AppTagMinutes   map[string]float64 `json:"appTagMinutes,omitempty"`
+}
+
+// Compile-time assertion that LinearTroubleshootingModel implements the TroubleshootingPredictor interface.
+var _ TroubleshootingPredictor = LinearTroubleshootingModel{}
+
+// Name returns the name of the model.
+func (m LinearTroubleshootingModel) Name() string { return m.ModelName }
+
+// PredictMinutes predicts the troubleshooting time of the VM, never negative.
+func (m LinearTroubleshootingModel) PredictMinutes(vm VMFeatures) (float64, error) {
+	mins := m.Intercept + m.MinutesPerDisk*float64(vm.DiskCount) + m.OSMinutes[vm.OS]
+	if vm.HasSnapshots {
+		mins += m.SnapshotMinutes
+	}
+	for _, tag := range vm.AppTags {
+		mins += m.AppTagMinutes[tag]
+	}
+	return math.Max(mins, 0), nil
+}
+
+// Validate checks the coefficients of the model are finite numbers.
+func (m LinearTroubleshootingModel) Validate() error {
+	if m.ModelName == "" {
+		return errors.New("model name is required")
+	}
+	coefficients := []float64{m.Intercept, m.MinutesPerDisk, m.SnapshotMinutes}
+	for _, c := range m.OSMinutes {
+		coefficients = append(coefficients, c)
+	}
+	for _, c := range m.AppTagMinutes {
+		coefficients = append(coefficients, c)
+	}
+	for _, c := range coefficients {
+		if math.IsNaN(c) || math.IsInf(c, 0) {
+			return fmt.Errorf("coefficient %v is not a finite number", c)
+		}
+	}
+	return nil
+}
+
+// Compile-time assertion that PredictedTroubleshooting implements the Calculator interface.
+var _ estimation.Calculator = (*PredictedTroubleshooting)(nil)
+
+// PredictedTroubleshooting estimates the post-migration troubleshooting time from the time a model
+// predicts for each VM. Without model or VM features, it falls back to the formula of
+// PostMigrationTroubleShooting, whose engineers and work hours it shares.
+type PredictedTroubleshooting struct {
+	predictor TroubleshootingPredictor
+	fallback  *PostMigrationTroubleShooting
+}
+
+// NewPredictedTroubleshooting creates a PredictedTroubleshooting calculator falling back to the
+// formula-based calculator. The predictor may be nil when models are given by ParamTroubleshootingPredictor.
+func NewPredictedTroubleshooting(predictor TroubleshootingPredictor, fallback *PostMigrationTroubleShooting) *PredictedTroubleshooting {
+	return &PredictedTroubleshooting{predictor: predictor, fallback: fallback}
+}
+
+// Name returns the name of the fallback, as both estimate the same phase.
+func (c *PredictedTroubleshooting) Name() string { return c.fallback.Name() }
+
+// Keys returns the list of parameter keys required by this calculator.
+func (c *PredictedTroubleshooting) Keys() []string {
+	return c.fallback.Keys()
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *PredictedTroubleshooting) OptionalKeys() []string {
+	return append([]string{ParamTroubleshootingPredictor, ParamVMFeatures}, c.fallback.OptionalKeys()...)
+}
+
+// Calculate predicts the troubleshooting time of each VM of ParamVMFeatures with the model of
+// ParamTroubleshootingPredictor, or the model of the calculator, and divides the total among the
+// engineers. ParamPostMigrationEngineers and ParamWorkHoursPerDay are optional as for the fallback.
+func (c *PredictedTroubleshooting) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	predictor := c.predictor
+	if p, ok := params[ParamTroubleshootingPredictor]; ok {
+		model, ok := p.Value.(TroubleshootingPredictor)
+		if !ok {
+			return estimation.Estimation{}, fmt.Errorf("param %s is not a troubleshooting predictor (type: %T)", p.Key, p.Value)
+		}
+		predictor = model
+	}
+	featuresParam, ok := params[ParamVMFeatures]
+	if predictor == nil || !ok {
+		return c.fallback.Calculate(params)
+	}
+	features, ok := featuresParam.Value.([]VMFeatures)
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("param %s is not a list of VM features (type: %T)", featuresParam.Key, featuresParam.Value)
+	}
+
+	engineerCount := c.fallback.engineerCount
+	if engParam, exists := params[ParamPostMigrationEngineers]; exists {
+		paramEngineers, err := getInt(engParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		engineerCount = paramEngineers
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	var notes []estimation.Annotation
+	workHoursPerDay, err := positiveParam(params, ParamWorkHoursPerDay, c.fallback.workHoursPerDay, &notes)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	var totalManMins float64
+	for _, vm := range features {
+		mins, err := predictor.PredictMinutes(vm)
+		if err != nil {
+			return estimation.Estimation{}, fmt.Errorf("model %s failed to predict: %w", predictor.Name(), err)
+		}
+		totalManMins += mins
+	}
+	realTimeMins := totalManMins / float64(engineerCount)
+
+	var avgMins float64
+	if len(features) > 0 {
+		avgMins = totalManMins / float64(len(features))
+	}
+	workDays := int(math.Ceil(realTimeMins / (workHoursPerDay * 60)))
+
+	duration, err := estimation.FromMinutes(realTimeMins)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, totalManMins/60)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		Reason: fmt.Sprintf("%d VMs @ %.1f mins each as predicted by model %s / %d engineers working %.0f h/day for a total of %d work days",
+			len(features), avgMins, predictor.Name(), engineerCount, workHoursPerDay, workDays),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = sum over the VMs of predicted_mins / post_migration_engineers",
+			Inputs: []estimation.Quantity{
+				quantity("vm_count", float64(len(features)), estimation.UnitCount),
+				input(params, ParamPostMigrationEngineers, float64(engineerCount), estimation.UnitCount),
+				input(params, ParamWorkHoursPerDay, workHoursPerDay, estimation.UnitHoursPerDay),
+			},
+			Intermediates: []estimation.Quantity{
+				quantity("predicted_mins_per_vm", avgMins, estimation.UnitMinutes),
+				quantity("effort_minutes", totalManMins, estimation.UnitMinutes),
+				quantity("work_days", float64(workDays), estimation.UnitDays),
+			},
+			Assumptions: []string{fmt.Sprintf("the minutes of each VM are predicted by model %s", predictor.Name())},
+		},
+		Annotations: notes,
+	}, nil
+}
@@ -0,0 +1,176 @@
+package calculators
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+var testTroubleshootingModel = LinearTroubleshootingModel{
+	ModelName:       "fy26",
+	Intercept:       20,
+	MinutesPerDisk:  5,
+	SnapshotMinutes: 15,
+	OSMinutes:       map[string]float64{"Microsoft Windows Server 2012": 40},
+	AppTagMinutes:   map[string]float64{"database": 60, "stateless": -30},
+}
+
+func TestLinearTroubleshootingModel_PredictMinutes(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		vm   VMFeatures
+		want float64
+	}{
+		{name: "base", vm: VMFeatures{OS: "RHEL 9", DiskCount: 1}, want: 25},
+		{name: "os and snapshots", vm: VMFeatures{OS: "Microsoft Windows Server 2012", DiskCount: 2, HasSnapshots: true}, want: 85},
+		{name: "app tags", vm: VMFeatures{DiskCount: 2, AppTags: []string{"database", "unknown"}}, want: 90},
+		{name: "never negative", vm: VMFeatures{AppTags: []string{"stateless"}}, want: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := testTroubleshootingModel.PredictMinutes(tc.vm)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %v mins, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLinearTroubleshootingModel_Validate(t *testing.T) {
+	t.Parallel()
+	if err := testTroubleshootingModel.Validate(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+	cases := []struct {
+		name  string
+		model LinearTroubleshootingModel
+	}{
+		{name: "no name", model: LinearTroubleshootingModel{Intercept: 10}},
+		{name: "infinite intercept", model: LinearTroubleshootingModel{ModelName: "m", Intercept: math.Inf(1)}},
+		{name: "NaN os coefficient", model: LinearTroubleshootingModel{ModelName: "m", OSMinutes: map[string]float64{"RHEL": math.NaN()}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tc.model.Validate(); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
+
+func TestPredictedTroubleshooting_Calculate(t *testing.T) {
+	t.Parallel()
+	calc := NewPredictedTroubleshooting(nil, NewPostMigrationTroubleShooting(WithEngineerCount(2)))
+
+	params := map[string]estimation.Param{
+		ParamVMCount:                  {Key: ParamVMCount, Value: 2},
+		ParamTroubleshootingPredictor: {Key: ParamTroubleshootingPredictor, Value: testTroubleshootingModel},
+		ParamVMFeatures: {Key: ParamVMFeatures, Value: []VMFeatures{
+			{OS: "RHEL 9", DiskCount: 1},
+			{OS: "Microsoft Windows Server 2012", DiskCount: 2, HasSnapshots: true},
+		}},
+	}
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (25 + 85) mins / 2 engineers = 55 mins
+	if result.Duration != 55*time.Minute {
+		t.Errorf("expected duration %v, got %v", 55*time.Minute, result.Duration)
+	}
+	if !strings.Contains(result.Reason, "model fy26") {
+		t.Errorf("expected reason to name the model, got: %q", result.Reason)
+	}
+	if calc.Name() != NewPostMigrationTroubleShooting().Name() {
+		t.Errorf("expected the name of the formula-based calculator, got %q", calc.Name())
+	}
+}
+
+func TestPredictedTroubleshooting_Calculate_FallsBack(t *testing.T) {
+	t.Parallel()
+	fallback := NewPostMigrationTroubleShooting()
+	features := estimation.Param{Key: ParamVMFeatures, Value: []VMFeatures{{DiskCount: 1}}}
+	cases := []struct {
+		name      string
+		predictor TroubleshootingPredictor
+		params    map[string]estimation.Param
+	}{
+		{
+			name:   "no model",
+			params: map[string]estimation.Param{ParamVMCount: {Key: ParamVMCount, Value: 10}, ParamVMFeatures: features},
+		},
+		{
+			name:      "no VM features",
+			predictor: testTroubleshootingModel,
+			params:    map[string]estimation.Param{ParamVMCount: {Key: ParamVMCount, Value: 10}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			want, err := fallback.Calculate(tc.params)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			got, err := NewPredictedTroubleshooting(tc.predictor, fallback).Calculate(tc.params)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected the estimation of the formula %+v, got %+v", want, got)
+			}
+		})
+	}
+}
+
+func TestPredictedTroubleshooting_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	features := estimation.Param{Key: ParamVMFeatures, Value: []VMFeatures{{DiskCount: 1}}}
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "invalid predictor type",
+			params: map[string]estimation.Param{
+				ParamTroubleshootingPredictor: {Key: ParamTroubleshootingPredictor, Value: "linear"},
+				ParamVMFeatures:               features,
+			},
+		},
+		{
+			name: "invalid features type",
+			params: map[string]estimation.Param{
+				ParamTroubleshootingPredictor: {Key: ParamTroubleshootingPredictor, Value: testTroubleshootingModel},
+				ParamVMFeatures:               {Key: ParamVMFeatures, Value: 3},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamTroubleshootingPredictor: {Key: ParamTroubleshootingPredictor, Value: testTroubleshootingModel},
+				ParamVMFeatures:               features,
+				ParamPostMigrationEngineers:   {Key: ParamPostMigrationEngineers, Value: 0},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			calc := NewPredictedTroubleshooting(nil, NewPostMigrationTroubleShooting())
+			if _, err := calc.Calculate(tc.params); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
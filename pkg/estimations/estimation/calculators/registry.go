@@ -0,0 +1,208 @@
+package calculators
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// Config configures a calculator built by a Registry, keyed by setting, e.g. {"engineer_count": 4}.
+// Values are numbers, or maps of numbers for the settings given per name (e.g. per OS family), as
+// decoded from JSON or YAML.
+type Config map[string]interface{}
+
+// Factory builds a calculator from its configuration. Settings missing from the configuration keep
+// the defaults of the calculator; unknown settings are rejected.
+type Factory func(Config) (estimation.Calculator, error)
+
+// Spec selects a calculator of a Registry by ID and configures it, e.g. an entry of a config file.
+type Spec struct {
+	ID       string `json:"id" yaml:"id"`
+	Disabled bool   `json:"disabled,omitempty" yaml:"disabled,omitempty"`
+	Config   Config `json:"config,omitempty" yaml:"config,omitempty"`
+}
+
+// Registry maps unique IDs to the factories of calculators, so callers (API, CLI, config files)
+// enable, disable and configure calculators by name at runtime instead of calling their constructors.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// DefaultRegistry holds the calculators of this package, each registered by its own file. The
+// predicted troubleshooting calculator is left out: its predictor is only known at runtime.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates a Registry with no calculators registered.
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// Register adds the factory of a calculator under its ID.
+// Register panics if the ID is empty or already registered, as registration happens at init time.
+func (r *Registry) Register(id string, f Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id == "" {
+		panic("calculators: calculator registered without ID")
+	}
+	if _, ok := r.factories[id]; ok {
+		panic(fmt.Sprintf("calculators: calculator %q already registered", id))
+	}
+	r.factories[id] = f
+}
+
+// Lookup returns the factory registered under the ID.
+func (r *Registry) Lookup(id string) (Factory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	f, ok := r.factories[id]
+	return f, ok
+}
+
+// IDs returns the registered IDs in alphabetical order.
+func (r *Registry) IDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]string, 0, len(r.factories))
+	for id := range r.factories {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// New builds the calculator registered under the ID with its configuration.
+func (r *Registry) New(id string, config Config) (estimation.Calculator, error) {
+	f, ok := r.Lookup(id)
+	if !ok {
+		return nil, fmt.Errorf("unknown calculator %q, expected one of %s", id, strings.Join(r.IDs(), ", "))
+	}
+	c, err := f(config)
+	if err != nil {
+		return nil, fmt.Errorf("calculator %s: %w", id, err)
+	}
+	return c, nil
+}
+
+// Engine builds an engine running the enabled calculators of the specs, in the order of the specs.
+// A calculator can only be selected once.
+func (r *Registry) Engine(specs ...Spec) (*estimation.Engine, error) {
+	engine := estimation.NewEngine()
+	selected := make(map[string]bool, len(specs))
+	for _, s := range specs {
+		if selected[s.ID] {
+			return nil, fmt.Errorf("calculator %q selected more than once", s.ID)
+		}
+		selected[s.ID] = true
+		if s.Disabled {
+			continue
+		}
+		c, err := r.New(s.ID, s.Config)
+		if err != nil {
+			return nil, err
+		}
+		engine.Register(c)
+	}
+	return engine, nil
+}
+
+// settings reads the settings of a Config, remembering those read so the unknown ones are rejected.
+// The first error is kept and returned by done.
+type settings struct {
+	config Config
+	read   map[string]bool
+	err    error
+}
+
+func newSettings(config Config) *settings {
+	return &settings{config: config, read: make(map[string]bool, len(config))}
+}
+
+func (s *settings) lookup(key string) (interface{}, bool) {
+	s.read[key] = true
+	v, ok := s.config[key]
+	return v, ok && s.err == nil
+}
+
+// float calls set with the number of the setting when configured.
+func (s *settings) float(key string, set func(float64)) {
+	v, ok := s.lookup(key)
+	if !ok {
+		return
+	}
+	f, err := getFloat(estimation.Param{Key: key, Value: v})
+	if err != nil {
+		s.err = err
+		return
+	}
+	set(f)
+}
+
+// int calls set with the integer of the setting when configured.
+func (s *settings) int(key string, set func(int)) {
+	v, ok := s.lookup(key)
+	if !ok {
+		return
+	}
+	i, err := getInt(estimation.Param{Key: key, Value: v})
+	if err != nil {
+		s.err = err
+		return
+	}
+	set(i)
+}
+
+// floats calls set with each name and number of a setting given per name.
+func (s *settings) floats(key string, set func(string, float64)) {
+	v, ok := s.lookup(key)
+	if !ok {
+		return
+	}
+	values, ok := v.(map[string]interface{})
+	if !ok {
+		if typed, isTyped := v.(map[string]float64); isTyped {
+			values = make(map[string]interface{}, len(typed))
+			for name, f := range typed {
+				values[name] = f
+			}
+		} else {
+			s.err = fmt.Errorf("param %s is not a map of numbers (type: %T)", key, v)
+			return
+		}
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f, err := getFloat(estimation.Param{Key: key + "." + name, Value: values[name]})
+		if err != nil {
+			s.err = err
+			return
+		}
+		set(name, f)
+	}
+}
+
+// done returns the first error met reading the settings, or the unknown settings of the Config.
+func (s *settings) done() error {
+	if s.err != nil {
+		return s.err
+	}
+	var unknown []string
+	for key := range s.config {
+		if !s.read[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown settings %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestDefaultRegistry_IDs(t *testing.T) {
+	t.Parallel()
+
+	ids := DefaultRegistry.IDs()
+	if len(ids) != 19 {
+		t.Errorf("expected 19 calculators registered, got %d: %v", len(ids), ids)
+	}
+	for _, id := range []string{"storage_migration", "post_migration_troubleshooting", "compliance_review"} {
+		if _, ok := DefaultRegistry.Lookup(id); !ok {
+			t.Errorf("expected %s to be registered", id)
+		}
+	}
+
+	// every calculator builds with its defaults
+	for _, id := range ids {
+		if _, err := DefaultRegistry.New(id, nil); err != nil {
+			t.Errorf("building %s: %v", id, err)
+		}
+	}
+}
+
+func TestRegistry_New_Config(t *testing.T) {
+	t.Parallel()
+
+	calc, err := DefaultRegistry.New("post_migration_troubleshooting", Config{
+		"troubleshoot_mins_per_vm": 30.0,
+		"engineer_count":           float64(2), // JSON numbers
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	est, err := calc.Calculate(map[string]estimation.Param{ParamVMCount: {Key: ParamVMCount, Value: 8}})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 8 VMs * 30 min / 2 engineers
+	if est.Duration != 2*time.Hour {
+		t.Errorf("expected 2h, got %v", est.Duration)
+	}
+
+	calc, err = DefaultRegistry.New("hardware_procurement", Config{"sku_lead_days": map[string]interface{}{"R760": 60.0}})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if h := calc.(*HardwareProcurement); h.skuLeadDays["R760"] != 60 {
+		t.Errorf("expected the SKU lead days configured, got %v", h.skuLeadDays)
+	}
+}
+
+func TestRegistry_New_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		id     string
+		config Config
+		want   string
+	}{
+		{name: "unknown calculator", id: "teleportation", want: `unknown calculator "teleportation"`},
+		{name: "unknown setting", id: "storage_migration", config: Config{"transfer_rate": 1000.0}, want: "unknown settings transfer_rate"},
+		{name: "not a number", id: "storage_migration", config: Config{"transfer_rate_mbps": "fast"}, want: "transfer_rate_mbps is not a number"},
+		{name: "not a map", id: "guest_os_remediation", config: Config{"os_family_mins": 30.0}, want: "os_family_mins is not a map of numbers"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := DefaultRegistry.New(tt.id, tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestRegistry_Engine(t *testing.T) {
+	t.Parallel()
+
+	engine, err := DefaultRegistry.Engine(
+		Spec{ID: "storage_migration", Config: Config{"transfer_rate_mbps": 1240.0}},
+		Spec{ID: "post_migration_troubleshooting", Disabled: true},
+		Spec{ID: "decommission"},
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := engine.Calculators(); len(got) != 2 || got[0] != "Storage Migration" || got[1] != "Decommission" {
+		t.Errorf("expected the enabled calculators in order, got %v", got)
+	}
+
+	if _, err := DefaultRegistry.Engine(Spec{ID: "decommission"}, Spec{ID: "decommission", Disabled: true}); err == nil {
+		t.Error("expected an error selecting a calculator twice")
+	}
+}
+
+func TestRegistry_Register_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry()
+	factory := func(Config) (estimation.Calculator, error) { return NewDecommission(), nil }
+	r.Register("decommission", factory)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic registering an ID twice")
+		}
+	}()
+	r.Register("decommission", factory)
+}
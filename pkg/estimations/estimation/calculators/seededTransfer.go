@@ -0,0 +1,241 @@
+package calculators
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamSeedCopyGBPerHour is the estimation.Param key for the rate, in GB per hour, at which disks are copied
+	// to the transfer appliance at the source and from it at the target.
+	ParamSeedCopyGBPerHour = "seed_copy_gb_per_hour"
+	// ParamShippingDays is the estimation.Param key for the calendar days the appliance takes to reach the target site.
+	ParamShippingDays = "shipping_days"
+	// ParamDailyChangeRatePercent is the estimation.Param key for the share of the disks rewritten per day.
+	ParamDailyChangeRatePercent = "daily_change_rate_percent"
+
+	// DefaultSeedCopyGBPerHour is about 500 MB/s, the sustained write rate of a disk-based transfer appliance.
+	DefaultSeedCopyGBPerHour      = 1800.0
+	DefaultShippingDays           = 5
+	DefaultDailyChangeRatePercent = 3.0
+)
+
+// Compile-time assertion that SeededTransfer implements the Calculator interface.
+var _ estimation.Calculator = (*SeededTransfer)(nil)
+
+// SeededTransfer estimates the data-gravity alternative to a network transfer: the disks are copied
+// to a transfer appliance, shipped to the target site and copied to the target storage, then the
+// data changed since the seed copy is synced over the network.
+// Shipping is modeled as calendar lead time: Duration is the time spent copying and syncing while
+// LeadTime is the calendar time from the seed copy to the end of the catch-up sync.
+type SeededTransfer struct {
+	seedCopyGBPerHour      float64
+	shippingDays           int
+	dailyChangeRatePercent float64
+	transferRateMbps       float64
+}
+
+// SeededTransferOption is a functional option for configuring a SeededTransfer calculator.
+type SeededTransferOption func(*SeededTransfer)
+
+// WithSeedCopyGBPerHour sets the rate of the copies to and from the appliance. Non-positive values are ignored.
+func WithSeedCopyGBPerHour(gbPerHour float64) SeededTransferOption {
+	return func(s *SeededTransfer) {
+		if gbPerHour > 0 {
+			s.seedCopyGBPerHour = gbPerHour
+		}
+	}
+}
+
+// WithShippingDays sets the calendar days the appliance takes to reach the target site.
+func WithShippingDays(days int) SeededTransferOption {
+	return func(s *SeededTransfer) {
+		s.shippingDays = days
+	}
+}
+
+// WithDailyChangeRatePercent sets the share of the disks rewritten per day while the appliance is on its way.
+func WithDailyChangeRatePercent(percent float64) SeededTransferOption {
+	return func(s *SeededTransfer) {
+		s.dailyChangeRatePercent = percent
+	}
+}
+
+// WithSyncTransferRateMbps sets the network transfer rate of the catch-up sync. Non-positive values are ignored.
+func WithSyncTransferRateMbps(mbps float64) SeededTransferOption {
+	return func(s *SeededTransfer) {
+		if mbps > 0 {
+			s.transferRateMbps = mbps
+		}
+	}
+}
+
+// NewSeededTransfer creates a SeededTransfer calculator with default settings that can be overridden by options.
+func NewSeededTransfer(opts ...SeededTransferOption) *SeededTransfer {
+	res := SeededTransfer{
+		seedCopyGBPerHour:      DefaultSeedCopyGBPerHour,
+		shippingDays:           DefaultShippingDays,
+		dailyChangeRatePercent: DefaultDailyChangeRatePercent,
+		transferRateMbps:       DefaultTransferRateMbps,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("seeded_transfer", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []SeededTransferOption
+		s.float("seed_copy_gb_per_hour", func(v float64) { opts = append(opts, WithSeedCopyGBPerHour(v)) })
+		s.int("shipping_days", func(v int) { opts = append(opts, WithShippingDays(v)) })
+		s.float("daily_change_rate_percent", func(v float64) { opts = append(opts, WithDailyChangeRatePercent(v)) })
+		s.float("transfer_rate_mbps", func(v float64) { opts = append(opts, WithSyncTransferRateMbps(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewSeededTransfer(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *SeededTransfer) Name() string { return "Seeded Transfer" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// seed_copy_gb_per_hour, shipping_days, daily_change_rate_percent and transfer_rate_mbps are optional.
+func (c *SeededTransfer) Keys() []string {
+	return []string{ParamTotalDiskGB}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *SeededTransfer) OptionalKeys() []string {
+	return []string{ParamDailyChangeRatePercent, ParamSeedCopyGBPerHour, ParamShippingDays, ParamTransferRateMbps}
+}
+
+// Calculate estimates the seed copies as totalDiskGB / seedCopyGBPerHour at each end, and the catch-up
+// sync as the data changed from the seed copy to the end of the ingest, capped at the disk size,
+// transferred at transfer_rate_mbps.
+func (c *SeededTransfer) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	diskParam, ok := params[ParamTotalDiskGB]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamTotalDiskGB)
+	}
+	totalGB, err := getFloat(diskParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if totalGB < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamTotalDiskGB)
+	}
+
+	copyRate := c.seedCopyGBPerHour
+	if p, exists := params[ParamSeedCopyGBPerHour]; exists {
+		if copyRate, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if copyRate <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be positive", ParamSeedCopyGBPerHour)
+	}
+
+	shippingDays := c.shippingDays
+	if p, exists := params[ParamShippingDays]; exists {
+		if shippingDays, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if shippingDays < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamShippingDays)
+	}
+
+	changeRate := c.dailyChangeRatePercent
+	if p, exists := params[ParamDailyChangeRatePercent]; exists {
+		if changeRate, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if changeRate < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamDailyChangeRatePercent)
+	}
+
+	var notes []estimation.Annotation
+	transferRateMbps, err := positiveParam(params, ParamTransferRateMbps, c.transferRateMbps, &notes)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	copyHours := totalGB / copyRate
+	// The data keeps changing from the seed copy at the source to the end of the ingest at the target
+	exposedDays := 2*copyHours/24 + float64(shippingDays)
+	changedGB := math.Min(totalGB, totalGB*changeRate/100*exposedDays)
+	syncMinutes := (changedGB * 1024) / (transferRateMbps / 8) / 60
+	syncHours := syncMinutes / 60
+
+	duration, err := estimation.FromHours(2*copyHours + syncHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	leadTime, err := estimation.FromHours(2*copyHours + syncHours + float64(shippingDays)*24)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		LeadTime: leadTime,
+		Reason: fmt.Sprintf("%.2f GB seeded at %.0f GB/h twice (%.1f h), %d calendar days shipping, %.2f GB changed at %.1f%%/day synced at %.0f Mbps (%.1f h)",
+			totalGB, copyRate, 2*copyHours, shippingDays, changedGB, changeRate, transferRateMbps, syncHours),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = 2 * copy_hours + sync_hours, copy_hours = total_disk_gb / seed_copy_gb_per_hour, " +
+				"sync_hours = changed_gb * 1024 / (transfer_rate_mbps / 8) / 3600, " +
+				"changed_gb = min(total_disk_gb, total_disk_gb * daily_change_rate_percent / 100 * exposed_days); " +
+				"lead_time = duration + shipping_days",
+			Inputs: []estimation.Quantity{
+				input(params, ParamTotalDiskGB, totalGB, estimation.UnitGB),
+				input(params, ParamSeedCopyGBPerHour, copyRate, estimation.UnitGBPerHour),
+				input(params, ParamShippingDays, float64(shippingDays), estimation.UnitDays),
+				input(params, ParamDailyChangeRatePercent, changeRate, estimation.UnitPercentPerDay),
+				input(params, ParamTransferRateMbps, transferRateMbps, estimation.UnitMbps),
+			},
+			Intermediates: []estimation.Quantity{
+				quantity("copy_hours", copyHours, estimation.UnitHours),
+				quantity("exposed_days", exposedDays, estimation.UnitDays),
+				quantity("changed_gb", changedGB, estimation.UnitGB),
+				quantity("sync_hours", syncHours, estimation.UnitHours),
+			},
+			Assumptions: []string{"the data keeps changing from the seed copy at the source to the end of the ingest at the target"},
+		},
+		Annotations: notes,
+	}, nil
+}
+
+// TransferComparison compares a network transfer of the disks with a seeded transfer.
+type TransferComparison struct {
+	Network estimation.Estimation
+	Seeded  estimation.Estimation
+	// SeededFaster tells whether the seeded transfer completes first in calendar time.
+	SeededFaster bool
+}
+
+// CompareTransfers estimates both transfers of the same params, e.g. to present the data-gravity
+// alternative next to the network transfer in a scenario comparison. Both run round the clock.
+func CompareTransfers(params map[string]estimation.Param, network *StorageMigration, seeded *SeededTransfer) (TransferComparison, error) {
+	n, err := network.Calculate(params)
+	if err != nil {
+		return TransferComparison{}, fmt.Errorf("network transfer: %w", err)
+	}
+	s, err := seeded.Calculate(params)
+	if err != nil {
+		return TransferComparison{}, fmt.Errorf("seeded transfer: %w", err)
+	}
+	return TransferComparison{
+		Network:      n,
+		Seeded:       s,
+		SeededFaster: s.Elapsed(0) < n.Elapsed(0),
+	}, nil
+}
@@ -0,0 +1,140 @@
+package calculators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestSeededTransfer_Calculate(t *testing.T) {
+	t.Parallel()
+	calc := NewSeededTransfer(WithShippingDays(1), WithSyncTransferRateMbps(1024))
+
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB:            {Key: ParamTotalDiskGB, Value: 3600.0},
+		ParamDailyChangeRatePercent: {Key: ParamDailyChangeRatePercent, Value: 6.0},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 2 h copy at each end, 252 GB changed over 28 h (3600 GB * 6% * 28/24) synced at 128 MB/s
+	expectedSync := time.Duration((252.0 * 1024 / 128 / 60) * float64(time.Minute))
+	expectedDuration := 4*time.Hour + expectedSync
+	if result.Duration != expectedDuration {
+		t.Errorf("expected duration %v, got %v", expectedDuration, result.Duration)
+	}
+	if result.LeadTime != expectedDuration+24*time.Hour {
+		t.Errorf("expected lead time %v, got %v", expectedDuration+24*time.Hour, result.LeadTime)
+	}
+	if result.Reason == "" {
+		t.Error("expected non-empty reason")
+	}
+}
+
+func TestSeededTransfer_Calculate_ChangesCappedAtDiskSize(t *testing.T) {
+	t.Parallel()
+	calc := NewSeededTransfer(WithShippingDays(30), WithDailyChangeRatePercent(10))
+
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB:       {Key: ParamTotalDiskGB, Value: 1000.0},
+		ParamSeedCopyGBPerHour: {Key: ParamSeedCopyGBPerHour, Value: 1000},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	network, err := NewStorageMigration().Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// everything changed in transit: the sync is a full network transfer after the seed copies
+	if expected := 2*time.Hour + network.Duration; result.Duration != expected {
+		t.Errorf("expected duration %v, got %v", expected, result.Duration)
+	}
+}
+
+func TestCompareTransfers(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name         string
+		totalGB      float64
+		seededFaster bool
+	}{
+		// 100 TB takes 15 days over the network, about 14 days seeded
+		{name: "large estate", totalGB: 100000, seededFaster: true},
+		{name: "small estate", totalGB: 500, seededFaster: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			params := map[string]estimation.Param{
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: tc.totalGB},
+			}
+			cmp, err := CompareTransfers(params, NewStorageMigration(), NewSeededTransfer())
+			if err != nil {
+				t.Fatalf("expected no error for case %q, got: %v", tc.name, err)
+			}
+			if cmp.SeededFaster != tc.seededFaster {
+				t.Errorf("expected seeded faster %v for case %q, got network %v and seeded %v",
+					tc.seededFaster, tc.name, cmp.Network.Elapsed(0), cmp.Seeded.Elapsed(0))
+			}
+		})
+	}
+}
+
+func TestSeededTransfer_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name:   "missing total_disk_gb param",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "negative disk size",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: -1.0},
+			},
+		},
+		{
+			name: "zero seed copy rate",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB:       {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamSeedCopyGBPerHour: {Key: ParamSeedCopyGBPerHour, Value: 0.0},
+			},
+		},
+		{
+			name: "negative shipping days",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB:  {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamShippingDays: {Key: ParamShippingDays, Value: -2},
+			},
+		},
+		{
+			name: "negative change rate",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB:            {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamDailyChangeRatePercent: {Key: ParamDailyChangeRatePercent, Value: -1.0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewSeededTransfer().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
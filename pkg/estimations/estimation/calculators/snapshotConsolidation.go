@@ -0,0 +1,173 @@
+package calculators
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamVMsWithSnapshots is the estimation.Param key for the number of source VMs with snapshots.
+	ParamVMsWithSnapshots = "vms_with_snapshots"
+	// ParamAvgSnapshotChainLength is the estimation.Param key for the average number of snapshots in the chain
+	// of a VM with snapshots.
+	ParamAvgSnapshotChainLength = "avg_snapshot_chain_length"
+	// ParamParallelConsolidations is the estimation.Param key for the number of VMs consolidated at the same time.
+	ParamParallelConsolidations = "parallel_consolidations"
+
+	DefaultSnapshotMergeMins         = 20.0
+	DefaultConsolidationOverheadMins = 5.0
+	DefaultParallelConsolidations    = 4
+)
+
+// Compile-time assertion that SnapshotConsolidation implements the Calculator interface.
+var _ estimation.Calculator = (*SnapshotConsolidation)(nil)
+
+// SnapshotConsolidation estimates the consolidation of the snapshots of the source VMs before the migration,
+// as MTV only migrates VMs without snapshots. Each snapshot of a chain is merged into its parent disk, so
+// a VM takes longer the longer its chain. Consolidations load the datastores, so only a few VMs are
+// consolidated at the same time.
+type SnapshotConsolidation struct {
+	mergeMins              float64
+	overheadMins           float64
+	parallelConsolidations int
+}
+
+// SnapshotConsolidationOption is a functional option for configuring a SnapshotConsolidation calculator.
+type SnapshotConsolidationOption func(*SnapshotConsolidation)
+
+// WithSnapshotMergeMins sets the minutes to merge one snapshot of a chain into its parent disk.
+func WithSnapshotMergeMins(mins float64) SnapshotConsolidationOption {
+	return func(s *SnapshotConsolidation) {
+		s.mergeMins = mins
+	}
+}
+
+// WithConsolidationOverheadMins sets the minutes spent on a VM besides merging, e.g. checking the chain and
+// verifying the VM has no snapshot left.
+func WithConsolidationOverheadMins(mins float64) SnapshotConsolidationOption {
+	return func(s *SnapshotConsolidation) {
+		s.overheadMins = mins
+	}
+}
+
+// WithParallelConsolidations sets the number of VMs consolidated at the same time. Non-positive values are ignored.
+func WithParallelConsolidations(count int) SnapshotConsolidationOption {
+	return func(s *SnapshotConsolidation) {
+		if count > 0 {
+			s.parallelConsolidations = count
+		}
+	}
+}
+
+// NewSnapshotConsolidation creates a SnapshotConsolidation calculator with default settings that can be overridden by options.
+func NewSnapshotConsolidation(opts ...SnapshotConsolidationOption) *SnapshotConsolidation {
+	res := SnapshotConsolidation{
+		mergeMins:              DefaultSnapshotMergeMins,
+		overheadMins:           DefaultConsolidationOverheadMins,
+		parallelConsolidations: DefaultParallelConsolidations,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("snapshot_consolidation", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []SnapshotConsolidationOption
+		s.float("merge_mins", func(v float64) { opts = append(opts, WithSnapshotMergeMins(v)) })
+		s.float("overhead_mins", func(v float64) { opts = append(opts, WithConsolidationOverheadMins(v)) })
+		s.int("parallel_consolidations", func(v int) { opts = append(opts, WithParallelConsolidations(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewSnapshotConsolidation(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *SnapshotConsolidation) Name() string { return "Snapshot Consolidation" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// parallel_consolidations is optional.
+func (c *SnapshotConsolidation) Keys() []string {
+	return []string{ParamVMsWithSnapshots, ParamAvgSnapshotChainLength}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *SnapshotConsolidation) OptionalKeys() []string {
+	return []string{ParamParallelConsolidations}
+}
+
+// Calculate estimates the consolidation as ceil(vms_with_snapshots / parallel_consolidations) batches of the
+// time to consolidate a VM: the overhead plus the merge of each snapshot of its chain.
+func (c *SnapshotConsolidation) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	vmParam, ok := params[ParamVMsWithSnapshots]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamVMsWithSnapshots)
+	}
+	vmCount, err := getInt(vmParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if vmCount < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamVMsWithSnapshots)
+	}
+
+	chainParam, ok := params[ParamAvgSnapshotChainLength]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamAvgSnapshotChainLength)
+	}
+	chainLength, err := getFloat(chainParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if chainLength < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamAvgSnapshotChainLength)
+	}
+
+	parallel := c.parallelConsolidations
+	if p, exists := params[ParamParallelConsolidations]; exists {
+		if parallel, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if parallel <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be > 0", ParamParallelConsolidations)
+	}
+
+	perVMMins := c.overheadMins + chainLength*c.mergeMins
+	batches := int(math.Ceil(float64(vmCount) / float64(parallel)))
+	totalMins := float64(batches) * perVMMins
+
+	duration, err := estimation.FromMinutes(totalMins)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Reason: fmt.Sprintf("%.0f min per VM (%.1f mins + %.1f snapshots @ %.1f mins), %d VMs in %d batches of %d",
+			perVMMins, c.overheadMins, chainLength, c.mergeMins, vmCount, batches, parallel),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = batches * minutes_per_vm, batches = ceil(vms_with_snapshots / parallel_consolidations), " +
+				"minutes_per_vm = overhead_mins + avg_snapshot_chain_length * merge_mins",
+			Inputs: []estimation.Quantity{
+				input(params, ParamVMsWithSnapshots, float64(vmCount), estimation.UnitCount),
+				input(params, ParamAvgSnapshotChainLength, chainLength, estimation.UnitCount),
+				quantity("overhead_mins", c.overheadMins, estimation.UnitMinutes),
+				quantity("merge_mins", c.mergeMins, estimation.UnitMinutes),
+				input(params, ParamParallelConsolidations, float64(parallel), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{
+				quantity("minutes_per_vm", perVMMins, estimation.UnitMinutes),
+				quantity("batches", float64(batches), estimation.UnitCount),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,116 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestSnapshotConsolidation_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewSnapshotConsolidation()
+
+	params := map[string]estimation.Param{
+		ParamVMsWithSnapshots:       {Key: ParamVMsWithSnapshots, Value: 10},
+		ParamAvgSnapshotChainLength: {Key: ParamAvgSnapshotChainLength, Value: 2.5},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 5 + 2.5 * 20 = 55 mins per VM, 10 VMs in 3 batches of 4 = 165 mins
+	if result.Duration != 165*time.Minute {
+		t.Errorf("expected duration 2h45m, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "3 batches of 4") {
+		t.Errorf("expected reason to mention the batches, got %q", result.Reason)
+	}
+}
+
+func TestSnapshotConsolidation_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewSnapshotConsolidation(WithSnapshotMergeMins(30), WithConsolidationOverheadMins(0), WithParallelConsolidations(1))
+
+	params := map[string]estimation.Param{
+		ParamVMsWithSnapshots:       {Key: ParamVMsWithSnapshots, Value: 6},
+		ParamAvgSnapshotChainLength: {Key: ParamAvgSnapshotChainLength, Value: 4},
+		ParamParallelConsolidations: {Key: ParamParallelConsolidations, Value: 3},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 4 * 30 = 2h per VM, 6 VMs in 2 batches of 3 = 4h
+	if result.Duration != 4*time.Hour {
+		t.Errorf("expected duration 4h, got %v", result.Duration)
+	}
+}
+
+func TestSnapshotConsolidation_Calculate_NoSnapshots(t *testing.T) {
+	t.Parallel()
+	params := map[string]estimation.Param{
+		ParamVMsWithSnapshots:       {Key: ParamVMsWithSnapshots, Value: 0},
+		ParamAvgSnapshotChainLength: {Key: ParamAvgSnapshotChainLength, Value: 0},
+	}
+
+	result, err := NewSnapshotConsolidation().Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Duration != 0 {
+		t.Errorf("expected no consolidation, got %v", result.Duration)
+	}
+}
+
+func TestSnapshotConsolidation_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "missing vms_with_snapshots",
+			params: map[string]estimation.Param{
+				ParamAvgSnapshotChainLength: {Key: ParamAvgSnapshotChainLength, Value: 2},
+			},
+		},
+		{
+			name: "missing avg_snapshot_chain_length",
+			params: map[string]estimation.Param{
+				ParamVMsWithSnapshots: {Key: ParamVMsWithSnapshots, Value: 2},
+			},
+		},
+		{
+			name: "negative avg_snapshot_chain_length",
+			params: map[string]estimation.Param{
+				ParamVMsWithSnapshots:       {Key: ParamVMsWithSnapshots, Value: 2},
+				ParamAvgSnapshotChainLength: {Key: ParamAvgSnapshotChainLength, Value: -1},
+			},
+		},
+		{
+			name: "zero parallel consolidations",
+			params: map[string]estimation.Param{
+				ParamVMsWithSnapshots:       {Key: ParamVMsWithSnapshots, Value: 2},
+				ParamAvgSnapshotChainLength: {Key: ParamAvgSnapshotChainLength, Value: 2},
+				ParamParallelConsolidations: {Key: ParamParallelConsolidations, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewSnapshotConsolidation().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamHostCount is the estimation.Param key for the number of ESXi hosts in the source environment.
+	ParamHostCount = "host_count"
+	// ParamStorageArrayCount is the estimation.Param key for the number of storage arrays to evacuate.
+	ParamStorageArrayCount = "storage_array_count"
+	// ParamLicenseNoticeDays is the estimation.Param key for the notice period, in calendar days, to terminate source licenses.
+	ParamLicenseNoticeDays = "license_notice_days"
+	// ParamSourceDecommissionEngineers is the estimation.Param key for the number of engineers tearing down the source
+	// environment. It is distinct from ParamDecommissionEngineers, the team retiring individual VMs.
+	ParamSourceDecommissionEngineers = "source_decommission_engineers"
+
+	DefaultHostDecommissionHours = 4.0
+	DefaultArrayEvacuationHours  = 40.0
+	DefaultLicenseNoticeDays     = 90
+)
+
+// Compile-time assertion that SourceDecommission implements the Calculator interface.
+var _ estimation.Calculator = (*SourceDecommission)(nil)
+
+// SourceDecommission estimates the post-migration teardown of the source environment:
+// decommissioning ESXi hosts, evacuating storage arrays and terminating licenses.
+// License termination is modeled as calendar lead time rather than effort.
+type SourceDecommission struct {
+	hostDecommissionHours float64
+	arrayEvacuationHours  float64
+	licenseNoticeDays     int
+	engineerCount         int
+}
+
+// SourceDecommissionOption is a functional option for configuring a SourceDecommission calculator.
+type SourceDecommissionOption func(*SourceDecommission)
+
+// WithHostDecommissionHours sets the effort, in hours, to decommission a single ESXi host.
+func WithHostDecommissionHours(hours float64) SourceDecommissionOption {
+	return func(s *SourceDecommission) {
+		s.hostDecommissionHours = hours
+	}
+}
+
+// WithArrayEvacuationHours sets the effort, in hours, to evacuate and retire a single storage array.
+func WithArrayEvacuationHours(hours float64) SourceDecommissionOption {
+	return func(s *SourceDecommission) {
+		s.arrayEvacuationHours = hours
+	}
+}
+
+// WithLicenseNoticeDays sets the license termination notice period in calendar days.
+func WithLicenseNoticeDays(days int) SourceDecommissionOption {
+	return func(s *SourceDecommission) {
+		s.licenseNoticeDays = days
+	}
+}
+
+// WithSourceDecommissionEngineerCount sets the number of engineers tearing down the source environment in parallel.
+func WithSourceDecommissionEngineerCount(count int) SourceDecommissionOption {
+	return func(s *SourceDecommission) {
+		s.engineerCount = count
+	}
+}
+
+// NewSourceDecommission creates a SourceDecommission calculator with default settings that can be overridden by options.
+func NewSourceDecommission(opts ...SourceDecommissionOption) *SourceDecommission {
+	res := SourceDecommission{
+		hostDecommissionHours: DefaultHostDecommissionHours,
+		arrayEvacuationHours:  DefaultArrayEvacuationHours,
+		licenseNoticeDays:     DefaultLicenseNoticeDays,
+		engineerCount:         DefaultEngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("source_decommission", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []SourceDecommissionOption
+		s.float("host_decommission_hours", func(v float64) { opts = append(opts, WithHostDecommissionHours(v)) })
+		s.float("array_evacuation_hours", func(v float64) { opts = append(opts, WithArrayEvacuationHours(v)) })
+		s.int("license_notice_days", func(v int) { opts = append(opts, WithLicenseNoticeDays(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithSourceDecommissionEngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewSourceDecommission(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *SourceDecommission) Name() string { return "Source Decommission" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// storage_array_count, license_notice_days and source_decommission_engineers are optional.
+func (c *SourceDecommission) Keys() []string {
+	return []string{ParamHostCount}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *SourceDecommission) OptionalKeys() []string {
+	return []string{ParamEngineerHourlyRate, ParamLicenseNoticeDays, ParamSourceDecommissionEngineers, ParamStorageArrayCount}
+}
+
+// Calculate estimates the teardown effort as (hosts * host hours + arrays * array hours) / engineers,
+// and returns the license notice period as LeadTime.
+func (c *SourceDecommission) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	hostParam, ok := params[ParamHostCount]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamHostCount)
+	}
+	hostCount, err := getInt(hostParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if hostCount < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamHostCount)
+	}
+
+	arrayCount := 0
+	if arrayParam, exists := params[ParamStorageArrayCount]; exists {
+		arrayCount, err = getInt(arrayParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if arrayCount < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamStorageArrayCount)
+		}
+	}
+
+	noticeDays := c.licenseNoticeDays
+	if noticeParam, exists := params[ParamLicenseNoticeDays]; exists {
+		noticeDays, err = getInt(noticeParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if noticeDays < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamLicenseNoticeDays)
+	}
+
+	engineerCount := c.engineerCount
+	if engParam, exists := params[ParamSourceDecommissionEngineers]; exists {
+		engineerCount, err = getInt(engParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	effortHours := float64(hostCount)*c.hostDecommissionHours + float64(arrayCount)*c.arrayEvacuationHours
+	realTimeHours := effortHours / float64(engineerCount)
+
+	duration, err := estimation.FromHours(realTimeHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	leadTime, err := estimation.FromDays(float64(noticeDays))
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	cost, err := laborCost(params, effortHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Cost:     cost,
+		LeadTime: leadTime,
+		Reason: fmt.Sprintf("%d hosts @ %.1f h + %d storage arrays @ %.1f h / %d engineers; %d calendar days license notice",
+			hostCount, c.hostDecommissionHours, arrayCount, c.arrayEvacuationHours, engineerCount, noticeDays),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = (host_count * host_decommission_hours + storage_array_count * array_evacuation_hours) / source_decommission_engineers; " +
+				"lead_time = license_notice_days",
+			Inputs: []estimation.Quantity{
+				input(params, ParamHostCount, float64(hostCount), estimation.UnitCount),
+				quantity("host_decommission_hours", c.hostDecommissionHours, estimation.UnitHours),
+				input(params, ParamStorageArrayCount, float64(arrayCount), estimation.UnitCount),
+				quantity("array_evacuation_hours", c.arrayEvacuationHours, estimation.UnitHours),
+				input(params, ParamLicenseNoticeDays, float64(noticeDays), estimation.UnitDays),
+				input(params, ParamSourceDecommissionEngineers, float64(engineerCount), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{quantity("effort_hours", effortHours, estimation.UnitHours)},
+			Assumptions:   []string{"the license notice runs alongside the teardown"},
+		},
+	}, nil
+}
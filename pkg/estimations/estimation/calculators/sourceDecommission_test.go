@@ -0,0 +1,126 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestSourceDecommission_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewSourceDecommission()
+
+	params := map[string]estimation.Param{
+		ParamHostCount:         {Key: ParamHostCount, Value: 20},
+		ParamStorageArrayCount: {Key: ParamStorageArrayCount, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (20 hosts * 4 h + 2 arrays * 40 h) / 10 engineers = 16 h
+	if result.Duration != 16*time.Hour {
+		t.Errorf("expected duration 16h, got %v", result.Duration)
+	}
+	// license termination is lead time, not effort
+	if result.LeadTime != DefaultLicenseNoticeDays*24*time.Hour {
+		t.Errorf("expected lead time of %d days, got %v", DefaultLicenseNoticeDays, result.LeadTime)
+	}
+	// 16h of effort at 8h a day is 2 days, well within the notice period
+	if got := result.Elapsed(DefaultWorkHoursPerDay); got != result.LeadTime {
+		t.Errorf("expected elapsed time to be driven by the notice period, got %v", got)
+	}
+	if !strings.Contains(result.Reason, "90 calendar days") {
+		t.Errorf("expected reason to mention notice period, got %q", result.Reason)
+	}
+}
+
+func TestSourceDecommission_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewSourceDecommission(
+		WithHostDecommissionHours(2),
+		WithArrayEvacuationHours(0),
+		WithLicenseNoticeDays(30),
+		WithSourceDecommissionEngineerCount(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamHostCount:                   {Key: ParamHostCount, Value: 10},
+		ParamLicenseNoticeDays:           {Key: ParamLicenseNoticeDays, Value: 0},
+		ParamSourceDecommissionEngineers: {Key: ParamSourceDecommissionEngineers, Value: 4},
+		// engineers retiring VMs do not tear down the source environment
+		ParamDecommissionEngineers: {Key: ParamDecommissionEngineers, Value: 1},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 10 hosts * 2 h / 4 engineers = 5 h
+	if result.Duration != 5*time.Hour {
+		t.Errorf("expected duration 5h, got %v", result.Duration)
+	}
+	if result.LeadTime != 0 {
+		t.Errorf("expected no lead time, got %v", result.LeadTime)
+	}
+	// 5h of effort at 8h a day takes 15h of calendar time
+	if got := result.Elapsed(DefaultWorkHoursPerDay); got != 15*time.Hour {
+		t.Errorf("expected elapsed time of 15h, got %v", got)
+	}
+}
+
+func TestSourceDecommission_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name:   "missing host_count param",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "negative host_count",
+			params: map[string]estimation.Param{
+				ParamHostCount: {Key: ParamHostCount, Value: -1},
+			},
+		},
+		{
+			name: "negative storage_array_count",
+			params: map[string]estimation.Param{
+				ParamHostCount:         {Key: ParamHostCount, Value: 1},
+				ParamStorageArrayCount: {Key: ParamStorageArrayCount, Value: -1},
+			},
+		},
+		{
+			name: "negative license_notice_days",
+			params: map[string]estimation.Param{
+				ParamHostCount:         {Key: ParamHostCount, Value: 1},
+				ParamLicenseNoticeDays: {Key: ParamLicenseNoticeDays, Value: -5},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamHostCount:                   {Key: ParamHostCount, Value: 1},
+				ParamSourceDecommissionEngineers: {Key: ParamSourceDecommissionEngineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewSourceDecommission().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
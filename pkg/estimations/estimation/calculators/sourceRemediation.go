@@ -0,0 +1,196 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamVCenterUpgrades is the estimation.Param key for the number of vCenter servers below the minimum supported version.
+	ParamVCenterUpgrades = "vcenter_upgrades"
+	// ParamESXiHostUpgrades is the estimation.Param key for the number of ESXi hosts below the minimum supported version.
+	ParamESXiHostUpgrades = "esxi_host_upgrades"
+	// ParamCBTEnablements is the estimation.Param key for the number of VMs targeted for warm migration without CBT.
+	ParamCBTEnablements = "cbt_enablements"
+	// ParamToolsUpgrades is the estimation.Param key for the number of VMs with VMware Tools missing or out of date.
+	ParamToolsUpgrades = "tools_upgrades"
+	// ParamRemediationEngineers is the estimation.Param key for the number of engineers remediating the source environment.
+	ParamRemediationEngineers = "remediation_engineers"
+
+	DefaultVCenterUpgradeHours  = 16.0
+	DefaultESXiHostUpgradeHours = 2.0
+	DefaultCBTEnablementMins    = 15.0
+	DefaultToolsUpgradeMins     = 30.0
+)
+
+// Compile-time assertion that SourceRemediation implements the Calculator interface.
+var _ estimation.Calculator = (*SourceRemediation)(nil)
+
+// SourceRemediation estimates the prerequisite work on the source environment before the first wave:
+// upgrading vCenter and ESXi hosts below the minimum supported version, enabling CBT on VMs
+// targeted for warm migration and upgrading VMware Tools.
+// vCenter upgrades are done one at a time; the rest is shared between the engineers.
+type SourceRemediation struct {
+	vcenterUpgradeHours  float64
+	esxiHostUpgradeHours float64
+	cbtEnablementMins    float64
+	toolsUpgradeMins     float64
+	engineerCount        int
+}
+
+// SourceRemediationOption is a functional option for configuring a SourceRemediation calculator.
+type SourceRemediationOption func(*SourceRemediation)
+
+// WithVCenterUpgradeHours sets the effort, in hours, to upgrade a single vCenter server.
+func WithVCenterUpgradeHours(hours float64) SourceRemediationOption {
+	return func(s *SourceRemediation) {
+		s.vcenterUpgradeHours = hours
+	}
+}
+
+// WithESXiHostUpgradeHours sets the effort, in hours, to evacuate, upgrade and reboot a single ESXi host.
+func WithESXiHostUpgradeHours(hours float64) SourceRemediationOption {
+	return func(s *SourceRemediation) {
+		s.esxiHostUpgradeHours = hours
+	}
+}
+
+// WithCBTEnablementMins sets the effort, in minutes, to enable CBT on a VM, including the snapshot cycle applying it.
+func WithCBTEnablementMins(mins float64) SourceRemediationOption {
+	return func(s *SourceRemediation) {
+		s.cbtEnablementMins = mins
+	}
+}
+
+// WithToolsUpgradeMins sets the effort, in minutes, to upgrade VMware Tools in a VM.
+func WithToolsUpgradeMins(mins float64) SourceRemediationOption {
+	return func(s *SourceRemediation) {
+		s.toolsUpgradeMins = mins
+	}
+}
+
+// WithRemediationEngineerCount sets the number of engineers remediating the source environment in parallel.
+func WithRemediationEngineerCount(count int) SourceRemediationOption {
+	return func(s *SourceRemediation) {
+		s.engineerCount = count
+	}
+}
+
+// NewSourceRemediation creates a SourceRemediation calculator with default settings that can be overridden by options.
+func NewSourceRemediation(opts ...SourceRemediationOption) *SourceRemediation {
+	res := SourceRemediation{
+		vcenterUpgradeHours:  DefaultVCenterUpgradeHours,
+		esxiHostUpgradeHours: DefaultESXiHostUpgradeHours,
+		cbtEnablementMins:    DefaultCBTEnablementMins,
+		toolsUpgradeMins:     DefaultToolsUpgradeMins,
+		engineerCount:        DefaultEngineerCount,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("source_remediation", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []SourceRemediationOption
+		s.float("vcenter_upgrade_hours", func(v float64) { opts = append(opts, WithVCenterUpgradeHours(v)) })
+		s.float("esxi_host_upgrade_hours", func(v float64) { opts = append(opts, WithESXiHostUpgradeHours(v)) })
+		s.float("cbt_enablement_mins", func(v float64) { opts = append(opts, WithCBTEnablementMins(v)) })
+		s.float("tools_upgrade_mins", func(v float64) { opts = append(opts, WithToolsUpgradeMins(v)) })
+		s.int("engineer_count", func(v int) { opts = append(opts, WithRemediationEngineerCount(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewSourceRemediation(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *SourceRemediation) Name() string { return "Source Remediation" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// All counts are optional: a missing count means there is nothing of that kind to remediate.
+func (c *SourceRemediation) Keys() []string {
+	return []string{}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *SourceRemediation) OptionalKeys() []string {
+	return []string{ParamCBTEnablements, ParamESXiHostUpgrades, ParamRemediationEngineers, ParamToolsUpgrades, ParamVCenterUpgrades}
+}
+
+// Calculate estimates the remediation effort as vCenter upgrades * vCenter hours plus
+// (hosts * host hours + CBT VMs * CBT minutes + Tools VMs * Tools minutes) / engineers.
+func (c *SourceRemediation) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	counts := make(map[string]int, 4)
+	for _, key := range []string{ParamVCenterUpgrades, ParamESXiHostUpgrades, ParamCBTEnablements, ParamToolsUpgrades} {
+		p, exists := params[key]
+		if !exists {
+			continue
+		}
+		count, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if count < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", key)
+		}
+		counts[key] = count
+	}
+
+	engineerCount := c.engineerCount
+	if engParam, exists := params[ParamRemediationEngineers]; exists {
+		var err error
+		engineerCount, err = getInt(engParam)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if engineerCount <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("engineers must be > 0")
+	}
+
+	serialHours := float64(counts[ParamVCenterUpgrades]) * c.vcenterUpgradeHours
+	sharedHours := float64(counts[ParamESXiHostUpgrades])*c.esxiHostUpgradeHours +
+		(float64(counts[ParamCBTEnablements])*c.cbtEnablementMins+float64(counts[ParamToolsUpgrades])*c.toolsUpgradeMins)/60
+	realTimeHours := serialHours + sharedHours/float64(engineerCount)
+
+	duration, err := estimation.FromHours(realTimeHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Reason: fmt.Sprintf("%d vCenter upgrades @ %.1f h + (%d ESXi hosts @ %.1f h + %d CBT enablements @ %.1f mins + %d VMware Tools upgrades @ %.1f mins) / %d engineers",
+			counts[ParamVCenterUpgrades], c.vcenterUpgradeHours,
+			counts[ParamESXiHostUpgrades], c.esxiHostUpgradeHours,
+			counts[ParamCBTEnablements], c.cbtEnablementMins,
+			counts[ParamToolsUpgrades], c.toolsUpgradeMins, engineerCount),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = serial_hours + shared_hours / remediation_engineers, serial_hours = vcenter_upgrades * vcenter_upgrade_hours, " +
+				"shared_hours = esxi_host_upgrades * esxi_host_upgrade_hours + (cbt_enablements * cbt_enablement_mins + tools_upgrades * tools_upgrade_mins) / 60",
+			Inputs: []estimation.Quantity{
+				input(params, ParamVCenterUpgrades, float64(counts[ParamVCenterUpgrades]), estimation.UnitCount),
+				quantity("vcenter_upgrade_hours", c.vcenterUpgradeHours, estimation.UnitHours),
+				input(params, ParamESXiHostUpgrades, float64(counts[ParamESXiHostUpgrades]), estimation.UnitCount),
+				quantity("esxi_host_upgrade_hours", c.esxiHostUpgradeHours, estimation.UnitHours),
+				input(params, ParamCBTEnablements, float64(counts[ParamCBTEnablements]), estimation.UnitCount),
+				quantity("cbt_enablement_mins", c.cbtEnablementMins, estimation.UnitMinutes),
+				input(params, ParamToolsUpgrades, float64(counts[ParamToolsUpgrades]), estimation.UnitCount),
+				quantity("tools_upgrade_mins", c.toolsUpgradeMins, estimation.UnitMinutes),
+				input(params, ParamRemediationEngineers, float64(engineerCount), estimation.UnitCount),
+			},
+			Intermediates: []estimation.Quantity{
+				quantity("serial_hours", serialHours, estimation.UnitHours),
+				quantity("shared_hours", sharedHours, estimation.UnitHours),
+			},
+			Assumptions: []string{"vCenter upgrades run one at a time, whatever the number of engineers"},
+		},
+	}, nil
+}
@@ -0,0 +1,112 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestSourceRemediation_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewSourceRemediation()
+
+	params := map[string]estimation.Param{
+		ParamVCenterUpgrades:  {Key: ParamVCenterUpgrades, Value: 1},
+		ParamESXiHostUpgrades: {Key: ParamESXiHostUpgrades, Value: 20},
+		ParamCBTEnablements:   {Key: ParamCBTEnablements, Value: 200},
+		ParamToolsUpgrades:    {Key: ParamToolsUpgrades, Value: 100},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 16 h + (20 hosts * 2 h + 200 VMs * 15 mins + 100 VMs * 30 mins) / 10 engineers = 16 h + 14 h
+	if result.Duration != 30*time.Hour {
+		t.Errorf("expected duration 30h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "200 CBT enablements") {
+		t.Errorf("expected reason to mention CBT enablements, got %q", result.Reason)
+	}
+}
+
+func TestSourceRemediation_Calculate_NothingToRemediate(t *testing.T) {
+	t.Parallel()
+	result, err := NewSourceRemediation().Calculate(map[string]estimation.Param{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Duration != 0 {
+		t.Errorf("expected no remediation effort, got %v", result.Duration)
+	}
+}
+
+func TestSourceRemediation_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewSourceRemediation(
+		WithVCenterUpgradeHours(8),
+		WithESXiHostUpgradeHours(1),
+		WithCBTEnablementMins(0),
+		WithToolsUpgradeMins(60),
+		WithRemediationEngineerCount(1),
+	)
+
+	params := map[string]estimation.Param{
+		ParamESXiHostUpgrades:     {Key: ParamESXiHostUpgrades, Value: 4},
+		ParamCBTEnablements:       {Key: ParamCBTEnablements, Value: 50},
+		ParamToolsUpgrades:        {Key: ParamToolsUpgrades, Value: 4},
+		ParamRemediationEngineers: {Key: ParamRemediationEngineers, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// (4 hosts * 1 h + 4 VMs * 60 mins) / 2 engineers = 4 h
+	if result.Duration != 4*time.Hour {
+		t.Errorf("expected duration 4h, got %v", result.Duration)
+	}
+}
+
+func TestSourceRemediation_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name: "negative esxi_host_upgrades",
+			params: map[string]estimation.Param{
+				ParamESXiHostUpgrades: {Key: ParamESXiHostUpgrades, Value: -1},
+			},
+		},
+		{
+			name: "non-numeric cbt_enablements",
+			params: map[string]estimation.Param{
+				ParamCBTEnablements: {Key: ParamCBTEnablements, Value: "many"},
+			},
+		},
+		{
+			name: "zero engineers",
+			params: map[string]estimation.Param{
+				ParamToolsUpgrades:        {Key: ParamToolsUpgrades, Value: 1},
+				ParamRemediationEngineers: {Key: ParamRemediationEngineers, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewSourceRemediation().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package calculators
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
 )
@@ -12,6 +11,12 @@ const (
 	ParamTotalDiskGB = "total_disk_gb"
 	// ParamTransferRateMbps is the estimation.Param key for the sustained network transfer rate in Mbps.
 	ParamTransferRateMbps = "transfer_rate_mbps"
+	// ParamCompressionRatio is the estimation.Param key for the compression ratio on the transfer path,
+	// e.g. 2 for 2:1. The bytes transferred are the disk size divided by the ratio.
+	ParamCompressionRatio = "compression_ratio"
+	// ParamDedupRatio is the estimation.Param key for the deduplication ratio on the transfer path,
+	// e.g. 1.5 for 1.5:1. The bytes transferred are the disk size divided by the ratio.
+	ParamDedupRatio = "dedup_ratio"
 	// DefaultTransferRateMbps is the default transfer rate in Mbps (megabits per second).
 	// 620 Mbps is equivalent to 77.6 MB/s, which matches the original 110 min/500 GB baseline.
 	DefaultTransferRateMbps = 620.0
@@ -23,6 +28,9 @@ var _ estimation.Calculator = (*StorageMigration)(nil)
 // StorageMigration estimates the time required to transfer VM storage data from the source to the target cluster.
 type StorageMigration struct {
 	transferRateMbps float64
+	compressionRatio float64
+	dedupRatio       float64
+	link             LinkModel
 }
 
 // StorageMigrationOption is a functional option for configuring a StorageMigration calculator.
@@ -38,11 +46,34 @@ func WithTransferRateMbps(mbps float64) StorageMigrationOption {
 	}
 }
 
+// WithCompressionRatio sets the expected compression ratio on the transfer path, e.g. 2 for 2:1.
+// Values below 1 are ignored and no compression is assumed.
+func WithCompressionRatio(ratio float64) StorageMigrationOption {
+	return func(s *StorageMigration) {
+		if ratio >= 1 {
+			s.compressionRatio = ratio
+		}
+	}
+}
+
+// WithDedupRatio sets the expected deduplication ratio on the transfer path, e.g. 1.5 for 1.5:1.
+// Values below 1 are ignored and no deduplication is assumed.
+func WithDedupRatio(ratio float64) StorageMigrationOption {
+	return func(s *StorageMigration) {
+		if ratio >= 1 {
+			s.dedupRatio = ratio
+		}
+	}
+}
+
 // NewStorageMigration creates a StorageMigration calculator with default settings.
 // Optional StorageMigrationOption values can be supplied to override the defaults.
 func NewStorageMigration(opts ...StorageMigrationOption) *StorageMigration {
 	res := StorageMigration{
 		transferRateMbps: DefaultTransferRateMbps,
+		compressionRatio: 1,
+		dedupRatio:       1,
+		link:             LinkModel{WindowKB: DefaultTCPWindowKB, Streams: DefaultParallelStreams},
 	}
 
 	for _, opt := range opts {
@@ -52,20 +83,48 @@ func NewStorageMigration(opts ...StorageMigrationOption) *StorageMigration {
 	return &res
 }
 
+func init() {
+	DefaultRegistry.Register("storage_migration", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []StorageMigrationOption
+		s.float("transfer_rate_mbps", func(v float64) { opts = append(opts, WithTransferRateMbps(v)) })
+		s.float("compression_ratio", func(v float64) { opts = append(opts, WithCompressionRatio(v)) })
+		s.float("dedup_ratio", func(v float64) { opts = append(opts, WithDedupRatio(v)) })
+		s.float("rtt_ms", func(v float64) { opts = append(opts, WithLinkModel(v, 0, 0)) })
+		s.float("tcp_window_kb", func(v float64) { opts = append(opts, WithLinkModel(0, v, 0)) })
+		s.int("parallel_streams", func(v int) { opts = append(opts, WithLinkModel(0, 0, v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewStorageMigration(opts...), nil
+	})
+}
+
 // Name returns the human-readable name of this calculator.
 func (c *StorageMigration) Name() string {
 	return "Storage Migration"
 }
 
 // Keys returns the list of parameter keys required by this calculator.
-// transfer_rate_mbps is optional and falls back to the struct default.
+// transfer_rate_mbps, compression_ratio, dedup_ratio, rtt_ms, tcp_window_kb and parallel_streams are optional
+// and fall back to the struct defaults.
 func (c *StorageMigration) Keys() []string {
 	return []string{ParamTotalDiskGB}
 }
 
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *StorageMigration) OptionalKeys() []string {
+	return []string{ParamCompressionRatio, ParamDedupRatio, ParamEgressCostPerGB, ParamParallelStreams, ParamRTTMs, ParamTCPWindowKB, ParamTempStorageCostPerGBDay, ParamTransferRateMbps}
+}
+
 // Calculate estimates the storage migration duration based on total disk size and network transfer rate.
-// Formula: (totalDiskGB * 1024) / (transferRateMbps / 8) / 60
-// transfer_rate_mbps is optional and falls back to the struct field default.
+// Formula: (totalDiskGB / compressionRatio / dedupRatio * 1024) / (transferRateMbps / 8) / 60
+// transfer_rate_mbps, compression_ratio, dedup_ratio, rtt_ms, tcp_window_kb and parallel_streams are optional
+// and fall back to the struct field defaults. With a round-trip time, the transfer rate is capped by the
+// throughput of the TCP streams, see LinkModel.
+// The reason states the ratios applied and the cap, so optimistic assumptions show in the breakdown.
+// egress_cost_per_gb and temp_storage_cost_per_gb_day price the bytes transferred and the disks staged
+// for the duration of the transfer.
 func (c *StorageMigration) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
 	diskParam, ok := params[ParamTotalDiskGB]
 	if !ok {
@@ -81,24 +140,102 @@ func (c *StorageMigration) Calculate(params map[string]estimation.Param) (estima
 		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamTotalDiskGB)
 	}
 
-	transferRateMbps := c.transferRateMbps
-	if rateParam, exists := params[ParamTransferRateMbps]; exists {
-		paramRate, err := getFloat(rateParam)
-		if err != nil {
-			return estimation.Estimation{}, err
-		}
-		if paramRate > 0 {
-			transferRateMbps = paramRate
-		}
+	var notes []estimation.Annotation
+	transferRateMbps, err := positiveParam(params, ParamTransferRateMbps, c.transferRateMbps, &notes)
+	if err != nil {
+		return estimation.Estimation{}, err
 	}
 
+	link, err := linkModelParam(params, c.link)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	rawRateMbps := transferRateMbps
+	transferRateMbps = link.Cap(rawRateMbps)
+
+	compressionRatio, err := ratioParam(params, ParamCompressionRatio, c.compressionRatio)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	dedupRatio, err := ratioParam(params, ParamDedupRatio, c.dedupRatio)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	effectiveGB := totalGB / compressionRatio / dedupRatio
 	transferRateMBps := transferRateMbps / 8
-	totalMinutes := (totalGB * 1024) / transferRateMBps / 60
+	totalMinutes := (effectiveGB * 1024) / transferRateMBps / 60
 	minsPer500GB := (500.0 * 1024.0) / transferRateMBps / 60.0
-	duration := time.Duration(totalMinutes * float64(time.Minute))
+	duration, err := estimation.FromMinutes(totalMinutes)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	reason := fmt.Sprintf("%.2f GB at %.0f Mbps (%.0f min/500GB)", totalGB, transferRateMbps, minsPer500GB)
+	if compressionRatio != 1 || dedupRatio != 1 {
+		reason = fmt.Sprintf("%.2f GB transferred as %.2f GB (compression %.2f:1, dedup %.2f:1) at %.0f Mbps (%.0f min/500GB)",
+			totalGB, effectiveGB, compressionRatio, dedupRatio, transferRateMbps, minsPer500GB)
+	}
+	explanation := &estimation.Explanation{
+		Formula: "duration = effective_gb * 1024 / (effective_rate_mbps / 8) / 60 minutes, " +
+			"effective_gb = total_disk_gb / compression_ratio / dedup_ratio",
+		Inputs: []estimation.Quantity{
+			input(params, ParamTotalDiskGB, totalGB, estimation.UnitGB),
+			input(params, ParamTransferRateMbps, rawRateMbps, estimation.UnitMbps),
+			input(params, ParamCompressionRatio, compressionRatio, estimation.UnitRatio),
+			input(params, ParamDedupRatio, dedupRatio, estimation.UnitRatio),
+		},
+		Intermediates: []estimation.Quantity{
+			quantity("effective_gb", effectiveGB, estimation.UnitGB),
+			quantity("effective_rate_mbps", transferRateMbps, estimation.UnitMbps),
+			quantity("minutes_per_500_gb", minsPer500GB, estimation.UnitMinutes),
+		},
+	}
+	if transferRateMbps < rawRateMbps {
+		reason += fmt.Sprintf(", capped from %.0f Mbps by %.0f ms RTT with %d stream(s) of %.0f KB window",
+			rawRateMbps, link.RTTMs, max(link.Streams, 1), link.WindowKB)
+		explanation.Formula += ", effective_rate_mbps = min(transfer_rate_mbps, throughput of parallel_streams TCP streams of tcp_window_kb over rtt_ms)"
+		explanation.Inputs = append(explanation.Inputs,
+			input(params, ParamRTTMs, link.RTTMs, estimation.UnitMilliseconds),
+			input(params, ParamTCPWindowKB, link.WindowKB, estimation.UnitKB),
+			input(params, ParamParallelStreams, float64(max(link.Streams, 1)), estimation.UnitCount),
+		)
+		explanation.Assumptions = append(explanation.Assumptions, "the transfer rate is capped by the throughput of the TCP streams")
+		// a supplied rate is capped, not one ignored in favor of the default
+		if p, ok := params[ParamTransferRateMbps]; ok && len(notes) == 0 {
+			notes = append(notes, estimation.Capped(ParamTransferRateMbps, p.Value, transferRateMbps, "the throughput of the TCP streams"))
+		}
+	} else {
+		explanation.Formula += ", effective_rate_mbps = transfer_rate_mbps"
+	}
+
+	// the disks are staged on the target until the transfer completes
+	cost, err := transferCost(params, effectiveGB, totalGB, duration)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
 
 	return estimation.Estimation{
-		Duration: duration,
-		Reason:   fmt.Sprintf("%.2f GB at %.0f Mbps (%.0f min/500GB)", totalGB, transferRateMbps, minsPer500GB),
+		Duration:    duration,
+		Reason:      reason,
+		Explanation: explanation,
+		Cost:        cost,
+		Annotations: notes,
 	}, nil
 }
+
+// ratioParam returns the reduction ratio of the param, or the default when the param is not set.
+func ratioParam(params map[string]estimation.Param, key string, def float64) (float64, error) {
+	p, ok := params[key]
+	if !ok {
+		return def, nil
+	}
+	ratio, err := getFloat(p)
+	if err != nil {
+		return 0, err
+	}
+	if ratio < 1 {
+		return 0, fmt.Errorf("%s must be at least 1", key)
+	}
+	return ratio, nil
+}
@@ -92,6 +92,88 @@ func TestStorageMigration_Calculate_ZeroGB(t *testing.T) {
 	}
 }
 
+func TestStorageMigration_Calculate_WithReductionRatios(t *testing.T) {
+	t.Parallel()
+	calc := NewStorageMigration(WithCompressionRatio(2), WithDedupRatio(0.5))
+
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 1000.0},
+		ParamDedupRatio:  {Key: ParamDedupRatio, Value: 1.25},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 1000 GB / 2 (compression) / 1.25 (dedup param overrides the ignored option) = 400 GB transferred
+	expectedMins := (400.0 * 1024.0) / (DefaultTransferRateMbps / 8) / 60.0
+	expectedDuration := time.Duration(expectedMins * float64(time.Minute))
+	if result.Duration != expectedDuration {
+		t.Errorf("expected duration %v, got %v", expectedDuration, result.Duration)
+	}
+	const expectedReason = "1000.00 GB transferred as 400.00 GB (compression 2.00:1, dedup 1.25:1) at 620 Mbps (110 min/500GB)"
+	if result.Reason != expectedReason {
+		t.Errorf("expected reason %q, got %q", expectedReason, result.Reason)
+	}
+
+	x := result.Explanation
+	if x == nil {
+		t.Fatal("expected an explanation")
+	}
+	if compression, _ := x.Input(ParamCompressionRatio); compression.Value != 2 || !compression.Default {
+		t.Errorf("expected the compression ratio of the calculator as input, got %+v", compression)
+	}
+	if dedup, _ := x.Input(ParamDedupRatio); dedup.Value != 1.25 || dedup.Default {
+		t.Errorf("expected the dedup ratio of the param as input, got %+v", dedup)
+	}
+	if effective, _ := x.Intermediate("effective_gb"); effective.Value != 400 || effective.Unit != estimation.UnitGB {
+		t.Errorf("expected 400 GB transferred, got %+v", effective)
+	}
+}
+
+func TestStorageMigration_Calculate_Annotations(t *testing.T) {
+	t.Parallel()
+	calc := NewStorageMigration()
+
+	result, err := calc.Calculate(map[string]estimation.Param{
+		ParamTotalDiskGB:      {Key: ParamTotalDiskGB, Value: 100.0},
+		ParamTransferRateMbps: {Key: ParamTransferRateMbps, Value: -50.0},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(result.Annotations) != 1 || result.Annotations[0].Kind != estimation.AnnotationIgnored ||
+		*result.Annotations[0].Applied != DefaultTransferRateMbps {
+		t.Errorf("expected the negative rate ignored for the default, got %+v", result.Annotations)
+	}
+
+	result, err = calc.Calculate(map[string]estimation.Param{
+		ParamTotalDiskGB:      {Key: ParamTotalDiskGB, Value: 100.0},
+		ParamTransferRateMbps: {Key: ParamTransferRateMbps, Value: 1000.0},
+		ParamRTTMs:            {Key: ParamRTTMs, Value: 100.0},
+		ParamTCPWindowKB:      {Key: ParamTCPWindowKB, Value: 1024.0},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(result.Annotations) != 1 || result.Annotations[0].Kind != estimation.AnnotationCapped ||
+		*result.Annotations[0].Applied != 83.89 {
+		t.Errorf("expected the rate capped to 83.89 Mbps, got %+v", result.Annotations)
+	}
+
+	result, err = calc.Calculate(map[string]estimation.Param{
+		ParamTotalDiskGB:      {Key: ParamTotalDiskGB, Value: 100.0},
+		ParamTransferRateMbps: {Key: ParamTransferRateMbps, Value: 1000.0},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(result.Annotations) != 0 {
+		t.Errorf("expected no annotation, got %+v", result.Annotations)
+	}
+}
+
 func TestStorageMigration_Calculate_ErrorCases(t *testing.T) {
 	t.Parallel()
 	cases := []struct {
@@ -114,6 +196,27 @@ func TestStorageMigration_Calculate_ErrorCases(t *testing.T) {
 				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: -100.0},
 			},
 		},
+		{
+			name: "compression ratio below 1",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB:      {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamCompressionRatio: {Key: ParamCompressionRatio, Value: 0.5},
+			},
+		},
+		{
+			name: "invalid dedup ratio type",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamDedupRatio:  {Key: ParamDedupRatio, Value: "high"},
+			},
+		},
+		{
+			name: "duration overflowing time.Duration",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB:      {Key: ParamTotalDiskGB, Value: 1e12},
+				ParamTransferRateMbps: {Key: ParamTransferRateMbps, Value: 0.001},
+			},
+		},
 	}
 
 	for _, tc := range cases {
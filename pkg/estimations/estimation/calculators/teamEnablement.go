@@ -0,0 +1,255 @@
+package calculators
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamOpsTeamSize is the estimation.Param key for the number of people of the operations team to train.
+	ParamOpsTeamSize = "ops_team_size"
+	// ParamCourseDaysPerPerson is the estimation.Param key for the working days of OpenShift/KubeVirt courses each person attends.
+	ParamCourseDaysPerPerson = "course_days_per_person"
+	// ParamTrainingSeats is the estimation.Param key for the number of people a course session takes at once.
+	ParamTrainingSeats = "training_seats"
+	// ParamLabSetupHours is the estimation.Param key for the effort, in hours, to set up the training lab.
+	ParamLabSetupHours = "lab_setup_hours"
+	// ParamWaveCount is the estimation.Param key for the number of migration waves.
+	ParamWaveCount = "wave_count"
+	// ParamShadowingSessionsPerWave is the estimation.Param key for the number of sessions the operations team
+	// shadows the migration team during each wave.
+	ParamShadowingSessionsPerWave = "shadowing_sessions_per_wave"
+	// ParamShadowingHoursPerSession is the estimation.Param key for the length, in hours, of a shadowing session.
+	ParamShadowingHoursPerSession = "shadowing_hours_per_session"
+
+	DefaultCourseDaysPerPerson      = 5.0
+	DefaultTrainingSeats            = 12
+	DefaultLabSetupHours            = 16.0
+	DefaultShadowingSessionsPerWave = 2
+	DefaultShadowingHoursPerSession = 4.0
+)
+
+// Compile-time assertion that TeamEnablement implements the Calculator interface.
+var _ estimation.Calculator = (*TeamEnablement)(nil)
+
+// TeamEnablement estimates the training of the operations team on OpenShift/KubeVirt: setting up a
+// lab, running the courses in as many sessions as the seats require and shadowing the migration
+// team during the waves. The team attends a session together, so the duration does not grow with
+// the team but with the number of sessions.
+type TeamEnablement struct {
+	courseDaysPerPerson      float64
+	trainingSeats            int
+	labSetupHours            float64
+	shadowingSessionsPerWave int
+	shadowingHoursPerSession float64
+}
+
+// TeamEnablementOption is a functional option for configuring a TeamEnablement calculator.
+type TeamEnablementOption func(*TeamEnablement)
+
+// WithCourseDaysPerPerson sets the working days of courses each person attends.
+func WithCourseDaysPerPerson(days float64) TeamEnablementOption {
+	return func(t *TeamEnablement) {
+		t.courseDaysPerPerson = days
+	}
+}
+
+// WithTrainingSeats sets the number of people a course session takes at once. Non-positive values are ignored.
+func WithTrainingSeats(seats int) TeamEnablementOption {
+	return func(t *TeamEnablement) {
+		if seats > 0 {
+			t.trainingSeats = seats
+		}
+	}
+}
+
+// WithLabSetupHours sets the effort, in hours, to set up the training lab.
+func WithLabSetupHours(hours float64) TeamEnablementOption {
+	return func(t *TeamEnablement) {
+		t.labSetupHours = hours
+	}
+}
+
+// WithShadowingSessionsPerWave sets the number of shadowing sessions during each wave.
+func WithShadowingSessionsPerWave(sessions int) TeamEnablementOption {
+	return func(t *TeamEnablement) {
+		t.shadowingSessionsPerWave = sessions
+	}
+}
+
+// WithShadowingHoursPerSession sets the length, in hours, of a shadowing session.
+func WithShadowingHoursPerSession(hours float64) TeamEnablementOption {
+	return func(t *TeamEnablement) {
+		t.shadowingHoursPerSession = hours
+	}
+}
+
+// NewTeamEnablement creates a TeamEnablement calculator with default settings that can be overridden by options.
+func NewTeamEnablement(opts ...TeamEnablementOption) *TeamEnablement {
+	res := TeamEnablement{
+		courseDaysPerPerson:      DefaultCourseDaysPerPerson,
+		trainingSeats:            DefaultTrainingSeats,
+		labSetupHours:            DefaultLabSetupHours,
+		shadowingSessionsPerWave: DefaultShadowingSessionsPerWave,
+		shadowingHoursPerSession: DefaultShadowingHoursPerSession,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("team_enablement", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []TeamEnablementOption
+		s.float("course_days_per_person", func(v float64) { opts = append(opts, WithCourseDaysPerPerson(v)) })
+		s.int("training_seats", func(v int) { opts = append(opts, WithTrainingSeats(v)) })
+		s.float("lab_setup_hours", func(v float64) { opts = append(opts, WithLabSetupHours(v)) })
+		s.int("shadowing_sessions_per_wave", func(v int) { opts = append(opts, WithShadowingSessionsPerWave(v)) })
+		s.float("shadowing_hours_per_session", func(v float64) { opts = append(opts, WithShadowingHoursPerSession(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewTeamEnablement(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *TeamEnablement) Name() string { return "Team Enablement" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// course_days_per_person, training_seats, lab_setup_hours, wave_count, shadowing_sessions_per_wave and
+// shadowing_hours_per_session are optional.
+func (c *TeamEnablement) Keys() []string {
+	return []string{ParamOpsTeamSize}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *TeamEnablement) OptionalKeys() []string {
+	return []string{ParamCourseDaysPerPerson, ParamLabSetupHours, ParamShadowingHoursPerSession, ParamShadowingSessionsPerWave, ParamTrainingSeats, ParamWaveCount}
+}
+
+// Calculate estimates the enablement as lab setup hours + ceil(team / seats) * course days of work
+// hours + waves * shadowing sessions * session hours.
+func (c *TeamEnablement) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	teamParam, ok := params[ParamOpsTeamSize]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamOpsTeamSize)
+	}
+	teamSize, err := getInt(teamParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if teamSize < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamOpsTeamSize)
+	}
+
+	courseDays := c.courseDaysPerPerson
+	if p, exists := params[ParamCourseDaysPerPerson]; exists {
+		if courseDays, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if courseDays < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamCourseDaysPerPerson)
+	}
+
+	seats := c.trainingSeats
+	if p, exists := params[ParamTrainingSeats]; exists {
+		if seats, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if seats <= 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be > 0", ParamTrainingSeats)
+	}
+
+	labHours := c.labSetupHours
+	if p, exists := params[ParamLabSetupHours]; exists {
+		if labHours, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if labHours < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamLabSetupHours)
+	}
+
+	waves := 0
+	if p, exists := params[ParamWaveCount]; exists {
+		if waves, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+		if waves < 0 {
+			return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamWaveCount)
+		}
+	}
+
+	sessionsPerWave := c.shadowingSessionsPerWave
+	if p, exists := params[ParamShadowingSessionsPerWave]; exists {
+		if sessionsPerWave, err = getInt(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if sessionsPerWave < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamShadowingSessionsPerWave)
+	}
+
+	sessionHours := c.shadowingHoursPerSession
+	if p, exists := params[ParamShadowingHoursPerSession]; exists {
+		if sessionHours, err = getFloat(p); err != nil {
+			return estimation.Estimation{}, err
+		}
+	}
+	if sessionHours < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamShadowingHoursPerSession)
+	}
+
+	inputs := []estimation.Quantity{
+		input(params, ParamOpsTeamSize, float64(teamSize), estimation.UnitCount),
+		input(params, ParamCourseDaysPerPerson, courseDays, estimation.UnitDays),
+		input(params, ParamTrainingSeats, float64(seats), estimation.UnitCount),
+		input(params, ParamLabSetupHours, labHours, estimation.UnitHours),
+		input(params, ParamWaveCount, float64(waves), estimation.UnitCount),
+		input(params, ParamShadowingSessionsPerWave, float64(sessionsPerWave), estimation.UnitCount),
+		input(params, ParamShadowingHoursPerSession, sessionHours, estimation.UnitHours),
+	}
+	if teamSize == 0 {
+		return estimation.Estimation{Duration: 0, Reason: "no operations team to train", Explanation: &estimation.Explanation{
+			Formula:     "duration = 0",
+			Inputs:      inputs,
+			Assumptions: []string{"no operations team to train"},
+		}}, nil
+	}
+
+	courseSessions := (teamSize + seats - 1) / seats
+	courseHours := float64(courseSessions) * courseDays * DefaultWorkHoursPerDay
+	shadowingHours := float64(waves*sessionsPerWave) * sessionHours
+	totalHours := labHours + courseHours + shadowingHours
+
+	duration, err := estimation.FromHours(totalHours)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	return estimation.Estimation{
+		Duration: duration,
+		Reason: fmt.Sprintf("%.1f h lab setup + %d people trained in %d sessions of %.1f days (%.1f h) + %d waves * %d shadowing sessions @ %.1f h",
+			labHours, teamSize, courseSessions, courseDays, courseHours, waves, sessionsPerWave, sessionHours),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = lab_setup_hours + course_hours + shadowing_hours, " +
+				"course_hours = ceil(ops_team_size / training_seats) * course_days_per_person * work_hours_per_day, " +
+				"shadowing_hours = wave_count * shadowing_sessions_per_wave * shadowing_hours_per_session",
+			Inputs: append(inputs, quantity("work_hours_per_day", DefaultWorkHoursPerDay, estimation.UnitHoursPerDay)),
+			Intermediates: []estimation.Quantity{
+				quantity("course_sessions", float64(courseSessions), estimation.UnitCount),
+				quantity("course_hours", courseHours, estimation.UnitHours),
+				quantity("shadowing_hours", shadowingHours, estimation.UnitHours),
+			},
+			Assumptions: []string{"the course sessions run one after the other"},
+		},
+	}, nil
+}
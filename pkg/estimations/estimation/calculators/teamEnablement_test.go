@@ -0,0 +1,127 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestTeamEnablement_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewTeamEnablement()
+
+	params := map[string]estimation.Param{
+		ParamOpsTeamSize: {Key: ParamOpsTeamSize, Value: 15},
+		ParamWaveCount:   {Key: ParamWaveCount, Value: 4},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 16 h lab + 2 sessions * 5 days * 8 h + 4 waves * 2 sessions * 4 h = 16 + 80 + 32 = 128 h
+	if result.Duration != 128*time.Hour {
+		t.Errorf("expected duration 128h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "15 people trained in 2 sessions") {
+		t.Errorf("expected reason to mention the course sessions, got %q", result.Reason)
+	}
+}
+
+func TestTeamEnablement_Calculate_ParamsOverrideOptions(t *testing.T) {
+	t.Parallel()
+	calc := NewTeamEnablement(
+		WithCourseDaysPerPerson(10),
+		WithTrainingSeats(2),
+		WithLabSetupHours(40),
+		WithShadowingSessionsPerWave(5),
+		WithShadowingHoursPerSession(8),
+	)
+
+	params := map[string]estimation.Param{
+		ParamOpsTeamSize:              {Key: ParamOpsTeamSize, Value: 6},
+		ParamCourseDaysPerPerson:      {Key: ParamCourseDaysPerPerson, Value: 3},
+		ParamTrainingSeats:            {Key: ParamTrainingSeats, Value: 6},
+		ParamLabSetupHours:            {Key: ParamLabSetupHours, Value: 0},
+		ParamShadowingSessionsPerWave: {Key: ParamShadowingSessionsPerWave, Value: 1},
+		ParamShadowingHoursPerSession: {Key: ParamShadowingHoursPerSession, Value: 2.5},
+		ParamWaveCount:                {Key: ParamWaveCount, Value: 2},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 1 session * 3 days * 8 h + 2 waves * 1 session * 2.5 h = 29 h
+	if result.Duration != 29*time.Hour {
+		t.Errorf("expected duration 29h, got %v", result.Duration)
+	}
+}
+
+func TestTeamEnablement_Calculate_NoTeam(t *testing.T) {
+	t.Parallel()
+	result, err := NewTeamEnablement().Calculate(map[string]estimation.Param{
+		ParamOpsTeamSize: {Key: ParamOpsTeamSize, Value: 0},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Duration != 0 {
+		t.Errorf("expected no duration without a team to train, got %v", result.Duration)
+	}
+}
+
+func TestTeamEnablement_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name:   "missing ops_team_size param",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "negative ops_team_size",
+			params: map[string]estimation.Param{
+				ParamOpsTeamSize: {Key: ParamOpsTeamSize, Value: -1},
+			},
+		},
+		{
+			name: "zero training seats",
+			params: map[string]estimation.Param{
+				ParamOpsTeamSize:   {Key: ParamOpsTeamSize, Value: 5},
+				ParamTrainingSeats: {Key: ParamTrainingSeats, Value: 0},
+			},
+		},
+		{
+			name: "negative wave_count",
+			params: map[string]estimation.Param{
+				ParamOpsTeamSize: {Key: ParamOpsTeamSize, Value: 5},
+				ParamWaveCount:   {Key: ParamWaveCount, Value: -2},
+			},
+		},
+		{
+			name: "invalid lab_setup_hours type",
+			params: map[string]estimation.Param{
+				ParamOpsTeamSize:   {Key: ParamOpsTeamSize, Value: 5},
+				ParamLabSetupHours: {Key: ParamLabSetupHours, Value: "two days"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewTeamEnablement().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package calculators
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
 )
@@ -31,3 +32,74 @@ func getFloat(p estimation.Param) (float64, error) {
 		return 0.0, fmt.Errorf("param %s is not a number (type: %T)", p.Key, p.Value)
 	}
 }
+
+// getCounts reads a param mapping names to non-negative counts, keyed in lower case. Counts of the
+// same name in another case are added up.
+func getCounts(p estimation.Param) (map[string]int, error) {
+	res := make(map[string]int)
+	add := func(name string, count int) error {
+		if count < 0 {
+			return fmt.Errorf("%s %s must be non-negative", p.Key, name)
+		}
+		res[strings.ToLower(name)] += count
+		return nil
+	}
+
+	switch v := p.Value.(type) {
+	case map[string]int:
+		for name, count := range v {
+			if err := add(name, count); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]float64:
+		for name, count := range v {
+			if err := add(name, int(count)); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for name, value := range v {
+			count, err := getInt(estimation.Param{Key: p.Key + "." + name, Value: value})
+			if err != nil {
+				return nil, err
+			}
+			if err := add(name, count); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("param %s is not a map of counts (type: %T)", p.Key, p.Value)
+	}
+	return res, nil
+}
+
+// input returns the Quantity of the input read from the param key, marked as the default of the
+// calculator when the param was not given.
+func input(params map[string]estimation.Param, key string, value float64, unit estimation.Unit) estimation.Quantity {
+	_, given := params[key]
+	return estimation.Quantity{Name: key, Value: value, Unit: unit, Default: !given}
+}
+
+// quantity returns an intermediate Quantity of an Explanation.
+func quantity(name string, value float64, unit estimation.Unit) estimation.Quantity {
+	return estimation.Quantity{Name: name, Value: value, Unit: unit}
+}
+
+// positiveParam returns the value of the param, or the default when the param is not set. A
+// non-positive value is ignored in favor of the default and annotated in notes.
+func positiveParam(params map[string]estimation.Param, key string, def float64, notes *[]estimation.Annotation) (float64, error) {
+	p, ok := params[key]
+	if !ok {
+		return def, nil
+	}
+	v, err := getFloat(p)
+	if err != nil {
+		return 0, err
+	}
+	if v <= 0 {
+		*notes = append(*notes, estimation.Ignored(key, p.Value, def, "must be positive"))
+		return def, nil
+	}
+	return v, nil
+}
@@ -0,0 +1,280 @@
+package calculators
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamChangeRatePercent is the estimation.Param key for the share of the disks rewritten per day while the
+	// VMs keep running during a warm migration.
+	ParamChangeRatePercent = "change_rate_percent"
+	// ParamDeltaPasses is the estimation.Param key for the number of incremental passes between the initial
+	// full copy and the cutover.
+	ParamDeltaPasses = "delta_passes"
+	// ParamPrecopyIntervalMinutes is the estimation.Param key for the minutes between two snapshots of the
+	// incremental passes.
+	ParamPrecopyIntervalMinutes = "precopy_interval_minutes"
+
+	DefaultChangeRatePercent      = 3.0
+	DefaultDeltaPasses            = 3
+	DefaultPrecopyIntervalMinutes = 60.0
+
+	minutesPerDay = 24 * 60
+	// deltaPassesShown is how many delta passes the reason lists before eliding the rest.
+	deltaPassesShown = 5
+)
+
+// Compile-time assertion that WarmMigrationDeltaSync implements the Calculator interface.
+var _ estimation.Calculator = (*WarmMigrationDeltaSync)(nil)
+
+// WarmMigrationDeltaSync estimates a warm migration: an initial full copy of the disks while the VMs
+// run, then incremental passes copying the blocks changed since the previous snapshot, as tracked by
+// CBT, and a final pass after the VMs are shut down for the cutover.
+// A pass starts at the next snapshot, no earlier than the precopy interval after the previous one,
+// and copies what changed since, so the passes shrink towards the cutover snapshot as long as the
+// disks change slower than they are copied. Only the final pass is downtime, see FinalSync.
+type WarmMigrationDeltaSync struct {
+	transferRateMbps       float64
+	changeRatePercent      float64
+	deltaPasses            int
+	precopyIntervalMinutes float64
+}
+
+// WarmMigrationDeltaSyncOption is a functional option for configuring a WarmMigrationDeltaSync calculator.
+type WarmMigrationDeltaSyncOption func(*WarmMigrationDeltaSync)
+
+// WithDeltaSyncTransferRateMbps sets the network transfer rate of the copies. Non-positive values are ignored.
+func WithDeltaSyncTransferRateMbps(mbps float64) WarmMigrationDeltaSyncOption {
+	return func(w *WarmMigrationDeltaSync) {
+		if mbps > 0 {
+			w.transferRateMbps = mbps
+		}
+	}
+}
+
+// WithChangeRatePercent sets the share of the disks rewritten per day.
+func WithChangeRatePercent(percent float64) WarmMigrationDeltaSyncOption {
+	return func(w *WarmMigrationDeltaSync) {
+		w.changeRatePercent = percent
+	}
+}
+
+// WithDeltaPasses sets the number of incremental passes before the cutover.
+func WithDeltaPasses(passes int) WarmMigrationDeltaSyncOption {
+	return func(w *WarmMigrationDeltaSync) {
+		w.deltaPasses = passes
+	}
+}
+
+// WithPrecopyIntervalMinutes sets the minutes between two snapshots. Non-positive values are ignored.
+func WithPrecopyIntervalMinutes(minutes float64) WarmMigrationDeltaSyncOption {
+	return func(w *WarmMigrationDeltaSync) {
+		if minutes > 0 {
+			w.precopyIntervalMinutes = minutes
+		}
+	}
+}
+
+// NewWarmMigrationDeltaSync creates a WarmMigrationDeltaSync calculator with default settings that can be overridden by options.
+func NewWarmMigrationDeltaSync(opts ...WarmMigrationDeltaSyncOption) *WarmMigrationDeltaSync {
+	res := WarmMigrationDeltaSync{
+		transferRateMbps:       DefaultTransferRateMbps,
+		changeRatePercent:      DefaultChangeRatePercent,
+		deltaPasses:            DefaultDeltaPasses,
+		precopyIntervalMinutes: DefaultPrecopyIntervalMinutes,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+func init() {
+	DefaultRegistry.Register("warm_migration_delta_sync", func(config Config) (estimation.Calculator, error) {
+		s := newSettings(config)
+		var opts []WarmMigrationDeltaSyncOption
+		s.float("transfer_rate_mbps", func(v float64) { opts = append(opts, WithDeltaSyncTransferRateMbps(v)) })
+		s.float("change_rate_percent", func(v float64) { opts = append(opts, WithChangeRatePercent(v)) })
+		s.int("delta_passes", func(v int) { opts = append(opts, WithDeltaPasses(v)) })
+		s.float("precopy_interval_minutes", func(v float64) { opts = append(opts, WithPrecopyIntervalMinutes(v)) })
+		if err := s.done(); err != nil {
+			return nil, err
+		}
+		return NewWarmMigrationDeltaSync(opts...), nil
+	})
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *WarmMigrationDeltaSync) Name() string { return "Warm Migration Delta Sync" }
+
+// Keys returns the list of parameter keys required by this calculator.
+// transfer_rate_mbps, change_rate_percent, delta_passes and precopy_interval_minutes are optional.
+func (c *WarmMigrationDeltaSync) Keys() []string {
+	return []string{ParamTotalDiskGB}
+}
+
+// OptionalKeys returns the optional parameter keys this calculator reads.
+func (c *WarmMigrationDeltaSync) OptionalKeys() []string {
+	return []string{ParamChangeRatePercent, ParamDeltaPasses, ParamPrecopyIntervalMinutes, ParamTransferRateMbps}
+}
+
+// Calculate estimates the warm migration from the start of the full copy to the end of the cutover
+// pass. Each incremental pass copies totalDiskGB * change_rate_percent for the time elapsed since
+// the previous snapshot at transfer_rate_mbps.
+func (c *WarmMigrationDeltaSync) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	s, err := c.sync(params)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+
+	passes := make([]string, 0, len(s.deltaGB))
+	for i, gb := range s.deltaGB {
+		if i == deltaPassesShown {
+			passes = append(passes, "...")
+			break
+		}
+		passes = append(passes, fmt.Sprintf("%.2f", gb))
+	}
+	intermediates := []estimation.Quantity{quantity("full_copy_hours", s.full.Hours(), estimation.UnitHours)}
+	for i, gb := range s.deltaGB {
+		intermediates = append(intermediates, quantity(fmt.Sprintf("delta_pass_%d_gb", i+1), gb, estimation.UnitGB))
+	}
+	intermediates = append(intermediates,
+		quantity("cutover_sync_gb", s.finalGB, estimation.UnitGB),
+		quantity("cutover_sync_minutes", s.final.Minutes(), estimation.UnitMinutes),
+	)
+
+	return estimation.Estimation{
+		Duration: s.total,
+		Reason: fmt.Sprintf("%.2f GB full copy at %.0f Mbps (%.1f h), %d delta passes at %.1f%%/day (%s GB), %.2f GB cutover sync (%.1f min downtime)",
+			s.totalGB, s.transferRateMbps, s.full.Hours(), len(s.deltaGB), s.changeRatePercent, strings.Join(passes, ", "), s.finalGB, s.final.Minutes()),
+		Explanation: &estimation.Explanation{
+			Formula: "duration = full copy + delta_passes incremental passes + cutover sync, each pass copying " +
+				"min(total_disk_gb, total_disk_gb * change_rate_percent / 100 * max(previous pass, precopy_interval_minutes) / 1440) " +
+				"at transfer_rate_mbps and starting at the next snapshot",
+			Inputs: []estimation.Quantity{
+				input(params, ParamTotalDiskGB, s.totalGB, estimation.UnitGB),
+				input(params, ParamTransferRateMbps, s.transferRateMbps, estimation.UnitMbps),
+				input(params, ParamChangeRatePercent, s.changeRatePercent, estimation.UnitPercentPerDay),
+				input(params, ParamDeltaPasses, float64(len(s.deltaGB)), estimation.UnitCount),
+				input(params, ParamPrecopyIntervalMinutes, s.intervalMinutes, estimation.UnitMinutes),
+			},
+			Intermediates: intermediates,
+			Assumptions:   []string{"the VMs are shut down for the cutover sync only"},
+		},
+		Annotations: s.notes,
+	}, nil
+}
+
+// FinalSync returns the duration of the cutover pass, copied while the VMs are shut down.
+func (c *WarmMigrationDeltaSync) FinalSync(params map[string]estimation.Param) (time.Duration, error) {
+	s, err := c.sync(params)
+	if err != nil {
+		return 0, err
+	}
+	return s.final, nil
+}
+
+// deltaSync is the breakdown of a warm migration into its passes.
+type deltaSync struct {
+	totalGB           float64
+	transferRateMbps  float64
+	changeRatePercent float64
+	intervalMinutes   float64
+	full              time.Duration
+	deltaGB           []float64
+	finalGB           float64
+	final             time.Duration
+	// total runs from the start of the full copy to the end of the cutover pass.
+	total time.Duration
+	notes []estimation.Annotation
+}
+
+func (c *WarmMigrationDeltaSync) sync(params map[string]estimation.Param) (deltaSync, error) {
+	diskParam, ok := params[ParamTotalDiskGB]
+	if !ok {
+		return deltaSync{}, fmt.Errorf("missing %s", ParamTotalDiskGB)
+	}
+	totalGB, err := getFloat(diskParam)
+	if err != nil {
+		return deltaSync{}, err
+	}
+	if totalGB < 0 {
+		return deltaSync{}, fmt.Errorf("%s must be non-negative", ParamTotalDiskGB)
+	}
+
+	var notes []estimation.Annotation
+	transferRateMbps, err := positiveParam(params, ParamTransferRateMbps, c.transferRateMbps, &notes)
+	if err != nil {
+		return deltaSync{}, err
+	}
+
+	changeRate := c.changeRatePercent
+	if p, exists := params[ParamChangeRatePercent]; exists {
+		if changeRate, err = getFloat(p); err != nil {
+			return deltaSync{}, err
+		}
+	}
+	if changeRate < 0 {
+		return deltaSync{}, fmt.Errorf("%s must be non-negative", ParamChangeRatePercent)
+	}
+
+	passes := c.deltaPasses
+	if p, exists := params[ParamDeltaPasses]; exists {
+		if passes, err = getInt(p); err != nil {
+			return deltaSync{}, err
+		}
+	}
+	if passes < 0 {
+		return deltaSync{}, fmt.Errorf("%s must be non-negative", ParamDeltaPasses)
+	}
+
+	intervalMinutes := c.precopyIntervalMinutes
+	if p, exists := params[ParamPrecopyIntervalMinutes]; exists {
+		if intervalMinutes, err = getFloat(p); err != nil {
+			return deltaSync{}, err
+		}
+	}
+	if intervalMinutes <= 0 {
+		return deltaSync{}, fmt.Errorf("%s must be positive", ParamPrecopyIntervalMinutes)
+	}
+
+	transferMinutes := func(gb float64) float64 {
+		return (gb * 1024) / (transferRateMbps / 8) / 60
+	}
+	// changed returns the GB rewritten while the previous pass ran, or until the next snapshot
+	changed := func(previousMinutes float64) float64 {
+		window := max(previousMinutes, intervalMinutes)
+		return min(totalGB, totalGB*changeRate/100*window/minutesPerDay)
+	}
+
+	s := deltaSync{totalGB: totalGB, transferRateMbps: transferRateMbps, changeRatePercent: changeRate, intervalMinutes: intervalMinutes, notes: notes}
+	previous := transferMinutes(totalGB)
+	if s.full, err = estimation.FromMinutes(previous); err != nil {
+		return deltaSync{}, err
+	}
+	totalMinutes := previous
+	for range passes {
+		gb := changed(previous)
+		s.deltaGB = append(s.deltaGB, gb)
+		// the pass starts at the next snapshot
+		totalMinutes += max(previous, intervalMinutes) - previous
+		previous = transferMinutes(gb)
+		totalMinutes += previous
+	}
+	s.finalGB = changed(previous)
+	finalMinutes := transferMinutes(s.finalGB)
+	if s.final, err = estimation.FromMinutes(finalMinutes); err != nil {
+		return deltaSync{}, err
+	}
+	if s.total, err = estimation.FromMinutes(totalMinutes + max(previous, intervalMinutes) - previous + finalMinutes); err != nil {
+		return deltaSync{}, err
+	}
+	return s, nil
+}
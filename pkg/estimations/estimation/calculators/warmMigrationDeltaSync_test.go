@@ -0,0 +1,130 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// 8192 Mbps copies 1 GB per second
+const oneGBPerSecondMbps = 8192.0
+
+func TestWarmMigrationDeltaSync_Calculate(t *testing.T) {
+	t.Parallel()
+	calc := NewWarmMigrationDeltaSync(WithDeltaSyncTransferRateMbps(oneGBPerSecondMbps))
+
+	// 1.44%/day of 6000 GB is 0.06 GB rewritten per minute
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB:       {Key: ParamTotalDiskGB, Value: 6000.0},
+		ParamChangeRatePercent: {Key: ParamChangeRatePercent, Value: 1.44},
+	}
+
+	result, err := calc.Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 100 min full copy, 6 GB changed meanwhile, then each pass copies the 3.6 GB of an hourly snapshot
+	// interval: 100 + 3 * 60 min of passes + 3.6 s cutover
+	want := 280*time.Minute + 3600*time.Millisecond
+	if diff := result.Duration - want; diff < -time.Millisecond || diff > time.Millisecond {
+		t.Errorf("expected duration %v, got %v", want, result.Duration)
+	}
+	if !strings.Contains(result.Reason, "3 delta passes at 1.4%/day (6.00, 3.60, 3.60 GB)") {
+		t.Errorf("expected reason to list the passes, got %q", result.Reason)
+	}
+
+	final, err := calc.FinalSync(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if diff := final - 3600*time.Millisecond; diff < -time.Millisecond || diff > time.Millisecond {
+		t.Errorf("expected a 3.6s cutover sync, got %v", final)
+	}
+}
+
+func TestWarmMigrationDeltaSync_DowntimeBelowColdCopy(t *testing.T) {
+	t.Parallel()
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 2000.0},
+	}
+
+	cold, err := NewStorageMigration().Calculate(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	final, err := NewWarmMigrationDeltaSync().FinalSync(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if final*100 > cold.Duration {
+		t.Errorf("expected the cutover sync to be a fraction of the cold copy, got %v against %v", final, cold.Duration)
+	}
+}
+
+func TestWarmMigrationDeltaSync_Calculate_NoPasses(t *testing.T) {
+	t.Parallel()
+	calc := NewWarmMigrationDeltaSync(WithDeltaSyncTransferRateMbps(oneGBPerSecondMbps), WithDeltaPasses(0))
+
+	params := map[string]estimation.Param{
+		ParamTotalDiskGB:            {Key: ParamTotalDiskGB, Value: 6000.0},
+		ParamChangeRatePercent:      {Key: ParamChangeRatePercent, Value: 1.44},
+		ParamPrecopyIntervalMinutes: {Key: ParamPrecopyIntervalMinutes, Value: 30},
+	}
+
+	// the cutover copies the 6 GB changed during the 100 min full copy
+	final, err := calc.FinalSync(params)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if diff := final - 6*time.Second; diff < -time.Millisecond || diff > time.Millisecond {
+		t.Errorf("expected a 6s cutover sync, got %v", final)
+	}
+}
+
+func TestWarmMigrationDeltaSync_Calculate_ErrorCases(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params map[string]estimation.Param
+	}{
+		{
+			name:   "missing total_disk_gb param",
+			params: map[string]estimation.Param{},
+		},
+		{
+			name: "negative change_rate_percent",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB:       {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamChangeRatePercent: {Key: ParamChangeRatePercent, Value: -1.0},
+			},
+		},
+		{
+			name: "negative delta_passes",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB: {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamDeltaPasses: {Key: ParamDeltaPasses, Value: -1},
+			},
+		},
+		{
+			name: "zero precopy interval",
+			params: map[string]estimation.Param{
+				ParamTotalDiskGB:            {Key: ParamTotalDiskGB, Value: 100.0},
+				ParamPrecopyIntervalMinutes: {Key: ParamPrecopyIntervalMinutes, Value: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewWarmMigrationDeltaSync().Calculate(tc.params)
+			if err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
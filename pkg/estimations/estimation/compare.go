@@ -0,0 +1,88 @@
+package estimation
+
+import (
+	"sort"
+	"time"
+)
+
+// Delta is how the estimation of a calculator differs from one scenario to the other.
+type Delta struct {
+	Calculator string
+	// A and B are the estimations of the scenarios, nil when the calculator gave none in the scenario.
+	A, B *Estimation
+	// Change is the duration of B minus the duration of A, a missing estimation counting as zero.
+	Change time.Duration
+}
+
+// PercentChange returns the change relative to the duration of A, zero when A took no time.
+func (d Delta) PercentChange() float64 {
+	if d.A == nil || d.A.Duration == 0 {
+		return 0
+	}
+	return float64(d.Change) / float64(d.A.Duration) * 100
+}
+
+// Comparison is the difference between the estimations of two scenarios, calculator by calculator.
+type Comparison struct {
+	// Deltas are in calculator name order.
+	Deltas         []Delta
+	TotalA, TotalB time.Duration
+	// Change is TotalB minus TotalA.
+	Change time.Duration
+}
+
+// PercentChange returns the change relative to TotalA, zero when A took no time.
+func (c Comparison) PercentChange() float64 {
+	if c.TotalA == 0 {
+		return 0
+	}
+	return float64(c.Change) / float64(c.TotalA) * 100
+}
+
+// Compare compares the results of the calculators run in two scenarios, e.g. with a 620 Mbps and a
+// 10 Gbps link. The totals are the sums of the durations.
+func Compare(a, b map[string]Estimation) (Comparison, error) {
+	names := make([]string, 0, len(a)+len(b))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var c Comparison
+	durationsA := make([]time.Duration, 0, len(a))
+	durationsB := make([]time.Duration, 0, len(b))
+	for _, name := range names {
+		d := Delta{Calculator: name}
+		if est, ok := a[name]; ok {
+			d.A = &est
+			d.Change -= est.Duration
+			durationsA = append(durationsA, est.Duration)
+		}
+		if est, ok := b[name]; ok {
+			d.B = &est
+			d.Change += est.Duration
+			durationsB = append(durationsB, est.Duration)
+		}
+		c.Deltas = append(c.Deltas, d)
+	}
+
+	var err error
+	if c.TotalA, err = Sum(durationsA...); err != nil {
+		return Comparison{}, err
+	}
+	if c.TotalB, err = Sum(durationsB...); err != nil {
+		return Comparison{}, err
+	}
+	c.Change = c.TotalB - c.TotalA
+	return c, nil
+}
+
+// Compare runs the calculators with the params of two scenarios and compares their results.
+func (e *Engine) Compare(a, b []Param) (Comparison, error) {
+	return Compare(e.Run(a), e.Run(b))
+}
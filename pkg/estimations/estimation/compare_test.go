@@ -0,0 +1,58 @@
+package estimation
+
+import (
+	"testing"
+	"time"
+)
+
+// perEngineer takes 10 hours of work shared by the engineers of the params.
+type perEngineer struct{}
+
+func (perEngineer) Name() string   { return "Checks" }
+func (perEngineer) Keys() []string { return []string{"engineers"} }
+func (perEngineer) Calculate(params map[string]Param) (Estimation, error) {
+	engineers := params["engineers"].Value.(float64)
+	return Estimation{Duration: time.Duration(10 / engineers * float64(time.Hour))}, nil
+}
+
+func TestEngine_Compare(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(perEngineer{})
+	e.Register(&mockCalculator{name: "Cutover", result: Estimation{Duration: time.Hour}})
+
+	c, err := e.Compare([]Param{{Key: "engineers", Value: 5.0}}, []Param{{Key: "engineers", Value: 10.0}})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if c.TotalA != 3*time.Hour || c.TotalB != 2*time.Hour || c.Change != -time.Hour {
+		t.Errorf("expected 3h to 2h, got %v to %v (%v)", c.TotalA, c.TotalB, c.Change)
+	}
+	if len(c.Deltas) != 2 || c.Deltas[0].Calculator != "Checks" || c.Deltas[1].Calculator != "Cutover" {
+		t.Fatalf("expected the deltas of Checks and Cutover, got %+v", c.Deltas)
+	}
+	if checks := c.Deltas[0]; checks.Change != -time.Hour || checks.PercentChange() != -50 {
+		t.Errorf("expected Checks 1h (50%%) shorter, got %v (%v%%)", checks.Change, checks.PercentChange())
+	}
+	if cutover := c.Deltas[1]; cutover.Change != 0 {
+		t.Errorf("expected Cutover unchanged, got %v", cutover.Change)
+	}
+}
+
+func TestCompare_MissingCalculator(t *testing.T) {
+	t.Parallel()
+	c, err := Compare(
+		map[string]Estimation{"Transfer": {Duration: 4 * time.Hour}},
+		map[string]Estimation{"Transfer": {Duration: 4 * time.Hour}, "Contingency": {Duration: time.Hour}},
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	buffer := c.Deltas[0]
+	if buffer.Calculator != "Contingency" || buffer.A != nil || buffer.B == nil || buffer.Change != time.Hour {
+		t.Errorf("expected the contingency added in B only, got %+v", buffer)
+	}
+	if buffer.PercentChange() != 0 {
+		t.Errorf("expected no percent change from nothing, got %v", buffer.PercentChange())
+	}
+}
@@ -0,0 +1,80 @@
+package estimation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Cost is what the work of an Estimation costs, in the currency of the rates it was priced with. It
+// adds up regardless of the schedule: two results running in parallel cost both.
+type Cost struct {
+	// EngineerHours is the effort priced as labor.
+	EngineerHours float64 `json:"engineerHours,omitempty"`
+	// Labor is the cost of the engineer-hours.
+	Labor float64 `json:"labor,omitempty"`
+	// Infrastructure is the cost of the resources used, e.g. egress and temporary storage.
+	Infrastructure float64 `json:"infrastructure,omitempty"`
+}
+
+// Total returns the labor and infrastructure cost.
+func (c Cost) Total() float64 {
+	return c.Labor + c.Infrastructure
+}
+
+// Scale returns the cost with its amounts multiplied by factor, e.g. an exchange rate converting
+// them into another currency. The engineer-hours are left as they are.
+func (c Cost) Scale(factor float64) Cost {
+	c.Labor *= factor
+	c.Infrastructure *= factor
+	return c
+}
+
+// Add returns the sum of the costs.
+func (c Cost) Add(o Cost) Cost {
+	return Cost{
+		EngineerHours:  c.EngineerHours + o.EngineerHours,
+		Labor:          c.Labor + o.Labor,
+		Infrastructure: c.Infrastructure + o.Infrastructure,
+	}
+}
+
+// Validate fails on negative or non-finite amounts.
+func (c Cost) Validate() error {
+	for _, amount := range []struct {
+		name  string
+		value float64
+	}{
+		{"engineer hours", c.EngineerHours},
+		{"labor cost", c.Labor},
+		{"infrastructure cost", c.Infrastructure},
+	} {
+		if amount.value < 0 || math.IsNaN(amount.value) || math.IsInf(amount.value, 0) {
+			return fmt.Errorf("invalid %s %v", amount.name, amount.value)
+		}
+	}
+	return nil
+}
+
+// TotalCost adds up the costs of the results, nil when none of them was priced.
+func TotalCost(results map[string]Estimation) *Cost {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	// floating point sums depend on the order
+	sort.Strings(names)
+
+	var total *Cost
+	for _, name := range names {
+		c := results[name].Cost
+		if c == nil {
+			continue
+		}
+		if total == nil {
+			total = &Cost{}
+		}
+		*total = total.Add(*c)
+	}
+	return total
+}
@@ -0,0 +1,51 @@
+package estimation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTotalCost(t *testing.T) {
+	t.Parallel()
+	results := map[string]Estimation{
+		"Storage Migration":     {Duration: 10 * time.Hour, Cost: &Cost{Infrastructure: 400}},
+		"Post-Migration Checks": {Duration: 2 * time.Hour, Cost: &Cost{EngineerHours: 20, Labor: 1800}},
+		"Cutover":               {Duration: time.Hour},
+	}
+
+	total := TotalCost(results)
+	if total == nil || *total != (Cost{EngineerHours: 20, Labor: 1800, Infrastructure: 400}) {
+		t.Fatalf("expected the costs added up, got %+v", total)
+	}
+	if total.Total() != 2200 {
+		t.Errorf("expected a total of 2200, got %v", total.Total())
+	}
+
+	// the results running in parallel cost both
+	s, err := NewPlan(results).Schedule()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if s.Cost == nil || *s.Cost != *total {
+		t.Errorf("expected the plan to cost %+v, got %+v", total, s.Cost)
+	}
+
+	if TotalCost(map[string]Estimation{"Cutover": {Duration: time.Hour}}) != nil {
+		t.Error("expected no cost when nothing was priced")
+	}
+}
+
+func TestEstimation_ValidateCost(t *testing.T) {
+	t.Parallel()
+	if err := (Estimation{Cost: &Cost{Labor: -1}}).Validate(); err == nil {
+		t.Error("expected an error for a negative cost")
+	}
+}
+
+func TestCost_Scale(t *testing.T) {
+	t.Parallel()
+	c := Cost{EngineerHours: 20, Labor: 1800, Infrastructure: 400}.Scale(1.5)
+	if c != (Cost{EngineerHours: 20, Labor: 2700, Infrastructure: 600}) {
+		t.Errorf("expected the amounts scaled and the hours kept, got %+v", c)
+	}
+}
@@ -1,4 +1,24 @@
 // Package estimation defines a pluggable migration estimation calculator.
 //
 // Each part of the calculation is encapsulated in one specific Calculator, and calculation results are aggregated by the Engine.
+// A Plan declares which results depend on which and totals them along the critical path, so phases
+// running in parallel are not added up.
+//
+// An Estimation may carry a three-point Range, optimistic, most likely and pessimistic, from which the
+// PERT expected value and standard deviation are derived. Spread adds one to any calculator.
+//
+// Besides its Reason, a sentence for display, an Estimation carries an Explanation: the inputs used,
+// the formula, the intermediate values and the assumptions, for renderers to format per locale or
+// output format.
+//
+// An Estimation may carry a Cost besides its duration: the labor cost of the engineer-hours and the
+// infrastructure cost, e.g. egress and temporary storage, which add up into the cost of the Plan.
+//
+// A Resolver resolves the params set by several layers, e.g. the request, a plan, the defaults of the
+// organization and the built-in constants, and keeps the Lineage of each: the layer whose value won
+// and the values it replaced.
+//
+// Calculators compute in float64 minutes or hours and convert the result with FromMinutes, FromHours
+// or FromDays, which fail with ErrDurationOutOfRange on NaN, infinite, negative or overflowing values
+// instead of returning a duration wrapped around the ~292 years a time.Duration holds.
 package estimation
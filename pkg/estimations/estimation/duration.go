@@ -0,0 +1,82 @@
+package estimation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// MaxDuration is the longest duration a time.Duration holds, about 292 years.
+const MaxDuration = time.Duration(math.MaxInt64)
+
+// ErrDurationOutOfRange is the error of a duration that is NaN, infinite, negative or longer than
+// MaxDuration. Calculators compute in float64 minutes or hours and convert the result with
+// FromMinutes, FromHours or FromDays, so a huge inventory at a tiny rate fails the estimation instead
+// of wrapping around to a garbage duration.
+var ErrDurationOutOfRange = errors.New("duration out of range")
+
+// FromMinutes converts a number of minutes to a duration.
+func FromMinutes(minutes float64) (time.Duration, error) {
+	return fromUnits(minutes, time.Minute, "minutes")
+}
+
+// FromHours converts a number of hours to a duration.
+func FromHours(hours float64) (time.Duration, error) {
+	return fromUnits(hours, time.Hour, "hours")
+}
+
+// FromDays converts a number of 24-hour days to a duration.
+func FromDays(days float64) (time.Duration, error) {
+	return fromUnits(days, 24*time.Hour, "days")
+}
+
+func fromUnits(value float64, unit time.Duration, name string) (time.Duration, error) {
+	ns := value * float64(unit)
+	// float64(math.MaxInt64) rounds up to 2^63, the first value that overflows
+	if math.IsNaN(ns) || ns < 0 || ns >= float64(math.MaxInt64) {
+		return 0, fmt.Errorf("%w: %g %s", ErrDurationOutOfRange, value, name)
+	}
+	return time.Duration(ns), nil
+}
+
+// Sum adds up non-negative durations, failing when one is negative or the total exceeds MaxDuration.
+func Sum(durations ...time.Duration) (time.Duration, error) {
+	var total time.Duration
+	for _, d := range durations {
+		if d < 0 || d > MaxDuration-total {
+			return 0, fmt.Errorf("%w: %s + %s", ErrDurationOutOfRange, total, d)
+		}
+		total += d
+	}
+	return total, nil
+}
+
+// Scale multiplies a non-negative duration by a non-negative factor.
+func Scale(d time.Duration, factor float64) (time.Duration, error) {
+	if d < 0 {
+		return 0, fmt.Errorf("%w: %s", ErrDurationOutOfRange, d)
+	}
+	return fromUnits(float64(d)*factor, time.Nanosecond, "ns")
+}
+
+// Validate checks the durations of the estimation are non-negative and its range is ordered.
+func (e Estimation) Validate() error {
+	if e.Duration < 0 {
+		return fmt.Errorf("%w: negative duration %s", ErrDurationOutOfRange, e.Duration)
+	}
+	if e.LeadTime < 0 {
+		return fmt.Errorf("%w: negative lead time %s", ErrDurationOutOfRange, e.LeadTime)
+	}
+	if e.Range != nil {
+		if err := e.Range.Validate(); err != nil {
+			return fmt.Errorf("invalid range: %w", err)
+		}
+	}
+	if e.Cost != nil {
+		if err := e.Cost.Validate(); err != nil {
+			return fmt.Errorf("invalid cost: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package estimation
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFromMinutes(t *testing.T) {
+	t.Parallel()
+	if d, err := FromMinutes(90); err != nil || d != 90*time.Minute {
+		t.Errorf("expected 1h30m, got %v, %v", d, err)
+	}
+	if d, err := FromDays(2); err != nil || d != 48*time.Hour {
+		t.Errorf("expected 48h, got %v, %v", d, err)
+	}
+
+	// time.Duration holds about 292 years, 153 million minutes
+	for _, minutes := range []float64{2e8, math.Inf(1), math.NaN(), -1} {
+		if _, err := FromMinutes(minutes); !errors.Is(err, ErrDurationOutOfRange) {
+			t.Errorf("FromMinutes(%g): expected ErrDurationOutOfRange, got %v", minutes, err)
+		}
+	}
+	if _, err := FromHours(float64(math.MaxInt64)); !errors.Is(err, ErrDurationOutOfRange) {
+		t.Errorf("expected ErrDurationOutOfRange, got %v", err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+	if d, err := Sum(time.Hour, 30*time.Minute); err != nil || d != 90*time.Minute {
+		t.Errorf("expected 1h30m, got %v, %v", d, err)
+	}
+	if _, err := Sum(MaxDuration, time.Nanosecond); !errors.Is(err, ErrDurationOutOfRange) {
+		t.Errorf("expected an overflow, got %v", err)
+	}
+	if _, err := Sum(time.Hour, -time.Minute); !errors.Is(err, ErrDurationOutOfRange) {
+		t.Errorf("expected a negative duration to be rejected, got %v", err)
+	}
+}
+
+func TestScale(t *testing.T) {
+	t.Parallel()
+	if d, err := Scale(time.Hour, 1.5); err != nil || d != 90*time.Minute {
+		t.Errorf("expected 1h30m, got %v, %v", d, err)
+	}
+	if _, err := Scale(MaxDuration/2, 3); !errors.Is(err, ErrDurationOutOfRange) {
+		t.Errorf("expected an overflow, got %v", err)
+	}
+	// calendar time saturates instead of wrapping around to a negative duration
+	if got := CalendarTime(MaxDuration/2, 8); got != MaxDuration {
+		t.Errorf("expected calendar time to saturate, got %v", got)
+	}
+}
+
+func TestThreePoint_ExpectedDoesNotOverflow(t *testing.T) {
+	t.Parallel()
+	// 4 * MostLikely overflows an int64
+	tp := Point(MaxDuration / 2)
+	if got := tp.Expected(); got < MaxDuration/2-time.Microsecond || got > MaxDuration/2+time.Microsecond {
+		t.Errorf("expected %v, got %v", MaxDuration/2, got)
+	}
+}
+
+func TestRun_InvalidEstimationEncodedInReason(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&mockCalculator{name: "negative", result: Estimation{Duration: -time.Hour, Reason: "wrapped around"}})
+
+	got := e.Run(nil)["negative"]
+	if got.Duration != 0 || got.Reason == "wrapped around" {
+		t.Errorf("expected the negative duration to be reported as an error, got %+v", got)
+	}
+}
+
+func TestPlan_ScheduleOverflow(t *testing.T) {
+	t.Parallel()
+	p := NewPlan(map[string]Estimation{
+		"a": {Duration: MaxDuration / 2},
+		"b": {Duration: MaxDuration / 2},
+		"c": {Duration: MaxDuration / 2},
+	}).After("b", "a").After("c", "b")
+	if _, err := p.Schedule(); !errors.Is(err, ErrDurationOutOfRange) {
+		t.Errorf("expected an overflow of the critical path, got %v", err)
+	}
+}
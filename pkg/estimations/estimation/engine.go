@@ -27,8 +27,23 @@ func (e *Engine) Register(c Calculator) {
 	e.calculators = append(e.calculators, c)
 }
 
+// Calculators returns the names of the registered calculators, in the order they run.
+func (e *Engine) Calculators() []string {
+	names := make([]string, 0, len(e.calculators))
+	for _, c := range e.calculators {
+		names = append(names, c.Name())
+	}
+	return names
+}
+
 // Run executes all registered calculators against the provided params
 func (e *Engine) Run(inputs []Param) map[string]Estimation {
+	return e.RunWithProgress(inputs, nil)
+}
+
+// RunWithProgress is Run calling progress, when not nil, after each calculator with the number of
+// calculators run so far and the number of registered calculators.
+func (e *Engine) RunWithProgress(inputs []Param, progress func(completed, total int)) map[string]Estimation {
 	// Convert slice to map for lookups by Calculators
 	paramMap := make(map[string]Param)
 	for _, p := range inputs {
@@ -39,16 +54,24 @@ func (e *Engine) Run(inputs []Param) map[string]Estimation {
 	// aggregate results
 	// TODO: maybe separate errors to a different result object
 	// TODO: in later phases, add different aggregations for parralelable calculations
-	for _, calc := range e.calculators {
+	for i, calc := range e.calculators {
 		est, err := calc.Calculate(paramMap)
+		if err == nil {
+			err = est.Validate()
+		}
 		if err != nil {
-			results[calc.Name()] = Estimation{
+			est = Estimation{
 				Duration: 0,
 				Reason:   fmt.Sprintf("Error: %v", err),
 			}
-			continue
+		}
+		for j := range est.Annotations {
+			est.Annotations[j].Calculator = calc.Name()
 		}
 		results[calc.Name()] = est
+		if progress != nil {
+			progress(i+1, len(e.calculators))
+		}
 	}
 	return results
 }
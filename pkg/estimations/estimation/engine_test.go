@@ -44,6 +44,9 @@ func TestRegister(t *testing.T) {
 	if len(e.calculators) != 2 {
 		t.Errorf("expected 2 calculators, got %d", len(e.calculators))
 	}
+	if names := e.Calculators(); len(names) != 2 || names[0] != "A" || names[1] != "B" {
+		t.Errorf("expected calculators [A B], got %v", names)
+	}
 }
 
 func TestRegister_PanicsOnDuplicate(t *testing.T) {
@@ -124,3 +127,21 @@ func TestRun_EmptyEngine(t *testing.T) {
 		t.Errorf("expected empty results for engine with no calculators, got %d", len(results))
 	}
 }
+
+func TestRunWithProgress(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&mockCalculator{name: "calc-a", result: Estimation{Duration: time.Hour}})
+	e.Register(&mockCalculator{name: "calc-b", err: errors.New("missing param")})
+
+	var calls [][2]int
+	results := e.RunWithProgress(nil, func(completed, total int) {
+		calls = append(calls, [2]int{completed, total})
+	})
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if len(calls) != 2 || calls[0] != [2]int{1, 2} || calls[1] != [2]int{2, 2} {
+		t.Errorf("expected progress after each calculator, got %v", calls)
+	}
+}
@@ -0,0 +1,103 @@
+package estimation
+
+import "sort"
+
+// Layer is a source of param values. The value of a param set by several layers is the one of the
+// layer of highest precedence.
+type Layer string
+
+const (
+	// LayerRequest holds the params given with the request.
+	LayerRequest Layer = "request"
+	// LayerWave holds the params of the wave of a plan migrating the cluster.
+	LayerWave Layer = "wave"
+	// LayerPlan holds the params of the plan.
+	LayerPlan Layer = "plan"
+	// LayerOrganization holds the calculator defaults of the organization.
+	LayerOrganization Layer = "organization"
+	// LayerInventory holds the params derived from the inventory.
+	LayerInventory Layer = "inventory"
+	// LayerBuiltIn holds the constants built into the planner.
+	LayerBuiltIn Layer = "built-in"
+)
+
+// Layers lists the layers from the highest precedence to the lowest.
+var Layers = []Layer{LayerRequest, LayerWave, LayerPlan, LayerOrganization, LayerInventory, LayerBuiltIn}
+
+// LayerValue is the value a layer sets a param to.
+type LayerValue struct {
+	Layer Layer       `json:"layer"`
+	Value interface{} `json:"value"`
+}
+
+// Lineage tells how the value of a param was resolved: the layer whose value won and the values of
+// all the layers setting the param, from the highest precedence to the lowest.
+type Lineage struct {
+	Key   string       `json:"param"`
+	Value interface{}  `json:"value"`
+	Layer Layer        `json:"layer"`
+	Chain []LayerValue `json:"chain"`
+}
+
+// Resolver resolves the params set by several layers.
+type Resolver struct {
+	values map[string]map[Layer]interface{}
+}
+
+// NewResolver creates a resolver with no param set.
+func NewResolver() *Resolver {
+	return &Resolver{values: make(map[string]map[Layer]interface{})}
+}
+
+// Set sets the param in the layer, replacing the value the layer set before.
+func (r *Resolver) Set(layer Layer, key string, value interface{}) *Resolver {
+	if r.values[key] == nil {
+		r.values[key] = make(map[Layer]interface{})
+	}
+	r.values[key][layer] = value
+	return r
+}
+
+// SetAll sets the params in the layer.
+func (r *Resolver) SetAll(layer Layer, params map[string]float64) *Resolver {
+	for key, value := range params {
+		r.Set(layer, key, value)
+	}
+	return r
+}
+
+// Params returns the effective params, in key order.
+func (r *Resolver) Params() []Param {
+	lineage := r.Lineage()
+	params := make([]Param, 0, len(lineage))
+	for _, l := range lineage {
+		params = append(params, Param{Key: l.Key, Value: l.Value})
+	}
+	return params
+}
+
+// Lineage returns the lineage of the effective params, in key order.
+func (r *Resolver) Lineage() []Lineage {
+	keys := make([]string, 0, len(r.values))
+	for key := range r.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := make([]Lineage, 0, len(keys))
+	for _, key := range keys {
+		l := Lineage{Key: key}
+		for _, layer := range Layers {
+			value, ok := r.values[key][layer]
+			if !ok {
+				continue
+			}
+			if len(l.Chain) == 0 {
+				l.Value, l.Layer = value, layer
+			}
+			l.Chain = append(l.Chain, LayerValue{Layer: layer, Value: value})
+		}
+		res = append(res, l)
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+package estimation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+	r := NewResolver().
+		Set(LayerBuiltIn, "transfer_rate_mbps", 620.0).
+		Set(LayerInventory, "vm_count", 40).
+		SetAll(LayerOrganization, map[string]float64{"transfer_rate_mbps": 1000}).
+		SetAll(LayerRequest, map[string]float64{"transfer_rate_mbps": 250, "work_hours_per_day": 6}).
+		SetAll(LayerPlan, map[string]float64{"transfer_rate_mbps": 800})
+
+	want := []Param{
+		{Key: "transfer_rate_mbps", Value: 250.0},
+		{Key: "vm_count", Value: 40},
+		{Key: "work_hours_per_day", Value: 6.0},
+	}
+	if got := r.Params(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	lineage := r.Lineage()
+	if len(lineage) != 3 {
+		t.Fatalf("expected the lineage of 3 params, got %v", lineage)
+	}
+	rate := lineage[0]
+	if rate.Layer != LayerRequest || rate.Value != 250.0 {
+		t.Errorf("expected the request to win, got %v from %s", rate.Value, rate.Layer)
+	}
+	wantChain := []LayerValue{
+		{Layer: LayerRequest, Value: 250.0},
+		{Layer: LayerPlan, Value: 800.0},
+		{Layer: LayerOrganization, Value: 1000.0},
+		{Layer: LayerBuiltIn, Value: 620.0},
+	}
+	if !reflect.DeepEqual(rate.Chain, wantChain) {
+		t.Errorf("expected the chain %v, got %v", wantChain, rate.Chain)
+	}
+	if vms := lineage[1]; vms.Layer != LayerInventory || len(vms.Chain) != 1 {
+		t.Errorf("expected vm_count from the inventory alone, got %+v", vms)
+	}
+}
@@ -0,0 +1,171 @@
+package estimation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Plan aggregates the results of calculators into the elapsed time of a migration. Results only run
+// one after the other when a dependency is declared between them, so the total is the length of the
+// critical path instead of the sum of all the durations.
+type Plan struct {
+	results map[string]Estimation
+	deps    map[string][]string
+}
+
+// Span is when a result runs, relative to the start of the plan.
+type Span struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Schedule is the outcome of a Plan.
+type Schedule struct {
+	// Total is the elapsed time of the plan, the end of its last result.
+	Total time.Duration
+	// Spans are the spans of the results, keyed by calculator name.
+	Spans map[string]Span
+	// CriticalPath are the results, in order, any delay of which delays the whole plan.
+	CriticalPath []string
+	// Expected and StdDev are the PERT mean and standard deviation of the elapsed time, from the
+	// three-point estimates of the results on the critical path.
+	Expected time.Duration
+	StdDev   time.Duration
+	// Cost is the cost of all the results, nil when none of them was priced.
+	Cost *Cost
+}
+
+// NewPlan creates a Plan of the results of an Engine run, keyed by calculator name. Without
+// dependencies all the results run in parallel.
+func NewPlan(results map[string]Estimation) *Plan {
+	return &Plan{results: results, deps: make(map[string][]string)}
+}
+
+// After declares that the result name starts once all of deps are done, e.g. troubleshooting after
+// cutover.
+func (p *Plan) After(name string, deps ...string) *Plan {
+	p.deps[name] = append(p.deps[name], deps...)
+	return p
+}
+
+// Schedule starts each result as soon as its dependencies are done. A result lasts the longer of its
+// effort and lead time, worked round the clock. It fails when a dependency names an unknown result or
+// the dependencies form a cycle.
+func (p *Plan) Schedule() (Schedule, error) {
+	names := make([]string, 0, len(p.results))
+	for name := range p.results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range sortedKeys(p.deps) {
+		if _, ok := p.results[name]; !ok {
+			return Schedule{}, fmt.Errorf("dependency declared on unknown result %q", name)
+		}
+		for _, dep := range p.deps[name] {
+			if _, ok := p.results[dep]; !ok {
+				return Schedule{}, fmt.Errorf("%q depends on unknown result %q", name, dep)
+			}
+		}
+	}
+
+	s := Schedule{Spans: make(map[string]Span, len(names))}
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(names))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+		state[name] = visiting
+		var start time.Duration
+		for _, dep := range p.deps[name] {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+			start = max(start, s.Spans[dep].End)
+		}
+		end, err := Sum(start, p.results[name].Elapsed(0))
+		if err != nil {
+			return fmt.Errorf("%q ends too late: %w", name, err)
+		}
+		s.Spans[name] = Span{Start: start, End: end}
+		state[name] = done
+		return nil
+	}
+
+	var last string
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return Schedule{}, err
+		}
+		if end := s.Spans[name].End; last == "" || end > s.Total {
+			s.Total = end
+			last = name
+		}
+	}
+
+	// walk back from the last result through the dependencies it waited for
+	for name := last; name != ""; {
+		s.CriticalPath = append([]string{name}, s.CriticalPath...)
+		start, next := s.Spans[name].Start, ""
+		for _, dep := range p.deps[name] {
+			if s.Spans[dep].End == start && (next == "" || dep < next) {
+				next = dep
+			}
+		}
+		name = next
+	}
+
+	devs := make([]time.Duration, 0, len(s.CriticalPath))
+	for _, name := range s.CriticalPath {
+		est := p.results[name]
+		tp := est.ThreePoint()
+		// a lead time longer than the effort is calendar time known for sure
+		if est.LeadTime > tp.Expected() {
+			tp = Point(est.LeadTime)
+		}
+		expected, err := Sum(s.Expected, tp.Expected())
+		if err != nil {
+			return Schedule{}, fmt.Errorf("expected elapsed time: %w", err)
+		}
+		s.Expected = expected
+		devs = append(devs, tp.StdDev())
+	}
+	s.StdDev = sumStdDev(devs...)
+	s.Cost = TotalCost(p.results)
+	return s, nil
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sumStdDev adds up standard deviations of independent durations: their variances add up. The sum
+// saturates to MaxDuration.
+func sumStdDev(devs ...time.Duration) time.Duration {
+	var variance float64
+	for _, d := range devs {
+		variance += float64(d) * float64(d)
+	}
+	dev, err := fromUnits(math.Sqrt(variance), time.Nanosecond, "ns")
+	if err != nil {
+		return MaxDuration
+	}
+	return dev
+}
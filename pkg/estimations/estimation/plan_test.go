@@ -0,0 +1,97 @@
+package estimation
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlan_Schedule(t *testing.T) {
+	t.Parallel()
+	results := map[string]Estimation{
+		"Storage Migration":   {Duration: 10 * time.Hour},
+		"Network Mapping":     {Duration: 2 * time.Hour},
+		"Cutover":             {Duration: 1 * time.Hour},
+		"Troubleshooting":     {Duration: 3 * time.Hour},
+		"Procurement":         {Duration: time.Hour, LeadTime: 12 * time.Hour},
+		"Stakeholder Comms":   {Duration: 4 * time.Hour},
+		"Post-Migration Docs": {Duration: 30 * time.Minute},
+	}
+
+	t.Run("no dependencies run in parallel", func(t *testing.T) {
+		t.Parallel()
+		s, err := NewPlan(results).Schedule()
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		// the procurement lead time is the longest
+		if s.Total != 12*time.Hour || len(s.CriticalPath) != 1 || s.CriticalPath[0] != "Procurement" {
+			t.Errorf("expected 12h on Procurement, got %v on %v", s.Total, s.CriticalPath)
+		}
+	})
+
+	t.Run("critical path", func(t *testing.T) {
+		t.Parallel()
+		s, err := NewPlan(results).
+			After("Cutover", "Storage Migration", "Network Mapping").
+			After("Troubleshooting", "Cutover").
+			After("Post-Migration Docs", "Troubleshooting", "Stakeholder Comms").
+			Schedule()
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		// 10h copy + 1h cutover + 3h troubleshooting + 30m docs, not the 33h30m of the sum
+		if want := 14*time.Hour + 30*time.Minute; s.Total != want {
+			t.Errorf("expected %v total, got %v", want, s.Total)
+		}
+		if got := strings.Join(s.CriticalPath, ","); got != "Storage Migration,Cutover,Troubleshooting,Post-Migration Docs" {
+			t.Errorf("unexpected critical path %s", got)
+		}
+		if span := s.Spans["Cutover"]; span.Start != 10*time.Hour || span.End != 11*time.Hour {
+			t.Errorf("expected the cutover after the copy, got %+v", span)
+		}
+		if span := s.Spans["Network Mapping"]; span.Start != 0 {
+			t.Errorf("expected the network mapping to start right away, got %+v", span)
+		}
+	})
+
+	t.Run("PERT along the critical path", func(t *testing.T) {
+		t.Parallel()
+		ranged := map[string]Estimation{
+			"Storage Migration": {Duration: 6 * time.Hour, Range: &ThreePoint{Optimistic: 4 * time.Hour, MostLikely: 6 * time.Hour, Pessimistic: 14 * time.Hour}},
+			"Cutover":           {Duration: time.Hour},
+			"Troubleshooting":   {Duration: 2 * time.Hour, Range: &ThreePoint{Optimistic: time.Hour, MostLikely: 2 * time.Hour, Pessimistic: 9 * time.Hour}},
+			"Procurement":       {Duration: time.Hour, LeadTime: 4 * time.Hour},
+		}
+		s, err := NewPlan(ranged).After("Cutover", "Storage Migration", "Procurement").After("Troubleshooting", "Cutover").Schedule()
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		// 7h + 1h + 3h
+		if s.Expected != 11*time.Hour {
+			t.Errorf("expected 11h, got %v", s.Expected)
+		}
+		// sqrt(100^2 + 80^2) minutes
+		if got := s.StdDev.Round(time.Minute); got != 128*time.Minute {
+			t.Errorf("expected about 2h8m, got %v", got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		cases := []struct {
+			name    string
+			plan    *Plan
+			wantErr string
+		}{
+			{name: "unknown dependency", plan: NewPlan(results).After("Cutover", "Rollback"), wantErr: `"Cutover" depends on unknown result "Rollback"`},
+			{name: "unknown result", plan: NewPlan(results).After("Rollback", "Cutover"), wantErr: `unknown result "Rollback"`},
+			{name: "cycle", plan: NewPlan(results).After("Cutover", "Troubleshooting").After("Troubleshooting", "Cutover"), wantErr: "dependency cycle: Cutover -> Troubleshooting -> Cutover"},
+		}
+		for _, tc := range cases {
+			if _, err := tc.plan.Schedule(); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("%s: expected error containing %q, got: %v", tc.name, tc.wantErr, err)
+			}
+		}
+	})
+}
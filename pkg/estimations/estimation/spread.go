@@ -0,0 +1,48 @@
+package estimation
+
+import "fmt"
+
+// Compile-time assertion that Spread implements the Calculator interface.
+var _ Calculator = (*Spread)(nil)
+
+// Spread turns the single-point estimate of a calculator into a three-point one, scaling its duration
+// by an optimistic and a pessimistic factor, e.g. 0.8 and 1.5 when the work can take 20% less or 50%
+// more. Calculators giving their own range keep it.
+type Spread struct {
+	calc        Calculator
+	optimistic  float64
+	pessimistic float64
+}
+
+// NewSpread wraps the calculator. It panics unless 0 <= optimistic <= 1 <= pessimistic, as the most
+// likely duration must lie within the range.
+func NewSpread(c Calculator, optimistic, pessimistic float64) *Spread {
+	if optimistic < 0 || optimistic > 1 || pessimistic < 1 {
+		panic(fmt.Sprintf("estimation: invalid spread %.2f-%.2f of calculator %q", optimistic, pessimistic, c.Name()))
+	}
+	return &Spread{calc: c, optimistic: optimistic, pessimistic: pessimistic}
+}
+
+// Name returns the name of the wrapped calculator.
+func (s *Spread) Name() string { return s.calc.Name() }
+
+// Keys returns the keys of the wrapped calculator.
+func (s *Spread) Keys() []string { return s.calc.Keys() }
+
+// Calculate runs the wrapped calculator and adds the range of its duration.
+func (s *Spread) Calculate(params map[string]Param) (Estimation, error) {
+	est, err := s.calc.Calculate(params)
+	if err != nil || est.Range != nil {
+		return est, err
+	}
+	optimistic, err := Scale(est.Duration, s.optimistic)
+	if err != nil {
+		return Estimation{}, err
+	}
+	pessimistic, err := Scale(est.Duration, s.pessimistic)
+	if err != nil {
+		return Estimation{}, fmt.Errorf("pessimistic duration: %w", err)
+	}
+	est.Range = &ThreePoint{Optimistic: optimistic, MostLikely: est.Duration, Pessimistic: pessimistic}
+	return est, nil
+}
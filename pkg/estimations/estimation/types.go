@@ -1,6 +1,7 @@
 package estimation
 
 import (
+	"errors"
 	"time"
 )
 
@@ -23,5 +24,149 @@ type Param struct {
 // Estimation the result of a Calculator calculation
 type Estimation struct {
 	Duration time.Duration
-	Reason   string
+	// Reason is the explanation of the estimation as an English sentence, for display only: read
+	// Explanation instead of parsing it.
+	Reason string
+	// Explanation is the structured account of how the estimation was calculated, nil for
+	// calculators that do not give one.
+	Explanation *Explanation
+	// LeadTime is calendar time that has to elapse regardless of effort (e.g. contractual notice periods).
+	// It runs alongside Duration and is zero for calculators that only model effort.
+	LeadTime time.Duration
+	// Range is the three-point estimate of Duration, whose most likely value is Duration. Nil for
+	// calculators giving a single point.
+	Range *ThreePoint
+	// Cost is what the work costs, nil for calculators not given the rates to price it.
+	Cost *Cost
+	// Annotations flag the supplied params the calculator did not use as given, e.g. a rate ignored
+	// or capped.
+	Annotations []Annotation
+}
+
+// Unit is the unit of a Quantity, left to renderers to format.
+type Unit string
+
+const (
+	UnitCount         Unit = "count"
+	UnitMinutes       Unit = "min"
+	UnitHours         Unit = "h"
+	UnitDays          Unit = "d"
+	UnitHoursPerDay   Unit = "h/d"
+	UnitGB            Unit = "GB"
+	UnitKB            Unit = "KB"
+	UnitGBPerHour     Unit = "GB/h"
+	UnitMbps          Unit = "Mbps"
+	UnitMilliseconds  Unit = "ms"
+	UnitPercent       Unit = "%"
+	UnitPercentPerDay Unit = "%/d"
+	UnitRatio         Unit = "ratio"
+)
+
+// Quantity is a named value of an Explanation, e.g. {vm_count 120 count}. Inputs are named after the
+// param they were read from.
+type Quantity struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+	Unit  Unit    `json:"unit,omitempty"`
+	// Default is set on inputs whose param was not given, so the calculator default was used.
+	Default bool `json:"default,omitempty"`
+}
+
+// Explanation is the structured account of an Estimation: the inputs used, the formula combining them,
+// the intermediate values and the assumptions made. Renderers format it per locale or output format
+// instead of parsing the Reason.
+type Explanation struct {
+	// Formula gives the duration and lead time in terms of the names of the quantities, e.g.
+	// "duration = vm_count * minutes_per_vm / engineers".
+	Formula       string     `json:"formula"`
+	Inputs        []Quantity `json:"inputs,omitempty"`
+	Intermediates []Quantity `json:"intermediates,omitempty"`
+	Assumptions   []string   `json:"assumptions,omitempty"`
+}
+
+// Input returns the input of the explanation with the name.
+func (x Explanation) Input(name string) (Quantity, bool) {
+	return find(x.Inputs, name)
+}
+
+// Intermediate returns the intermediate value of the explanation with the name.
+func (x Explanation) Intermediate(name string) (Quantity, bool) {
+	return find(x.Intermediates, name)
+}
+
+func find(quantities []Quantity, name string) (Quantity, bool) {
+	for _, q := range quantities {
+		if q.Name == name {
+			return q, true
+		}
+	}
+	return Quantity{}, false
+}
+
+// Elapsed returns the calendar time needed to complete the estimation.
+// Duration is working time: it is stretched to calendar time assuming workHoursPerDay hours of work
+// per calendar day (a value <= 0 or >= 24 means round-the-clock work, e.g. unattended data transfer).
+// Effort and lead time run concurrently, so the longer of the two wins.
+func (e Estimation) Elapsed(workHoursPerDay float64) time.Duration {
+	effort := CalendarTime(e.Duration, workHoursPerDay)
+	if e.LeadTime > effort {
+		return e.LeadTime
+	}
+	return effort
+}
+
+// CalendarTime converts working time into calendar time for the given number of work hours per day.
+// A value <= 0 or >= 24 means round-the-clock work and returns the working time unchanged. Calendar
+// time longer than MaxDuration saturates to MaxDuration instead of wrapping around.
+func CalendarTime(work time.Duration, workHoursPerDay float64) time.Duration {
+	if workHoursPerDay <= 0 || workHoursPerDay >= 24 {
+		return work
+	}
+	calendar, err := Scale(work, 24/workHoursPerDay)
+	if err != nil {
+		return MaxDuration
+	}
+	return calendar
+}
+
+// ThreePoint is a PERT estimate of a duration: the best case, the most likely one and the worst case.
+type ThreePoint struct {
+	Optimistic  time.Duration
+	MostLikely  time.Duration
+	Pessimistic time.Duration
+}
+
+// Point returns the estimate of a duration known for sure.
+func Point(d time.Duration) ThreePoint {
+	return ThreePoint{Optimistic: d, MostLikely: d, Pessimistic: d}
+}
+
+// Validate checks the durations are non-negative and ordered.
+func (t ThreePoint) Validate() error {
+	if t.Optimistic < 0 {
+		return errors.New("optimistic duration must be non-negative")
+	}
+	if t.Optimistic > t.MostLikely || t.MostLikely > t.Pessimistic {
+		return errors.New("durations must be ordered optimistic <= most likely <= pessimistic")
+	}
+	return nil
+}
+
+// Expected returns the PERT mean, (O + 4M + P) / 6, computed in float64 so that 4M does not overflow.
+func (t ThreePoint) Expected() time.Duration {
+	return time.Duration((float64(t.Optimistic) + 4*float64(t.MostLikely) + float64(t.Pessimistic)) / 6)
+}
+
+// StdDev returns the PERT standard deviation, (P - O) / 6.
+func (t ThreePoint) StdDev() time.Duration {
+	return (t.Pessimistic - t.Optimistic) / 6
+}
+
+// ThreePoint returns the three-point estimate of the Duration, a single point when the calculator
+// gave no range.
+func (e Estimation) ThreePoint() ThreePoint {
+	if e.Range != nil {
+		return *e.Range
+	}
+	return Point(e.Duration)
 }
@@ -0,0 +1,104 @@
+package estimation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimation_Elapsed(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		est             Estimation
+		workHoursPerDay float64
+		want            time.Duration
+	}{
+		{name: "round-the-clock effort", est: Estimation{Duration: 48 * time.Hour}, want: 48 * time.Hour},
+		// 48h of effort at 8h a day is 6 days
+		{name: "effort stretched by work hours", est: Estimation{Duration: 48 * time.Hour}, workHoursPerDay: 8, want: 6 * 24 * time.Hour},
+		{name: "lead time dominates", est: Estimation{Duration: 16 * time.Hour, LeadTime: 90 * 24 * time.Hour}, workHoursPerDay: 8, want: 90 * 24 * time.Hour},
+		{name: "stretched effort exceeds lead time", est: Estimation{Duration: 48 * time.Hour, LeadTime: 3 * 24 * time.Hour}, workHoursPerDay: 8, want: 6 * 24 * time.Hour},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.est.Elapsed(tc.workHoursPerDay); got != tc.want {
+				t.Errorf("expected elapsed %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestThreePoint(t *testing.T) {
+	t.Parallel()
+	tp := ThreePoint{Optimistic: 4 * time.Hour, MostLikely: 6 * time.Hour, Pessimistic: 14 * time.Hour}
+	if err := tp.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// (4 + 4*6 + 14) / 6 = 7h
+	if got := tp.Expected(); got != 7*time.Hour {
+		t.Errorf("expected 7h, got %v", got)
+	}
+	// (14 - 4) / 6
+	if got := tp.StdDev(); got != 100*time.Minute {
+		t.Errorf("expected 1h40m, got %v", got)
+	}
+
+	if err := (ThreePoint{Optimistic: 2 * time.Hour, MostLikely: time.Hour, Pessimistic: 3 * time.Hour}).Validate(); err == nil {
+		t.Error("expected unordered durations to be rejected")
+	}
+	if got := (Estimation{Duration: time.Hour}).ThreePoint(); got != Point(time.Hour) || got.StdDev() != 0 {
+		t.Errorf("expected a single point without range, got %+v", got)
+	}
+}
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+	calc := NewSpread(&mockCalculator{name: "A", result: Estimation{Duration: 10 * time.Hour}}, 0.8, 1.5)
+
+	est, err := calc.Calculate(nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want := ThreePoint{Optimistic: 8 * time.Hour, MostLikely: 10 * time.Hour, Pessimistic: 15 * time.Hour}
+	if est.Range == nil || *est.Range != want {
+		t.Errorf("expected range %+v, got %+v", want, est.Range)
+	}
+	if calc.Name() != "A" {
+		t.Errorf("expected the name of the wrapped calculator, got %s", calc.Name())
+	}
+
+	own := ThreePoint{Optimistic: time.Hour, MostLikely: 2 * time.Hour, Pessimistic: 3 * time.Hour}
+	est, _ = NewSpread(&mockCalculator{name: "B", result: Estimation{Duration: 2 * time.Hour, Range: &own}}, 0.5, 2).Calculate(nil)
+	if *est.Range != own {
+		t.Errorf("expected the range of the calculator to be kept, got %+v", est.Range)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic on a pessimistic factor below 1, got none")
+		}
+	}()
+	NewSpread(&mockCalculator{name: "C"}, 0.8, 0.9)
+}
+
+func TestExplanationLookup(t *testing.T) {
+	t.Parallel()
+	x := Explanation{
+		Formula:       "duration = vm_count * minutes_per_vm",
+		Inputs:        []Quantity{{Name: "vm_count", Value: 12, Unit: UnitCount}, {Name: "minutes_per_vm", Value: 30, Unit: UnitMinutes, Default: true}},
+		Intermediates: []Quantity{{Name: "effort_minutes", Value: 360, Unit: UnitMinutes}},
+	}
+
+	if q, ok := x.Input("minutes_per_vm"); !ok || q.Value != 30 || !q.Default {
+		t.Errorf("expected the default minutes per VM, got %+v", q)
+	}
+	if q, ok := x.Intermediate("effort_minutes"); !ok || q.Value != 360 {
+		t.Errorf("expected 360 minutes of effort, got %+v", q)
+	}
+	if _, ok := x.Input("effort_minutes"); ok {
+		t.Error("expected intermediates not to be found among the inputs")
+	}
+}
@@ -0,0 +1,389 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/namespaces"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+	"sigs.k8s.io/yaml"
+)
+
+var start = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+func chart(t *testing.T) gantt.Chart {
+	t.Helper()
+	step := func(name string, effort time.Duration, pool string, units int) scheduling.Step {
+		return scheduling.Step{Phase: scheduling.Phase{Name: name, Effort: effort}, Pool: pool, Units: units}
+	}
+	waves := []scheduling.WavePlan{
+		{Wave: "wave-1", Steps: []scheduling.Step{step("Pre-Copy", 24*time.Hour, "", 0), step("Validation", 48*time.Hour, "engineers", 2)}},
+		{Wave: "wave-2", Steps: []scheduling.Step{step("Pre-Copy", 24*time.Hour, "", 0), step("Validation", 48*time.Hour, "engineers", 2)}},
+	}
+	tl, err := scheduling.NewPipeline(scheduling.ModePipelined,
+		scheduling.WithOverlap("Validation", "Pre-Copy"),
+		scheduling.WithResourcePool("engineers", 4),
+	).Layout(waves)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	return gantt.New(start, tl, start)
+}
+
+func TestMSProject(t *testing.T) {
+	t.Parallel()
+	out, err := MSProject("datacenter exit", chart(t), map[string]int{"engineers": 4})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	var doc msProject
+	if err := xml.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("expected well-formed XML, got: %v", err)
+	}
+	if doc.Name != "datacenter exit" || doc.StartDate != "2026-03-02T00:00:00" {
+		t.Errorf("unexpected project header: %q %q", doc.Name, doc.StartDate)
+	}
+	// two wave summaries with two phases each
+	if len(doc.Tasks) != 6 {
+		t.Fatalf("expected 6 tasks, got %d", len(doc.Tasks))
+	}
+	if doc.Tasks[0].Summary != 1 || doc.Tasks[1].OutlineLevel != 2 {
+		t.Errorf("expected wave summaries with phases below, got %+v", doc.Tasks[:2])
+	}
+	if d := doc.Tasks[2].Duration; d != "PT48H0M0S" {
+		t.Errorf("expected validation to last 48h, got %s", d)
+	}
+
+	// wave-2 pre-copy overlaps wave-1 validation
+	preCopy := doc.Tasks[4]
+	if len(preCopy.Predecessors) != 1 || preCopy.Predecessors[0].PredecessorUID != 3 || preCopy.Predecessors[0].Type != msProjectStartToStart {
+		t.Errorf("expected wave-2 pre-copy to start with wave-1 validation, got %+v", preCopy.Predecessors)
+	}
+	validation := doc.Tasks[2]
+	if len(validation.Predecessors) != 1 || validation.Predecessors[0].Type != msProjectFinishToStart {
+		t.Errorf("expected wave-1 validation to follow pre-copy, got %+v", validation.Predecessors)
+	}
+
+	if len(doc.Resources) != 1 || doc.Resources[0].Name != "engineers" || doc.Resources[0].MaxUnits != 4 {
+		t.Errorf("expected the engineers pool as resource, got %+v", doc.Resources)
+	}
+	if len(doc.Assignments) != 2 || doc.Assignments[0].TaskUID != 3 || doc.Assignments[0].Units != 2 {
+		t.Errorf("expected validations assigned 2 engineers, got %+v", doc.Assignments)
+	}
+}
+
+func TestMSProject_UnknownDependency(t *testing.T) {
+	t.Parallel()
+	c := chart(t)
+	c.Dependencies = append(c.Dependencies, gantt.Dependency{From: scheduling.BarID{Wave: "wave-3", Phase: "Pre-Copy"}, To: c.Dependencies[0].To})
+	if _, err := MSProject("datacenter exit", c, nil); err == nil {
+		t.Errorf("expected error for unknown dependency, got nil")
+	}
+}
+
+func TestSmartsheetCSV(t *testing.T) {
+	t.Parallel()
+	out, err := SmartsheetCSV(chart(t))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("expected valid CSV, got: %v", err)
+	}
+	if len(records) != 7 {
+		t.Fatalf("expected header and 6 rows, got %d", len(records))
+	}
+
+	cases := []struct {
+		row  int
+		col  int
+		want string
+	}{
+		{row: 1, col: 0, want: "wave-1"},
+		{row: 2, col: 3, want: "2026-03-02 00:00"},
+		{row: 3, col: 5, want: "48eh"},
+		{row: 3, col: 6, want: "2FS"},
+		{row: 3, col: 7, want: "engineers"},
+		{row: 3, col: 8, want: "2"},
+		// rows are numbered from 1 without the header: wave-1 validation is row 3
+		{row: 5, col: 6, want: "3SS"},
+	}
+	for _, tc := range cases {
+		if got := records[tc.row][tc.col]; got != tc.want {
+			t.Errorf("expected %q in row %d column %q, got %q", tc.want, tc.row, smartsheetHeader[tc.col], got)
+		}
+	}
+}
+
+func TestParseMSProject_RoundTrip(t *testing.T) {
+	t.Parallel()
+	c := chart(t)
+	out, err := MSProject("datacenter exit", c, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	schedule, err := ParseMSProject(out, time.UTC)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(schedule) != 4 {
+		t.Fatalf("expected 4 phases, got %d", len(schedule))
+	}
+	if s := schedule[3]; s.Wave != "wave-2" || s.Phase != "Validation" || !s.End.Equal(c.End) {
+		t.Errorf("unexpected phase %+v", s)
+	}
+	if d := Reconcile(c, schedule); len(d) != 0 {
+		t.Errorf("expected no discrepancy for an unchanged schedule, got %+v", d)
+	}
+}
+
+func TestParseMSProject_RoundTripInLocation(t *testing.T) {
+	t.Parallel()
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+	tl, err := scheduling.NewPipeline(scheduling.ModeSerial).Layout([]scheduling.WavePlan{
+		{Wave: "wave-1", Steps: []scheduling.Step{{Phase: scheduling.Phase{Name: "Pre-Copy", Effort: 24 * time.Hour}}}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	local := time.Date(2026, 3, 2, 9, 0, 0, 0, paris)
+	c := gantt.New(local, tl, local)
+	out, err := MSProject("datacenter exit", c, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	schedule, err := ParseMSProject(out, paris)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(schedule) != 1 || !schedule[0].Start.Equal(local) || !schedule[0].End.Equal(c.End) {
+		t.Fatalf("expected the phase to start at %s, got %+v", local, schedule)
+	}
+	if d := Reconcile(c, schedule); len(d) != 0 {
+		t.Errorf("expected no discrepancy for an unchanged schedule, got %+v", d)
+	}
+}
+
+func TestParseMSProject_Invalid(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		doc  string
+	}{
+		{name: "not xml", doc: "wave-1,Pre-Copy"},
+		{name: "phase outside wave", doc: `<Project><Tasks><Task><Name>Pre-Copy</Name><OutlineLevel>2</OutlineLevel></Task></Tasks></Project>`},
+		{name: "invalid start", doc: `<Project><Tasks><Task><Name>wave-1</Name><OutlineLevel>1</OutlineLevel></Task>` +
+			`<Task><Name>Pre-Copy</Name><OutlineLevel>2</OutlineLevel><Start>monday</Start><Finish>2026-03-02T00:00:00</Finish></Task></Tasks></Project>`},
+		{name: "finish before start", doc: `<Project><Tasks><Task><Name>wave-1</Name><OutlineLevel>1</OutlineLevel></Task>` +
+			`<Task><Name>Pre-Copy</Name><OutlineLevel>2</OutlineLevel><Start>2026-03-03T00:00:00</Start><Finish>2026-03-02T00:00:00</Finish></Task></Tasks></Project>`},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := ParseMSProject([]byte(tc.doc), time.UTC); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+	c := chart(t)
+	day := 24 * time.Hour
+	schedule := []plan.ScheduledPhase{
+		{Wave: "wave-1", Phase: "Pre-Copy", Start: start, End: start.Add(day)},
+		// half the estimated effort
+		{Wave: "wave-1", Phase: "Validation", Start: start.Add(day), End: start.Add(2 * day)},
+		// before wave-1 validation starts
+		{Wave: "wave-2", Phase: "Pre-Copy", Start: start, End: start.Add(day)},
+		{Wave: "wave-2", Phase: "Cutover", Start: start, End: start.Add(day)},
+	}
+
+	got := make(map[DiscrepancyKind]string)
+	for _, d := range Reconcile(c, schedule) {
+		got[d.Kind] = d.Wave + "/" + d.Phase
+	}
+	want := map[DiscrepancyKind]string{
+		DiscrepancyEffortShortened:    "wave-1/Validation",
+		DiscrepancyDependencyViolated: "wave-2/Pre-Copy",
+		DiscrepancyUnknownPhase:       "wave-2/Cutover",
+		DiscrepancyMissingPhase:       "wave-2/Validation",
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	for kind, phase := range want {
+		if got[kind] != phase {
+			t.Errorf("expected %s for %s, got %q", kind, phase, got[kind])
+		}
+	}
+}
+
+func TestMTVPlans(t *testing.T) {
+	t.Parallel()
+	c := chart(t)
+	out, err := MTVPlans("b7f3c0e2-0000-4000-8000-000000000001", "Datacenter Exit", c)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	docs := strings.Split(string(out), "---\n")
+	if len(docs) != 2 {
+		t.Fatalf("expected a plan per wave, got %d", len(docs))
+	}
+	var cr mtvPlan
+	if err := yaml.Unmarshal([]byte(docs[1]), &cr); err != nil {
+		t.Fatalf("expected valid YAML, got: %v", err)
+	}
+	if cr.Kind != "Plan" || cr.APIVersion != mtvAPIVersion || cr.Metadata.Name != "datacenter-exit-wave-2" {
+		t.Errorf("unexpected resource header %s %s %s", cr.APIVersion, cr.Kind, cr.Metadata.Name)
+	}
+
+	estimate, ok, err := ParseWaveEstimate(cr.Metadata.Annotations)
+	if !ok || err != nil {
+		t.Fatalf("expected an estimate annotation, got %v %v", ok, err)
+	}
+	if estimate.PlanID != cr.Metadata.Annotations[MTVAnnotationPlanID] || estimate.Wave != "wave-2" {
+		t.Errorf("unexpected estimate %+v", estimate)
+	}
+	if len(estimate.Phases) != 2 || !estimate.End.Equal(c.End) || estimate.Phases[1].Pool != "engineers" {
+		t.Errorf("expected the phases of wave-2 ending with the program, got %+v", estimate)
+	}
+}
+
+func testDesign() namespaces.Design {
+	return namespaces.Design{
+		Namespaces: []namespaces.Namespace{
+			{Name: "finance", Waves: []string{"wave-1", "wave-2"}, VMs: []namespaces.VM{{ID: "vm-1", Wave: "wave-1"}, {ID: "vm-3", Wave: "wave-2"}}},
+			{Name: "hr", Waves: []string{"wave-1"}, VMs: []namespaces.VM{{ID: "vm-2", Wave: "wave-1"}}},
+		},
+		Unmapped: []namespaces.VM{{ID: "vm-4", Wave: "wave-2"}},
+	}
+}
+
+func TestMTVPlans_Namespaces(t *testing.T) {
+	t.Parallel()
+	out, err := MTVPlans("b7f3c0e2-0000-4000-8000-000000000001", "Datacenter Exit", chart(t), WithNamespaces(testDesign()))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	docs := strings.Split(string(out), "---\n")
+	if len(docs) != 4 {
+		t.Fatalf("expected a plan per wave and namespace and one for the unmapped VMs, got %d", len(docs))
+	}
+	var crs []mtvPlan
+	for _, doc := range docs {
+		var cr mtvPlan
+		if err := yaml.Unmarshal([]byte(doc), &cr); err != nil {
+			t.Fatalf("expected valid YAML, got: %v", err)
+		}
+		crs = append(crs, cr)
+	}
+	if crs[0].Metadata.Name != "datacenter-exit-wave-1-finance" || crs[0].Spec.TargetNamespace != "finance" || len(crs[0].Spec.VMs) != 1 || crs[0].Spec.VMs[0].ID != "vm-1" {
+		t.Errorf("expected vm-1 migrated to finance by wave-1, got %+v", crs[0])
+	}
+	if crs[1].Spec.TargetNamespace != "hr" || crs[2].Spec.TargetNamespace != "finance" || crs[2].Spec.VMs[0].ID != "vm-3" {
+		t.Errorf("unexpected target namespaces %+v %+v", crs[1].Spec, crs[2].Spec)
+	}
+	if unmapped := crs[3]; unmapped.Metadata.Name != "datacenter-exit-wave-2" || unmapped.Spec.TargetNamespace != "" || unmapped.Spec.VMs[0].ID != "vm-4" {
+		t.Errorf("expected vm-4 in a plan without target namespace, got %+v", unmapped)
+	}
+}
+
+func TestTerraformNamespaces(t *testing.T) {
+	t.Parallel()
+	d := testDesign()
+	d.Namespaces = append(d.Namespaces, namespaces.Namespace{Name: "100-payroll"})
+	out, err := TerraformNamespaces("b7f3c0e2-0000-4000-8000-000000000001", d)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	tf := string(out)
+	if strings.Count(tf, `resource "kubernetes_namespace"`) != 3 {
+		t.Fatalf("expected a resource per namespace, got:\n%s", tf)
+	}
+	for _, want := range []string{`"finance" {`, `name = "hr"`, `"ns_100_payroll" {`, `"migration-planner.kubev2v.io/plan-id" = "b7f3c0e2-0000-4000-8000-000000000001"`} {
+		if !strings.Contains(tf, want) {
+			t.Errorf("expected %s in:\n%s", want, tf)
+		}
+	}
+}
+
+func TestAnsibleNamespaces(t *testing.T) {
+	t.Parallel()
+	out, err := AnsibleNamespaces("b7f3c0e2-0000-4000-8000-000000000001", "Datacenter Exit", testDesign())
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	var plays []ansiblePlay
+	if err := yaml.Unmarshal(out, &plays); err != nil {
+		t.Fatalf("expected valid YAML, got: %v", err)
+	}
+	if len(plays) != 1 || plays[0].Hosts != "localhost" || len(plays[0].Tasks) != 2 {
+		t.Fatalf("expected a play with a task per namespace, got %+v", plays)
+	}
+	ns := plays[0].Tasks[1].K8s.Definition
+	if ns.Kind != "Namespace" || ns.Metadata.Name != "hr" || ns.Metadata.Labels[MTVAnnotationPlanID] == "" {
+		t.Errorf("unexpected namespace %+v", ns)
+	}
+}
+
+func TestParseWaveEstimate(t *testing.T) {
+	t.Parallel()
+	if _, ok, err := ParseWaveEstimate(map[string]string{"owner": "team-a"}); ok || err != nil {
+		t.Errorf("expected no estimate on foreign plans, got %v %v", ok, err)
+	}
+	if _, _, err := ParseWaveEstimate(map[string]string{MTVAnnotationEstimate: "{"}); err == nil {
+		t.Errorf("expected error for case %q, got nil", "invalid annotation")
+	}
+}
+
+func TestSmartsheetCSV_Sites(t *testing.T) {
+	t.Parallel()
+	c := chart(t)
+	c.AssignSources(map[string]string{"wave-1": "vcenter-east", "wave-2": "vcenter-paris"})
+	east, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	c.Sites = []gantt.Site{{Source: "vcenter-east", Location: east}}
+
+	out, err := SmartsheetCSV(c)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("expected valid CSV, got: %v", err)
+	}
+	if got := records[0][len(records[0])-2]; got != "Site Start Date" {
+		t.Errorf("expected the site columns, got %v", records[0])
+	}
+	// wave-1 starts at midnight UTC, 7pm the day before in New York
+	if got := records[1][9:]; got[0] != "America/New_York" || got[1] != "2026-03-01 19:00" {
+		t.Errorf("expected wave-1 in the local time of its site, got %v", got)
+	}
+	if got := records[4][9:]; got[0] != "" || got[1] != "" {
+		t.Errorf("expected no site dates for a source without site, got %v", got)
+	}
+}
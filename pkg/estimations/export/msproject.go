@@ -0,0 +1,181 @@
+// Package export renders a Gantt chart in the interchange formats of project management tools,
+// so a PMO can import the migration schedule directly instead of re-keying it, and reads the
+// schedules edited there back into the plan.
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+const (
+	msProjectNamespace = "http://schemas.microsoft.com/project"
+	msProjectDateTime  = "2006-01-02T15:04:05"
+
+	// msProjectElapsedHours is the duration format for elapsed hours: lead time runs around the clock.
+	msProjectElapsedHours = 6
+	// msProjectStartNoEarlierThan pins a task on its computed start.
+	msProjectStartNoEarlierThan = 4
+)
+
+// MS Project predecessor link types.
+const (
+	msProjectFinishToStart = 1
+	msProjectStartToStart  = 3
+)
+
+type msProject struct {
+	XMLName     xml.Name              `xml:"Project"`
+	Xmlns       string                `xml:"xmlns,attr"`
+	Name        string                `xml:"Name"`
+	Title       string                `xml:"Title"`
+	StartDate   string                `xml:"StartDate"`
+	FinishDate  string                `xml:"FinishDate"`
+	Tasks       []msProjectTask       `xml:"Tasks>Task"`
+	Resources   []msProjectResource   `xml:"Resources>Resource,omitempty"`
+	Assignments []msProjectAssignment `xml:"Assignments>Assignment,omitempty"`
+}
+
+type msProjectTask struct {
+	UID            int                    `xml:"UID"`
+	ID             int                    `xml:"ID"`
+	Name           string                 `xml:"Name"`
+	OutlineLevel   int                    `xml:"OutlineLevel"`
+	Summary        int                    `xml:"Summary"`
+	Start          string                 `xml:"Start"`
+	Finish         string                 `xml:"Finish"`
+	Duration       string                 `xml:"Duration"`
+	DurationFormat int                    `xml:"DurationFormat"`
+	ConstraintType int                    `xml:"ConstraintType,omitempty"`
+	ConstraintDate string                 `xml:"ConstraintDate,omitempty"`
+	Predecessors   []msProjectPredecessor `xml:"PredecessorLink"`
+}
+
+type msProjectPredecessor struct {
+	PredecessorUID int `xml:"PredecessorUID"`
+	Type           int `xml:"Type"`
+}
+
+type msProjectResource struct {
+	UID  int    `xml:"UID"`
+	ID   int    `xml:"ID"`
+	Name string `xml:"Name"`
+	// MaxUnits is a ratio: 1 is one full-time unit.
+	MaxUnits int `xml:"MaxUnits"`
+}
+
+type msProjectAssignment struct {
+	UID         int `xml:"UID"`
+	TaskUID     int `xml:"TaskUID"`
+	ResourceUID int `xml:"ResourceUID"`
+	Units       int `xml:"Units"`
+}
+
+// MSProject renders the chart as an MS Project XML (MSPDI) document named name. Each wave is a
+// summary task holding its phases, dependencies become predecessor links and the pool held by a
+// phase becomes a resource assignment. pools gives the size of each pool; pools not listed are
+// exported with the largest number of units assigned from them.
+func MSProject(name string, c gantt.Chart, pools map[string]int) ([]byte, error) {
+	doc := msProject{
+		Xmlns:      msProjectNamespace,
+		Name:       name,
+		Title:      name,
+		StartDate:  c.Start.Format(msProjectDateTime),
+		FinishDate: c.End.Format(msProjectDateTime),
+	}
+
+	uids := make(map[scheduling.BarID]int, len(c.Bars))
+	resources := make(map[string]int)
+	uid := 0
+	for _, w := range c.Waves {
+		uid++
+		doc.Tasks = append(doc.Tasks, msProjectTask{
+			UID:            uid,
+			ID:             uid,
+			Name:           w.Name,
+			OutlineLevel:   1,
+			Summary:        1,
+			Start:          w.Start.Format(msProjectDateTime),
+			Finish:         w.End.Format(msProjectDateTime),
+			Duration:       msProjectDuration(w.End.Sub(w.Start)),
+			DurationFormat: msProjectElapsedHours,
+		})
+
+		for _, b := range c.Bars {
+			if b.Wave != w.Name {
+				continue
+			}
+			uid++
+			uids[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}] = uid
+			doc.Tasks = append(doc.Tasks, msProjectTask{
+				UID:            uid,
+				ID:             uid,
+				Name:           b.Phase,
+				OutlineLevel:   2,
+				Start:          b.Start.Format(msProjectDateTime),
+				Finish:         b.End.Format(msProjectDateTime),
+				Duration:       msProjectDuration(b.End.Sub(b.Start)),
+				DurationFormat: msProjectElapsedHours,
+				ConstraintType: msProjectStartNoEarlierThan,
+				ConstraintDate: b.Start.Format(msProjectDateTime),
+			})
+
+			if b.Pool == "" {
+				continue
+			}
+			r, ok := resources[b.Pool]
+			if !ok {
+				r = len(doc.Resources) + 1
+				resources[b.Pool] = r
+				doc.Resources = append(doc.Resources, msProjectResource{UID: r, ID: r, Name: b.Pool, MaxUnits: pools[b.Pool]})
+			}
+			if b.Units > doc.Resources[r-1].MaxUnits {
+				doc.Resources[r-1].MaxUnits = b.Units
+			}
+			doc.Assignments = append(doc.Assignments, msProjectAssignment{
+				UID:         len(doc.Assignments) + 1,
+				TaskUID:     uid,
+				ResourceUID: r,
+				Units:       b.Units,
+			})
+		}
+	}
+
+	tasks := make(map[int]int, len(doc.Tasks))
+	for i, t := range doc.Tasks {
+		tasks[t.UID] = i
+	}
+	for _, d := range c.Dependencies {
+		from, ok := uids[d.From]
+		to, found := uids[d.To]
+		if !ok || !found {
+			return nil, fmt.Errorf("dependency %s/%s -> %s/%s references an unknown bar", d.From.Wave, d.From.Phase, d.To.Wave, d.To.Phase)
+		}
+		kind := msProjectFinishToStart
+		if d.Type == gantt.DependencyStartToStart {
+			kind = msProjectStartToStart
+		}
+		t := &doc.Tasks[tasks[to]]
+		t.Predecessors = append(t.Predecessors, msProjectPredecessor{PredecessorUID: from, Type: kind})
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MS Project document: %w", err)
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// msProjectDuration formats d as the ISO 8601 duration MS Project expects, e.g. PT36H30M0S.
+func msProjectDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	h := d / time.Hour
+	d -= h * time.Hour
+	m := d / time.Minute
+	d -= m * time.Minute
+	return fmt.Sprintf("PT%dH%dM%dS", h, m, d/time.Second)
+}
@@ -0,0 +1,185 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/dnslabel"
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/namespaces"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// MTVAnnotationPlanID is the annotation of an MTV Plan holding the ID of the planner plan it comes from.
+	MTVAnnotationPlanID = "migration-planner.kubev2v.io/plan-id"
+	// MTVAnnotationEstimate is the annotation of an MTV Plan holding the WaveEstimate of its wave as compact JSON.
+	MTVAnnotationEstimate = "migration-planner.kubev2v.io/estimate"
+
+	mtvAPIVersion = "forklift.konveyor.io/v1beta1"
+)
+
+// WaveEstimate is the estimate of a wave as planned, embedded in the MTV Plan migrating it so an
+// executed migration can always be traced back to its estimate.
+type WaveEstimate struct {
+	PlanID string          `json:"planId"`
+	Wave   string          `json:"wave"`
+	Start  time.Time       `json:"start"`
+	End    time.Time       `json:"end"`
+	Phases []PhaseEstimate `json:"phases"`
+}
+
+// PhaseEstimate is the estimated span of a phase of a wave.
+type PhaseEstimate struct {
+	Phase string    `json:"phase"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Pool  string    `json:"pool,omitempty"`
+	Units int       `json:"units,omitempty"`
+}
+
+type mtvPlan struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Metadata   mtvMetadata `json:"metadata"`
+	Spec       mtvPlanSpec `json:"spec"`
+}
+
+type mtvMetadata struct {
+	Name        string            `json:"name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type mtvPlanSpec struct {
+	Description     string  `json:"description"`
+	TargetNamespace string  `json:"targetNamespace,omitempty"`
+	VMs             []mtvVM `json:"vms,omitempty"`
+}
+
+type mtvVM struct {
+	ID string `json:"id"`
+}
+
+type mtvOptions struct {
+	design *namespaces.Design
+}
+
+// MTVOption is a functional option for rendering MTV Plans.
+type MTVOption func(*mtvOptions)
+
+// WithNamespaces splits the Plan of each wave into a Plan per target namespace of the design,
+// listing the VMs the wave migrates to it. The VMs of the wave the design leaves unmapped get a Plan
+// without target namespace.
+func WithNamespaces(d namespaces.Design) MTVOption {
+	return func(o *mtvOptions) {
+		o.design = &d
+	}
+}
+
+// MTVPlans renders a Plan custom resource of Migration Toolkit for Virtualization for each wave of
+// the chart, as a multi-document YAML stream. The resources carry the plan ID and the estimate of
+// their wave in annotations; providers and mappings are left to the migration team, and so are the
+// VMs without namespace design.
+func MTVPlans(planID, name string, c gantt.Chart, opts ...MTVOption) ([]byte, error) {
+	var o mtvOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var buf bytes.Buffer
+	for _, w := range c.Waves {
+		estimate := WaveEstimate{PlanID: planID, Wave: w.Name, Start: w.Start, End: w.End}
+		for _, b := range c.Bars {
+			if b.Wave == w.Name {
+				estimate.Phases = append(estimate.Phases, PhaseEstimate{Phase: b.Phase, Start: b.Start, End: b.End, Pool: b.Pool, Units: b.Units})
+			}
+		}
+		snapshot, err := json.Marshal(estimate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode estimate of wave %s: %w", w.Name, err)
+		}
+
+		cr := mtvPlan{
+			APIVersion: mtvAPIVersion,
+			Kind:       "Plan",
+			Metadata: mtvMetadata{
+				Name:   mtvName(name, w.Name),
+				Labels: map[string]string{MTVAnnotationPlanID: planID},
+				Annotations: map[string]string{
+					MTVAnnotationPlanID:   planID,
+					MTVAnnotationEstimate: string(snapshot),
+				},
+			},
+			Spec: mtvPlanSpec{
+				Description: fmt.Sprintf("%s: %s, planned from %s to %s", name, w.Name, w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339)),
+			},
+		}
+		if o.design == nil {
+			if err := writeMTVPlan(&buf, cr); err != nil {
+				return nil, fmt.Errorf("failed to render MTV plan of wave %s: %w", w.Name, err)
+			}
+			continue
+		}
+
+		targets, unmapped := o.design.Wave(w.Name)
+		for _, ns := range targets {
+			split := cr
+			split.Metadata.Name = mtvName(name, w.Name+"-"+ns.Name)
+			split.Spec.TargetNamespace = ns.Name
+			split.Spec.VMs = mtvVMs(ns.VMs)
+			if err := writeMTVPlan(&buf, split); err != nil {
+				return nil, fmt.Errorf("failed to render MTV plan of wave %s to namespace %s: %w", w.Name, ns.Name, err)
+			}
+		}
+		if len(unmapped) > 0 {
+			cr.Spec.VMs = mtvVMs(unmapped)
+			if err := writeMTVPlan(&buf, cr); err != nil {
+				return nil, fmt.Errorf("failed to render MTV plan of wave %s: %w", w.Name, err)
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// writeMTVPlan appends a Plan to the YAML stream.
+func writeMTVPlan(buf *bytes.Buffer, cr mtvPlan) error {
+	doc, err := yaml.Marshal(cr)
+	if err != nil {
+		return err
+	}
+	if buf.Len() > 0 {
+		buf.WriteString("---\n")
+	}
+	buf.Write(doc)
+	return nil
+}
+
+func mtvVMs(vms []namespaces.VM) []mtvVM {
+	res := make([]mtvVM, 0, len(vms))
+	for _, vm := range vms {
+		res = append(res, mtvVM{ID: vm.ID})
+	}
+	return res
+}
+
+// ParseWaveEstimate reads the estimate embedded in the annotations of an MTV Plan. It returns false
+// when the plan does not come from the planner.
+func ParseWaveEstimate(annotations map[string]string) (WaveEstimate, bool, error) {
+	snapshot, ok := annotations[MTVAnnotationEstimate]
+	if !ok {
+		return WaveEstimate{}, false, nil
+	}
+	var estimate WaveEstimate
+	if err := json.Unmarshal([]byte(snapshot), &estimate); err != nil {
+		return WaveEstimate{}, true, fmt.Errorf("invalid %s annotation: %w", MTVAnnotationEstimate, err)
+	}
+	return estimate, true, nil
+}
+
+// mtvName derives a DNS-1123 label from the plan and wave names.
+func mtvName(plan, wave string) string {
+	return dnslabel.From(plan + "-" + wave)
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/namespaces"
+	"sigs.k8s.io/yaml"
+)
+
+// terraformIdentifier matches the names Terraform accepts for a resource.
+var terraformIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// TerraformNamespaces renders a kubernetes_namespace resource of the Terraform Kubernetes provider
+// for each namespace of the design, labeled with the plan ID.
+func TerraformNamespaces(planID string, d namespaces.Design) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, ns := range d.Namespaces {
+		id := strings.ReplaceAll(ns.Name, "-", "_")
+		if !terraformIdentifier.MatchString(id) {
+			id = "ns_" + id
+		}
+		if !terraformIdentifier.MatchString(id) {
+			return nil, fmt.Errorf("namespace %q has no Terraform resource name", ns.Name)
+		}
+
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "resource \"kubernetes_namespace\" %q {\n", id)
+		buf.WriteString("  metadata {\n")
+		fmt.Fprintf(&buf, "    name = %q\n", ns.Name)
+		buf.WriteString("    labels = {\n")
+		fmt.Fprintf(&buf, "      %q = %q\n", MTVAnnotationPlanID, planID)
+		buf.WriteString("    }\n")
+		buf.WriteString("  }\n")
+		buf.WriteString("}\n")
+	}
+	return buf.Bytes(), nil
+}
+
+type ansiblePlay struct {
+	Name        string        `json:"name"`
+	Hosts       string        `json:"hosts"`
+	GatherFacts bool          `json:"gather_facts"`
+	Tasks       []ansibleTask `json:"tasks"`
+}
+
+type ansibleTask struct {
+	Name string     `json:"name"`
+	K8s  ansibleK8s `json:"kubernetes.core.k8s"`
+}
+
+type ansibleK8s struct {
+	State      string       `json:"state"`
+	Definition k8sNamespace `json:"definition"`
+}
+
+type k8sNamespace struct {
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Metadata   k8sMetadata `json:"metadata"`
+}
+
+type k8sMetadata struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+}
+
+// AnsibleNamespaces renders an Ansible playbook creating each namespace of the design with the
+// kubernetes.core.k8s module, labeled with the plan ID.
+func AnsibleNamespaces(planID, name string, d namespaces.Design) ([]byte, error) {
+	play := ansiblePlay{
+		Name:  fmt.Sprintf("Create the target namespaces of %s", name),
+		Hosts: "localhost",
+		Tasks: []ansibleTask{},
+	}
+	for _, ns := range d.Namespaces {
+		play.Tasks = append(play.Tasks, ansibleTask{
+			Name: fmt.Sprintf("Create namespace %s", ns.Name),
+			K8s: ansibleK8s{
+				State: "present",
+				Definition: k8sNamespace{
+					APIVersion: "v1",
+					Kind:       "Namespace",
+					Metadata: k8sMetadata{
+						Name:   ns.Name,
+						Labels: map[string]string{MTVAnnotationPlanID: planID},
+					},
+				},
+			},
+		})
+	}
+
+	doc, err := yaml.Marshal([]ansiblePlay{play})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render Ansible playbook: %w", err)
+	}
+	return doc, nil
+}
@@ -0,0 +1,141 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+// DiscrepancyKind is the reason an imported schedule departs from the estimates.
+type DiscrepancyKind string
+
+const (
+	// DiscrepancyUnknownPhase is a phase of the import missing from the plan. It is not imported.
+	DiscrepancyUnknownPhase DiscrepancyKind = "unknown-phase"
+	// DiscrepancyMissingPhase is a phase of the plan missing from the import. It keeps its computed dates.
+	DiscrepancyMissingPhase DiscrepancyKind = "missing-phase"
+	// DiscrepancyEffortShortened is a phase given less time than the estimated effort.
+	DiscrepancyEffortShortened DiscrepancyKind = "effort-shortened"
+	// DiscrepancyLeadTimeShortened is a phase given time for its effort but not for all its lead time.
+	DiscrepancyLeadTimeShortened DiscrepancyKind = "lead-time-shortened"
+	// DiscrepancyDependencyViolated is a phase starting before a phase it depends on allows it.
+	DiscrepancyDependencyViolated DiscrepancyKind = "dependency-violated"
+)
+
+// Discrepancy flags a phase whose imported dates do not match the estimates.
+type Discrepancy struct {
+	Wave    string
+	Phase   string
+	Kind    DiscrepancyKind
+	Message string
+}
+
+// ParseMSProject reads back the phases of an MS Project XML document, as exported by MSProject and
+// edited by a PM: top-level tasks are waves and the tasks below them their phases. MS Project dates
+// have no time zone: they are read in loc, the location of the plan the document was exported from.
+func ParseMSProject(data []byte, loc *time.Location) ([]plan.ScheduledPhase, error) {
+	var doc msProject
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse MS Project document: %w", err)
+	}
+
+	var (
+		phases []plan.ScheduledPhase
+		wave   string
+	)
+	for _, t := range doc.Tasks {
+		switch t.OutlineLevel {
+		case 0:
+			// project summary task
+			continue
+		case 1:
+			wave = t.Name
+			continue
+		case 2:
+		default:
+			return nil, fmt.Errorf("task %q is nested below a phase", t.Name)
+		}
+		if wave == "" {
+			return nil, fmt.Errorf("task %q is not part of a wave", t.Name)
+		}
+
+		start, err := time.ParseInLocation(msProjectDateTime, t.Start, loc)
+		if err != nil {
+			return nil, fmt.Errorf("task %q has invalid start %q", t.Name, t.Start)
+		}
+		end, err := time.ParseInLocation(msProjectDateTime, t.Finish, loc)
+		if err != nil {
+			return nil, fmt.Errorf("task %q has invalid finish %q", t.Name, t.Finish)
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("task %q finishes before it starts", t.Name)
+		}
+		phases = append(phases, plan.ScheduledPhase{Wave: wave, Phase: t.Name, Start: start, End: end})
+	}
+	return phases, nil
+}
+
+// Reconcile compares an imported schedule with the chart laid out from the estimates. Phases are
+// matched by wave and phase name; the planner stays the source of truth for effort, so a phase given
+// less time than estimated or starting before its dependencies allow is flagged.
+func Reconcile(c gantt.Chart, schedule []plan.ScheduledPhase) []Discrepancy {
+	bars := make(map[scheduling.BarID]gantt.Bar, len(c.Bars))
+	for _, b := range c.Bars {
+		bars[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}] = b
+	}
+
+	var discrepancies []Discrepancy
+	scheduled := make(map[scheduling.BarID]plan.ScheduledPhase, len(schedule))
+	for _, s := range schedule {
+		id := scheduling.BarID{Wave: s.Wave, Phase: s.Phase}
+		b, ok := bars[id]
+		if !ok {
+			discrepancies = append(discrepancies, Discrepancy{Wave: s.Wave, Phase: s.Phase, Kind: DiscrepancyUnknownPhase,
+				Message: "phase is not part of the plan"})
+			continue
+		}
+		scheduled[id] = s
+
+		span, effort, planned := s.End.Sub(s.Start), b.Released.Sub(b.Start), b.End.Sub(b.Start)
+		switch {
+		case span < effort:
+			discrepancies = append(discrepancies, Discrepancy{Wave: s.Wave, Phase: s.Phase, Kind: DiscrepancyEffortShortened,
+				Message: fmt.Sprintf("scheduled for %s while the effort is estimated at %s", span, effort)})
+		case span < planned:
+			discrepancies = append(discrepancies, Discrepancy{Wave: s.Wave, Phase: s.Phase, Kind: DiscrepancyLeadTimeShortened,
+				Message: fmt.Sprintf("scheduled for %s while effort and lead time take %s", span, planned)})
+		}
+	}
+
+	for _, b := range c.Bars {
+		if _, ok := scheduled[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}]; !ok {
+			discrepancies = append(discrepancies, Discrepancy{Wave: b.Wave, Phase: b.Phase, Kind: DiscrepancyMissingPhase,
+				Message: "phase is missing from the schedule"})
+		}
+	}
+
+	dates := func(id scheduling.BarID) (time.Time, time.Time) {
+		if s, ok := scheduled[id]; ok {
+			return s.Start, s.End
+		}
+		return bars[id].Start, bars[id].End
+	}
+	for _, d := range c.Dependencies {
+		fromStart, fromEnd := dates(d.From)
+		toStart, _ := dates(d.To)
+		earliest := fromEnd
+		if d.Type == gantt.DependencyStartToStart {
+			earliest = fromStart
+		}
+		if toStart.Before(earliest) {
+			discrepancies = append(discrepancies, Discrepancy{Wave: d.To.Wave, Phase: d.To.Phase, Kind: DiscrepancyDependencyViolated,
+				Message: fmt.Sprintf("starts %s before %s / %s allows it", earliest.Sub(toStart), d.From.Wave, d.From.Phase)})
+		}
+	}
+
+	return discrepancies
+}
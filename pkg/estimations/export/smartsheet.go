@@ -0,0 +1,101 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+const smartsheetDateTime = "2006-01-02 15:04"
+
+var smartsheetHeader = []string{"Task Name", "Wave", "Level", "Start Date", "End Date", "Duration", "Predecessors", "Assigned To", "Units"}
+
+// smartsheetSiteHeader are the columns added for a program whose sources have sites: the dates as
+// read on the wall clock of the site migrated.
+var smartsheetSiteHeader = []string{"Site Time Zone", "Site Start Date", "Site End Date"}
+
+// SmartsheetCSV renders the chart as a CSV Smartsheet imports as a project sheet. Wave rows come
+// first with their phases indented below them; Predecessors references rows by their 1-based
+// number as Smartsheet does, e.g. "2FS, 4SS". A program whose sources have sites gets the dates
+// in the local time of each site as well.
+func SmartsheetCSV(c gantt.Chart) ([]byte, error) {
+	rows := make(map[scheduling.BarID]int, len(c.Bars))
+	n := 0
+	for _, w := range c.Waves {
+		n++
+		for _, b := range c.Bars {
+			if b.Wave == w.Name {
+				n++
+				rows[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}] = n
+			}
+		}
+	}
+
+	predecessors := make(map[scheduling.BarID][]string)
+	for _, d := range c.Dependencies {
+		from, ok := rows[d.From]
+		if _, found := rows[d.To]; !ok || !found {
+			return nil, fmt.Errorf("dependency %s/%s -> %s/%s references an unknown bar", d.From.Wave, d.From.Phase, d.To.Wave, d.To.Phase)
+		}
+		kind := "FS"
+		if d.Type == gantt.DependencyStartToStart {
+			kind = "SS"
+		}
+		predecessors[d.To] = append(predecessors[d.To], strconv.Itoa(from)+kind)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := smartsheetHeader
+	if len(c.Sites) > 0 {
+		header = append(append([]string{}, smartsheetHeader...), smartsheetSiteHeader...)
+	}
+	// site appends the site-local dates to a record when the program has sites
+	site := func(record []string, source string, start, end time.Time) []string {
+		if len(c.Sites) == 0 {
+			return record
+		}
+		loc := c.Location(source)
+		if loc == nil {
+			return append(record, "", "", "")
+		}
+		return append(record, loc.String(), start.In(loc).Format(smartsheetDateTime), end.In(loc).Format(smartsheetDateTime))
+	}
+	records := [][]string{header}
+	for _, wave := range c.Waves {
+		records = append(records, site([]string{
+			wave.Name, wave.Name, "0",
+			wave.Start.Format(smartsheetDateTime), wave.End.Format(smartsheetDateTime), smartsheetDuration(wave.End.Sub(wave.Start)),
+			"", "", "",
+		}, wave.Source, wave.Start, wave.End))
+		for _, b := range c.Bars {
+			if b.Wave != wave.Name {
+				continue
+			}
+			units := ""
+			if b.Pool != "" {
+				units = strconv.Itoa(b.Units)
+			}
+			records = append(records, site([]string{
+				b.Phase, b.Wave, "1",
+				b.Start.Format(smartsheetDateTime), b.End.Format(smartsheetDateTime), smartsheetDuration(b.End.Sub(b.Start)),
+				strings.Join(predecessors[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}], ", "), b.Pool, units,
+			}, b.Source, b.Start, b.End))
+		}
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("failed to write Smartsheet CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// smartsheetDuration formats d in elapsed hours, e.g. "36.5eh": lead time runs around the clock.
+func smartsheetDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', -1, 64) + "eh"
+}
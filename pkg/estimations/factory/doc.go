@@ -0,0 +1,8 @@
+// Package factory plans a migration top-down from the throughput of a migration factory: instead of
+// adding up per-VM times, the program sets a target number of VMs migrated per week and Solve
+// back-computes the bandwidth, the engineers and the wave size the target requires.
+//
+// The requirements are compared with the current assumptions, the params an estimation of the same
+// cluster runs with, so the model tells which resource makes the target infeasible and the
+// throughput the assumptions sustain instead.
+package factory
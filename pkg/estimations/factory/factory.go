@@ -0,0 +1,250 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+const (
+	// ParamWorkDaysPerWeek is the estimation.Param key for the number of days per week the engineers work.
+	ParamWorkDaysPerWeek = "work_days_per_week"
+	// ParamTransferHoursPerWeek is the estimation.Param key for the number of hours per week the link
+	// transfers, e.g. 60 for nights and weekends only.
+	ParamTransferHoursPerWeek = "transfer_hours_per_week"
+	// ParamWavesPerWeek is the estimation.Param key for the number of waves the factory cuts over per week.
+	ParamWavesPerWeek = "waves_per_week"
+	// ParamMaxWaveVMs is the estimation.Param key for the number of VMs a wave can cut over at most.
+	ParamMaxWaveVMs = "max_wave_vms"
+
+	DefaultWorkDaysPerWeek      = 5.0
+	DefaultTransferHoursPerWeek = 7 * 24.0
+	DefaultWavesPerWeek         = 1
+)
+
+// Resource is a resource of the factory the throughput depends on.
+type Resource string
+
+const (
+	ResourceBandwidth Resource = "bandwidth"
+	ResourceEngineers Resource = "engineers"
+	ResourceWaveSize  Resource = "wave-size"
+)
+
+// quantities name the amounts of each resource in the messages of the requirements.
+var quantities = map[Resource]string{
+	ResourceBandwidth: "Mbps",
+	ResourceEngineers: "engineers",
+	ResourceWaveSize:  "VMs per wave",
+}
+
+// Assumptions are the resources and per-VM figures the factory runs with.
+type Assumptions struct {
+	// VMs and DiskGB are the scope of the migration.
+	VMs    int
+	DiskGB float64
+
+	TransferRateMbps     float64
+	TransferHoursPerWeek float64
+	Engineers            int
+	// EngineerMinsPerVM is the engineer time each VM takes, the troubleshooting after its migration.
+	EngineerMinsPerVM float64
+	WorkHoursPerDay   float64
+	WorkDaysPerWeek   float64
+	WavesPerWeek      int
+	// MaxWaveVMs is the largest wave the factory cuts over, zero when waves are not limited.
+	MaxWaveVMs int
+}
+
+// NewAssumptions reads the assumptions from the params of an estimation, falling back to the
+// defaults of the calculators.
+func NewAssumptions(params []estimation.Param) (Assumptions, error) {
+	a := Assumptions{
+		TransferRateMbps:     calculators.DefaultTransferRateMbps,
+		TransferHoursPerWeek: DefaultTransferHoursPerWeek,
+		Engineers:            calculators.DefaultEngineerCount,
+		EngineerMinsPerVM:    calculators.DefaultTroubleshootMinsPerVM,
+		WorkHoursPerDay:      calculators.DefaultWorkHoursPerDay,
+		WorkDaysPerWeek:      DefaultWorkDaysPerWeek,
+		WavesPerWeek:         DefaultWavesPerWeek,
+	}
+	for _, p := range params {
+		v, err := value(p)
+		if err != nil {
+			continue
+		}
+		switch p.Key {
+		case calculators.ParamVMCount:
+			a.VMs = int(v)
+		case calculators.ParamTotalDiskGB:
+			a.DiskGB = v
+		case calculators.ParamTransferRateMbps:
+			a.TransferRateMbps = v
+		case ParamTransferHoursPerWeek:
+			a.TransferHoursPerWeek = v
+		case calculators.ParamPostMigrationEngineers:
+			a.Engineers = int(v)
+		case calculators.ParamTroubleshootMinsPerVM:
+			a.EngineerMinsPerVM = v
+		case calculators.ParamWorkHoursPerDay:
+			a.WorkHoursPerDay = v
+		case ParamWorkDaysPerWeek:
+			a.WorkDaysPerWeek = v
+		case ParamWavesPerWeek:
+			a.WavesPerWeek = int(v)
+		case ParamMaxWaveVMs:
+			a.MaxWaveVMs = int(v)
+		}
+	}
+	return a, a.Validate()
+}
+
+// Validate checks the assumptions can sustain a throughput.
+func (a Assumptions) Validate() error {
+	switch {
+	case a.VMs <= 0:
+		return errors.New("the migration has no VMs")
+	case a.DiskGB < 0:
+		return errors.New("disk size must be non-negative")
+	case a.TransferRateMbps <= 0:
+		return errors.New("transfer rate must be positive")
+	case a.TransferHoursPerWeek <= 0 || a.TransferHoursPerWeek > 7*24:
+		return fmt.Errorf("transfer hours per week %.4g must be in (0, 168]", a.TransferHoursPerWeek)
+	case a.Engineers < 0:
+		return errors.New("engineers must be non-negative")
+	case a.EngineerMinsPerVM < 0:
+		return errors.New("engineer minutes per VM must be non-negative")
+	case a.WorkHoursPerDay <= 0 || a.WorkHoursPerDay > 24:
+		return fmt.Errorf("work hours per day %.4g must be in (0, 24]", a.WorkHoursPerDay)
+	case a.WorkDaysPerWeek <= 0 || a.WorkDaysPerWeek > 7:
+		return fmt.Errorf("work days per week %.4g must be in (0, 7]", a.WorkDaysPerWeek)
+	case a.WavesPerWeek <= 0:
+		return errors.New("waves per week must be positive")
+	case a.MaxWaveVMs < 0:
+		return errors.New("max wave VMs must be non-negative")
+	}
+	return nil
+}
+
+// Requirement is what the target requires of a resource, against what the assumptions provide.
+type Requirement struct {
+	Resource Resource
+	Unit     estimation.Unit
+	Required float64
+	// Available is the resource the assumptions provide.
+	Available float64
+	// MaxVMsPerWeek is the throughput the available resource sustains.
+	MaxVMsPerWeek float64
+	Feasible      bool
+	Message       string
+}
+
+// Model is the factory the target requires.
+type Model struct {
+	TargetVMsPerWeek float64
+	Assumptions      Assumptions
+	// Weeks is the number of weeks the factory takes to migrate every VM at the target.
+	Weeks int
+	// WaveVMs is the size of the waves cutting over the target, Waves their number.
+	WaveVMs int
+	Waves   int
+	// Requirements are those of the resources limiting the throughput. A resource the migration does
+	// not use, e.g. the engineers of VMs taking no engineer time, is left out.
+	Requirements []Requirement
+	// AchievableVMsPerWeek is the throughput the assumptions sustain, capped at the target.
+	AchievableVMsPerWeek float64
+	// Bottleneck is the resource sustaining the lowest throughput when the target is infeasible.
+	Bottleneck Resource
+}
+
+// Feasible reports whether the assumptions sustain the target.
+func (m Model) Feasible() bool {
+	return m.Bottleneck == ""
+}
+
+// Solve back-computes the resources migrating vmsPerWeek VMs per week. The transfer runs during the
+// transfer hours of the week, the engineers work their days and the waves are evenly sized.
+func Solve(vmsPerWeek float64, a Assumptions) (Model, error) {
+	if vmsPerWeek <= 0 || math.IsNaN(vmsPerWeek) || math.IsInf(vmsPerWeek, 0) {
+		return Model{}, fmt.Errorf("invalid target of %v VMs per week", vmsPerWeek)
+	}
+	if err := a.Validate(); err != nil {
+		return Model{}, err
+	}
+
+	m := Model{
+		TargetVMsPerWeek:     vmsPerWeek,
+		Assumptions:          a,
+		Weeks:                int(math.Ceil(float64(a.VMs) / vmsPerWeek)),
+		WaveVMs:              int(math.Ceil(vmsPerWeek / float64(a.WavesPerWeek))),
+		AchievableVMsPerWeek: vmsPerWeek,
+	}
+	m.Waves = int(math.Ceil(float64(a.VMs) / float64(m.WaveVMs)))
+
+	if gbPerVM := a.DiskGB / float64(a.VMs); gbPerVM > 0 {
+		// Mbps sustaining a VM per week
+		mbpsPerVM := gbPerVM * 1024 * 8 / (a.TransferHoursPerWeek * 3600)
+		m.add(Requirement{
+			Resource:      ResourceBandwidth,
+			Unit:          estimation.UnitMbps,
+			Required:      round(vmsPerWeek * mbpsPerVM),
+			Available:     a.TransferRateMbps,
+			MaxVMsPerWeek: round(a.TransferRateMbps / mbpsPerVM),
+		})
+	}
+
+	if a.EngineerMinsPerVM > 0 {
+		hoursPerEngineer := a.WorkHoursPerDay * a.WorkDaysPerWeek
+		required := vmsPerWeek * a.EngineerMinsPerVM / 60 / hoursPerEngineer
+		m.add(Requirement{
+			Resource:      ResourceEngineers,
+			Unit:          estimation.UnitCount,
+			Required:      math.Ceil(required - 1e-9),
+			Available:     float64(a.Engineers),
+			MaxVMsPerWeek: round(float64(a.Engineers) * hoursPerEngineer * 60 / a.EngineerMinsPerVM),
+		})
+	}
+
+	if a.MaxWaveVMs > 0 {
+		m.add(Requirement{
+			Resource:      ResourceWaveSize,
+			Unit:          estimation.UnitCount,
+			Required:      float64(m.WaveVMs),
+			Available:     float64(a.MaxWaveVMs),
+			MaxVMsPerWeek: float64(a.MaxWaveVMs * a.WavesPerWeek),
+		})
+	}
+	return m, nil
+}
+
+// add adds the requirement, explaining it and recording whether it makes the target infeasible.
+func (m *Model) add(r Requirement) {
+	r.Feasible = r.MaxVMsPerWeek >= m.TargetVMsPerWeek
+	r.Message = fmt.Sprintf("%.4g %s required, %.4g available: up to %.4g VMs per week", r.Required, quantities[r.Resource], r.Available, r.MaxVMsPerWeek)
+	if !r.Feasible {
+		r.Message = fmt.Sprintf("infeasible: %s", r.Message)
+		if r.MaxVMsPerWeek < m.AchievableVMsPerWeek {
+			m.AchievableVMsPerWeek = r.MaxVMsPerWeek
+			m.Bottleneck = r.Resource
+		}
+	}
+	m.Requirements = append(m.Requirements, r)
+}
+
+func value(p estimation.Param) (float64, error) {
+	switch v := p.Value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	default:
+		return 0, fmt.Errorf("param %s is not a number", p.Key)
+	}
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,108 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// testAssumptions migrate 1000 VMs of 100 GB over the default 620 Mbps link with 10 engineers
+// spending an hour on each VM: the link sustains about 457 VMs per week, the engineers 400.
+func testAssumptions(t *testing.T, params ...estimation.Param) Assumptions {
+	t.Helper()
+	a, err := NewAssumptions(append([]estimation.Param{
+		{Key: calculators.ParamVMCount, Value: 1000},
+		{Key: calculators.ParamTotalDiskGB, Value: 100000.0},
+	}, params...))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	return a
+}
+
+func requirement(m Model, r Resource) *Requirement {
+	for i := range m.Requirements {
+		if m.Requirements[i].Resource == r {
+			return &m.Requirements[i]
+		}
+	}
+	return nil
+}
+
+func TestSolve_Feasible(t *testing.T) {
+	t.Parallel()
+	m, err := Solve(200, testAssumptions(t))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !m.Feasible() || m.AchievableVMsPerWeek != 200 {
+		t.Errorf("expected 200 VMs per week feasible, got %+v", m)
+	}
+	if m.Weeks != 5 || m.WaveVMs != 200 || m.Waves != 5 {
+		t.Errorf("expected 5 weekly waves of 200 VMs, got %d weeks of %d waves of %d", m.Weeks, m.Waves, m.WaveVMs)
+	}
+	if bw := requirement(m, ResourceBandwidth); bw == nil || bw.Required != 270.9 || bw.MaxVMsPerWeek != 457.73 || !bw.Feasible {
+		t.Errorf("unexpected bandwidth requirement %+v", bw)
+	}
+	if eng := requirement(m, ResourceEngineers); eng == nil || eng.Required != 5 || eng.Available != 10 {
+		t.Errorf("unexpected engineers requirement %+v", eng)
+	}
+	if requirement(m, ResourceWaveSize) != nil {
+		t.Errorf("expected no wave size requirement without a limit")
+	}
+}
+
+func TestSolve_Bottleneck(t *testing.T) {
+	t.Parallel()
+	m, err := Solve(500, testAssumptions(t))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if m.Feasible() || m.Bottleneck != ResourceEngineers || m.AchievableVMsPerWeek != 400 {
+		t.Errorf("expected the engineers to cap the factory at 400 VMs per week, got %s at %v", m.Bottleneck, m.AchievableVMsPerWeek)
+	}
+	bw := requirement(m, ResourceBandwidth)
+	if bw == nil || bw.Feasible || !strings.HasPrefix(bw.Message, "infeasible: 677.2 Mbps required, 620 available") {
+		t.Errorf("expected the bandwidth infeasible too, got %+v", bw)
+	}
+	if eng := requirement(m, ResourceEngineers); eng.Required != 13 {
+		t.Errorf("expected 13 engineers required, got %v", eng.Required)
+	}
+
+	// nights and weekends only, 2 waves a week of at most 150 VMs
+	m, err = Solve(500, testAssumptions(t,
+		estimation.Param{Key: ParamTransferHoursPerWeek, Value: 60.0},
+		estimation.Param{Key: calculators.ParamPostMigrationEngineers, Value: 20.0},
+		estimation.Param{Key: ParamWavesPerWeek, Value: 2.0},
+		estimation.Param{Key: ParamMaxWaveVMs, Value: 150.0},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if m.WaveVMs != 250 || m.Bottleneck != ResourceBandwidth || m.AchievableVMsPerWeek != 163.48 {
+		t.Errorf("expected the 60h link to cap the factory, got %s at %v with waves of %d", m.Bottleneck, m.AchievableVMsPerWeek, m.WaveVMs)
+	}
+	if ws := requirement(m, ResourceWaveSize); ws == nil || ws.Feasible || ws.MaxVMsPerWeek != 300 {
+		t.Errorf("unexpected wave size requirement %+v", ws)
+	}
+}
+
+func TestSolve_Errors(t *testing.T) {
+	t.Parallel()
+	if _, err := Solve(0, testAssumptions(t)); err == nil {
+		t.Errorf("expected an error without target")
+	}
+	if _, err := NewAssumptions(nil); err == nil || !strings.Contains(err.Error(), "no VMs") {
+		t.Errorf("expected an error without VMs, got %v", err)
+	}
+	if _, err := NewAssumptions([]estimation.Param{
+		{Key: calculators.ParamVMCount, Value: 10},
+		{Key: ParamWorkDaysPerWeek, Value: 8.0},
+	}); err == nil {
+		t.Errorf("expected an error for 8 work days per week")
+	}
+}
@@ -0,0 +1,7 @@
+// Package firmware flags the VMs whose firmware needs special handling on KubeVirt: UEFI VMs, whose
+// boot entries live in the source NVRAM, secure boot VMs, which need SMM and signed guest drivers on
+// the target, and VMs with a virtual TPM, whose state is not migrated.
+//
+// The findings are reported as assessment concerns and counted into the params of the
+// FirmwareRemediation calculator, which sizes the remediation work.
+package firmware
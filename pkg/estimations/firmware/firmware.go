@@ -0,0 +1,109 @@
+package firmware
+
+import (
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Kind identifies a firmware issue.
+type Kind string
+
+const (
+	// KindEFIBoot is a UEFI VM without secure boot: its boot entries are not migrated and the
+	// bootloader may have to be reinstalled or registered again.
+	KindEFIBoot Kind = "efi-boot"
+	// KindSecureBoot is a UEFI VM with secure boot: the target VM needs SMM and secure boot
+	// firmware, and the virtio drivers injected into the guest must be signed.
+	KindSecureBoot Kind = "secure-boot"
+	// KindTPM is a VM with a virtual TPM: its state is not migrated, so disks encrypted against it
+	// (BitLocker, LUKS) need their recovery keys and the secrets must be sealed again.
+	KindTPM Kind = "tpm"
+)
+
+// IDs of the concerns of the firmware issues.
+const (
+	ConcernEFIBoot    = "firmware." + string(KindEFIBoot)
+	ConcernSecureBoot = "firmware." + string(KindSecureBoot)
+	ConcernTPM        = "firmware." + string(KindTPM)
+)
+
+// Finding is a firmware issue of a VM.
+type Finding struct {
+	Kind Kind
+	// VM is the name of the VM the finding is about.
+	VM      string
+	Message string
+}
+
+// Concern returns the finding as an assessment concern. A UEFI VM is only informational, since
+// KubeVirt supports EFI; secure boot and TPM need work on the guest.
+func (f Finding) Concern() inventory.Concern {
+	labels := map[Kind]string{
+		KindEFIBoot:    "UEFI boot entries not migrated",
+		KindSecureBoot: "Secure boot enabled",
+		KindTPM:        "Virtual TPM state not migrated",
+	}
+	category := inventory.ConcernCategoryWarning
+	if f.Kind == KindEFIBoot {
+		category = inventory.ConcernCategoryInformation
+	}
+	return inventory.Concern{
+		ID:         "firmware." + string(f.Kind),
+		Label:      labels[f.Kind],
+		Category:   category,
+		Assessment: f.Message,
+	}
+}
+
+// Analyze returns the firmware issues of vms. A secure boot VM is reported for secure boot only, as
+// its boot entries are handled with it; a VM with a TPM is reported for the TPM too. VMs with an
+// unknown or BIOS firmware have no issue.
+func Analyze(vms []inventory.VM) []Finding {
+	var findings []Finding
+	for _, vm := range vms {
+		if vm.Firmware == inventory.FirmwareEFI {
+			if vm.SecureBoot {
+				findings = append(findings, Finding{
+					Kind:    KindSecureBoot,
+					VM:      vm.Name,
+					Message: "secure boot requires SMM and secure boot firmware on the target and signed virtio drivers in the guest",
+				})
+			} else {
+				findings = append(findings, Finding{
+					Kind:    KindEFIBoot,
+					VM:      vm.Name,
+					Message: "UEFI boot entries are kept in the source NVRAM and are not migrated",
+				})
+			}
+		}
+		if vm.TPM {
+			findings = append(findings, Finding{
+				Kind:    KindTPM,
+				VM:      vm.Name,
+				Message: "virtual TPM state is not migrated: disks encrypted against it need their recovery keys",
+			})
+		}
+	}
+	return findings
+}
+
+// Params counts the findings into the params of the FirmwareRemediation calculator.
+func Params(findings []Finding) []estimation.Param {
+	vms := make(map[string]int, 3)
+	for _, f := range findings {
+		vms[f.Concern().ID]++
+	}
+	return ConcernParams(vms)
+}
+
+// ConcernParams is Params for the VM counts of the concerns of an assessment, keyed by concern ID,
+// once its VMs are aggregated away. The assessments aggregate the warnings only, so the UEFI VMs
+// without secure boot count as none there.
+func ConcernParams(vms map[string]int) []estimation.Param {
+	return []estimation.Param{
+		{Key: calculators.ParamEFIBootVMs, Value: vms[ConcernEFIBoot]},
+		{Key: calculators.ParamSecureBootVMs, Value: vms[ConcernSecureBoot]},
+		{Key: calculators.ParamTPMVMs, Value: vms[ConcernTPM]},
+	}
+}
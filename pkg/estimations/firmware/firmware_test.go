@@ -0,0 +1,62 @@
+package firmware
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+	vms := []inventory.VM{
+		{Name: "legacy01", Firmware: inventory.FirmwareBIOS},
+		{Name: "unknown01"},
+		{Name: "web01", Firmware: inventory.FirmwareEFI},
+		{Name: "dc01", Firmware: inventory.FirmwareEFI, SecureBoot: true},
+		{Name: "win11", Firmware: inventory.FirmwareEFI, SecureBoot: true, TPM: true},
+	}
+
+	got := make(map[Kind][]string)
+	for _, f := range Analyze(vms) {
+		got[f.Kind] = append(got[f.Kind], f.VM)
+	}
+	want := map[Kind][]string{
+		KindEFIBoot:    {"web01"},
+		KindSecureBoot: {"dc01", "win11"},
+		KindTPM:        {"win11"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected findings %v, got %v", want, got)
+	}
+}
+
+func TestFinding_Concern(t *testing.T) {
+	t.Parallel()
+	c := Finding{Kind: KindTPM, VM: "win11", Message: "state lost"}.Concern()
+	if c.ID != "firmware.tpm" || c.Category != inventory.ConcernCategoryWarning || c.Assessment != "state lost" {
+		t.Errorf("unexpected concern %+v", c)
+	}
+	if c := (Finding{Kind: KindEFIBoot}).Concern(); c.Category != inventory.ConcernCategoryInformation {
+		t.Errorf("expected UEFI boot to be informational, got %q", c.Category)
+	}
+}
+
+func TestParams(t *testing.T) {
+	t.Parallel()
+	findings := []Finding{{Kind: KindEFIBoot}, {Kind: KindSecureBoot}, {Kind: KindSecureBoot}, {Kind: KindTPM}}
+
+	got := make(map[string]interface{})
+	for _, p := range Params(findings) {
+		got[p.Key] = p.Value
+	}
+	want := map[string]interface{}{
+		calculators.ParamEFIBootVMs:    1,
+		calculators.ParamSecureBootVMs: 2,
+		calculators.ParamTPMVMs:        1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected params %v, got %v", want, got)
+	}
+}
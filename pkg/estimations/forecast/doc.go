@@ -0,0 +1,8 @@
+// Package forecast forecasts the volume a migration program moves quarter by quarter: the VMs and
+// disk GB of its waves, landed in the quarter each wave is expected to end. Waves with progress
+// recorded land when they ended; the remaining ones are simulated, their effort drawn in every
+// trial from the distributions fitted to the actual durations of their phases, or from a
+// three-point range for the phases without one, and the delay met so far carried over, giving the
+// P50 and P80 bands of each quarter. The forecast is a flat list of quarters so BI tools read it as is, in JSON
+// or CSV.
+package forecast
@@ -0,0 +1,332 @@
+package forecast
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/distribution"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/simulation"
+)
+
+const (
+	// DefaultOptimistic and DefaultPessimistic are the factors the effort of a remaining wave is
+	// drawn between when no spread is given, around the effort planned.
+	DefaultOptimistic  = 0.9
+	DefaultPessimistic = 1.5
+)
+
+// Quarter is the volume forecast to be migrated within a fiscal quarter. P80 is the volume
+// migrated in at least 80% of the trials, a safer commitment than the median P50.
+type Quarter struct {
+	// Quarter is the name of the quarter, e.g. "FY2026 Q3".
+	Quarter string    `json:"quarter"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	// ActualVMs and ActualDiskGB are the volume of the waves recorded to have ended in the quarter.
+	ActualVMs           int     `json:"actualVms"`
+	ActualDiskGB        float64 `json:"actualDiskGb"`
+	VMsP50              int     `json:"vmsP50"`
+	VMsP80              int     `json:"vmsP80"`
+	DiskGBP50           float64 `json:"diskGbP50"`
+	DiskGBP80           float64 `json:"diskGbP80"`
+	CumulativeVMsP50    int     `json:"cumulativeVmsP50"`
+	CumulativeVMsP80    int     `json:"cumulativeVmsP80"`
+	CumulativeDiskGBP50 float64 `json:"cumulativeDiskGbP50"`
+	CumulativeDiskGBP80 float64 `json:"cumulativeDiskGbP80"`
+}
+
+// Forecast is the quarterly forecast of a plan.
+type Forecast struct {
+	Plan        string    `json:"plan"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	Trials      int       `json:"trials"`
+	TotalVMs    int       `json:"totalVms"`
+	TotalDiskGB float64   `json:"totalDiskGb"`
+	Quarters    []Quarter `json:"quarters"`
+}
+
+type options struct {
+	trials   int
+	seed     uint64
+	spread   simulation.Triangular
+	fits     map[string]distribution.Distribution
+	calendar cost.FiscalCalendar
+}
+
+// Option is a functional option for configuring a forecast.
+type Option func(*options)
+
+// WithTrials sets the number of trials, simulation.DefaultTrials by default.
+func WithTrials(n int) Option {
+	return func(o *options) {
+		o.trials = n
+	}
+}
+
+// WithSeed seeds the random numbers, so a forecast made twice gives the same result.
+func WithSeed(seed uint64) Option {
+	return func(o *options) {
+		o.seed = seed
+	}
+}
+
+// WithSpread sets the factors the effort of a remaining wave is drawn between, e.g. 0.8 and 2 for
+// a wave done in 80% of the effort planned at best and twice at worst.
+func WithSpread(optimistic, pessimistic float64) Option {
+	return func(o *options) {
+		o.spread = simulation.Triangular{Min: optimistic, Mode: 1, Max: pessimistic}
+	}
+}
+
+// WithDistributions draws the effort of the steps of a phase from the distribution fitted to the
+// actual durations of the phase, keyed by phase name: the effort planned is scaled by a sample
+// relative to the mean of the distribution. The steps of the phases without a fit, e.g. with too
+// few actuals, keep the spread.
+func WithDistributions(fits map[string]distribution.Distribution) Option {
+	return func(o *options) {
+		o.fits = fits
+	}
+}
+
+// WithFiscalCalendar buckets the volume by the quarters of the fiscal year of the calendar,
+// calendar quarters by default.
+func WithFiscalCalendar(cal cost.FiscalCalendar) Option {
+	return func(o *options) {
+		o.calendar = cal
+	}
+}
+
+// New forecasts the volume of the plan as of now. The waves with progress recorded land in the
+// quarter they ended, with the VMs recorded migrated; the others land when they end in each trial,
+// delayed as much as the latest recorded wave ended behind plan, and not before now.
+func New(p plan.Plan, now time.Time, opts ...Option) (Forecast, error) {
+	o := options{
+		trials: simulation.DefaultTrials,
+		spread: simulation.Triangular{Min: DefaultOptimistic, Mode: 1, Max: DefaultPessimistic},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.trials <= 0 {
+		return Forecast{}, fmt.Errorf("trials must be positive, got %d", o.trials)
+	}
+	if err := o.spread.Validate(); err != nil {
+		return Forecast{}, err
+	}
+	if o.spread.Min <= 0 {
+		return Forecast{}, fmt.Errorf("optimistic factor must be positive, got %g", o.spread.Min)
+	}
+
+	baseline, err := waveEnds(p)
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	recorded := make(map[string]plan.WaveProgress, len(p.Progress))
+	var delay time.Duration
+	for _, wp := range p.Progress {
+		recorded[wp.Wave] = wp
+		delay = max(delay, wp.End.Sub(baseline[wp.Wave]))
+	}
+
+	f := Forecast{Plan: p.Name, GeneratedAt: now, Trials: o.trials}
+	var landings []landing
+	for _, w := range p.Waves {
+		if wp, ok := recorded[w.Name]; ok {
+			landings = append(landings, landing{at: wp.End, vms: wp.VMsMigrated, diskGB: w.DiskGB})
+			f.TotalVMs += wp.VMsMigrated
+		} else {
+			f.TotalVMs += w.VMs
+		}
+		f.TotalDiskGB += w.DiskGB
+	}
+
+	// every trial lands the remaining waves once, at the end of the trial
+	r := rand.New(rand.NewPCG(o.seed, o.seed))
+	trials := make([][]landing, o.trials)
+	for i := range trials {
+		ends, err := waveEnds(sample(p, recorded, o, r))
+		if err != nil {
+			return Forecast{}, err
+		}
+		for _, w := range p.Waves {
+			if _, ok := recorded[w.Name]; ok {
+				continue
+			}
+			at := ends[w.Name].Add(delay)
+			if at.Before(now) {
+				at = now
+			}
+			trials[i] = append(trials[i], landing{at: at, vms: w.VMs, diskGB: w.DiskGB})
+		}
+	}
+
+	if f.Quarters, err = quarters(o.calendar, landings, trials); err != nil {
+		return Forecast{}, err
+	}
+	return f, nil
+}
+
+// landing is the volume of a wave migrated at the end of the wave.
+type landing struct {
+	at     time.Time
+	vms    int
+	diskGB float64
+}
+
+// waveEnds lays out the plan and returns the end date of each wave.
+func waveEnds(p plan.Plan) (map[string]time.Time, error) {
+	tl, err := p.Timeline()
+	if err != nil {
+		return nil, err
+	}
+	ends := make(map[string]time.Duration, len(p.Waves))
+	for _, b := range tl.Bars {
+		ends[b.Wave] = max(ends[b.Wave], b.End)
+	}
+	res := make(map[string]time.Time, len(ends))
+	for wave, end := range ends {
+		res[wave] = p.Start.Add(end)
+	}
+	return res, nil
+}
+
+// sample returns a copy of the plan with the effort of each remaining wave scaled by a factor drawn
+// from the spread, but for the steps of the phases with a fitted distribution, scaled by a factor
+// drawn from the distribution.
+func sample(p plan.Plan, recorded map[string]plan.WaveProgress, o options, r *rand.Rand) plan.Plan {
+	trial := p
+	trial.Waves = make([]plan.Wave, len(p.Waves))
+	for i, w := range p.Waves {
+		trial.Waves[i] = w
+		if _, ok := recorded[w.Name]; ok {
+			continue
+		}
+		factor := o.spread.Sample(r)
+		trial.Waves[i].Steps = make([]plan.Step, len(w.Steps))
+		for j, s := range w.Steps {
+			if d, ok := o.fits[s.Phase]; ok && d != nil && d.Mean() > 0 {
+				s.Effort = time.Duration(float64(s.Effort) * d.Sample(r) / d.Mean())
+			} else {
+				s.Effort = time.Duration(float64(s.Effort) * factor)
+			}
+			trial.Waves[i].Steps[j] = s
+		}
+	}
+	return trial
+}
+
+// quarters buckets the landings by fiscal quarter, from the first quarter with a landing to the last.
+func quarters(cal cost.FiscalCalendar, actuals []landing, trials [][]landing) ([]Quarter, error) {
+	var first, last time.Time
+	span := func(ls []landing) {
+		for _, l := range ls {
+			if first.IsZero() || l.at.Before(first) {
+				first = l.at
+			}
+			if l.at.After(last) {
+				last = l.at
+			}
+		}
+	}
+	span(actuals)
+	for _, trial := range trials {
+		span(trial)
+	}
+	if first.IsZero() {
+		return []Quarter{}, nil
+	}
+
+	var res []Quarter
+	cumulativeVMs := make([]float64, len(trials))
+	cumulativeGB := make([]float64, len(trials))
+	var actualVMs, prevVMsP50, prevVMsP80 int
+	var actualGB, prevGBP50, prevGBP80 float64
+	period, err := cal.Period(first, cost.GranularityQuarter)
+	if err != nil {
+		return nil, err
+	}
+	for !period.Start.After(last) {
+		start, end := period.Start, period.End
+		q := Quarter{Quarter: period.Label, Start: start, End: end}
+		for _, l := range actuals {
+			if within(l.at, start, end) {
+				q.ActualVMs += l.vms
+				q.ActualDiskGB += l.diskGB
+			}
+		}
+		actualVMs += q.ActualVMs
+		actualGB += q.ActualDiskGB
+		for i, trial := range trials {
+			for _, l := range trial {
+				if within(l.at, start, end) {
+					cumulativeVMs[i] += float64(l.vms)
+					cumulativeGB[i] += l.diskGB
+				}
+			}
+		}
+
+		// the volume migrated in at least a share p of the trials is the (1-p) percentile
+		q.CumulativeVMsP50 = actualVMs + int(percentile(cumulativeVMs, 0.5))
+		q.CumulativeVMsP80 = actualVMs + int(percentile(cumulativeVMs, 0.2))
+		q.CumulativeDiskGBP50 = actualGB + percentile(cumulativeGB, 0.5)
+		q.CumulativeDiskGBP80 = actualGB + percentile(cumulativeGB, 0.2)
+		q.VMsP50, prevVMsP50 = q.CumulativeVMsP50-prevVMsP50, q.CumulativeVMsP50
+		q.VMsP80, prevVMsP80 = q.CumulativeVMsP80-prevVMsP80, q.CumulativeVMsP80
+		q.DiskGBP50, prevGBP50 = q.CumulativeDiskGBP50-prevGBP50, q.CumulativeDiskGBP50
+		q.DiskGBP80, prevGBP80 = q.CumulativeDiskGBP80-prevGBP80, q.CumulativeDiskGBP80
+		res = append(res, q)
+		if period, err = cal.Period(end, cost.GranularityQuarter); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func within(t, start, end time.Time) bool {
+	return !t.Before(start) && t.Before(end)
+}
+
+// percentile returns the value of the values within which a share p of them fall, ranked as
+// simulation.Result.Percentile ranks completion times.
+func percentile(values []float64, p float64) float64 {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
+
+// CSV renders the quarters of the forecast as CSV, one row per quarter under a header row.
+func (f Forecast) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{{
+		"plan", "quarter", "start", "end", "actual_vms", "actual_disk_gb",
+		"vms_p50", "vms_p80", "disk_gb_p50", "disk_gb_p80",
+		"cumulative_vms_p50", "cumulative_vms_p80", "cumulative_disk_gb_p50", "cumulative_disk_gb_p80",
+	}}
+	for _, q := range f.Quarters {
+		rows = append(rows, []string{
+			f.Plan, q.Quarter, q.Start.Format(time.DateOnly), q.End.Format(time.DateOnly),
+			strconv.Itoa(q.ActualVMs), formatGB(q.ActualDiskGB),
+			strconv.Itoa(q.VMsP50), strconv.Itoa(q.VMsP80), formatGB(q.DiskGBP50), formatGB(q.DiskGBP80),
+			strconv.Itoa(q.CumulativeVMsP50), strconv.Itoa(q.CumulativeVMsP80), formatGB(q.CumulativeDiskGBP50), formatGB(q.CumulativeDiskGBP80),
+		})
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatGB(gb float64) string {
+	return strconv.FormatFloat(gb, 'f', 2, 64)
+}
@@ -0,0 +1,222 @@
+package forecast
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/distribution"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+func testPlan() plan.Plan {
+	wave := func(name string, vms int, diskGB float64) plan.Wave {
+		return plan.Wave{Name: name, VMs: vms, DiskGB: diskGB, Steps: []plan.Step{
+			{Phase: "Storage Migration", Effort: 30 * 24 * time.Hour},
+			{Phase: "Cutover", Effort: 10 * 24 * time.Hour},
+		}}
+	}
+	return plan.Plan{
+		Name:  "datacenter-exit",
+		Start: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+		Mode:  scheduling.ModeSerial,
+		Waves: []plan.Wave{wave("wave-1", 100, 4000), wave("wave-2", 200, 8000), wave("wave-3", 300, 12000)},
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	p := testPlan()
+	// wave-1 ended 20 days behind its planned end, 2026-02-14
+	p.Progress = []plan.WaveProgress{{
+		Wave:        "wave-1",
+		Start:       p.Start,
+		End:         time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC),
+		VMsMigrated: 90,
+	}}
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	f, err := New(p, now, WithSeed(1), WithTrials(500))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	if f.TotalVMs != 590 || f.TotalDiskGB != 24000 {
+		t.Fatalf("totals = %d VMs, %g GB, want 590 VMs, 24000 GB", f.TotalVMs, f.TotalDiskGB)
+	}
+	if len(f.Quarters) == 0 || f.Quarters[0].Quarter != "FY2026 Q1" {
+		t.Fatalf("quarters = %+v, want to start in FY2026 Q1", f.Quarters)
+	}
+	if q := f.Quarters[0]; q.ActualVMs != 90 || q.ActualDiskGB != 4000 || q.CumulativeVMsP50 != 90 {
+		t.Errorf("first quarter = %+v, want the 90 VMs of wave-1 migrated", q)
+	}
+
+	last := f.Quarters[len(f.Quarters)-1]
+	if last.CumulativeVMsP50 != 590 || last.CumulativeVMsP80 != 590 || last.CumulativeDiskGBP80 != 24000 {
+		t.Errorf("last quarter = %+v, want the whole scope migrated", last)
+	}
+	for i, q := range f.Quarters {
+		if q.CumulativeVMsP80 > q.CumulativeVMsP50 {
+			t.Errorf("%s: P80 of %d VMs above P50 of %d", q.Quarter, q.CumulativeVMsP80, q.CumulativeVMsP50)
+		}
+		if q.VMsP50 < 0 || q.VMsP80 < 0 {
+			t.Errorf("%s: negative volume %+v", q.Quarter, q)
+		}
+		if i > 0 && !q.Start.Equal(f.Quarters[i-1].End) {
+			t.Errorf("%s starts %s, want the end of the previous quarter", q.Quarter, q.Start)
+		}
+	}
+
+	again, err := New(p, now, WithSeed(1), WithTrials(500))
+	if err != nil {
+		t.Fatalf("forecasting again: %v", err)
+	}
+	if again.Quarters[1] != f.Quarters[1] {
+		t.Errorf("same seed gave %+v, then %+v", f.Quarters[1], again.Quarters[1])
+	}
+}
+
+func TestNewDelaysRemainingWaves(t *testing.T) {
+	t.Parallel()
+
+	p := testPlan()
+	onTime, err := New(p, p.Start, WithSeed(1), WithSpread(1, 1), WithTrials(10))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	// wave-3 ends on 2026-05-05 as planned
+	if q := onTime.Quarters[1]; q.Quarter != "FY2026 Q2" || q.VMsP50 != 300 {
+		t.Fatalf("second quarter = %+v, want the 300 VMs of wave-3", q)
+	}
+
+	p.Progress = []plan.WaveProgress{{Wave: "wave-1", Start: p.Start, End: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), VMsMigrated: 100}}
+	late, err := New(p, p.Start, WithSeed(1), WithSpread(1, 1), WithTrials(10))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	// the 76 days wave-1 is late push wave-2 into June and wave-3 into July
+	want := map[string]int{"FY2026 Q1": 0, "FY2026 Q2": 200, "FY2026 Q3": 300}
+	for _, q := range late.Quarters {
+		if q.VMsP50-q.ActualVMs != want[q.Quarter] {
+			t.Errorf("%s: %d VMs forecast, want %d", q.Quarter, q.VMsP50-q.ActualVMs, want[q.Quarter])
+		}
+	}
+}
+
+func TestNewFiscalQuarters(t *testing.T) {
+	t.Parallel()
+
+	p := testPlan()
+	cal, err := cost.NewFiscalCalendar(time.October)
+	if err != nil {
+		t.Fatalf("creating calendar: %v", err)
+	}
+	f, err := New(p, p.Start, WithSeed(1), WithSpread(1, 1), WithTrials(10), WithFiscalCalendar(cal))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	// wave-1 and wave-2 end in February and March, wave-3 in May
+	want := []struct {
+		quarter string
+		start   time.Time
+		vms     int
+	}{
+		{quarter: "FY2026 Q2", start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), vms: 300},
+		{quarter: "FY2026 Q3", start: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), vms: 300},
+	}
+	if len(f.Quarters) != len(want) {
+		t.Fatalf("quarters = %+v, want %d", f.Quarters, len(want))
+	}
+	for i, w := range want {
+		if q := f.Quarters[i]; q.Quarter != w.quarter || !q.Start.Equal(w.start) || q.VMsP50 != w.vms {
+			t.Errorf("quarter %d = %+v, want %s starting %s with %d VMs", i, q, w.quarter, w.start, w.vms)
+		}
+	}
+}
+
+func TestNewSamplesFittedPhases(t *testing.T) {
+	t.Parallel()
+
+	p := testPlan()
+	certain, err := New(p, p.Start, WithSeed(1), WithSpread(1, 1), WithTrials(200))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	for _, q := range certain.Quarters {
+		if q.CumulativeVMsP50 != q.CumulativeVMsP80 {
+			t.Fatalf("%s: P50 of %d VMs and P80 of %d without variance", q.Quarter, q.CumulativeVMsP50, q.CumulativeVMsP80)
+		}
+	}
+
+	// a fit of a phase the plan does not have leaves the spread alone
+	other, err := New(p, p.Start, WithSeed(1), WithSpread(1, 1), WithTrials(200),
+		WithDistributions(map[string]distribution.Distribution{"Validation": distribution.LogNormal{Mu: 1, Sigma: 1}}))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	for i, q := range other.Quarters {
+		if q != certain.Quarters[i] {
+			t.Errorf("%s = %+v, want %+v", q.Quarter, q, certain.Quarters[i])
+		}
+	}
+
+	fitted, err := New(p, p.Start, WithSeed(1), WithSpread(1, 1), WithTrials(200),
+		WithDistributions(map[string]distribution.Distribution{"Storage Migration": distribution.LogNormal{Mu: 1, Sigma: 0.5}}))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	spread := false
+	for _, q := range fitted.Quarters {
+		if q.CumulativeVMsP80 > q.CumulativeVMsP50 {
+			t.Errorf("%s: P80 of %d VMs above P50 of %d", q.Quarter, q.CumulativeVMsP80, q.CumulativeVMsP50)
+		}
+		spread = spread || q.CumulativeVMsP80 < q.CumulativeVMsP50
+	}
+	if !spread {
+		t.Errorf("quarters = %+v, want the storage migration actuals to spread the P50 and P80", fitted.Quarters)
+	}
+	if last := fitted.Quarters[len(fitted.Quarters)-1]; last.CumulativeVMsP80 != 600 {
+		t.Errorf("last quarter = %+v, want the whole scope migrated", last)
+	}
+}
+
+func TestNewRejectsInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	p := testPlan()
+	if _, err := New(p, p.Start, WithTrials(0)); err == nil {
+		t.Error("expected an error for zero trials")
+	}
+	if _, err := New(p, p.Start, WithSpread(1.2, 1.1)); err == nil {
+		t.Error("expected an error for an unordered spread")
+	}
+	if _, err := New(p, p.Start, WithSpread(0, 1.1)); err == nil {
+		t.Error("expected an error for a zero optimistic factor")
+	}
+}
+
+func TestCSV(t *testing.T) {
+	t.Parallel()
+
+	p := testPlan()
+	f, err := New(p, p.Start, WithSeed(1), WithSpread(1, 1), WithTrials(10))
+	if err != nil {
+		t.Fatalf("forecasting: %v", err)
+	}
+	out, err := f.CSV()
+	if err != nil {
+		t.Fatalf("rendering CSV: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != len(f.Quarters)+1 {
+		t.Fatalf("got %d lines, want a header and %d quarters", len(lines), len(f.Quarters))
+	}
+	if !strings.HasPrefix(lines[0], "plan,quarter,start,end,actual_vms") {
+		t.Errorf("header = %q", lines[0])
+	}
+	if want := "datacenter-exit,FY2026 Q1,2026-01-01,2026-04-01,0,0.00,300,300,12000.00,12000.00,300,300,12000.00,12000.00"; lines[1] != want {
+		t.Errorf("first row = %q, want %q", lines[1], want)
+	}
+}
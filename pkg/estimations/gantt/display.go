@@ -0,0 +1,143 @@
+package gantt
+
+import (
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+// Absorb merges the bars shorter than threshold into the previous bar of their wave, or the next one for
+// the first bars of a wave, as a buffer of that phase. A wave made of short bars only is merged into
+// its first bar. Dependencies on a merged bar move to the bar absorbing it.
+func (c *Chart) Absorb(threshold time.Duration) {
+	waves := make(map[string][]int)
+	for i, b := range c.Bars {
+		waves[b.Wave] = append(waves[b.Wave], i)
+	}
+
+	// target maps the position of each bar to the position of the bar it is merged into, itself when kept
+	target := make([]int, len(c.Bars))
+	for _, bars := range waves {
+		keepers := make([]int, 0, len(bars))
+		for _, i := range bars {
+			if c.Bars[i].End.Sub(c.Bars[i].Start) >= threshold {
+				keepers = append(keepers, i)
+			}
+		}
+		if len(keepers) == 0 {
+			keepers = bars[:1]
+		}
+		k := 0
+		for _, i := range bars {
+			for k+1 < len(keepers) && keepers[k+1] <= i {
+				k++
+			}
+			target[i] = keepers[k]
+		}
+	}
+
+	kept := make([]Bar, 0, len(c.Bars))
+	position := make(map[int]int, len(c.Bars))
+	for i, b := range c.Bars {
+		if target[i] == i {
+			position[i] = len(kept)
+			kept = append(kept, b)
+		}
+	}
+	ids := make(map[scheduling.BarID]scheduling.BarID)
+	for i, b := range c.Bars {
+		if target[i] == i {
+			continue
+		}
+		into := &kept[position[target[i]]]
+		if b.Start.Before(into.Start) {
+			into.Start = b.Start
+		}
+		if b.End.After(into.End) {
+			into.End = b.End
+		}
+		if b.Released.After(into.Released) {
+			into.Released = b.Released
+		}
+		into.Shifts = append(into.Shifts, b.Shifts...)
+		ids[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}] = scheduling.BarID{Wave: into.Wave, Phase: into.Phase}
+	}
+	c.Bars = kept
+
+	deps := make([]Dependency, 0, len(c.Dependencies))
+	seen := make(map[Dependency]bool, len(c.Dependencies))
+	for _, d := range c.Dependencies {
+		if id, ok := ids[d.From]; ok {
+			d.From = id
+		}
+		if id, ok := ids[d.To]; ok {
+			d.To = id
+		}
+		if d.From == d.To || seen[d] {
+			continue
+		}
+		seen[d] = true
+		deps = append(deps, d)
+	}
+	c.Dependencies = deps
+}
+
+// Round snaps the dates of the bars to the nearest multiple of unit from the start of the chart and
+// updates the spans of the waves, the sources and the end of the chart.
+func (c *Chart) Round(unit time.Duration) {
+	if unit <= 0 {
+		return
+	}
+	round := func(t time.Time) time.Time {
+		return c.Start.Add(t.Sub(c.Start).Round(unit))
+	}
+	for i := range c.Bars {
+		b := &c.Bars[i]
+		b.Start, b.End, b.Released = round(b.Start), round(b.End), round(b.Released)
+	}
+	c.respan()
+}
+
+// Stretch lengthens the bars shorter than minimum to minimum, so no phase is reported shorter, and updates
+// the spans of the waves, the sources and the end of the chart.
+func (c *Chart) Stretch(minimum time.Duration) {
+	if minimum <= 0 {
+		return
+	}
+	for i := range c.Bars {
+		b := &c.Bars[i]
+		if b.End.Sub(b.Start) < minimum {
+			b.End = b.Start.Add(minimum)
+		}
+	}
+	c.respan()
+}
+
+// respan computes the spans of the waves, the sources and the end of the chart from the bars.
+func (c *Chart) respan() {
+	for j := range c.Waves {
+		w := &c.Waves[j]
+		first := true
+		for _, b := range c.Bars {
+			if b.Wave != w.Name {
+				continue
+			}
+			if first || b.Start.Before(w.Start) {
+				w.Start = b.Start
+			}
+			if first || b.End.After(w.End) {
+				w.End = b.End
+			}
+			first = false
+		}
+	}
+	if len(c.Sources) > 0 {
+		c.spanSources()
+	}
+	c.End = c.Start
+	for _, w := range c.Waves {
+		if w.End.After(c.End) {
+			c.End = w.End
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Package gantt renders a laid-out migration program as a Gantt chart, either as a data model
+// for clients drawing their own chart or as a self-contained SVG for reports and emails.
+package gantt
+
+import (
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+// Bar is a phase of a wave on the calendar. Effort ends at Released; the rest of the bar,
+// up to End, is lead time.
+type Bar struct {
+	Wave string
+	// Source is the source migrated by the wave in a multi-source program.
+	Source   string
+	Phase    string
+	Start    time.Time
+	End      time.Time
+	Released time.Time
+	Pool     string
+	Units    int
+	// Shifts is the effort carried out by each shift, when the pool is worked in shifts.
+	Shifts []scheduling.ShiftWork
+}
+
+// WaveSpan is the calendar span of all the phases of a wave.
+type WaveSpan struct {
+	Name   string
+	Source string
+	Start  time.Time
+	End    time.Time
+}
+
+// Milestone is a dated event drawn across the chart.
+type Milestone struct {
+	Name string
+	At   time.Time
+}
+
+// DependencyType is how a bar depends on another.
+type DependencyType string
+
+const (
+	// DependencyFinishToStart means To starts once From finished.
+	DependencyFinishToStart DependencyType = "finish-to-start"
+	// DependencyStartToStart means To may start once From started, e.g. an allowed overlap between waves.
+	DependencyStartToStart DependencyType = "start-to-start"
+)
+
+// Dependency states that bar To can only start once bar From allows it.
+type Dependency struct {
+	From scheduling.BarID
+	To   scheduling.BarID
+	Type DependencyType
+}
+
+// Chart is a program timeline anchored on the calendar.
+type Chart struct {
+	Start        time.Time
+	End          time.Time
+	Waves        []WaveSpan
+	Bars         []Bar
+	Dependencies []Dependency
+	// Sources are the spans of the sources of a multi-source program, see AssignSources.
+	Sources []SourceSpan
+	// Sites are the time zones and maintenance windows of the sources, in source order.
+	Sites []Site
+	// Milestones are the dated milestones the waves depend on, e.g. the target build-out.
+	Milestones []Milestone
+	// Today is set when the given current time falls within the chart.
+	Today *time.Time
+	// Watermark is stamped across the rendered chart when set, e.g. who exported it and when.
+	Watermark string
+}
+
+// New anchors the timeline at start. today is marked on the chart when it falls between
+// the start and the end of the program.
+func New(start time.Time, tl scheduling.Timeline, today time.Time) Chart {
+	c := Chart{
+		Start: start,
+		End:   start.Add(tl.Total),
+		Bars:  make([]Bar, 0, len(tl.Bars)),
+	}
+
+	ends := make(map[scheduling.BarID]time.Duration, len(tl.Bars))
+	for _, b := range tl.Bars {
+		ends[b.BarID] = b.End
+	}
+
+	waves := make(map[string]int)
+	for _, b := range tl.Bars {
+		bar := Bar{
+			Wave:     b.Wave,
+			Phase:    b.Phase,
+			Start:    start.Add(b.Start),
+			End:      start.Add(b.End),
+			Released: start.Add(b.Released),
+			Pool:     b.Pool,
+			Units:    b.Units,
+			Shifts:   b.Shifts,
+		}
+		c.Bars = append(c.Bars, bar)
+
+		for _, dep := range b.DependsOn {
+			// the layout only starts a bar before its dependency ends when an overlap allows it
+			kind := DependencyFinishToStart
+			if b.Start < ends[dep] {
+				kind = DependencyStartToStart
+			}
+			c.Dependencies = append(c.Dependencies, Dependency{From: dep, To: b.BarID, Type: kind})
+		}
+
+		i, ok := waves[b.Wave]
+		if !ok {
+			waves[b.Wave] = len(c.Waves)
+			c.Waves = append(c.Waves, WaveSpan{Name: b.Wave, Start: bar.Start, End: bar.End})
+			continue
+		}
+		if bar.Start.Before(c.Waves[i].Start) {
+			c.Waves[i].Start = bar.Start
+		}
+		if bar.End.After(c.Waves[i].End) {
+			c.Waves[i].End = bar.End
+		}
+	}
+
+	if !today.Before(c.Start) && !today.After(c.End) {
+		c.Today = &today
+	}
+
+	return c
+}
+
+// Move puts a bar on new dates, keeping its effort ahead of its lead time, and updates the spans of
+// its wave, its source and the end of the chart. It reports whether the bar is on the chart.
+func (c *Chart) Move(id scheduling.BarID, start, end time.Time) bool {
+	i := -1
+	for j, b := range c.Bars {
+		if b.Wave == id.Wave && b.Phase == id.Phase {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return false
+	}
+
+	b := &c.Bars[i]
+	released := start.Add(b.Released.Sub(b.Start))
+	if released.After(end) {
+		released = end
+	}
+	b.Start, b.End, b.Released = start, end, released
+
+	for j := range c.Waves {
+		if c.Waves[j].Name != id.Wave {
+			continue
+		}
+		first := true
+		for _, bar := range c.Bars {
+			if bar.Wave != id.Wave {
+				continue
+			}
+			if first || bar.Start.Before(c.Waves[j].Start) {
+				c.Waves[j].Start = bar.Start
+			}
+			if first || bar.End.After(c.Waves[j].End) {
+				c.Waves[j].End = bar.End
+			}
+			first = false
+		}
+	}
+	if len(c.Sources) > 0 {
+		c.spanSources()
+	}
+	if start.Before(c.Start) {
+		c.Start = start
+	}
+	c.End = c.Start
+	for _, w := range c.Waves {
+		if w.End.After(c.End) {
+			c.End = w.End
+		}
+	}
+	return true
+}
@@ -0,0 +1,264 @@
+package gantt
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+var start = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+func timeline(t *testing.T) scheduling.Timeline {
+	t.Helper()
+	step := func(name string, effort time.Duration) scheduling.Step {
+		return scheduling.Step{Phase: scheduling.Phase{Name: name, Effort: effort}}
+	}
+	waves := []scheduling.WavePlan{
+		{Wave: "wave-1", Steps: []scheduling.Step{step("Pre-Copy", 24*time.Hour), step("Validation", 48*time.Hour)}},
+		{Wave: "wave-2 <db>", Steps: []scheduling.Step{step("Pre-Copy", 24*time.Hour), step("Validation", 48*time.Hour)}},
+	}
+	tl, err := scheduling.NewPipeline(scheduling.ModePipelined, scheduling.WithOverlap("Validation", "Pre-Copy")).Layout(waves)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	return tl
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	c := New(start, timeline(t), start.Add(36*time.Hour))
+
+	if !c.End.Equal(start.Add(5 * 24 * time.Hour)) {
+		t.Errorf("expected end after 5 days, got %v", c.End)
+	}
+	if len(c.Bars) != 4 {
+		t.Fatalf("expected 4 bars, got %d", len(c.Bars))
+	}
+	if len(c.Waves) != 2 {
+		t.Fatalf("expected 2 waves, got %d", len(c.Waves))
+	}
+	// wave-2 pre-copy starts with wave-1 validation on day 2 and its validation follows wave-1
+	if w := c.Waves[1]; !w.Start.Equal(start.Add(24*time.Hour)) || !w.End.Equal(c.End) {
+		t.Errorf("unexpected span for wave-2: %v - %v", w.Start, w.End)
+	}
+	if len(c.Dependencies) != 4 {
+		t.Fatalf("expected 4 dependencies, got %v", c.Dependencies)
+	}
+	// wave-2 pre-copy overlaps wave-1 validation
+	if d := c.Dependencies[1]; d.To.Wave != "wave-2 <db>" || d.Type != DependencyStartToStart {
+		t.Errorf("expected a start-to-start dependency for wave-2 pre-copy, got %+v", d)
+	}
+	if d := c.Dependencies[0]; d.Type != DependencyFinishToStart {
+		t.Errorf("expected a finish-to-start dependency within wave-1, got %+v", d)
+	}
+	if c.Today == nil || !c.Today.Equal(start.Add(36*time.Hour)) {
+		t.Errorf("expected today marker, got %v", c.Today)
+	}
+}
+
+func TestNew_TodayOutsideProgram(t *testing.T) {
+	t.Parallel()
+	c := New(start, timeline(t), start.AddDate(1, 0, 0))
+	if c.Today != nil {
+		t.Errorf("expected no today marker, got %v", c.Today)
+	}
+}
+
+func TestChart_Move(t *testing.T) {
+	t.Parallel()
+	c := New(start, timeline(t), start)
+
+	// wave-2 validation pushed out by a week and squeezed to a day
+	id := scheduling.BarID{Wave: "wave-2 <db>", Phase: "Validation"}
+	at := start.AddDate(0, 0, 7)
+	if !c.Move(id, at, at.Add(24*time.Hour)) {
+		t.Fatalf("expected bar to be moved")
+	}
+
+	b := c.Bars[3]
+	if !b.Start.Equal(at) || !b.Released.Equal(b.End) {
+		t.Errorf("expected effort clamped to the new end, got %v - %v released %v", b.Start, b.End, b.Released)
+	}
+	if !c.Waves[1].End.Equal(at.Add(24*time.Hour)) || !c.End.Equal(c.Waves[1].End) {
+		t.Errorf("expected wave and chart to end with the moved bar, got %v and %v", c.Waves[1].End, c.End)
+	}
+	if c.Move(scheduling.BarID{Wave: "wave-3", Phase: "Validation"}, at, at) {
+		t.Errorf("expected unknown bar not to be moved")
+	}
+}
+
+func TestChart_SVG(t *testing.T) {
+	t.Parallel()
+	svg := New(start, timeline(t), start.Add(36*time.Hour)).SVG()
+
+	var doc struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(svg, &doc); err != nil {
+		t.Fatalf("expected well-formed SVG, got: %v", err)
+	}
+	if doc.XMLName.Local != "svg" {
+		t.Errorf("expected svg root, got %q", doc.XMLName.Local)
+	}
+
+	out := string(svg)
+	for _, want := range []string{"wave-2 &lt;db&gt;", "Validation", "today", "marker-end", "Mar 02"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected SVG to contain %q", want)
+		}
+	}
+}
+
+func TestChart_SVG_Empty(t *testing.T) {
+	t.Parallel()
+	svg := New(start, scheduling.Timeline{}, start).SVG()
+	if err := xml.Unmarshal(svg, new(struct{})); err != nil {
+		t.Fatalf("expected well-formed SVG, got: %v", err)
+	}
+}
+
+func TestChart_HTML(t *testing.T) {
+	t.Parallel()
+	page, err := New(start, timeline(t), start).HTML("exit <2026>")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	out := string(page)
+	for _, want := range []string{"<title>exit &lt;2026&gt;</title>", "<svg ", "<td>wave-2 &lt;db&gt;</td>", "Mon Mar 02 2026"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected HTML to contain %q", want)
+		}
+	}
+}
+
+func TestChart_Watermark(t *testing.T) {
+	t.Parallel()
+	c := New(start, timeline(t), start)
+	c.Watermark = "acme <alice> 2026-03-02T00:00:00Z"
+
+	if err := xml.Unmarshal(c.SVG(), new(struct{})); err != nil {
+		t.Fatalf("expected well-formed SVG, got: %v", err)
+	}
+	if !strings.Contains(string(c.SVG()), "acme &lt;alice&gt; 2026-03-02T00:00:00Z") {
+		t.Errorf("expected SVG to contain the watermark")
+	}
+	page, err := c.HTML("exit")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(string(page), "<footer>acme &lt;alice&gt; 2026-03-02T00:00:00Z</footer>") {
+		t.Errorf("expected HTML to end with the watermark")
+	}
+}
+
+func TestChart_AssignSources(t *testing.T) {
+	t.Parallel()
+	c := New(start, timeline(t), start)
+	c.AssignSources(map[string]string{"wave-1": "vcenter-east", "wave-2 <db>": "rhv-west"})
+
+	if len(c.Sources) != 2 || c.Sources[0].Name != "vcenter-east" || c.Sources[1].Waves != 1 {
+		t.Fatalf("expected a span per source, got %+v", c.Sources)
+	}
+	if !c.Sources[1].End.Equal(c.Waves[1].End) || c.Bars[3].Source != "rhv-west" {
+		t.Errorf("expected the source to span its waves, got %+v", c.Sources[1])
+	}
+
+	at := start.AddDate(0, 0, 7)
+	c.Move(scheduling.BarID{Wave: "wave-2 <db>", Phase: "Validation"}, at, at.Add(24*time.Hour))
+	if !c.Sources[1].End.Equal(at.Add(24 * time.Hour)) {
+		t.Errorf("expected the source to end with the moved bar, got %v", c.Sources[1].End)
+	}
+
+	page, err := c.HTML("exit")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for _, want := range []string{"<td>vcenter-east</td>", "<th>All sources</th>", "wave-1 (vcenter-east)"} {
+		if !strings.Contains(string(page), want) {
+			t.Errorf("expected HTML to contain %q", want)
+		}
+	}
+}
+
+func TestChart_SVG_Milestones(t *testing.T) {
+	t.Parallel()
+	c := New(start, timeline(t), start)
+	c.Milestones = []Milestone{{Name: "cluster <a> ready", At: start.Add(24 * time.Hour)}, {Name: "too late", At: start.AddDate(1, 0, 0)}}
+
+	svg := string(c.SVG())
+	if !strings.Contains(svg, "cluster &lt;a&gt; ready: 2026-03-03") {
+		t.Errorf("expected the milestone to be drawn, got %s", svg)
+	}
+	if strings.Contains(svg, "too late") {
+		t.Errorf("expected the milestone outside the chart not to be drawn")
+	}
+}
+
+func TestChart_Absorb(t *testing.T) {
+	t.Parallel()
+	step := func(name string, effort time.Duration) scheduling.Step {
+		return scheduling.Step{Phase: scheduling.Phase{Name: name, Effort: effort}}
+	}
+	tl, err := scheduling.NewPipeline(scheduling.ModeSerial).Layout([]scheduling.WavePlan{
+		{Wave: "wave-1", Steps: []scheduling.Step{step("Preparation", 2*time.Hour), step("Transfer", 48*time.Hour), step("Cutover", 4*time.Hour)}},
+		{Wave: "wave-2", Steps: []scheduling.Step{step("Transfer", 6*time.Hour)}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	c := New(start, tl, start)
+	end := c.End
+
+	c.Absorb(24 * time.Hour)
+
+	if len(c.Bars) != 2 {
+		t.Fatalf("expected the short phases absorbed into 2 bars, got %+v", c.Bars)
+	}
+	// the preparation is absorbed by the next phase, the cutover by the previous one
+	if b := c.Bars[0]; b.Phase != "Transfer" || !b.Start.Equal(start) || !b.End.Equal(start.Add(54*time.Hour)) {
+		t.Errorf("unexpected wave-1 bar: %+v", b)
+	}
+	if b := c.Bars[1]; b.Wave != "wave-2" || b.Phase != "Transfer" {
+		t.Errorf("expected the wave of short phases only to keep its first bar, got %+v", b)
+	}
+	if !c.End.Equal(end) {
+		t.Errorf("expected the end to stay %v, got %v", end, c.End)
+	}
+	if len(c.Dependencies) != 1 || c.Dependencies[0].From != (scheduling.BarID{Wave: "wave-1", Phase: "Transfer"}) {
+		t.Errorf("expected the dependencies to move to the absorbing bars, got %+v", c.Dependencies)
+	}
+}
+
+func TestChart_Round(t *testing.T) {
+	t.Parallel()
+	step := func(name string, effort time.Duration) scheduling.Step {
+		return scheduling.Step{Phase: scheduling.Phase{Name: name, Effort: effort}}
+	}
+	tl, err := scheduling.NewPipeline(scheduling.ModeSerial).Layout([]scheduling.WavePlan{
+		{Wave: "wave-1", Steps: []scheduling.Step{step("Transfer", 13*time.Hour+20*time.Minute), step("Cutover", 40*time.Minute)}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	c := New(start, tl, start)
+
+	c.Round(12 * time.Hour)
+	if b := c.Bars[0]; !b.End.Equal(start.Add(12 * time.Hour)) {
+		t.Errorf("expected the transfer to end on the half-day, got %v", b.End)
+	}
+	if !c.End.Equal(start.Add(12*time.Hour)) || !c.Waves[0].End.Equal(c.End) {
+		t.Errorf("expected the wave and the chart to end on the half-day, got %v and %v", c.Waves[0].End, c.End)
+	}
+
+	c.Stretch(12 * time.Hour)
+	if b := c.Bars[1]; b.End.Sub(b.Start) != 12*time.Hour {
+		t.Errorf("expected the cutover stretched to half a day, got %v", b.End.Sub(b.Start))
+	}
+	if !c.End.Equal(start.Add(24 * time.Hour)) {
+		t.Errorf("expected the chart to end after the stretched cutover, got %v", c.End)
+	}
+}
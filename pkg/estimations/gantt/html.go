@@ -0,0 +1,130 @@
+package gantt
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+)
+
+var reportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2rem; color: #151515; }
+table { border-collapse: collapse; margin: 1rem 0; }
+th, td { border-bottom: 1px solid #d2d2d2; padding: 0.25rem 1rem 0.25rem 0; text-align: left; }
+.chart { overflow-x: auto; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Start}} to {{.End}}</p>
+<div class="chart">{{.SVG}}</div>
+{{- if .Sources}}
+<table>
+<thead><tr><th>Source</th><th>Time zone</th><th>Waves</th><th>Start</th><th>End</th></tr></thead>
+<tbody>
+{{- range .Sources}}
+<tr><td>{{.Name}}</td><td>{{.TimeZone}}</td><td>{{.Waves}}</td><td>{{.Start}}</td><td>{{.End}}</td></tr>
+{{- end}}
+<tr><th>All sources</th><th></th><th>{{len .Waves}}</th><th>{{.Start}}</th><th>{{.End}}</th></tr>
+</tbody>
+</table>
+{{- end}}
+{{- if .Windows}}
+<table>
+<thead><tr><th>Source</th><th>Cutover window</th><th>Opens (site time)</th><th>Closes (site time)</th></tr></thead>
+<tbody>
+{{- range .Windows}}
+<tr><td>{{.Source}}</td><td>{{.Name}}</td><td>{{.Start}}</td><td>{{.End}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+<table>
+<thead><tr><th>Wave</th><th>Start</th><th>End</th></tr></thead>
+<tbody>
+{{- range .Waves}}
+<tr><td>{{.Name}}</td><td>{{.Start}}</td><td>{{.End}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- if .Watermark}}
+<footer>{{.Watermark}}</footer>
+{{- end}}
+</body>
+</html>
+`))
+
+type reportWave struct {
+	Name       string
+	Start, End string
+}
+
+type reportSource struct {
+	Name       string
+	TimeZone   string
+	Waves      int
+	Start, End string
+}
+
+type reportWindow struct {
+	Source, Name string
+	Start, End   string
+}
+
+// HTML renders the chart as a self-contained HTML page for browsers: the SVG chart followed by
+// the roll-up of each source of a multi-source program in the time zone of its site, the cutover
+// windows of the sites, the span of each wave and the watermark.
+func (c Chart) HTML(title string) ([]byte, error) {
+	const day = "Mon Jan 02 2006"
+	const minute = "Mon Jan 02 2006 15:04 MST"
+	data := struct {
+		Title      string
+		Start, End string
+		SVG        template.HTML
+		Sources    []reportSource
+		Windows    []reportWindow
+		Waves      []reportWave
+		Watermark  string
+	}{
+		Title:     title,
+		Watermark: c.Watermark,
+		Start:     c.Start.Format(day),
+		End:       c.End.Format(day),
+		// SVG escapes all the labels it draws
+		SVG: template.HTML(c.SVG()),
+	}
+	for _, src := range c.Sources {
+		start, end := src.Start, src.End
+		rs := reportSource{Name: src.Name, Waves: src.Waves}
+		if loc := c.Location(src.Name); loc != nil {
+			start, end = start.In(loc), end.In(loc)
+			rs.TimeZone = loc.String()
+		}
+		rs.Start, rs.End = start.Format(day), end.Format(day)
+		data.Sources = append(data.Sources, rs)
+	}
+	for _, site := range c.Sites {
+		for _, w := range site.Windows {
+			data.Windows = append(data.Windows, reportWindow{
+				Source: site.Source,
+				Name:   w.Name,
+				Start:  w.Start.In(site.Location).Format(minute),
+				End:    w.End.In(site.Location).Format(minute),
+			})
+		}
+	}
+	for _, w := range c.Waves {
+		data.Waves = append(data.Waves, reportWave{Name: w.Name, Start: w.Start.Format(day), End: w.End.Format(day)})
+	}
+
+	var buf bytes.Buffer
+	if err := reportTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,25 @@
+package gantt
+
+import (
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+// Site is the datacenter of a source of a multi-source program: the time zone its schedule is
+// read in on site and the maintenance windows its waves may be cut over in over the program.
+type Site struct {
+	Source   string
+	Location *time.Location
+	Windows  []scheduling.Window
+}
+
+// Location returns the time zone of the site of the source, nil when the chart has none.
+func (c Chart) Location(source string) *time.Location {
+	for _, s := range c.Sites {
+		if s.Source == source {
+			return s.Location
+		}
+	}
+	return nil
+}
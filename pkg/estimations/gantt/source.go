@@ -0,0 +1,51 @@
+package gantt
+
+import "time"
+
+// SourceSpan is the calendar span of the waves migrating a source of a multi-source program.
+type SourceSpan struct {
+	Name  string
+	Start time.Time
+	End   time.Time
+	Waves int
+}
+
+// AssignSources groups the waves of the chart by the source they migrate, given by wave name, and
+// computes the span of each source. Waves without a source are left out of the source spans.
+func (c *Chart) AssignSources(waveSources map[string]string) {
+	if len(waveSources) == 0 {
+		return
+	}
+	for i := range c.Waves {
+		c.Waves[i].Source = waveSources[c.Waves[i].Name]
+	}
+	for i := range c.Bars {
+		c.Bars[i].Source = waveSources[c.Bars[i].Wave]
+	}
+	c.spanSources()
+}
+
+// spanSources computes the source spans from the wave spans, in order of the first wave of each source.
+func (c *Chart) spanSources() {
+	c.Sources = c.Sources[:0]
+	index := make(map[string]int)
+	for _, w := range c.Waves {
+		if w.Source == "" {
+			continue
+		}
+		i, ok := index[w.Source]
+		if !ok {
+			index[w.Source] = len(c.Sources)
+			c.Sources = append(c.Sources, SourceSpan{Name: w.Source, Start: w.Start, End: w.End, Waves: 1})
+			continue
+		}
+		s := &c.Sources[i]
+		s.Waves++
+		if w.Start.Before(s.Start) {
+			s.Start = w.Start
+		}
+		if w.End.After(s.End) {
+			s.End = w.End
+		}
+	}
+}
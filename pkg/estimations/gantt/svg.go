@@ -0,0 +1,189 @@
+package gantt
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+const (
+	labelWidth   = 240.0
+	plotWidth    = 800.0
+	headerHeight = 40.0
+	rowHeight    = 24.0
+	barPadding   = 5.0
+	rightMargin  = 20.0
+)
+
+// palette colors the phases in order of first appearance.
+var palette = []string{"#0066cc", "#4cb140", "#f0ab00", "#009596", "#8f4700", "#5752d1", "#c9190b"}
+
+// SVG renders the chart as a standalone SVG document: one summary row per wave followed by
+// its phases, arrows for dependencies, a dashed red line for today and the watermark. The
+// lead-time part of a bar is drawn lighter than its effort.
+func (c Chart) SVG() []byte {
+	rows := make(map[scheduling.BarID]float64, len(c.Bars))
+	height := headerHeight + float64(len(c.Waves)+len(c.Bars))*rowHeight
+	width := labelWidth + plotWidth + rightMargin
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" font-family="sans-serif" font-size="12">`,
+		width, height, width, height)
+	buf.WriteString(`<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="#6a6e73"/></marker></defs>`)
+	fmt.Fprintf(&buf, `<rect width="%.0f" height="%.0f" fill="#ffffff"/>`, width, height)
+
+	for _, tick := range c.ticks() {
+		x := c.x(tick.at)
+		fmt.Fprintf(&buf, `<line x1="%.1f" y1="%.0f" x2="%.1f" y2="%.0f" stroke="#d2d2d2"/>`, x, headerHeight-10, x, height)
+		fmt.Fprintf(&buf, `<text x="%.1f" y="%.0f" fill="#6a6e73">%s</text>`, x+2, headerHeight-14, tick.label)
+	}
+
+	colors := make(map[string]string)
+	y := headerHeight
+	for _, w := range c.Waves {
+		label := w.Name
+		if w.Source != "" {
+			label = fmt.Sprintf("%s (%s)", w.Name, w.Source)
+		}
+		writeLabel(&buf, 8, y, label, true)
+		fmt.Fprintf(&buf, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="#3c3f42"/>`,
+			c.x(w.Start), y+barPadding+4, c.x(w.End)-c.x(w.Start), rowHeight-2*barPadding-8)
+		y += rowHeight
+
+		for _, b := range c.Bars {
+			if b.Wave != w.Name {
+				continue
+			}
+			color, ok := colors[b.Phase]
+			if !ok {
+				color = palette[len(colors)%len(palette)]
+				colors[b.Phase] = color
+			}
+			rows[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}] = y + rowHeight/2
+
+			writeLabel(&buf, 20, y, b.Phase, false)
+			fmt.Fprintf(&buf, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"><title>`,
+				c.x(b.Start), y+barPadding, c.x(b.Released)-c.x(b.Start), rowHeight-2*barPadding, color)
+			escape(&buf, fmt.Sprintf("%s / %s: %s - %s", b.Wave, b.Phase, b.Start.Format(time.DateTime), b.End.Format(time.DateTime)))
+			buf.WriteString(`</title></rect>`)
+			if b.End.After(b.Released) {
+				fmt.Fprintf(&buf, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s" fill-opacity="0.3"/>`,
+					c.x(b.Released), y+barPadding, c.x(b.End)-c.x(b.Released), rowHeight-2*barPadding, color)
+			}
+			y += rowHeight
+		}
+	}
+
+	ends := make(map[scheduling.BarID]time.Time, len(c.Bars))
+	starts := make(map[scheduling.BarID]time.Time, len(c.Bars))
+	for _, b := range c.Bars {
+		id := scheduling.BarID{Wave: b.Wave, Phase: b.Phase}
+		starts[id] = b.Start
+		ends[id] = b.End
+	}
+	for _, d := range c.Dependencies {
+		fromY, ok := rows[d.From]
+		toY, found := rows[d.To]
+		if !ok || !found {
+			continue
+		}
+		from := ends[d.From]
+		if d.Type == DependencyStartToStart {
+			from = starts[d.From]
+		}
+		fmt.Fprintf(&buf, `<path d="M%.1f,%.1f H%.1f V%.1f H%.1f" fill="none" stroke="#6a6e73" marker-end="url(#arrow)"/>`,
+			c.x(from), fromY, c.x(from)+4, toY, c.x(starts[d.To]))
+	}
+
+	for _, m := range c.Milestones {
+		if m.At.Before(c.Start) || m.At.After(c.End) {
+			continue
+		}
+		x := c.x(m.At)
+		fmt.Fprintf(&buf, `<line x1="%.1f" y1="%.0f" x2="%.1f" y2="%.0f" stroke="#3e8635" stroke-dasharray="2 2"><title>`, x, headerHeight-10, x, height)
+		escape(&buf, fmt.Sprintf("%s: %s", m.Name, m.At.Format(time.DateOnly)))
+		buf.WriteString(`</title></line>`)
+	}
+
+	if c.Today != nil {
+		x := c.x(*c.Today)
+		fmt.Fprintf(&buf, `<line x1="%.1f" y1="%.0f" x2="%.1f" y2="%.0f" stroke="#c9190b" stroke-dasharray="4 2"/>`, x, headerHeight-10, x, height)
+		fmt.Fprintf(&buf, `<text x="%.1f" y="12" fill="#c9190b" text-anchor="middle">today</text>`, x)
+	}
+
+	if c.Watermark != "" {
+		fmt.Fprintf(&buf, `<text x="%.0f" y="%.0f" fill="#6a6e73" fill-opacity="0.3" font-size="24" text-anchor="middle" transform="rotate(-15 %.0f %.0f)">`,
+			width/2, height/2, width/2, height/2)
+		escape(&buf, c.Watermark)
+		buf.WriteString(`</text>`)
+	}
+
+	buf.WriteString(`</svg>`)
+	return buf.Bytes()
+}
+
+// x returns the horizontal position of t.
+func (c Chart) x(t time.Time) float64 {
+	span := c.End.Sub(c.Start)
+	if span <= 0 {
+		return labelWidth
+	}
+	return labelWidth + float64(t.Sub(c.Start))/float64(span)*plotWidth
+}
+
+type tick struct {
+	at    time.Time
+	label string
+}
+
+// ticks returns the axis graduations: days for programs up to two weeks, weeks up to six
+// months and months beyond.
+func (c Chart) ticks() []tick {
+	span := c.End.Sub(c.Start)
+	if span <= 0 {
+		return nil
+	}
+
+	y, m, d := c.Start.Date()
+	at := time.Date(y, m, d, 0, 0, 0, 0, c.Start.Location())
+	next := func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	layout := "Jan 02"
+	switch {
+	case span > 183*24*time.Hour:
+		at = time.Date(y, m, 1, 0, 0, 0, 0, c.Start.Location())
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+		layout = "Jan 2006"
+	case span > 14*24*time.Hour:
+		for at.Weekday() != time.Monday {
+			at = at.AddDate(0, 0, -1)
+		}
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	}
+
+	var ticks []tick
+	for ; !at.After(c.End); at = next(at) {
+		if at.Before(c.Start) {
+			continue
+		}
+		ticks = append(ticks, tick{at: at, label: at.Format(layout)})
+	}
+	return ticks
+}
+
+func writeLabel(buf *bytes.Buffer, x, y float64, text string, bold bool) {
+	weight := "normal"
+	if bold {
+		weight = "bold"
+	}
+	fmt.Fprintf(buf, `<text x="%.0f" y="%.1f" font-weight="%s">`, x, y+rowHeight/2+4, weight)
+	escape(buf, text)
+	buf.WriteString(`</text>`)
+}
+
+func escape(buf *bytes.Buffer, text string) {
+	// EscapeText only fails when the writer does
+	_ = xml.EscapeText(buf, []byte(text))
+}
@@ -0,0 +1,5 @@
+// Package guardrails flags the params of an estimation that are implausible for the environment
+// they describe, e.g. a transfer rate faster than any NIC of the source hosts or more engineers than
+// the organization has. Guardrails never reject an estimation: they return warnings to report next
+// to it, so a typo is noticed instead of silently producing a nonsense estimate.
+package guardrails
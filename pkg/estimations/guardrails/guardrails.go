@@ -0,0 +1,110 @@
+package guardrails
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// DefaultMaxWorkHoursPerDay is the working day above which the hours are flagged when the policy sets none.
+const DefaultMaxWorkHoursPerDay = 16.0
+
+// EngineerParams are the params counting the engineers of the organization working on a task.
+var EngineerParams = []string{
+	calculators.ParamPostMigrationEngineers,
+	calculators.ParamRemediationEngineers,
+	calculators.ParamDecommissionEngineers,
+	calculators.ParamSourceDecommissionEngineers,
+	calculators.ParamBackupEngineers,
+	calculators.ParamDREngineers,
+	calculators.ParamDiscoveryAnalysts,
+}
+
+// Policy is what an organization declares to check the params of its estimations against.
+type Policy struct {
+	// TeamSize is the number of engineers of the organization, not checked when zero.
+	TeamSize int `json:"teamSize,omitempty"`
+	// MaxWorkHoursPerDay is the longest plausible working day, DefaultMaxWorkHoursPerDay when zero.
+	MaxWorkHoursPerDay float64 `json:"maxWorkHoursPerDay,omitempty"`
+}
+
+// Validate checks the team size and the working day are plausible themselves.
+func (p Policy) Validate() error {
+	if p.TeamSize < 0 {
+		return errors.New("team size must be non-negative")
+	}
+	if p.MaxWorkHoursPerDay < 0 || p.MaxWorkHoursPerDay > 24 {
+		return fmt.Errorf("max work hours per day %.4g must be in [0, 24]", p.MaxWorkHoursPerDay)
+	}
+	return nil
+}
+
+// Warning flags an implausible param.
+type Warning struct {
+	Param   string
+	Value   float64
+	Message string
+}
+
+// Check returns a warning for each implausible param: a transfer rate above the fastest NIC of the
+// source hosts, an engineer count above the team size of the organization and a working day longer
+// than the policy allows. nicSpeedsMbps are the speeds of the NICs found in the inventory, the
+// transfer rate is not checked without them. Params that are not numbers are left to the calculators.
+func Check(params []estimation.Param, policy Policy, nicSpeedsMbps []int) []Warning {
+	maxHours := policy.MaxWorkHoursPerDay
+	if maxHours == 0 {
+		maxHours = DefaultMaxWorkHoursPerDay
+	}
+
+	var warnings []Warning
+	for _, p := range params {
+		v, ok := number(p.Value)
+		if !ok {
+			continue
+		}
+		switch {
+		case p.Key == calculators.ParamTransferRateMbps && len(nicSpeedsMbps) > 0:
+			if fastest := slices.Max(nicSpeedsMbps); v > float64(fastest) {
+				warnings = append(warnings, Warning{
+					Param:   p.Key,
+					Value:   v,
+					Message: fmt.Sprintf("transfer rate of %.0f Mbps is above the fastest NIC of the source hosts (%d Mbps)", v, fastest),
+				})
+			}
+		case slices.Contains(EngineerParams, p.Key) && policy.TeamSize > 0:
+			if v > float64(policy.TeamSize) {
+				warnings = append(warnings, Warning{
+					Param:   p.Key,
+					Value:   v,
+					Message: fmt.Sprintf("%.0f engineers exceed the team of %d declared by the organization", v, policy.TeamSize),
+				})
+			}
+		case p.Key == calculators.ParamWorkHoursPerDay:
+			if v > maxHours {
+				warnings = append(warnings, Warning{
+					Param:   p.Key,
+					Value:   v,
+					Message: fmt.Sprintf("%.4g work hours per day exceed the plausible %.4g", v, maxHours),
+				})
+			}
+		}
+	}
+	return warnings
+}
+
+// number returns the value of a param as a float64, as the calculators read it.
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
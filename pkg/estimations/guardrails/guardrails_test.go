@@ -0,0 +1,114 @@
+package guardrails
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+	nics := []int{1000, 10000}
+
+	tests := []struct {
+		name   string
+		params []estimation.Param
+		policy Policy
+		nics   []int
+		want   []string
+	}{
+		{
+			name: "plausible params",
+			params: []estimation.Param{
+				{Key: calculators.ParamTransferRateMbps, Value: 8000.0},
+				{Key: calculators.ParamPostMigrationEngineers, Value: 5},
+				{Key: calculators.ParamWorkHoursPerDay, Value: 8.0},
+			},
+			policy: Policy{TeamSize: 10},
+			nics:   nics,
+		},
+		{
+			name:   "transfer rate above the fastest NIC",
+			params: []estimation.Param{{Key: calculators.ParamTransferRateMbps, Value: 40000.0}},
+			nics:   nics,
+			want:   []string{calculators.ParamTransferRateMbps},
+		},
+		{
+			name:   "transfer rate without NIC speeds",
+			params: []estimation.Param{{Key: calculators.ParamTransferRateMbps, Value: 40000.0}},
+		},
+		{
+			name: "engineers above the team size",
+			params: []estimation.Param{
+				{Key: calculators.ParamPostMigrationEngineers, Value: 12},
+				{Key: calculators.ParamRemediationEngineers, Value: 4},
+			},
+			policy: Policy{TeamSize: 10},
+			want:   []string{calculators.ParamPostMigrationEngineers},
+		},
+		{
+			name:   "engineers without a team size",
+			params: []estimation.Param{{Key: calculators.ParamPostMigrationEngineers, Value: 500}},
+		},
+		{
+			name:   "work hours above the default maximum",
+			params: []estimation.Param{{Key: calculators.ParamWorkHoursPerDay, Value: 20.0}},
+			want:   []string{calculators.ParamWorkHoursPerDay},
+		},
+		{
+			name:   "work hours above the maximum of the policy",
+			params: []estimation.Param{{Key: calculators.ParamWorkHoursPerDay, Value: 12}},
+			policy: Policy{MaxWorkHoursPerDay: 10},
+			want:   []string{calculators.ParamWorkHoursPerDay},
+		},
+		{
+			name:   "value not a number",
+			params: []estimation.Param{{Key: calculators.ParamWorkHoursPerDay, Value: "twenty"}},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			warnings := Check(tc.params, tc.policy, tc.nics)
+			if len(warnings) != len(tc.want) {
+				t.Fatalf("expected warnings for %v, got %+v", tc.want, warnings)
+			}
+			for i, w := range warnings {
+				if w.Param != tc.want[i] {
+					t.Errorf("expected a warning for %s, got %s", tc.want[i], w.Param)
+				}
+				if w.Message == "" {
+					t.Errorf("expected warning for %s to be explained", w.Param)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		policy  Policy
+		wantErr string
+	}{
+		{name: "negative team size", policy: Policy{TeamSize: -1}, wantErr: "team size"},
+		{name: "day above 24 hours", policy: Policy{MaxWorkHoursPerDay: 25}, wantErr: "max work hours"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.policy.Validate()
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+	if err := (Policy{TeamSize: 8, MaxWorkHoursPerDay: 12}).Validate(); err != nil {
+		t.Errorf("expected a valid policy, got: %v", err)
+	}
+}
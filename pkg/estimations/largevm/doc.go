@@ -0,0 +1,10 @@
+// Package largevm gives the largest VMs their own estimation track. Averaged over a wave, a VM with
+// several terabytes of disk or dozens of vCPUs hides how long its own copy and validation take, and
+// a few of them copied at once saturate the transfer network.
+//
+// A Track routes the VMs above its threshold out of the statistical per-method estimates. Each of
+// them is estimated on its own, with a longer validation and, when migrated warm, the delta passes
+// of its own change rate, and gets its own wave plan; the transfers share a resource pool whose
+// capacity is the track's transfer concurrency. EstimateAll runs both tracks over the assignments,
+// configured from the estimation params with ParamOptions.
+package largevm
@@ -0,0 +1,259 @@
+package largevm
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+const (
+	// DefaultMaxDiskGB is the disk size above which a VM is large.
+	DefaultMaxDiskGB = 4096.0
+	// DefaultMaxCPUCount is the vCPU count above which a VM is large.
+	DefaultMaxCPUCount = 64
+	// DefaultTransferConcurrency is the number of large VMs copied at the same time.
+	DefaultTransferConcurrency = 1
+	// DefaultValidationMins is the validation effort of a large VM, done by a single engineer.
+	DefaultValidationMins = 480.0
+
+	// PoolTransfer is the resource pool shared by the transfers of the large VMs.
+	PoolTransfer = "large-vm-transfer"
+
+	// ParamMaxDiskGB is the estimation.Param key for the disk size above which a VM is large.
+	ParamMaxDiskGB = "large_vm_max_disk_gb"
+	// ParamMaxCPUCount is the estimation.Param key for the vCPU count above which a VM is large.
+	ParamMaxCPUCount = "large_vm_max_cpu_count"
+	// ParamTransferConcurrency is the estimation.Param key for the number of large VMs copied at the same time.
+	ParamTransferConcurrency = "large_vm_transfer_concurrency"
+	// ParamValidationMins is the estimation.Param key for the validation effort, in minutes, of a large VM.
+	ParamValidationMins = "large_vm_validation_mins"
+)
+
+// Track estimates and schedules the large VMs one by one.
+type Track struct {
+	maxDiskGB           float64
+	maxCPUCount         int
+	transferConcurrency int
+	validationMins      float64
+	transferRateMbps    float64
+}
+
+// TrackOption is a functional option for configuring a Track.
+type TrackOption func(*Track)
+
+// WithMaxDiskGB sets the disk size above which a VM is large. Non-positive values are ignored.
+func WithMaxDiskGB(gb float64) TrackOption {
+	return func(t *Track) {
+		if gb > 0 {
+			t.maxDiskGB = gb
+		}
+	}
+}
+
+// WithMaxCPUCount sets the vCPU count above which a VM is large. Non-positive values are ignored.
+func WithMaxCPUCount(count int) TrackOption {
+	return func(t *Track) {
+		if count > 0 {
+			t.maxCPUCount = count
+		}
+	}
+}
+
+// WithTransferConcurrency sets the number of large VMs copied at the same time. Non-positive values are ignored.
+func WithTransferConcurrency(count int) TrackOption {
+	return func(t *Track) {
+		if count > 0 {
+			t.transferConcurrency = count
+		}
+	}
+}
+
+// WithValidationMins sets the validation effort, in minutes, of a large VM. Non-positive values are ignored.
+func WithValidationMins(mins float64) TrackOption {
+	return func(t *Track) {
+		if mins > 0 {
+			t.validationMins = mins
+		}
+	}
+}
+
+// WithTransferRateMbps sets the transfer rate of a single large VM. Non-positive values are ignored.
+func WithTransferRateMbps(mbps float64) TrackOption {
+	return func(t *Track) {
+		if mbps > 0 {
+			t.transferRateMbps = mbps
+		}
+	}
+}
+
+// NewTrack creates a Track with default settings that can be overridden by options.
+func NewTrack(opts ...TrackOption) *Track {
+	t := Track{
+		maxDiskGB:           DefaultMaxDiskGB,
+		maxCPUCount:         DefaultMaxCPUCount,
+		transferConcurrency: DefaultTransferConcurrency,
+		validationMins:      DefaultValidationMins,
+		transferRateMbps:    calculators.DefaultTransferRateMbps,
+	}
+
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	return &t
+}
+
+// ParamOptions returns the options set by the estimation params: the thresholds, the transfer
+// concurrency and the validation effort under the Param keys of this package, and the transfer rate
+// under calculators.ParamTransferRateMbps. Other params are ignored.
+func ParamOptions(params []estimation.Param) ([]TrackOption, error) {
+	var opts []TrackOption
+	for _, p := range params {
+		switch p.Key {
+		case ParamMaxDiskGB, ParamMaxCPUCount, ParamTransferConcurrency, ParamValidationMins, calculators.ParamTransferRateMbps:
+		default:
+			continue
+		}
+		v, err := value(p)
+		if err != nil {
+			return nil, err
+		}
+		switch p.Key {
+		case ParamMaxDiskGB:
+			opts = append(opts, WithMaxDiskGB(v))
+		case ParamMaxCPUCount:
+			opts = append(opts, WithMaxCPUCount(int(v)))
+		case ParamTransferConcurrency:
+			opts = append(opts, WithTransferConcurrency(int(v)))
+		case ParamValidationMins:
+			opts = append(opts, WithValidationMins(v))
+		case calculators.ParamTransferRateMbps:
+			opts = append(opts, WithTransferRateMbps(v))
+		}
+	}
+	return opts, nil
+}
+
+// IsLarge reports whether vm is above the disk size or vCPU threshold.
+func (t *Track) IsLarge(vm inventory.VM) bool {
+	return vm.DiskGB > t.maxDiskGB || vm.CPUCount > t.maxCPUCount
+}
+
+// Split separates the large VMs to migrate from the other assignments, which keep the per-method
+// estimates. Large VMs being retired or replatformed are not copied and stay with the others.
+func (t *Track) Split(assignments []method.Assignment) (regular, large []method.Assignment) {
+	for _, a := range assignments {
+		if (a.Method == method.Cold || a.Method == method.Warm) && t.IsLarge(a.Candidate.VM) {
+			large = append(large, a)
+			continue
+		}
+		regular = append(regular, a)
+	}
+	return regular, large
+}
+
+// Estimates are the per-method estimates of the regular VMs and the estimates of each large VM.
+type Estimates struct {
+	// Methods are the estimates of the regular VMs, keyed by method then calculator name.
+	Methods map[method.Method]map[string]estimation.Estimation
+	// Large are the estimates of the large VMs, keyed by VM ID then as Estimate keys them.
+	Large map[string]map[string]estimation.Estimation
+	// LargeVMs are the large VMs, in assignment order.
+	LargeVMs []method.Assignment
+}
+
+// EstimateAll splits the large VMs out of the assignments, runs the per-method calculators on the
+// others with method.Estimate and estimates each large VM on its own.
+func (t *Track) EstimateAll(assignments []method.Assignment, calcs map[method.Method][]estimation.Calculator, extra ...estimation.Param) (Estimates, error) {
+	regular, large := t.Split(assignments)
+	res := Estimates{
+		Methods:  method.Estimate(regular, calcs, extra...),
+		Large:    make(map[string]map[string]estimation.Estimation, len(large)),
+		LargeVMs: large,
+	}
+	for _, a := range large {
+		results, err := t.Estimate(a)
+		if err != nil {
+			return Estimates{}, err
+		}
+		res.Large[a.Candidate.VM.ID] = results
+	}
+	return res, nil
+}
+
+// Estimate estimates the copy and the validation of a single large VM, keyed by calculator name but
+// for the copy, keyed by scheduling.PhaseStorageMigration whatever the method: a VM migrated cold is
+// copied at once, a VM migrated warm with a full copy then delta passes at its own change rate, when
+// known, up to the cutover.
+func (t *Track) Estimate(a method.Assignment) (map[string]estimation.Estimation, error) {
+	params := map[string]estimation.Param{
+		calculators.ParamVMCount:     {Key: calculators.ParamVMCount, Value: 1},
+		calculators.ParamTotalDiskGB: {Key: calculators.ParamTotalDiskGB, Value: a.Candidate.VM.DiskGB},
+	}
+	var transfer estimation.Calculator = calculators.NewStorageMigration(calculators.WithTransferRateMbps(t.transferRateMbps))
+	if a.Method == method.Warm {
+		transfer = calculators.NewWarmMigrationDeltaSync(calculators.WithDeltaSyncTransferRateMbps(t.transferRateMbps))
+		if rate := a.Candidate.DailyChangeRatePercent; rate > 0 {
+			params[calculators.ParamChangeRatePercent] = estimation.Param{Key: calculators.ParamChangeRatePercent, Value: rate}
+		}
+	}
+	validation := calculators.NewPostMigrationTroubleShooting(
+		calculators.WithTroubleshootMinsPerVM(t.validationMins),
+		calculators.WithEngineerCount(1),
+	)
+
+	res := make(map[string]estimation.Estimation, 2)
+	for name, c := range map[string]estimation.Calculator{
+		scheduling.PhaseStorageMigration: transfer,
+		validation.Name():                validation,
+	} {
+		est, err := c.Calculate(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate %s of %s: %w", c.Name(), a.Candidate.VM.Name, err)
+		}
+		res[name] = est
+	}
+	return res, nil
+}
+
+// Waves returns one wave plan per large VM, named after the VM. Its storage migration uses a unit of
+// PoolTransfer, so laying the plans out with PipelineOptions copies at most the transfer concurrency
+// of large VMs at a time.
+func (t *Track) Waves(large []method.Assignment, workHoursPerDay float64) ([]scheduling.WavePlan, error) {
+	waves := make([]scheduling.WavePlan, 0, len(large))
+	for _, a := range large {
+		results, err := t.Estimate(a)
+		if err != nil {
+			return nil, err
+		}
+		wave := scheduling.ProgramWave(a.Candidate.VM.Name, results, workHoursPerDay)
+		for i := range wave.Steps {
+			if wave.Steps[i].Phase.Name == scheduling.PhaseStorageMigration {
+				wave.Steps[i].Pool = PoolTransfer
+				wave.Steps[i].Units = 1
+			}
+		}
+		waves = append(waves, wave)
+	}
+	return waves, nil
+}
+
+// PipelineOptions returns the options limiting a Pipeline to the transfer concurrency of the track.
+func (t *Track) PipelineOptions() []scheduling.PipelineOption {
+	return []scheduling.PipelineOption{scheduling.WithResourcePool(PoolTransfer, t.transferConcurrency)}
+}
+
+func value(p estimation.Param) (float64, error) {
+	switch v := p.Value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	default:
+		return 0, fmt.Errorf("param %s is not a number", p.Key)
+	}
+}
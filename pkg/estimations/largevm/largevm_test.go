@@ -0,0 +1,193 @@
+package largevm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func assign(name string, m method.Method, diskGB float64, cpus int) method.Assignment {
+	return method.Assignment{
+		Candidate: method.Candidate{VM: inventory.VM{Name: name, DiskGB: diskGB, CPUCount: cpus}},
+		Method:    m,
+	}
+}
+
+func TestTrack_Split(t *testing.T) {
+	t.Parallel()
+	assignments := []method.Assignment{
+		assign("web01", method.Cold, 100, 4),
+		assign("dwh01", method.Warm, 6000, 16),
+		assign("hana01", method.Cold, 1000, 96),
+		assign("edge01", method.Cold, 4096, 64),
+		// retired VMs are not copied
+		assign("archive01", method.Retire, 10000, 8),
+	}
+
+	regular, large := NewTrack().Split(assignments)
+
+	names := func(as []method.Assignment) []string {
+		var res []string
+		for _, a := range as {
+			res = append(res, a.Candidate.VM.Name)
+		}
+		return res
+	}
+	if got := names(large); len(got) != 2 || got[0] != "dwh01" || got[1] != "hana01" {
+		t.Errorf("expected dwh01 and hana01 to be large, got %v", got)
+	}
+	if got := names(regular); len(got) != 3 {
+		t.Errorf("expected 3 regular VMs, got %v", got)
+	}
+
+	_, large = NewTrack(WithMaxDiskGB(1000), WithMaxCPUCount(128)).Split(assignments)
+	if got := names(large); len(got) != 2 || got[0] != "dwh01" || got[1] != "edge01" {
+		t.Errorf("expected dwh01 and edge01 to be large with custom thresholds, got %v", got)
+	}
+}
+
+func TestTrack_Estimate(t *testing.T) {
+	t.Parallel()
+	// 5625 GB at 800 Mbps (100 MB/s) = 16 h
+	track := NewTrack(WithTransferRateMbps(800), WithValidationMins(600))
+
+	results, err := track.Estimate(assign("dwh01", method.Cold, 5625, 16))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if got := results[scheduling.PhaseStorageMigration].Duration; got != 16*time.Hour {
+		t.Errorf("expected a 16h transfer, got %v", got)
+	}
+	// validation is not shared between engineers
+	if got := results[scheduling.PhasePostMigration].Duration; got != 10*time.Hour {
+		t.Errorf("expected a 10h validation, got %v", got)
+	}
+
+	// a warm copy adds the delta passes to the full copy, more of them the faster the disks change
+	warm := assign("dwh01", method.Warm, 5625, 16)
+	results, err = track.Estimate(warm)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	slow := results[scheduling.PhaseStorageMigration]
+	if slow.Duration <= 16*time.Hour || slow.Explanation == nil {
+		t.Fatalf("expected the delta passes after the 16h full copy, got %+v", slow)
+	}
+	warm.Candidate.DailyChangeRatePercent = 20
+	if results, err = track.Estimate(warm); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := results[scheduling.PhaseStorageMigration].Duration; got <= slow.Duration {
+		t.Errorf("expected a longer warm copy at 20%%/day than %v, got %v", slow.Duration, got)
+	}
+
+	if _, err := track.Estimate(assign("bad01", method.Cold, -1, 128)); err == nil {
+		t.Errorf("expected error for a negative disk size, got nil")
+	}
+}
+
+func TestTrack_Waves_TransferConcurrency(t *testing.T) {
+	t.Parallel()
+	large := []method.Assignment{
+		assign("dwh01", method.Cold, 5625, 16),
+		assign("dwh02", method.Cold, 5625, 16),
+		assign("dwh03", method.Cold, 5625, 16),
+	}
+
+	for _, tc := range []struct {
+		concurrency int
+		// lastStart is the start of the transfer of dwh03
+		lastStart time.Duration
+	}{
+		{concurrency: 1, lastStart: 32 * time.Hour},
+		{concurrency: 2, lastStart: 16 * time.Hour},
+		{concurrency: 3, lastStart: 0},
+	} {
+		track := NewTrack(WithTransferRateMbps(800), WithTransferConcurrency(tc.concurrency))
+		waves, err := track.Waves(large, 8)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if len(waves) != 3 || waves[2].Wave != "dwh03" {
+			t.Fatalf("expected a wave per VM, got %+v", waves)
+		}
+
+		timeline, err := scheduling.NewPipeline(scheduling.ModeParallel, track.PipelineOptions()...).Layout(waves)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		found := false
+		for _, b := range timeline.Bars {
+			if b.Wave != "dwh03" || b.Phase != scheduling.PhaseStorageMigration {
+				continue
+			}
+			found = true
+			if b.Start != tc.lastStart {
+				t.Errorf("expected dwh03 to start at %v with concurrency %d, got %v", tc.lastStart, tc.concurrency, b.Start)
+			}
+		}
+		if !found {
+			t.Errorf("expected a storage migration bar for dwh03")
+		}
+	}
+}
+
+func TestParamOptions(t *testing.T) {
+	t.Parallel()
+	opts, err := ParamOptions([]estimation.Param{
+		{Key: ParamMaxDiskGB, Value: 1000.0},
+		{Key: ParamMaxCPUCount, Value: 128},
+		{Key: ParamValidationMins, Value: 0.0},
+		{Key: calculators.ParamTransferRateMbps, Value: 800.0},
+		{Key: calculators.ParamVMCount, Value: "ignored"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	track := NewTrack(opts...)
+	if track.maxDiskGB != 1000 || track.maxCPUCount != 128 || track.transferRateMbps != 800 {
+		t.Errorf("expected the params to configure the track, got %+v", track)
+	}
+	if track.validationMins != DefaultValidationMins {
+		t.Errorf("expected a zero validation effort ignored, got %v", track.validationMins)
+	}
+
+	if _, err := ParamOptions([]estimation.Param{{Key: ParamTransferConcurrency, Value: "two"}}); err == nil {
+		t.Error("expected an error for a transfer concurrency that is not a number")
+	}
+}
+
+func TestTrack_EstimateAll(t *testing.T) {
+	t.Parallel()
+	assignments := []method.Assignment{
+		assign("web01", method.Cold, 100, 4),
+		assign("web02", method.Cold, 300, 4),
+		assign("dwh01", method.Warm, 6000, 16),
+	}
+	assignments[2].Candidate.VM.ID = "vm-dwh01"
+	calcs := map[method.Method][]estimation.Calculator{
+		method.Cold: {calculators.NewStorageMigration(calculators.WithTransferRateMbps(800))},
+		method.Warm: {calculators.NewWarmMigrationDeltaSync()},
+	}
+
+	res, err := NewTrack().EstimateAll(assignments, calcs)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 400 GB at 800 Mbps = 4096 s
+	if got := res.Methods[method.Cold][scheduling.PhaseStorageMigration].Duration; got != 4096*time.Second {
+		t.Errorf("expected the regular VMs alone in the cold estimate, got %v", got)
+	}
+	if _, ok := res.Methods[method.Warm]; ok {
+		t.Errorf("expected no warm estimate without regular warm VM, got %+v", res.Methods[method.Warm])
+	}
+	if len(res.LargeVMs) != 1 || res.Large["vm-dwh01"][scheduling.PhaseStorageMigration].Duration == 0 {
+		t.Errorf("expected dwh01 estimated on its own, got %+v", res.Large)
+	}
+}
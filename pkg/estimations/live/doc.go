@@ -0,0 +1,4 @@
+// Package live builds the status board of a wave during its cutover night: the state of each VM
+// derived from the migration status reported by MTV and the completion of its validation
+// checklists, the time elapsed against the estimate of the wave and what currently blocks it.
+package live
@@ -0,0 +1,155 @@
+package live
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+)
+
+// State is the state of a VM during the cutover of its wave.
+type State string
+
+const (
+	StateSyncing    State = "syncing"
+	StateCutover    State = "cutover"
+	StateValidating State = "validating"
+	StateDone       State = "done"
+	StateFailed     State = "failed"
+)
+
+// syncPhases are the MTV pipeline phases copying the disks while the source VM keeps running.
+// The other phases run once the source VM is shut down.
+var syncPhases = map[string]bool{
+	"Initialize":     true,
+	"PreHook":        true,
+	"DiskAllocation": true,
+	"DiskTransfer":   true,
+	"Precopy":        true,
+	"CreateSnapshot": true,
+	"CopyDisks":      true,
+}
+
+// Tracking is the migration status of a VM as reported by MTV in the status of its Plan.
+type Tracking struct {
+	VMID string `json:"vmId"`
+	Name string `json:"name"`
+	// Phase is the current phase of the MTV pipeline of the VM, e.g. DiskTransfer or Cutover.
+	Phase     string     `json:"phase"`
+	Started   *time.Time `json:"started,omitempty"`
+	Completed *time.Time `json:"completed,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// VM is the status of a VM of the wave.
+type VM struct {
+	ID      string
+	Name    string
+	State   State
+	Elapsed time.Duration
+}
+
+// Blocker is what keeps a VM from being done.
+type Blocker struct {
+	VMID   string
+	VM     string
+	Reason string
+}
+
+// Board is the status of a wave during its cutover.
+type Board struct {
+	Wave string
+	// At is the time the board was built.
+	At time.Time
+	// Estimated is the duration of the wave as planned and Elapsed the time since its first VM started,
+	// until its last VM completed once they all are.
+	Estimated time.Duration
+	Elapsed   time.Duration
+	States    map[State]int
+	VMs       []VM
+	Blockers  []Blocker
+}
+
+// Build computes the board of the wave at now from the MTV status of its VMs and their checklists.
+// VMs are sorted by name and the blockers follow the order of the VMs.
+func Build(wave string, estimated time.Duration, tracking []Tracking, checklists []checklist.Checklist, now time.Time) Board {
+	open := make(map[string][]string)
+	for _, c := range checklists {
+		if c.Wave != wave {
+			continue
+		}
+		for _, item := range c.Items {
+			if !item.Done {
+				open[c.VM.ID] = append(open[c.VM.ID], fmt.Sprintf("%s: %s", c.Template, item.Name))
+			}
+		}
+	}
+
+	sorted := append([]Tracking(nil), tracking...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	board := Board{
+		Wave:      wave,
+		At:        now,
+		Estimated: estimated,
+		States:    make(map[State]int),
+		VMs:       make([]VM, 0, len(sorted)),
+	}
+	var first, last *time.Time
+	running := false
+	for _, t := range sorted {
+		vm := VM{ID: t.VMID, Name: t.Name, State: state(t, open[t.VMID])}
+		if t.Started != nil {
+			end := now
+			if t.Completed != nil {
+				end = *t.Completed
+			}
+			vm.Elapsed = end.Sub(*t.Started)
+			if first == nil || t.Started.Before(*first) {
+				first = t.Started
+			}
+		}
+		if t.Completed == nil {
+			running = true
+		} else if last == nil || t.Completed.After(*last) {
+			last = t.Completed
+		}
+		board.VMs = append(board.VMs, vm)
+		board.States[vm.State]++
+
+		switch vm.State {
+		case StateFailed:
+			board.Blockers = append(board.Blockers, Blocker{VMID: t.VMID, VM: t.Name, Reason: t.Error})
+		case StateValidating:
+			for _, item := range open[t.VMID] {
+				board.Blockers = append(board.Blockers, Blocker{VMID: t.VMID, VM: t.Name, Reason: item})
+			}
+		}
+	}
+
+	if first != nil {
+		end := now
+		if !running && last != nil {
+			end = *last
+		}
+		board.Elapsed = end.Sub(*first)
+	}
+	return board
+}
+
+// state derives the state of a VM from its MTV status and the open items of its checklists.
+func state(t Tracking, open []string) State {
+	switch {
+	case t.Error != "":
+		return StateFailed
+	case t.Completed == nil && (t.Started == nil || syncPhases[t.Phase]):
+		return StateSyncing
+	case t.Completed == nil:
+		return StateCutover
+	case len(open) > 0:
+		return StateValidating
+	default:
+		return StateDone
+	}
+}
@@ -0,0 +1,94 @@
+package live
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/checklist"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour int) *time.Time {
+		ts := time.Date(2026, 3, 7, hour, 0, 0, 0, time.UTC)
+		return &ts
+	}
+	now := *at(6)
+	tracking := []Tracking{
+		{VMID: "vm-5", Name: "web-2", Phase: "Completed", Started: at(0), Completed: at(3)},
+		{VMID: "vm-4", Name: "web-1", Phase: "Completed", Started: at(0), Completed: at(2)},
+		{VMID: "vm-3", Name: "db-1", Phase: "ImageConversion", Started: at(1), Error: "virt-v2v failed: disk not found"},
+		{VMID: "vm-2", Name: "app-2", Phase: "Cutover", Started: at(2)},
+		{VMID: "vm-1", Name: "app-1", Phase: "DiskTransfer", Started: at(4)},
+	}
+	checklists := []checklist.Checklist{
+		{Wave: "wave-1", VM: checklist.VM{ID: "vm-4"}, Template: "base", Items: []checklist.Item{{Name: "OS boots", Done: true}}},
+		{Wave: "wave-1", VM: checklist.VM{ID: "vm-5"}, Template: "base", Items: []checklist.Item{{Name: "OS boots", Done: true}, {Name: "Monitoring restored"}}},
+		{Wave: "wave-2", VM: checklist.VM{ID: "vm-4"}, Template: "base", Items: []checklist.Item{{Name: "OS boots"}}},
+	}
+
+	board := Build("wave-1", 8*time.Hour, tracking, checklists, now)
+
+	if board.Elapsed != 6*time.Hour {
+		t.Errorf("expected 6h elapsed, got %s", board.Elapsed)
+	}
+	wantStates := map[string]State{"app-1": StateSyncing, "app-2": StateCutover, "db-1": StateFailed, "web-1": StateDone, "web-2": StateValidating}
+	if len(board.VMs) != len(wantStates) {
+		t.Fatalf("expected %d VMs, got %d", len(wantStates), len(board.VMs))
+	}
+	for i, vm := range board.VMs {
+		if i > 0 && board.VMs[i-1].Name > vm.Name {
+			t.Errorf("expected VMs sorted by name, got %s before %s", board.VMs[i-1].Name, vm.Name)
+		}
+		if vm.State != wantStates[vm.Name] {
+			t.Errorf("expected %s to be %s, got %s", vm.Name, wantStates[vm.Name], vm.State)
+		}
+	}
+	if board.VMs[0].Elapsed != 2*time.Hour {
+		t.Errorf("expected app-1 to run for 2h, got %s", board.VMs[0].Elapsed)
+	}
+	if board.VMs[4].Elapsed != 3*time.Hour {
+		t.Errorf("expected web-2 to have taken 3h, got %s", board.VMs[4].Elapsed)
+	}
+	if board.States[StateDone] != 1 || board.States[StateFailed] != 1 {
+		t.Errorf("unexpected state counts %v", board.States)
+	}
+
+	if len(board.Blockers) != 2 {
+		t.Fatalf("expected 2 blockers, got %v", board.Blockers)
+	}
+	if board.Blockers[0].VM != "db-1" || board.Blockers[0].Reason != "virt-v2v failed: disk not found" {
+		t.Errorf("unexpected blocker %+v", board.Blockers[0])
+	}
+	if board.Blockers[1].VM != "web-2" || board.Blockers[1].Reason != "base: Monitoring restored" {
+		t.Errorf("unexpected blocker %+v", board.Blockers[1])
+	}
+}
+
+func TestBuildCompletedWave(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	end := start.Add(5 * time.Hour)
+	board := Build("wave-1", 8*time.Hour, []Tracking{{VMID: "vm-1", Name: "web-1", Started: &start, Completed: &end}}, nil, end.Add(24*time.Hour))
+
+	if board.Elapsed != 5*time.Hour {
+		t.Errorf("expected the elapsed time to stop at the last completion, got %s", board.Elapsed)
+	}
+	if board.VMs[0].State != StateDone {
+		t.Errorf("expected a VM without checklist to be done once migrated, got %s", board.VMs[0].State)
+	}
+}
+
+func TestBuildNotStarted(t *testing.T) {
+	t.Parallel()
+
+	board := Build("wave-1", time.Hour, []Tracking{{VMID: "vm-1", Name: "web-1"}}, nil, time.Now())
+	if board.Elapsed != 0 {
+		t.Errorf("expected no elapsed time before the first VM starts, got %s", board.Elapsed)
+	}
+	if board.VMs[0].State != StateSyncing {
+		t.Errorf("expected a VM not started to be syncing, got %s", board.VMs[0].State)
+	}
+}
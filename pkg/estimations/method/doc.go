@@ -0,0 +1,7 @@
+// Package method decides, per VM, how it leaves the source environment.
+//
+// Every VM is assigned one Method (cold, warm, replatform or retire) by a Selector
+// that evaluates assessment results, disk size, change rate and business constraints.
+// The assignments are then estimated per method, each with its own set of calculators,
+// so that VMs being retired or rebuilt do not inflate the migration effort.
+package method
@@ -0,0 +1,101 @@
+package method
+
+import (
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// Summary aggregates the VMs assigned to one method.
+type Summary struct {
+	VMCount int
+	DiskGB  float64
+	// Share is the fraction (0–1) of all assigned VMs that use this method.
+	Share float64
+	// ChangeRatePercent is the daily change rate of the VMs weighted by their disk size, over the VMs
+	// whose change rate is known. Zero means unknown.
+	ChangeRatePercent float64
+}
+
+// Params returns the calculator inputs describing the VMs of this summary. The change rate is only
+// given when known, so calculators fall back to their default.
+func (s Summary) Params() []estimation.Param {
+	params := []estimation.Param{
+		{Key: calculators.ParamVMCount, Value: s.VMCount},
+		{Key: calculators.ParamTotalDiskGB, Value: s.DiskGB},
+	}
+	if s.ChangeRatePercent > 0 {
+		params = append(params, estimation.Param{Key: calculators.ParamChangeRatePercent, Value: s.ChangeRatePercent})
+	}
+	return params
+}
+
+// Summarize groups assignments by method. Every method in Methods is present in the result.
+func Summarize(assignments []Assignment) map[Method]Summary {
+	res := make(map[Method]Summary, len(Methods))
+	for _, m := range Methods {
+		res[m] = Summary{}
+	}
+	// changedGB and knownGB accumulate the disks of the VMs with a known change rate
+	changedGB := make(map[Method]float64, len(Methods))
+	knownGB := make(map[Method]float64, len(Methods))
+	for _, a := range assignments {
+		s := res[a.Method]
+		s.VMCount++
+		s.DiskGB += a.Candidate.VM.DiskGB
+		res[a.Method] = s
+		if rate := a.Candidate.DailyChangeRatePercent; rate > 0 {
+			changedGB[a.Method] += a.Candidate.VM.DiskGB * rate
+			knownGB[a.Method] += a.Candidate.VM.DiskGB
+		}
+	}
+	if len(assignments) > 0 {
+		for m, s := range res {
+			s.Share = float64(s.VMCount) / float64(len(assignments))
+			if knownGB[m] > 0 {
+				s.ChangeRatePercent = changedGB[m] / knownGB[m]
+			}
+			res[m] = s
+		}
+	}
+	return res
+}
+
+// DefaultCalculators returns the calculators used for each method when the caller does not provide its own.
+// Retired VMs are estimated with the Decommission calculator; replatformed VMs have no calculators
+// and are excluded from the migration effort. Warm VMs are copied with incremental passes sized by
+// their change rate instead of a cold copy.
+func DefaultCalculators() map[Method][]estimation.Calculator {
+	return map[Method][]estimation.Calculator{
+		Cold: {
+			calculators.NewStorageMigration(),
+			calculators.NewPostMigrationTroubleShooting(),
+		},
+		Warm: {
+			calculators.NewWarmMigrationDeltaSync(),
+			calculators.NewPostMigrationTroubleShooting(),
+		},
+		Retire: {
+			calculators.NewDecommission(),
+		},
+	}
+}
+
+// Estimate runs, for every method that has both VMs and calculators, a dedicated Engine over the
+// VMs assigned to that method. extra params (e.g. transfer rate, engineer count) are passed to every engine.
+func Estimate(assignments []Assignment, calcs map[Method][]estimation.Calculator, extra ...estimation.Param) map[Method]map[string]estimation.Estimation {
+	summaries := Summarize(assignments)
+	res := make(map[Method]map[string]estimation.Estimation)
+	for _, m := range Methods {
+		s := summaries[m]
+		if s.VMCount == 0 || len(calcs[m]) == 0 {
+			continue
+		}
+		engine := estimation.NewEngine()
+		for _, c := range calcs[m] {
+			engine.Register(c)
+		}
+		params := append(s.Params(), extra...)
+		res[m] = engine.Run(params)
+	}
+	return res
+}
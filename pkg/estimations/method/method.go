@@ -0,0 +1,169 @@
+package method
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Method is the migration approach chosen for a VM.
+type Method string
+
+const (
+	// Cold copies the VM disks while the source VM is powered off.
+	Cold Method = "cold"
+	// Warm pre-copies the disks while the source VM is running and only powers it off for the final sync.
+	Warm Method = "warm"
+	// Replatform rebuilds the workload on the target instead of moving the VM.
+	Replatform Method = "replatform"
+	// Retire decommissions the VM instead of migrating it.
+	Retire Method = "retire"
+)
+
+// Methods lists all methods in canonical order.
+var Methods = []Method{Cold, Warm, Replatform, Retire}
+
+const (
+	// DefaultMaxWarmChangeRatePercent is the daily change rate above which warm migration is not expected to converge.
+	DefaultMaxWarmChangeRatePercent = 20.0
+)
+
+// Candidate is a VM together with the business input needed to choose its migration method.
+type Candidate struct {
+	VM inventory.VM
+	// DailyChangeRatePercent is the share of the VM's disks rewritten per day. Zero means unknown.
+	DailyChangeRatePercent float64
+	// MaxDowntime is the longest outage tolerated by the VM's application. Zero means unconstrained.
+	MaxDowntime time.Duration
+	// Retire marks VMs the business has decided to decommission.
+	Retire bool
+	// Replatform marks VMs whose workload is rebuilt on the target.
+	Replatform bool
+}
+
+// Assignment is the method chosen for one VM and the reason for the choice.
+type Assignment struct {
+	Candidate Candidate
+	Method    Method
+	Reason    string
+}
+
+// Selector assigns a Method to each Candidate.
+// Rules are evaluated in order and the first matching rule wins:
+// business flags, assessment blockers, downtime constraints and finally cold migration as the default.
+type Selector struct {
+	transferRateMbps         float64
+	maxWarmChangeRatePercent float64
+	retirePoweredOff         bool
+}
+
+// SelectorOption is a functional option for configuring a Selector.
+type SelectorOption func(*Selector)
+
+// WithTransferRateMbps sets the transfer rate used to predict the cold copy outage.
+// Non-positive values are ignored and the default is kept.
+func WithTransferRateMbps(mbps float64) SelectorOption {
+	return func(s *Selector) {
+		if mbps > 0 {
+			s.transferRateMbps = mbps
+		}
+	}
+}
+
+// WithMaxWarmChangeRatePercent sets the daily change rate above which warm migration is not considered.
+// Non-positive values are ignored and the default is kept.
+func WithMaxWarmChangeRatePercent(percent float64) SelectorOption {
+	return func(s *Selector) {
+		if percent > 0 {
+			s.maxWarmChangeRatePercent = percent
+		}
+	}
+}
+
+// WithRetirePoweredOff makes the Selector retire VMs that are powered off at assessment time.
+func WithRetirePoweredOff(retire bool) SelectorOption {
+	return func(s *Selector) {
+		s.retirePoweredOff = retire
+	}
+}
+
+// NewSelector creates a Selector with default settings that can be overridden by options.
+func NewSelector(opts ...SelectorOption) *Selector {
+	s := Selector{
+		transferRateMbps:         calculators.DefaultTransferRateMbps,
+		maxWarmChangeRatePercent: DefaultMaxWarmChangeRatePercent,
+	}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return &s
+}
+
+// Select assigns a method to a single candidate.
+// An error is returned when the downtime check can not be evaluated, e.g. for a negative disk size.
+func (s *Selector) Select(c Candidate) (Assignment, error) {
+	assign := func(m Method, format string, args ...any) (Assignment, error) {
+		return Assignment{Candidate: c, Method: m, Reason: fmt.Sprintf(format, args...)}, nil
+	}
+
+	if c.Retire {
+		return assign(Retire, "flagged for retirement")
+	}
+	if s.retirePoweredOff && c.VM.PowerState == inventory.PowerStateOff {
+		return assign(Retire, "powered off at assessment time")
+	}
+	if c.Replatform {
+		return assign(Replatform, "flagged for replatforming")
+	}
+	if concern, ok := c.VM.CriticalConcern(); ok {
+		return assign(Replatform, "can not be migrated as-is: %s", concern.Label)
+	}
+
+	if c.MaxDowntime > 0 {
+		coldOutage, err := s.coldOutage(c.VM.DiskGB)
+		if err != nil {
+			return Assignment{}, fmt.Errorf("VM %q: failed to estimate cold copy outage: %w", c.VM.Name, err)
+		}
+		if coldOutage > c.MaxDowntime {
+			if c.DailyChangeRatePercent > s.maxWarmChangeRatePercent {
+				return assign(Replatform, "cold copy of %.0f GB takes %s, exceeding the %s downtime limit, and %.0f%% daily change rate is too high for warm migration",
+					c.VM.DiskGB, coldOutage.Round(time.Minute), c.MaxDowntime, c.DailyChangeRatePercent)
+			}
+			return assign(Warm, "cold copy of %.0f GB takes %s, exceeding the %s downtime limit",
+				c.VM.DiskGB, coldOutage.Round(time.Minute), c.MaxDowntime)
+		}
+		return assign(Cold, "cold copy of %.0f GB fits the %s downtime limit", c.VM.DiskGB, c.MaxDowntime)
+	}
+
+	return assign(Cold, "no downtime constraint")
+}
+
+// SelectAll assigns a method to every candidate, preserving order. It stops at the first candidate that fails.
+func (s *Selector) SelectAll(candidates []Candidate) ([]Assignment, error) {
+	res := make([]Assignment, 0, len(candidates))
+	for _, c := range candidates {
+		a, err := s.Select(c)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+	return res, nil
+}
+
+// coldOutage predicts the outage of a cold copy using the StorageMigration calculator.
+func (s *Selector) coldOutage(diskGB float64) (time.Duration, error) {
+	est, err := calculators.NewStorageMigration(calculators.WithTransferRateMbps(s.transferRateMbps)).
+		Calculate(map[string]estimation.Param{
+			calculators.ParamTotalDiskGB: {Key: calculators.ParamTotalDiskGB, Value: diskGB},
+		})
+	if err != nil {
+		return 0, err
+	}
+	return est.Duration, nil
+}
@@ -0,0 +1,270 @@
+package method
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestSelect_Rules(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name       string
+		opts       []SelectorOption
+		candidate  Candidate
+		wantMethod Method
+		wantReason string
+	}{
+		{
+			name:       "retire flag wins over everything",
+			candidate:  Candidate{VM: inventory.VM{DiskGB: 10}, Retire: true, Replatform: true},
+			wantMethod: Retire,
+			wantReason: "retirement",
+		},
+		{
+			name:       "powered off VM retired when enabled",
+			opts:       []SelectorOption{WithRetirePoweredOff(true)},
+			candidate:  Candidate{VM: inventory.VM{PowerState: inventory.PowerStateOff}},
+			wantMethod: Retire,
+			wantReason: "powered off",
+		},
+		{
+			name:       "powered off VM migrated by default",
+			candidate:  Candidate{VM: inventory.VM{PowerState: inventory.PowerStateOff}},
+			wantMethod: Cold,
+		},
+		{
+			name:       "replatform flag",
+			candidate:  Candidate{Replatform: true},
+			wantMethod: Replatform,
+		},
+		{
+			name: "critical concern forces replatform",
+			candidate: Candidate{VM: inventory.VM{Concerns: []inventory.Concern{
+				{Label: "RDM disk", Category: inventory.ConcernCategoryCritical},
+			}}},
+			wantMethod: Replatform,
+			wantReason: "RDM disk",
+		},
+		{
+			name: "warning concern does not change the method",
+			candidate: Candidate{VM: inventory.VM{Concerns: []inventory.Concern{
+				{Label: "CBT disabled", Category: inventory.ConcernCategoryWarning},
+			}}},
+			wantMethod: Cold,
+		},
+		{
+			// 2000 GB at 620 Mbps ≈ 7h20m
+			name:       "large VM with tight downtime goes warm",
+			candidate:  Candidate{VM: inventory.VM{DiskGB: 2000}, MaxDowntime: time.Hour, DailyChangeRatePercent: 5},
+			wantMethod: Warm,
+			wantReason: "exceeding the 1h0m0s downtime limit",
+		},
+		{
+			name:       "high change rate prevents warm",
+			candidate:  Candidate{VM: inventory.VM{DiskGB: 2000}, MaxDowntime: time.Hour, DailyChangeRatePercent: 50},
+			wantMethod: Replatform,
+			wantReason: "too high for warm",
+		},
+		{
+			name:       "custom change rate threshold",
+			opts:       []SelectorOption{WithMaxWarmChangeRatePercent(60)},
+			candidate:  Candidate{VM: inventory.VM{DiskGB: 2000}, MaxDowntime: time.Hour, DailyChangeRatePercent: 50},
+			wantMethod: Warm,
+		},
+		{
+			name:       "small VM fits downtime",
+			candidate:  Candidate{VM: inventory.VM{DiskGB: 10}, MaxDowntime: time.Hour},
+			wantMethod: Cold,
+			wantReason: "fits",
+		},
+		{
+			name:       "faster link makes cold fit",
+			opts:       []SelectorOption{WithTransferRateMbps(100000)},
+			candidate:  Candidate{VM: inventory.VM{DiskGB: 2000}, MaxDowntime: time.Hour},
+			wantMethod: Cold,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			a, err := NewSelector(tc.opts...).Select(tc.candidate)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if a.Method != tc.wantMethod {
+				t.Errorf("expected method %s, got %s (%s)", tc.wantMethod, a.Method, a.Reason)
+			}
+			if a.Reason == "" {
+				t.Error("expected non-empty reason")
+			}
+			if tc.wantReason != "" && !strings.Contains(a.Reason, tc.wantReason) {
+				t.Errorf("expected reason to contain %q, got %q", tc.wantReason, a.Reason)
+			}
+		})
+	}
+}
+
+func selectAll(t *testing.T, candidates []Candidate) []Assignment {
+	t.Helper()
+	assignments, err := NewSelector().SelectAll(candidates)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	return assignments
+}
+
+func TestSelect_InvalidDiskSize(t *testing.T) {
+	t.Parallel()
+	_, err := NewSelector().Select(Candidate{VM: inventory.VM{Name: "broken", DiskGB: -1}, MaxDowntime: time.Hour})
+	if err == nil {
+		t.Error("expected error for a negative disk size")
+	}
+	if _, err := NewSelector().SelectAll([]Candidate{{}, {VM: inventory.VM{DiskGB: -1}, MaxDowntime: time.Hour}}); err == nil {
+		t.Error("expected SelectAll to fail on an invalid candidate")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+	assignments := selectAll(t, []Candidate{
+		{VM: inventory.VM{DiskGB: 100}},
+		{VM: inventory.VM{DiskGB: 200}},
+		{VM: inventory.VM{DiskGB: 300}},
+		{VM: inventory.VM{DiskGB: 400}, Retire: true},
+	})
+
+	summaries := Summarize(assignments)
+	if len(summaries) != len(Methods) {
+		t.Fatalf("expected an entry for every method, got %d", len(summaries))
+	}
+	if got := summaries[Cold]; got.VMCount != 3 || got.DiskGB != 600 {
+		t.Errorf("unexpected cold summary %+v", got)
+	}
+	if got := summaries[Retire]; got.VMCount != 1 || math.Abs(got.Share-0.25) > 1e-9 {
+		t.Errorf("unexpected retire summary %+v", got)
+	}
+	if got := summaries[Warm]; got.VMCount != 0 || got.Share != 0 {
+		t.Errorf("unexpected warm summary %+v", got)
+	}
+}
+
+func TestEstimate_RetiredVMsUseDecommissionTrack(t *testing.T) {
+	t.Parallel()
+	assignments := selectAll(t, []Candidate{
+		{VM: inventory.VM{DiskGB: 500}},
+		{VM: inventory.VM{DiskGB: 500}, Retire: true},
+	})
+
+	results := Estimate(assignments, DefaultCalculators())
+	if _, ok := results[Retire]["Decommission"]; !ok {
+		t.Error("expected retired VMs to be estimated by the decommission calculator")
+	}
+	if _, ok := results[Retire]["Storage Migration"]; ok {
+		t.Error("expected no storage migration for retired VMs")
+	}
+	cold, ok := results[Cold]
+	if !ok {
+		t.Fatal("expected estimation for cold VMs")
+	}
+
+	all := estimation.NewEngine()
+	for _, c := range DefaultCalculators()[Cold] {
+		all.Register(c)
+	}
+	// only the single cold VM (500 GB) is estimated
+	want := all.Run(Summary{VMCount: 1, DiskGB: 500}.Params())
+	for name, est := range want {
+		if cold[name].Duration != est.Duration {
+			t.Errorf("%s: expected %v, got %v", name, est.Duration, cold[name].Duration)
+		}
+	}
+}
+
+func TestEstimate_WarmVMsUseDeltaSyncWithTheirChangeRate(t *testing.T) {
+	t.Parallel()
+	assignments := selectAll(t, []Candidate{
+		{VM: inventory.VM{DiskGB: 2000}, MaxDowntime: time.Hour, DailyChangeRatePercent: 5},
+		{VM: inventory.VM{DiskGB: 3000}, MaxDowntime: time.Hour, DailyChangeRatePercent: 10},
+	})
+
+	warm := Summarize(assignments)[Warm]
+	if warm.VMCount != 2 {
+		t.Fatalf("expected both VMs to go warm, got %+v", warm)
+	}
+	// (2000 * 5 + 3000 * 10) / 5000
+	if math.Abs(warm.ChangeRatePercent-8) > 1e-9 {
+		t.Errorf("expected a disk-weighted change rate of 8%%, got %v", warm.ChangeRatePercent)
+	}
+
+	results := Estimate(assignments, DefaultCalculators())
+	got, ok := results[Warm]["Warm Migration Delta Sync"]
+	if !ok {
+		t.Fatal("expected warm VMs to be estimated by the delta sync calculator")
+	}
+	if _, ok := results[Warm]["Storage Migration"]; ok {
+		t.Error("expected no cold storage migration for warm VMs")
+	}
+	if !strings.Contains(got.Reason, "8.0%/day") {
+		t.Errorf("expected the change rate of the VMs in the reason, got %q", got.Reason)
+	}
+}
+
+func TestReportRetirement(t *testing.T) {
+	t.Parallel()
+	assignments := selectAll(t, []Candidate{
+		{VM: inventory.VM{DiskGB: 100}},
+		{VM: inventory.VM{DiskGB: 1000}, Retire: true},
+		{VM: inventory.VM{DiskGB: 1000}, Retire: true},
+	})
+	migration := DefaultCalculators()[Cold]
+
+	report, err := ReportRetirement(assignments, migration, calculators.NewDecommission())
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if report.VMCount != 2 || report.DiskGB != 2000 {
+		t.Errorf("unexpected retired totals: %d VMs, %.0f GB", report.VMCount, report.DiskGB)
+	}
+	if len(report.AvoidedMigration) != len(migration) {
+		t.Errorf("expected %d avoided migration entries, got %d", len(migration), len(report.AvoidedMigration))
+	}
+	if report.Decommission.Duration <= 0 {
+		t.Error("expected positive decommission effort")
+	}
+	if report.Savings != report.AvoidedMigrationTotal-report.Decommission.Duration {
+		t.Errorf("savings %v do not match avoided %v - decommission %v",
+			report.Savings, report.AvoidedMigrationTotal, report.Decommission.Duration)
+	}
+}
+
+func TestReportRetirement_NoRetiredVMs(t *testing.T) {
+	t.Parallel()
+	assignments := selectAll(t, []Candidate{{VM: inventory.VM{DiskGB: 100}}})
+
+	report, err := ReportRetirement(assignments, DefaultCalculators()[Cold], calculators.NewDecommission())
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if report.VMCount != 0 || report.Savings != 0 || report.AvoidedMigrationTotal != 0 {
+		t.Errorf("expected empty report, got %+v", report)
+	}
+}
+
+func TestReportRetirement_MigrationCalculatorError(t *testing.T) {
+	t.Parallel()
+	assignments := selectAll(t, []Candidate{{VM: inventory.VM{DiskGB: 100}, Retire: true}})
+	zeroEngineers := estimation.Param{Key: calculators.ParamPostMigrationEngineers, Value: 0}
+
+	_, err := ReportRetirement(assignments, DefaultCalculators()[Cold], calculators.NewDecommission(), zeroEngineers)
+	if err == nil {
+		t.Error("expected error when a migration calculator fails")
+	}
+}
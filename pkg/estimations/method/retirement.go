@@ -0,0 +1,65 @@
+package method
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// RetirementReport quantifies the effect of retiring VMs instead of migrating them.
+type RetirementReport struct {
+	VMCount int
+	DiskGB  float64
+	// AvoidedMigration is the migration effort the retired VMs would have required, per calculator.
+	AvoidedMigration map[string]estimation.Estimation
+	// AvoidedMigrationTotal is the sum of AvoidedMigration.
+	AvoidedMigrationTotal time.Duration
+	// Decommission is the effort needed to retire the VMs.
+	Decommission estimation.Estimation
+	// Savings is AvoidedMigrationTotal minus the decommission duration. Both are calendar time as returned by
+	// the calculators, each track staffed by its own team (post-migration engineers vs. decommission engineers),
+	// so Savings is the difference in elapsed time, not in engineer-hours.
+	// It is negative when retiring takes longer than migrating.
+	Savings time.Duration
+}
+
+// ReportRetirement builds the RetirementReport for the VMs assigned to Retire.
+// migration is the set of calculators the VMs would have gone through had they been migrated,
+// decommission estimates the retirement itself. extra params are passed to both.
+// Any calculator failure is returned: a missing part would otherwise silently understate the savings.
+func ReportRetirement(assignments []Assignment, migration []estimation.Calculator, decommission estimation.Calculator, extra ...estimation.Param) (RetirementReport, error) {
+	summary := Summarize(assignments)[Retire]
+	report := RetirementReport{
+		VMCount:          summary.VMCount,
+		DiskGB:           summary.DiskGB,
+		AvoidedMigration: map[string]estimation.Estimation{},
+	}
+	if summary.VMCount == 0 {
+		return report, nil
+	}
+
+	params := append(summary.Params(), extra...)
+	paramMap := make(map[string]estimation.Param, len(params))
+	for _, p := range params {
+		paramMap[p.Key] = p
+	}
+
+	for _, c := range migration {
+		est, err := c.Calculate(paramMap)
+		if err != nil {
+			return RetirementReport{}, fmt.Errorf("failed to estimate avoided %s: %w", c.Name(), err)
+		}
+		report.AvoidedMigration[c.Name()] = est
+		report.AvoidedMigrationTotal += est.Duration
+	}
+
+	est, err := decommission.Calculate(paramMap)
+	if err != nil {
+		return RetirementReport{}, fmt.Errorf("failed to estimate decommission: %w", err)
+	}
+	report.Decommission = est
+	report.Savings = report.AvoidedMigrationTotal - est.Duration
+
+	return report, nil
+}
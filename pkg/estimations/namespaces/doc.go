@@ -0,0 +1,9 @@
+// Package namespaces designs the target OpenShift namespaces of a migration plan from the structure
+// of its source: the folders of the VMs its waves list, their owner or cost-center tag, or their
+// application, as the namespace mapping of the plan says.
+//
+// The proposed names are derived as DNS-1123 labels. The design reports the names Kubernetes would
+// reject or reserves, e.g. "default" or "openshift-*", and the sources whose derived names collide,
+// so the mapping is fixed before the namespaces reach the MTV Plans and the Terraform and Ansible
+// exports of the plan.
+package namespaces
@@ -0,0 +1,194 @@
+package namespaces
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/dnslabel"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+// IssueKind is what is wrong with a proposed namespace.
+type IssueKind string
+
+const (
+	// IssueInvalidName is a name Kubernetes rejects or reserves.
+	IssueInvalidName IssueKind = "invalid-name"
+	// IssueCollision is a name derived from several sources, merging VMs meant apart. Sources mapped
+	// to the same namespace on purpose, with an override, do not collide.
+	IssueCollision IssueKind = "collision"
+)
+
+// Namespace is a proposed target namespace and the VMs landing in it.
+type Namespace struct {
+	Name string
+	// Sources are the folder paths, tag values or applications whose VMs land in the namespace,
+	// sorted, empty for the default namespace only.
+	Sources []string
+	// Waves are the waves migrating VMs to the namespace, in plan order.
+	Waves []string
+	// VMs are the VMs landing in the namespace, in plan order.
+	VMs []VM
+}
+
+// VM is a VM a wave migrates.
+type VM struct {
+	ID   string
+	Wave string
+}
+
+// Issue is a proposed namespace to fix in the mapping.
+type Issue struct {
+	Kind      IssueKind
+	Namespace string
+	Sources   []string
+	Message   string
+}
+
+// Design is the target namespaces proposed for a plan.
+type Design struct {
+	Mapping plan.NamespaceMapping
+	// Namespaces are sorted by name.
+	Namespaces []Namespace
+	// Unmapped are the VMs the strategy names no namespace for, without default.
+	Unmapped []VM
+	Issues   []Issue
+}
+
+// Propose proposes the target namespaces of the VMs the waves of the plan list, and checks them.
+// It fails when the mapping is invalid.
+func Propose(p plan.Plan, m plan.NamespaceMapping) (Design, error) {
+	if err := m.Validate(); err != nil {
+		return Design{}, err
+	}
+
+	d := Design{Mapping: m}
+	byName := make(map[string]*Namespace)
+	// derived keeps the sources each name was derived from, to find the collisions
+	derived := make(map[string][]string)
+	for _, w := range p.Waves {
+		for _, vm := range w.Members {
+			source := m.Source(vm)
+			name, ok := m.Overrides[source]
+			switch {
+			case ok:
+			case source != "":
+				name = Name(m.Prefix + source)
+				if !slices.Contains(derived[name], source) {
+					derived[name] = append(derived[name], source)
+				}
+			case m.Default != "":
+				name = m.Default
+			default:
+				d.Unmapped = append(d.Unmapped, VM{ID: vm.ID, Wave: w.Name})
+				continue
+			}
+
+			ns, ok := byName[name]
+			if !ok {
+				ns = &Namespace{Name: name}
+				byName[name] = ns
+			}
+			if source != "" && !slices.Contains(ns.Sources, source) {
+				ns.Sources = append(ns.Sources, source)
+			}
+			if !slices.Contains(ns.Waves, w.Name) {
+				ns.Waves = append(ns.Waves, w.Name)
+			}
+			ns.VMs = append(ns.VMs, VM{ID: vm.ID, Wave: w.Name})
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(byName)) {
+		ns := byName[name]
+		slices.Sort(ns.Sources)
+		d.Namespaces = append(d.Namespaces, *ns)
+		if reason := invalid(name); reason != "" {
+			d.Issues = append(d.Issues, Issue{
+				Kind:      IssueInvalidName,
+				Namespace: name,
+				Sources:   ns.Sources,
+				Message:   fmt.Sprintf("namespace %q %s", name, reason),
+			})
+		}
+		if sources := derived[name]; len(sources) > 1 {
+			slices.Sort(sources)
+			d.Issues = append(d.Issues, Issue{
+				Kind:      IssueCollision,
+				Namespace: name,
+				Sources:   sources,
+				Message:   fmt.Sprintf("namespace %q is derived from %s", name, strings.Join(quote(sources), ", ")),
+			})
+		}
+	}
+	return d, nil
+}
+
+// Wave returns the namespaces the wave migrates VMs to, sorted by name, with the VMs of the wave
+// only, and the VMs of the wave left unmapped.
+func (d Design) Wave(wave string) ([]Namespace, []VM) {
+	var res []Namespace
+	for _, ns := range d.Namespaces {
+		if !slices.Contains(ns.Waves, wave) {
+			continue
+		}
+		ns.Waves = []string{wave}
+		ns.VMs = inWave(ns.VMs, wave)
+		res = append(res, ns)
+	}
+	return res, inWave(d.Unmapped, wave)
+}
+
+// Err returns an error describing the issues of the design, nil without issue.
+func (d Design) Err() error {
+	switch len(d.Issues) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("namespace mapping has an issue: %s", d.Issues[0].Message)
+	default:
+		return fmt.Errorf("namespace mapping has %d issues, the first: %s", len(d.Issues), d.Issues[0].Message)
+	}
+}
+
+// Name derives a DNS-1123 label from a source, e.g. "finance-payroll" from "Finance/Payroll". It is
+// empty when the source has no letter or digit.
+func Name(source string) string {
+	return dnslabel.From(source)
+}
+
+// invalid returns why Kubernetes rejects or reserves the namespace name, empty when it is valid.
+func invalid(name string) string {
+	switch {
+	case name == "":
+		return "is empty"
+	case len(name) > dnslabel.MaxLength:
+		return fmt.Sprintf("is longer than %d characters", dnslabel.MaxLength)
+	case !dnslabel.Valid(name):
+		return "is not a DNS-1123 label"
+	case name == "default" || name == "openshift" || strings.HasPrefix(name, "openshift-") || strings.HasPrefix(name, "kube-"):
+		return "is reserved"
+	default:
+		return ""
+	}
+}
+
+func inWave(vms []VM, wave string) []VM {
+	var res []VM
+	for _, vm := range vms {
+		if vm.Wave == wave {
+			res = append(res, vm)
+		}
+	}
+	return res
+}
+
+func quote(values []string) []string {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		res = append(res, fmt.Sprintf("%q", v))
+	}
+	return res
+}
@@ -0,0 +1,137 @@
+package namespaces
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+)
+
+func testPlan() plan.Plan {
+	return plan.Plan{
+		Name:  "namespaces",
+		Start: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Waves: []plan.Wave{
+			{
+				Name: "wave-1",
+				Members: []plan.WaveVM{
+					{ID: "vm-1", Folder: "Finance/Payroll", Application: "payroll", Owner: "alice"},
+					{ID: "vm-2", Folder: "Finance/Ledger", Application: "ledger", Owner: "alice"},
+					{ID: "vm-3", Folder: "HR", Application: "hiring"},
+				},
+			},
+			{
+				Name: "wave-2",
+				Members: []plan.WaveVM{
+					{ID: "vm-4", Folder: "Finance/Payroll", Application: "payroll", Owner: "bob"},
+					{ID: "vm-5", Application: "payroll"},
+				},
+			},
+		},
+	}
+}
+
+func ids(vms []VM) string {
+	res := make([]string, 0, len(vms))
+	for _, vm := range vms {
+		res = append(res, vm.ID)
+	}
+	return strings.Join(res, ",")
+}
+
+func TestPropose_ByFolder(t *testing.T) {
+	t.Parallel()
+	d, err := Propose(testPlan(), plan.NamespaceMapping{Strategy: plan.NamespaceByFolder, Prefix: "acme-"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(d.Namespaces) != 2 || d.Namespaces[0].Name != "acme-finance" || d.Namespaces[1].Name != "acme-hr" {
+		t.Fatalf("expected the acme-finance and acme-hr namespaces, got %+v", d.Namespaces)
+	}
+	finance := d.Namespaces[0]
+	if ids(finance.VMs) != "vm-1,vm-2,vm-4" || strings.Join(finance.Waves, ",") != "wave-1,wave-2" {
+		t.Errorf("unexpected finance namespace %+v", finance)
+	}
+	if ids(d.Unmapped) != "vm-5" {
+		t.Errorf("expected vm-5 unmapped, got %v", d.Unmapped)
+	}
+	if len(d.Issues) != 0 || d.Err() != nil {
+		t.Errorf("expected no issue, got %+v", d.Issues)
+	}
+	wave, unmapped := d.Wave("wave-2")
+	if len(wave) != 1 || wave[0].Name != "acme-finance" || ids(wave[0].VMs) != "vm-4" || ids(unmapped) != "vm-5" {
+		t.Errorf("expected wave-2 to migrate vm-4 to acme-finance and leave vm-5 unmapped, got %+v %+v", wave, unmapped)
+	}
+}
+
+func TestPropose_FolderDepthAndDefault(t *testing.T) {
+	t.Parallel()
+	d, err := Propose(testPlan(), plan.NamespaceMapping{Strategy: plan.NamespaceByFolder, FolderDepth: 2, Default: "unsorted"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	var names []string
+	for _, ns := range d.Namespaces {
+		names = append(names, ns.Name)
+	}
+	if strings.Join(names, ",") != "finance-ledger,finance-payroll,hr,unsorted" {
+		t.Errorf("unexpected namespaces %v", names)
+	}
+	if len(d.Unmapped) != 0 {
+		t.Errorf("expected the default namespace to take vm-5, got %v unmapped", d.Unmapped)
+	}
+}
+
+func TestPropose_Issues(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Waves[1].Members = append(p.Waves[1].Members, plan.WaveVM{ID: "vm-6", Application: "Payroll"})
+
+	d, err := Propose(p, plan.NamespaceMapping{
+		Strategy:  plan.NamespaceByApplication,
+		Overrides: map[string]string{"hiring": "openshift-hiring", "ledger": "payroll"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(d.Issues) != 2 {
+		t.Fatalf("expected a reserved name and a collision, got %+v", d.Issues)
+	}
+	if i := d.Issues[0]; i.Kind != IssueInvalidName || i.Namespace != "openshift-hiring" {
+		t.Errorf("expected openshift-hiring reserved, got %+v", i)
+	}
+	// the ledger override merges on purpose, only the payroll applications differing by case collide
+	if i := d.Issues[1]; i.Kind != IssueCollision || i.Namespace != "payroll" || strings.Join(i.Sources, ",") != "Payroll,payroll" {
+		t.Errorf("expected Payroll and payroll to collide, got %+v", i)
+	}
+	if d.Err() == nil {
+		t.Error("expected the issues reported as an error")
+	}
+}
+
+func TestPropose_InvalidMapping(t *testing.T) {
+	t.Parallel()
+	if _, err := Propose(testPlan(), plan.NamespaceMapping{Strategy: plan.NamespaceByTag, Tag: "team"}); err == nil {
+		t.Error("expected an error for an unknown tag")
+	}
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"Finance/Payroll":       "finance-payroll",
+		"  SAP  ERP (prod) ":    "sap-erp-prod",
+		"cc_100":                "cc-100",
+		"***":                   "",
+		strings.Repeat("a", 70): strings.Repeat("a", 63),
+	}
+	for source, want := range cases {
+		if got := Name(source); got != want {
+			t.Errorf("Name(%q): expected %q, got %q", source, want, got)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+// Package pervm estimates the VMs of an inventory one by one instead of in aggregate. Summed over a
+// wave, a VM with a 10 TB disk is averaged away with the small ones; estimated on its own, its copy
+// stands out and the slowest VM of the wave, the one the wave waits for, can be named.
+//
+// Run feeds an Engine the params describing each VM alone, on top of the params shared by all of
+// them, e.g. the transfer rate.
+package pervm
@@ -0,0 +1,103 @@
+package pervm
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/devices"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/firmware"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Result is the estimation of a single VM.
+type Result struct {
+	ID   string
+	Name string
+	// Estimations are the results of the calculators for the VM, keyed by calculator name.
+	Estimations map[string]estimation.Estimation
+	// Duration is the sum of the durations of the estimations, the time spent on the VM alone.
+	Duration time.Duration
+}
+
+// Report is the estimation of every VM, in the order of the inventory.
+type Report struct {
+	Results []Result
+}
+
+// Params returns the params describing vm alone: a VM count of one, its disk size, its firmware and
+// the driver changes of its devices.
+func Params(vm inventory.VM) []estimation.Param {
+	params := []estimation.Param{
+		{Key: calculators.ParamVMCount, Value: 1},
+		{Key: calculators.ParamTotalDiskGB, Value: vm.DiskGB},
+	}
+	params = append(params, firmware.Params(firmware.Analyze([]inventory.VM{vm}))...)
+	return append(params, devices.Params([]devices.Analysis{devices.Analyze(vm)})...)
+}
+
+// Run runs the engine once per VM with the params of the VM, which take precedence over the shared
+// params. As with Engine.Run, a calculator failing for a VM gives a zero estimation with the error as
+// reason.
+func Run(engine *estimation.Engine, vms []inventory.VM, shared ...estimation.Param) Report {
+	report := Report{Results: make([]Result, 0, len(vms))}
+	for _, vm := range vms {
+		params := append(append([]estimation.Param{}, shared...), Params(vm)...)
+		res := Result{ID: vm.ID, Name: vm.Name, Estimations: engine.Run(params)}
+		for _, est := range res.Estimations {
+			res.Duration += est.Duration
+		}
+		report.Results = append(report.Results, res)
+	}
+	return report
+}
+
+// Slowest returns the VM taking the longest over all the calculators. It is false for an empty report.
+func (r Report) Slowest() (Result, bool) {
+	return r.slowest(func(res Result) time.Duration { return res.Duration })
+}
+
+// SlowestBy returns the VM the calculator estimates the longest, e.g. the largest copy for the
+// storage migration. It is false when no VM was estimated by the calculator.
+func (r Report) SlowestBy(calculator string) (Result, bool) {
+	var estimated Report
+	for _, res := range r.Results {
+		if _, ok := res.Estimations[calculator]; ok {
+			estimated.Results = append(estimated.Results, res)
+		}
+	}
+	return estimated.slowest(func(res Result) time.Duration { return res.Estimations[calculator].Duration })
+}
+
+// slowest returns the first of the results with the longest duration.
+func (r Report) slowest(duration func(Result) time.Duration) (Result, bool) {
+	if len(r.Results) == 0 {
+		return Result{}, false
+	}
+	slowest := r.Results[0]
+	for _, res := range r.Results[1:] {
+		if duration(res) > duration(slowest) {
+			slowest = res
+		}
+	}
+	return slowest, true
+}
+
+// Sorted returns the results from the slowest VM to the fastest, VMs taking as long in inventory order.
+func (r Report) Sorted() []Result {
+	sorted := append([]Result{}, r.Results...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Duration > sorted[j].Duration })
+	return sorted
+}
+
+// Totals returns the durations of each calculator summed over the VMs.
+func (r Report) Totals() map[string]time.Duration {
+	totals := make(map[string]time.Duration)
+	for _, res := range r.Results {
+		for name, est := range res.Estimations {
+			totals[name] += est.Duration
+		}
+	}
+	return totals
+}
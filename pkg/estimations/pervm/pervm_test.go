@@ -0,0 +1,76 @@
+package pervm
+
+import (
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func testEngine() *estimation.Engine {
+	engine := estimation.NewEngine()
+	engine.Register(calculators.NewStorageMigration())
+	engine.Register(calculators.NewPostMigrationTroubleShooting())
+	engine.Register(calculators.NewFirmwareRemediation())
+	return engine
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	vms := []inventory.VM{
+		{ID: "vm-1", Name: "web01", DiskGB: 100},
+		{ID: "vm-2", Name: "dwh01", DiskGB: 10240},
+		{ID: "vm-3", Name: "app01", DiskGB: 200, Firmware: inventory.FirmwareEFI, SecureBoot: true},
+	}
+
+	report := Run(testEngine(), vms, estimation.Param{Key: calculators.ParamTotalDiskGB, Value: 1e6})
+
+	if len(report.Results) != 3 || report.Results[0].Name != "web01" {
+		t.Fatalf("expected a result per VM in inventory order, got %+v", report.Results)
+	}
+	storage := calculators.NewStorageMigration().Name()
+	// the disk of the VM takes precedence over the shared param
+	if got := report.Results[0].Estimations[storage].Duration; got >= report.Results[2].Estimations[storage].Duration {
+		t.Errorf("expected the copy of 100 GB to be shorter than the copy of 200 GB, got %s", got)
+	}
+	firmware := calculators.NewFirmwareRemediation().Name()
+	if report.Results[0].Estimations[firmware].Duration != 0 || report.Results[2].Estimations[firmware].Duration == 0 {
+		t.Errorf("expected firmware remediation for the secure boot VM only, got %+v", report.Results)
+	}
+	for _, res := range report.Results {
+		sum := res.Estimations[storage].Duration + res.Estimations[firmware].Duration +
+			res.Estimations[calculators.NewPostMigrationTroubleShooting().Name()].Duration
+		if res.Duration != sum {
+			t.Errorf("%s: duration %s, want the sum of its estimations %s", res.Name, res.Duration, sum)
+		}
+	}
+
+	slowest, ok := report.Slowest()
+	if !ok || slowest.ID != "vm-2" {
+		t.Errorf("expected dwh01 to be the slowest VM, got %+v", slowest)
+	}
+	slowestFirmware, ok := report.SlowestBy(firmware)
+	if !ok || slowestFirmware.ID != "vm-3" {
+		t.Errorf("expected app01 to have the longest firmware remediation, got %+v", slowestFirmware)
+	}
+	if _, ok := report.SlowestBy("unknown"); ok {
+		t.Error("expected no slowest VM for an unknown calculator")
+	}
+	if sorted := report.Sorted(); sorted[0].ID != "vm-2" || sorted[2].ID != "vm-1" {
+		t.Errorf("expected dwh01, app01, web01, got %s, %s, %s", sorted[0].Name, sorted[1].Name, sorted[2].Name)
+	}
+
+	totals := report.Totals()
+	if want := report.Results[0].Estimations[storage].Duration + report.Results[1].Estimations[storage].Duration +
+		report.Results[2].Estimations[storage].Duration; totals[storage] != want {
+		t.Errorf("expected a storage migration total of %s, got %s", want, totals[storage])
+	}
+}
+
+func TestSlowest_EmptyReport(t *testing.T) {
+	t.Parallel()
+	if _, ok := Run(testEngine(), nil).Slowest(); ok {
+		t.Error("expected no slowest VM without VMs")
+	}
+}
@@ -0,0 +1,72 @@
+package plan
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// digestPrefix names the hash function of a digest, so a digest outlives a change of function.
+const digestPrefix = "sha256:"
+
+// ErrDigestMismatch is the error of a plan whose content does not match the digest it came with,
+// e.g. an exported plan edited before being imported again.
+var ErrDigestMismatch = errors.New("plan content does not match its digest")
+
+// CanonicalJSON encodes the plan as canonical JSON: object keys sorted, no insignificant
+// whitespace and numbers as encoded by the plan. Two plans with the same content encode to the
+// same bytes whatever the order their maps were filled in.
+func (p Plan) CanonicalJSON() ([]byte, error) {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("encoding plan: %w", err)
+	}
+	return canonicalize(raw)
+}
+
+// Digest returns the content digest of the plan, "sha256:" followed by the hex SHA-256 of its
+// canonical JSON. Plans with the same digest have the same content, so the digest stands for the
+// plan in equality checks, caches and re-submissions.
+func (p Plan) Digest() (string, error) {
+	doc, err := p.CanonicalJSON()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(doc)
+	return digestPrefix + hex.EncodeToString(sum[:]), nil
+}
+
+// VerifyDigest checks the content of the plan matches the digest, failing with ErrDigestMismatch
+// when it was changed since the digest was taken.
+func (p Plan) VerifyDigest(digest string) error {
+	got, err := p.Digest()
+	if err != nil {
+		return err
+	}
+	if got != digest {
+		return fmt.Errorf("%w: expected %s, got %s", ErrDigestMismatch, digest, got)
+	}
+	return nil
+}
+
+// canonicalize re-encodes a JSON document through generic values, which encoding/json writes with
+// sorted object keys. Numbers are kept as written, not rounded through float64.
+func canonicalize(raw []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decoding plan: %w", err)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("encoding plan: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,206 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// EffortCategory is the kind of work of a phase, to report the effort of a VM by kind.
+type EffortCategory string
+
+const (
+	EffortTransfer        EffortCategory = "transfer"
+	EffortConversion      EffortCategory = "conversion"
+	EffortValidation      EffortCategory = "validation"
+	EffortTroubleshooting EffortCategory = "troubleshooting"
+	EffortOther           EffortCategory = "other"
+)
+
+// effortKeywords map the words found in phase names to their category, checked in order.
+var effortKeywords = []struct {
+	category EffortCategory
+	words    []string
+}{
+	{EffortTroubleshooting, []string{"troubleshoot", "hypercare", "remediat"}},
+	{EffortConversion, []string{"convers", "convert", "v2v", "reconfigur"}},
+	{EffortValidation, []string{"validat", "test", "check", "verif", "acceptance"}},
+	{EffortTransfer, []string{"transfer", "copy", "storage", "sync", "replicat", "cutover"}},
+}
+
+// outlierRatio is the number of times the median effort of the VMs of a plan above which a VM is
+// an outlier.
+const outlierRatio = 3.0
+
+// WaveVM is a VM migrated by a wave.
+type WaveVM struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	// Application is the application the VM belongs to, whose team the effort is charged to.
+	Application string `json:"application,omitempty"`
+	// Owner and CostCenter are the application owner and cost-center tags of the VM, to charge its
+	// effort back.
+	Owner      string `json:"owner,omitempty"`
+	CostCenter string `json:"costCenter,omitempty"`
+	// Folder is the path of the VM in the folder and vApp hierarchy of its source, e.g.
+	// "Finance/Payroll". It must lie in one of the folders of its wave when the wave has some.
+	Folder string  `json:"folder,omitempty"`
+	DiskGB float64 `json:"diskGb,omitempty"`
+}
+
+// PhaseCategory returns the category of a phase from its name, e.g. EffortTransfer for "Storage
+// Migration" and EffortTroubleshooting for "Post-Migration Troubleshooting".
+func PhaseCategory(phase string) EffortCategory {
+	name := strings.ToLower(phase)
+	for _, k := range effortKeywords {
+		for _, w := range k.words {
+			if strings.Contains(name, w) {
+				return k.category
+			}
+		}
+	}
+	return EffortOther
+}
+
+// PhaseEffort is the contribution of a VM to a phase of its wave.
+type PhaseEffort struct {
+	Phase    string
+	Category EffortCategory
+	// Share is the fraction of the effort of the phase taken by the VM: its fraction of the disks of
+	// the wave for a transfer phase, an even share of the VMs of the wave otherwise.
+	Share  float64
+	Effort time.Duration
+}
+
+// VMEffort is the effort of a plan taken by one of its VMs, phase by phase.
+type VMEffort struct {
+	VM     WaveVM
+	Wave   string
+	Phases []PhaseEffort
+	Total  time.Duration
+	// ByCategory sums the efforts of the phases by category.
+	ByCategory map[EffortCategory]time.Duration
+	// PlanShare is the fraction of the effort of the plan taken by the VM.
+	PlanShare float64
+	// MedianRatio is the total of the VM over the median total of the VMs of the plan. An Outlier
+	// takes more than three times the median.
+	MedianRatio float64
+	Outlier     bool
+}
+
+// EffortLedger decomposes the effort of the waves into the contributions of their VMs, in the order
+// of the waves and their members. The efforts of the waves after the pilot follow the learning
+// curve. Only the members of the waves are in the ledger, the other VMs of a wave taking their share
+// all the same.
+func (p Plan) EffortLedger() []VMEffort {
+	var (
+		ledger []VMEffort
+		total  time.Duration
+	)
+	for i, w := range p.Waves {
+		count := max(w.VMs, len(w.Members))
+		var membersGB float64
+		for _, vm := range w.Members {
+			membersGB += vm.DiskGB
+		}
+		diskGB := max(w.DiskGB, membersGB)
+
+		efforts := make([]time.Duration, 0, len(w.Steps))
+		for _, s := range w.Steps {
+			effort := p.LearningEffort(i, s)
+			efforts = append(efforts, effort)
+			total += effort
+		}
+
+		for _, vm := range w.Members {
+			entry := VMEffort{VM: vm, Wave: w.Name, ByCategory: make(map[EffortCategory]time.Duration)}
+			for j, s := range w.Steps {
+				category := PhaseCategory(s.Phase)
+				share := 1 / float64(count)
+				if category == EffortTransfer && diskGB > 0 {
+					share = vm.DiskGB / diskGB
+				}
+				effort := time.Duration(float64(efforts[j]) * share)
+				entry.Phases = append(entry.Phases, PhaseEffort{Phase: s.Phase, Category: category, Share: share, Effort: effort})
+				entry.ByCategory[category] += effort
+				entry.Total += effort
+			}
+			ledger = append(ledger, entry)
+		}
+	}
+
+	totals := make([]time.Duration, 0, len(ledger))
+	for _, e := range ledger {
+		totals = append(totals, e.Total)
+	}
+	median := medianDuration(totals)
+	for i := range ledger {
+		if total > 0 {
+			ledger[i].PlanShare = float64(ledger[i].Total) / float64(total)
+		}
+		if median > 0 {
+			ledger[i].MedianRatio = float64(ledger[i].Total) / float64(median)
+			ledger[i].Outlier = ledger[i].MedianRatio > outlierRatio
+		}
+	}
+	return ledger
+}
+
+// VMEffort returns the entry of the ledger of the VM with the ID, or the name when no VM has the ID.
+func (p Plan) VMEffort(vm string) (VMEffort, bool) {
+	ledger := p.EffortLedger()
+	if i := slices.IndexFunc(ledger, func(e VMEffort) bool { return e.VM.ID == vm }); i >= 0 {
+		return ledger[i], true
+	}
+	if i := slices.IndexFunc(ledger, func(e VMEffort) bool { return e.VM.Name != "" && e.VM.Name == vm }); i >= 0 {
+		return ledger[i], true
+	}
+	return VMEffort{}, false
+}
+
+// medianDuration returns the median of the durations, zero without durations.
+func medianDuration(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
+
+// validateMembers checks the members of the waves: a VM is migrated by a single wave, a wave does
+// not list more VMs than it migrates, nor VMs outside its folders.
+func (p Plan) validateMembers() error {
+	seen := make(map[string]string)
+	for _, w := range p.Waves {
+		if w.VMs > 0 && len(w.Members) > w.VMs {
+			return fmt.Errorf("wave %q lists %d VMs but migrates %d", w.Name, len(w.Members), w.VMs)
+		}
+		for _, vm := range w.Members {
+			if vm.ID == "" {
+				return errors.New("VM id is required")
+			}
+			if wave, ok := seen[vm.ID]; ok {
+				return fmt.Errorf("VM %q is migrated by waves %q and %q", vm.ID, wave, w.Name)
+			}
+			seen[vm.ID] = w.Name
+			if vm.DiskGB < 0 {
+				return fmt.Errorf("VM %q of wave %q has a negative disk size", vm.ID, w.Name)
+			}
+			if len(w.Folders) > 0 && vm.Folder != "" && !slices.ContainsFunc(w.Folders, func(f string) bool {
+				return inventory.InFolder(vm.Folder, f)
+			}) {
+				return fmt.Errorf("VM %q of wave %q is in folder %q, outside the folders of the wave", vm.ID, w.Name, vm.Folder)
+			}
+		}
+	}
+	return nil
+}
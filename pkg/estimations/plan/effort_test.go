@@ -0,0 +1,139 @@
+package plan
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func testLedgerPlan() Plan {
+	return Plan{
+		Name:  "ledger",
+		Start: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Waves: []Wave{
+			{
+				Name: "wave-1",
+				VMs:  4,
+				Members: []WaveVM{
+					{ID: "vm-1", Name: "db01", Application: "billing", DiskGB: 800},
+					{ID: "vm-2", Name: "web01", Application: "billing", DiskGB: 100},
+					{ID: "vm-3", Name: "web02", Application: "shop", DiskGB: 100},
+				},
+				Steps: []Step{
+					{Phase: "Storage Migration", Effort: 10 * time.Hour},
+					{Phase: "Guest OS Conversion", Effort: 4 * time.Hour},
+					{Phase: "Post-Migration Checks", Effort: 2 * time.Hour},
+					{Phase: "Post-Migration Troubleshooting", Effort: 8 * time.Hour},
+				},
+			},
+			{
+				Name:    "wave-2",
+				Members: []WaveVM{{ID: "vm-4", Name: "app01"}, {ID: "vm-5", Name: "app02"}},
+				Steps:   []Step{{Phase: "Storage Migration", Effort: 2 * time.Hour}, {Phase: "Kickoff", Effort: time.Hour}},
+			},
+		},
+	}
+}
+
+func TestPhaseCategory(t *testing.T) {
+	t.Parallel()
+	cases := map[string]EffortCategory{
+		"Storage Migration":              EffortTransfer,
+		"Pre-Copy":                       EffortTransfer,
+		"Guest OS Reconfiguration":       EffortConversion,
+		"Validation":                     EffortValidation,
+		"Post-Migration Checks":          EffortValidation,
+		"Post-Migration Troubleshooting": EffortTroubleshooting,
+		"Kickoff":                        EffortOther,
+	}
+	for phase, want := range cases {
+		if got := PhaseCategory(phase); got != want {
+			t.Errorf("expected %s for %q, got %s", want, phase, got)
+		}
+	}
+}
+
+func TestPlan_EffortLedger(t *testing.T) {
+	t.Parallel()
+	ledger := testLedgerPlan().EffortLedger()
+	if len(ledger) != 5 {
+		t.Fatalf("expected the 5 members in the ledger, got %d", len(ledger))
+	}
+
+	// db01 holds 800 of the 1000 GB of wave-1 and is one of its 4 VMs
+	db := ledger[0]
+	if db.Wave != "wave-1" || db.VM.Application != "billing" || len(db.Phases) != 4 {
+		t.Fatalf("unexpected entry %+v", db)
+	}
+	if p := db.Phases[0]; p.Category != EffortTransfer || p.Share != 0.8 || p.Effort != 8*time.Hour {
+		t.Errorf("expected 80%% of the transfer, got %+v", p)
+	}
+	if p := db.Phases[3]; p.Category != EffortTroubleshooting || p.Share != 0.25 || p.Effort != 2*time.Hour {
+		t.Errorf("expected a quarter of the troubleshooting, got %+v", p)
+	}
+	if db.Total != 11*time.Hour+30*time.Minute || db.ByCategory[EffortConversion] != time.Hour {
+		t.Errorf("unexpected total %v and categories %v", db.Total, db.ByCategory)
+	}
+	// 27h of effort in the plan
+	if got := db.PlanShare; got < 0.425 || got > 0.426 {
+		t.Errorf("expected db01 to take 11.5h out of 27h, got %v", got)
+	}
+
+	// wave-2 has no disk sizes: the transfer is shared evenly
+	if p := ledger[3].Phases[0]; p.Share != 0.5 || p.Effort != time.Hour {
+		t.Errorf("expected half of the transfer, got %+v", p)
+	}
+
+	// the median is the 4.5h of a web server, db01 takes about 2.6 times as much
+	if db.Outlier || db.MedianRatio < 2.5 {
+		t.Errorf("expected db01 under the outlier ratio, got %v", db.MedianRatio)
+	}
+}
+
+func TestPlan_EffortLedger_Outlier(t *testing.T) {
+	t.Parallel()
+	p := testLedgerPlan()
+	p.Waves[0].Members[0].DiskGB = 20000
+	p.Waves[0].DiskGB = 20200
+
+	e, ok := p.VMEffort("db01")
+	if !ok || e.VM.ID != "vm-1" {
+		t.Fatalf("expected db01 found by name, got %+v", e)
+	}
+	if !e.Outlier {
+		t.Errorf("expected db01 an outlier, got ratio %v", e.MedianRatio)
+	}
+	if _, ok := p.VMEffort("vm-9"); ok {
+		t.Errorf("expected no entry for a VM of no wave")
+	}
+}
+
+func TestPlan_Validate_Members(t *testing.T) {
+	t.Parallel()
+	cases := map[string]func(*Plan){
+		"VM id is required":    func(p *Plan) { p.Waves[1].Members[0].ID = "" },
+		`is migrated by waves`: func(p *Plan) { p.Waves[1].Members[0].ID = "vm-1" },
+		"lists 3 VMs but":      func(p *Plan) { p.Waves[0].VMs = 2 },
+		"negative disk size":   func(p *Plan) { p.Waves[0].Members[1].DiskGB = -1 },
+		`VM "vm-2" of wave "wave-1" is in folder "HR"`: func(p *Plan) {
+			p.Waves[0].Folders = []string{"Finance"}
+			p.Waves[0].Members[0].Folder = "Finance/Payroll"
+			p.Waves[0].Members[1].Folder = "HR"
+		},
+		"": func(p *Plan) {},
+	}
+	for want, change := range cases {
+		p := testLedgerPlan()
+		change(&p)
+		err := p.Validate()
+		if want == "" {
+			if err != nil {
+				t.Errorf("expected a valid plan, got %v", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q, got %v", want, err)
+		}
+	}
+}
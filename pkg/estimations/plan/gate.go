@@ -0,0 +1,144 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	// ErrGateNotFound is returned when approving a gate the plan does not declare.
+	ErrGateNotFound = errors.New("gate not found")
+	// ErrNotApprover is returned when a user not holding the role of a gate approves it.
+	ErrNotApprover = errors.New("user is not an approver of the gate")
+)
+
+// Boundary is the end of a phase of a wave, or of the whole wave when Phase is empty.
+type Boundary struct {
+	Wave  string `json:"wave"`
+	Phase string `json:"phase,omitempty"`
+}
+
+func (b Boundary) String() string {
+	if b.Phase == "" {
+		return fmt.Sprintf("wave %q", b.Wave)
+	}
+	return fmt.Sprintf("wave %q phase %q", b.Wave, b.Phase)
+}
+
+// Gate requires the sign-off of a role between two boundaries of the program, e.g. security
+// approving the validation of wave 1 before the transfer of wave 2 starts. Progress of the wave held
+// by the gate can not be recorded until it is approved.
+type Gate struct {
+	Name string `json:"name"`
+	// Role is the role signing off, e.g. "security".
+	Role string `json:"role"`
+	// Approvers are the users holding the role. Empty means any user may sign off for it.
+	Approvers []string `json:"approvers,omitempty"`
+	// After is the boundary reviewed by the role and Before the one held until it signs off.
+	After  Boundary `json:"after"`
+	Before Boundary `json:"before"`
+}
+
+// Approval is the recorded sign-off of a gate.
+type Approval struct {
+	Gate       string    `json:"gate"`
+	Role       string    `json:"role"`
+	ApprovedBy string    `json:"approvedBy"`
+	ApprovedAt time.Time `json:"approvedAt"`
+	Comment    string    `json:"comment,omitempty"`
+}
+
+// validateGates checks the gates are named once, hold boundaries of the plan and the approvals sign
+// off declared gates once.
+func (p Plan) validateGates() error {
+	gates := make(map[string]bool, len(p.Gates))
+	for _, g := range p.Gates {
+		if g.Name == "" {
+			return errors.New("gate name is required")
+		}
+		if gates[g.Name] {
+			return fmt.Errorf("duplicate gate %q", g.Name)
+		}
+		gates[g.Name] = true
+		if g.Role == "" {
+			return fmt.Errorf("gate %q has no role", g.Name)
+		}
+		for _, b := range []Boundary{g.After, g.Before} {
+			if !p.hasBoundary(b) {
+				return fmt.Errorf("gate %q holds unknown %s", g.Name, b)
+			}
+		}
+		if g.After == g.Before {
+			return fmt.Errorf("gate %q holds the boundary it reviews", g.Name)
+		}
+	}
+
+	approved := make(map[string]bool, len(p.Approvals))
+	for _, a := range p.Approvals {
+		if !gates[a.Gate] {
+			return fmt.Errorf("approval of unknown gate %q", a.Gate)
+		}
+		if approved[a.Gate] {
+			return fmt.Errorf("gate %q is approved more than once", a.Gate)
+		}
+		approved[a.Gate] = true
+		if a.ApprovedBy == "" {
+			return fmt.Errorf("approval of gate %q has no approver", a.Gate)
+		}
+	}
+
+	for _, wp := range p.Progress {
+		if pending := p.PendingGates(wp.Wave); len(pending) > 0 {
+			return fmt.Errorf("progress recorded for wave %q held by gate %q awaiting the sign-off of %s",
+				wp.Wave, pending[0].Name, pending[0].Role)
+		}
+	}
+	return nil
+}
+
+// hasBoundary reports whether the wave of the boundary exists and runs its phase.
+func (p Plan) hasBoundary(b Boundary) bool {
+	for _, w := range p.Waves {
+		if w.Name != b.Wave {
+			continue
+		}
+		return b.Phase == "" || slices.ContainsFunc(w.Steps, func(s Step) bool { return s.Phase == b.Phase })
+	}
+	return false
+}
+
+// PendingGates returns the gates holding the wave that are not approved yet, in declaration order.
+func (p Plan) PendingGates(wave string) []Gate {
+	var pending []Gate
+	for _, g := range p.Gates {
+		if g.Before.Wave == wave && !p.approved(g.Name) {
+			pending = append(pending, g)
+		}
+	}
+	return pending
+}
+
+func (p Plan) approved(gate string) bool {
+	return slices.ContainsFunc(p.Approvals, func(a Approval) bool { return a.Gate == gate })
+}
+
+// Approve records the sign-off of a gate by a user. It fails with ErrGateNotFound for an unknown
+// gate and ErrNotApprover for a user not listed as an approver of its role.
+func (p *Plan) Approve(gate, username string, at time.Time, comment string) (Approval, error) {
+	i := slices.IndexFunc(p.Gates, func(g Gate) bool { return g.Name == gate })
+	if i < 0 {
+		return Approval{}, fmt.Errorf("%w: %q", ErrGateNotFound, gate)
+	}
+	g := p.Gates[i]
+	if len(g.Approvers) > 0 && !slices.Contains(g.Approvers, username) {
+		return Approval{}, fmt.Errorf("%w: %s does not sign off for %s", ErrNotApprover, username, g.Role)
+	}
+	if p.approved(gate) {
+		return Approval{}, fmt.Errorf("gate %q is already approved", gate)
+	}
+	a := Approval{Gate: gate, Role: g.Role, ApprovedBy: username, ApprovedAt: at, Comment: comment}
+	p.Approvals = append(p.Approvals, a)
+	return a, nil
+}
@@ -0,0 +1,100 @@
+package plan
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func testGatedPlan() Plan {
+	p := testPlan()
+	p.Gates = []Gate{{
+		Name:      "wave-1 security review",
+		Role:      "security",
+		Approvers: []string{"alice"},
+		After:     Boundary{Wave: "wave-1", Phase: "Validation"},
+		Before:    Boundary{Wave: "wave-2", Phase: "Pre-Copy"},
+	}}
+	return p
+}
+
+func TestPlan_Approve(t *testing.T) {
+	t.Parallel()
+	p := testGatedPlan()
+	if err := p.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if pending := p.PendingGates("wave-2"); len(pending) != 1 {
+		t.Fatalf("expected wave-2 held by the gate, got %v", pending)
+	}
+	if pending := p.PendingGates("wave-1"); len(pending) != 0 {
+		t.Errorf("expected wave-1 not held, got %v", pending)
+	}
+
+	// progress past the gate is blocked until the sign-off
+	p.RecordProgress(WaveProgress{Wave: "wave-2", Start: p.Start, End: p.Start.Add(24 * time.Hour), VMsMigrated: 10})
+	if err := p.Validate(); err == nil {
+		t.Error("expected progress of a held wave to be rejected")
+	}
+	p.Progress = nil
+
+	at := p.Start.Add(48 * time.Hour)
+	if _, err := p.Approve("wave-1 security review", "bob", at, ""); !errors.Is(err, ErrNotApprover) {
+		t.Errorf("expected ErrNotApprover, got %v", err)
+	}
+	if _, err := p.Approve("wave-1 change board", "alice", at, ""); !errors.Is(err, ErrGateNotFound) {
+		t.Errorf("expected ErrGateNotFound, got %v", err)
+	}
+	a, err := p.Approve("wave-1 security review", "alice", at, "pen test passed")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if a.Role != "security" || a.ApprovedBy != "alice" || !a.ApprovedAt.Equal(at) {
+		t.Errorf("unexpected approval %+v", a)
+	}
+	if _, err := p.Approve("wave-1 security review", "alice", at, ""); err == nil {
+		t.Error("expected error when approving a gate twice")
+	}
+
+	p.RecordProgress(WaveProgress{Wave: "wave-2", Start: p.Start, End: p.Start.Add(24 * time.Hour), VMsMigrated: 10})
+	if err := p.Validate(); err != nil {
+		t.Errorf("expected progress recorded once approved, got: %v", err)
+	}
+}
+
+func TestPlan_ValidateGates(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		modify func(p *Plan)
+	}{
+		{name: "gate without name", modify: func(p *Plan) { p.Gates[0].Name = "" }},
+		{name: "gate without role", modify: func(p *Plan) { p.Gates[0].Role = "" }},
+		{name: "duplicate gate", modify: func(p *Plan) { p.Gates = append(p.Gates, p.Gates[0]) }},
+		{name: "unknown wave", modify: func(p *Plan) { p.Gates[0].Before.Wave = "wave-3" }},
+		{name: "unknown phase", modify: func(p *Plan) { p.Gates[0].After.Phase = "Cutover" }},
+		{name: "gate holding the boundary it reviews", modify: func(p *Plan) { p.Gates[0].Before = p.Gates[0].After }},
+		{name: "approval of unknown gate", modify: func(p *Plan) {
+			p.Approvals = []Approval{{Gate: "change board", ApprovedBy: "alice"}}
+		}},
+		{name: "approval without approver", modify: func(p *Plan) {
+			p.Approvals = []Approval{{Gate: "wave-1 security review"}}
+		}},
+		{name: "gate approved twice", modify: func(p *Plan) {
+			a := Approval{Gate: "wave-1 security review", ApprovedBy: "alice"}
+			p.Approvals = []Approval{a, a}
+		}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := testGatedPlan()
+			tc.modify(&p)
+			if err := p.Validate(); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// KPI identifies a program key performance indicator.
+type KPI string
+
+const (
+	// KPIVelocity is the number of VMs migrated per week over the program so far.
+	KPIVelocity KPI = "vms-per-week"
+	// KPIRollbackRate is the percentage of the VMs cut over that were rolled back to the source.
+	KPIRollbackRate KPI = "rollback-rate"
+	// KPIP1Incidents is the number of P1 incidents raised by a wave.
+	KPIP1Incidents KPI = "p1-incidents"
+)
+
+// KPIs are the targets a program is tracked against. A nil target is not tracked.
+type KPIs struct {
+	MinVMsPerWeek          *float64 `json:"minVmsPerWeek,omitempty"`
+	MaxRollbackRatePercent *float64 `json:"maxRollbackRatePercent,omitempty"`
+	MaxP1IncidentsPerWave  *int     `json:"maxP1IncidentsPerWave,omitempty"`
+}
+
+// Validate checks the targets are within range.
+func (k KPIs) Validate() error {
+	if k.MinVMsPerWeek != nil && *k.MinVMsPerWeek < 0 {
+		return errors.New("VMs per week target must be non-negative")
+	}
+	if k.MaxRollbackRatePercent != nil && (*k.MaxRollbackRatePercent < 0 || *k.MaxRollbackRatePercent > 100) {
+		return fmt.Errorf("invalid rollback rate target %.1f%%", *k.MaxRollbackRatePercent)
+	}
+	if k.MaxP1IncidentsPerWave != nil && *k.MaxP1IncidentsPerWave < 0 {
+		return errors.New("P1 incidents target must be non-negative")
+	}
+	return nil
+}
+
+// WaveProgress is the actual outcome of a completed wave.
+type WaveProgress struct {
+	Wave  string    `json:"wave"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// VMsMigrated is the number of VMs cut over and kept on the target.
+	VMsMigrated int `json:"vmsMigrated"`
+	// Rollbacks is the number of VMs cut over and rolled back to the source.
+	Rollbacks   int `json:"rollbacks"`
+	P1Incidents int `json:"p1Incidents"`
+}
+
+// KPIStatus is a KPI actual measured against its target.
+type KPIStatus struct {
+	KPI KPI
+	// Wave is empty for program-wide KPIs.
+	Wave     string
+	Target   float64
+	Actual   float64
+	Breached bool
+	Message  string
+}
+
+// KPIStatus measures the progress recorded so far against the KPI targets. Velocity and rollback
+// rate are measured over the whole program, P1 incidents per wave.
+func (p Plan) KPIStatus() []KPIStatus {
+	if p.KPIs == nil || len(p.Progress) == 0 {
+		return nil
+	}
+
+	var (
+		statuses           []KPIStatus
+		migrated, rollback int
+		first, last        time.Time
+	)
+	for _, wp := range p.Progress {
+		migrated += wp.VMsMigrated
+		rollback += wp.Rollbacks
+		if first.IsZero() || wp.Start.Before(first) {
+			first = wp.Start
+		}
+		if wp.End.After(last) {
+			last = wp.End
+		}
+	}
+
+	if target := p.KPIs.MinVMsPerWeek; target != nil {
+		// a program shorter than a day is measured over a day, not extrapolated from minutes
+		span := last.Sub(first)
+		if span < 24*time.Hour {
+			span = 24 * time.Hour
+		}
+		actual := float64(migrated) / (span.Hours() / (7 * 24))
+		statuses = append(statuses, KPIStatus{
+			KPI:      KPIVelocity,
+			Target:   *target,
+			Actual:   actual,
+			Breached: actual < *target,
+			Message:  fmt.Sprintf("%d VMs migrated in %s: %.1f VMs per week against a target of %.1f", migrated, span, actual, *target),
+		})
+	}
+
+	if target := p.KPIs.MaxRollbackRatePercent; target != nil && migrated+rollback > 0 {
+		actual := float64(rollback) / float64(migrated+rollback) * 100
+		statuses = append(statuses, KPIStatus{
+			KPI:      KPIRollbackRate,
+			Target:   *target,
+			Actual:   actual,
+			Breached: actual > *target,
+			Message:  fmt.Sprintf("%d of %d VMs rolled back: %.1f%% against a maximum of %.1f%%", rollback, migrated+rollback, actual, *target),
+		})
+	}
+
+	if target := p.KPIs.MaxP1IncidentsPerWave; target != nil {
+		for _, wp := range p.Progress {
+			statuses = append(statuses, KPIStatus{
+				KPI:      KPIP1Incidents,
+				Wave:     wp.Wave,
+				Target:   float64(*target),
+				Actual:   float64(wp.P1Incidents),
+				Breached: wp.P1Incidents > *target,
+				Message:  fmt.Sprintf("%d P1 incidents against a maximum of %d", wp.P1Incidents, *target),
+			})
+		}
+	}
+
+	return statuses
+}
+
+// NewBreaches returns the KPIs breached in current that were not breached in previous, so a
+// threshold alert is raised once when it is crossed rather than on every update.
+func NewBreaches(previous, current []KPIStatus) []KPIStatus {
+	type key struct {
+		kpi  KPI
+		wave string
+	}
+	breached := make(map[key]bool, len(previous))
+	for _, s := range previous {
+		if s.Breached {
+			breached[key{s.KPI, s.Wave}] = true
+		}
+	}
+
+	var breaches []KPIStatus
+	for _, s := range current {
+		if s.Breached && !breached[key{s.KPI, s.Wave}] {
+			breaches = append(breaches, s)
+		}
+	}
+	return breaches
+}
+
+// RecordProgress records the outcome of a wave, replacing what was recorded for it before.
+func (p *Plan) RecordProgress(wp WaveProgress) {
+	for i, existing := range p.Progress {
+		if existing.Wave == wp.Wave {
+			p.Progress[i] = wp
+			return
+		}
+	}
+	p.Progress = append(p.Progress, wp)
+}
@@ -0,0 +1,96 @@
+package plan
+
+import (
+	"testing"
+	"time"
+)
+
+func testProgressPlan() Plan {
+	velocity, rollbackRate, p1 := 20.0, 5.0, 1
+	p := testPlan()
+	p.KPIs = &KPIs{MinVMsPerWeek: &velocity, MaxRollbackRatePercent: &rollbackRate, MaxP1IncidentsPerWave: &p1}
+	p.Progress = []WaveProgress{
+		{Wave: "wave-1", Start: p.Start, End: p.Start.Add(3 * 24 * time.Hour), VMsMigrated: 19, Rollbacks: 1},
+		{Wave: "wave-2", Start: p.Start.Add(7 * 24 * time.Hour), End: p.Start.Add(14 * 24 * time.Hour), VMsMigrated: 21, P1Incidents: 2},
+	}
+	return p
+}
+
+func TestPlan_KPIStatus(t *testing.T) {
+	t.Parallel()
+	statuses := testProgressPlan().KPIStatus()
+	if len(statuses) != 4 {
+		t.Fatalf("expected 4 statuses, got %d", len(statuses))
+	}
+
+	// 40 VMs over 2 weeks
+	if s := statuses[0]; s.KPI != KPIVelocity || s.Actual != 20 || s.Breached {
+		t.Errorf("expected velocity of 20 VMs per week on target, got %+v", s)
+	}
+	// 1 rollback out of 41 VMs cut over
+	if s := statuses[1]; s.KPI != KPIRollbackRate || s.Breached {
+		t.Errorf("expected rollback rate on target, got %+v", s)
+	}
+	if s := statuses[2]; s.Wave != "wave-1" || s.Breached {
+		t.Errorf("expected wave-1 P1 incidents on target, got %+v", s)
+	}
+	if s := statuses[3]; s.Wave != "wave-2" || !s.Breached {
+		t.Errorf("expected wave-2 P1 incidents breached, got %+v", s)
+	}
+}
+
+func TestPlan_KPIStatus_Untracked(t *testing.T) {
+	t.Parallel()
+	p := testProgressPlan()
+	p.KPIs = &KPIs{}
+	if statuses := p.KPIStatus(); len(statuses) != 0 {
+		t.Errorf("expected no statuses without targets, got %d", len(statuses))
+	}
+}
+
+func TestNewBreaches(t *testing.T) {
+	t.Parallel()
+	p := testProgressPlan()
+	previous := p.KPIStatus()
+
+	p.RecordProgress(WaveProgress{Wave: "wave-1", Start: p.Start, End: p.Start.Add(3 * 24 * time.Hour), VMsMigrated: 10, Rollbacks: 10})
+	if len(p.Progress) != 2 {
+		t.Fatalf("expected wave-1 progress to be replaced, got %d entries", len(p.Progress))
+	}
+
+	// velocity and rollback rate are breached, wave-2 P1 incidents were already
+	breaches := NewBreaches(previous, p.KPIStatus())
+	if len(breaches) != 2 {
+		t.Fatalf("expected 2 new breaches, got %d", len(breaches))
+	}
+	if breaches[0].KPI != KPIVelocity || breaches[1].KPI != KPIRollbackRate {
+		t.Errorf("expected velocity and rollback rate breaches, got %s and %s", breaches[0].KPI, breaches[1].KPI)
+	}
+}
+
+func TestPlan_Validate_Progress(t *testing.T) {
+	t.Parallel()
+	negative := -1.0
+	cases := []struct {
+		name   string
+		modify func(*Plan)
+	}{
+		{name: "negative velocity target", modify: func(p *Plan) { p.KPIs.MinVMsPerWeek = &negative }},
+		{name: "unknown wave", modify: func(p *Plan) { p.Progress[0].Wave = "wave-3" }},
+		{name: "duplicate wave", modify: func(p *Plan) { p.Progress[1].Wave = "wave-1" }},
+		{name: "ends before start", modify: func(p *Plan) { p.Progress[0].End = p.Start.Add(-time.Hour) }},
+		{name: "negative rollbacks", modify: func(p *Plan) { p.Progress[0].Rollbacks = -1 }},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := testProgressPlan()
+			tc.modify(&p)
+			if err := p.Validate(); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
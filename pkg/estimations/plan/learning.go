@@ -0,0 +1,111 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// LearningCurve lowers the effort of the waves following the pilot as the team gets faster, so the
+// conservative efforts of the pilot are not extrapolated to the whole program.
+// The effort of the n-th wave after the pilot is its own effort * the pilot factor of the phase *
+// (n+1)^log2(Rate), no lower than Floor times its own effort: each time the number of waves
+// migrated doubles, a wave takes Rate times the effort of the pilot.
+type LearningCurve struct {
+	// Pilot is the wave migrated first with conservative efforts, the first wave when empty.
+	Pilot string `json:"pilot,omitempty"`
+	// Rate is the share of the effort left each time the number of waves migrated doubles, in (0, 1].
+	// 1 means no learning.
+	Rate float64 `json:"rate"`
+	// Floor is the lowest share of their effort the waves are lowered to, in [0, 1].
+	Floor float64 `json:"floor,omitempty"`
+	// PilotFactors maps a phase to its actual effort in the pilot over the planned one, measured once
+	// the pilot is migrated. They scale the phase in the waves after the pilot on top of the rate.
+	PilotFactors map[string]float64 `json:"pilotFactors,omitempty"`
+}
+
+// validateLearningCurve checks the pilot is a wave of the plan and the curve lowers the efforts.
+func (p Plan) validateLearningCurve() error {
+	lc := p.LearningCurve
+	if lc == nil {
+		return nil
+	}
+	if lc.Pilot != "" && !slices.ContainsFunc(p.Waves, func(w Wave) bool { return w.Name == lc.Pilot }) {
+		return fmt.Errorf("learning curve pilot %q is not a wave of the plan", lc.Pilot)
+	}
+	if lc.Rate <= 0 || lc.Rate > 1 {
+		return fmt.Errorf("learning rate %.2f must be in (0, 1]", lc.Rate)
+	}
+	if lc.Floor < 0 || lc.Floor > 1 {
+		return fmt.Errorf("learning floor %.2f must be in [0, 1]", lc.Floor)
+	}
+	for phase, f := range lc.PilotFactors {
+		if f <= 0 {
+			return fmt.Errorf("pilot factor of phase %q must be positive", phase)
+		}
+	}
+	return nil
+}
+
+// pilot returns the position of the pilot in the waves, -1 without a learning curve.
+func (p Plan) pilot() int {
+	if p.LearningCurve == nil {
+		return -1
+	}
+	if p.LearningCurve.Pilot == "" {
+		return 0
+	}
+	return slices.IndexFunc(p.Waves, func(w Wave) bool { return w.Name == p.LearningCurve.Pilot })
+}
+
+// Factor returns the share of its effort the n-th wave after the pilot spends on the phase.
+// The pilot and the waves before it, n <= 0, keep their effort.
+func (lc LearningCurve) Factor(phase string, n int) float64 {
+	if n <= 0 {
+		return 1
+	}
+	factor := math.Pow(float64(n+1), math.Log2(lc.Rate))
+	if f, ok := lc.PilotFactors[phase]; ok {
+		factor *= f
+	}
+	return max(factor, lc.Floor)
+}
+
+// LearningEffort returns the effort of the step of the wave at position i of the plan, lowered by
+// the learning curve when the wave follows the pilot.
+func (p Plan) LearningEffort(i int, s Step) time.Duration {
+	pilot := p.pilot()
+	if pilot < 0 || i <= pilot {
+		return s.Effort
+	}
+	return time.Duration(float64(s.Effort) * p.LearningCurve.Factor(s.Phase, i-pilot))
+}
+
+// PilotFactors returns the actual effort of each phase of the pilot over its planned effort, from
+// the time each phase actually took summed over the VMs of the pilot. Phases without actuals or
+// planned effort are left out.
+func (p Plan) PilotFactors(actuals map[string]time.Duration) (map[string]float64, error) {
+	i := p.pilot()
+	if i < 0 {
+		return nil, errors.New("plan has no learning curve")
+	}
+	factors := make(map[string]float64)
+	for _, s := range p.Waves[i].Steps {
+		actual, ok := actuals[s.Phase]
+		if !ok || actual <= 0 || s.Effort <= 0 {
+			continue
+		}
+		factors[s.Phase] = float64(actual) / float64(s.Effort)
+	}
+	return factors, nil
+}
+
+// PilotWave returns the name of the pilot wave, empty without a learning curve.
+func (p Plan) PilotWave() string {
+	if i := p.pilot(); i >= 0 {
+		return p.Waves[i].Name
+	}
+	return ""
+}
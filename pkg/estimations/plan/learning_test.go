@@ -0,0 +1,140 @@
+package plan
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+func learningPlan(waves int) Plan {
+	p := Plan{
+		Name:  "datacenter exit",
+		Start: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+		Mode:  scheduling.ModeSerial,
+	}
+	for i := range waves {
+		p.Waves = append(p.Waves, Wave{Name: fmt.Sprintf("wave-%d", i+1), Steps: []Step{
+			{Phase: "Transfer", Effort: 10 * time.Hour},
+			{Phase: "Validation", Effort: 10 * time.Hour},
+		}})
+	}
+	return p
+}
+
+func TestLearningCurve_Factor(t *testing.T) {
+	t.Parallel()
+	lc := LearningCurve{Rate: 0.8, Floor: 0.5, PilotFactors: map[string]float64{"Transfer": 0.9}}
+
+	tests := []struct {
+		name  string
+		phase string
+		n     int
+		want  float64
+	}{
+		{name: "pilot keeps its effort", phase: "Validation", n: 0, want: 1},
+		{name: "first wave after the pilot doubles the waves migrated", phase: "Validation", n: 1, want: 0.8},
+		{name: "third wave after the pilot doubles them twice", phase: "Validation", n: 3, want: 0.64},
+		{name: "pilot factor applies on top of the rate", phase: "Transfer", n: 1, want: 0.72},
+		{name: "floor bounds the improvement", phase: "Validation", n: 40, want: 0.5},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := lc.Factor(tc.phase, tc.n); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("expected factor %.4f, got %.4f", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPlan_Timeline_LearningCurve(t *testing.T) {
+	t.Parallel()
+	p := learningPlan(4)
+	linear, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	p.LearningCurve = &LearningCurve{Rate: 0.8}
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 20h pilot, then 20h * (0.8 + 0.8^log2(3) + 0.64)
+	want := 20*time.Hour + time.Duration(float64(20*time.Hour)*(0.8+math.Pow(3, math.Log2(0.8))+0.64))
+	if d := tl.Total - want; d < -time.Second || d > time.Second {
+		t.Errorf("expected total %v, got %v", want, tl.Total)
+	}
+	if tl.Total >= linear.Total {
+		t.Errorf("expected the learning curve to shorten the program from %v, got %v", linear.Total, tl.Total)
+	}
+	if tl.Bars[1].End != 20*time.Hour {
+		t.Errorf("expected the pilot to keep its effort, got %v", tl.Bars[1].End)
+	}
+}
+
+func TestPlan_LearningCurve_NamedPilot(t *testing.T) {
+	t.Parallel()
+	p := learningPlan(3)
+	p.LearningCurve = &LearningCurve{Pilot: "wave-2", Rate: 0.5}
+
+	if got := p.LearningEffort(0, p.Waves[0].Steps[0]); got != 10*time.Hour {
+		t.Errorf("expected the wave before the pilot to keep its effort, got %v", got)
+	}
+	if got := p.LearningEffort(1, p.Waves[1].Steps[0]); got != 10*time.Hour {
+		t.Errorf("expected the pilot to keep its effort, got %v", got)
+	}
+	if got := p.LearningEffort(2, p.Waves[2].Steps[0]); got != 5*time.Hour {
+		t.Errorf("expected the wave after the pilot at half its effort, got %v", got)
+	}
+}
+
+func TestPlan_PilotFactors(t *testing.T) {
+	t.Parallel()
+	p := learningPlan(2)
+	if _, err := p.PilotFactors(nil); err == nil {
+		t.Fatal("expected an error without learning curve")
+	}
+
+	p.LearningCurve = &LearningCurve{Rate: 1}
+	factors, err := p.PilotFactors(map[string]time.Duration{"Transfer": 7 * time.Hour, "Cutover": time.Hour})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(factors) != 1 || factors["Transfer"] != 0.7 {
+		t.Errorf("expected only the transfer factor at 0.7, got %v", factors)
+	}
+}
+
+func TestPlan_ValidateLearningCurve(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		curve   LearningCurve
+		wantErr string
+	}{
+		{name: "unknown pilot", curve: LearningCurve{Pilot: "wave-9", Rate: 0.9}, wantErr: "not a wave"},
+		{name: "no rate", curve: LearningCurve{}, wantErr: "learning rate"},
+		{name: "rate above one", curve: LearningCurve{Rate: 1.2}, wantErr: "learning rate"},
+		{name: "floor above one", curve: LearningCurve{Rate: 0.9, Floor: 1.5}, wantErr: "learning floor"},
+		{name: "non-positive pilot factor", curve: LearningCurve{Rate: 0.9, PilotFactors: map[string]float64{"Transfer": 0}}, wantErr: "pilot factor"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := learningPlan(2)
+			p.LearningCurve = &tc.curve
+			err := p.Validate()
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// Milestone is a dated step of the target build-out, e.g. a cluster ready on March 1 or a storage
+// expansion on April 15. The waves depending on it do not start before its date.
+type Milestone struct {
+	Name string    `json:"name"`
+	Date time.Time `json:"date"`
+	Note string    `json:"note,omitempty"`
+}
+
+// MilestoneSlip moves a milestone to another date, e.g. a hardware delivery running late.
+type MilestoneSlip struct {
+	Milestone string    `json:"milestone"`
+	Date      time.Time `json:"date"`
+}
+
+// MilestoneImpact is the effect of a milestone slip on the program.
+type MilestoneImpact struct {
+	Milestone string
+	// From and To are the planned and slipped dates of the milestone.
+	From time.Time
+	To   time.Time
+	// Waves are the waves gated by the milestone.
+	Waves []string
+	// Delay is how much later the program ends because of the slip alone, zero when the waves it
+	// gates had enough slack.
+	Delay time.Duration
+}
+
+// validateMilestones checks the milestones are distinct and dated, and the waves and targets only
+// depend on declared milestones.
+func (p Plan) validateMilestones() error {
+	names := make(map[string]bool, len(p.Milestones))
+	for _, m := range p.Milestones {
+		if m.Name == "" {
+			return errors.New("milestone name is required")
+		}
+		if names[m.Name] {
+			return fmt.Errorf("duplicate milestone %q", m.Name)
+		}
+		names[m.Name] = true
+		if m.Date.IsZero() {
+			return fmt.Errorf("milestone %q has no date", m.Name)
+		}
+	}
+	for _, w := range p.Waves {
+		for _, m := range w.Milestones {
+			if !names[m] {
+				return fmt.Errorf("wave %q depends on unknown milestone %q", w.Name, m)
+			}
+		}
+	}
+	for _, t := range p.Targets {
+		for _, m := range t.Milestones {
+			if !names[m] {
+				return fmt.Errorf("target %q depends on unknown milestone %q", t.Name, m)
+			}
+		}
+	}
+	return nil
+}
+
+// milestone returns the milestone of the given name.
+func (p Plan) milestone(name string) (Milestone, bool) {
+	for _, m := range p.Milestones {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return Milestone{}, false
+}
+
+// Prerequisites returns the names of the milestones a wave waits for: its own and the ones of the
+// targets its clusters land on.
+func (p Plan) Prerequisites(w Wave) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(milestones []string) {
+		for _, m := range milestones {
+			if !seen[m] {
+				seen[m] = true
+				names = append(names, m)
+			}
+		}
+	}
+	add(w.Milestones)
+	for _, t := range p.WaveTargets(w) {
+		add(t.Milestones)
+	}
+	return names
+}
+
+// Slip returns a copy of the plan with the milestones moved to the slipped dates, to lay out a
+// slip without changing the plan itself.
+func (p Plan) Slip(slips []MilestoneSlip) (Plan, error) {
+	scenario := p
+	scenario.Milestones = append([]Milestone{}, p.Milestones...)
+	for _, s := range slips {
+		found := false
+		for i := range scenario.Milestones {
+			if scenario.Milestones[i].Name == s.Milestone {
+				scenario.Milestones[i].Date = s.Date
+				found = true
+			}
+		}
+		if !found {
+			return Plan{}, fmt.Errorf("unknown milestone %q", s.Milestone)
+		}
+	}
+	return scenario, nil
+}
+
+// SlipImpacts lays out the plan with each slip alone and reports how much later the program ends.
+func (p Plan) SlipImpacts(slips []MilestoneSlip) ([]MilestoneImpact, error) {
+	baseline, err := p.Timeline()
+	if err != nil {
+		return nil, err
+	}
+
+	impacts := make([]MilestoneImpact, 0, len(slips))
+	for _, s := range slips {
+		m, ok := p.milestone(s.Milestone)
+		if !ok {
+			return nil, fmt.Errorf("unknown milestone %q", s.Milestone)
+		}
+		scenario, err := p.Slip([]MilestoneSlip{s})
+		if err != nil {
+			return nil, err
+		}
+		tl, err := scenario.Timeline()
+		if err != nil {
+			return nil, err
+		}
+
+		impact := MilestoneImpact{Milestone: m.Name, From: m.Date, To: s.Date, Delay: tl.Total - baseline.Total}
+		for _, w := range p.Waves {
+			for _, name := range p.Prerequisites(w) {
+				if name == m.Name {
+					impact.Waves = append(impact.Waves, w.Name)
+				}
+			}
+		}
+		impacts = append(impacts, impact)
+	}
+	return impacts, nil
+}
+
+// ProcurementMilestone returns the build-out milestone of hardware ordered on the given date, from
+// its HardwareProcurement estimation: the lead time runs from the order to the last node racked.
+func ProcurementMilestone(name string, ordered time.Time, est estimation.Estimation) Milestone {
+	return Milestone{Name: name, Date: ordered.Add(est.LeadTime), Note: est.Reason}
+}
@@ -0,0 +1,95 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// NamespaceStrategy is the source structure the target namespaces of the VMs are proposed from.
+type NamespaceStrategy string
+
+const (
+	// NamespaceByFolder names a namespace after the leading folders of its VMs, e.g. "finance" for
+	// "Finance/Payroll".
+	NamespaceByFolder NamespaceStrategy = "folder"
+	// NamespaceByTag names a namespace after the owner or cost-center tag of its VMs.
+	NamespaceByTag NamespaceStrategy = "tag"
+	// NamespaceByApplication names a namespace after the application of its VMs.
+	NamespaceByApplication NamespaceStrategy = "application"
+)
+
+// Tags of the VMs read by the NamespaceByTag strategy.
+const (
+	TagOwner      = "owner"
+	TagCostCenter = "cost-center"
+)
+
+// NamespaceMapping maps the VMs of the waves to target OpenShift namespaces, from the members the
+// waves list.
+type NamespaceMapping struct {
+	Strategy NamespaceStrategy `json:"strategy"`
+	// Tag is TagOwner or TagCostCenter for the tag strategy.
+	Tag string `json:"tag,omitempty"`
+	// FolderDepth is the number of leading folders naming a namespace for the folder strategy, 1 when
+	// zero: "Finance/Payroll" lands in "finance" at depth 1 and in "finance-payroll" at depth 2.
+	FolderDepth int `json:"folderDepth,omitempty"`
+	// Prefix is prepended to the proposed names, e.g. "acme-".
+	Prefix string `json:"prefix,omitempty"`
+	// Default is the namespace of the VMs the strategy names none for, e.g. in no folder. They are
+	// left unmapped when empty.
+	Default string `json:"default,omitempty"`
+	// Overrides maps a folder path, tag value or application to the namespace of its VMs, in place of
+	// the proposed name.
+	Overrides map[string]string `json:"overrides,omitempty"`
+}
+
+// Validate checks the strategy is known and has what it reads. The names are checked by the design
+// of the namespaces, which reports every issue rather than the first one.
+func (m NamespaceMapping) Validate() error {
+	switch m.Strategy {
+	case NamespaceByFolder, NamespaceByApplication:
+		if m.Tag != "" {
+			return fmt.Errorf("namespace strategy %q reads no tag", m.Strategy)
+		}
+	case NamespaceByTag:
+		if m.Tag != TagOwner && m.Tag != TagCostCenter {
+			return fmt.Errorf("unknown namespace tag %q", m.Tag)
+		}
+	default:
+		return fmt.Errorf("unknown namespace strategy %q", m.Strategy)
+	}
+	if m.FolderDepth < 0 {
+		return errors.New("namespace folder depth must be non-negative")
+	}
+	return nil
+}
+
+// Source returns the folder path, tag value or application the strategy names the namespace of the
+// VM after, empty when the VM has none.
+func (m NamespaceMapping) Source(vm WaveVM) string {
+	switch m.Strategy {
+	case NamespaceByFolder:
+		return leadingFolders(vm.Folder, max(m.FolderDepth, 1))
+	case NamespaceByTag:
+		if m.Tag == TagCostCenter {
+			return vm.CostCenter
+		}
+		return vm.Owner
+	case NamespaceByApplication:
+		return vm.Application
+	default:
+		return ""
+	}
+}
+
+// leadingFolders returns the first depth folders of the path.
+func leadingFolders(path string, depth int) string {
+	var segments []string
+	for _, s := range strings.Split(path, "/") {
+		if s = strings.TrimSpace(s); s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return strings.Join(segments[:min(depth, len(segments))], "/")
+}
@@ -0,0 +1,194 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/compliance"
+)
+
+// Target is a target cluster or availability zone the VMs of the program land on.
+type Target struct {
+	Name string `json:"name"`
+	// Zone is the availability zone of the target, if any.
+	Zone string `json:"zone,omitempty"`
+	// CPUCores and MemoryGB are the capacity of the target. Zero means unconstrained.
+	CPUCores int `json:"cpuCores,omitempty"`
+	MemoryGB int `json:"memoryGb,omitempty"`
+	// Ready is the calendar date the target is built. Nil means it is available from the plan start.
+	Ready *time.Time `json:"ready,omitempty"`
+	// Milestones are the build-out milestones the target waits for, e.g. a storage expansion.
+	Milestones []string `json:"milestones,omitempty"`
+	// Residency is the jurisdiction the data of the target stays in, e.g. compliance.ResidencyEU.
+	Residency string `json:"residency,omitempty"`
+	// Certifications are the compliance regimes the target is audited for.
+	Certifications []compliance.Label `json:"certifications,omitempty"`
+}
+
+// Placement maps a source cluster or datacenter to the target its VMs land on, with the resources
+// they need there.
+type Placement struct {
+	// Source is the plan source of the cluster, empty for a single-source plan.
+	Source  string `json:"source,omitempty"`
+	Cluster string `json:"cluster"`
+	Target  string `json:"target"`
+	// CPUCores and MemoryGB are the resources requested by the VMs of the cluster.
+	CPUCores int `json:"cpuCores,omitempty"`
+	MemoryGB int `json:"memoryGb,omitempty"`
+	// Compliance are the compliance regimes the VMs of the cluster are subject to. The target must
+	// comply with all of them.
+	Compliance []compliance.Label `json:"compliance,omitempty"`
+}
+
+// validatePlacements checks the placements map each source cluster once to a declared target
+// complying with their regimes, fit the capacity of their targets, and cover the clusters of the waves.
+func (p Plan) validatePlacements() error {
+	targets := make(map[string]Target, len(p.Targets))
+	for _, t := range p.Targets {
+		if t.Name == "" {
+			return errors.New("target name is required")
+		}
+		if _, ok := targets[t.Name]; ok {
+			return fmt.Errorf("duplicate target %q", t.Name)
+		}
+		if t.CPUCores < 0 || t.MemoryGB < 0 {
+			return fmt.Errorf("target %q has a negative capacity", t.Name)
+		}
+		for _, l := range t.Certifications {
+			if err := l.Validate(); err != nil {
+				return fmt.Errorf("target %q: %w", t.Name, err)
+			}
+		}
+		targets[t.Name] = t
+	}
+
+	type demand struct{ cpu, memory int }
+	placed := make(map[string]demand, len(p.Targets))
+	clusters := make(map[placementKey]bool, len(p.Placements))
+	for _, pl := range p.Placements {
+		if pl.Cluster == "" {
+			return errors.New("placement cluster is required")
+		}
+		key := placementKey{source: pl.Source, cluster: pl.Cluster}
+		if clusters[key] {
+			return fmt.Errorf("cluster %q is placed more than once", pl.Cluster)
+		}
+		clusters[key] = true
+		t, ok := targets[pl.Target]
+		if !ok {
+			return fmt.Errorf("cluster %q is placed on unknown target %q", pl.Cluster, pl.Target)
+		}
+		for _, l := range pl.Compliance {
+			if err := l.Validate(); err != nil {
+				return fmt.Errorf("cluster %q: %w", pl.Cluster, err)
+			}
+		}
+		if violations := compliance.Check(pl.Cluster, pl.Compliance, t.Site()); len(violations) > 0 {
+			return fmt.Errorf("cluster %q can not be placed on target %q: %s", pl.Cluster, pl.Target, violations[0].Message)
+		}
+		if pl.Source != "" && !p.hasSource(pl.Source) {
+			return fmt.Errorf("cluster %q belongs to unknown source %q", pl.Cluster, pl.Source)
+		}
+		if pl.CPUCores < 0 || pl.MemoryGB < 0 {
+			return fmt.Errorf("cluster %q requests negative resources", pl.Cluster)
+		}
+		d := placed[pl.Target]
+		d.cpu += pl.CPUCores
+		d.memory += pl.MemoryGB
+		placed[pl.Target] = d
+	}
+
+	for name, d := range placed {
+		t := targets[name]
+		if t.CPUCores > 0 && d.cpu > t.CPUCores {
+			return fmt.Errorf("target %q has %d CPU cores for %d placed", name, t.CPUCores, d.cpu)
+		}
+		if t.MemoryGB > 0 && d.memory > t.MemoryGB {
+			return fmt.Errorf("target %q has %d GB of memory for %d GB placed", name, t.MemoryGB, d.memory)
+		}
+	}
+
+	if len(p.Placements) == 0 {
+		return nil
+	}
+	for _, w := range p.Waves {
+		for _, c := range w.Clusters {
+			if !clusters[placementKey{source: w.Source, cluster: c}] {
+				return fmt.Errorf("cluster %q of wave %q has no placement", c, w.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Site returns the target as a compliance site.
+func (t Target) Site() compliance.Site {
+	return compliance.Site{Name: t.Name, Residency: t.Residency, Certifications: t.Certifications}
+}
+
+// ComplianceViolations returns the placements breaking the compliance regime of their cluster, to
+// report them as assessment concerns. Validate rejects the plan on the first one.
+func (p Plan) ComplianceViolations() []compliance.Violation {
+	var violations []compliance.Violation
+	for _, pl := range p.Placements {
+		for _, t := range p.Targets {
+			if t.Name == pl.Target {
+				violations = append(violations, compliance.Check(pl.Cluster, pl.Compliance, t.Site())...)
+			}
+		}
+	}
+	return violations
+}
+
+type placementKey struct {
+	source, cluster string
+}
+
+func (p Plan) hasSource(name string) bool {
+	for _, s := range p.Sources {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// WaveTargets returns the targets the clusters of a wave land on, in placement order.
+func (p Plan) WaveTargets(w Wave) []Target {
+	clusters := make(map[string]bool, len(w.Clusters))
+	for _, c := range w.Clusters {
+		clusters[c] = true
+	}
+	var targets []Target
+	seen := make(map[string]bool)
+	for _, pl := range p.Placements {
+		if pl.Source != w.Source || !clusters[pl.Cluster] || seen[pl.Target] {
+			continue
+		}
+		seen[pl.Target] = true
+		for _, t := range p.Targets {
+			if t.Name == pl.Target {
+				targets = append(targets, t)
+			}
+		}
+	}
+	return targets
+}
+
+// notBefore returns the earliest offset from the plan start a wave may start at: once all the
+// targets its clusters land on are built and all its prerequisite milestones are reached.
+func (p Plan) notBefore(w Wave) time.Duration {
+	var earliest time.Duration
+	for _, t := range p.WaveTargets(w) {
+		if t.Ready != nil && t.Ready.Sub(p.Start) > earliest {
+			earliest = t.Ready.Sub(p.Start)
+		}
+	}
+	for _, name := range p.Prerequisites(w) {
+		if m, ok := p.milestone(name); ok && m.Date.Sub(p.Start) > earliest {
+			earliest = m.Date.Sub(p.Start)
+		}
+	}
+	return earliest
+}
@@ -0,0 +1,402 @@
+// Package plan defines a migration program as it is stored and exchanged over the API:
+// waves of phases anchored on a start date, laid out by the scheduling Pipeline.
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/display"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Plan is a migration program: the waves to migrate, the order in which they run and the
+// resource pools they share.
+type Plan struct {
+	Name string `json:"name"`
+	// Start is the calendar date the first wave starts.
+	Start    time.Time       `json:"start"`
+	Mode     scheduling.Mode `json:"mode"`
+	Overlaps []Overlap       `json:"overlaps,omitempty"`
+	// Currency is the ISO 4217 code of the currency the plan costs are displayed in, the one of the
+	// rate card pricing them when empty.
+	Currency string `json:"currency,omitempty"`
+	// ExchangeRates are the rates entered for the plan converting the costs into Currency. They take
+	// precedence over the rates of the exchange rate service of the server.
+	ExchangeRates []ExchangeRate `json:"exchangeRates,omitempty"`
+	// ParamsCurrency is the ISO 4217 code of the currency of the cost params, e.g.
+	// engineer_hourly_rate, the estimations of the clusters of the plan are priced with. Their costs
+	// are converted into Currency like the other costs. Currency when empty.
+	ParamsCurrency string `json:"paramsCurrency,omitempty"`
+	// Display is how the durations and dates of the plan are reported, as computed when nil.
+	Display *display.Policy `json:"display,omitempty"`
+	// TransferRateMbps is the bandwidth available to the migration, used to compare the plan with
+	// similar environments. Zero when unknown.
+	TransferRateMbps float64 `json:"transferRateMbps,omitempty"`
+	// Pools maps a resource pool to its capacity.
+	Pools map[string]int `json:"pools,omitempty"`
+	// CapacityChanges are the known staffing changes of the pools over the program.
+	CapacityChanges []CapacityChange `json:"capacityChanges,omitempty"`
+	// Calendars maps a resource pool to the working calendar of its team.
+	Calendars map[string]calendar.Calendar `json:"calendars,omitempty"`
+	// Shifts maps a resource pool to the teams working it in turn, e.g. a follow-the-sun factory.
+	// They take precedence over the pool calendar.
+	Shifts map[string][]Shift `json:"shifts,omitempty"`
+	// Sources are the environments of a program spanning several inventory sources, e.g. vCenters.
+	// Each wave then migrates one of them.
+	Sources []Source `json:"sources,omitempty"`
+	// Targets are the target clusters or availability zones and Placements map the source clusters
+	// to them. A wave does not start before the targets of its clusters are built.
+	Targets    []Target    `json:"targets,omitempty"`
+	Placements []Placement `json:"placements,omitempty"`
+	// Milestones are the dated steps of the target build-out the waves and targets depend on.
+	Milestones []Milestone `json:"milestones,omitempty"`
+	Waves      []Wave      `json:"waves"`
+	// Namespaces maps the VMs the waves list to their target namespaces, none when nil.
+	Namespaces *NamespaceMapping `json:"namespaces,omitempty"`
+	// LearningCurve lowers the effort of the waves following the pilot, none when nil.
+	LearningCurve *LearningCurve `json:"learningCurve,omitempty"`
+	// Contingency pads each wave with buffers reported as phases of their own, none when nil.
+	Contingency *contingency.Policy `json:"contingency,omitempty"`
+	// Schedule holds the dates PMs set in their own tooling. They take precedence over the layout
+	// on the calendar while effort keeps coming from the steps.
+	Schedule []ScheduledPhase `json:"schedule,omitempty"`
+	// Gates are the sign-offs required between waves or phases and Approvals the ones recorded.
+	Gates     []Gate     `json:"gates,omitempty"`
+	Approvals []Approval `json:"approvals,omitempty"`
+	// KPIs are the targets the program is tracked against and Progress the actuals recorded per wave.
+	KPIs     *KPIs          `json:"kpis,omitempty"`
+	Progress []WaveProgress `json:"progress,omitempty"`
+}
+
+// ExchangeRate converts the costs in From, e.g. the currency of a rate card, into the plan currency:
+// amount in the plan currency = amount in From * Rate, as of a date reported along with the costs.
+type ExchangeRate struct {
+	From string    `json:"from"`
+	Rate float64   `json:"rate"`
+	AsOf time.Time `json:"asOf"`
+}
+
+// ScheduledPhase is the calendar span given to a phase of a wave outside the planner.
+type ScheduledPhase struct {
+	Wave  string    `json:"wave"`
+	Phase string    `json:"phase"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// CapacityChange changes the capacity of a resource pool by Delta units from a calendar date on,
+// e.g. two engineers leaving in June (-2) or three contractors joining in August (+3).
+type CapacityChange struct {
+	Pool  string    `json:"pool"`
+	At    time.Time `json:"at"`
+	Delta int       `json:"delta"`
+	Note  string    `json:"note,omitempty"`
+}
+
+// Overlap allows phase Next of a wave to start while the previous wave runs phase Current.
+type Overlap struct {
+	Current string `json:"current"`
+	Next    string `json:"next"`
+}
+
+// Wave is a group of VMs migrated together, broken down in sequential steps.
+type Wave struct {
+	Name string `json:"name"`
+	// Source is the name of the plan source the VMs of the wave belong to, empty for a single-source plan.
+	Source string `json:"source,omitempty"`
+	// Clusters are the source clusters or datacenters whose VMs the wave migrates.
+	Clusters []string `json:"clusters,omitempty"`
+	// Folders are the source folders or vApps whose VMs the wave migrates, e.g. "Finance/Payroll",
+	// for the organizations drawing their application boundaries in the folder hierarchy.
+	Folders []string `json:"folders,omitempty"`
+	// Milestones are the build-out milestones the wave waits for, on top of the ones of its targets.
+	Milestones []string `json:"milestones,omitempty"`
+	// VMs and DiskGB are the scope of the wave, used to forecast the volume migrated over time.
+	// Zero when unknown.
+	VMs    int     `json:"vms,omitempty"`
+	DiskGB float64 `json:"diskGb,omitempty"`
+	// Members are the VMs of the wave when known, to decompose the effort of the plan by VM.
+	Members []WaveVM `json:"members,omitempty"`
+	Steps   []Step   `json:"steps"`
+}
+
+// Step is a phase of a wave.
+type Step struct {
+	Phase    string        `json:"phase"`
+	Effort   time.Duration `json:"effort"`
+	LeadTime time.Duration `json:"leadTime,omitempty"`
+	// WorkHoursPerDay is the number of hours per day spent on the effort. Zero means round the clock,
+	// or the working day of the organization for a plan created under its calculator defaults.
+	WorkHoursPerDay float64 `json:"workHoursPerDay,omitempty"`
+	Pool            string  `json:"pool,omitempty"`
+	Units           int     `json:"units,omitempty"`
+}
+
+// Validate checks the plan can be laid out.
+func (p Plan) Validate() error {
+	if p.Name == "" {
+		return errors.New("plan name is required")
+	}
+	if p.Start.IsZero() {
+		return errors.New("plan start is required")
+	}
+	if p.Currency != "" && !currencyCode.MatchString(p.Currency) {
+		return fmt.Errorf("invalid currency %q", p.Currency)
+	}
+	if p.ParamsCurrency != "" && !currencyCode.MatchString(p.ParamsCurrency) {
+		return fmt.Errorf("invalid params currency %q", p.ParamsCurrency)
+	}
+	if len(p.ExchangeRates) > 0 && p.Currency == "" {
+		return errors.New("exchange rates require a plan currency")
+	}
+	rates := make(map[string]bool, len(p.ExchangeRates))
+	for _, r := range p.ExchangeRates {
+		switch {
+		case !currencyCode.MatchString(r.From):
+			return fmt.Errorf("invalid exchange rate currency %q", r.From)
+		case r.From == p.Currency:
+			return fmt.Errorf("exchange rate from %s converts the plan currency into itself", r.From)
+		case rates[r.From]:
+			return fmt.Errorf("duplicate exchange rate from %s", r.From)
+		case r.Rate <= 0 || math.IsNaN(r.Rate) || math.IsInf(r.Rate, 0):
+			return fmt.Errorf("exchange rate from %s must be a positive number", r.From)
+		case r.AsOf.IsZero():
+			return fmt.Errorf("exchange rate from %s has no as-of date", r.From)
+		}
+		rates[r.From] = true
+	}
+	if p.TransferRateMbps < 0 {
+		return errors.New("transfer rate must not be negative")
+	}
+	if p.Display != nil {
+		if err := p.Display.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, c := range p.CapacityChanges {
+		if _, ok := p.Pools[c.Pool]; !ok {
+			return fmt.Errorf("capacity change of undeclared pool %q", c.Pool)
+		}
+		if c.At.Before(p.Start) {
+			return fmt.Errorf("capacity change of pool %q is before the plan start", c.Pool)
+		}
+	}
+	if len(p.Waves) == 0 {
+		return errors.New("plan has no waves")
+	}
+	seen := make(map[string]bool, len(p.Waves))
+	for _, w := range p.Waves {
+		if w.Name == "" {
+			return errors.New("wave name is required")
+		}
+		if seen[w.Name] {
+			return fmt.Errorf("duplicate wave %q", w.Name)
+		}
+		seen[w.Name] = true
+		if w.VMs < 0 || w.DiskGB < 0 {
+			return fmt.Errorf("wave %q has a negative scope", w.Name)
+		}
+		for _, s := range w.Steps {
+			if s.Phase == "" {
+				return fmt.Errorf("wave %q has a step without phase", w.Name)
+			}
+			if s.Effort < 0 || s.LeadTime < 0 {
+				return fmt.Errorf("wave %q phase %q has a negative duration", w.Name, s.Phase)
+			}
+			if s.WorkHoursPerDay < 0 || s.WorkHoursPerDay > 24 {
+				return fmt.Errorf("wave %q phase %q has invalid work hours per day %.1f", w.Name, s.Phase, s.WorkHoursPerDay)
+			}
+		}
+	}
+	if err := p.validateMembers(); err != nil {
+		return err
+	}
+	if p.Namespaces != nil {
+		if err := p.Namespaces.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := p.validateSources(); err != nil {
+		return err
+	}
+	if err := p.validatePlacements(); err != nil {
+		return err
+	}
+	if err := p.validateMilestones(); err != nil {
+		return err
+	}
+	if err := p.validateGates(); err != nil {
+		return err
+	}
+	if err := p.validateLearningCurve(); err != nil {
+		return err
+	}
+	if p.Contingency != nil {
+		if err := p.Contingency.Validate(); err != nil {
+			return err
+		}
+	}
+	for pool, c := range p.Calendars {
+		if !p.usesPool(pool) {
+			return fmt.Errorf("calendar of unknown pool %q", pool)
+		}
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("calendar of pool %q: %w", pool, err)
+		}
+	}
+	for pool, shifts := range p.Shifts {
+		if !p.usesPool(pool) {
+			return fmt.Errorf("shifts of unknown pool %q", pool)
+		}
+		if err := validateShifts(shifts); err != nil {
+			return fmt.Errorf("shifts of pool %q: %w", pool, err)
+		}
+	}
+	for _, sp := range p.Schedule {
+		if sp.End.Before(sp.Start) {
+			return fmt.Errorf("wave %q phase %q is scheduled to end before it starts", sp.Wave, sp.Phase)
+		}
+	}
+	if p.KPIs != nil {
+		if err := p.KPIs.Validate(); err != nil {
+			return err
+		}
+	}
+	recorded := make(map[string]bool, len(p.Progress))
+	for _, wp := range p.Progress {
+		if !seen[wp.Wave] {
+			return fmt.Errorf("progress recorded for unknown wave %q", wp.Wave)
+		}
+		if recorded[wp.Wave] {
+			return fmt.Errorf("duplicate progress for wave %q", wp.Wave)
+		}
+		recorded[wp.Wave] = true
+		if wp.End.Before(wp.Start) {
+			return fmt.Errorf("wave %q is recorded to end before it starts", wp.Wave)
+		}
+		if wp.VMsMigrated < 0 || wp.Rollbacks < 0 || wp.P1Incidents < 0 {
+			return fmt.Errorf("wave %q has negative progress counts", wp.Wave)
+		}
+	}
+	return nil
+}
+
+// usesPool reports whether the pool is declared or used by a step.
+func (p Plan) usesPool(pool string) bool {
+	if _, ok := p.Pools[pool]; ok {
+		return true
+	}
+	for _, w := range p.Waves {
+		for _, s := range w.Steps {
+			if s.Pool == pool {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Pipeline returns the scheduling Pipeline configured with the plan mode, overlaps, pools, their
+// capacity changes, calendars and shifts. Invalid shifts are left out; they are rejected by Validate.
+func (p Plan) Pipeline() *scheduling.Pipeline {
+	opts := make([]scheduling.PipelineOption, 0, len(p.Overlaps)+len(p.Pools)+len(p.CapacityChanges)+len(p.Calendars)+len(p.Shifts)+1)
+	opts = append(opts, scheduling.WithProgramStart(p.Start))
+	for _, o := range p.Overlaps {
+		opts = append(opts, scheduling.WithOverlap(o.Current, o.Next))
+	}
+	for name, capacity := range p.Pools {
+		opts = append(opts, scheduling.WithResourcePool(name, capacity))
+	}
+	for pool, c := range p.Calendars {
+		opts = append(opts, scheduling.WithPoolCalendar(pool, c))
+	}
+	for pool, shifts := range p.Shifts {
+		if converted, err := poolShifts(shifts); err == nil {
+			opts = append(opts, scheduling.WithPoolShifts(pool, converted...))
+		}
+	}
+	for _, c := range p.CapacityChanges {
+		opts = append(opts, scheduling.WithCapacityChange(scheduling.CapacityChange{Pool: c.Pool, At: c.At.Sub(p.Start), Delta: c.Delta}))
+	}
+	mode := p.Mode
+	if mode == "" {
+		mode = scheduling.ModeSerial
+	}
+	return scheduling.NewPipeline(mode, opts...)
+}
+
+// WavePlans converts the waves to scheduling wave plans, holding each wave until its targets are
+// built and its prerequisite milestones reached. The efforts of the waves after the pilot follow
+// the learning curve.
+func (p Plan) WavePlans() []scheduling.WavePlan {
+	plans := make([]scheduling.WavePlan, 0, len(p.Waves))
+	for i, w := range p.Waves {
+		wp := scheduling.WavePlan{Wave: w.Name, NotBefore: p.notBefore(w)}
+		for _, s := range w.Steps {
+			wp.Steps = append(wp.Steps, scheduling.Step{
+				Phase: scheduling.Phase{
+					Name:            s.Phase,
+					Effort:          p.LearningEffort(i, s),
+					LeadTime:        s.LeadTime,
+					WorkHoursPerDay: s.WorkHoursPerDay,
+				},
+				Pool:  s.Pool,
+				Units: s.Units,
+			})
+		}
+		wp.Steps = append(wp.Steps, p.buffers(wp.Steps)...)
+		plans = append(plans, wp)
+	}
+	return plans
+}
+
+// buffers returns the contingency buffers of the steps of a wave as unpooled steps following them,
+// padding the calendar time of the phases.
+func (p Plan) buffers(steps []scheduling.Step) []scheduling.Step {
+	if p.Contingency == nil {
+		return nil
+	}
+	items := make([]contingency.Item, 0, len(steps))
+	for _, s := range steps {
+		items = append(items, contingency.Item{Name: s.Phase.Name, Duration: s.Phase.Elapsed()})
+	}
+	buffers := p.Contingency.Pad(items)
+	res := make([]scheduling.Step, 0, len(buffers))
+	for _, b := range buffers {
+		res = append(res, scheduling.Step{Phase: scheduling.Phase{Name: b.Name(), Effort: b.Duration}})
+	}
+	return res
+}
+
+// Timeline validates the plan and lays it out. Bar offsets are relative to Start.
+func (p Plan) Timeline() (scheduling.Timeline, error) {
+	if err := p.Validate(); err != nil {
+		return scheduling.Timeline{}, err
+	}
+	return p.Pipeline().Layout(p.WavePlans())
+}
+
+// DisplayPolicy returns the policy the plan is reported under, the zero one reporting the durations
+// as computed when the plan has none.
+func (p Plan) DisplayPolicy() display.Policy {
+	if p.Display == nil {
+		return display.Policy{}
+	}
+	return *p.Display
+}
+
+// WhatIf returns a copy of the plan with additional capacity changes, to lay out a staffing scenario
+// without changing the plan itself.
+func (p Plan) WhatIf(changes []CapacityChange) Plan {
+	scenario := p
+	scenario.CapacityChanges = append(append([]CapacityChange{}, p.CapacityChanges...), changes...)
+	return scenario
+}
@@ -0,0 +1,434 @@
+package plan
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/compliance"
+	"github.com/kubev2v/migration-planner/pkg/estimations/contingency"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+func testPlan() Plan {
+	return Plan{
+		Name:     "datacenter exit",
+		Start:    time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+		Mode:     scheduling.ModePipelined,
+		Overlaps: []Overlap{{Current: "Validation", Next: "Pre-Copy"}},
+		Pools:    map[string]int{"engineers": 4},
+		Waves: []Wave{
+			{Name: "wave-1", Steps: []Step{
+				{Phase: "Pre-Copy", Effort: 4 * time.Hour},
+				{Phase: "Validation", Effort: 6 * time.Hour, Pool: "engineers", Units: 2},
+			}},
+			{Name: "wave-2", Steps: []Step{
+				{Phase: "Pre-Copy", Effort: 4 * time.Hour},
+				{Phase: "Validation", Effort: 6 * time.Hour, Pool: "engineers", Units: 2},
+			}},
+		},
+	}
+}
+
+func TestPlan_Timeline(t *testing.T) {
+	t.Parallel()
+	tl, err := testPlan().Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// wave-2 pre-copy starts with wave-1 validation at 4h, its validation waits for wave-1 to end at 10h
+	if tl.Total != 16*time.Hour {
+		t.Errorf("expected total 16h, got %v", tl.Total)
+	}
+	if len(tl.Bars) != 4 {
+		t.Fatalf("expected 4 bars, got %d", len(tl.Bars))
+	}
+	if tl.Bars[2].Start != 4*time.Hour {
+		t.Errorf("expected wave-2 pre-copy at 4h, got %v", tl.Bars[2].Start)
+	}
+}
+
+func TestPlan_Timeline_DefaultsToSerial(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Mode = ""
+
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if tl.Total != 20*time.Hour {
+		t.Errorf("expected total 20h, got %v", tl.Total)
+	}
+}
+
+func TestPlan_Timeline_Contingency(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Mode = scheduling.ModeSerial
+	p.Contingency = &contingency.Policy{Rules: []contingency.Rule{
+		{Name: "validation", Phase: "Validation", Percent: 50},
+		{Name: "change management", PerWave: 2 * time.Hour},
+	}}
+
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// each wave: 4h pre-copy, 6h validation, 3h and 2h of buffers
+	if tl.Total != 30*time.Hour {
+		t.Errorf("expected total 30h, got %v", tl.Total)
+	}
+	if len(tl.Bars) != 8 {
+		t.Fatalf("expected 8 bars, got %d", len(tl.Bars))
+	}
+	if b := tl.Bars[2]; b.Phase != "Contingency (validation)" || b.End-b.Start != 3*time.Hour {
+		t.Errorf("expected the validation buffer to follow the phases of wave-1, got %+v", b)
+	}
+}
+
+func TestPlan_WhatIf(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Mode = scheduling.ModeParallel
+
+	// half of the engineers leave an hour in: wave-2 validation waits for wave-1 to release its engineers
+	scenario := p.WhatIf([]CapacityChange{{Pool: "engineers", At: p.Start.Add(time.Hour), Delta: -2, Note: "two engineers leave"}})
+	if len(p.CapacityChanges) != 0 {
+		t.Fatalf("expected the plan to be left unchanged, got %d capacity changes", len(p.CapacityChanges))
+	}
+
+	baseline, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	tl, err := scenario.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if baseline.Total != 10*time.Hour {
+		t.Errorf("expected baseline total 10h, got %v", baseline.Total)
+	}
+	if tl.Total != 16*time.Hour {
+		t.Errorf("expected scenario total 16h, got %v", tl.Total)
+	}
+}
+
+func TestPlan_Timeline_PoolCalendar(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Mode = scheduling.ModeSerial
+	p.Waves = p.Waves[:1]
+	p.Waves[0].Steps[1].WorkHoursPerDay = 6
+	// New Year's Day 2026 is a Thursday
+	p.Start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p.Calendars = map[string]calendar.Calendar{"engineers": {Region: calendar.RegionUS}}
+
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// pre-copy runs through the holiday, validation waits for Friday and takes all of it
+	if tl.Total != 48*time.Hour {
+		t.Errorf("expected total 48h, got %v", tl.Total)
+	}
+}
+
+func TestPlan_Validate(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		modify func(*Plan)
+	}{
+		{name: "missing name", modify: func(p *Plan) { p.Name = "" }},
+		{name: "missing start", modify: func(p *Plan) { p.Start = time.Time{} }},
+		{name: "invalid currency", modify: func(p *Plan) { p.Currency = "dollars" }},
+		{name: "invalid params currency", modify: func(p *Plan) { p.ParamsCurrency = "eur" }},
+		{name: "exchange rates without currency", modify: func(p *Plan) {
+			p.ExchangeRates = []ExchangeRate{{From: "EUR", Rate: 1.1, AsOf: p.Start}}
+		}},
+		{name: "exchange rate into the plan currency", modify: func(p *Plan) {
+			p.Currency = "USD"
+			p.ExchangeRates = []ExchangeRate{{From: "USD", Rate: 1, AsOf: p.Start}}
+		}},
+		{name: "duplicate exchange rate", modify: func(p *Plan) {
+			p.Currency = "USD"
+			p.ExchangeRates = []ExchangeRate{{From: "EUR", Rate: 1.1, AsOf: p.Start}, {From: "EUR", Rate: 1.2, AsOf: p.Start}}
+		}},
+		{name: "infinite exchange rate", modify: func(p *Plan) {
+			p.Currency = "USD"
+			p.ExchangeRates = []ExchangeRate{{From: "EUR", Rate: math.Inf(1), AsOf: p.Start}}
+		}},
+		{name: "exchange rate without as-of date", modify: func(p *Plan) {
+			p.Currency = "USD"
+			p.ExchangeRates = []ExchangeRate{{From: "EUR", Rate: 1.1}}
+		}},
+		{name: "negative transfer rate", modify: func(p *Plan) { p.TransferRateMbps = -1 }},
+		{name: "no waves", modify: func(p *Plan) { p.Waves = nil }},
+		{name: "duplicate wave", modify: func(p *Plan) { p.Waves[1].Name = "wave-1" }},
+		{name: "step without phase", modify: func(p *Plan) { p.Waves[0].Steps[0].Phase = "" }},
+		{name: "negative effort", modify: func(p *Plan) { p.Waves[0].Steps[0].Effort = -time.Hour }},
+		{name: "too many work hours", modify: func(p *Plan) { p.Waves[0].Steps[0].WorkHoursPerDay = 25 }},
+		{name: "capacity change of undeclared pool", modify: func(p *Plan) {
+			p.CapacityChanges = []CapacityChange{{Pool: "contractors", At: p.Start, Delta: 3}}
+		}},
+		{name: "capacity change before start", modify: func(p *Plan) {
+			p.CapacityChanges = []CapacityChange{{Pool: "engineers", At: p.Start.Add(-time.Hour), Delta: -1}}
+		}},
+		{name: "calendar of unknown pool", modify: func(p *Plan) {
+			p.Calendars = map[string]calendar.Calendar{"contractors": {Region: calendar.RegionUS}}
+		}},
+		{name: "calendar of unknown region", modify: func(p *Plan) {
+			p.Calendars = map[string]calendar.Calendar{"engineers": {Region: "FR"}}
+		}},
+		{name: "shifts of unknown pool", modify: func(p *Plan) {
+			p.Shifts = map[string][]Shift{"contractors": {{Name: "emea", Start: "08:00", End: "16:00"}}}
+		}},
+		{name: "duplicate shift", modify: func(p *Plan) {
+			p.Shifts = map[string][]Shift{"engineers": {{Name: "emea", Start: "08:00", End: "16:00"}, {Name: "emea", Start: "16:00", End: "00:00"}}}
+		}},
+		{name: "shift with invalid time zone", modify: func(p *Plan) {
+			p.Shifts = map[string][]Shift{"engineers": {{Name: "emea", TimeZone: "Europe/Atlantis", Start: "08:00", End: "16:00"}}}
+		}},
+		{name: "shift with invalid start", modify: func(p *Plan) {
+			p.Shifts = map[string][]Shift{"engineers": {{Name: "emea", Start: "8am", End: "16:00"}}}
+		}},
+		{name: "shift with invalid day", modify: func(p *Plan) {
+			p.Shifts = map[string][]Shift{"engineers": {{Name: "emea", Start: "08:00", End: "16:00", Days: []string{"Funday"}}}}
+		}},
+		{name: "duplicate source", modify: func(p *Plan) {
+			p.Sources = []Source{{Name: "vcenter-east"}, {Name: "vcenter-east"}}
+		}},
+		{name: "source with negative transfer rate", modify: func(p *Plan) {
+			p.Sources = []Source{{Name: "vcenter-east", TransferRateMbps: -1}}
+			p.Waves[0].Source, p.Waves[1].Source = "vcenter-east", "vcenter-east"
+		}},
+		{name: "wave without source", modify: func(p *Plan) {
+			p.Sources = []Source{{Name: "vcenter-east"}}
+			p.Waves[0].Source = "vcenter-east"
+		}},
+		{name: "wave of unknown source", modify: func(p *Plan) { p.Waves[0].Source = "vcenter-west" }},
+		{name: "duplicate target", modify: func(p *Plan) { p.Targets = []Target{{Name: "ocp-east"}, {Name: "ocp-east"}} }},
+		{name: "placement on unknown target", modify: func(p *Plan) {
+			p.Placements = []Placement{{Cluster: "cluster-a", Target: "ocp-east"}}
+		}},
+		{name: "cluster placed twice", modify: func(p *Plan) {
+			p.Targets = []Target{{Name: "ocp-east"}, {Name: "ocp-west"}}
+			p.Placements = []Placement{{Cluster: "cluster-a", Target: "ocp-east"}, {Cluster: "cluster-a", Target: "ocp-west"}}
+		}},
+		{name: "placement exceeds target capacity", modify: func(p *Plan) {
+			p.Targets = []Target{{Name: "ocp-east", CPUCores: 64}}
+			p.Placements = []Placement{{Cluster: "cluster-a", Target: "ocp-east", CPUCores: 48}, {Cluster: "cluster-b", Target: "ocp-east", CPUCores: 32}}
+		}},
+		{name: "wave cluster without placement", modify: func(p *Plan) {
+			p.Targets = []Target{{Name: "ocp-east"}}
+			p.Placements = []Placement{{Cluster: "cluster-a", Target: "ocp-east"}}
+			p.Waves[0].Clusters = []string{"cluster-b"}
+		}},
+		{name: "target with unknown certification", modify: func(p *Plan) {
+			p.Targets = []Target{{Name: "ocp-east", Certifications: []compliance.Label{"sox"}}}
+		}},
+		{name: "residency violated", modify: func(p *Plan) {
+			p.Targets = []Target{{Name: "ocp-east", Residency: "US"}}
+			p.Placements = []Placement{{Cluster: "cluster-a", Target: "ocp-east", Compliance: []compliance.Label{compliance.LabelEUResidency}}}
+		}},
+		{name: "uncertified target", modify: func(p *Plan) {
+			p.Targets = []Target{{Name: "ocp-east", Residency: compliance.ResidencyEU}}
+			p.Placements = []Placement{{Cluster: "cluster-a", Target: "ocp-east", Compliance: []compliance.Label{compliance.LabelPCI}}}
+		}},
+		{name: "duplicate milestone", modify: func(p *Plan) {
+			p.Milestones = []Milestone{{Name: "cluster-a ready", Date: p.Start}, {Name: "cluster-a ready", Date: p.Start}}
+		}},
+		{name: "undated milestone", modify: func(p *Plan) { p.Milestones = []Milestone{{Name: "cluster-a ready"}} }},
+		{name: "wave depends on unknown milestone", modify: func(p *Plan) { p.Waves[0].Milestones = []string{"cluster-a ready"} }},
+		{name: "target depends on unknown milestone", modify: func(p *Plan) {
+			p.Targets = []Target{{Name: "ocp-east", Milestones: []string{"storage expansion"}}}
+		}},
+		{name: "unknown namespace strategy", modify: func(p *Plan) { p.Namespaces = &NamespaceMapping{Strategy: "cluster"} }},
+		{name: "namespace strategy without tag", modify: func(p *Plan) { p.Namespaces = &NamespaceMapping{Strategy: NamespaceByTag} }},
+		{name: "scheduled backwards", modify: func(p *Plan) {
+			p.Schedule = []ScheduledPhase{{Wave: "wave-1", Phase: "Pre-Copy", Start: p.Start.Add(time.Hour), End: p.Start}}
+		}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := testPlan()
+			tc.modify(&p)
+			if err := p.Validate(); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
+
+func TestPlan_ComplianceViolations(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Targets = []Target{
+		{Name: "ocp-frankfurt", Residency: compliance.ResidencyEU, Certifications: []compliance.Label{compliance.LabelPCI}},
+		{Name: "ocp-virginia", Residency: "US", Certifications: []compliance.Label{compliance.LabelPCI, compliance.LabelHIPAA}},
+	}
+	p.Placements = []Placement{
+		{Cluster: "cluster-a", Target: "ocp-frankfurt", Compliance: []compliance.Label{compliance.LabelPCI, compliance.LabelEUResidency}},
+		{Cluster: "cluster-b", Target: "ocp-virginia", Compliance: []compliance.Label{compliance.LabelHIPAA}},
+	}
+	if err := p.Validate(); err != nil {
+		t.Fatalf("expected compliant placements, got: %v", err)
+	}
+	if got := p.ComplianceViolations(); len(got) != 0 {
+		t.Errorf("expected no violation, got %v", got)
+	}
+
+	p.Placements[1].Compliance = append(p.Placements[1].Compliance, compliance.LabelEUResidency)
+	got := p.ComplianceViolations()
+	if len(got) != 1 || got[0].Subject != "cluster-b" || got[0].Label != compliance.LabelEUResidency {
+		t.Fatalf("expected the residency of cluster-b violated, got %v", got)
+	}
+	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "must stay in the EU") {
+		t.Errorf("expected the violation to reject the plan, got %v", err)
+	}
+}
+
+func TestPlan_Sources(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.TransferRateMbps = 1000
+	p.Sources = []Source{{Name: "vcenter-east", TransferRateMbps: 400}, {Name: "rhv-west"}}
+	p.Waves[0].Source = "vcenter-east"
+	p.Waves[1].Source = "rhv-west"
+
+	if err := p.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := p.WaveSources(); got["wave-1"] != "vcenter-east" || got["wave-2"] != "rhv-west" {
+		t.Errorf("expected the waves mapped to their source, got %v", got)
+	}
+	if got := p.TransferRate("vcenter-east"); got != 400 {
+		t.Errorf("expected the transfer rate of the source, got %v", got)
+	}
+	if got := p.TransferRate("rhv-west"); got != 1000 {
+		t.Errorf("expected the transfer rate of the plan, got %v", got)
+	}
+}
+
+func TestPlan_Timeline_WaitsForTarget(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Mode = scheduling.ModeParallel
+	ready := p.Start.AddDate(0, 0, 14)
+	p.Targets = []Target{{Name: "ocp-east", Zone: "us-east-1a"}, {Name: "ocp-west", Ready: &ready}}
+	p.Placements = []Placement{{Cluster: "cluster-a", Target: "ocp-east"}, {Cluster: "cluster-b", Target: "ocp-west"}}
+	p.Waves[0].Clusters = []string{"cluster-a"}
+	p.Waves[1].Clusters = []string{"cluster-b"}
+
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if tl.Bars[0].Start != 0 {
+		t.Errorf("expected wave-1 to start with the plan, got %v", tl.Bars[0].Start)
+	}
+	if tl.Bars[2].Start != 14*24*time.Hour {
+		t.Errorf("expected wave-2 to start once its target is built, got %v", tl.Bars[2].Start)
+	}
+}
+
+func TestPlan_SlipImpacts(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Mode = scheduling.ModeParallel
+	p.Milestones = []Milestone{
+		{Name: "cluster-a ready", Date: p.Start.AddDate(0, 0, 2)},
+		{Name: "storage expansion", Date: p.Start.AddDate(0, 0, 1)},
+	}
+	p.Targets = []Target{{Name: "ocp-east", Milestones: []string{"storage expansion"}}}
+	p.Placements = []Placement{{Cluster: "cluster-b", Target: "ocp-east"}}
+	p.Waves[0].Milestones = []string{"cluster-a ready"}
+	p.Waves[1].Clusters = []string{"cluster-b"}
+
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if tl.Bars[0].Start != 48*time.Hour || tl.Bars[2].Start != 24*time.Hour {
+		t.Fatalf("expected the waves pushed past their milestones, got %v and %v", tl.Bars[0].Start, tl.Bars[2].Start)
+	}
+
+	// wave-2 ends 10h before wave-1: a 6h slip of its milestone is absorbed, a 2 days slip is not
+	impacts, err := p.SlipImpacts([]MilestoneSlip{
+		{Milestone: "storage expansion", Date: p.Start.AddDate(0, 0, 1).Add(6 * time.Hour)},
+		{Milestone: "cluster-a ready", Date: p.Start.AddDate(0, 0, 4)},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if impacts[0].Delay != 0 || len(impacts[0].Waves) != 1 || impacts[0].Waves[0] != "wave-2" {
+		t.Errorf("expected the storage expansion slip to be absorbed, got %+v", impacts[0])
+	}
+	if impacts[1].Delay != 48*time.Hour {
+		t.Errorf("expected the cluster-a slip to delay the end by 48h, got %v", impacts[1].Delay)
+	}
+	if p.Milestones[1].Date != p.Start.AddDate(0, 0, 1) {
+		t.Errorf("expected the plan to be left unchanged, got %v", p.Milestones[1].Date)
+	}
+	if _, err := p.SlipImpacts([]MilestoneSlip{{Milestone: "network ready", Date: p.Start}}); err == nil {
+		t.Errorf("expected error for unknown milestone, got nil")
+	}
+}
+
+func TestProcurementMilestone(t *testing.T) {
+	t.Parallel()
+	ordered := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	est := estimation.Estimation{Duration: 48 * time.Hour, LeadTime: 66 * 24 * time.Hour, Reason: "12 nodes"}
+
+	m := ProcurementMilestone("cluster-a ready", ordered, est)
+	if !m.Date.Equal(time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the milestone once the nodes are racked, got %v", m.Date)
+	}
+	if m.Name != "cluster-a ready" || m.Note != "12 nodes" {
+		t.Errorf("expected the milestone to be named and noted after the estimation, got %+v", m)
+	}
+}
+
+func TestPlan_Digest(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Pools = map[string]int{"engineers": 4, "dba": 1, "network": 2}
+	digest, err := p.Digest()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.HasPrefix(digest, "sha256:") || len(digest) != len("sha256:")+64 {
+		t.Errorf("unexpected digest %q", digest)
+	}
+
+	// the same content filled in another order
+	same := testPlan()
+	same.Pools = map[string]int{"network": 2, "engineers": 4}
+	same.Pools["dba"] = 1
+	if err := same.VerifyDigest(digest); err != nil {
+		t.Errorf("expected the same digest, got: %v", err)
+	}
+
+	changed := testPlan()
+	changed.Pools = map[string]int{"engineers": 4, "dba": 1, "network": 3}
+	if err := changed.VerifyDigest(digest); !errors.Is(err, ErrDigestMismatch) {
+		t.Errorf("expected ErrDigestMismatch, got: %v", err)
+	}
+
+	doc, err := p.CanonicalJSON()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.HasPrefix(string(doc), `{"mode":"pipelined","name":"datacenter exit","overlaps":`) {
+		t.Errorf("expected sorted keys, got %s", doc)
+	}
+}
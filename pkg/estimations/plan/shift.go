@@ -0,0 +1,140 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+// Shift is a team working a pool from its IANA time zone, UTC when empty. Start and End are local
+// times of day ("15:04"); a shift ending before it starts runs past midnight.
+type Shift struct {
+	Name     string `json:"name"`
+	TimeZone string `json:"timeZone"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	// Days are the weekdays the shift starts on ("Monday"). Empty means Monday to Friday.
+	Days []string `json:"days,omitempty"`
+	// Calendar holds the holidays of the team.
+	Calendar *calendar.Calendar `json:"calendar,omitempty"`
+}
+
+// PoolCoverage is the weekly coverage of a pool worked in shifts.
+type PoolCoverage struct {
+	Pool   string
+	Shifts []string
+	// Gaps are the periods of the working week, in UTC, no shift covers.
+	Gaps []scheduling.Gap
+}
+
+var weekdays = map[string]time.Weekday{
+	"Sunday": time.Sunday, "Monday": time.Monday, "Tuesday": time.Tuesday, "Wednesday": time.Wednesday,
+	"Thursday": time.Thursday, "Friday": time.Friday, "Saturday": time.Saturday,
+}
+
+// Coverage returns the weekly coverage of the pools worked in shifts, in pool order. Gaps are searched
+// on the days the shifts work: Monday to Friday for a 24x5 factory, every day once a shift works on weekends.
+func (p Plan) Coverage() ([]PoolCoverage, error) {
+	coverage := make([]PoolCoverage, 0, len(p.Shifts))
+	for _, pool := range slices.Sorted(maps.Keys(p.Shifts)) {
+		shifts, err := poolShifts(p.Shifts[pool])
+		if err != nil {
+			return nil, fmt.Errorf("shifts of pool %q: %w", pool, err)
+		}
+		c := PoolCoverage{Pool: pool, Gaps: shifts.WeeklyGaps(workedDays(shifts))}
+		for _, s := range shifts {
+			c.Shifts = append(c.Shifts, s.Name)
+		}
+		coverage = append(coverage, c)
+	}
+	return coverage, nil
+}
+
+// validateShifts checks the shifts of a pool.
+func validateShifts(shifts []Shift) error {
+	if len(shifts) == 0 {
+		return errors.New("no shifts")
+	}
+	seen := make(map[string]bool, len(shifts))
+	for _, s := range shifts {
+		if s.Name == "" {
+			return errors.New("shift name is required")
+		}
+		if seen[s.Name] {
+			return fmt.Errorf("duplicate shift %q", s.Name)
+		}
+		seen[s.Name] = true
+		if s.Calendar != nil {
+			if err := s.Calendar.Validate(); err != nil {
+				return fmt.Errorf("calendar of shift %q: %w", s.Name, err)
+			}
+		}
+	}
+	_, err := poolShifts(shifts)
+	return err
+}
+
+// poolShifts converts the shifts of a pool to scheduling shifts.
+func poolShifts(shifts []Shift) (scheduling.Shifts, error) {
+	converted := make(scheduling.Shifts, 0, len(shifts))
+	for _, s := range shifts {
+		loc, err := time.LoadLocation(s.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("shift %q has invalid time zone %q", s.Name, s.TimeZone)
+		}
+		start, err := timeOfDay(s.Start)
+		if err != nil {
+			return nil, fmt.Errorf("shift %q has invalid start: %w", s.Name, err)
+		}
+		end, err := timeOfDay(s.End)
+		if err != nil {
+			return nil, fmt.Errorf("shift %q has invalid end: %w", s.Name, err)
+		}
+		shift := scheduling.Shift{Name: s.Name, Location: loc, Start: start, End: end}
+		for _, d := range s.Days {
+			day, ok := weekdays[d]
+			if !ok {
+				return nil, fmt.Errorf("shift %q has invalid day %q", s.Name, d)
+			}
+			shift.Days = append(shift.Days, day)
+		}
+		if s.Calendar != nil {
+			shift.Calendar = *s.Calendar
+		}
+		converted = append(converted, shift)
+	}
+	return converted, nil
+}
+
+// workedDays returns the weekdays any shift starts on.
+func workedDays(shifts scheduling.Shifts) []time.Weekday {
+	worked := make(map[time.Weekday]bool)
+	var days []time.Weekday
+	for _, s := range shifts {
+		starts := s.Days
+		if len(starts) == 0 {
+			starts = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+		}
+		for _, d := range starts {
+			if !worked[d] {
+				worked[d] = true
+				days = append(days, d)
+			}
+		}
+	}
+	return days
+}
+
+// timeOfDay parses a "15:04" time of day to an offset from midnight.
+func timeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a time of day", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,69 @@
+package plan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+func followTheSun() []Shift {
+	return []Shift{
+		{Name: "apac", TimeZone: "Asia/Kolkata", Start: "09:30", End: "17:30"},
+		{Name: "emea", TimeZone: "UTC", Start: "12:00", End: "20:00"},
+		{Name: "amer", TimeZone: "Etc/GMT+5", Start: "15:00", End: "23:00"},
+	}
+}
+
+func TestPlan_Timeline_PoolShifts(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Mode = scheduling.ModeSerial
+	p.Waves = p.Waves[:1]
+	p.Waves[0].Steps[1].WorkHoursPerDay = 8
+	// Monday, as the Asia-Pacific shift comes on duty
+	p.Start = time.Date(2026, time.October, 12, 4, 0, 0, 0, time.UTC)
+	p.Shifts = map[string][]Shift{"engineers": followTheSun()}
+	if err := p.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	withoutShifts := testPlan()
+	withoutShifts.Mode = scheduling.ModeSerial
+	withoutShifts.Waves = withoutShifts.Waves[:1]
+	withoutShifts.Waves[0].Steps[1].WorkHoursPerDay = 8
+	withoutShifts.Start = p.Start
+	single, err := withoutShifts.Timeline()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if tl.Total >= single.Total {
+		t.Errorf("expected shifts to shorten the program from %v, got %v", single.Total, tl.Total)
+	}
+}
+
+func TestPlan_Coverage(t *testing.T) {
+	t.Parallel()
+	p := testPlan()
+	p.Shifts = map[string][]Shift{"engineers": followTheSun()[:2]}
+
+	coverage, err := p.Coverage()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(coverage) != 1 || coverage[0].Pool != "engineers" || len(coverage[0].Shifts) != 2 {
+		t.Fatalf("expected the coverage of engineers by two shifts, got %+v", coverage)
+	}
+	// nobody from 20:00 to 04:00 UTC, Monday to Friday
+	gaps := coverage[0].Gaps
+	if len(gaps) != 10 {
+		t.Fatalf("expected 10 gaps, got %d: %+v", len(gaps), gaps)
+	}
+	if want := (scheduling.Gap{Day: time.Monday, From: 20 * time.Hour, To: 24 * time.Hour}); gaps[1] != want {
+		t.Errorf("expected gap %+v, got %+v", want, gaps[1])
+	}
+}
@@ -0,0 +1,85 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Source is one of the environments a program spanning several inventory sources migrates, e.g. a
+// vCenter or RHV instance, with the parameters that differ from one environment to the other.
+type Source struct {
+	Name string `json:"name"`
+	// SourceID is the planner source holding the inventory collected by the agent of the environment.
+	SourceID *uuid.UUID `json:"sourceId,omitempty"`
+	// TransferRateMbps is the bandwidth of the link between the environment and the target. Zero
+	// means the transfer rate of the plan.
+	TransferRateMbps float64 `json:"transferRateMbps,omitempty"`
+	// TimeZone is the IANA time zone of the datacenter of the environment, UTC when empty. The
+	// maintenance windows and blackouts are given in its local time.
+	TimeZone string `json:"timeZone,omitempty"`
+	// MaintenanceWindows are the windows the waves of the environment may be cut over in, and
+	// Blackouts the days they may not.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	Blackouts          []Blackout          `json:"blackouts,omitempty"`
+}
+
+// validateSources checks the sources are distinct and every wave migrates one of them when the
+// plan declares any.
+func (p Plan) validateSources() error {
+	names := make(map[string]bool, len(p.Sources))
+	ids := make(map[uuid.UUID]bool, len(p.Sources))
+	for _, s := range p.Sources {
+		if s.Name == "" {
+			return errors.New("source name is required")
+		}
+		if names[s.Name] {
+			return fmt.Errorf("duplicate source %q", s.Name)
+		}
+		names[s.Name] = true
+		if s.SourceID != nil {
+			if ids[*s.SourceID] {
+				return fmt.Errorf("source %q references the inventory of another source", s.Name)
+			}
+			ids[*s.SourceID] = true
+		}
+		if s.TransferRateMbps < 0 {
+			return fmt.Errorf("source %q has a negative transfer rate", s.Name)
+		}
+		if err := s.validateWindows(); err != nil {
+			return err
+		}
+	}
+	for _, w := range p.Waves {
+		switch {
+		case w.Source == "" && len(p.Sources) > 0:
+			return fmt.Errorf("wave %q has no source", w.Name)
+		case w.Source != "" && !names[w.Source]:
+			return fmt.Errorf("wave %q migrates unknown source %q", w.Name, w.Source)
+		}
+	}
+	return nil
+}
+
+// TransferRate returns the bandwidth available to the migration of a source: its own transfer rate
+// when set, the transfer rate of the plan otherwise.
+func (p Plan) TransferRate(source string) float64 {
+	for _, s := range p.Sources {
+		if s.Name == source && s.TransferRateMbps > 0 {
+			return s.TransferRateMbps
+		}
+	}
+	return p.TransferRateMbps
+}
+
+// WaveSources maps the waves to the source they migrate. It is empty for a single-source plan.
+func (p Plan) WaveSources() map[string]string {
+	sources := make(map[string]string, len(p.Waves))
+	for _, w := range p.Waves {
+		if w.Source != "" {
+			sources[w.Name] = w.Source
+		}
+	}
+	return sources
+}
@@ -0,0 +1,175 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+const dateLayout = "2006-01-02"
+
+// MaintenanceWindow is a weekly window during which the waves of a source may be cut over. Start and
+// End are times of day ("15:04") in the time zone of the source; a window ending before it starts
+// runs past midnight.
+type MaintenanceWindow struct {
+	Name  string `json:"name"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Days are the weekdays the window opens on ("Saturday"). Empty means every day.
+	Days []string `json:"days,omitempty"`
+}
+
+// Blackout is a period during which no wave of a source is cut over, e.g. a quarter-end close. Start
+// and End are the first and last days ("2006-01-02") in the time zone of the source.
+type Blackout struct {
+	Name  string `json:"name"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Location returns the time zone of the source, UTC when it has none. It falls back to UTC for an
+// invalid time zone; Validate rejects those.
+func (s Source) Location() *time.Location {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// CutoverWindows returns the maintenance windows of the source open at some point between from and
+// to, in time order. The windows are laid out on the wall clock of the source, so a 01:00-05:00
+// window lasts three hours on the night clocks move forward. A window overlapping a blackout is left out.
+func (s Source) CutoverWindows(from, to time.Time) ([]scheduling.Window, error) {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("source %q has invalid time zone %q", s.Name, s.TimeZone)
+	}
+	blackouts, err := s.blackoutSpans(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	type window struct {
+		name       string
+		start, end time.Duration
+		days       map[time.Weekday]bool
+	}
+	windows := make([]window, 0, len(s.MaintenanceWindows))
+	for _, mw := range s.MaintenanceWindows {
+		w := window{name: mw.Name}
+		if w.start, err = timeOfDay(mw.Start); err != nil {
+			return nil, fmt.Errorf("maintenance window %q of source %q has invalid start: %w", mw.Name, s.Name, err)
+		}
+		if w.end, err = timeOfDay(mw.End); err != nil {
+			return nil, fmt.Errorf("maintenance window %q of source %q has invalid end: %w", mw.Name, s.Name, err)
+		}
+		if len(mw.Days) > 0 {
+			w.days = make(map[time.Weekday]bool, len(mw.Days))
+			for _, d := range mw.Days {
+				day, ok := weekdays[d]
+				if !ok {
+					return nil, fmt.Errorf("maintenance window %q of source %q has invalid day %q", mw.Name, s.Name, d)
+				}
+				w.days[day] = true
+			}
+		}
+		windows = append(windows, w)
+	}
+
+	var res []scheduling.Window
+	// start the day before: a window opened the previous evening may still be open at from
+	local := from.In(loc)
+	for day := time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, w := range windows {
+			if w.days != nil && !w.days[day.Weekday()] {
+				continue
+			}
+			start := wallClock(day, w.start)
+			endDay := day
+			if w.end <= w.start {
+				endDay = day.AddDate(0, 0, 1)
+			}
+			end := wallClock(endDay, w.end)
+			if !end.After(from) || !start.Before(to) || blackedOut(blackouts, start, end) {
+				continue
+			}
+			res = append(res, scheduling.Window{Name: w.name, Start: start, End: end})
+		}
+	}
+	slices.SortStableFunc(res, func(a, b scheduling.Window) int { return a.Start.Compare(b.Start) })
+	return res, nil
+}
+
+// wallClock returns the time of day on a day, both on the wall clock of the location of day. A time
+// skipped when clocks move forward is normalized the way time.Date does.
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, day.Location())
+}
+
+// span is a half-open period of time.
+type span struct {
+	start, end time.Time
+}
+
+// blackoutSpans returns the blackouts of the source from the midnight starting their first day to
+// the midnight ending their last day, in the time zone of the source.
+func (s Source) blackoutSpans(loc *time.Location) ([]span, error) {
+	spans := make([]span, 0, len(s.Blackouts))
+	for _, b := range s.Blackouts {
+		start, err := time.ParseInLocation(dateLayout, b.Start, loc)
+		if err != nil {
+			return nil, fmt.Errorf("blackout %q of source %q has invalid start %q", b.Name, s.Name, b.Start)
+		}
+		last, err := time.ParseInLocation(dateLayout, b.End, loc)
+		if err != nil {
+			return nil, fmt.Errorf("blackout %q of source %q has invalid end %q", b.Name, s.Name, b.End)
+		}
+		if last.Before(start) {
+			return nil, fmt.Errorf("blackout %q of source %q ends before it starts", b.Name, s.Name)
+		}
+		spans = append(spans, span{start: start, end: last.AddDate(0, 0, 1)})
+	}
+	return spans, nil
+}
+
+// blackedOut reports whether the period from start to end overlaps a blackout.
+func blackedOut(blackouts []span, start, end time.Time) bool {
+	for _, b := range blackouts {
+		if start.Before(b.end) && end.After(b.start) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWindows checks the time zone, maintenance windows and blackouts of the source.
+func (s Source) validateWindows() error {
+	if _, err := time.LoadLocation(s.TimeZone); err != nil {
+		return fmt.Errorf("source %q has invalid time zone %q", s.Name, s.TimeZone)
+	}
+	seen := make(map[string]bool, len(s.MaintenanceWindows))
+	for _, mw := range s.MaintenanceWindows {
+		if mw.Name == "" {
+			return fmt.Errorf("source %q has a maintenance window without name", s.Name)
+		}
+		if seen[mw.Name] {
+			return fmt.Errorf("source %q has duplicate maintenance window %q", s.Name, mw.Name)
+		}
+		seen[mw.Name] = true
+		if mw.Start == mw.End {
+			return fmt.Errorf("maintenance window %q of source %q is empty", mw.Name, s.Name)
+		}
+	}
+	for _, b := range s.Blackouts {
+		if b.Name == "" {
+			return errors.New("blackout name is required")
+		}
+	}
+	// lay out a week to check the times of day, days and dates
+	_, err := s.CutoverWindows(time.Time{}, time.Time{}.AddDate(0, 0, 7))
+	return err
+}
@@ -0,0 +1,117 @@
+package plan
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSource_CutoverWindows(t *testing.T) {
+	t.Parallel()
+	s := Source{
+		Name:               "vcenter-east",
+		TimeZone:           "America/New_York",
+		MaintenanceWindows: []MaintenanceWindow{{Name: "weekend", Start: "22:00", End: "04:00", Days: []string{"Saturday"}}},
+		Blackouts:          []Blackout{{Name: "quarter-end close", Start: "2026-03-28", End: "2026-03-31"}},
+	}
+
+	windows, err := s.CutoverWindows(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 8, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// Saturdays Mar 7, 14, 21 and Apr 4; Mar 28 is blacked out
+	if len(windows) != 4 {
+		t.Fatalf("expected 4 windows, got %+v", windows)
+	}
+
+	cases := []struct {
+		name      string
+		window    int
+		wantStart time.Time
+		want      time.Duration
+	}{
+		// clocks move forward at 2am on Sunday Mar 8: 22:00 EST to 04:00 EDT
+		{name: "spring forward", window: 0, wantStart: time.Date(2026, 3, 8, 3, 0, 0, 0, time.UTC), want: 5 * time.Hour},
+		{name: "daylight saving time", window: 1, wantStart: time.Date(2026, 3, 15, 2, 0, 0, 0, time.UTC), want: 6 * time.Hour},
+		{name: "after the blackout", window: 3, wantStart: time.Date(2026, 4, 5, 2, 0, 0, 0, time.UTC), want: 6 * time.Hour},
+	}
+	for _, tc := range cases {
+		w := windows[tc.window]
+		if !w.Start.Equal(tc.wantStart) || w.Length() != tc.want {
+			t.Errorf("%s: expected %v for %v, got %v for %v", tc.name, tc.wantStart, tc.want, w.Start.UTC(), w.Length())
+		}
+		if local := w.Start.In(s.Location()); local.Hour() != 22 || local.Weekday() != time.Saturday {
+			t.Errorf("%s: expected the window to open on Saturday 22:00 on site, got %v", tc.name, local)
+		}
+	}
+}
+
+func TestSource_CutoverWindows_FallBack(t *testing.T) {
+	t.Parallel()
+	s := Source{
+		Name:               "vcenter-paris",
+		TimeZone:           "Europe/Paris",
+		MaintenanceWindows: []MaintenanceWindow{{Name: "night", Start: "01:00", End: "05:00"}},
+	}
+
+	// clocks move back at 3am on Sunday Oct 25
+	windows, err := s.CutoverWindows(time.Date(2026, 10, 24, 12, 0, 0, 0, time.UTC), time.Date(2026, 10, 26, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(windows) != 2 {
+		t.Fatalf("expected 2 windows, got %+v", windows)
+	}
+	if got := windows[0].Length(); got != 5*time.Hour {
+		t.Errorf("expected the night clocks move back to last 5h, got %v", got)
+	}
+	if got := windows[1].Length(); got != 4*time.Hour {
+		t.Errorf("expected the next night to last 4h, got %v", got)
+	}
+}
+
+func TestSource_CutoverWindows_OpenAtFrom(t *testing.T) {
+	t.Parallel()
+	s := Source{Name: "rhv-west", MaintenanceWindows: []MaintenanceWindow{{Name: "night", Start: "22:00", End: "02:00"}}}
+
+	from := time.Date(2026, 6, 2, 1, 0, 0, 0, time.UTC)
+	windows, err := s.CutoverWindows(from, from.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(windows) != 1 || !windows[0].Start.Equal(time.Date(2026, 6, 1, 22, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the window opened the evening before, got %+v", windows)
+	}
+}
+
+func TestPlan_Validate_Windows(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		source Source
+	}{
+		{name: "invalid time zone", source: Source{TimeZone: "Europe/Atlantis"}},
+		{name: "window without name", source: Source{MaintenanceWindows: []MaintenanceWindow{{Start: "22:00", End: "04:00"}}}},
+		{name: "duplicate window", source: Source{MaintenanceWindows: []MaintenanceWindow{
+			{Name: "night", Start: "22:00", End: "04:00"}, {Name: "night", Start: "01:00", End: "03:00"},
+		}}},
+		{name: "empty window", source: Source{MaintenanceWindows: []MaintenanceWindow{{Name: "night", Start: "22:00", End: "22:00"}}}},
+		{name: "invalid window start", source: Source{MaintenanceWindows: []MaintenanceWindow{{Name: "night", Start: "10pm", End: "04:00"}}}},
+		{name: "invalid window day", source: Source{MaintenanceWindows: []MaintenanceWindow{{Name: "night", Start: "22:00", End: "04:00", Days: []string{"Funday"}}}}},
+		{name: "invalid blackout date", source: Source{Blackouts: []Blackout{{Name: "close", Start: "03/28/2026", End: "2026-03-31"}}}},
+		{name: "blackout ending before it starts", source: Source{Blackouts: []Blackout{{Name: "close", Start: "2026-03-31", End: "2026-03-28"}}}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := testPlan()
+			tc.source.Name = "vcenter-east"
+			p.Sources = []Source{tc.source}
+			p.Waves[0].Source, p.Waves[1].Source = "vcenter-east", "vcenter-east"
+			if err := p.Validate(); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Package portfolio builds the executive report of a migration portfolio: the program totals of
+// several plans, the status of each plan derived from the progress recorded against its waves, the
+// risks raised by any of them (KPI breaches and overdue waves) and their labor cost priced with a
+// rate card, spread over the months or fiscal quarters the work spans. Program managers overseeing
+// many migrations at once read one report instead of one per plan.
+package portfolio
@@ -0,0 +1,400 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+// DefaultRole is the rate card role the steps without a resource pool are priced with. The steps
+// of a pool are priced with the rate of the role named after the pool.
+const DefaultRole = "engineer"
+
+// Status is where a plan of the portfolio stands.
+type Status string
+
+const (
+	StatusNotStarted Status = "not-started"
+	StatusInProgress Status = "in-progress"
+	StatusCompleted  Status = "completed"
+)
+
+// RiskKind is what raised a risk.
+type RiskKind string
+
+const (
+	// RiskKPIBreach is a KPI target of the plan missed by the progress recorded so far.
+	RiskKPIBreach RiskKind = "kpi-breach"
+	// RiskOverdue is a wave due to be over without any progress recorded against it.
+	RiskOverdue RiskKind = "overdue"
+)
+
+// Entry is a plan of the portfolio, laid out on the calendar.
+type Entry struct {
+	ID    string
+	Plan  plan.Plan
+	Chart gantt.Chart
+	// Rates convert the cost of the plan into the currency of the plan, e.g. the rates entered for
+	// the plan. Nil when the plan has no currency of its own.
+	Rates *cost.Rates
+}
+
+// Report is the executive report of a portfolio of plans.
+type Report struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	// Start and End span all the plans of the portfolio.
+	Start  time.Time    `json:"start"`
+	End    time.Time    `json:"end"`
+	Totals Totals       `json:"totals"`
+	Plans  []PlanStatus `json:"plans"`
+	Risks  []Risk       `json:"risks,omitempty"`
+	// Cost is the labor cost of the portfolio, nil when no rate card was given.
+	Cost *Cost `json:"cost,omitempty"`
+}
+
+// Totals are the figures of the whole program.
+type Totals struct {
+	Plans          int           `json:"plans"`
+	Waves          int           `json:"waves"`
+	CompletedWaves int           `json:"completedWaves"`
+	VMsMigrated    int           `json:"vmsMigrated"`
+	Rollbacks      int           `json:"rollbacks"`
+	P1Incidents    int           `json:"p1Incidents"`
+	Effort         time.Duration `json:"effort"`
+}
+
+// PlanStatus is the status of a plan of the portfolio.
+type PlanStatus struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Status         Status        `json:"status"`
+	Start          time.Time     `json:"start"`
+	End            time.Time     `json:"end"`
+	Waves          int           `json:"waves"`
+	CompletedWaves int           `json:"completedWaves"`
+	VMsMigrated    int           `json:"vmsMigrated"`
+	Rollbacks      int           `json:"rollbacks"`
+	P1Incidents    int           `json:"p1Incidents"`
+	Effort         time.Duration `json:"effort"`
+	Risks          int           `json:"risks"`
+	// Cost is the labor cost of the plan in Currency, nil without rate card. Currency is the one of
+	// the plan when set, else the one of the rate card, and ExchangeRates converted the cost into it.
+	Cost          *float64            `json:"cost,omitempty"`
+	Currency      string              `json:"currency,omitempty"`
+	ExchangeRates []cost.ExchangeRate `json:"exchangeRates,omitempty"`
+}
+
+// Risk is a risk raised by a plan of the portfolio.
+type Risk struct {
+	PlanID string   `json:"planId"`
+	Plan   string   `json:"plan"`
+	Kind   RiskKind `json:"kind"`
+	// Wave is empty for the risks of the whole plan.
+	Wave    string `json:"wave,omitempty"`
+	Message string `json:"message"`
+}
+
+// Cost is the labor cost of the portfolio priced with a rate card.
+type Cost struct {
+	RateCard string `json:"rateCard"`
+	// Currency is the one the plans are displayed in, the one of the rate card for the plans without
+	// a currency of their own. ExchangeRates are the rates converting the costs of the plans into it.
+	Currency      string              `json:"currency"`
+	ExchangeRates []cost.ExchangeRate `json:"exchangeRates,omitempty"`
+	Total         float64             `json:"total"`
+	// Unpriced explains the phases left out of the total, e.g. worked by a role the card has no
+	// rate for or started after the card expired.
+	Unpriced []string `json:"unpriced,omitempty"`
+	// CashFlow spreads the total over the budget periods the priced phases span, by Granularity.
+	Granularity cost.Granularity `json:"granularity"`
+	CashFlow    []BudgetPeriod   `json:"cashFlow"`
+}
+
+// BudgetPeriod is the labor cost of the portfolio spent within a month or a fiscal quarter.
+type BudgetPeriod struct {
+	// Period is the name of the period, e.g. "2026-03" or "FY2026 Q2".
+	Period string    `json:"period"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+	// Plans maps the IDs of the plans with a cost within the period to that cost.
+	Plans      map[string]float64 `json:"plans"`
+	Total      float64            `json:"total"`
+	Cumulative float64            `json:"cumulative"`
+}
+
+type options struct {
+	calendar    cost.FiscalCalendar
+	granularity cost.Granularity
+}
+
+// Option is a functional option for configuring a report.
+type Option func(*options)
+
+// WithFiscalCalendar sets the calendar the cost is bucketed by, the fiscal year starting in
+// January by default.
+func WithFiscalCalendar(cal cost.FiscalCalendar) Option {
+	return func(o *options) {
+		o.calendar = cal
+	}
+}
+
+// WithGranularity sets the length of the budget periods of the cash flow, cost.GranularityQuarter
+// by default.
+func WithGranularity(g cost.Granularity) Option {
+	return func(o *options) {
+		o.granularity = g
+	}
+}
+
+// Build reports on the plans as of now, pricing their effort with the card when given and spreading
+// the cost over the budget periods of the fiscal calendar. The cost of each plan is converted into
+// the currency of the plan with its rates, so all the plans have to share a currency. The plans are
+// listed by start date.
+func Build(ctx context.Context, entries []Entry, card *cost.RateCard, now time.Time, opts ...Option) (Report, error) {
+	o := options{granularity: cost.GranularityQuarter}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	r := Report{GeneratedAt: now, Plans: make([]PlanStatus, 0, len(entries))}
+	if card != nil {
+		r.Cost = &Cost{RateCard: fmt.Sprintf("%s v%d", card.Name, card.Version), Currency: card.Currency, Granularity: o.granularity}
+	}
+
+	var flows []cost.CashFlow
+	for _, e := range entries {
+		status, risks := planStatus(e, now)
+		if card != nil {
+			flow, unpriced, err := planCost(ctx, e, *card, o)
+			if err != nil {
+				return Report{}, err
+			}
+			if len(flows) > 0 && flow.Currency != r.Cost.Currency {
+				return Report{}, fmt.Errorf("plan %q is displayed in %s, the other plans in %s", e.Plan.Name, flow.Currency, r.Cost.Currency)
+			}
+			flows = append(flows, flow)
+			status.Cost = &flow.Total
+			status.Currency = flow.Currency
+			status.ExchangeRates = flow.ExchangeRates
+			r.Cost.Currency = flow.Currency
+			r.Cost.Total += flow.Total
+			r.Cost.Unpriced = append(r.Cost.Unpriced, unpriced...)
+			for _, rate := range flow.ExchangeRates {
+				if !slices.ContainsFunc(r.Cost.ExchangeRates, rate.Equal) {
+					r.Cost.ExchangeRates = append(r.Cost.ExchangeRates, rate)
+				}
+			}
+		}
+		r.Plans = append(r.Plans, status)
+		r.Risks = append(r.Risks, risks...)
+
+		if r.Start.IsZero() || e.Chart.Start.Before(r.Start) {
+			r.Start = e.Chart.Start
+		}
+		if e.Chart.End.After(r.End) {
+			r.End = e.Chart.End
+		}
+		r.Totals.Plans++
+		r.Totals.Waves += status.Waves
+		r.Totals.CompletedWaves += status.CompletedWaves
+		r.Totals.VMsMigrated += status.VMsMigrated
+		r.Totals.Rollbacks += status.Rollbacks
+		r.Totals.P1Incidents += status.P1Incidents
+		r.Totals.Effort += status.Effort
+	}
+
+	sort.SliceStable(r.Plans, func(i, j int) bool {
+		if !r.Plans[i].Start.Equal(r.Plans[j].Start) {
+			return r.Plans[i].Start.Before(r.Plans[j].Start)
+		}
+		return r.Plans[i].Name < r.Plans[j].Name
+	})
+
+	if r.Cost != nil {
+		sort.SliceStable(r.Cost.ExchangeRates, func(i, j int) bool {
+			a, b := r.Cost.ExchangeRates[i], r.Cost.ExchangeRates[j]
+			if a.From != b.From {
+				return a.From < b.From
+			}
+			return a.AsOf.Before(b.AsOf)
+		})
+		periods, err := budgetPeriods(o, flows)
+		if err != nil {
+			return Report{}, err
+		}
+		r.Cost.CashFlow = periods
+	}
+	return r, nil
+}
+
+// planCost prices the plan with the card and spreads its cost over the budget periods, in the
+// currency of the plan or else of the card. The cash flow breaks the cost of a period down by plan.
+func planCost(ctx context.Context, e Entry, card cost.RateCard, o options) (cost.CashFlow, []string, error) {
+	items, unpriced := price(e, card)
+	for i := range items {
+		items[i].Name = e.ID
+	}
+
+	currency, rates := card.Currency, e.Rates
+	if e.Plan.Currency != "" {
+		currency = e.Plan.Currency
+	}
+	if rates == nil {
+		var err error
+		if rates, err = cost.NewRates(); err != nil {
+			return cost.CashFlow{}, nil, err
+		}
+	}
+	cf, err := o.calendar.CashFlowIn(ctx, items, o.granularity, currency, rates)
+	if err != nil {
+		return cost.CashFlow{}, nil, fmt.Errorf("plan %q: %w", e.Plan.Name, err)
+	}
+	return cf, unpriced, nil
+}
+
+// budgetPeriods merges the cash flows of the plans, from the first period any of them spends in to
+// the last.
+func budgetPeriods(o options, flows []cost.CashFlow) ([]BudgetPeriod, error) {
+	var first, last time.Time
+	for _, cf := range flows {
+		for _, row := range cf.Rows {
+			if first.IsZero() || row.Period.Start.Before(first) {
+				first = row.Period.Start
+			}
+			if row.Period.End.After(last) {
+				last = row.Period.End
+			}
+		}
+	}
+	periods := []BudgetPeriod{}
+	if first.IsZero() {
+		return periods, nil
+	}
+
+	period, err := o.calendar.Period(first, o.granularity)
+	if err != nil {
+		return nil, err
+	}
+	var cumulative float64
+	for period.Start.Before(last) {
+		bp := BudgetPeriod{Period: period.Label, Start: period.Start, End: period.End, Plans: map[string]float64{}}
+		for _, cf := range flows {
+			for _, row := range cf.Rows {
+				if !row.Period.Start.Equal(period.Start) {
+					continue
+				}
+				for id, amount := range row.Items {
+					bp.Plans[id] += amount
+				}
+				bp.Total += row.Total
+			}
+		}
+		cumulative += bp.Total
+		bp.Cumulative = cumulative
+		periods = append(periods, bp)
+		if period, err = o.calendar.Period(period.End, o.granularity); err != nil {
+			return nil, err
+		}
+	}
+	return periods, nil
+}
+
+// planStatus measures the progress recorded against the plan and the risks it raises.
+func planStatus(e Entry, now time.Time) (PlanStatus, []Risk) {
+	p := e.Plan
+	status := PlanStatus{
+		ID:    e.ID,
+		Name:  p.Name,
+		Start: e.Chart.Start,
+		End:   e.Chart.End,
+		Waves: len(p.Waves),
+	}
+	for i, w := range p.Waves {
+		for _, s := range w.Steps {
+			status.Effort += p.LearningEffort(i, s)
+		}
+	}
+
+	completed := make(map[string]bool, len(p.Progress))
+	for _, wp := range p.Progress {
+		completed[wp.Wave] = true
+		status.VMsMigrated += wp.VMsMigrated
+		status.Rollbacks += wp.Rollbacks
+		status.P1Incidents += wp.P1Incidents
+	}
+	for _, w := range p.Waves {
+		if completed[w.Name] {
+			status.CompletedWaves++
+		}
+	}
+	switch {
+	case status.Waves > 0 && status.CompletedWaves == status.Waves:
+		status.Status = StatusCompleted
+	case len(p.Progress) > 0 || !now.Before(e.Chart.Start):
+		status.Status = StatusInProgress
+	default:
+		status.Status = StatusNotStarted
+	}
+
+	var risks []Risk
+	for _, kpi := range p.KPIStatus() {
+		if kpi.Breached {
+			risks = append(risks, Risk{PlanID: e.ID, Plan: p.Name, Kind: RiskKPIBreach, Wave: kpi.Wave, Message: kpi.Message})
+		}
+	}
+	for _, w := range e.Chart.Waves {
+		if !completed[w.Name] && now.After(w.End) {
+			risks = append(risks, Risk{
+				PlanID:  e.ID,
+				Plan:    p.Name,
+				Kind:    RiskOverdue,
+				Wave:    w.Name,
+				Message: fmt.Sprintf("wave was due on %s and has no progress recorded", w.End.Format(time.DateOnly)),
+			})
+		}
+	}
+	status.Risks = len(risks)
+	return status, risks
+}
+
+// price prices the effort of each step of the plan over the calendar span of its bar. Steps the
+// card can not price are left out and explained.
+func price(e Entry, card cost.RateCard) ([]cost.Item, []string) {
+	bars := make(map[scheduling.BarID]gantt.Bar, len(e.Chart.Bars))
+	for _, b := range e.Chart.Bars {
+		bars[scheduling.BarID{Wave: b.Wave, Phase: b.Phase}] = b
+	}
+
+	var (
+		items    []cost.Item
+		unpriced []string
+	)
+	for i, w := range e.Plan.Waves {
+		for _, s := range w.Steps {
+			role := s.Pool
+			if role == "" {
+				role = DefaultRole
+			}
+			start, elapsed := e.Chart.Start, time.Duration(0)
+			if b, ok := bars[scheduling.BarID{Wave: w.Name, Phase: s.Phase}]; ok {
+				start, elapsed = b.Start, b.End.Sub(b.Start)
+			}
+			name := fmt.Sprintf("%s/%s/%s", e.Plan.Name, w.Name, s.Phase)
+			item, err := card.Labor(name, role, estimation.Estimation{Duration: e.Plan.LearningEffort(i, s)}, max(s.Units, 1), start, elapsed)
+			if err != nil {
+				unpriced = append(unpriced, err.Error())
+				continue
+			}
+			items = append(items, item)
+		}
+	}
+	return items, unpriced
+}
@@ -0,0 +1,239 @@
+package portfolio
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/cost"
+	"github.com/kubev2v/migration-planner/pkg/estimations/gantt"
+	"github.com/kubev2v/migration-planner/pkg/estimations/plan"
+	"github.com/kubev2v/migration-planner/pkg/estimations/scheduling"
+)
+
+func newEntry(t *testing.T, id string, p plan.Plan) Entry {
+	t.Helper()
+	tl, err := p.Timeline()
+	if err != nil {
+		t.Fatalf("laying out %s: %v", p.Name, err)
+	}
+	return Entry{ID: id, Plan: p, Chart: gantt.New(p.Start, tl, p.Start)}
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	wave := func(name, pool string) plan.Wave {
+		return plan.Wave{Name: name, Steps: []plan.Step{
+			{Phase: "Storage Migration", Effort: 24 * time.Hour, Pool: pool},
+			{Phase: "Cutover", Effort: 12 * time.Hour},
+		}}
+	}
+	minVelocity := 50.0
+
+	running := plan.Plan{
+		Name:  "datacenter-exit",
+		Start: day(2),
+		Mode:  scheduling.ModeSerial,
+		Waves: []plan.Wave{wave("wave-1", "storage"), wave("wave-2", "storage"), wave("wave-3", "storage")},
+		KPIs:  &plan.KPIs{MinVMsPerWeek: &minVelocity},
+		Progress: []plan.WaveProgress{
+			{Wave: "wave-1", Start: day(2), End: day(9), VMsMigrated: 20, Rollbacks: 1, P1Incidents: 2},
+		},
+	}
+	upcoming := plan.Plan{
+		Name:  "branch-offices",
+		Start: day(1).AddDate(0, 6, 0),
+		Mode:  scheduling.ModeSerial,
+		Waves: []plan.Wave{wave("wave-1", "")},
+	}
+	card := cost.RateCard{
+		Name:        "partner",
+		Version:     2,
+		Currency:    "EUR",
+		ValidFrom:   day(1),
+		HourlyRates: map[string]float64{"storage": 100, DefaultRole: 50},
+	}
+
+	now := day(5).Add(6 * time.Hour)
+	r, err := Build(context.Background(), []Entry{newEntry(t, "p-2", upcoming), newEntry(t, "p-1", running)}, &card, now)
+	if err != nil {
+		t.Fatalf("building report: %v", err)
+	}
+
+	if !r.GeneratedAt.Equal(now) || !r.Start.Equal(day(2)) || !r.End.After(upcoming.Start) {
+		t.Errorf("unexpected span %s - %s generated at %s", r.Start, r.End, r.GeneratedAt)
+	}
+	want := Totals{Plans: 2, Waves: 4, CompletedWaves: 1, VMsMigrated: 20, Rollbacks: 1, P1Incidents: 2, Effort: 4 * 36 * time.Hour}
+	if r.Totals != want {
+		t.Errorf("expected totals %+v, got %+v", want, r.Totals)
+	}
+
+	if len(r.Plans) != 2 || r.Plans[0].ID != "p-1" || r.Plans[1].ID != "p-2" {
+		t.Fatalf("expected the plans by start date, got %+v", r.Plans)
+	}
+	if r.Plans[0].Status != StatusInProgress || r.Plans[1].Status != StatusNotStarted {
+		t.Errorf("unexpected statuses %s and %s", r.Plans[0].Status, r.Plans[1].Status)
+	}
+
+	// wave-1 is behind the velocity target and wave-2 was due on the 5th without progress
+	if len(r.Risks) != 2 || r.Plans[0].Risks != 2 || r.Plans[1].Risks != 0 {
+		t.Fatalf("unexpected risks %+v", r.Risks)
+	}
+	if r.Risks[0].Kind != RiskKPIBreach || r.Risks[0].Plan != "datacenter-exit" {
+		t.Errorf("expected a KPI breach first, got %+v", r.Risks[0])
+	}
+	if r.Risks[1].Kind != RiskOverdue || r.Risks[1].Wave != "wave-2" {
+		t.Errorf("expected wave-2 to be overdue, got %+v", r.Risks[1])
+	}
+
+	// 24h of storage at 100 and 12h of cutover at 50 per wave
+	if r.Cost == nil || r.Cost.RateCard != "partner v2" || r.Cost.Currency != "EUR" {
+		t.Fatalf("unexpected cost %+v", r.Cost)
+	}
+	if *r.Plans[0].Cost != 3*3000 || r.Cost.Total != 9000+2*600+600 {
+		t.Errorf("unexpected costs %v and %v", *r.Plans[0].Cost, r.Cost.Total)
+	}
+	if len(r.Cost.Unpriced) != 0 {
+		t.Errorf("expected every phase priced, got %v", r.Cost.Unpriced)
+	}
+
+	// datacenter-exit is spent in March, branch-offices in September, nothing in between
+	if r.Cost.Granularity != cost.GranularityQuarter || len(r.Cost.CashFlow) != 3 {
+		t.Fatalf("expected three quarters, got %+v", r.Cost.CashFlow)
+	}
+	first, last := r.Cost.CashFlow[0], r.Cost.CashFlow[2]
+	if first.Period != "FY2026 Q1" || first.Plans["p-1"] != 9000 || first.Total != 9000 {
+		t.Errorf("unexpected first quarter %+v", first)
+	}
+	if r.Cost.CashFlow[1].Total != 0 {
+		t.Errorf("expected nothing spent in the second quarter, got %+v", r.Cost.CashFlow[1])
+	}
+	if last.Period != "FY2026 Q3" || last.Plans["p-2"] != 1800 || last.Cumulative != r.Cost.Total {
+		t.Errorf("unexpected last quarter %+v", last)
+	}
+}
+
+func TestBuildCashFlow(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)
+	p := plan.Plan{
+		Name:  "exit",
+		Start: start,
+		Mode:  scheduling.ModeSerial,
+		Waves: []plan.Wave{{Name: "wave-1", Steps: []plan.Step{{Phase: "Storage Migration", Effort: 96 * time.Hour}}}},
+	}
+	card := cost.RateCard{Name: "internal", Version: 1, Currency: "USD", ValidFrom: start, HourlyRates: map[string]float64{DefaultRole: 10}}
+	cal, err := cost.NewFiscalCalendar(time.April)
+	if err != nil {
+		t.Fatalf("creating calendar: %v", err)
+	}
+
+	// the four days of storage migration straddle the start of the fiscal year
+	r, err := Build(context.Background(), []Entry{newEntry(t, "p-1", p)}, &card, start, WithFiscalCalendar(cal))
+	if err != nil {
+		t.Fatalf("building report: %v", err)
+	}
+	if len(r.Cost.CashFlow) != 2 {
+		t.Fatalf("expected two quarters, got %+v", r.Cost.CashFlow)
+	}
+	if q := r.Cost.CashFlow[0]; q.Period != "FY2026 Q4" || q.Total != 480 {
+		t.Errorf("unexpected last quarter of FY2026 %+v", q)
+	}
+	if q := r.Cost.CashFlow[1]; q.Period != "FY2027 Q1" || q.Total != 480 || q.Cumulative != 960 {
+		t.Errorf("unexpected first quarter of FY2027 %+v", q)
+	}
+
+	r, err = Build(context.Background(), []Entry{newEntry(t, "p-1", p)}, &card, start, WithGranularity(cost.GranularityMonth))
+	if err != nil {
+		t.Fatalf("building report: %v", err)
+	}
+	if len(r.Cost.CashFlow) != 2 || r.Cost.CashFlow[0].Period != "2026-03" || r.Cost.CashFlow[1].Period != "2026-04" {
+		t.Errorf("expected March and April, got %+v", r.Cost.CashFlow)
+	}
+
+	if _, err := Build(context.Background(), []Entry{newEntry(t, "p-1", p)}, &card, start, WithGranularity("week")); err == nil {
+		t.Error("expected an error for an unknown granularity")
+	}
+}
+
+func TestBuildConvertsToPlanCurrency(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	asOf := time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC)
+	p := plan.Plan{
+		Name:     "exit",
+		Start:    start,
+		Mode:     scheduling.ModeSerial,
+		Currency: "USD",
+		Waves:    []plan.Wave{{Name: "wave-1", Steps: []plan.Step{{Phase: "Cutover", Effort: 10 * time.Hour}}}},
+	}
+	card := cost.RateCard{Name: "partner", Version: 1, Currency: "EUR", ValidFrom: start, HourlyRates: map[string]float64{DefaultRole: 100}}
+	rates, err := cost.NewRates(cost.WithRate("EUR", "USD", 1.25, asOf))
+	if err != nil {
+		t.Fatalf("creating rates: %v", err)
+	}
+	e := newEntry(t, "p-1", p)
+	e.Rates = rates
+
+	r, err := Build(context.Background(), []Entry{e}, &card, start)
+	if err != nil {
+		t.Fatalf("building report: %v", err)
+	}
+	if r.Cost.Currency != "USD" || r.Cost.Total != 1250 || r.Cost.CashFlow[0].Total != 1250 {
+		t.Errorf("expected 1000 EUR converted to 1250 USD, got %+v", r.Cost)
+	}
+	if status := r.Plans[0]; *status.Cost != 1250 || status.Currency != "USD" || len(status.ExchangeRates) != 1 {
+		t.Errorf("unexpected plan cost %+v", status)
+	}
+	if len(r.Cost.ExchangeRates) != 1 || !r.Cost.ExchangeRates[0].AsOf.Equal(asOf) || r.Cost.ExchangeRates[0].Source != cost.RateSourceManual {
+		t.Errorf("expected the rate of the plan reported, got %+v", r.Cost.ExchangeRates)
+	}
+
+	// the plan is in USD but the other one in the EUR of the card
+	other := p
+	other.Currency = ""
+	if _, err := Build(context.Background(), []Entry{e, newEntry(t, "p-2", other)}, &card, start); err == nil {
+		t.Error("expected an error for plans displayed in different currencies")
+	}
+	// a plan without rate to convert the card
+	if _, err := Build(context.Background(), []Entry{newEntry(t, "p-1", p)}, &card, start); err == nil {
+		t.Error("expected an error without exchange rate")
+	}
+}
+
+func TestBuildUnpriced(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	p := plan.Plan{
+		Name:  "exit",
+		Start: start,
+		Mode:  scheduling.ModeSerial,
+		Waves: []plan.Wave{{Name: "wave-1", Steps: []plan.Step{
+			{Phase: "Storage Migration", Effort: 10 * time.Hour, Pool: "storage"},
+			{Phase: "Cutover", Effort: 2 * time.Hour},
+		}}},
+	}
+	card := cost.RateCard{Name: "internal", Version: 1, Currency: "USD", ValidFrom: start, HourlyRates: map[string]float64{DefaultRole: 80}}
+
+	r, err := Build(context.Background(), []Entry{newEntry(t, "p-1", p)}, &card, start)
+	if err != nil {
+		t.Fatalf("building report: %v", err)
+	}
+
+	if r.Cost.Total != 160 {
+		t.Errorf("expected the cutover priced alone, got %v", r.Cost.Total)
+	}
+	if len(r.Cost.Unpriced) != 1 || !strings.Contains(r.Cost.Unpriced[0], `no rate for role "storage"`) {
+		t.Errorf("expected the storage migration unpriced, got %v", r.Cost.Unpriced)
+	}
+
+	if r, err = Build(context.Background(), []Entry{newEntry(t, "p-1", p)}, nil, start); err != nil || r.Cost != nil || r.Plans[0].Cost != nil {
+		t.Errorf("expected no cost without rate card, got %+v", r.Cost)
+	}
+}
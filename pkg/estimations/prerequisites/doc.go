@@ -0,0 +1,7 @@
+// Package prerequisites checks the source environment meets the requirements of the migration
+// toolkit before the first wave: vCenter and ESXi versions, CBT on the VMs migrated warm and
+// up-to-date VMware Tools.
+//
+// The findings are reported as assessment concerns and counted into the params of the
+// SourceRemediation calculator, which sizes the prerequisite work.
+package prerequisites
@@ -0,0 +1,181 @@
+package prerequisites
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Minimum versions supported by the migration toolkit.
+var (
+	DefaultMinVCenterVersion = MustParseVersion("6.5")
+	DefaultMinESXiVersion    = MustParseVersion("6.5")
+)
+
+// Kind identifies a prerequisite.
+type Kind string
+
+const (
+	// KindVCenterVersion is a vCenter below the minimum supported version.
+	KindVCenterVersion Kind = "vcenter-version"
+	// KindESXiVersion is an ESXi host below the minimum supported version.
+	KindESXiVersion Kind = "esxi-version"
+	// KindCBTDisabled is a VM targeted for warm migration without Changed Block Tracking.
+	KindCBTDisabled Kind = "cbt-disabled"
+	// KindToolsOutdated is a VM with VMware Tools missing or out of date.
+	KindToolsOutdated Kind = "tools-outdated"
+)
+
+// IDs of the concerns of the prerequisites.
+const (
+	ConcernVCenterVersion = "prerequisite." + string(KindVCenterVersion)
+	ConcernESXiVersion    = "prerequisite." + string(KindESXiVersion)
+	ConcernCBTDisabled    = "prerequisite." + string(KindCBTDisabled)
+	ConcernToolsOutdated  = "prerequisite." + string(KindToolsOutdated)
+)
+
+// Finding is a prerequisite the source environment does not meet.
+type Finding struct {
+	Kind Kind
+	// Subject is the vCenter, host or VM the finding is about.
+	Subject string
+	Message string
+}
+
+// Concern returns the finding as an assessment concern. None of the findings blocks the migration:
+// they are work to do before it.
+func (f Finding) Concern() inventory.Concern {
+	labels := map[Kind]string{
+		KindVCenterVersion: "vCenter version not supported",
+		KindESXiVersion:    "ESXi version not supported",
+		KindCBTDisabled:    "Changed Block Tracking disabled",
+		KindToolsOutdated:  "VMware Tools out of date",
+	}
+	return inventory.Concern{
+		ID:         "prerequisite." + string(f.Kind),
+		Label:      labels[f.Kind],
+		Category:   inventory.ConcernCategoryWarning,
+		Assessment: f.Message,
+	}
+}
+
+// Source is the part of the source environment the prerequisites apply to.
+type Source struct {
+	// VCenterVersion is empty when unknown.
+	VCenterVersion string
+	Hosts          []inventory.Host
+	// Assignments are the VMs to migrate with their method; CBT is only required for warm migration.
+	Assignments []method.Assignment
+}
+
+// Checker checks a Source against the minimum supported versions.
+type Checker struct {
+	minVCenterVersion Version
+	minESXiVersion    Version
+}
+
+// CheckerOption is a functional option for configuring a Checker.
+type CheckerOption func(*Checker)
+
+// WithMinVCenterVersion sets the oldest supported vCenter version.
+func WithMinVCenterVersion(v Version) CheckerOption {
+	return func(c *Checker) {
+		c.minVCenterVersion = v
+	}
+}
+
+// WithMinESXiVersion sets the oldest supported ESXi version.
+func WithMinESXiVersion(v Version) CheckerOption {
+	return func(c *Checker) {
+		c.minESXiVersion = v
+	}
+}
+
+// NewChecker creates a Checker with default settings that can be overridden by options.
+func NewChecker(opts ...CheckerOption) *Checker {
+	c := Checker{
+		minVCenterVersion: DefaultMinVCenterVersion,
+		minESXiVersion:    DefaultMinESXiVersion,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// Check returns the prerequisites src does not meet. Unknown or unreadable versions and Tools
+// states are not reported: only what is known to need work is.
+func (c *Checker) Check(src Source) []Finding {
+	var findings []Finding
+
+	if v, err := ParseVersion(src.VCenterVersion); err == nil && v.Less(c.minVCenterVersion) {
+		findings = append(findings, Finding{
+			Kind:    KindVCenterVersion,
+			Subject: "vCenter",
+			Message: fmt.Sprintf("vCenter %s is older than the minimum supported version %s", v, c.minVCenterVersion),
+		})
+	}
+
+	for _, h := range src.Hosts {
+		if v, err := ParseVersion(h.Version); err == nil && v.Less(c.minESXiVersion) {
+			findings = append(findings, Finding{
+				Kind:    KindESXiVersion,
+				Subject: h.ID,
+				Message: fmt.Sprintf("ESXi %s is older than the minimum supported version %s", v, c.minESXiVersion),
+			})
+		}
+	}
+
+	for _, a := range src.Assignments {
+		vm := a.Candidate.VM
+		if a.Method == method.Warm && !vm.ChangeTrackingEnabled {
+			findings = append(findings, Finding{
+				Kind:    KindCBTDisabled,
+				Subject: vm.Name,
+				Message: "warm migration requires Changed Block Tracking on the VM and its disks",
+			})
+		}
+		if a.Method != method.Cold && a.Method != method.Warm {
+			continue
+		}
+		switch vm.ToolsStatus {
+		case inventory.ToolsStatusOld, inventory.ToolsStatusNotInstalled:
+			findings = append(findings, Finding{
+				Kind:    KindToolsOutdated,
+				Subject: vm.Name,
+				Message: fmt.Sprintf("VMware Tools are %s: guest information and quiesced snapshots are unreliable", vm.ToolsStatus),
+			})
+		}
+	}
+
+	return findings
+}
+
+// Params counts the findings into the params of the SourceRemediation calculator.
+func Params(findings []Finding) []estimation.Param {
+	return ConcernParams(findings, nil)
+}
+
+// ConcernParams is Params for an assessment whose VMs are aggregated away: the findings are those of
+// its vCenter and hosts, and vms the VM counts of its concerns, keyed by concern ID.
+func ConcernParams(findings []Finding, vms map[string]int) []estimation.Param {
+	counts := maps.Clone(vms)
+	if counts == nil {
+		counts = make(map[string]int, 4)
+	}
+	for _, f := range findings {
+		counts[f.Concern().ID]++
+	}
+	return []estimation.Param{
+		{Key: calculators.ParamVCenterUpgrades, Value: counts[ConcernVCenterVersion]},
+		{Key: calculators.ParamESXiHostUpgrades, Value: counts[ConcernESXiVersion]},
+		{Key: calculators.ParamCBTEnablements, Value: counts[ConcernCBTDisabled]},
+		{Key: calculators.ParamToolsUpgrades, Value: counts[ConcernToolsOutdated]},
+	}
+}
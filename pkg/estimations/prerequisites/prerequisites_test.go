@@ -0,0 +1,110 @@
+package prerequisites
+
+import (
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/method"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in   string
+		want Version
+	}{
+		{in: "7.0.3", want: Version{Major: 7, Minor: 0, Patch: 3}},
+		{in: "6.7", want: Version{Major: 6, Minor: 7}},
+		{in: "VMware ESXi 8.0.2 build-22380479", want: Version{Major: 8, Minor: 0, Patch: 2}},
+	}
+
+	for _, tc := range cases {
+		got, err := ParseVersion(tc.in)
+		if err != nil {
+			t.Fatalf("expected no error for %q, got: %v", tc.in, err)
+		}
+		if got != tc.want {
+			t.Errorf("expected %v for %q, got %v", tc.want, tc.in, got)
+		}
+	}
+
+	if _, err := ParseVersion("unknown"); err == nil {
+		t.Errorf("expected error for a string without version, got nil")
+	}
+}
+
+func TestVersion_Less(t *testing.T) {
+	t.Parallel()
+	if !MustParseVersion("6.7.3").Less(MustParseVersion("7.0")) {
+		t.Errorf("expected 6.7.3 to be older than 7.0")
+	}
+	if MustParseVersion("7.0.1").Less(MustParseVersion("7.0.1")) {
+		t.Errorf("expected a version not to be older than itself")
+	}
+}
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+	assign := func(name string, m method.Method, cbt bool, tools string) method.Assignment {
+		return method.Assignment{
+			Candidate: method.Candidate{VM: inventory.VM{Name: name, ChangeTrackingEnabled: cbt, ToolsStatus: tools}},
+			Method:    m,
+		}
+	}
+	src := Source{
+		VCenterVersion: "6.0.0",
+		Hosts: []inventory.Host{
+			{ID: "host-1", Version: "6.0.0"},
+			{ID: "host-2", Version: "7.0.3"},
+			{ID: "host-3"},
+		},
+		Assignments: []method.Assignment{
+			assign("db01", method.Warm, false, inventory.ToolsStatusOK),
+			assign("web01", method.Cold, false, inventory.ToolsStatusOld),
+			assign("app01", method.Warm, true, ""),
+			// retired VMs need no remediation
+			assign("old01", method.Retire, false, inventory.ToolsStatusNotInstalled),
+		},
+	}
+
+	findings := NewChecker().Check(src)
+
+	got := make(map[Kind][]string)
+	for _, f := range findings {
+		got[f.Kind] = append(got[f.Kind], f.Subject)
+	}
+	want := map[Kind][]string{
+		KindVCenterVersion: {"vCenter"},
+		KindESXiVersion:    {"host-1"},
+		KindCBTDisabled:    {"db01"},
+		KindToolsOutdated:  {"web01"},
+	}
+	for kind, subjects := range want {
+		if len(got[kind]) != len(subjects) || got[kind][0] != subjects[0] {
+			t.Errorf("expected %s findings for %v, got %v", kind, subjects, got[kind])
+		}
+	}
+
+	params := make(map[string]any)
+	for _, p := range Params(findings) {
+		params[p.Key] = p.Value
+	}
+	if params[calculators.ParamESXiHostUpgrades] != 1 || params[calculators.ParamCBTEnablements] != 1 {
+		t.Errorf("unexpected remediation params %v", params)
+	}
+
+	c := findings[0].Concern()
+	if c.Category != inventory.ConcernCategoryWarning || c.ID != "prerequisite.vcenter-version" {
+		t.Errorf("expected a warning concern, got %+v", c)
+	}
+}
+
+func TestChecker_Check_MinVersions(t *testing.T) {
+	t.Parallel()
+	checker := NewChecker(WithMinVCenterVersion(MustParseVersion("8.0")), WithMinESXiVersion(MustParseVersion("8.0")))
+	findings := checker.Check(Source{VCenterVersion: "7.0.3", Hosts: []inventory.Host{{ID: "host-1", Version: "7.0.3"}}})
+	if len(findings) != 2 {
+		t.Errorf("expected vCenter and host findings, got %+v", findings)
+	}
+}
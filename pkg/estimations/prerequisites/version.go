@@ -0,0 +1,62 @@
+package prerequisites
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var versionPattern = regexp.MustCompile(`(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+
+// Version is a vSphere product version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseVersion reads the first version number of s, so both "7.0.3" and the full product name
+// "VMware ESXi 7.0.3 build-20036589" are accepted. Missing minor and patch numbers are zero.
+func ParseVersion(s string) (Version, error) {
+	m := versionPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, fmt.Errorf("no version in %q", s)
+	}
+
+	var v Version
+	for i, dst := range []*int{&v.Major, &v.Minor, &v.Patch} {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+		*dst = n
+	}
+	return v, nil
+}
+
+// MustParseVersion is like ParseVersion but panics on error. It is meant for constants.
+func MustParseVersion(s string) Version {
+	v, err := ParseVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Less reports whether v is older than o.
+func (v Version) Less(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major < o.Major
+	}
+	if v.Minor != o.Minor {
+		return v.Minor < o.Minor
+	}
+	return v.Patch < o.Patch
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
@@ -0,0 +1,9 @@
+// Package protection sizes the re-establishment of data protection on the target: the backup jobs
+// and the DR replication of the source stop at the VMware boundary, so every migrated VM is attached
+// to a backup policy and seeded with a full backup, and every application with a recovery point
+// objective has its replication to the DR site set up again.
+//
+// The applications declare their RPO, which puts them in a tier, and list their VMs, whose inventory
+// sizes drive the seeding and the initial replication. Params counts them into the params of the
+// BackupReestablishment and DRReplication calculators.
+package protection
@@ -0,0 +1,131 @@
+package protection
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// Tier is how an application is protected on the target, as required by its RPO.
+type Tier string
+
+const (
+	// TierContinuous replicates the writes to the DR site as they happen, for RPOs under MinScheduledRPO.
+	TierContinuous Tier = "continuous"
+	// TierScheduled replicates snapshots to the DR site on a schedule at least as frequent as the RPO.
+	TierScheduled Tier = "scheduled"
+	// TierBackup only relies on the daily backups, for RPOs of a day or more and undeclared ones.
+	TierBackup Tier = "backup"
+
+	// MinScheduledRPO is the shortest RPO replicating snapshots on a schedule can meet.
+	MinScheduledRPO = time.Hour
+	// MinBackupRPO is the shortest RPO the daily backups meet on their own.
+	MinBackupRPO = 24 * time.Hour
+)
+
+// TierOf returns the tier meeting the RPO. Zero means no objective was declared.
+func TierOf(rpo time.Duration) Tier {
+	switch {
+	case rpo <= 0 || rpo >= MinBackupRPO:
+		return TierBackup
+	case rpo >= MinScheduledRPO:
+		return TierScheduled
+	default:
+		return TierContinuous
+	}
+}
+
+// Application is a business service migrated to the target, with its VMs and recovery point objective.
+type Application struct {
+	Name string
+	// RPO is the largest window of data loss the application tolerates. Zero means no objective was declared.
+	RPO time.Duration
+	VMs []inventory.VM
+}
+
+// Tier returns the tier meeting the RPO of the application.
+func (a Application) Tier() Tier {
+	return TierOf(a.RPO)
+}
+
+// DiskGB returns the disk size of the VMs of the application.
+func (a Application) DiskGB() float64 {
+	var gb float64
+	for _, vm := range a.VMs {
+		gb += vm.DiskGB
+	}
+	return gb
+}
+
+// Params counts the applications into the params of the BackupReestablishment and DRReplication
+// calculators: every VM is backed up, while only the applications of the replicated tiers are
+// replicated. A VM listed by several applications is only counted once.
+func Params(apps []Application) ([]estimation.Param, error) {
+	seen := make(map[string]bool)
+	vmCount := 0
+	var totalGB float64
+	tierApps := make(map[Tier]int, 3)
+	tierGB := make(map[Tier]float64, 3)
+	for _, a := range apps {
+		if a.RPO < 0 {
+			return nil, fmt.Errorf("application %s has a negative RPO", a.Name)
+		}
+		for _, vm := range a.VMs {
+			if vm.DiskGB < 0 {
+				return nil, fmt.Errorf("VM %s of application %s has a negative disk size", vm.Name, a.Name)
+			}
+			if vm.ID != "" && seen[vm.ID] {
+				continue
+			}
+			seen[vm.ID] = true
+			vmCount++
+			totalGB += vm.DiskGB
+		}
+		tier := a.Tier()
+		tierApps[tier]++
+		tierGB[tier] += a.DiskGB()
+	}
+	return []estimation.Param{
+		{Key: calculators.ParamVMCount, Value: vmCount},
+		{Key: calculators.ParamTotalDiskGB, Value: totalGB},
+		{Key: calculators.ParamDRContinuousApps, Value: tierApps[TierContinuous]},
+		{Key: calculators.ParamDRContinuousDiskGB, Value: tierGB[TierContinuous]},
+		{Key: calculators.ParamDRScheduledApps, Value: tierApps[TierScheduled]},
+		{Key: calculators.ParamDRScheduledDiskGB, Value: tierGB[TierScheduled]},
+	}, nil
+}
+
+// DefaultCalculators returns the calculators of the data protection track.
+func DefaultCalculators() []estimation.Calculator {
+	return []estimation.Calculator{
+		calculators.NewBackupReestablishment(),
+		calculators.NewDRReplication(),
+	}
+}
+
+// Estimate runs the calculators of the data protection track on the applications. extra params,
+// e.g. the number of engineers, are passed to the calculators.
+func Estimate(apps []Application, calcs []estimation.Calculator, extra ...estimation.Param) (map[string]estimation.Estimation, error) {
+	params, err := Params(apps)
+	if err != nil {
+		return nil, err
+	}
+	params = append(params, extra...)
+	paramMap := make(map[string]estimation.Param, len(params))
+	for _, p := range params {
+		paramMap[p.Key] = p
+	}
+
+	res := make(map[string]estimation.Estimation, len(calcs))
+	for _, calc := range calcs {
+		est, err := calc.Calculate(paramMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate %s: %w", calc.Name(), err)
+		}
+		res[calc.Name()] = est
+	}
+	return res, nil
+}
@@ -0,0 +1,96 @@
+package protection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func TestTierOf(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		rpo  time.Duration
+		want Tier
+	}{
+		{0, TierBackup},
+		{5 * time.Minute, TierContinuous},
+		{time.Hour, TierScheduled},
+		{4 * time.Hour, TierScheduled},
+		{24 * time.Hour, TierBackup},
+	}
+	for _, tc := range cases {
+		if got := TierOf(tc.rpo); got != tc.want {
+			t.Errorf("RPO %v: expected tier %s, got %s", tc.rpo, tc.want, got)
+		}
+	}
+}
+
+func TestParams(t *testing.T) {
+	t.Parallel()
+	shared := inventory.VM{ID: "vm-1", Name: "db", DiskGB: 500}
+	apps := []Application{
+		{Name: "ledger", RPO: 5 * time.Minute, VMs: []inventory.VM{shared, {ID: "vm-2", DiskGB: 100}}},
+		{Name: "crm", RPO: 4 * time.Hour, VMs: []inventory.VM{shared}},
+		{Name: "intranet", VMs: []inventory.VM{{ID: "vm-3", DiskGB: 50}}},
+	}
+
+	params, err := Params(apps)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	got := make(map[string]any, len(params))
+	for _, p := range params {
+		got[p.Key] = p.Value
+	}
+	want := map[string]any{
+		// the shared VM is backed up once
+		calculators.ParamVMCount:     3,
+		calculators.ParamTotalDiskGB: 650.0,
+		// but replicated with each application
+		calculators.ParamDRContinuousApps:   1,
+		calculators.ParamDRContinuousDiskGB: 600.0,
+		calculators.ParamDRScheduledApps:    1,
+		calculators.ParamDRScheduledDiskGB:  500.0,
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("%s: expected %v, got %v", key, value, got[key])
+		}
+	}
+
+	if _, err := Params([]Application{{Name: "broken", RPO: -time.Minute}}); err == nil {
+		t.Error("expected error for a negative RPO")
+	}
+	if _, err := Params([]Application{{Name: "broken", VMs: []inventory.VM{{DiskGB: -1}}}}); err == nil {
+		t.Error("expected error for a negative disk size")
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	t.Parallel()
+	apps := []Application{
+		{Name: "ledger", RPO: 15 * time.Minute, VMs: []inventory.VM{{ID: "vm-1", DiskGB: 1000}}},
+		{Name: "intranet", VMs: []inventory.VM{{ID: "vm-2", DiskGB: 1000}}},
+	}
+
+	results, err := Estimate(apps, DefaultCalculators())
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	backup, ok := results["Backup Re-establishment"]
+	if !ok || backup.Duration != time.Hour {
+		t.Errorf("expected 2 VMs reattached in 1h, got %+v", backup)
+	}
+	dr, ok := results["DR Replication"]
+	if !ok || dr.Duration != time.Duration(calculators.DefaultContinuousReplicationSetupHours*float64(time.Hour)) {
+		t.Errorf("expected the continuous replication of the ledger only, got %+v", dr)
+	}
+
+	zeroEngineers := estimation.Param{Key: calculators.ParamDREngineers, Value: 0}
+	if _, err := Estimate(apps, DefaultCalculators(), zeroEngineers); err == nil {
+		t.Error("expected error when a calculator fails")
+	}
+}
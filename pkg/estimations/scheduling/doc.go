@@ -0,0 +1,26 @@
+// Package scheduling places migration waves on the calendar.
+//
+// The estimation engine answers "how long does this take"; the Scheduler answers
+// "when can it happen" by fitting each wave into a maintenance Window while honoring
+// the recovery objectives (RTO/RPO) declared by the applications in the wave.
+// Placements that would violate a declared objective are rejected with a
+// PlacementError explaining which application is affected and why.
+//
+// A Pipeline lays out the phases of consecutive waves on a Timeline, either serially,
+// fully in parallel, or pipelined: selected phase pairs may overlap across waves
+// (e.g. pre-copying wave N+1 while wave N is validated) as long as the shared
+// resource pools have enough capacity. The capacity of a pool may change over the program,
+// e.g. engineers leaving or contractors joining, see WithCapacityChange. ProgramWave builds the phases of a program,
+// from storage migration to the source decommission, out of calculator results.
+//
+// Stored plans (see package plan) are laid out with a Pipeline and rendered by package gantt.
+//
+// Effort is working time; manual phases are stretched to calendar time using the work hours
+// per day, while lead times (notice periods, procurement) are already calendar time. Manual phases
+// of a pool with a WorkCalendar (see package calendar) do not progress on its days off.
+//
+// Pools may also be worked in Shifts, e.g. three teams in different time zones running a
+// follow-the-sun factory around the clock on weekdays: manual phases of the pool only progress while a
+// shift is on duty, the work is split across the shifts on the timeline, and WeeklyGaps shows the
+// periods of the working week no shift covers.
+package scheduling
@@ -0,0 +1,88 @@
+package scheduling
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// Names of the program phases known to the planner.
+const (
+	PhaseSourceRemediation  = "Source Remediation"
+	PhaseStorageMigration   = "Storage Migration"
+	PhasePostMigration      = "Post-Migration Checks"
+	PhaseSourceDecommission = "Source Decommission"
+)
+
+// programPhases lists the known phases in execution order. Manual phases are carried out by
+// engineers within their working hours; the others (data transfer) run round the clock.
+var programPhases = []struct {
+	name   string
+	manual bool
+}{
+	{name: PhaseSourceRemediation, manual: true},
+	{name: PhaseStorageMigration},
+	{name: PhasePostMigration, manual: true},
+	{name: PhaseSourceDecommission, manual: true},
+}
+
+// Phase is a stage of the migration program.
+// Effort is working time; LeadTime is calendar time that has to pass regardless of effort
+// (procurement, notice periods). Both run concurrently.
+type Phase struct {
+	Name     string
+	Effort   time.Duration
+	LeadTime time.Duration
+	// WorkHoursPerDay is the number of hours per calendar day spent on the effort.
+	// Zero means round-the-clock work.
+	WorkHoursPerDay float64
+}
+
+// PhaseFromEstimation builds a Phase from a calculator result whose effort is carried out
+// workHoursPerDay hours a day (zero for round-the-clock work).
+func PhaseFromEstimation(name string, est estimation.Estimation, workHoursPerDay float64) Phase {
+	return Phase{Name: name, Effort: est.Duration, LeadTime: est.LeadTime, WorkHoursPerDay: workHoursPerDay}
+}
+
+// Span returns the calendar time during which the effort is carried out, ignoring lead time.
+func (p Phase) Span() time.Duration {
+	return estimation.CalendarTime(p.Effort, p.WorkHoursPerDay)
+}
+
+// Elapsed returns the calendar time of the phase: the longer of the effort span and the lead time.
+func (p Phase) Elapsed() time.Duration {
+	return estimation.Estimation{Duration: p.Effort, LeadTime: p.LeadTime}.Elapsed(p.WorkHoursPerDay)
+}
+
+// ProgramWave turns calculator results, keyed by calculator name, into the ordered steps of one wave:
+// source remediation, storage migration, post-migration checks and source decommission, followed by any other result in
+// name order. Manual phases are stretched over workHoursPerDay hours per calendar day.
+func ProgramWave(wave string, results map[string]estimation.Estimation, workHoursPerDay float64) WavePlan {
+	plan := WavePlan{Wave: wave}
+	known := make(map[string]bool, len(programPhases))
+	for _, pp := range programPhases {
+		known[pp.name] = true
+		est, ok := results[pp.name]
+		if !ok {
+			continue
+		}
+		hours := 0.0
+		if pp.manual {
+			hours = workHoursPerDay
+		}
+		plan.Steps = append(plan.Steps, Step{Phase: PhaseFromEstimation(pp.name, est, hours)})
+	}
+
+	var others []string
+	for name := range results {
+		if !known[name] {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+	for _, name := range others {
+		plan.Steps = append(plan.Steps, Step{Phase: PhaseFromEstimation(name, results[name], workHoursPerDay)})
+	}
+	return plan
+}
@@ -0,0 +1,77 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const day = 24 * time.Hour
+
+func TestPhaseFromEstimation_Elapsed(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		est             estimation.Estimation
+		workHoursPerDay float64
+		want            time.Duration
+	}{
+		{name: "round-the-clock effort", est: estimation.Estimation{Duration: 16 * time.Hour}, want: 16 * time.Hour},
+		{name: "effort within working hours", est: estimation.Estimation{Duration: 48 * time.Hour}, workHoursPerDay: 8, want: 6 * day},
+		{name: "lead time dominates", est: estimation.Estimation{Duration: 16 * time.Hour, LeadTime: 90 * day}, workHoursPerDay: 8, want: 90 * day},
+		{name: "effort dominates", est: estimation.Estimation{Duration: 48 * time.Hour, LeadTime: day}, workHoursPerDay: 8, want: 6 * day},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := PhaseFromEstimation(PhaseSourceDecommission, tc.est, tc.workHoursPerDay)
+			if p.Effort != tc.est.Duration || p.LeadTime != tc.est.LeadTime {
+				t.Errorf("phase does not carry estimation values: %+v", p)
+			}
+			if got := p.Elapsed(); got != tc.want {
+				t.Errorf("expected elapsed %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestProgramWave_OrdersPhasesAndIncludesSourceDecommission(t *testing.T) {
+	t.Parallel()
+	results := map[string]estimation.Estimation{
+		PhaseSourceDecommission: {Duration: 16 * time.Hour, LeadTime: 90 * day},
+		"Custom":                {Duration: time.Hour},
+		PhasePostMigration:      {Duration: 16 * time.Hour},
+		PhaseStorageMigration:   {Duration: 10 * time.Hour},
+		PhaseSourceRemediation:  {Duration: 8 * time.Hour},
+	}
+
+	plan := ProgramWave("program", results, 8)
+	want := []string{PhaseSourceRemediation, PhaseStorageMigration, PhasePostMigration, PhaseSourceDecommission, "Custom"}
+	if len(plan.Steps) != len(want) {
+		t.Fatalf("expected %d steps, got %d", len(want), len(plan.Steps))
+	}
+	for i, name := range want {
+		if plan.Steps[i].Phase.Name != name {
+			t.Errorf("step %d: expected %s, got %s", i, name, plan.Steps[i].Phase.Name)
+		}
+	}
+	// data transfer runs round the clock, manual work does not
+	if got := plan.Steps[1].Phase.Elapsed(); got != 10*time.Hour {
+		t.Errorf("expected storage migration to take 10h, got %v", got)
+	}
+	if got := plan.Steps[2].Phase.Elapsed(); got != 2*day {
+		t.Errorf("expected post-migration checks to take 2 days, got %v", got)
+	}
+
+	tl, err := NewPipeline(ModeSerial).Layout([]WavePlan{plan})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 1 day of remediation + 10h + 2 days + 90 days notice + 3h custom step
+	if want := day + 10*time.Hour + 2*day + 90*day + 3*time.Hour; tl.Total != want {
+		t.Errorf("expected total %v, got %v", want, tl.Total)
+	}
+}
@@ -0,0 +1,424 @@
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// maxDuration is an offset after every capacity change.
+	maxDuration = time.Duration(math.MaxInt64)
+	// maxIdleDays is the longest run of days off a pool calendar may have.
+	maxIdleDays = 366
+)
+
+// Mode is how the phases of consecutive waves are laid out on the timeline.
+type Mode string
+
+const (
+	// ModeSerial starts a wave only after the previous wave finished all its phases.
+	ModeSerial Mode = "serial"
+	// ModeParallel starts all waves at the same time.
+	ModeParallel Mode = "parallel"
+	// ModePipelined behaves like ModeSerial except for the declared Overlap pairs.
+	ModePipelined Mode = "pipelined"
+)
+
+// Overlap allows phase Next of wave N+1 to start as soon as wave N starts phase Current,
+// e.g. pre-copying the next wave while the current one is being validated.
+type Overlap struct {
+	Current string
+	Next    string
+}
+
+// Step is a phase of a wave. While it runs it consumes Units of the named resource pool.
+type Step struct {
+	Phase Phase
+	Pool  string
+	Units int
+}
+
+// WavePlan is the ordered list of steps of one wave. Steps of a wave always run one after the other.
+type WavePlan struct {
+	Wave  string
+	Steps []Step
+	// NotBefore is the earliest offset from the program start the wave may start at, e.g. once the
+	// target it lands on is built.
+	NotBefore time.Duration
+}
+
+// BarID identifies a phase of a wave on the timeline.
+type BarID struct {
+	Wave  string
+	Phase string
+}
+
+// Bar is a step placed on the timeline. Start and End are offsets from the program start.
+// The pool units are held from Start until Released, the end of the effort; a phase waiting
+// on lead time (e.g. a license notice period) ends later without holding anyone.
+type Bar struct {
+	BarID
+	Start     time.Duration
+	End       time.Duration
+	Released  time.Duration
+	Pool      string
+	Units     int
+	DependsOn []BarID
+	// Shifts is the effort carried out by each shift of the pool, when the pool works in shifts.
+	Shifts []ShiftWork
+}
+
+// Timeline is the result of laying out wave plans.
+type Timeline struct {
+	Bars  []Bar
+	Total time.Duration
+}
+
+// CapacityChange changes the capacity of a resource pool by Delta units from At on, e.g. two
+// engineers leaving (-2) or three contractors joining (+3). At is an offset from the program start.
+type CapacityChange struct {
+	Pool  string
+	At    time.Duration
+	Delta int
+}
+
+// WorkCalendar tells the working days of the team behind a resource pool.
+type WorkCalendar interface {
+	IsWorkingDay(t time.Time) bool
+}
+
+// Pipeline lays out wave plans on a timeline according to a Mode, allowed overlaps and resource pools.
+type Pipeline struct {
+	mode      Mode
+	overlaps  map[string]string // next phase -> current phase
+	pools     map[string]int
+	changes   map[string][]CapacityChange
+	start     time.Time
+	calendars map[string]WorkCalendar
+	shifts    map[string]Shifts
+}
+
+// PipelineOption is a functional option for configuring a Pipeline.
+type PipelineOption func(*Pipeline)
+
+// WithOverlap allows phase next of wave N+1 to run while phase current of wave N runs.
+// Only used in ModePipelined.
+func WithOverlap(current, next string) PipelineOption {
+	return func(p *Pipeline) {
+		p.overlaps[next] = current
+	}
+}
+
+// WithResourcePool declares the capacity of a resource pool. Steps using a pool without a
+// declared capacity are not constrained.
+func WithResourcePool(name string, capacity int) PipelineOption {
+	return func(p *Pipeline) {
+		p.pools[name] = capacity
+	}
+}
+
+// WithCapacityChange changes the capacity of a declared resource pool from an offset of the program
+// start on. Changes to the same pool add up; units held by a running step are not taken back when
+// the capacity drops, later steps wait until the pool fits them again.
+func WithCapacityChange(c CapacityChange) PipelineOption {
+	return func(p *Pipeline) {
+		p.changes[c.Pool] = append(p.changes[c.Pool], c)
+	}
+}
+
+// WithProgramStart anchors the timeline on the calendar. It is required by pool calendars.
+func WithProgramStart(start time.Time) PipelineOption {
+	return func(p *Pipeline) {
+		p.start = start
+	}
+}
+
+// WithPoolCalendar sets the working calendar of the team behind a resource pool: the manual steps
+// of the pool only progress on its working days, so a phase running over a holiday ends later.
+// Round-the-clock steps, such as data transfers, are not affected.
+func WithPoolCalendar(pool string, c WorkCalendar) PipelineOption {
+	return func(p *Pipeline) {
+		p.calendars[pool] = c
+	}
+}
+
+// WithPoolShifts sets the shifts of the teams behind a resource pool, e.g. three teams in different
+// time zones running a follow-the-sun factory: the manual steps of the pool only progress while a
+// shift is on duty, at the pace of one shift whatever the number of shifts on duty. Shifts take
+// precedence over the pool calendar; each shift follows its own calendar instead.
+func WithPoolShifts(pool string, shifts ...Shift) PipelineOption {
+	return func(p *Pipeline) {
+		p.shifts[pool] = shifts
+	}
+}
+
+// NewPipeline creates a Pipeline for the given mode.
+func NewPipeline(mode Mode, opts ...PipelineOption) *Pipeline {
+	p := Pipeline{
+		mode:      mode,
+		overlaps:  make(map[string]string),
+		pools:     make(map[string]int),
+		changes:   make(map[string][]CapacityChange),
+		calendars: make(map[string]WorkCalendar),
+		shifts:    make(map[string]Shifts),
+	}
+
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	return &p
+}
+
+// Layout places every step of every wave as early as the mode, overlaps, resource pools and the
+// earliest start of the wave allow.
+// A step that would exceed the capacity of its pool is delayed until enough units are released;
+// a step demanding more units than the pool will ever have for its duration is an error, as are an
+// unknown mode, negative units and capacity changes to undeclared pools or before the program start.
+func (p *Pipeline) Layout(waves []WavePlan) (Timeline, error) {
+	switch p.mode {
+	case ModeSerial, ModeParallel, ModePipelined:
+	default:
+		return Timeline{}, fmt.Errorf("unknown pipeline mode %q", p.mode)
+	}
+	if len(p.calendars) > 0 && p.start.IsZero() {
+		return Timeline{}, fmt.Errorf("pool calendars require the program start")
+	}
+	if len(p.shifts) > 0 && p.start.IsZero() {
+		return Timeline{}, fmt.Errorf("pool shifts require the program start")
+	}
+	for pool, changes := range p.changes {
+		if _, ok := p.pools[pool]; !ok {
+			return Timeline{}, fmt.Errorf("capacity change for undeclared pool %q", pool)
+		}
+		for _, c := range changes {
+			if c.At < 0 {
+				return Timeline{}, fmt.Errorf("capacity change of pool %q before the program start", pool)
+			}
+		}
+	}
+
+	var tl Timeline
+	var prev *waveBars
+
+	for _, w := range waves {
+		cur := &waveBars{starts: map[string]time.Duration{}}
+		var prevStep *BarID
+		stepEnd := w.NotBefore
+
+		for _, step := range w.Steps {
+			if step.Units < 0 {
+				return Timeline{}, fmt.Errorf("wave %q phase %q has negative units %d", w.Wave, step.Phase.Name, step.Units)
+			}
+
+			id := BarID{Wave: w.Wave, Phase: step.Phase.Name}
+			earliest := stepEnd
+			var deps []BarID
+			if prevStep != nil {
+				deps = append(deps, *prevStep)
+			}
+
+			if prev != nil {
+				after, dep := p.crossWaveConstraint(prev, step.Phase.Name)
+				if after > earliest {
+					earliest = after
+				}
+				if dep != nil {
+					deps = append(deps, *dep)
+				}
+			}
+
+			start, ok := p.fitPool(tl.Bars, step, earliest)
+			if !ok {
+				return Timeline{}, fmt.Errorf("wave %q phase %q needs %d units of pool %q which only has %d",
+					w.Wave, step.Phase.Name, step.Units, step.Pool, p.capacity(step.Pool, maxDuration))
+			}
+
+			span, shifts := p.span(step, start)
+			bar := Bar{
+				BarID:     id,
+				Start:     start,
+				End:       start + max(span, step.Phase.LeadTime),
+				Released:  start + span,
+				Pool:      step.Pool,
+				Units:     step.Units,
+				DependsOn: deps,
+				Shifts:    shifts,
+			}
+			tl.Bars = append(tl.Bars, bar)
+			cur.starts[id.Phase] = bar.Start
+			cur.last = id
+			if bar.End > cur.end {
+				cur.end = bar.End
+			}
+			if bar.End > tl.Total {
+				tl.Total = bar.End
+			}
+
+			stepEnd = bar.End
+			prevStep = &id
+		}
+		if len(w.Steps) > 0 {
+			prev = cur
+		}
+	}
+
+	return tl, nil
+}
+
+// waveBars keeps what the next wave needs to know about the previous one.
+type waveBars struct {
+	starts map[string]time.Duration
+	end    time.Duration
+	last   BarID
+}
+
+// crossWaveConstraint returns the earliest start allowed by the previous wave and the bar it depends on.
+func (p *Pipeline) crossWaveConstraint(prev *waveBars, phase string) (time.Duration, *BarID) {
+	switch p.mode {
+	case ModeParallel:
+		return 0, nil
+	case ModePipelined:
+		if current, ok := p.overlaps[phase]; ok {
+			if start, found := prev.starts[current]; found {
+				return start, &BarID{Wave: prev.last.Wave, Phase: current}
+			}
+		}
+	}
+	last := prev.last
+	return prev.end, &last
+}
+
+// fitPool returns the earliest start at or after earliest where the step fits in its resource pool
+// for its span, or false when the pool never has enough units for it.
+func (p *Pipeline) fitPool(bars []Bar, step Step, earliest time.Duration) (time.Duration, bool) {
+	if _, ok := p.pools[step.Pool]; !ok || step.Units == 0 {
+		return earliest, true
+	}
+
+	var inPool []Bar
+	for _, b := range bars {
+		if b.Pool == step.Pool && b.Units > 0 {
+			inPool = append(inPool, b)
+		}
+	}
+
+	// candidate starts: earliest and every moment units are released or the capacity changes after it
+	candidates := []time.Duration{earliest}
+	for _, b := range inPool {
+		if b.Released > earliest {
+			candidates = append(candidates, b.Released)
+		}
+	}
+	for _, c := range p.changes[step.Pool] {
+		if c.At > earliest {
+			candidates = append(candidates, c.At)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	for _, start := range candidates {
+		if p.fits(inPool, step, start, start+p.stepSpan(step, start)) {
+			return start, true
+		}
+	}
+	// after the last candidate the pool is empty and its capacity final: the step never fits
+	return 0, false
+}
+
+// fits reports whether the step units are available in its pool at every moment of [from, to).
+// Usage only grows when a bar starts and capacity only drops when it changes, so checking from and
+// those moments is enough.
+func (p *Pipeline) fits(bars []Bar, step Step, from, to time.Duration) bool {
+	points := []time.Duration{from}
+	for _, b := range bars {
+		if b.Start > from && b.Start < to {
+			points = append(points, b.Start)
+		}
+	}
+	for _, c := range p.changes[step.Pool] {
+		if c.At > from && c.At < to {
+			points = append(points, c.At)
+		}
+	}
+	for _, t := range points {
+		if usage(bars, t)+step.Units > p.capacity(step.Pool, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// span returns the calendar time the effort of a step started at an offset of the program start takes,
+// following the shifts of its pool or skipping the days off of its pool calendar, and the effort each
+// shift carries out.
+func (p *Pipeline) span(step Step, at time.Duration) (time.Duration, []ShiftWork) {
+	if shifts, ok := p.shifts[step.Pool]; ok && step.Phase.WorkHoursPerDay > 0 {
+		// shifts without anyone on duty would never end the step
+		if span, work, ok := shifts.Split(p.start.Add(at), step.Phase.Effort); ok {
+			return span, work
+		}
+		return step.Phase.Span(), nil
+	}
+	return p.calendarSpan(step, at), nil
+}
+
+// stepSpan returns the calendar time of a step started at an offset of the program start.
+func (p *Pipeline) stepSpan(step Step, at time.Duration) time.Duration {
+	span, _ := p.span(step, at)
+	return span
+}
+
+// calendarSpan returns the calendar time of a step skipping the days off of its pool calendar.
+func (p *Pipeline) calendarSpan(step Step, at time.Duration) time.Duration {
+	c, ok := p.calendars[step.Pool]
+	if !ok || step.Phase.WorkHoursPerDay <= 0 {
+		return step.Phase.Span()
+	}
+
+	// the effort is spread over working days the same way it is spread over every day without calendar
+	from := p.start.Add(at)
+	remaining := estimation.CalendarTime(step.Phase.Effort, step.Phase.WorkHoursPerDay)
+	t, idle := from, 0
+	for remaining > 0 {
+		midnight := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		if c.IsWorkingDay(t) {
+			if available := midnight.Sub(t); available >= remaining {
+				return t.Add(remaining).Sub(from)
+			}
+			remaining -= midnight.Sub(t)
+			idle = 0
+		} else if idle++; idle > maxIdleDays {
+			// a calendar without working days would never end the step
+			return step.Phase.Span()
+		}
+		t = midnight
+	}
+	return t.Sub(from)
+}
+
+// capacity returns the capacity of a declared pool at an offset of the program start.
+func (p *Pipeline) capacity(pool string, at time.Duration) int {
+	capacity := p.pools[pool]
+	for _, c := range p.changes[pool] {
+		if c.At <= at {
+			capacity += c.Delta
+		}
+	}
+	return capacity
+}
+
+// usage returns the number of units held by bars at an offset of the program start.
+func usage(bars []Bar, at time.Duration) int {
+	used := 0
+	for _, b := range bars {
+		if b.Start <= at && at < b.Released {
+			used += b.Units
+		}
+	}
+	return used
+}
@@ -0,0 +1,379 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+)
+
+const (
+	phasePreCopy    = "Pre-Copy"
+	phaseCutover    = "Cutover"
+	phaseValidation = "Validation"
+)
+
+// twoWaves returns two identical waves: pre-copy 4h, cutover 2h, validation 6h (2 engineers).
+func twoWaves(preCopyUnits int) []WavePlan {
+	wave := func(name string) WavePlan {
+		return WavePlan{
+			Wave: name,
+			Steps: []Step{
+				{Phase: Phase{Name: phasePreCopy, Effort: 4 * time.Hour}, Pool: "engineers", Units: preCopyUnits},
+				{Phase: Phase{Name: phaseCutover, Effort: 2 * time.Hour}},
+				{Phase: Phase{Name: phaseValidation, Effort: 6 * time.Hour}, Pool: "engineers", Units: 2},
+			},
+		}
+	}
+	return []WavePlan{wave("wave-1"), wave("wave-2")}
+}
+
+func findBar(t *testing.T, tl Timeline, wave, phase string) Bar {
+	t.Helper()
+	for _, b := range tl.Bars {
+		if b.Wave == wave && b.Phase == phase {
+			return b
+		}
+	}
+	t.Fatalf("bar %s/%s not found", wave, phase)
+	return Bar{}
+}
+
+func TestPipeline_Layout_Modes(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name         string
+		pipeline     *Pipeline
+		wantTotal    time.Duration
+		wantPreCopy2 time.Duration
+		wantCutover2 time.Duration
+	}{
+		{
+			name:         "serial",
+			pipeline:     NewPipeline(ModeSerial, WithOverlap(phaseValidation, phasePreCopy)),
+			wantTotal:    24 * time.Hour,
+			wantPreCopy2: 12 * time.Hour,
+			wantCutover2: 16 * time.Hour,
+		},
+		{
+			name:         "parallel",
+			pipeline:     NewPipeline(ModeParallel),
+			wantTotal:    12 * time.Hour,
+			wantPreCopy2: 0,
+			wantCutover2: 4 * time.Hour,
+		},
+		{
+			// pre-copy of wave 2 starts with validation of wave 1, cutover still waits for wave 1 to finish
+			name:         "pipelined",
+			pipeline:     NewPipeline(ModePipelined, WithOverlap(phaseValidation, phasePreCopy)),
+			wantTotal:    20 * time.Hour,
+			wantPreCopy2: 6 * time.Hour,
+			wantCutover2: 12 * time.Hour,
+		},
+		{
+			name:         "pipelined without overlaps is serial",
+			pipeline:     NewPipeline(ModePipelined),
+			wantTotal:    24 * time.Hour,
+			wantPreCopy2: 12 * time.Hour,
+			wantCutover2: 16 * time.Hour,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tl, err := tc.pipeline.Layout(twoWaves(0))
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if tl.Total != tc.wantTotal {
+				t.Errorf("expected total %v, got %v", tc.wantTotal, tl.Total)
+			}
+			if got := findBar(t, tl, "wave-2", phasePreCopy).Start; got != tc.wantPreCopy2 {
+				t.Errorf("expected wave-2 pre-copy at %v, got %v", tc.wantPreCopy2, got)
+			}
+			if got := findBar(t, tl, "wave-2", phaseCutover).Start; got != tc.wantCutover2 {
+				t.Errorf("expected wave-2 cutover at %v, got %v", tc.wantCutover2, got)
+			}
+		})
+	}
+}
+
+func TestPipeline_Layout_Dependencies(t *testing.T) {
+	t.Parallel()
+	tl, err := NewPipeline(ModePipelined, WithOverlap(phaseValidation, phasePreCopy)).Layout(twoWaves(0))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	preCopy := findBar(t, tl, "wave-2", phasePreCopy)
+	if len(preCopy.DependsOn) != 1 || preCopy.DependsOn[0] != (BarID{Wave: "wave-1", Phase: phaseValidation}) {
+		t.Errorf("expected wave-2 pre-copy to depend on wave-1 validation, got %v", preCopy.DependsOn)
+	}
+	cutover := findBar(t, tl, "wave-2", phaseCutover)
+	want := []BarID{{Wave: "wave-2", Phase: phasePreCopy}, {Wave: "wave-1", Phase: phaseValidation}}
+	if len(cutover.DependsOn) != len(want) || cutover.DependsOn[0] != want[0] || cutover.DependsOn[1] != want[1] {
+		t.Errorf("expected wave-2 cutover dependencies %v, got %v", want, cutover.DependsOn)
+	}
+}
+
+func TestPipeline_Layout_ResourcePoolPreventsOverlap(t *testing.T) {
+	t.Parallel()
+	// wave-1 validation holds 2 of 3 engineers, wave-2 pre-copy needs 2 more: it has to wait
+	p := NewPipeline(ModePipelined,
+		WithOverlap(phaseValidation, phasePreCopy),
+		WithResourcePool("engineers", 3),
+	)
+
+	tl, err := p.Layout(twoWaves(2))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := findBar(t, tl, "wave-2", phasePreCopy).Start; got != 12*time.Hour {
+		t.Errorf("expected wave-2 pre-copy delayed to 12h, got %v", got)
+	}
+	if tl.Total != 24*time.Hour {
+		t.Errorf("expected total 24h, got %v", tl.Total)
+	}
+}
+
+func TestPipeline_Layout_ResourcePoolAllowsOverlap(t *testing.T) {
+	t.Parallel()
+	p := NewPipeline(ModePipelined,
+		WithOverlap(phaseValidation, phasePreCopy),
+		WithResourcePool("engineers", 4),
+	)
+
+	tl, err := p.Layout(twoWaves(2))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := findBar(t, tl, "wave-2", phasePreCopy).Start; got != 6*time.Hour {
+		t.Errorf("expected wave-2 pre-copy at 6h, got %v", got)
+	}
+}
+
+func TestPipeline_Layout_DemandExceedsCapacity(t *testing.T) {
+	t.Parallel()
+	p := NewPipeline(ModeSerial, WithResourcePool("engineers", 1))
+
+	if _, err := p.Layout(twoWaves(0)); err == nil {
+		t.Error("expected error when a step needs more units than the pool has")
+	}
+}
+
+func TestPipeline_Layout_CapacityChanges(t *testing.T) {
+	t.Parallel()
+	// both validations start at 6h when the pool has room for them
+	cases := []struct {
+		name       string
+		capacity   int
+		change     CapacityChange
+		wantStart2 time.Duration
+		wantTotal  time.Duration
+	}{
+		{
+			name:       "engineers leave",
+			capacity:   4,
+			change:     CapacityChange{Pool: "engineers", At: 7 * time.Hour, Delta: -2},
+			wantStart2: 12 * time.Hour,
+			wantTotal:  18 * time.Hour,
+		},
+		{
+			name:       "contractors join",
+			capacity:   2,
+			change:     CapacityChange{Pool: "engineers", At: 8 * time.Hour, Delta: 2},
+			wantStart2: 8 * time.Hour,
+			wantTotal:  14 * time.Hour,
+		},
+		{
+			name:       "capacity drops after the program",
+			capacity:   4,
+			change:     CapacityChange{Pool: "engineers", At: 20 * time.Hour, Delta: -2},
+			wantStart2: 6 * time.Hour,
+			wantTotal:  12 * time.Hour,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := NewPipeline(ModeParallel, WithResourcePool("engineers", tc.capacity), WithCapacityChange(tc.change))
+			tl, err := p.Layout(twoWaves(0))
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if got := findBar(t, tl, "wave-1", phaseValidation).Start; got != 6*time.Hour {
+				t.Errorf("expected wave-1 validation at 6h, got %v", got)
+			}
+			if got := findBar(t, tl, "wave-2", phaseValidation).Start; got != tc.wantStart2 {
+				t.Errorf("expected wave-2 validation at %v, got %v", tc.wantStart2, got)
+			}
+			if tl.Total != tc.wantTotal {
+				t.Errorf("expected total %v, got %v", tc.wantTotal, tl.Total)
+			}
+		})
+	}
+}
+
+func TestPipeline_Layout_DemandFitsOnceCapacityGrows(t *testing.T) {
+	t.Parallel()
+	p := NewPipeline(ModeSerial, WithResourcePool("engineers", 1),
+		WithCapacityChange(CapacityChange{Pool: "engineers", At: 10 * time.Hour, Delta: 1}))
+
+	tl, err := p.Layout(twoWaves(0))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := findBar(t, tl, "wave-1", phaseValidation).Start; got != 10*time.Hour {
+		t.Errorf("expected wave-1 validation to wait for the second engineer at 10h, got %v", got)
+	}
+}
+
+func TestPipeline_Layout_PoolCalendars(t *testing.T) {
+	t.Parallel()
+	// Thursday before Easter: the German team is off from Good Friday to Easter Monday, the US team
+	// only for the weekend
+	start := time.Date(2026, time.April, 2, 0, 0, 0, 0, time.UTC)
+	step := func(pool string) Step {
+		return Step{Phase: Phase{Name: phaseValidation, Effort: 16 * time.Hour, WorkHoursPerDay: 8}, Pool: pool, Units: 1}
+	}
+	waves := []WavePlan{
+		{Wave: "wave-de", Steps: []Step{
+			{Phase: Phase{Name: phasePreCopy, Effort: 4 * time.Hour}, Pool: "de"},
+			step("de"),
+		}},
+		{Wave: "wave-us", Steps: []Step{step("us")}},
+	}
+
+	p := NewPipeline(ModeParallel,
+		WithProgramStart(start),
+		WithPoolCalendar("de", calendar.Calendar{Region: calendar.RegionDE}),
+		WithPoolCalendar("us", calendar.Calendar{Region: calendar.RegionUS}),
+	)
+	tl, err := p.Layout(waves)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// the round-the-clock pre-copy is not affected by the calendar
+	if got := findBar(t, tl, "wave-de", phasePreCopy).End; got != 4*time.Hour {
+		t.Errorf("expected pre-copy to end at 4h, got %v", got)
+	}
+	// 20h of Thursday, nothing until Tuesday, then all of Tuesday
+	if got := findBar(t, tl, "wave-de", phaseValidation).End; got != 6*day+4*time.Hour {
+		t.Errorf("expected wave-de validation to end on Wednesday 4am, got %v", got)
+	}
+	// Thursday and Friday
+	if got := findBar(t, tl, "wave-us", phaseValidation).End; got != 2*day {
+		t.Errorf("expected wave-us validation to end on Saturday, got %v", got)
+	}
+}
+
+func TestPipeline_Layout_PoolCalendarRequiresStart(t *testing.T) {
+	t.Parallel()
+	p := NewPipeline(ModeSerial, WithPoolCalendar("engineers", calendar.Calendar{}))
+
+	if _, err := p.Layout(twoWaves(0)); err == nil {
+		t.Error("expected error for a pool calendar without program start")
+	}
+}
+
+func TestPipeline_Layout_LeadTimeDoesNotHoldPool(t *testing.T) {
+	t.Parallel()
+	// wave-1 decommission takes 8h of effort but waits 90 days for the license notice;
+	// wave-2 can use the engineers as soon as the effort is done
+	waves := []WavePlan{
+		{Wave: "wave-1", Steps: []Step{
+			{Phase: Phase{Name: PhaseSourceDecommission, Effort: 8 * time.Hour, LeadTime: 90 * day}, Pool: "engineers", Units: 2},
+		}},
+		{Wave: "wave-2", Steps: []Step{
+			{Phase: Phase{Name: phaseValidation, Effort: 4 * time.Hour}, Pool: "engineers", Units: 2},
+		}},
+	}
+
+	tl, err := NewPipeline(ModeParallel, WithResourcePool("engineers", 2)).Layout(waves)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	decommission := findBar(t, tl, "wave-1", PhaseSourceDecommission)
+	if decommission.End != 90*day || decommission.Released != 8*time.Hour {
+		t.Errorf("expected decommission to end at 90 days and release engineers at 8h, got %+v", decommission)
+	}
+	if got := findBar(t, tl, "wave-2", phaseValidation).Start; got != 8*time.Hour {
+		t.Errorf("expected wave-2 validation at 8h, got %v", got)
+	}
+	if tl.Total != 90*day {
+		t.Errorf("expected total 90 days, got %v", tl.Total)
+	}
+}
+
+func TestPipeline_Layout_NotBefore(t *testing.T) {
+	t.Parallel()
+	// wave-2 lands on a target built 3 days into the program
+	waves := twoWaves(0)
+	waves[1].NotBefore = 3 * day
+
+	tl, err := NewPipeline(ModeParallel).Layout(waves)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got := findBar(t, tl, "wave-1", phasePreCopy).Start; got != 0 {
+		t.Errorf("expected wave-1 pre-copy at the program start, got %v", got)
+	}
+	if got := findBar(t, tl, "wave-2", phasePreCopy).Start; got != 3*day {
+		t.Errorf("expected wave-2 pre-copy once its target is built, got %v", got)
+	}
+	if tl.Total != 3*day+12*time.Hour {
+		t.Errorf("expected total 3 days 12h, got %v", tl.Total)
+	}
+}
+
+func TestPipeline_Layout_InvalidInput(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		pipeline *Pipeline
+		waves    []WavePlan
+	}{
+		{
+			name:     "unknown mode",
+			pipeline: NewPipeline(Mode("staggered")),
+			waves:    twoWaves(0),
+		},
+		{
+			name:     "negative units",
+			pipeline: NewPipeline(ModeSerial, WithResourcePool("engineers", 2)),
+			waves:    twoWaves(-1),
+		},
+		{
+			name:     "capacity change of undeclared pool",
+			pipeline: NewPipeline(ModeSerial, WithCapacityChange(CapacityChange{Pool: "contractors", At: time.Hour, Delta: 3})),
+			waves:    twoWaves(0),
+		},
+		{
+			name: "capacity change before start",
+			pipeline: NewPipeline(ModeSerial, WithResourcePool("engineers", 2),
+				WithCapacityChange(CapacityChange{Pool: "engineers", At: -time.Hour, Delta: 1})),
+			waves: twoWaves(0),
+		},
+		{
+			name: "capacity drops below demand for good",
+			pipeline: NewPipeline(ModeSerial, WithResourcePool("engineers", 2),
+				WithCapacityChange(CapacityChange{Pool: "engineers", At: time.Hour, Delta: -1})),
+			waves: twoWaves(0),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := tc.pipeline.Layout(tc.waves); err == nil {
+				t.Errorf("expected error for case %q, got nil", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package scheduling
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Scheduler assigns waves to maintenance windows.
+type Scheduler struct{}
+
+// NewScheduler creates a Scheduler.
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Place checks whether the wave fits the window and returns the resulting Placement.
+//
+// A wave containing an application with a tight RTO (shorter than a cold cutover followed by a rollback)
+// is assigned warm migration, and the window must leave headroom for a rollback after the cutover.
+// The returned error is a *PlacementError listing every violated constraint.
+func (s *Scheduler) Place(w Wave, win Window) (Placement, error) {
+	p, perr := s.place(w, win)
+	if perr != nil {
+		return Placement{}, perr
+	}
+	return p, nil
+}
+
+func (s *Scheduler) place(w Wave, win Window) (Placement, *PlacementError) {
+	var violations []Violation
+
+	method := CutoverCold
+	outage := w.ColdCutover
+	tight := tightRTOApplications(w)
+	if len(tight) > 0 {
+		if reason := warmUnavailable(w); reason != "" {
+			// keep the cold outage so the window check below reports the cutover that would actually happen
+			for _, app := range tight {
+				violations = append(violations, Violation{
+					Application: app.Name,
+					Reason: fmt.Sprintf("RTO %s requires warm migration (cold cutover %s + rollback %s = %s) but %s",
+						app.RTO, w.ColdCutover, w.Rollback, w.ColdCutover+w.Rollback, reason),
+				})
+			}
+		} else {
+			method = CutoverWarm
+			outage = w.WarmCutover
+		}
+	}
+
+	// Applications with a declared RTO must survive a failed cutover: outage plus rollback has to stay within the RTO.
+	if method == CutoverWarm {
+		for _, app := range w.Applications {
+			if app.RTO > 0 && outage+w.Rollback > app.RTO {
+				violations = append(violations, Violation{
+					Application: app.Name,
+					Reason: fmt.Sprintf("RTO %s can not be met: warm cutover %s + rollback %s = %s",
+						app.RTO, outage, w.Rollback, outage+w.Rollback),
+				})
+			}
+		}
+	}
+
+	for _, app := range w.Applications {
+		if app.RPO > 0 && w.Validation > app.RPO {
+			violations = append(violations, Violation{
+				Application: app.Name,
+				Reason: fmt.Sprintf("RPO %s can not be met: a rollback after %s of validation discards writes accepted by the target",
+					app.RPO, w.Validation),
+			})
+		}
+	}
+
+	reserved := outage
+	if len(tight) > 0 {
+		reserved += w.Rollback
+	}
+	if reserved > win.Length() {
+		reason := fmt.Sprintf("window length %s is shorter than the %s cutover (%s)", win.Length(), method, outage)
+		if len(tight) > 0 {
+			reason = fmt.Sprintf("window length %s leaves no rollback headroom: %s cutover %s + rollback %s = %s",
+				win.Length(), method, outage, w.Rollback, reserved)
+		}
+		violations = append(violations, Violation{Reason: reason})
+	}
+
+	if len(violations) > 0 {
+		return Placement{}, &PlacementError{Wave: w.Name, Window: win.Name, Violations: violations}
+	}
+
+	return Placement{
+		Wave:     w,
+		Window:   win,
+		Method:   method,
+		Outage:   outage,
+		Reserved: reserved,
+	}, nil
+}
+
+// Schedule places the waves, in order, into the windows sorted by start time.
+// Each window hosts at most one wave and a wave is never placed before the wave preceding it.
+// Waves that fit no remaining window are reported in Schedule.Unscheduled with the reason for every rejection.
+func (s *Scheduler) Schedule(waves []Wave, windows []Window) Schedule {
+	sorted := make([]Window, len(windows))
+	copy(sorted, windows)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var result Schedule
+	next := 0
+	for _, w := range waves {
+		var rejections []*PlacementError
+		placed := false
+		for i := next; i < len(sorted); i++ {
+			p, perr := s.place(w, sorted[i])
+			if perr != nil {
+				rejections = append(rejections, perr)
+				continue
+			}
+			result.Placements = append(result.Placements, p)
+			next = i + 1
+			placed = true
+			break
+		}
+		if !placed {
+			result.Unscheduled = append(result.Unscheduled, Unscheduled{Wave: w, Rejections: rejections})
+		}
+	}
+	return result
+}
+
+// warmUnavailable returns why warm migration can not be used for the wave, or an empty string if it can.
+// A warm cutover that is not shorter than the cold one brings no benefit and most likely is a data entry error.
+func warmUnavailable(w Wave) string {
+	switch {
+	case w.WarmCutover <= 0:
+		return "warm migration is not available"
+	case w.WarmCutover >= w.ColdCutover:
+		return fmt.Sprintf("the warm cutover %s is not shorter than the cold cutover", w.WarmCutover)
+	}
+	return ""
+}
+
+// tightRTOApplications returns the applications whose RTO can not absorb a cold cutover followed by a rollback.
+func tightRTOApplications(w Wave) []Application {
+	var tight []Application
+	coldWorstCase := w.ColdCutover + w.Rollback
+	for _, app := range w.Applications {
+		if app.RTO > 0 && app.RTO < coldWorstCase {
+			tight = append(tight, app)
+		}
+	}
+	return tight
+}
@@ -0,0 +1,245 @@
+package scheduling
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var baseTime = time.Date(2026, time.March, 7, 22, 0, 0, 0, time.UTC)
+
+func window(name string, startOffset, length time.Duration) Window {
+	start := baseTime.Add(startOffset)
+	return Window{Name: name, Start: start, End: start.Add(length)}
+}
+
+func TestPlace_NoObjectivesUsesColdCutover(t *testing.T) {
+	t.Parallel()
+	s := NewScheduler()
+	w := Wave{
+		Name:         "wave-1",
+		Applications: []Application{{Name: "intranet"}},
+		ColdCutover:  4 * time.Hour,
+		WarmCutover:  30 * time.Minute,
+		Rollback:     2 * time.Hour,
+	}
+
+	p, err := s.Place(w, window("sat", 0, 5*time.Hour))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if p.Method != CutoverCold {
+		t.Errorf("expected cold cutover, got %s", p.Method)
+	}
+	// no tight RTO, so no rollback headroom is reserved
+	if p.Reserved != 4*time.Hour {
+		t.Errorf("expected 4h reserved, got %v", p.Reserved)
+	}
+	if !p.End().Equal(baseTime.Add(4 * time.Hour)) {
+		t.Errorf("unexpected end %v", p.End())
+	}
+}
+
+func TestPlace_TightRTOAssignsWarmWithRollbackHeadroom(t *testing.T) {
+	t.Parallel()
+	s := NewScheduler()
+	w := Wave{
+		Name:         "wave-1",
+		Applications: []Application{{Name: "payments", RTO: 2 * time.Hour}},
+		ColdCutover:  4 * time.Hour,
+		WarmCutover:  30 * time.Minute,
+		Rollback:     time.Hour,
+	}
+
+	p, err := s.Place(w, window("sat", 0, 2*time.Hour))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if p.Method != CutoverWarm {
+		t.Errorf("expected warm cutover, got %s", p.Method)
+	}
+	if p.Outage != 30*time.Minute {
+		t.Errorf("expected 30m outage, got %v", p.Outage)
+	}
+	// 30m warm cutover + 1h rollback headroom
+	if p.Reserved != 90*time.Minute {
+		t.Errorf("expected 1h30m reserved, got %v", p.Reserved)
+	}
+}
+
+func TestPlace_Violations(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name       string
+		wave       Wave
+		window     Window
+		wantReason string
+		wantApp    string
+	}{
+		{
+			name: "tight RTO without warm migration",
+			wave: Wave{
+				Name:         "wave-1",
+				Applications: []Application{{Name: "payments", RTO: time.Hour}},
+				ColdCutover:  4 * time.Hour,
+				Rollback:     time.Hour,
+			},
+			window:     window("sat", 0, 12*time.Hour),
+			wantReason: "warm migration is not available",
+			wantApp:    "payments",
+		},
+		{
+			name: "warm cutover not shorter than cold cutover",
+			wave: Wave{
+				Name:         "wave-1",
+				Applications: []Application{{Name: "payments", RTO: time.Hour}},
+				ColdCutover:  4 * time.Hour,
+				WarmCutover:  4 * time.Hour,
+				Rollback:     time.Hour,
+			},
+			window:     window("sat", 0, 12*time.Hour),
+			wantReason: "not shorter than the cold cutover",
+			wantApp:    "payments",
+		},
+		{
+			// without warm migration the window has to hold the cold cutover, not a 0s warm one
+			name: "window check uses cold outage when warm is unavailable",
+			wave: Wave{
+				Name:         "wave-1",
+				Applications: []Application{{Name: "payments", RTO: time.Hour}},
+				ColdCutover:  4 * time.Hour,
+				Rollback:     time.Hour,
+			},
+			window:     window("short", 0, 2*time.Hour),
+			wantReason: "cold cutover 4h0m0s + rollback 1h0m0s",
+		},
+		{
+			name: "warm cutover plus rollback exceeds RTO",
+			wave: Wave{
+				Name:         "wave-1",
+				Applications: []Application{{Name: "payments", RTO: time.Hour}},
+				ColdCutover:  4 * time.Hour,
+				WarmCutover:  45 * time.Minute,
+				Rollback:     30 * time.Minute,
+			},
+			window:     window("sat", 0, 12*time.Hour),
+			wantReason: "RTO 1h0m0s can not be met",
+			wantApp:    "payments",
+		},
+		{
+			name: "window without rollback headroom",
+			wave: Wave{
+				Name:         "wave-1",
+				Applications: []Application{{Name: "payments", RTO: 3 * time.Hour}},
+				ColdCutover:  4 * time.Hour,
+				WarmCutover:  30 * time.Minute,
+				Rollback:     time.Hour,
+			},
+			window:     window("short", 0, time.Hour),
+			wantReason: "no rollback headroom",
+		},
+		{
+			name: "window shorter than cold cutover",
+			wave: Wave{
+				Name:        "wave-1",
+				ColdCutover: 4 * time.Hour,
+			},
+			window:     window("short", 0, time.Hour),
+			wantReason: "shorter than the cold cutover",
+		},
+		{
+			name: "validation longer than RPO",
+			wave: Wave{
+				Name:         "wave-1",
+				Applications: []Application{{Name: "ledger", RPO: 15 * time.Minute}},
+				ColdCutover:  time.Hour,
+				Validation:   time.Hour,
+			},
+			window:     window("sat", 0, 12*time.Hour),
+			wantReason: "RPO 15m0s can not be met",
+			wantApp:    "ledger",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewScheduler().Place(tc.wave, tc.window)
+			var perr *PlacementError
+			if !errors.As(err, &perr) {
+				t.Fatalf("expected *PlacementError, got %v", err)
+			}
+			found := false
+			for _, v := range perr.Violations {
+				if strings.Contains(v.Reason, tc.wantReason) && v.Application == tc.wantApp {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("expected violation containing %q for %q, got: %v", tc.wantReason, tc.wantApp, perr)
+			}
+		})
+	}
+}
+
+func TestSchedule_SkipsWindowsThatViolateRTO(t *testing.T) {
+	t.Parallel()
+	s := NewScheduler()
+	waves := []Wave{
+		{Name: "wave-1", ColdCutover: 2 * time.Hour},
+		{
+			Name:         "wave-2",
+			Applications: []Application{{Name: "payments", RTO: 2 * time.Hour}},
+			ColdCutover:  4 * time.Hour,
+			WarmCutover:  30 * time.Minute,
+			Rollback:     time.Hour,
+		},
+	}
+	// given out of order on purpose
+	windows := []Window{
+		window("w3", 48*time.Hour, 4*time.Hour),
+		window("w1", 0, 4*time.Hour),
+		window("w2", 24*time.Hour, time.Hour), // too short for warm cutover + rollback
+	}
+
+	sched := s.Schedule(waves, windows)
+	if len(sched.Unscheduled) != 0 {
+		t.Fatalf("expected all waves scheduled, got unscheduled: %+v", sched.Unscheduled)
+	}
+	if len(sched.Placements) != 2 {
+		t.Fatalf("expected 2 placements, got %d", len(sched.Placements))
+	}
+	if sched.Placements[0].Window.Name != "w1" {
+		t.Errorf("expected wave-1 in w1, got %s", sched.Placements[0].Window.Name)
+	}
+	if sched.Placements[1].Window.Name != "w3" {
+		t.Errorf("expected wave-2 in w3, got %s", sched.Placements[1].Window.Name)
+	}
+}
+
+func TestSchedule_ReportsUnscheduledWaves(t *testing.T) {
+	t.Parallel()
+	s := NewScheduler()
+	waves := []Wave{
+		{
+			Name:         "wave-1",
+			Applications: []Application{{Name: "payments", RTO: 30 * time.Minute}},
+			ColdCutover:  4 * time.Hour,
+			Rollback:     time.Hour,
+		},
+	}
+	windows := []Window{window("w1", 0, 12*time.Hour), window("w2", 24*time.Hour, 12*time.Hour)}
+
+	sched := s.Schedule(waves, windows)
+	if len(sched.Placements) != 0 {
+		t.Fatalf("expected no placements, got %d", len(sched.Placements))
+	}
+	if len(sched.Unscheduled) != 1 {
+		t.Fatalf("expected 1 unscheduled wave, got %d", len(sched.Unscheduled))
+	}
+	if got := len(sched.Unscheduled[0].Rejections); got != 2 {
+		t.Errorf("expected one rejection per window, got %d", got)
+	}
+}
@@ -0,0 +1,237 @@
+package scheduling
+
+import (
+	"sort"
+	"time"
+)
+
+// maxIdle is the longest period without anyone on duty a span computation goes through.
+const maxIdle = maxIdleDays * 24 * time.Hour
+
+// Shift is the working hours of a team in its time zone, e.g. 09:00 to 17:00 in Asia/Kolkata.
+type Shift struct {
+	Name     string
+	Location *time.Location
+	// Start and End are offsets from local midnight. A shift ending before it starts runs past midnight.
+	Start time.Duration
+	End   time.Duration
+	// Days are the local weekdays the shift starts on. Empty means Monday to Friday.
+	Days []time.Weekday
+	// Calendar holds the days off of the team. A shift starting on a day off does not take place.
+	Calendar WorkCalendar
+}
+
+// Gap is a period of a week no shift covers. From and To are offsets from midnight UTC of Day.
+type Gap struct {
+	Day  time.Weekday
+	From time.Duration
+	To   time.Duration
+}
+
+// ShiftWork is the part of the effort of a step a shift carried out.
+type ShiftWork struct {
+	Shift string
+	Work  time.Duration
+}
+
+// Shifts are the teams working a pool in turn, handing the work over at the end of their shift.
+// Time covered by several shifts at once counts once: overlaps are handovers.
+type Shifts []Shift
+
+// OnDuty reports whether a shift is working at t.
+func (ss Shifts) OnDuty(t time.Time) bool {
+	return ss.onDuty(t) >= 0
+}
+
+// onDuty returns the index of the first shift working at t, or -1.
+func (ss Shifts) onDuty(t time.Time) int {
+	for i, s := range ss {
+		if s.onDuty(t, true) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Span returns the wall-clock time from start it takes the shifts to carry out effort.
+// ok is false without shifts or when nobody is on duty for longer than a year.
+func (ss Shifts) Span(start time.Time, effort time.Duration) (time.Duration, bool) {
+	span, _, ok := ss.Split(start, effort)
+	return span, ok
+}
+
+// Split returns the wall-clock time from start it takes the shifts to carry out effort and the part
+// of the effort each shift carries out, in the order of the shifts. During an overlap the work goes
+// to the first shift on duty in the list.
+func (ss Shifts) Split(start time.Time, effort time.Duration) (time.Duration, []ShiftWork, bool) {
+	if len(ss) == 0 {
+		return 0, nil, false
+	}
+	work := make([]ShiftWork, len(ss))
+	for i, s := range ss {
+		work[i].Shift = s.Name
+	}
+	t, remaining, idle := start, effort, time.Duration(0)
+	for remaining > 0 {
+		next := ss.nextChange(t)
+		i := ss.onDuty(t)
+		if i < 0 {
+			if idle += next.Sub(t); idle > maxIdle {
+				return 0, nil, false
+			}
+			t = next
+			continue
+		}
+		idle = 0
+		done := min(next.Sub(t), remaining)
+		work[i].Work += done
+		remaining -= done
+		t = t.Add(done)
+	}
+
+	// shifts that never came on duty are left out
+	carried := work[:0]
+	for _, w := range work {
+		if w.Work > 0 {
+			carried = append(carried, w)
+		}
+	}
+	return t.Sub(start), carried, true
+}
+
+// WeeklyGaps returns the periods of the given UTC weekdays no shift covers in a regular week,
+// holidays aside. Empty days means Monday to Friday, the coverage of a 24x5 factory.
+func (ss Shifts) WeeklyGaps(days []time.Weekday) []Gap {
+	if len(days) == 0 {
+		days = workWeek
+	}
+	sorted := append([]time.Weekday{}, days...)
+	sort.Slice(sorted, func(i, j int) bool { return weekdayIndex(sorted[i]) < weekdayIndex(sorted[j]) })
+
+	var gaps []Gap
+	for _, day := range sorted {
+		// 2024-01-01 is a Monday
+		midnight := time.Date(2024, time.January, 1+weekdayIndex(day), 0, 0, 0, 0, time.UTC)
+		end := midnight.Add(24 * time.Hour)
+		for t := midnight; t.Before(end); {
+			next := ss.nextWeeklyChange(t)
+			if next.After(end) {
+				next = end
+			}
+			if !ss.onDutyWeekly(t) {
+				if n := len(gaps); n > 0 && gaps[n-1].Day == day && gaps[n-1].To == t.Sub(midnight) {
+					gaps[n-1].To = next.Sub(midnight)
+				} else {
+					gaps = append(gaps, Gap{Day: day, From: t.Sub(midnight), To: next.Sub(midnight)})
+				}
+			}
+			t = next
+		}
+	}
+	return gaps
+}
+
+var workWeek = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// weekdayIndex numbers the weekdays from Monday.
+func weekdayIndex(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
+
+func (ss Shifts) onDutyWeekly(t time.Time) bool {
+	for _, s := range ss {
+		if s.onDuty(t, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextChange returns the first moment after t the coverage may change: a shift starting or ending,
+// or a local midnight when days off apply.
+func (ss Shifts) nextChange(t time.Time) time.Time {
+	var next time.Time
+	for _, s := range ss {
+		if n := s.nextChange(t, true); next.IsZero() || n.Before(next) {
+			next = n
+		}
+	}
+	return next
+}
+
+func (ss Shifts) nextWeeklyChange(t time.Time) time.Time {
+	var next time.Time
+	for _, s := range ss {
+		if n := s.nextChange(t, false); next.IsZero() || n.Before(next) {
+			next = n
+		}
+	}
+	return next
+}
+
+// onDuty reports whether the shift is working at t, considering its days off when holidays is set.
+func (s Shift) onDuty(t time.Time, holidays bool) bool {
+	local := t.In(s.location())
+	// the shift may have started the day before and run past midnight
+	for _, offset := range []int{0, -1} {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, local.Location())
+		if !s.worksOn(day, holidays) {
+			continue
+		}
+		from, to := s.window(day)
+		if !local.Before(from) && local.Before(to) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextChange returns the first start or end of the shift after t, or the next local midnight when
+// days off apply and come first.
+func (s Shift) nextChange(t time.Time, holidays bool) time.Time {
+	local := t.In(s.location())
+	var next time.Time
+	for offset := -1; offset <= 1; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, local.Location())
+		from, to := s.window(day)
+		for _, b := range []time.Time{from, to} {
+			if b.After(t) && (next.IsZero() || b.Before(next)) {
+				next = b
+			}
+		}
+	}
+	midnight := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, local.Location())
+	if holidays && s.Calendar != nil && midnight.Before(next) {
+		next = midnight
+	}
+	return next
+}
+
+// window returns the start and end of the shift starting on a local day.
+func (s Shift) window(day time.Time) (time.Time, time.Time) {
+	end := s.End
+	if end <= s.Start {
+		end += 24 * time.Hour
+	}
+	return day.Add(s.Start), day.Add(end)
+}
+
+func (s Shift) worksOn(day time.Time, holidays bool) bool {
+	days := s.Days
+	if len(days) == 0 {
+		days = workWeek
+	}
+	for _, d := range days {
+		if d == day.Weekday() {
+			return !holidays || s.Calendar == nil || s.Calendar.IsWorkingDay(day)
+		}
+	}
+	return false
+}
+
+func (s Shift) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
@@ -0,0 +1,165 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/calendar"
+)
+
+// followTheSun are three 8h shifts covering the day: 04:00-12:00, 12:00-20:00 and 20:00-04:00 UTC.
+func followTheSun() Shifts {
+	return Shifts{
+		{Name: "apac", Location: time.FixedZone("IST", 5*3600+1800), Start: 9*time.Hour + 30*time.Minute, End: 17*time.Hour + 30*time.Minute},
+		{Name: "emea", Location: time.FixedZone("CET", 3600), Start: 13 * time.Hour, End: 21 * time.Hour},
+		{Name: "amer", Location: time.FixedZone("EST", -5*3600), Start: 15 * time.Hour, End: 23 * time.Hour},
+	}
+}
+
+func TestShifts_Split(t *testing.T) {
+	t.Parallel()
+	monday := time.Date(2026, time.October, 12, 4, 0, 0, 0, time.UTC)
+	friday := time.Date(2026, time.October, 16, 4, 0, 0, 0, time.UTC)
+	holiday := followTheSun()
+	holiday[1].Calendar = calendar.Calendar{Holidays: []calendar.Holiday{{Date: time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)}}}
+
+	cases := []struct {
+		name   string
+		shifts Shifts
+		start  time.Time
+		effort time.Duration
+		span   time.Duration
+		work   []ShiftWork
+	}{
+		{
+			name:   "handed over around the clock",
+			shifts: followTheSun(),
+			start:  monday,
+			effort: 24 * time.Hour,
+			span:   24 * time.Hour,
+			work:   []ShiftWork{{"apac", 8 * time.Hour}, {"emea", 8 * time.Hour}, {"amer", 8 * time.Hour}},
+		},
+		{
+			// Friday to Saturday 04:00, nothing until Monday 04:00 UTC, then 16h
+			name:   "over the weekend",
+			shifts: followTheSun(),
+			start:  friday,
+			effort: 40 * time.Hour,
+			span:   3*day + 16*time.Hour,
+			work:   []ShiftWork{{"apac", 16 * time.Hour}, {"emea", 16 * time.Hour}, {"amer", 8 * time.Hour}},
+		},
+		{
+			name:   "team on holiday",
+			shifts: holiday,
+			start:  monday,
+			effort: 24 * time.Hour,
+			span:   32 * time.Hour,
+			work:   []ShiftWork{{"apac", 16 * time.Hour}, {"amer", 8 * time.Hour}},
+		},
+		{
+			name:   "overlap counts once",
+			shifts: Shifts{{Name: "early", Start: 6 * time.Hour, End: 14 * time.Hour}, {Name: "late", Start: 10 * time.Hour, End: 18 * time.Hour}},
+			start:  monday.Add(2 * time.Hour),
+			effort: 12 * time.Hour,
+			span:   12 * time.Hour,
+			work:   []ShiftWork{{"early", 8 * time.Hour}, {"late", 4 * time.Hour}},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			span, work, ok := tc.shifts.Split(tc.start, tc.effort)
+			if !ok {
+				t.Fatal("expected the effort to be carried out")
+			}
+			if span != tc.span {
+				t.Errorf("expected span %v, got %v", tc.span, span)
+			}
+			if len(work) != len(tc.work) {
+				t.Fatalf("expected work %v, got %v", tc.work, work)
+			}
+			for i := range work {
+				if work[i] != tc.work[i] {
+					t.Errorf("expected work %v, got %v", tc.work, work)
+				}
+			}
+		})
+	}
+}
+
+func TestShifts_Split_NobodyOnDuty(t *testing.T) {
+	t.Parallel()
+	monday := time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)
+	if _, _, ok := (Shifts{}).Split(monday, time.Hour); ok {
+		t.Error("expected no span without shifts")
+	}
+	weekend := Shifts{{Name: "never", Start: 9 * time.Hour, End: 17 * time.Hour, Calendar: calendar.Calendar{
+		Holidays: weekdaysOf(2026, 2027),
+	}}}
+	if _, _, ok := weekend.Split(monday, time.Hour); ok {
+		t.Error("expected no span for a shift never on duty")
+	}
+}
+
+func weekdaysOf(years ...int) []calendar.Holiday {
+	var holidays []calendar.Holiday
+	for _, year := range years {
+		for d := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() == year; d = d.AddDate(0, 0, 1) {
+			holidays = append(holidays, calendar.Holiday{Date: d})
+		}
+	}
+	return holidays
+}
+
+func TestShifts_WeeklyGaps(t *testing.T) {
+	t.Parallel()
+	// the Americas shift starting on Sunday evening would cover Monday night UTC
+	gaps := followTheSun().WeeklyGaps(nil)
+	want := []Gap{{Day: time.Monday, From: 0, To: 4 * time.Hour}}
+	if len(gaps) != len(want) || gaps[0] != want[0] {
+		t.Errorf("expected gaps %v, got %v", want, gaps)
+	}
+
+	twoShifts := followTheSun()[:2]
+	gaps = twoShifts.WeeklyGaps([]time.Weekday{time.Wednesday})
+	want = []Gap{{Day: time.Wednesday, From: 0, To: 4 * time.Hour}, {Day: time.Wednesday, From: 20 * time.Hour, To: 24 * time.Hour}}
+	if len(gaps) != len(want) || gaps[0] != want[0] || gaps[1] != want[1] {
+		t.Errorf("expected gaps %v, got %v", want, gaps)
+	}
+}
+
+func TestPipeline_Layout_PoolShifts(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, time.October, 12, 4, 0, 0, 0, time.UTC)
+	waves := []WavePlan{
+		{Wave: "wave-1", Steps: []Step{
+			{Phase: Phase{Name: phasePreCopy, Effort: 4 * time.Hour}, Pool: "factory"},
+			{Phase: Phase{Name: phaseValidation, Effort: 24 * time.Hour, WorkHoursPerDay: 8}, Pool: "factory", Units: 1},
+		}},
+	}
+
+	p := NewPipeline(ModeSerial, WithProgramStart(start), WithPoolShifts("factory", followTheSun()...))
+	tl, err := p.Layout(waves)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// the round-the-clock pre-copy is not affected by the shifts
+	if bar := findBar(t, tl, "wave-1", phasePreCopy); bar.End != 4*time.Hour || bar.Shifts != nil {
+		t.Errorf("expected pre-copy to end at 4h without shifts, got %v %v", bar.End, bar.Shifts)
+	}
+	// three shifts carry out 24h of effort in one day instead of three
+	validation := findBar(t, tl, "wave-1", phaseValidation)
+	if validation.End != 28*time.Hour {
+		t.Errorf("expected validation to end at 28h, got %v", validation.End)
+	}
+	if len(validation.Shifts) != 3 {
+		t.Errorf("expected validation carried out by three shifts, got %v", validation.Shifts)
+	}
+
+	if _, err := NewPipeline(ModeSerial, WithPoolShifts("factory", followTheSun()...)).Layout(waves); err == nil {
+		t.Error("expected error for pool shifts without program start")
+	}
+}
@@ -0,0 +1,112 @@
+package scheduling
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CutoverMethod is the way a wave is switched over from the source to the target.
+type CutoverMethod string
+
+const (
+	// CutoverCold copies the data while the source VMs are powered off.
+	CutoverCold CutoverMethod = "cold"
+	// CutoverWarm pre-copies the data while the source is running and only powers it off for the final delta sync.
+	CutoverWarm CutoverMethod = "warm"
+)
+
+// Application is a business service moved as part of a wave, together with its recovery objectives.
+type Application struct {
+	Name string
+	// RTO is the longest outage the application tolerates. Zero means no objective was declared.
+	RTO time.Duration
+	// RPO is the largest window of data loss the application tolerates. Zero means no objective was declared.
+	RPO time.Duration
+}
+
+// Wave is a group of applications cut over together in a single maintenance window.
+type Wave struct {
+	Name         string
+	Applications []Application
+	// ColdCutover is the outage caused by a cold migration of the wave.
+	ColdCutover time.Duration
+	// WarmCutover is the outage caused by the final sync of a warm migration.
+	// Zero means warm migration is not available for the wave. It is only used when shorter than ColdCutover.
+	WarmCutover time.Duration
+	// Rollback is the time needed to bring the source back if the cutover fails.
+	Rollback time.Duration
+	// Validation is the time between cutover and the go/no-go decision. Writes accepted by
+	// the target during that period are lost if the wave is rolled back.
+	Validation time.Duration
+}
+
+// Window is a maintenance window during which a wave may be cut over.
+type Window struct {
+	Name  string
+	Start time.Time
+	End   time.Time
+}
+
+// Length returns the usable length of the window.
+func (w Window) Length() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// Placement is a wave assigned to a maintenance window.
+type Placement struct {
+	Wave   Wave
+	Window Window
+	Method CutoverMethod
+	// Outage is the expected downtime for the chosen method.
+	Outage time.Duration
+	// Reserved is the part of the window held for the wave, including rollback headroom when required.
+	Reserved time.Duration
+}
+
+// End returns the time at which the reserved part of the placement ends.
+func (p Placement) End() time.Time {
+	return p.Window.Start.Add(p.Reserved)
+}
+
+// Violation explains why a wave can not be placed in a window.
+type Violation struct {
+	// Application is the application whose objective is violated; empty for wave-level violations.
+	Application string
+	Reason      string
+}
+
+func (v Violation) String() string {
+	if v.Application == "" {
+		return v.Reason
+	}
+	return fmt.Sprintf("%s: %s", v.Application, v.Reason)
+}
+
+// PlacementError is returned when a wave can not be placed in a window.
+type PlacementError struct {
+	Wave       string
+	Window     string
+	Violations []Violation
+}
+
+func (e *PlacementError) Error() string {
+	reasons := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		reasons = append(reasons, v.String())
+	}
+	return fmt.Sprintf("wave %q can not be placed in window %q: %s", e.Wave, e.Window, strings.Join(reasons, "; "))
+}
+
+// Unscheduled is a wave that did not fit any of the available windows.
+type Unscheduled struct {
+	Wave Wave
+	// Rejections holds one PlacementError per window that was tried.
+	Rejections []*PlacementError
+}
+
+// Schedule is the result of placing a list of waves into maintenance windows.
+type Schedule struct {
+	Placements  []Placement
+	Unscheduled []Unscheduled
+}
@@ -0,0 +1,6 @@
+// Package simulation runs Monte Carlo simulations of an estimation. Inputs known only as a range,
+// e.g. a transfer rate anywhere between 400 and 800 Mbps, are given as distributions; each trial
+// samples them, runs the calculators and schedules the results as an estimation.Plan. The totals of
+// the trials give the chance to complete the migration within a given time, reported as the P50,
+// P80 and P95 completion times.
+package simulation
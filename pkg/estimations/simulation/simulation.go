@@ -0,0 +1,204 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// DefaultTrials is the number of trials run when none is given.
+const DefaultTrials = 1000
+
+// Sampler draws a value of an input. The fitted distributions of the distribution package are
+// samplers too.
+type Sampler interface {
+	Sample(r *rand.Rand) float64
+}
+
+// Uniform draws values evenly between Min and Max.
+type Uniform struct {
+	Min float64
+	Max float64
+}
+
+// Sample draws a value.
+func (u Uniform) Sample(r *rand.Rand) float64 {
+	return u.Min + r.Float64()*(u.Max-u.Min)
+}
+
+// Validate checks the bounds are ordered.
+func (u Uniform) Validate() error {
+	if u.Min > u.Max {
+		return fmt.Errorf("uniform min %g is above max %g", u.Min, u.Max)
+	}
+	return nil
+}
+
+// Triangular draws values between Min and Max, most of them around Mode, the way a three-point
+// estimate is usually given.
+type Triangular struct {
+	Min  float64
+	Mode float64
+	Max  float64
+}
+
+// Sample draws a value by inverting the cumulative distribution.
+func (t Triangular) Sample(r *rand.Rand) float64 {
+	if t.Min == t.Max {
+		return t.Min
+	}
+	u := r.Float64()
+	if u < (t.Mode-t.Min)/(t.Max-t.Min) {
+		return t.Min + math.Sqrt(u*(t.Max-t.Min)*(t.Mode-t.Min))
+	}
+	return t.Max - math.Sqrt((1-u)*(t.Max-t.Min)*(t.Max-t.Mode))
+}
+
+// Validate checks the values are ordered.
+func (t Triangular) Validate() error {
+	if t.Min > t.Mode || t.Mode > t.Max {
+		return fmt.Errorf("triangular values must be ordered min <= mode <= max, got %g, %g, %g", t.Min, t.Mode, t.Max)
+	}
+	return nil
+}
+
+// Simulation runs the calculators of an engine over randomized inputs.
+type Simulation struct {
+	engine *estimation.Engine
+	params []estimation.Param
+	inputs map[string]Sampler
+	deps   map[string][]string
+	trials int
+	seed   uint64
+}
+
+// Option is a functional option for configuring a Simulation.
+type Option func(*Simulation)
+
+// WithInput samples the param key from s in every trial, overriding any fixed value of the param.
+func WithInput(key string, s Sampler) Option {
+	return func(sim *Simulation) {
+		sim.inputs[key] = s
+	}
+}
+
+// WithDependency declares that the result name starts once all of deps are done, see estimation.Plan.
+func WithDependency(name string, deps ...string) Option {
+	return func(sim *Simulation) {
+		sim.deps[name] = append(sim.deps[name], deps...)
+	}
+}
+
+// WithTrials sets the number of trials, DefaultTrials by default.
+func WithTrials(n int) Option {
+	return func(sim *Simulation) {
+		sim.trials = n
+	}
+}
+
+// WithSeed seeds the random numbers, so a simulation run twice gives the same result.
+func WithSeed(seed uint64) Option {
+	return func(sim *Simulation) {
+		sim.seed = seed
+	}
+}
+
+// New creates a Simulation of the engine, run with the fixed params and the inputs of the options.
+func New(engine *estimation.Engine, params []estimation.Param, opts ...Option) *Simulation {
+	sim := &Simulation{
+		engine: engine,
+		params: params,
+		inputs: make(map[string]Sampler),
+		deps:   make(map[string][]string),
+		trials: DefaultTrials,
+	}
+	for _, opt := range opts {
+		opt(sim)
+	}
+	return sim
+}
+
+// Result is the outcome of a Simulation.
+type Result struct {
+	Trials int
+	// Totals are the completion times of the trials, in ascending order.
+	Totals []time.Duration
+	Mean   time.Duration
+	P50    time.Duration
+	P80    time.Duration
+	P95    time.Duration
+}
+
+// Percentile returns the completion time within which a share p of the trials completed, e.g. 0.8
+// for P80.
+func (r Result) Percentile(p float64) time.Duration {
+	if len(r.Totals) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(r.Totals)))) - 1
+	return r.Totals[min(max(rank, 0), len(r.Totals)-1)]
+}
+
+// Run runs the trials. It stops early when ctx is done, and fails when an input is invalid or the
+// dependencies do not make a plan.
+func (s *Simulation) Run(ctx context.Context) (Result, error) {
+	if s.trials <= 0 {
+		return Result{}, errors.New("number of trials must be positive")
+	}
+	keys := make([]string, 0, len(s.inputs))
+	for key, sampler := range s.inputs {
+		if v, ok := sampler.(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Result{}, fmt.Errorf("input %s: %w", key, err)
+			}
+		}
+		keys = append(keys, key)
+	}
+	// sample in a stable order, map iteration would change the draws of a seed
+	slices.Sort(keys)
+
+	params := make([]estimation.Param, 0, len(s.params)+len(keys))
+	for _, p := range s.params {
+		if _, sampled := s.inputs[p.Key]; !sampled {
+			params = append(params, p)
+		}
+	}
+	fixed := len(params)
+
+	r := rand.New(rand.NewPCG(s.seed, s.seed))
+	res := Result{Trials: s.trials, Totals: make([]time.Duration, 0, s.trials)}
+	var sum float64
+	for range s.trials {
+		if err := ctx.Err(); err != nil {
+			return Result{}, err
+		}
+		params = params[:fixed]
+		for _, key := range keys {
+			params = append(params, estimation.Param{Key: key, Value: s.inputs[key].Sample(r)})
+		}
+
+		plan := estimation.NewPlan(s.engine.Run(params))
+		for name, deps := range s.deps {
+			plan.After(name, deps...)
+		}
+		schedule, err := plan.Schedule()
+		if err != nil {
+			return Result{}, err
+		}
+		res.Totals = append(res.Totals, schedule.Total)
+		sum += float64(schedule.Total)
+	}
+
+	slices.Sort(res.Totals)
+	res.Mean = time.Duration(sum / float64(s.trials))
+	res.P50 = res.Percentile(0.5)
+	res.P80 = res.Percentile(0.8)
+	res.P95 = res.Percentile(0.95)
+	return res, nil
+}
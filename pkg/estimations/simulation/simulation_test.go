@@ -0,0 +1,132 @@
+package simulation
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// transfer copies disk_gb at rate_mbps, 100 GB at 800 Mbps take 1024s.
+type transfer struct{}
+
+func (transfer) Name() string   { return "Transfer" }
+func (transfer) Keys() []string { return []string{"disk_gb", "rate_mbps"} }
+func (transfer) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	gb, rate := params["disk_gb"].Value.(float64), params["rate_mbps"].Value.(float64)
+	return estimation.Estimation{Duration: time.Duration(gb * 8 * 1024 / rate * float64(time.Second))}, nil
+}
+
+// fixed takes the same time in every trial.
+type fixed struct {
+	name string
+	d    time.Duration
+}
+
+func (f fixed) Name() string   { return f.name }
+func (f fixed) Keys() []string { return nil }
+func (f fixed) Calculate(map[string]estimation.Param) (estimation.Estimation, error) {
+	return estimation.Estimation{Duration: f.d}, nil
+}
+
+func newEngine() *estimation.Engine {
+	e := estimation.NewEngine()
+	e.Register(transfer{})
+	e.Register(fixed{name: "Cutover", d: time.Hour})
+	return e
+}
+
+func TestSimulation_Run(t *testing.T) {
+	t.Parallel()
+	// 10 TB between 400 and 800 Mbps take between about 29h and 58h
+	params := []estimation.Param{{Key: "disk_gb", Value: 10240.0}, {Key: "rate_mbps", Value: 600.0}}
+	fastest, slowest := 10240*8*1024/800*time.Second, 10240*8*1024/400*time.Second
+
+	res, err := New(newEngine(), params,
+		WithInput("rate_mbps", Uniform{Min: 400, Max: 800}),
+		WithDependency("Cutover", "Transfer"),
+		WithTrials(2000),
+		WithSeed(42),
+	).Run(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if res.Trials != 2000 || len(res.Totals) != 2000 {
+		t.Fatalf("expected 2000 trials, got %d", len(res.Totals))
+	}
+	if res.Totals[0] < fastest+time.Hour || res.Totals[len(res.Totals)-1] > slowest+time.Hour {
+		t.Errorf("expected the totals between %v and %v, got %v to %v", fastest+time.Hour, slowest+time.Hour, res.Totals[0], res.Totals[len(res.Totals)-1])
+	}
+	if !(res.P50 < res.P80 && res.P80 < res.P95) {
+		t.Errorf("expected increasing percentiles, got P50 %v, P80 %v, P95 %v", res.P50, res.P80, res.P95)
+	}
+	// the median rate is 600 Mbps, within a percent
+	median := 10240*8*1024/600*time.Second + time.Hour
+	if diff := res.P50 - median; diff < -median/100 || diff > median/100 {
+		t.Errorf("expected P50 around %v, got %v", median, res.P50)
+	}
+
+	again, err := New(newEngine(), params, WithInput("rate_mbps", Uniform{Min: 400, Max: 800}), WithDependency("Cutover", "Transfer"), WithTrials(2000), WithSeed(42)).Run(context.Background())
+	if err != nil || again.P80 != res.P80 {
+		t.Errorf("expected the same seed to give the same P80 %v, got %v, %v", res.P80, again.P80, err)
+	}
+}
+
+func TestSimulation_Run_Errors(t *testing.T) {
+	t.Parallel()
+	params := []estimation.Param{{Key: "disk_gb", Value: 100.0}}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		opts    []Option
+		wantErr string
+	}{
+		{name: "no trials", ctx: context.Background(), opts: []Option{WithTrials(0)}, wantErr: "number of trials must be positive"},
+		{name: "invalid input", ctx: context.Background(), opts: []Option{WithInput("rate_mbps", Uniform{Min: 800, Max: 400})}, wantErr: "input rate_mbps: uniform min 800 is above max 400"},
+		{name: "unknown dependency", ctx: context.Background(), opts: []Option{WithInput("rate_mbps", Uniform{Min: 400, Max: 800}), WithDependency("Cutover", "Rollback")}, wantErr: `"Cutover" depends on unknown result "Rollback"`},
+		{name: "canceled", ctx: canceled, opts: []Option{WithInput("rate_mbps", Uniform{Min: 400, Max: 800})}, wantErr: "context canceled"},
+	}
+	for _, tc := range cases {
+		if _, err := New(newEngine(), params, tc.opts...).Run(tc.ctx); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("%s: expected error containing %q, got: %v", tc.name, tc.wantErr, err)
+		}
+	}
+}
+
+func TestTriangular_Sample(t *testing.T) {
+	t.Parallel()
+	tri := Triangular{Min: 2, Mode: 3, Max: 8}
+	r := rand.New(rand.NewPCG(1, 1))
+	var sum float64
+	for range 10000 {
+		v := tri.Sample(r)
+		if v < tri.Min || v > tri.Max {
+			t.Fatalf("expected samples between %g and %g, got %g", tri.Min, tri.Max, v)
+		}
+		sum += v
+	}
+	// the mean of a triangular distribution is (min + mode + max) / 3
+	if mean := sum / 10000; mean < 4.25 || mean > 4.41 {
+		t.Errorf("expected a mean around 4.33, got %g", mean)
+	}
+	if err := (Triangular{Min: 2, Mode: 9, Max: 8}).Validate(); err == nil {
+		t.Errorf("expected an unordered triangular to be invalid")
+	}
+}
+
+func TestResult_Percentile(t *testing.T) {
+	t.Parallel()
+	res := Result{Totals: []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}}
+	for p, want := range map[float64]time.Duration{0: 1, 0.5: 5, 0.8: 8, 0.95: 10, 1: 10} {
+		if got := res.Percentile(p); got != want {
+			t.Errorf("P%g: expected %v, got %v", p*100, want, got)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+// Package solver inverts an estimation: given a deadline and the params the program can adjust
+// within bounds, e.g. the transfer rate, the engineers or the parallel cutovers, Solve searches the
+// smallest changes making the estimation end by the deadline.
+//
+// The estimation is a black box evaluated for the end date it lands on, assumed monotonic in each
+// adjustable param: the closer a param to its best bound, the earlier the end. Solve changes a
+// single param when one is enough, bisecting between its current value and its best bound, and moves
+// all of them together otherwise. When even every param at its best bound misses the deadline, the
+// result proves the deadline infeasible within the bounds.
+package solver
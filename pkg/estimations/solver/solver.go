@@ -0,0 +1,297 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// maxSteps bounds the bisection steps of a search, enough to resolve a range to a millionth.
+const maxSteps = 20
+
+// dateFormat formats the dates of the messages.
+const dateFormat = "2006-01-02 15:04"
+
+// Bound is a param Solve may adjust, between Min and Max. Integer params, e.g. engineers, take whole
+// values, the others are resolved to a hundredth.
+type Bound struct {
+	Key     string
+	Min     float64
+	Max     float64
+	Integer bool
+}
+
+// step is the resolution of the values of the param.
+func (b Bound) step() float64 {
+	if b.Integer {
+		return 1
+	}
+	return 0.01
+}
+
+// Evaluate returns the end date of the estimation run with the params.
+type Evaluate func(params []estimation.Param) (time.Time, error)
+
+// Change is the change of a param.
+type Change struct {
+	Key  string
+	From float64
+	To   float64
+}
+
+// Result is what it takes to meet a deadline.
+type Result struct {
+	Deadline time.Time
+	// Feasible tells whether the deadline is met within the bounds.
+	Feasible bool
+	// Changes are the smallest changes meeting the deadline, none when it is met already. When the
+	// deadline is infeasible, they set every param to its best bound: the proof the deadline is missed
+	// even so.
+	Changes []Change
+	// End is the end date of the estimation with the changes.
+	End time.Time
+	// Message explains the result.
+	Message string
+	// Evaluations is the number of estimations run.
+	Evaluations int
+}
+
+// candidate is an adjustable param with an effect on the end date.
+type candidate struct {
+	bound Bound
+	from  float64
+	// best is the bound ending the estimation earlier, end the end date with it.
+	best float64
+	end  time.Time
+}
+
+// value is the value of the param moved by the fraction t from its current value to its best bound,
+// rounded to its resolution towards the best bound.
+func (c candidate) value(t float64) float64 {
+	v := c.from + t*(c.best-c.from)
+	step := c.bound.step()
+	if c.best > c.from {
+		v = math.Min(math.Ceil(v/step-1e-9)*step, c.best)
+	} else {
+		v = math.Max(math.Floor(v/step+1e-9)*step, c.best)
+	}
+	return math.Round(v/step) * step
+}
+
+// share is the fraction of its range to the best bound the param is moved by.
+func (c candidate) share(to float64) float64 {
+	return math.Abs(to-c.from) / math.Abs(c.best-c.from)
+}
+
+type search struct {
+	params      []estimation.Param
+	eval        Evaluate
+	evaluations int
+}
+
+// run evaluates the params with the values replaced.
+func (s *search) run(values map[string]float64) (time.Time, error) {
+	params := make([]estimation.Param, 0, len(s.params)+len(values))
+	for _, p := range s.params {
+		if _, ok := values[p.Key]; !ok {
+			params = append(params, p)
+		}
+	}
+	for key, v := range values {
+		params = append(params, estimation.Param{Key: key, Value: v})
+	}
+	s.evaluations++
+	return s.eval(params)
+}
+
+// bisect searches the smallest fraction t in [0, 1] of the move of the candidates to their best
+// bounds meeting the deadline, knowing t = 1 meets it with the end date given.
+func (s *search) bisect(deadline time.Time, candidates []candidate, end time.Time) (map[string]float64, time.Time, error) {
+	values := func(t float64) map[string]float64 {
+		res := make(map[string]float64, len(candidates))
+		for _, c := range candidates {
+			res[c.bound.Key] = c.value(t)
+		}
+		return res
+	}
+
+	lo, hi := 0.0, 1.0
+	for i := 0; i < maxSteps; i++ {
+		mid := (lo + hi) / 2
+		if same(values(mid), values(hi)) {
+			// the values are resolved: the ones between meet the deadline like those of hi
+			hi = mid
+			continue
+		}
+		if same(values(mid), values(lo)) {
+			lo = mid
+			continue
+		}
+		midEnd, err := s.run(values(mid))
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if midEnd.After(deadline) {
+			lo = mid
+		} else {
+			hi, end = mid, midEnd
+		}
+	}
+	return values(hi), end, nil
+}
+
+func same(a, b map[string]float64) bool {
+	for key, v := range a {
+		if b[key] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Solve searches the smallest changes of the bounded params making the estimation of params end by
+// the deadline. A single param is changed when one is enough, the one moved by the smallest fraction
+// of the way to its best bound; otherwise all the params with an effect move together by the same
+// fraction. The params to adjust must have a numeric value in params.
+func Solve(params []estimation.Param, deadline time.Time, bounds []Bound, eval Evaluate) (Result, error) {
+	current, err := currentValues(params, bounds)
+	if err != nil {
+		return Result{}, err
+	}
+
+	s := search{params: params, eval: eval}
+	res := Result{Deadline: deadline}
+	end, err := s.run(nil)
+	if err != nil {
+		return Result{}, err
+	}
+	if !end.After(deadline) {
+		res.Feasible, res.End, res.Evaluations = true, end, s.evaluations
+		res.Message = fmt.Sprintf("the estimation ends on %s, by the deadline of %s", end.Format(dateFormat), deadline.Format(dateFormat))
+		return res, nil
+	}
+
+	// the best bound of each param is the one ending the estimation earlier; params with no effect
+	// are left as they are
+	var candidates []candidate
+	all := map[string]float64{}
+	for _, b := range bounds {
+		endMin, err := s.run(map[string]float64{b.Key: b.Min})
+		if err != nil {
+			return Result{}, err
+		}
+		endMax, err := s.run(map[string]float64{b.Key: b.Max})
+		if err != nil {
+			return Result{}, err
+		}
+		c := candidate{bound: b, from: current[b.Key], best: b.Max, end: endMax}
+		if endMin.Before(endMax) {
+			c.best, c.end = b.Min, endMin
+		}
+		if !c.end.Before(end) || c.best == c.from {
+			continue
+		}
+		candidates = append(candidates, c)
+		all[b.Key] = c.best
+	}
+
+	allEnd := end
+	if len(candidates) > 0 {
+		if allEnd, err = s.run(all); err != nil {
+			return Result{}, err
+		}
+	}
+	if allEnd.After(deadline) {
+		res.Changes, res.End, res.Evaluations = changes(candidates, all), allEnd, s.evaluations
+		res.Message = fmt.Sprintf("infeasible within the bounds: the estimation ends on %s, after the deadline of %s", allEnd.Format(dateFormat), deadline.Format(dateFormat))
+		if len(res.Changes) > 0 {
+			res.Message += fmt.Sprintf(", even with %s", describe(res.Changes))
+		}
+		return res, nil
+	}
+
+	// a single param when one is enough, the one moved by the smallest share of its range
+	var single []Change
+	share := math.Inf(1)
+	for _, c := range candidates {
+		if c.end.After(deadline) {
+			continue
+		}
+		values, cEnd, err := s.bisect(deadline, []candidate{c}, c.end)
+		if err != nil {
+			return Result{}, err
+		}
+		if to := values[c.bound.Key]; c.share(to) < share {
+			single, share, end = changes([]candidate{c}, values), c.share(to), cEnd
+		}
+	}
+	if single == nil {
+		values, allEnd, err := s.bisect(deadline, candidates, allEnd)
+		if err != nil {
+			return Result{}, err
+		}
+		single, end = changes(candidates, values), allEnd
+	}
+
+	res.Feasible, res.Changes, res.End, res.Evaluations = true, single, end, s.evaluations
+	res.Message = fmt.Sprintf("%s ends the estimation on %s, by the deadline of %s", describe(res.Changes), end.Format(dateFormat), deadline.Format(dateFormat))
+	return res, nil
+}
+
+// currentValues returns the values of the params to adjust, checking their bounds.
+func currentValues(params []estimation.Param, bounds []Bound) (map[string]float64, error) {
+	if len(bounds) == 0 {
+		return nil, errors.New("no param to adjust")
+	}
+	values := make(map[string]float64, len(params))
+	for _, p := range params {
+		switch v := p.Value.(type) {
+		case float64:
+			values[p.Key] = v
+		case int:
+			values[p.Key] = float64(v)
+		}
+	}
+
+	current := make(map[string]float64, len(bounds))
+	for _, b := range bounds {
+		switch {
+		case b.Key == "":
+			return nil, errors.New("param to adjust without key")
+		case math.IsNaN(b.Min) || math.IsNaN(b.Max) || b.Min > b.Max:
+			return nil, fmt.Errorf("invalid bounds [%g, %g] of param %s", b.Min, b.Max, b.Key)
+		}
+		if _, ok := current[b.Key]; ok {
+			return nil, fmt.Errorf("param %s adjusted twice", b.Key)
+		}
+		v, ok := values[b.Key]
+		if !ok {
+			return nil, fmt.Errorf("param %s to adjust has no numeric value", b.Key)
+		}
+		current[b.Key] = v
+	}
+	return current, nil
+}
+
+// changes returns the changes of the candidates to the values, in the order of the bounds.
+func changes(candidates []candidate, values map[string]float64) []Change {
+	var res []Change
+	for _, c := range candidates {
+		if to, ok := values[c.bound.Key]; ok && to != c.from {
+			res = append(res, Change{Key: c.bound.Key, From: c.from, To: to})
+		}
+	}
+	return res
+}
+
+func describe(changes []Change) string {
+	parts := make([]string, 0, len(changes))
+	for _, c := range changes {
+		parts = append(parts, fmt.Sprintf("%s from %g to %g", c.Key, c.From, c.To))
+	}
+	return strings.Join(parts, ", ")
+}
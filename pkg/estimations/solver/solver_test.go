@@ -0,0 +1,125 @@
+package solver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+var start = time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+// testEval ends the estimation after 1000 hours of work shared by the engineers and 2000 GB·h/Mb of
+// transfer over the link, in hours from start.
+func testEval(params []estimation.Param) (time.Time, error) {
+	values := map[string]float64{}
+	for _, p := range params {
+		values[p.Key] = p.Value.(float64)
+	}
+	hours := 1000/values["engineers"] + 2000/values["rate"]
+	return start.Add(time.Duration(hours * float64(time.Hour))), nil
+}
+
+var testParams = []estimation.Param{
+	{Key: "engineers", Value: 2.0},
+	{Key: "rate", Value: 100.0},
+	{Key: "vm_count", Value: 500.0},
+}
+
+func deadline(hours int) time.Time {
+	return start.Add(time.Duration(hours) * time.Hour)
+}
+
+func TestSolve_Met(t *testing.T) {
+	t.Parallel()
+	res, err := Solve(testParams, deadline(600), []Bound{{Key: "engineers", Min: 1, Max: 5, Integer: true}}, testEval)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !res.Feasible || len(res.Changes) != 0 || res.End != deadline(520) || res.Evaluations != 1 {
+		t.Errorf("expected the deadline met without change, got %+v", res)
+	}
+}
+
+func TestSolve_SingleParam(t *testing.T) {
+	t.Parallel()
+	// the link alone can not save 220 hours, 4 engineers can
+	res, err := Solve(testParams, deadline(300), []Bound{
+		{Key: "engineers", Min: 1, Max: 10, Integer: true},
+		{Key: "rate", Min: 50, Max: 1000},
+	}, testEval)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !res.Feasible || len(res.Changes) != 1 || res.Changes[0] != (Change{Key: "engineers", From: 2, To: 4}) {
+		t.Fatalf("expected 4 engineers, got %+v", res)
+	}
+	if res.End != deadline(270) {
+		t.Errorf("expected the estimation to end after 270 hours, got %v", res.End.Sub(start))
+	}
+	if !strings.HasPrefix(res.Message, "engineers from 2 to 4 ends the estimation on 2026-01-16 06:00") {
+		t.Errorf("unexpected message %q", res.Message)
+	}
+}
+
+func TestSolve_AllParams(t *testing.T) {
+	t.Parallel()
+	// 5 engineers end after 220 hours, the link after 502: both have to change
+	res, err := Solve(testParams, deadline(210), []Bound{
+		{Key: "engineers", Min: 1, Max: 5, Integer: true},
+		{Key: "rate", Min: 50, Max: 1000},
+	}, testEval)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !res.Feasible || len(res.Changes) != 2 || res.End.After(res.Deadline) {
+		t.Fatalf("expected both params changed to meet the deadline, got %+v", res)
+	}
+	if res.Changes[0].To != 5 || res.Changes[1].To >= 1000 || res.Changes[1].To <= 100 {
+		t.Errorf("expected 5 engineers and a link below its bound, got %+v", res.Changes)
+	}
+}
+
+func TestSolve_Infeasible(t *testing.T) {
+	t.Parallel()
+	res, err := Solve(testParams, deadline(100), []Bound{
+		{Key: "engineers", Min: 1, Max: 5, Integer: true},
+		{Key: "rate", Min: 50, Max: 1000},
+		{Key: "vm_count", Min: 0, Max: 1000},
+	}, testEval)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if res.Feasible || res.End != deadline(202) {
+		t.Fatalf("expected the deadline infeasible, ending after 202 hours at best, got %+v", res)
+	}
+	want := []Change{{Key: "engineers", From: 2, To: 5}, {Key: "rate", From: 100, To: 1000}}
+	if len(res.Changes) != len(want) || res.Changes[0] != want[0] || res.Changes[1] != want[1] {
+		t.Errorf("expected the params without effect left out of the proof, got %+v", res.Changes)
+	}
+	if !strings.HasPrefix(res.Message, "infeasible within the bounds") {
+		t.Errorf("unexpected message %q", res.Message)
+	}
+}
+
+func TestSolve_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		bounds []Bound
+	}{
+		{name: "no bound"},
+		{name: "no value", bounds: []Bound{{Key: "parallel_cutovers", Min: 1, Max: 4}}},
+		{name: "inverted bounds", bounds: []Bound{{Key: "rate", Min: 1000, Max: 50}}},
+		{name: "adjusted twice", bounds: []Bound{{Key: "rate", Min: 50, Max: 100}, {Key: "rate", Min: 50, Max: 1000}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := Solve(testParams, deadline(100), tt.bounds, testEval); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package converters
+
+import (
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+const mibPerGiB = 1024.0
+
+// VMFromModel converts a VM read by the duckdb parser into the per-VM domain model.
+// The disk size is the sum of the VM's disks; the total disk capacity reported in vInfo
+// is used when the disk table has no rows for the VM.
+func VMFromModel(vm models.VM) inventory.VM {
+	var diskMiB int64
+	disks := make([]inventory.Disk, 0, len(vm.Disks))
+	for _, d := range vm.Disks {
+		diskMiB += d.Capacity
+		disks = append(disks, inventory.Disk{
+			Controller: d.Controller,
+			Bus:        d.Bus,
+			CapacityGB: float64(d.Capacity) / mibPerGiB,
+			RDM:        d.RDM,
+			Mode:       d.Mode,
+		})
+	}
+	if diskMiB == 0 {
+		diskMiB = int64(vm.TotalDiskCapacityMiB)
+	}
+
+	nics := make([]inventory.NIC, 0, len(vm.NICs))
+	for _, n := range vm.NICs {
+		nics = append(nics, inventory.NIC{Adapter: n.Adapter})
+	}
+
+	concerns := make([]inventory.Concern, 0, len(vm.Concerns))
+	for _, c := range vm.Concerns {
+		concerns = append(concerns, inventory.Concern{
+			ID:         c.Id,
+			Label:      c.Label,
+			Category:   c.Category,
+			Assessment: c.Assessment,
+		})
+	}
+
+	return inventory.VM{
+		ID:                    vm.ID,
+		Name:                  vm.Name,
+		Datacenter:            vm.Datacenter,
+		Cluster:               vm.Cluster,
+		Folder:                inventory.NormalizeFolder(vm.FolderPath, vm.Datacenter),
+		VApp:                  vm.VApp,
+		GuestOS:               vm.EffectiveGuestName(),
+		PowerState:            vm.PowerState,
+		CPUCount:              int(vm.CpuCount),
+		MemoryMB:              int(vm.MemoryMB),
+		DiskGB:                float64(diskMiB) / mibPerGiB,
+		ChangeTrackingEnabled: vm.ChangeTrackingEnabled,
+		ToolsStatus:           vm.ToolsStatus,
+		Firmware:              strings.ToLower(vm.Firmware),
+		SecureBoot:            vm.SecureBoot,
+		TPM:                   vm.TPM,
+		Disks:                 disks,
+		NICs:                  nics,
+		Concerns:              concerns,
+	}
+}
+
+// VMsFromModels converts a list of parser VMs, preserving order.
+func VMsFromModels(vms []models.VM) []inventory.VM {
+	res := make([]inventory.VM, 0, len(vms))
+	for _, vm := range vms {
+		res = append(res, VMFromModel(vm))
+	}
+	return res
+}
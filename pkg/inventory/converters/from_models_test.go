@@ -0,0 +1,81 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVMFromModel(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    models.VM
+		validate func(t *testing.T, result inventory.VM)
+	}{
+		{
+			name: "disk size summed from disks",
+			input: models.VM{
+				ID:                       "vm-1",
+				Name:                     "db01",
+				Cluster:                  "cluster-1",
+				PowerState:               inventory.PowerStateOn,
+				CpuCount:                 4,
+				MemoryMB:                 8192,
+				GuestName:                "Red Hat Enterprise Linux 8",
+				GuestNameFromVmwareTools: "Red Hat Enterprise Linux 8 (64-bit)",
+				TotalDiskCapacityMiB:     1,
+				ChangeTrackingEnabled:    true,
+				Firmware:                 "EFI",
+				Disks: models.Disks{
+					{Capacity: 10240, Controller: "SCSI controller 0", Bus: "scsi"},
+					{Capacity: 2048, Controller: "IDE 0", Bus: "ide"},
+				},
+				NICs: models.NICs{{Adapter: "Vmxnet3"}},
+				Concerns: models.Concerns{
+					{Id: "rdm", Label: "RDM disk", Category: inventory.ConcernCategoryCritical},
+				},
+			},
+			validate: func(t *testing.T, result inventory.VM) {
+				assert.Equal(t, "vm-1", result.ID)
+				assert.Equal(t, "Red Hat Enterprise Linux 8 (64-bit)", result.GuestOS)
+				assert.Equal(t, 4, result.CPUCount)
+				assert.Equal(t, 8192, result.MemoryMB)
+				assert.InDelta(t, 12.0, result.DiskGB, 1e-9)
+				assert.True(t, result.ChangeTrackingEnabled)
+				assert.Equal(t, inventory.FirmwareEFI, result.Firmware)
+				require.Len(t, result.Disks, 2)
+				assert.Equal(t, inventory.Disk{Controller: "IDE 0", Bus: "ide", CapacityGB: 2}, result.Disks[1])
+				assert.Equal(t, []inventory.NIC{{Adapter: "Vmxnet3"}}, result.NICs)
+				require.Len(t, result.Concerns, 1)
+				assert.Equal(t, "RDM disk", result.Concerns[0].Label)
+				_, critical := result.CriticalConcern()
+				assert.True(t, critical)
+			},
+		},
+		{
+			name:  "falls back to vInfo capacity without disks",
+			input: models.VM{ID: "vm-2", TotalDiskCapacityMiB: 51200},
+			validate: func(t *testing.T, result inventory.VM) {
+				assert.InDelta(t, 50.0, result.DiskGB, 1e-9)
+				assert.Empty(t, result.Concerns)
+			},
+		},
+		{
+			name:  "folder path normalized from the datacenter VM folder",
+			input: models.VM{ID: "vm-3", Datacenter: "DC1", FolderPath: "/DC1/vm/Finance/Payroll", VApp: "payroll-app"},
+			validate: func(t *testing.T, result inventory.VM) {
+				assert.Equal(t, "Finance/Payroll", result.Folder)
+				assert.Equal(t, "payroll-app", result.VApp)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.validate(t, VMFromModel(tt.input))
+		})
+	}
+}
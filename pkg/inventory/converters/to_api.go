@@ -1,6 +1,7 @@
 package converters
 
 import (
+	"slices"
 	"strings"
 
 	api "github.com/kubev2v/migration-planner/api/v1alpha1"
@@ -94,6 +95,12 @@ func toAPIVMs(v *inventory.VMsData) api.VMs {
 	migratableWithWarnings := v.TotalMigratableWithWarnings
 	totalWithSharedDisks := v.TotalWithSharedDisks
 
+	var folders *[]api.VMFolder
+	if v.Folders != nil {
+		f := toAPIFolders(v.Folders)
+		folders = &f
+	}
+
 	return api.VMs{
 		Total:                       v.Total,
 		TotalMigratable:             v.TotalMigratable,
@@ -134,7 +141,27 @@ func toAPIVMs(v *inventory.VMsData) api.VMs {
 		DiskTypes:                &diskTypes,
 		MigrationWarnings:        migrationWarnings,
 		NotMigratableReasons:     notMigratableReasons,
+		Folders:                  folders,
+	}
+}
+
+func toAPIFolders(folders []inventory.Folder) []api.VMFolder {
+	res := make([]api.VMFolder, 0, len(folders))
+	for _, f := range folders {
+		folder := api.VMFolder{
+			Name:    f.Name,
+			Path:    f.Path,
+			VApp:    f.VApp,
+			VmCount: f.VMs,
+			DiskGB:  f.DiskGB,
+		}
+		if len(f.Children) > 0 {
+			children := toAPIFolders(f.Children)
+			folder.Children = &children
+		}
+		res = append(res, folder)
 	}
+	return res
 }
 
 func toAPIInfra(i *inventory.InfraData) api.Infra {
@@ -160,6 +187,14 @@ func toAPIInfra(i *inventory.InfraData) api.Infra {
 			mem := int64(h.MemoryMB)
 			host.MemoryMB = &mem
 		}
+		if len(h.NICSpeedsMbps) > 0 {
+			speeds := slices.Clone(h.NICSpeedsMbps)
+			host.NicSpeedsMbps = &speeds
+		}
+		if h.Version != "" {
+			version := h.Version
+			host.Version = &version
+		}
 		hosts = append(hosts, host)
 	}
 
@@ -222,6 +257,10 @@ func toAPIInfra(i *inventory.InfraData) api.Infra {
 	if len(i.ClustersPerDatacenter) > 0 {
 		infra.ClustersPerDatacenter = &i.ClustersPerDatacenter
 	}
+	if i.VCenterVersion != "" {
+		version := i.VCenterVersion
+		infra.VcenterVersion = &version
+	}
 
 	return infra
 }
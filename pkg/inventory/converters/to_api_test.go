@@ -292,6 +292,31 @@ func TestToAPIVMs_MigrationIssues(t *testing.T) {
 	}
 }
 
+func TestToAPIVMs_Folders(t *testing.T) {
+	result := toAPIVMs(&inventory.VMsData{})
+	assert.Nil(t, result.Folders, "no folders when the source does not report them")
+
+	result = toAPIVMs(&inventory.VMsData{
+		Folders: inventory.FolderTree([]inventory.VM{
+			{Folder: "Finance/Payroll", DiskGB: 100},
+			{Folder: "Finance/Payroll", VApp: "web", DiskGB: 20},
+		}),
+	})
+	require.NotNil(t, result.Folders)
+	require.Len(t, *result.Folders, 1)
+	finance := (*result.Folders)[0]
+	assert.Equal(t, "Finance", finance.Name)
+	assert.Equal(t, 2, finance.VmCount)
+	assert.InDelta(t, 120.0, finance.DiskGB, 1e-9)
+	require.NotNil(t, finance.Children)
+	payroll := (*finance.Children)[0]
+	assert.Equal(t, "Finance/Payroll", payroll.Path)
+	require.NotNil(t, payroll.Children)
+	web := (*payroll.Children)[0]
+	assert.True(t, web.VApp)
+	assert.Nil(t, web.Children)
+}
+
 func TestAnonymizeNFSDatastore(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -368,12 +393,14 @@ func TestToAPIInfra_Hosts(t *testing.T) {
 			input: inventory.InfraData{
 				Hosts: []inventory.Host{
 					{
-						ID:         "host-123",
-						Vendor:     "Dell",
-						Model:      "PowerEdge R740",
-						CpuCores:   32,
-						CpuSockets: 2,
-						MemoryMB:   131072,
+						ID:            "host-123",
+						Vendor:        "Dell",
+						Model:         "PowerEdge R740",
+						CpuCores:      32,
+						CpuSockets:    2,
+						MemoryMB:      131072,
+						NICSpeedsMbps: []int{10000, 25000},
+						Version:       "7.0.3",
 					},
 				},
 				HostPowerStates: map[string]int{"poweredOn": 1},
@@ -439,6 +466,20 @@ func TestToAPIInfra_Hosts(t *testing.T) {
 				} else {
 					assert.Nil(t, actual.MemoryMB)
 				}
+
+				if len(expected.NICSpeedsMbps) > 0 {
+					require.NotNil(t, actual.NicSpeedsMbps)
+					assert.Equal(t, expected.NICSpeedsMbps, *actual.NicSpeedsMbps)
+				} else {
+					assert.Nil(t, actual.NicSpeedsMbps)
+				}
+
+				if expected.Version != "" {
+					require.NotNil(t, actual.Version)
+					assert.Equal(t, expected.Version, *actual.Version)
+				} else {
+					assert.Nil(t, actual.Version)
+				}
 			}
 		})
 	}
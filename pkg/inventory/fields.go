@@ -0,0 +1,154 @@
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// FieldType is the type of the values of an extension field.
+type FieldType string
+
+// Types of the extension fields.
+const (
+	FieldTypeString     FieldType = "string"
+	FieldTypeNumber     FieldType = "number"
+	FieldTypeBoolean    FieldType = "boolean"
+	FieldTypeStringList FieldType = "stringList"
+)
+
+// FieldSource is how the values of an extension field are populated.
+type FieldSource string
+
+// Sources of the extension fields.
+const (
+	// FieldSourcePlugin is an agent plugin, the field is named "<plugin>.<fact>".
+	FieldSourcePlugin FieldSource = "plugin"
+	// FieldSourceImport is an inventory importer, e.g. a spreadsheet column.
+	FieldSourceImport FieldSource = "import"
+)
+
+var fieldName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*\.[a-z0-9][a-z0-9_-]*$`)
+
+// Field is an extension field registered so its values are checked and it can be referenced by name,
+// e.g. by assessment rules, filters and report templates.
+type Field struct {
+	// Name is the key of the field in the Extensions of a VM, "<prefix>.<name>", e.g. "software.installed".
+	Name        string      `json:"name"`
+	Type        FieldType   `json:"type"`
+	Source      FieldSource `json:"source"`
+	Description string      `json:"description,omitempty"`
+}
+
+// Validate checks the field is well named and of a known type and source.
+func (f Field) Validate() error {
+	if !fieldName.MatchString(f.Name) {
+		return fmt.Errorf("invalid field name %q: expected <prefix>.<name> of lowercase letters, digits, '-' and '_'", f.Name)
+	}
+	switch f.Type {
+	case FieldTypeString, FieldTypeNumber, FieldTypeBoolean, FieldTypeStringList:
+	default:
+		return fmt.Errorf("field %s has unknown type %q", f.Name, f.Type)
+	}
+	switch f.Source {
+	case FieldSourcePlugin, FieldSourceImport:
+	default:
+		return fmt.Errorf("field %s has unknown source %q", f.Name, f.Source)
+	}
+	return nil
+}
+
+// Check checks the value is of the type of the field. Numbers may be of any Go numeric type and lists
+// may be decoded from JSON as []any.
+func (f Field) Check(value any) error {
+	ok := false
+	switch f.Type {
+	case FieldTypeString:
+		_, ok = value.(string)
+	case FieldTypeNumber:
+		switch value.(type) {
+		case int, int32, int64, float32, float64:
+			ok = true
+		}
+	case FieldTypeBoolean:
+		_, ok = value.(bool)
+	case FieldTypeStringList:
+		switch v := value.(type) {
+		case []string:
+			ok = true
+		case []any:
+			ok = true
+			for _, item := range v {
+				if _, isString := item.(string); !isString {
+					ok = false
+					break
+				}
+			}
+		}
+	}
+	if !ok {
+		return fmt.Errorf("field %s expects a %s, got %T", f.Name, f.Type, value)
+	}
+	return nil
+}
+
+// Schema is the set of extension fields of an organization.
+type Schema struct {
+	Fields []Field `json:"fields"`
+}
+
+// Validate checks the fields are valid and registered once.
+func (s Schema) Validate() error {
+	seen := make(map[string]bool, len(s.Fields))
+	for _, f := range s.Fields {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("field %s registered twice", f.Name)
+		}
+		seen[f.Name] = true
+	}
+	return nil
+}
+
+// Field returns the field registered under name.
+func (s Schema) Field(name string) (Field, bool) {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// Reference checks a rule, filter or report template can reference the field name and returns it.
+func (s Schema) Reference(name string) (Field, error) {
+	f, ok := s.Field(name)
+	if !ok {
+		return Field{}, fmt.Errorf("unknown inventory field %q", name)
+	}
+	return f, nil
+}
+
+// Check checks the extensions of the VM are registered fields holding values of their type.
+func (s Schema) Check(vm VM) error {
+	keys := make([]string, 0, len(vm.Extensions))
+	for k := range vm.Extensions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var failures []error
+	for _, k := range keys {
+		f, err := s.Reference(k)
+		if err == nil {
+			err = f.Check(vm.Extensions[k])
+		}
+		if err != nil {
+			failures = append(failures, fmt.Errorf("VM %s: %w", vm.ID, err))
+		}
+	}
+	return errors.Join(failures...)
+}
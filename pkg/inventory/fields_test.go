@@ -0,0 +1,73 @@
+package inventory
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchema_Validate(t *testing.T) {
+	t.Parallel()
+	valid := Field{Name: "software.installed", Type: FieldTypeStringList, Source: FieldSourcePlugin}
+
+	cases := []struct {
+		name    string
+		schema  Schema
+		wantErr string
+	}{
+		{name: "valid", schema: Schema{Fields: []Field{valid, {Name: "cmdb.owner", Type: FieldTypeString, Source: FieldSourceImport}}}},
+		{name: "no prefix", schema: Schema{Fields: []Field{{Name: "owner", Type: FieldTypeString, Source: FieldSourceImport}}}, wantErr: `invalid field name "owner"`},
+		{name: "unknown type", schema: Schema{Fields: []Field{{Name: "cmdb.owner", Type: "date", Source: FieldSourceImport}}}, wantErr: `unknown type "date"`},
+		{name: "unknown source", schema: Schema{Fields: []Field{{Name: "cmdb.owner", Type: FieldTypeString, Source: "manual"}}}, wantErr: `unknown source "manual"`},
+		{name: "duplicate", schema: Schema{Fields: []Field{valid, valid}}, wantErr: "registered twice"},
+	}
+	for _, tc := range cases {
+		err := tc.schema.Validate()
+		if tc.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: expected no error, got: %v", tc.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("%s: expected error containing %q, got: %v", tc.name, tc.wantErr, err)
+		}
+	}
+}
+
+func TestSchema_Check(t *testing.T) {
+	t.Parallel()
+	schema := Schema{Fields: []Field{
+		{Name: "software.installed", Type: FieldTypeStringList, Source: FieldSourcePlugin},
+		{Name: "accounts.local_admins", Type: FieldTypeNumber, Source: FieldSourcePlugin},
+		{Name: "cmdb.critical", Type: FieldTypeBoolean, Source: FieldSourceImport},
+	}}
+
+	ok := VM{ID: "vm-1", Extensions: map[string]any{
+		"software.installed":    []any{"nginx", "postgresql"},
+		"accounts.local_admins": 2,
+		"cmdb.critical":         true,
+	}}
+	if err := schema.Check(ok); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+
+	bad := VM{ID: "vm-2", Extensions: map[string]any{
+		"software.installed":    []any{"nginx", 3},
+		"accounts.local_admins": "2",
+		"cmdb.owner":            "team-a",
+	}}
+	err := schema.Check(bad)
+	for _, want := range []string{
+		"VM vm-2: field software.installed expects a stringList",
+		"VM vm-2: field accounts.local_admins expects a number, got string",
+		`VM vm-2: unknown inventory field "cmdb.owner"`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q, got: %v", want, err)
+		}
+	}
+
+	if f, err := schema.Reference("cmdb.critical"); err != nil || f.Type != FieldTypeBoolean {
+		t.Errorf("expected the cmdb.critical boolean, got %+v, %v", f, err)
+	}
+}
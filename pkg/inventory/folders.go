@@ -0,0 +1,143 @@
+package inventory
+
+import (
+	"sort"
+	"strings"
+)
+
+// Folder is a folder or a vApp of the source hierarchy with the VMs under it. Many organizations
+// encode their application boundaries in their folders, so waves and target namespaces are scoped
+// by folder.
+type Folder struct {
+	Name string
+	// Path is the path of the folder from the VM folder of its datacenter, e.g. "Finance/Payroll".
+	Path string
+	// VApp tells the folder is a vApp.
+	VApp bool
+	// VMs and DiskGB are the VMs under the folder, its subfolders included, and their disk size.
+	VMs      int
+	DiskGB   float64
+	Children []Folder
+}
+
+// FolderCount is the number of VMs placed directly in a folder, or a vApp of the folder, and their
+// disk size.
+type FolderCount struct {
+	Folder string
+	VApp   string
+	VMs    int
+	DiskGB float64
+}
+
+// NormalizeFolder returns the path of a folder from the VM folder of its datacenter, as the sources
+// report it: "/DC1/vm/Finance/Payroll", "DC1/Finance/Payroll" and "Finance/Payroll/" are all
+// "Finance/Payroll" in datacenter DC1.
+func NormalizeFolder(path, datacenter string) string {
+	segments := splitPath(path)
+	if len(segments) > 0 && datacenter != "" && segments[0] == datacenter {
+		segments = segments[1:]
+	}
+	if len(segments) > 0 && segments[0] == "vm" {
+		segments = segments[1:]
+	}
+	return strings.Join(segments, "/")
+}
+
+// Hierarchy returns the path of the VM in the folder hierarchy, its vApp under its folder, empty for
+// a VM in the VM folder of its datacenter.
+func (vm VM) Hierarchy() string {
+	return strings.Join(append(splitPath(vm.Folder), splitPath(vm.VApp)...), "/")
+}
+
+// InFolder tells whether the VM is in the folder or vApp of the path, or in one under it.
+func (vm VM) InFolder(path string) bool {
+	return InFolder(vm.Hierarchy(), path)
+}
+
+// InFolder tells whether the hierarchy path, e.g. of a VM, is the folder of the path or under it.
+func InFolder(hierarchy, path string) bool {
+	path = strings.Join(splitPath(path), "/")
+	if path == "" {
+		return false
+	}
+	hierarchy = strings.Join(splitPath(hierarchy), "/")
+	return hierarchy == path || strings.HasPrefix(hierarchy, path+"/")
+}
+
+// FolderTree returns the folder hierarchy of the VMs, see BuildFolderTree.
+func FolderTree(vms []VM) []Folder {
+	type key struct{ folder, vApp string }
+	byFolder := make(map[key]*FolderCount)
+	var counts []*FolderCount
+	for _, vm := range vms {
+		k := key{vm.Folder, vm.VApp}
+		c, ok := byFolder[k]
+		if !ok {
+			c = &FolderCount{Folder: vm.Folder, VApp: vm.VApp}
+			byFolder[k] = c
+			counts = append(counts, c)
+		}
+		c.VMs++
+		c.DiskGB += vm.DiskGB
+	}
+	res := make([]FolderCount, 0, len(counts))
+	for _, c := range counts {
+		res = append(res, *c)
+	}
+	return BuildFolderTree(res)
+}
+
+// BuildFolderTree returns the folders holding the counted VMs, their parents included, as a tree
+// sorted by name. The VMs in the VM folder of their datacenter are in no folder.
+func BuildFolderTree(counts []FolderCount) []Folder {
+	type node struct {
+		Folder
+		children map[string]*node
+	}
+	root := &node{children: make(map[string]*node)}
+	for _, c := range counts {
+		folders, vApps := splitPath(c.Folder), splitPath(c.VApp)
+		n := root
+		for i, name := range append(folders, vApps...) {
+			child, ok := n.children[name]
+			if !ok {
+				path := name
+				if n != root {
+					path = n.Path + "/" + name
+				}
+				child = &node{Folder: Folder{Name: name, Path: path, VApp: i >= len(folders)}, children: make(map[string]*node)}
+				n.children[name] = child
+			}
+			child.VMs += c.VMs
+			child.DiskGB += c.DiskGB
+			n = child
+		}
+	}
+
+	var build func(n *node) []Folder
+	build = func(n *node) []Folder {
+		if len(n.children) == 0 {
+			return nil
+		}
+		res := make([]Folder, 0, len(n.children))
+		for _, child := range n.children {
+			f := child.Folder
+			f.Children = build(child)
+			res = append(res, f)
+		}
+		sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+		return res
+	}
+	return build(root)
+}
+
+// splitPath splits a "/"-separated path into its non-empty segments.
+func splitPath(path string) []string {
+	var res []string
+	for _, s := range strings.Split(path, "/") {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
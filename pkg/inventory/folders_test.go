@@ -0,0 +1,70 @@
+package inventory
+
+import (
+	"testing"
+)
+
+func TestNormalizeFolder(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"/DC1/vm/Finance/Payroll": "Finance/Payroll",
+		"DC1/Finance/Payroll":     "Finance/Payroll",
+		"Finance/Payroll/":        "Finance/Payroll",
+		"vm":                      "",
+		"":                        "",
+	}
+	for path, want := range cases {
+		if got := NormalizeFolder(path, "DC1"); got != want {
+			t.Errorf("NormalizeFolder(%q): expected %q, got %q", path, want, got)
+		}
+	}
+}
+
+func TestVM_InFolder(t *testing.T) {
+	t.Parallel()
+	vm := VM{Folder: "Finance/Payroll", VApp: "payroll-app"}
+	if vm.Hierarchy() != "Finance/Payroll/payroll-app" {
+		t.Errorf("unexpected hierarchy %q", vm.Hierarchy())
+	}
+	for path, want := range map[string]bool{
+		"Finance":                     true,
+		"/Finance/Payroll/":           true,
+		"Finance/Payroll/payroll-app": true,
+		"Fin":                         false,
+		"HR":                          false,
+		"":                            false,
+	} {
+		if got := vm.InFolder(path); got != want {
+			t.Errorf("InFolder(%q): expected %t, got %t", path, want, got)
+		}
+	}
+}
+
+func TestFolderTree(t *testing.T) {
+	t.Parallel()
+	tree := FolderTree([]VM{
+		{Name: "payroll-db", Folder: "Finance/Payroll", DiskGB: 100},
+		{Name: "payroll-web", Folder: "Finance/Payroll", VApp: "web", DiskGB: 20},
+		{Name: "ledger", Folder: "Finance", DiskGB: 50},
+		{Name: "hr", Folder: "HR", DiskGB: 10},
+		{Name: "loose"},
+	})
+
+	if len(tree) != 2 || tree[0].Name != "Finance" || tree[1].Name != "HR" {
+		t.Fatalf("expected the Finance and HR folders, got %+v", tree)
+	}
+	finance := tree[0]
+	if finance.VMs != 3 || finance.DiskGB != 170 || len(finance.Children) != 1 {
+		t.Errorf("expected 3 VMs of 170 GB under Finance, got %+v", finance)
+	}
+	payroll := finance.Children[0]
+	if payroll.Path != "Finance/Payroll" || payroll.VMs != 2 || len(payroll.Children) != 1 {
+		t.Fatalf("unexpected payroll folder %+v", payroll)
+	}
+	if web := payroll.Children[0]; !web.VApp || web.Path != "Finance/Payroll/web" || web.VMs != 1 {
+		t.Errorf("expected the web vApp under payroll, got %+v", web)
+	}
+	if payroll.VApp || finance.VApp {
+		t.Error("expected the folders not flagged as vApps")
+	}
+}
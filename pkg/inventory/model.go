@@ -35,6 +35,8 @@ type VMsData struct {
 	DiskTypes                   map[string]DiskTypeSummary
 	MigrationWarnings           []MigrationIssue
 	NotMigratableReasons        []MigrationIssue
+	// Folders is the folder and vApp hierarchy of the VMs. Nil when the source does not report it.
+	Folders []Folder
 }
 
 // InfraData contains infrastructure-level data (hosts, datastores, networks).
@@ -48,6 +50,8 @@ type InfraData struct {
 	ClustersPerDatacenter []int
 	CPUOverCommitment     *float64
 	MemoryOverCommitment  *float64
+	// VCenterVersion is the version of the vCenter managing the hosts, e.g. "8.0.3". Empty when unknown.
+	VCenterVersion string
 }
 
 // ResourceBreakdown contains resource totals split by migrability status.
@@ -95,6 +99,10 @@ type Host struct {
 	CpuCores   int
 	CpuSockets int
 	MemoryMB   int
+	// Version is the ESXi version, e.g. "7.0.3". Empty when unknown.
+	Version string
+	// NICSpeedsMbps are the link speeds of the physical NICs of the host. Empty when unknown.
+	NICSpeedsMbps []int
 }
 
 // Datastore represents a VMware datastore.
@@ -0,0 +1,10 @@
+// Package plugin lets the agent collect extra per-VM facts through customer-provided executables.
+//
+// A plugin is an executable file in the plugin directory of the agent. It receives a Request on its
+// standard input, listing the VMs of the inventory, and writes a Response on its standard output
+// holding the facts it collected for each of them, e.g. the installed software or the local admin
+// accounts. The facts are stored in the Extensions of the inventory VMs under "<plugin>.<fact>", where
+// assessment rules and calculators read them. Given the inventory.Schema of the organization, only
+// the facts of registered fields holding values of their type are kept. A failing plugin never fails
+// the inventory collection: its error is reported and the facts of the other plugins are kept.
+package plugin
@@ -0,0 +1,230 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+const (
+	// Version is the version of the JSON contract between the agent and its plugins.
+	Version = "v1"
+	// DefaultTimeout is the time a plugin is given to collect the facts of all the VMs.
+	DefaultTimeout = 5 * time.Minute
+
+	// waitDelay is the time the output of a killed plugin is still read, e.g. when it left children
+	// holding its standard output.
+	waitDelay = time.Second
+	// maxStderr is the length of the standard error of a failed plugin kept in its error.
+	maxStderr = 512
+)
+
+var validName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Request is the input of a plugin.
+type Request struct {
+	Version string   `json:"version"`
+	VMs     []Target `json:"vms"`
+}
+
+// Target is a VM a plugin collects facts about.
+type Target struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	GuestOS string `json:"guestOS,omitempty"`
+}
+
+// Response is the output of a plugin.
+type Response struct {
+	Version string `json:"version"`
+	// Facts are the facts collected by the plugin, keyed by VM ID and then by fact name. VMs the
+	// plugin knows nothing about are left out.
+	Facts map[string]map[string]any `json:"facts"`
+}
+
+// Plugin is an executable collecting facts.
+type Plugin struct {
+	// Name prefixes the facts of the plugin. It is the file name without extension.
+	Name string
+	Path string
+}
+
+// Discover returns the plugins of dir: its executable regular files, sorted by name. A missing
+// directory holds no plugins.
+func Discover(dir string) ([]Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read plugin directory: %w", err)
+	}
+
+	var plugins []Plugin
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		name := strings.ToLower(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())))
+		if !validName.MatchString(name) {
+			return nil, fmt.Errorf("invalid plugin name %q: use lowercase letters, digits, '-' and '_'", entry.Name())
+		}
+		plugins = append(plugins, Plugin{Name: name, Path: filepath.Join(dir, entry.Name())})
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+	return plugins, nil
+}
+
+// Facts are the facts collected by all the plugins, keyed by VM ID and then by "<plugin>.<fact>".
+type Facts map[string]map[string]any
+
+// Runner runs plugins.
+type Runner struct {
+	plugins []Plugin
+	timeout time.Duration
+	schema  *inventory.Schema
+}
+
+// Option is a functional option for configuring a Runner.
+type Option func(*Runner)
+
+// WithTimeout sets the time each plugin is given.
+func WithTimeout(d time.Duration) Option {
+	return func(r *Runner) {
+		r.timeout = d
+	}
+}
+
+// WithSchema only keeps the facts of the registered extension fields holding values of their type;
+// the others are reported as errors.
+func WithSchema(s inventory.Schema) Option {
+	return func(r *Runner) {
+		r.schema = &s
+	}
+}
+
+// NewRunner creates a Runner of the plugins, with settings that can be overridden by options.
+func NewRunner(plugins []Plugin, opts ...Option) *Runner {
+	r := Runner{plugins: plugins, timeout: DefaultTimeout}
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+// Collect runs the plugins one after the other on the VMs. It returns the facts of the plugins that
+// succeeded, along with the errors of the others joined.
+func (r *Runner) Collect(ctx context.Context, vms []inventory.VM) (Facts, error) {
+	req := Request{Version: Version, VMs: make([]Target, 0, len(vms))}
+	known := make(map[string]bool, len(vms))
+	for _, vm := range vms {
+		req.VMs = append(req.VMs, Target{ID: vm.ID, Name: vm.Name, GuestOS: vm.GuestOS})
+		known[vm.ID] = true
+	}
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plugin request: %w", err)
+	}
+
+	facts := make(Facts)
+	var failures []error
+	for _, p := range r.plugins {
+		resp, err := r.run(ctx, p, input)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("plugin %s: %w", p.Name, err))
+			continue
+		}
+		for id, vmFacts := range resp.Facts {
+			if !known[id] {
+				failures = append(failures, fmt.Errorf("plugin %s: unknown VM %q", p.Name, id))
+				continue
+			}
+			for name, value := range vmFacts {
+				key := p.Name + "." + name
+				if err := r.check(key, value); err != nil {
+					failures = append(failures, fmt.Errorf("plugin %s: VM %s: %w", p.Name, id, err))
+					continue
+				}
+				if facts[id] == nil {
+					facts[id] = make(map[string]any)
+				}
+				facts[id][key] = value
+			}
+		}
+	}
+	return facts, errors.Join(failures...)
+}
+
+// check checks the fact against the schema, if any.
+func (r *Runner) check(key string, value any) error {
+	if r.schema == nil {
+		return nil
+	}
+	f, err := r.schema.Reference(key)
+	if err != nil {
+		return err
+	}
+	return f.Check(value)
+}
+
+// run executes the plugin with the request as input and decodes its response.
+func (r *Runner) run(ctx context.Context, p Plugin, input []byte) (Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return Response{}, fmt.Errorf("timed out after %v", r.timeout)
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxStderr {
+			msg = msg[:maxStderr]
+		}
+		return Response{}, fmt.Errorf("%w: %s", err, msg)
+	}
+
+	var resp Response
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return Response{}, fmt.Errorf("invalid response: %w", err)
+	}
+	if resp.Version != Version {
+		return Response{}, fmt.Errorf("unsupported contract version %q, expected %q", resp.Version, Version)
+	}
+	return resp, nil
+}
+
+// Apply stores the facts in the Extensions of the VMs they were collected for.
+func Apply(vms []inventory.VM, facts Facts) {
+	for i := range vms {
+		vmFacts, ok := facts[vms[i].ID]
+		if !ok {
+			continue
+		}
+		if vms[i].Extensions == nil {
+			vms[i].Extensions = make(map[string]any, len(vmFacts))
+		}
+		for k, v := range vmFacts {
+			vms[i].Extensions[k] = v
+		}
+	}
+}
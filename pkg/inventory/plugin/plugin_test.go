@@ -0,0 +1,101 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+func writePlugin(t *testing.T, dir, name, script string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script+"\n"), 0o755); err != nil {
+		t.Fatalf("failed to write plugin: %v", err)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writePlugin(t, dir, "software.sh", "true")
+	writePlugin(t, dir, "accounts", "true")
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("not a plugin"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	plugins, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(plugins) != 2 || plugins[0].Name != "accounts" || plugins[1].Name != "software" {
+		t.Errorf("expected the accounts and software plugins, got %+v", plugins)
+	}
+
+	if plugins, err := Discover(filepath.Join(dir, "missing")); err != nil || len(plugins) != 0 {
+		t.Errorf("expected no plugins in a missing directory, got %+v, %v", plugins, err)
+	}
+}
+
+func TestRunner_Collect(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	// echoes the IDs it received so the request is checked too
+	writePlugin(t, dir, "software", `cat > /dev/null; echo '{"version":"v1","facts":{"vm-1":{"installed":["nginx","postgresql"]}}}'`)
+	writePlugin(t, dir, "accounts", `grep -q '"id":"vm-2"' && echo '{"version":"v1","facts":{"vm-2":{"local_admins":2},"vm-9":{"local_admins":1}}}'`)
+	writePlugin(t, dir, "broken", `echo "no access to guest" >&2; exit 3`)
+	writePlugin(t, dir, "legacy", `echo '{"version":"v0","facts":{}}'`)
+	writePlugin(t, dir, "slow", `sleep 5`)
+
+	plugins, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	vms := []inventory.VM{{ID: "vm-1", Name: "web"}, {ID: "vm-2", Name: "db"}}
+	facts, err := NewRunner(plugins, WithTimeout(500*time.Millisecond)).Collect(context.Background(), vms)
+
+	for _, want := range []string{"plugin broken: exit status 3: no access to guest", "plugin legacy: unsupported contract version", `plugin accounts: unknown VM "vm-9"`, "plugin slow: timed out"} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q, got: %v", want, err)
+		}
+	}
+
+	Apply(vms, facts)
+	if v, ok := vms[0].Extension("software.installed"); !ok || len(v.([]any)) != 2 {
+		t.Errorf("expected the installed software of vm-1, got %v", vms[0].Extensions)
+	}
+	if v, ok := vms[1].Extension("accounts.local_admins"); !ok || v != float64(2) {
+		t.Errorf("expected the local admins of vm-2, got %v", vms[1].Extensions)
+	}
+	if _, ok := vms[1].Extension("software.installed"); ok {
+		t.Errorf("expected no software facts for vm-2")
+	}
+}
+
+func TestRunner_Collect_WithSchema(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writePlugin(t, dir, "software", `echo '{"version":"v1","facts":{"vm-1":{"installed":["nginx"],"count":"one","unregistered":true}}}'`)
+
+	plugins, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	schema := inventory.Schema{Fields: []inventory.Field{
+		{Name: "software.installed", Type: inventory.FieldTypeStringList, Source: inventory.FieldSourcePlugin},
+		{Name: "software.count", Type: inventory.FieldTypeNumber, Source: inventory.FieldSourcePlugin},
+	}}
+	facts, err := NewRunner(plugins, WithSchema(schema)).Collect(context.Background(), []inventory.VM{{ID: "vm-1"}})
+
+	for _, want := range []string{"field software.count expects a number, got string", `unknown inventory field "software.unregistered"`} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error containing %q, got: %v", want, err)
+		}
+	}
+	if len(facts["vm-1"]) != 1 || facts["vm-1"]["software.installed"] == nil {
+		t.Errorf("expected only the installed software to be kept, got %v", facts)
+	}
+}
@@ -0,0 +1,117 @@
+package inventory
+
+// Power states reported by vSphere for a VM.
+const (
+	PowerStateOn        = "poweredOn"
+	PowerStateOff       = "poweredOff"
+	PowerStateSuspended = "suspended"
+)
+
+// VMware Tools states reported by vSphere for a VM.
+const (
+	ToolsStatusOK           = "toolsOk"
+	ToolsStatusOld          = "toolsOld"
+	ToolsStatusNotInstalled = "toolsNotInstalled"
+	ToolsStatusNotRunning   = "toolsNotRunning"
+)
+
+// Firmware types reported by vSphere for a VM.
+const (
+	FirmwareBIOS = "bios"
+	FirmwareEFI  = "efi"
+)
+
+// Disk modes reported by vSphere for a disk. A disk in no other mode is dependent.
+const (
+	DiskModeIndependentPersistent    = "independent_persistent"
+	DiskModeIndependentNonPersistent = "independent_nonpersistent"
+)
+
+// Concern categories assigned by the validation policies.
+const (
+	ConcernCategoryCritical    = "Critical"
+	ConcernCategoryWarning     = "Warning"
+	ConcernCategoryInformation = "Information"
+)
+
+// VM is the per-VM domain representation, used where decisions are taken for
+// individual machines rather than from the aggregated VMsData statistics.
+type VM struct {
+	ID         string
+	Name       string
+	Datacenter string
+	Cluster    string
+	// Folder is the path of the VM's folder from the VM folder of its datacenter, "/"-separated,
+	// e.g. "Finance/Payroll". Empty for a VM in the VM folder itself, see NormalizeFolder.
+	Folder string
+	// VApp is the path of the vApp holding the VM, nested vApps "/"-separated. Empty when none.
+	VApp       string
+	GuestOS    string
+	PowerState string
+	CPUCount   int
+	MemoryMB   int
+	DiskGB     float64
+	// ChangeTrackingEnabled reports whether CBT is on, which warm migration requires.
+	ChangeTrackingEnabled bool
+	// ToolsStatus is the VMware Tools state, one of the ToolsStatus constants. Empty when unknown.
+	ToolsStatus string
+	// Firmware is FirmwareBIOS or FirmwareEFI. Empty when unknown.
+	Firmware   string
+	SecureBoot bool
+	// TPM reports whether the VM has a virtual TPM.
+	TPM bool
+	// Encrypted reports whether the VM is encrypted with vSphere VM Encryption. False when unknown.
+	Encrypted bool
+	// USBDevices is the number of USB devices passed through to the VM.
+	USBDevices int
+	Disks      []Disk
+	NICs       []NIC
+	Concerns   []Concern
+	// Extensions are the extra facts collected about the VM by agent plugins, keyed by
+	// "<plugin>.<fact>", e.g. "software.installed". Nil when no plugin ran.
+	Extensions map[string]any
+}
+
+// Disk is a virtual disk of a VM.
+type Disk struct {
+	// Controller is the controller as reported by vSphere, either its type (e.g. "VMware Paravirtual")
+	// or its label (e.g. "SCSI controller 0").
+	Controller string
+	// Bus is the controller bus: ide, scsi, sata or nvme. Empty when unknown.
+	Bus        string
+	CapacityGB float64
+	// RDM reports whether the disk is a raw device mapping rather than a virtual disk file.
+	RDM bool
+	// Mode is the disk mode as reported by vSphere, e.g. DiskModeIndependentPersistent. Empty when unknown.
+	Mode string
+}
+
+// NIC is a network adapter of a VM.
+type NIC struct {
+	// Adapter is the adapter type as reported by vSphere, e.g. "Vmxnet3" or "E1000E".
+	Adapter string
+}
+
+// Concern is a validation finding attached to a single VM.
+type Concern struct {
+	ID         string
+	Label      string
+	Category   string
+	Assessment string
+}
+
+// Extension returns the fact collected by a plugin under key.
+func (vm VM) Extension(key string) (any, bool) {
+	v, ok := vm.Extensions[key]
+	return v, ok
+}
+
+// CriticalConcern returns the first concern that prevents migrating the VM as-is.
+func (vm VM) CriticalConcern() (Concern, bool) {
+	for _, c := range vm.Concerns {
+		if c.Category == ConcernCategoryCritical {
+			return c, true
+		}
+	}
+	return Concern{}, false
+}
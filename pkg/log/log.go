@@ -11,7 +11,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	gormlogger "gorm.io/gorm/logger"
 
-	"github.com/kubev2v/migration-planner/pkg/requestid"
+	"github.com/kubev2v/migration-planner/pkg/logctx"
 )
 
 func InitLog(lvl zap.AtomicLevel) *zap.Logger {
@@ -20,8 +20,13 @@ func InitLog(lvl zap.AtomicLevel) *zap.Logger {
 	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 	cfg.Encoding = "json"
 
+	// The latest entries are also kept in memory, see Recent
+	recent.LevelEnabler = lvl
 	logger, err := cfg.Build(
 		zap.AddStacktrace(zap.DPanicLevel),
+		zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, recent.Core())
+		}),
 	)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize zap logger: %v\n", err)
@@ -81,12 +86,12 @@ func (l *StructuredLogger) getLogFunc() func(msg string, fields ...zap.Field) {
 	}
 }
 
-// WithContext returns a new StructuredLogger with request context
+// WithContext returns a new StructuredLogger with the fields of the context: request ID,
+// organization, plan and job when set, see logctx.
 func (l *StructuredLogger) WithContext(ctx context.Context) *StructuredLogger {
-	// Extract request ID if available
-	if requestID := requestid.FromContext(ctx); requestID != "" {
+	if fields := logctx.Fields(ctx); len(fields) > 0 {
 		return &StructuredLogger{
-			logger:  l.logger.With(zap.String("request_id", requestID)),
+			logger:  l.logger.With(fields...),
 			service: l.service,
 			level:   l.level,
 		}
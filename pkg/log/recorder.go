@@ -0,0 +1,129 @@
+package log
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// DefaultRecorderSize is the number of log entries kept by the recorder of InitLog.
+const DefaultRecorderSize = 5000
+
+var recent = NewRecorder(DefaultRecorderSize, zapcore.DebugLevel)
+
+// Recent returns the recorder of the latest entries logged by the logger of InitLog, e.g. to
+// attach the logs of a request to a support bundle.
+func Recent() *Recorder {
+	return recent
+}
+
+// Entry is a recorded log entry.
+type Entry struct {
+	Time    time.Time      `json:"time"`
+	Level   string         `json:"level"`
+	Logger  string         `json:"logger,omitempty"`
+	Message string         `json:"message"`
+	Fields  map[string]any `json:"fields,omitempty"`
+}
+
+// Recorder keeps the latest log entries in memory, the oldest being dropped once full.
+type Recorder struct {
+	zapcore.LevelEnabler
+
+	mu      sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// NewRecorder creates a Recorder keeping the latest size entries of the level.
+func NewRecorder(size int, level zapcore.LevelEnabler) *Recorder {
+	return &Recorder{LevelEnabler: level, entries: make([]Entry, size)}
+}
+
+// Core returns a core recording the entries, to tee with the core writing them.
+func (r *Recorder) Core() zapcore.Core {
+	return &recorderCore{recorder: r}
+}
+
+// Find returns the recorded entries having the field, oldest first.
+func (r *Recorder) Find(key, value string) []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var found []Entry
+	r.each(func(e Entry) {
+		if v, ok := e.Fields[key].(string); ok && v == value {
+			found = append(found, e)
+		}
+	})
+	return found
+}
+
+func (r *Recorder) record(e Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.entries) == 0 {
+		return
+	}
+	r.entries[r.next] = e
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+func (r *Recorder) each(fn func(Entry)) {
+	if r.full {
+		for _, e := range r.entries[r.next:] {
+			fn(e)
+		}
+	}
+	for _, e := range r.entries[:r.next] {
+		fn(e)
+	}
+}
+
+type recorderCore struct {
+	recorder *Recorder
+	fields   []zapcore.Field
+}
+
+func (c *recorderCore) Enabled(level zapcore.Level) bool {
+	return c.recorder.Enabled(level)
+}
+
+func (c *recorderCore) With(fields []zapcore.Field) zapcore.Core {
+	return &recorderCore{recorder: c.recorder, fields: append(append([]zapcore.Field{}, c.fields...), fields...)}
+}
+
+func (c *recorderCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *recorderCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range c.fields {
+		f.AddTo(enc)
+	}
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	c.recorder.record(Entry{
+		Time:    entry.Time,
+		Level:   entry.Level.String(),
+		Logger:  entry.LoggerName,
+		Message: entry.Message,
+		Fields:  enc.Fields,
+	})
+	return nil
+}
+
+func (c *recorderCore) Sync() error {
+	return nil
+}
@@ -0,0 +1,39 @@
+package log
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewRecorder(3, zapcore.InfoLevel)
+	logger := zap.New(recorder.Core())
+
+	logger.With(zap.String("request_id", "req-1")).Info("first")
+	logger.Debug("not recorded", zap.String("request_id", "req-1"))
+	logger.Info("other request", zap.String("request_id", "req-2"))
+	logger.Info("second", zap.String("request_id", "req-1"), zap.Int("rows", 2))
+
+	entries := recorder.Find("request_id", "req-1")
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %v", entries)
+	}
+	if entries[0].Message != "first" || entries[1].Message != "second" {
+		t.Errorf("expected the entries oldest first, got %v", entries)
+	}
+	if entries[1].Fields["rows"] != int64(2) {
+		t.Errorf("expected the fields of the entry, got %v", entries[1].Fields)
+	}
+
+	// the oldest entries are dropped once full
+	logger.Info("third", zap.String("request_id", "req-2"))
+	logger.Info("fourth", zap.String("request_id", "req-2"))
+	entries = recorder.Find("request_id", "req-1")
+	if len(entries) != 1 || entries[0].Message != "second" {
+		t.Errorf("expected only the latest entry of the request, got %v", entries)
+	}
+}
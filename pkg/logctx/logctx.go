@@ -0,0 +1,95 @@
+// Package logctx attaches structured logging fields to a context, so every line logged by a single
+// operation carries the request, organization, plan and job it belongs to without passing them
+// from function to function.
+package logctx
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/kubev2v/migration-planner/pkg/requestid"
+)
+
+const (
+	KeyRequestID = "request_id"
+	KeyOrgID     = "org_id"
+	KeyPlanID    = "plan_id"
+	KeyJobID     = "job_id"
+)
+
+type fieldsKey struct{}
+
+type field struct {
+	key   string
+	value string
+}
+
+// With returns a copy of the context carrying the field. A field already carried is replaced.
+func With(ctx context.Context, key, value string) context.Context {
+	current := fields(ctx)
+	next := make([]field, 0, len(current)+1)
+	for _, f := range current {
+		if f.key != key {
+			next = append(next, f)
+		}
+	}
+	next = append(next, field{key: key, value: value})
+	return context.WithValue(ctx, fieldsKey{}, next)
+}
+
+// WithOrgID attaches the organization the operation runs for.
+func WithOrgID(ctx context.Context, orgID string) context.Context {
+	return With(ctx, KeyOrgID, orgID)
+}
+
+// WithPlanID attaches the plan the operation works on.
+func WithPlanID(ctx context.Context, planID uuid.UUID) context.Context {
+	return With(ctx, KeyPlanID, planID.String())
+}
+
+// WithJobID attaches the background job running the operation.
+func WithJobID(ctx context.Context, jobID int64) context.Context {
+	return With(ctx, KeyJobID, strconv.FormatInt(jobID, 10))
+}
+
+// Value returns the value of the field carried by the context.
+func Value(ctx context.Context, key string) (string, bool) {
+	if key == KeyRequestID {
+		id := requestid.FromContext(ctx)
+		return id, id != ""
+	}
+	for _, f := range fields(ctx) {
+		if f.key == key {
+			return f.value, true
+		}
+	}
+	return "", false
+}
+
+// Fields returns the fields carried by the context, the request ID first, to add to a log line.
+func Fields(ctx context.Context) []zap.Field {
+	carried := fields(ctx)
+	res := make([]zap.Field, 0, len(carried)+1)
+	if id := requestid.FromContext(ctx); id != "" {
+		res = append(res, zap.String(KeyRequestID, id))
+	}
+	for _, f := range carried {
+		res = append(res, zap.String(f.key, f.value))
+	}
+	return res
+}
+
+// Logger returns the global logger with the fields carried by the context.
+func Logger(ctx context.Context) *zap.Logger {
+	return zap.L().With(Fields(ctx)...)
+}
+
+func fields(ctx context.Context) []field {
+	if f, ok := ctx.Value(fieldsKey{}).([]field); ok {
+		return f
+	}
+	return nil
+}
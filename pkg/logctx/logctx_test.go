@@ -0,0 +1,78 @@
+package logctx_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+	"github.com/kubev2v/migration-planner/pkg/requestid"
+)
+
+func TestFields(t *testing.T) {
+	t.Parallel()
+
+	planID := uuid.New()
+	ctx := requestid.ToContext(context.Background(), "req-1")
+	ctx = logctx.WithOrgID(ctx, "acme")
+	ctx = logctx.WithPlanID(ctx, planID)
+	ctx = logctx.WithJobID(ctx, 42)
+
+	got := logctx.Fields(ctx)
+	want := []zap.Field{
+		zap.String(logctx.KeyRequestID, "req-1"),
+		zap.String(logctx.KeyOrgID, "acme"),
+		zap.String(logctx.KeyPlanID, planID.String()),
+		zap.String(logctx.KeyJobID, "42"),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d fields, got %v", len(want), got)
+	}
+	for i := range want {
+		if !got[i].Equals(want[i]) {
+			t.Errorf("expected field %d to be %v, got %v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestWithReplacesField(t *testing.T) {
+	t.Parallel()
+
+	parent := logctx.WithOrgID(context.Background(), "acme")
+	child := logctx.WithOrgID(parent, "globex")
+
+	if org, _ := logctx.Value(child, logctx.KeyOrgID); org != "globex" {
+		t.Errorf("expected the field to be replaced, got %q", org)
+	}
+	if org, _ := logctx.Value(parent, logctx.KeyOrgID); org != "acme" {
+		t.Errorf("expected the parent context to keep its field, got %q", org)
+	}
+	if n := len(logctx.Fields(child)); n != 1 {
+		t.Errorf("expected a single field, got %d", n)
+	}
+	if _, ok := logctx.Value(child, logctx.KeyPlanID); ok {
+		t.Error("expected no plan ID")
+	}
+}
+
+func TestLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	restore := zap.ReplaceGlobals(zap.New(core))
+	defer restore()
+
+	ctx := logctx.WithOrgID(requestid.ToContext(context.Background(), "req-1"), "acme")
+	logctx.Logger(ctx).Info("hello")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected one log line, got %d", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields[logctx.KeyRequestID] != "req-1" || fields[logctx.KeyOrgID] != "acme" {
+		t.Errorf("expected the fields of the context, got %v", fields)
+	}
+}
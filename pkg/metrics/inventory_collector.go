@@ -6,6 +6,7 @@ import (
 
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/logctx"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
@@ -85,9 +86,10 @@ func (c *inventoryStatsCollector) Describe(ch chan<- *prometheus.Desc) {
 
 // Collect implements Collector.
 func (c *inventoryStatsCollector) Collect(ch chan<- prometheus.Metric) {
-	stats, err := c.store.Statistics(context.Background())
+	ctx := logctx.With(context.Background(), "collector", "inventory_stats")
+	stats, err := c.store.Statistics(ctx)
 	if err != nil {
-		zap.S().Named("inventory_collector").Errorf("failed to collect inventory statistics: %s", err)
+		logctx.Logger(ctx).Named("inventory_collector").Error("failed to collect inventory statistics", zap.Error(err))
 		return
 	}
 	ch <- prometheus.MustNewConstMetric(c.totalVm, prometheus.GaugeValue, float64(stats.Vms.Total))
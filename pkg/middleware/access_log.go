@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+)
+
+// AccessLogSampling logs one in Every requests to the paths matching Path, e.g. the health checks
+// polled by the load balancer. Every below 2 logs them all.
+type AccessLogSampling struct {
+	Path  *regexp.Regexp
+	Every int
+}
+
+// sampler counts the requests of a sampling rule.
+type sampler struct {
+	AccessLogSampling
+	count atomic.Uint64
+}
+
+// AccessLog logs one record per request once it is served: its method, path, route, status, latency,
+// the bytes written and the fields of its context, the request ID first, see logctx. It must follow
+// RequestID and LogFields. The requests matching a sampling rule are logged one in Every, with the
+// rate as sample_rate to weigh them; the failed ones are always logged. The record is logged at
+// error level for a 5xx status, warning for a 4xx one.
+func AccessLog(logger *zap.Logger, sampling ...AccessLogSampling) func(next http.Handler) http.Handler {
+	samplers := make([]*sampler, 0, len(sampling))
+	for _, s := range sampling {
+		samplers = append(samplers, &sampler{AccessLogSampling: s})
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			// handlers may rewrite the URL
+			path := r.URL.Path
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			rate := 1
+			for _, s := range samplers {
+				if s.Every < 2 || !s.Path.MatchString(path) {
+					continue
+				}
+				// the first request of the rule is logged, then one in Every
+				if (s.count.Add(1)-1)%uint64(s.Every) != 0 && status < http.StatusBadRequest {
+					return
+				}
+				rate = s.Every
+				break
+			}
+
+			fields := append(logctx.Fields(r.Context()),
+				zap.String("method", r.Method),
+				zap.String("path", path),
+				zap.Int("status", status),
+				zap.Duration("latency", time.Since(start)),
+				zap.Int("bytes", ww.BytesWritten()),
+				zap.String("ip", clientIP(r)),
+				zap.String("user_agent", r.UserAgent()),
+			)
+			// the route is known once the router has matched the request
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				fields = append(fields, zap.String("route", rctx.RoutePattern()))
+			}
+			if rate > 1 && status < http.StatusBadRequest {
+				fields = append(fields, zap.Int("sample_rate", rate))
+			}
+
+			switch {
+			case status >= http.StatusInternalServerError:
+				logger.Error("access", fields...)
+			case status >= http.StatusBadRequest:
+				logger.Warn("access", fields...)
+			default:
+				logger.Info("access", fields...)
+			}
+		})
+	}
+}
+
+// clientIP returns the IP of the client, the first of X-Forwarded-For behind a proxy.
+func clientIP(r *http.Request) string {
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		ip, _, _ := strings.Cut(xff, ",")
+		return strings.TrimSpace(ip)
+	}
+	if xri := r.Header.Get("X-Real-IP"); xri != "" {
+		return xri
+	}
+	return r.RemoteAddr
+}
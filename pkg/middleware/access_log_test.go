@@ -0,0 +1,80 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/kubev2v/migration-planner/pkg/middleware"
+)
+
+func TestAccessLog(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	handler := middleware.RequestID(middleware.AccessLog(zap.New(core))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("not found"))
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/plans", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-1")
+	req.Header.Set("X-Forwarded-For", "10.0.0.1, 10.0.0.2")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if logs.Len() != 1 {
+		t.Fatalf("expected one record, got %d", logs.Len())
+	}
+	entry := logs.All()[0]
+	if entry.Level != zapcore.WarnLevel || entry.Message != "access" {
+		t.Errorf("expected a warning for a 404, got %v %q", entry.Level, entry.Message)
+	}
+	fields := entry.ContextMap()
+	want := map[string]interface{}{
+		"request_id": "req-1",
+		"method":     http.MethodGet,
+		"path":       "/api/v1/plans",
+		"status":     int64(http.StatusNotFound),
+		"bytes":      int64(9),
+		"ip":         "10.0.0.1",
+	}
+	for key, value := range want {
+		if fields[key] != value {
+			t.Errorf("expected %s %v, got %v", key, value, fields[key])
+		}
+	}
+	if _, ok := fields["latency"]; !ok {
+		t.Error("expected the latency logged")
+	}
+}
+
+func TestAccessLog_Sampling(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	status := http.StatusOK
+	handler := middleware.AccessLog(zap.New(core), middleware.AccessLogSampling{Path: regexp.MustCompile(`^/health$`), Every: 10})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(status) }))
+
+	for range 25 {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	}
+	if logs.Len() != 3 {
+		t.Fatalf("expected 3 of 25 health checks logged, got %d", logs.Len())
+	}
+	if rate := logs.All()[0].ContextMap()["sample_rate"]; rate != int64(10) {
+		t.Errorf("expected a sample rate of 10, got %v", rate)
+	}
+
+	// the failed health checks and the other paths are all logged
+	status = http.StatusServiceUnavailable
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/plans", nil))
+	if logs.Len() != 5 {
+		t.Errorf("expected the failed requests logged, got %d records", logs.Len())
+	}
+	if _, ok := logs.All()[3].ContextMap()["sample_rate"]; ok {
+		t.Error("expected no sample rate for a failed request")
+	}
+}
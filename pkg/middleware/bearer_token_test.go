@@ -0,0 +1,44 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/middleware"
+)
+
+func TestBearerToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		token  string
+		header string
+		want   int
+	}{
+		{name: "no token configured", token: "", header: "", want: http.StatusOK},
+		{name: "matching token", token: "s3cr3t", header: "Bearer s3cr3t", want: http.StatusOK},
+		{name: "missing header", token: "s3cr3t", header: "", want: http.StatusUnauthorized},
+		{name: "wrong token", token: "s3cr3t", header: "Bearer other", want: http.StatusUnauthorized},
+		{name: "wrong scheme", token: "s3cr3t", header: "Basic s3cr3t", want: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := middleware.BearerToken(tc.token)(http.HandlerFunc(nopHandler))
+			req := httptest.NewRequest(http.MethodGet, "/debug/vars", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Errorf("expected status %d for case %q, got %d", tc.want, tc.name, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+)
+
+var planPath = regexp.MustCompile(`^/api/v1/plans/([^/]+)`)
+
+// LogFields attaches the organization of the request and the plan of its path to its context, so
+// the lines logged while serving it carry them, see logctx. organization tells the organization of
+// an authenticated request.
+func LogFields(organization func(*http.Request) (string, bool)) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			if org, ok := organization(r); ok {
+				ctx = logctx.WithOrgID(ctx, org)
+			}
+			if m := planPath.FindStringSubmatch(r.URL.Path); m != nil {
+				if planID, err := uuid.Parse(m[1]); err == nil {
+					ctx = logctx.WithPlanID(ctx, planID)
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/migration-planner/pkg/logctx"
+	"github.com/kubev2v/migration-planner/pkg/middleware"
+)
+
+func TestLogFields(t *testing.T) {
+	planID := uuid.New()
+	tests := []struct {
+		name   string
+		path   string
+		org    string
+		wantOK bool
+		plan   string
+	}{
+		{name: "plan of an organization", path: "/api/v1/plans/" + planID.String() + "/gantt", org: "acme", wantOK: true, plan: planID.String()},
+		{name: "no plan", path: "/api/v1/assessments", org: "acme", wantOK: true},
+		{name: "plan ID not a UUID", path: "/api/v1/plans/latest", org: "acme", wantOK: true},
+		{name: "anonymous", path: "/api/v1/plans/" + planID.String(), plan: planID.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organization := func(*http.Request) (string, bool) { return tt.org, tt.org != "" }
+
+			var org, plan string
+			var orgOK bool
+			handler := middleware.LogFields(organization)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				org, orgOK = logctx.Value(r.Context(), logctx.KeyOrgID)
+				plan, _ = logctx.Value(r.Context(), logctx.KeyPlanID)
+			}))
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if orgOK != tt.wantOK || org != tt.org {
+				t.Errorf("expected organization %q, got %q", tt.org, org)
+			}
+			if plan != tt.plan {
+				t.Errorf("expected plan %q, got %q", tt.plan, plan)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// QuotaPolicy is what happens to an operation once its organization runs as many as its quota allows.
+type QuotaPolicy string
+
+const (
+	// QuotaPolicyReject answers 429 right away.
+	QuotaPolicyReject QuotaPolicy = "reject"
+	// QuotaPolicyQueue waits for a running operation to finish, answering 429 after the queue timeout.
+	QuotaPolicyQueue QuotaPolicy = "queue"
+)
+
+// QuotaRule caps the number of concurrent requests of an organization matching Method and Path.
+type QuotaRule struct {
+	// Operation names the rule in the 429 responses, e.g. "simulation".
+	Operation string
+	Method    string
+	Path      *regexp.Regexp
+	Limit     int
+}
+
+// QuotaExceeded is the body of the 429 responses.
+type QuotaExceeded struct {
+	Message      string `json:"message"`
+	Operation    string `json:"operation"`
+	Organization string `json:"organization"`
+	InFlight     int    `json:"inFlight"`
+	Limit        int    `json:"limit"`
+}
+
+// ConcurrencyQuota caps the concurrent expensive operations per organization, so a single
+// organization can not take all the capacity of a shared deployment.
+type ConcurrencyQuota struct {
+	rules        []QuotaRule
+	policy       QuotaPolicy
+	queueTimeout time.Duration
+	organization func(*http.Request) (string, bool)
+
+	mu    sync.Mutex
+	slots map[string]chan struct{} // operation/organization -> running requests
+}
+
+// NewConcurrencyQuota creates a ConcurrencyQuota. organization tells the organization of an
+// authenticated request; requests without one and rules without a positive limit are not capped.
+func NewConcurrencyQuota(policy QuotaPolicy, queueTimeout time.Duration, organization func(*http.Request) (string, bool), rules ...QuotaRule) *ConcurrencyQuota {
+	return &ConcurrencyQuota{
+		rules:        rules,
+		policy:       policy,
+		queueTimeout: queueTimeout,
+		organization: organization,
+		slots:        make(map[string]chan struct{}),
+	}
+}
+
+// Handler enforces the quotas. It has to run after the authentication middleware.
+func (q *ConcurrencyQuota) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rule, ok := q.match(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		org, ok := q.organization(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		slots := q.slotsOf(rule, org)
+		if !q.acquire(r, slots) {
+			q.reject(w, rule, org, len(slots))
+			return
+		}
+		defer func() { <-slots }()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (q *ConcurrencyQuota) match(r *http.Request) (QuotaRule, bool) {
+	for _, rule := range q.rules {
+		if rule.Limit > 0 && r.Method == rule.Method && rule.Path.MatchString(r.URL.Path) {
+			return rule, true
+		}
+	}
+	return QuotaRule{}, false
+}
+
+func (q *ConcurrencyQuota) slotsOf(rule QuotaRule, org string) chan struct{} {
+	key := rule.Operation + "/" + org
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	slots, ok := q.slots[key]
+	if !ok {
+		slots = make(chan struct{}, rule.Limit)
+		q.slots[key] = slots
+	}
+	return slots
+}
+
+// acquire takes a slot, waiting for one under the queue policy.
+func (q *ConcurrencyQuota) acquire(r *http.Request, slots chan struct{}) bool {
+	select {
+	case slots <- struct{}{}:
+		return true
+	default:
+	}
+	if q.policy != QuotaPolicyQueue {
+		return false
+	}
+
+	timer := time.NewTimer(q.queueTimeout)
+	defer timer.Stop()
+	select {
+	case slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+func (q *ConcurrencyQuota) reject(w http.ResponseWriter, rule QuotaRule, org string, inFlight int) {
+	zap.S().Named("quota").Warnw("Concurrency quota exceeded",
+		"operation", rule.Operation,
+		"org_id", org,
+		"in_flight", inFlight,
+		"limit", rule.Limit,
+	)
+
+	retryAfter := 1
+	if q.policy == QuotaPolicyQueue {
+		retryAfter = max(retryAfter, int(math.Ceil(q.queueTimeout.Seconds())))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(retryAfter))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(QuotaExceeded{
+		Message:      fmt.Sprintf("organization %s already runs %d of %d concurrent %s operations", org, inFlight, rule.Limit, rule.Operation),
+		Operation:    rule.Operation,
+		Organization: org,
+		InFlight:     inFlight,
+		Limit:        rule.Limit,
+	})
+}
@@ -0,0 +1,127 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/middleware"
+)
+
+func orgHeader(r *http.Request) (string, bool) {
+	org := r.Header.Get("X-Org")
+	return org, org != ""
+}
+
+var simulationRule = middleware.QuotaRule{
+	Operation: "simulation",
+	Method:    http.MethodPost,
+	Path:      regexp.MustCompile(`^/api/v1/plans/[^/]+/what-if$`),
+	Limit:     1,
+}
+
+// blockingHandler holds the requests until release is closed.
+func blockingHandler(started chan<- struct{}, release <-chan struct{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func serve(handler http.Handler, method, path, org string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if org != "" {
+		req.Header.Set("X-Org", org)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestConcurrencyQuota_RejectsOverQuota(t *testing.T) {
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	quota := middleware.NewConcurrencyQuota(middleware.QuotaPolicyReject, 0, orgHeader, simulationRule)
+	handler := quota.Handler(blockingHandler(started, release))
+
+	done := make(chan int)
+	go func() { done <- serve(handler, http.MethodPost, "/api/v1/plans/1/what-if", "acme").Code }()
+	<-started
+
+	rec := serve(handler, http.MethodPost, "/api/v1/plans/2/what-if", "acme")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("expected Retry-After header to be set")
+	}
+	var body middleware.QuotaExceeded
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("expected a JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	if body.Operation != "simulation" || body.Organization != "acme" || body.InFlight != 1 || body.Limit != 1 {
+		t.Errorf("unexpected body %+v", body)
+	}
+
+	// other organizations and operations are not affected
+	go func() { <-started }()
+	close(release)
+	if code := serve(handler, http.MethodPost, "/api/v1/plans/2/what-if", "globex").Code; code != http.StatusOK {
+		t.Errorf("expected another organization to get status 200, got %d", code)
+	}
+	if code := serve(handler, http.MethodGet, "/api/v1/plans/2", "acme").Code; code != http.StatusOK {
+		t.Errorf("expected an uncapped operation to get status 200, got %d", code)
+	}
+	if code := <-done; code != http.StatusOK {
+		t.Errorf("expected the first request to get status 200, got %d", code)
+	}
+}
+
+func TestConcurrencyQuota_QueuesOverQuota(t *testing.T) {
+	started, release := make(chan struct{}, 2), make(chan struct{})
+	quota := middleware.NewConcurrencyQuota(middleware.QuotaPolicyQueue, time.Minute, orgHeader, simulationRule)
+	handler := quota.Handler(blockingHandler(started, release))
+
+	done := make(chan int, 2)
+	go func() { done <- serve(handler, http.MethodPost, "/api/v1/plans/1/what-if", "acme").Code }()
+	<-started
+	go func() { done <- serve(handler, http.MethodPost, "/api/v1/plans/2/what-if", "acme").Code }()
+
+	select {
+	case <-started:
+		t.Fatal("expected the second request to wait for the first one")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	for range 2 {
+		if code := <-done; code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+	}
+}
+
+func TestConcurrencyQuota_QueueTimeout(t *testing.T) {
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	defer close(release)
+	quota := middleware.NewConcurrencyQuota(middleware.QuotaPolicyQueue, 10*time.Millisecond, orgHeader, simulationRule)
+	handler := quota.Handler(blockingHandler(started, release))
+
+	go serve(handler, http.MethodPost, "/api/v1/plans/1/what-if", "acme")
+	<-started
+
+	if code := serve(handler, http.MethodPost, "/api/v1/plans/2/what-if", "acme").Code; code != http.StatusTooManyRequests {
+		t.Errorf("expected status 429 after the queue timeout, got %d", code)
+	}
+}
+
+func TestConcurrencyQuota_SkipsAnonymousRequests(t *testing.T) {
+	quota := middleware.NewConcurrencyQuota(middleware.QuotaPolicyReject, 0, orgHeader, simulationRule)
+	handler := quota.Handler(http.HandlerFunc(nopHandler))
+
+	if code := serve(handler, http.MethodPost, "/api/v1/plans/1/what-if", "").Code; code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", code)
+	}
+}
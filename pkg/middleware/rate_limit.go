@@ -0,0 +1,274 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// rateSweepInterval is how often the buckets of the clients gone idle are dropped.
+	rateSweepInterval = time.Minute
+	// DefaultMaxRateBuckets bounds the number of buckets kept, one per client and route.
+	DefaultMaxRateBuckets = 100000
+)
+
+// RateRule limits the requests of each client matching Method and Path with a token bucket: Rate
+// requests per second on average, in bursts of up to Burst requests.
+type RateRule struct {
+	// Route names the rule in the 429 responses, e.g. "estimation". Rules with the same route share
+	// the buckets of the clients.
+	Route string
+	// Method matches any method when empty.
+	Method string
+	Path   *regexp.Regexp
+	Rate   float64
+	Burst  int
+}
+
+// RateLimited is the body of the 429 responses.
+type RateLimited struct {
+	Message    string `json:"message"`
+	Route      string `json:"route"`
+	Client     string `json:"client"`
+	RetryAfter int    `json:"retryAfter"`
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiter limits the rate of the requests of each client, its authenticated user within its
+// organization or else its IP address, per route, so a single client can not flood the expensive
+// endpoints. The IP address is the one of the connection, or the one the trusted proxies forward.
+type RateLimiter struct {
+	rules          []RateRule
+	organization   func(*http.Request) (string, bool)
+	user           func(*http.Request) (string, bool)
+	now            func() time.Time
+	trustedProxies []netip.Prefix
+	maxBuckets     int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket // route/client -> tokens left
+	lastSweep time.Time
+}
+
+// RateLimiterOption is a functional option for configuring a RateLimiter.
+type RateLimiterOption func(*RateLimiter)
+
+// WithRateClock sets the clock the buckets refill with, time.Now by default.
+func WithRateClock(now func() time.Time) RateLimiterOption {
+	return func(l *RateLimiter) {
+		l.now = now
+	}
+}
+
+// WithTrustedProxies sets the networks of the proxies whose X-Forwarded-For header tells the address
+// of the client. Without, the header is ignored: clients could rotate it to escape their limit.
+func WithTrustedProxies(prefixes ...netip.Prefix) RateLimiterOption {
+	return func(l *RateLimiter) {
+		l.trustedProxies = prefixes
+	}
+}
+
+// WithMaxRateBuckets bounds the number of buckets kept, DefaultMaxRateBuckets by default. Once
+// reached, the buckets idle the longest are dropped first. Non-positive values are ignored.
+func WithMaxRateBuckets(count int) RateLimiterOption {
+	return func(l *RateLimiter) {
+		if count > 0 {
+			l.maxBuckets = count
+		}
+	}
+}
+
+// NewRateLimiter creates a RateLimiter. organization and user tell the organization and the
+// authenticated user of a request, users of the same name in different organizations being different
+// clients; requests without user are keyed by their IP address. A request is limited by the first rule
+// it matches, rules without a positive rate or burst let it through.
+func NewRateLimiter(organization, user func(*http.Request) (string, bool), rules []RateRule, opts ...RateLimiterOption) *RateLimiter {
+	l := &RateLimiter{
+		rules:        rules,
+		organization: organization,
+		user:         user,
+		now:          time.Now,
+		maxBuckets:   DefaultMaxRateBuckets,
+		buckets:      make(map[string]*bucket),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	l.lastSweep = l.now()
+	return l
+}
+
+// Handler enforces the limits. It has to run after the authentication middleware.
+func (l *RateLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rule, ok := l.match(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		client := l.client(r)
+
+		if wait, ok := l.take(rule, client); !ok {
+			l.reject(w, rule, client, wait)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *RateLimiter) match(r *http.Request) (RateRule, bool) {
+	for _, rule := range l.rules {
+		if (rule.Method == "" || r.Method == rule.Method) && rule.Path.MatchString(r.URL.Path) {
+			return rule, rule.Rate > 0 && rule.Burst > 0
+		}
+	}
+	return RateRule{}, false
+}
+
+func (l *RateLimiter) client(r *http.Request) string {
+	user, ok := l.user(r)
+	if !ok {
+		return "ip:" + l.clientIP(r)
+	}
+	if org, ok := l.organization(r); ok {
+		return "user:" + org + "/" + user
+	}
+	return "user:" + user
+}
+
+// take takes a token from the bucket of the client, or returns how long until the next one.
+func (l *RateLimiter) take(rule RateRule, client string) (time.Duration, bool) {
+	key := rule.Route + "/" + client
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		l.evict(now)
+		b = &bucket{tokens: float64(rule.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = min(float64(rule.Burst), b.tokens+now.Sub(b.last).Seconds()*rule.Rate)
+	b.last = now
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / rule.Rate * float64(time.Second)), false
+	}
+	b.tokens--
+	return 0, true
+}
+
+// sweep drops the buckets idle long enough to have refilled whatever their rule, as a new bucket
+// starts full anyway.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	l.dropRefilled(now)
+}
+
+// evict makes room for a new bucket once the limit is reached: the buckets refilled go first, then
+// the one idle the longest.
+func (l *RateLimiter) evict(now time.Time) {
+	if len(l.buckets) < l.maxBuckets {
+		return
+	}
+	l.dropRefilled(now)
+	for len(l.buckets) >= l.maxBuckets {
+		var oldest string
+		for key, b := range l.buckets {
+			if oldest == "" || b.last.Before(l.buckets[oldest].last) {
+				oldest = key
+			}
+		}
+		delete(l.buckets, oldest)
+	}
+}
+
+// dropRefilled drops the buckets idle long enough to have refilled whatever their rule.
+func (l *RateLimiter) dropRefilled(now time.Time) {
+	refill := rateSweepInterval
+	for _, rule := range l.rules {
+		if rule.Rate > 0 {
+			refill = max(refill, time.Duration(float64(rule.Burst)/rule.Rate*float64(time.Second)))
+		}
+	}
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// clientIP returns the IP address of the connection or, when it comes from a trusted proxy, the last
+// address of X-Forwarded-For not of a trusted proxy. The port is left out so a client can not escape
+// its limit by opening new connections.
+func (l *RateLimiter) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !l.trusted(addr) {
+		return host
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		if !l.trusted(hop) {
+			return hop.Unmap().String()
+		}
+	}
+	return host
+}
+
+func (l *RateLimiter) trusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, p := range l.trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *RateLimiter) reject(w http.ResponseWriter, rule RateRule, client string, wait time.Duration) {
+	zap.S().Named("rate_limit").Warnw("Rate limit exceeded",
+		"route", rule.Route,
+		"client", client,
+		"rate", rule.Rate,
+		"burst", rule.Burst,
+	)
+
+	retryAfter := max(1, int(math.Ceil(wait.Seconds())))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(retryAfter))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(RateLimited{
+		Message:    fmt.Sprintf("client %s exceeds %g %s requests per second, retry in %ds", client, rule.Rate, rule.Route, retryAfter),
+		Route:      rule.Route,
+		Client:     client,
+		RetryAfter: retryAfter,
+	})
+}
@@ -0,0 +1,226 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/middleware"
+)
+
+func userHeader(r *http.Request) (string, bool) {
+	user := r.Header.Get("X-User")
+	return user, user != ""
+}
+
+// fakeClock is a clock the tests move by hand.
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+var estimationRule = middleware.RateRule{
+	Route:  "estimation",
+	Method: http.MethodPost,
+	Path:   regexp.MustCompile(`^/api/v1/estimations$`),
+	Rate:   0.5,
+	Burst:  2,
+}
+
+func request(handler http.Handler, method, path, user, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if user != "" {
+		req.Header.Set("X-User", user)
+	}
+	req.RemoteAddr = ip
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+}
+
+func TestRateLimiter_RejectsOverBurst(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	limiter := middleware.NewRateLimiter(orgHeader, userHeader, []middleware.RateRule{estimationRule}, middleware.WithRateClock(clock.Now))
+	handler := limiter.Handler(okHandler())
+
+	for i := 0; i < 2; i++ {
+		if code := request(handler, http.MethodPost, "/api/v1/estimations", "alice", "10.0.0.1:1234").Code; code != http.StatusOK {
+			t.Fatalf("expected the burst to get status 200, got %d", code)
+		}
+	}
+	rec := request(handler, http.MethodPost, "/api/v1/estimations", "alice", "10.0.0.1:1234")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("expected to retry in 2s at 0.5 request per second, got %q", got)
+	}
+	var body middleware.RateLimited
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("expected a JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	if body.Route != "estimation" || body.Client != "user:alice" || body.RetryAfter != 2 {
+		t.Errorf("unexpected body %+v", body)
+	}
+
+	// other clients and routes are not affected
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "bob", "10.0.0.1:1234").Code; code != http.StatusOK {
+		t.Errorf("expected another user to get status 200, got %d", code)
+	}
+	if code := request(handler, http.MethodGet, "/api/v1/estimations", "alice", "10.0.0.1:1234").Code; code != http.StatusOK {
+		t.Errorf("expected an unlimited route to get status 200, got %d", code)
+	}
+
+	// the bucket refills over time
+	clock.now = clock.now.Add(2 * time.Second)
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "alice", "10.0.0.1:1234").Code; code != http.StatusOK {
+		t.Errorf("expected a token back after 2s, got %d", code)
+	}
+}
+
+func TestRateLimiter_KeysAnonymousRequestsByIP(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	rule := estimationRule
+	rule.Burst = 1
+	limiter := middleware.NewRateLimiter(orgHeader, userHeader, []middleware.RateRule{rule}, middleware.WithRateClock(clock.Now))
+	handler := limiter.Handler(okHandler())
+
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "", "10.0.0.1:1234").Code; code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	rec := request(handler, http.MethodPost, "/api/v1/estimations", "", "10.0.0.1:1234")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the same address to get status 429, got %d", rec.Code)
+	}
+	var body middleware.RateLimited
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Client != "ip:10.0.0.1" {
+		t.Errorf("expected the client keyed by its address, got %+v %v", body, err)
+	}
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "", "10.0.0.1:5678").Code; code != http.StatusTooManyRequests {
+		t.Errorf("expected another port of the same address to get status 429, got %d", code)
+	}
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "", "10.0.0.2:1234").Code; code != http.StatusOK {
+		t.Errorf("expected another address to get status 200, got %d", code)
+	}
+}
+
+func TestRateLimiter_DisabledRule(t *testing.T) {
+	rule := estimationRule
+	rule.Rate = 0
+	// the first matching rule decides, even disabled
+	limiter := middleware.NewRateLimiter(orgHeader, userHeader, []middleware.RateRule{rule, {Route: "api", Path: regexp.MustCompile(`^/api/`), Rate: 1, Burst: 1}})
+	handler := limiter.Handler(okHandler())
+
+	for i := 0; i < 3; i++ {
+		if code := request(handler, http.MethodPost, "/api/v1/estimations", "alice", "10.0.0.1:1234").Code; code != http.StatusOK {
+			t.Fatalf("expected a disabled limit to get status 200, got %d", code)
+		}
+	}
+}
+
+func TestRateLimiter_KeysUsersByOrganization(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	rule := estimationRule
+	rule.Burst = 1
+	limiter := middleware.NewRateLimiter(orgHeader, userHeader, []middleware.RateRule{rule}, middleware.WithRateClock(clock.Now))
+	handler := limiter.Handler(okHandler())
+
+	post := func(org string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/estimations", nil)
+		req.Header.Set("X-User", "alice")
+		req.Header.Set("X-Org", org)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if code := post("acme").Code; code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	rec := post("acme")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the same user of the same organization to get status 429, got %d", rec.Code)
+	}
+	var body middleware.RateLimited
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Client != "user:acme/alice" {
+		t.Errorf("expected the client keyed by its organization and user, got %+v %v", body, err)
+	}
+	// a user of the same name in another organization has a bucket of its own
+	if code := post("globex").Code; code != http.StatusOK {
+		t.Errorf("expected the user of another organization to get status 200, got %d", code)
+	}
+}
+
+func TestRateLimiter_ForwardedFor(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	rule := estimationRule
+	rule.Burst = 1
+
+	post := func(handler http.Handler, ip, forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/estimations", nil)
+		req.RemoteAddr = ip
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// without trusted proxy, rotating the header does not escape the limit
+	handler := middleware.NewRateLimiter(orgHeader, userHeader, []middleware.RateRule{rule}, middleware.WithRateClock(clock.Now)).Handler(okHandler())
+	if code := post(handler, "203.0.113.7:1234", "198.51.100.1").Code; code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	if code := post(handler, "203.0.113.7:1234", "198.51.100.2").Code; code != http.StatusTooManyRequests {
+		t.Errorf("expected a forged X-Forwarded-For to get status 429, got %d", code)
+	}
+
+	// behind a trusted proxy, the clients are told apart by the address it forwards
+	limiter := middleware.NewRateLimiter(orgHeader, userHeader, []middleware.RateRule{rule},
+		middleware.WithRateClock(clock.Now), middleware.WithTrustedProxies(netip.MustParsePrefix("10.0.0.0/8")))
+	handler = limiter.Handler(okHandler())
+	if code := post(handler, "10.0.0.1:1234", "198.51.100.1, 10.0.0.2").Code; code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	rec := post(handler, "10.0.0.3:1234", "192.0.2.9, 198.51.100.1")
+	var body middleware.RateLimited
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || rec.Code != http.StatusTooManyRequests || body.Client != "ip:198.51.100.1" {
+		t.Errorf("expected the forwarded client limited whatever it prepends, got %d %+v %v", rec.Code, body, err)
+	}
+	if code := post(handler, "10.0.0.1:1234", "198.51.100.2").Code; code != http.StatusOK {
+		t.Errorf("expected another forwarded client to get status 200, got %d", code)
+	}
+}
+
+func TestRateLimiter_MaxBuckets(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	rule := estimationRule
+	rule.Burst = 1
+	limiter := middleware.NewRateLimiter(orgHeader, userHeader, []middleware.RateRule{rule},
+		middleware.WithRateClock(clock.Now), middleware.WithMaxRateBuckets(2))
+	handler := limiter.Handler(okHandler())
+
+	for _, ip := range []string{"10.0.0.1:1234", "10.0.0.2:1234"} {
+		if code := request(handler, http.MethodPost, "/api/v1/estimations", "", ip).Code; code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", code)
+		}
+		clock.now = clock.now.Add(time.Second)
+	}
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "", "10.0.0.2:1234").Code; code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", code)
+	}
+
+	// a third client evicts the bucket idle the longest, 10.0.0.1, and keeps the one of 10.0.0.2
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "", "10.0.0.3:1234").Code; code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	if code := request(handler, http.MethodPost, "/api/v1/estimations", "", "10.0.0.2:1234").Code; code != http.StatusTooManyRequests {
+		t.Errorf("expected the bucket of 10.0.0.2 kept, got %d", code)
+	}
+}
@@ -0,0 +1,65 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kubev2v/migration-planner/pkg/middleware"
+	"github.com/kubev2v/migration-planner/pkg/tracing"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var handlerSpan trace.SpanContext
+	router := chi.NewRouter()
+	router.Use(middleware.RequestID, middleware.Tracing)
+	router.Get("/api/v1/plans/{id}", func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/plans/42", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-1")
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "GET /api/v1/plans/{id}" {
+		t.Errorf("expected the span named after the route, got %q", span.Name())
+	}
+	if span.SpanContext().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || span.Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("expected the span to continue the trace of the caller, got %s", span.SpanContext().TraceID())
+	}
+	if handlerSpan.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("expected the span in the context of the request")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected an error status on a 500, got %v", span.Status())
+	}
+
+	var requestID string
+	for _, attr := range span.Attributes() {
+		if attr.Key == tracing.RequestIDKey {
+			requestID = attr.Value.AsString()
+		}
+	}
+	if requestID != "req-1" {
+		t.Errorf("expected the request ID attribute req-1, got %q", requestID)
+	}
+}
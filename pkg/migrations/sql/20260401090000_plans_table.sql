@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE plans (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP,
+    name TEXT NOT NULL,
+    org_id TEXT NOT NULL,
+    username VARCHAR(255),
+    document jsonb NOT NULL
+);
+CREATE UNIQUE INDEX plans_org_id_user_name ON plans (org_id, username, name);
+CREATE INDEX plans_org_id_idx ON plans (org_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE plans;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE rate_cards (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    name TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    org_id TEXT NOT NULL,
+    username VARCHAR(255),
+    currency VARCHAR(3) NOT NULL,
+    region TEXT,
+    valid_from TIMESTAMP NOT NULL,
+    valid_until TIMESTAMP,
+    hourly_rates jsonb NOT NULL
+);
+CREATE UNIQUE INDEX rate_cards_org_id_name_version ON rate_cards (org_id, name, version);
+CREATE INDEX rate_cards_org_id_idx ON rate_cards (org_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE rate_cards;
+-- +goose StatementEnd
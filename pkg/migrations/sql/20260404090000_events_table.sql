@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE events (
+    seq BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    org_id TEXT NOT NULL,
+    username TEXT NOT NULL,
+    aggregate_type TEXT NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    payload jsonb NOT NULL,
+    occurred_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX events_org_id_seq_idx ON events (org_id, seq);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE events;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE shares (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    plan_id VARCHAR(255) NOT NULL REFERENCES plans(id) ON DELETE CASCADE,
+    org_id TEXT NOT NULL,
+    username VARCHAR(255),
+    token_hash TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    report BYTEA NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    views INTEGER NOT NULL DEFAULT 0,
+    last_viewed_at TIMESTAMP
+);
+CREATE UNIQUE INDEX shares_token_hash ON shares (token_hash);
+CREATE INDEX shares_plan_id_idx ON shares (plan_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE shares;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE export_policies (
+    org_id TEXT PRIMARY KEY,
+    watermark BOOLEAN NOT NULL DEFAULT false,
+    raw_exports BOOLEAN NOT NULL DEFAULT true,
+    updated_at TIMESTAMP,
+    updated_by VARCHAR(255)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE export_policies;
+-- +goose StatementEnd
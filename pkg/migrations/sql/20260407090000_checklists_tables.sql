@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE checklist_templates (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    name TEXT NOT NULL,
+    org_id TEXT NOT NULL,
+    username VARCHAR(255),
+    document jsonb NOT NULL
+);
+CREATE UNIQUE INDEX checklist_templates_org_id_name ON checklist_templates (org_id, name);
+
+CREATE TABLE checklists (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP,
+    plan_id VARCHAR(255) NOT NULL REFERENCES plans(id) ON DELETE CASCADE,
+    wave TEXT NOT NULL,
+    vm_id TEXT NOT NULL,
+    template TEXT NOT NULL,
+    document jsonb NOT NULL
+);
+CREATE UNIQUE INDEX checklists_plan_id_wave_vm_id_template ON checklists (plan_id, wave, vm_id, template);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE checklists;
+DROP TABLE checklist_templates;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE vm_trackings (
+    plan_id VARCHAR(255) NOT NULL REFERENCES plans(id) ON DELETE CASCADE,
+    wave TEXT NOT NULL,
+    vm_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    phase TEXT,
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    error TEXT,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (plan_id, wave, vm_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vm_trackings;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE export_policies ADD COLUMN benchmarks BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE benchmark_samples (
+    id VARCHAR(255) PRIMARY KEY,
+    vm_band TEXT NOT NULL,
+    network TEXT NOT NULL,
+    planned_weeks DOUBLE PRECISION NOT NULL,
+    actual_weeks DOUBLE PRECISION NOT NULL,
+    contributed_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX benchmark_samples_cohort ON benchmark_samples (vm_band, network);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE benchmark_samples;
+ALTER TABLE export_policies DROP COLUMN benchmarks;
+-- +goose StatementEnd
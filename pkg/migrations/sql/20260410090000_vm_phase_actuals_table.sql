@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE vm_phase_actuals (
+    plan_id VARCHAR(255) NOT NULL REFERENCES plans(id) ON DELETE CASCADE,
+    vm_id TEXT NOT NULL,
+    phase TEXT NOT NULL,
+    org_id TEXT NOT NULL,
+    wave TEXT,
+    started_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (plan_id, vm_id, phase)
+);
+CREATE INDEX vm_phase_actuals_org_phase ON vm_phase_actuals (org_id, phase);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vm_phase_actuals;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE troubleshooting_models (
+    org_id TEXT PRIMARY KEY,
+    document JSONB NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_by VARCHAR(255)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE troubleshooting_models;
+-- +goose StatementEnd
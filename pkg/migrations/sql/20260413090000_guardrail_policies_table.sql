@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE guardrail_policies (
+    org_id TEXT PRIMARY KEY,
+    document JSONB NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_by VARCHAR(255)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE guardrail_policies;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook_endpoints (
+    id TEXT PRIMARY KEY,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    disabled_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE TABLE webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    endpoint_id TEXT NOT NULL,
+    event_seq BIGINT NOT NULL REFERENCES events(seq) ON DELETE CASCADE,
+    org_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    status TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (endpoint_id, event_seq)
+);
+CREATE INDEX webhook_deliveries_endpoint_id_status_idx ON webhook_deliveries (endpoint_id, status, event_seq);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_endpoints;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE estimation_runs (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    org_id TEXT NOT NULL,
+    username VARCHAR(255),
+    assessment_id VARCHAR(255) NOT NULL REFERENCES assessments(id) ON DELETE CASCADE,
+    cluster_id TEXT NOT NULL,
+    planner_version TEXT,
+    total_duration BIGINT NOT NULL,
+    document JSONB NOT NULL
+);
+CREATE INDEX estimation_runs_assessment_id_idx ON estimation_runs (assessment_id, cluster_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE estimation_runs;
+-- +goose StatementEnd
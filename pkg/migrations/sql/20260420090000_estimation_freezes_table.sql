@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the assessment is not referenced: a freeze outlives it
+CREATE TABLE estimation_freezes (
+    id VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    org_id TEXT NOT NULL,
+    username VARCHAR(255),
+    name TEXT NOT NULL,
+    sow_id TEXT NOT NULL,
+    assessment_id VARCHAR(255) NOT NULL,
+    cluster_id TEXT NOT NULL,
+    plan_id VARCHAR(255),
+    total_duration BIGINT NOT NULL,
+    digest TEXT NOT NULL,
+    document JSONB NOT NULL,
+    report BYTEA NOT NULL
+);
+CREATE UNIQUE INDEX estimation_freezes_org_id_sow_id_name ON estimation_freezes (org_id, sow_id, name);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- a freeze is referenced by a statement of work: it must never change
+CREATE FUNCTION estimation_freezes_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'estimation freezes are immutable';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER estimation_freezes_immutable BEFORE UPDATE ON estimation_freezes
+    FOR EACH ROW EXECUTE FUNCTION estimation_freezes_immutable();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE estimation_freezes;
+DROP FUNCTION estimation_freezes_immutable();
+-- +goose StatementEnd
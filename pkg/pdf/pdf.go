@@ -0,0 +1,207 @@
+// Package pdf renders plain text documents as PDF, e.g. the reports attached to a statement of work,
+// without depending on a rendering engine: A4 pages set in the standard Helvetica fonts.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth  = 595 // A4, in points
+	pageHeight = 842
+	margin     = 56
+	fontSize   = 10
+	titleSize  = 16
+	headSize   = 12
+	leading    = 14
+	// wrapAt is the number of characters set on a line, about the width of the page in Helvetica 10pt.
+	wrapAt = 95
+)
+
+type style int
+
+const (
+	styleText style = iota
+	styleHeading
+	styleTitle
+)
+
+type line struct {
+	style style
+	text  string
+}
+
+// Document is a text document: a title followed by headings and lines of text, wrapped to the page
+// width and broken into pages.
+type Document struct {
+	title string
+	lines []line
+}
+
+// New creates a document starting with the title.
+func New(title string) *Document {
+	return &Document{title: title, lines: []line{{style: styleTitle, text: title}}}
+}
+
+// Heading starts a section.
+func (d *Document) Heading(text string) *Document {
+	d.lines = append(d.lines, line{style: styleText}, line{style: styleHeading, text: text})
+	return d
+}
+
+// Text adds a paragraph, wrapped to the page width.
+func (d *Document) Text(text string) *Document {
+	for _, l := range wrap(text, wrapAt) {
+		d.lines = append(d.lines, line{style: styleText, text: l})
+	}
+	return d
+}
+
+// Textf adds a formatted paragraph.
+func (d *Document) Textf(format string, args ...interface{}) *Document {
+	return d.Text(fmt.Sprintf(format, args...))
+}
+
+// Bytes renders the document. The same document always renders to the same bytes.
+func (d *Document) Bytes() []byte {
+	pages := d.paginate()
+
+	// objects 1 to 4 are the catalog, the page tree and the fonts, then a page and its content per page
+	var objects []string
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+2*i))
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	)
+	for i, p := range pages {
+		content := p.content(i+1, len(pages))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, 6+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+	objects = append(objects, fmt.Sprintf("<< /Title (%s) /Producer (migration-planner) >>", escape(d.title)))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, 0, len(objects))
+	for i, o := range objects {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, len(objects), xref)
+	return buf.Bytes()
+}
+
+type page struct {
+	lines []line
+}
+
+// paginate breaks the lines into pages, leaving room for the page number at the bottom.
+func (d *Document) paginate() []page {
+	perPage := (pageHeight - 2*margin - leading) / leading
+	var pages []page
+	current := page{}
+	for _, l := range d.lines {
+		if len(current.lines) == perPage {
+			pages = append(pages, current)
+			current = page{}
+		}
+		// no blank line at the top of a page
+		if len(current.lines) == 0 && l.style == styleText && l.text == "" && len(pages) > 0 {
+			continue
+		}
+		current.lines = append(current.lines, l)
+	}
+	return append(pages, current)
+}
+
+// content draws the lines of the page and its number.
+func (p page) content(number, total int) string {
+	var b strings.Builder
+	y := pageHeight - margin
+	for _, l := range p.lines {
+		font, size := "F1", fontSize
+		switch l.style {
+		case styleHeading:
+			font, size = "F2", headSize
+		case styleTitle:
+			font, size = "F2", titleSize
+		}
+		if l.text != "" {
+			fmt.Fprintf(&b, "BT /%s %d Tf %d %d Td (%s) Tj ET\n", font, size, margin, y, escape(l.text))
+		}
+		y -= leading
+	}
+	fmt.Fprintf(&b, "BT /F1 8 Tf %d %d Td (%s) Tj ET", margin, margin/2, escape(fmt.Sprintf("Page %d of %d", number, total)))
+	return b.String()
+}
+
+// wrap breaks the text into lines of at most width characters, at spaces when it can.
+func wrap(text string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+		current := ""
+		for _, w := range words {
+			for len(w) > width {
+				if current != "" {
+					lines = append(lines, current)
+					current = ""
+				}
+				lines = append(lines, w[:width])
+				w = w[width:]
+			}
+			switch {
+			case current == "":
+				current = w
+			case len(current)+1+len(w) > width:
+				lines = append(lines, current)
+				current = w
+			default:
+				current += " " + w
+			}
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// escape escapes the text for a PDF string. Characters outside of Latin-1 have no glyph in the
+// standard fonts and are replaced by a question mark.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20:
+			b.WriteByte(' ')
+		case r < 0x80:
+			b.WriteRune(r)
+		case r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,76 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDocument_Bytes(t *testing.T) {
+	t.Parallel()
+	d := New("SOW-1234 (baseline)").Heading("Breakdown").Text("Storage Migration: 36h")
+	out := d.Bytes()
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("expected a PDF file, got %q", out)
+	}
+	if !bytes.Contains(out, []byte(`(SOW-1234 \(baseline\)) Tj`)) {
+		t.Errorf("expected the title escaped, got %s", out)
+	}
+	if !bytes.Contains(out, []byte("(Storage Migration: 36h) Tj")) {
+		t.Errorf("expected the text drawn, got %s", out)
+	}
+	if !bytes.Equal(out, d.Bytes()) {
+		t.Errorf("expected the same document to render to the same bytes")
+	}
+
+	// every object is where the cross-reference table says it is
+	xref := regexp.MustCompile(`(?m)^(\d{10}) 00000 n $`).FindAllStringSubmatch(string(out), -1)
+	if len(xref) != 7 {
+		t.Fatalf("expected 7 objects, got %d", len(xref))
+	}
+	for i, entry := range xref {
+		offset, _ := strconv.Atoi(entry[1])
+		if want := fmt.Sprintf("%d 0 obj", i+1); !strings.HasPrefix(string(out[offset:]), want) {
+			t.Errorf("expected object %d at offset %d", i+1, offset)
+		}
+	}
+}
+
+func TestDocument_Pages(t *testing.T) {
+	t.Parallel()
+	d := New("long report")
+	for i := 0; i < 120; i++ {
+		d.Textf("line %d", i)
+	}
+	out := string(d.Bytes())
+
+	if !strings.Contains(out, "/Count 3") || !strings.Contains(out, "(Page 3 of 3) Tj") {
+		t.Errorf("expected 3 pages, got %s", out)
+	}
+	if !strings.Contains(out, "(line 119) Tj") {
+		t.Errorf("expected the last line drawn")
+	}
+}
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+	got := wrap("the quick brown fox jumps\n\nover", 10)
+	want := []string{"the quick", "brown fox", "jumps", "", "over"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got := wrap(strings.Repeat("x", 25), 10); len(got) != 3 || got[2] != "xxxxx" {
+		t.Errorf("expected a long word broken, got %q", got)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	t.Parallel()
+	if got := escape(`a\b (c) é €`); got != `a\\b \(c\) \351 ?` {
+		t.Errorf("unexpected escape %q", got)
+	}
+}
@@ -0,0 +1,102 @@
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var (
+	startXref = regexp.MustCompile(`startxref\s+(\d+)\s+%%EOF\s*$`)
+	trailer   = regexp.MustCompile(`(?s)trailer\s*<<(.*?)>>\s*startxref`)
+	size      = regexp.MustCompile(`/Size (\d+)`)
+	info      = regexp.MustCompile(`/Info (\d+) 0 R`)
+	kids      = regexp.MustCompile(`/Kids \[([^\]]*)\]`)
+	reference = regexp.MustCompile(`(\d+) 0 R`)
+	contents  = regexp.MustCompile(`/Contents (\d+ 0 R|\[[^\]]*\])`)
+)
+
+// Stamp draws the text across every page of a document rendered by Bytes, e.g. who downloaded it and
+// when. The document is stamped with an incremental update: its bytes are kept as they are and the
+// stamp is appended, so the document as rendered can still be told apart from the stamp.
+func Stamp(doc []byte, text string) ([]byte, error) {
+	m := startXref.FindSubmatch(doc)
+	if m == nil {
+		return nil, errors.New("not a PDF document: no cross-reference table")
+	}
+	prev := string(m[1])
+	trailers := trailer.FindAllSubmatch(doc, -1)
+	if trailers == nil {
+		return nil, errors.New("not a PDF document: no trailer")
+	}
+	last := trailers[len(trailers)-1][1]
+	sm := size.FindSubmatch(last)
+	if sm == nil {
+		return nil, errors.New("not a PDF document: no size in the trailer")
+	}
+	next, _ := strconv.Atoi(string(sm[1]))
+
+	pages, err := object(doc, 2)
+	if err != nil {
+		return nil, err
+	}
+	km := kids.FindSubmatch(pages)
+	if km == nil {
+		return nil, errors.New("not a document rendered by Bytes: no page tree")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(doc)
+	offsets := make(map[int]int)
+	for _, kid := range reference.FindAllSubmatch(km[1], -1) {
+		number, _ := strconv.Atoi(string(kid[1]))
+		page, err := object(doc, number)
+		if err != nil {
+			return nil, err
+		}
+		cm := contents.FindSubmatchIndex(page)
+		if cm == nil {
+			return nil, fmt.Errorf("page %d has no content", number)
+		}
+		current := bytes.Trim(page[cm[2]:cm[3]], "[]")
+		stamped := fmt.Sprintf("%s/Contents [%s %d 0 R]%s", page[:cm[0]], current, next, page[cm[1]:])
+
+		offsets[number] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", number, stamped)
+		content := stampContent(text)
+		offsets[next] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n<< /Length %d >>\nstream\n%s\nendstream\nendobj\n", next, len(content), content)
+		next++
+	}
+
+	xref := buf.Len()
+	buf.WriteString("xref\n")
+	for number := 1; number < next; number++ {
+		if offset, ok := offsets[number]; ok {
+			fmt.Fprintf(&buf, "%d 1\n%010d 00000 n \n", number, offset)
+		}
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R", next)
+	if im := info.FindSubmatch(last); im != nil {
+		fmt.Fprintf(&buf, " /Info %s 0 R", im[1])
+	}
+	fmt.Fprintf(&buf, " /Prev %s >>\nstartxref\n%d\n%%%%EOF\n", prev, xref)
+	return buf.Bytes(), nil
+}
+
+// stampContent draws the text diagonally across the page, in light grey for the page to stay readable.
+func stampContent(text string) string {
+	return fmt.Sprintf("q 0.8 g BT /F1 14 Tf 0.7071 0.7071 -0.7071 0.7071 %d %d Tm (%s) Tj ET Q", margin+60, margin+120, escape(text))
+}
+
+// object returns the body of the last revision of the object.
+func object(doc []byte, number int) ([]byte, error) {
+	re := regexp.MustCompile(fmt.Sprintf(`(?s)(?:^|\n)%d 0 obj\n(.*?)\nendobj\n`, number))
+	all := re.FindAllSubmatch(doc, -1)
+	if all == nil {
+		return nil, fmt.Errorf("not a document rendered by Bytes: no object %d", number)
+	}
+	return all[len(all)-1][1], nil
+}
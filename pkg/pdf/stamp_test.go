@@ -0,0 +1,57 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestStamp(t *testing.T) {
+	t.Parallel()
+	d := New("long report")
+	for i := 0; i < 60; i++ {
+		d.Textf("line %d", i)
+	}
+	doc := d.Bytes()
+
+	out, err := Stamp(doc, "acme · jdoe · 2026-04-06T09:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !bytes.HasPrefix(out, doc) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("expected the stamp appended to the document as rendered")
+	}
+	update := string(out[len(doc):])
+	if strings.Count(update, `(acme \267 jdoe \267 2026-04-06T09:00:00Z) Tj`) != 2 {
+		t.Errorf("expected both pages stamped, got %s", update)
+	}
+	if !strings.Contains(update, "/Contents [6 0 R 10 0 R]") || !strings.Contains(update, "/Prev ") {
+		t.Errorf("expected the pages to draw their content then the stamp, got %s", update)
+	}
+
+	// every object of the update is where its cross-reference section says it is
+	xref := regexp.MustCompile(`(?m)^(\d+) 1\n(\d{10}) 00000 n $`).FindAllStringSubmatch(update, -1)
+	if len(xref) != 4 {
+		t.Fatalf("expected 2 pages and 2 contents in the update, got %d", len(xref))
+	}
+	for _, entry := range xref {
+		offset, _ := strconv.Atoi(entry[2])
+		if !strings.HasPrefix(string(out[offset:]), entry[1]+" 0 obj") {
+			t.Errorf("expected object %s at offset %d", entry[1], offset)
+		}
+	}
+
+	again, err := Stamp(out, "second")
+	if err != nil {
+		t.Fatalf("unexpected error stamping again %v", err)
+	}
+	if !strings.Contains(string(again[len(out):]), "/Contents [6 0 R 10 0 R 12 0 R]") {
+		t.Errorf("expected the stamps to pile up, got %s", again[len(out):])
+	}
+
+	if _, err := Stamp([]byte("not a pdf"), "stamp"); err == nil {
+		t.Error("expected an error for a document which is not a PDF")
+	}
+}
@@ -0,0 +1,228 @@
+package replay
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBodySize bounds the request bodies captured; larger bodies are omitted.
+	maxBodySize = 1 << 20
+	// maxResponseSize bounds the response bodies read for the ID of the resource they return.
+	maxResponseSize = 64 << 10
+)
+
+// identifyingKeys are the JSON keys and query parameters whose values are anonymized, lower case,
+// along with the values nested in them. Keys ending with "name" are anonymized as well.
+var identifyingKeys = map[string]bool{
+	"email":            true,
+	"description":      true,
+	"hostname":         true,
+	"host":             true,
+	"ip":               true,
+	"ipaddress":        true,
+	"ipv4":             true,
+	"defaultgateway":   true,
+	"dns":              true,
+	"subnetmask":       true,
+	"url":              true,
+	"httpurl":          true,
+	"httpsurl":         true,
+	"noproxy":          true,
+	"proxy":            true,
+	"credentialurl":    true,
+	"certificatechain": true,
+	"folder":           true,
+	"folders":          true,
+	"path":             true,
+	"orgid":            true,
+	"organization":     true,
+	"owner":            true,
+	"approvers":        true,
+	"comment":          true,
+	"note":             true,
+	"sshpublickey":     true,
+	"token":            true,
+	"password":         true,
+}
+
+// Recorder captures the requests of an HTTP server as anonymized records: identifying values are
+// replaced by stable pseudonyms, headers and non-JSON bodies are left out.
+type Recorder struct {
+	mu    sync.Mutex
+	enc   *json.Encoder
+	start time.Time
+	salt  []byte
+}
+
+// NewRecorder creates a Recorder writing the records to w as JSON lines.
+func NewRecorder(w io.Writer) *Recorder {
+	salt := make([]byte, 16)
+	_, _ = rand.Read(salt)
+	return &Recorder{enc: json.NewEncoder(w), start: time.Now(), salt: salt}
+}
+
+// Handler is the middleware capturing the requests.
+func (rec *Recorder) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived := time.Now()
+		record := Record{
+			Offset:      arrived.Sub(rec.start),
+			Method:      r.Method,
+			Path:        r.URL.Path,
+			Query:       rec.anonymizeQuery(r.URL.Query()),
+			ContentType: r.Header.Get("Content-Type"),
+		}
+
+		if r.Body != nil && r.Body != http.NoBody {
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+			if err == nil {
+				r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+				if len(body) <= maxBodySize && json.Valid(body) {
+					record.Body = rec.anonymizeJSON(body)
+				} else if len(body) > 0 {
+					record.BodyOmitted = true
+				}
+			}
+		}
+
+		cw := &captureWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(cw, r)
+
+		record.Status = cw.status
+		record.Duration = time.Since(arrived)
+		record.ResourceID = resourceID(cw.body.Bytes())
+		rec.write(record)
+	})
+}
+
+func (rec *Recorder) write(record Record) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if err := rec.enc.Encode(record); err != nil {
+		zap.S().Named("traffic_capture").Errorw("failed to write record", "error", err)
+	}
+}
+
+// anonymizeJSON replaces the identifying values of a JSON document.
+func (rec *Recorder) anonymizeJSON(body []byte) json.RawMessage {
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil
+	}
+	out, err := json.Marshal(rec.anonymize(doc, false))
+	if err != nil {
+		return nil
+	}
+	return out
+}
+
+func (rec *Recorder) anonymize(v any, identifying bool) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			v[k] = rec.anonymize(child, identifying || isIdentifying(k))
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = rec.anonymize(child, identifying)
+		}
+		return v
+	case string:
+		if identifying {
+			return rec.pseudonym(v)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func (rec *Recorder) anonymizeQuery(query url.Values) string {
+	for k, values := range query {
+		if !isIdentifying(k) {
+			continue
+		}
+		for i, v := range values {
+			values[i] = rec.pseudonym(v)
+		}
+	}
+	return query.Encode()
+}
+
+// pseudonym returns the stable pseudonym of a value within the capture.
+func (rec *Recorder) pseudonym(value string) string {
+	if value == "" {
+		return value
+	}
+	sum := sha256.Sum256(append(append([]byte{}, rec.salt...), value...))
+	return "anon-" + hex.EncodeToString(sum[:6])
+}
+
+func isIdentifying(key string) bool {
+	key = strings.ToLower(key)
+	return identifyingKeys[key] || strings.HasSuffix(key, "name")
+}
+
+// resourceID returns the ID of the resource of a JSON response, if any.
+func resourceID(body []byte) string {
+	var res struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil || len(res.ID) == 0 {
+		return ""
+	}
+	var id string
+	if err := json.Unmarshal(res.ID, &id); err == nil {
+		return id
+	}
+	return string(res.ID)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// captureWriter keeps the status and the beginning of the body of a response.
+type captureWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *captureWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	if room := maxResponseSize - w.body.Len(); room > 0 {
+		w.body.Write(b[:min(len(b), room)])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends the buffered response to the client, for the streamed responses to keep streaming.
+func (w *captureWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped writer to http.ResponseController.
+func (w *captureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
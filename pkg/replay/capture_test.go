@@ -0,0 +1,94 @@
+package replay
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	v1alpha1 "github.com/kubev2v/migration-planner/api/v1alpha1"
+	agent "github.com/kubev2v/migration-planner/api/v1alpha1/agent"
+)
+
+// identifyingWords are the words of the keys carrying addresses, credentials, paths or free text.
+var identifyingWords = map[string]bool{
+	"ip": true, "ipv4": true, "url": true, "proxy": true, "dns": true, "gateway": true, "mask": true,
+	"certificate": true, "credential": true, "folder": true, "folders": true, "path": true,
+	"email": true, "hostname": true, "owner": true, "approvers": true, "comment": true, "note": true,
+	"ssh": true, "password": true, "token": true, "secret": true,
+}
+
+// notIdentifying are the keys with an identifying word holding no identifying value, e.g. a number.
+var notIdentifying = map[string]bool{"folderDepth": true}
+
+var wordBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// TestIdentifyingKeys walks the request bodies and query parameters of the APIs and checks the keys
+// made of an identifying word are anonymized, for a new field not to leak into the captures.
+func TestIdentifyingKeys(t *testing.T) {
+	t.Parallel()
+	keys := make(map[string]bool)
+	for _, load := range []func() (*openapi3.T, error){v1alpha1.GetSwagger, agent.GetSwagger} {
+		spec, err := load()
+		if err != nil {
+			t.Fatalf("loading spec: %v", err)
+		}
+		requestKeys(spec, keys)
+	}
+
+	for key := range keys {
+		if notIdentifying[key] {
+			continue
+		}
+		for _, word := range strings.FieldsFunc(wordBoundary.ReplaceAllString(key, "${1}_${2}"), func(r rune) bool { return r == '_' }) {
+			if identifyingWords[strings.ToLower(word)] && !isIdentifying(key) {
+				t.Errorf("the values of %q are captured as is", key)
+			}
+		}
+	}
+	for _, key := range []string{"defaultGateway", "dns", "subnetMask", "certificateChain", "httpUrl", "httpsUrl", "noProxy", "credentialUrl", "folder"} {
+		if !keys[key] {
+			t.Errorf("expected %q in the request schemas", key)
+		}
+	}
+}
+
+// requestKeys adds the query parameters and the keys of the request bodies of the spec.
+func requestKeys(spec *openapi3.T, keys map[string]bool) {
+	seen := make(map[*openapi3.Schema]bool)
+	var walk func(ref *openapi3.SchemaRef)
+	walk = func(ref *openapi3.SchemaRef) {
+		if ref == nil || ref.Value == nil || seen[ref.Value] {
+			return
+		}
+		s := ref.Value
+		seen[s] = true
+		for key, prop := range s.Properties {
+			keys[key] = true
+			walk(prop)
+		}
+		walk(s.Items)
+		walk(s.AdditionalProperties.Schema)
+		for _, refs := range []openapi3.SchemaRefs{s.AllOf, s.OneOf, s.AnyOf} {
+			for _, r := range refs {
+				walk(r)
+			}
+		}
+	}
+
+	for _, item := range spec.Paths.Map() {
+		for _, op := range item.Operations() {
+			for _, p := range op.Parameters {
+				if p.Value != nil && p.Value.In == openapi3.ParameterInQuery {
+					keys[p.Value.Name] = true
+				}
+			}
+			if op.RequestBody == nil || op.RequestBody.Value == nil {
+				continue
+			}
+			for _, media := range op.RequestBody.Value.Content {
+				walk(media.Schema)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Package replay captures the API traffic of the planner and replays it against another instance,
+// e.g. a staging one, to validate performance changes under a realistic load.
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Record is a captured request, one JSON line of a capture file.
+type Record struct {
+	// Offset is the time the request arrived at, from the start of the capture.
+	Offset      time.Duration   `json:"offset"`
+	Method      string          `json:"method"`
+	Path        string          `json:"path"`
+	Query       string          `json:"query,omitempty"`
+	ContentType string          `json:"contentType,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	// BodyOmitted tells the request had a body which is not JSON, e.g. an uploaded file. Such
+	// requests are not replayed.
+	BodyOmitted bool `json:"bodyOmitted,omitempty"`
+	// ResourceID is the ID of the resource returned by the request, so the replay can substitute
+	// the ID of the resource created on the target in the following requests.
+	ResourceID string        `json:"resourceId,omitempty"`
+	Status     int           `json:"status"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// Read reads the records of a capture file, in order.
+func Read(r io.Reader) ([]Record, error) {
+	var records []Record
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxBodySize*2)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return nil, fmt.Errorf("invalid record at line %d: %w", line, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read records: %w", err)
+	}
+	return records, nil
+}
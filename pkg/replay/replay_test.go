@@ -0,0 +1,132 @@
+package replay_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/replay"
+)
+
+// planServer creates plans and serves them by ID, answering with a new ID on each creation.
+func planServer(t *testing.T, ids ...string) (http.Handler, *[]string) {
+	t.Helper()
+	var mu sync.Mutex
+	var paths []string
+	next := 0
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		paths = append(paths, r.URL.Path)
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1/plans" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"` + ids[next] + `","name":"plan"}`))
+			next++
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}), &paths
+}
+
+func TestCaptureAndReplay(t *testing.T) {
+	t.Parallel()
+
+	var capture bytes.Buffer
+	recorder := replay.NewRecorder(&capture)
+	handler, _ := planServer(t, "captured-id")
+	srv := httptest.NewServer(recorder.Handler(handler))
+	defer srv.Close()
+
+	requests := []struct {
+		method, path, contentType, body string
+	}{
+		{http.MethodPost, "/api/v1/plans", "application/json", `{"name":"Acme payroll","waves":[{"name":"wave-1","vmCount":12}]}`},
+		{http.MethodGet, "/api/v1/plans/captured-id?username=jdoe", "", ""},
+		{http.MethodPost, "/api/v1/assessments/rvtools", "multipart/form-data", "PK\x03\x04"},
+	}
+	for _, r := range requests {
+		req, err := http.NewRequest(r.method, srv.URL+r.path, strings.NewReader(r.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.contentType != "" {
+			req.Header.Set("Content-Type", r.contentType)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+
+	records, err := replay.Read(&capture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(records))
+	}
+
+	created := records[0]
+	if created.ResourceID != "captured-id" || created.Status != http.StatusCreated {
+		t.Errorf("expected the ID and status of the created plan, got %q and %d", created.ResourceID, created.Status)
+	}
+	var body struct {
+		Name  string `json:"name"`
+		Waves []struct {
+			Name    string `json:"name"`
+			VMCount int    `json:"vmCount"`
+		} `json:"waves"`
+	}
+	if err := json.Unmarshal(created.Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(created.Body), "Acme") || !strings.HasPrefix(body.Name, "anon-") {
+		t.Errorf("expected the name to be anonymized, got %s", created.Body)
+	}
+	if body.Waves[0].Name == "wave-1" || body.Waves[0].VMCount != 12 {
+		t.Errorf("expected only the identifying values to be anonymized, got %s", created.Body)
+	}
+	if strings.Contains(records[1].Query, "jdoe") {
+		t.Errorf("expected the username to be anonymized, got %q", records[1].Query)
+	}
+	if !records[2].BodyOmitted || records[2].Body != nil {
+		t.Errorf("expected the uploaded file to be omitted, got %+v", records[2])
+	}
+
+	target, paths := planServer(t, "replayed-id")
+	targetSrv := httptest.NewServer(target)
+	defer targetSrv.Close()
+
+	report, _ := replay.NewReplayer(targetSrv.URL, nil, 0).Run(context.Background(), records)
+	if report.Sent != 2 || report.Skipped != 1 || report.Mismatched != 0 {
+		t.Errorf("expected 2 requests sent and the upload skipped, got %+v", report)
+	}
+	if got := (*paths)[len(*paths)-1]; got != "/api/v1/plans/replayed-id" {
+		t.Errorf("expected the captured ID to be replaced by the replayed one, got %q", got)
+	}
+}
+
+func TestCapture_Flush(t *testing.T) {
+	t.Parallel()
+	handler := replay.NewRecorder(io.Discard).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("data: progress\n\n"))
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("expected the capture to keep the response streamed, got %v", err)
+		}
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/plans/captured-id/progress", nil))
+	if !w.Flushed {
+		t.Error("expected the response to be flushed")
+	}
+}
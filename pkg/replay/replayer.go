@@ -0,0 +1,214 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Replayer sends captured requests to a target instance, keeping their relative timing divided by
+// a speed-up factor.
+type Replayer struct {
+	baseURL string
+	client  *http.Client
+	speedup float64
+	editors []func(*http.Request)
+
+	mu sync.Mutex
+	// ids maps the IDs of the resources returned during the capture to the IDs returned by the target.
+	ids map[string]string
+}
+
+// NewReplayer creates a Replayer sending the requests to the base URL. A speed-up below or equal
+// to zero sends each request once the previous one is answered.
+func NewReplayer(baseURL string, client *http.Client, speedup float64) *Replayer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Replayer{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  client,
+		speedup: speedup,
+		ids:     map[string]string{},
+	}
+}
+
+// WithRequestEditor adds a function editing the requests before they are sent, e.g. to authenticate them.
+func (rp *Replayer) WithRequestEditor(fn func(*http.Request)) *Replayer {
+	rp.editors = append(rp.editors, fn)
+	return rp
+}
+
+// Result is the outcome of a replayed request.
+type Result struct {
+	Record Record
+	Status int
+	// Duration is the time the target took to answer, zero for a failed request.
+	Duration time.Duration
+	Err      error
+}
+
+// Report summarizes a replay.
+type Report struct {
+	Sent    int
+	Skipped int
+	Failed  int
+	// Mismatched counts the requests answered with another status than during the capture.
+	Mismatched int
+	// Recorded and Replayed are the latency percentiles during the capture and on the target.
+	Recorded Latencies
+	Replayed Latencies
+}
+
+// Latencies are latency percentiles.
+type Latencies struct {
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+// Run replays the records and waits for all their responses. Records whose body was omitted are
+// skipped. Requests still in flight are cancelled once ctx is done.
+func (rp *Replayer) Run(ctx context.Context, records []Record) (Report, []Result) {
+	start := time.Now()
+	results := make([]Result, len(records))
+	var wg sync.WaitGroup
+	for i, rec := range records {
+		if rec.BodyOmitted {
+			results[i] = Result{Record: rec}
+			continue
+		}
+		if err := rp.wait(ctx, start, rec.Offset); err != nil {
+			results[i] = Result{Record: rec, Err: err}
+			continue
+		}
+		if rp.speedup <= 0 {
+			results[i] = rp.send(ctx, rec)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = rp.send(ctx, rec)
+		}()
+	}
+	wg.Wait()
+	return Summarize(results), results
+}
+
+// wait waits until the time of the record on the accelerated clock of the replay.
+func (rp *Replayer) wait(ctx context.Context, start time.Time, offset time.Duration) error {
+	if rp.speedup <= 0 {
+		return ctx.Err()
+	}
+	delay := time.Until(start.Add(time.Duration(float64(offset) / rp.speedup)))
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (rp *Replayer) send(ctx context.Context, rec Record) Result {
+	target := rp.baseURL + rp.substitute(rec.Path)
+	if rec.Query != "" {
+		target += "?" + rp.substitute(rec.Query)
+	}
+	var body io.Reader
+	if len(rec.Body) > 0 {
+		body = bytes.NewReader([]byte(rp.substitute(string(rec.Body))))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, rec.Method, target, body)
+	if err != nil {
+		return Result{Record: rec, Err: fmt.Errorf("failed to create request: %w", err)}
+	}
+	if rec.ContentType != "" {
+		req.Header.Set("Content-Type", rec.ContentType)
+	}
+	for _, edit := range rp.editors {
+		edit(req)
+	}
+
+	sent := time.Now()
+	resp, err := rp.client.Do(req)
+	if err != nil {
+		return Result{Record: rec, Err: fmt.Errorf("failed to send %s %s: %w", rec.Method, rec.Path, err)}
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	duration := time.Since(sent)
+	if err != nil {
+		return Result{Record: rec, Status: resp.StatusCode, Err: fmt.Errorf("failed to read response: %w", err)}
+	}
+
+	if rec.ResourceID != "" {
+		if id := resourceID(respBody); id != "" {
+			rp.mu.Lock()
+			rp.ids[rec.ResourceID] = id
+			rp.mu.Unlock()
+		}
+	}
+	return Result{Record: rec, Status: resp.StatusCode, Duration: duration}
+}
+
+// substitute replaces the IDs of the resources of the capture by the IDs of the target.
+func (rp *Replayer) substitute(s string) string {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	for captured, replayed := range rp.ids {
+		s = strings.ReplaceAll(s, captured, replayed)
+	}
+	return s
+}
+
+// Summarize reports the results of a replay.
+func Summarize(results []Result) Report {
+	var report Report
+	var recorded, replayed []time.Duration
+	for _, res := range results {
+		switch {
+		case res.Record.BodyOmitted:
+			report.Skipped++
+			continue
+		case res.Err != nil:
+			report.Failed++
+			continue
+		}
+		report.Sent++
+		if res.Status != res.Record.Status {
+			report.Mismatched++
+		}
+		recorded = append(recorded, res.Record.Duration)
+		replayed = append(replayed, res.Duration)
+	}
+	report.Recorded = percentiles(recorded)
+	report.Replayed = percentiles(replayed)
+	return report
+}
+
+func percentiles(durations []time.Duration) Latencies {
+	if len(durations) == 0 {
+		return Latencies{}
+	}
+	slices.Sort(durations)
+	at := func(p float64) time.Duration {
+		return durations[int(p*float64(len(durations)-1))]
+	}
+	return Latencies{P50: at(0.50), P95: at(0.95), P99: at(0.99), Max: durations[len(durations)-1]}
+}
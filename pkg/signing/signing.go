@@ -0,0 +1,329 @@
+// Package signing signs exports with an x509 certificate and verifies them, so a contractual
+// deliverable, e.g. an exported plan, can be proven unmodified later on.
+//
+// Signatures are detached: the export is left as is and the Signature travels next to it. A signature
+// covers the SHA-256 digest of the export and the time it was signed, and carries the certificate
+// chain of the signer, which the verifier checks against the roots it trusts or a pinned signing
+// certificate. Signing certificates must allow code signing or document signing.
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Version is the version of the signed message layout.
+const Version = "planner-signature-v1"
+
+const digestPrefix = "sha256:"
+
+// ErrInvalidSignature is the error of a signature which does not match the export or its certificate.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// oidDocumentSigning is the document signing extended key usage of RFC 9336, unknown to crypto/x509.
+var oidDocumentSigning = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 36}
+
+// maxClockSkew is how far in the future of the verification time a signing time is accepted.
+const maxClockSkew = 5 * time.Minute
+
+// Signature is the detached signature of an export.
+type Signature struct {
+	Version string `json:"version"`
+	// Digest is the digest of the export, "sha256:" followed by its hex SHA-256.
+	Digest   string    `json:"digest"`
+	SignedAt time.Time `json:"signedAt"`
+	// Value is the signature of the message of Digest and SignedAt, see message.
+	Value []byte `json:"value"`
+	// Certificates is the PEM encoded certificate chain of the signer, the signing certificate first.
+	Certificates string `json:"certificates"`
+}
+
+// Signer signs exports with a private key and its certificate.
+type Signer struct {
+	key   crypto.Signer
+	chain []*x509.Certificate
+	pem   string
+	now   func() time.Time
+}
+
+// NewSigner creates a signer from the PEM encoded private key, PKCS #8, PKCS #1 or SEC 1, and the PEM
+// encoded certificate chain of the key, the certificate of the key first.
+func NewSigner(keyPEM, certPEM []byte) (*Signer, error) {
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := parseCertificates(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	if !publicKeyEqual(chain[0].PublicKey, key.Public()) {
+		return nil, errors.New("the certificate is not the certificate of the private key")
+	}
+	if !signingUsage(chain[0]) {
+		return nil, errors.New("the certificate allows neither code signing nor document signing")
+	}
+	return &Signer{key: key, chain: chain, pem: string(certPEM), now: time.Now}, nil
+}
+
+// Certificate returns the signing certificate.
+func (s *Signer) Certificate() *x509.Certificate {
+	return s.chain[0]
+}
+
+// Sign signs the export.
+func (s *Signer) Sign(data []byte) (Signature, error) {
+	sig := Signature{
+		Version:      Version,
+		Digest:       Digest(data),
+		SignedAt:     s.now().UTC().Truncate(time.Second),
+		Certificates: s.pem,
+	}
+
+	msg := sig.message()
+	var err error
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		sig.Value, err = s.key.Sign(rand.Reader, msg, crypto.Hash(0))
+	} else {
+		sum := sha256.Sum256(msg)
+		sig.Value, err = s.key.Sign(rand.Reader, sum[:], crypto.SHA256)
+	}
+	if err != nil {
+		return Signature{}, fmt.Errorf("signing: %w", err)
+	}
+	return sig, nil
+}
+
+// Digest returns the digest of the export as found in its signature.
+func Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return digestPrefix + hex.EncodeToString(sum[:])
+}
+
+// message is what is signed: the version, the digest of the export and the signing time, one per line.
+func (sig Signature) message() []byte {
+	return []byte(strings.Join([]string{sig.Version, sig.Digest, sig.SignedAt.UTC().Format(time.RFC3339)}, "\n"))
+}
+
+// Encode encodes the signature as base64 encoded JSON, e.g. for an HTTP header.
+func (sig Signature) Encode() (string, error) {
+	doc, err := json.Marshal(sig)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(doc), nil
+}
+
+// Decode decodes a signature encoded by Encode, or given as plain JSON.
+func Decode(s string) (Signature, error) {
+	doc := []byte(strings.TrimSpace(s))
+	if !json.Valid(doc) {
+		decoded, err := base64.StdEncoding.DecodeString(string(doc))
+		if err != nil {
+			return Signature{}, fmt.Errorf("decoding signature: %w", err)
+		}
+		doc = decoded
+	}
+	var sig Signature
+	if err := json.Unmarshal(doc, &sig); err != nil {
+		return Signature{}, fmt.Errorf("decoding signature: %w", err)
+	}
+	return sig, nil
+}
+
+// VerifyOptions are the trust anchors a signature is verified against. At least one of Roots and
+// Fingerprint must be set.
+type VerifyOptions struct {
+	// Roots are the certificates trusted to issue signing certificates.
+	Roots *x509.CertPool
+	// Fingerprint pins the signing certificate by its SHA-256 fingerprint, see Fingerprint.
+	Fingerprint string
+	// Now is the time the signing certificate must be valid at, time.Now when zero. The signing time
+	// is written by the signer and is not trusted for it.
+	Now time.Time
+}
+
+// Fingerprint returns the SHA-256 fingerprint of a certificate, "sha256:" followed by its hex digest.
+func Fingerprint(cert *x509.Certificate) string {
+	return Digest(cert.Raw)
+}
+
+// Verify checks the signature is the signature of the export by the certificate it carries, the
+// certificate allows code signing or document signing and is valid at the verification time, and it
+// chains up to one of the roots or is the pinned one. It returns the signing certificate.
+func Verify(data []byte, sig Signature, opts VerifyOptions) (*x509.Certificate, error) {
+	if opts.Roots == nil && opts.Fingerprint == "" {
+		return nil, errors.New("no trusted roots nor pinned signer to verify the signature against")
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	if sig.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %q", ErrInvalidSignature, sig.Version)
+	}
+	if got := Digest(data); got != sig.Digest {
+		return nil, fmt.Errorf("%w: the export was modified, its digest is %s instead of %s", ErrInvalidSignature, got, sig.Digest)
+	}
+
+	chain, err := parseCertificates([]byte(sig.Certificates))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	leaf := chain[0]
+	if opts.Fingerprint != "" && !strings.EqualFold(Fingerprint(leaf), opts.Fingerprint) {
+		return nil, fmt.Errorf("%w: signed by %s, not the pinned signer", ErrInvalidSignature, Fingerprint(leaf))
+	}
+	if !signingUsage(leaf) {
+		return nil, fmt.Errorf("%w: the certificate allows neither code signing nor document signing", ErrInvalidSignature)
+	}
+	if now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+		return nil, fmt.Errorf("%w: the certificate is not valid at %s", ErrInvalidSignature, now.UTC().Format(time.RFC3339))
+	}
+	if sig.SignedAt.Before(leaf.NotBefore) || sig.SignedAt.After(now.Add(maxClockSkew)) {
+		return nil, fmt.Errorf("%w: signing time %s out of the validity of the certificate", ErrInvalidSignature, sig.SignedAt.Format(time.RFC3339))
+	}
+	if opts.Roots != nil {
+		if err := verifyChain(leaf, chain[1:], opts.Roots, now); err != nil {
+			return nil, fmt.Errorf("%w: untrusted certificate: %v", ErrInvalidSignature, err)
+		}
+	}
+
+	if err := verifyValue(leaf.PublicKey, sig.message(), sig.Value); err != nil {
+		return nil, err
+	}
+	return leaf, nil
+}
+
+// signingUsage tells whether the certificate allows code signing or document signing.
+func signingUsage(cert *x509.Certificate) bool {
+	return slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageCodeSigning) ||
+		slices.ContainsFunc(cert.UnknownExtKeyUsage, oidDocumentSigning.Equal)
+}
+
+// verifyChain checks the certificate chains up to one of the roots at the time, through issuers
+// allowing its signing usage. crypto/x509 checks the nesting of the code signing usage only; the
+// issuers of a document signing certificate are checked here.
+func verifyChain(leaf *x509.Certificate, intermediates []*x509.Certificate, roots *x509.CertPool, now time.Time) error {
+	pool := x509.NewCertPool()
+	for _, c := range intermediates {
+		pool.AddCert(c)
+	}
+	opts := x509.VerifyOptions{Roots: roots, Intermediates: pool, CurrentTime: now}
+	if slices.Contains(leaf.ExtKeyUsage, x509.ExtKeyUsageCodeSigning) {
+		opts.KeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}
+		_, err := leaf.Verify(opts)
+		return err
+	}
+
+	opts.KeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageAny}
+	chains, err := leaf.Verify(opts)
+	if err != nil {
+		return err
+	}
+	for _, chain := range chains {
+		if !slices.ContainsFunc(chain[1:], func(issuer *x509.Certificate) bool { return !allowsDocumentSigning(issuer) }) {
+			return nil
+		}
+	}
+	return errors.New("an issuer of the certificate does not allow document signing")
+}
+
+// allowsDocumentSigning tells whether an issuer lets the certificates it issues sign documents: it
+// has no extended key usage, any, or document signing.
+func allowsDocumentSigning(issuer *x509.Certificate) bool {
+	if len(issuer.ExtKeyUsage) == 0 && len(issuer.UnknownExtKeyUsage) == 0 {
+		return true
+	}
+	return slices.Contains(issuer.ExtKeyUsage, x509.ExtKeyUsageAny) ||
+		slices.ContainsFunc(issuer.UnknownExtKeyUsage, oidDocumentSigning.Equal)
+}
+
+func verifyValue(pub crypto.PublicKey, msg, value []byte) error {
+	sum := sha256.Sum256(msg)
+	var ok bool
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		ok = ecdsa.VerifyASN1(k, sum[:], value)
+	case *rsa.PublicKey:
+		ok = rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], value) == nil
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(k, msg, value)
+	default:
+		return fmt.Errorf("%w: unsupported key type %T", ErrInvalidSignature, pub)
+	}
+	if !ok {
+		return fmt.Errorf("%w: the signature does not match the certificate", ErrInvalidSignature)
+	}
+	return nil
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate: %w", err)
+		}
+		chain = append(chain, c)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no PEM encoded certificate")
+	}
+	return chain, nil
+}
+
+func publicKeyEqual(a, b crypto.PublicKey) bool {
+	k, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && k.Equal(b)
+}
@@ -0,0 +1,223 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+type issuer struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+// newCertificate issues a code signing certificate for the key, self-signed when parent is nil. The
+// options change the template before it is issued.
+func newCertificate(t *testing.T, name string, key crypto.Signer, parent *issuer, ca bool, opts ...func(*x509.Certificate)) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  ca,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	for _, opt := range opts {
+		opt(tmpl)
+	}
+	signerCert, signerKey := tmpl, key
+	if parent != nil {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signerCert, key.Public(), signerKey)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+	return cert
+}
+
+func encode(t *testing.T, key crypto.Signer, certs ...*x509.Certificate) ([]byte, []byte) {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("encoding key: %v", err)
+	}
+	var chain []byte
+	for _, c := range certs {
+		chain = append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})...)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), chain
+}
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ca := &issuer{key: caKey}
+	ca.cert = newCertificate(t, "planner CA", caKey, nil, true)
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	cert := newCertificate(t, "planner exports", key, ca, false)
+
+	signer, err := NewSigner(encode(t, key, cert))
+	if err != nil {
+		t.Fatalf("creating signer: %v", err)
+	}
+	export := []byte(`{"name":"datacenter exit"}`)
+	sig, err := signer.Sign(export)
+	if err != nil {
+		t.Fatalf("signing: %v", err)
+	}
+
+	// the signature travels as a header value
+	header, err := sig.Encode()
+	if err != nil {
+		t.Fatalf("encoding: %v", err)
+	}
+	decoded, err := Decode(header)
+	if err != nil {
+		t.Fatalf("decoding: %v", err)
+	}
+
+	roots := VerifyOptions{Roots: x509.NewCertPool()}
+	roots.Roots.AddCert(ca.cert)
+	signedBy, err := Verify(export, decoded, roots)
+	if err != nil {
+		t.Fatalf("verifying: %v", err)
+	}
+	if signedBy.Subject.CommonName != "planner exports" {
+		t.Errorf("signed by %s", signedBy.Subject)
+	}
+
+	if _, err := Verify([]byte(`{"name":"datacenter exit, 2 weeks earlier"}`), decoded, roots); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected the modified export to be rejected, got: %v", err)
+	}
+	backdated := decoded
+	backdated.SignedAt = backdated.SignedAt.Add(-time.Minute)
+	if _, err := Verify(export, backdated, roots); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected the changed signing time to be rejected, got: %v", err)
+	}
+	if _, err := Verify(export, decoded, VerifyOptions{Roots: x509.NewCertPool()}); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected an untrusted certificate to be rejected, got: %v", err)
+	}
+	if _, err := Verify(export, decoded, VerifyOptions{}); err == nil {
+		t.Error("expected a verification without trusted roots nor pinned signer to fail")
+	}
+
+	// the certificate is checked at the verification time, not at the signing time the signer wrote
+	expired := roots
+	expired.Now = cert.NotAfter.Add(time.Minute)
+	if _, err := Verify(export, decoded, expired); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected an expired certificate to be rejected, got: %v", err)
+	}
+}
+
+func TestVerify_PinnedSigner(t *testing.T) {
+	t.Parallel()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	cert := newCertificate(t, "planner exports", key, nil, false)
+	signer, err := NewSigner(encode(t, key, cert))
+	if err != nil {
+		t.Fatalf("creating signer: %v", err)
+	}
+	sig, err := signer.Sign([]byte("wave,phase\n"))
+	if err != nil {
+		t.Fatalf("signing: %v", err)
+	}
+
+	if _, err := Verify([]byte("wave,phase\n"), sig, VerifyOptions{Fingerprint: strings.ToUpper(Fingerprint(cert))}); err != nil {
+		t.Errorf("verifying against the pinned signer: %v", err)
+	}
+	other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	pinned := Fingerprint(newCertificate(t, "planner exports", other, nil, false))
+	if _, err := Verify([]byte("wave,phase\n"), sig, VerifyOptions{Fingerprint: pinned}); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected another signer to be rejected, got: %v", err)
+	}
+}
+
+func TestVerify_ExtKeyUsage(t *testing.T) {
+	t.Parallel()
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ca := &issuer{key: caKey}
+	ca.cert = newCertificate(t, "planner CA", caKey, nil, true, func(c *x509.Certificate) { c.ExtKeyUsage = nil })
+	roots := VerifyOptions{Roots: x509.NewCertPool()}
+	roots.Roots.AddCert(ca.cert)
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	documentSigning := newCertificate(t, "planner exports", key, ca, false, func(c *x509.Certificate) {
+		c.ExtKeyUsage = nil
+		c.UnknownExtKeyUsage = []asn1.ObjectIdentifier{oidDocumentSigning}
+	})
+	signer, err := NewSigner(encode(t, key, documentSigning))
+	if err != nil {
+		t.Fatalf("creating document signer: %v", err)
+	}
+	sig, err := signer.Sign([]byte("wave,phase\n"))
+	if err != nil {
+		t.Fatalf("signing: %v", err)
+	}
+	if _, err := Verify([]byte("wave,phase\n"), sig, roots); err != nil {
+		t.Errorf("verifying a document signing certificate: %v", err)
+	}
+
+	// a TLS server certificate of the same CA signs nothing
+	serverAuth := newCertificate(t, "planner.example.com", key, ca, false, func(c *x509.Certificate) {
+		c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	})
+	if _, err := NewSigner(encode(t, key, serverAuth)); err == nil {
+		t.Error("expected a signer with a server certificate to be refused")
+	}
+	_, chain := encode(t, key, serverAuth)
+	sig.Certificates = string(chain)
+	if _, err := Verify([]byte("wave,phase\n"), sig, roots); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected a server certificate to be rejected, got: %v", err)
+	}
+}
+
+func TestSignVerify_Ed25519(t *testing.T) {
+	t.Parallel()
+	_, key, _ := ed25519.GenerateKey(rand.Reader)
+	cert := newCertificate(t, "self-signed", key, nil, true)
+
+	signer, err := NewSigner(encode(t, key, cert))
+	if err != nil {
+		t.Fatalf("creating signer: %v", err)
+	}
+	sig, err := signer.Sign([]byte("wave,phase\n"))
+	if err != nil {
+		t.Fatalf("signing: %v", err)
+	}
+	roots := VerifyOptions{Roots: x509.NewCertPool()}
+	roots.Roots.AddCert(cert)
+	if _, err := Verify([]byte("wave,phase\n"), sig, roots); err != nil {
+		t.Errorf("verifying: %v", err)
+	}
+}
+
+func TestNewSigner_KeyMismatch(t *testing.T) {
+	t.Parallel()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	other, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	keyPEM, _ := encode(t, key)
+	_, certPEM := encode(t, other, newCertificate(t, "other", other, nil, true))
+
+	if _, err := NewSigner(keyPEM, certPEM); err == nil {
+		t.Error("expected an error for a certificate of another key")
+	}
+}
@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// setup installs a provider recording the spans. The provider is global: the tests are not parallel.
+func setup(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return recorder
+}
+
+func TestTransport(t *testing.T) {
+	recorder := setup(t)
+
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	ctx, parent := Tracer().Start(context.Background(), "parent")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/hook?token=secret", nil)
+	resp, err := (&http.Client{Transport: Transport(nil)}).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	_ = resp.Body.Close()
+	parent.End()
+
+	if req.Header.Get("traceparent") != "" {
+		t.Errorf("expected the request of the caller left unchanged")
+	}
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	client := spans[0]
+	if client.SpanKind() != trace.SpanKindClient || client.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("expected a client span child of the caller, got %v", client)
+	}
+	if want := "00-" + client.SpanContext().TraceID().String() + "-" + client.SpanContext().SpanID().String() + "-01"; traceparent != want {
+		t.Errorf("expected traceparent %q sent, got %q", want, traceparent)
+	}
+	if client.Status().Code != codes.Error {
+		t.Errorf("expected an error status on a 502, got %v", client.Status())
+	}
+	for _, attr := range client.Attributes() {
+		if attr.Key == "url.full" && attr.Value.AsString() != srv.URL+"/hook" {
+			t.Errorf("expected the URL without its query, got %s", attr.Value.AsString())
+		}
+	}
+}
+
+func TestInjectExtract(t *testing.T) {
+	recorder := setup(t)
+
+	if carrier := Inject(context.Background()); carrier != nil {
+		t.Errorf("expected no carrier without trace, got %v", carrier)
+	}
+
+	ctx, span := Tracer().Start(context.Background(), "request")
+	carrier := Inject(ctx)
+	span.End()
+
+	_, job := Tracer().Start(Extract(context.Background(), carrier), "job")
+	End(job, errors.New("failed"))
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	if spans[1].SpanContext().TraceID() != span.SpanContext().TraceID() || spans[1].Parent().SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("expected the job to continue the trace of the request")
+	}
+	if spans[1].Status().Code != codes.Error || len(spans[1].Events()) != 1 {
+		t.Errorf("expected the error recorded on the job span, got %v", spans[1].Status())
+	}
+}
@@ -0,0 +1,246 @@
+package scenario_test
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/client"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/config"
+	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store"
+	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+const clusterID = "cluster-1"
+
+// newInventory is what the discovery of a vCenter with a single cluster reports.
+func newInventory(vms int) api.Inventory {
+	osInfo := map[string]api.OsInfo{
+		"Red Hat Enterprise Linux 9 (64-bit)": {Count: vms - 2, Supported: true},
+		"CentOS 7 (64-bit)":                   {Count: 2, Supported: false},
+	}
+	data := api.InventoryData{
+		Vms: api.VMs{
+			Total:    vms,
+			OsInfo:   &osInfo,
+			DiskGB:   api.VMResourceBreakdown{Total: vms * 120},
+			CpuCores: api.VMResourceBreakdown{Total: vms * 4},
+			RamGB:    api.VMResourceBreakdown{Total: vms * 8},
+		},
+	}
+	return api.Inventory{
+		VcenterId: "vcenter-1",
+		Vcenter:   &data,
+		Clusters:  map[string]api.InventoryData{clusterID: data},
+	}
+}
+
+// newServer boots the API the way the api server does: the handlers behind the request validator
+// of the OpenAPI spec, on top of the store.
+func newServer(s store.Store) *httptest.Server {
+	swagger, err := api.GetSwagger()
+	Expect(err).To(BeNil())
+	swagger.Servers = nil
+
+	router := chi.NewRouter()
+	authenticator, err := auth.NewNoneAuthenticator()
+	Expect(err).To(BeNil())
+	router.Use(authenticator.Authenticator, oapimiddleware.OapiRequestValidator(swagger))
+
+	h := handlers.NewServiceHandler(
+		service.NewSourceService(s, nil),
+		service.NewAssessmentService(s, nil),
+		nil,
+		service.NewSizerService(nil, s),
+		service.NewEstimationService(s),
+		service.NewPlanService(s),
+		service.NewRateCardService(s),
+	)
+	server.HandlerFromMux(server.NewStrictHandler(h, nil), router)
+	return httptest.NewServer(router)
+}
+
+// The scenario goes through the API the way a migration program does, and checks what one module
+// hands over to the next: the inventory the estimation is computed from, the estimation the plan is
+// built from, the plan the actuals are recorded against and the report rendered from all of it.
+var _ = Describe("migration program", Ordered, func() {
+	var (
+		s      store.Store
+		gormdb *gorm.DB
+		srv    *httptest.Server
+		c      *client.ClientWithResponses
+		ctx    context.Context
+		suffix string
+
+		assessment api.Assessment
+		estimate   api.MigrationEstimationResponse
+		plan       api.Plan
+	)
+
+	BeforeAll(func() {
+		cfg, err := config.New()
+		Expect(err).To(BeNil())
+		db, err := store.InitDB(cfg)
+		Expect(err).To(BeNil())
+
+		s = store.NewStore(db)
+		gormdb = db
+		srv = newServer(s)
+		c, err = client.NewClientWithResponses(srv.URL, client.WithHTTPClient(srv.Client()))
+		Expect(err).To(BeNil())
+		ctx = context.TODO()
+		suffix = uuid.NewString()[:8]
+	})
+
+	AfterAll(func() {
+		srv.Close()
+		gormdb.Exec("DELETE FROM plans WHERE name = ?;", "exit-"+suffix)
+		gormdb.Exec("DELETE FROM assessments WHERE name = ?;", "datacenter-"+suffix)
+		_ = s.Close()
+	})
+
+	It("assesses the discovered inventory", func() {
+		inventory := newInventory(40)
+		resp, err := c.CreateAssessmentWithResponse(ctx, api.AssessmentForm{
+			Name:       "datacenter-" + suffix,
+			SourceType: "inventory",
+			Inventory:  &inventory,
+		})
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode()).To(Equal(http.StatusCreated), string(resp.Body))
+		assessment = *resp.JSON201
+
+		got, err := c.GetAssessmentWithResponse(ctx, assessment.Id)
+		Expect(err).To(BeNil())
+		Expect(got.StatusCode()).To(Equal(http.StatusOK), string(got.Body))
+		Expect(got.JSON200.Snapshots).To(HaveLen(1))
+		Expect(got.JSON200.Snapshots[0].Inventory.Clusters).To(HaveKey(clusterID))
+		Expect(got.JSON200.Snapshots[0].Inventory.Clusters[clusterID].Vms.Total).To(Equal(40))
+	})
+
+	It("estimates the migration of the stored inventory", func() {
+		resp, err := c.CalculateMigrationEstimationWithResponse(ctx, assessment.Id, api.MigrationEstimationRequest{ClusterId: clusterID})
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK), string(resp.Body))
+		estimate = *resp.JSON200
+
+		Expect(estimate.Breakdown).NotTo(BeEmpty())
+		total, err := time.ParseDuration(estimate.TotalDuration)
+		Expect(err).To(BeNil())
+		for name, detail := range estimate.Breakdown {
+			d, err := time.ParseDuration(detail.Duration)
+			Expect(err).To(BeNil(), name)
+			Expect(d).To(BeNumerically(">=", 0), name)
+			Expect(d).To(BeNumerically("<=", total), name)
+		}
+	})
+
+	It("plans the waves from the estimation", func() {
+		phases := make([]string, 0, len(estimate.Breakdown))
+		for name := range estimate.Breakdown {
+			phases = append(phases, name)
+		}
+		sort.Strings(phases)
+
+		wave := func(name string) api.PlanWave {
+			w := api.PlanWave{Name: name}
+			for _, phase := range phases {
+				w.Steps = append(w.Steps, api.PlanStep{Phase: phase, Effort: estimate.Breakdown[phase].Duration, LeadTime: estimate.Breakdown[phase].LeadTime})
+			}
+			return w
+		}
+		mode := api.PlanModeSerial
+		resp, err := c.CreatePlanWithResponse(ctx, api.CreatePlanJSONRequestBody{
+			Name:  "exit-" + suffix,
+			Start: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			Mode:  &mode,
+			Waves: []api.PlanWave{wave("wave-1"), wave("wave-2")},
+		})
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode()).To(Equal(http.StatusCreated), string(resp.Body))
+		plan = *resp.JSON201
+
+		gantt, err := c.GetPlanGanttWithResponse(ctx, plan.Id, &api.GetPlanGanttParams{})
+		Expect(err).To(BeNil())
+		Expect(gantt.StatusCode()).To(Equal(http.StatusOK), string(gantt.Body))
+		chart := gantt.JSON200
+
+		// every phase of the estimation is a bar worked for exactly its estimated duration
+		Expect(chart.Waves).To(HaveLen(2))
+		Expect(chart.Bars).To(HaveLen(2 * len(phases)))
+		for _, bar := range chart.Bars {
+			effort, err := time.ParseDuration(estimate.Breakdown[bar.Phase].Duration)
+			Expect(err).To(BeNil(), bar.Phase)
+			Expect(bar.Released.Sub(bar.Start)).To(BeNumerically("~", effort, time.Second), bar.Phase)
+			Expect(bar.End).NotTo(BeTemporally("<", bar.Released), bar.Phase)
+		}
+		// serial waves never overlap and the chart spans all of them
+		Expect(chart.Waves[0].Name).To(Equal("wave-1"))
+		Expect(chart.Waves[1].Start).NotTo(BeTemporally("<", chart.Waves[0].End))
+		Expect(chart.Start).To(Equal(plan.Start))
+		Expect(chart.End).To(Equal(chart.Waves[1].End))
+	})
+
+	It("tracks the actuals recorded against the plan", func() {
+		actuals := api.RecordPlanProgressJSONRequestBody{
+			Wave:        "wave-1",
+			Start:       plan.Start,
+			End:         plan.Start.Add(7 * 24 * time.Hour),
+			VmsMigrated: 18,
+			Rollbacks:   1,
+		}
+		resp, err := c.RecordPlanProgressWithResponse(ctx, plan.Id, actuals)
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK), string(resp.Body))
+
+		got, err := c.GetPlanProgressWithResponse(ctx, plan.Id)
+		Expect(err).To(BeNil())
+		Expect(got.StatusCode()).To(Equal(http.StatusOK), string(got.Body))
+		Expect(got.JSON200.Waves).To(HaveLen(1))
+		Expect(got.JSON200.Waves[0].VmsMigrated).To(Equal(18))
+		Expect(got.JSON200.Waves[0].VmsMigrated).To(BeNumerically("<=", assessment.Snapshots[0].Inventory.Clusters[clusterID].Vms.Total))
+		Expect(got.JSON200.Kpis).NotTo(BeEmpty())
+
+		// an unknown wave is rejected instead of skewing the KPIs
+		actuals.Wave = "wave-9"
+		resp, err = c.RecordPlanProgressWithResponse(ctx, plan.Id, actuals)
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("renders the report of the plan", func() {
+		resp, err := c.ExportPlanWithResponse(ctx, plan.Id, &api.ExportPlanParams{Format: api.ExportFormatSmartsheet})
+		Expect(err).To(BeNil())
+		Expect(resp.StatusCode()).To(Equal(http.StatusOK), string(resp.Body))
+
+		rows, err := csv.NewReader(strings.NewReader(string(resp.Body))).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(rows).NotTo(BeEmpty())
+		Expect(rows[0][0]).To(Equal("Task Name"))
+
+		// the report lists every phase of every wave of the plan
+		tasks := map[string]int{}
+		for _, row := range rows[1:] {
+			if row[2] == "1" {
+				tasks[row[0]]++
+			}
+		}
+		for phase := range estimate.Breakdown {
+			Expect(tasks).To(HaveKeyWithValue(phase, 2))
+		}
+	})
+})